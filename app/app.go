@@ -2,11 +2,13 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	stdlog "log"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/gorilla/mux"
 	"github.com/rakyll/statik/fs"
@@ -37,6 +39,7 @@ import (
 	evmKeeper "github.com/axelarnetwork/axelar-core/x/evm/keeper"
 	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
 	"github.com/axelarnetwork/axelar-core/x/nexus"
+	nexusExported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	nexusKeeper "github.com/axelarnetwork/axelar-core/x/nexus/keeper"
 	nexusTypes "github.com/axelarnetwork/axelar-core/x/nexus/types"
 	"github.com/axelarnetwork/axelar-core/x/permission"
@@ -182,6 +185,20 @@ var (
 	_ servertypes.Application = (*AxelarApp)(nil)
 )
 
+// chainAdapters are the adapters of external chains that are compiled into the app
+var chainAdapters = make(map[string]nexusExported.ChainAdapter)
+
+// RegisterChainAdapter registers the adapter of an external chain for every app created afterwards,
+// e.g. from an init function of the adapter's package. Panics if an adapter for the chain has been registered already
+func RegisterChainAdapter(chain string, adapter nexusExported.ChainAdapter) {
+	chain = strings.ToLower(chain)
+	if _, ok := chainAdapters[chain]; ok {
+		panic(fmt.Sprintf("adapter for chain %s has already been registered", chain))
+	}
+
+	chainAdapters[chain] = adapter
+}
+
 func init() {
 	userHomeDir, err := os.UserHomeDir()
 	if err != nil {
@@ -364,11 +381,11 @@ func NewAxelarApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		appCodec, keys[rewardTypes.StoreKey], app.getSubspace(rewardTypes.ModuleName), bankK, distrK, stakingK,
 	)
 	tssK := tssKeeper.NewKeeper(
-		appCodec, keys[tssTypes.StoreKey], app.getSubspace(tssTypes.ModuleName), slashingK, rewardK,
+		appCodec, keys[tssTypes.StoreKey], app.getSubspace(tssTypes.ModuleName), stakingK, slashingK, rewardK,
 	)
 	snapK := snapKeeper.NewKeeper(
 		appCodec, keys[snapTypes.StoreKey], app.getSubspace(snapTypes.ModuleName), stakingK, bankK,
-		slashingK, tssK,
+		feegrantK, slashingK, tssK,
 	)
 	votingK := voteKeeper.NewKeeper(
 		appCodec, keys[voteTypes.StoreKey], app.getSubspace(voteTypes.ModuleName), snapK, stakingK, rewardK,
@@ -388,9 +405,12 @@ func NewAxelarApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	nexusRouter := nexusTypes.NewRouter()
 	nexusRouter.AddAddressValidator(evmTypes.ModuleName, evmKeeper.NewAddressValidator()).
 		AddAddressValidator(axelarnetTypes.ModuleName, axelarnetKeeper.NewAddressValidator(axelarnetK))
+	for chain, adapter := range chainAdapters {
+		nexusRouter.AddChainAdapter(chain, adapter)
+	}
 	nexusK.SetRouter(nexusRouter)
 
-	axelarnetModule := axelarnet.NewAppModule(axelarnetK, nexusK, bankK, app.transferKeeper, app.ibcKeeper.ChannelKeeper, accountK, transferModule, logger)
+	axelarnetModule := axelarnet.NewAppModule(axelarnetK, nexusK, bankK, app.transferKeeper, app.ibcKeeper.ChannelKeeper, app.ibcKeeper.ClientKeeper, accountK, transferModule, logger)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
@@ -434,7 +454,7 @@ func NewAxelarApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		snapshot.NewAppModule(snapK, nexusK),
 		tss.NewAppModule(tssK, snapK, votingK, nexusK, stakingK, rewardK),
 		vote.NewAppModule(votingK),
-		nexus.NewAppModule(nexusK, snapK, stakingK),
+		nexus.NewAppModule(nexusK, snapK, votingK, stakingK),
 		evm.NewAppModule(evmK, tssK, votingK, tssK, nexusK, snapK, logger),
 		axelarnetModule,
 		reward.NewAppModule(rewardK, nexusK, mintK, stakingK, tssK, snapK, bankK, bApp.MsgServiceRouter(), bApp.Router()),
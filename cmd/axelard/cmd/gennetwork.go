@@ -46,7 +46,7 @@ func SetGenesisChainParamsCmd(defaultNodeHome string) *cobra.Command {
 		Short: "Set chain parameters in genesis.json",
 		Long: "Set chain parameters in genesis.json. " +
 			"The provided platform must be one of those axelar supports (bitcoin, EVM). " +
-			"In the case of Bitcoin, there is no need for the chain argument.",
+			"In the case of Bitcoin, the chain argument is optional and defaults to Bitcoin.",
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
@@ -70,9 +70,23 @@ func SetGenesisChainParamsCmd(defaultNodeHome string) *cobra.Command {
 
 			switch strings.ToLower(platformStr) {
 			case strings.ToLower(btc.Bitcoin.Name):
+				utxoChainName := btc.Bitcoin.Name
+				if len(args) > 1 {
+					utxoChainName = args[1]
+				}
+
+				// fetch existing UTXO chain, or add new one
 				genesisState := bitcoinTypes.GetGenesisStateFromAppState(cdc, appState)
 				moduleName = bitcoinTypes.ModuleName
 
+				_, index := findUTXOChain(genesisState.Chains, utxoChainName)
+				if index < 0 {
+					chain := bitcoinTypes.GenesisState_Chain{Params: bitcoinTypes.DefaultParams()}
+					chain.Params.Chain = utxoChainName
+					genesisState.Chains = append(genesisState.Chains, chain)
+					index = len(genesisState.Chains) - 1
+				}
+
 				// update expected network
 				if expectedNetwork != "" {
 					network, err := bitcoinTypes.NetworkFromStr(expectedNetwork)
@@ -80,17 +94,17 @@ func SetGenesisChainParamsCmd(defaultNodeHome string) *cobra.Command {
 						return err
 					}
 
-					genesisState.Params.Network = network
+					genesisState.Chains[index].Params.Network = network
 				}
 
 				// update confirmation height
 				if confirmationHeight > 0 {
-					genesisState.Params.ConfirmationHeight = confirmationHeight
+					genesisState.Chains[index].Params.ConfirmationHeight = confirmationHeight
 				}
 
 				// update revote locking period
 				if revoteLockingPeriod > 0 {
-					genesisState.Params.RevoteLockingPeriod = revoteLockingPeriod
+					genesisState.Chains[index].Params.RevoteLockingPeriod = revoteLockingPeriod
 				}
 
 				genesisStateBz, err = cdc.MarshalJSON(&genesisState)
@@ -205,6 +219,17 @@ func findEVMChain(chains []evmTypes.GenesisState_Chain, chainName string) (chain
 	return
 }
 
+func findUTXOChain(chains []bitcoinTypes.GenesisState_Chain, chainName string) (chain bitcoinTypes.GenesisState_Chain, index int) {
+	for index, chain = range chains {
+		if strings.EqualFold(chainName, chain.Params.Chain) {
+			return
+		}
+	}
+
+	index = -1
+	return
+}
+
 func findEVMNetwork(networks []evmTypes.NetworkInfo, network string) (index int) {
 	var info evmTypes.NetworkInfo
 	for index, info = range networks {
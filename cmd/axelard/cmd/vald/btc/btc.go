@@ -1,14 +1,17 @@
 package btc
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
 
 	reward "github.com/axelarnetwork/axelar-core/x/reward/types"
 	sdkClient "github.com/cosmos/cosmos-sdk/client"
 	sdkFlags "github.com/cosmos/cosmos-sdk/client/flags"
 
 	tmEvents "github.com/axelarnetwork/tm-events/events"
+	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcutil"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -21,19 +24,19 @@ import (
 	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
 )
 
-// Mgr manages all communication with Bitcoin
+// Mgr manages all communication with Bitcoin and other UTXO chains
 type Mgr struct {
 	cliCtx      sdkClient.Context
 	logger      log.Logger
 	broadcaster types.Broadcaster
-	rpc         rpc3.Client
+	rpcs        map[string]rpc3.Client
 	cdc         *codec.LegacyAmino
 }
 
-// NewMgr returns a new Mgr instance
-func NewMgr(rpc rpc3.Client, cliCtx sdkClient.Context, broadcaster types.Broadcaster, logger log.Logger, cdc *codec.LegacyAmino) *Mgr {
+// NewMgr returns a new Mgr instance. The rpc clients are mapped by lower case chain name
+func NewMgr(rpcs map[string]rpc3.Client, cliCtx sdkClient.Context, broadcaster types.Broadcaster, logger log.Logger, cdc *codec.LegacyAmino) *Mgr {
 	return &Mgr{
-		rpc:         rpc,
+		rpcs:        rpcs,
 		cliCtx:      cliCtx,
 		logger:      logger.With("listener", "btc"),
 		broadcaster: broadcaster,
@@ -41,23 +44,24 @@ func NewMgr(rpc rpc3.Client, cliCtx sdkClient.Context, broadcaster types.Broadca
 	}
 }
 
-// ProcessConfirmation votes on the correctness of a Bitcoin deposit
+// ProcessConfirmation votes on the correctness of a deposit on a UTXO chain
 func (mgr *Mgr) ProcessConfirmation(e tmEvents.Event) error {
-	if mgr.rpc == nil {
-		mgr.logger.Error("no bitcoin rpc endpoint was provided during start-up, ignoring confirmation event")
-		return nil
-	}
-
-	outPointInfo, confHeight, pollKey, err := parseConfirmationParams(mgr.cdc, e.Attributes)
+	chain, outPointInfo, confHeight, pollKey, err := parseConfirmationParams(mgr.cdc, e.Attributes)
 	if err != nil {
 		return sdkerrors.Wrap(err, "Bitcoin transaction confirmation failed")
 	}
 
-	err = confirmTx(mgr.rpc, outPointInfo, confHeight)
+	rpc, ok := mgr.rpcs[strings.ToLower(chain)]
+	if !ok {
+		mgr.logger.Error(fmt.Sprintf("no rpc endpoint for chain %s was provided during start-up, ignoring confirmation event", chain))
+		return nil
+	}
+
+	err = confirmTx(rpc, outPointInfo, confHeight)
 	if err != nil {
 		mgr.logger.Debug(sdkerrors.Wrap(err, "tx outpoint confirmation failed").Error())
 	}
-	msg := btc.NewVoteConfirmOutpointRequest(mgr.cliCtx.FromAddress, pollKey, outPointInfo.GetOutPoint(), err == nil)
+	msg := btc.NewVoteConfirmOutpointRequest(mgr.cliCtx.FromAddress, chain, pollKey, outPointInfo.GetOutPoint(), err == nil)
 	refundableMsg := reward.NewRefundMsgRequest(mgr.cliCtx.FromAddress, msg)
 
 	mgr.logger.Debug(fmt.Sprintf("broadcasting vote %v for poll %s", msg.Confirmed, pollKey.String()))
@@ -65,8 +69,9 @@ func (mgr *Mgr) ProcessConfirmation(e tmEvents.Event) error {
 	return err
 }
 
-func parseConfirmationParams(cdc *codec.LegacyAmino, attributes map[string]string) (outPoint btc.OutPointInfo, confHeight int64, pollKey vote.PollKey, err error) {
+func parseConfirmationParams(cdc *codec.LegacyAmino, attributes map[string]string) (chain string, outPoint btc.OutPointInfo, confHeight int64, pollKey vote.PollKey, err error) {
 	parsers := []*parse.AttributeParser{
+		{Key: btc.AttributeKeyChain, Map: parse.IdentityMap},
 		{Key: btc.AttributeKeyOutPointInfo, Map: func(s string) (interface{}, error) {
 			cdc.MustUnmarshalJSON([]byte(s), &outPoint)
 			return outPoint, nil
@@ -80,10 +85,10 @@ func parseConfirmationParams(cdc *codec.LegacyAmino, attributes map[string]strin
 
 	results, err := parse.Parse(attributes, parsers)
 	if err != nil {
-		return btc.OutPointInfo{}, 0, vote.PollKey{}, err
+		return "", btc.OutPointInfo{}, 0, vote.PollKey{}, err
 	}
 
-	return results[0].(btc.OutPointInfo), results[1].(int64), results[2].(vote.PollKey), nil
+	return results[0].(string), results[1].(btc.OutPointInfo), results[2].(int64), results[3].(vote.PollKey), nil
 }
 
 func confirmTx(rpc rpc3.Client, outPointInfo btc.OutPointInfo, requiredConfirmations int64) error {
@@ -100,7 +105,7 @@ func confirmTx(rpc rpc3.Client, outPointInfo btc.OutPointInfo, requiredConfirmat
 		return fmt.Errorf("deposit must be only spendable by a single address")
 	}
 
-	if actualTxOut.ScriptPubKey.Addresses[0] != outPointInfo.Address {
+	if !paysToAddress(actualTxOut.ScriptPubKey, outPointInfo.Address) {
 		return fmt.Errorf("expected destination address does not match actual destination address")
 	}
 
@@ -116,3 +121,18 @@ func confirmTx(rpc rpc3.Client, outPointInfo btc.OutPointInfo, requiredConfirmat
 
 	return nil
 }
+
+// paysToAddress returns true if the given script pays to the given address. Nodes of some chains, e.g. bitcoin cash,
+// encode addresses differently from axelar, so the scripts are compared if the addresses do not match
+func paysToAddress(scriptPubKey btcjson.ScriptPubKeyResult, address string) bool {
+	if scriptPubKey.Addresses[0] == address {
+		return true
+	}
+
+	expected, err := btc.PayToScriptAddrScript(address)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(hex.EncodeToString(expected), scriptPubKey.Hex)
+}
@@ -1,14 +1,18 @@
 package btc
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 
 	rewardtypes "github.com/axelarnetwork/axelar-core/x/reward/types"
 	tmEvents "github.com/axelarnetwork/tm-events/events"
 	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -18,9 +22,11 @@ import (
 
 	"github.com/axelarnetwork/axelar-core/app"
 	mock3 "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster/types/mock"
+	rpc3 "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/btc/rpc"
 	mock2 "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/btc/rpc/mock"
 	"github.com/axelarnetwork/axelar-core/testutils"
 	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	btcexported "github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
 	btc "github.com/axelarnetwork/axelar-core/x/bitcoin/types"
 	"github.com/axelarnetwork/axelar-core/x/vote/exported"
 )
@@ -41,13 +47,14 @@ func TestMgr_ProcessConfirmation(t *testing.T) {
 		broadcaster = &mock3.BroadcasterMock{}
 		ctx := client.Context{}
 
-		mgr = NewMgr(rpc, ctx, broadcaster, log.TestingLogger(), cdc)
+		mgr = NewMgr(map[string]rpc3.Client{strings.ToLower(btcexported.Bitcoin.Name): rpc}, ctx, broadcaster, log.TestingLogger(), cdc)
 
 		confHeight = rand.PosI64()
 		pollKey := exported.NewPollKey(btc.ModuleName, rand.StrBetween(1, 100))
 
 		info = randomOutpointInfo()
 		attributes = map[string]string{
+			btc.AttributeKeyChain:        btcexported.Bitcoin.Name,
 			btc.AttributeKeyConfHeight:   strconv.FormatInt(confHeight, 10),
 			btc.AttributeKeyOutPointInfo: string(mgr.cdc.MustMarshalJSON(info)),
 			btc.AttributeKeyPoll:         string(mgr.cdc.MustMarshalJSON(pollKey)),
@@ -128,6 +135,34 @@ func TestMgr_ProcessConfirmation(t *testing.T) {
 		assert.False(t, msg.(*btc.VoteConfirmOutpointRequest).Confirmed)
 	})
 
+	t.Run("happy path with differently encoded address", testutils.Func(func(t *testing.T) {
+		setup()
+		addr, err := btcutil.NewAddressScriptHash(rand.Bytes(int(rand.I64Between(1, 100))), &chaincfg.MainNetParams)
+		if err != nil {
+			panic(err)
+		}
+		info.Address = addr.EncodeAddress()
+		attributes[btc.AttributeKeyOutPointInfo] = string(mgr.cdc.MustMarshalJSON(info))
+		payScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			panic(err)
+		}
+
+		rpc.GetTxOutFunc = func(*chainhash.Hash, uint32, bool) (*btcjson.GetTxOutResult, error) {
+			return &btcjson.GetTxOutResult{
+				Confirmations: rand.PInt64Gen().Where(func(h int64) bool { return h >= confHeight }).Next(),
+				Value:         info.Amount.ToBTC(),
+				ScriptPubKey:  btcjson.ScriptPubKeyResult{Addresses: []string{"bitcoincash:" + rand.StrBetween(10, 50)}, Hex: hex.EncodeToString(payScript)},
+			}, nil
+		}
+
+		err = mgr.ProcessConfirmation(tmEvents.Event{Attributes: attributes})
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0])
+		assert.True(t, msg.(*btc.VoteConfirmOutpointRequest).Confirmed)
+	}).Repeat(repetitionCount))
+
 	t.Run("happy path", testutils.Func(func(t *testing.T) {
 		setup()
 		rpc.GetTxOutFunc = func(*chainhash.Hash, uint32, bool) (*btcjson.GetTxOutResult, error) {
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"time"
 
 	bitcoin "github.com/axelarnetwork/axelar-core/x/bitcoin/types"
@@ -14,7 +15,9 @@ type ValdConfig struct {
 	tss.TssConfig     `mapstructure:",squash"`
 	BroadcastConfig   `mapstructure:",squash"`
 
-	EVMConfig []evm.EVMConfig `mapstructure:"axelar_bridge_evm"`
+	UTXOConfig    []bitcoin.BtcConfig `mapstructure:"axelar_bridge_utxo"`
+	EVMConfig     []evm.EVMConfig     `mapstructure:"axelar_bridge_evm"`
+	RelayerConfig RelayerConfig       `mapstructure:"evm_relayer"`
 }
 
 // DefaultValdConfig returns a configurations populated with default values
@@ -24,6 +27,7 @@ func DefaultValdConfig() ValdConfig {
 		BtcConfig:       bitcoin.DefaultConfig(),
 		TssConfig:       tss.DefaultConfig(),
 		BroadcastConfig: DefaultBroadcastConfig(),
+		RelayerConfig:   DefaultRelayerConfig(),
 	}
 }
 
@@ -40,3 +44,50 @@ func DefaultBroadcastConfig() BroadcastConfig {
 		MinTimeout: 5 * time.Second,
 	}
 }
+
+// RelayerConfig is the configuration for the relayer of signed EVM command batches
+type RelayerConfig struct {
+	Enabled bool `mapstructure:"enable"`
+	// file containing the hex encoded private key of the EVM account that pays for the relayed transactions
+	PrivateKeyFile string `mapstructure:"private-key-file"`
+	// chains to relay to, all chains with a bridge if empty
+	Chains []string `mapstructure:"chains"`
+	// percentage by which the gas price is increased when a transaction is resubmitted
+	GasBumpPercent uint64 `mapstructure:"gas-bump-percent"`
+	// upper limit of the gas price in wei, no limit if zero
+	MaxGasPrice     uint64        `mapstructure:"max-gas-price"`
+	ResubmitTimeout time.Duration `mapstructure:"resubmit-timeout"`
+	MaxResubmits    int           `mapstructure:"max-resubmits"`
+}
+
+// DefaultRelayerConfig returns a configurations populated with default values
+func DefaultRelayerConfig() RelayerConfig {
+	return RelayerConfig{
+		Enabled:         false,
+		GasBumpPercent:  20,
+		ResubmitTimeout: 2 * time.Minute,
+		MaxResubmits:    5,
+	}
+}
+
+// Validate returns an error if the relayer configuration is invalid
+func (c RelayerConfig) Validate() error {
+	if c.PrivateKeyFile == "" {
+		return fmt.Errorf("relayer private key file must be set")
+	}
+
+	// nodes reject replacement transactions that do not increase the gas price by at least 10%
+	if c.GasBumpPercent < 10 {
+		return fmt.Errorf("relayer gas bump must be at least 10%%")
+	}
+
+	if c.ResubmitTimeout <= 0 {
+		return fmt.Errorf("relayer resubmit timeout must be positive")
+	}
+
+	if c.MaxResubmits < 0 {
+		return fmt.Errorf("relayer max resubmits must not be negative")
+	}
+
+	return nil
+}
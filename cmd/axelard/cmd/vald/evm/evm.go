@@ -3,6 +3,7 @@ package evm
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"sort"
@@ -17,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	geth "github.com/ethereum/go-ethereum/core/types"
@@ -39,8 +41,15 @@ var (
 	SinglesigTransferOperatorshipSig = crypto.Keccak256Hash([]byte("OperatorshipTransferred(address,address)"))
 	MultisigTransferOwnershipSig     = crypto.Keccak256Hash([]byte("OwnershipTransferred(address[],uint256,address[],uint256)"))
 	MultisigTransferOperatorshipSig  = crypto.Keccak256Hash([]byte("OperatorshipTransferred(address[],uint256,address[],uint256)"))
+	ExecutedSig                      = crypto.Keccak256Hash([]byte("Executed(bytes32)"))
+	UpgradedSig                      = crypto.Keccak256Hash([]byte("Upgraded(address)"))
 )
 
+var errTraceFailed = errors.New("could not trace transaction")
+
+// ERC20DecimalsSelector is the call data of the ERC20 decimals() function
+var ERC20DecimalsSelector = crypto.Keccak256([]byte("decimals()"))[:4]
+
 // Mgr manages all communication with Ethereum
 type Mgr struct {
 	cliCtx      sdkClient.Context
@@ -90,7 +99,7 @@ func (mgr Mgr) ProcessChainConfirmation(e tmEvents.Event) (err error) {
 
 // ProcessGatewayDeploymentConfirmation votes on an EVM chain's gateway deployment
 func (mgr Mgr) ProcessGatewayDeploymentConfirmation(e tmEvents.Event) error {
-	chain, txID, address, bytecodeHash, confHeight, pollKey, err := parseGatewayDeploymentParams(mgr.cdc, e.Attributes)
+	chain, txID, address, bytecodeHash, confHeight, finalityMode, pollKey, err := parseGatewayDeploymentParams(mgr.cdc, e.Attributes)
 	if err != nil {
 		return sdkerrors.Wrap(err, "EVM gateway deployment confirmation failed")
 	}
@@ -100,7 +109,7 @@ func (mgr Mgr) ProcessGatewayDeploymentConfirmation(e tmEvents.Event) error {
 		return sdkerrors.Wrap(err, fmt.Sprintf("Unable to find an RPC for chain '%s'", chain))
 	}
 
-	confirmed := mgr.validate(rpc, txID, confHeight, func(tx *geth.Transaction, txReceipt *geth.Receipt) bool {
+	confirmed := mgr.validate(rpc, txID, confHeight, finalityMode, func(tx *geth.Transaction, txReceipt *geth.Receipt) bool {
 		if !bytes.Equal(crypto.Keccak256(tx.Data()), bytecodeHash.Bytes()) {
 			return false
 		}
@@ -123,7 +132,7 @@ func (mgr Mgr) ProcessGatewayDeploymentConfirmation(e tmEvents.Event) error {
 
 // ProcessDepositConfirmation votes on the correctness of an EVM chain token deposit
 func (mgr Mgr) ProcessDepositConfirmation(e tmEvents.Event) (err error) {
-	chain, txID, amount, burnAddr, tokenAddr, confHeight, pollKey, err := parseDepositConfirmationParams(mgr.cdc, e.Attributes)
+	chain, txID, amount, burnAddr, tokenAddr, confHeight, finalityMode, pollKey, err := parseDepositConfirmationParams(mgr.cdc, e.Attributes)
 	if err != nil {
 		return sdkerrors.Wrap(err, "EVM deposit confirmation failed")
 	}
@@ -133,8 +142,8 @@ func (mgr Mgr) ProcessDepositConfirmation(e tmEvents.Event) (err error) {
 		return sdkerrors.Wrap(err, fmt.Sprintf("Unable to find an RPC for chain '%s'", chain))
 	}
 
-	confirmed := mgr.validate(rpc, txID, confHeight, func(_ *geth.Transaction, txReceipt *geth.Receipt) bool {
-		err = confirmERC20Deposit(txReceipt, amount, burnAddr, tokenAddr)
+	confirmed := mgr.validate(rpc, txID, confHeight, finalityMode, func(_ *geth.Transaction, txReceipt *geth.Receipt) bool {
+		err = confirmDeposit(rpc, txReceipt, amount, burnAddr, tokenAddr)
 		if err != nil {
 			mgr.logger.Debug(sdkerrors.Wrap(err, "deposit confirmation failed").Error())
 			return false
@@ -149,9 +158,45 @@ func (mgr Mgr) ProcessDepositConfirmation(e tmEvents.Event) (err error) {
 	return err
 }
 
+// ProcessDepositReverification votes on whether a previously confirmed EVM chain token deposit is still part of the canonical chain
+func (mgr Mgr) ProcessDepositReverification(e tmEvents.Event) (err error) {
+	chain, txID, amount, burnAddr, tokenAddr, confHeight, finalityMode, pollKey, err := parseDepositConfirmationParams(mgr.cdc, e.Attributes)
+	if err != nil {
+		return sdkerrors.Wrap(err, "EVM deposit reverification failed")
+	}
+
+	rpc, found := mgr.rpcs[strings.ToLower(chain)]
+	if !found {
+		return fmt.Errorf("unable to find an RPC for chain '%s'", chain)
+	}
+
+	// a vote against the deposit reverts its transfer, so no vote is cast unless the node could be queried successfully
+	txReceipt, canonical, err := mgr.getCanonicalReceipt(rpc, txID, confHeight, finalityMode)
+	if err != nil {
+		return sdkerrors.Wrap(err, "EVM deposit reverification failed")
+	}
+
+	if canonical {
+		err := confirmDeposit(rpc, txReceipt, amount, burnAddr, tokenAddr)
+		switch {
+		case errors.Is(err, errTraceFailed):
+			return sdkerrors.Wrap(err, "EVM deposit reverification failed")
+		case err != nil:
+			mgr.logger.Debug(sdkerrors.Wrap(err, "deposit reverification failed").Error())
+			canonical = false
+		}
+	}
+
+	msg := evmTypes.NewVoteReverifyDepositRequest(mgr.cliCtx.FromAddress, chain, pollKey, txID, evmTypes.Address(burnAddr), canonical)
+	refundableMsg := reward.NewRefundMsgRequest(mgr.cliCtx.FromAddress, msg)
+	mgr.logger.Info(fmt.Sprintf("broadcasting vote %v for poll %s", msg.Canonical, pollKey.String()))
+	_, err = mgr.broadcaster.Broadcast(mgr.cliCtx.WithBroadcastMode(sdkFlags.BroadcastBlock), refundableMsg)
+	return err
+}
+
 // ProcessTokenConfirmation votes on the correctness of an EVM chain token deployment
 func (mgr Mgr) ProcessTokenConfirmation(e tmEvents.Event) error {
-	chain, txID, gatewayAddr, tokenAddr, asset, symbol, confHeight, pollKey, err := parseTokenConfirmationParams(mgr.cdc, e.Attributes)
+	chain, txID, gatewayAddr, tokenAddr, asset, symbol, decimals, confHeight, finalityMode, pollKey, err := parseTokenConfirmationParams(mgr.cdc, e.Attributes)
 	if err != nil {
 		return sdkerrors.Wrap(err, "EVM token deployment confirmation failed")
 	}
@@ -161,12 +206,19 @@ func (mgr Mgr) ProcessTokenConfirmation(e tmEvents.Event) error {
 		return sdkerrors.Wrap(err, fmt.Sprintf("Unable to find an RPC for chain '%s'", chain))
 	}
 
-	confirmed := mgr.validate(rpc, txID, confHeight, func(_ *geth.Transaction, txReceipt *geth.Receipt) bool {
+	confirmed := mgr.validate(rpc, txID, confHeight, finalityMode, func(_ *geth.Transaction, txReceipt *geth.Receipt) bool {
 		err = confirmERC20TokenDeployment(txReceipt, symbol, gatewayAddr, tokenAddr)
 		if err != nil {
 			mgr.logger.Debug(sdkerrors.Wrap(err, "token confirmation failed").Error())
 			return false
 		}
+
+		// external tokens are registered at an arbitrary address, so the contract must match the registered details
+		err = confirmERC20TokenDecimals(rpc, txReceipt.BlockNumber, tokenAddr, decimals)
+		if err != nil {
+			mgr.logger.Debug(sdkerrors.Wrap(err, "token confirmation failed").Error())
+			return false
+		}
 		return true
 	})
 
@@ -179,7 +231,7 @@ func (mgr Mgr) ProcessTokenConfirmation(e tmEvents.Event) error {
 
 // ProcessTransferKeyConfirmation votes on the correctness of an EVM chain key transfer
 func (mgr Mgr) ProcessTransferKeyConfirmation(e tmEvents.Event) (err error) {
-	chain, txID, transferKeyType, keyType, gatewayAddr, newAddrs, threshold, confHeight, pollKey, err := parseTransferKeyConfirmationParams(mgr.cdc, e.Attributes)
+	chain, txID, transferKeyType, keyType, gatewayAddr, newAddrs, threshold, confHeight, finalityMode, pollKey, err := parseTransferKeyConfirmationParams(mgr.cdc, e.Attributes)
 	if err != nil {
 		return sdkerrors.Wrap(err, "EVM key transfer confirmation failed")
 	}
@@ -189,7 +241,7 @@ func (mgr Mgr) ProcessTransferKeyConfirmation(e tmEvents.Event) (err error) {
 		return sdkerrors.Wrap(err, fmt.Sprintf("Unable to find an RPC for chain '%s'", chain))
 	}
 
-	confirmed := mgr.validate(rpc, txID, confHeight, func(_ *geth.Transaction, txReceipt *geth.Receipt) bool {
+	confirmed := mgr.validate(rpc, txID, confHeight, finalityMode, func(_ *geth.Transaction, txReceipt *geth.Receipt) bool {
 		switch keyType {
 		case tss.Threshold:
 			if err = confirmSinglesigTransferKey(txReceipt, transferKeyType, gatewayAddr, newAddrs[0]); err != nil {
@@ -216,6 +268,127 @@ func (mgr Mgr) ProcessTransferKeyConfirmation(e tmEvents.Event) (err error) {
 	return err
 }
 
+// ProcessBatchExecutionConfirmation votes on the commands that have been executed by the gateway in a transaction
+func (mgr Mgr) ProcessBatchExecutionConfirmation(e tmEvents.Event) (err error) {
+	chain, txID, gatewayAddr, confHeight, finalityMode, pollKey, err := parseBatchExecutionConfirmationParams(mgr.cdc, e.Attributes)
+	if err != nil {
+		return sdkerrors.Wrap(err, "EVM batch execution confirmation failed")
+	}
+
+	rpc, found := mgr.rpcs[strings.ToLower(chain)]
+	if !found {
+		return fmt.Errorf("unable to find an RPC for chain '%s'", chain)
+	}
+
+	var executedCommandIDs []evmTypes.CommandID
+	mgr.validate(rpc, txID, confHeight, finalityMode, func(_ *geth.Transaction, txReceipt *geth.Receipt) bool {
+		executedCommandIDs = getExecutedCommandIDs(txReceipt, gatewayAddr)
+		return true
+	})
+
+	msg := evmTypes.NewVoteConfirmBatchExecutionRequest(mgr.cliCtx.FromAddress, chain, pollKey, executedCommandIDs)
+	refundableMsg := reward.NewRefundMsgRequest(mgr.cliCtx.FromAddress, msg)
+	mgr.logger.Info(fmt.Sprintf("broadcasting vote for %d executed command(s) for poll %s", len(executedCommandIDs), pollKey.String()))
+	_, err = mgr.broadcaster.Broadcast(mgr.cliCtx.WithBroadcastMode(sdkFlags.BroadcastBlock), refundableMsg)
+	return err
+}
+
+// ProcessGasInfoConfirmation votes on the base fee, priority fee and block gas limit of a chain at a given block
+func (mgr Mgr) ProcessGasInfoConfirmation(e tmEvents.Event) (err error) {
+	chain, blockNumber, confHeight, finalityMode, pollKey, err := parseGasInfoConfirmationParams(mgr.cdc, e.Attributes)
+	if err != nil {
+		return sdkerrors.Wrap(err, "EVM gas info confirmation failed")
+	}
+
+	rpc, found := mgr.rpcs[strings.ToLower(chain)]
+	if !found {
+		return fmt.Errorf("unable to find an RPC for chain '%s'", chain)
+	}
+
+	gasInfo, ok := mgr.getGasInfo(rpc, blockNumber, confHeight, finalityMode)
+	if !ok {
+		gasInfo = evmTypes.GasInfo{BaseFee: sdk.ZeroInt(), PriorityFee: sdk.ZeroInt()}
+	}
+
+	msg := evmTypes.NewVoteConfirmGasInfoRequest(mgr.cliCtx.FromAddress, chain, pollKey, gasInfo)
+	refundableMsg := reward.NewRefundMsgRequest(mgr.cliCtx.FromAddress, msg)
+	mgr.logger.Info(fmt.Sprintf("broadcasting vote %t on gas info at block %d for poll %s", ok, blockNumber, pollKey.String()))
+	_, err = mgr.broadcaster.Broadcast(mgr.cliCtx.WithBroadcastMode(sdkFlags.BroadcastBlock), refundableMsg)
+	return err
+}
+
+// ProcessGatewayUpgradeConfirmation votes on the correctness of an upgrade of the gateway to a new implementation
+func (mgr Mgr) ProcessGatewayUpgradeConfirmation(e tmEvents.Event) (err error) {
+	chain, txID, gatewayAddr, implementation, confHeight, finalityMode, pollKey, err := parseGatewayUpgradeConfirmationParams(mgr.cdc, e.Attributes)
+	if err != nil {
+		return sdkerrors.Wrap(err, "EVM gateway upgrade confirmation failed")
+	}
+
+	rpc, found := mgr.rpcs[strings.ToLower(chain)]
+	if !found {
+		return fmt.Errorf("unable to find an RPC for chain '%s'", chain)
+	}
+
+	confirmed := mgr.validate(rpc, txID, confHeight, finalityMode, func(_ *geth.Transaction, txReceipt *geth.Receipt) bool {
+		if err := confirmGatewayUpgrade(txReceipt, gatewayAddr, implementation); err != nil {
+			mgr.logger.Debug(sdkerrors.Wrap(err, "gateway upgrade confirmation failed").Error())
+			return false
+		}
+
+		return true
+	})
+
+	msg := evmTypes.NewVoteConfirmGatewayUpgradeRequest(mgr.cliCtx.FromAddress, chain, pollKey, txID, confirmed)
+	refundableMsg := reward.NewRefundMsgRequest(mgr.cliCtx.FromAddress, msg)
+	mgr.logger.Info(fmt.Sprintf("broadcasting vote %v for poll %s", msg.Confirmed, pollKey.String()))
+	_, err = mgr.broadcaster.Broadcast(mgr.cliCtx.WithBroadcastMode(sdkFlags.BroadcastBlock), refundableMsg)
+	return err
+}
+
+func (mgr Mgr) getGasInfo(rpc rpc.Client, blockNumber uint64, confHeight uint64, finalityMode evmTypes.FinalityMode) (evmTypes.GasInfo, bool) {
+	block, err := rpc.BlockByNumber(context.Background(), new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		mgr.logger.Debug(sdkerrors.Wrap(err, "block by number call failed").Error())
+		return evmTypes.GasInfo{}, false
+	}
+
+	if !mgr.isFinalized(rpc, blockNumber, block.Hash(), confHeight, finalityMode) {
+		return evmTypes.GasInfo{}, false
+	}
+
+	// chains that do not support EIP-1559 have no base fee, so the whole gas price counts as priority fee
+	baseFee := big.NewInt(0)
+	if block.BaseFee() != nil {
+		baseFee = block.BaseFee()
+	}
+
+	return evmTypes.GasInfo{
+		BlockNumber:   blockNumber,
+		BaseFee:       sdk.NewIntFromBigInt(baseFee),
+		PriorityFee:   sdk.NewIntFromBigInt(medianPriorityFee(block.Transactions(), baseFee)),
+		BlockGasLimit: block.GasLimit(),
+	}, true
+}
+
+// medianPriorityFee returns the median of the priority fees per gas that the given transactions effectively paid
+func medianPriorityFee(txs geth.Transactions, baseFee *big.Int) *big.Int {
+	var tips []*big.Int
+	for _, tx := range txs {
+		tip, err := tx.EffectiveGasTip(baseFee)
+		if err != nil {
+			continue
+		}
+		tips = append(tips, tip)
+	}
+
+	if len(tips) == 0 {
+		return big.NewInt(0)
+	}
+
+	sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) < 0 })
+	return tips[len(tips)/2]
+}
+
 func parseNewChainParams(attributes map[string]string) (chain string, nativeAsset string, err error) {
 	parsers := []*parse.AttributeParser{
 		{Key: evmTypes.AttributeKeyChain, Map: parse.IdentityMap},
@@ -257,6 +430,7 @@ func parseGatewayDeploymentParams(cdc *codec.LegacyAmino, attributes map[string]
 	address common.Address,
 	bytecodeHash common.Hash,
 	confHeight uint64,
+	finalityMode evmTypes.FinalityMode,
 	pollKey vote.PollKey,
 	err error,
 ) {
@@ -272,6 +446,7 @@ func parseGatewayDeploymentParams(cdc *codec.LegacyAmino, attributes map[string]
 			return common.HexToHash(s), nil
 		}},
 		{Key: evmTypes.AttributeKeyConfHeight, Map: func(s string) (interface{}, error) { return strconv.ParseUint(s, 10, 64) }},
+		{Key: evmTypes.AttributeKeyFinalityMode, Map: parseFinalityMode},
 		{Key: evmTypes.AttributeKeyPoll, Map: func(s string) (interface{}, error) {
 			cdc.MustUnmarshalJSON([]byte(s), &pollKey)
 			return pollKey, nil
@@ -280,7 +455,7 @@ func parseGatewayDeploymentParams(cdc *codec.LegacyAmino, attributes map[string]
 
 	results, err := parse.Parse(attributes, parsers)
 	if err != nil {
-		return "", common.Hash{}, common.Address{}, common.Hash{}, 0, vote.PollKey{}, err
+		return "", common.Hash{}, common.Address{}, common.Hash{}, 0, evmTypes.FinalityUnspecified, vote.PollKey{}, err
 	}
 
 	return results[0].(string),
@@ -288,7 +463,8 @@ func parseGatewayDeploymentParams(cdc *codec.LegacyAmino, attributes map[string]
 		results[2].(common.Address),
 		results[3].(common.Hash),
 		results[4].(uint64),
-		results[5].(vote.PollKey),
+		results[5].(evmTypes.FinalityMode),
+		results[6].(vote.PollKey),
 		nil
 }
 
@@ -298,6 +474,7 @@ func parseDepositConfirmationParams(cdc *codec.LegacyAmino, attributes map[strin
 	amount sdk.Uint,
 	burnAddr, tokenAddr common.Address,
 	confHeight uint64,
+	finalityMode evmTypes.FinalityMode,
 	pollKey vote.PollKey,
 	err error,
 ) {
@@ -314,6 +491,7 @@ func parseDepositConfirmationParams(cdc *codec.LegacyAmino, attributes map[strin
 			return common.HexToAddress(s), nil
 		}},
 		{Key: evmTypes.AttributeKeyConfHeight, Map: func(s string) (interface{}, error) { return strconv.ParseUint(s, 10, 64) }},
+		{Key: evmTypes.AttributeKeyFinalityMode, Map: parseFinalityMode},
 		{Key: evmTypes.AttributeKeyPoll, Map: func(s string) (interface{}, error) {
 			cdc.MustUnmarshalJSON([]byte(s), &pollKey)
 			return pollKey, nil
@@ -322,7 +500,7 @@ func parseDepositConfirmationParams(cdc *codec.LegacyAmino, attributes map[strin
 
 	results, err := parse.Parse(attributes, parsers)
 	if err != nil {
-		return "", [32]byte{}, sdk.Uint{}, [20]byte{}, [20]byte{}, 0, vote.PollKey{}, err
+		return "", [32]byte{}, sdk.Uint{}, [20]byte{}, [20]byte{}, 0, evmTypes.FinalityUnspecified, vote.PollKey{}, err
 	}
 
 	return results[0].(string),
@@ -331,7 +509,8 @@ func parseDepositConfirmationParams(cdc *codec.LegacyAmino, attributes map[strin
 		results[3].(common.Address),
 		results[4].(common.Address),
 		results[5].(uint64),
-		results[6].(vote.PollKey),
+		results[6].(evmTypes.FinalityMode),
+		results[7].(vote.PollKey),
 		nil
 }
 
@@ -341,7 +520,9 @@ func parseTokenConfirmationParams(cdc *codec.LegacyAmino, attributes map[string]
 	gatewayAddr, tokenAddr common.Address,
 	asset string,
 	symbol string,
+	decimals uint8,
 	confHeight uint64,
+	finalityMode evmTypes.FinalityMode,
 	pollKey vote.PollKey,
 	err error,
 ) {
@@ -358,7 +539,12 @@ func parseTokenConfirmationParams(cdc *codec.LegacyAmino, attributes map[string]
 		}},
 		{Key: evmTypes.AttributeKeyAsset, Map: parse.IdentityMap},
 		{Key: evmTypes.AttributeKeySymbol, Map: parse.IdentityMap},
+		{Key: evmTypes.AttributeKeyDecimals, Map: func(s string) (interface{}, error) {
+			d, err := strconv.ParseUint(s, 10, 8)
+			return uint8(d), err
+		}},
 		{Key: evmTypes.AttributeKeyConfHeight, Map: func(s string) (interface{}, error) { return strconv.ParseUint(s, 10, 64) }},
+		{Key: evmTypes.AttributeKeyFinalityMode, Map: parseFinalityMode},
 		{Key: evmTypes.AttributeKeyPoll, Map: func(s string) (interface{}, error) {
 			cdc.MustUnmarshalJSON([]byte(s), &pollKey)
 			return pollKey, nil
@@ -367,7 +553,7 @@ func parseTokenConfirmationParams(cdc *codec.LegacyAmino, attributes map[string]
 
 	results, err := parse.Parse(attributes, parsers)
 	if err != nil {
-		return "", [32]byte{}, [20]byte{}, [20]byte{}, "", "", 0, vote.PollKey{}, err
+		return "", [32]byte{}, [20]byte{}, [20]byte{}, "", "", 0, 0, evmTypes.FinalityUnspecified, vote.PollKey{}, err
 	}
 
 	return results[0].(string),
@@ -376,8 +562,10 @@ func parseTokenConfirmationParams(cdc *codec.LegacyAmino, attributes map[string]
 		results[3].(common.Address),
 		results[4].(string),
 		results[5].(string),
-		results[6].(uint64),
-		results[7].(vote.PollKey),
+		results[6].(uint8),
+		results[7].(uint64),
+		results[8].(evmTypes.FinalityMode),
+		results[9].(vote.PollKey),
 		nil
 }
 
@@ -390,6 +578,7 @@ func parseTransferKeyConfirmationParams(cdc *codec.LegacyAmino, attributes map[s
 	newAddrs []common.Address,
 	threshold uint8,
 	confHeight uint64,
+	finalityMode evmTypes.FinalityMode,
 	pollKey vote.PollKey,
 	err error,
 ) {
@@ -430,6 +619,7 @@ func parseTransferKeyConfirmationParams(cdc *codec.LegacyAmino, attributes map[s
 			return uint8(threshold), nil
 		}},
 		{Key: evmTypes.AttributeKeyConfHeight, Map: func(s string) (interface{}, error) { return strconv.ParseUint(s, 10, 64) }},
+		{Key: evmTypes.AttributeKeyFinalityMode, Map: parseFinalityMode},
 		{Key: evmTypes.AttributeKeyPoll, Map: func(s string) (interface{}, error) {
 			cdc.MustUnmarshalJSON([]byte(s), &pollKey)
 			return pollKey, nil
@@ -438,7 +628,7 @@ func parseTransferKeyConfirmationParams(cdc *codec.LegacyAmino, attributes map[s
 
 	results, err := parse.Parse(attributes, parsers)
 	if err != nil {
-		return "", common.Hash{}, evmTypes.UnspecifiedTransferKeyType, tss.KEY_TYPE_UNSPECIFIED, common.Address{}, nil, 0, 0, vote.PollKey{}, err
+		return "", common.Hash{}, evmTypes.UnspecifiedTransferKeyType, tss.KEY_TYPE_UNSPECIFIED, common.Address{}, nil, 0, 0, evmTypes.FinalityUnspecified, vote.PollKey{}, err
 	}
 
 	return results[0].(string),
@@ -449,18 +639,127 @@ func parseTransferKeyConfirmationParams(cdc *codec.LegacyAmino, attributes map[s
 		results[5].([]common.Address),
 		results[6].(uint8),
 		results[7].(uint64),
-		results[8].(vote.PollKey),
+		results[8].(evmTypes.FinalityMode),
+		results[9].(vote.PollKey),
 		nil
 }
 
-func (mgr Mgr) validate(rpc rpc.Client, txID common.Hash, confHeight uint64, validateTx func(tx *geth.Transaction, txReceipt *geth.Receipt) bool) bool {
-	blockNumber, err := rpc.BlockNumber(context.Background())
+func parseBatchExecutionConfirmationParams(cdc *codec.LegacyAmino, attributes map[string]string) (
+	chain string,
+	txID common.Hash,
+	gatewayAddr common.Address,
+	confHeight uint64,
+	finalityMode evmTypes.FinalityMode,
+	pollKey vote.PollKey,
+	err error,
+) {
+	parsers := []*parse.AttributeParser{
+		{Key: evmTypes.AttributeKeyChain, Map: parse.IdentityMap},
+		{Key: evmTypes.AttributeKeyTxID, Map: func(s string) (interface{}, error) {
+			return common.HexToHash(s), nil
+		}},
+		{Key: evmTypes.AttributeKeyGatewayAddress, Map: func(s string) (interface{}, error) {
+			return common.HexToAddress(s), nil
+		}},
+		{Key: evmTypes.AttributeKeyConfHeight, Map: func(s string) (interface{}, error) { return strconv.ParseUint(s, 10, 64) }},
+		{Key: evmTypes.AttributeKeyFinalityMode, Map: parseFinalityMode},
+		{Key: evmTypes.AttributeKeyPoll, Map: func(s string) (interface{}, error) {
+			cdc.MustUnmarshalJSON([]byte(s), &pollKey)
+			return pollKey, nil
+		}},
+	}
+
+	results, err := parse.Parse(attributes, parsers)
 	if err != nil {
-		mgr.logger.Debug(sdkerrors.Wrap(err, "checking block number failed").Error())
-		// TODO: this error is not the caller's fault, so we should implement a retry here instead of voting against
-		return false
+		return "", common.Hash{}, common.Address{}, 0, evmTypes.FinalityUnspecified, vote.PollKey{}, err
 	}
 
+	return results[0].(string),
+		results[1].(common.Hash),
+		results[2].(common.Address),
+		results[3].(uint64),
+		results[4].(evmTypes.FinalityMode),
+		results[5].(vote.PollKey),
+		nil
+}
+
+func parseGatewayUpgradeConfirmationParams(cdc *codec.LegacyAmino, attributes map[string]string) (
+	chain string,
+	txID common.Hash,
+	gatewayAddr common.Address,
+	implementation common.Address,
+	confHeight uint64,
+	finalityMode evmTypes.FinalityMode,
+	pollKey vote.PollKey,
+	err error,
+) {
+	parsers := []*parse.AttributeParser{
+		{Key: evmTypes.AttributeKeyChain, Map: parse.IdentityMap},
+		{Key: evmTypes.AttributeKeyTxID, Map: func(s string) (interface{}, error) {
+			return common.HexToHash(s), nil
+		}},
+		{Key: evmTypes.AttributeKeyGatewayAddress, Map: func(s string) (interface{}, error) {
+			return common.HexToAddress(s), nil
+		}},
+		{Key: evmTypes.AttributeKeyAddress, Map: func(s string) (interface{}, error) {
+			return common.HexToAddress(s), nil
+		}},
+		{Key: evmTypes.AttributeKeyConfHeight, Map: func(s string) (interface{}, error) { return strconv.ParseUint(s, 10, 64) }},
+		{Key: evmTypes.AttributeKeyFinalityMode, Map: parseFinalityMode},
+		{Key: evmTypes.AttributeKeyPoll, Map: func(s string) (interface{}, error) {
+			cdc.MustUnmarshalJSON([]byte(s), &pollKey)
+			return pollKey, nil
+		}},
+	}
+
+	results, err := parse.Parse(attributes, parsers)
+	if err != nil {
+		return "", common.Hash{}, common.Address{}, common.Address{}, 0, evmTypes.FinalityUnspecified, vote.PollKey{}, err
+	}
+
+	return results[0].(string),
+		results[1].(common.Hash),
+		results[2].(common.Address),
+		results[3].(common.Address),
+		results[4].(uint64),
+		results[5].(evmTypes.FinalityMode),
+		results[6].(vote.PollKey),
+		nil
+}
+
+func parseGasInfoConfirmationParams(cdc *codec.LegacyAmino, attributes map[string]string) (
+	chain string,
+	blockNumber uint64,
+	confHeight uint64,
+	finalityMode evmTypes.FinalityMode,
+	pollKey vote.PollKey,
+	err error,
+) {
+	parsers := []*parse.AttributeParser{
+		{Key: evmTypes.AttributeKeyChain, Map: parse.IdentityMap},
+		{Key: evmTypes.AttributeKeyBlockNumber, Map: func(s string) (interface{}, error) { return strconv.ParseUint(s, 10, 64) }},
+		{Key: evmTypes.AttributeKeyConfHeight, Map: func(s string) (interface{}, error) { return strconv.ParseUint(s, 10, 64) }},
+		{Key: evmTypes.AttributeKeyFinalityMode, Map: parseFinalityMode},
+		{Key: evmTypes.AttributeKeyPoll, Map: func(s string) (interface{}, error) {
+			cdc.MustUnmarshalJSON([]byte(s), &pollKey)
+			return pollKey, nil
+		}},
+	}
+
+	results, err := parse.Parse(attributes, parsers)
+	if err != nil {
+		return "", 0, 0, evmTypes.FinalityUnspecified, vote.PollKey{}, err
+	}
+
+	return results[0].(string),
+		results[1].(uint64),
+		results[2].(uint64),
+		results[3].(evmTypes.FinalityMode),
+		results[4].(vote.PollKey),
+		nil
+}
+
+func (mgr Mgr) validate(rpc rpc.Client, txID common.Hash, confHeight uint64, finalityMode evmTypes.FinalityMode, validateTx func(tx *geth.Transaction, txReceipt *geth.Receipt) bool) bool {
 	tx, _, err := rpc.TransactionByHash(context.Background(), txID)
 	if err != nil {
 		mgr.logger.Debug(sdkerrors.Wrap(err, "transaction by hash call failed").Error())
@@ -473,8 +772,7 @@ func (mgr Mgr) validate(rpc rpc.Client, txID common.Hash, confHeight uint64, val
 		return false
 	}
 
-	if !isTxFinalized(txReceipt, blockNumber, confHeight) {
-		mgr.logger.Debug(fmt.Sprintf("transaction %s does not have enough confirmations yet", txReceipt.TxHash.String()))
+	if !mgr.isFinalized(rpc, txReceipt.BlockNumber.Uint64(), txReceipt.BlockHash, confHeight, finalityMode) {
 		return false
 	}
 
@@ -486,6 +784,163 @@ func (mgr Mgr) validate(rpc rpc.Client, txID common.Hash, confHeight uint64, val
 	return validateTx(tx, txReceipt)
 }
 
+// isFinalized checks that the given block is final according to the chain's finality mode
+// and that it is still part of the canonical chain
+func (mgr Mgr) isFinalized(rpc rpc.Client, blockNumber uint64, blockHash common.Hash, confHeight uint64, finalityMode evmTypes.FinalityMode) bool {
+	// TODO: errors are not the caller's fault, so we should implement a retry here instead of voting against
+	if err := checkFinality(rpc, blockNumber, confHeight, finalityMode); err != nil {
+		mgr.logger.Debug(err.Error())
+		return false
+	}
+
+	// the block might have been reorged out of the canonical chain after it was observed
+	canonicalHash, err := rpc.BlockHashByNumber(context.Background(), new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		mgr.logger.Debug(sdkerrors.Wrap(err, "block hash by number call failed").Error())
+		return false
+	}
+
+	if canonicalHash != blockHash {
+		mgr.logger.Debug(fmt.Sprintf("block %d with hash %s is no longer part of the canonical chain", blockNumber, blockHash.Hex()))
+		return false
+	}
+
+	return true
+}
+
+// getCanonicalReceipt returns the receipt of the given transaction and whether it is part of a final block of the canonical chain.
+// Unlike validate, it returns an error if the node cannot be queried or the block is not final yet,
+// so that only a node that positively reports the transaction as missing counts as a reorg
+func (mgr Mgr) getCanonicalReceipt(rpc rpc.Client, txID common.Hash, confHeight uint64, finalityMode evmTypes.FinalityMode) (*geth.Receipt, bool, error) {
+	txReceipt, err := rpc.TransactionReceipt(context.Background(), txID)
+	switch {
+	case errors.Is(err, ethereum.NotFound):
+		mgr.logger.Debug(fmt.Sprintf("transaction %s not found", txID.Hex()))
+		return nil, false, nil
+	case err != nil:
+		return nil, false, sdkerrors.Wrap(err, "transaction receipt call failed")
+	}
+
+	// a reorged transaction might have been included again in a block that is not final yet
+	blockNumber := txReceipt.BlockNumber.Uint64()
+	if err := checkFinality(rpc, blockNumber, confHeight, finalityMode); err != nil {
+		return nil, false, err
+	}
+
+	canonicalHash, err := rpc.BlockHashByNumber(context.Background(), new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		return nil, false, sdkerrors.Wrap(err, "block hash by number call failed")
+	}
+
+	if canonicalHash != txReceipt.BlockHash {
+		mgr.logger.Debug(fmt.Sprintf("block %d with hash %s is no longer part of the canonical chain", blockNumber, txReceipt.BlockHash.Hex()))
+		return nil, false, nil
+	}
+
+	if !isTxSuccessful(txReceipt) {
+		mgr.logger.Debug(fmt.Sprintf("transaction %s failed", txReceipt.TxHash.String()))
+		return nil, false, nil
+	}
+
+	return txReceipt, true, nil
+}
+
+// checkFinality returns an error if the given block is not final according to the chain's finality mode
+func checkFinality(rpc rpc.Client, blockNumber uint64, confHeight uint64, finalityMode evmTypes.FinalityMode) error {
+	switch finalityMode {
+	case evmTypes.FinalityFinalized, evmTypes.FinalitySafe:
+		tag := finalityTag(finalityMode)
+		header, err := rpc.HeaderByTag(context.Background(), tag)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "retrieving %s block failed", tag)
+		}
+
+		if header.Number.Uint64() < blockNumber {
+			return fmt.Errorf("block %d is not %s yet", blockNumber, tag)
+		}
+	default:
+		latest, err := rpc.BlockNumber(context.Background())
+		if err != nil {
+			return sdkerrors.Wrap(err, "checking block number failed")
+		}
+
+		if latest < blockNumber || latest-blockNumber+1 < confHeight {
+			return fmt.Errorf("block %d does not have enough confirmations yet", blockNumber)
+		}
+	}
+
+	return nil
+}
+
+func finalityTag(finalityMode evmTypes.FinalityMode) string {
+	switch finalityMode {
+	case evmTypes.FinalityFinalized:
+		return "finalized"
+	case evmTypes.FinalitySafe:
+		return "safe"
+	default:
+		return "latest"
+	}
+}
+
+func parseFinalityMode(s string) (interface{}, error) {
+	mode, ok := evmTypes.FinalityMode_value[s]
+	if !ok {
+		return nil, fmt.Errorf("unknown finality mode %s", s)
+	}
+
+	return evmTypes.FinalityMode(mode), nil
+}
+
+// confirmDeposit checks that the given receipt transfers the expected amount to the burner address.
+// A zero token address denotes a deposit of the chain's native currency
+func confirmDeposit(rpc rpc.Client, txReceipt *geth.Receipt, amount sdk.Uint, burnAddr common.Address, tokenAddr common.Address) error {
+	if tokenAddr == (common.Address{}) {
+		return confirmNativeDeposit(rpc, txReceipt, amount, burnAddr)
+	}
+
+	return confirmERC20Deposit(txReceipt, amount, burnAddr, tokenAddr)
+}
+
+// confirmNativeDeposit checks the value transferred to the burner address by the given transaction, including value sent by internal calls.
+// The transaction must be traced, because the burner's balance could also have been changed by other transactions in the same block
+func confirmNativeDeposit(rpc rpc.Client, txReceipt *geth.Receipt, amount sdk.Uint, burnAddr common.Address) error {
+	frame, err := rpc.TraceTransaction(context.Background(), txReceipt.TxHash)
+	if err != nil {
+		return fmt.Errorf("%w %s: %s", errTraceFailed, txReceipt.TxHash.Hex(), err.Error())
+	}
+
+	actualAmount := sumValueTransferredTo(*frame, burnAddr)
+	if actualAmount.Cmp(amount.BigInt()) != 0 {
+		return fmt.Errorf("given deposit amount: %s, actual amount: %s", amount.String(), actualAmount.String())
+	}
+
+	return nil
+}
+
+// sumValueTransferredTo adds up the value of all successful calls to the given address in the call tree
+func sumValueTransferredTo(frame rpc.CallFrame, to common.Address) *big.Int {
+	total := big.NewInt(0)
+
+	/* Reverted calls do not transfer value, neither do their sub-calls */
+	if frame.Error != "" {
+		return total
+	}
+
+	switch strings.ToUpper(frame.Type) {
+	case "CALL", "CREATE", "CREATE2", "SELFDESTRUCT":
+		if frame.To == to && frame.Value != nil {
+			total.Add(total, frame.Value.ToInt())
+		}
+	}
+
+	for _, call := range frame.Calls {
+		total.Add(total, sumValueTransferredTo(call, to))
+	}
+
+	return total
+}
+
 func confirmERC20Deposit(txReceipt *geth.Receipt, amount sdk.Uint, burnAddr common.Address, tokenAddr common.Address) error {
 	actualAmount := sdk.ZeroUint()
 	for _, log := range txReceipt.Logs {
@@ -509,7 +964,7 @@ func confirmERC20Deposit(txReceipt *geth.Receipt, amount sdk.Uint, burnAddr comm
 	}
 
 	if !actualAmount.Equal(amount) {
-		return fmt.Errorf("given deposit amount: %d, actual amount: %d", amount.Uint64(), actualAmount.Uint64())
+		return fmt.Errorf("given deposit amount: %s, actual amount: %s", amount.String(), actualAmount.String())
 	}
 
 	return nil
@@ -546,6 +1001,24 @@ func confirmERC20TokenDeployment(txReceipt *geth.Receipt, expectedSymbol string,
 	return fmt.Errorf("failed to confirm token deployment for symbol '%s' at contract address '%s'", expectedSymbol, expectedAddr.String())
 }
 
+func confirmERC20TokenDecimals(rpc rpc.Client, blockNumber *big.Int, tokenAddr common.Address, expectedDecimals uint8) error {
+	result, err := rpc.CallContract(context.Background(), ethereum.CallMsg{To: &tokenAddr, Data: ERC20DecimalsSelector}, blockNumber)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to retrieve decimals of token at contract address '%s'", tokenAddr.String())
+	}
+
+	// decimals() returns a single uint8 that is ABI encoded as a 32 byte word
+	if len(result) != common.HashLength {
+		return fmt.Errorf("unexpected result of decimals call to token at contract address '%s'", tokenAddr.String())
+	}
+
+	if decimals := new(big.Int).SetBytes(result); !decimals.IsUint64() || decimals.Uint64() != uint64(expectedDecimals) {
+		return fmt.Errorf("token at contract address '%s' has %s decimals, expected %d", tokenAddr.String(), decimals.String(), expectedDecimals)
+	}
+
+	return nil
+}
+
 func confirmSinglesigTransferKey(txReceipt *geth.Receipt, transferKeyType evmTypes.TransferKeyType, gatewayAddr common.Address, expectedNewAddr common.Address) (err error) {
 	for i := len(txReceipt.Logs) - 1; i >= 0; i-- {
 		log := txReceipt.Logs[i]
@@ -573,6 +1046,30 @@ func confirmSinglesigTransferKey(txReceipt *geth.Receipt, transferKeyType evmTyp
 	return fmt.Errorf("failed to confirm %s transfer for new address '%s' at contract address '%s'", transferKeyType.SimpleString(), expectedNewAddr.String(), gatewayAddr.String())
 }
 
+func confirmGatewayUpgrade(txReceipt *geth.Receipt, gatewayAddr common.Address, expectedImplementation common.Address) error {
+	for i := len(txReceipt.Logs) - 1; i >= 0; i-- {
+		log := txReceipt.Logs[i]
+		// Event is not emitted by the axelar gateway
+		if log.Address != gatewayAddr {
+			continue
+		}
+
+		// There might be several upgrade events. Only interest in the last one.
+		actualImplementation, err := decodeUpgradedEvent(log)
+		if err != nil {
+			continue
+		}
+
+		if actualImplementation != expectedImplementation {
+			break
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("failed to confirm upgrade to implementation '%s' at gateway address '%s'", expectedImplementation.String(), gatewayAddr.String())
+}
+
 func addressesToHexes(addresses []common.Address) []string {
 	hexes := make([]string, len(addresses))
 	for i, address := range addresses {
@@ -628,8 +1125,30 @@ func confirmMultisigTransferKey(txReceipt *geth.Receipt, transferKeyType evmType
 	return fmt.Errorf("failed to confirm %s transfer for new addresses '%s' and threshold '%d' at contract address '%s'", transferKeyType.SimpleString(), strings.Join(addressesToHexes(expectedNewAddrs), ","), expectedNewThreshold, gatewayAddr.String())
 }
 
-func isTxFinalized(txReceipt *geth.Receipt, blockNumber uint64, confirmationHeight uint64) bool {
-	return blockNumber-txReceipt.BlockNumber.Uint64()+1 >= confirmationHeight
+func getExecutedCommandIDs(txReceipt *geth.Receipt, gatewayAddr common.Address) []evmTypes.CommandID {
+	var commandIDs []evmTypes.CommandID
+	seen := make(map[evmTypes.CommandID]bool)
+	for _, log := range txReceipt.Logs {
+		// Event is not emitted by the axelar gateway
+		if log.Address != gatewayAddr {
+			continue
+		}
+
+		// Event is not for a command execution
+		commandID, err := decodeExecutedEvent(log)
+		if err != nil {
+			continue
+		}
+
+		if seen[commandID] {
+			continue
+		}
+
+		seen[commandID] = true
+		commandIDs = append(commandIDs, commandID)
+	}
+
+	return commandIDs
 }
 
 func isTxSuccessful(txReceipt *geth.Receipt) bool {
@@ -672,6 +1191,22 @@ func decodeERC20TokenDeploymentEvent(log *geth.Log) (string, common.Address, err
 	return args[0].(string), args[1].(common.Address), nil
 }
 
+func decodeExecutedEvent(log *geth.Log) (evmTypes.CommandID, error) {
+	if len(log.Topics) != 2 || log.Topics[0] != ExecutedSig {
+		return evmTypes.CommandID{}, fmt.Errorf("event is not for a command execution")
+	}
+
+	return evmTypes.CommandID(log.Topics[1]), nil
+}
+
+func decodeUpgradedEvent(log *geth.Log) (common.Address, error) {
+	if len(log.Topics) != 2 || log.Topics[0] != UpgradedSig {
+		return common.Address{}, fmt.Errorf("event is not for a gateway upgrade")
+	}
+
+	return common.BytesToAddress(log.Topics[1][:]), nil
+}
+
 func decodeSinglesigKeyTransferEvent(log *geth.Log, transferKeyType evmTypes.TransferKeyType) (common.Address, error) {
 	var topic common.Hash
 	switch transferKeyType {
@@ -1,6 +1,7 @@
 package evm
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math/big"
@@ -12,8 +13,10 @@ import (
 	tmEvents "github.com/axelarnetwork/tm-events/events"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	geth "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/tendermint/tendermint/libs/log"
@@ -177,10 +180,11 @@ func TestDecodeMultisigKeyTransferEvent(t *testing.T) {
 
 func TestMgr_ProccessDepositConfirmation(t *testing.T) {
 	var (
-		mgr         *Mgr
-		attributes  map[string]string
-		rpc         *mock.ClientMock
-		broadcaster *mock2.BroadcasterMock
+		mgr             *Mgr
+		attributes      map[string]string
+		rpc             *mock.ClientMock
+		broadcaster     *mock2.BroadcasterMock
+		canonicalHeader *geth.Header
 	)
 	setup := func() {
 		cdc := app.MakeEncodingConfig().Amino
@@ -198,19 +202,26 @@ func TestMgr_ProccessDepositConfirmation(t *testing.T) {
 			evmTypes.AttributeKeyDepositAddress: common.Bytes2Hex(burnAddrBytes),
 			evmTypes.AttributeKeyTokenAddress:   common.Bytes2Hex(tokenAddrBytes),
 			evmTypes.AttributeKeyConfHeight:     strconv.FormatUint(uint64(confHeight), 10),
+			evmTypes.AttributeKeyFinalityMode:   evmTypes.FinalityDepth.String(),
 			evmTypes.AttributeKeyPoll:           string(cdc.MustMarshalJSON(pollKey)),
 		}
 
+		canonicalHeader = &geth.Header{Number: big.NewInt(blockNumber), Difficulty: big.NewInt(0), Extra: rand.Bytes(32)}
+
 		rpc = &mock.ClientMock{
 			BlockNumberFunc: func(context.Context) (uint64, error) {
 				return uint64(blockNumber), nil
 			},
+			BlockHashByNumberFunc: func(context.Context, *big.Int) (common.Hash, error) {
+				return canonicalHeader.Hash(), nil
+			},
 			TransactionByHashFunc: func(ctx context.Context, hash common.Hash) (*geth.Transaction, bool, error) {
 				return &geth.Transaction{}, false, nil
 			},
 			TransactionReceiptFunc: func(context.Context, common.Hash) (*geth.Receipt, error) {
 				receipt := &geth.Receipt{
 					BlockNumber: big.NewInt(rand.I64Between(0, blockNumber-confHeight)),
+					BlockHash:   canonicalHeader.Hash(),
 					Logs: []*geth.Log{
 						/* ERC20 transfer to burner address of a random token */
 						{
@@ -322,6 +333,215 @@ func TestMgr_ProccessDepositConfirmation(t *testing.T) {
 		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0])
 		assert.False(t, msg.(*evmTypes.VoteConfirmDepositRequest).Confirmed)
 	}).Repeat(repeats))
+
+	t.Run("block no longer canonical", testutils.Func(func(t *testing.T) {
+		setup()
+		rpc.BlockHashByNumberFunc = func(context.Context, *big.Int) (common.Hash, error) {
+			return common.BytesToHash(rand.Bytes(common.HashLength)), nil
+		}
+
+		err := mgr.ProcessDepositConfirmation(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0])
+		assert.False(t, msg.(*evmTypes.VoteConfirmDepositRequest).Confirmed)
+	}).Repeat(repeats))
+
+	t.Run("block finalized by tag", testutils.Func(func(t *testing.T) {
+		setup()
+		mode := []evmTypes.FinalityMode{evmTypes.FinalityFinalized, evmTypes.FinalitySafe}[rand.I64Between(0, 2)]
+		attributes[evmTypes.AttributeKeyFinalityMode] = mode.String()
+		receipt, _ := rpc.TransactionReceipt(context.Background(), common.Hash{})
+		rpc.TransactionReceiptFunc = func(context.Context, common.Hash) (*geth.Receipt, error) { return receipt, nil }
+		rpc.BlockNumberFunc = func(context.Context) (uint64, error) { return 0, fmt.Errorf("depth must not be checked") }
+		rpc.HeaderByTagFunc = func(_ context.Context, tag string) (*geth.Header, error) {
+			assert.Equal(t, finalityTag(mode), tag)
+			return &geth.Header{Number: new(big.Int).Add(receipt.BlockNumber, big.NewInt(rand.I64Between(0, 100)))}, nil
+		}
+
+		err := mgr.ProcessDepositConfirmation(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0])
+		assert.True(t, msg.(*evmTypes.VoteConfirmDepositRequest).Confirmed)
+	}).Repeat(repeats))
+
+	t.Run("block not finalized by tag yet", testutils.Func(func(t *testing.T) {
+		setup()
+		attributes[evmTypes.AttributeKeyFinalityMode] = evmTypes.FinalityFinalized.String()
+		receipt, _ := rpc.TransactionReceipt(context.Background(), common.Hash{})
+		receipt.BlockNumber = big.NewInt(rand.I64Between(1, 1000000))
+		rpc.TransactionReceiptFunc = func(context.Context, common.Hash) (*geth.Receipt, error) { return receipt, nil }
+		rpc.HeaderByTagFunc = func(context.Context, string) (*geth.Header, error) {
+			return &geth.Header{Number: new(big.Int).Sub(receipt.BlockNumber, big.NewInt(1))}, nil
+		}
+
+		err := mgr.ProcessDepositConfirmation(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0])
+		assert.False(t, msg.(*evmTypes.VoteConfirmDepositRequest).Confirmed)
+	}).Repeat(repeats))
+
+	t.Run("unknown finality mode", testutils.Func(func(t *testing.T) {
+		setup()
+		attributes[evmTypes.AttributeKeyFinalityMode] = rand.StrBetween(5, 20)
+
+		err := mgr.ProcessDepositConfirmation(tmEvents.Event{Attributes: attributes})
+
+		assert.Error(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 0)
+	}).Repeat(repeats))
+
+	setupNativeDeposit := func() (common.Address, *big.Int, *geth.Receipt) {
+		attributes[evmTypes.AttributeKeyTokenAddress] = common.Address{}.Hex()
+		burnAddr := common.HexToAddress(attributes[evmTypes.AttributeKeyDepositAddress])
+		amount, _ := new(big.Int).SetString(attributes[evmTypes.AttributeKeyAmount], 10)
+
+		receipt, _ := rpc.TransactionReceipt(context.Background(), common.Hash{})
+		receipt.BlockNumber = new(big.Int).Add(receipt.BlockNumber, big.NewInt(1))
+		receipt.Logs = nil
+		rpc.TransactionReceiptFunc = func(context.Context, common.Hash) (*geth.Receipt, error) { return receipt, nil }
+
+		return burnAddr, amount, receipt
+	}
+
+	t.Run("native deposit with internal transfers", testutils.Func(func(t *testing.T) {
+		setup()
+		burnAddr, amount, _ := setupNativeDeposit()
+		internalAmount := big.NewInt(rand.I64Between(0, amount.Int64()))
+
+		rpc.TraceTransactionFunc = func(context.Context, common.Hash) (*evmRpc.CallFrame, error) {
+			return &evmRpc.CallFrame{
+				Type:  "CALL",
+				To:    common.BytesToAddress(rand.Bytes(common.AddressLength)),
+				Value: (*hexutil.Big)(new(big.Int).Sub(amount, internalAmount)),
+				Calls: []evmRpc.CallFrame{
+					/* internal transfer to the burner */
+					{Type: "CALL", To: burnAddr, Value: (*hexutil.Big)(internalAmount)},
+					/* direct transfer to the burner */
+					{Type: "CALL", To: burnAddr, Value: (*hexutil.Big)(new(big.Int).Sub(amount, internalAmount))},
+					/* reverted transfer to the burner */
+					{Type: "CALL", To: burnAddr, Value: (*hexutil.Big)(big.NewInt(rand.PosI64())), Error: "execution reverted"},
+					/* call without value */
+					{Type: "STATICCALL", To: burnAddr},
+				},
+			}, nil
+		}
+
+		err := mgr.ProcessDepositConfirmation(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0])
+		assert.True(t, msg.(*evmTypes.VoteConfirmDepositRequest).Confirmed)
+	}).Repeat(repeats))
+
+	t.Run("native deposit without tracing support", testutils.Func(func(t *testing.T) {
+		setup()
+		setupNativeDeposit()
+
+		rpc.TraceTransactionFunc = func(context.Context, common.Hash) (*evmRpc.CallFrame, error) {
+			return nil, fmt.Errorf("the method debug_traceTransaction does not exist")
+		}
+
+		err := mgr.ProcessDepositConfirmation(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0])
+		assert.False(t, msg.(*evmTypes.VoteConfirmDepositRequest).Confirmed)
+	}).Repeat(repeats))
+
+	t.Run("native deposit amount mismatch", testutils.Func(func(t *testing.T) {
+		setup()
+		burnAddr, amount, _ := setupNativeDeposit()
+
+		rpc.TraceTransactionFunc = func(context.Context, common.Hash) (*evmRpc.CallFrame, error) {
+			return &evmRpc.CallFrame{Type: "CALL", To: burnAddr, Value: (*hexutil.Big)(new(big.Int).Add(amount, big.NewInt(1)))}, nil
+		}
+
+		err := mgr.ProcessDepositConfirmation(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0])
+		assert.False(t, msg.(*evmTypes.VoteConfirmDepositRequest).Confirmed)
+	}).Repeat(repeats))
+
+	t.Run("reverify canonical deposit", testutils.Func(func(t *testing.T) {
+		setup()
+
+		err := mgr.ProcessDepositReverification(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0])
+		assert.True(t, msg.(*evmTypes.VoteReverifyDepositRequest).Canonical)
+	}).Repeat(repeats))
+
+	t.Run("reverify reorged deposit", testutils.Func(func(t *testing.T) {
+		setup()
+		rpc.TransactionReceiptFunc = func(context.Context, common.Hash) (*geth.Receipt, error) { return nil, ethereum.NotFound }
+
+		err := mgr.ProcessDepositReverification(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0])
+		assert.False(t, msg.(*evmTypes.VoteReverifyDepositRequest).Canonical)
+	}).Repeat(repeats))
+
+	t.Run("reverify deposit in block that is no longer canonical", testutils.Func(func(t *testing.T) {
+		setup()
+		rpc.BlockHashByNumberFunc = func(context.Context, *big.Int) (common.Hash, error) {
+			return common.BytesToHash(rand.Bytes(common.HashLength)), nil
+		}
+
+		err := mgr.ProcessDepositReverification(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0])
+		assert.False(t, msg.(*evmTypes.VoteReverifyDepositRequest).Canonical)
+	}).Repeat(repeats))
+
+	t.Run("should not vote on reverification when the rpc fails", testutils.Func(func(t *testing.T) {
+		setup()
+		switch rand.I64Between(0, 3) {
+		case 0:
+			rpc.TransactionReceiptFunc = func(context.Context, common.Hash) (*geth.Receipt, error) {
+				return nil, fmt.Errorf("connection refused")
+			}
+		case 1:
+			rpc.BlockHashByNumberFunc = func(context.Context, *big.Int) (common.Hash, error) {
+				return common.Hash{}, fmt.Errorf("connection refused")
+			}
+		default:
+			rpc.BlockNumberFunc = func(context.Context) (uint64, error) { return 0, fmt.Errorf("connection refused") }
+		}
+
+		err := mgr.ProcessDepositReverification(tmEvents.Event{Attributes: attributes})
+
+		assert.Error(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should not vote on reverification of a native deposit when tracing fails", testutils.Func(func(t *testing.T) {
+		setup()
+		setupNativeDeposit()
+		rpc.TraceTransactionFunc = func(context.Context, common.Hash) (*evmRpc.CallFrame, error) {
+			return nil, fmt.Errorf("connection refused")
+		}
+
+		err := mgr.ProcessDepositReverification(tmEvents.Event{Attributes: attributes})
+
+		assert.Error(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 0)
+	}).Repeat(repeats))
 }
 
 func TestMgr_ProccessTokenConfirmation(t *testing.T) {
@@ -331,6 +551,8 @@ func TestMgr_ProccessTokenConfirmation(t *testing.T) {
 		rpc              *mock.ClientMock
 		broadcaster      *mock2.BroadcasterMock
 		gatewayAddrBytes []byte
+		canonicalHeader  *geth.Header
+		decimals         uint8
 	)
 	setup := func() {
 		cdc := app.MakeEncodingConfig().Amino
@@ -342,27 +564,36 @@ func TestMgr_ProccessTokenConfirmation(t *testing.T) {
 		confHeight := rand.I64Between(0, blockNumber-1)
 
 		symbol := rand.StrBetween(5, 20)
+		decimals = uint8(rand.I64Between(0, 256))
 		attributes = map[string]string{
 			evmTypes.AttributeKeyChain:          "Ethereum",
 			evmTypes.AttributeKeyTxID:           common.Bytes2Hex(rand.Bytes(common.HashLength)),
 			evmTypes.AttributeKeyGatewayAddress: common.Bytes2Hex(gatewayAddrBytes),
 			evmTypes.AttributeKeyTokenAddress:   common.Bytes2Hex(tokenAddrBytes),
 			evmTypes.AttributeKeySymbol:         symbol,
+			evmTypes.AttributeKeyDecimals:       strconv.FormatUint(uint64(decimals), 10),
 			evmTypes.AttributeKeyAsset:          "satoshi",
 			evmTypes.AttributeKeyConfHeight:     strconv.FormatUint(uint64(confHeight), 10),
+			evmTypes.AttributeKeyFinalityMode:   evmTypes.FinalityDepth.String(),
 			evmTypes.AttributeKeyPoll:           string(cdc.MustMarshalJSON(pollKey)),
 		}
 
+		canonicalHeader = &geth.Header{Number: big.NewInt(blockNumber), Difficulty: big.NewInt(0), Extra: rand.Bytes(32)}
+
 		rpc = &mock.ClientMock{
 			BlockNumberFunc: func(context.Context) (uint64, error) {
 				return uint64(blockNumber), nil
 			},
+			BlockHashByNumberFunc: func(context.Context, *big.Int) (common.Hash, error) {
+				return canonicalHeader.Hash(), nil
+			},
 			TransactionByHashFunc: func(ctx context.Context, hash common.Hash) (*geth.Transaction, bool, error) {
 				return &geth.Transaction{}, false, nil
 			},
 			TransactionReceiptFunc: func(context.Context, common.Hash) (*geth.Receipt, error) {
 				receipt := &geth.Receipt{
 					BlockNumber: big.NewInt(rand.I64Between(0, blockNumber-confHeight)),
+					BlockHash:   canonicalHeader.Hash(),
 					Logs: createTokenLogs(
 						symbol,
 						common.BytesToAddress(gatewayAddrBytes),
@@ -374,6 +605,12 @@ func TestMgr_ProccessTokenConfirmation(t *testing.T) {
 				}
 				return receipt, nil
 			},
+			CallContractFunc: func(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+				if *msg.To != common.BytesToAddress(tokenAddrBytes) || !bytes.Equal(msg.Data, ERC20DecimalsSelector) {
+					return nil, fmt.Errorf("execution reverted")
+				}
+				return common.LeftPadBytes([]byte{decimals}, common.HashLength), nil
+			},
 		}
 		broadcaster = &mock2.BroadcasterMock{}
 		evmMap := make(map[string]evmRpc.Client)
@@ -470,6 +707,30 @@ func TestMgr_ProccessTokenConfirmation(t *testing.T) {
 		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0])
 		assert.False(t, msg.(*evmTypes.VoteConfirmTokenRequest).Confirmed)
 	}).Repeat(repeats))
+
+	t.Run("wrong token decimals", testutils.Func(func(t *testing.T) {
+		setup()
+		attributes[evmTypes.AttributeKeyDecimals] = strconv.FormatUint(uint64(decimals+uint8(rand.I64Between(1, 256))), 10)
+
+		err := mgr.ProcessTokenConfirmation(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0])
+		assert.False(t, msg.(*evmTypes.VoteConfirmTokenRequest).Confirmed)
+	}).Repeat(repeats))
+
+	t.Run("token without decimals", testutils.Func(func(t *testing.T) {
+		setup()
+		rpc.CallContractFunc = func(context.Context, ethereum.CallMsg, *big.Int) ([]byte, error) { return nil, nil }
+
+		err := mgr.ProcessTokenConfirmation(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0])
+		assert.False(t, msg.(*evmTypes.VoteConfirmTokenRequest).Confirmed)
+	}).Repeat(repeats))
 }
 
 func TestMgr_ProcessTransferKeyConfirmation(t *testing.T) {
@@ -479,6 +740,7 @@ func TestMgr_ProcessTransferKeyConfirmation(t *testing.T) {
 		rpc                   *mock.ClientMock
 		broadcaster           *mock2.BroadcasterMock
 		prevNewOwnerAddrBytes []byte
+		canonicalHeader       *geth.Header
 	)
 	setup := func() {
 		cdc := app.MakeEncodingConfig().Amino
@@ -499,19 +761,26 @@ func TestMgr_ProcessTransferKeyConfirmation(t *testing.T) {
 			evmTypes.AttributeKeyAddress:         common.Bytes2Hex(newOwnerAddrBytes),
 			evmTypes.AttributeKeyThreshold:       "",
 			evmTypes.AttributeKeyConfHeight:      strconv.FormatUint(uint64(confHeight), 10),
+			evmTypes.AttributeKeyFinalityMode:    evmTypes.FinalityDepth.String(),
 			evmTypes.AttributeKeyPoll:            string(cdc.MustMarshalJSON(pollKey)),
 		}
 
+		canonicalHeader = &geth.Header{Number: big.NewInt(blockNumber), Difficulty: big.NewInt(0), Extra: rand.Bytes(32)}
+
 		rpc = &mock.ClientMock{
 			BlockNumberFunc: func(context.Context) (uint64, error) {
 				return uint64(blockNumber), nil
 			},
+			BlockHashByNumberFunc: func(context.Context, *big.Int) (common.Hash, error) {
+				return canonicalHeader.Hash(), nil
+			},
 			TransactionByHashFunc: func(ctx context.Context, hash common.Hash) (*geth.Transaction, bool, error) {
 				return &geth.Transaction{}, false, nil
 			},
 			TransactionReceiptFunc: func(context.Context, common.Hash) (*geth.Receipt, error) {
 				receipt := &geth.Receipt{
 					BlockNumber: big.NewInt(rand.I64Between(0, blockNumber-confHeight)),
+					BlockHash:   canonicalHeader.Hash(),
 					Logs: []*geth.Log{
 						/* previous transfer ownership event */
 						{
@@ -709,6 +978,411 @@ func createTokenLogs(denom string, gateway, tokenAddr common.Address, deploySig
 	return logs
 }
 
+func TestMgr_ProcessGasInfoConfirmation(t *testing.T) {
+	var (
+		mgr         *Mgr
+		attributes  map[string]string
+		rpc         *mock.ClientMock
+		broadcaster *mock2.BroadcasterMock
+		blockNumber uint64
+		baseFee     *big.Int
+		gasLimit    uint64
+		block       *geth.Block
+	)
+	setup := func() {
+		cdc := app.MakeEncodingConfig().Amino
+		pollKey := exported.NewPollKey(evmTypes.ModuleName, rand.StrBetween(5, 20))
+
+		blockNumber = uint64(rand.I64Between(1000, 1000000))
+		confHeight := uint64(rand.I64Between(1, 100))
+		latest := blockNumber + confHeight + uint64(rand.I64Between(0, 100))
+		attributes = map[string]string{
+			evmTypes.AttributeKeyChain:        "Ethereum",
+			evmTypes.AttributeKeyBlockNumber:  strconv.FormatUint(blockNumber, 10),
+			evmTypes.AttributeKeyConfHeight:   strconv.FormatUint(confHeight, 10),
+			evmTypes.AttributeKeyFinalityMode: evmTypes.FinalityDepth.String(),
+			evmTypes.AttributeKeyPoll:         string(cdc.MustMarshalJSON(pollKey)),
+		}
+
+		baseFee = big.NewInt(rand.I64Between(1, 100000000000))
+		gasLimit = uint64(rand.I64Between(1000000, 30000000))
+		block = geth.NewBlockWithHeader(&geth.Header{Number: new(big.Int).SetUint64(blockNumber), GasLimit: gasLimit, BaseFee: baseFee}).
+			WithBody([]*geth.Transaction{
+				geth.NewTx(&geth.DynamicFeeTx{GasTipCap: big.NewInt(3), GasFeeCap: new(big.Int).Add(baseFee, big.NewInt(10))}),
+				geth.NewTx(&geth.DynamicFeeTx{GasTipCap: big.NewInt(20), GasFeeCap: new(big.Int).Add(baseFee, big.NewInt(7))}),
+				geth.NewTx(&geth.LegacyTx{GasPrice: new(big.Int).Add(baseFee, big.NewInt(1))}),
+			}, nil)
+
+		rpc = &mock.ClientMock{
+			BlockNumberFunc: func(context.Context) (uint64, error) { return latest, nil },
+			BlockByNumberFunc: func(_ context.Context, number *big.Int) (*geth.Block, error) {
+				if number.Uint64() != blockNumber {
+					return nil, fmt.Errorf("not found")
+				}
+				return block, nil
+			},
+			BlockHashByNumberFunc: func(context.Context, *big.Int) (common.Hash, error) {
+				return block.Hash(), nil
+			},
+		}
+		broadcaster = &mock2.BroadcasterMock{}
+		evmMap := make(map[string]evmRpc.Client)
+		evmMap["ethereum"] = rpc
+		mgr = NewMgr(evmMap, client.Context{}, broadcaster, log.TestingLogger(), cdc)
+	}
+
+	repeats := 20
+	t.Run("should vote for the median priority fee", testutils.Func(func(t *testing.T) {
+		setup()
+
+		err := mgr.ProcessGasInfoConfirmation(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		gasInfo := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0]).(*evmTypes.VoteConfirmGasInfoRequest).GasInfo
+		assert.Equal(t, blockNumber, gasInfo.BlockNumber)
+		assert.Equal(t, sdk.NewIntFromBigInt(baseFee), gasInfo.BaseFee)
+		assert.Equal(t, sdk.NewInt(3), gasInfo.PriorityFee)
+		assert.Equal(t, gasLimit, gasInfo.BlockGasLimit)
+	}).Repeat(repeats))
+
+	t.Run("should treat the gas price as priority fee on chains without base fee", testutils.Func(func(t *testing.T) {
+		setup()
+		block = geth.NewBlockWithHeader(&geth.Header{Number: new(big.Int).SetUint64(blockNumber), GasLimit: gasLimit}).
+			WithBody([]*geth.Transaction{geth.NewTx(&geth.LegacyTx{GasPrice: big.NewInt(42)})}, nil)
+
+		err := mgr.ProcessGasInfoConfirmation(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		gasInfo := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0]).(*evmTypes.VoteConfirmGasInfoRequest).GasInfo
+		assert.True(t, gasInfo.BaseFee.IsZero())
+		assert.Equal(t, sdk.NewInt(42), gasInfo.PriorityFee)
+	}).Repeat(repeats))
+
+	t.Run("should vote empty when the block is not final", testutils.Func(func(t *testing.T) {
+		setup()
+		rpc.BlockNumberFunc = func(context.Context) (uint64, error) { return blockNumber, nil }
+		attributes[evmTypes.AttributeKeyConfHeight] = "2"
+
+		err := mgr.ProcessGasInfoConfirmation(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		gasInfo := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0]).(*evmTypes.VoteConfirmGasInfoRequest).GasInfo
+		assert.Zero(t, gasInfo.BlockNumber)
+	}).Repeat(repeats))
+
+	t.Run("should vote empty when the block cannot be retrieved", testutils.Func(func(t *testing.T) {
+		setup()
+		rpc.BlockByNumberFunc = func(context.Context, *big.Int) (*geth.Block, error) { return nil, fmt.Errorf("error") }
+
+		err := mgr.ProcessGasInfoConfirmation(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		gasInfo := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0]).(*evmTypes.VoteConfirmGasInfoRequest).GasInfo
+		assert.Zero(t, gasInfo.BlockNumber)
+	}).Repeat(repeats))
+
+	t.Run("missing attributes", testutils.Func(func(t *testing.T) {
+		setup()
+		for key := range attributes {
+			delete(attributes, key)
+
+			err := mgr.ProcessGasInfoConfirmation(tmEvents.Event{Attributes: attributes})
+			assert.Error(t, err)
+			assert.Len(t, broadcaster.BroadcastCalls(), 0)
+		}
+	}).Repeat(repeats))
+}
+
 func unwrapRefundMsg(msg sdk.Msg) sdk.Msg {
 	return msg.(*rewardtypes.RefundMsgRequest).GetInnerMessage()
 }
+
+func TestMgr_ProcessGatewayUpgradeConfirmation(t *testing.T) {
+	var (
+		mgr                *Mgr
+		attributes         map[string]string
+		rpc                *mock.ClientMock
+		broadcaster        *mock2.BroadcasterMock
+		gatewayAddr        common.Address
+		implementation     common.Address
+		prevImplementation common.Address
+		canonicalHeader    *geth.Header
+	)
+	setup := func() {
+		cdc := app.MakeEncodingConfig().Amino
+		pollKey := exported.NewPollKey(evmTypes.ModuleName, rand.StrBetween(5, 20))
+
+		gatewayAddr = common.BytesToAddress(rand.Bytes(common.AddressLength))
+		implementation = common.BytesToAddress(rand.Bytes(common.AddressLength))
+		prevImplementation = common.BytesToAddress(rand.Bytes(common.AddressLength))
+		blockNumber := rand.PInt64Gen().Where(func(i int64) bool { return i != 0 }).Next() // restrict to int64 so the block number in the receipt doesn't overflow
+		confHeight := rand.I64Between(0, blockNumber-1)
+
+		attributes = map[string]string{
+			evmTypes.AttributeKeyChain:          "Ethereum",
+			evmTypes.AttributeKeyTxID:           common.Bytes2Hex(rand.Bytes(common.HashLength)),
+			evmTypes.AttributeKeyGatewayAddress: gatewayAddr.Hex(),
+			evmTypes.AttributeKeyAddress:        implementation.Hex(),
+			evmTypes.AttributeKeyConfHeight:     strconv.FormatUint(uint64(confHeight), 10),
+			evmTypes.AttributeKeyFinalityMode:   evmTypes.FinalityDepth.String(),
+			evmTypes.AttributeKeyPoll:           string(cdc.MustMarshalJSON(pollKey)),
+		}
+
+		canonicalHeader = &geth.Header{Number: big.NewInt(blockNumber), Difficulty: big.NewInt(0), Extra: rand.Bytes(32)}
+
+		rpc = &mock.ClientMock{
+			BlockNumberFunc: func(context.Context) (uint64, error) {
+				return uint64(blockNumber), nil
+			},
+			BlockHashByNumberFunc: func(context.Context, *big.Int) (common.Hash, error) {
+				return canonicalHeader.Hash(), nil
+			},
+			TransactionByHashFunc: func(ctx context.Context, hash common.Hash) (*geth.Transaction, bool, error) {
+				return &geth.Transaction{}, false, nil
+			},
+			TransactionReceiptFunc: func(context.Context, common.Hash) (*geth.Receipt, error) {
+				receipt := &geth.Receipt{
+					BlockNumber: big.NewInt(rand.I64Between(0, blockNumber-confHeight)),
+					BlockHash:   canonicalHeader.Hash(),
+					Logs: []*geth.Log{
+						/* previous upgrade event */
+						{
+							Address: gatewayAddr,
+							Topics:  []common.Hash{UpgradedSig, common.BytesToHash(common.LeftPadBytes(prevImplementation.Bytes(), common.HashLength))},
+						},
+						/* the upgrade of our concern */
+						{
+							Address: gatewayAddr,
+							Topics:  []common.Hash{UpgradedSig, common.BytesToHash(common.LeftPadBytes(implementation.Bytes(), common.HashLength))},
+						},
+						/* an invalid upgrade event */
+						{
+							Address: gatewayAddr,
+							Topics:  []common.Hash{UpgradedSig},
+						},
+						/* upgrade event from a random address */
+						{
+							Address: common.BytesToAddress(rand.Bytes(common.AddressLength)),
+							Topics:  []common.Hash{UpgradedSig, common.BytesToHash(common.LeftPadBytes(rand.Bytes(common.AddressLength), common.HashLength))},
+						},
+					},
+					Status: 1,
+				}
+				return receipt, nil
+			},
+		}
+		broadcaster = &mock2.BroadcasterMock{}
+		evmMap := make(map[string]evmRpc.Client)
+		evmMap["ethereum"] = rpc
+		mgr = NewMgr(evmMap, client.Context{}, broadcaster, log.TestingLogger(), cdc)
+	}
+
+	repeats := 20
+	t.Run("happy path", testutils.Func(func(t *testing.T) {
+		setup()
+
+		err := mgr.ProcessGatewayUpgradeConfirmation(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0]).(*evmTypes.VoteConfirmGatewayUpgradeRequest)
+		assert.True(t, msg.Confirmed)
+		assert.Equal(t, common.HexToHash(attributes[evmTypes.AttributeKeyTxID]), common.Hash(msg.TxID))
+	}).Repeat(repeats))
+
+	t.Run("missing attributes", testutils.Func(func(t *testing.T) {
+		setup()
+		for key := range attributes {
+			delete(attributes, key)
+
+			err := mgr.ProcessGatewayUpgradeConfirmation(tmEvents.Event{Attributes: attributes})
+			assert.Error(t, err)
+			assert.Len(t, broadcaster.BroadcastCalls(), 0)
+		}
+	}).Repeat(repeats))
+
+	t.Run("no tx receipt", testutils.Func(func(t *testing.T) {
+		setup()
+		rpc.TransactionReceiptFunc = func(context.Context, common.Hash) (*geth.Receipt, error) { return nil, fmt.Errorf("error") }
+
+		err := mgr.ProcessGatewayUpgradeConfirmation(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0])
+		assert.False(t, msg.(*evmTypes.VoteConfirmGatewayUpgradeRequest).Confirmed)
+	}).Repeat(repeats))
+
+	t.Run("implementation mismatch", testutils.Func(func(t *testing.T) {
+		setup()
+		attributes[evmTypes.AttributeKeyAddress] = common.BytesToAddress(rand.Bytes(common.AddressLength)).Hex()
+
+		err := mgr.ProcessGatewayUpgradeConfirmation(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0])
+		assert.False(t, msg.(*evmTypes.VoteConfirmGatewayUpgradeRequest).Confirmed)
+	}).Repeat(repeats))
+
+	t.Run("implementation not last upgrade event", testutils.Func(func(t *testing.T) {
+		setup()
+		attributes[evmTypes.AttributeKeyAddress] = prevImplementation.Hex()
+
+		err := mgr.ProcessGatewayUpgradeConfirmation(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0])
+		assert.False(t, msg.(*evmTypes.VoteConfirmGatewayUpgradeRequest).Confirmed)
+	}).Repeat(repeats))
+}
+
+func TestMgr_ProcessBatchExecutionConfirmation(t *testing.T) {
+	var (
+		mgr             *Mgr
+		attributes      map[string]string
+		rpc             *mock.ClientMock
+		broadcaster     *mock2.BroadcasterMock
+		gatewayAddr     common.Address
+		commandIDs      []evmTypes.CommandID
+		receiptStatus   uint64
+		canonicalHeader *geth.Header
+	)
+	setup := func() {
+		cdc := app.MakeEncodingConfig().Amino
+		pollKey := exported.NewPollKey(evmTypes.ModuleName, rand.StrBetween(5, 20))
+
+		gatewayAddr = common.BytesToAddress(rand.Bytes(common.AddressLength))
+		commandIDs = nil
+		for i := 0; i < int(rand.I64Between(1, 10)); i++ {
+			commandIDs = append(commandIDs, evmTypes.CommandID(common.BytesToHash(rand.Bytes(common.HashLength))))
+		}
+		receiptStatus = geth.ReceiptStatusSuccessful
+		blockNumber := rand.PInt64Gen().Where(func(i int64) bool { return i != 0 }).Next() // restrict to int64 so the block number in the receipt doesn't overflow
+		confHeight := rand.I64Between(0, blockNumber-1)
+
+		attributes = map[string]string{
+			evmTypes.AttributeKeyChain:          "Ethereum",
+			evmTypes.AttributeKeyTxID:           common.Bytes2Hex(rand.Bytes(common.HashLength)),
+			evmTypes.AttributeKeyGatewayAddress: gatewayAddr.Hex(),
+			evmTypes.AttributeKeyConfHeight:     strconv.FormatUint(uint64(confHeight), 10),
+			evmTypes.AttributeKeyFinalityMode:   evmTypes.FinalityDepth.String(),
+			evmTypes.AttributeKeyPoll:           string(cdc.MustMarshalJSON(pollKey)),
+		}
+
+		canonicalHeader = &geth.Header{Number: big.NewInt(blockNumber), Difficulty: big.NewInt(0), Extra: rand.Bytes(32)}
+
+		rpc = &mock.ClientMock{
+			BlockNumberFunc: func(context.Context) (uint64, error) {
+				return uint64(blockNumber), nil
+			},
+			BlockHashByNumberFunc: func(context.Context, *big.Int) (common.Hash, error) {
+				return canonicalHeader.Hash(), nil
+			},
+			TransactionByHashFunc: func(ctx context.Context, hash common.Hash) (*geth.Transaction, bool, error) {
+				return &geth.Transaction{}, false, nil
+			},
+			TransactionReceiptFunc: func(context.Context, common.Hash) (*geth.Receipt, error) {
+				var logs []*geth.Log
+				for _, commandID := range commandIDs {
+					logs = append(logs, &geth.Log{Address: gatewayAddr, Topics: []common.Hash{ExecutedSig, common.Hash(commandID)}})
+				}
+
+				receipt := &geth.Receipt{
+					BlockNumber: big.NewInt(rand.I64Between(0, blockNumber-confHeight)),
+					BlockHash:   canonicalHeader.Hash(),
+					Logs:        logs,
+					Status:      receiptStatus,
+				}
+				return receipt, nil
+			},
+		}
+		broadcaster = &mock2.BroadcasterMock{
+			BroadcastFunc: func(client.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return nil, nil },
+		}
+		evmMap := make(map[string]evmRpc.Client)
+		evmMap["ethereum"] = rpc
+		mgr = NewMgr(evmMap, client.Context{}, broadcaster, log.TestingLogger(), cdc)
+	}
+
+	repeats := 20
+	t.Run("should vote for the commands executed in the transaction", testutils.Func(func(t *testing.T) {
+		setup()
+
+		err := mgr.ProcessBatchExecutionConfirmation(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0]).(*evmTypes.VoteConfirmBatchExecutionRequest)
+		assert.Equal(t, commandIDs, msg.ExecutedCommandIDs)
+	}).Repeat(repeats))
+
+	t.Run("missing attributes", testutils.Func(func(t *testing.T) {
+		setup()
+		for key := range attributes {
+			delete(attributes, key)
+
+			err := mgr.ProcessBatchExecutionConfirmation(tmEvents.Event{Attributes: attributes})
+			assert.Error(t, err)
+			assert.Len(t, broadcaster.BroadcastCalls(), 0)
+		}
+	}).Repeat(repeats))
+
+	t.Run("should vote for no commands when there is no tx receipt", testutils.Func(func(t *testing.T) {
+		setup()
+		rpc.TransactionReceiptFunc = func(context.Context, common.Hash) (*geth.Receipt, error) { return nil, fmt.Errorf("error") }
+
+		err := mgr.ProcessBatchExecutionConfirmation(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0]).(*evmTypes.VoteConfirmBatchExecutionRequest)
+		assert.Empty(t, msg.ExecutedCommandIDs)
+	}).Repeat(repeats))
+
+	t.Run("should vote for no commands when the transaction failed", testutils.Func(func(t *testing.T) {
+		setup()
+		receiptStatus = geth.ReceiptStatusFailed
+
+		err := mgr.ProcessBatchExecutionConfirmation(tmEvents.Event{Attributes: attributes})
+
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0]).(*evmTypes.VoteConfirmBatchExecutionRequest)
+		assert.Empty(t, msg.ExecutedCommandIDs)
+	}).Repeat(repeats))
+}
+
+func TestGetExecutedCommandIDs(t *testing.T) {
+	t.Run("should only return commands executed by the gateway once", testutils.Func(func(t *testing.T) {
+		gatewayAddr := common.BytesToAddress(rand.Bytes(common.AddressLength))
+		first := evmTypes.CommandID(common.BytesToHash(rand.Bytes(common.HashLength)))
+		second := evmTypes.CommandID(common.BytesToHash(rand.Bytes(common.HashLength)))
+
+		receipt := &geth.Receipt{
+			Logs: []*geth.Log{
+				{Address: gatewayAddr, Topics: []common.Hash{ExecutedSig, common.Hash(first)}},
+				/* executed event from a random address */
+				{Address: common.BytesToAddress(rand.Bytes(common.AddressLength)), Topics: []common.Hash{ExecutedSig, common.BytesToHash(rand.Bytes(common.HashLength))}},
+				/* an invalid executed event */
+				{Address: gatewayAddr, Topics: []common.Hash{ExecutedSig}},
+				/* another gateway event */
+				{Address: gatewayAddr, Topics: []common.Hash{common.BytesToHash(rand.Bytes(common.HashLength)), common.BytesToHash(rand.Bytes(common.HashLength))}},
+				{Address: gatewayAddr, Topics: []common.Hash{ExecutedSig, common.Hash(second)}},
+				/* duplicate executed event */
+				{Address: gatewayAddr, Topics: []common.Hash{ExecutedSig, common.Hash(first)}},
+			},
+		}
+
+		assert.Equal(t, []evmTypes.CommandID{first, second}, getExecutedCommandIDs(receipt, gatewayAddr))
+	}).Repeat(20))
+
+	t.Run("should return no commands when the gateway emitted no executed events", testutils.Func(func(t *testing.T) {
+		receipt := &geth.Receipt{Logs: []*geth.Log{{Address: common.BytesToAddress(rand.Bytes(common.AddressLength)), Topics: []common.Hash{ExecutedSig, common.BytesToHash(rand.Bytes(common.HashLength))}}}}
+
+		assert.Empty(t, getExecutedCommandIDs(receipt, common.BytesToAddress(rand.Bytes(common.AddressLength))))
+	}).Repeat(20))
+}
@@ -0,0 +1,410 @@
+package evm
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	sdkClient "github.com/cosmos/cosmos-sdk/client"
+	sdkFlags "github.com/cosmos/cosmos-sdk/client/flags"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	geth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	tmLog "github.com/tendermint/tendermint/libs/log"
+
+	broadcasterTypes "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster/types"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/config"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/x/evm/keeper"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+)
+
+// maxBatchLookback is the maximum number of signed command batches per chain the relayer looks back on for unexecuted commands
+const maxBatchLookback = 20
+
+// isCommandExecutedSelector is the call data selector of the gateway's isCommandExecuted(bytes32) function
+var isCommandExecutedSelector = crypto.Keccak256([]byte("isCommandExecuted(bytes32)"))[:4]
+
+// Relayer submits signed command batches to the gateway contracts of EVM chains
+type Relayer struct {
+	rpcs        map[string]rpc.TxClient
+	query       func(path string) ([]byte, error)
+	broadcaster broadcasterTypes.Broadcaster
+	cliCtx      sdkClient.Context
+	key         *ecdsa.PrivateKey
+	from        common.Address
+	config      config.RelayerConfig
+	logger      tmLog.Logger
+	now         func() time.Time
+	relays      map[string]map[string]*relay
+}
+
+// relay tracks the submission of a command batch to a chain
+type relay struct {
+	batchID     string
+	gateway     common.Address
+	data        []byte
+	nonce       uint64
+	gasLimit    uint64
+	gasPrice    *big.Int
+	txIDs       []common.Hash
+	submittedAt time.Time
+	resubmits   int
+	executedBy  *common.Hash
+	done        bool
+}
+
+// NewRelayer returns a new Relayer instance
+func NewRelayer(rpcs map[string]rpc.TxClient, cliCtx sdkClient.Context, broadcaster broadcasterTypes.Broadcaster, key *ecdsa.PrivateKey, conf config.RelayerConfig, logger tmLog.Logger) *Relayer {
+	return &Relayer{
+		rpcs: rpcs,
+		query: func(path string) ([]byte, error) {
+			bz, _, err := cliCtx.Query(path)
+			return bz, err
+		},
+		broadcaster: broadcaster,
+		cliCtx:      cliCtx,
+		key:         key,
+		from:        crypto.PubkeyToAddress(key.PublicKey),
+		config:      conf,
+		logger:      logger.With("listener", "evm-relayer"),
+		now:         time.Now,
+		relays:      make(map[string]map[string]*relay),
+	}
+}
+
+// ProcessNewBlockHeader relays the signed command batches of every chain that have not been executed yet
+func (r *Relayer) ProcessNewBlockHeader(_ int64) error {
+	chains := make([]string, 0, len(r.rpcs))
+	for chain := range r.rpcs {
+		chains = append(chains, chain)
+	}
+	sort.Strings(chains)
+
+	for _, chain := range chains {
+		if err := r.relayBatches(chain); err != nil {
+			r.logger.Error(sdkerrors.Wrapf(err, "failed to relay command batches to chain %s", chain).Error())
+		}
+	}
+
+	return nil
+}
+
+func (r *Relayer) relayBatches(chain string) error {
+	gateway, err := r.queryGatewayAddress(chain)
+	if err != nil {
+		return err
+	}
+
+	batches, err := r.getUnexecutedBatches(chain, gateway)
+	if err != nil {
+		return err
+	}
+
+	// forget about batches that have been executed in the meantime
+	relays := make(map[string]*relay)
+	for _, batch := range batches {
+		if rel, ok := r.relays[chain][batch.ID]; ok {
+			relays[batch.ID] = rel
+		}
+	}
+	r.relays[chain] = relays
+
+	for _, batch := range batches {
+		rel, ok := relays[batch.ID]
+		if !ok {
+			err = r.submit(chain, gateway, batch)
+		} else {
+			err = r.track(chain, rel)
+		}
+
+		if err != nil {
+			r.logger.Error(sdkerrors.Wrapf(err, "failed to relay command batch %s to chain %s", batch.ID, chain).Error())
+		}
+	}
+
+	return nil
+}
+
+// getUnexecutedBatches returns the signed command batches of the given chain, oldest first, that contain commands
+// which the gateway has not executed yet. Looking at the gateway itself prevents resubmitting executed batches after a restart
+func (r *Relayer) getUnexecutedBatches(chain string, gateway common.Address) ([]evmTypes.QueryBatchedCommandsResponse, error) {
+	bz, err := r.query(fmt.Sprintf("custom/%s/%s/%s", evmTypes.QuerierRoute, keeper.QLatestBatchedCommands, chain))
+	if err != nil {
+		// no command batch has been created for the chain yet
+		r.logger.Debug(sdkerrors.Wrapf(err, "no command batch found for chain %s", chain).Error())
+		return nil, nil
+	}
+
+	var batches []evmTypes.QueryBatchedCommandsResponse
+	for i := 0; i < maxBatchLookback; i++ {
+		var batch evmTypes.QueryBatchedCommandsResponse
+		if err := evmTypes.ModuleCdc.UnmarshalLengthPrefixed(bz, &batch); err != nil {
+			return nil, err
+		}
+
+		if batch.Status == evmTypes.BatchSigned {
+			// all earlier batches are considered to be executed once a fully executed batch is found
+			if len(batch.Executions) == len(batch.CommandIDs) {
+				break
+			}
+
+			executed, err := r.isBatchExecuted(chain, gateway, batch)
+			if err != nil {
+				return nil, err
+			}
+
+			if executed {
+				break
+			}
+
+			batches = append([]evmTypes.QueryBatchedCommandsResponse{batch}, batches...)
+		}
+
+		if batch.PrevBatchedCommandsID == "" {
+			break
+		}
+
+		bz, err = r.query(fmt.Sprintf("custom/%s/%s/%s/%s", evmTypes.QuerierRoute, keeper.QBatchedCommands, chain, batch.PrevBatchedCommandsID))
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "could not retrieve command batch %s", batch.PrevBatchedCommandsID)
+		}
+	}
+
+	return batches, nil
+}
+
+// isBatchExecuted returns true if the gateway has executed all commands of the given batch
+func (r *Relayer) isBatchExecuted(chain string, gateway common.Address, batch evmTypes.QueryBatchedCommandsResponse) (bool, error) {
+	for _, commandID := range batch.CommandIDs {
+		id, err := hex.DecodeString(commandID)
+		if err != nil {
+			return false, sdkerrors.Wrapf(err, "invalid command ID %s in batch %s", commandID, batch.ID)
+		}
+
+		executed, err := isCommandExecuted(r.rpcs[chain], gateway, common.BytesToHash(id))
+		if err != nil {
+			return false, err
+		}
+
+		if !executed {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func isCommandExecuted(client rpc.Client, gateway common.Address, commandID common.Hash) (bool, error) {
+	data := append(append([]byte{}, isCommandExecutedSelector...), commandID.Bytes()...)
+	result, err := client.CallContract(context.Background(), ethereum.CallMsg{To: &gateway, Data: data}, nil)
+	if err != nil {
+		return false, sdkerrors.Wrapf(err, "could not check execution of command %s", commandID.Hex())
+	}
+
+	// isCommandExecuted() returns a single bool that is ABI encoded as a 32 byte word
+	if len(result) != common.HashLength {
+		return false, fmt.Errorf("unexpected result of isCommandExecuted call to gateway '%s'", gateway.String())
+	}
+
+	return new(big.Int).SetBytes(result).Sign() != 0, nil
+}
+
+func (r *Relayer) submit(chain string, gateway common.Address, batch evmTypes.QueryBatchedCommandsResponse) error {
+	client := r.rpcs[chain]
+
+	data, err := hex.DecodeString(batch.ExecuteData)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "invalid execute data for batch %s", batch.ID)
+	}
+
+	nonce, err := client.PendingNonceAt(context.Background(), r.from)
+	if err != nil {
+		return sdkerrors.Wrap(err, "could not retrieve nonce")
+	}
+
+	gasPrice, err := client.SuggestGasPrice(context.Background())
+	if err != nil {
+		return sdkerrors.Wrap(err, "could not retrieve gas price")
+	}
+	gasPrice = r.capGasPrice(gasPrice)
+
+	gasLimit, err := client.EstimateGas(context.Background(), ethereum.CallMsg{From: r.from, To: &gateway, GasPrice: gasPrice, Data: data})
+	if err != nil {
+		return sdkerrors.Wrapf(err, "could not estimate gas for batch %s", batch.ID)
+	}
+
+	rel := &relay{
+		batchID:  batch.ID,
+		gateway:  gateway,
+		data:     data,
+		nonce:    nonce,
+		gasLimit: gasLimit,
+		gasPrice: gasPrice,
+	}
+	if err := r.send(chain, rel); err != nil {
+		return err
+	}
+
+	r.relays[chain][batch.ID] = rel
+	return nil
+}
+
+func (r *Relayer) track(chain string, rel *relay) error {
+	if rel.executedBy != nil {
+		return r.confirmExecution(chain, rel)
+	}
+
+	if rel.done {
+		return nil
+	}
+
+	client := r.rpcs[chain]
+
+	// any of the submitted transactions may have been mined, because they all share the same nonce
+	for _, txID := range rel.txIDs {
+		receipt, err := client.TransactionReceipt(context.Background(), txID)
+		if err != nil {
+			continue
+		}
+
+		if !isTxSuccessful(receipt) {
+			rel.done = true
+			return fmt.Errorf("transaction %s executing batch %s failed", txID.Hex(), rel.batchID)
+		}
+
+		r.logger.Info(fmt.Sprintf("batch %s was executed on chain %s in transaction %s", rel.batchID, chain, txID.Hex()))
+		executedBy := txID
+		rel.executedBy = &executedBy
+
+		return r.confirmExecution(chain, rel)
+	}
+
+	confirmedNonce, err := client.NonceAt(context.Background(), r.from, nil)
+	if err != nil {
+		return sdkerrors.Wrap(err, "could not retrieve nonce")
+	}
+
+	// the nonce has been used by a different transaction of the account, so the batch must be submitted again
+	if confirmedNonce > rel.nonce {
+		r.logger.Info(fmt.Sprintf("nonce %d of batch %s for chain %s has been used by another transaction, submitting again", rel.nonce, rel.batchID, chain))
+		delete(r.relays[chain], rel.batchID)
+		return nil
+	}
+
+	if r.now().Sub(rel.submittedAt) < r.config.ResubmitTimeout {
+		return nil
+	}
+
+	if rel.resubmits >= r.config.MaxResubmits {
+		rel.done = true
+		return fmt.Errorf("batch %s was not executed on chain %s after %d resubmissions", rel.batchID, chain, rel.resubmits)
+	}
+
+	gasPrice := r.capGasPrice(bumpGasPrice(rel.gasPrice, r.config.GasBumpPercent))
+	if gasPrice.Cmp(rel.gasPrice) <= 0 {
+		r.logger.Info(fmt.Sprintf("gas price for batch %s on chain %s reached the limit of %s wei, waiting for execution", rel.batchID, chain, rel.gasPrice.String()))
+		rel.submittedAt = r.now()
+		return nil
+	}
+
+	prevGasPrice := rel.gasPrice
+	rel.gasPrice = gasPrice
+	rel.resubmits++
+	if err := r.send(chain, rel); err != nil {
+		// try again with the same gas price on the next block
+		rel.gasPrice = prevGasPrice
+		rel.resubmits--
+		return err
+	}
+
+	return nil
+}
+
+// confirmExecution starts the confirmation of the execution of the given batch on Axelar, so the executed commands are recorded
+func (r *Relayer) confirmExecution(chain string, rel *relay) error {
+	if rel.done {
+		return nil
+	}
+
+	batchID, err := hex.DecodeString(rel.batchID)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "invalid batch ID %s", rel.batchID)
+	}
+
+	msg := evmTypes.NewConfirmBatchExecutionRequest(r.cliCtx.FromAddress, chain, batchID, *rel.executedBy)
+	if _, err := r.broadcaster.Broadcast(r.cliCtx.WithBroadcastMode(sdkFlags.BroadcastBlock), msg); err != nil {
+		// try again on the next block
+		return sdkerrors.Wrapf(err, "could not confirm execution of batch %s", rel.batchID)
+	}
+
+	r.logger.Info(fmt.Sprintf("confirming execution of batch %s on chain %s in transaction %s", rel.batchID, chain, rel.executedBy.Hex()))
+	rel.done = true
+	return nil
+}
+
+func (r *Relayer) send(chain string, rel *relay) error {
+	client := r.rpcs[chain]
+
+	chainID, err := client.ChainID(context.Background())
+	if err != nil {
+		return sdkerrors.Wrap(err, "could not retrieve chain ID")
+	}
+
+	tx := geth.NewTransaction(rel.nonce, rel.gateway, big.NewInt(0), rel.gasLimit, rel.gasPrice, rel.data)
+	signedTx, err := geth.SignTx(tx, geth.LatestSignerForChainID(chainID), r.key)
+	if err != nil {
+		return err
+	}
+
+	if err := client.SendTransaction(context.Background(), signedTx); err != nil {
+		return sdkerrors.Wrapf(err, "could not send transaction for batch %s", rel.batchID)
+	}
+
+	rel.txIDs = append(rel.txIDs, signedTx.Hash())
+	rel.submittedAt = r.now()
+
+	r.logger.Info(fmt.Sprintf("submitted batch %s to chain %s in transaction %s", rel.batchID, chain, signedTx.Hash().Hex()),
+		"nonce", rel.nonce, "gas price", rel.gasPrice.String(), "resubmits", rel.resubmits)
+	return nil
+}
+
+func (r *Relayer) queryGatewayAddress(chain string) (common.Address, error) {
+	bz, err := r.query(fmt.Sprintf("custom/%s/%s/%s", evmTypes.QuerierRoute, keeper.QAxelarGatewayAddress, chain))
+	if err != nil {
+		return common.Address{}, sdkerrors.Wrapf(err, "could not retrieve gateway address for chain %s", chain)
+	}
+
+	return common.BytesToAddress(bz), nil
+}
+
+func (r *Relayer) capGasPrice(gasPrice *big.Int) *big.Int {
+	if r.config.MaxGasPrice == 0 {
+		return gasPrice
+	}
+
+	maxGasPrice := new(big.Int).SetUint64(r.config.MaxGasPrice)
+	if gasPrice.Cmp(maxGasPrice) > 0 {
+		return maxGasPrice
+	}
+
+	return gasPrice
+}
+
+func bumpGasPrice(gasPrice *big.Int, percent uint64) *big.Int {
+	bumped := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(100+percent))
+	bumped.Div(bumped, big.NewInt(100))
+
+	// make sure the gas price increases even for tiny values
+	if bumped.Cmp(gasPrice) <= 0 {
+		bumped.Add(gasPrice, big.NewInt(1))
+	}
+
+	return bumped
+}
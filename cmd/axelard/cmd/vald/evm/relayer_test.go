@@ -0,0 +1,280 @@
+package evm
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	sdkClient "github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	geth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+
+	broadcastMock "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster/types/mock"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/config"
+	evmRpc "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm/rpc/mock"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/evm/keeper"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+)
+
+func TestRelayer_ProcessNewBlockHeader(t *testing.T) {
+	var (
+		relayer     *Relayer
+		rpc         *mock.TxClientMock
+		broadcaster *broadcastMock.BroadcasterMock
+		batch       *evmTypes.QueryBatchedCommandsResponse
+		batches     map[string]*evmTypes.QueryBatchedCommandsResponse
+		executed    map[common.Hash]bool
+		gateway     common.Address
+		now         time.Time
+		nonce       uint64
+		gasPrice    *big.Int
+		receipts    map[common.Hash]*geth.Receipt
+	)
+
+	chain := "ethereum"
+	conf := config.DefaultRelayerConfig()
+
+	newBatch := func(prevID string) *evmTypes.QueryBatchedCommandsResponse {
+		b := &evmTypes.QueryBatchedCommandsResponse{
+			ID:                    hex.EncodeToString(rand.Bytes(common.HashLength)),
+			Status:                evmTypes.BatchSigned,
+			ExecuteData:           hex.EncodeToString(rand.Bytes(int(rand.I64Between(100, 1000)))),
+			PrevBatchedCommandsID: prevID,
+		}
+		for i := 0; i < int(rand.I64Between(1, 5)); i++ {
+			b.CommandIDs = append(b.CommandIDs, hex.EncodeToString(rand.Bytes(common.HashLength)))
+		}
+		batches[b.ID] = b
+
+		return b
+	}
+
+	executeOnChain := func(b *evmTypes.QueryBatchedCommandsResponse) {
+		for _, commandID := range b.CommandIDs {
+			id, _ := hex.DecodeString(commandID)
+			executed[common.BytesToHash(id)] = true
+		}
+	}
+
+	setup := func() {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			panic(err)
+		}
+
+		gateway = common.BytesToAddress(rand.Bytes(common.AddressLength))
+		now = time.Now()
+		nonce = uint64(rand.I64Between(0, 1000))
+		gasPrice = big.NewInt(rand.I64Between(1, 1000000000))
+		receipts = make(map[common.Hash]*geth.Receipt)
+		batches = make(map[string]*evmTypes.QueryBatchedCommandsResponse)
+		executed = make(map[common.Hash]bool)
+		batch = newBatch("")
+
+		rpc = &mock.TxClientMock{
+			ChainIDFunc:         func(context.Context) (*big.Int, error) { return big.NewInt(1), nil },
+			PendingNonceAtFunc:  func(context.Context, common.Address) (uint64, error) { return nonce, nil },
+			NonceAtFunc:         func(context.Context, common.Address, *big.Int) (uint64, error) { return nonce, nil },
+			SuggestGasPriceFunc: func(context.Context) (*big.Int, error) { return gasPrice, nil },
+			EstimateGasFunc:     func(context.Context, ethereum.CallMsg) (uint64, error) { return 1000000, nil },
+			SendTransactionFunc: func(context.Context, *geth.Transaction) error { return nil },
+			CallContractFunc: func(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+				if *msg.To != gateway || len(msg.Data) != 4+common.HashLength {
+					return nil, fmt.Errorf("unexpected contract call")
+				}
+
+				result := make([]byte, common.HashLength)
+				if executed[common.BytesToHash(msg.Data[4:])] {
+					result[common.HashLength-1] = 1
+				}
+				return result, nil
+			},
+			TransactionReceiptFunc: func(_ context.Context, txID common.Hash) (*geth.Receipt, error) {
+				receipt, ok := receipts[txID]
+				if !ok {
+					return nil, ethereum.NotFound
+				}
+				return receipt, nil
+			},
+		}
+
+		broadcaster = &broadcastMock.BroadcasterMock{
+			BroadcastFunc: func(sdkClient.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil },
+		}
+
+		relayer = &Relayer{
+			rpcs: map[string]evmRpc.TxClient{chain: rpc},
+			query: func(path string) ([]byte, error) {
+				switch {
+				case strings.Contains(path, keeper.QLatestBatchedCommands):
+					return evmTypes.ModuleCdc.MarshalLengthPrefixed(batch)
+				case strings.Contains(path, keeper.QBatchedCommands):
+					b, ok := batches[path[strings.LastIndex(path, "/")+1:]]
+					if !ok {
+						return nil, fmt.Errorf("batch not found")
+					}
+					return evmTypes.ModuleCdc.MarshalLengthPrefixed(b)
+				case strings.Contains(path, keeper.QAxelarGatewayAddress):
+					return gateway.Bytes(), nil
+				default:
+					return nil, fmt.Errorf("unknown query %s", path)
+				}
+			},
+			broadcaster: broadcaster,
+			cliCtx:      sdkClient.Context{FromAddress: rand.AccAddr()},
+			key:         key,
+			from:        crypto.PubkeyToAddress(key.PublicKey),
+			config:      conf,
+			logger:      log.TestingLogger(),
+			now:         func() time.Time { return now },
+			relays:      make(map[string]map[string]*relay),
+		}
+	}
+
+	repeats := 20
+	t.Run("should submit a signed batch to the gateway once", testutils.Func(func(t *testing.T) {
+		setup()
+
+		assert.NoError(t, relayer.ProcessNewBlockHeader(rand.PosI64()))
+		assert.NoError(t, relayer.ProcessNewBlockHeader(rand.PosI64()))
+
+		assert.Len(t, rpc.SendTransactionCalls(), 1)
+		tx := rpc.SendTransactionCalls()[0].Tx
+		assert.Equal(t, gateway, *tx.To())
+		assert.Equal(t, nonce, tx.Nonce())
+		assert.Equal(t, gasPrice, tx.GasPrice())
+		assert.Equal(t, batch.ExecuteData, hex.EncodeToString(tx.Data()))
+	}).Repeat(repeats))
+
+	t.Run("should not submit unsigned or executed batches", testutils.Func(func(t *testing.T) {
+		setup()
+
+		batch.Status = evmTypes.BatchSigning
+		assert.NoError(t, relayer.ProcessNewBlockHeader(rand.PosI64()))
+
+		batch.Status = evmTypes.BatchSigned
+		batch.Executions = make([]evmTypes.CommandExecution, len(batch.CommandIDs))
+		assert.NoError(t, relayer.ProcessNewBlockHeader(rand.PosI64()))
+
+		batch.Executions = nil
+		executeOnChain(batch)
+		assert.NoError(t, relayer.ProcessNewBlockHeader(rand.PosI64()))
+
+		assert.Len(t, rpc.SendTransactionCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should submit all unexecuted signed batches oldest first", testutils.Func(func(t *testing.T) {
+		setup()
+
+		executedBatch := batch
+		executeOnChain(executedBatch)
+		first := newBatch(executedBatch.ID)
+		unsigned := newBatch(first.ID)
+		unsigned.Status = evmTypes.BatchSigning
+		batch = newBatch(unsigned.ID)
+
+		assert.NoError(t, relayer.ProcessNewBlockHeader(rand.PosI64()))
+
+		assert.Len(t, rpc.SendTransactionCalls(), 2)
+		assert.Equal(t, first.ExecuteData, hex.EncodeToString(rpc.SendTransactionCalls()[0].Tx.Data()))
+		assert.Equal(t, batch.ExecuteData, hex.EncodeToString(rpc.SendTransactionCalls()[1].Tx.Data()))
+	}).Repeat(repeats))
+
+	t.Run("should not resubmit batches executed on chain after a restart", testutils.Func(func(t *testing.T) {
+		setup()
+
+		assert.NoError(t, relayer.ProcessNewBlockHeader(rand.PosI64()))
+		assert.Len(t, rpc.SendTransactionCalls(), 1)
+
+		// restart
+		relayer.relays = make(map[string]map[string]*relay)
+		executeOnChain(batch)
+		assert.NoError(t, relayer.ProcessNewBlockHeader(rand.PosI64()))
+
+		assert.Len(t, rpc.SendTransactionCalls(), 1)
+	}).Repeat(repeats))
+
+	t.Run("should confirm the batch execution once the transaction is mined", testutils.Func(func(t *testing.T) {
+		setup()
+
+		broadcaster.BroadcastFunc = func(sdkClient.Context, ...sdk.Msg) (*sdk.TxResponse, error) {
+			return nil, fmt.Errorf("broadcast failed")
+		}
+
+		assert.NoError(t, relayer.ProcessNewBlockHeader(rand.PosI64()))
+		txID := rpc.SendTransactionCalls()[0].Tx.Hash()
+		receipts[txID] = &geth.Receipt{Status: geth.ReceiptStatusSuccessful}
+
+		// broadcasting is retried until it succeeds
+		assert.NoError(t, relayer.ProcessNewBlockHeader(rand.PosI64()))
+		broadcaster.BroadcastFunc = func(sdkClient.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil }
+		assert.NoError(t, relayer.ProcessNewBlockHeader(rand.PosI64()))
+		assert.NoError(t, relayer.ProcessNewBlockHeader(rand.PosI64()))
+
+		assert.Len(t, broadcaster.BroadcastCalls(), 2)
+		msg := broadcaster.BroadcastCalls()[1].Msgs[0].(*evmTypes.ConfirmBatchExecutionRequest)
+		assert.Equal(t, relayer.cliCtx.FromAddress, msg.Sender)
+		assert.Equal(t, chain, msg.Chain)
+		assert.Equal(t, batch.ID, hex.EncodeToString(msg.BatchID))
+		assert.Equal(t, txID.Bytes(), msg.TxID.Bytes())
+		assert.Len(t, rpc.SendTransactionCalls(), 1)
+	}).Repeat(repeats))
+
+	t.Run("should resubmit with a bumped gas price and the same nonce until the batch is executed", testutils.Func(func(t *testing.T) {
+		setup()
+
+		assert.NoError(t, relayer.ProcessNewBlockHeader(rand.PosI64()))
+
+		now = now.Add(conf.ResubmitTimeout)
+		assert.NoError(t, relayer.ProcessNewBlockHeader(rand.PosI64()))
+
+		assert.Len(t, rpc.SendTransactionCalls(), 2)
+		first, second := rpc.SendTransactionCalls()[0].Tx, rpc.SendTransactionCalls()[1].Tx
+		assert.Equal(t, first.Nonce(), second.Nonce())
+		assert.Equal(t, bumpGasPrice(first.GasPrice(), conf.GasBumpPercent), second.GasPrice())
+		assert.Equal(t, 1, second.GasPrice().Cmp(first.GasPrice()))
+
+		// the first submission gets mined
+		receipts[first.Hash()] = &geth.Receipt{Status: geth.ReceiptStatusSuccessful}
+		now = now.Add(conf.ResubmitTimeout)
+		assert.NoError(t, relayer.ProcessNewBlockHeader(rand.PosI64()))
+
+		assert.Len(t, rpc.SendTransactionCalls(), 2)
+	}).Repeat(repeats))
+
+	t.Run("should submit again with a new nonce if the nonce was used by another transaction", testutils.Func(func(t *testing.T) {
+		setup()
+
+		assert.NoError(t, relayer.ProcessNewBlockHeader(rand.PosI64()))
+
+		nonce++
+		assert.NoError(t, relayer.ProcessNewBlockHeader(rand.PosI64()))
+		assert.NoError(t, relayer.ProcessNewBlockHeader(rand.PosI64()))
+
+		assert.Len(t, rpc.SendTransactionCalls(), 2)
+		assert.Equal(t, nonce, rpc.SendTransactionCalls()[1].Tx.Nonce())
+	}).Repeat(repeats))
+
+	t.Run("should stop resubmitting after the maximum number of resubmissions", testutils.Func(func(t *testing.T) {
+		setup()
+
+		for i := 0; i < conf.MaxResubmits+5; i++ {
+			assert.NoError(t, relayer.ProcessNewBlockHeader(rand.PosI64()))
+			now = now.Add(conf.ResubmitTimeout)
+		}
+
+		assert.Len(t, rpc.SendTransactionCalls(), conf.MaxResubmits+1)
+	}).Repeat(repeats))
+}
@@ -6,8 +6,10 @@ package mock
 import (
 	"context"
 	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm/rpc"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
 	"sync"
 )
 
@@ -21,9 +23,24 @@ var _ rpc.Client = &ClientMock{}
 //
 // 		// make and configure a mocked rpc.Client
 // 		mockedClient := &ClientMock{
+// 			BlockByNumberFunc: func(ctx context.Context, number *big.Int) (*types.Block, error) {
+// 				panic("mock out the BlockByNumber method")
+// 			},
+// 			BlockHashByNumberFunc: func(ctx context.Context, number *big.Int) (common.Hash, error) {
+// 				panic("mock out the BlockHashByNumber method")
+// 			},
 // 			BlockNumberFunc: func(ctx context.Context) (uint64, error) {
 // 				panic("mock out the BlockNumber method")
 // 			},
+// 			CallContractFunc: func(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+// 				panic("mock out the CallContract method")
+// 			},
+// 			HeaderByTagFunc: func(ctx context.Context, tag string) (*types.Header, error) {
+// 				panic("mock out the HeaderByTag method")
+// 			},
+// 			TraceTransactionFunc: func(ctx context.Context, txHash common.Hash) (*rpc.CallFrame, error) {
+// 				panic("mock out the TraceTransaction method")
+// 			},
 // 			TransactionByHashFunc: func(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
 // 				panic("mock out the TransactionByHash method")
 // 			},
@@ -37,9 +54,24 @@ var _ rpc.Client = &ClientMock{}
 //
 // 	}
 type ClientMock struct {
+	// BlockByNumberFunc mocks the BlockByNumber method.
+	BlockByNumberFunc func(ctx context.Context, number *big.Int) (*types.Block, error)
+
+	// BlockHashByNumberFunc mocks the BlockHashByNumber method.
+	BlockHashByNumberFunc func(ctx context.Context, number *big.Int) (common.Hash, error)
+
 	// BlockNumberFunc mocks the BlockNumber method.
 	BlockNumberFunc func(ctx context.Context) (uint64, error)
 
+	// CallContractFunc mocks the CallContract method.
+	CallContractFunc func(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+
+	// HeaderByTagFunc mocks the HeaderByTag method.
+	HeaderByTagFunc func(ctx context.Context, tag string) (*types.Header, error)
+
+	// TraceTransactionFunc mocks the TraceTransaction method.
+	TraceTransactionFunc func(ctx context.Context, txHash common.Hash) (*rpc.CallFrame, error)
+
 	// TransactionByHashFunc mocks the TransactionByHash method.
 	TransactionByHashFunc func(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
 
@@ -48,11 +80,48 @@ type ClientMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// BlockByNumber holds details about calls to the BlockByNumber method.
+		BlockByNumber []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Number is the number argument value.
+			Number *big.Int
+		}
+		// BlockHashByNumber holds details about calls to the BlockHashByNumber method.
+		BlockHashByNumber []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Number is the number argument value.
+			Number *big.Int
+		}
 		// BlockNumber holds details about calls to the BlockNumber method.
 		BlockNumber []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 		}
+		// CallContract holds details about calls to the CallContract method.
+		CallContract []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Msg is the msg argument value.
+			Msg ethereum.CallMsg
+			// BlockNumber is the blockNumber argument value.
+			BlockNumber *big.Int
+		}
+		// HeaderByTag holds details about calls to the HeaderByTag method.
+		HeaderByTag []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tag is the tag argument value.
+			Tag string
+		}
+		// TraceTransaction holds details about calls to the TraceTransaction method.
+		TraceTransaction []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TxHash is the txHash argument value.
+			TxHash common.Hash
+		}
 		// TransactionByHash holds details about calls to the TransactionByHash method.
 		TransactionByHash []struct {
 			// Ctx is the ctx argument value.
@@ -68,11 +137,86 @@ type ClientMock struct {
 			TxHash common.Hash
 		}
 	}
+	lockBlockByNumber      sync.RWMutex
+	lockBlockHashByNumber  sync.RWMutex
 	lockBlockNumber        sync.RWMutex
+	lockCallContract       sync.RWMutex
+	lockHeaderByTag        sync.RWMutex
+	lockTraceTransaction   sync.RWMutex
 	lockTransactionByHash  sync.RWMutex
 	lockTransactionReceipt sync.RWMutex
 }
 
+// BlockByNumber calls BlockByNumberFunc.
+func (mock *ClientMock) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	if mock.BlockByNumberFunc == nil {
+		panic("ClientMock.BlockByNumberFunc: method is nil but Client.BlockByNumber was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Number *big.Int
+	}{
+		Ctx:    ctx,
+		Number: number,
+	}
+	mock.lockBlockByNumber.Lock()
+	mock.calls.BlockByNumber = append(mock.calls.BlockByNumber, callInfo)
+	mock.lockBlockByNumber.Unlock()
+	return mock.BlockByNumberFunc(ctx, number)
+}
+
+// BlockByNumberCalls gets all the calls that were made to BlockByNumber.
+// Check the length with:
+//     len(mockedClient.BlockByNumberCalls())
+func (mock *ClientMock) BlockByNumberCalls() []struct {
+	Ctx    context.Context
+	Number *big.Int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Number *big.Int
+	}
+	mock.lockBlockByNumber.RLock()
+	calls = mock.calls.BlockByNumber
+	mock.lockBlockByNumber.RUnlock()
+	return calls
+}
+
+// BlockHashByNumber calls BlockHashByNumberFunc.
+func (mock *ClientMock) BlockHashByNumber(ctx context.Context, number *big.Int) (common.Hash, error) {
+	if mock.BlockHashByNumberFunc == nil {
+		panic("ClientMock.BlockHashByNumberFunc: method is nil but Client.BlockHashByNumber was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Number *big.Int
+	}{
+		Ctx:    ctx,
+		Number: number,
+	}
+	mock.lockBlockHashByNumber.Lock()
+	mock.calls.BlockHashByNumber = append(mock.calls.BlockHashByNumber, callInfo)
+	mock.lockBlockHashByNumber.Unlock()
+	return mock.BlockHashByNumberFunc(ctx, number)
+}
+
+// BlockHashByNumberCalls gets all the calls that were made to BlockHashByNumber.
+// Check the length with:
+//     len(mockedClient.BlockHashByNumberCalls())
+func (mock *ClientMock) BlockHashByNumberCalls() []struct {
+	Ctx    context.Context
+	Number *big.Int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Number *big.Int
+	}
+	mock.lockBlockHashByNumber.RLock()
+	calls = mock.calls.BlockHashByNumber
+	mock.lockBlockHashByNumber.RUnlock()
+	return calls
+}
+
 // BlockNumber calls BlockNumberFunc.
 func (mock *ClientMock) BlockNumber(ctx context.Context) (uint64, error) {
 	if mock.BlockNumberFunc == nil {
@@ -104,6 +248,115 @@ func (mock *ClientMock) BlockNumberCalls() []struct {
 	return calls
 }
 
+// CallContract calls CallContractFunc.
+func (mock *ClientMock) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if mock.CallContractFunc == nil {
+		panic("ClientMock.CallContractFunc: method is nil but Client.CallContract was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		Msg         ethereum.CallMsg
+		BlockNumber *big.Int
+	}{
+		Ctx:         ctx,
+		Msg:         msg,
+		BlockNumber: blockNumber,
+	}
+	mock.lockCallContract.Lock()
+	mock.calls.CallContract = append(mock.calls.CallContract, callInfo)
+	mock.lockCallContract.Unlock()
+	return mock.CallContractFunc(ctx, msg, blockNumber)
+}
+
+// CallContractCalls gets all the calls that were made to CallContract.
+// Check the length with:
+//     len(mockedClient.CallContractCalls())
+func (mock *ClientMock) CallContractCalls() []struct {
+	Ctx         context.Context
+	Msg         ethereum.CallMsg
+	BlockNumber *big.Int
+} {
+	var calls []struct {
+		Ctx         context.Context
+		Msg         ethereum.CallMsg
+		BlockNumber *big.Int
+	}
+	mock.lockCallContract.RLock()
+	calls = mock.calls.CallContract
+	mock.lockCallContract.RUnlock()
+	return calls
+}
+
+// HeaderByTag calls HeaderByTagFunc.
+func (mock *ClientMock) HeaderByTag(ctx context.Context, tag string) (*types.Header, error) {
+	if mock.HeaderByTagFunc == nil {
+		panic("ClientMock.HeaderByTagFunc: method is nil but Client.HeaderByTag was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Tag string
+	}{
+		Ctx: ctx,
+		Tag: tag,
+	}
+	mock.lockHeaderByTag.Lock()
+	mock.calls.HeaderByTag = append(mock.calls.HeaderByTag, callInfo)
+	mock.lockHeaderByTag.Unlock()
+	return mock.HeaderByTagFunc(ctx, tag)
+}
+
+// HeaderByTagCalls gets all the calls that were made to HeaderByTag.
+// Check the length with:
+//     len(mockedClient.HeaderByTagCalls())
+func (mock *ClientMock) HeaderByTagCalls() []struct {
+	Ctx context.Context
+	Tag string
+} {
+	var calls []struct {
+		Ctx context.Context
+		Tag string
+	}
+	mock.lockHeaderByTag.RLock()
+	calls = mock.calls.HeaderByTag
+	mock.lockHeaderByTag.RUnlock()
+	return calls
+}
+
+// TraceTransaction calls TraceTransactionFunc.
+func (mock *ClientMock) TraceTransaction(ctx context.Context, txHash common.Hash) (*rpc.CallFrame, error) {
+	if mock.TraceTransactionFunc == nil {
+		panic("ClientMock.TraceTransactionFunc: method is nil but Client.TraceTransaction was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		TxHash common.Hash
+	}{
+		Ctx:    ctx,
+		TxHash: txHash,
+	}
+	mock.lockTraceTransaction.Lock()
+	mock.calls.TraceTransaction = append(mock.calls.TraceTransaction, callInfo)
+	mock.lockTraceTransaction.Unlock()
+	return mock.TraceTransactionFunc(ctx, txHash)
+}
+
+// TraceTransactionCalls gets all the calls that were made to TraceTransaction.
+// Check the length with:
+//     len(mockedClient.TraceTransactionCalls())
+func (mock *ClientMock) TraceTransactionCalls() []struct {
+	Ctx    context.Context
+	TxHash common.Hash
+} {
+	var calls []struct {
+		Ctx    context.Context
+		TxHash common.Hash
+	}
+	mock.lockTraceTransaction.RLock()
+	calls = mock.calls.TraceTransaction
+	mock.lockTraceTransaction.RUnlock()
+	return calls
+}
+
 // TransactionByHash calls TransactionByHashFunc.
 func (mock *ClientMock) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
 	if mock.TransactionByHashFunc == nil {
@@ -173,3 +426,705 @@ func (mock *ClientMock) TransactionReceiptCalls() []struct {
 	mock.lockTransactionReceipt.RUnlock()
 	return calls
 }
+
+// Ensure, that TxClientMock does implement rpc.TxClient.
+// If this is not the case, regenerate this file with moq.
+var _ rpc.TxClient = &TxClientMock{}
+
+// TxClientMock is a mock implementation of rpc.TxClient.
+//
+// 	func TestSomethingThatUsesTxClient(t *testing.T) {
+//
+// 		// make and configure a mocked rpc.TxClient
+// 		mockedTxClient := &TxClientMock{
+// 			BlockByNumberFunc: func(ctx context.Context, number *big.Int) (*types.Block, error) {
+// 				panic("mock out the BlockByNumber method")
+// 			},
+// 			BlockHashByNumberFunc: func(ctx context.Context, number *big.Int) (common.Hash, error) {
+// 				panic("mock out the BlockHashByNumber method")
+// 			},
+// 			BlockNumberFunc: func(ctx context.Context) (uint64, error) {
+// 				panic("mock out the BlockNumber method")
+// 			},
+// 			CallContractFunc: func(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+// 				panic("mock out the CallContract method")
+// 			},
+// 			ChainIDFunc: func(ctx context.Context) (*big.Int, error) {
+// 				panic("mock out the ChainID method")
+// 			},
+// 			EstimateGasFunc: func(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+// 				panic("mock out the EstimateGas method")
+// 			},
+// 			HeaderByTagFunc: func(ctx context.Context, tag string) (*types.Header, error) {
+// 				panic("mock out the HeaderByTag method")
+// 			},
+// 			NonceAtFunc: func(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+// 				panic("mock out the NonceAt method")
+// 			},
+// 			PendingNonceAtFunc: func(ctx context.Context, account common.Address) (uint64, error) {
+// 				panic("mock out the PendingNonceAt method")
+// 			},
+// 			SendTransactionFunc: func(ctx context.Context, tx *types.Transaction) error {
+// 				panic("mock out the SendTransaction method")
+// 			},
+// 			SuggestGasPriceFunc: func(ctx context.Context) (*big.Int, error) {
+// 				panic("mock out the SuggestGasPrice method")
+// 			},
+// 			TraceTransactionFunc: func(ctx context.Context, txHash common.Hash) (*rpc.CallFrame, error) {
+// 				panic("mock out the TraceTransaction method")
+// 			},
+// 			TransactionByHashFunc: func(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+// 				panic("mock out the TransactionByHash method")
+// 			},
+// 			TransactionReceiptFunc: func(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+// 				panic("mock out the TransactionReceipt method")
+// 			},
+// 		}
+//
+// 		// use mockedTxClient in code that requires rpc.TxClient
+// 		// and then make assertions.
+//
+// 	}
+type TxClientMock struct {
+	// BlockByNumberFunc mocks the BlockByNumber method.
+	BlockByNumberFunc func(ctx context.Context, number *big.Int) (*types.Block, error)
+
+	// BlockHashByNumberFunc mocks the BlockHashByNumber method.
+	BlockHashByNumberFunc func(ctx context.Context, number *big.Int) (common.Hash, error)
+
+	// BlockNumberFunc mocks the BlockNumber method.
+	BlockNumberFunc func(ctx context.Context) (uint64, error)
+
+	// CallContractFunc mocks the CallContract method.
+	CallContractFunc func(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+
+	// ChainIDFunc mocks the ChainID method.
+	ChainIDFunc func(ctx context.Context) (*big.Int, error)
+
+	// EstimateGasFunc mocks the EstimateGas method.
+	EstimateGasFunc func(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
+
+	// HeaderByTagFunc mocks the HeaderByTag method.
+	HeaderByTagFunc func(ctx context.Context, tag string) (*types.Header, error)
+
+	// NonceAtFunc mocks the NonceAt method.
+	NonceAtFunc func(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+
+	// PendingNonceAtFunc mocks the PendingNonceAt method.
+	PendingNonceAtFunc func(ctx context.Context, account common.Address) (uint64, error)
+
+	// SendTransactionFunc mocks the SendTransaction method.
+	SendTransactionFunc func(ctx context.Context, tx *types.Transaction) error
+
+	// SuggestGasPriceFunc mocks the SuggestGasPrice method.
+	SuggestGasPriceFunc func(ctx context.Context) (*big.Int, error)
+
+	// TraceTransactionFunc mocks the TraceTransaction method.
+	TraceTransactionFunc func(ctx context.Context, txHash common.Hash) (*rpc.CallFrame, error)
+
+	// TransactionByHashFunc mocks the TransactionByHash method.
+	TransactionByHashFunc func(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+
+	// TransactionReceiptFunc mocks the TransactionReceipt method.
+	TransactionReceiptFunc func(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// BlockByNumber holds details about calls to the BlockByNumber method.
+		BlockByNumber []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Number is the number argument value.
+			Number *big.Int
+		}
+		// BlockHashByNumber holds details about calls to the BlockHashByNumber method.
+		BlockHashByNumber []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Number is the number argument value.
+			Number *big.Int
+		}
+		// BlockNumber holds details about calls to the BlockNumber method.
+		BlockNumber []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// CallContract holds details about calls to the CallContract method.
+		CallContract []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Msg is the msg argument value.
+			Msg ethereum.CallMsg
+			// BlockNumber is the blockNumber argument value.
+			BlockNumber *big.Int
+		}
+		// ChainID holds details about calls to the ChainID method.
+		ChainID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// EstimateGas holds details about calls to the EstimateGas method.
+		EstimateGas []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Msg is the msg argument value.
+			Msg ethereum.CallMsg
+		}
+		// HeaderByTag holds details about calls to the HeaderByTag method.
+		HeaderByTag []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tag is the tag argument value.
+			Tag string
+		}
+		// NonceAt holds details about calls to the NonceAt method.
+		NonceAt []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Account is the account argument value.
+			Account common.Address
+			// BlockNumber is the blockNumber argument value.
+			BlockNumber *big.Int
+		}
+		// PendingNonceAt holds details about calls to the PendingNonceAt method.
+		PendingNonceAt []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Account is the account argument value.
+			Account common.Address
+		}
+		// SendTransaction holds details about calls to the SendTransaction method.
+		SendTransaction []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx *types.Transaction
+		}
+		// SuggestGasPrice holds details about calls to the SuggestGasPrice method.
+		SuggestGasPrice []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// TraceTransaction holds details about calls to the TraceTransaction method.
+		TraceTransaction []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TxHash is the txHash argument value.
+			TxHash common.Hash
+		}
+		// TransactionByHash holds details about calls to the TransactionByHash method.
+		TransactionByHash []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Hash is the hash argument value.
+			Hash common.Hash
+		}
+		// TransactionReceipt holds details about calls to the TransactionReceipt method.
+		TransactionReceipt []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TxHash is the txHash argument value.
+			TxHash common.Hash
+		}
+	}
+	lockBlockByNumber      sync.RWMutex
+	lockBlockHashByNumber  sync.RWMutex
+	lockBlockNumber        sync.RWMutex
+	lockCallContract       sync.RWMutex
+	lockChainID            sync.RWMutex
+	lockEstimateGas        sync.RWMutex
+	lockHeaderByTag        sync.RWMutex
+	lockNonceAt            sync.RWMutex
+	lockPendingNonceAt     sync.RWMutex
+	lockSendTransaction    sync.RWMutex
+	lockSuggestGasPrice    sync.RWMutex
+	lockTraceTransaction   sync.RWMutex
+	lockTransactionByHash  sync.RWMutex
+	lockTransactionReceipt sync.RWMutex
+}
+
+// BlockByNumber calls BlockByNumberFunc.
+func (mock *TxClientMock) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	if mock.BlockByNumberFunc == nil {
+		panic("TxClientMock.BlockByNumberFunc: method is nil but TxClient.BlockByNumber was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Number *big.Int
+	}{
+		Ctx:    ctx,
+		Number: number,
+	}
+	mock.lockBlockByNumber.Lock()
+	mock.calls.BlockByNumber = append(mock.calls.BlockByNumber, callInfo)
+	mock.lockBlockByNumber.Unlock()
+	return mock.BlockByNumberFunc(ctx, number)
+}
+
+// BlockByNumberCalls gets all the calls that were made to BlockByNumber.
+// Check the length with:
+//     len(mockedTxClient.BlockByNumberCalls())
+func (mock *TxClientMock) BlockByNumberCalls() []struct {
+	Ctx    context.Context
+	Number *big.Int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Number *big.Int
+	}
+	mock.lockBlockByNumber.RLock()
+	calls = mock.calls.BlockByNumber
+	mock.lockBlockByNumber.RUnlock()
+	return calls
+}
+
+// BlockHashByNumber calls BlockHashByNumberFunc.
+func (mock *TxClientMock) BlockHashByNumber(ctx context.Context, number *big.Int) (common.Hash, error) {
+	if mock.BlockHashByNumberFunc == nil {
+		panic("TxClientMock.BlockHashByNumberFunc: method is nil but TxClient.BlockHashByNumber was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Number *big.Int
+	}{
+		Ctx:    ctx,
+		Number: number,
+	}
+	mock.lockBlockHashByNumber.Lock()
+	mock.calls.BlockHashByNumber = append(mock.calls.BlockHashByNumber, callInfo)
+	mock.lockBlockHashByNumber.Unlock()
+	return mock.BlockHashByNumberFunc(ctx, number)
+}
+
+// BlockHashByNumberCalls gets all the calls that were made to BlockHashByNumber.
+// Check the length with:
+//     len(mockedTxClient.BlockHashByNumberCalls())
+func (mock *TxClientMock) BlockHashByNumberCalls() []struct {
+	Ctx    context.Context
+	Number *big.Int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Number *big.Int
+	}
+	mock.lockBlockHashByNumber.RLock()
+	calls = mock.calls.BlockHashByNumber
+	mock.lockBlockHashByNumber.RUnlock()
+	return calls
+}
+
+// BlockNumber calls BlockNumberFunc.
+func (mock *TxClientMock) BlockNumber(ctx context.Context) (uint64, error) {
+	if mock.BlockNumberFunc == nil {
+		panic("TxClientMock.BlockNumberFunc: method is nil but TxClient.BlockNumber was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockBlockNumber.Lock()
+	mock.calls.BlockNumber = append(mock.calls.BlockNumber, callInfo)
+	mock.lockBlockNumber.Unlock()
+	return mock.BlockNumberFunc(ctx)
+}
+
+// BlockNumberCalls gets all the calls that were made to BlockNumber.
+// Check the length with:
+//     len(mockedTxClient.BlockNumberCalls())
+func (mock *TxClientMock) BlockNumberCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockBlockNumber.RLock()
+	calls = mock.calls.BlockNumber
+	mock.lockBlockNumber.RUnlock()
+	return calls
+}
+
+// CallContract calls CallContractFunc.
+func (mock *TxClientMock) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if mock.CallContractFunc == nil {
+		panic("TxClientMock.CallContractFunc: method is nil but TxClient.CallContract was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		Msg         ethereum.CallMsg
+		BlockNumber *big.Int
+	}{
+		Ctx:         ctx,
+		Msg:         msg,
+		BlockNumber: blockNumber,
+	}
+	mock.lockCallContract.Lock()
+	mock.calls.CallContract = append(mock.calls.CallContract, callInfo)
+	mock.lockCallContract.Unlock()
+	return mock.CallContractFunc(ctx, msg, blockNumber)
+}
+
+// CallContractCalls gets all the calls that were made to CallContract.
+// Check the length with:
+//     len(mockedTxClient.CallContractCalls())
+func (mock *TxClientMock) CallContractCalls() []struct {
+	Ctx         context.Context
+	Msg         ethereum.CallMsg
+	BlockNumber *big.Int
+} {
+	var calls []struct {
+		Ctx         context.Context
+		Msg         ethereum.CallMsg
+		BlockNumber *big.Int
+	}
+	mock.lockCallContract.RLock()
+	calls = mock.calls.CallContract
+	mock.lockCallContract.RUnlock()
+	return calls
+}
+
+// ChainID calls ChainIDFunc.
+func (mock *TxClientMock) ChainID(ctx context.Context) (*big.Int, error) {
+	if mock.ChainIDFunc == nil {
+		panic("TxClientMock.ChainIDFunc: method is nil but TxClient.ChainID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockChainID.Lock()
+	mock.calls.ChainID = append(mock.calls.ChainID, callInfo)
+	mock.lockChainID.Unlock()
+	return mock.ChainIDFunc(ctx)
+}
+
+// ChainIDCalls gets all the calls that were made to ChainID.
+// Check the length with:
+//     len(mockedTxClient.ChainIDCalls())
+func (mock *TxClientMock) ChainIDCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockChainID.RLock()
+	calls = mock.calls.ChainID
+	mock.lockChainID.RUnlock()
+	return calls
+}
+
+// EstimateGas calls EstimateGasFunc.
+func (mock *TxClientMock) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	if mock.EstimateGasFunc == nil {
+		panic("TxClientMock.EstimateGasFunc: method is nil but TxClient.EstimateGas was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Msg ethereum.CallMsg
+	}{
+		Ctx: ctx,
+		Msg: msg,
+	}
+	mock.lockEstimateGas.Lock()
+	mock.calls.EstimateGas = append(mock.calls.EstimateGas, callInfo)
+	mock.lockEstimateGas.Unlock()
+	return mock.EstimateGasFunc(ctx, msg)
+}
+
+// EstimateGasCalls gets all the calls that were made to EstimateGas.
+// Check the length with:
+//     len(mockedTxClient.EstimateGasCalls())
+func (mock *TxClientMock) EstimateGasCalls() []struct {
+	Ctx context.Context
+	Msg ethereum.CallMsg
+} {
+	var calls []struct {
+		Ctx context.Context
+		Msg ethereum.CallMsg
+	}
+	mock.lockEstimateGas.RLock()
+	calls = mock.calls.EstimateGas
+	mock.lockEstimateGas.RUnlock()
+	return calls
+}
+
+// HeaderByTag calls HeaderByTagFunc.
+func (mock *TxClientMock) HeaderByTag(ctx context.Context, tag string) (*types.Header, error) {
+	if mock.HeaderByTagFunc == nil {
+		panic("TxClientMock.HeaderByTagFunc: method is nil but TxClient.HeaderByTag was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Tag string
+	}{
+		Ctx: ctx,
+		Tag: tag,
+	}
+	mock.lockHeaderByTag.Lock()
+	mock.calls.HeaderByTag = append(mock.calls.HeaderByTag, callInfo)
+	mock.lockHeaderByTag.Unlock()
+	return mock.HeaderByTagFunc(ctx, tag)
+}
+
+// HeaderByTagCalls gets all the calls that were made to HeaderByTag.
+// Check the length with:
+//     len(mockedTxClient.HeaderByTagCalls())
+func (mock *TxClientMock) HeaderByTagCalls() []struct {
+	Ctx context.Context
+	Tag string
+} {
+	var calls []struct {
+		Ctx context.Context
+		Tag string
+	}
+	mock.lockHeaderByTag.RLock()
+	calls = mock.calls.HeaderByTag
+	mock.lockHeaderByTag.RUnlock()
+	return calls
+}
+
+// NonceAt calls NonceAtFunc.
+func (mock *TxClientMock) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	if mock.NonceAtFunc == nil {
+		panic("TxClientMock.NonceAtFunc: method is nil but TxClient.NonceAt was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		Account     common.Address
+		BlockNumber *big.Int
+	}{
+		Ctx:         ctx,
+		Account:     account,
+		BlockNumber: blockNumber,
+	}
+	mock.lockNonceAt.Lock()
+	mock.calls.NonceAt = append(mock.calls.NonceAt, callInfo)
+	mock.lockNonceAt.Unlock()
+	return mock.NonceAtFunc(ctx, account, blockNumber)
+}
+
+// NonceAtCalls gets all the calls that were made to NonceAt.
+// Check the length with:
+//     len(mockedTxClient.NonceAtCalls())
+func (mock *TxClientMock) NonceAtCalls() []struct {
+	Ctx         context.Context
+	Account     common.Address
+	BlockNumber *big.Int
+} {
+	var calls []struct {
+		Ctx         context.Context
+		Account     common.Address
+		BlockNumber *big.Int
+	}
+	mock.lockNonceAt.RLock()
+	calls = mock.calls.NonceAt
+	mock.lockNonceAt.RUnlock()
+	return calls
+}
+
+// PendingNonceAt calls PendingNonceAtFunc.
+func (mock *TxClientMock) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	if mock.PendingNonceAtFunc == nil {
+		panic("TxClientMock.PendingNonceAtFunc: method is nil but TxClient.PendingNonceAt was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Account common.Address
+	}{
+		Ctx:     ctx,
+		Account: account,
+	}
+	mock.lockPendingNonceAt.Lock()
+	mock.calls.PendingNonceAt = append(mock.calls.PendingNonceAt, callInfo)
+	mock.lockPendingNonceAt.Unlock()
+	return mock.PendingNonceAtFunc(ctx, account)
+}
+
+// PendingNonceAtCalls gets all the calls that were made to PendingNonceAt.
+// Check the length with:
+//     len(mockedTxClient.PendingNonceAtCalls())
+func (mock *TxClientMock) PendingNonceAtCalls() []struct {
+	Ctx     context.Context
+	Account common.Address
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Account common.Address
+	}
+	mock.lockPendingNonceAt.RLock()
+	calls = mock.calls.PendingNonceAt
+	mock.lockPendingNonceAt.RUnlock()
+	return calls
+}
+
+// SendTransaction calls SendTransactionFunc.
+func (mock *TxClientMock) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if mock.SendTransactionFunc == nil {
+		panic("TxClientMock.SendTransactionFunc: method is nil but TxClient.SendTransaction was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Tx  *types.Transaction
+	}{
+		Ctx: ctx,
+		Tx:  tx,
+	}
+	mock.lockSendTransaction.Lock()
+	mock.calls.SendTransaction = append(mock.calls.SendTransaction, callInfo)
+	mock.lockSendTransaction.Unlock()
+	return mock.SendTransactionFunc(ctx, tx)
+}
+
+// SendTransactionCalls gets all the calls that were made to SendTransaction.
+// Check the length with:
+//     len(mockedTxClient.SendTransactionCalls())
+func (mock *TxClientMock) SendTransactionCalls() []struct {
+	Ctx context.Context
+	Tx  *types.Transaction
+} {
+	var calls []struct {
+		Ctx context.Context
+		Tx  *types.Transaction
+	}
+	mock.lockSendTransaction.RLock()
+	calls = mock.calls.SendTransaction
+	mock.lockSendTransaction.RUnlock()
+	return calls
+}
+
+// SuggestGasPrice calls SuggestGasPriceFunc.
+func (mock *TxClientMock) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	if mock.SuggestGasPriceFunc == nil {
+		panic("TxClientMock.SuggestGasPriceFunc: method is nil but TxClient.SuggestGasPrice was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockSuggestGasPrice.Lock()
+	mock.calls.SuggestGasPrice = append(mock.calls.SuggestGasPrice, callInfo)
+	mock.lockSuggestGasPrice.Unlock()
+	return mock.SuggestGasPriceFunc(ctx)
+}
+
+// SuggestGasPriceCalls gets all the calls that were made to SuggestGasPrice.
+// Check the length with:
+//     len(mockedTxClient.SuggestGasPriceCalls())
+func (mock *TxClientMock) SuggestGasPriceCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockSuggestGasPrice.RLock()
+	calls = mock.calls.SuggestGasPrice
+	mock.lockSuggestGasPrice.RUnlock()
+	return calls
+}
+
+// TraceTransaction calls TraceTransactionFunc.
+func (mock *TxClientMock) TraceTransaction(ctx context.Context, txHash common.Hash) (*rpc.CallFrame, error) {
+	if mock.TraceTransactionFunc == nil {
+		panic("TxClientMock.TraceTransactionFunc: method is nil but TxClient.TraceTransaction was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		TxHash common.Hash
+	}{
+		Ctx:    ctx,
+		TxHash: txHash,
+	}
+	mock.lockTraceTransaction.Lock()
+	mock.calls.TraceTransaction = append(mock.calls.TraceTransaction, callInfo)
+	mock.lockTraceTransaction.Unlock()
+	return mock.TraceTransactionFunc(ctx, txHash)
+}
+
+// TraceTransactionCalls gets all the calls that were made to TraceTransaction.
+// Check the length with:
+//     len(mockedTxClient.TraceTransactionCalls())
+func (mock *TxClientMock) TraceTransactionCalls() []struct {
+	Ctx    context.Context
+	TxHash common.Hash
+} {
+	var calls []struct {
+		Ctx    context.Context
+		TxHash common.Hash
+	}
+	mock.lockTraceTransaction.RLock()
+	calls = mock.calls.TraceTransaction
+	mock.lockTraceTransaction.RUnlock()
+	return calls
+}
+
+// TransactionByHash calls TransactionByHashFunc.
+func (mock *TxClientMock) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	if mock.TransactionByHashFunc == nil {
+		panic("TxClientMock.TransactionByHashFunc: method is nil but TxClient.TransactionByHash was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Hash common.Hash
+	}{
+		Ctx:  ctx,
+		Hash: hash,
+	}
+	mock.lockTransactionByHash.Lock()
+	mock.calls.TransactionByHash = append(mock.calls.TransactionByHash, callInfo)
+	mock.lockTransactionByHash.Unlock()
+	return mock.TransactionByHashFunc(ctx, hash)
+}
+
+// TransactionByHashCalls gets all the calls that were made to TransactionByHash.
+// Check the length with:
+//     len(mockedTxClient.TransactionByHashCalls())
+func (mock *TxClientMock) TransactionByHashCalls() []struct {
+	Ctx  context.Context
+	Hash common.Hash
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Hash common.Hash
+	}
+	mock.lockTransactionByHash.RLock()
+	calls = mock.calls.TransactionByHash
+	mock.lockTransactionByHash.RUnlock()
+	return calls
+}
+
+// TransactionReceipt calls TransactionReceiptFunc.
+func (mock *TxClientMock) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if mock.TransactionReceiptFunc == nil {
+		panic("TxClientMock.TransactionReceiptFunc: method is nil but TxClient.TransactionReceipt was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		TxHash common.Hash
+	}{
+		Ctx:    ctx,
+		TxHash: txHash,
+	}
+	mock.lockTransactionReceipt.Lock()
+	mock.calls.TransactionReceipt = append(mock.calls.TransactionReceipt, callInfo)
+	mock.lockTransactionReceipt.Unlock()
+	return mock.TransactionReceiptFunc(ctx, txHash)
+}
+
+// TransactionReceiptCalls gets all the calls that were made to TransactionReceipt.
+// Check the length with:
+//     len(mockedTxClient.TransactionReceiptCalls())
+func (mock *TxClientMock) TransactionReceiptCalls() []struct {
+	Ctx    context.Context
+	TxHash common.Hash
+} {
+	var calls []struct {
+		Ctx    context.Context
+		TxHash common.Hash
+	}
+	mock.lockTransactionReceipt.RLock()
+	calls = mock.calls.TransactionReceipt
+	mock.lockTransactionReceipt.RUnlock()
+	return calls
+}
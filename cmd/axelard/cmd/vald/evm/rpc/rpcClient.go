@@ -2,32 +2,113 @@ package rpc
 
 import (
 	"context"
+	"math/big"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	evmClient "github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
-//go:generate moq -out ./mock/rpcClient.go -pkg mock . Client
+//go:generate moq -out ./mock/rpcClient.go -pkg mock . Client TxClient
 
 // Client provides calls to an EVM RPC endpoint
 type Client interface {
 	BlockNumber(ctx context.Context) (uint64, error)
+	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+	// BlockHashByNumber returns the hash of the canonical block at the given height as reported by the node
+	BlockHashByNumber(ctx context.Context, number *big.Int) (common.Hash, error)
+	// HeaderByTag returns the header of the block referenced by the given block tag, e.g. "finalized" or "safe"
+	HeaderByTag(ctx context.Context, tag string) (*types.Header, error)
 	TransactionByHash(ctx context.Context, hash common.Hash) (tx *types.Transaction, isPending bool, err error)
 	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	// TraceTransaction returns the call tree of the given transaction, including internal calls
+	TraceTransaction(ctx context.Context, txHash common.Hash) (*CallFrame, error)
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// CallFrame is a call made during the execution of a transaction, as reported by the call tracer
+type CallFrame struct {
+	Type  string         `json:"type"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Value *hexutil.Big   `json:"value,omitempty"`
+	Error string         `json:"error,omitempty"`
+	Calls []CallFrame    `json:"calls,omitempty"`
+}
+
+// TxClient provides calls to an EVM RPC endpoint to submit transactions
+type TxClient interface {
+	Client
+	ChainID(ctx context.Context) (*big.Int, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
 }
 
 // ClientImpl implements Client
 type ClientImpl struct {
 	*evmClient.Client
+	rpc *rpc.Client
+}
+
+// HeaderByTag returns the header of the block referenced by the given block tag
+func (c *ClientImpl) HeaderByTag(ctx context.Context, tag string) (*types.Header, error) {
+	var header *types.Header
+	if err := c.rpc.CallContext(ctx, &header, "eth_getBlockByNumber", tag, false); err != nil {
+		return nil, err
+	}
+
+	if header == nil {
+		return nil, ethereum.NotFound
+	}
+
+	return header, nil
+}
+
+// BlockHashByNumber returns the hash of the canonical block at the given height as reported by the node.
+// The hash is not recomputed from the header, because the header type does not know the fields added by recent forks
+func (c *ClientImpl) BlockHashByNumber(ctx context.Context, number *big.Int) (common.Hash, error) {
+	var block *struct {
+		Hash common.Hash `json:"hash"`
+	}
+	if err := c.rpc.CallContext(ctx, &block, "eth_getBlockByNumber", hexutil.EncodeBig(number), false); err != nil {
+		return common.Hash{}, err
+	}
+
+	if block == nil {
+		return common.Hash{}, ethereum.NotFound
+	}
+
+	return block.Hash, nil
+}
+
+// TraceTransaction returns the call tree of the given transaction, including internal calls.
+// The endpoint must support the debug namespace
+func (c *ClientImpl) TraceTransaction(ctx context.Context, txHash common.Hash) (*CallFrame, error) {
+	var frame *CallFrame
+	if err := c.rpc.CallContext(ctx, &frame, "debug_traceTransaction", txHash, map[string]string{"tracer": "callTracer"}); err != nil {
+		return nil, err
+	}
+
+	if frame == nil {
+		return nil, ethereum.NotFound
+	}
+
+	return frame, nil
 }
 
 // NewClient returns an EVM rpc client
-func NewClient(url string) (*evmClient.Client, error) {
-	client, err := evmClient.Dial(url)
+func NewClient(url string) (*ClientImpl, error) {
+	rpcClient, err := rpc.DialContext(context.Background(), url)
 	if err != nil {
 		return nil, err
 	}
+	client := &ClientImpl{Client: evmClient.NewClient(rpcClient), rpc: rpcClient}
 
 	// try to access network
 	if _, err := client.ChainID(context.Background()); err != nil {
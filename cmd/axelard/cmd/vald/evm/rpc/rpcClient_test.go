@@ -0,0 +1,116 @@
+package rpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	geth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+)
+
+func TestClientImpl_BlockHashByNumber(t *testing.T) {
+	var (
+		blockHash   common.Hash
+		blockNumber *big.Int
+		client      *rpc.ClientImpl
+		block       map[string]interface{}
+	)
+
+	setup := func(t *testing.T) {
+		blockHash = common.BytesToHash(rand.Bytes(common.HashLength))
+		blockNumber = big.NewInt(rand.PosI64())
+
+		// a header after the Shanghai and Cancun forks, containing fields the header type does not know
+		block = map[string]interface{}{
+			"hash":                  blockHash,
+			"number":                hexutil.EncodeBig(blockNumber),
+			"parentHash":            common.BytesToHash(rand.Bytes(common.HashLength)),
+			"sha3Uncles":            geth.EmptyUncleHash,
+			"miner":                 common.BytesToAddress(rand.Bytes(common.AddressLength)),
+			"stateRoot":             common.BytesToHash(rand.Bytes(common.HashLength)),
+			"transactionsRoot":      common.BytesToHash(rand.Bytes(common.HashLength)),
+			"receiptsRoot":          common.BytesToHash(rand.Bytes(common.HashLength)),
+			"logsBloom":             geth.Bloom{},
+			"difficulty":            "0x0",
+			"gasLimit":              hexutil.Uint64(rand.PosI64()),
+			"gasUsed":               hexutil.Uint64(rand.PosI64()),
+			"timestamp":             hexutil.Uint64(rand.PosI64()),
+			"extraData":             hexutil.Bytes(rand.Bytes(32)),
+			"mixHash":               common.BytesToHash(rand.Bytes(common.HashLength)),
+			"nonce":                 geth.BlockNonce{},
+			"baseFeePerGas":         hexutil.EncodeBig(big.NewInt(rand.PosI64())),
+			"withdrawalsRoot":       common.BytesToHash(rand.Bytes(common.HashLength)),
+			"blobGasUsed":           hexutil.Uint64(rand.PosI64()),
+			"excessBlobGas":         hexutil.Uint64(rand.PosI64()),
+			"parentBeaconBlockRoot": common.BytesToHash(rand.Bytes(common.HashLength)),
+			"transactions":          []interface{}{},
+			"uncles":                []interface{}{},
+			"withdrawals":           []interface{}{},
+		}
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				ID     json.RawMessage   `json:"id"`
+				Method string            `json:"method"`
+				Params []json.RawMessage `json:"params"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			var result interface{}
+			switch req.Method {
+			case "eth_chainId":
+				result = hexutil.EncodeBig(big.NewInt(1))
+			case "eth_getBlockByNumber":
+				if string(req.Params[0]) == fmt.Sprintf("%q", hexutil.EncodeBig(blockNumber)) {
+					result = block
+				}
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+		}))
+		t.Cleanup(server.Close)
+
+		var err error
+		client, err = rpc.NewClient(server.URL)
+		require.NoError(t, err)
+	}
+
+	repeats := 20
+	t.Run("should return the hash reported by the node for a post-Shanghai block", testutils.Func(func(t *testing.T) {
+		setup(t)
+
+		actual, err := client.BlockHashByNumber(context.Background(), blockNumber)
+
+		assert.NoError(t, err)
+		assert.Equal(t, blockHash, actual)
+
+		// recomputing the hash from the decoded header drops the fields added by recent forks
+		header, err := client.HeaderByNumber(context.Background(), blockNumber)
+		assert.NoError(t, err)
+		assert.NotEqual(t, blockHash, header.Hash())
+	}).Repeat(repeats))
+
+	t.Run("should return error when the block does not exist", testutils.Func(func(t *testing.T) {
+		setup(t)
+
+		_, err := client.BlockHashByNumber(context.Background(), new(big.Int).Add(blockNumber, big.NewInt(1)))
+
+		assert.Error(t, err)
+	}).Repeat(repeats))
+}
@@ -0,0 +1,18 @@
+package adapter
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+//go:generate moq -out ./mock/adapter.go -pkg mock . ChainAdapter
+
+// ChainAdapter verifies events on an external chain that is neither EVM-compatible nor UTXO-based
+type ChainAdapter interface {
+	// Chain returns the name of the chain this adapter is responsible for
+	Chain() string
+	// ConfirmDeposit returns true if the transaction with the given ID transferred the given amount to the deposit address
+	// and has reached finality on the external chain
+	ConfirmDeposit(ctx context.Context, txID string, depositAddress string, amount sdk.Coin) (bool, error)
+}
@@ -0,0 +1,130 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mock
+
+import (
+	"context"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/external/adapter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"sync"
+)
+
+// Ensure, that ChainAdapterMock does implement adapter.ChainAdapter.
+// If this is not the case, regenerate this file with moq.
+var _ adapter.ChainAdapter = &ChainAdapterMock{}
+
+// ChainAdapterMock is a mock implementation of adapter.ChainAdapter.
+//
+// 	func TestSomethingThatUsesChainAdapter(t *testing.T) {
+//
+// 		// make and configure a mocked adapter.ChainAdapter
+// 		mockedChainAdapter := &ChainAdapterMock{
+// 			ChainFunc: func() string {
+// 				panic("mock out the Chain method")
+// 			},
+// 			ConfirmDepositFunc: func(ctx context.Context, txID string, depositAddress string, amount sdk.Coin) (bool, error) {
+// 				panic("mock out the ConfirmDeposit method")
+// 			},
+// 		}
+//
+// 		// use mockedChainAdapter in code that requires adapter.ChainAdapter
+// 		// and then make assertions.
+//
+// 	}
+type ChainAdapterMock struct {
+	// ChainFunc mocks the Chain method.
+	ChainFunc func() string
+
+	// ConfirmDepositFunc mocks the ConfirmDeposit method.
+	ConfirmDepositFunc func(ctx context.Context, txID string, depositAddress string, amount sdk.Coin) (bool, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Chain holds details about calls to the Chain method.
+		Chain []struct {
+		}
+		// ConfirmDeposit holds details about calls to the ConfirmDeposit method.
+		ConfirmDeposit []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TxID is the txID argument value.
+			TxID string
+			// DepositAddress is the depositAddress argument value.
+			DepositAddress string
+			// Amount is the amount argument value.
+			Amount sdk.Coin
+		}
+	}
+	lockChain          sync.RWMutex
+	lockConfirmDeposit sync.RWMutex
+}
+
+// Chain calls ChainFunc.
+func (mock *ChainAdapterMock) Chain() string {
+	if mock.ChainFunc == nil {
+		panic("ChainAdapterMock.ChainFunc: method is nil but ChainAdapter.Chain was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockChain.Lock()
+	mock.calls.Chain = append(mock.calls.Chain, callInfo)
+	mock.lockChain.Unlock()
+	return mock.ChainFunc()
+}
+
+// ChainCalls gets all the calls that were made to Chain.
+// Check the length with:
+//     len(mockedChainAdapter.ChainCalls())
+func (mock *ChainAdapterMock) ChainCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockChain.RLock()
+	calls = mock.calls.Chain
+	mock.lockChain.RUnlock()
+	return calls
+}
+
+// ConfirmDeposit calls ConfirmDepositFunc.
+func (mock *ChainAdapterMock) ConfirmDeposit(ctx context.Context, txID string, depositAddress string, amount sdk.Coin) (bool, error) {
+	if mock.ConfirmDepositFunc == nil {
+		panic("ChainAdapterMock.ConfirmDepositFunc: method is nil but ChainAdapter.ConfirmDeposit was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		TxID           string
+		DepositAddress string
+		Amount         sdk.Coin
+	}{
+		Ctx:            ctx,
+		TxID:           txID,
+		DepositAddress: depositAddress,
+		Amount:         amount,
+	}
+	mock.lockConfirmDeposit.Lock()
+	mock.calls.ConfirmDeposit = append(mock.calls.ConfirmDeposit, callInfo)
+	mock.lockConfirmDeposit.Unlock()
+	return mock.ConfirmDepositFunc(ctx, txID, depositAddress, amount)
+}
+
+// ConfirmDepositCalls gets all the calls that were made to ConfirmDeposit.
+// Check the length with:
+//     len(mockedChainAdapter.ConfirmDepositCalls())
+func (mock *ChainAdapterMock) ConfirmDepositCalls() []struct {
+	Ctx            context.Context
+	TxID           string
+	DepositAddress string
+	Amount         sdk.Coin
+} {
+	var calls []struct {
+		Ctx            context.Context
+		TxID           string
+		DepositAddress string
+		Amount         sdk.Coin
+	}
+	mock.lockConfirmDeposit.RLock()
+	calls = mock.calls.ConfirmDeposit
+	mock.lockConfirmDeposit.RUnlock()
+	return calls
+}
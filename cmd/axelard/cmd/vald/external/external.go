@@ -0,0 +1,124 @@
+package external
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	sdkClient "github.com/cosmos/cosmos-sdk/client"
+	sdkFlags "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster/types"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/external/adapter"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/parse"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/types"
+	reward "github.com/axelarnetwork/axelar-core/x/reward/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+)
+
+// registeredAdapters are the adapters of external chains that are compiled into vald
+var registeredAdapters []adapter.ChainAdapter
+
+// Register registers the adapter of an external chain with vald, e.g. from an init function of the adapter's package
+func Register(a adapter.ChainAdapter) {
+	registeredAdapters = append(registeredAdapters, a)
+}
+
+// RegisteredAdapters returns all adapters that have been registered with vald
+func RegisteredAdapters() []adapter.ChainAdapter {
+	return registeredAdapters
+}
+
+// Mgr manages all communication with external chains
+type Mgr struct {
+	cliCtx      sdkClient.Context
+	logger      log.Logger
+	broadcaster types.Broadcaster
+	adapters    map[string]adapter.ChainAdapter
+	cdc         *codec.LegacyAmino
+}
+
+// NewMgr returns a new Mgr instance
+func NewMgr(cliCtx sdkClient.Context, broadcaster types.Broadcaster, logger log.Logger, cdc *codec.LegacyAmino) *Mgr {
+	return &Mgr{
+		cliCtx:      cliCtx,
+		logger:      logger.With("listener", "external"),
+		broadcaster: broadcaster,
+		adapters:    make(map[string]adapter.ChainAdapter),
+		cdc:         cdc,
+	}
+}
+
+// RegisterAdapter registers the adapter for an external chain. The adapters are mapped by lower case chain name
+func (mgr *Mgr) RegisterAdapter(a adapter.ChainAdapter) error {
+	chain := strings.ToLower(a.Chain())
+	if chain == "" {
+		return fmt.Errorf("adapter chain name cannot be empty")
+	}
+
+	if _, ok := mgr.adapters[chain]; ok {
+		return fmt.Errorf("adapter for chain %s has already been registered", a.Chain())
+	}
+
+	mgr.adapters[chain] = a
+	return nil
+}
+
+// ProcessDepositConfirmation votes on the correctness of a deposit on an external chain
+func (mgr *Mgr) ProcessDepositConfirmation(e tmEvents.Event) error {
+	chain, txID, depositAddress, amount, pollKey, err := parseDepositConfirmationParams(mgr.cdc, e.Attributes)
+	if err != nil {
+		return sdkerrors.Wrap(err, "external deposit confirmation failed")
+	}
+
+	a, ok := mgr.adapters[strings.ToLower(chain)]
+	if !ok {
+		mgr.logger.Debug(fmt.Sprintf("no adapter for chain %s was registered during start-up, ignoring deposit confirmation event", chain))
+		return nil
+	}
+
+	confirmed, err := a.ConfirmDeposit(context.Background(), txID, depositAddress, amount)
+	if err != nil {
+		mgr.logger.Debug(sdkerrors.Wrapf(err, "deposit confirmation on chain %s failed", chain).Error())
+		confirmed = false
+	}
+
+	msg := nexus.NewVoteConfirmExternalDepositRequest(mgr.cliCtx.FromAddress, pollKey, confirmed)
+	refundableMsg := reward.NewRefundMsgRequest(mgr.cliCtx.FromAddress, msg)
+
+	mgr.logger.Debug(fmt.Sprintf("broadcasting vote %v for poll %s", msg.Confirmed, pollKey.String()))
+	_, err = mgr.broadcaster.Broadcast(mgr.cliCtx.WithBroadcastMode(sdkFlags.BroadcastBlock), refundableMsg)
+	return err
+}
+
+func parseDepositConfirmationParams(cdc *codec.LegacyAmino, attributes map[string]string) (
+	chain string,
+	txID string,
+	depositAddress string,
+	amount sdk.Coin,
+	pollKey vote.PollKey,
+	err error,
+) {
+	parsers := []*parse.AttributeParser{
+		{Key: nexus.AttributeKeyChain, Map: parse.IdentityMap},
+		{Key: nexus.AttributeKeyTxID, Map: parse.IdentityMap},
+		{Key: nexus.AttributeKeyDepositAddress, Map: parse.IdentityMap},
+		{Key: nexus.AttributeKeyAmount, Map: func(s string) (interface{}, error) { return sdk.ParseCoinNormalized(s) }},
+		{Key: nexus.AttributeKeyPoll, Map: func(s string) (interface{}, error) {
+			cdc.MustUnmarshalJSON([]byte(s), &pollKey)
+			return pollKey, nil
+		}},
+	}
+
+	results, err := parse.Parse(attributes, parsers)
+	if err != nil {
+		return "", "", "", sdk.Coin{}, vote.PollKey{}, err
+	}
+
+	return results[0].(string), results[1].(string), results[2].(string), results[3].(sdk.Coin), results[4].(vote.PollKey), nil
+}
@@ -0,0 +1,132 @@
+package external
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	broadcasterMock "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster/types/mock"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/external/adapter"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/external/adapter/mock"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/types"
+	rewardtypes "github.com/axelarnetwork/axelar-core/x/reward/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+)
+
+func TestMgr_RegisterAdapter(t *testing.T) {
+	mgr := NewMgr(client.Context{}, &broadcasterMock.BroadcasterMock{}, log.TestingLogger(), app.MakeEncodingConfig().Amino)
+	chain := rand.StrBetween(5, 20)
+
+	assert.NoError(t, mgr.RegisterAdapter(&mock.ChainAdapterMock{ChainFunc: func() string { return chain }}))
+	assert.Error(t, mgr.RegisterAdapter(&mock.ChainAdapterMock{ChainFunc: func() string { return chain }}))
+	assert.Error(t, mgr.RegisterAdapter(&mock.ChainAdapterMock{ChainFunc: func() string { return "" }}))
+}
+
+func TestRegister(t *testing.T) {
+	defer func(adapters []adapter.ChainAdapter) { registeredAdapters = adapters }(registeredAdapters)
+
+	a := &mock.ChainAdapterMock{ChainFunc: func() string { return rand.StrBetween(5, 20) }}
+	Register(a)
+
+	assert.Contains(t, RegisteredAdapters(), adapter.ChainAdapter(a))
+}
+
+func TestMgr_ProcessDepositConfirmation(t *testing.T) {
+	var (
+		mgr         *Mgr
+		adapter     *mock.ChainAdapterMock
+		broadcaster *broadcasterMock.BroadcasterMock
+		attributes  map[string]string
+		pollKey     vote.PollKey
+		amount      sdk.Coin
+	)
+
+	setup := func() {
+		cdc := app.MakeEncodingConfig().Amino
+		broadcaster = &broadcasterMock.BroadcasterMock{}
+		chain := rand.StrBetween(5, 20)
+		adapter = &mock.ChainAdapterMock{
+			ChainFunc: func() string { return chain },
+			ConfirmDepositFunc: func(context.Context, string, string, sdk.Coin) (bool, error) {
+				return true, nil
+			},
+		}
+
+		mgr = NewMgr(client.Context{}, broadcaster, log.TestingLogger(), cdc)
+		if err := mgr.RegisterAdapter(adapter); err != nil {
+			panic(err)
+		}
+
+		pollKey = vote.NewPollKey(nexus.ModuleName, rand.StrBetween(1, 100))
+		amount = sdk.NewInt64Coin("uext", rand.PosI64())
+		attributes = map[string]string{
+			nexus.AttributeKeyChain:          chain,
+			nexus.AttributeKeyTxID:           rand.HexStr(64),
+			nexus.AttributeKeyDepositAddress: rand.HexStr(40),
+			nexus.AttributeKeyAmount:         amount.String(),
+			nexus.AttributeKeyPoll:           string(cdc.MustMarshalJSON(pollKey)),
+		}
+	}
+
+	repetitionCount := 20
+	t.Run("missing attributes", testutils.Func(func(t *testing.T) {
+		setup()
+		for key := range attributes {
+			delete(attributes, key)
+			err := mgr.ProcessDepositConfirmation(tmEvents.Event{Attributes: attributes})
+			assert.Error(t, err)
+			assert.Len(t, broadcaster.BroadcastCalls(), 0)
+		}
+	}).Repeat(repetitionCount))
+
+	t.Run("no adapter for chain", testutils.Func(func(t *testing.T) {
+		setup()
+		attributes[nexus.AttributeKeyChain] = rand.StrBetween(21, 30)
+
+		err := mgr.ProcessDepositConfirmation(tmEvents.Event{Attributes: attributes})
+		assert.NoError(t, err)
+		assert.Len(t, adapter.ConfirmDepositCalls(), 0)
+		assert.Len(t, broadcaster.BroadcastCalls(), 0)
+	}).Repeat(repetitionCount))
+
+	t.Run("adapter fails", testutils.Func(func(t *testing.T) {
+		setup()
+		adapter.ConfirmDepositFunc = func(context.Context, string, string, sdk.Coin) (bool, error) {
+			return true, fmt.Errorf("some error")
+		}
+
+		err := mgr.ProcessDepositConfirmation(tmEvents.Event{Attributes: attributes})
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0])
+		assert.False(t, msg.(*nexus.VoteConfirmExternalDepositRequest).Confirmed)
+	}).Repeat(repetitionCount))
+
+	t.Run("deposit confirmed", testutils.Func(func(t *testing.T) {
+		setup()
+
+		err := mgr.ProcessDepositConfirmation(tmEvents.Event{Attributes: attributes})
+		assert.NoError(t, err)
+		assert.Len(t, adapter.ConfirmDepositCalls(), 1)
+		assert.Equal(t, attributes[nexus.AttributeKeyTxID], adapter.ConfirmDepositCalls()[0].TxID)
+		assert.Equal(t, attributes[nexus.AttributeKeyDepositAddress], adapter.ConfirmDepositCalls()[0].DepositAddress)
+		assert.Equal(t, amount, adapter.ConfirmDepositCalls()[0].Amount)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		msg := unwrapRefundMsg(broadcaster.BroadcastCalls()[0].Msgs[0]).(*nexus.VoteConfirmExternalDepositRequest)
+		assert.True(t, msg.Confirmed)
+		assert.Equal(t, pollKey, msg.PollKey)
+	}).Repeat(repetitionCount))
+}
+
+func unwrapRefundMsg(msg sdk.Msg) sdk.Msg {
+	return msg.(*rewardtypes.RefundMsgRequest).GetInnerMessage()
+}
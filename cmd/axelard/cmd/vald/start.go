@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/tendermint/libs/log"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
@@ -37,10 +38,12 @@ import (
 	btcRPC "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/btc/rpc"
 	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm"
 	evmRPC "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/external"
 	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/tss"
 	utils2 "github.com/axelarnetwork/axelar-core/utils"
 	btcTypes "github.com/axelarnetwork/axelar-core/x/bitcoin/types"
 	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexusTypes "github.com/axelarnetwork/axelar-core/x/nexus/types"
 	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
 	tssTypes "github.com/axelarnetwork/axelar-core/x/tss/types"
 )
@@ -126,8 +129,21 @@ func GetValdCommand() *cobra.Command {
 			fPath := filepath.Join(valdHome, "state.json")
 			stateSource := NewRWFile(fPath)
 
+			// an optional separate account broadcasts votes, so vote and tss traffic do not compete for the same account sequence
+			voteBroadcaster := serverCtx.Viper.GetString("broadcast.vote-broadcaster-account")
+
+			// when enabled, all broadcaster accounts pay fees from the fee allowance the operator granted them when registering the proxies,
+			// granted fees do not qualify for refunds
+			if serverCtx.Viper.GetBool("broadcast.use-fee-allowance") {
+				operator, err := sdk.ValAddressFromBech32(valAddr)
+				if err != nil {
+					return err
+				}
+				cliCtx = cliCtx.WithFeeGranterAddress(sdk.AccAddress(operator))
+			}
+
 			logger.Info("start listening to events")
-			listen(cliCtx, txf, valdConf, valAddr, recoveryJSON, stateSource, logger)
+			listen(cliCtx, txf, valdConf, valAddr, voteBroadcaster, recoveryJSON, stateSource, logger)
 			logger.Info("shutting down")
 			return nil
 		},
@@ -160,7 +176,7 @@ func setPersistentFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().String(flags.FlagChainID, app.Name, "The network chain ID")
 }
 
-func listen(ctx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig, valAddr string, recoveryJSON []byte, stateSource ReadWriter, logger log.Logger) {
+func listen(ctx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig, valAddr string, voteBroadcaster string, recoveryJSON []byte, stateSource ReadWriter, logger log.Logger) {
 	encCfg := app.MakeEncodingConfig()
 	cdc := encCfg.Amino
 	sender, err := ctx.Keyring.Key(ctx.From)
@@ -173,6 +189,20 @@ func listen(ctx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig,
 
 	bc := createBroadcaster(txf, axelarCfg, logger)
 
+	voteCtx, voteBc := ctx, bc
+	if voteBroadcaster != "" {
+		voteSender, err := ctx.Keyring.Key(voteBroadcaster)
+		if err != nil {
+			panic(sdkerrors.Wrap(err, "failed to read vote broadcaster account info from keyring"))
+		}
+		voteCtx = ctx.
+			WithFrom(voteSender.GetName()).
+			WithFromAddress(voteSender.GetAddress()).
+			WithFromName(voteSender.GetName())
+
+		voteBc = createBroadcaster(txf, axelarCfg, logger)
+	}
+
 	stateStore := NewStateStore(stateSource)
 	startBlock, err := stateStore.GetState()
 	if err != nil {
@@ -199,13 +229,29 @@ func listen(ctx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig,
 		}
 	}
 
-	btcMgr := createBTCMgr(axelarCfg, ctx, bc, logger, cdc)
-	evmMgr := createEVMMgr(axelarCfg, ctx, bc, logger, cdc)
+	btcMgr := createBTCMgr(axelarCfg, voteCtx, voteBc, logger, cdc)
+	evmRPCs := createEVMRPCs(axelarCfg, logger)
+	evmMgr := createEVMMgr(evmRPCs, voteCtx, voteBc, logger, cdc)
+	// adapters for external chains must be registered with the manager before the event listeners start
+	externalMgr := external.NewMgr(voteCtx, voteBc, logger, cdc)
+	for _, adapter := range external.RegisteredAdapters() {
+		if err := externalMgr.RegisterAdapter(adapter); err != nil {
+			panic(fmt.Errorf("unable to register external chain adapter: %v", err))
+		}
+	}
 
 	// we have two processes listening to block headers
 	blockHeaderForTSS := tmEvents.MustSubscribeBlockHeader(eventBus)
 	blockHeaderForStateUpdate := tmEvents.MustSubscribeBlockHeader(eventBus)
 
+	// the optional relayer checks for signed command batches on every block
+	var blockHeaderForRelayer tmEvents.FilteredSubscriber
+	var relayer *evm.Relayer
+	if axelarCfg.RelayerConfig.Enabled {
+		relayer = createEVMRelayer(axelarCfg, evmRPCs, voteCtx, voteBc, logger)
+		blockHeaderForRelayer = tmEvents.MustSubscribeBlockHeader(eventBus)
+	}
+
 	subscribe := func(eventType, module, action string) tmEvents.FilteredSubscriber {
 		return tmEvents.MustSubscribeWithAttributes(eventBus,
 			eventType, module, sdk.Attribute{Key: sdk.AttributeKeyAction, Value: action})
@@ -227,6 +273,8 @@ func listen(ctx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig,
 
 	keygenMsg := subscribe(tssTypes.EventTypeKeygen, tssTypes.ModuleName, tssTypes.AttributeValueMsg)
 	signMsg := subscribe(tssTypes.EventTypeSign, tssTypes.ModuleName, tssTypes.AttributeValueMsg)
+	reshareStart := subscribe(tssTypes.EventTypeReshare, tssTypes.ModuleName, tssTypes.AttributeValueStart)
+	reshareMsg := subscribe(tssTypes.EventTypeReshare, tssTypes.ModuleName, tssTypes.AttributeValueMsg)
 
 	btcConf := subscribe(btcTypes.EventTypeOutpointConfirmation, btcTypes.ModuleName, btcTypes.AttributeValueStart)
 
@@ -236,6 +284,12 @@ func listen(ctx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig,
 	evmDepConf := subscribe(evmTypes.EventTypeDepositConfirmation, evmTypes.ModuleName, evmTypes.AttributeValueStart)
 	evmTokConf := subscribe(evmTypes.EventTypeTokenConfirmation, evmTypes.ModuleName, evmTypes.AttributeValueStart)
 	evmTraConf := subscribe(evmTypes.EventTypeTransferKeyConfirmation, evmTypes.ModuleName, evmTypes.AttributeValueStart)
+	evmBatchExecConf := subscribe(evmTypes.EventTypeBatchExecutionConfirmation, evmTypes.ModuleName, evmTypes.AttributeValueStart)
+	evmGasInfoConf := subscribe(evmTypes.EventTypeGasInfoConfirmation, evmTypes.ModuleName, evmTypes.AttributeValueStart)
+	evmDepReverify := subscribe(evmTypes.EventTypeDepositReverification, evmTypes.ModuleName, evmTypes.AttributeValueStart)
+	evmGatewayUpgradeConf := subscribe(evmTypes.EventTypeGatewayUpgradeConfirmation, evmTypes.ModuleName, evmTypes.AttributeValueStart)
+
+	externalDepConf := subscribe(nexusTypes.EventTypeExternalDepositConfirmation, nexusTypes.ModuleName, nexusTypes.AttributeValueStart)
 
 	eventCtx, cancelEventCtx := context.WithCancel(context.Background())
 	// stop the jobs if process gets interrupted/terminated
@@ -262,6 +316,8 @@ func listen(ctx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig,
 		tmEvents.Consume(keygenMsg, tssMgr.ProcessKeygenMsg),
 		tmEvents.Consume(signStart, tssMgr.ProcessSignStart),
 		tmEvents.Consume(signMsg, tssMgr.ProcessSignMsg),
+		tmEvents.Consume(reshareStart, tssMgr.ProcessReshareStart),
+		tmEvents.Consume(reshareMsg, tssMgr.ProcessReshareMsg),
 		tmEvents.Consume(btcConf, btcMgr.ProcessConfirmation),
 		tmEvents.Consume(evmNewChain, evmMgr.ProcessNewChain),
 		tmEvents.Consume(evmChainConf, evmMgr.ProcessChainConfirmation),
@@ -269,6 +325,15 @@ func listen(ctx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig,
 		tmEvents.Consume(evmDepConf, evmMgr.ProcessDepositConfirmation),
 		tmEvents.Consume(evmTokConf, evmMgr.ProcessTokenConfirmation),
 		tmEvents.Consume(evmTraConf, evmMgr.ProcessTransferKeyConfirmation),
+		tmEvents.Consume(evmBatchExecConf, evmMgr.ProcessBatchExecutionConfirmation),
+		tmEvents.Consume(evmGasInfoConf, evmMgr.ProcessGasInfoConfirmation),
+		tmEvents.Consume(evmDepReverify, evmMgr.ProcessDepositReverification),
+		tmEvents.Consume(evmGatewayUpgradeConf, evmMgr.ProcessGatewayUpgradeConfirmation),
+		tmEvents.Consume(externalDepConf, externalMgr.ProcessDepositConfirmation),
+	}
+
+	if relayer != nil {
+		js = append(js, tmEvents.Consume(blockHeaderForRelayer, tmEvents.OnlyBlockHeight(relayer.ProcessNewBlockHeader)))
 	}
 
 	// errGroup runs async processes and cancels their context if ANY of them returns an error.
@@ -323,11 +388,25 @@ func createTSSMgr(broadcaster broadcasterTypes.Broadcaster, cliCtx client.Contex
 }
 
 func createBTCMgr(axelarCfg config.ValdConfig, cliCtx client.Context, b broadcasterTypes.Broadcaster, logger log.Logger, cdc *codec.LegacyAmino) *btc.Mgr {
-	var rpc *btcRPC.ClientImpl
-	var err error
+	rpcs := make(map[string]btcRPC.Client)
 
+	confs := axelarCfg.UTXOConfig
 	if axelarCfg.BtcConfig.RPCAddr != "" {
-		rpc, err = btcRPC.NewRPCClient(axelarCfg.BtcConfig, logger)
+		btcConf := axelarCfg.BtcConfig
+		if btcConf.Name == "" {
+			btcConf.Name = btcTypes.DefaultConfig().Name
+		}
+		confs = append([]btcTypes.BtcConfig{btcConf}, confs...)
+	}
+
+	for _, utxoChainConf := range confs {
+		if _, found := rpcs[strings.ToLower(utxoChainConf.Name)]; found {
+			msg := fmt.Errorf("duplicate bridge configuration found for UTXO chain %s", utxoChainConf.Name)
+			logger.Error(msg.Error())
+			panic(msg)
+		}
+
+		rpc, err := btcRPC.NewRPCClient(utxoChainConf, logger)
 		if err != nil {
 			logger.Error(err.Error())
 			panic(err)
@@ -335,15 +414,17 @@ func createBTCMgr(axelarCfg config.ValdConfig, cliCtx client.Context, b broadcas
 
 		// clean up btcRPC connection on process shutdown
 		cleanupCommands = append(cleanupCommands, rpc.Shutdown)
-		logger.Info("Successfully connected to Bitcoin bridge ")
+
+		rpcs[strings.ToLower(utxoChainConf.Name)] = rpc
+		logger.Info(fmt.Sprintf("Successfully connected to UTXO bridge for chain %s", utxoChainConf.Name))
 	}
 
-	btcMgr := btc.NewMgr(rpc, cliCtx, b, logger, cdc)
+	btcMgr := btc.NewMgr(rpcs, cliCtx, b, logger, cdc)
 	return btcMgr
 }
 
-func createEVMMgr(axelarCfg config.ValdConfig, cliCtx client.Context, b broadcasterTypes.Broadcaster, logger log.Logger, cdc *codec.LegacyAmino) *evm.Mgr {
-	rpcs := make(map[string]evmRPC.Client)
+func createEVMRPCs(axelarCfg config.ValdConfig, logger log.Logger) map[string]evmRPC.TxClient {
+	rpcs := make(map[string]evmRPC.TxClient)
 
 	for _, evmChainConf := range axelarCfg.EVMConfig {
 		if !evmChainConf.WithBridge {
@@ -368,10 +449,46 @@ func createEVMMgr(axelarCfg config.ValdConfig, cliCtx client.Context, b broadcas
 		logger.Info(fmt.Sprintf("Successfully connected to EVM bridge for chain %s", evmChainConf.Name))
 	}
 
-	evmMgr := evm.NewMgr(rpcs, cliCtx, b, logger, cdc)
+	return rpcs
+}
+
+func createEVMMgr(rpcs map[string]evmRPC.TxClient, cliCtx client.Context, b broadcasterTypes.Broadcaster, logger log.Logger, cdc *codec.LegacyAmino) *evm.Mgr {
+	clients := make(map[string]evmRPC.Client)
+	for chain, rpc := range rpcs {
+		clients[chain] = rpc
+	}
+
+	evmMgr := evm.NewMgr(clients, cliCtx, b, logger, cdc)
 	return evmMgr
 }
 
+func createEVMRelayer(axelarCfg config.ValdConfig, rpcs map[string]evmRPC.TxClient, cliCtx client.Context, b broadcasterTypes.Broadcaster, logger log.Logger) *evm.Relayer {
+	relayerCfg := axelarCfg.RelayerConfig
+	if err := relayerCfg.Validate(); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid EVM relayer configuration"))
+	}
+
+	key, err := crypto.LoadECDSA(relayerCfg.PrivateKeyFile)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "failed to load EVM relayer private key"))
+	}
+
+	relayed := rpcs
+	if len(relayerCfg.Chains) > 0 {
+		relayed = make(map[string]evmRPC.TxClient)
+		for _, chain := range relayerCfg.Chains {
+			rpc, ok := rpcs[strings.ToLower(chain)]
+			if !ok {
+				panic(fmt.Errorf("no EVM bridge configured for relayer chain %s", chain))
+			}
+			relayed[strings.ToLower(chain)] = rpc
+		}
+	}
+
+	logger.Info(fmt.Sprintf("relaying signed command batches from account %s", crypto.PubkeyToAddress(key.PublicKey).Hex()))
+	return evm.NewRelayer(relayed, cliCtx, b, key, relayerCfg, logger)
+}
+
 // RWFile implements the ReadWriter interface for an underlying file
 type RWFile struct {
 	path string
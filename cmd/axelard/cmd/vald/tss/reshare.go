@@ -0,0 +1,254 @@
+package tss
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	sdkFlags "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/parse"
+	"github.com/axelarnetwork/axelar-core/utils"
+	reward "github.com/axelarnetwork/axelar-core/x/reward/types"
+	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/types"
+	voting "github.com/axelarnetwork/axelar-core/x/vote/exported"
+)
+
+type reshareStartParams struct {
+	keyID                     string
+	sessionID                 string
+	threshold                 uint32
+	participants              []string
+	participantShareCounts    []uint32
+	oldParticipants           []string
+	oldParticipantShareCounts []uint32
+	timeout                   int64
+}
+
+// ProcessReshareStart starts the communication with the reshare protocol
+func (mgr *Mgr) ProcessReshareStart(e tmEvents.Event) error {
+	params, err := parseReshareStartParams(mgr.cdc, e.Attributes)
+	if err != nil {
+		return err
+	}
+
+	myOldIndex := utils.IndexOf(params.oldParticipants, mgr.principalAddr)
+	myNewIndex := utils.IndexOf(params.participants, mgr.principalAddr)
+	if myOldIndex == -1 && myNewIndex == -1 {
+		// do not participate
+		return nil
+	}
+
+	done := false
+	session := mgr.timeoutQueue.Enqueue(params.sessionID, e.Height+params.timeout)
+
+	stream, cancel, err := mgr.startReshare(params, int32(myOldIndex), int32(myNewIndex))
+	if err != nil {
+		return err
+	}
+	// reshare sessions have their own IDs, so they can share the stream map with keygen sessions
+	mgr.setKeygenStream(params.sessionID, stream)
+
+	// use error channel to coordinate errors during communication with reshare protocol
+	errChan := make(chan error, 4)
+	intermediateMsgs, result, streamErrChan := handleStream(stream, cancel, mgr.Logger)
+	go func() {
+		err, ok := <-streamErrChan
+		if ok {
+			errChan <- err
+		}
+	}()
+	go func() {
+		err := mgr.handleIntermediateReshareMsgs(params.sessionID, intermediateMsgs)
+		if err != nil {
+			errChan <- err
+		}
+	}()
+	go func() {
+		session.WaitForTimeout()
+
+		if done {
+			return
+		}
+
+		errChan <- mgr.abortKeygen(params.sessionID)
+		mgr.Logger.Info(fmt.Sprintf("aborted reshare protocol %s due to timeout", params.sessionID))
+	}()
+	go func() {
+		err := mgr.handleReshareResult(params.sessionID, myNewIndex != -1, result)
+		done = true
+
+		errChan <- err
+	}()
+
+	return <-errChan
+}
+
+// ProcessReshareMsg forwards blockchain messages to the reshare protocol
+func (mgr *Mgr) ProcessReshareMsg(e tmEvents.Event) error {
+	sessionID, from, payload := parseMsgParams(mgr.cdc, e.Attributes)
+	msgIn := prepareTrafficIn(mgr.principalAddr, from, sessionID, payload, mgr.Logger)
+
+	stream, ok := mgr.getKeygenStream(sessionID)
+	if !ok {
+		mgr.Logger.Info(fmt.Sprintf("no reshare session with id %s. This process does not participate", sessionID))
+		return nil
+	}
+
+	if err := stream.Send(msgIn); err != nil {
+		return sdkerrors.Wrap(err, "failure to send incoming msg to gRPC server")
+	}
+	return nil
+}
+
+func parseReshareStartParams(cdc *codec.LegacyAmino, attributes map[string]string) (reshareStartParams, error) {
+	var params reshareStartParams
+
+	parsers := []*parse.AttributeParser{
+		{Key: tss.AttributeKeyKeyID, Map: parse.IdentityMap},
+		{Key: tss.AttributeKeySessionID, Map: parse.IdentityMap},
+		{Key: tss.AttributeKeyThreshold, Map: func(s string) (interface{}, error) {
+			t, err := strconv.ParseInt(s, 10, 32)
+			if err != nil {
+				return 0, err
+			}
+			return uint32(t), nil
+		}},
+		{Key: tss.AttributeKeyParticipants, Map: func(s string) (interface{}, error) {
+			cdc.MustUnmarshalJSON([]byte(s), &params.participants)
+			return params.participants, nil
+		}},
+		{Key: tss.AttributeKeyParticipantShareCounts, Map: func(s string) (interface{}, error) {
+			cdc.MustUnmarshalJSON([]byte(s), &params.participantShareCounts)
+			return params.participantShareCounts, nil
+		}},
+		{Key: tss.AttributeKeyOldParticipants, Map: func(s string) (interface{}, error) {
+			cdc.MustUnmarshalJSON([]byte(s), &params.oldParticipants)
+			return params.oldParticipants, nil
+		}},
+		{Key: tss.AttributeKeyOldParticipantShareCounts, Map: func(s string) (interface{}, error) {
+			cdc.MustUnmarshalJSON([]byte(s), &params.oldParticipantShareCounts)
+			return params.oldParticipantShareCounts, nil
+		}},
+		{Key: tss.AttributeKeyTimeout, Map: func(s string) (interface{}, error) {
+			return strconv.ParseInt(s, 10, 64)
+		}},
+	}
+
+	results, err := parse.Parse(attributes, parsers)
+	if err != nil {
+		return reshareStartParams{}, err
+	}
+
+	params.keyID = results[0].(string)
+	params.sessionID = results[1].(string)
+	params.threshold = results[2].(uint32)
+	params.timeout = results[7].(int64)
+
+	return params, nil
+}
+
+func (mgr *Mgr) startReshare(params reshareStartParams, myOldIndex int32, myNewIndex int32) (Stream, context.CancelFunc, error) {
+	if _, ok := mgr.getKeygenStream(params.sessionID); ok {
+		return nil, nil, fmt.Errorf("reshare protocol for ID %s already in progress", params.sessionID)
+	}
+
+	grpcCtx, cancel := context.WithTimeout(context.Background(), mgr.Timeout)
+	stream, err := mgr.client.Reshare(grpcCtx)
+	if err != nil {
+		cancel()
+		return nil, nil, sdkerrors.Wrap(err, "failed tofnd gRPC call Reshare")
+	}
+
+	reshareInit := &tofnd.MessageIn_ReshareInit{
+		ReshareInit: &tofnd.ReshareInit{
+			NewSessionUid:       params.sessionID,
+			KeyUid:              params.keyID,
+			OldPartyUids:        params.oldParticipants,
+			OldPartyShareCounts: params.oldParticipantShareCounts,
+			NewPartyUids:        params.participants,
+			NewPartyShareCounts: params.participantShareCounts,
+			NewThreshold:        params.threshold,
+			MyOldPartyIndex:     myOldIndex,
+			MyNewPartyIndex:     myNewIndex,
+		},
+	}
+
+	if err := stream.Send(&tofnd.MessageIn{Data: reshareInit}); err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	return stream, cancel, nil
+}
+
+func (mgr *Mgr) handleIntermediateReshareMsgs(sessionID string, intermediate <-chan *tofnd.TrafficOut) error {
+	for msg := range intermediate {
+		mgr.Logger.Debug(fmt.Sprintf("outgoing reshare msg: session [%.20s] from me [%.20s] to [%.20s] broadcast [%t]\n",
+			sessionID, mgr.principalAddr, msg.ToPartyUid, msg.IsBroadcast))
+		// sender is set by broadcaster
+		tssMsg := &tss.ProcessReshareTrafficRequest{Sender: mgr.cliCtx.FromAddress, SessionID: sessionID, Payload: msg}
+		refundableMsg := reward.NewRefundMsgRequest(mgr.cliCtx.FromAddress, tssMsg)
+		if _, err := mgr.broadcaster.Broadcast(mgr.cliCtx.WithBroadcastMode(sdkFlags.BroadcastSync), refundableMsg); err != nil {
+			return sdkerrors.Wrap(err, "handler goroutine: failure to broadcast outgoing reshare msg")
+		}
+	}
+	return nil
+}
+
+func (mgr *Mgr) handleReshareResult(sessionID string, isNewParty bool, resultChan <-chan interface{}) error {
+	// Delete the reference to the reshare stream with sessionID because entering this function means the tss protocol has completed
+	defer func() {
+		mgr.keygen.Lock()
+		defer mgr.keygen.Unlock()
+		delete(mgr.keygenStreams, sessionID)
+	}()
+
+	r, ok := <-resultChan
+	if !ok {
+		return fmt.Errorf("failed to receive reshare result, channel was closed by the server")
+	}
+
+	result, ok := r.(*tofnd.MessageOut_KeygenResult)
+	if !ok {
+		return fmt.Errorf("failed to receive reshare result, received unexpected type %T", r)
+	}
+
+	mgr.Logger.Debug(fmt.Sprintf("handler goroutine: received reshare result for %s [%+v]", sessionID, result))
+
+	// only the receivers of the new shares vote on the outcome of the session
+	if !isNewParty {
+		return nil
+	}
+
+	switch res := result.GetKeygenResultData().(type) {
+	case *tofnd.MessageOut_KeygenResult_Criminals:
+		// prepare criminals for Validate()
+		// criminals have to be sorted in ascending order
+		sort.Stable(res.Criminals)
+	case *tofnd.MessageOut_KeygenResult_Data:
+		if res.Data.GetPubKey() == nil {
+			return fmt.Errorf("public key missing from the result")
+		}
+		if res.Data.GetGroupRecoverInfo() == nil {
+			return fmt.Errorf("group recovery data missing from the result")
+		}
+		if res.Data.GetPrivateRecoverInfo() == nil {
+			return fmt.Errorf("private recovery data missing from the result")
+		}
+	default:
+		return fmt.Errorf("invalid data type")
+	}
+
+	pollKey := voting.NewPollKey(tss.ModuleName, sessionID)
+	vote := &tss.VoteReshareRequest{Sender: mgr.cliCtx.FromAddress, PollKey: pollKey, Result: result}
+	refundableMsg := reward.NewRefundMsgRequest(mgr.cliCtx.FromAddress, vote)
+
+	_, err := mgr.broadcaster.Broadcast(mgr.cliCtx.WithBroadcastMode(sdkFlags.BroadcastBlock), refundableMsg)
+	return err
+}
@@ -30,6 +30,9 @@ var _ rpc.Client = &ClientMock{}
 // 			RecoverFunc: func(ctx context.Context, in *tofnd.RecoverRequest, opts ...grpc.CallOption) (*tofnd.RecoverResponse, error) {
 // 				panic("mock out the Recover method")
 // 			},
+// 			ReshareFunc: func(ctx context.Context, opts ...grpc.CallOption) (tofnd.GG20_ReshareClient, error) {
+// 				panic("mock out the Reshare method")
+// 			},
 // 			SignFunc: func(ctx context.Context, opts ...grpc.CallOption) (tofnd.GG20_SignClient, error) {
 // 				panic("mock out the Sign method")
 // 			},
@@ -49,6 +52,9 @@ type ClientMock struct {
 	// RecoverFunc mocks the Recover method.
 	RecoverFunc func(ctx context.Context, in *tofnd.RecoverRequest, opts ...grpc.CallOption) (*tofnd.RecoverResponse, error)
 
+	// ReshareFunc mocks the Reshare method.
+	ReshareFunc func(ctx context.Context, opts ...grpc.CallOption) (tofnd.GG20_ReshareClient, error)
+
 	// SignFunc mocks the Sign method.
 	SignFunc func(ctx context.Context, opts ...grpc.CallOption) (tofnd.GG20_SignClient, error)
 
@@ -79,6 +85,13 @@ type ClientMock struct {
 			// Opts is the opts argument value.
 			Opts []grpc.CallOption
 		}
+		// Reshare holds details about calls to the Reshare method.
+		Reshare []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
 		// Sign holds details about calls to the Sign method.
 		Sign []struct {
 			// Ctx is the ctx argument value.
@@ -90,6 +103,7 @@ type ClientMock struct {
 	lockKeyPresence sync.RWMutex
 	lockKeygen      sync.RWMutex
 	lockRecover     sync.RWMutex
+	lockReshare     sync.RWMutex
 	lockSign        sync.RWMutex
 }
 
@@ -206,6 +220,41 @@ func (mock *ClientMock) RecoverCalls() []struct {
 	return calls
 }
 
+// Reshare calls ReshareFunc.
+func (mock *ClientMock) Reshare(ctx context.Context, opts ...grpc.CallOption) (tofnd.GG20_ReshareClient, error) {
+	if mock.ReshareFunc == nil {
+		panic("ClientMock.ReshareFunc: method is nil but Client.Reshare was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockReshare.Lock()
+	mock.calls.Reshare = append(mock.calls.Reshare, callInfo)
+	mock.lockReshare.Unlock()
+	return mock.ReshareFunc(ctx, opts...)
+}
+
+// ReshareCalls gets all the calls that were made to Reshare.
+// Check the length with:
+//     len(mockedClient.ReshareCalls())
+func (mock *ClientMock) ReshareCalls() []struct {
+	Ctx  context.Context
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []grpc.CallOption
+	}
+	mock.lockReshare.RLock()
+	calls = mock.calls.Reshare
+	mock.lockReshare.RUnlock()
+	return calls
+}
+
 // Sign calls SignFunc.
 func (mock *ClientMock) Sign(ctx context.Context, opts ...grpc.CallOption) (tofnd.GG20_SignClient, error) {
 	if mock.SignFunc == nil {
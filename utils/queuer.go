@@ -72,6 +72,11 @@ func (q BlockHeightKVQueue) Enqueue(key Key, value codec.ProtoMarshaler) {
 	q.store.Set(key, value)
 }
 
+// Remove removes the given key from the queue at the queue's block height, the value stored at the key is kept
+func (q BlockHeightKVQueue) Remove(key Key) {
+	q.store.Delete(q.name.Append(q.blockHeight).Append(key))
+}
+
 // Dequeue pops the bottom of the queue and unmarshals it into the given object, and return true if anything
 // in the queue is found and the value passes the optional filter function
 func (q BlockHeightKVQueue) Dequeue(value codec.ProtoMarshaler, filter ...func(value codec.ProtoMarshaler) bool) bool {
@@ -59,6 +59,25 @@ func TestNewBlockHeightKVQueue(t *testing.T) {
 		}
 		assert.Equal(t, items, actualItems)
 	}).Repeat(repeats))
+
+	t.Run("remove", testutils.Func(func(t *testing.T) {
+		ctx, cdc := setup()
+		store := NewNormalizedStore(ctx.KVStore(sdk.NewKVStoreKey(stringGen.Next())), cdc)
+
+		blockHeight := rand.I64Between(1, 10000)
+		kvQueue := NewBlockHeightKVQueue("test-remove", store, blockHeight, log.TestingLogger())
+		removed := rand.Str(10)
+		kept := rand.Str(10)
+		kvQueue.Enqueue(KeyFromStr(removed), &gogoprototypes.StringValue{Value: removed})
+		kvQueue.WithBlockHeight(blockHeight+1).Enqueue(KeyFromStr(kept), &gogoprototypes.StringValue{Value: kept})
+
+		kvQueue.Remove(KeyFromStr(removed))
+		assert.Equal(t, []Key{KeyFromStr(kept)}, kvQueue.Keys())
+
+		var value gogoprototypes.StringValue
+		assert.True(t, store.Get(KeyFromStr(removed), &value))
+		assert.Equal(t, removed, value.Value)
+	}).Repeat(repeats))
 }
 
 func TestNewSequenceKVQueue(t *testing.T) {
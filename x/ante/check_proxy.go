@@ -1,10 +1,17 @@
 package ante
 
 import (
-	"github.com/axelarnetwork/axelar-core/x/ante/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/axelarnetwork/axelar-core/x/ante/types"
+	bitcoin "github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/types"
+	reward "github.com/axelarnetwork/axelar-core/x/reward/types"
+	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/types"
 )
 
 // CheckProxy checks if the proxy already sent its readiness message
@@ -19,15 +26,28 @@ func NewCheckProxy(snapshotter types.Snapshotter) CheckProxy {
 	}
 }
 
-// AnteHandle fails the transaction if it finds any validator holding tss share of active keys is trying to unbond
+// AnteHandle fails the transaction if a validator without an active proxy is created,
+// or if a proxy broadcasts messages its role does not allow. It also warns if a proxy's fee allowance is nearly used up
 func (d CheckProxy) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	// exempt genesis validator(s) from this check
 	if ctx.BlockHeight() == 0 {
 		return next(ctx, tx, simulate)
 	}
 
+	// fees have already been deducted at this point, so the remaining allowance is up to date
+	if feeTx, ok := tx.(sdk.FeeTx); ok && !feeTx.FeeGranter().Empty() {
+		d.snapshotter.CheckProxyFeeAllowance(ctx, feeTx.FeeGranter(), feeTx.FeePayer())
+	}
+
 	msgs := tx.GetMsgs()
 	for _, msg := range msgs {
+		// vald wraps its votes and tss messages to get them refunded, so the role restrictions apply to the wrapped message
+		if refundMsg, ok := msg.(*reward.RefundMsgRequest); ok {
+			if innerMsg := refundMsg.GetInnerMessage(); innerMsg != nil {
+				msg = innerMsg
+			}
+		}
+
 		switch msg := msg.(type) {
 		case *stakingtypes.MsgCreateValidator:
 			valAddress, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
@@ -37,6 +57,20 @@ func (d CheckProxy) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next s
 			if proxy, active := d.snapshotter.GetProxy(ctx, valAddress); proxy.Empty() || !active {
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "no proxy found for operator %s", valAddress.String())
 			}
+		case *tss.HeartBeatRequest, *tss.ProcessKeygenTrafficRequest, *tss.VotePubKeyRequest,
+			*tss.ProcessReshareTrafficRequest, *tss.VoteReshareRequest, *tss.ProcessSignTrafficRequest,
+			*tss.VoteSigRequest, *tss.SubmitMultisigPubKeysRequest, *tss.SubmitMultisigSignaturesRequest:
+
+			if err := d.checkProxyRole(ctx, msg, snapshot.ProxyTss); err != nil {
+				return ctx, err
+			}
+		case *evm.VoteConfirmGatewayDeploymentRequest, *evm.VoteConfirmChainRequest, *evm.VoteConfirmDepositRequest,
+			*evm.VoteConfirmTokenRequest, *evm.VoteConfirmTransferKeyRequest, *evm.VoteConfirmBatchExecutionRequest, *evm.VoteConfirmGasInfoRequest, *evm.VoteReverifyDepositRequest, *evm.VoteConfirmGatewayUpgradeRequest, *bitcoin.VoteConfirmOutpointRequest,
+			*nexus.VoteConfirmExternalDepositRequest:
+
+			if err := d.checkProxyRole(ctx, msg, snapshot.ProxyVote); err != nil {
+				return ctx, err
+			}
 		default:
 			continue
 		}
@@ -44,3 +78,12 @@ func (d CheckProxy) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next s
 
 	return next(ctx, tx, simulate)
 }
+
+func (d CheckProxy) checkProxyRole(ctx sdk.Context, msg sdk.Msg, required snapshot.ProxyRole) error {
+	signer := msg.GetSigners()[0]
+	if role, ok := d.snapshotter.GetProxyRole(ctx, signer); ok && !role.CanBroadcast(required) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s proxy %s is not authorized to send transaction %T", role.SimpleString(), signer, msg)
+	}
+
+	return nil
+}
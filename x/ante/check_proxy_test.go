@@ -0,0 +1,89 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/ante"
+	"github.com/axelarnetwork/axelar-core/x/ante/types/mock"
+	reward "github.com/axelarnetwork/axelar-core/x/reward/types"
+	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
+	tssexported "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+func TestCheckProxy(t *testing.T) {
+	var (
+		ctx        sdk.Context
+		role       snapshot.ProxyRole
+		nextCalled bool
+		handler    ante.CheckProxy
+	)
+
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		nextCalled = true
+		return ctx, nil
+	}
+
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+		nextCalled = false
+		handler = ante.NewCheckProxy(&mock.SnapshotterMock{
+			GetProxyRoleFunc: func(sdk.Context, sdk.AccAddress) (snapshot.ProxyRole, bool) { return role, true },
+		})
+	}
+
+	newHeartBeatTx := func(wrapped bool) sdk.Tx {
+		sender := rand.AccAddr()
+		var msg sdk.Msg = tss.NewHeartBeatRequest(sender, []tssexported.KeyID{tssexported.KeyID(rand.Str(10))})
+		if wrapped {
+			msg = reward.NewRefundMsgRequest(sender, msg)
+		}
+
+		return legacytx.NewStdTx([]sdk.Msg{msg}, legacytx.StdFee{}, nil, "")
+	}
+
+	repeats := 20
+	t.Run("should allow tss proxies to send tss messages", testutils.Func(func(t *testing.T) {
+		setup()
+		role = []snapshot.ProxyRole{snapshot.ProxyAll, snapshot.ProxyTss}[rand.I64Between(0, 2)]
+
+		_, err := handler.AnteHandle(ctx, newHeartBeatTx(false), false, next)
+		assert.NoError(t, err)
+		assert.True(t, nextCalled)
+	}).Repeat(repeats))
+
+	t.Run("should allow tss proxies to send tss messages wrapped for refunds", testutils.Func(func(t *testing.T) {
+		setup()
+		role = []snapshot.ProxyRole{snapshot.ProxyAll, snapshot.ProxyTss}[rand.I64Between(0, 2)]
+
+		_, err := handler.AnteHandle(ctx, newHeartBeatTx(true), false, next)
+		assert.NoError(t, err)
+		assert.True(t, nextCalled)
+	}).Repeat(repeats))
+
+	t.Run("should reject tss messages from vote proxies", testutils.Func(func(t *testing.T) {
+		setup()
+		role = snapshot.ProxyVote
+
+		_, err := handler.AnteHandle(ctx, newHeartBeatTx(false), false, next)
+		assert.Error(t, err)
+		assert.False(t, nextCalled)
+	}).Repeat(repeats))
+
+	t.Run("should reject tss messages wrapped for refunds from vote proxies", testutils.Func(func(t *testing.T) {
+		setup()
+		role = snapshot.ProxyVote
+
+		_, err := handler.AnteHandle(ctx, newHeartBeatTx(true), false, next)
+		assert.Error(t, err)
+		assert.False(t, nextCalled)
+	}).Repeat(repeats))
+}
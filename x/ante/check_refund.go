@@ -51,8 +51,9 @@ func (d CheckRefundFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
 		}
 
+		// refunds are paid to the signer, so fees covered by a granter must not be refunded
 		fee := feeTx.GetFee()
-		if len(fee) > 0 {
+		if len(fee) > 0 && feeTx.FeeGranter().Empty() {
 			req := msgs[0].(*rewardtypes.RefundMsgRequest)
 			err := d.reward.SetPendingRefund(ctx, *req, fee[0])
 			if err != nil {
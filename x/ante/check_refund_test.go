@@ -0,0 +1,86 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/ante"
+	"github.com/axelarnetwork/axelar-core/x/ante/types/mock"
+	reward "github.com/axelarnetwork/axelar-core/x/reward/types"
+	tssexported "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+func TestCheckRefundFeeDecorator(t *testing.T) {
+	var (
+		ctx        sdk.Context
+		rewarder   *mock.RewardMock
+		nextCalled bool
+		handler    ante.CheckRefundFeeDecorator
+	)
+
+	encCfg := app.MakeEncodingConfig()
+
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		nextCalled = true
+		return ctx, nil
+	}
+
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+		nextCalled = false
+		rewarder = &mock.RewardMock{
+			SetPendingRefundFunc: func(sdk.Context, reward.RefundMsgRequest, sdk.Coin) error { return nil },
+		}
+		snapshotter := &mock.SnapshotterMock{
+			GetOperatorFunc: func(sdk.Context, sdk.AccAddress) sdk.ValAddress { return rand.ValAddr() },
+		}
+		staking := &mock.StakingMock{
+			ValidatorFunc: func(sdk.Context, sdk.ValAddress) stakingtypes.ValidatorI {
+				return stakingtypes.Validator{Status: stakingtypes.Bonded}
+			},
+		}
+		handler = ante.NewCheckRefundFeeDecorator(encCfg.InterfaceRegistry, nil, staking, snapshotter, rewarder)
+	}
+
+	newRefundableTx := func(granter sdk.AccAddress) sdk.Tx {
+		sender := rand.AccAddr()
+		msg := reward.NewRefundMsgRequest(sender, tss.NewHeartBeatRequest(sender, []tssexported.KeyID{tssexported.KeyID(rand.Str(10))}))
+
+		txBuilder := encCfg.TxConfig.NewTxBuilder()
+		if err := txBuilder.SetMsgs(msg); err != nil {
+			panic(err)
+		}
+		txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uaxl", rand.I64Between(1, 100000))))
+		txBuilder.SetFeeGranter(granter)
+
+		return txBuilder.GetTx()
+	}
+
+	repeats := 20
+	t.Run("should record a refund for fees paid by the signer", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := handler.AnteHandle(ctx, newRefundableTx(nil), false, next)
+		assert.NoError(t, err)
+		assert.True(t, nextCalled)
+		assert.Len(t, rewarder.SetPendingRefundCalls(), 1)
+	}).Repeat(repeats))
+
+	t.Run("should not record a refund for fees paid by a granter", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := handler.AnteHandle(ctx, newRefundableTx(rand.AccAddr()), false, next)
+		assert.NoError(t, err)
+		assert.True(t, nextCalled)
+		assert.Len(t, rewarder.SetPendingRefundCalls(), 0)
+	}).Repeat(repeats))
+}
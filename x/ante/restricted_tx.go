@@ -31,18 +31,20 @@ func (d RestrictedTx) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next
 	for _, msg := range msgs {
 		switch msg := msg.(type) {
 		case *permissionTypes.UpdateGovernanceKeyRequest, *permissionTypes.RegisterControllerRequest,
-			*axelarnet.RegisterFeeCollectorRequest:
+			*axelarnet.RegisterFeeCollectorRequest, *axelarnet.RerouteFailedIBCTransferRequest,
+			*axelarnet.RefundFailedIBCTransferRequest:
 
 			signer := msg.GetSigners()[0]
 			if permission.ROLE_ACCESS_CONTROL != d.permission.GetRole(ctx, signer) {
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not authorized to send transaction %T", signer, msg)
 			}
 		case *tss.RegisterExternalKeysRequest, *tss.StartKeygenRequest,
-			*tss.RotateKeyRequest, *axelarnet.RegisterIBCPathRequest,
-			*axelarnet.RegisterAssetRequest, *axelarnet.AddCosmosBasedChainRequest,
+			*tss.StartReshareRequest, *tss.RotateKeyRequest, *axelarnet.RegisterIBCPathRequest,
+			*axelarnet.RegisterAssetRequest, *axelarnet.AddCosmosBasedChainRequest, *axelarnet.SetCosmosChainIDRequest,
 			*evm.AddChainRequest, *evm.ConfirmGatewayDeploymentRequest,
-			*evm.CreateDeployTokenRequest, *evm.CreateTransferOwnershipRequest,
-			*evm.CreateTransferOperatorshipRequest:
+			*evm.CreateDeployTokenRequest, *evm.CreateRegisterExternalTokenRequest,
+			*evm.CreateTransferOwnershipRequest, *evm.CreateTransferOperatorshipRequest,
+			*evm.CreateUpgradeGatewayRequest:
 
 			signer := msg.GetSigners()[0]
 			if permission.ROLE_CHAIN_MANAGEMENT != d.permission.GetRole(ctx, signer) {
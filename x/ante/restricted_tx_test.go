@@ -0,0 +1,67 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/ante"
+	"github.com/axelarnetwork/axelar-core/x/ante/types/mock"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/types"
+	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
+)
+
+func TestRestrictedTx(t *testing.T) {
+	var (
+		ctx        sdk.Context
+		role       permission.Role
+		nextCalled bool
+		handler    ante.RestrictedTx
+	)
+
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		nextCalled = true
+		return ctx, nil
+	}
+
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+		nextCalled = false
+		handler = ante.NewRestrictedTx(&mock.PermissionMock{
+			GetRoleFunc: func(sdk.Context, sdk.AccAddress) permission.Role { return role },
+		})
+	}
+
+	newUpgradeGatewayTx := func() sdk.Tx {
+		msg := evm.NewCreateUpgradeGatewayRequest(rand.AccAddr(), rand.Str(5), common.BytesToAddress(rand.Bytes(common.AddressLength)), common.BytesToHash(rand.Bytes(common.HashLength)), nil, evm.AllGatewayCommands())
+		return legacytx.NewStdTx([]sdk.Msg{msg}, legacytx.StdFee{}, nil, "")
+	}
+
+	repeats := 20
+	t.Run("should allow chain management to upgrade the gateway", testutils.Func(func(t *testing.T) {
+		setup()
+		role = permission.ROLE_CHAIN_MANAGEMENT
+
+		_, err := handler.AnteHandle(ctx, newUpgradeGatewayTx(), false, next)
+
+		assert.NoError(t, err)
+		assert.True(t, nextCalled)
+	}).Repeat(repeats))
+
+	t.Run("should reject gateway upgrades from other roles", testutils.Func(func(t *testing.T) {
+		setup()
+		role = []permission.Role{permission.ROLE_UNSPECIFIED, permission.ROLE_ACCESS_CONTROL}[rand.I64Between(0, 2)]
+
+		_, err := handler.AnteHandle(ctx, newUpgradeGatewayTx(), false, next)
+
+		assert.Error(t, err)
+		assert.False(t, nextCalled)
+	}).Repeat(repeats))
+}
@@ -12,6 +12,8 @@ import (
 	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
 )
 
+//go:generate moq -out ./mock/expected_keepers.go -pkg mock . Tss Nexus Snapshotter Staking Reward Permission
+
 // Tss provides access to the tss functionality
 type Tss interface {
 	GetCurrentKeyID(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool)
@@ -33,6 +35,8 @@ type Snapshotter interface {
 	GetSnapshot(ctx sdk.Context, counter int64) (snapshot.Snapshot, bool)
 	GetOperator(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress
 	GetProxy(ctx sdk.Context, operator sdk.ValAddress) (sdk.AccAddress, bool)
+	GetProxyRole(ctx sdk.Context, proxy sdk.AccAddress) (snapshot.ProxyRole, bool)
+	CheckProxyFeeAllowance(ctx sdk.Context, granter sdk.AccAddress, proxy sdk.AccAddress)
 }
 
 // Staking adopts the methods from "github.com/cosmos/cosmos-sdk/x/staking/exported" that are
@@ -0,0 +1,984 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mock
+
+import (
+	"github.com/axelarnetwork/axelar-core/x/ante/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
+	rewardtypes "github.com/axelarnetwork/axelar-core/x/reward/types"
+	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"sync"
+)
+
+// Ensure, that TssMock does implement types.Tss.
+// If this is not the case, regenerate this file with moq.
+var _ types.Tss = &TssMock{}
+
+// TssMock is a mock implementation of types.Tss.
+//
+//	func TestSomethingThatUsesTss(t *testing.T) {
+//
+//		// make and configure a mocked types.Tss
+//		mockedTss := &TssMock{
+//			GetCurrentKeyIDFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool) {
+//				panic("mock out the GetCurrentKeyID method")
+//			},
+//			GetKeyByRotationCountFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole, rotationCount int64) (tss.Key, bool) {
+//				panic("mock out the GetKeyByRotationCount method")
+//			},
+//			GetKeyUnbondingLockingKeyRotationCountFunc: func(ctx sdk.Context) int64 {
+//				panic("mock out the GetKeyUnbondingLockingKeyRotationCount method")
+//			},
+//			GetNextKeyIDFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool) {
+//				panic("mock out the GetNextKeyID method")
+//			},
+//			GetOldActiveKeysFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) ([]tss.Key, error) {
+//				panic("mock out the GetOldActiveKeys method")
+//			},
+//			GetRotationCountFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) int64 {
+//				panic("mock out the GetRotationCount method")
+//			},
+//			GetSnapshotCounterForKeyIDFunc: func(ctx sdk.Context, keyID tss.KeyID) (int64, bool) {
+//				panic("mock out the GetSnapshotCounterForKeyID method")
+//			},
+//		}
+//
+//		// use mockedTss in code that requires types.Tss
+//		// and then make assertions.
+//
+//	}
+type TssMock struct {
+	// GetCurrentKeyIDFunc mocks the GetCurrentKeyID method.
+	GetCurrentKeyIDFunc func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool)
+
+	// GetKeyByRotationCountFunc mocks the GetKeyByRotationCount method.
+	GetKeyByRotationCountFunc func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole, rotationCount int64) (tss.Key, bool)
+
+	// GetKeyUnbondingLockingKeyRotationCountFunc mocks the GetKeyUnbondingLockingKeyRotationCount method.
+	GetKeyUnbondingLockingKeyRotationCountFunc func(ctx sdk.Context) int64
+
+	// GetNextKeyIDFunc mocks the GetNextKeyID method.
+	GetNextKeyIDFunc func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool)
+
+	// GetOldActiveKeysFunc mocks the GetOldActiveKeys method.
+	GetOldActiveKeysFunc func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) ([]tss.Key, error)
+
+	// GetRotationCountFunc mocks the GetRotationCount method.
+	GetRotationCountFunc func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) int64
+
+	// GetSnapshotCounterForKeyIDFunc mocks the GetSnapshotCounterForKeyID method.
+	GetSnapshotCounterForKeyIDFunc func(ctx sdk.Context, keyID tss.KeyID) (int64, bool)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetCurrentKeyID holds details about calls to the GetCurrentKeyID method.
+		GetCurrentKeyID []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Chain is the chain argument value.
+			Chain nexus.Chain
+			// KeyRole is the keyRole argument value.
+			KeyRole tss.KeyRole
+		}
+		// GetKeyByRotationCount holds details about calls to the GetKeyByRotationCount method.
+		GetKeyByRotationCount []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Chain is the chain argument value.
+			Chain nexus.Chain
+			// KeyRole is the keyRole argument value.
+			KeyRole tss.KeyRole
+			// RotationCount is the rotationCount argument value.
+			RotationCount int64
+		}
+		// GetKeyUnbondingLockingKeyRotationCount holds details about calls to the GetKeyUnbondingLockingKeyRotationCount method.
+		GetKeyUnbondingLockingKeyRotationCount []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+		}
+		// GetNextKeyID holds details about calls to the GetNextKeyID method.
+		GetNextKeyID []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Chain is the chain argument value.
+			Chain nexus.Chain
+			// KeyRole is the keyRole argument value.
+			KeyRole tss.KeyRole
+		}
+		// GetOldActiveKeys holds details about calls to the GetOldActiveKeys method.
+		GetOldActiveKeys []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Chain is the chain argument value.
+			Chain nexus.Chain
+			// KeyRole is the keyRole argument value.
+			KeyRole tss.KeyRole
+		}
+		// GetRotationCount holds details about calls to the GetRotationCount method.
+		GetRotationCount []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Chain is the chain argument value.
+			Chain nexus.Chain
+			// KeyRole is the keyRole argument value.
+			KeyRole tss.KeyRole
+		}
+		// GetSnapshotCounterForKeyID holds details about calls to the GetSnapshotCounterForKeyID method.
+		GetSnapshotCounterForKeyID []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// KeyID is the keyID argument value.
+			KeyID tss.KeyID
+		}
+	}
+	lockGetCurrentKeyID                        sync.RWMutex
+	lockGetKeyByRotationCount                  sync.RWMutex
+	lockGetKeyUnbondingLockingKeyRotationCount sync.RWMutex
+	lockGetNextKeyID                           sync.RWMutex
+	lockGetOldActiveKeys                       sync.RWMutex
+	lockGetRotationCount                       sync.RWMutex
+	lockGetSnapshotCounterForKeyID             sync.RWMutex
+}
+
+// GetCurrentKeyID calls GetCurrentKeyIDFunc.
+func (mock *TssMock) GetCurrentKeyID(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool) {
+	if mock.GetCurrentKeyIDFunc == nil {
+		panic("TssMock.GetCurrentKeyIDFunc: method is nil but Tss.GetCurrentKeyID was just called")
+	}
+	callInfo := struct {
+		Ctx     sdk.Context
+		Chain   nexus.Chain
+		KeyRole tss.KeyRole
+	}{
+		Ctx:     ctx,
+		Chain:   chain,
+		KeyRole: keyRole,
+	}
+	mock.lockGetCurrentKeyID.Lock()
+	mock.calls.GetCurrentKeyID = append(mock.calls.GetCurrentKeyID, callInfo)
+	mock.lockGetCurrentKeyID.Unlock()
+	return mock.GetCurrentKeyIDFunc(ctx, chain, keyRole)
+}
+
+// GetCurrentKeyIDCalls gets all the calls that were made to GetCurrentKeyID.
+// Check the length with:
+//
+//	len(mockedTss.GetCurrentKeyIDCalls())
+func (mock *TssMock) GetCurrentKeyIDCalls() []struct {
+	Ctx     sdk.Context
+	Chain   nexus.Chain
+	KeyRole tss.KeyRole
+} {
+	var calls []struct {
+		Ctx     sdk.Context
+		Chain   nexus.Chain
+		KeyRole tss.KeyRole
+	}
+	mock.lockGetCurrentKeyID.RLock()
+	calls = mock.calls.GetCurrentKeyID
+	mock.lockGetCurrentKeyID.RUnlock()
+	return calls
+}
+
+// GetKeyByRotationCount calls GetKeyByRotationCountFunc.
+func (mock *TssMock) GetKeyByRotationCount(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole, rotationCount int64) (tss.Key, bool) {
+	if mock.GetKeyByRotationCountFunc == nil {
+		panic("TssMock.GetKeyByRotationCountFunc: method is nil but Tss.GetKeyByRotationCount was just called")
+	}
+	callInfo := struct {
+		Ctx           sdk.Context
+		Chain         nexus.Chain
+		KeyRole       tss.KeyRole
+		RotationCount int64
+	}{
+		Ctx:           ctx,
+		Chain:         chain,
+		KeyRole:       keyRole,
+		RotationCount: rotationCount,
+	}
+	mock.lockGetKeyByRotationCount.Lock()
+	mock.calls.GetKeyByRotationCount = append(mock.calls.GetKeyByRotationCount, callInfo)
+	mock.lockGetKeyByRotationCount.Unlock()
+	return mock.GetKeyByRotationCountFunc(ctx, chain, keyRole, rotationCount)
+}
+
+// GetKeyByRotationCountCalls gets all the calls that were made to GetKeyByRotationCount.
+// Check the length with:
+//
+//	len(mockedTss.GetKeyByRotationCountCalls())
+func (mock *TssMock) GetKeyByRotationCountCalls() []struct {
+	Ctx           sdk.Context
+	Chain         nexus.Chain
+	KeyRole       tss.KeyRole
+	RotationCount int64
+} {
+	var calls []struct {
+		Ctx           sdk.Context
+		Chain         nexus.Chain
+		KeyRole       tss.KeyRole
+		RotationCount int64
+	}
+	mock.lockGetKeyByRotationCount.RLock()
+	calls = mock.calls.GetKeyByRotationCount
+	mock.lockGetKeyByRotationCount.RUnlock()
+	return calls
+}
+
+// GetKeyUnbondingLockingKeyRotationCount calls GetKeyUnbondingLockingKeyRotationCountFunc.
+func (mock *TssMock) GetKeyUnbondingLockingKeyRotationCount(ctx sdk.Context) int64 {
+	if mock.GetKeyUnbondingLockingKeyRotationCountFunc == nil {
+		panic("TssMock.GetKeyUnbondingLockingKeyRotationCountFunc: method is nil but Tss.GetKeyUnbondingLockingKeyRotationCount was just called")
+	}
+	callInfo := struct {
+		Ctx sdk.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetKeyUnbondingLockingKeyRotationCount.Lock()
+	mock.calls.GetKeyUnbondingLockingKeyRotationCount = append(mock.calls.GetKeyUnbondingLockingKeyRotationCount, callInfo)
+	mock.lockGetKeyUnbondingLockingKeyRotationCount.Unlock()
+	return mock.GetKeyUnbondingLockingKeyRotationCountFunc(ctx)
+}
+
+// GetKeyUnbondingLockingKeyRotationCountCalls gets all the calls that were made to GetKeyUnbondingLockingKeyRotationCount.
+// Check the length with:
+//
+//	len(mockedTss.GetKeyUnbondingLockingKeyRotationCountCalls())
+func (mock *TssMock) GetKeyUnbondingLockingKeyRotationCountCalls() []struct {
+	Ctx sdk.Context
+} {
+	var calls []struct {
+		Ctx sdk.Context
+	}
+	mock.lockGetKeyUnbondingLockingKeyRotationCount.RLock()
+	calls = mock.calls.GetKeyUnbondingLockingKeyRotationCount
+	mock.lockGetKeyUnbondingLockingKeyRotationCount.RUnlock()
+	return calls
+}
+
+// GetNextKeyID calls GetNextKeyIDFunc.
+func (mock *TssMock) GetNextKeyID(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool) {
+	if mock.GetNextKeyIDFunc == nil {
+		panic("TssMock.GetNextKeyIDFunc: method is nil but Tss.GetNextKeyID was just called")
+	}
+	callInfo := struct {
+		Ctx     sdk.Context
+		Chain   nexus.Chain
+		KeyRole tss.KeyRole
+	}{
+		Ctx:     ctx,
+		Chain:   chain,
+		KeyRole: keyRole,
+	}
+	mock.lockGetNextKeyID.Lock()
+	mock.calls.GetNextKeyID = append(mock.calls.GetNextKeyID, callInfo)
+	mock.lockGetNextKeyID.Unlock()
+	return mock.GetNextKeyIDFunc(ctx, chain, keyRole)
+}
+
+// GetNextKeyIDCalls gets all the calls that were made to GetNextKeyID.
+// Check the length with:
+//
+//	len(mockedTss.GetNextKeyIDCalls())
+func (mock *TssMock) GetNextKeyIDCalls() []struct {
+	Ctx     sdk.Context
+	Chain   nexus.Chain
+	KeyRole tss.KeyRole
+} {
+	var calls []struct {
+		Ctx     sdk.Context
+		Chain   nexus.Chain
+		KeyRole tss.KeyRole
+	}
+	mock.lockGetNextKeyID.RLock()
+	calls = mock.calls.GetNextKeyID
+	mock.lockGetNextKeyID.RUnlock()
+	return calls
+}
+
+// GetOldActiveKeys calls GetOldActiveKeysFunc.
+func (mock *TssMock) GetOldActiveKeys(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) ([]tss.Key, error) {
+	if mock.GetOldActiveKeysFunc == nil {
+		panic("TssMock.GetOldActiveKeysFunc: method is nil but Tss.GetOldActiveKeys was just called")
+	}
+	callInfo := struct {
+		Ctx     sdk.Context
+		Chain   nexus.Chain
+		KeyRole tss.KeyRole
+	}{
+		Ctx:     ctx,
+		Chain:   chain,
+		KeyRole: keyRole,
+	}
+	mock.lockGetOldActiveKeys.Lock()
+	mock.calls.GetOldActiveKeys = append(mock.calls.GetOldActiveKeys, callInfo)
+	mock.lockGetOldActiveKeys.Unlock()
+	return mock.GetOldActiveKeysFunc(ctx, chain, keyRole)
+}
+
+// GetOldActiveKeysCalls gets all the calls that were made to GetOldActiveKeys.
+// Check the length with:
+//
+//	len(mockedTss.GetOldActiveKeysCalls())
+func (mock *TssMock) GetOldActiveKeysCalls() []struct {
+	Ctx     sdk.Context
+	Chain   nexus.Chain
+	KeyRole tss.KeyRole
+} {
+	var calls []struct {
+		Ctx     sdk.Context
+		Chain   nexus.Chain
+		KeyRole tss.KeyRole
+	}
+	mock.lockGetOldActiveKeys.RLock()
+	calls = mock.calls.GetOldActiveKeys
+	mock.lockGetOldActiveKeys.RUnlock()
+	return calls
+}
+
+// GetRotationCount calls GetRotationCountFunc.
+func (mock *TssMock) GetRotationCount(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) int64 {
+	if mock.GetRotationCountFunc == nil {
+		panic("TssMock.GetRotationCountFunc: method is nil but Tss.GetRotationCount was just called")
+	}
+	callInfo := struct {
+		Ctx     sdk.Context
+		Chain   nexus.Chain
+		KeyRole tss.KeyRole
+	}{
+		Ctx:     ctx,
+		Chain:   chain,
+		KeyRole: keyRole,
+	}
+	mock.lockGetRotationCount.Lock()
+	mock.calls.GetRotationCount = append(mock.calls.GetRotationCount, callInfo)
+	mock.lockGetRotationCount.Unlock()
+	return mock.GetRotationCountFunc(ctx, chain, keyRole)
+}
+
+// GetRotationCountCalls gets all the calls that were made to GetRotationCount.
+// Check the length with:
+//
+//	len(mockedTss.GetRotationCountCalls())
+func (mock *TssMock) GetRotationCountCalls() []struct {
+	Ctx     sdk.Context
+	Chain   nexus.Chain
+	KeyRole tss.KeyRole
+} {
+	var calls []struct {
+		Ctx     sdk.Context
+		Chain   nexus.Chain
+		KeyRole tss.KeyRole
+	}
+	mock.lockGetRotationCount.RLock()
+	calls = mock.calls.GetRotationCount
+	mock.lockGetRotationCount.RUnlock()
+	return calls
+}
+
+// GetSnapshotCounterForKeyID calls GetSnapshotCounterForKeyIDFunc.
+func (mock *TssMock) GetSnapshotCounterForKeyID(ctx sdk.Context, keyID tss.KeyID) (int64, bool) {
+	if mock.GetSnapshotCounterForKeyIDFunc == nil {
+		panic("TssMock.GetSnapshotCounterForKeyIDFunc: method is nil but Tss.GetSnapshotCounterForKeyID was just called")
+	}
+	callInfo := struct {
+		Ctx   sdk.Context
+		KeyID tss.KeyID
+	}{
+		Ctx:   ctx,
+		KeyID: keyID,
+	}
+	mock.lockGetSnapshotCounterForKeyID.Lock()
+	mock.calls.GetSnapshotCounterForKeyID = append(mock.calls.GetSnapshotCounterForKeyID, callInfo)
+	mock.lockGetSnapshotCounterForKeyID.Unlock()
+	return mock.GetSnapshotCounterForKeyIDFunc(ctx, keyID)
+}
+
+// GetSnapshotCounterForKeyIDCalls gets all the calls that were made to GetSnapshotCounterForKeyID.
+// Check the length with:
+//
+//	len(mockedTss.GetSnapshotCounterForKeyIDCalls())
+func (mock *TssMock) GetSnapshotCounterForKeyIDCalls() []struct {
+	Ctx   sdk.Context
+	KeyID tss.KeyID
+} {
+	var calls []struct {
+		Ctx   sdk.Context
+		KeyID tss.KeyID
+	}
+	mock.lockGetSnapshotCounterForKeyID.RLock()
+	calls = mock.calls.GetSnapshotCounterForKeyID
+	mock.lockGetSnapshotCounterForKeyID.RUnlock()
+	return calls
+}
+
+// Ensure, that NexusMock does implement types.Nexus.
+// If this is not the case, regenerate this file with moq.
+var _ types.Nexus = &NexusMock{}
+
+// NexusMock is a mock implementation of types.Nexus.
+//
+//	func TestSomethingThatUsesNexus(t *testing.T) {
+//
+//		// make and configure a mocked types.Nexus
+//		mockedNexus := &NexusMock{
+//			GetChainsFunc: func(ctx sdk.Context) []nexus.Chain {
+//				panic("mock out the GetChains method")
+//			},
+//		}
+//
+//		// use mockedNexus in code that requires types.Nexus
+//		// and then make assertions.
+//
+//	}
+type NexusMock struct {
+	// GetChainsFunc mocks the GetChains method.
+	GetChainsFunc func(ctx sdk.Context) []nexus.Chain
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetChains holds details about calls to the GetChains method.
+		GetChains []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+		}
+	}
+	lockGetChains sync.RWMutex
+}
+
+// GetChains calls GetChainsFunc.
+func (mock *NexusMock) GetChains(ctx sdk.Context) []nexus.Chain {
+	if mock.GetChainsFunc == nil {
+		panic("NexusMock.GetChainsFunc: method is nil but Nexus.GetChains was just called")
+	}
+	callInfo := struct {
+		Ctx sdk.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetChains.Lock()
+	mock.calls.GetChains = append(mock.calls.GetChains, callInfo)
+	mock.lockGetChains.Unlock()
+	return mock.GetChainsFunc(ctx)
+}
+
+// GetChainsCalls gets all the calls that were made to GetChains.
+// Check the length with:
+//
+//	len(mockedNexus.GetChainsCalls())
+func (mock *NexusMock) GetChainsCalls() []struct {
+	Ctx sdk.Context
+} {
+	var calls []struct {
+		Ctx sdk.Context
+	}
+	mock.lockGetChains.RLock()
+	calls = mock.calls.GetChains
+	mock.lockGetChains.RUnlock()
+	return calls
+}
+
+// Ensure, that SnapshotterMock does implement types.Snapshotter.
+// If this is not the case, regenerate this file with moq.
+var _ types.Snapshotter = &SnapshotterMock{}
+
+// SnapshotterMock is a mock implementation of types.Snapshotter.
+//
+//	func TestSomethingThatUsesSnapshotter(t *testing.T) {
+//
+//		// make and configure a mocked types.Snapshotter
+//		mockedSnapshotter := &SnapshotterMock{
+//			CheckProxyFeeAllowanceFunc: func(ctx sdk.Context, granter sdk.AccAddress, proxy sdk.AccAddress)  {
+//				panic("mock out the CheckProxyFeeAllowance method")
+//			},
+//			GetOperatorFunc: func(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
+//				panic("mock out the GetOperator method")
+//			},
+//			GetProxyFunc: func(ctx sdk.Context, operator sdk.ValAddress) (sdk.AccAddress, bool) {
+//				panic("mock out the GetProxy method")
+//			},
+//			GetProxyRoleFunc: func(ctx sdk.Context, proxy sdk.AccAddress) (snapshot.ProxyRole, bool) {
+//				panic("mock out the GetProxyRole method")
+//			},
+//			GetSnapshotFunc: func(ctx sdk.Context, counter int64) (snapshot.Snapshot, bool) {
+//				panic("mock out the GetSnapshot method")
+//			},
+//		}
+//
+//		// use mockedSnapshotter in code that requires types.Snapshotter
+//		// and then make assertions.
+//
+//	}
+type SnapshotterMock struct {
+	// CheckProxyFeeAllowanceFunc mocks the CheckProxyFeeAllowance method.
+	CheckProxyFeeAllowanceFunc func(ctx sdk.Context, granter sdk.AccAddress, proxy sdk.AccAddress)
+
+	// GetOperatorFunc mocks the GetOperator method.
+	GetOperatorFunc func(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress
+
+	// GetProxyFunc mocks the GetProxy method.
+	GetProxyFunc func(ctx sdk.Context, operator sdk.ValAddress) (sdk.AccAddress, bool)
+
+	// GetProxyRoleFunc mocks the GetProxyRole method.
+	GetProxyRoleFunc func(ctx sdk.Context, proxy sdk.AccAddress) (snapshot.ProxyRole, bool)
+
+	// GetSnapshotFunc mocks the GetSnapshot method.
+	GetSnapshotFunc func(ctx sdk.Context, counter int64) (snapshot.Snapshot, bool)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CheckProxyFeeAllowance holds details about calls to the CheckProxyFeeAllowance method.
+		CheckProxyFeeAllowance []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Granter is the granter argument value.
+			Granter sdk.AccAddress
+			// Proxy is the proxy argument value.
+			Proxy sdk.AccAddress
+		}
+		// GetOperator holds details about calls to the GetOperator method.
+		GetOperator []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Proxy is the proxy argument value.
+			Proxy sdk.AccAddress
+		}
+		// GetProxy holds details about calls to the GetProxy method.
+		GetProxy []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Operator is the operator argument value.
+			Operator sdk.ValAddress
+		}
+		// GetProxyRole holds details about calls to the GetProxyRole method.
+		GetProxyRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Proxy is the proxy argument value.
+			Proxy sdk.AccAddress
+		}
+		// GetSnapshot holds details about calls to the GetSnapshot method.
+		GetSnapshot []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Counter is the counter argument value.
+			Counter int64
+		}
+	}
+	lockCheckProxyFeeAllowance sync.RWMutex
+	lockGetOperator            sync.RWMutex
+	lockGetProxy               sync.RWMutex
+	lockGetProxyRole           sync.RWMutex
+	lockGetSnapshot            sync.RWMutex
+}
+
+// CheckProxyFeeAllowance calls CheckProxyFeeAllowanceFunc.
+func (mock *SnapshotterMock) CheckProxyFeeAllowance(ctx sdk.Context, granter sdk.AccAddress, proxy sdk.AccAddress) {
+	if mock.CheckProxyFeeAllowanceFunc == nil {
+		panic("SnapshotterMock.CheckProxyFeeAllowanceFunc: method is nil but Snapshotter.CheckProxyFeeAllowance was just called")
+	}
+	callInfo := struct {
+		Ctx     sdk.Context
+		Granter sdk.AccAddress
+		Proxy   sdk.AccAddress
+	}{
+		Ctx:     ctx,
+		Granter: granter,
+		Proxy:   proxy,
+	}
+	mock.lockCheckProxyFeeAllowance.Lock()
+	mock.calls.CheckProxyFeeAllowance = append(mock.calls.CheckProxyFeeAllowance, callInfo)
+	mock.lockCheckProxyFeeAllowance.Unlock()
+	mock.CheckProxyFeeAllowanceFunc(ctx, granter, proxy)
+}
+
+// CheckProxyFeeAllowanceCalls gets all the calls that were made to CheckProxyFeeAllowance.
+// Check the length with:
+//
+//	len(mockedSnapshotter.CheckProxyFeeAllowanceCalls())
+func (mock *SnapshotterMock) CheckProxyFeeAllowanceCalls() []struct {
+	Ctx     sdk.Context
+	Granter sdk.AccAddress
+	Proxy   sdk.AccAddress
+} {
+	var calls []struct {
+		Ctx     sdk.Context
+		Granter sdk.AccAddress
+		Proxy   sdk.AccAddress
+	}
+	mock.lockCheckProxyFeeAllowance.RLock()
+	calls = mock.calls.CheckProxyFeeAllowance
+	mock.lockCheckProxyFeeAllowance.RUnlock()
+	return calls
+}
+
+// GetOperator calls GetOperatorFunc.
+func (mock *SnapshotterMock) GetOperator(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
+	if mock.GetOperatorFunc == nil {
+		panic("SnapshotterMock.GetOperatorFunc: method is nil but Snapshotter.GetOperator was just called")
+	}
+	callInfo := struct {
+		Ctx   sdk.Context
+		Proxy sdk.AccAddress
+	}{
+		Ctx:   ctx,
+		Proxy: proxy,
+	}
+	mock.lockGetOperator.Lock()
+	mock.calls.GetOperator = append(mock.calls.GetOperator, callInfo)
+	mock.lockGetOperator.Unlock()
+	return mock.GetOperatorFunc(ctx, proxy)
+}
+
+// GetOperatorCalls gets all the calls that were made to GetOperator.
+// Check the length with:
+//
+//	len(mockedSnapshotter.GetOperatorCalls())
+func (mock *SnapshotterMock) GetOperatorCalls() []struct {
+	Ctx   sdk.Context
+	Proxy sdk.AccAddress
+} {
+	var calls []struct {
+		Ctx   sdk.Context
+		Proxy sdk.AccAddress
+	}
+	mock.lockGetOperator.RLock()
+	calls = mock.calls.GetOperator
+	mock.lockGetOperator.RUnlock()
+	return calls
+}
+
+// GetProxy calls GetProxyFunc.
+func (mock *SnapshotterMock) GetProxy(ctx sdk.Context, operator sdk.ValAddress) (sdk.AccAddress, bool) {
+	if mock.GetProxyFunc == nil {
+		panic("SnapshotterMock.GetProxyFunc: method is nil but Snapshotter.GetProxy was just called")
+	}
+	callInfo := struct {
+		Ctx      sdk.Context
+		Operator sdk.ValAddress
+	}{
+		Ctx:      ctx,
+		Operator: operator,
+	}
+	mock.lockGetProxy.Lock()
+	mock.calls.GetProxy = append(mock.calls.GetProxy, callInfo)
+	mock.lockGetProxy.Unlock()
+	return mock.GetProxyFunc(ctx, operator)
+}
+
+// GetProxyCalls gets all the calls that were made to GetProxy.
+// Check the length with:
+//
+//	len(mockedSnapshotter.GetProxyCalls())
+func (mock *SnapshotterMock) GetProxyCalls() []struct {
+	Ctx      sdk.Context
+	Operator sdk.ValAddress
+} {
+	var calls []struct {
+		Ctx      sdk.Context
+		Operator sdk.ValAddress
+	}
+	mock.lockGetProxy.RLock()
+	calls = mock.calls.GetProxy
+	mock.lockGetProxy.RUnlock()
+	return calls
+}
+
+// GetProxyRole calls GetProxyRoleFunc.
+func (mock *SnapshotterMock) GetProxyRole(ctx sdk.Context, proxy sdk.AccAddress) (snapshot.ProxyRole, bool) {
+	if mock.GetProxyRoleFunc == nil {
+		panic("SnapshotterMock.GetProxyRoleFunc: method is nil but Snapshotter.GetProxyRole was just called")
+	}
+	callInfo := struct {
+		Ctx   sdk.Context
+		Proxy sdk.AccAddress
+	}{
+		Ctx:   ctx,
+		Proxy: proxy,
+	}
+	mock.lockGetProxyRole.Lock()
+	mock.calls.GetProxyRole = append(mock.calls.GetProxyRole, callInfo)
+	mock.lockGetProxyRole.Unlock()
+	return mock.GetProxyRoleFunc(ctx, proxy)
+}
+
+// GetProxyRoleCalls gets all the calls that were made to GetProxyRole.
+// Check the length with:
+//
+//	len(mockedSnapshotter.GetProxyRoleCalls())
+func (mock *SnapshotterMock) GetProxyRoleCalls() []struct {
+	Ctx   sdk.Context
+	Proxy sdk.AccAddress
+} {
+	var calls []struct {
+		Ctx   sdk.Context
+		Proxy sdk.AccAddress
+	}
+	mock.lockGetProxyRole.RLock()
+	calls = mock.calls.GetProxyRole
+	mock.lockGetProxyRole.RUnlock()
+	return calls
+}
+
+// GetSnapshot calls GetSnapshotFunc.
+func (mock *SnapshotterMock) GetSnapshot(ctx sdk.Context, counter int64) (snapshot.Snapshot, bool) {
+	if mock.GetSnapshotFunc == nil {
+		panic("SnapshotterMock.GetSnapshotFunc: method is nil but Snapshotter.GetSnapshot was just called")
+	}
+	callInfo := struct {
+		Ctx     sdk.Context
+		Counter int64
+	}{
+		Ctx:     ctx,
+		Counter: counter,
+	}
+	mock.lockGetSnapshot.Lock()
+	mock.calls.GetSnapshot = append(mock.calls.GetSnapshot, callInfo)
+	mock.lockGetSnapshot.Unlock()
+	return mock.GetSnapshotFunc(ctx, counter)
+}
+
+// GetSnapshotCalls gets all the calls that were made to GetSnapshot.
+// Check the length with:
+//
+//	len(mockedSnapshotter.GetSnapshotCalls())
+func (mock *SnapshotterMock) GetSnapshotCalls() []struct {
+	Ctx     sdk.Context
+	Counter int64
+} {
+	var calls []struct {
+		Ctx     sdk.Context
+		Counter int64
+	}
+	mock.lockGetSnapshot.RLock()
+	calls = mock.calls.GetSnapshot
+	mock.lockGetSnapshot.RUnlock()
+	return calls
+}
+
+// Ensure, that StakingMock does implement types.Staking.
+// If this is not the case, regenerate this file with moq.
+var _ types.Staking = &StakingMock{}
+
+// StakingMock is a mock implementation of types.Staking.
+//
+//	func TestSomethingThatUsesStaking(t *testing.T) {
+//
+//		// make and configure a mocked types.Staking
+//		mockedStaking := &StakingMock{
+//			ValidatorFunc: func(ctx sdk.Context, addr sdk.ValAddress) stakingtypes.ValidatorI {
+//				panic("mock out the Validator method")
+//			},
+//		}
+//
+//		// use mockedStaking in code that requires types.Staking
+//		// and then make assertions.
+//
+//	}
+type StakingMock struct {
+	// ValidatorFunc mocks the Validator method.
+	ValidatorFunc func(ctx sdk.Context, addr sdk.ValAddress) stakingtypes.ValidatorI
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Validator holds details about calls to the Validator method.
+		Validator []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Addr is the addr argument value.
+			Addr sdk.ValAddress
+		}
+	}
+	lockValidator sync.RWMutex
+}
+
+// Validator calls ValidatorFunc.
+func (mock *StakingMock) Validator(ctx sdk.Context, addr sdk.ValAddress) stakingtypes.ValidatorI {
+	if mock.ValidatorFunc == nil {
+		panic("StakingMock.ValidatorFunc: method is nil but Staking.Validator was just called")
+	}
+	callInfo := struct {
+		Ctx  sdk.Context
+		Addr sdk.ValAddress
+	}{
+		Ctx:  ctx,
+		Addr: addr,
+	}
+	mock.lockValidator.Lock()
+	mock.calls.Validator = append(mock.calls.Validator, callInfo)
+	mock.lockValidator.Unlock()
+	return mock.ValidatorFunc(ctx, addr)
+}
+
+// ValidatorCalls gets all the calls that were made to Validator.
+// Check the length with:
+//
+//	len(mockedStaking.ValidatorCalls())
+func (mock *StakingMock) ValidatorCalls() []struct {
+	Ctx  sdk.Context
+	Addr sdk.ValAddress
+} {
+	var calls []struct {
+		Ctx  sdk.Context
+		Addr sdk.ValAddress
+	}
+	mock.lockValidator.RLock()
+	calls = mock.calls.Validator
+	mock.lockValidator.RUnlock()
+	return calls
+}
+
+// Ensure, that RewardMock does implement types.Reward.
+// If this is not the case, regenerate this file with moq.
+var _ types.Reward = &RewardMock{}
+
+// RewardMock is a mock implementation of types.Reward.
+//
+//	func TestSomethingThatUsesReward(t *testing.T) {
+//
+//		// make and configure a mocked types.Reward
+//		mockedReward := &RewardMock{
+//			SetPendingRefundFunc: func(ctx sdk.Context, req rewardtypes.RefundMsgRequest, fee sdk.Coin) error {
+//				panic("mock out the SetPendingRefund method")
+//			},
+//		}
+//
+//		// use mockedReward in code that requires types.Reward
+//		// and then make assertions.
+//
+//	}
+type RewardMock struct {
+	// SetPendingRefundFunc mocks the SetPendingRefund method.
+	SetPendingRefundFunc func(ctx sdk.Context, req rewardtypes.RefundMsgRequest, fee sdk.Coin) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// SetPendingRefund holds details about calls to the SetPendingRefund method.
+		SetPendingRefund []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Req is the req argument value.
+			Req rewardtypes.RefundMsgRequest
+			// Fee is the fee argument value.
+			Fee sdk.Coin
+		}
+	}
+	lockSetPendingRefund sync.RWMutex
+}
+
+// SetPendingRefund calls SetPendingRefundFunc.
+func (mock *RewardMock) SetPendingRefund(ctx sdk.Context, req rewardtypes.RefundMsgRequest, fee sdk.Coin) error {
+	if mock.SetPendingRefundFunc == nil {
+		panic("RewardMock.SetPendingRefundFunc: method is nil but Reward.SetPendingRefund was just called")
+	}
+	callInfo := struct {
+		Ctx sdk.Context
+		Req rewardtypes.RefundMsgRequest
+		Fee sdk.Coin
+	}{
+		Ctx: ctx,
+		Req: req,
+		Fee: fee,
+	}
+	mock.lockSetPendingRefund.Lock()
+	mock.calls.SetPendingRefund = append(mock.calls.SetPendingRefund, callInfo)
+	mock.lockSetPendingRefund.Unlock()
+	return mock.SetPendingRefundFunc(ctx, req, fee)
+}
+
+// SetPendingRefundCalls gets all the calls that were made to SetPendingRefund.
+// Check the length with:
+//
+//	len(mockedReward.SetPendingRefundCalls())
+func (mock *RewardMock) SetPendingRefundCalls() []struct {
+	Ctx sdk.Context
+	Req rewardtypes.RefundMsgRequest
+	Fee sdk.Coin
+} {
+	var calls []struct {
+		Ctx sdk.Context
+		Req rewardtypes.RefundMsgRequest
+		Fee sdk.Coin
+	}
+	mock.lockSetPendingRefund.RLock()
+	calls = mock.calls.SetPendingRefund
+	mock.lockSetPendingRefund.RUnlock()
+	return calls
+}
+
+// Ensure, that PermissionMock does implement types.Permission.
+// If this is not the case, regenerate this file with moq.
+var _ types.Permission = &PermissionMock{}
+
+// PermissionMock is a mock implementation of types.Permission.
+//
+//	func TestSomethingThatUsesPermission(t *testing.T) {
+//
+//		// make and configure a mocked types.Permission
+//		mockedPermission := &PermissionMock{
+//			GetRoleFunc: func(ctx sdk.Context, address sdk.AccAddress) permission.Role {
+//				panic("mock out the GetRole method")
+//			},
+//		}
+//
+//		// use mockedPermission in code that requires types.Permission
+//		// and then make assertions.
+//
+//	}
+type PermissionMock struct {
+	// GetRoleFunc mocks the GetRole method.
+	GetRoleFunc func(ctx sdk.Context, address sdk.AccAddress) permission.Role
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetRole holds details about calls to the GetRole method.
+		GetRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Address is the address argument value.
+			Address sdk.AccAddress
+		}
+	}
+	lockGetRole sync.RWMutex
+}
+
+// GetRole calls GetRoleFunc.
+func (mock *PermissionMock) GetRole(ctx sdk.Context, address sdk.AccAddress) permission.Role {
+	if mock.GetRoleFunc == nil {
+		panic("PermissionMock.GetRoleFunc: method is nil but Permission.GetRole was just called")
+	}
+	callInfo := struct {
+		Ctx     sdk.Context
+		Address sdk.AccAddress
+	}{
+		Ctx:     ctx,
+		Address: address,
+	}
+	mock.lockGetRole.Lock()
+	mock.calls.GetRole = append(mock.calls.GetRole, callInfo)
+	mock.lockGetRole.Unlock()
+	return mock.GetRoleFunc(ctx, address)
+}
+
+// GetRoleCalls gets all the calls that were made to GetRole.
+// Check the length with:
+//
+//	len(mockedPermission.GetRoleCalls())
+func (mock *PermissionMock) GetRoleCalls() []struct {
+	Ctx     sdk.Context
+	Address sdk.AccAddress
+} {
+	var calls []struct {
+		Ctx     sdk.Context
+		Address sdk.AccAddress
+	}
+	mock.lockGetRole.RLock()
+	calls = mock.calls.GetRole
+	mock.lockGetRole.RUnlock()
+	return calls
+}
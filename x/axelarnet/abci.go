@@ -1,8 +1,13 @@
 package axelarnet
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/keeper"
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/types"
 )
 
 // BeginBlocker check for infraction evidence or downtime of validators
@@ -10,6 +15,28 @@ import (
 func BeginBlocker(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlocker called every block, process inflation, update validator set.
-func EndBlocker(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func EndBlocker(ctx sdk.Context, _ abci.RequestEndBlock, k keeper.Keeper, t types.IBCTransferKeeper, c types.ChannelKeeper, cl types.ClientKeeper) []abci.ValidatorUpdate {
+	resendRetryingTransfers(ctx, k, t, c, cl)
+
 	return nil
 }
+
+// resendRetryingTransfers resends all IBC transfers whose retry backoff has passed.
+// A transfer that cannot be sent counts as another failed attempt
+func resendRetryingTransfers(ctx sdk.Context, k keeper.Keeper, t types.IBCTransferKeeper, c types.ChannelKeeper, cl types.ClientKeeper) {
+	for {
+		transfer, ok := k.DequeueRetryingIBCTransfer(ctx)
+		if !ok {
+			return
+		}
+
+		transfer.Attempts++
+		if err := keeper.SendIBCTransfer(ctx, k, t, c, cl, transfer, transfer.IBCPath()); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to resend IBC transfer %d: %s", transfer.ID, err.Error()))
+			emitIBCTransferStatusEvent(ctx, k.RetryOrFailIBCTransfer(ctx, transfer))
+			continue
+		}
+
+		k.Logger(ctx).Debug(fmt.Sprintf("resent IBC transfer %d of %s to %s (attempt %d)", transfer.ID, transfer.Token, transfer.Receiver, transfer.Attempts))
+	}
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/keeper"
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCommandFailedIBCTransfers(queryRoute),
+	)
+
+	return queryCmd
+}
+
+// GetCommandFailedIBCTransfers returns the query for all IBC transfers that failed after all retries
+func GetCommandFailedIBCTransfers(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "failed-ibc-transfers",
+		Short: "Returns the IBC transfers that failed after all retries and can be rerouted or refunded",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, _, err := clientCtx.Query(fmt.Sprintf("custom/%s/%s", queryRoute, keeper.QueryFailedIBCTransfers))
+			if err != nil {
+				return sdkerrors.Wrap(err, "couldn't resolve failed IBC transfers")
+			}
+
+			var res types.QueryFailedIBCTransfersResponse
+			types.ModuleCdc.MustUnmarshalLengthPrefixed(bz, &res)
+
+			return clientCtx.PrintProto(&res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
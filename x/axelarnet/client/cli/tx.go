@@ -3,6 +3,7 @@ package cli
 import (
 	"encoding/hex"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -33,6 +34,9 @@ func GetTxCmd() *cobra.Command {
 		GetCmdRegisterAsset(),
 		GetCmdRouteIBCTransfersTx(),
 		GetCmdRegisterFeeCollector(),
+		GetCmdRerouteFailedIBCTransfer(),
+		GetCmdRefundFailedIBCTransfer(),
+		GetCmdSetCosmosChainID(),
 	)
 
 	return axelarTxCmd
@@ -149,9 +153,9 @@ func GetCmdRegisterIBCPathTx() *cobra.Command {
 // GetCmdAddCosmosBasedChain returns the cli command to register a new cosmos based chain in nexus
 func GetCmdAddCosmosBasedChain() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "add-cosmos-based-chain [name] [native asset] [min amount] [address prefix]",
+		Use:   "add-cosmos-based-chain [name] [native asset] [min amount] [address prefix] [chain ID]",
 		Short: "Add a new cosmos based chain",
-		Args:  cobra.ExactArgs(4),
+		Args:  cobra.ExactArgs(5),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -166,8 +170,9 @@ func GetCmdAddCosmosBasedChain() *cobra.Command {
 			}
 
 			addrPrefix := args[3]
+			chainID := args[4]
 
-			msg := types.NewAddCosmosBasedChainRequest(cliCtx.GetFromAddress(), name, nativeAsset, addrPrefix, minAmount)
+			msg := types.NewAddCosmosBasedChainRequest(cliCtx.GetFromAddress(), name, nativeAsset, addrPrefix, chainID, minAmount)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -260,3 +265,93 @@ func GetCmdRegisterFeeCollector() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdRerouteFailedIBCTransfer returns the cli command to resend an IBC transfer that failed after all retries
+func GetCmdRerouteFailedIBCTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reroute-failed-ibc-transfer [transfer ID]",
+		Short: "Resend an IBC transfer that failed after all retries, optionally to another cosmos chain or receiver",
+		Args:  cobra.ExactArgs(1),
+	}
+	chain := cmd.Flags().String("chain", "", "the cosmos chain to send the transfer to instead of the original one")
+	receiver := cmd.Flags().String("receiver", "", "the address to send the transfer to instead of the original one")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		cliCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		id, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		msg := types.NewRerouteFailedIBCTransferRequest(cliCtx.GetFromAddress(), id, *chain, *receiver)
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRefundFailedIBCTransfer returns the cli command to release the tokens of an IBC transfer that failed after all retries
+func GetCmdRefundFailedIBCTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund-failed-ibc-transfer [transfer ID] [recipient]",
+		Short: "Release the tokens of an IBC transfer that failed after all retries to an Axelar account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewRefundFailedIBCTransferRequest(cliCtx.GetFromAddress(), id, recipient)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetCosmosChainID returns the cli command to set the chain ID of a cosmos chain that was added before chain IDs were recorded
+func GetCmdSetCosmosChainID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-cosmos-chain-id [chain] [chain ID]",
+		Short: "Set the chain ID of a cosmos based chain that was added without one",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewSetCosmosChainIDRequest(cliCtx.GetFromAddress(), args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -3,6 +3,7 @@ package rest
 import (
 	"encoding/hex"
 	"net/http"
+	"strconv"
 
 	clientUtils "github.com/axelarnetwork/axelar-core/utils"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -24,6 +25,9 @@ const (
 	TxRegisterAsset           = "register-asset"
 	TxRegisterFeeCollector    = "register-fee-collector"
 	TxRouteIBCTransfers       = "route-ibc-transfers"
+	TxRerouteFailedTransfer   = "reroute-failed-ibc-transfer"
+	TxRefundFailedTransfer    = "refund-failed-ibc-transfer"
+	TxSetCosmosChainID        = "set-cosmos-chain-id"
 )
 
 // ReqLink represents a request to link a cross-chain address to an EVM chain address
@@ -61,6 +65,7 @@ type ReqAddCosmosBasedChain struct {
 	NativeAsset string       `json:"native_asset" yaml:"native_asset"`
 	AddrPrefix  string       `json:"addr_prefix" yaml:"addr_prefix"`
 	MinAmount   string       `json:"min_amount" yaml:"min_amount"`
+	ChainID     string       `json:"chain_id" yaml:"chain_id"`
 }
 
 // ReqRegisterAsset represents a request to register an asset to a cosmos based chain
@@ -82,6 +87,28 @@ type ReqRouteIBCTransfers struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 }
 
+// ReqRerouteFailedIBCTransfer represents a request to resend an IBC transfer that failed after all retries
+type ReqRerouteFailedIBCTransfer struct {
+	BaseReq  rest.BaseReq `json:"base_req" yaml:"base_req"`
+	ID       string       `json:"id" yaml:"id"`
+	Chain    string       `json:"chain" yaml:"chain"`
+	Receiver string       `json:"receiver" yaml:"receiver"`
+}
+
+// ReqRefundFailedIBCTransfer represents a request to release the tokens of an IBC transfer that failed after all retries
+type ReqRefundFailedIBCTransfer struct {
+	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
+	ID        string       `json:"id" yaml:"id"`
+	Recipient string       `json:"recipient" yaml:"recipient"`
+}
+
+// ReqSetCosmosChainID represents a request to set the chain ID of a cosmos chain that was added before chain IDs were recorded
+type ReqSetCosmosChainID struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Chain   string       `json:"chain" yaml:"chain"`
+	ChainID string       `json:"chain_id" yaml:"chain_id"`
+}
+
 // RegisterRoutes registers this module's REST routes with the given router
 func RegisterRoutes(cliCtx client.Context, r *mux.Router) {
 	registerTx := clientUtils.RegisterTxHandlerFn(r, types.RestRoute)
@@ -93,6 +120,9 @@ func RegisterRoutes(cliCtx client.Context, r *mux.Router) {
 	registerTx(TxHandlerRegisterAsset(cliCtx), TxRegisterAsset)
 	registerTx(TxHandlerRegisterFeeCollector(cliCtx), TxRegisterFeeCollector)
 	registerTx(TxHandlerRouteIBCTransfers(cliCtx), TxRouteIBCTransfers)
+	registerTx(TxHandlerRerouteFailedIBCTransfer(cliCtx), TxRerouteFailedTransfer)
+	registerTx(TxHandlerRefundFailedIBCTransfer(cliCtx), TxRefundFailedTransfer)
+	registerTx(TxHandlerSetCosmosChainID(cliCtx), TxSetCosmosChainID)
 }
 
 // TxHandlerLink returns the handler to link an Axelar address to a cross-chain address
@@ -237,7 +267,7 @@ func TxHandlerAddCosmosBasedChain(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewAddCosmosBasedChainRequest(fromAddr, req.Name, req.NativeAsset, req.AddrPrefix, minAmount)
+		msg := types.NewAddCosmosBasedChainRequest(fromAddr, req.Name, req.NativeAsset, req.AddrPrefix, req.ChainID, minAmount)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -337,3 +367,102 @@ func TxHandlerRouteIBCTransfers(cliCtx client.Context) http.HandlerFunc {
 		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
 	}
 }
+
+// TxHandlerRerouteFailedIBCTransfer returns the handler to resend an IBC transfer that failed after all retries
+func TxHandlerRerouteFailedIBCTransfer(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ReqRerouteFailedIBCTransfer
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, ok := clientUtils.ExtractReqSender(w, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		id, err := strconv.ParseUint(req.ID, 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewRerouteFailedIBCTransferRequest(fromAddr, id, req.Chain, req.Receiver)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+// TxHandlerRefundFailedIBCTransfer returns the handler to release the tokens of an IBC transfer that failed after all retries
+func TxHandlerRefundFailedIBCTransfer(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ReqRefundFailedIBCTransfer
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, ok := clientUtils.ExtractReqSender(w, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		id, err := strconv.ParseUint(req.ID, 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewRefundFailedIBCTransferRequest(fromAddr, id, recipient)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+// TxHandlerSetCosmosChainID returns the handler to set the chain ID of a cosmos chain that was added before chain IDs were recorded
+func TxHandlerSetCosmosChainID(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ReqSetCosmosChainID
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, ok := clientUtils.ExtractReqSender(w, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := types.NewSetCosmosChainIDRequest(fromAddr, req.Chain, req.ChainID)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
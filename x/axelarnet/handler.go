@@ -11,8 +11,8 @@ import (
 )
 
 // NewHandler returns the handler of the Cosmos module
-func NewHandler(k types.BaseKeeper, n types.Nexus, b types.BankKeeper, t types.IBCTransferKeeper, c types.ChannelKeeper, a types.AccountKeeper) sdk.Handler {
-	server := keeper.NewMsgServerImpl(k, n, b, t, c, a)
+func NewHandler(k types.BaseKeeper, n types.Nexus, b types.BankKeeper, t types.IBCTransferKeeper, c types.ChannelKeeper, cl types.ClientKeeper, a types.AccountKeeper) sdk.Handler {
+	server := keeper.NewMsgServerImpl(k, n, b, t, c, cl, a)
 	h := func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 		switch msg := msg.(type) {
@@ -69,6 +69,27 @@ func NewHandler(k types.BaseKeeper, n types.Nexus, b types.BankKeeper, t types.I
 			res, err := server.RegisterFeeCollector(sdk.WrapSDKContext(ctx), msg)
 			result, err := sdk.WrapServiceResult(ctx, res, err)
 			return result, err
+		case *types.RerouteFailedIBCTransferRequest:
+			res, err := server.RerouteFailedIBCTransfer(sdk.WrapSDKContext(ctx), msg)
+			result, err := sdk.WrapServiceResult(ctx, res, err)
+			if err == nil {
+				result.Log = fmt.Sprintf("successfully rerouted failed IBC transfer %d", msg.ID)
+			}
+			return result, err
+		case *types.RefundFailedIBCTransferRequest:
+			res, err := server.RefundFailedIBCTransfer(sdk.WrapSDKContext(ctx), msg)
+			result, err := sdk.WrapServiceResult(ctx, res, err)
+			if err == nil {
+				result.Log = fmt.Sprintf("successfully refunded failed IBC transfer %d to %s", msg.ID, msg.Recipient.String())
+			}
+			return result, err
+		case *types.SetCosmosChainIDRequest:
+			res, err := server.SetCosmosChainID(sdk.WrapSDKContext(ctx), msg)
+			result, err := sdk.WrapServiceResult(ctx, res, err)
+			if err == nil {
+				result.Log = fmt.Sprintf("successfully set chain ID %s for chain %s", msg.ChainID, msg.Chain)
+			}
+			return result, err
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
 				fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg))
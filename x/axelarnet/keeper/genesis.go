@@ -18,6 +18,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, nexus types.Nexus, genState *types.
 		k.SetCosmosChain(ctx, types.CosmosChain{
 			Name:       chain.Name,
 			AddrPrefix: chain.AddrPrefix,
+			ChainID:    chain.ChainID,
 		})
 
 		if err := k.RegisterIBCPath(ctx, chain.Name, chain.IBCPath); err != nil {
@@ -34,6 +35,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, nexus types.Nexus, genState *types.
 	for _, transfer := range genState.PendingTransfers {
 		k.SetPendingIBCTransfer(ctx, transfer)
 	}
+
+	k.setTransferNonce(ctx, genState.TransferNonce)
+
+	for _, transfer := range genState.RetryingTransfers {
+		k.setRetryingIBCTransfer(ctx, transfer)
+	}
+
+	for _, transfer := range genState.FailedTransfers {
+		k.setFailedIBCTransfer(ctx, transfer)
+	}
 }
 
 // ExportGenesis returns the reward module's genesis state.
@@ -49,6 +60,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			Assets:     assets,
 			IBCPath:    ibcPath,
 			AddrPrefix: chain.AddrPrefix,
+			ChainID:    chain.ChainID,
 		})
 	}
 
@@ -58,5 +70,13 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 
 	collector, _ := k.GetFeeCollector(ctx)
-	return types.NewGenesisState(k.getParams(ctx), collector, chains, transfers)
+	return types.NewGenesisState(
+		k.getParams(ctx),
+		collector,
+		chains,
+		transfers,
+		k.getRetryingIBCTransfers(ctx),
+		k.GetFailedIBCTransfers(ctx),
+		k.getTransferNonce(ctx),
+	)
 }
@@ -34,7 +34,8 @@ func TestGenesis(t *testing.T) {
 		}).
 		When("the state is initialized from a genesis state",
 			func(t *testing.T) {
-				initialGenesis = types.NewGenesisState(types.DefaultParams(), rand.AccAddr(), randomChains(), randomTransfers())
+				retrying, failed, nonce := randomRetryingAndFailedTransfers()
+				initialGenesis = types.NewGenesisState(types.DefaultParams(), rand.AccAddr(), randomChains(), randomTransfers(), retrying, failed, nonce)
 				assert.NoError(t, initialGenesis.Validate())
 
 				n := &mock.NexusMock{
@@ -55,6 +56,9 @@ func TestGenesis(t *testing.T) {
 				assert.Equal(t, initialGenesis.CollectorAddress, exportedGenesis.CollectorAddress)
 				assert.Equal(t, initialGenesis.Params, exportedGenesis.Params)
 				assert.ElementsMatch(t, initialGenesis.PendingTransfers, exportedGenesis.PendingTransfers)
+				assert.ElementsMatch(t, initialGenesis.RetryingTransfers, exportedGenesis.RetryingTransfers)
+				assert.ElementsMatch(t, initialGenesis.FailedTransfers, exportedGenesis.FailedTransfers)
+				assert.Equal(t, initialGenesis.TransferNonce, exportedGenesis.TransferNonce)
 				assert.Equal(t, len(initialGenesis.Chains), len(exportedGenesis.Chains))
 
 				for i := range initialGenesis.Chains {
@@ -75,6 +79,27 @@ func randomTransfers() []types.IBCTransfer {
 	return transfers
 }
 
+func randomRetryingAndFailedTransfers() ([]types.IBCTransfer, []types.IBCTransfer, uint64) {
+	var retrying, failed []types.IBCTransfer
+	nonce := uint64(rand.I64Between(0, 50))
+	for id := uint64(1); id <= nonce; id++ {
+		transfer := randomIBCTransfer()
+		transfer.ID = id
+		transfer.Attempts = uint64(rand.I64Between(1, 10))
+
+		if rand.Bools(0.5).Next() {
+			transfer.Status = types.TransferRetrying
+			transfer.RetryHeight = rand.I64Between(1, 1000000)
+			retrying = append(retrying, transfer)
+		} else {
+			transfer.Status = types.TransferFailed
+			failed = append(failed, transfer)
+		}
+	}
+
+	return retrying, failed, nonce + uint64(rand.I64Between(0, 10))
+}
+
 func randomIBCTransfer() types.IBCTransfer {
 	denom := rand.Strings(5, 20).WithAlphabet([]rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXY")).Next()
 	return types.IBCTransfer{
@@ -107,5 +132,6 @@ func randomChain() types.CosmosChain {
 		IBCPath:    randomIBCPath(),
 		Assets:     assets,
 		AddrPrefix: rand.StrBetween(5, 10),
+		ChainID:    rand.StrBetween(5, 20),
 	}
 }
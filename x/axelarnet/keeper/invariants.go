@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+)
+
+// RegisterInvariants registers all axelarnet module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper, n types.Nexus, b types.BankKeeper) {
+	ir.RegisterRoute(types.ModuleName, "escrow-balances", EscrowBalanceInvariant(k, n, b))
+}
+
+// EscrowBalanceInvariant checks that the escrow account of every asset locked by axelarnet
+// holds at least the amount pending for transfer plus the amount circulating on chains that are not IBC connected.
+// Assets sent to cosmos chains leave the escrow account through ICS-20, so their totals are not covered
+func EscrowBalanceInvariant(k Keeper, n types.Nexus, b types.BankKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		escrowedDenoms := getEscrowedDenoms(ctx, k)
+
+		var assets []string
+		outstanding := make(map[string]sdk.Int)
+		for asset := range escrowedDenoms {
+			assets = append(assets, asset)
+			outstanding[asset] = sdk.ZeroInt()
+		}
+		sort.Strings(assets)
+
+		cosmosChains := make(map[string]bool)
+		for _, chain := range k.GetCosmosChains(ctx) {
+			cosmosChains[strings.ToLower(chain)] = true
+		}
+
+		for _, chain := range n.GetChains(ctx) {
+			for _, transfer := range n.GetTransfersForChain(ctx, chain, nexus.Pending) {
+				if amount, ok := outstanding[transfer.Asset.Denom]; ok {
+					outstanding[transfer.Asset.Denom] = amount.Add(transfer.Asset.Amount)
+				}
+			}
+
+			if chain.Name == exported.Axelarnet.Name || cosmosChains[strings.ToLower(chain.Name)] {
+				continue
+			}
+
+			for _, asset := range assets {
+				outstanding[asset] = outstanding[asset].Add(n.GetChainTotal(ctx, chain, asset).Amount)
+			}
+		}
+
+		for _, asset := range assets {
+			denom := escrowedDenoms[asset]
+			balance := b.GetBalance(ctx, types.GetEscrowAddress(denom), denom)
+
+			if balance.Amount.LT(outstanding[asset]) {
+				broken = true
+				msg += fmt.Sprintf("\tescrow account of %s holds %s, but %s%s are outstanding\n",
+					asset, balance, outstanding[asset], denom)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "escrow balances", msg), broken
+	}
+}
+
+// getEscrowedDenoms maps every asset axelarnet locks in an escrow account to the denomination it holds the asset in
+func getEscrowedDenoms(ctx sdk.Context, k Keeper) map[string]string {
+	denoms := map[string]string{exported.Axelarnet.NativeAsset: exported.Axelarnet.NativeAsset}
+
+	for _, chain := range k.GetCosmosChains(ctx) {
+		path, ok := k.GetIBCPath(ctx, chain)
+		if !ok {
+			continue
+		}
+
+		for _, asset := range k.getAssets(ctx, chain) {
+			if _, ok := denoms[asset.Denom]; ok {
+				continue
+			}
+
+			// pending transfers of the asset are released from the escrow account of its ICS-20 denomination
+			denomTrace := ibctransfertypes.ParseDenomTrace(fmt.Sprintf("%s/%s", path, asset.Denom))
+			denoms[asset.Denom] = denomTrace.IBCDenom()
+		}
+	}
+
+	return denoms
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	params "github.com/cosmos/cosmos-sdk/x/params/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	appParams "github.com/axelarnetwork/axelar-core/app/params"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
+	axelarnetKeeper "github.com/axelarnetwork/axelar-core/x/axelarnet/keeper"
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/types/mock"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/exported"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+)
+
+func TestEscrowBalanceInvariant(t *testing.T) {
+	var (
+		ctx      sdk.Context
+		keeper   axelarnetKeeper.Keeper
+		nexusK   *mock.NexusMock
+		bankK    *mock.BankKeeperMock
+		balances map[string]sdk.Int
+		ibcDenom string
+	)
+
+	setup := func() {
+		encCfg := appParams.MakeEncodingConfig()
+		axelarnetSubspace := params.NewSubspace(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("axelarnetKey"), sdk.NewKVStoreKey("tAxelarnetKey"), "axelarnet")
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+		keeper = axelarnetKeeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("axelarnet"), axelarnetSubspace)
+
+		cosmosChain := nexus.Chain{Name: rand.StrBetween(5, 20), NativeAsset: "uosmo", SupportsForeignAssets: true, Module: types.ModuleName}
+		path := randomIBCPath()
+		keeper.SetCosmosChain(ctx, types.CosmosChain{Name: cosmosChain.Name, AddrPrefix: "osmo"})
+		assert.NoError(t, keeper.RegisterIBCPath(ctx, cosmosChain.Name, path))
+		assert.NoError(t, keeper.RegisterAssetToCosmosChain(ctx, types.Asset{Denom: "uosmo", MinAmount: sdk.OneInt()}, cosmosChain.Name))
+		ibcDenom = ibctransfertypes.ParseDenomTrace(path + "/uosmo").IBCDenom()
+
+		pending := map[string][]nexus.CrossChainTransfer{
+			exported.Axelarnet.Name: {{Asset: sdk.NewInt64Coin(exported.Axelarnet.NativeAsset, 5)}},
+			evm.Ethereum.Name:       {{Asset: sdk.NewInt64Coin("uosmo", 10)}, {Asset: sdk.NewInt64Coin(exported.Axelarnet.NativeAsset, 5)}},
+		}
+		totals := map[string]sdk.Coins{
+			evm.Ethereum.Name: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 20), sdk.NewInt64Coin(exported.Axelarnet.NativeAsset, 10)),
+			// assets sent to cosmos chains have already left the escrow accounts
+			cosmosChain.Name: sdk.NewCoins(sdk.NewInt64Coin(exported.Axelarnet.NativeAsset, 1000)),
+		}
+
+		nexusK = &mock.NexusMock{
+			GetChainsFunc: func(sdk.Context) []nexus.Chain {
+				return []nexus.Chain{exported.Axelarnet, evm.Ethereum, cosmosChain}
+			},
+			GetTransfersForChainFunc: func(_ sdk.Context, chain nexus.Chain, state nexus.TransferState) []nexus.CrossChainTransfer {
+				assert.Equal(t, nexus.Pending, state)
+				return pending[chain.Name]
+			},
+			GetChainTotalFunc: func(_ sdk.Context, chain nexus.Chain, denom string) sdk.Coin {
+				return sdk.NewCoin(denom, totals[chain.Name].AmountOf(denom))
+			},
+		}
+
+		balances = map[string]sdk.Int{
+			ibcDenom:                       sdk.NewInt(30),
+			exported.Axelarnet.NativeAsset: sdk.NewInt(20),
+		}
+		bankK = &mock.BankKeeperMock{
+			GetBalanceFunc: func(_ sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+				assert.Equal(t, types.GetEscrowAddress(denom), addr)
+				return sdk.NewCoin(denom, balances[denom])
+			},
+		}
+	}
+
+	t.Run("should hold if escrow accounts cover pending transfers and foreign totals", func(t *testing.T) {
+		setup()
+
+		_, broken := axelarnetKeeper.EscrowBalanceInvariant(keeper, nexusK, bankK)(ctx)
+		assert.False(t, broken)
+	})
+
+	t.Run("should break if the escrow account of an ICS-20 asset is short", func(t *testing.T) {
+		setup()
+		balances[ibcDenom] = sdk.NewInt(29)
+
+		msg, broken := axelarnetKeeper.EscrowBalanceInvariant(keeper, nexusK, bankK)(ctx)
+		assert.True(t, broken)
+		assert.Contains(t, msg, "uosmo")
+	})
+
+	t.Run("should break if the escrow account of the native asset is short", func(t *testing.T) {
+		setup()
+		balances[exported.Axelarnet.NativeAsset] = sdk.NewInt(19)
+
+		msg, broken := axelarnetKeeper.EscrowBalanceInvariant(keeper, nexusK, bankK)(ctx)
+		assert.True(t, broken)
+		assert.Contains(t, msg, exported.Axelarnet.NativeAsset)
+	})
+}
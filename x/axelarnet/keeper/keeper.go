@@ -20,8 +20,14 @@ var (
 	chainByAssetPrefix = utils.KeyFromStr("chain_by_asset")
 	assetByChainPrefix = utils.KeyFromStr("asset_by_chain")
 	feeCollector       = utils.KeyFromStr("fee_collector")
+
+	retryingTransferPrefix = utils.KeyFromStr("retrying_transfer")
+	failedTransferPrefix   = utils.KeyFromStr("failed_transfer")
+	transferNonceKey       = utils.KeyFromStr("ibc_transfer_nonce")
 )
 
+const transferRetryQueueName = "ibc_transfer_retry_queue"
+
 // Keeper provides access to all state changes regarding the Axelarnet module
 type Keeper struct {
 	storeKey sdk.StoreKey
@@ -72,6 +78,22 @@ func (k Keeper) GetTransactionFeeRate(ctx sdk.Context) sdk.Dec {
 	return result
 }
 
+// GetRouteMaxAttempts returns the number of times an IBC transfer routed by axelarnet is sent before it is marked as failed
+func (k Keeper) GetRouteMaxAttempts(ctx sdk.Context) uint64 {
+	var result uint64
+	k.params.Get(ctx, types.KeyRouteMaxAttempts, &result)
+
+	return result
+}
+
+// GetRouteRetryBackoff returns the number of blocks to wait before resending a timed out or rejected IBC transfer
+func (k Keeper) GetRouteRetryBackoff(ctx sdk.Context) uint64 {
+	var result uint64
+	k.params.Get(ctx, types.KeyRouteRetryBackoff, &result)
+
+	return result
+}
+
 // RegisterIBCPath registers an IBC path for a cosmos chain
 func (k Keeper) RegisterIBCPath(ctx sdk.Context, chain, path string) error {
 	value, ok := k.getCosmosChain(ctx, chain)
@@ -102,6 +124,17 @@ func (k Keeper) GetIBCPath(ctx sdk.Context, chain string) (string, bool) {
 	return string(bz), true
 }
 
+// GetCosmosChainByIBCPath retrieves the cosmos chain the specified IBC path is registered for
+func (k Keeper) GetCosmosChainByIBCPath(ctx sdk.Context, path string) (types.CosmosChain, bool) {
+	for _, chain := range k.GetCosmosChains(ctx) {
+		if chainPath, ok := k.GetIBCPath(ctx, chain); ok && chainPath == path {
+			return k.GetCosmosChainByName(ctx, chain)
+		}
+	}
+
+	return types.CosmosChain{}, false
+}
+
 // SetPendingIBCTransfer saves a pending IBC transfer routed by axelarnet
 func (k Keeper) SetPendingIBCTransfer(ctx sdk.Context, transfer types.IBCTransfer) {
 	bz := make([]byte, 8)
@@ -145,6 +178,121 @@ func (k Keeper) DeletePendingIBCTransfer(ctx sdk.Context, portID, channelID stri
 	k.getStore(ctx).Delete(key)
 }
 
+// RetryOrFailIBCTransfer schedules the given timed out or rejected IBC transfer to be resent after the retry backoff,
+// or marks it as failed if it has been sent the maximum number of times. It returns the transfer in its new state
+func (k Keeper) RetryOrFailIBCTransfer(ctx sdk.Context, transfer types.IBCTransfer) types.IBCTransfer {
+	if transfer.ID == 0 {
+		transfer.ID = k.nextTransferID(ctx)
+	}
+
+	if transfer.Attempts < k.GetRouteMaxAttempts(ctx) {
+		transfer.Status = types.TransferRetrying
+		transfer.RetryHeight = ctx.BlockHeight() + int64(k.GetRouteRetryBackoff(ctx))
+		k.setRetryingIBCTransfer(ctx, transfer)
+
+		return transfer
+	}
+
+	transfer.Status = types.TransferFailed
+	transfer.RetryHeight = 0
+	k.setFailedIBCTransfer(ctx, transfer)
+
+	return transfer
+}
+
+func (k Keeper) setRetryingIBCTransfer(ctx sdk.Context, transfer types.IBCTransfer) {
+	k.getTransferRetryQueue(ctx, transfer.RetryHeight).Enqueue(retryingTransferPrefix.Append(transferIDKey(transfer.ID)), &transfer)
+}
+
+// DequeueRetryingIBCTransfer pops the next IBC transfer that is due to be resent at the current block height
+func (k Keeper) DequeueRetryingIBCTransfer(ctx sdk.Context) (types.IBCTransfer, bool) {
+	var transfer types.IBCTransfer
+	ok := k.getTransferRetryQueue(ctx, ctx.BlockHeight()).Dequeue(&transfer, func(value codec.ProtoMarshaler) bool {
+		return value.(*types.IBCTransfer).RetryHeight <= ctx.BlockHeight()
+	})
+	if !ok {
+		return types.IBCTransfer{}, false
+	}
+
+	k.getStore(ctx).Delete(retryingTransferPrefix.Append(transferIDKey(transfer.ID)))
+
+	return transfer, true
+}
+
+func (k Keeper) getRetryingIBCTransfers(ctx sdk.Context) []types.IBCTransfer {
+	var transfers []types.IBCTransfer
+	for _, key := range k.getTransferRetryQueue(ctx, ctx.BlockHeight()).Keys() {
+		var transfer types.IBCTransfer
+		k.getStore(ctx).Get(key, &transfer)
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers
+}
+
+func (k Keeper) getTransferRetryQueue(ctx sdk.Context, height int64) utils.BlockHeightKVQueue {
+	return utils.NewBlockHeightKVQueue(transferRetryQueueName, k.getStore(ctx), height, k.Logger(ctx))
+}
+
+func (k Keeper) setFailedIBCTransfer(ctx sdk.Context, transfer types.IBCTransfer) {
+	k.getStore(ctx).Set(failedTransferPrefix.Append(transferIDKey(transfer.ID)), &transfer)
+}
+
+// GetFailedIBCTransfer gets an IBC transfer that failed after all retries
+func (k Keeper) GetFailedIBCTransfer(ctx sdk.Context, id uint64) (types.IBCTransfer, bool) {
+	var transfer types.IBCTransfer
+	return transfer, k.getStore(ctx).Get(failedTransferPrefix.Append(transferIDKey(id)), &transfer)
+}
+
+// GetFailedIBCTransfers gets all IBC transfers that failed after all retries
+func (k Keeper) GetFailedIBCTransfers(ctx sdk.Context) []types.IBCTransfer {
+	iter := k.getStore(ctx).Iterator(failedTransferPrefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	var transfers []types.IBCTransfer
+	for ; iter.Valid(); iter.Next() {
+		var transfer types.IBCTransfer
+		iter.UnmarshalValue(&transfer)
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers
+}
+
+// DeleteFailedIBCTransfer deletes an IBC transfer that failed after all retries
+func (k Keeper) DeleteFailedIBCTransfer(ctx sdk.Context, id uint64) {
+	k.getStore(ctx).Delete(failedTransferPrefix.Append(transferIDKey(id)))
+}
+
+func (k Keeper) nextTransferID(ctx sdk.Context) uint64 {
+	id := k.getTransferNonce(ctx) + 1
+	k.setTransferNonce(ctx, id)
+
+	return id
+}
+
+func (k Keeper) getTransferNonce(ctx sdk.Context) uint64 {
+	bz := k.getStore(ctx).GetRaw(transferNonceKey)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setTransferNonce(ctx sdk.Context, nonce uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, nonce)
+	k.getStore(ctx).SetRaw(transferNonceKey, bz)
+}
+
+func transferIDKey(id uint64) utils.Key {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+
+	return utils.KeyFromBz(bz)
+}
+
 // GetCosmosChainByName gets the address prefix of the given cosmos chain
 func (k Keeper) GetCosmosChainByName(ctx sdk.Context, chain string) (types.CosmosChain, bool) {
 	key := cosmosChainPrefix.Append(utils.LowerCaseKey(chain))
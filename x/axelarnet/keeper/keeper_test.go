@@ -18,6 +18,7 @@ import (
 	"github.com/axelarnetwork/axelar-core/testutils/rand"
 	axelarnetKeeper "github.com/axelarnetwork/axelar-core/x/axelarnet/keeper"
 	"github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/types/mock"
 )
 
 func TestKeeper_GetIBCPath(t *testing.T) {
@@ -61,6 +62,100 @@ func TestKeeper_GetIBCPath(t *testing.T) {
 		assert.Error(t, err2)
 	}).Repeat(repeats))
 
+	t.Run("should return the cosmos chain the given IBC path is registered for", testutils.Func(func(t *testing.T) {
+		setup()
+		path := randomIBCPath()
+		chain := randomChain()
+		chain.Assets = nil
+		chain.IBCPath = ""
+		keeper.SetCosmosChain(ctx, chain)
+		assert.NoError(t, keeper.RegisterIBCPath(ctx, chain.Name, path))
+
+		result, ok := keeper.GetCosmosChainByIBCPath(ctx, path)
+		assert.True(t, ok)
+		assert.Equal(t, chain.Name, result.Name)
+
+		_, ok = keeper.GetCosmosChainByIBCPath(ctx, path+"/"+randomIBCPath())
+		assert.False(t, ok)
+	}).Repeat(repeats))
+
+}
+
+func TestKeeper_RetryOrFailIBCTransfer(t *testing.T) {
+	var (
+		ctx    sdk.Context
+		keeper axelarnetKeeper.Keeper
+		p      types.Params
+	)
+	setup := func() {
+		encCfg := appParams.MakeEncodingConfig()
+		axelarnetSubspace := params.NewSubspace(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("axelarnetKey"), sdk.NewKVStoreKey("tAxelarnetKey"), "axelarnet")
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{Height: rand.I64Between(1, 1000)}, false, log.TestingLogger())
+		keeper = axelarnetKeeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("axelarnet"), axelarnetSubspace)
+
+		genesis := types.DefaultGenesisState()
+		genesis.Params.RouteMaxAttempts = uint64(rand.I64Between(2, 10))
+		genesis.Params.RouteRetryBackoff = uint64(rand.I64Between(1, 100))
+		p = genesis.Params
+		keeper.InitGenesis(ctx, &mock.NexusMock{}, genesis)
+	}
+
+	t.Run("should schedule a retry after the backoff while attempts are left", testutils.Func(func(t *testing.T) {
+		setup()
+		transfer := randomIBCTransfer()
+		transfer.Attempts = 1
+
+		retrying := keeper.RetryOrFailIBCTransfer(ctx, transfer)
+		assert.Equal(t, types.TransferRetrying, retrying.Status)
+		assert.Equal(t, uint64(1), retrying.ID)
+		assert.Equal(t, ctx.BlockHeight()+int64(p.RouteRetryBackoff), retrying.RetryHeight)
+
+		_, ok := keeper.DequeueRetryingIBCTransfer(ctx.WithBlockHeight(retrying.RetryHeight - 1))
+		assert.False(t, ok)
+
+		actual, ok := keeper.DequeueRetryingIBCTransfer(ctx.WithBlockHeight(retrying.RetryHeight))
+		assert.True(t, ok)
+		assert.Equal(t, retrying, actual)
+
+		_, ok = keeper.DequeueRetryingIBCTransfer(ctx.WithBlockHeight(retrying.RetryHeight))
+		assert.False(t, ok)
+		assert.Empty(t, keeper.GetFailedIBCTransfers(ctx))
+	}).Repeat(20))
+
+	t.Run("should mark the transfer as failed once all attempts are used up", testutils.Func(func(t *testing.T) {
+		setup()
+		transfer := randomIBCTransfer()
+		transfer.Attempts = p.RouteMaxAttempts
+
+		failed := keeper.RetryOrFailIBCTransfer(ctx, transfer)
+		assert.Equal(t, types.TransferFailed, failed.Status)
+
+		_, ok := keeper.DequeueRetryingIBCTransfer(ctx.WithBlockHeight(ctx.BlockHeight() + int64(p.RouteRetryBackoff)))
+		assert.False(t, ok)
+
+		actual, ok := keeper.GetFailedIBCTransfer(ctx, failed.ID)
+		assert.True(t, ok)
+		assert.Equal(t, failed, actual)
+		assert.Equal(t, []types.IBCTransfer{failed}, keeper.GetFailedIBCTransfers(ctx))
+
+		keeper.DeleteFailedIBCTransfer(ctx, failed.ID)
+		_, ok = keeper.GetFailedIBCTransfer(ctx, failed.ID)
+		assert.False(t, ok)
+	}).Repeat(20))
+
+	t.Run("should keep the ID of a transfer across attempts", testutils.Func(func(t *testing.T) {
+		setup()
+		transfer := randomIBCTransfer()
+		transfer.Attempts = 1
+
+		first := keeper.RetryOrFailIBCTransfer(ctx, randomIBCTransfer())
+		retrying := keeper.RetryOrFailIBCTransfer(ctx, transfer)
+		assert.NotEqual(t, first.ID, retrying.ID)
+
+		retrying.Attempts = p.RouteMaxAttempts
+		failed := keeper.RetryOrFailIBCTransfer(ctx, retrying)
+		assert.Equal(t, retrying.ID, failed.ID)
+	}).Repeat(20))
 }
 
 func TestKeeper_RegisterCosmosChain(t *testing.T) {
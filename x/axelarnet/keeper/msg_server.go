@@ -4,12 +4,17 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/modules/light-clients/07-tendermint/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -26,17 +31,19 @@ type msgServer struct {
 	bank        types.BankKeeper
 	ibcTransfer types.IBCTransferKeeper
 	ibcChannel  types.ChannelKeeper
+	ibcClient   types.ClientKeeper
 	account     types.AccountKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the axelarnet MsgServiceServer interface for the provided Keeper.
-func NewMsgServerImpl(k types.BaseKeeper, n types.Nexus, b types.BankKeeper, t types.IBCTransferKeeper, c types.ChannelKeeper, a types.AccountKeeper) types.MsgServiceServer {
+func NewMsgServerImpl(k types.BaseKeeper, n types.Nexus, b types.BankKeeper, t types.IBCTransferKeeper, c types.ChannelKeeper, cl types.ClientKeeper, a types.AccountKeeper) types.MsgServiceServer {
 	return msgServer{
 		BaseKeeper:  k,
 		nexus:       n,
 		bank:        b,
 		ibcTransfer: t,
 		ibcChannel:  c,
+		ibcClient:   cl,
 		account:     a,
 	}
 }
@@ -88,7 +95,7 @@ func (s msgServer) ConfirmDeposit(c context.Context, req *types.ConfirmDepositRe
 	// check if the format of token denomination is 'ibc/{hash}'
 	case isIBCDenom(req.Token.Denom):
 		// get base denomination and tracing path
-		denomTrace, err := s.parseIBCDenom(ctx, req.Token.Denom)
+		denomTrace, err := parseIBCDenom(ctx, s.ibcTransfer, req.Token.Denom)
 		if err != nil {
 			return nil, err
 		}
@@ -100,7 +107,7 @@ func (s msgServer) ConfirmDeposit(c context.Context, req *types.ConfirmDepositRe
 		}
 		path, ok := s.BaseKeeper.GetIBCPath(ctx, chain.Name)
 		if !ok {
-			return nil, fmt.Errorf("path not found for chain %s", chain)
+			return nil, fmt.Errorf("path not found for chain %s", chain.Name)
 		}
 		if path != denomTrace.Path {
 			return nil, fmt.Errorf("path %s does not match registered path %s for asset %s", denomTrace.GetPath(), path, denomTrace.GetBaseDenom())
@@ -238,6 +245,31 @@ func (s msgServer) ExecutePendingTransfers(c context.Context, req *types.Execute
 // RegisterIBCPath handles register an IBC path for a chain
 func (s msgServer) RegisterIBCPath(c context.Context, req *types.RegisterIBCPathRequest) (*types.RegisterIBCPathResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+
+	chain, ok := s.BaseKeeper.GetCosmosChainByName(ctx, req.Chain)
+	if !ok {
+		return nil, fmt.Errorf("unknown cosmos chain %s", req.Chain)
+	}
+
+	chainID, err := ValidateIBCPath(ctx, s.ibcChannel, s.ibcClient, req.Path)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "invalid path %s for chain %s", req.Path, req.Chain)
+	}
+
+	// a multi-hop path must pass through registered chains, starting with the directly connected one
+	expected := chain
+	intermediates, err := getIntermediateChains(ctx, s.BaseKeeper, req.Path)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "invalid path %s for chain %s", req.Path, req.Chain)
+	}
+	if len(intermediates) > 0 {
+		expected = intermediates[0]
+	}
+
+	if chainID != expected.ChainID {
+		return nil, fmt.Errorf("path %s leads to chain ID %s, but chain %s has chain ID %s", req.Path, chainID, expected.Name, expected.ChainID)
+	}
+
 	if err := s.BaseKeeper.RegisterIBCPath(ctx, req.Chain, req.Path); err != nil {
 		return nil, err
 	}
@@ -259,14 +291,40 @@ func (s msgServer) AddCosmosBasedChain(c context.Context, req *types.AddCosmosBa
 	s.BaseKeeper.SetCosmosChain(ctx, types.CosmosChain{
 		Name:       req.Chain.Name,
 		AddrPrefix: req.AddrPrefix,
+		ChainID:    req.ChainID,
 	})
-	if err := s.BaseKeeper.RegisterAssetToCosmosChain(ctx, types.Asset{Denom: req.Chain.NativeAsset, MinAmount: req.MinAmount}, req.Chain.Name); err != nil {
+	asset := withDenomMetadata(ctx, s.BaseKeeper, s.bank, req.Chain.Name, types.Asset{Denom: req.Chain.NativeAsset, MinAmount: req.MinAmount})
+	if err := s.BaseKeeper.RegisterAssetToCosmosChain(ctx, asset, req.Chain.Name); err != nil {
+		return &types.AddCosmosBasedChainResponse{}, err
+	}
+
+	if err := registerAssetPrecision(ctx, s.nexus, req.Chain, asset, true); err != nil {
 		return &types.AddCosmosBasedChainResponse{}, err
 	}
 
 	return &types.AddCosmosBasedChainResponse{}, nil
 }
 
+// SetCosmosChainID handles setting the chain ID of a cosmos based chain that was added before chain IDs were recorded
+func (s msgServer) SetCosmosChainID(c context.Context, req *types.SetCosmosChainIDRequest) (*types.SetCosmosChainIDResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chain, ok := s.BaseKeeper.GetCosmosChainByName(ctx, req.Chain)
+	if !ok {
+		return nil, fmt.Errorf("unknown cosmos chain %s", req.Chain)
+	}
+
+	// IBC paths are verified against the chain ID, so it must not change once it is known
+	if chain.ChainID != "" {
+		return nil, fmt.Errorf("chain %s already has chain ID %s", chain.Name, chain.ChainID)
+	}
+
+	chain.ChainID = req.ChainID
+	s.BaseKeeper.SetCosmosChain(ctx, chain)
+
+	return &types.SetCosmosChainIDResponse{}, nil
+}
+
 // RegisterAsset handles register an asset to a cosmos based chain
 func (s msgServer) RegisterAsset(c context.Context, req *types.RegisterAssetRequest) (*types.RegisterAssetResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -276,13 +334,55 @@ func (s msgServer) RegisterAsset(c context.Context, req *types.RegisterAssetRequ
 		return &types.RegisterAssetResponse{}, fmt.Errorf("chain '%s' not found", req.Chain)
 	}
 
+	// the cosmos chain is the origin of the asset if no other chain carries it yet
+	isOrigin := true
+	for _, c := range s.nexus.GetChainsWithAsset(ctx, req.Asset.Denom) {
+		if c.Name != chain.Name && c.Name != exported.Axelarnet.Name {
+			isOrigin = false
+		}
+	}
+
 	s.nexus.RegisterAsset(ctx, chain, req.Asset.Denom)
 	s.nexus.RegisterAsset(ctx, exported.Axelarnet, req.Asset.Denom)
-	s.BaseKeeper.RegisterAssetToCosmosChain(ctx, req.Asset, req.Chain)
+	asset := withDenomMetadata(ctx, s.BaseKeeper, s.bank, req.Chain, req.Asset)
+	s.BaseKeeper.RegisterAssetToCosmosChain(ctx, asset, req.Chain)
+
+	if err := registerAssetPrecision(ctx, s.nexus, chain, asset, isOrigin); err != nil {
+		return &types.RegisterAssetResponse{}, err
+	}
 
 	return &types.RegisterAssetResponse{}, nil
 }
 
+// registerAssetPrecision registers the decimals of the asset on the given cosmos chain and on axelarnet.
+// Only the origin chain of the asset defines the precision nexus accounts for it with,
+// and assets keep that precision when they are transferred between axelarnet and cosmos chains.
+// Assets without a precision are transferred without conversion
+func registerAssetPrecision(ctx sdk.Context, n types.Nexus, chain nexus.Chain, asset types.Asset, isOrigin bool) error {
+	precision, ok := n.GetAssetPrecision(ctx, asset.Denom)
+	switch {
+	case ok:
+		if asset.Decimals != 0 && asset.Decimals != precision {
+			return fmt.Errorf("asset %s has %d decimals on chain %s, but a precision of %d decimals", asset.Denom, asset.Decimals, chain.Name, precision)
+		}
+	case isOrigin && asset.Decimals != 0:
+		if err := n.SetAssetPrecision(ctx, asset.Denom, asset.Decimals); err != nil {
+			return err
+		}
+		precision = asset.Decimals
+	default:
+		return nil
+	}
+
+	for _, c := range []nexus.Chain{chain, exported.Axelarnet} {
+		if err := n.RegisterAssetDecimals(ctx, c, asset.Denom, precision); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // RouteIBCTransfers routes Transfer to cosmos chains
 func (s msgServer) RouteIBCTransfers(c context.Context, req *types.RouteIBCTransfersRequest) (*types.RouteIBCTransfersResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -301,6 +401,12 @@ func (s msgServer) RouteIBCTransfers(c context.Context, req *types.RouteIBCTrans
 			continue
 		}
 
+		// keep the transfers pending in nexus until the path can be used again
+		if _, err := ValidateIBCPath(ctx, s.ibcChannel, s.ibcClient, path); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("cannot route transfers to %s: %s", chain.Name, err.Error()))
+			continue
+		}
+
 		pendingTransfers := s.nexus.GetTransfersForChain(ctx, chain, nexus.Pending)
 		for _, p := range pendingTransfers {
 			token, sender, err := prepareTransfer(ctx, s.BaseKeeper, s.nexus, s.bank, s.account, p)
@@ -309,7 +415,7 @@ func (s msgServer) RouteIBCTransfers(c context.Context, req *types.RouteIBCTrans
 				continue
 			}
 
-			err = IBCTransfer(ctx, s.BaseKeeper, s.ibcTransfer, s.ibcChannel, token, sender, p.Recipient.Address, path)
+			err = IBCTransfer(ctx, s.BaseKeeper, s.ibcTransfer, s.ibcChannel, s.ibcClient, token, sender, p.Recipient.Address, path)
 			if err != nil {
 				ctx.Logger().Error(fmt.Sprintf("failed to send IBC transfer %s for %s:  %e", token, p.Recipient.Address, err))
 				continue
@@ -323,35 +429,237 @@ func (s msgServer) RouteIBCTransfers(c context.Context, req *types.RouteIBCTrans
 }
 
 // IBCTransfer inits an IBC transfer
-func IBCTransfer(ctx sdk.Context, k types.BaseKeeper, t types.IBCTransferKeeper, c types.ChannelKeeper, token sdk.Coin, sender sdk.AccAddress, receiver string, path string) error {
-	// split path to portID and channelID
-	pathSplit := strings.SplitN(path, "/", 2)
-	if len(pathSplit) != 2 {
-		return fmt.Errorf("invalid path %s", path)
-	}
-	portID, channelID := pathSplit[0], pathSplit[1]
-	_, state, err := c.GetChannelClientState(ctx, portID, channelID)
+func IBCTransfer(ctx sdk.Context, k types.BaseKeeper, t types.IBCTransferKeeper, c types.ChannelKeeper, cl types.ClientKeeper, token sdk.Coin, sender sdk.AccAddress, receiver string, path string) error {
+	return SendIBCTransfer(ctx, k, t, c, cl, types.IBCTransfer{
+		Sender:   sender,
+		Receiver: receiver,
+		Token:    token,
+		Attempts: 1,
+	}, path)
+}
+
+// SendIBCTransfer sends the given transfer through the given IBC path and keeps track of it until it is acknowledged
+func SendIBCTransfer(ctx sdk.Context, k types.BaseKeeper, t types.IBCTransferKeeper, c types.ChannelKeeper, cl types.ClientKeeper, transfer types.IBCTransfer, path string) error {
+	portID, channelID, forwardPath, err := splitIBCPath(path)
+	if err != nil {
+		return err
+	}
+
+	clientID, state, err := c.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	// refuse to route over expired or frozen clients
+	if err := validateClientStatus(ctx, cl, clientID); err != nil {
+		return err
+	}
+
+	// vouchers must leave along the hops they arrived by, so they are unwound instead of being wrapped again
+	if err := validateUnwindingPath(ctx, t, transfer.Token, path); err != nil {
+		return err
+	}
+
+	// destinations that are not directly connected are reached through the packet forwarding of the chains in between
+	receiver, err := getForwardReceiver(ctx, k, transfer.Receiver, path)
 	if err != nil {
 		return err
 	}
 
 	height := clienttypes.NewHeight(state.GetLatestHeight().GetRevisionNumber(), state.GetLatestHeight().GetRevisionHeight()+k.GetRouteTimeoutWindow(ctx))
-	err = t.SendTransfer(ctx, portID, channelID, token, sender, receiver, height, 0)
+	err = t.SendTransfer(ctx, portID, channelID, transfer.Token, transfer.Sender, receiver, height, 0)
 	if err == nil {
 		// SendTransfer would return error if the next sequence not found
 		seq, _ := c.GetNextSequenceSend(ctx, portID, channelID)
-		k.SetPendingIBCTransfer(ctx, types.IBCTransfer{
-			Sender:    sender,
-			Receiver:  receiver,
-			Token:     token,
-			PortID:    portID,
-			ChannelID: channelID,
-			Sequence:  seq - 1,
-		})
+		transfer.PortID = portID
+		transfer.ChannelID = channelID
+		transfer.ForwardPath = forwardPath
+		transfer.Sequence = seq - 1
+		transfer.RetryHeight = 0
+		transfer.Status = types.TransferPending
+		k.SetPendingIBCTransfer(ctx, transfer)
 	}
 	return err
 }
 
+// ValidateIBCPath checks that the first hop of the given path refers to an open channel whose light client is active,
+// and returns the chain ID of the counterparty chain
+func ValidateIBCPath(ctx sdk.Context, c types.ChannelKeeper, cl types.ClientKeeper, path string) (string, error) {
+	portID, channelID, _, err := splitIBCPath(path)
+	if err != nil {
+		return "", err
+	}
+
+	channel, ok := c.GetChannel(ctx, portID, channelID)
+	if !ok {
+		return "", fmt.Errorf("channel %s not found", path)
+	}
+
+	if channel.State != channeltypes.OPEN {
+		return "", fmt.Errorf("channel %s is in state %s", path, channel.State)
+	}
+
+	clientID, state, err := c.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return "", err
+	}
+
+	if err := validateClientStatus(ctx, cl, clientID); err != nil {
+		return "", err
+	}
+
+	tmState, ok := state.(*ibctmtypes.ClientState)
+	if !ok {
+		return "", fmt.Errorf("client %s of type %s does not track a cosmos chain", clientID, state.ClientType())
+	}
+
+	return tmState.ChainId, nil
+}
+
+func validateClientStatus(ctx sdk.Context, cl types.ClientKeeper, clientID string) error {
+	resp, err := cl.ClientStatus(sdk.WrapSDKContext(ctx), &clienttypes.QueryClientStatusRequest{ClientId: clientID})
+	if err != nil {
+		return err
+	}
+
+	if resp.Status != ibcexported.Active.String() {
+		return fmt.Errorf("client %s is %s", clientID, resp.Status)
+	}
+
+	return nil
+}
+
+// splitIBCPath returns the port ID and channel ID of the first hop of the given path,
+// and the remaining hops the transfer is forwarded along
+func splitIBCPath(path string) (string, string, string, error) {
+	hops, err := types.SplitIBCPath(path)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	ids := strings.SplitN(hops[0], "/", 2)
+	return ids[0], ids[1], strings.Join(hops[1:], "/"), nil
+}
+
+// getIntermediateChains returns the cosmos chains a transfer along the given path passes through before reaching its destination.
+// Each of them must be registered with the path that leads to it, so tokens can be forwarded to their addresses
+func getIntermediateChains(ctx sdk.Context, k types.BaseKeeper, path string) ([]types.CosmosChain, error) {
+	hops, err := types.SplitIBCPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var chains []types.CosmosChain
+	for i := 1; i < len(hops); i++ {
+		prefix := strings.Join(hops[:i], "/")
+		chain, ok := k.GetCosmosChainByIBCPath(ctx, prefix)
+		if !ok {
+			return nil, fmt.Errorf("no cosmos chain registered for intermediate path %s", prefix)
+		}
+
+		chains = append(chains, chain)
+	}
+
+	return chains, nil
+}
+
+// validateUnwindingPath checks that an ICS-20 voucher sent along the given path is unwound along its denom trace.
+// The path must either lead back towards the source chain of the voucher or pass through it,
+// otherwise the voucher would be wrapped again by every chain it reaches
+func validateUnwindingPath(ctx sdk.Context, t types.IBCTransferKeeper, token sdk.Coin, path string) error {
+	// tokens that originate from Axelar are escrowed and wrapped once by the first chain they reach
+	if !isIBCDenom(token.Denom) {
+		return nil
+	}
+
+	denomTrace, err := parseIBCDenom(ctx, t, token.Denom)
+	if err != nil {
+		return err
+	}
+
+	if denomTrace.Path == "" {
+		return nil
+	}
+
+	traceHops, err := types.SplitIBCPath(denomTrace.Path)
+	if err != nil {
+		return err
+	}
+
+	pathHops, err := types.SplitIBCPath(path)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < len(traceHops) && i < len(pathHops); i++ {
+		if traceHops[i] != pathHops[i] {
+			return fmt.Errorf("token %s must be unwound along %s, path %s would wrap it again", denomTrace.GetFullDenomPath(), denomTrace.Path, path)
+		}
+	}
+
+	return nil
+}
+
+// getForwardReceiver returns the receiver that makes the intermediate chains of the given path forward a transfer to the final receiver.
+// The tokens pass through the account of the final receiver on each intermediate chain,
+// so the receiver can recover them with the same key should the forwarding fail
+func getForwardReceiver(ctx sdk.Context, k types.BaseKeeper, receiver string, path string) (string, error) {
+	hops, err := types.SplitIBCPath(path)
+	if err != nil {
+		return "", err
+	}
+
+	chains, err := getIntermediateChains(ctx, k, path)
+	if err != nil {
+		return "", err
+	}
+
+	if len(chains) == 0 {
+		return receiver, nil
+	}
+
+	_, receiverBz, err := bech32.DecodeAndConvert(receiver)
+	if err != nil {
+		return "", sdkerrors.Wrapf(err, "cannot derive the intermediate receivers of %s", receiver)
+	}
+
+	for i := len(chains) - 1; i >= 0; i-- {
+		intermediate, err := sdk.Bech32ifyAddressBytes(chains[i].AddrPrefix, receiverBz)
+		if err != nil {
+			return "", err
+		}
+
+		receiver = types.NewForwardReceiver(intermediate, hops[i+1], receiver)
+	}
+
+	return receiver, nil
+}
+
+// withDenomMetadata fills in the display metadata of the given asset from the bank metadata of the denomination
+// Axelar holds it in. Values given with the asset are kept if the bank has no metadata for it
+func withDenomMetadata(ctx sdk.Context, k types.BaseKeeper, b types.BankKeeper, chain string, asset types.Asset) types.Asset {
+	denom := asset.Denom
+	if path, ok := k.GetIBCPath(ctx, chain); ok {
+		denom = ibctransfertypes.ParseDenomTrace(fmt.Sprintf("%s/%s", path, asset.Denom)).IBCDenom()
+	}
+
+	if metadata, ok := b.GetDenomMetaData(ctx, denom); ok && metadata.Display != "" {
+		asset.DisplayDenom = metadata.Display
+		asset.Decimals = 0
+		for _, unit := range metadata.DenomUnits {
+			if unit.Denom == metadata.Display {
+				asset.Decimals = unit.Exponent
+			}
+		}
+	}
+
+	if asset.DisplayDenom == "" {
+		asset.DisplayDenom = asset.Denom
+	}
+
+	return asset
+}
+
 // RegisterFeeCollector handles register axelar fee collector account
 func (s msgServer) RegisterFeeCollector(c context.Context, req *types.RegisterFeeCollectorRequest) (*types.RegisterFeeCollectorResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -363,6 +671,76 @@ func (s msgServer) RegisterFeeCollector(c context.Context, req *types.RegisterFe
 	return &types.RegisterFeeCollectorResponse{}, nil
 }
 
+// RerouteFailedIBCTransfer handles resending an IBC transfer that failed after all retries
+func (s msgServer) RerouteFailedIBCTransfer(c context.Context, req *types.RerouteFailedIBCTransferRequest) (*types.RerouteFailedIBCTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	transfer, ok := s.GetFailedIBCTransfer(ctx, req.ID)
+	if !ok {
+		return nil, fmt.Errorf("no failed IBC transfer with ID %d", req.ID)
+	}
+
+	path := transfer.IBCPath()
+	if req.Chain != "" {
+		path, ok = s.GetIBCPath(ctx, req.Chain)
+		if !ok {
+			return nil, fmt.Errorf("no IBC path registered for chain %s", req.Chain)
+		}
+	}
+
+	if req.Receiver != "" {
+		transfer.Receiver = req.Receiver
+	}
+
+	s.DeleteFailedIBCTransfer(ctx, transfer.ID)
+	transfer.Attempts = 1
+	// an ICS-20 voucher is refused if the new path would wrap it again instead of unwinding it
+	if err := SendIBCTransfer(ctx, s.BaseKeeper, s.ibcTransfer, s.ibcChannel, s.ibcClient, transfer, path); err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to reroute IBC transfer %d", transfer.ID)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeIBCTransfer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueReroute),
+			sdk.NewAttribute(types.AttributeKeyTransferID, strconv.FormatUint(transfer.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyDestinationAddress, transfer.Receiver),
+			sdk.NewAttribute(types.AttributeKeySourceChannel, path),
+		))
+
+	s.Logger(ctx).Info(fmt.Sprintf("rerouted failed IBC transfer %d of %s to %s through %s", transfer.ID, transfer.Token, transfer.Receiver, path))
+
+	return &types.RerouteFailedIBCTransferResponse{}, nil
+}
+
+// RefundFailedIBCTransfer handles releasing the tokens of an IBC transfer that failed after all retries to an account on Axelar
+func (s msgServer) RefundFailedIBCTransfer(c context.Context, req *types.RefundFailedIBCTransferRequest) (*types.RefundFailedIBCTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	transfer, ok := s.GetFailedIBCTransfer(ctx, req.ID)
+	if !ok {
+		return nil, fmt.Errorf("no failed IBC transfer with ID %d", req.ID)
+	}
+
+	if err := s.bank.SendCoins(ctx, transfer.Sender, req.Recipient, sdk.NewCoins(transfer.Token)); err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to refund IBC transfer %d", transfer.ID)
+	}
+	s.DeleteFailedIBCTransfer(ctx, transfer.ID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeIBCTransfer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueRefund),
+			sdk.NewAttribute(types.AttributeKeyTransferID, strconv.FormatUint(transfer.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, req.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, transfer.Token.String()),
+		))
+
+	s.Logger(ctx).Info(fmt.Sprintf("refunded failed IBC transfer %d of %s to %s", transfer.ID, transfer.Token, req.Recipient))
+
+	return &types.RefundFailedIBCTransferResponse{}, nil
+}
+
 // isIBCDenom validates that the given denomination is a valid ICS token representation (ibc/{hash})
 func isIBCDenom(denom string) bool {
 	if err := sdk.ValidateDenom(denom); err != nil {
@@ -381,14 +759,14 @@ func isIBCDenom(denom string) bool {
 }
 
 // parseIBCDenom retrieves the full identifiers trace and base denomination from the IBC transfer keeper store
-func (s msgServer) parseIBCDenom(ctx sdk.Context, ibcDenom string) (ibctransfertypes.DenomTrace, error) {
+func parseIBCDenom(ctx sdk.Context, t types.IBCTransferKeeper, ibcDenom string) (ibctransfertypes.DenomTrace, error) {
 	denomSplit := strings.Split(ibcDenom, "/")
 
 	hash, err := ibctransfertypes.ParseHexHash(denomSplit[1])
 	if err != nil {
 		return ibctransfertypes.DenomTrace{}, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid denom trace hash %s, %s", hash, err))
 	}
-	denomTrace, found := s.ibcTransfer.GetDenomTrace(ctx, hash)
+	denomTrace, found := t.GetDenomTrace(ctx, hash)
 	if !found {
 		return ibctransfertypes.DenomTrace{}, status.Error(
 			codes.NotFound,
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"crypto/sha256"
 	"fmt"
 	mathRand "math/rand"
@@ -9,12 +10,14 @@ import (
 
 	ibctypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
 	ibcclient "github.com/cosmos/ibc-go/modules/core/exported"
 	ibctmtypes "github.com/cosmos/ibc-go/modules/light-clients/07-tendermint/types"
 	ibctesting "github.com/cosmos/ibc-go/testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/assert"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
@@ -57,7 +60,7 @@ func TestHandleMsgLink(t *testing.T) {
 		}
 
 		ctx = rand.Context(nil)
-		server = keeper.NewMsgServerImpl(&mock.BaseKeeperMock{}, nexusKeeper, &mock.BankKeeperMock{}, &mock.IBCTransferKeeperMock{}, &mock.ChannelKeeperMock{}, &mock.AccountKeeperMock{})
+		server = keeper.NewMsgServerImpl(&mock.BaseKeeperMock{}, nexusKeeper, &mock.BankKeeperMock{}, &mock.IBCTransferKeeperMock{}, &mock.ChannelKeeperMock{}, &mock.ClientKeeperMock{}, &mock.AccountKeeperMock{})
 	}
 
 	repeatCount := 20
@@ -135,7 +138,7 @@ func TestHandleMsgConfirmDeposit(t *testing.T) {
 			},
 		}
 		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
-		server = keeper.NewMsgServerImpl(axelarnetKeeper, nexusKeeper, bankKeeper, transferKeeper, &mock.ChannelKeeperMock{}, &mock.AccountKeeperMock{})
+		server = keeper.NewMsgServerImpl(axelarnetKeeper, nexusKeeper, bankKeeper, transferKeeper, &mock.ChannelKeeperMock{}, &mock.ClientKeeperMock{}, &mock.AccountKeeperMock{})
 	}
 
 	repeatCount := 20
@@ -199,6 +202,23 @@ func TestHandleMsgConfirmDeposit(t *testing.T) {
 		assert.Equal(t, msg.Token.Amount, nexusKeeper.EnqueueForTransferCalls()[0].Amount.Amount)
 	}).Repeat(repeatCount))
 
+	t.Run("should unwind ICS20 tokens that reached Axelar through several hops to their source chain", testutils.Func(func(t *testing.T) {
+		setup()
+		multiHopPath := fmt.Sprintf("%s/%s", randomIBCPath(), randomIBCPath())
+		baseDenom := randomDenom()
+		nexusKeeper.IsAssetRegisteredFunc = func(sdk.Context, nexus.Chain, string) bool { return false }
+		axelarnetKeeper.GetIBCPathFunc = func(sdk.Context, string) (string, bool) { return multiHopPath, true }
+		transferKeeper.GetDenomTraceFunc = func(sdk.Context, tmbytes.HexBytes) (ibctypes.DenomTrace, bool) {
+			return ibctypes.DenomTrace{Path: multiHopPath, BaseDenom: baseDenom}, true
+		}
+		msg = randomMsgConfirmDeposit()
+		msg.Token.Denom = randomIBCDenom()
+		_, err := server.ConfirmDeposit(sdk.WrapSDKContext(ctx), msg)
+		assert.NoError(t, err)
+		assert.Len(t, nexusKeeper.EnqueueForTransferCalls(), 1)
+		assert.Equal(t, baseDenom, nexusKeeper.EnqueueForTransferCalls()[0].Amount.Denom)
+	}).Repeat(repeatCount))
+
 	t.Run("should return error when ICS20 token hash not found in IBCTransferKeeper", testutils.Func(func(t *testing.T) {
 		setup()
 		nexusKeeper.IsAssetRegisteredFunc = func(sdk.Context, nexus.Chain, string) bool { return false }
@@ -332,7 +352,7 @@ func TestHandleMsgExecutePendingTransfers(t *testing.T) {
 			GetModuleAddressFunc: func(string) sdk.AccAddress { return rand.AccAddr() },
 		}
 		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
-		server = keeper.NewMsgServerImpl(axelarnetKeeper, nexusKeeper, bankKeeper, &mock.IBCTransferKeeperMock{}, &mock.ChannelKeeperMock{}, accountKeeper)
+		server = keeper.NewMsgServerImpl(axelarnetKeeper, nexusKeeper, bankKeeper, &mock.IBCTransferKeeperMock{}, &mock.ChannelKeeperMock{}, &mock.ClientKeeperMock{}, accountKeeper)
 	}
 
 	repeatCount := 20
@@ -418,22 +438,34 @@ func TestHandleMsgRegisterIBCPath(t *testing.T) {
 	var (
 		server          types.MsgServiceServer
 		axelarnetKeeper *mock.BaseKeeperMock
+		channelKeeper   *mock.ChannelKeeperMock
+		clientKeeper    *mock.ClientKeeperMock
 		ctx             sdk.Context
 		msg             *types.RegisterIBCPathRequest
 	)
 	setup := func() {
+		msg = randomMsgRegisterIBCPath()
 		axelarnetKeeper = &mock.BaseKeeperMock{
+			GetCosmosChainByNameFunc: func(_ sdk.Context, chain string) (types.CosmosChain, bool) {
+				return types.CosmosChain{Name: chain, AddrPrefix: rand.Str(5), ChainID: clientState().ChainId}, chain == msg.Chain
+			},
 			RegisterIBCPathFunc: func(sdk.Context, string, string) error { return nil },
 		}
+		channelKeeper = &mock.ChannelKeeperMock{
+			GetChannelFunc: func(sdk.Context, string, string) (channeltypes.Channel, bool) { return openChannel(), true },
+			GetChannelClientStateFunc: func(sdk.Context, string, string) (string, ibcclient.ClientState, error) {
+				return "07-tendermint-0", clientState(), nil
+			},
+		}
+		clientKeeper = activeClientKeeper()
 		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
 
-		server = keeper.NewMsgServerImpl(axelarnetKeeper, &mock.NexusMock{}, &mock.BankKeeperMock{}, &mock.IBCTransferKeeperMock{}, &mock.ChannelKeeperMock{}, &mock.AccountKeeperMock{})
+		server = keeper.NewMsgServerImpl(axelarnetKeeper, &mock.NexusMock{}, &mock.BankKeeperMock{}, &mock.IBCTransferKeeperMock{}, channelKeeper, clientKeeper, &mock.AccountKeeperMock{})
 	}
 
 	repeatCount := 20
 	t.Run("should register an IBC tracing path for an chain if not registered yet", testutils.Func(func(t *testing.T) {
 		setup()
-		msg = randomMsgRegisterIBCPath()
 		_, err := server.RegisterIBCPath(sdk.WrapSDKContext(ctx), msg)
 		assert.NoError(t, err)
 		assert.Len(t, axelarnetKeeper.RegisterIBCPathCalls(), 1)
@@ -442,10 +474,270 @@ func TestHandleMsgRegisterIBCPath(t *testing.T) {
 	t.Run("should return error if an asset is already registered", testutils.Func(func(t *testing.T) {
 		setup()
 		axelarnetKeeper.RegisterIBCPathFunc = func(sdk.Context, string, string) error { return fmt.Errorf("failed") }
-		msg = randomMsgRegisterIBCPath()
 		_, err := server.RegisterIBCPath(sdk.WrapSDKContext(ctx), msg)
 		assert.Error(t, err)
 	}).Repeat(repeatCount))
+
+	t.Run("should return error if the chain is unknown", testutils.Func(func(t *testing.T) {
+		setup()
+		axelarnetKeeper.GetCosmosChainByNameFunc = func(sdk.Context, string) (types.CosmosChain, bool) { return types.CosmosChain{}, false }
+		_, err := server.RegisterIBCPath(sdk.WrapSDKContext(ctx), msg)
+		assert.Error(t, err)
+		assert.Len(t, axelarnetKeeper.RegisterIBCPathCalls(), 0)
+	}).Repeat(repeatCount))
+
+	t.Run("should return error if the channel does not exist", testutils.Func(func(t *testing.T) {
+		setup()
+		channelKeeper.GetChannelFunc = func(sdk.Context, string, string) (channeltypes.Channel, bool) { return channeltypes.Channel{}, false }
+		_, err := server.RegisterIBCPath(sdk.WrapSDKContext(ctx), msg)
+		assert.Error(t, err)
+		assert.Len(t, axelarnetKeeper.RegisterIBCPathCalls(), 0)
+	}).Repeat(repeatCount))
+
+	t.Run("should return error if the channel is not open", testutils.Func(func(t *testing.T) {
+		setup()
+		channelKeeper.GetChannelFunc = func(sdk.Context, string, string) (channeltypes.Channel, bool) {
+			channel := openChannel()
+			channel.State = channeltypes.CLOSED
+			return channel, true
+		}
+		_, err := server.RegisterIBCPath(sdk.WrapSDKContext(ctx), msg)
+		assert.Error(t, err)
+		assert.Len(t, axelarnetKeeper.RegisterIBCPathCalls(), 0)
+	}).Repeat(repeatCount))
+
+	t.Run("should return error if the client is not active", testutils.Func(func(t *testing.T) {
+		setup()
+		status := []ibcclient.Status{ibcclient.Expired, ibcclient.Frozen, ibcclient.Unknown}[rand.I64Between(0, 3)]
+		clientKeeper.ClientStatusFunc = func(context.Context, *clienttypes.QueryClientStatusRequest) (*clienttypes.QueryClientStatusResponse, error) {
+			return &clienttypes.QueryClientStatusResponse{Status: status.String()}, nil
+		}
+		_, err := server.RegisterIBCPath(sdk.WrapSDKContext(ctx), msg)
+		assert.Error(t, err)
+		assert.Len(t, axelarnetKeeper.RegisterIBCPathCalls(), 0)
+	}).Repeat(repeatCount))
+
+	t.Run("should register a multi-hop path through a directly connected chain", testutils.Func(func(t *testing.T) {
+		setup()
+		via := types.CosmosChain{Name: rand.StrBetween(5, 20), AddrPrefix: rand.Str(5), ChainID: clientState().ChainId}
+		viaPath := randomIBCPath()
+		msg.Path = fmt.Sprintf("%s/%s", viaPath, randomIBCPath())
+		axelarnetKeeper.GetCosmosChainByNameFunc = func(_ sdk.Context, chain string) (types.CosmosChain, bool) {
+			return types.CosmosChain{Name: chain, AddrPrefix: rand.Str(5), ChainID: rand.StrBetween(5, 20)}, true
+		}
+		axelarnetKeeper.GetCosmosChainByIBCPathFunc = func(_ sdk.Context, path string) (types.CosmosChain, bool) {
+			return via, path == viaPath
+		}
+
+		_, err := server.RegisterIBCPath(sdk.WrapSDKContext(ctx), msg)
+		assert.NoError(t, err)
+		assert.Len(t, axelarnetKeeper.RegisterIBCPathCalls(), 1)
+	}).Repeat(repeatCount))
+
+	t.Run("should return error if an intermediate chain of a multi-hop path is not registered", testutils.Func(func(t *testing.T) {
+		setup()
+		msg.Path = fmt.Sprintf("%s/%s", randomIBCPath(), randomIBCPath())
+		axelarnetKeeper.GetCosmosChainByIBCPathFunc = func(sdk.Context, string) (types.CosmosChain, bool) { return types.CosmosChain{}, false }
+
+		_, err := server.RegisterIBCPath(sdk.WrapSDKContext(ctx), msg)
+		assert.Error(t, err)
+		assert.Len(t, axelarnetKeeper.RegisterIBCPathCalls(), 0)
+	}).Repeat(repeatCount))
+
+	t.Run("should return error if the client tracks a different chain ID", testutils.Func(func(t *testing.T) {
+		setup()
+		axelarnetKeeper.GetCosmosChainByNameFunc = func(_ sdk.Context, chain string) (types.CosmosChain, bool) {
+			return types.CosmosChain{Name: chain, ChainID: rand.StrBetween(5, 20)}, true
+		}
+		_, err := server.RegisterIBCPath(sdk.WrapSDKContext(ctx), msg)
+		assert.Error(t, err)
+		assert.Len(t, axelarnetKeeper.RegisterIBCPathCalls(), 0)
+	}).Repeat(repeatCount))
+}
+
+func TestHandleMsgSetCosmosChainID(t *testing.T) {
+	var (
+		server          types.MsgServiceServer
+		axelarnetKeeper *mock.BaseKeeperMock
+		ctx             sdk.Context
+		chain           types.CosmosChain
+		msg             *types.SetCosmosChainIDRequest
+	)
+	setup := func() {
+		// chains added before chain IDs were recorded have none
+		chain = types.CosmosChain{Name: rand.StrBetween(5, 20), AddrPrefix: rand.Str(5)}
+		msg = types.NewSetCosmosChainIDRequest(rand.AccAddr(), chain.Name, clientState().ChainId)
+		axelarnetKeeper = &mock.BaseKeeperMock{
+			GetCosmosChainByNameFunc: func(_ sdk.Context, name string) (types.CosmosChain, bool) {
+				return chain, name == chain.Name
+			},
+			SetCosmosChainFunc:  func(_ sdk.Context, c types.CosmosChain) { chain = c },
+			RegisterIBCPathFunc: func(sdk.Context, string, string) error { return nil },
+		}
+		channelKeeper := &mock.ChannelKeeperMock{
+			GetChannelFunc: func(sdk.Context, string, string) (channeltypes.Channel, bool) { return openChannel(), true },
+			GetChannelClientStateFunc: func(sdk.Context, string, string) (string, ibcclient.ClientState, error) {
+				return "07-tendermint-0", clientState(), nil
+			},
+		}
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+
+		server = keeper.NewMsgServerImpl(axelarnetKeeper, &mock.NexusMock{}, &mock.BankKeeperMock{}, &mock.IBCTransferKeeperMock{}, channelKeeper, activeClientKeeper(), &mock.AccountKeeperMock{})
+	}
+
+	repeatCount := 20
+	t.Run("should set the chain ID so an IBC path can be registered for the chain", testutils.Func(func(t *testing.T) {
+		setup()
+		_, err := server.RegisterIBCPath(sdk.WrapSDKContext(ctx), types.NewRegisterIBCPathRequest(rand.AccAddr(), chain.Name, randomIBCPath()))
+		assert.Error(t, err)
+
+		_, err = server.SetCosmosChainID(sdk.WrapSDKContext(ctx), msg)
+		assert.NoError(t, err)
+		assert.Len(t, axelarnetKeeper.SetCosmosChainCalls(), 1)
+		assert.Equal(t, msg.ChainID, chain.ChainID)
+
+		_, err = server.RegisterIBCPath(sdk.WrapSDKContext(ctx), types.NewRegisterIBCPathRequest(rand.AccAddr(), chain.Name, randomIBCPath()))
+		assert.NoError(t, err)
+		assert.Len(t, axelarnetKeeper.RegisterIBCPathCalls(), 1)
+	}).Repeat(repeatCount))
+
+	t.Run("should return error if the chain already has a chain ID", testutils.Func(func(t *testing.T) {
+		setup()
+		chain.ChainID = rand.StrBetween(5, 20)
+
+		_, err := server.SetCosmosChainID(sdk.WrapSDKContext(ctx), msg)
+		assert.Error(t, err)
+		assert.Len(t, axelarnetKeeper.SetCosmosChainCalls(), 0)
+	}).Repeat(repeatCount))
+
+	t.Run("should return error if the chain is unknown", testutils.Func(func(t *testing.T) {
+		setup()
+		msg.Chain = rand.StrBetween(21, 30)
+
+		_, err := server.SetCosmosChainID(sdk.WrapSDKContext(ctx), msg)
+		assert.Error(t, err)
+		assert.Len(t, axelarnetKeeper.SetCosmosChainCalls(), 0)
+	}).Repeat(repeatCount))
+}
+
+func TestHandleMsgRegisterAsset(t *testing.T) {
+	var (
+		server          types.MsgServiceServer
+		axelarnetKeeper *mock.BaseKeeperMock
+		nexusKeeper     *mock.NexusMock
+		bankKeeper      *mock.BankKeeperMock
+		ctx             sdk.Context
+		ibcPath         string
+	)
+	setup := func() {
+		ibcPath = randomIBCPath()
+		axelarnetKeeper = &mock.BaseKeeperMock{
+			GetIBCPathFunc:                 func(sdk.Context, string) (string, bool) { return ibcPath, true },
+			RegisterAssetToCosmosChainFunc: func(sdk.Context, types.Asset, string) error { return nil },
+		}
+		nexusKeeper = &mock.NexusMock{
+			GetChainFunc: func(_ sdk.Context, chain string) (nexus.Chain, bool) {
+				return nexus.Chain{Name: chain, NativeAsset: randomDenom(), SupportsForeignAssets: true, Module: rand.Str(10)}, true
+			},
+			RegisterAssetFunc:         func(sdk.Context, nexus.Chain, string) {},
+			SetAssetPrecisionFunc:     func(sdk.Context, string, uint32) error { return nil },
+			GetAssetPrecisionFunc:     func(sdk.Context, string) (uint32, bool) { return 0, false },
+			GetChainsWithAssetFunc:    func(sdk.Context, string) []nexus.Chain { return nil },
+			RegisterAssetDecimalsFunc: func(sdk.Context, nexus.Chain, string, uint32) error { return nil },
+		}
+		bankKeeper = &mock.BankKeeperMock{
+			GetDenomMetaDataFunc: func(sdk.Context, string) (banktypes.Metadata, bool) { return banktypes.Metadata{}, false },
+		}
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+
+		server = keeper.NewMsgServerImpl(axelarnetKeeper, nexusKeeper, bankKeeper, &mock.IBCTransferKeeperMock{}, &mock.ChannelKeeperMock{}, &mock.ClientKeeperMock{}, &mock.AccountKeeperMock{})
+	}
+
+	repeatCount := 20
+	t.Run("should store decimals and display denom from the bank metadata of the IBC denom", testutils.Func(func(t *testing.T) {
+		setup()
+		denom := randomDenom()
+		display := randomDenom()
+		decimals := uint32(rand.I64Between(1, 19))
+		ibcDenom := ibctypes.ParseDenomTrace(fmt.Sprintf("%s/%s", ibcPath, denom)).IBCDenom()
+		bankKeeper.GetDenomMetaDataFunc = func(_ sdk.Context, d string) (banktypes.Metadata, bool) {
+			return banktypes.Metadata{
+				Base:    ibcDenom,
+				Display: display,
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: ibcDenom, Exponent: 0},
+					{Denom: display, Exponent: decimals},
+				},
+			}, d == ibcDenom
+		}
+
+		_, err := server.RegisterAsset(sdk.WrapSDKContext(ctx), types.NewRegisterAssetRequest(rand.AccAddr(), testChain, types.Asset{Denom: denom, MinAmount: sdk.NewInt(1000)}))
+		assert.NoError(t, err)
+		assert.Len(t, axelarnetKeeper.RegisterAssetToCosmosChainCalls(), 1)
+		asset := axelarnetKeeper.RegisterAssetToCosmosChainCalls()[0].Asset
+		assert.Equal(t, denom, asset.Denom)
+		assert.Equal(t, display, asset.DisplayDenom)
+		assert.Equal(t, decimals, asset.Decimals)
+
+		assert.Len(t, nexusKeeper.SetAssetPrecisionCalls(), 1)
+		assert.Equal(t, denom, nexusKeeper.SetAssetPrecisionCalls()[0].Asset)
+		assert.Equal(t, decimals, nexusKeeper.SetAssetPrecisionCalls()[0].Decimals)
+		assert.Len(t, nexusKeeper.RegisterAssetDecimalsCalls(), 2)
+		assert.Equal(t, testChain, nexusKeeper.RegisterAssetDecimalsCalls()[0].Chain.Name)
+		assert.Equal(t, exported.Axelarnet.Name, nexusKeeper.RegisterAssetDecimalsCalls()[1].Chain.Name)
+	}).Repeat(repeatCount))
+
+	t.Run("should fail if the asset decimals conflict with the registered precision", testutils.Func(func(t *testing.T) {
+		setup()
+		decimals := uint32(rand.I64Between(1, 19))
+		nexusKeeper.GetAssetPrecisionFunc = func(sdk.Context, string) (uint32, bool) { return decimals + 1, true }
+
+		_, err := server.RegisterAsset(sdk.WrapSDKContext(ctx), types.NewRegisterAssetRequest(rand.AccAddr(), testChain, types.Asset{Denom: randomDenom(), MinAmount: sdk.NewInt(1000), Decimals: decimals}))
+		assert.Error(t, err)
+		assert.Len(t, nexusKeeper.SetAssetPrecisionCalls(), 0)
+		assert.Len(t, nexusKeeper.RegisterAssetDecimalsCalls(), 0)
+	}).Repeat(repeatCount))
+
+	t.Run("should register the precision defined by the origin chain for assets of other chains", testutils.Func(func(t *testing.T) {
+		setup()
+		precision := uint32(rand.I64Between(1, 19))
+		nexusKeeper.GetChainsWithAssetFunc = func(sdk.Context, string) []nexus.Chain {
+			return []nexus.Chain{{Name: rand.StrBetween(5, 10), NativeAsset: randomDenom(), SupportsForeignAssets: true, Module: rand.Str(10)}}
+		}
+		nexusKeeper.GetAssetPrecisionFunc = func(sdk.Context, string) (uint32, bool) { return precision, true }
+
+		_, err := server.RegisterAsset(sdk.WrapSDKContext(ctx), types.NewRegisterAssetRequest(rand.AccAddr(), testChain, types.Asset{Denom: randomDenom(), MinAmount: sdk.NewInt(1000)}))
+		assert.NoError(t, err)
+		assert.Len(t, nexusKeeper.SetAssetPrecisionCalls(), 0)
+		assert.Len(t, nexusKeeper.RegisterAssetDecimalsCalls(), 2)
+		for _, call := range nexusKeeper.RegisterAssetDecimalsCalls() {
+			assert.Equal(t, precision, call.Decimals)
+		}
+	}).Repeat(repeatCount))
+
+	t.Run("should not define the precision of assets of other chains", testutils.Func(func(t *testing.T) {
+		setup()
+		nexusKeeper.GetChainsWithAssetFunc = func(sdk.Context, string) []nexus.Chain {
+			return []nexus.Chain{{Name: rand.StrBetween(5, 10), NativeAsset: randomDenom(), SupportsForeignAssets: true, Module: rand.Str(10)}}
+		}
+
+		_, err := server.RegisterAsset(sdk.WrapSDKContext(ctx), types.NewRegisterAssetRequest(rand.AccAddr(), testChain, types.Asset{Denom: randomDenom(), MinAmount: sdk.NewInt(1000), Decimals: uint32(rand.I64Between(1, 19))}))
+		assert.NoError(t, err)
+		assert.Len(t, nexusKeeper.SetAssetPrecisionCalls(), 0)
+		assert.Len(t, nexusKeeper.RegisterAssetDecimalsCalls(), 0)
+	}).Repeat(repeatCount))
+
+	t.Run("should default the display denom to the base denom without bank metadata", testutils.Func(func(t *testing.T) {
+		setup()
+		denom := randomDenom()
+
+		_, err := server.RegisterAsset(sdk.WrapSDKContext(ctx), types.NewRegisterAssetRequest(rand.AccAddr(), testChain, types.Asset{Denom: denom, MinAmount: sdk.NewInt(1000)}))
+		assert.NoError(t, err)
+		asset := axelarnetKeeper.RegisterAssetToCosmosChainCalls()[0].Asset
+		assert.Equal(t, denom, asset.DisplayDenom)
+		assert.Equal(t, uint32(0), asset.Decimals)
+		assert.Len(t, nexusKeeper.SetAssetPrecisionCalls(), 0)
+	}).Repeat(repeatCount))
 }
 
 func TestHandleMsgRouteIBCTransfers(t *testing.T) {
@@ -455,6 +747,7 @@ func TestHandleMsgRouteIBCTransfers(t *testing.T) {
 		nexusKeeper     *mock.NexusMock
 		bankKeeper      *mock.BankKeeperMock
 		channelKeeper   *mock.ChannelKeeperMock
+		clientKeeper    *mock.ClientKeeperMock
 		transferKeeper  *mock.IBCTransferKeeperMock
 		accountKeeper   *mock.AccountKeeperMock
 		ctx             sdk.Context
@@ -504,12 +797,17 @@ func TestHandleMsgRouteIBCTransfers(t *testing.T) {
 			MintCoinsFunc: func(sdk.Context, string, sdk.Coins) error { return nil },
 		}
 		channelKeeper = &mock.ChannelKeeperMock{
+			GetChannelFunc: func(sdk.Context, string, string) (channeltypes.Channel, bool) { return openChannel(), true },
 			GetChannelClientStateFunc: func(sdk.Context, string, string) (string, ibcclient.ClientState, error) {
 				return "07-tendermint-0", clientState(), nil
 			},
 			GetNextSequenceSendFunc: func(ctx sdk.Context, portID, channelID string) (uint64, bool) { return uint64(rand.PosI64()), true },
 		}
+		clientKeeper = activeClientKeeper()
 		transferKeeper = &mock.IBCTransferKeeperMock{
+			GetDenomTraceFunc: func(sdk.Context, tmbytes.HexBytes) (ibctypes.DenomTrace, bool) {
+				return ibctypes.DenomTrace{Path: ibcPath, BaseDenom: testToken}, true
+			},
 			SendTransferFunc: func(ctx sdk.Context, sourcePort, sourceChannel string, token sdk.Coin, sender sdk.AccAddress, receiver string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64) error {
 				return nil
 			},
@@ -519,7 +817,7 @@ func TestHandleMsgRouteIBCTransfers(t *testing.T) {
 		}
 
 		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
-		server = keeper.NewMsgServerImpl(axelarnetKeeper, nexusKeeper, bankKeeper, transferKeeper, channelKeeper, accountKeeper)
+		server = keeper.NewMsgServerImpl(axelarnetKeeper, nexusKeeper, bankKeeper, transferKeeper, channelKeeper, clientKeeper, accountKeeper)
 	}
 	repeatCount := 20
 	t.Run("should route ibc token back to cosmos chains, and archive pending transfers when get pending transfers from nexus keeper", testutils.Func(func(t *testing.T) {
@@ -549,6 +847,226 @@ func TestHandleMsgRouteIBCTransfers(t *testing.T) {
 		_, err := server.RouteIBCTransfers(sdk.WrapSDKContext(ctx), msg)
 		assert.NoError(t, err)
 	}).Repeat(repeatCount))
+
+	t.Run("should send transfers to chains that are not directly connected through the intermediate chains", testutils.Func(func(t *testing.T) {
+		setup()
+		via := types.CosmosChain{Name: rand.StrBetween(5, 20), AddrPrefix: "via"}
+		firstHop := randomIBCPath()
+		forwardPath := randomIBCPath()
+		axelarnetKeeper.GetIBCPathFunc = func(sdk.Context, string) (string, bool) {
+			return fmt.Sprintf("%s/%s", firstHop, forwardPath), true
+		}
+		axelarnetKeeper.GetCosmosChainByIBCPathFunc = func(_ sdk.Context, path string) (types.CosmosChain, bool) {
+			return via, path == firstHop
+		}
+		transferKeeper.GetDenomTraceFunc = func(sdk.Context, tmbytes.HexBytes) (ibctypes.DenomTrace, bool) {
+			return ibctypes.DenomTrace{Path: firstHop, BaseDenom: testToken}, true
+		}
+		msg = types.NewRouteIBCTransfersRequest(rand.AccAddr())
+		_, err := server.RouteIBCTransfers(sdk.WrapSDKContext(ctx), msg)
+		assert.NoError(t, err)
+
+		assert.Len(t, transferKeeper.SendTransferCalls(), len(transfers))
+		assert.Len(t, axelarnetKeeper.SetPendingIBCTransferCalls(), len(transfers))
+		for i, call := range transferKeeper.SendTransferCalls() {
+			assert.Equal(t, firstHop, fmt.Sprintf("%s/%s", call.SourcePort, call.SourceChannel))
+
+			recipient, err := sdk.AccAddressFromBech32(transfers[i].Recipient.Address)
+			assert.NoError(t, err)
+			intermediate, err := sdk.Bech32ifyAddressBytes(via.AddrPrefix, recipient)
+			assert.NoError(t, err)
+			assert.Equal(t, types.NewForwardReceiver(intermediate, forwardPath, transfers[i].Recipient.Address), call.Receiver)
+
+			pending := axelarnetKeeper.SetPendingIBCTransferCalls()[i].Transfer
+			assert.Equal(t, forwardPath, pending.ForwardPath)
+			assert.Equal(t, transfers[i].Recipient.Address, pending.Receiver)
+		}
+	}).Repeat(repeatCount))
+
+	t.Run("should keep transfers pending when the path would wrap the tokens again instead of unwinding them", testutils.Func(func(t *testing.T) {
+		setup()
+		transferKeeper.GetDenomTraceFunc = func(sdk.Context, tmbytes.HexBytes) (ibctypes.DenomTrace, bool) {
+			return ibctypes.DenomTrace{Path: randomIBCPath(), BaseDenom: testToken}, true
+		}
+		msg = types.NewRouteIBCTransfersRequest(rand.AccAddr())
+		_, err := server.RouteIBCTransfers(sdk.WrapSDKContext(ctx), msg)
+		assert.NoError(t, err)
+
+		assert.Len(t, transferKeeper.SendTransferCalls(), 0)
+		assert.Len(t, nexusKeeper.ArchivePendingTransferCalls(), 0)
+	}).Repeat(repeatCount))
+
+	t.Run("should return error for multi-hop transfers to receivers that are not bech32 addresses", testutils.Func(func(t *testing.T) {
+		setup()
+		firstHop := randomIBCPath()
+		axelarnetKeeper.GetIBCPathFunc = func(sdk.Context, string) (string, bool) {
+			return fmt.Sprintf("%s/%s", firstHop, randomIBCPath()), true
+		}
+		axelarnetKeeper.GetCosmosChainByIBCPathFunc = func(_ sdk.Context, path string) (types.CosmosChain, bool) {
+			return types.CosmosChain{Name: rand.StrBetween(5, 20), AddrPrefix: "via"}, path == firstHop
+		}
+		transferKeeper.GetDenomTraceFunc = func(sdk.Context, tmbytes.HexBytes) (ibctypes.DenomTrace, bool) {
+			return ibctypes.DenomTrace{Path: firstHop, BaseDenom: testToken}, true
+		}
+		nexusKeeper.GetTransfersForChainFunc = func(sdk.Context, nexus.Chain, nexus.TransferState) []nexus.CrossChainTransfer {
+			transfer := randomTransfer(testToken, testChain, sdk.NewInt(1000000))
+			transfer.Recipient.Address = rand.StrBetween(5, 20)
+			transfers = []nexus.CrossChainTransfer{transfer}
+			return transfers
+		}
+		msg = types.NewRouteIBCTransfersRequest(rand.AccAddr())
+		_, err := server.RouteIBCTransfers(sdk.WrapSDKContext(ctx), msg)
+		assert.NoError(t, err)
+
+		assert.Len(t, transferKeeper.SendTransferCalls(), 0)
+		assert.Len(t, nexusKeeper.ArchivePendingTransferCalls(), 0)
+	}).Repeat(repeatCount))
+
+	t.Run("should keep transfers pending when the client of the path is not active", testutils.Func(func(t *testing.T) {
+		setup()
+		clientKeeper.ClientStatusFunc = func(context.Context, *clienttypes.QueryClientStatusRequest) (*clienttypes.QueryClientStatusResponse, error) {
+			return &clienttypes.QueryClientStatusResponse{Status: ibcclient.Expired.String()}, nil
+		}
+		msg = types.NewRouteIBCTransfersRequest(rand.AccAddr())
+		_, err := server.RouteIBCTransfers(sdk.WrapSDKContext(ctx), msg)
+		assert.NoError(t, err)
+		assert.Len(t, nexusKeeper.GetTransfersForChainCalls(), 0)
+		assert.Len(t, transferKeeper.SendTransferCalls(), 0)
+	}).Repeat(repeatCount))
+}
+
+func TestHandleMsgRerouteAndRefundFailedIBCTransfer(t *testing.T) {
+	var (
+		server          types.MsgServiceServer
+		axelarnetKeeper *mock.BaseKeeperMock
+		bankKeeper      *mock.BankKeeperMock
+		transferKeeper  *mock.IBCTransferKeeperMock
+		ctx             sdk.Context
+		failed          types.IBCTransfer
+		chainPath       string
+	)
+	setup := func() {
+		failed = randomIBCTransfer()
+		failed.ID = uint64(rand.PosI64())
+		failed.Attempts = uint64(rand.I64Between(1, 10))
+		failed.Status = types.TransferFailed
+		chainPath = randomIBCPath()
+
+		axelarnetKeeper = &mock.BaseKeeperMock{
+			GetFailedIBCTransferFunc: func(_ sdk.Context, id uint64) (types.IBCTransfer, bool) {
+				return failed, id == failed.ID
+			},
+			DeleteFailedIBCTransferFunc: func(sdk.Context, uint64) {},
+			GetIBCPathFunc: func(_ sdk.Context, chain string) (string, bool) {
+				return chainPath, chain == testChain
+			},
+			GetRouteTimeoutWindowFunc: func(sdk.Context) uint64 { return 10 },
+			SetPendingIBCTransferFunc: func(sdk.Context, types.IBCTransfer) {},
+			LoggerFunc:                func(ctx sdk.Context) log.Logger { return ctx.Logger() },
+		}
+		bankKeeper = &mock.BankKeeperMock{
+			SendCoinsFunc: func(sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) error { return nil },
+		}
+		channelKeeper := &mock.ChannelKeeperMock{
+			GetChannelFunc: func(sdk.Context, string, string) (channeltypes.Channel, bool) { return openChannel(), true },
+			GetChannelClientStateFunc: func(sdk.Context, string, string) (string, ibcclient.ClientState, error) {
+				return "07-tendermint-0", clientState(), nil
+			},
+			GetNextSequenceSendFunc: func(sdk.Context, string, string) (uint64, bool) { return uint64(rand.PosI64()), true },
+		}
+		transferKeeper = &mock.IBCTransferKeeperMock{
+			SendTransferFunc: func(sdk.Context, string, string, sdk.Coin, sdk.AccAddress, string, clienttypes.Height, uint64) error {
+				return nil
+			},
+		}
+
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+		server = keeper.NewMsgServerImpl(axelarnetKeeper, &mock.NexusMock{}, bankKeeper, transferKeeper, channelKeeper, activeClientKeeper(), &mock.AccountKeeperMock{})
+	}
+
+	repeatCount := 20
+	t.Run("should resend a failed transfer through its original channel", testutils.Func(func(t *testing.T) {
+		setup()
+		_, err := server.RerouteFailedIBCTransfer(sdk.WrapSDKContext(ctx), types.NewRerouteFailedIBCTransferRequest(rand.AccAddr(), failed.ID, "", ""))
+		assert.NoError(t, err)
+
+		assert.Len(t, transferKeeper.SendTransferCalls(), 1)
+		assert.Equal(t, failed.PortID, transferKeeper.SendTransferCalls()[0].SourcePort)
+		assert.Equal(t, failed.ChannelID, transferKeeper.SendTransferCalls()[0].SourceChannel)
+		assert.Equal(t, failed.Receiver, transferKeeper.SendTransferCalls()[0].Receiver)
+
+		assert.Len(t, axelarnetKeeper.DeleteFailedIBCTransferCalls(), 1)
+		assert.Len(t, axelarnetKeeper.SetPendingIBCTransferCalls(), 1)
+		pending := axelarnetKeeper.SetPendingIBCTransferCalls()[0].Transfer
+		assert.Equal(t, failed.ID, pending.ID)
+		assert.Equal(t, uint64(1), pending.Attempts)
+		assert.Equal(t, types.TransferPending, pending.Status)
+	}).Repeat(repeatCount))
+
+	t.Run("should resend a failed transfer to another chain and receiver", testutils.Func(func(t *testing.T) {
+		setup()
+		receiver := rand.StrBetween(5, 20)
+		_, err := server.RerouteFailedIBCTransfer(sdk.WrapSDKContext(ctx), types.NewRerouteFailedIBCTransferRequest(rand.AccAddr(), failed.ID, testChain, receiver))
+		assert.NoError(t, err)
+
+		assert.Len(t, transferKeeper.SendTransferCalls(), 1)
+		assert.Equal(t, chainPath, fmt.Sprintf("%s/%s", transferKeeper.SendTransferCalls()[0].SourcePort, transferKeeper.SendTransferCalls()[0].SourceChannel))
+		assert.Equal(t, receiver, transferKeeper.SendTransferCalls()[0].Receiver)
+	}).Repeat(repeatCount))
+
+	t.Run("should return error when rerouting to a chain without IBC path", testutils.Func(func(t *testing.T) {
+		setup()
+		_, err := server.RerouteFailedIBCTransfer(sdk.WrapSDKContext(ctx), types.NewRerouteFailedIBCTransferRequest(rand.AccAddr(), failed.ID, rand.StrBetween(5, 20), ""))
+		assert.Error(t, err)
+		assert.Len(t, transferKeeper.SendTransferCalls(), 0)
+	}).Repeat(repeatCount))
+
+	t.Run("should return error when rerouting an ICS-20 voucher onto the path of another chain", testutils.Func(func(t *testing.T) {
+		setup()
+		trace := ibctypes.DenomTrace{Path: failed.IBCPath(), BaseDenom: testToken}
+		failed.Token = sdk.NewCoin(trace.IBCDenom(), failed.Token.Amount)
+		transferKeeper.GetDenomTraceFunc = func(sdk.Context, tmbytes.HexBytes) (ibctypes.DenomTrace, bool) { return trace, true }
+
+		_, err := server.RerouteFailedIBCTransfer(sdk.WrapSDKContext(ctx), types.NewRerouteFailedIBCTransferRequest(rand.AccAddr(), failed.ID, testChain, ""))
+		assert.Error(t, err)
+		assert.Len(t, transferKeeper.SendTransferCalls(), 0)
+	}).Repeat(repeatCount))
+
+	t.Run("should reroute an ICS-20 voucher back along its denom trace", testutils.Func(func(t *testing.T) {
+		setup()
+		trace := ibctypes.DenomTrace{Path: chainPath, BaseDenom: testToken}
+		failed.Token = sdk.NewCoin(trace.IBCDenom(), failed.Token.Amount)
+		transferKeeper.GetDenomTraceFunc = func(sdk.Context, tmbytes.HexBytes) (ibctypes.DenomTrace, bool) { return trace, true }
+
+		_, err := server.RerouteFailedIBCTransfer(sdk.WrapSDKContext(ctx), types.NewRerouteFailedIBCTransferRequest(rand.AccAddr(), failed.ID, testChain, ""))
+		assert.NoError(t, err)
+		assert.Len(t, transferKeeper.SendTransferCalls(), 1)
+		assert.Equal(t, failed.Token, transferKeeper.SendTransferCalls()[0].Token)
+	}).Repeat(repeatCount))
+
+	t.Run("should refund a failed transfer from its sender to the recipient", testutils.Func(func(t *testing.T) {
+		setup()
+		recipient := rand.AccAddr()
+		_, err := server.RefundFailedIBCTransfer(sdk.WrapSDKContext(ctx), types.NewRefundFailedIBCTransferRequest(rand.AccAddr(), failed.ID, recipient))
+		assert.NoError(t, err)
+
+		assert.Len(t, bankKeeper.SendCoinsCalls(), 1)
+		assert.Equal(t, failed.Sender, bankKeeper.SendCoinsCalls()[0].FromAddr)
+		assert.Equal(t, recipient, bankKeeper.SendCoinsCalls()[0].ToAddr)
+		assert.Equal(t, sdk.NewCoins(failed.Token), bankKeeper.SendCoinsCalls()[0].Amt)
+		assert.Len(t, axelarnetKeeper.DeleteFailedIBCTransferCalls(), 1)
+	}).Repeat(repeatCount))
+
+	t.Run("should return error when the failed transfer is unknown", testutils.Func(func(t *testing.T) {
+		setup()
+		_, err := server.RefundFailedIBCTransfer(sdk.WrapSDKContext(ctx), types.NewRefundFailedIBCTransferRequest(rand.AccAddr(), failed.ID+1, rand.AccAddr()))
+		assert.Error(t, err)
+
+		_, err = server.RerouteFailedIBCTransfer(sdk.WrapSDKContext(ctx), types.NewRerouteFailedIBCTransferRequest(rand.AccAddr(), failed.ID+1, "", ""))
+		assert.Error(t, err)
+		assert.Len(t, bankKeeper.SendCoinsCalls(), 0)
+		assert.Len(t, transferKeeper.SendTransferCalls(), 0)
+	}).Repeat(repeatCount))
 }
 
 func randomMsgLink() *types.LinkRequest {
@@ -597,6 +1115,18 @@ func randomDenom() string {
 	return d[0]
 }
 
+func openChannel() channeltypes.Channel {
+	return channeltypes.NewChannel(channeltypes.OPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty(ibctypes.PortID, "channel-0"), []string{"connection-0"}, ibctypes.Version)
+}
+
+func activeClientKeeper() *mock.ClientKeeperMock {
+	return &mock.ClientKeeperMock{
+		ClientStatusFunc: func(context.Context, *clienttypes.QueryClientStatusRequest) (*clienttypes.QueryClientStatusResponse, error) {
+			return &clienttypes.QueryClientStatusResponse{Status: ibcclient.Active.String()}, nil
+		},
+	}
+}
+
 func clientState() *ibctmtypes.ClientState {
 	return ibctmtypes.NewClientState(
 		"07-tendermint-0",
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+)
+
+// Query labels
+const (
+	QueryFailedIBCTransfers = "failed-ibc-transfers"
+)
+
+// NewQuerier returns a new querier for the axelarnet module
+func NewQuerier(k types.BaseKeeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case QueryFailedIBCTransfers:
+			return QueryFailedIBCTransfersHandler(ctx, k)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("unknown axelarnet query endpoint: %s", path[0]))
+		}
+	}
+}
+
+// QueryFailedIBCTransfersHandler returns all IBC transfers that failed after all retries and wait to be rerouted or refunded
+func QueryFailedIBCTransfersHandler(ctx sdk.Context, k types.BaseKeeper) ([]byte, error) {
+	resp := types.QueryFailedIBCTransfersResponse{Transfers: k.GetFailedIBCTransfers(ctx)}
+
+	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
+}
@@ -3,6 +3,7 @@ package axelarnet
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -15,9 +16,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	"github.com/cosmos/ibc-go/modules/apps/transfer"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/modules/core/exported"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/axelarnetwork/axelar-core/x/axelarnet/client/cli"
@@ -81,7 +84,7 @@ func (AppModuleBasic) GetTxCmd() *cobra.Command {
 
 // GetQueryCmd returns all CLI query commands for this module
 func (AppModuleBasic) GetQueryCmd() *cobra.Command {
-	return nil
+	return cli.GetQueryCmd(types.QuerierRoute)
 }
 
 // AppModule implements module.AppModule
@@ -93,6 +96,7 @@ type AppModule struct {
 	bank     types.BankKeeper
 	transfer types.IBCTransferKeeper
 	channel  types.ChannelKeeper
+	client   types.ClientKeeper
 	account  types.AccountKeeper
 
 	transferModule transfer.AppModule
@@ -105,6 +109,7 @@ func NewAppModule(
 	bank types.BankKeeper,
 	transfer types.IBCTransferKeeper,
 	channel types.ChannelKeeper,
+	client types.ClientKeeper,
 	account types.AccountKeeper,
 	transferModule transfer.AppModule,
 	logger log.Logger) AppModule {
@@ -116,14 +121,15 @@ func NewAppModule(
 		bank:           bank,
 		transfer:       transfer,
 		channel:        channel,
+		client:         client,
 		account:        account,
 		transferModule: transferModule,
 	}
 }
 
 // RegisterInvariants registers this module's invariants
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
-	// No invariants yet
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper, am.nexus, am.bank)
 }
 
 // InitGenesis initializes the module's keeper from the given genesis state
@@ -145,7 +151,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 
 // Route returns the module's route
 func (am AppModule) Route() sdk.Route {
-	return sdk.NewRoute(types.RouterKey, NewHandler(am.keeper, am.nexus, am.bank, am.transfer, am.channel, am.account))
+	return sdk.NewRoute(types.RouterKey, NewHandler(am.keeper, am.nexus, am.bank, am.transfer, am.channel, am.client, am.account))
 }
 
 // QuerierRoute returns this module's query route
@@ -155,7 +161,7 @@ func (AppModule) QuerierRoute() string {
 
 // LegacyQuerierHandler returns a new query handler for this module
 func (am AppModule) LegacyQuerierHandler(*codec.LegacyAmino) sdk.Querier {
-	return nil
+	return keeper.NewQuerier(am.keeper)
 }
 
 // RegisterServices registers a GRPC query service to respond to the
@@ -170,7 +176,7 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 
 // EndBlock executes all state transitions this module requires at the end of each new block
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
-	return EndBlocker(ctx, req)
+	return EndBlocker(ctx, req, am.keeper, am.transfer, am.channel, am.client)
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
@@ -244,13 +250,126 @@ func (am AppModule) OnChanCloseConfirm(
 
 // OnRecvPacket implements the IBCModule interface. A successful acknowledgement
 // is returned if the packet data is succesfully decoded and the receive application
-// logic returns without error.
+// logic returns without error. If the receiver of the transfer is a routing memo,
+// the received tokens are forwarded to the chain it names, and an error acknowledgement
+// is returned if routing fails, so the tokens are refunded on the source chain.
 func (am AppModule) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
-	return am.transferModule.OnRecvPacket(ctx, packet, relayer)
+	server := keeper.NewMsgServerImpl(am.keeper, am.nexus, am.bank, am.transfer, am.channel, am.client, am.account)
+	recv := func(packet channeltypes.Packet) ibcexported.Acknowledgement {
+		return am.transferModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	return onRecvPacket(ctx, am.keeper, server, recv, packet)
+}
+
+// onRecvPacket passes the packet on to the transfer module with recv, routing it first if its receiver is a routing memo
+func onRecvPacket(
+	ctx sdk.Context,
+	k types.BaseKeeper,
+	server types.MsgServiceServer,
+	recv func(packet channeltypes.Packet) ibcexported.Acknowledgement,
+	packet channeltypes.Packet,
+) ibcexported.Acknowledgement {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return recv(packet)
+	}
+
+	memo, ok := types.ParseRoutingMemo(data.Receiver)
+	if !ok {
+		return recv(packet)
+	}
+
+	ack, err := routeIBCTransfer(ctx, server, recv, packet, data, memo)
+	if err != nil {
+		k.Logger(ctx).Info(fmt.Sprintf("failed to route IBC transfer %s/%s/%d: %s",
+			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), err.Error()))
+		return channeltypes.NewErrorAcknowledgement(err.Error())
+	}
+
+	return ack
+}
+
+// routeIBCTransfer receives the tokens of an ICS-20 transfer on a newly linked deposit address
+// and enqueues them for transfer to the destination named by the routing memo
+func routeIBCTransfer(
+	ctx sdk.Context,
+	server types.MsgServiceServer,
+	recv func(packet channeltypes.Packet) ibcexported.Acknowledgement,
+	packet channeltypes.Packet,
+	data ibctransfertypes.FungibleTokenPacketData,
+	memo types.RoutingMemo,
+) (ibcexported.Acknowledgement, error) {
+	if err := memo.Validate(); err != nil {
+		return nil, err
+	}
+
+	denom, asset := getReceivedDenom(packet, data)
+
+	linkRes, err := server.Link(sdk.WrapSDKContext(ctx), &types.LinkRequest{
+		RecipientAddr:  memo.DestinationAddress,
+		RecipientChain: memo.DestinationChain,
+		Asset:          asset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	depositAddress, err := sdk.AccAddressFromBech32(linkRes.DepositAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// the transfer module credits the tokens to the deposit address instead of the memo
+	data.Receiver = depositAddress.String()
+	packet.Data = data.GetBytes()
+
+	ack := recv(packet)
+	if !ack.Success() {
+		return ack, nil
+	}
+
+	if _, err := server.ConfirmDeposit(sdk.WrapSDKContext(ctx), &types.ConfirmDepositRequest{
+		TxID:           tmhash.Sum(ctx.TxBytes()),
+		Token:          sdk.NewCoin(denom, sdk.NewIntFromUint64(data.Amount)),
+		DepositAddress: depositAddress,
+	}); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeIBCRouting,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySourceChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositAddress, depositAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyDestinationChain, memo.DestinationChain),
+			sdk.NewAttribute(types.AttributeKeyDestinationAddress, memo.DestinationAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueRouted)),
+	)
+
+	return ack, nil
+}
+
+// getReceivedDenom returns the denomination the transfer module credits for the given packet on this chain,
+// and the asset it represents in nexus
+func getReceivedDenom(packet channeltypes.Packet, data ibctransfertypes.FungibleTokenPacketData) (string, string) {
+	if ibctransfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		// the tokens return to this chain, so the sender chain's prefix is removed
+		voucherPrefix := ibctransfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		denomTrace := ibctransfertypes.ParseDenomTrace(data.Denom[len(voucherPrefix):])
+
+		return denomTrace.IBCDenom(), denomTrace.GetBaseDenom()
+	}
+
+	prefixedDenom := ibctransfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + data.Denom
+	denomTrace := ibctransfertypes.ParseDenomTrace(prefixedDenom)
+
+	return denomTrace.IBCDenom(), denomTrace.GetBaseDenom()
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface
@@ -266,7 +385,7 @@ func (am AppModule) OnAcknowledgementPacket(
 		_ = types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack)
 		switch ack.Response.(type) {
 		case *channeltypes.Acknowledgement_Error:
-			err = resendTransferRoutedByAxelar(ctx, am.keeper, am.transfer, am.channel, packet)
+			retryOrFailTransferRoutedByAxelar(ctx, am.keeper, packet)
 		default:
 			// the acknowledgement succeeded on the receiving chain, delete the pending ibc transfer if it routed by axelarnet
 			am.keeper.DeletePendingIBCTransfer(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
@@ -283,22 +402,37 @@ func (am AppModule) OnTimeoutPacket(
 ) (*sdk.Result, error) {
 	result, err := am.transferModule.OnTimeoutPacket(ctx, packet, relayer)
 	if err == nil {
-		err = resendTransferRoutedByAxelar(ctx, am.keeper, am.transfer, am.channel, packet)
+		retryOrFailTransferRoutedByAxelar(ctx, am.keeper, packet)
 	}
 	return result, err
 }
 
-func resendTransferRoutedByAxelar(ctx sdk.Context, k keeper.Keeper, t types.IBCTransferKeeper, c types.ChannelKeeper, packet channeltypes.Packet) error {
-	// resend pending IBC transfer routed by axelarnet
+// retryOrFailTransferRoutedByAxelar schedules a timed out or rejected IBC transfer routed by axelarnet to be resent,
+// or marks it as failed once it has been sent the maximum number of times.
+// The refund of the packet has already been processed at this point, so it must never return an error that reverts it
+func retryOrFailTransferRoutedByAxelar(ctx sdk.Context, k keeper.Keeper, packet channeltypes.Packet) {
 	p, ok := k.GetPendingIBCTransfer(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 	if !ok {
-		return nil
+		return
 	}
-	path := fmt.Sprintf("%s/%s", packet.GetSourcePort(), packet.GetSourceChannel())
-	err := keeper.IBCTransfer(ctx, k, t, c, p.Token, p.Sender, p.Receiver, path)
-	// delete the timed out transfer
-	if err != nil {
-		k.DeletePendingIBCTransfer(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.DeletePendingIBCTransfer(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	emitIBCTransferStatusEvent(ctx, k.RetryOrFailIBCTransfer(ctx, p))
+}
+
+func emitIBCTransferStatusEvent(ctx sdk.Context, transfer types.IBCTransfer) {
+	action := types.AttributeValueRetry
+	if transfer.Status == types.TransferFailed {
+		action = types.AttributeValueFailed
 	}
-	return err
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeIBCTransfer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, action),
+			sdk.NewAttribute(types.AttributeKeyTransferID, strconv.FormatUint(transfer.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyAttempts, strconv.FormatUint(transfer.Attempts, 10)),
+			sdk.NewAttribute(types.AttributeKeyDestinationAddress, transfer.Receiver),
+			sdk.NewAttribute(types.AttributeKeyAmount, transfer.Token.String()),
+		))
 }
@@ -0,0 +1,199 @@
+package axelarnet
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/modules/core/exported"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/keeper"
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/types/mock"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+)
+
+func TestOnRecvPacket_Routing(t *testing.T) {
+	var (
+		ctx             sdk.Context
+		axelarnetKeeper *mock.BaseKeeperMock
+		nexusKeeper     *mock.NexusMock
+		bankKeeper      *mock.BankKeeperMock
+		server          types.MsgServiceServer
+		packet          channeltypes.Packet
+		data            ibctransfertypes.FungibleTokenPacketData
+		memo            types.RoutingMemo
+		destination     nexus.Chain
+		received        []channeltypes.Packet
+		recvAck         ibcexported.Acknowledgement
+	)
+
+	recv := func(packet channeltypes.Packet) ibcexported.Acknowledgement {
+		received = append(received, packet)
+		return recvAck
+	}
+
+	setPacketData := func() {
+		packet.Data = data.GetBytes()
+	}
+
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+		received = nil
+		recvAck = channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+
+		destination = nexus.Chain{Name: rand.StrBetween(5, 20), NativeAsset: rand.StrBetween(3, 10), SupportsForeignAssets: true, Module: rand.Str(10)}
+		memo = types.RoutingMemo{DestinationChain: destination.Name, DestinationAddress: rand.StrBetween(10, 50)}
+		bz, err := json.Marshal(memo)
+		if err != nil {
+			panic(err)
+		}
+
+		data = ibctransfertypes.NewFungibleTokenPacketData(randomDenom(), uint64(rand.I64Between(1, 100000000)), rand.AccAddr().String(), string(bz))
+		packet = channeltypes.NewPacket(data.GetBytes(), uint64(rand.PosI64()), ibctransfertypes.PortID, "channel-1", ibctransfertypes.PortID, "channel-0", clienttypes.NewHeight(0, uint64(rand.PosI64())), 0)
+		path := fmt.Sprintf("%s/%s", packet.GetDestPort(), packet.GetDestChannel())
+
+		axelarnetKeeper = &mock.BaseKeeperMock{
+			GetCosmosChainByAssetFunc: func(_ sdk.Context, asset string) (types.CosmosChain, bool) {
+				return types.CosmosChain{Name: rand.StrBetween(5, 20)}, asset == data.Denom
+			},
+			GetIBCPathFunc:            func(sdk.Context, string) (string, bool) { return path, true },
+			GetTransactionFeeRateFunc: func(sdk.Context) sdk.Dec { return sdk.ZeroDec() },
+			LoggerFunc:                func(ctx sdk.Context) log.Logger { return ctx.Logger() },
+		}
+		nexusKeeper = &mock.NexusMock{
+			GetChainFunc: func(_ sdk.Context, chain string) (nexus.Chain, bool) {
+				return destination, chain == destination.Name
+			},
+			IsAssetRegisteredFunc:  func(sdk.Context, nexus.Chain, string) bool { return true },
+			LinkAddressesFunc:      func(sdk.Context, nexus.CrossChainAddress, nexus.CrossChainAddress) error { return nil },
+			AddToChainTotalFunc:    func(sdk.Context, nexus.Chain, sdk.Coin) {},
+			EnqueueForTransferFunc: func(sdk.Context, nexus.CrossChainAddress, sdk.Coin, sdk.Dec) error { return nil },
+		}
+		bankKeeper = &mock.BankKeeperMock{
+			SendCoinsFunc: func(sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) error { return nil },
+		}
+		transferKeeper := &mock.IBCTransferKeeperMock{
+			GetDenomTraceFunc: func(sdk.Context, tmbytes.HexBytes) (ibctransfertypes.DenomTrace, bool) {
+				return ibctransfertypes.DenomTrace{Path: path, BaseDenom: data.Denom}, true
+			},
+		}
+
+		server = keeper.NewMsgServerImpl(axelarnetKeeper, nexusKeeper, bankKeeper, transferKeeper, &mock.ChannelKeeperMock{}, &mock.ClientKeeperMock{}, &mock.AccountKeeperMock{})
+	}
+
+	repeatCount := 20
+	t.Run("should receive the tokens on a linked deposit address and enqueue them for the destination", testutils.Func(func(t *testing.T) {
+		setup()
+
+		ack := onRecvPacket(ctx, axelarnetKeeper, server, recv, packet)
+		assert.True(t, ack.Success())
+		assert.Equal(t, recvAck, ack)
+
+		assert.Len(t, nexusKeeper.LinkAddressesCalls(), 1)
+		depositAddress := nexusKeeper.LinkAddressesCalls()[0].Sender
+		assert.Equal(t, exported.Axelarnet, depositAddress.Chain)
+		assert.Equal(t, nexus.CrossChainAddress{Chain: destination, Address: memo.DestinationAddress}, nexusKeeper.LinkAddressesCalls()[0].Recipient)
+
+		assert.Len(t, received, 1)
+		var receivedData ibctransfertypes.FungibleTokenPacketData
+		assert.NoError(t, ibctransfertypes.ModuleCdc.UnmarshalJSON(received[0].GetData(), &receivedData))
+		assert.Equal(t, depositAddress.Address, receivedData.Receiver)
+		assert.Equal(t, data.Denom, receivedData.Denom)
+		assert.Equal(t, data.Amount, receivedData.Amount)
+
+		prefixedDenom := ibctransfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), data.Denom)
+		voucher := ibctransfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+		assert.Len(t, bankKeeper.SendCoinsCalls(), 1)
+		assert.Equal(t, depositAddress.Address, bankKeeper.SendCoinsCalls()[0].FromAddr.String())
+		assert.Equal(t, types.GetEscrowAddress(voucher), bankKeeper.SendCoinsCalls()[0].ToAddr)
+		assert.Equal(t, sdk.NewCoins(sdk.NewCoin(voucher, sdk.NewIntFromUint64(data.Amount))), bankKeeper.SendCoinsCalls()[0].Amt)
+
+		assert.Len(t, nexusKeeper.EnqueueForTransferCalls(), 1)
+		assert.Equal(t, depositAddress, nexusKeeper.EnqueueForTransferCalls()[0].Sender)
+		assert.Equal(t, sdk.NewCoin(data.Denom, sdk.NewIntFromUint64(data.Amount)), nexusKeeper.EnqueueForTransferCalls()[0].Amount)
+
+		assert.Len(t, testutils.Events(ctx.EventManager().ABCIEvents()).Filter(func(event abci.Event) bool { return event.Type == types.EventTypeIBCRouting }), 1)
+	}).Repeat(repeatCount))
+
+	t.Run("should pass transfers without routing memo to the transfer module unchanged", testutils.Func(func(t *testing.T) {
+		setup()
+		data.Receiver = rand.AccAddr().String()
+		setPacketData()
+
+		ack := onRecvPacket(ctx, axelarnetKeeper, server, recv, packet)
+		assert.Equal(t, recvAck, ack)
+		assert.Equal(t, []channeltypes.Packet{packet}, received)
+		assert.Len(t, nexusKeeper.LinkAddressesCalls(), 0)
+		assert.Len(t, nexusKeeper.EnqueueForTransferCalls(), 0)
+	}).Repeat(repeatCount))
+
+	t.Run("should return an error acknowledgement when the routing memo is invalid", testutils.Func(func(t *testing.T) {
+		setup()
+		data.Receiver = fmt.Sprintf(`{"destination_chain":"%s","destination_address":""}`, destination.Name)
+		setPacketData()
+
+		ack := onRecvPacket(ctx, axelarnetKeeper, server, recv, packet)
+		assert.False(t, ack.Success())
+		assert.Len(t, received, 0)
+		assert.Len(t, nexusKeeper.EnqueueForTransferCalls(), 0)
+	}).Repeat(repeatCount))
+
+	t.Run("should return an error acknowledgement when the destination chain is unknown", testutils.Func(func(t *testing.T) {
+		setup()
+		nexusKeeper.GetChainFunc = func(sdk.Context, string) (nexus.Chain, bool) { return nexus.Chain{}, false }
+
+		ack := onRecvPacket(ctx, axelarnetKeeper, server, recv, packet)
+		assert.False(t, ack.Success())
+		assert.Len(t, received, 0)
+		assert.Len(t, nexusKeeper.EnqueueForTransferCalls(), 0)
+	}).Repeat(repeatCount))
+
+	t.Run("should return an error acknowledgement when the asset is not registered for the destination", testutils.Func(func(t *testing.T) {
+		setup()
+		nexusKeeper.IsAssetRegisteredFunc = func(sdk.Context, nexus.Chain, string) bool { return false }
+
+		ack := onRecvPacket(ctx, axelarnetKeeper, server, recv, packet)
+		assert.False(t, ack.Success())
+		assert.Len(t, received, 0)
+		assert.Len(t, nexusKeeper.LinkAddressesCalls(), 0)
+	}).Repeat(repeatCount))
+
+	t.Run("should return the acknowledgement of the transfer module when it fails to receive the tokens", testutils.Func(func(t *testing.T) {
+		setup()
+		recvAck = channeltypes.NewErrorAcknowledgement(rand.Str(20))
+
+		ack := onRecvPacket(ctx, axelarnetKeeper, server, recv, packet)
+		assert.Equal(t, recvAck, ack)
+		assert.Len(t, received, 1)
+		assert.Len(t, bankKeeper.SendCoinsCalls(), 0)
+		assert.Len(t, nexusKeeper.EnqueueForTransferCalls(), 0)
+	}).Repeat(repeatCount))
+
+	t.Run("should return an error acknowledgement when the deposit cannot be confirmed", testutils.Func(func(t *testing.T) {
+		setup()
+		axelarnetKeeper.GetCosmosChainByAssetFunc = func(sdk.Context, string) (types.CosmosChain, bool) { return types.CosmosChain{}, false }
+
+		ack := onRecvPacket(ctx, axelarnetKeeper, server, recv, packet)
+		assert.False(t, ack.Success())
+		assert.Len(t, received, 1)
+		assert.Len(t, nexusKeeper.EnqueueForTransferCalls(), 0)
+		assert.Len(t, testutils.Events(ctx.EventManager().ABCIEvents()).Filter(func(event abci.Event) bool { return event.Type == types.EventTypeIBCRouting }), 0)
+	}).Repeat(repeatCount))
+}
+
+func randomDenom() string {
+	return rand.Strings(3, 10).WithAlphabet([]rune("abcdefghijklmnopqrstuvwxyz")).Take(1)[0]
+}
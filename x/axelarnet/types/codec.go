@@ -18,6 +18,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&RegisterAssetRequest{}, "axelarnet/RegisterAsset", nil)
 	cdc.RegisterConcrete(&RouteIBCTransfersRequest{}, "axelarnet/RouteIBCTransfers", nil)
 	cdc.RegisterConcrete(&RegisterFeeCollectorRequest{}, "axelarnet/RegisterFeeCollector", nil)
+	cdc.RegisterConcrete(&RerouteFailedIBCTransferRequest{}, "axelarnet/RerouteFailedIBCTransfer", nil)
+	cdc.RegisterConcrete(&RefundFailedIBCTransferRequest{}, "axelarnet/RefundFailedIBCTransfer", nil)
+	cdc.RegisterConcrete(&SetCosmosChainIDRequest{}, "axelarnet/SetCosmosChainID", nil)
 }
 
 // RegisterInterfaces registers types and interfaces with the given registry
@@ -31,6 +34,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&RegisterAssetRequest{},
 		&RouteIBCTransfersRequest{},
 		&RegisterFeeCollectorRequest{},
+		&RerouteFailedIBCTransferRequest{},
+		&RefundFailedIBCTransferRequest{},
+		&SetCosmosChainIDRequest{},
 	)
 	registry.RegisterInterface("reward.v1beta1.Refundable",
 		(*exported.Refundable)(nil))
@@ -4,6 +4,8 @@ package types
 const (
 	EventTypeDepositConfirmation = "depositConfirmation"
 	EventTypeLink                = "link"
+	EventTypeIBCRouting          = "ibcRouting"
+	EventTypeIBCTransfer         = "ibcTransfer"
 )
 
 // Event attribute keys
@@ -14,9 +16,19 @@ const (
 	AttributeKeyDepositAddress     = "depositAddress"
 	AttributeKeyDestinationAddress = "destinationAddress"
 	AttributeKeyDestinationChain   = "destinationChain"
+	AttributeKeySourceChannel      = "sourceChannel"
+	AttributeKeySequence           = "sequence"
+	AttributeKeyTransferID         = "transferID"
+	AttributeKeyAttempts           = "attempts"
+	AttributeKeyRecipient          = "recipient"
 )
 
 // Event attribute values
 const (
 	AttributeValueConfirm = "confirm"
+	AttributeValueRouted  = "routed"
+	AttributeValueRetry   = "retry"
+	AttributeValueFailed  = "failed"
+	AttributeValueReroute = "reroute"
+	AttributeValueRefund  = "refund"
 )
@@ -1,7 +1,11 @@
 package types
 
 import (
+	"context"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
 	ibcclient "github.com/cosmos/ibc-go/modules/core/exported"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,7 +16,7 @@ import (
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 )
 
-//go:generate moq -out ./mock/expected_keepers.go -pkg mock . BaseKeeper  Nexus  BankKeeper IBCTransferKeeper ChannelKeeper AccountKeeper
+//go:generate moq -out ./mock/expected_keepers.go -pkg mock . BaseKeeper  Nexus  BankKeeper IBCTransferKeeper ChannelKeeper ClientKeeper AccountKeeper
 
 // BaseKeeper is implemented by this module's base keeper
 type BaseKeeper interface {
@@ -22,11 +26,15 @@ type BaseKeeper interface {
 
 	RegisterIBCPath(ctx sdk.Context, asset, path string) error
 	GetIBCPath(ctx sdk.Context, chain string) (string, bool)
+	GetCosmosChainByIBCPath(ctx sdk.Context, path string) (CosmosChain, bool)
 	GetFeeCollector(ctx sdk.Context) (sdk.AccAddress, bool)
 	SetFeeCollector(ctx sdk.Context, address sdk.AccAddress) error
 	SetPendingIBCTransfer(ctx sdk.Context, transfer IBCTransfer)
 	GetPendingIBCTransfer(ctx sdk.Context, portID, channelID string, sequence uint64) (IBCTransfer, bool)
 	DeletePendingIBCTransfer(ctx sdk.Context, portID, channelID string, sequence uint64)
+	GetFailedIBCTransfer(ctx sdk.Context, id uint64) (IBCTransfer, bool)
+	GetFailedIBCTransfers(ctx sdk.Context) []IBCTransfer
+	DeleteFailedIBCTransfer(ctx sdk.Context, id uint64)
 	GetCosmosChains(ctx sdk.Context) []string
 	RegisterAssetToCosmosChain(ctx sdk.Context, asset Asset, chain string) error
 	GetCosmosChainByAsset(ctx sdk.Context, asset string) (CosmosChain, bool)
@@ -41,9 +49,15 @@ type Nexus interface {
 	GetTransfersForChain(ctx sdk.Context, chain nexus.Chain, state nexus.TransferState) []nexus.CrossChainTransfer
 	ArchivePendingTransfer(ctx sdk.Context, transfer nexus.CrossChainTransfer)
 	GetChain(ctx sdk.Context, chain string) (nexus.Chain, bool)
+	GetChains(ctx sdk.Context) []nexus.Chain
+	GetChainTotal(ctx sdk.Context, chain nexus.Chain, denom string) sdk.Coin
 	LinkAddresses(ctx sdk.Context, sender nexus.CrossChainAddress, recipient nexus.CrossChainAddress) error
 	IsAssetRegistered(ctx sdk.Context, chain nexus.Chain, denom string) bool
 	RegisterAsset(ctx sdk.Context, chain nexus.Chain, denom string)
+	SetAssetPrecision(ctx sdk.Context, asset string, decimals uint32) error
+	GetAssetPrecision(ctx sdk.Context, asset string) (uint32, bool)
+	GetChainsWithAsset(ctx sdk.Context, denom string) []nexus.Chain
+	RegisterAssetDecimals(ctx sdk.Context, chain nexus.Chain, asset string, decimals uint32) error
 	GetRecipient(ctx sdk.Context, sender nexus.CrossChainAddress) (nexus.CrossChainAddress, bool)
 	AddToChainTotal(ctx sdk.Context, chain nexus.Chain, amount sdk.Coin)
 	SetChain(ctx sdk.Context, chain nexus.Chain)
@@ -57,6 +71,8 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
 }
 
 // IBCTransferKeeper provides functionality to manage IBC transfers
@@ -69,6 +85,12 @@ type IBCTransferKeeper interface {
 type ChannelKeeper interface {
 	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
 	GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, ibcclient.ClientState, error)
+	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
+}
+
+// ClientKeeper provides functionality to query the status of IBC light clients
+type ClientKeeper interface {
+	ClientStatus(c context.Context, req *clienttypes.QueryClientStatusRequest) (*clienttypes.QueryClientStatusResponse, error)
 }
 
 // AccountKeeper defines the account contract that must be fulfilled when
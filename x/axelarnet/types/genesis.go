@@ -8,14 +8,19 @@ import (
 )
 
 // NewGenesisState returns a new GenesisState instance
-func NewGenesisState(p Params, feeCollector sdk.AccAddress, chains []CosmosChain, transfers []IBCTransfer) *GenesisState {
+func NewGenesisState(p Params, feeCollector sdk.AccAddress, chains []CosmosChain, transfers []IBCTransfer, retrying []IBCTransfer, failed []IBCTransfer, transferNonce uint64) *GenesisState {
 	SortChains(chains)
 	SortTransfers(transfers)
+	SortTransfers(retrying)
+	SortTransfers(failed)
 	return &GenesisState{
-		Params:           p,
-		CollectorAddress: feeCollector,
-		Chains:           chains,
-		PendingTransfers: transfers,
+		Params:            p,
+		CollectorAddress:  feeCollector,
+		Chains:            chains,
+		PendingTransfers:  transfers,
+		RetryingTransfers: retrying,
+		FailedTransfers:   failed,
+		TransferNonce:     transferNonce,
 	}
 }
 
@@ -32,7 +37,9 @@ func DefaultGenesisState() *GenesisState {
 				MinAmount: sdk.NewInt(100000),
 			}},
 		}},
-		PendingTransfers: []IBCTransfer{},
+		PendingTransfers:  []IBCTransfer{},
+		RetryingTransfers: []IBCTransfer{},
+		FailedTransfers:   []IBCTransfer{},
 	}
 }
 
@@ -60,6 +67,34 @@ func (m GenesisState) Validate() error {
 		}
 	}
 
+	ids := make(map[uint64]bool)
+	for i, transfer := range append(m.RetryingTransfers, m.FailedTransfers...) {
+		if err := transfer.Validate(); err != nil {
+			return getValidateError(sdkerrors.Wrap(err, fmt.Sprintf("faulty retrying or failed transfer entry %d", i)))
+		}
+
+		if transfer.ID == 0 || transfer.ID > m.TransferNonce {
+			return getValidateError(fmt.Errorf("transfer ID %d is not covered by the transfer nonce %d", transfer.ID, m.TransferNonce))
+		}
+
+		if ids[transfer.ID] {
+			return getValidateError(fmt.Errorf("duplicate transfer ID %d", transfer.ID))
+		}
+		ids[transfer.ID] = true
+	}
+
+	for _, transfer := range m.RetryingTransfers {
+		if transfer.Status != TransferRetrying {
+			return getValidateError(fmt.Errorf("retrying transfer %d has status %s", transfer.ID, transfer.Status))
+		}
+	}
+
+	for _, transfer := range m.FailedTransfers {
+		if transfer.Status != TransferFailed {
+			return getValidateError(fmt.Errorf("failed transfer %d has status %s", transfer.ID, transfer.Status))
+		}
+	}
+
 	return nil
 }
 
@@ -25,10 +25,13 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Params           Params                                        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	CollectorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=collector_address,json=collectorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"collector_address,omitempty"`
-	Chains           []CosmosChain                                 `protobuf:"bytes,3,rep,name=chains,proto3" json:"chains"`
-	PendingTransfers []IBCTransfer                                 `protobuf:"bytes,4,rep,name=pending_transfers,json=pendingTransfers,proto3" json:"pending_transfers"`
+	Params            Params                                        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	CollectorAddress  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=collector_address,json=collectorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"collector_address,omitempty"`
+	Chains            []CosmosChain                                 `protobuf:"bytes,3,rep,name=chains,proto3" json:"chains"`
+	PendingTransfers  []IBCTransfer                                 `protobuf:"bytes,4,rep,name=pending_transfers,json=pendingTransfers,proto3" json:"pending_transfers"`
+	RetryingTransfers []IBCTransfer                                 `protobuf:"bytes,5,rep,name=retrying_transfers,json=retryingTransfers,proto3" json:"retrying_transfers"`
+	FailedTransfers   []IBCTransfer                                 `protobuf:"bytes,6,rep,name=failed_transfers,json=failedTransfers,proto3" json:"failed_transfers"`
+	TransferNonce     uint64                                        `protobuf:"varint,7,opt,name=transfer_nonce,json=transferNonce,proto3" json:"transfer_nonce,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,29 +74,32 @@ func init() {
 func init() { proto.RegisterFile("axelarnet/v1beta1/genesis.proto", fileDescriptor_66897da6dce28ff8) }
 
 var fileDescriptor_66897da6dce28ff8 = []byte{
-	// 339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x31, 0x4f, 0x32, 0x31,
-	0x1c, 0xc6, 0xaf, 0x40, 0x18, 0x0a, 0x03, 0x5c, 0xde, 0xe1, 0x5e, 0x12, 0x0b, 0x71, 0x62, 0xe1,
-	0x2e, 0x60, 0xa2, 0x8b, 0x0b, 0xc7, 0x60, 0xdc, 0x14, 0x99, 0x1c, 0x24, 0xa5, 0xf7, 0xf7, 0xb8,
-	0x00, 0xed, 0xa5, 0xad, 0x8a, 0xdf, 0xc2, 0x8f, 0xe3, 0x47, 0x60, 0x64, 0x74, 0x22, 0x0a, 0xdf,
-	0xc2, 0xc9, 0x70, 0x2d, 0x68, 0x82, 0x4e, 0x77, 0xfd, 0x3f, 0xcf, 0xf3, 0xeb, 0xd3, 0x16, 0xd7,
-	0xe9, 0x1c, 0xa6, 0x54, 0x72, 0xd0, 0xc1, 0x63, 0x7b, 0x04, 0x9a, 0xb6, 0x83, 0x18, 0x38, 0xa8,
-	0x44, 0xf9, 0xa9, 0x14, 0x5a, 0xb8, 0xd5, 0xbd, 0xc1, 0xb7, 0x86, 0xda, 0xbf, 0x58, 0xc4, 0x22,
-	0x53, 0x83, 0xed, 0x9f, 0x31, 0xd6, 0xc8, 0x21, 0x29, 0xa5, 0x92, 0xce, 0x2c, 0xa8, 0x76, 0x74,
-	0xa8, 0xeb, 0xe7, 0x14, 0xac, 0x7c, 0xfc, 0x9a, 0xc3, 0xe5, 0x0b, 0xb3, 0xf3, 0x8d, 0xa6, 0x1a,
-	0xdc, 0x33, 0x5c, 0x34, 0x79, 0x0f, 0x35, 0x50, 0xb3, 0xd4, 0xf9, 0xef, 0x1f, 0x34, 0xf1, 0xaf,
-	0x32, 0x43, 0x58, 0x58, 0xac, 0xea, 0x4e, 0xdf, 0xda, 0xdd, 0x3b, 0x5c, 0x65, 0x62, 0x3a, 0x05,
-	0xa6, 0x85, 0x1c, 0xd2, 0x28, 0x92, 0xa0, 0x94, 0x97, 0x6b, 0xa0, 0x66, 0x39, 0x6c, 0x7f, 0xae,
-	0xea, 0xad, 0x38, 0xd1, 0xe3, 0x87, 0x91, 0xcf, 0xc4, 0x2c, 0x60, 0x42, 0xcd, 0x84, 0xb2, 0x9f,
-	0x96, 0x8a, 0x26, 0xb6, 0x52, 0x97, 0xb1, 0xae, 0x09, 0xf6, 0x2b, 0x7b, 0x96, 0x9d, 0xb8, 0xe7,
-	0xb8, 0xc8, 0xc6, 0x34, 0xe1, 0xca, 0xcb, 0x37, 0xf2, 0xcd, 0x52, 0x87, 0xfc, 0x52, 0xac, 0x97,
-	0x11, 0x7b, 0x5b, 0xdb, 0xae, 0x9d, 0xc9, 0xb8, 0xd7, 0xb8, 0x9a, 0x02, 0x8f, 0x12, 0x1e, 0x0f,
-	0xb5, 0xa4, 0x5c, 0xdd, 0x83, 0x54, 0x5e, 0xe1, 0x4f, 0xd0, 0x65, 0xd8, 0x1b, 0x58, 0x9b, 0x05,
-	0x55, 0x6c, 0x7c, 0x37, 0x56, 0xe1, 0x60, 0xf1, 0x41, 0x9c, 0xc5, 0x9a, 0xa0, 0xe5, 0x9a, 0xa0,
-	0xf7, 0x35, 0x41, 0x2f, 0x1b, 0xe2, 0x2c, 0x37, 0xc4, 0x79, 0xdb, 0x10, 0xe7, 0xf6, 0xf4, 0xc7,
-	0x79, 0xf7, 0xfc, 0x27, 0x21, 0x27, 0x76, 0xd5, 0x62, 0x42, 0x42, 0x30, 0xff, 0xd6, 0xcc, 0x1d,
-	0x8c, 0x8a, 0xd9, 0xbb, 0x9c, 0x7c, 0x05, 0x00, 0x00, 0xff, 0xff, 0x32, 0x44, 0xc4, 0x2f, 0x22,
-	0x02, 0x00, 0x00,
+	// 394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xbd, 0x8e, 0xda, 0x30,
+	0x00, 0xc7, 0x93, 0x92, 0xa6, 0x92, 0xa1, 0x2d, 0x89, 0x3a, 0xa4, 0x48, 0x35, 0x51, 0xa5, 0x4a,
+	0x59, 0x48, 0x04, 0x95, 0xda, 0xa5, 0x0b, 0x61, 0xa8, 0xba, 0xf4, 0x03, 0x98, 0x3a, 0x14, 0x19,
+	0xc7, 0x84, 0x88, 0x60, 0x47, 0xb6, 0xdb, 0xc2, 0x03, 0x74, 0xef, 0x63, 0x31, 0x32, 0xde, 0x84,
+	0xee, 0xe0, 0x2d, 0x6e, 0x3a, 0x91, 0x38, 0xc0, 0x89, 0xbb, 0x81, 0x29, 0xc9, 0xff, 0xe3, 0x97,
+	0xbf, 0x64, 0x83, 0x26, 0x5a, 0x90, 0x14, 0x71, 0x4a, 0x64, 0xf0, 0xa7, 0x3d, 0x26, 0x12, 0xb5,
+	0x83, 0x98, 0x50, 0x22, 0x12, 0xe1, 0x67, 0x9c, 0x49, 0x66, 0x5b, 0x87, 0x80, 0xaf, 0x02, 0x8d,
+	0x57, 0x31, 0x8b, 0x59, 0xee, 0x06, 0xfb, 0xb7, 0x22, 0xd8, 0x80, 0xe7, 0xa4, 0x0c, 0x71, 0x34,
+	0x57, 0xa0, 0xc6, 0x9b, 0x73, 0x5f, 0x2e, 0x33, 0xa2, 0xec, 0xb7, 0xff, 0x0c, 0x50, 0xfb, 0x5c,
+	0xfc, 0x79, 0x20, 0x91, 0x24, 0xf6, 0x47, 0x60, 0x16, 0x7d, 0x47, 0x77, 0x75, 0xaf, 0xda, 0x79,
+	0xed, 0x9f, 0x2d, 0xf1, 0xbf, 0xe7, 0x81, 0xd0, 0x58, 0x6d, 0x9a, 0x5a, 0x5f, 0xc5, 0xed, 0x5f,
+	0xc0, 0xc2, 0x2c, 0x4d, 0x09, 0x96, 0x8c, 0x8f, 0x50, 0x14, 0x71, 0x22, 0x84, 0xf3, 0xc4, 0xd5,
+	0xbd, 0x5a, 0xd8, 0xbe, 0xdd, 0x34, 0x5b, 0x71, 0x22, 0xa7, 0xbf, 0xc7, 0x3e, 0x66, 0xf3, 0x00,
+	0x33, 0x31, 0x67, 0x42, 0x3d, 0x5a, 0x22, 0x9a, 0xa9, 0x49, 0x5d, 0x8c, 0xbb, 0x45, 0xb1, 0x5f,
+	0x3f, 0xb0, 0x94, 0x62, 0x7f, 0x02, 0x26, 0x9e, 0xa2, 0x84, 0x0a, 0xa7, 0xe2, 0x56, 0xbc, 0x6a,
+	0x07, 0x3e, 0x30, 0xac, 0x97, 0x13, 0x7b, 0xfb, 0x58, 0xb9, 0xae, 0xe8, 0xd8, 0x3f, 0x80, 0x95,
+	0x11, 0x1a, 0x25, 0x34, 0x1e, 0x49, 0x8e, 0xa8, 0x98, 0x10, 0x2e, 0x1c, 0xe3, 0x51, 0xd0, 0x97,
+	0xb0, 0x37, 0x54, 0x31, 0x05, 0xaa, 0xab, 0x7a, 0x29, 0x0b, 0x7b, 0x00, 0x6c, 0x4e, 0x24, 0x5f,
+	0xde, 0x67, 0x3e, 0xbd, 0x80, 0x69, 0x95, 0xfd, 0x23, 0xf4, 0x1b, 0xa8, 0x4f, 0x50, 0x92, 0x92,
+	0xe8, 0x04, 0x69, 0x5e, 0x80, 0x7c, 0x59, 0xb4, 0x8f, 0xc0, 0x77, 0xe0, 0x45, 0x49, 0x1a, 0x51,
+	0x46, 0x31, 0x71, 0x9e, 0xb9, 0xba, 0x67, 0xf4, 0x9f, 0x97, 0xea, 0xd7, 0xbd, 0x18, 0x0e, 0x57,
+	0x37, 0x50, 0x5b, 0x6d, 0xa1, 0xbe, 0xde, 0x42, 0xfd, 0x7a, 0x0b, 0xf5, 0xff, 0x3b, 0xa8, 0xad,
+	0x77, 0x50, 0xbb, 0xda, 0x41, 0xed, 0xe7, 0x87, 0x93, 0xc3, 0x3b, 0xac, 0xf8, 0xcb, 0xf8, 0x4c,
+	0x7d, 0xb5, 0x30, 0xe3, 0x24, 0x58, 0x1c, 0xbd, 0xe2, 0x40, 0xc7, 0x66, 0x7e, 0xc9, 0xde, 0xdf,
+	0x0d, 0x00, 0xca, 0x0b, 0x4e, 0xce, 0xef, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -116,6 +122,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TransferNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TransferNonce))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.FailedTransfers) > 0 {
+		for iNdEx := len(m.FailedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RetryingTransfers) > 0 {
+		for iNdEx := len(m.RetryingTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetryingTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.PendingTransfers) > 0 {
 		for iNdEx := len(m.PendingTransfers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -199,6 +238,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RetryingTransfers) > 0 {
+		for _, e := range m.RetryingTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FailedTransfers) > 0 {
+		for _, e := range m.FailedTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TransferNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.TransferNonce))
+	}
 	return n
 }
 
@@ -372,6 +426,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryingTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryingTransfers = append(m.RetryingTransfers, IBCTransfer{})
+			if err := m.RetryingTransfers[len(m.RetryingTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedTransfers = append(m.FailedTransfers, IBCTransfer{})
+			if err := m.FailedTransfers[len(m.FailedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferNonce", wireType)
+			}
+			m.TransferNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4,11 +4,14 @@
 package mock
 
 import (
+	context "context"
 	axelarnettypes "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
 	exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	cosmossdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	ibctypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
 	ibcclient "github.com/cosmos/ibc-go/modules/core/exported"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	"github.com/tendermint/tendermint/libs/log"
@@ -25,6 +28,9 @@ var _ axelarnettypes.BaseKeeper = &BaseKeeperMock{}
 //
 // 		// make and configure a mocked axelarnettypes.BaseKeeper
 // 		mockedBaseKeeper := &BaseKeeperMock{
+// 			DeleteFailedIBCTransferFunc: func(ctx cosmossdktypes.Context, id uint64)  {
+// 				panic("mock out the DeleteFailedIBCTransfer method")
+// 			},
 // 			DeletePendingIBCTransferFunc: func(ctx cosmossdktypes.Context, portID string, channelID string, sequence uint64)  {
 // 				panic("mock out the DeletePendingIBCTransfer method")
 // 			},
@@ -34,12 +40,21 @@ var _ axelarnettypes.BaseKeeper = &BaseKeeperMock{}
 // 			GetCosmosChainByAssetFunc: func(ctx cosmossdktypes.Context, asset string) (axelarnettypes.CosmosChain, bool) {
 // 				panic("mock out the GetCosmosChainByAsset method")
 // 			},
+// 			GetCosmosChainByIBCPathFunc: func(ctx cosmossdktypes.Context, path string) (axelarnettypes.CosmosChain, bool) {
+// 				panic("mock out the GetCosmosChainByIBCPath method")
+// 			},
 // 			GetCosmosChainByNameFunc: func(ctx cosmossdktypes.Context, chain string) (axelarnettypes.CosmosChain, bool) {
 // 				panic("mock out the GetCosmosChainByName method")
 // 			},
 // 			GetCosmosChainsFunc: func(ctx cosmossdktypes.Context) []string {
 // 				panic("mock out the GetCosmosChains method")
 // 			},
+// 			GetFailedIBCTransferFunc: func(ctx cosmossdktypes.Context, id uint64) (axelarnettypes.IBCTransfer, bool) {
+// 				panic("mock out the GetFailedIBCTransfer method")
+// 			},
+// 			GetFailedIBCTransfersFunc: func(ctx cosmossdktypes.Context) []axelarnettypes.IBCTransfer {
+// 				panic("mock out the GetFailedIBCTransfers method")
+// 			},
 // 			GetFeeCollectorFunc: func(ctx cosmossdktypes.Context) (cosmossdktypes.AccAddress, bool) {
 // 				panic("mock out the GetFeeCollector method")
 // 			},
@@ -80,6 +95,9 @@ var _ axelarnettypes.BaseKeeper = &BaseKeeperMock{}
 //
 // 	}
 type BaseKeeperMock struct {
+	// DeleteFailedIBCTransferFunc mocks the DeleteFailedIBCTransfer method.
+	DeleteFailedIBCTransferFunc func(ctx cosmossdktypes.Context, id uint64)
+
 	// DeletePendingIBCTransferFunc mocks the DeletePendingIBCTransfer method.
 	DeletePendingIBCTransferFunc func(ctx cosmossdktypes.Context, portID string, channelID string, sequence uint64)
 
@@ -89,12 +107,21 @@ type BaseKeeperMock struct {
 	// GetCosmosChainByAssetFunc mocks the GetCosmosChainByAsset method.
 	GetCosmosChainByAssetFunc func(ctx cosmossdktypes.Context, asset string) (axelarnettypes.CosmosChain, bool)
 
+	// GetCosmosChainByIBCPathFunc mocks the GetCosmosChainByIBCPath method.
+	GetCosmosChainByIBCPathFunc func(ctx cosmossdktypes.Context, path string) (axelarnettypes.CosmosChain, bool)
+
 	// GetCosmosChainByNameFunc mocks the GetCosmosChainByName method.
 	GetCosmosChainByNameFunc func(ctx cosmossdktypes.Context, chain string) (axelarnettypes.CosmosChain, bool)
 
 	// GetCosmosChainsFunc mocks the GetCosmosChains method.
 	GetCosmosChainsFunc func(ctx cosmossdktypes.Context) []string
 
+	// GetFailedIBCTransferFunc mocks the GetFailedIBCTransfer method.
+	GetFailedIBCTransferFunc func(ctx cosmossdktypes.Context, id uint64) (axelarnettypes.IBCTransfer, bool)
+
+	// GetFailedIBCTransfersFunc mocks the GetFailedIBCTransfers method.
+	GetFailedIBCTransfersFunc func(ctx cosmossdktypes.Context) []axelarnettypes.IBCTransfer
+
 	// GetFeeCollectorFunc mocks the GetFeeCollector method.
 	GetFeeCollectorFunc func(ctx cosmossdktypes.Context) (cosmossdktypes.AccAddress, bool)
 
@@ -130,6 +157,13 @@ type BaseKeeperMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// DeleteFailedIBCTransfer holds details about calls to the DeleteFailedIBCTransfer method.
+		DeleteFailedIBCTransfer []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// ID is the id argument value.
+			ID uint64
+		}
 		// DeletePendingIBCTransfer holds details about calls to the DeletePendingIBCTransfer method.
 		DeletePendingIBCTransfer []struct {
 			// Ctx is the ctx argument value.
@@ -157,6 +191,13 @@ type BaseKeeperMock struct {
 			// Asset is the asset argument value.
 			Asset string
 		}
+		// GetCosmosChainByIBCPath holds details about calls to the GetCosmosChainByIBCPath method.
+		GetCosmosChainByIBCPath []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Path is the path argument value.
+			Path string
+		}
 		// GetCosmosChainByName holds details about calls to the GetCosmosChainByName method.
 		GetCosmosChainByName []struct {
 			// Ctx is the ctx argument value.
@@ -169,6 +210,18 @@ type BaseKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx cosmossdktypes.Context
 		}
+		// GetFailedIBCTransfer holds details about calls to the GetFailedIBCTransfer method.
+		GetFailedIBCTransfer []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// ID is the id argument value.
+			ID uint64
+		}
+		// GetFailedIBCTransfers holds details about calls to the GetFailedIBCTransfers method.
+		GetFailedIBCTransfers []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+		}
 		// GetFeeCollector holds details about calls to the GetFeeCollector method.
 		GetFeeCollector []struct {
 			// Ctx is the ctx argument value.
@@ -247,11 +300,15 @@ type BaseKeeperMock struct {
 			Transfer axelarnettypes.IBCTransfer
 		}
 	}
+	lockDeleteFailedIBCTransfer    sync.RWMutex
 	lockDeletePendingIBCTransfer   sync.RWMutex
 	lockGetAsset                   sync.RWMutex
 	lockGetCosmosChainByAsset      sync.RWMutex
+	lockGetCosmosChainByIBCPath    sync.RWMutex
 	lockGetCosmosChainByName       sync.RWMutex
 	lockGetCosmosChains            sync.RWMutex
+	lockGetFailedIBCTransfer       sync.RWMutex
+	lockGetFailedIBCTransfers      sync.RWMutex
 	lockGetFeeCollector            sync.RWMutex
 	lockGetIBCPath                 sync.RWMutex
 	lockGetPendingIBCTransfer      sync.RWMutex
@@ -265,6 +322,41 @@ type BaseKeeperMock struct {
 	lockSetPendingIBCTransfer      sync.RWMutex
 }
 
+// DeleteFailedIBCTransfer calls DeleteFailedIBCTransferFunc.
+func (mock *BaseKeeperMock) DeleteFailedIBCTransfer(ctx cosmossdktypes.Context, id uint64) {
+	if mock.DeleteFailedIBCTransferFunc == nil {
+		panic("BaseKeeperMock.DeleteFailedIBCTransferFunc: method is nil but BaseKeeper.DeleteFailedIBCTransfer was just called")
+	}
+	callInfo := struct {
+		Ctx cosmossdktypes.Context
+		ID  uint64
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteFailedIBCTransfer.Lock()
+	mock.calls.DeleteFailedIBCTransfer = append(mock.calls.DeleteFailedIBCTransfer, callInfo)
+	mock.lockDeleteFailedIBCTransfer.Unlock()
+	mock.DeleteFailedIBCTransferFunc(ctx, id)
+}
+
+// DeleteFailedIBCTransferCalls gets all the calls that were made to DeleteFailedIBCTransfer.
+// Check the length with:
+//     len(mockedBaseKeeper.DeleteFailedIBCTransferCalls())
+func (mock *BaseKeeperMock) DeleteFailedIBCTransferCalls() []struct {
+	Ctx cosmossdktypes.Context
+	ID  uint64
+} {
+	var calls []struct {
+		Ctx cosmossdktypes.Context
+		ID  uint64
+	}
+	mock.lockDeleteFailedIBCTransfer.RLock()
+	calls = mock.calls.DeleteFailedIBCTransfer
+	mock.lockDeleteFailedIBCTransfer.RUnlock()
+	return calls
+}
+
 // DeletePendingIBCTransfer calls DeletePendingIBCTransferFunc.
 func (mock *BaseKeeperMock) DeletePendingIBCTransfer(ctx cosmossdktypes.Context, portID string, channelID string, sequence uint64) {
 	if mock.DeletePendingIBCTransferFunc == nil {
@@ -382,6 +474,41 @@ func (mock *BaseKeeperMock) GetCosmosChainByAssetCalls() []struct {
 	return calls
 }
 
+// GetCosmosChainByIBCPath calls GetCosmosChainByIBCPathFunc.
+func (mock *BaseKeeperMock) GetCosmosChainByIBCPath(ctx cosmossdktypes.Context, path string) (axelarnettypes.CosmosChain, bool) {
+	if mock.GetCosmosChainByIBCPathFunc == nil {
+		panic("BaseKeeperMock.GetCosmosChainByIBCPathFunc: method is nil but BaseKeeper.GetCosmosChainByIBCPath was just called")
+	}
+	callInfo := struct {
+		Ctx  cosmossdktypes.Context
+		Path string
+	}{
+		Ctx:  ctx,
+		Path: path,
+	}
+	mock.lockGetCosmosChainByIBCPath.Lock()
+	mock.calls.GetCosmosChainByIBCPath = append(mock.calls.GetCosmosChainByIBCPath, callInfo)
+	mock.lockGetCosmosChainByIBCPath.Unlock()
+	return mock.GetCosmosChainByIBCPathFunc(ctx, path)
+}
+
+// GetCosmosChainByIBCPathCalls gets all the calls that were made to GetCosmosChainByIBCPath.
+// Check the length with:
+//     len(mockedBaseKeeper.GetCosmosChainByIBCPathCalls())
+func (mock *BaseKeeperMock) GetCosmosChainByIBCPathCalls() []struct {
+	Ctx  cosmossdktypes.Context
+	Path string
+} {
+	var calls []struct {
+		Ctx  cosmossdktypes.Context
+		Path string
+	}
+	mock.lockGetCosmosChainByIBCPath.RLock()
+	calls = mock.calls.GetCosmosChainByIBCPath
+	mock.lockGetCosmosChainByIBCPath.RUnlock()
+	return calls
+}
+
 // GetCosmosChainByName calls GetCosmosChainByNameFunc.
 func (mock *BaseKeeperMock) GetCosmosChainByName(ctx cosmossdktypes.Context, chain string) (axelarnettypes.CosmosChain, bool) {
 	if mock.GetCosmosChainByNameFunc == nil {
@@ -448,6 +575,72 @@ func (mock *BaseKeeperMock) GetCosmosChainsCalls() []struct {
 	return calls
 }
 
+// GetFailedIBCTransfer calls GetFailedIBCTransferFunc.
+func (mock *BaseKeeperMock) GetFailedIBCTransfer(ctx cosmossdktypes.Context, id uint64) (axelarnettypes.IBCTransfer, bool) {
+	if mock.GetFailedIBCTransferFunc == nil {
+		panic("BaseKeeperMock.GetFailedIBCTransferFunc: method is nil but BaseKeeper.GetFailedIBCTransfer was just called")
+	}
+	callInfo := struct {
+		Ctx cosmossdktypes.Context
+		ID  uint64
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetFailedIBCTransfer.Lock()
+	mock.calls.GetFailedIBCTransfer = append(mock.calls.GetFailedIBCTransfer, callInfo)
+	mock.lockGetFailedIBCTransfer.Unlock()
+	return mock.GetFailedIBCTransferFunc(ctx, id)
+}
+
+// GetFailedIBCTransferCalls gets all the calls that were made to GetFailedIBCTransfer.
+// Check the length with:
+//     len(mockedBaseKeeper.GetFailedIBCTransferCalls())
+func (mock *BaseKeeperMock) GetFailedIBCTransferCalls() []struct {
+	Ctx cosmossdktypes.Context
+	ID  uint64
+} {
+	var calls []struct {
+		Ctx cosmossdktypes.Context
+		ID  uint64
+	}
+	mock.lockGetFailedIBCTransfer.RLock()
+	calls = mock.calls.GetFailedIBCTransfer
+	mock.lockGetFailedIBCTransfer.RUnlock()
+	return calls
+}
+
+// GetFailedIBCTransfers calls GetFailedIBCTransfersFunc.
+func (mock *BaseKeeperMock) GetFailedIBCTransfers(ctx cosmossdktypes.Context) []axelarnettypes.IBCTransfer {
+	if mock.GetFailedIBCTransfersFunc == nil {
+		panic("BaseKeeperMock.GetFailedIBCTransfersFunc: method is nil but BaseKeeper.GetFailedIBCTransfers was just called")
+	}
+	callInfo := struct {
+		Ctx cosmossdktypes.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetFailedIBCTransfers.Lock()
+	mock.calls.GetFailedIBCTransfers = append(mock.calls.GetFailedIBCTransfers, callInfo)
+	mock.lockGetFailedIBCTransfers.Unlock()
+	return mock.GetFailedIBCTransfersFunc(ctx)
+}
+
+// GetFailedIBCTransfersCalls gets all the calls that were made to GetFailedIBCTransfers.
+// Check the length with:
+//     len(mockedBaseKeeper.GetFailedIBCTransfersCalls())
+func (mock *BaseKeeperMock) GetFailedIBCTransfersCalls() []struct {
+	Ctx cosmossdktypes.Context
+} {
+	var calls []struct {
+		Ctx cosmossdktypes.Context
+	}
+	mock.lockGetFailedIBCTransfers.RLock()
+	calls = mock.calls.GetFailedIBCTransfers
+	mock.lockGetFailedIBCTransfers.RUnlock()
+	return calls
+}
+
 // GetFeeCollector calls GetFeeCollectorFunc.
 func (mock *BaseKeeperMock) GetFeeCollector(ctx cosmossdktypes.Context) (cosmossdktypes.AccAddress, bool) {
 	if mock.GetFeeCollectorFunc == nil {
@@ -852,9 +1045,21 @@ var _ axelarnettypes.Nexus = &NexusMock{}
 // 			EnqueueForTransferFunc: func(ctx cosmossdktypes.Context, sender exported.CrossChainAddress, amount cosmossdktypes.Coin, feeRate cosmossdktypes.Dec) error {
 // 				panic("mock out the EnqueueForTransfer method")
 // 			},
+// 			GetAssetPrecisionFunc: func(ctx cosmossdktypes.Context, asset string) (uint32, bool) {
+// 				panic("mock out the GetAssetPrecision method")
+// 			},
 // 			GetChainFunc: func(ctx cosmossdktypes.Context, chain string) (exported.Chain, bool) {
 // 				panic("mock out the GetChain method")
 // 			},
+// 			GetChainTotalFunc: func(ctx cosmossdktypes.Context, chain exported.Chain, denom string) cosmossdktypes.Coin {
+// 				panic("mock out the GetChainTotal method")
+// 			},
+// 			GetChainsFunc: func(ctx cosmossdktypes.Context) []exported.Chain {
+// 				panic("mock out the GetChains method")
+// 			},
+// 			GetChainsWithAssetFunc: func(ctx cosmossdktypes.Context, denom string) []exported.Chain {
+// 				panic("mock out the GetChainsWithAsset method")
+// 			},
 // 			GetRecipientFunc: func(ctx cosmossdktypes.Context, sender exported.CrossChainAddress) (exported.CrossChainAddress, bool) {
 // 				panic("mock out the GetRecipient method")
 // 			},
@@ -870,6 +1075,12 @@ var _ axelarnettypes.Nexus = &NexusMock{}
 // 			RegisterAssetFunc: func(ctx cosmossdktypes.Context, chain exported.Chain, denom string)  {
 // 				panic("mock out the RegisterAsset method")
 // 			},
+// 			RegisterAssetDecimalsFunc: func(ctx cosmossdktypes.Context, chain exported.Chain, asset string, decimals uint32) error {
+// 				panic("mock out the RegisterAssetDecimals method")
+// 			},
+// 			SetAssetPrecisionFunc: func(ctx cosmossdktypes.Context, asset string, decimals uint32) error {
+// 				panic("mock out the SetAssetPrecision method")
+// 			},
 // 			SetChainFunc: func(ctx cosmossdktypes.Context, chain exported.Chain)  {
 // 				panic("mock out the SetChain method")
 // 			},
@@ -889,9 +1100,21 @@ type NexusMock struct {
 	// EnqueueForTransferFunc mocks the EnqueueForTransfer method.
 	EnqueueForTransferFunc func(ctx cosmossdktypes.Context, sender exported.CrossChainAddress, amount cosmossdktypes.Coin, feeRate cosmossdktypes.Dec) error
 
+	// GetAssetPrecisionFunc mocks the GetAssetPrecision method.
+	GetAssetPrecisionFunc func(ctx cosmossdktypes.Context, asset string) (uint32, bool)
+
 	// GetChainFunc mocks the GetChain method.
 	GetChainFunc func(ctx cosmossdktypes.Context, chain string) (exported.Chain, bool)
 
+	// GetChainTotalFunc mocks the GetChainTotal method.
+	GetChainTotalFunc func(ctx cosmossdktypes.Context, chain exported.Chain, denom string) cosmossdktypes.Coin
+
+	// GetChainsFunc mocks the GetChains method.
+	GetChainsFunc func(ctx cosmossdktypes.Context) []exported.Chain
+
+	// GetChainsWithAssetFunc mocks the GetChainsWithAsset method.
+	GetChainsWithAssetFunc func(ctx cosmossdktypes.Context, denom string) []exported.Chain
+
 	// GetRecipientFunc mocks the GetRecipient method.
 	GetRecipientFunc func(ctx cosmossdktypes.Context, sender exported.CrossChainAddress) (exported.CrossChainAddress, bool)
 
@@ -907,6 +1130,12 @@ type NexusMock struct {
 	// RegisterAssetFunc mocks the RegisterAsset method.
 	RegisterAssetFunc func(ctx cosmossdktypes.Context, chain exported.Chain, denom string)
 
+	// RegisterAssetDecimalsFunc mocks the RegisterAssetDecimals method.
+	RegisterAssetDecimalsFunc func(ctx cosmossdktypes.Context, chain exported.Chain, asset string, decimals uint32) error
+
+	// SetAssetPrecisionFunc mocks the SetAssetPrecision method.
+	SetAssetPrecisionFunc func(ctx cosmossdktypes.Context, asset string, decimals uint32) error
+
 	// SetChainFunc mocks the SetChain method.
 	SetChainFunc func(ctx cosmossdktypes.Context, chain exported.Chain)
 
@@ -939,6 +1168,13 @@ type NexusMock struct {
 			// FeeRate is the feeRate argument value.
 			FeeRate cosmossdktypes.Dec
 		}
+		// GetAssetPrecision holds details about calls to the GetAssetPrecision method.
+		GetAssetPrecision []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Asset is the asset argument value.
+			Asset string
+		}
 		// GetChain holds details about calls to the GetChain method.
 		GetChain []struct {
 			// Ctx is the ctx argument value.
@@ -946,6 +1182,27 @@ type NexusMock struct {
 			// Chain is the chain argument value.
 			Chain string
 		}
+		// GetChainTotal holds details about calls to the GetChainTotal method.
+		GetChainTotal []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Chain is the chain argument value.
+			Chain exported.Chain
+			// Denom is the denom argument value.
+			Denom string
+		}
+		// GetChains holds details about calls to the GetChains method.
+		GetChains []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+		}
+		// GetChainsWithAsset holds details about calls to the GetChainsWithAsset method.
+		GetChainsWithAsset []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Denom is the denom argument value.
+			Denom string
+		}
 		// GetRecipient holds details about calls to the GetRecipient method.
 		GetRecipient []struct {
 			// Ctx is the ctx argument value.
@@ -989,6 +1246,26 @@ type NexusMock struct {
 			// Denom is the denom argument value.
 			Denom string
 		}
+		// RegisterAssetDecimals holds details about calls to the RegisterAssetDecimals method.
+		RegisterAssetDecimals []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Chain is the chain argument value.
+			Chain exported.Chain
+			// Asset is the asset argument value.
+			Asset string
+			// Decimals is the decimals argument value.
+			Decimals uint32
+		}
+		// SetAssetPrecision holds details about calls to the SetAssetPrecision method.
+		SetAssetPrecision []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Asset is the asset argument value.
+			Asset string
+			// Decimals is the decimals argument value.
+			Decimals uint32
+		}
 		// SetChain holds details about calls to the SetChain method.
 		SetChain []struct {
 			// Ctx is the ctx argument value.
@@ -1000,12 +1277,18 @@ type NexusMock struct {
 	lockAddToChainTotal        sync.RWMutex
 	lockArchivePendingTransfer sync.RWMutex
 	lockEnqueueForTransfer     sync.RWMutex
+	lockGetAssetPrecision      sync.RWMutex
 	lockGetChain               sync.RWMutex
+	lockGetChainTotal          sync.RWMutex
+	lockGetChains              sync.RWMutex
+	lockGetChainsWithAsset     sync.RWMutex
 	lockGetRecipient           sync.RWMutex
 	lockGetTransfersForChain   sync.RWMutex
 	lockIsAssetRegistered      sync.RWMutex
 	lockLinkAddresses          sync.RWMutex
 	lockRegisterAsset          sync.RWMutex
+	lockRegisterAssetDecimals  sync.RWMutex
+	lockSetAssetPrecision      sync.RWMutex
 	lockSetChain               sync.RWMutex
 }
 
@@ -1126,6 +1409,41 @@ func (mock *NexusMock) EnqueueForTransferCalls() []struct {
 	return calls
 }
 
+// GetAssetPrecision calls GetAssetPrecisionFunc.
+func (mock *NexusMock) GetAssetPrecision(ctx cosmossdktypes.Context, asset string) (uint32, bool) {
+	if mock.GetAssetPrecisionFunc == nil {
+		panic("NexusMock.GetAssetPrecisionFunc: method is nil but Nexus.GetAssetPrecision was just called")
+	}
+	callInfo := struct {
+		Ctx   cosmossdktypes.Context
+		Asset string
+	}{
+		Ctx:   ctx,
+		Asset: asset,
+	}
+	mock.lockGetAssetPrecision.Lock()
+	mock.calls.GetAssetPrecision = append(mock.calls.GetAssetPrecision, callInfo)
+	mock.lockGetAssetPrecision.Unlock()
+	return mock.GetAssetPrecisionFunc(ctx, asset)
+}
+
+// GetAssetPrecisionCalls gets all the calls that were made to GetAssetPrecision.
+// Check the length with:
+//     len(mockedNexus.GetAssetPrecisionCalls())
+func (mock *NexusMock) GetAssetPrecisionCalls() []struct {
+	Ctx   cosmossdktypes.Context
+	Asset string
+} {
+	var calls []struct {
+		Ctx   cosmossdktypes.Context
+		Asset string
+	}
+	mock.lockGetAssetPrecision.RLock()
+	calls = mock.calls.GetAssetPrecision
+	mock.lockGetAssetPrecision.RUnlock()
+	return calls
+}
+
 // GetChain calls GetChainFunc.
 func (mock *NexusMock) GetChain(ctx cosmossdktypes.Context, chain string) (exported.Chain, bool) {
 	if mock.GetChainFunc == nil {
@@ -1161,6 +1479,111 @@ func (mock *NexusMock) GetChainCalls() []struct {
 	return calls
 }
 
+// GetChainTotal calls GetChainTotalFunc.
+func (mock *NexusMock) GetChainTotal(ctx cosmossdktypes.Context, chain exported.Chain, denom string) cosmossdktypes.Coin {
+	if mock.GetChainTotalFunc == nil {
+		panic("NexusMock.GetChainTotalFunc: method is nil but Nexus.GetChainTotal was just called")
+	}
+	callInfo := struct {
+		Ctx   cosmossdktypes.Context
+		Chain exported.Chain
+		Denom string
+	}{
+		Ctx:   ctx,
+		Chain: chain,
+		Denom: denom,
+	}
+	mock.lockGetChainTotal.Lock()
+	mock.calls.GetChainTotal = append(mock.calls.GetChainTotal, callInfo)
+	mock.lockGetChainTotal.Unlock()
+	return mock.GetChainTotalFunc(ctx, chain, denom)
+}
+
+// GetChainTotalCalls gets all the calls that were made to GetChainTotal.
+// Check the length with:
+//     len(mockedNexus.GetChainTotalCalls())
+func (mock *NexusMock) GetChainTotalCalls() []struct {
+	Ctx   cosmossdktypes.Context
+	Chain exported.Chain
+	Denom string
+} {
+	var calls []struct {
+		Ctx   cosmossdktypes.Context
+		Chain exported.Chain
+		Denom string
+	}
+	mock.lockGetChainTotal.RLock()
+	calls = mock.calls.GetChainTotal
+	mock.lockGetChainTotal.RUnlock()
+	return calls
+}
+
+// GetChains calls GetChainsFunc.
+func (mock *NexusMock) GetChains(ctx cosmossdktypes.Context) []exported.Chain {
+	if mock.GetChainsFunc == nil {
+		panic("NexusMock.GetChainsFunc: method is nil but Nexus.GetChains was just called")
+	}
+	callInfo := struct {
+		Ctx cosmossdktypes.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetChains.Lock()
+	mock.calls.GetChains = append(mock.calls.GetChains, callInfo)
+	mock.lockGetChains.Unlock()
+	return mock.GetChainsFunc(ctx)
+}
+
+// GetChainsCalls gets all the calls that were made to GetChains.
+// Check the length with:
+//     len(mockedNexus.GetChainsCalls())
+func (mock *NexusMock) GetChainsCalls() []struct {
+	Ctx cosmossdktypes.Context
+} {
+	var calls []struct {
+		Ctx cosmossdktypes.Context
+	}
+	mock.lockGetChains.RLock()
+	calls = mock.calls.GetChains
+	mock.lockGetChains.RUnlock()
+	return calls
+}
+
+// GetChainsWithAsset calls GetChainsWithAssetFunc.
+func (mock *NexusMock) GetChainsWithAsset(ctx cosmossdktypes.Context, denom string) []exported.Chain {
+	if mock.GetChainsWithAssetFunc == nil {
+		panic("NexusMock.GetChainsWithAssetFunc: method is nil but Nexus.GetChainsWithAsset was just called")
+	}
+	callInfo := struct {
+		Ctx   cosmossdktypes.Context
+		Denom string
+	}{
+		Ctx:   ctx,
+		Denom: denom,
+	}
+	mock.lockGetChainsWithAsset.Lock()
+	mock.calls.GetChainsWithAsset = append(mock.calls.GetChainsWithAsset, callInfo)
+	mock.lockGetChainsWithAsset.Unlock()
+	return mock.GetChainsWithAssetFunc(ctx, denom)
+}
+
+// GetChainsWithAssetCalls gets all the calls that were made to GetChainsWithAsset.
+// Check the length with:
+//     len(mockedNexus.GetChainsWithAssetCalls())
+func (mock *NexusMock) GetChainsWithAssetCalls() []struct {
+	Ctx   cosmossdktypes.Context
+	Denom string
+} {
+	var calls []struct {
+		Ctx   cosmossdktypes.Context
+		Denom string
+	}
+	mock.lockGetChainsWithAsset.RLock()
+	calls = mock.calls.GetChainsWithAsset
+	mock.lockGetChainsWithAsset.RUnlock()
+	return calls
+}
+
 // GetRecipient calls GetRecipientFunc.
 func (mock *NexusMock) GetRecipient(ctx cosmossdktypes.Context, sender exported.CrossChainAddress) (exported.CrossChainAddress, bool) {
 	if mock.GetRecipientFunc == nil {
@@ -1352,6 +1775,88 @@ func (mock *NexusMock) RegisterAssetCalls() []struct {
 	return calls
 }
 
+// RegisterAssetDecimals calls RegisterAssetDecimalsFunc.
+func (mock *NexusMock) RegisterAssetDecimals(ctx cosmossdktypes.Context, chain exported.Chain, asset string, decimals uint32) error {
+	if mock.RegisterAssetDecimalsFunc == nil {
+		panic("NexusMock.RegisterAssetDecimalsFunc: method is nil but Nexus.RegisterAssetDecimals was just called")
+	}
+	callInfo := struct {
+		Ctx      cosmossdktypes.Context
+		Chain    exported.Chain
+		Asset    string
+		Decimals uint32
+	}{
+		Ctx:      ctx,
+		Chain:    chain,
+		Asset:    asset,
+		Decimals: decimals,
+	}
+	mock.lockRegisterAssetDecimals.Lock()
+	mock.calls.RegisterAssetDecimals = append(mock.calls.RegisterAssetDecimals, callInfo)
+	mock.lockRegisterAssetDecimals.Unlock()
+	return mock.RegisterAssetDecimalsFunc(ctx, chain, asset, decimals)
+}
+
+// RegisterAssetDecimalsCalls gets all the calls that were made to RegisterAssetDecimals.
+// Check the length with:
+//     len(mockedNexus.RegisterAssetDecimalsCalls())
+func (mock *NexusMock) RegisterAssetDecimalsCalls() []struct {
+	Ctx      cosmossdktypes.Context
+	Chain    exported.Chain
+	Asset    string
+	Decimals uint32
+} {
+	var calls []struct {
+		Ctx      cosmossdktypes.Context
+		Chain    exported.Chain
+		Asset    string
+		Decimals uint32
+	}
+	mock.lockRegisterAssetDecimals.RLock()
+	calls = mock.calls.RegisterAssetDecimals
+	mock.lockRegisterAssetDecimals.RUnlock()
+	return calls
+}
+
+// SetAssetPrecision calls SetAssetPrecisionFunc.
+func (mock *NexusMock) SetAssetPrecision(ctx cosmossdktypes.Context, asset string, decimals uint32) error {
+	if mock.SetAssetPrecisionFunc == nil {
+		panic("NexusMock.SetAssetPrecisionFunc: method is nil but Nexus.SetAssetPrecision was just called")
+	}
+	callInfo := struct {
+		Ctx      cosmossdktypes.Context
+		Asset    string
+		Decimals uint32
+	}{
+		Ctx:      ctx,
+		Asset:    asset,
+		Decimals: decimals,
+	}
+	mock.lockSetAssetPrecision.Lock()
+	mock.calls.SetAssetPrecision = append(mock.calls.SetAssetPrecision, callInfo)
+	mock.lockSetAssetPrecision.Unlock()
+	return mock.SetAssetPrecisionFunc(ctx, asset, decimals)
+}
+
+// SetAssetPrecisionCalls gets all the calls that were made to SetAssetPrecision.
+// Check the length with:
+//     len(mockedNexus.SetAssetPrecisionCalls())
+func (mock *NexusMock) SetAssetPrecisionCalls() []struct {
+	Ctx      cosmossdktypes.Context
+	Asset    string
+	Decimals uint32
+} {
+	var calls []struct {
+		Ctx      cosmossdktypes.Context
+		Asset    string
+		Decimals uint32
+	}
+	mock.lockSetAssetPrecision.RLock()
+	calls = mock.calls.SetAssetPrecision
+	mock.lockSetAssetPrecision.RUnlock()
+	return calls
+}
+
 // SetChain calls SetChainFunc.
 func (mock *NexusMock) SetChain(ctx cosmossdktypes.Context, chain exported.Chain) {
 	if mock.SetChainFunc == nil {
@@ -1400,6 +1905,12 @@ var _ axelarnettypes.BankKeeper = &BankKeeperMock{}
 // 			BurnCoinsFunc: func(ctx cosmossdktypes.Context, moduleName string, amt cosmossdktypes.Coins) error {
 // 				panic("mock out the BurnCoins method")
 // 			},
+// 			GetBalanceFunc: func(ctx cosmossdktypes.Context, addr cosmossdktypes.AccAddress, denom string) cosmossdktypes.Coin {
+// 				panic("mock out the GetBalance method")
+// 			},
+// 			GetDenomMetaDataFunc: func(ctx cosmossdktypes.Context, denom string) (banktypes.Metadata, bool) {
+// 				panic("mock out the GetDenomMetaData method")
+// 			},
 // 			MintCoinsFunc: func(ctx cosmossdktypes.Context, moduleName string, amt cosmossdktypes.Coins) error {
 // 				panic("mock out the MintCoins method")
 // 			},
@@ -1422,6 +1933,12 @@ type BankKeeperMock struct {
 	// BurnCoinsFunc mocks the BurnCoins method.
 	BurnCoinsFunc func(ctx cosmossdktypes.Context, moduleName string, amt cosmossdktypes.Coins) error
 
+	// GetBalanceFunc mocks the GetBalance method.
+	GetBalanceFunc func(ctx cosmossdktypes.Context, addr cosmossdktypes.AccAddress, denom string) cosmossdktypes.Coin
+
+	// GetDenomMetaDataFunc mocks the GetDenomMetaData method.
+	GetDenomMetaDataFunc func(ctx cosmossdktypes.Context, denom string) (banktypes.Metadata, bool)
+
 	// MintCoinsFunc mocks the MintCoins method.
 	MintCoinsFunc func(ctx cosmossdktypes.Context, moduleName string, amt cosmossdktypes.Coins) error
 
@@ -1445,6 +1962,22 @@ type BankKeeperMock struct {
 			// Amt is the amt argument value.
 			Amt cosmossdktypes.Coins
 		}
+		// GetBalance holds details about calls to the GetBalance method.
+		GetBalance []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Addr is the addr argument value.
+			Addr cosmossdktypes.AccAddress
+			// Denom is the denom argument value.
+			Denom string
+		}
+		// GetDenomMetaData holds details about calls to the GetDenomMetaData method.
+		GetDenomMetaData []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Denom is the denom argument value.
+			Denom string
+		}
 		// MintCoins holds details about calls to the MintCoins method.
 		MintCoins []struct {
 			// Ctx is the ctx argument value.
@@ -1489,6 +2022,8 @@ type BankKeeperMock struct {
 		}
 	}
 	lockBurnCoins                    sync.RWMutex
+	lockGetBalance                   sync.RWMutex
+	lockGetDenomMetaData             sync.RWMutex
 	lockMintCoins                    sync.RWMutex
 	lockSendCoins                    sync.RWMutex
 	lockSendCoinsFromAccountToModule sync.RWMutex
@@ -1534,6 +2069,80 @@ func (mock *BankKeeperMock) BurnCoinsCalls() []struct {
 	return calls
 }
 
+// GetBalance calls GetBalanceFunc.
+func (mock *BankKeeperMock) GetBalance(ctx cosmossdktypes.Context, addr cosmossdktypes.AccAddress, denom string) cosmossdktypes.Coin {
+	if mock.GetBalanceFunc == nil {
+		panic("BankKeeperMock.GetBalanceFunc: method is nil but BankKeeper.GetBalance was just called")
+	}
+	callInfo := struct {
+		Ctx   cosmossdktypes.Context
+		Addr  cosmossdktypes.AccAddress
+		Denom string
+	}{
+		Ctx:   ctx,
+		Addr:  addr,
+		Denom: denom,
+	}
+	mock.lockGetBalance.Lock()
+	mock.calls.GetBalance = append(mock.calls.GetBalance, callInfo)
+	mock.lockGetBalance.Unlock()
+	return mock.GetBalanceFunc(ctx, addr, denom)
+}
+
+// GetBalanceCalls gets all the calls that were made to GetBalance.
+// Check the length with:
+//     len(mockedBankKeeper.GetBalanceCalls())
+func (mock *BankKeeperMock) GetBalanceCalls() []struct {
+	Ctx   cosmossdktypes.Context
+	Addr  cosmossdktypes.AccAddress
+	Denom string
+} {
+	var calls []struct {
+		Ctx   cosmossdktypes.Context
+		Addr  cosmossdktypes.AccAddress
+		Denom string
+	}
+	mock.lockGetBalance.RLock()
+	calls = mock.calls.GetBalance
+	mock.lockGetBalance.RUnlock()
+	return calls
+}
+
+// GetDenomMetaData calls GetDenomMetaDataFunc.
+func (mock *BankKeeperMock) GetDenomMetaData(ctx cosmossdktypes.Context, denom string) (banktypes.Metadata, bool) {
+	if mock.GetDenomMetaDataFunc == nil {
+		panic("BankKeeperMock.GetDenomMetaDataFunc: method is nil but BankKeeper.GetDenomMetaData was just called")
+	}
+	callInfo := struct {
+		Ctx   cosmossdktypes.Context
+		Denom string
+	}{
+		Ctx:   ctx,
+		Denom: denom,
+	}
+	mock.lockGetDenomMetaData.Lock()
+	mock.calls.GetDenomMetaData = append(mock.calls.GetDenomMetaData, callInfo)
+	mock.lockGetDenomMetaData.Unlock()
+	return mock.GetDenomMetaDataFunc(ctx, denom)
+}
+
+// GetDenomMetaDataCalls gets all the calls that were made to GetDenomMetaData.
+// Check the length with:
+//     len(mockedBankKeeper.GetDenomMetaDataCalls())
+func (mock *BankKeeperMock) GetDenomMetaDataCalls() []struct {
+	Ctx   cosmossdktypes.Context
+	Denom string
+} {
+	var calls []struct {
+		Ctx   cosmossdktypes.Context
+		Denom string
+	}
+	mock.lockGetDenomMetaData.RLock()
+	calls = mock.calls.GetDenomMetaData
+	mock.lockGetDenomMetaData.RUnlock()
+	return calls
+}
+
 // MintCoins calls MintCoinsFunc.
 func (mock *BankKeeperMock) MintCoins(ctx cosmossdktypes.Context, moduleName string, amt cosmossdktypes.Coins) error {
 	if mock.MintCoinsFunc == nil {
@@ -1868,6 +2477,9 @@ var _ axelarnettypes.ChannelKeeper = &ChannelKeeperMock{}
 //
 // 		// make and configure a mocked axelarnettypes.ChannelKeeper
 // 		mockedChannelKeeper := &ChannelKeeperMock{
+// 			GetChannelFunc: func(ctx cosmossdktypes.Context, portID string, channelID string) (channeltypes.Channel, bool) {
+// 				panic("mock out the GetChannel method")
+// 			},
 // 			GetChannelClientStateFunc: func(ctx cosmossdktypes.Context, portID string, channelID string) (string, ibcclient.ClientState, error) {
 // 				panic("mock out the GetChannelClientState method")
 // 			},
@@ -1881,6 +2493,9 @@ var _ axelarnettypes.ChannelKeeper = &ChannelKeeperMock{}
 //
 // 	}
 type ChannelKeeperMock struct {
+	// GetChannelFunc mocks the GetChannel method.
+	GetChannelFunc func(ctx cosmossdktypes.Context, portID string, channelID string) (channeltypes.Channel, bool)
+
 	// GetChannelClientStateFunc mocks the GetChannelClientState method.
 	GetChannelClientStateFunc func(ctx cosmossdktypes.Context, portID string, channelID string) (string, ibcclient.ClientState, error)
 
@@ -1889,6 +2504,15 @@ type ChannelKeeperMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// GetChannel holds details about calls to the GetChannel method.
+		GetChannel []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// PortID is the portID argument value.
+			PortID string
+			// ChannelID is the channelID argument value.
+			ChannelID string
+		}
 		// GetChannelClientState holds details about calls to the GetChannelClientState method.
 		GetChannelClientState []struct {
 			// Ctx is the ctx argument value.
@@ -1908,10 +2532,50 @@ type ChannelKeeperMock struct {
 			ChannelID string
 		}
 	}
+	lockGetChannel            sync.RWMutex
 	lockGetChannelClientState sync.RWMutex
 	lockGetNextSequenceSend   sync.RWMutex
 }
 
+// GetChannel calls GetChannelFunc.
+func (mock *ChannelKeeperMock) GetChannel(ctx cosmossdktypes.Context, portID string, channelID string) (channeltypes.Channel, bool) {
+	if mock.GetChannelFunc == nil {
+		panic("ChannelKeeperMock.GetChannelFunc: method is nil but ChannelKeeper.GetChannel was just called")
+	}
+	callInfo := struct {
+		Ctx       cosmossdktypes.Context
+		PortID    string
+		ChannelID string
+	}{
+		Ctx:       ctx,
+		PortID:    portID,
+		ChannelID: channelID,
+	}
+	mock.lockGetChannel.Lock()
+	mock.calls.GetChannel = append(mock.calls.GetChannel, callInfo)
+	mock.lockGetChannel.Unlock()
+	return mock.GetChannelFunc(ctx, portID, channelID)
+}
+
+// GetChannelCalls gets all the calls that were made to GetChannel.
+// Check the length with:
+//     len(mockedChannelKeeper.GetChannelCalls())
+func (mock *ChannelKeeperMock) GetChannelCalls() []struct {
+	Ctx       cosmossdktypes.Context
+	PortID    string
+	ChannelID string
+} {
+	var calls []struct {
+		Ctx       cosmossdktypes.Context
+		PortID    string
+		ChannelID string
+	}
+	mock.lockGetChannel.RLock()
+	calls = mock.calls.GetChannel
+	mock.lockGetChannel.RUnlock()
+	return calls
+}
+
 // GetChannelClientState calls GetChannelClientStateFunc.
 func (mock *ChannelKeeperMock) GetChannelClientState(ctx cosmossdktypes.Context, portID string, channelID string) (string, ibcclient.ClientState, error) {
 	if mock.GetChannelClientStateFunc == nil {
@@ -1990,6 +2654,77 @@ func (mock *ChannelKeeperMock) GetNextSequenceSendCalls() []struct {
 	return calls
 }
 
+// Ensure, that ClientKeeperMock does implement axelarnettypes.ClientKeeper.
+// If this is not the case, regenerate this file with moq.
+var _ axelarnettypes.ClientKeeper = &ClientKeeperMock{}
+
+// ClientKeeperMock is a mock implementation of axelarnettypes.ClientKeeper.
+//
+// 	func TestSomethingThatUsesClientKeeper(t *testing.T) {
+//
+// 		// make and configure a mocked axelarnettypes.ClientKeeper
+// 		mockedClientKeeper := &ClientKeeperMock{
+// 			ClientStatusFunc: func(c context.Context, req *clienttypes.QueryClientStatusRequest) (*clienttypes.QueryClientStatusResponse, error) {
+// 				panic("mock out the ClientStatus method")
+// 			},
+// 		}
+//
+// 		// use mockedClientKeeper in code that requires axelarnettypes.ClientKeeper
+// 		// and then make assertions.
+//
+// 	}
+type ClientKeeperMock struct {
+	// ClientStatusFunc mocks the ClientStatus method.
+	ClientStatusFunc func(c context.Context, req *clienttypes.QueryClientStatusRequest) (*clienttypes.QueryClientStatusResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ClientStatus holds details about calls to the ClientStatus method.
+		ClientStatus []struct {
+			// C is the c argument value.
+			C context.Context
+			// Req is the req argument value.
+			Req *clienttypes.QueryClientStatusRequest
+		}
+	}
+	lockClientStatus sync.RWMutex
+}
+
+// ClientStatus calls ClientStatusFunc.
+func (mock *ClientKeeperMock) ClientStatus(c context.Context, req *clienttypes.QueryClientStatusRequest) (*clienttypes.QueryClientStatusResponse, error) {
+	if mock.ClientStatusFunc == nil {
+		panic("ClientKeeperMock.ClientStatusFunc: method is nil but ClientKeeper.ClientStatus was just called")
+	}
+	callInfo := struct {
+		C   context.Context
+		Req *clienttypes.QueryClientStatusRequest
+	}{
+		C:   c,
+		Req: req,
+	}
+	mock.lockClientStatus.Lock()
+	mock.calls.ClientStatus = append(mock.calls.ClientStatus, callInfo)
+	mock.lockClientStatus.Unlock()
+	return mock.ClientStatusFunc(c, req)
+}
+
+// ClientStatusCalls gets all the calls that were made to ClientStatus.
+// Check the length with:
+//     len(mockedClientKeeper.ClientStatusCalls())
+func (mock *ClientKeeperMock) ClientStatusCalls() []struct {
+	C   context.Context
+	Req *clienttypes.QueryClientStatusRequest
+} {
+	var calls []struct {
+		C   context.Context
+		Req *clienttypes.QueryClientStatusRequest
+	}
+	mock.lockClientStatus.RLock()
+	calls = mock.calls.ClientStatus
+	mock.lockClientStatus.RUnlock()
+	return calls
+}
+
 // Ensure, that AccountKeeperMock does implement axelarnettypes.AccountKeeper.
 // If this is not the case, regenerate this file with moq.
 var _ axelarnettypes.AccountKeeper = &AccountKeeperMock{}
@@ -11,7 +11,7 @@ import (
 )
 
 // NewAddCosmosBasedChainRequest is the constructor for NewAddCosmosBasedChainRequest
-func NewAddCosmosBasedChainRequest(sender sdk.AccAddress, name, nativeAsset, addrPrefix string, minAmount sdk.Int) *AddCosmosBasedChainRequest {
+func NewAddCosmosBasedChainRequest(sender sdk.AccAddress, name, nativeAsset, addrPrefix, chainID string, minAmount sdk.Int) *AddCosmosBasedChainRequest {
 	return &AddCosmosBasedChainRequest{
 		Sender: sender,
 		Chain: nexus.Chain{
@@ -23,6 +23,7 @@ func NewAddCosmosBasedChainRequest(sender sdk.AccAddress, name, nativeAsset, add
 		},
 		AddrPrefix: addrPrefix,
 		MinAmount:  minAmount,
+		ChainID:    chainID,
 	}
 }
 
@@ -50,6 +51,10 @@ func (m AddCosmosBasedChainRequest) ValidateBasic() error {
 		return fmt.Errorf("address prefix cannot be empty")
 	}
 
+	if m.ChainID == "" {
+		return fmt.Errorf("chain ID cannot be empty")
+	}
+
 	if m.MinAmount.LTE(sdk.ZeroInt()) {
 		return fmt.Errorf("minimum mint/withdrawal amount must be greater than zero")
 	}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewRerouteFailedIBCTransferRequest creates a message of type RerouteFailedIBCTransferRequest
+func NewRerouteFailedIBCTransferRequest(sender sdk.AccAddress, id uint64, chain, receiver string) *RerouteFailedIBCTransferRequest {
+	return &RerouteFailedIBCTransferRequest{
+		Sender:   sender,
+		ID:       id,
+		Chain:    chain,
+		Receiver: receiver,
+	}
+}
+
+// Route returns the route for this message
+func (m RerouteFailedIBCTransferRequest) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (m RerouteFailedIBCTransferRequest) Type() string {
+	return "RerouteFailedIBCTransfer"
+}
+
+// ValidateBasic executes a stateless message validation
+func (m RerouteFailedIBCTransferRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+
+	if m.ID == 0 {
+		return fmt.Errorf("missing transfer ID")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes that need to be signed
+func (m RerouteFailedIBCTransferRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the set of signers for this message
+func (m RerouteFailedIBCTransferRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// NewRefundFailedIBCTransferRequest creates a message of type RefundFailedIBCTransferRequest
+func NewRefundFailedIBCTransferRequest(sender sdk.AccAddress, id uint64, recipient sdk.AccAddress) *RefundFailedIBCTransferRequest {
+	return &RefundFailedIBCTransferRequest{
+		Sender:    sender,
+		ID:        id,
+		Recipient: recipient,
+	}
+}
+
+// Route returns the route for this message
+func (m RefundFailedIBCTransferRequest) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (m RefundFailedIBCTransferRequest) Type() string {
+	return "RefundFailedIBCTransfer"
+}
+
+// ValidateBasic executes a stateless message validation
+func (m RefundFailedIBCTransferRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+
+	if m.ID == 0 {
+		return fmt.Errorf("missing transfer ID")
+	}
+
+	if err := sdk.VerifyAddressFormat(m.Recipient); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "recipient").Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes that need to be signed
+func (m RefundFailedIBCTransferRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the set of signers for this message
+func (m RefundFailedIBCTransferRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
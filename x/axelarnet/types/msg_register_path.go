@@ -43,6 +43,10 @@ func (m RegisterIBCPathRequest) ValidateBasic() error {
 		return sdkerrors.Wrap(err, "invalid path")
 	}
 
+	if _, err := SplitIBCPath(m.Path); err != nil {
+		return sdkerrors.Wrap(err, "invalid path")
+	}
+
 	return nil
 }
 
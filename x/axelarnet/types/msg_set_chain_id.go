@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewSetCosmosChainIDRequest creates a message of type SetCosmosChainIDRequest
+func NewSetCosmosChainIDRequest(sender sdk.AccAddress, chain, chainID string) *SetCosmosChainIDRequest {
+	return &SetCosmosChainIDRequest{
+		Sender:  sender,
+		Chain:   chain,
+		ChainID: chainID,
+	}
+}
+
+// Route returns the route for this message
+func (m SetCosmosChainIDRequest) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (m SetCosmosChainIDRequest) Type() string {
+	return "SetCosmosChainID"
+}
+
+// ValidateBasic executes a stateless message validation
+func (m SetCosmosChainIDRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
+	if m.ChainID == "" {
+		return fmt.Errorf("chain ID cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes that need to be signed
+func (m SetCosmosChainIDRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the set of signers for this message
+func (m SetCosmosChainIDRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
@@ -18,6 +18,8 @@ var (
 	KeyRouteTimeoutWindow = []byte("routeTimeoutWindow")
 	KeyTransactionFeeRate = []byte("transactionFeeRate")
 	KeyMinAmount          = []byte("minAmount")
+	KeyRouteMaxAttempts   = []byte("routeMaxAttempts")
+	KeyRouteRetryBackoff  = []byte("routeRetryBackoff")
 )
 
 // KeyTable retrieves a subspace table for the module
@@ -30,6 +32,8 @@ func DefaultParams() Params {
 	return Params{
 		RouteTimeoutWindow: 17000,
 		TransactionFeeRate: sdktypes.NewDecWithPrec(1, 3), // 0.1%
+		RouteMaxAttempts:   5,
+		RouteRetryBackoff:  100,
 	}
 }
 
@@ -45,6 +49,8 @@ func (m *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyRouteTimeoutWindow, &m.RouteTimeoutWindow, validatePosUInt64("RouteTimeoutWindow")),
 		params.NewParamSetPair(KeyTransactionFeeRate, &m.TransactionFeeRate, validateTransactionFeeRate),
+		params.NewParamSetPair(KeyRouteMaxAttempts, &m.RouteMaxAttempts, validatePosUInt64("RouteMaxAttempts")),
+		params.NewParamSetPair(KeyRouteRetryBackoff, &m.RouteRetryBackoff, validatePosUInt64("RouteRetryBackoff")),
 	}
 }
 
@@ -58,6 +64,14 @@ func (m Params) Validate() error {
 		return err
 	}
 
+	if err := validatePosUInt64("RouteMaxAttempts")(m.RouteMaxAttempts); err != nil {
+		return err
+	}
+
+	if err := validatePosUInt64("RouteRetryBackoff")(m.RouteRetryBackoff); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -29,6 +29,12 @@ type Params struct {
 	// IBC packet route timeout window
 	RouteTimeoutWindow uint64                                 `protobuf:"varint,1,opt,name=route_timeout_window,json=routeTimeoutWindow,proto3" json:"route_timeout_window,omitempty"`
 	TransactionFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=transaction_fee_rate,json=transactionFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"transaction_fee_rate"`
+	// maximum number of times an IBC transfer routed by axelarnet is sent before
+	// it is marked as failed
+	RouteMaxAttempts uint64 `protobuf:"varint,3,opt,name=route_max_attempts,json=routeMaxAttempts,proto3" json:"route_max_attempts,omitempty"`
+	// number of blocks to wait before resending a timed out or rejected IBC
+	// transfer
+	RouteRetryBackoff uint64 `protobuf:"varint,4,opt,name=route_retry_backoff,json=routeRetryBackoff,proto3" json:"route_retry_backoff,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -71,24 +77,27 @@ func init() {
 func init() { proto.RegisterFile("axelarnet/v1beta1/params.proto", fileDescriptor_2ac91a59ad64a1d4) }
 
 var fileDescriptor_2ac91a59ad64a1d4 = []byte{
-	// 265 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0xcf, 0xc1, 0x4a, 0xc3, 0x30,
-	0x18, 0x07, 0xf0, 0x46, 0x64, 0x60, 0x6f, 0x96, 0x1d, 0x86, 0x87, 0x6c, 0x78, 0x90, 0x5d, 0x96,
-	0x38, 0x04, 0x1f, 0x60, 0x88, 0x67, 0x29, 0x03, 0xc1, 0x4b, 0x4d, 0xb3, 0xcf, 0x5a, 0x66, 0xfb,
-	0x95, 0xe4, 0xab, 0x9d, 0x6f, 0xe1, 0x03, 0xf8, 0x40, 0x3d, 0xee, 0x28, 0x1e, 0x86, 0xb6, 0x2f,
-	0x22, 0xa6, 0x65, 0xee, 0x94, 0x84, 0x5f, 0xbe, 0xff, 0xc7, 0xdf, 0xe7, 0x6a, 0x03, 0x2f, 0xca,
-	0xe4, 0x40, 0xf2, 0x75, 0x1e, 0x03, 0xa9, 0xb9, 0x2c, 0x94, 0x51, 0x99, 0x15, 0x85, 0x41, 0xc2,
-	0xe0, 0x74, 0xef, 0xa2, 0xf7, 0xb3, 0x61, 0x82, 0x09, 0x3a, 0x95, 0x7f, 0xb7, 0xee, 0xe3, 0xf9,
-	0x07, 0xf3, 0x07, 0x77, 0x6e, 0x32, 0xb8, 0xf4, 0x87, 0x06, 0x4b, 0x82, 0x88, 0xd2, 0x0c, 0xb0,
-	0xa4, 0xa8, 0x4a, 0xf3, 0x15, 0x56, 0x23, 0x36, 0x61, 0xd3, 0xe3, 0x30, 0x70, 0xb6, 0xec, 0xe8,
-	0xde, 0x49, 0xf0, 0xe8, 0x0f, 0xc9, 0xa8, 0xdc, 0x2a, 0x4d, 0x29, 0xe6, 0xd1, 0x13, 0x40, 0x64,
-	0x14, 0xc1, 0xe8, 0x68, 0xc2, 0xa6, 0x27, 0x0b, 0x51, 0xef, 0xc6, 0xde, 0xd7, 0x6e, 0x7c, 0x91,
-	0xa4, 0xf4, 0x5c, 0xc6, 0x42, 0x63, 0x26, 0x35, 0xda, 0x0c, 0x6d, 0x7f, 0xcc, 0xec, 0x6a, 0x2d,
-	0xe9, 0xad, 0x00, 0x2b, 0x6e, 0x40, 0x87, 0xc1, 0x41, 0xd6, 0x2d, 0x40, 0xa8, 0x08, 0x16, 0xcb,
-	0xfa, 0x87, 0x7b, 0x75, 0xc3, 0xd9, 0xb6, 0xe1, 0xec, 0xbb, 0xe1, 0xec, 0xbd, 0xe5, 0xde, 0xb6,
-	0xe5, 0xde, 0x67, 0xcb, 0xbd, 0x87, 0xeb, 0x83, 0xe4, 0x7d, 0xe1, 0x0a, 0xcd, 0xba, 0x7f, 0xcd,
-	0x34, 0x1a, 0x90, 0x9b, 0x7f, 0xeb, 0xb6, 0xc5, 0x03, 0xd7, 0xfd, 0xea, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0x87, 0x68, 0x28, 0x0e, 0x46, 0x01, 0x00, 0x00,
+	// 314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0xd0, 0xbf, 0x4e, 0xfb, 0x30,
+	0x10, 0x07, 0xf0, 0xf8, 0xf7, 0xab, 0x2a, 0x91, 0x89, 0x9a, 0x0e, 0x11, 0x83, 0x5b, 0x31, 0xa0,
+	0x0e, 0x34, 0xa1, 0x42, 0x62, 0xa7, 0x42, 0x6c, 0x48, 0x28, 0xaa, 0x84, 0xc4, 0x62, 0x9c, 0xf4,
+	0x5a, 0xa2, 0x92, 0x5c, 0x64, 0x5f, 0x69, 0xfa, 0x16, 0x3c, 0x56, 0xc7, 0x8e, 0x88, 0xa1, 0x82,
+	0xe6, 0x2d, 0x98, 0x50, 0x9d, 0xa8, 0x74, 0xf2, 0x9f, 0xcf, 0xd7, 0xf6, 0xf9, 0x5c, 0xa1, 0x0a,
+	0x78, 0x55, 0x3a, 0x03, 0x0a, 0xde, 0x06, 0x11, 0x90, 0x1a, 0x04, 0xb9, 0xd2, 0x2a, 0x35, 0x7e,
+	0xae, 0x91, 0x90, 0xb7, 0xf6, 0xee, 0xd7, 0x7e, 0xda, 0x9e, 0xe2, 0x14, 0xad, 0x06, 0xbb, 0x59,
+	0x15, 0x3c, 0xfb, 0x61, 0x6e, 0xf3, 0xc1, 0x9e, 0xe4, 0x97, 0x6e, 0x5b, 0xe3, 0x9c, 0x40, 0x52,
+	0x92, 0x02, 0xce, 0x49, 0x2e, 0x92, 0x6c, 0x8c, 0x0b, 0x8f, 0x75, 0x59, 0xaf, 0x11, 0x72, 0x6b,
+	0xa3, 0x8a, 0x1e, 0xad, 0xf0, 0x67, 0xb7, 0x4d, 0x5a, 0x65, 0x46, 0xc5, 0x94, 0x60, 0x26, 0x27,
+	0x00, 0x52, 0x2b, 0x02, 0xef, 0x5f, 0x97, 0xf5, 0x8e, 0x86, 0xfe, 0x6a, 0xd3, 0x71, 0x3e, 0x37,
+	0x9d, 0xf3, 0x69, 0x42, 0x2f, 0xf3, 0xc8, 0x8f, 0x31, 0x0d, 0x62, 0x34, 0x29, 0x9a, 0x7a, 0xe8,
+	0x9b, 0xf1, 0x2c, 0xa0, 0x65, 0x0e, 0xc6, 0xbf, 0x85, 0x38, 0xe4, 0x07, 0x77, 0xdd, 0x01, 0x84,
+	0x8a, 0x80, 0x5f, 0xb8, 0xd5, 0xbb, 0x32, 0x55, 0x85, 0x54, 0x44, 0x90, 0xe6, 0x64, 0xbc, 0xff,
+	0xb6, 0xa2, 0x63, 0x2b, 0xf7, 0xaa, 0xb8, 0xa9, 0xf7, 0xb9, 0xef, 0x9e, 0x54, 0x69, 0x0d, 0xa4,
+	0x97, 0x32, 0x52, 0xf1, 0x0c, 0x27, 0x13, 0xaf, 0x61, 0xe3, 0x2d, 0x4b, 0xe1, 0x4e, 0x86, 0x15,
+	0x0c, 0x47, 0xab, 0x6f, 0xe1, 0xac, 0xb6, 0x82, 0xad, 0xb7, 0x82, 0x7d, 0x6d, 0x05, 0x7b, 0x2f,
+	0x85, 0xb3, 0x2e, 0x85, 0xf3, 0x51, 0x0a, 0xe7, 0xe9, 0xfa, 0xa0, 0xee, 0x7d, 0x3b, 0x17, 0xa8,
+	0x67, 0xf5, 0xaa, 0x1f, 0xa3, 0x86, 0xa0, 0xf8, 0xb3, 0xea, 0x2f, 0x51, 0xd3, 0x76, 0xf6, 0xea,
+	0x77, 0x00, 0x00, 0x4a, 0x32, 0x99, 0xa4, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -111,6 +120,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RouteRetryBackoff != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RouteRetryBackoff))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RouteMaxAttempts != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RouteMaxAttempts))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.TransactionFeeRate.Size()
 		i -= size
@@ -151,6 +170,12 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.TransactionFeeRate.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.RouteMaxAttempts != 0 {
+		n += 1 + sovParams(uint64(m.RouteMaxAttempts))
+	}
+	if m.RouteRetryBackoff != 0 {
+		n += 1 + sovParams(uint64(m.RouteRetryBackoff))
+	}
 	return n
 }
 
@@ -242,6 +267,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteMaxAttempts", wireType)
+			}
+			m.RouteMaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RouteMaxAttempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteRetryBackoff", wireType)
+			}
+			m.RouteRetryBackoff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RouteRetryBackoff |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
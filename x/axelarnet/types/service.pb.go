@@ -35,44 +35,51 @@ func init() {
 }
 
 var fileDescriptor_8c81cd7f69d43e55 = []byte{
-	// 581 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x3b, 0x22, 0x1e, 0x06, 0x54, 0xba, 0x16, 0x84, 0x20, 0x5b, 0x5d, 0xa9, 0xc6, 0xb4,
-	0xb3, 0xdb, 0x1f, 0xd0, 0x43, 0x6f, 0xcd, 0xaa, 0x50, 0x50, 0x28, 0xb1, 0x27, 0x2f, 0x32, 0xd9,
-	0x7d, 0xd9, 0x0c, 0x49, 0x66, 0xd6, 0x99, 0x49, 0x8d, 0xa8, 0x17, 0x0f, 0x5e, 0x15, 0x7a, 0xf1,
-	0xe8, 0x55, 0xf0, 0x0f, 0xf0, 0xe8, 0xd1, 0x63, 0xc1, 0x8b, 0x47, 0x49, 0xfc, 0x43, 0x64, 0x67,
-	0x77, 0x9a, 0xb6, 0xd9, 0x26, 0xf1, 0x96, 0xf0, 0x3e, 0xdf, 0x7c, 0x3f, 0xfb, 0xf6, 0x11, 0xbc,
-	0x4c, 0x07, 0xd0, 0xa5, 0x92, 0x83, 0x0e, 0x0e, 0x37, 0x9a, 0xa0, 0xe9, 0x46, 0xa0, 0x40, 0x1e,
-	0xb2, 0x08, 0xfc, 0x54, 0x0a, 0x2d, 0x9c, 0xc5, 0x13, 0xc0, 0x2f, 0x80, 0xca, 0x52, 0x22, 0x12,
-	0x61, 0xa6, 0x41, 0xf6, 0x29, 0x07, 0x2b, 0xb7, 0x12, 0x21, 0x92, 0x2e, 0x04, 0x34, 0x65, 0x01,
-	0xe5, 0x5c, 0x68, 0xaa, 0x99, 0xe0, 0xaa, 0x98, 0x56, 0x26, 0x7b, 0xf4, 0x20, 0x9f, 0x6d, 0x7e,
-	0xc0, 0x18, 0x3f, 0x55, 0xc9, 0xb3, 0xbc, 0xd7, 0x79, 0x8b, 0x2f, 0x3f, 0x61, 0xbc, 0xe3, 0xb8,
-	0xfe, 0x44, 0xb5, 0x9f, 0x0d, 0x1a, 0xf0, 0xb2, 0x0f, 0x4a, 0x57, 0x96, 0x2f, 0x9c, 0xab, 0x54,
-	0x70, 0x05, 0xde, 0xd6, 0xfb, 0x5f, 0x7f, 0x8f, 0x2e, 0x11, 0xaf, 0x1a, 0xe4, 0x60, 0x30, 0x76,
-	0xe8, 0x32, 0xde, 0x09, 0xde, 0x48, 0x88, 0x58, 0xca, 0x80, 0xeb, 0x17, 0x51, 0x9b, 0x32, 0xfe,
-	0x6e, 0x07, 0xd5, 0x9c, 0x23, 0x84, 0xaf, 0x85, 0x82, 0xb7, 0x98, 0xec, 0x3d, 0x84, 0x54, 0x28,
-	0xa6, 0x9d, 0x6a, 0x49, 0xd1, 0x59, 0xc4, 0x2a, 0x3d, 0x98, 0x83, 0x2c, 0xe4, 0xd6, 0x8c, 0xdc,
-	0x3d, 0xef, 0xce, 0xa4, 0x5c, 0x94, 0x27, 0x48, 0x9c, 0x47, 0x32, 0xab, 0xef, 0x08, 0xdf, 0x7c,
-	0x34, 0x80, 0xa8, 0xaf, 0x61, 0x1f, 0x78, 0xcc, 0x78, 0x72, 0x20, 0x29, 0x57, 0x2d, 0x90, 0xca,
-	0xd9, 0x28, 0x29, 0xbd, 0x80, 0xb5, 0x9e, 0x9b, 0xff, 0x13, 0x29, 0x84, 0xb7, 0x8d, 0xf0, 0xba,
-	0xb7, 0x3a, 0x29, 0x0c, 0x79, 0x94, 0xa4, 0x79, 0x96, 0x68, 0x1b, 0xce, 0xd4, 0x3f, 0x23, 0x7c,
-	0xbd, 0x01, 0x09, 0x53, 0x1a, 0xe4, 0x5e, 0x3d, 0xdc, 0xa7, 0xba, 0xed, 0x94, 0xed, 0xe9, 0x1c,
-	0x63, 0x55, 0x6b, 0xf3, 0xa0, 0x85, 0xa2, 0x6f, 0x14, 0xab, 0xde, 0xdd, 0x49, 0x45, 0x59, 0x44,
-	0x08, 0x6b, 0x46, 0x24, 0xa5, 0xba, 0x9d, 0xa9, 0x7d, 0x45, 0xf8, 0xc6, 0x6e, 0x1c, 0x87, 0x42,
-	0xf5, 0x84, 0xaa, 0x53, 0x05, 0x71, 0x98, 0xdd, 0x81, 0x43, 0x4a, 0x3a, 0x4b, 0x38, 0xab, 0xe8,
-	0xcf, 0x8b, 0xcf, 0xbe, 0x4b, 0x1a, 0xc7, 0x24, 0x32, 0x39, 0xd2, 0xcc, 0x82, 0xc4, 0x1c, 0x66,
-	0xe6, 0xfa, 0x11, 0xe1, 0xab, 0xf6, 0xb9, 0x77, 0x95, 0x02, 0xed, 0xdc, 0x9f, 0xb2, 0x19, 0x43,
-	0x58, 0xbf, 0xea, 0x6c, 0xb0, 0x30, 0x5b, 0x35, 0x66, 0x2b, 0xde, 0xed, 0x29, 0x0b, 0xa4, 0x59,
-	0x22, 0x33, 0xfa, 0x82, 0xf0, 0x62, 0x43, 0xf4, 0x35, 0xec, 0xd5, 0xc3, 0xf1, 0x35, 0xae, 0x96,
-	0x95, 0x9d, 0xa7, 0xac, 0xd9, 0xda, 0x7c, 0x70, 0x61, 0xb7, 0x6e, 0xec, 0x6a, 0xde, 0x4a, 0x89,
-	0x5d, 0x16, 0x32, 0xef, 0xf6, 0xcc, 0xed, 0x7d, 0x43, 0x78, 0xc9, 0x3e, 0xe9, 0x63, 0x80, 0x50,
-	0x74, 0xbb, 0x10, 0x69, 0x21, 0x1d, 0x7f, 0xca, 0x4a, 0x4e, 0x83, 0x56, 0x34, 0x98, 0x9b, 0x9f,
-	0xfd, 0x8e, 0x4f, 0x36, 0xd9, 0x02, 0x20, 0x91, 0x4d, 0xee, 0xa0, 0x5a, 0xfd, 0xe0, 0xe7, 0xd0,
-	0x45, 0xc7, 0x43, 0x17, 0xfd, 0x19, 0xba, 0xe8, 0xd3, 0xc8, 0x5d, 0xf8, 0x31, 0x72, 0xd1, 0xf1,
-	0xc8, 0x5d, 0xf8, 0x3d, 0x72, 0x17, 0x9e, 0x6f, 0x27, 0x4c, 0xb7, 0xfb, 0x4d, 0x3f, 0x12, 0xbd,
-	0xf1, 0xaf, 0xbd, 0x12, 0xb2, 0x53, 0x7c, 0x23, 0x91, 0x90, 0x10, 0x0c, 0x4e, 0x35, 0xe9, 0xd7,
-	0x29, 0xa8, 0xe6, 0x15, 0xf3, 0x2f, 0xbb, 0xf5, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xdf, 0xd1, 0x3a,
-	0x3c, 0xeb, 0x05, 0x00, 0x00,
+	// 695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x4f, 0x6b, 0x13, 0x4f,
+	0x18, 0xc7, 0x3b, 0x3f, 0x7e, 0x78, 0x58, 0xb0, 0xda, 0xb5, 0x50, 0x09, 0xb2, 0xd5, 0x95, 0x6a,
+	0x4c, 0xba, 0xbb, 0x4d, 0x0a, 0x55, 0x7a, 0x6b, 0x52, 0x0b, 0x05, 0x85, 0x92, 0xf6, 0xe4, 0x45,
+	0x26, 0xbb, 0x4f, 0x36, 0x43, 0xd3, 0x99, 0x75, 0x67, 0x52, 0x23, 0xea, 0xc5, 0x37, 0xa0, 0xd0,
+	0x8b, 0x17, 0x41, 0xbc, 0x09, 0xbe, 0x00, 0x3d, 0x08, 0x1e, 0x3d, 0x16, 0xbc, 0x78, 0x94, 0xc6,
+	0x17, 0x22, 0x33, 0xbb, 0xd3, 0x7f, 0x99, 0xfc, 0xe9, 0x2d, 0xe1, 0xf9, 0x7c, 0xf3, 0x7c, 0xf6,
+	0xd9, 0x67, 0x26, 0xd6, 0x3c, 0xee, 0x41, 0x07, 0xa7, 0x14, 0x44, 0xb0, 0x5f, 0x69, 0x82, 0xc0,
+	0x95, 0x80, 0x43, 0xba, 0x4f, 0x42, 0xf0, 0x93, 0x94, 0x09, 0x66, 0xcf, 0x1c, 0x03, 0x7e, 0x0e,
+	0x14, 0x66, 0x63, 0x16, 0x33, 0x55, 0x0d, 0xe4, 0xa7, 0x0c, 0x2c, 0xdc, 0x88, 0x19, 0x8b, 0x3b,
+	0x10, 0xe0, 0x84, 0x04, 0x98, 0x52, 0x26, 0xb0, 0x20, 0x8c, 0xf2, 0xbc, 0x5a, 0x18, 0xec, 0x23,
+	0x7a, 0x59, 0xad, 0xfa, 0x69, 0xda, 0xb2, 0x1e, 0xf3, 0x78, 0x3b, 0xeb, 0x6b, 0xbf, 0xb2, 0xfe,
+	0x7f, 0x44, 0xe8, 0xae, 0xed, 0xf8, 0x03, 0xad, 0x7d, 0x59, 0x68, 0xc0, 0xb3, 0x2e, 0x70, 0x51,
+	0x98, 0x1f, 0x5a, 0xe7, 0x09, 0xa3, 0x1c, 0xdc, 0xe5, 0x37, 0xbf, 0xfe, 0x1e, 0xfc, 0xe7, 0xb9,
+	0xc5, 0x20, 0x03, 0x83, 0x13, 0x87, 0x0e, 0xa1, 0xbb, 0xc1, 0xcb, 0x14, 0x42, 0x92, 0x10, 0xa0,
+	0xe2, 0x69, 0xd8, 0xc6, 0x84, 0xbe, 0x5e, 0x45, 0x25, 0xfb, 0x00, 0x59, 0xd3, 0x75, 0x46, 0x5b,
+	0x24, 0xdd, 0x5b, 0x87, 0x84, 0x71, 0x22, 0xec, 0xa2, 0xa1, 0xd1, 0x59, 0x44, 0x2b, 0xdd, 0x9b,
+	0x80, 0xcc, 0xe5, 0x16, 0x95, 0xdc, 0x1d, 0xf7, 0xd6, 0xa0, 0x5c, 0x98, 0x25, 0xbc, 0x28, 0x8b,
+	0x48, 0xab, 0xaf, 0xc8, 0x9a, 0x7b, 0xd8, 0x83, 0xb0, 0x2b, 0x60, 0x0b, 0x68, 0x44, 0x68, 0xbc,
+	0x93, 0x62, 0xca, 0x5b, 0x90, 0x72, 0xbb, 0x62, 0x68, 0x3a, 0x84, 0xd5, 0x9e, 0xd5, 0x8b, 0x44,
+	0x72, 0xe1, 0x15, 0x25, 0xbc, 0xe4, 0x96, 0x07, 0x85, 0x21, 0x8b, 0x7a, 0x49, 0x96, 0xf5, 0x84,
+	0x0e, 0x4b, 0xf5, 0xf7, 0xc8, 0xba, 0xd2, 0x80, 0x98, 0x70, 0x01, 0xe9, 0x66, 0xad, 0xbe, 0x85,
+	0x45, 0xdb, 0x36, 0xcd, 0xe9, 0x1c, 0xa3, 0x55, 0x4b, 0x93, 0xa0, 0xb9, 0xa2, 0xaf, 0x14, 0x8b,
+	0xee, 0xed, 0x41, 0xc5, 0x34, 0x8f, 0x78, 0xa4, 0x19, 0x7a, 0x09, 0x16, 0x6d, 0xa9, 0xf6, 0x19,
+	0x59, 0xd7, 0xd6, 0xa2, 0xa8, 0xce, 0xf8, 0x1e, 0xe3, 0x35, 0xcc, 0x21, 0xaa, 0xcb, 0x3d, 0xb0,
+	0x3d, 0x43, 0x4f, 0x03, 0xa7, 0x15, 0xfd, 0x49, 0xf1, 0xf1, 0x7b, 0x89, 0xa3, 0xc8, 0x0b, 0x55,
+	0xce, 0x6b, 0xca, 0xa0, 0xa7, 0x16, 0x53, 0xba, 0xbe, 0x45, 0xd6, 0x65, 0xfd, 0xdc, 0x6b, 0x9c,
+	0x83, 0xb0, 0xef, 0x8e, 0x98, 0x8c, 0x22, 0xb4, 0x5f, 0x71, 0x3c, 0x98, 0x9b, 0x95, 0x95, 0xd9,
+	0x82, 0x7b, 0x73, 0xc4, 0x00, 0xb1, 0x4c, 0x48, 0xa3, 0x8f, 0xc8, 0x9a, 0x69, 0xb0, 0xae, 0x80,
+	0xcd, 0x5a, 0xfd, 0x64, 0x1b, 0xcb, 0xa6, 0x66, 0xe7, 0x29, 0x6d, 0xb6, 0x38, 0x19, 0x9c, 0xdb,
+	0x2d, 0x29, 0xbb, 0x92, 0xbb, 0x60, 0xb0, 0x93, 0x21, 0xf5, 0x6e, 0xcf, 0xec, 0xde, 0x17, 0x64,
+	0xcd, 0xea, 0x27, 0xdd, 0x00, 0xa8, 0xb3, 0x4e, 0x07, 0x42, 0xc1, 0x52, 0xdb, 0x1f, 0x31, 0x92,
+	0xd3, 0xa0, 0x16, 0x0d, 0x26, 0xe6, 0xc7, 0xbf, 0xe3, 0xe3, 0x49, 0xb6, 0x00, 0xbc, 0x50, 0x27,
+	0xa5, 0xee, 0x77, 0x64, 0x5d, 0x6f, 0x80, 0x7a, 0x96, 0x0d, 0x4c, 0x3a, 0x10, 0x9d, 0x1a, 0x83,
+	0x5d, 0x35, 0x2a, 0x98, 0x61, 0xad, 0xbd, 0x7c, 0xa1, 0x4c, 0xae, 0xfe, 0x40, 0xa9, 0x57, 0x5d,
+	0xcf, 0xa4, 0x9e, 0x0d, 0xba, 0xa5, 0xc2, 0x67, 0xe6, 0x2d, 0xfd, 0xbf, 0x21, 0x6b, 0xae, 0x01,
+	0xad, 0x2e, 0x8d, 0x06, 0xf5, 0x2b, 0x46, 0x15, 0x23, 0x3b, 0xea, 0x96, 0x1a, 0x1a, 0xc9, 0xe5,
+	0xef, 0x2b, 0xf9, 0x8a, 0xbb, 0x68, 0x92, 0x97, 0xd1, 0x61, 0xee, 0x1f, 0x90, 0x75, 0x75, 0x1b,
+	0x44, 0x76, 0x68, 0xd5, 0x79, 0xdd, 0x5c, 0xb7, 0x4d, 0x97, 0xcf, 0x79, 0x48, 0xdb, 0x96, 0x27,
+	0x62, 0xc7, 0xaf, 0x32, 0x07, 0xa1, 0xaf, 0x00, 0x75, 0xf8, 0x3d, 0x12, 0xad, 0xa2, 0x52, 0x6d,
+	0xe7, 0xe7, 0x91, 0x83, 0x0e, 0x8f, 0x1c, 0xf4, 0xe7, 0xc8, 0x41, 0xef, 0xfa, 0xce, 0xd4, 0x8f,
+	0xbe, 0x83, 0x0e, 0xfb, 0xce, 0xd4, 0xef, 0xbe, 0x33, 0xf5, 0x64, 0x25, 0x26, 0xa2, 0xdd, 0x6d,
+	0xfa, 0x21, 0xdb, 0x3b, 0xf9, 0xa9, 0xe7, 0x2c, 0xdd, 0xcd, 0xbf, 0x79, 0x21, 0x4b, 0x21, 0xe8,
+	0x9d, 0x6a, 0x23, 0x5e, 0x24, 0xc0, 0x9b, 0x97, 0xd4, 0x3f, 0xf0, 0xf2, 0xbf, 0x01, 0x00, 0xaa,
+	0xd8, 0x51, 0x60, 0x07, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -95,6 +102,9 @@ type MsgServiceClient interface {
 	RegisterAsset(ctx context.Context, in *RegisterAssetRequest, opts ...grpc.CallOption) (*RegisterAssetResponse, error)
 	RouteIBCTransfers(ctx context.Context, in *RouteIBCTransfersRequest, opts ...grpc.CallOption) (*RouteIBCTransfersResponse, error)
 	RegisterFeeCollector(ctx context.Context, in *RegisterFeeCollectorRequest, opts ...grpc.CallOption) (*RegisterFeeCollectorResponse, error)
+	RerouteFailedIBCTransfer(ctx context.Context, in *RerouteFailedIBCTransferRequest, opts ...grpc.CallOption) (*RerouteFailedIBCTransferResponse, error)
+	RefundFailedIBCTransfer(ctx context.Context, in *RefundFailedIBCTransferRequest, opts ...grpc.CallOption) (*RefundFailedIBCTransferResponse, error)
+	SetCosmosChainID(ctx context.Context, in *SetCosmosChainIDRequest, opts ...grpc.CallOption) (*SetCosmosChainIDResponse, error)
 }
 
 type msgServiceClient struct {
@@ -177,6 +187,33 @@ func (c *msgServiceClient) RegisterFeeCollector(ctx context.Context, in *Registe
 	return out, nil
 }
 
+func (c *msgServiceClient) RerouteFailedIBCTransfer(ctx context.Context, in *RerouteFailedIBCTransferRequest, opts ...grpc.CallOption) (*RerouteFailedIBCTransferResponse, error) {
+	out := new(RerouteFailedIBCTransferResponse)
+	err := c.cc.Invoke(ctx, "/axelarnet.v1beta1.MsgService/RerouteFailedIBCTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RefundFailedIBCTransfer(ctx context.Context, in *RefundFailedIBCTransferRequest, opts ...grpc.CallOption) (*RefundFailedIBCTransferResponse, error) {
+	out := new(RefundFailedIBCTransferResponse)
+	err := c.cc.Invoke(ctx, "/axelarnet.v1beta1.MsgService/RefundFailedIBCTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) SetCosmosChainID(ctx context.Context, in *SetCosmosChainIDRequest, opts ...grpc.CallOption) (*SetCosmosChainIDResponse, error) {
+	out := new(SetCosmosChainIDResponse)
+	err := c.cc.Invoke(ctx, "/axelarnet.v1beta1.MsgService/SetCosmosChainID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 type MsgServiceServer interface {
 	Link(context.Context, *LinkRequest) (*LinkResponse, error)
@@ -187,6 +224,9 @@ type MsgServiceServer interface {
 	RegisterAsset(context.Context, *RegisterAssetRequest) (*RegisterAssetResponse, error)
 	RouteIBCTransfers(context.Context, *RouteIBCTransfersRequest) (*RouteIBCTransfersResponse, error)
 	RegisterFeeCollector(context.Context, *RegisterFeeCollectorRequest) (*RegisterFeeCollectorResponse, error)
+	RerouteFailedIBCTransfer(context.Context, *RerouteFailedIBCTransferRequest) (*RerouteFailedIBCTransferResponse, error)
+	RefundFailedIBCTransfer(context.Context, *RefundFailedIBCTransferRequest) (*RefundFailedIBCTransferResponse, error)
+	SetCosmosChainID(context.Context, *SetCosmosChainIDRequest) (*SetCosmosChainIDResponse, error)
 }
 
 // UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
@@ -217,6 +257,15 @@ func (*UnimplementedMsgServiceServer) RouteIBCTransfers(ctx context.Context, req
 func (*UnimplementedMsgServiceServer) RegisterFeeCollector(ctx context.Context, req *RegisterFeeCollectorRequest) (*RegisterFeeCollectorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterFeeCollector not implemented")
 }
+func (*UnimplementedMsgServiceServer) RerouteFailedIBCTransfer(ctx context.Context, req *RerouteFailedIBCTransferRequest) (*RerouteFailedIBCTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RerouteFailedIBCTransfer not implemented")
+}
+func (*UnimplementedMsgServiceServer) RefundFailedIBCTransfer(ctx context.Context, req *RefundFailedIBCTransferRequest) (*RefundFailedIBCTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundFailedIBCTransfer not implemented")
+}
+func (*UnimplementedMsgServiceServer) SetCosmosChainID(ctx context.Context, req *SetCosmosChainIDRequest) (*SetCosmosChainIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCosmosChainID not implemented")
+}
 
 func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
 	s.RegisterService(&_MsgService_serviceDesc, srv)
@@ -366,6 +415,60 @@ func _MsgService_RegisterFeeCollector_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_RerouteFailedIBCTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RerouteFailedIBCTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RerouteFailedIBCTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarnet.v1beta1.MsgService/RerouteFailedIBCTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RerouteFailedIBCTransfer(ctx, req.(*RerouteFailedIBCTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RefundFailedIBCTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundFailedIBCTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RefundFailedIBCTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarnet.v1beta1.MsgService/RefundFailedIBCTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RefundFailedIBCTransfer(ctx, req.(*RefundFailedIBCTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_SetCosmosChainID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCosmosChainIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).SetCosmosChainID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarnet.v1beta1.MsgService/SetCosmosChainID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).SetCosmosChainID(ctx, req.(*SetCosmosChainIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MsgService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "axelarnet.v1beta1.MsgService",
 	HandlerType: (*MsgServiceServer)(nil),
@@ -402,6 +505,18 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterFeeCollector",
 			Handler:    _MsgService_RegisterFeeCollector_Handler,
 		},
+		{
+			MethodName: "RerouteFailedIBCTransfer",
+			Handler:    _MsgService_RerouteFailedIBCTransfer_Handler,
+		},
+		{
+			MethodName: "RefundFailedIBCTransfer",
+			Handler:    _MsgService_RefundFailedIBCTransfer_Handler,
+		},
+		{
+			MethodName: "SetCosmosChainID",
+			Handler:    _MsgService_SetCosmosChainID_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "axelarnet/v1beta1/service.proto",
@@ -343,6 +343,7 @@ type AddCosmosBasedChainRequest struct {
 	Chain      exported.Chain                                `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain"`
 	AddrPrefix string                                        `protobuf:"bytes,3,opt,name=addr_prefix,json=addrPrefix,proto3" json:"addr_prefix,omitempty"`
 	MinAmount  github_com_cosmos_cosmos_sdk_types.Int        `protobuf:"bytes,4,opt,name=min_amount,json=minAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_amount"`
+	ChainID    string                                        `protobuf:"bytes,5,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *AddCosmosBasedChainRequest) Reset()         { *m = AddCosmosBasedChainRequest{} }
@@ -642,6 +643,239 @@ func (m *RegisterFeeCollectorResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RegisterFeeCollectorResponse proto.InternalMessageInfo
 
+// RerouteFailedIBCTransferRequest represents a message to resend an IBC
+// transfer that failed after all retries, optionally to another cosmos chain or
+// receiver
+type RerouteFailedIBCTransferRequest struct {
+	Sender   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	ID       uint64                                        `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Chain    string                                        `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
+	Receiver string                                        `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+}
+
+func (m *RerouteFailedIBCTransferRequest) Reset()         { *m = RerouteFailedIBCTransferRequest{} }
+func (m *RerouteFailedIBCTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*RerouteFailedIBCTransferRequest) ProtoMessage()    {}
+func (*RerouteFailedIBCTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a791a0da77994a4e, []int{16}
+}
+func (m *RerouteFailedIBCTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RerouteFailedIBCTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RerouteFailedIBCTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RerouteFailedIBCTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RerouteFailedIBCTransferRequest.Merge(m, src)
+}
+func (m *RerouteFailedIBCTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RerouteFailedIBCTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RerouteFailedIBCTransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RerouteFailedIBCTransferRequest proto.InternalMessageInfo
+
+type RerouteFailedIBCTransferResponse struct {
+}
+
+func (m *RerouteFailedIBCTransferResponse) Reset()         { *m = RerouteFailedIBCTransferResponse{} }
+func (m *RerouteFailedIBCTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*RerouteFailedIBCTransferResponse) ProtoMessage()    {}
+func (*RerouteFailedIBCTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a791a0da77994a4e, []int{17}
+}
+func (m *RerouteFailedIBCTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RerouteFailedIBCTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RerouteFailedIBCTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RerouteFailedIBCTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RerouteFailedIBCTransferResponse.Merge(m, src)
+}
+func (m *RerouteFailedIBCTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RerouteFailedIBCTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RerouteFailedIBCTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RerouteFailedIBCTransferResponse proto.InternalMessageInfo
+
+// RefundFailedIBCTransferRequest represents a message to release the tokens of
+// an IBC transfer that failed after all retries to an account on Axelar
+type RefundFailedIBCTransferRequest struct {
+	Sender    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	ID        uint64                                        `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Recipient github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=recipient,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"recipient,omitempty"`
+}
+
+func (m *RefundFailedIBCTransferRequest) Reset()         { *m = RefundFailedIBCTransferRequest{} }
+func (m *RefundFailedIBCTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*RefundFailedIBCTransferRequest) ProtoMessage()    {}
+func (*RefundFailedIBCTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a791a0da77994a4e, []int{18}
+}
+func (m *RefundFailedIBCTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefundFailedIBCTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefundFailedIBCTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefundFailedIBCTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundFailedIBCTransferRequest.Merge(m, src)
+}
+func (m *RefundFailedIBCTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefundFailedIBCTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundFailedIBCTransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundFailedIBCTransferRequest proto.InternalMessageInfo
+
+type RefundFailedIBCTransferResponse struct {
+}
+
+func (m *RefundFailedIBCTransferResponse) Reset()         { *m = RefundFailedIBCTransferResponse{} }
+func (m *RefundFailedIBCTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*RefundFailedIBCTransferResponse) ProtoMessage()    {}
+func (*RefundFailedIBCTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a791a0da77994a4e, []int{19}
+}
+func (m *RefundFailedIBCTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefundFailedIBCTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefundFailedIBCTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefundFailedIBCTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundFailedIBCTransferResponse.Merge(m, src)
+}
+func (m *RefundFailedIBCTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefundFailedIBCTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundFailedIBCTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundFailedIBCTransferResponse proto.InternalMessageInfo
+
+// SetCosmosChainIDRequest represents a message to set the chain ID of a cosmos
+// chain that was added before chain IDs were recorded
+type SetCosmosChainIDRequest struct {
+	Sender  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	Chain   string                                        `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	ChainID string                                        `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *SetCosmosChainIDRequest) Reset()         { *m = SetCosmosChainIDRequest{} }
+func (m *SetCosmosChainIDRequest) String() string { return proto.CompactTextString(m) }
+func (*SetCosmosChainIDRequest) ProtoMessage()    {}
+func (*SetCosmosChainIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a791a0da77994a4e, []int{20}
+}
+func (m *SetCosmosChainIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCosmosChainIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCosmosChainIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetCosmosChainIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCosmosChainIDRequest.Merge(m, src)
+}
+func (m *SetCosmosChainIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCosmosChainIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCosmosChainIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCosmosChainIDRequest proto.InternalMessageInfo
+
+type SetCosmosChainIDResponse struct {
+}
+
+func (m *SetCosmosChainIDResponse) Reset()         { *m = SetCosmosChainIDResponse{} }
+func (m *SetCosmosChainIDResponse) String() string { return proto.CompactTextString(m) }
+func (*SetCosmosChainIDResponse) ProtoMessage()    {}
+func (*SetCosmosChainIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a791a0da77994a4e, []int{21}
+}
+func (m *SetCosmosChainIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCosmosChainIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCosmosChainIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetCosmosChainIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCosmosChainIDResponse.Merge(m, src)
+}
+func (m *SetCosmosChainIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCosmosChainIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCosmosChainIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCosmosChainIDResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*LinkRequest)(nil), "axelarnet.v1beta1.LinkRequest")
 	proto.RegisterType((*LinkResponse)(nil), "axelarnet.v1beta1.LinkResponse")
@@ -659,59 +893,74 @@ func init() {
 	proto.RegisterType((*RouteIBCTransfersResponse)(nil), "axelarnet.v1beta1.RouteIBCTransfersResponse")
 	proto.RegisterType((*RegisterFeeCollectorRequest)(nil), "axelarnet.v1beta1.RegisterFeeCollectorRequest")
 	proto.RegisterType((*RegisterFeeCollectorResponse)(nil), "axelarnet.v1beta1.RegisterFeeCollectorResponse")
+	proto.RegisterType((*RerouteFailedIBCTransferRequest)(nil), "axelarnet.v1beta1.RerouteFailedIBCTransferRequest")
+	proto.RegisterType((*RerouteFailedIBCTransferResponse)(nil), "axelarnet.v1beta1.RerouteFailedIBCTransferResponse")
+	proto.RegisterType((*RefundFailedIBCTransferRequest)(nil), "axelarnet.v1beta1.RefundFailedIBCTransferRequest")
+	proto.RegisterType((*RefundFailedIBCTransferResponse)(nil), "axelarnet.v1beta1.RefundFailedIBCTransferResponse")
+	proto.RegisterType((*SetCosmosChainIDRequest)(nil), "axelarnet.v1beta1.SetCosmosChainIDRequest")
+	proto.RegisterType((*SetCosmosChainIDResponse)(nil), "axelarnet.v1beta1.SetCosmosChainIDResponse")
 }
 
 func init() { proto.RegisterFile("axelarnet/v1beta1/tx.proto", fileDescriptor_a791a0da77994a4e) }
 
 var fileDescriptor_a791a0da77994a4e = []byte{
-	// 738 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0x8e, 0xfb, 0x4b, 0xaa, 0x5f, 0x27, 0x69, 0x2b, 0xac, 0xb4, 0x4d, 0x52, 0xe2, 0xb4, 0x96,
-	0x80, 0x5e, 0x6a, 0x2b, 0xe5, 0x8f, 0xc4, 0x31, 0x49, 0x41, 0x8a, 0x04, 0x52, 0x65, 0x55, 0x1c,
-	0x7a, 0x89, 0x1c, 0x7b, 0x92, 0x58, 0x49, 0x76, 0x8d, 0x77, 0x03, 0xee, 0x43, 0x20, 0x21, 0xc4,
-	0x6b, 0xf0, 0x0a, 0x88, 0x63, 0x8f, 0x3d, 0x22, 0x0e, 0x11, 0xa4, 0x6f, 0xc1, 0x09, 0xd9, 0xbb,
-	0x4e, 0xa2, 0x36, 0x48, 0xa8, 0x22, 0x9c, 0xbc, 0x3b, 0x33, 0x3b, 0xf3, 0xcd, 0xb7, 0xdf, 0x8e,
-	0xa1, 0x64, 0x87, 0x38, 0xb0, 0x03, 0x82, 0xdc, 0x7c, 0x53, 0x6d, 0x23, 0xb7, 0xab, 0x26, 0x0f,
-	0x0d, 0x3f, 0xa0, 0x9c, 0xaa, 0x77, 0xa6, 0x3e, 0x43, 0xfa, 0x4a, 0xc5, 0x2e, 0xa5, 0xdd, 0x01,
-	0x9a, 0x71, 0x40, 0x7b, 0xd4, 0x31, 0x6d, 0x72, 0x2e, 0xa2, 0x4b, 0xf9, 0x2e, 0xed, 0xd2, 0x78,
-	0x69, 0x46, 0x2b, 0x69, 0xd5, 0x1c, 0xca, 0x86, 0x94, 0x99, 0x6d, 0x9b, 0xe1, 0xb4, 0x82, 0x43,
-	0x3d, 0x22, 0xfd, 0x45, 0xe1, 0x6f, 0x89, 0x83, 0x62, 0x23, 0x5d, 0x3a, 0xc1, 0x70, 0xc4, 0x4c,
-	0x0c, 0x7d, 0x1a, 0x70, 0x74, 0x67, 0xf8, 0xce, 0x7d, 0x4c, 0x62, 0xca, 0x0b, 0xe0, 0xcf, 0xdc,
-	0xfa, 0x67, 0x05, 0xb2, 0x2f, 0x3c, 0xd2, 0xb7, 0xf0, 0xf5, 0x08, 0x19, 0x57, 0x9b, 0xb0, 0xca,
-	0x90, 0xb8, 0x18, 0x14, 0x94, 0x3d, 0xe5, 0x20, 0x57, 0xaf, 0xfe, 0x1c, 0x57, 0x0e, 0xbb, 0x1e,
-	0xef, 0x8d, 0xda, 0x86, 0x43, 0x87, 0xb2, 0xbe, 0xfc, 0x1c, 0x32, 0xb7, 0x2f, 0xb3, 0xd5, 0x1c,
-	0xa7, 0xe6, 0xba, 0x01, 0x32, 0x66, 0xc9, 0x04, 0xea, 0x3d, 0xd8, 0x08, 0xd0, 0xf1, 0x7c, 0x0f,
-	0x09, 0x6f, 0xd9, 0xae, 0x1b, 0x14, 0x56, 0xf6, 0x94, 0x83, 0x35, 0x6b, 0x7d, 0x6a, 0x8d, 0x4e,
-	0xa8, 0x0f, 0x60, 0x73, 0x16, 0xe6, 0xf4, 0x6c, 0x8f, 0x14, 0xfe, 0x8b, 0xe3, 0x66, 0xa7, 0x1b,
-	0x91, 0x55, 0xcd, 0x43, 0xc6, 0x66, 0x0c, 0x79, 0x21, 0x1d, 0xbb, 0xc5, 0x46, 0xaf, 0x42, 0x4e,
-	0xe0, 0x67, 0x3e, 0x25, 0x0c, 0xd5, 0x7d, 0xc8, 0xb9, 0xe8, 0x53, 0xe6, 0xc9, 0x9a, 0x4a, 0x1c,
-	0x9c, 0x95, 0xb6, 0xa8, 0xa2, 0xfe, 0x71, 0x05, 0xb6, 0x1a, 0x94, 0x74, 0xbc, 0x60, 0x78, 0x2c,
-	0xcc, 0x4b, 0xe8, 0xbe, 0x0c, 0x19, 0x1e, 0xb6, 0x3c, 0x37, 0x6e, 0x3a, 0x57, 0xff, 0x7f, 0x32,
-	0xae, 0xa4, 0x4f, 0xc3, 0xe6, 0xb1, 0x95, 0xe6, 0x61, 0xd3, 0x55, 0x1f, 0x43, 0x86, 0xd3, 0x3e,
-	0x8a, 0x5e, 0xb3, 0x47, 0x45, 0x43, 0x5e, 0x6c, 0xa4, 0x82, 0x44, 0x4b, 0x46, 0x83, 0x7a, 0xa4,
-	0x9e, 0xbe, 0x18, 0x57, 0x52, 0x96, 0x88, 0x56, 0xcf, 0x60, 0x73, 0xbe, 0x3b, 0x64, 0x2c, 0x66,
-	0xe3, 0x56, 0x48, 0x37, 0xe6, 0x38, 0x41, 0xc6, 0xf4, 0x02, 0x6c, 0x5f, 0x67, 0x45, 0x70, 0xaa,
-	0xf7, 0x41, 0x7b, 0x16, 0xa2, 0x33, 0xe2, 0x78, 0x82, 0xc4, 0xf5, 0x48, 0xf7, 0x34, 0xb0, 0x09,
-	0xeb, 0x60, 0xc0, 0xfe, 0x3e, 0x71, 0xfa, 0x3e, 0x54, 0x7e, 0x5b, 0x4c, 0xe2, 0x79, 0xa7, 0xc0,
-	0xb6, 0x85, 0x5d, 0x8f, 0x71, 0x0c, 0x9a, 0xf5, 0xc6, 0x89, 0xcd, 0x7b, 0x4b, 0xb8, 0xc1, 0x3c,
-	0x64, 0x84, 0x1c, 0x85, 0x6c, 0xc5, 0x46, 0x55, 0x21, 0xed, 0xdb, 0xbc, 0x27, 0x35, 0x1a, 0xaf,
-	0xf5, 0x22, 0xec, 0xdc, 0x80, 0x23, 0xa1, 0x7e, 0x58, 0x81, 0x52, 0xcd, 0x75, 0x1b, 0x71, 0xbd,
-	0xba, 0xcd, 0xd0, 0x8d, 0xc5, 0xbc, 0x04, 0xb8, 0x4f, 0xe7, 0xe1, 0x66, 0x8f, 0xca, 0x46, 0x3c,
-	0x1c, 0x8c, 0x64, 0x38, 0xcc, 0x44, 0x15, 0x05, 0x25, 0xaa, 0x12, 0x3d, 0x55, 0x20, 0x1b, 0xa9,
-	0xa9, 0xe5, 0x07, 0xd8, 0xf1, 0x42, 0xd9, 0x1a, 0x44, 0xa6, 0x93, 0xd8, 0xa2, 0xbe, 0x04, 0x18,
-	0x7a, 0xa4, 0x65, 0x0f, 0xe9, 0x88, 0x70, 0xa9, 0x38, 0x23, 0xca, 0xf0, 0x6d, 0x5c, 0xb9, 0xff,
-	0x07, 0x70, 0x9b, 0x84, 0x5b, 0x6b, 0x43, 0x8f, 0xd4, 0xe2, 0x04, 0x7a, 0x19, 0x76, 0x17, 0x72,
-	0x22, 0x39, 0xfb, 0xa4, 0x40, 0x3e, 0xe1, 0xb3, 0x16, 0x3d, 0xf2, 0x7f, 0x76, 0xb9, 0x8f, 0x92,
-	0x11, 0x23, 0x5e, 0x65, 0xc1, 0xb8, 0x31, 0xdf, 0x8d, 0x18, 0x50, 0x42, 0x9f, 0x18, 0x41, 0x3b,
-	0xb0, 0x75, 0x0d, 0xae, 0x6c, 0x04, 0xa1, 0x60, 0xd1, 0x11, 0xc7, 0x66, 0xbd, 0xb1, 0xcc, 0x17,
-	0xb3, 0x0b, 0xc5, 0x05, 0x65, 0x24, 0x86, 0x2f, 0x0a, 0xec, 0x26, 0xe8, 0x9e, 0x23, 0x36, 0xe8,
-	0x60, 0x80, 0x0e, 0xa7, 0xc1, 0x12, 0x38, 0x7d, 0x05, 0xeb, 0x1d, 0xc4, 0x96, 0x93, 0x94, 0x90,
-	0xa3, 0xef, 0x16, 0x19, 0x73, 0x9d, 0x39, 0xa4, 0xba, 0x06, 0x77, 0x17, 0x77, 0x20, 0x5a, 0xac,
-	0x9f, 0x5e, 0xfc, 0xd0, 0x52, 0x17, 0x13, 0x4d, 0xb9, 0x9c, 0x68, 0xca, 0xf7, 0x89, 0xa6, 0xbc,
-	0xbf, 0xd2, 0x52, 0x97, 0x57, 0x5a, 0xea, 0xeb, 0x95, 0x96, 0x3a, 0x7b, 0x32, 0x57, 0x7a, 0x7a,
-	0x9d, 0x6f, 0x69, 0xd0, 0x97, 0xbb, 0x43, 0x87, 0x06, 0x68, 0x86, 0x33, 0x9f, 0x80, 0xd3, 0x5e,
-	0x8d, 0x7f, 0x90, 0x0f, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xe1, 0xe1, 0xd9, 0x89, 0x00, 0x08,
-	0x00, 0x00,
+	// 883 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6e, 0x23, 0xc5,
+	0x13, 0xf6, 0x38, 0x76, 0x36, 0x29, 0x7b, 0x77, 0xf5, 0x1b, 0x65, 0x93, 0xb1, 0xf3, 0xcb, 0x38,
+	0x3b, 0x12, 0xcb, 0x5e, 0x32, 0x56, 0x96, 0x3f, 0x12, 0x47, 0xdb, 0x61, 0x25, 0x4b, 0x20, 0xa2,
+	0x21, 0xe2, 0xb0, 0x17, 0x6b, 0x3c, 0x5d, 0xb6, 0x5b, 0xb6, 0xbb, 0x4d, 0x77, 0x7b, 0x99, 0x7d,
+	0x08, 0x24, 0x0e, 0x3c, 0x01, 0x77, 0xae, 0x1c, 0x38, 0x20, 0x8e, 0x39, 0xee, 0x81, 0x03, 0xe2,
+	0x60, 0x81, 0xf3, 0x16, 0x9c, 0xd0, 0x4c, 0xf7, 0x78, 0xac, 0x4d, 0x82, 0x50, 0x84, 0x97, 0x93,
+	0xbb, 0xab, 0xaa, 0xab, 0xbe, 0xaf, 0xe6, 0xeb, 0x6a, 0x43, 0x3d, 0x8c, 0x71, 0x12, 0x0a, 0x86,
+	0xaa, 0xf9, 0xf2, 0xb4, 0x8f, 0x2a, 0x3c, 0x6d, 0xaa, 0xd8, 0x9f, 0x09, 0xae, 0xb8, 0xfd, 0xbf,
+	0x95, 0xcf, 0x37, 0xbe, 0x7a, 0x6d, 0xc8, 0xf9, 0x70, 0x82, 0xcd, 0x34, 0xa0, 0x3f, 0x1f, 0x34,
+	0x43, 0xf6, 0x4a, 0x47, 0xd7, 0xf7, 0x86, 0x7c, 0xc8, 0xd3, 0x65, 0x33, 0x59, 0x19, 0xab, 0x1b,
+	0x71, 0x39, 0xe5, 0xb2, 0xd9, 0x0f, 0x25, 0xae, 0x2a, 0x44, 0x9c, 0x32, 0xe3, 0xaf, 0x69, 0x7f,
+	0x4f, 0x1f, 0xd4, 0x1b, 0xe3, 0xf2, 0x18, 0xc6, 0x73, 0xd9, 0xc4, 0x78, 0xc6, 0x85, 0x42, 0x92,
+	0xe3, 0x7b, 0x35, 0xc3, 0x2c, 0xe6, 0xe8, 0x06, 0xf8, 0xb9, 0xdb, 0xfb, 0xc9, 0x82, 0xca, 0x27,
+	0x94, 0x8d, 0x03, 0xfc, 0x72, 0x8e, 0x52, 0xd9, 0x5d, 0xd8, 0x96, 0xc8, 0x08, 0x0a, 0xc7, 0x3a,
+	0xb6, 0x9e, 0x56, 0xdb, 0xa7, 0x7f, 0x2e, 0x1a, 0x27, 0x43, 0xaa, 0x46, 0xf3, 0xbe, 0x1f, 0xf1,
+	0xa9, 0xa9, 0x6f, 0x7e, 0x4e, 0x24, 0x19, 0x9b, 0x6c, 0xad, 0x28, 0x6a, 0x11, 0x22, 0x50, 0xca,
+	0xc0, 0x24, 0xb0, 0xdf, 0x81, 0x07, 0x02, 0x23, 0x3a, 0xa3, 0xc8, 0x54, 0x2f, 0x24, 0x44, 0x38,
+	0xc5, 0x63, 0xeb, 0xe9, 0x6e, 0x70, 0x7f, 0x65, 0x4d, 0x4e, 0xd8, 0xef, 0xc2, 0xc3, 0x3c, 0x2c,
+	0x1a, 0x85, 0x94, 0x39, 0x5b, 0x69, 0x5c, 0x7e, 0xba, 0x93, 0x58, 0xed, 0x3d, 0x28, 0x87, 0x52,
+	0xa2, 0x72, 0x4a, 0xa9, 0x5b, 0x6f, 0xbc, 0x53, 0xa8, 0x6a, 0xfc, 0x72, 0xc6, 0x99, 0x44, 0xfb,
+	0x31, 0x54, 0x09, 0xce, 0xb8, 0xa4, 0xa6, 0xa6, 0x95, 0x06, 0x57, 0x8c, 0x2d, 0xa9, 0xe8, 0x7d,
+	0x5b, 0x84, 0x47, 0x1d, 0xce, 0x06, 0x54, 0x4c, 0xcf, 0xb4, 0x79, 0x03, 0xec, 0x8f, 0xa0, 0xac,
+	0xe2, 0x1e, 0x25, 0x29, 0xe9, 0x6a, 0x7b, 0x67, 0xb9, 0x68, 0x94, 0x2e, 0xe2, 0xee, 0x59, 0x50,
+	0x52, 0x71, 0x97, 0xd8, 0x1f, 0x40, 0x59, 0xf1, 0x31, 0x6a, 0xae, 0x95, 0x67, 0x35, 0xdf, 0x7c,
+	0xd8, 0x44, 0x05, 0x99, 0x96, 0xfc, 0x0e, 0xa7, 0xac, 0x5d, 0xba, 0x5c, 0x34, 0x0a, 0x81, 0x8e,
+	0xb6, 0x5f, 0xc0, 0xc3, 0x75, 0x76, 0x28, 0xa5, 0x53, 0xba, 0x2b, 0xd2, 0x07, 0x6b, 0x3d, 0x41,
+	0x29, 0x3d, 0x07, 0xf6, 0xdf, 0xec, 0x8a, 0xee, 0xa9, 0x37, 0x06, 0xf7, 0xe3, 0x18, 0xa3, 0xb9,
+	0xc2, 0x73, 0x64, 0x84, 0xb2, 0xe1, 0x85, 0x08, 0x99, 0x1c, 0xa0, 0x90, 0xff, 0x7e, 0xe3, 0xbc,
+	0xc7, 0xd0, 0xb8, 0xb5, 0x98, 0xc1, 0xf3, 0xb5, 0x05, 0xfb, 0x01, 0x0e, 0xa9, 0x54, 0x28, 0xba,
+	0xed, 0xce, 0x79, 0xa8, 0x46, 0x1b, 0xf8, 0x82, 0x7b, 0x50, 0xd6, 0x72, 0xd4, 0xb2, 0xd5, 0x1b,
+	0xdb, 0x86, 0xd2, 0x2c, 0x54, 0x23, 0xa3, 0xd1, 0x74, 0xed, 0xd5, 0xe0, 0xe0, 0x1a, 0x1c, 0x03,
+	0xf5, 0xc7, 0x22, 0xd4, 0x5b, 0x84, 0x74, 0xd2, 0x7a, 0xed, 0x50, 0x22, 0x49, 0xc5, 0xbc, 0x01,
+	0xb8, 0x1f, 0xad, 0xc3, 0xad, 0x3c, 0x3b, 0xf2, 0xd3, 0xe1, 0xe0, 0x67, 0xc3, 0x21, 0x17, 0xd5,
+	0x28, 0xcc, 0x55, 0xa5, 0x39, 0x35, 0xa0, 0x92, 0xa8, 0xa9, 0x37, 0x13, 0x38, 0xa0, 0xb1, 0xa1,
+	0x06, 0x89, 0xe9, 0x3c, 0xb5, 0xd8, 0x9f, 0x02, 0x4c, 0x29, 0xeb, 0x85, 0x53, 0x3e, 0x67, 0xca,
+	0x28, 0xce, 0x4f, 0x32, 0xfc, 0xb6, 0x68, 0x3c, 0xf9, 0x07, 0x70, 0xbb, 0x4c, 0x05, 0xbb, 0x53,
+	0xca, 0x5a, 0x69, 0x02, 0xfb, 0x09, 0xec, 0xa4, 0x85, 0x93, 0xeb, 0x51, 0x4e, 0x8a, 0xb5, 0x2b,
+	0xcb, 0x45, 0xe3, 0x5e, 0x8a, 0xac, 0x7b, 0x16, 0xdc, 0x4b, 0x9d, 0x5d, 0xe2, 0x1d, 0xc1, 0xe1,
+	0x8d, 0xbd, 0x33, 0xbd, 0xfd, 0xde, 0x82, 0xbd, 0xac, 0xef, 0x2d, 0x29, 0x51, 0xbd, 0x35, 0x11,
+	0xbc, 0x9f, 0x8d, 0x22, 0x7d, 0x7b, 0x1d, 0xff, 0xda, 0x3b, 0xe0, 0xa7, 0x80, 0xb2, 0x36, 0xeb,
+	0x51, 0x75, 0x00, 0x8f, 0xde, 0x80, 0x6b, 0x88, 0x20, 0x38, 0x01, 0x9f, 0x2b, 0xec, 0xb6, 0x3b,
+	0x9b, 0xbc, 0x59, 0x87, 0x50, 0xbb, 0xa1, 0x8c, 0xc1, 0xf0, 0xb3, 0x05, 0x87, 0x19, 0xba, 0xe7,
+	0x88, 0x1d, 0x3e, 0x99, 0x60, 0xa4, 0xb8, 0xd8, 0x40, 0x4f, 0xbf, 0x80, 0xfb, 0x03, 0xc4, 0x5e,
+	0x94, 0x95, 0x70, 0x8a, 0x77, 0xcd, 0x58, 0x1d, 0xac, 0x21, 0xf5, 0x5c, 0xf8, 0xff, 0xcd, 0x0c,
+	0x0c, 0xc5, 0x1f, 0x2c, 0x68, 0x04, 0x28, 0x92, 0x16, 0x3c, 0x0f, 0xe9, 0x04, 0xc9, 0x5a, 0x23,
+	0x36, 0x40, 0x73, 0x1f, 0x8a, 0x66, 0xfc, 0x97, 0xda, 0xdb, 0xcb, 0x45, 0xa3, 0xd8, 0x3d, 0x0b,
+	0x8a, 0x94, 0xe4, 0x92, 0xda, 0x5a, 0x97, 0x54, 0x1d, 0x76, 0x04, 0x46, 0x48, 0x5f, 0xa2, 0x30,
+	0x0f, 0xdc, 0x6a, 0xef, 0x79, 0x70, 0x7c, 0x3b, 0x6e, 0x43, 0xee, 0x17, 0x0b, 0xdc, 0x00, 0x07,
+	0x73, 0x46, 0xfe, 0x4b, 0x6e, 0x9f, 0xc1, 0xee, 0xea, 0xd5, 0x76, 0xb6, 0xee, 0x5a, 0x25, 0xcf,
+	0x91, 0xbc, 0x06, 0xb7, 0xb2, 0x32, 0xcc, 0xbf, 0xb3, 0xe0, 0xe0, 0x73, 0x54, 0x7a, 0x4c, 0x64,
+	0x33, 0xe4, 0x6d, 0x4d, 0x82, 0xf5, 0x51, 0xb6, 0xf5, 0x37, 0xa3, 0xac, 0x0e, 0xce, 0x75, 0x8c,
+	0x9a, 0x40, 0xfb, 0xe2, 0xf2, 0x0f, 0xb7, 0x70, 0xb9, 0x74, 0xad, 0xd7, 0x4b, 0xd7, 0xfa, 0x7d,
+	0xe9, 0x5a, 0xdf, 0x5c, 0xb9, 0x85, 0xd7, 0x57, 0x6e, 0xe1, 0xd7, 0x2b, 0xb7, 0xf0, 0xe2, 0xc3,
+	0x35, 0xb8, 0xab, 0x31, 0xf3, 0x15, 0x17, 0x63, 0xb3, 0x3b, 0x89, 0xb8, 0xc0, 0x66, 0x9c, 0xfb,
+	0x34, 0x85, 0xfe, 0x76, 0xfa, 0x07, 0xef, 0xbd, 0xbf, 0x06, 0x00, 0xe0, 0xcf, 0x5d, 0x77, 0xc0,
+	0x0a, 0x00, 0x00,
 }
 
 func (m *LinkRequest) Marshal() (dAtA []byte, err error) {
@@ -1012,6 +1261,13 @@ func (m *AddCosmosBasedChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.MinAmount.Size()
 		i -= size
@@ -1255,110 +1511,314 @@ func (m *RegisterFeeCollectorResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RerouteFailedIBCTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *LinkRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *RerouteFailedIBCTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RerouteFailedIBCTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.RecipientAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.RecipientChain)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Asset)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LinkResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DepositAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *RerouteFailedIBCTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ConfirmDepositRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TxID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Token.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.DepositAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *RerouteFailedIBCTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConfirmDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RerouteFailedIBCTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ExecutePendingTransfersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *RefundFailedIBCTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ExecutePendingTransfersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *RefundFailedIBCTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisterIBCPathRequest) Size() (n int) {
+func (m *RefundFailedIBCTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefundFailedIBCTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefundFailedIBCTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefundFailedIBCTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SetCosmosChainIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCosmosChainIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCosmosChainIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetCosmosChainIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCosmosChainIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCosmosChainIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecipientAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecipientChain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *LinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DepositAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfirmDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.DepositAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfirmDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExecutePendingTransfersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutePendingTransfersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RegisterIBCPathRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1406,6 +1866,10 @@ func (m *AddCosmosBasedChainRequest) Size() (n int) {
 	}
 	l = m.MinAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1482,25 +1946,614 @@ func (m *RegisterFeeCollectorRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *RegisterFeeCollectorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RerouteFailedIBCTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RerouteFailedIBCTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RefundFailedIBCTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RefundFailedIBCTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SetCosmosChainIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SetCosmosChainIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfirmDepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfirmDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfirmDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = append(m.TxID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxID == nil {
+				m.TxID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositAddress = append(m.DepositAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositAddress == nil {
+				m.DepositAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *RegisterFeeCollectorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *ConfirmDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfirmDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfirmDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *LinkRequest) Unmarshal(dAtA []byte) error {
+func (m *ExecutePendingTransfersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1523,10 +2576,10 @@ func (m *LinkRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LinkRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutePendingTransfersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutePendingTransfersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1563,11 +2616,111 @@ func (m *LinkRequest) Unmarshal(dAtA []byte) error {
 				m.Sender = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutePendingTransfersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutePendingTransfersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutePendingTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterIBCPathRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterIBCPathRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterIBCPathRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1577,27 +2730,29 @@ func (m *LinkRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientAddr = string(dAtA[iNdEx:postIndex])
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1625,11 +2780,11 @@ func (m *LinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientChain = string(dAtA[iNdEx:postIndex])
+			m.Chain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1657,7 +2812,7 @@ func (m *LinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Asset = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1680,7 +2835,7 @@ func (m *LinkRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LinkResponse) Unmarshal(dAtA []byte) error {
+func (m *RegisterIBCPathResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1690,57 +2845,25 @@ func (m *LinkResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LinkResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterIBCPathResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterIBCPathResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1762,7 +2885,7 @@ func (m *LinkResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfirmDepositRequest) Unmarshal(dAtA []byte) error {
+func (m *AddCosmosBasedChainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1785,10 +2908,10 @@ func (m *ConfirmDepositRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfirmDepositRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddCosmosBasedChainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfirmDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddCosmosBasedChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1827,9 +2950,9 @@ func (m *ConfirmDepositRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1839,31 +2962,30 @@ func (m *ConfirmDepositRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxID = append(m.TxID[:0], dAtA[iNdEx:postIndex]...)
-			if m.TxID == nil {
-				m.TxID = []byte{}
+			if err := m.Chain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddrPrefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1873,28 +2995,27 @@ func (m *ConfirmDepositRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AddrPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1921,10 +3042,41 @@ func (m *ConfirmDepositRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositAddress = append(m.DepositAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.DepositAddress == nil {
-				m.DepositAddress = []byte{}
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1947,7 +3099,7 @@ func (m *ConfirmDepositRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfirmDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *AddCosmosBasedChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1970,10 +3122,10 @@ func (m *ConfirmDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfirmDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddCosmosBasedChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfirmDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddCosmosBasedChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1997,7 +3149,7 @@ func (m *ConfirmDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecutePendingTransfersRequest) Unmarshal(dAtA []byte) error {
+func (m *RegisterAssetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2020,10 +3172,10 @@ func (m *ExecutePendingTransfersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutePendingTransfersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterAssetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutePendingTransfersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterAssetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2060,6 +3212,71 @@ func (m *ExecutePendingTransfersRequest) Unmarshal(dAtA []byte) error {
 				m.Sender = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2081,7 +3298,7 @@ func (m *ExecutePendingTransfersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecutePendingTransfersResponse) Unmarshal(dAtA []byte) error {
+func (m *RegisterAssetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2104,10 +3321,10 @@ func (m *ExecutePendingTransfersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutePendingTransfersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterAssetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutePendingTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2131,7 +3348,7 @@ func (m *ExecutePendingTransfersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterIBCPathRequest) Unmarshal(dAtA []byte) error {
+func (m *RouteIBCTransfersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2148,89 +3365,23 @@ func (m *RegisterIBCPathRequest) Unmarshal(dAtA []byte) error {
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterIBCPathRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterIBCPathRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sender == nil {
-				m.Sender = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Chain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteIBCTransfersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteIBCTransfersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2240,23 +3391,25 @@ func (m *RegisterIBCPathRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2279,7 +3432,7 @@ func (m *RegisterIBCPathRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterIBCPathResponse) Unmarshal(dAtA []byte) error {
+func (m *RouteIBCTransfersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2302,10 +3455,10 @@ func (m *RegisterIBCPathResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterIBCPathResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RouteIBCTransfersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterIBCPathResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RouteIBCTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2329,7 +3482,7 @@ func (m *RegisterIBCPathResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddCosmosBasedChainRequest) Unmarshal(dAtA []byte) error {
+func (m *RegisterFeeCollectorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2352,10 +3505,10 @@ func (m *AddCosmosBasedChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddCosmosBasedChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterFeeCollectorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddCosmosBasedChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterFeeCollectorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2394,72 +3547,7 @@ func (m *AddCosmosBasedChainRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Chain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddrPrefix", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AddrPrefix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2486,8 +3574,9 @@ func (m *AddCosmosBasedChainRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FeeCollector = append(m.FeeCollector[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeeCollector == nil {
+				m.FeeCollector = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2511,7 +3600,7 @@ func (m *AddCosmosBasedChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddCosmosBasedChainResponse) Unmarshal(dAtA []byte) error {
+func (m *RegisterFeeCollectorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2534,10 +3623,10 @@ func (m *AddCosmosBasedChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddCosmosBasedChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterFeeCollectorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddCosmosBasedChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterFeeCollectorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2561,7 +3650,7 @@ func (m *AddCosmosBasedChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterAssetRequest) Unmarshal(dAtA []byte) error {
+func (m *RerouteFailedIBCTransferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2584,10 +3673,10 @@ func (m *RegisterAssetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterAssetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RerouteFailedIBCTransferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterAssetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RerouteFailedIBCTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2625,6 +3714,25 @@ func (m *RegisterAssetRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
@@ -2656,11 +3764,11 @@ func (m *RegisterAssetRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Chain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2670,24 +3778,23 @@ func (m *RegisterAssetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2710,7 +3817,7 @@ func (m *RegisterAssetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterAssetResponse) Unmarshal(dAtA []byte) error {
+func (m *RerouteFailedIBCTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2733,10 +3840,10 @@ func (m *RegisterAssetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterAssetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RerouteFailedIBCTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RerouteFailedIBCTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2760,7 +3867,7 @@ func (m *RegisterAssetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RouteIBCTransfersRequest) Unmarshal(dAtA []byte) error {
+func (m *RefundFailedIBCTransferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2783,10 +3890,10 @@ func (m *RouteIBCTransfersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RouteIBCTransfersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefundFailedIBCTransferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RouteIBCTransfersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefundFailedIBCTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2823,6 +3930,59 @@ func (m *RouteIBCTransfersRequest) Unmarshal(dAtA []byte) error {
 				m.Sender = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2844,7 +4004,7 @@ func (m *RouteIBCTransfersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RouteIBCTransfersResponse) Unmarshal(dAtA []byte) error {
+func (m *RefundFailedIBCTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2867,10 +4027,10 @@ func (m *RouteIBCTransfersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RouteIBCTransfersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefundFailedIBCTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RouteIBCTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefundFailedIBCTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2894,7 +4054,7 @@ func (m *RouteIBCTransfersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterFeeCollectorRequest) Unmarshal(dAtA []byte) error {
+func (m *SetCosmosChainIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2917,10 +4077,10 @@ func (m *RegisterFeeCollectorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterFeeCollectorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetCosmosChainIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterFeeCollectorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetCosmosChainIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2959,9 +4119,9 @@ func (m *RegisterFeeCollectorRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2971,25 +4131,55 @@ func (m *RegisterFeeCollectorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeCollector = append(m.FeeCollector[:0], dAtA[iNdEx:postIndex]...)
-			if m.FeeCollector == nil {
-				m.FeeCollector = []byte{}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3012,7 +4202,7 @@ func (m *RegisterFeeCollectorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterFeeCollectorResponse) Unmarshal(dAtA []byte) error {
+func (m *SetCosmosChainIDResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3035,10 +4225,10 @@ func (m *RegisterFeeCollectorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterFeeCollectorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetCosmosChainIDResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterFeeCollectorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetCosmosChainIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1,9 +1,12 @@
 package types
 
 import (
+	"bytes"
 	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/axelarnetwork/axelar-core/utils"
 
@@ -49,6 +52,80 @@ func (m IBCTransfer) Validate() error {
 	return nil
 }
 
+// RoutingMemo instructs axelarnet to forward tokens received through an ICS-20 transfer to another chain.
+// It is passed in the receiver field of the transfer as a JSON object
+type RoutingMemo struct {
+	DestinationChain   string `json:"destination_chain"`
+	DestinationAddress string `json:"destination_address"`
+}
+
+// ParseRoutingMemo parses the receiver of an ICS-20 transfer as a routing memo.
+// Returns false if the receiver is not a routing memo
+func ParseRoutingMemo(receiver string) (RoutingMemo, bool) {
+	decoder := json.NewDecoder(bytes.NewBufferString(receiver))
+	decoder.DisallowUnknownFields()
+
+	var memo RoutingMemo
+	if err := decoder.Decode(&memo); err != nil {
+		return RoutingMemo{}, false
+	}
+
+	if decoder.More() {
+		return RoutingMemo{}, false
+	}
+
+	return memo, true
+}
+
+// Validate checks the stateless validity of the routing memo
+func (m RoutingMemo) Validate() error {
+	if m.DestinationChain == "" {
+		return fmt.Errorf("destination chain is empty")
+	}
+
+	if m.DestinationAddress == "" {
+		return fmt.Errorf("destination address is empty")
+	}
+
+	return nil
+}
+
+// IBCPath returns the path the transfer is sent along, including the hops it is forwarded along
+func (m IBCTransfer) IBCPath() string {
+	path := fmt.Sprintf("%s/%s", m.PortID, m.ChannelID)
+	if m.ForwardPath != "" {
+		path = fmt.Sprintf("%s/%s", path, m.ForwardPath)
+	}
+
+	return path
+}
+
+// SplitIBCPath splits the given IBC path into its hops. Each hop consists of a port ID and a channel ID,
+// the first hop leads from Axelar to a directly connected chain, every further hop leads from the previous chain to the next one
+func SplitIBCPath(path string) ([]string, error) {
+	segments := strings.Split(path, "/")
+	if len(segments)%2 != 0 {
+		return nil, fmt.Errorf("path %s must consist of port ID and channel ID pairs", path)
+	}
+
+	var hops []string
+	for i := 0; i < len(segments); i += 2 {
+		if segments[i] == "" || segments[i+1] == "" {
+			return nil, fmt.Errorf("path %s contains an empty identifier", path)
+		}
+
+		hops = append(hops, fmt.Sprintf("%s/%s", segments[i], segments[i+1]))
+	}
+
+	return hops, nil
+}
+
+// NewForwardReceiver returns the receiver of an ICS-20 transfer that instructs the packet forward middleware of
+// an intermediate chain to forward the tokens received by the intermediate address along the given hop to the receiver
+func NewForwardReceiver(intermediate, hop, receiver string) string {
+	return fmt.Sprintf("%s|%s:%s", intermediate, hop, receiver)
+}
+
 type sortedChains []CosmosChain
 
 func (s sortedChains) Len() int {
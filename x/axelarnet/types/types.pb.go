@@ -25,6 +25,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type IBCTransferStatus int32
+
+const (
+	TransferUnspecified IBCTransferStatus = 0
+	TransferPending     IBCTransferStatus = 1
+	TransferRetrying    IBCTransferStatus = 2
+	TransferFailed      IBCTransferStatus = 3
+)
+
+var IBCTransferStatus_name = map[int32]string{
+	0: "IBC_TRANSFER_STATUS_UNSPECIFIED",
+	1: "IBC_TRANSFER_STATUS_PENDING",
+	2: "IBC_TRANSFER_STATUS_RETRYING",
+	3: "IBC_TRANSFER_STATUS_FAILED",
+}
+
+var IBCTransferStatus_value = map[string]int32{
+	"IBC_TRANSFER_STATUS_UNSPECIFIED": 0,
+	"IBC_TRANSFER_STATUS_PENDING":     1,
+	"IBC_TRANSFER_STATUS_RETRYING":    2,
+	"IBC_TRANSFER_STATUS_FAILED":      3,
+}
+
+func (x IBCTransferStatus) String() string {
+	return proto.EnumName(IBCTransferStatus_name, int32(x))
+}
+
+func (IBCTransferStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_60cef2e8b09d640f, []int{0}
+}
+
 type IBCTransfer struct {
 	Sender    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
 	Receiver  string                                        `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
@@ -32,6 +63,15 @@ type IBCTransfer struct {
 	PortID    string                                        `protobuf:"bytes,4,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
 	ChannelID string                                        `protobuf:"bytes,5,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 	Sequence  uint64                                        `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	ID        uint64                                        `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
+	// number of times the transfer has been sent
+	Attempts uint64 `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// block height at which a retrying transfer is resent
+	RetryHeight int64             `protobuf:"varint,9,opt,name=retry_height,json=retryHeight,proto3" json:"retry_height,omitempty"`
+	Status      IBCTransferStatus `protobuf:"varint,10,opt,name=status,proto3,enum=axelarnet.v1beta1.IBCTransferStatus" json:"status,omitempty"`
+	// hops the counterparty chain forwards the transfer along to reach the
+	// receiver
+	ForwardPath string `protobuf:"bytes,11,opt,name=forward_path,json=forwardPath,proto3" json:"forward_path,omitempty"`
 }
 
 func (m *IBCTransfer) Reset()         { *m = IBCTransfer{} }
@@ -72,6 +112,9 @@ type CosmosChain struct {
 	IBCPath    string  `protobuf:"bytes,2,opt,name=ibc_path,json=ibcPath,proto3" json:"ibc_path,omitempty"`
 	Assets     []Asset `protobuf:"bytes,3,rep,name=assets,proto3" json:"assets"`
 	AddrPrefix string  `protobuf:"bytes,4,opt,name=addr_prefix,json=addrPrefix,proto3" json:"addr_prefix,omitempty"`
+	// chain ID of the counterparty chain, as tracked by the IBC client of its
+	// path
+	ChainID string `protobuf:"bytes,5,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *CosmosChain) Reset()         { *m = CosmosChain{} }
@@ -108,8 +151,10 @@ func (m *CosmosChain) XXX_DiscardUnknown() {
 var xxx_messageInfo_CosmosChain proto.InternalMessageInfo
 
 type Asset struct {
-	Denom     string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	MinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_amount,json=minAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_amount"`
+	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	MinAmount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_amount,json=minAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_amount"`
+	Decimals     uint32                                 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	DisplayDenom string                                 `protobuf:"bytes,4,opt,name=display_denom,json=displayDenom,proto3" json:"display_denom,omitempty"`
 }
 
 func (m *Asset) Reset()         { *m = Asset{} }
@@ -146,6 +191,7 @@ func (m *Asset) XXX_DiscardUnknown() {
 var xxx_messageInfo_Asset proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("axelarnet.v1beta1.IBCTransferStatus", IBCTransferStatus_name, IBCTransferStatus_value)
 	proto.RegisterType((*IBCTransfer)(nil), "axelarnet.v1beta1.IBCTransfer")
 	proto.RegisterType((*CosmosChain)(nil), "axelarnet.v1beta1.CosmosChain")
 	proto.RegisterType((*Asset)(nil), "axelarnet.v1beta1.Asset")
@@ -154,39 +200,58 @@ func init() {
 func init() { proto.RegisterFile("axelarnet/v1beta1/types.proto", fileDescriptor_60cef2e8b09d640f) }
 
 var fileDescriptor_60cef2e8b09d640f = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0x8d, 0x9b, 0xc4, 0x69, 0xce, 0x65, 0xe0, 0xd4, 0xc1, 0x44, 0xc2, 0x8e, 0x82, 0x54, 0x65,
-	0x20, 0xb6, 0x52, 0x44, 0xf7, 0xd8, 0x5d, 0x3c, 0x20, 0x45, 0x56, 0x27, 0x96, 0xe8, 0x7c, 0xfe,
-	0x35, 0x39, 0x25, 0xbe, 0x0b, 0x77, 0x97, 0x12, 0xbe, 0x05, 0x33, 0x23, 0x9f, 0x26, 0x63, 0x47,
-	0xc4, 0x60, 0x81, 0xf3, 0x2d, 0x98, 0x90, 0xff, 0x10, 0x90, 0x58, 0x3a, 0xf9, 0xf7, 0x7e, 0xef,
-	0xf9, 0xdd, 0x7b, 0x3e, 0xa3, 0x97, 0x64, 0x0f, 0x1b, 0x22, 0x39, 0x68, 0xff, 0x61, 0x9a, 0x80,
-	0x26, 0x53, 0x5f, 0x7f, 0xda, 0x82, 0xf2, 0xb6, 0x52, 0x68, 0x81, 0x9f, 0x9f, 0x68, 0xaf, 0xa1,
-	0x07, 0x97, 0x4b, 0xb1, 0x14, 0x15, 0xeb, 0x97, 0x53, 0x2d, 0x1c, 0x38, 0x54, 0xa8, 0x4c, 0x28,
-	0x3f, 0x21, 0x0a, 0x4e, 0x4e, 0x54, 0x30, 0x5e, 0xf3, 0xa3, 0x2f, 0x67, 0xc8, 0x8a, 0x82, 0xf0,
-	0x4e, 0x12, 0xae, 0xee, 0x41, 0xe2, 0x08, 0x99, 0x0a, 0x78, 0x0a, 0xd2, 0x36, 0x86, 0xc6, 0xf8,
-	0x22, 0x98, 0xfe, 0xca, 0xdd, 0xc9, 0x92, 0xe9, 0xd5, 0x2e, 0xf1, 0xa8, 0xc8, 0xfc, 0xc6, 0xae,
-	0x7e, 0x4c, 0x54, 0xba, 0x6e, 0x62, 0xcd, 0x28, 0x9d, 0xa5, 0xa9, 0x04, 0xa5, 0xe2, 0xc6, 0x00,
-	0x0f, 0xd0, 0xb9, 0x04, 0x0a, 0xec, 0x01, 0xa4, 0x7d, 0x36, 0x34, 0xc6, 0xfd, 0xf8, 0x84, 0xf1,
-	0x5b, 0xd4, 0xd5, 0x62, 0x0d, 0xdc, 0x6e, 0x0f, 0x8d, 0xb1, 0x75, 0xfd, 0xc2, 0xab, 0x0d, 0xbd,
-	0x32, 0xe6, 0x9f, 0x46, 0x5e, 0x28, 0x18, 0x0f, 0x3a, 0x87, 0xdc, 0x6d, 0xc5, 0xb5, 0x1a, 0xbf,
-	0x42, 0xbd, 0xad, 0x90, 0x7a, 0xc1, 0x52, 0xbb, 0x53, 0x3a, 0x06, 0xa8, 0xc8, 0x5d, 0x73, 0x2e,
-	0xa4, 0x8e, 0x6e, 0x63, 0xb3, 0xa4, 0xa2, 0x14, 0xbf, 0x46, 0x88, 0xae, 0x08, 0xe7, 0xb0, 0x29,
-	0x75, 0xdd, 0x4a, 0xf7, 0xac, 0xc8, 0xdd, 0x7e, 0x58, 0x6f, 0xa3, 0xdb, 0xb8, 0xdf, 0x08, 0xa2,
-	0xb4, 0x4c, 0xa9, 0xe0, 0xc3, 0x0e, 0x38, 0x05, 0xdb, 0x1c, 0x1a, 0xe3, 0x4e, 0x7c, 0xc2, 0xa3,
-	0xaf, 0x06, 0xb2, 0xc2, 0x2a, 0x58, 0xb8, 0x22, 0x8c, 0x63, 0x8c, 0x3a, 0x9c, 0x64, 0x50, 0x7d,
-	0x9a, 0x7e, 0x5c, 0xcd, 0xf8, 0x0a, 0x9d, 0xb3, 0x84, 0x2e, 0xb6, 0x44, 0xaf, 0xea, 0x96, 0x81,
-	0x55, 0xe4, 0x6e, 0x2f, 0x0a, 0xc2, 0x39, 0xd1, 0xab, 0xb8, 0xc7, 0x12, 0x5a, 0x0e, 0xf8, 0x06,
-	0x99, 0x44, 0x29, 0xd0, 0xca, 0x6e, 0x0f, 0xdb, 0x63, 0xeb, 0xda, 0xf6, 0xfe, 0xbb, 0x42, 0x6f,
-	0x56, 0x0a, 0x9a, 0xc6, 0x8d, 0x1a, 0xbb, 0xc8, 0x22, 0x69, 0x2a, 0x17, 0x5b, 0x09, 0xf7, 0x6c,
-	0x5f, 0xd7, 0x8e, 0x51, 0xb9, 0x9a, 0x57, 0x9b, 0xd1, 0x06, 0x75, 0xab, 0xf7, 0xf0, 0x25, 0xea,
-	0xa6, 0xc0, 0x45, 0xd6, 0xc4, 0xab, 0x01, 0x7e, 0x87, 0x50, 0xc6, 0xf8, 0x82, 0x64, 0x62, 0xc7,
-	0x75, 0x95, 0xf0, 0x22, 0xf0, 0xca, 0x13, 0xbe, 0xe7, 0xee, 0xd5, 0x13, 0x2e, 0x36, 0xe2, 0x3a,
-	0xee, 0x67, 0x8c, 0xcf, 0x2a, 0x83, 0xe0, 0xee, 0xf0, 0xd3, 0x69, 0x1d, 0x0a, 0xc7, 0x78, 0x2c,
-	0x1c, 0xe3, 0x47, 0xe1, 0x18, 0x9f, 0x8f, 0x4e, 0xeb, 0xf1, 0xe8, 0xb4, 0xbe, 0x1d, 0x9d, 0xd6,
-	0xfb, 0x9b, 0x7f, 0x0c, 0x4f, 0xf5, 0x3e, 0x0a, 0xb9, 0x6e, 0xd0, 0x84, 0x0a, 0x09, 0xfe, 0xfe,
-	0x2f, 0x57, 0x1f, 0x92, 0x98, 0xd5, 0xcf, 0xf8, 0xe6, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6e,
-	0x90, 0x30, 0x1e, 0xf6, 0x02, 0x00, 0x00,
+	// 808 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x6f, 0xe3, 0x44,
+	0x1b, 0x8e, 0x93, 0x36, 0x69, 0xc6, 0xed, 0x7e, 0xdd, 0xd9, 0xea, 0xc3, 0x18, 0xb0, 0x4d, 0x17,
+	0xad, 0x22, 0x44, 0x1d, 0xb5, 0x40, 0x4f, 0x7b, 0x89, 0x93, 0x14, 0x2c, 0x41, 0x15, 0x4d, 0xd2,
+	0x03, 0x5c, 0xac, 0x89, 0x67, 0x9a, 0x8c, 0x1a, 0x8f, 0xc3, 0xcc, 0x74, 0xb7, 0xfd, 0x07, 0xa8,
+	0x27, 0xfe, 0x40, 0x4f, 0x70, 0xe0, 0xc2, 0xff, 0xa8, 0x38, 0xad, 0x38, 0x21, 0x0e, 0x11, 0xa4,
+	0xff, 0x02, 0x09, 0x09, 0xcd, 0xd8, 0xc9, 0xae, 0xb4, 0x3d, 0x70, 0xca, 0xbc, 0xcf, 0xfb, 0x3e,
+	0x8f, 0x9f, 0x99, 0xe7, 0x55, 0xc0, 0x07, 0xf8, 0x8a, 0xce, 0xb0, 0xe0, 0x54, 0xb5, 0x5f, 0x1c,
+	0x8e, 0xa9, 0xc2, 0x87, 0x6d, 0x75, 0x3d, 0xa7, 0x32, 0x9c, 0x8b, 0x5c, 0xe5, 0xf0, 0xf1, 0xba,
+	0x1d, 0x96, 0x6d, 0x77, 0x6f, 0x92, 0x4f, 0x72, 0xd3, 0x6d, 0xeb, 0x53, 0x31, 0xe8, 0x7a, 0x69,
+	0x2e, 0xb3, 0x5c, 0xb6, 0xc7, 0x58, 0xd2, 0xb5, 0x52, 0x9a, 0x33, 0x5e, 0xf4, 0xf7, 0x7f, 0xab,
+	0x01, 0x3b, 0x8e, 0xba, 0x23, 0x81, 0xb9, 0x3c, 0xa7, 0x02, 0xc6, 0xa0, 0x2e, 0x29, 0x27, 0x54,
+	0x38, 0x56, 0x60, 0xb5, 0xb6, 0xa3, 0xc3, 0xbf, 0x17, 0xfe, 0xc1, 0x84, 0xa9, 0xe9, 0xe5, 0x38,
+	0x4c, 0xf3, 0xac, 0x5d, 0xca, 0x15, 0x3f, 0x07, 0x92, 0x5c, 0x94, 0xb6, 0x3a, 0x69, 0xda, 0x21,
+	0x44, 0x50, 0x29, 0x51, 0x29, 0x00, 0x5d, 0xb0, 0x25, 0x68, 0x4a, 0xd9, 0x0b, 0x2a, 0x9c, 0x6a,
+	0x60, 0xb5, 0x9a, 0x68, 0x5d, 0xc3, 0xcf, 0xc1, 0xa6, 0xca, 0x2f, 0x28, 0x77, 0x6a, 0x81, 0xd5,
+	0xb2, 0x8f, 0xde, 0x0d, 0x0b, 0xc1, 0x50, 0xdb, 0x5c, 0xdd, 0x28, 0xec, 0xe6, 0x8c, 0x47, 0x1b,
+	0x77, 0x0b, 0xbf, 0x82, 0x8a, 0x69, 0xf8, 0x14, 0x34, 0xe6, 0xb9, 0x50, 0x09, 0x23, 0xce, 0x86,
+	0x56, 0x8c, 0xc0, 0x72, 0xe1, 0xd7, 0x07, 0xb9, 0x50, 0x71, 0x0f, 0xd5, 0x75, 0x2b, 0x26, 0xf0,
+	0x13, 0x00, 0xd2, 0x29, 0xe6, 0x9c, 0xce, 0xf4, 0xdc, 0xa6, 0x99, 0xdb, 0x59, 0x2e, 0xfc, 0x66,
+	0xb7, 0x40, 0xe3, 0x1e, 0x6a, 0x96, 0x03, 0x31, 0xd1, 0x2e, 0x25, 0xfd, 0xee, 0x92, 0xf2, 0x94,
+	0x3a, 0xf5, 0xc0, 0x6a, 0x6d, 0xa0, 0x75, 0x0d, 0xff, 0x0f, 0xaa, 0x8c, 0x38, 0x0d, 0x8d, 0x46,
+	0xf5, 0xe5, 0xc2, 0xaf, 0xc6, 0x3d, 0x54, 0x65, 0x86, 0x83, 0x95, 0xa2, 0xd9, 0x5c, 0x49, 0x67,
+	0xab, 0xe0, 0xac, 0x6a, 0xf8, 0x21, 0xd8, 0x16, 0x54, 0x89, 0xeb, 0x64, 0x4a, 0xd9, 0x64, 0xaa,
+	0x9c, 0x66, 0x60, 0xb5, 0x6a, 0xc8, 0x36, 0xd8, 0x97, 0x06, 0x82, 0xcf, 0x41, 0x5d, 0x2a, 0xac,
+	0x2e, 0xa5, 0x03, 0x02, 0xab, 0xf5, 0xe8, 0xe8, 0xa3, 0xf0, 0xad, 0x34, 0xc3, 0x37, 0x32, 0x19,
+	0x9a, 0x59, 0x54, 0x72, 0xf4, 0x07, 0xce, 0x73, 0xf1, 0x12, 0x0b, 0x92, 0xcc, 0xb1, 0x9a, 0x3a,
+	0xb6, 0x79, 0x5a, 0xbb, 0xc4, 0x06, 0x58, 0x4d, 0xf7, 0x7f, 0xb5, 0x80, 0xdd, 0x35, 0x0f, 0xda,
+	0x9d, 0x62, 0xc6, 0x21, 0x04, 0x1b, 0x1c, 0x67, 0xd4, 0x44, 0xda, 0x44, 0xe6, 0x0c, 0x9f, 0x81,
+	0x2d, 0x36, 0x4e, 0x0b, 0x09, 0x93, 0x4e, 0x64, 0x2f, 0x17, 0x7e, 0x23, 0x8e, 0xba, 0x5a, 0x02,
+	0x35, 0xd8, 0x38, 0xd5, 0x07, 0x78, 0x0c, 0xea, 0x58, 0x4a, 0xaa, 0xa4, 0x53, 0x0b, 0x6a, 0x2d,
+	0xfb, 0xc8, 0x79, 0xc0, 0x6c, 0x47, 0x0f, 0x94, 0x49, 0x95, 0xd3, 0xd0, 0x07, 0x36, 0x26, 0x44,
+	0x24, 0x73, 0x41, 0xcf, 0xd9, 0x55, 0x11, 0x17, 0x02, 0x1a, 0x1a, 0x18, 0x44, 0x1b, 0x48, 0xb5,
+	0xbb, 0xd7, 0x21, 0x19, 0x03, 0xc6, 0x71, 0xdc, 0x43, 0x0d, 0xd3, 0x8c, 0xc9, 0xfe, 0x2f, 0x16,
+	0xd8, 0x34, 0x1f, 0x80, 0x7b, 0x60, 0x93, 0x50, 0x9e, 0x67, 0xe5, 0x3d, 0x8a, 0x02, 0x7e, 0x0d,
+	0x40, 0xc6, 0x78, 0x82, 0xb3, 0xfc, 0x92, 0x2b, 0x73, 0x95, 0xed, 0x28, 0xd4, 0x56, 0xfe, 0x58,
+	0xf8, 0xcf, 0xfe, 0xc3, 0xe6, 0xc6, 0x5c, 0xa1, 0x66, 0xc6, 0x78, 0xc7, 0x08, 0xe8, 0x6c, 0x09,
+	0x4d, 0x59, 0x86, 0x67, 0xd2, 0x2c, 0xe7, 0x0e, 0x5a, 0xd7, 0xf0, 0x29, 0xd8, 0x21, 0x4c, 0xce,
+	0x67, 0xf8, 0x3a, 0x29, 0x8c, 0x14, 0xb7, 0xda, 0x2e, 0xc1, 0x9e, 0xc6, 0x3e, 0xfe, 0xc7, 0x02,
+	0x8f, 0xdf, 0x4a, 0x0f, 0x3e, 0x07, 0x7e, 0x1c, 0x75, 0x93, 0x11, 0xea, 0x9c, 0x0e, 0x4f, 0xfa,
+	0x28, 0x19, 0x8e, 0x3a, 0xa3, 0xb3, 0x61, 0x72, 0x76, 0x3a, 0x1c, 0xf4, 0xbb, 0xf1, 0x49, 0xdc,
+	0xef, 0xed, 0x56, 0xdc, 0x77, 0x6e, 0x6e, 0x83, 0x27, 0x2b, 0xe2, 0x19, 0x97, 0x73, 0x9a, 0xb2,
+	0x73, 0x46, 0x09, 0xfc, 0x0c, 0xbc, 0xf7, 0x10, 0x7b, 0xd0, 0x3f, 0xed, 0xc5, 0xa7, 0x5f, 0xec,
+	0x5a, 0xee, 0x93, 0x9b, 0xdb, 0xe0, 0x7f, 0x2b, 0xe6, 0x80, 0x72, 0xc2, 0xf8, 0x04, 0x1e, 0x83,
+	0xf7, 0x1f, 0x62, 0xa1, 0xfe, 0x08, 0x7d, 0xa3, 0x69, 0x55, 0x77, 0xef, 0xe6, 0x36, 0xd8, 0x5d,
+	0xd1, 0x90, 0x5e, 0x51, 0xcd, 0x3b, 0x02, 0xee, 0x43, 0xbc, 0x93, 0x4e, 0xfc, 0x55, 0xbf, 0xb7,
+	0x5b, 0x73, 0xe1, 0xcd, 0x6d, 0xf0, 0x68, 0xc5, 0x3a, 0xc1, 0x6c, 0x46, 0x89, 0xbb, 0xf5, 0xfd,
+	0x8f, 0x5e, 0xe5, 0xe7, 0x9f, 0x3c, 0x2b, 0x1a, 0xdd, 0xfd, 0xe5, 0x55, 0xee, 0x96, 0x9e, 0xf5,
+	0x6a, 0xe9, 0x59, 0x7f, 0x2e, 0x3d, 0xeb, 0x87, 0x7b, 0xaf, 0xf2, 0xea, 0xde, 0xab, 0xfc, 0x7e,
+	0xef, 0x55, 0xbe, 0x3d, 0x7e, 0x23, 0x91, 0xf5, 0x22, 0xbd, 0xcc, 0xc5, 0x45, 0x59, 0x1d, 0xa4,
+	0xb9, 0xa0, 0xed, 0xab, 0xd7, 0xbd, 0x22, 0xa5, 0x71, 0xdd, 0xfc, 0x5d, 0x7d, 0xfa, 0xef, 0x00,
+	0xec, 0x29, 0xe4, 0x1d, 0x18, 0x05, 0x00, 0x00,
 }
 
 func (m *IBCTransfer) Marshal() (dAtA []byte, err error) {
@@ -209,6 +274,33 @@ func (m *IBCTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ForwardPath) > 0 {
+		i -= len(m.ForwardPath)
+		copy(dAtA[i:], m.ForwardPath)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ForwardPath)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RetryHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RetryHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.Sequence != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
 		i--
@@ -275,6 +367,13 @@ func (m *CosmosChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.AddrPrefix) > 0 {
 		i -= len(m.AddrPrefix)
 		copy(dAtA[i:], m.AddrPrefix)
@@ -333,6 +432,18 @@ func (m *Asset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DisplayDenom) > 0 {
+		i -= len(m.DisplayDenom)
+		copy(dAtA[i:], m.DisplayDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DisplayDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.MinAmount.Size()
 		i -= size
@@ -391,6 +502,22 @@ func (m *IBCTransfer) Size() (n int) {
 	if m.Sequence != 0 {
 		n += 1 + sovTypes(uint64(m.Sequence))
 	}
+	if m.ID != 0 {
+		n += 1 + sovTypes(uint64(m.ID))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovTypes(uint64(m.Attempts))
+	}
+	if m.RetryHeight != 0 {
+		n += 1 + sovTypes(uint64(m.RetryHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	l = len(m.ForwardPath)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -418,6 +545,10 @@ func (m *CosmosChain) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -433,6 +564,13 @@ func (m *Asset) Size() (n int) {
 	}
 	l = m.MinAmount.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	if m.Decimals != 0 {
+		n += 1 + sovTypes(uint64(m.Decimals))
+	}
+	l = len(m.DisplayDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -653,6 +791,114 @@ func (m *IBCTransfer) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryHeight", wireType)
+			}
+			m.RetryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= IBCTransferStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -833,6 +1079,38 @@ func (m *CosmosChain) Unmarshal(dAtA []byte) error {
 			}
 			m.AddrPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -948,6 +1226,57 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
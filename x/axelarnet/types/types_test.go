@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+)
+
+func TestParseRoutingMemo(t *testing.T) {
+	t.Run("should parse a routing memo", func(t *testing.T) {
+		memo, ok := ParseRoutingMemo(`{"destination_chain":"Ethereum","destination_address":"0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8"}`)
+		assert.True(t, ok)
+		assert.NoError(t, memo.Validate())
+		assert.Equal(t, "Ethereum", memo.DestinationChain)
+		assert.Equal(t, "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8", memo.DestinationAddress)
+	})
+
+	t.Run("should not parse a plain receiver address", func(t *testing.T) {
+		_, ok := ParseRoutingMemo(rand.AccAddr().String())
+		assert.False(t, ok)
+	})
+
+	t.Run("should not parse unknown fields", func(t *testing.T) {
+		_, ok := ParseRoutingMemo(`{"destination_chain":"Ethereum","destination_address":"0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8","amount":"1"}`)
+		assert.False(t, ok)
+	})
+
+	t.Run("should not parse trailing data", func(t *testing.T) {
+		_, ok := ParseRoutingMemo(`{"destination_chain":"Ethereum","destination_address":"0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8"}{}`)
+		assert.False(t, ok)
+	})
+
+	t.Run("should fail validation if the destination is incomplete", func(t *testing.T) {
+		memo, ok := ParseRoutingMemo(`{"destination_chain":"Ethereum"}`)
+		assert.True(t, ok)
+		assert.Error(t, memo.Validate())
+	})
+}
+
+func TestSplitIBCPath(t *testing.T) {
+	t.Run("should split a multi-hop path into its hops", func(t *testing.T) {
+		hops, err := SplitIBCPath("transfer/channel-1/transfer/channel-7")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"transfer/channel-1", "transfer/channel-7"}, hops)
+	})
+
+	t.Run("should return error for incomplete hops", func(t *testing.T) {
+		_, err := SplitIBCPath("transfer/channel-1/transfer")
+		assert.Error(t, err)
+
+		_, err = SplitIBCPath("transfer//transfer/channel-7")
+		assert.Error(t, err)
+	})
+}
+
+func TestIBCTransfer_IBCPath(t *testing.T) {
+	transfer := IBCTransfer{PortID: "transfer", ChannelID: "channel-1"}
+	assert.Equal(t, "transfer/channel-1", transfer.IBCPath())
+
+	transfer.ForwardPath = "transfer/channel-7"
+	assert.Equal(t, "transfer/channel-1/transfer/channel-7", transfer.IBCPath())
+}
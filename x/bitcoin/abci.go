@@ -1,18 +1,115 @@
 package bitcoin
 
 import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/keeper"
 	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
 )
 
 // BeginBlocker check for infraction evidence or downtime of validators
 // on every begin block
-func BeginBlocker(_ sdk.Context, _ abci.RequestBeginBlock, _ types.BTCKeeper) {}
+func BeginBlocker(_ sdk.Context, _ abci.RequestBeginBlock, _ types.BaseKeeper) {}
 
 // EndBlocker called every block, process inflation, update validator set.
-func EndBlocker(ctx sdk.Context, req abci.RequestEndBlock, k types.BTCKeeper, signer types.Signer) []abci.ValidatorUpdate {
+func EndBlocker(ctx sdk.Context, req abci.RequestEndBlock, k types.BaseKeeper, n types.Nexus, signer types.Signer, snapshotter types.Snapshotter) []abci.ValidatorUpdate {
+	for _, chainName := range k.GetChains(ctx) {
+		pruneExpiredDepositAddresses(ctx, k.ForChain(chainName))
+
+		if chain, ok := n.GetChain(ctx, chainName); ok && n.IsChainActivated(ctx, chain) {
+			createRotationConsolidations(ctx, k.ForChain(chainName), n, signer, snapshotter, chain)
+		}
+	}
 
 	return nil
 }
+
+// createRotationConsolidations creates consolidation transactions to keys that have been assigned as next keys
+// and are not yet the target of a consolidation transaction
+func createRotationConsolidations(ctx sdk.Context, k types.BTCKeeper, n types.Nexus, signer types.Signer, snapshotter types.Snapshotter, chain nexus.Chain) {
+	for _, txType := range []types.TxType{types.MasterConsolidation, types.SecondaryConsolidation} {
+		keyRole := tss.MasterKey
+		if txType == types.SecondaryConsolidation {
+			keyRole = tss.SecondaryKey
+		}
+
+		nextKey, ok := signer.GetNextKey(ctx, chain, keyRole)
+		if !ok {
+			continue
+		}
+
+		// a consolidation in progress either rotates to the next key already or has to be signed first
+		if _, ok := k.GetUnsignedTx(ctx, txType); ok {
+			continue
+		}
+
+		// the consolidation must not leave partial state behind if it fails
+		cachedCtx, writeCache := ctx.CacheContext()
+		cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+
+		var err error
+		switch txType {
+		case types.MasterConsolidation:
+			err = keeper.CreateMasterConsolidationTx(cachedCtx, chain, k, signer, snapshotter, nextKey.ID, 0)
+		case types.SecondaryConsolidation:
+			err = keeper.CreateSecondaryConsolidationTx(cachedCtx, chain, k, signer, n, snapshotter, nextKey.ID, 0)
+		}
+
+		if err != nil {
+			k.Logger(ctx).Debug(fmt.Sprintf("cannot create %s transaction for the next %s key %s of chain %s yet: %s",
+				txType.SimpleString(), keyRole.SimpleString(), nextKey.ID, chain.Name, err))
+			continue
+		}
+
+		writeCache()
+		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+
+		k.Logger(ctx).Info(fmt.Sprintf("created %s transaction for the next %s key %s of chain %s",
+			txType.SimpleString(), keyRole.SimpleString(), nextKey.ID, chain.Name))
+	}
+}
+
+func pruneExpiredDepositAddresses(ctx sdk.Context, k types.BTCKeeper) {
+	queue := k.GetDepositAddressExpiryQueue(ctx)
+	isPrunable := func(value codec.ProtoMarshaler) bool {
+		return value.(*types.DepositAddressExpiry).IsPrunable(ctx.BlockHeight())
+	}
+
+	for {
+		// unmarshalling does not reset fields, so a fresh value is needed for every iteration
+		var expiry types.DepositAddressExpiry
+		if !queue.Dequeue(&expiry, isPrunable) {
+			break
+		}
+
+		// the recipient might have been linked to a newer deposit address in the meantime
+		if depositAddr, err := k.GetDepositAddress(ctx, expiry.Recipient); err == nil && depositAddr.EncodeAddress() == expiry.Address {
+			k.DeleteDepositAddress(ctx, expiry.Recipient)
+		}
+
+		// funded deposit addresses are kept to be able to spend their outpoints and to reject further deposits
+		if !expiry.Funded {
+			k.DeleteAddressInfo(ctx, expiry.Address)
+			k.DeleteDepositAddressExpiry(ctx, expiry.Address)
+		}
+
+		k.Logger(ctx).Debug(fmt.Sprintf("pruned expired deposit address %s", expiry.Address))
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeDepositAddress,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChain, k.GetName()),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValuePruned),
+			sdk.NewAttribute(types.AttributeKeyDepositAddress, expiry.Address),
+			sdk.NewAttribute(types.AttributeKeyDestinationChain, expiry.Recipient.Chain.Name),
+			sdk.NewAttribute(types.AttributeKeyDestinationAddress, expiry.Recipient.Address),
+			sdk.NewAttribute(types.AttributeKeyExpiresAt, strconv.FormatInt(expiry.ExpiresAt, 10)),
+		))
+	}
+}
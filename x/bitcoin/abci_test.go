@@ -0,0 +1,238 @@
+package bitcoin
+
+import (
+	mathRand "math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/utils"
+	utilsmock "github.com/axelarnetwork/axelar-core/utils/mock"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/types/mock"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	tssTestUtils "github.com/axelarnetwork/axelar-core/x/tss/exported/testutils"
+)
+
+func TestEndBlocker_CreateRotationConsolidations(t *testing.T) {
+	var (
+		ctx          sdk.Context
+		baseKeeper   *mock.BaseKeeperMock
+		btcKeeper    *mock.BTCKeeperMock
+		nexusKeeper  *mock.NexusMock
+		signerKeeper *mock.SignerMock
+		snapshotter  *mock.SnapshotterMock
+
+		secondaryKey     tss.Key
+		nextSecondaryKey tss.Key
+		inputs           []types.OutPointInfo
+		unsignedTxs      map[types.TxType]types.UnsignedTx
+		unconfirmed      btcutil.Amount
+	)
+
+	newKey := func(keyRole tss.KeyRole) tss.Key {
+		privKey, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			panic(err)
+		}
+
+		return tss.Key{
+			ID:        tssTestUtils.RandKeyID(),
+			PublicKey: &tss.Key_ECDSAKey_{ECDSAKey: &tss.Key_ECDSAKey{Value: privKey.PubKey().SerializeCompressed()}},
+			Role:      keyRole,
+		}
+	}
+
+	setup := func() {
+		ctx = sdk.NewContext(store.NewCommitMultiStore(dbm.NewMemDB()), tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+		secondaryKey = newKey(tss.SecondaryKey)
+		nextSecondaryKey = newKey(tss.SecondaryKey)
+		unsignedTxs = map[types.TxType]types.UnsignedTx{}
+		unconfirmed = 0
+
+		network := types.DefaultParams().Network
+		satoshi, err := types.ToSatoshiCoin(types.DefaultParams().MinOutputAmount)
+		if err != nil {
+			panic(err)
+		}
+		minOutputAmount := btcutil.Amount(satoshi.Amount.Int64())
+
+		secondaryAddress, err := types.NewSecondaryConsolidationAddress(secondaryKey, network)
+		if err != nil {
+			panic(err)
+		}
+
+		inputs = make([]types.OutPointInfo, rand.I64Between(1, 10))
+		for i := range inputs {
+			txHash, err := chainhash.NewHash(rand.Bytes(chainhash.HashSize))
+			if err != nil {
+				panic(err)
+			}
+
+			inputs[i] = types.OutPointInfo{
+				OutPoint: wire.NewOutPoint(txHash, mathRand.Uint32()).String(),
+				Amount:   btcutil.Amount(rand.I64Between(int64(minOutputAmount)*10, 10000000000)),
+				Address:  secondaryAddress.Address,
+			}
+		}
+
+		btcKeeper = &mock.BTCKeeperMock{
+			LoggerFunc:  func(sdk.Context) log.Logger { return log.TestingLogger() },
+			GetNameFunc: func() string { return exported.Bitcoin.Name },
+			GetDepositAddressExpiryQueueFunc: func(sdk.Context) utils.KVQueue {
+				return &utilsmock.KVQueueMock{
+					DequeueFunc: func(codec.ProtoMarshaler, ...func(codec.ProtoMarshaler) bool) bool { return false },
+				}
+			},
+			GetUnsignedTxFunc: func(_ sdk.Context, txType types.TxType) (types.UnsignedTx, bool) {
+				tx, ok := unsignedTxs[txType]
+				return tx, ok
+			},
+			GetConfirmedOutpointInfoQueueForKeyFunc: func(_ sdk.Context, keyID tss.KeyID) utils.KVQueue {
+				dequeueCount := 0
+				return &utilsmock.KVQueueMock{
+					IsEmptyFunc: func() bool { return true },
+					DequeueFunc: func(value codec.ProtoMarshaler, _ ...func(value codec.ProtoMarshaler) bool) bool {
+						if keyID != secondaryKey.ID || dequeueCount >= len(inputs) {
+							return false
+						}
+
+						types.ModuleCdc.MustUnmarshalLengthPrefixed(types.ModuleCdc.MustMarshalLengthPrefixed(&inputs[dequeueCount]), value)
+						dequeueCount++
+						return true
+					},
+				}
+			},
+			GetOutPointInfoFunc: func(_ sdk.Context, outPoint wire.OutPoint) (types.OutPointInfo, types.OutPointState, bool) {
+				for _, input := range inputs {
+					if input.OutPoint == outPoint.String() {
+						return input, types.OutPointState_Spent, true
+					}
+				}
+
+				return types.OutPointInfo{}, types.OutPointState_None, false
+			},
+			GetAddressInfoFunc:           func(sdk.Context, string) (types.AddressInfo, bool) { return secondaryAddress, true },
+			GetMaxInputCountFunc:         func(sdk.Context) int64 { return types.DefaultParams().MaxInputCount },
+			GetMaxTxSizeFunc:             func(sdk.Context) int64 { return types.DefaultParams().MaxTxSize },
+			GetMinOutputAmountFunc:       func(sdk.Context) btcutil.Amount { return minOutputAmount },
+			GetMinRelayTxFeeRateFunc:     func(sdk.Context) int64 { return types.DefaultParams().MinRelayTxFeeRate },
+			GetDustLimitFunc:             func(sdk.Context) btcutil.Amount { return btcutil.Amount(types.DefaultParams().DustLimit) },
+			GetNetworkFunc:               func(sdk.Context) types.Network { return network },
+			GetAnyoneCanSpendAddressFunc: func(sdk.Context) types.AddressInfo { return types.NewAnyoneCanSpendAddress(network) },
+			GetUnconfirmedAmountFunc:     func(sdk.Context, tss.KeyID) btcutil.Amount { return unconfirmed },
+			DeleteOutpointInfoFunc:       func(sdk.Context, wire.OutPoint) {},
+			SetSpentOutpointInfoFunc:     func(sdk.Context, types.OutPointInfo) {},
+			SetAddressInfoFunc:           func(sdk.Context, types.AddressInfo) {},
+			SetUnsignedTxFunc:            func(_ sdk.Context, tx types.UnsignedTx) { unsignedTxs[tx.Type] = tx },
+		}
+		baseKeeper = &mock.BaseKeeperMock{
+			ForChainFunc:  func(string) types.BTCKeeper { return btcKeeper },
+			GetChainsFunc: func(sdk.Context) []string { return []string{exported.Bitcoin.Name} },
+		}
+		nexusKeeper = &mock.NexusMock{
+			GetChainFunc:             func(sdk.Context, string) (nexus.Chain, bool) { return exported.Bitcoin, true },
+			IsChainActivatedFunc:     func(sdk.Context, nexus.Chain) bool { return true },
+			GetTransfersForChainFunc: func(sdk.Context, nexus.Chain, nexus.TransferState) []nexus.CrossChainTransfer { return nil },
+		}
+		signerKeeper = &mock.SignerMock{
+			GetKeyFunc: func(_ sdk.Context, keyID tss.KeyID) (tss.Key, bool) {
+				switch keyID {
+				case secondaryKey.ID:
+					return secondaryKey, true
+				case nextSecondaryKey.ID:
+					return nextSecondaryKey, true
+				default:
+					return tss.Key{}, false
+				}
+			},
+			GetCurrentKeyFunc: func(_ sdk.Context, _ nexus.Chain, keyRole tss.KeyRole) (tss.Key, bool) {
+				return secondaryKey, keyRole == tss.SecondaryKey
+			},
+			GetNextKeyFunc: func(_ sdk.Context, _ nexus.Chain, keyRole tss.KeyRole) (tss.Key, bool) {
+				return nextSecondaryKey, keyRole == tss.SecondaryKey
+			},
+			GetRotationCountFunc:                       func(sdk.Context, nexus.Chain, tss.KeyRole) int64 { return 1 },
+			GetKeyUnbondingLockingKeyRotationCountFunc: func(sdk.Context) int64 { return 2 },
+			AssertMatchesRequirementsFunc: func(sdk.Context, snapshot.Snapshotter, nexus.Chain, tss.KeyID, tss.KeyRole) error {
+				return nil
+			},
+		}
+		snapshotter = &mock.SnapshotterMock{}
+	}
+
+	hasConsolidationEvent := func() bool {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeConsolidationTx {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	repeats := 20
+	t.Run("should create a consolidation transaction rotating to the assigned next key", testutils.Func(func(t *testing.T) {
+		setup()
+
+		EndBlocker(ctx, abci.RequestEndBlock{}, baseKeeper, nexusKeeper, signerKeeper, snapshotter)
+
+		assert.Len(t, btcKeeper.SetUnsignedTxCalls(), 1)
+		unsignedTx := btcKeeper.SetUnsignedTxCalls()[0].Tx
+		assert.Equal(t, types.SecondaryConsolidation, unsignedTx.Type)
+		assert.True(t, unsignedTx.Info.RotateKey)
+		assert.Len(t, unsignedTx.GetTx().TxIn, len(inputs))
+		assert.Len(t, signerKeeper.AssignNextKeyCalls(), 0)
+		assert.True(t, hasConsolidationEvent())
+
+		// the consolidation is in progress now
+		EndBlocker(ctx, abci.RequestEndBlock{}, baseKeeper, nexusKeeper, signerKeeper, snapshotter)
+		assert.Len(t, btcKeeper.SetUnsignedTxCalls(), 1)
+	}).Repeat(repeats))
+
+	t.Run("should not create a consolidation transaction while another one is in progress", testutils.Func(func(t *testing.T) {
+		setup()
+		unsignedTxs[types.SecondaryConsolidation] = types.UnsignedTx{Type: types.SecondaryConsolidation}
+
+		EndBlocker(ctx, abci.RequestEndBlock{}, baseKeeper, nexusKeeper, signerKeeper, snapshotter)
+
+		assert.Len(t, btcKeeper.SetUnsignedTxCalls(), 0)
+		assert.Len(t, btcKeeper.GetConfirmedOutpointInfoQueueForKeyCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should not create a consolidation transaction without an assigned next key", testutils.Func(func(t *testing.T) {
+		setup()
+		signerKeeper.GetNextKeyFunc = func(sdk.Context, nexus.Chain, tss.KeyRole) (tss.Key, bool) { return tss.Key{}, false }
+
+		EndBlocker(ctx, abci.RequestEndBlock{}, baseKeeper, nexusKeeper, signerKeeper, snapshotter)
+
+		assert.Len(t, btcKeeper.SetUnsignedTxCalls(), 0)
+		assert.Len(t, btcKeeper.GetUnsignedTxCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should not emit events if the consolidation transaction cannot be created", testutils.Func(func(t *testing.T) {
+		setup()
+		unconfirmed = btcutil.Amount(rand.PosI64())
+
+		EndBlocker(ctx, abci.RequestEndBlock{}, baseKeeper, nexusKeeper, signerKeeper, snapshotter)
+
+		assert.Len(t, btcKeeper.SetUnsignedTxCalls(), 0)
+		assert.Empty(t, ctx.EventManager().Events())
+	}).Repeat(repeats))
+}
@@ -1,15 +1,19 @@
 package cli
 
 import (
+	"encoding/base64"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/spf13/cobra"
 
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
 	"github.com/axelarnetwork/axelar-core/x/bitcoin/keeper"
 	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
 )
@@ -28,12 +32,15 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(
 		GetCmdDepositAddresses(queryRoute),
 		GetCmdDepositStatus(queryRoute),
+		GetCmdDepositAddressExpiry(queryRoute),
 		GetCmdConsolidationAddress(queryRoute),
 		GetCmdNextKeyID(queryRoute),
 		GetCmdMinOutputAmount(queryRoute),
 		GetCmdLatestTx(queryRoute),
 		GetCmdSignedTx(queryRoute),
+		GetCmdPsbt(queryRoute),
 	)
+	cmd.PersistentFlags().String(flagChain, exported.Bitcoin.Name, "name of the UTXO chain to query")
 
 	return cmd
 }
@@ -41,7 +48,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 // GetCmdDepositAddresses returns a bitcoin deposit address for a recipient address on another blockchain
 func GetCmdDepositAddresses(queryRoute string) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "deposit-addresses [chain] [recipient address]",
+		Use:   "deposit-addresses [recipient chain] [recipient address]",
 		Short: "Returns a bitcoin deposit address for a recipient address on another blockchain",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -50,7 +57,12 @@ func GetCmdDepositAddresses(queryRoute string) *cobra.Command {
 				return err
 			}
 
-			path := fmt.Sprintf("custom/%s/%s", queryRoute, keeper.QDepositAddress)
+			chain, err := cmd.Flags().GetString(flagChain)
+			if err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QDepositAddress, chain)
 			params := types.DepositQueryParams{Chain: args[0], Address: args[1]}
 
 			bz, _, err := clientCtx.QueryWithData(path, types.ModuleCdc.MustMarshalLengthPrefixed(&params))
@@ -81,11 +93,16 @@ func GetCmdDepositStatus(queryRoute string) *cobra.Command {
 				return err
 			}
 
+			chain, err := cmd.Flags().GetString(flagChain)
+			if err != nil {
+				return err
+			}
+
 			_, err = types.OutPointFromStr(args[0])
 			if err != nil {
 				return err
 			}
-			path := fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QDepositStatus, args[0])
+			path := fmt.Sprintf("custom/%s/%s/%s/%s", queryRoute, keeper.QDepositStatus, chain, args[0])
 			bz, _, err := clientCtx.Query(path)
 			if err != nil {
 				return sdkerrors.Wrap(err, types.ErrDepositStatus)
@@ -102,6 +119,40 @@ func GetCmdDepositStatus(queryRoute string) *cobra.Command {
 	return cmd
 }
 
+// GetCmdDepositAddressExpiry returns the expiry of a bitcoin deposit address
+func GetCmdDepositAddressExpiry(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-address-expiry [address]",
+		Short: "Returns the block height at which the given bitcoin deposit address expires",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chain, err := cmd.Flags().GetString(flagChain)
+			if err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("custom/%s/%s/%s/%s", queryRoute, keeper.QDepositAddressExpiry, chain, args[0])
+			bz, _, err := clientCtx.Query(path)
+			if err != nil {
+				return sdkerrors.Wrap(err, types.ErrDepositAddrExpiry)
+			}
+
+			var res types.QueryDepositAddressExpiryResponse
+			types.ModuleCdc.MustUnmarshalLengthPrefixed(bz, &res)
+
+			return clientCtx.PrintProto(&res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdConsolidationAddress returns the consolidation address
 func GetCmdConsolidationAddress(queryRoute string) *cobra.Command {
 	cmd := &cobra.Command{
@@ -118,6 +169,11 @@ func GetCmdConsolidationAddress(queryRoute string) *cobra.Command {
 			return err
 		}
 
+		chain, err := cmd.Flags().GetString(flagChain)
+		if err != nil {
+			return err
+		}
+
 		var query string
 		var param string
 		switch {
@@ -131,7 +187,7 @@ func GetCmdConsolidationAddress(queryRoute string) *cobra.Command {
 			return fmt.Errorf("one and only one of the two flags key-role and key-id has to be set")
 		}
 
-		path := fmt.Sprintf("custom/%s/%s/%s", queryRoute, query, param)
+		path := fmt.Sprintf("custom/%s/%s/%s/%s", queryRoute, query, chain, param)
 
 		bz, _, err := clientCtx.Query(path)
 		if err != nil {
@@ -160,7 +216,12 @@ func GetCmdNextKeyID(queryRoute string) *cobra.Command {
 				return err
 			}
 
-			path := fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QNextKeyID, args[0])
+			chain, err := cmd.Flags().GetString(flagChain)
+			if err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("custom/%s/%s/%s/%s", queryRoute, keeper.QNextKeyID, chain, args[0])
 
 			bz, _, err := clientCtx.Query(path)
 			if err != nil {
@@ -189,7 +250,12 @@ func GetCmdMinOutputAmount(queryRoute string) *cobra.Command {
 				return err
 			}
 
-			path := fmt.Sprintf("custom/%s/%s", queryRoute, keeper.QMinOutputAmount)
+			chain, err := cmd.Flags().GetString(flagChain)
+			if err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QMinOutputAmount, chain)
 
 			bz, _, err := clientCtx.Query(path)
 			if err != nil {
@@ -218,7 +284,12 @@ func GetCmdLatestTx(queryRoute string) *cobra.Command {
 				return err
 			}
 
-			path := fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QLatestTxByTxType, args[0])
+			chain, err := cmd.Flags().GetString(flagChain)
+			if err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("custom/%s/%s/%s/%s", queryRoute, keeper.QLatestTxByTxType, chain, args[0])
 
 			bz, _, err := clientCtx.Query(path)
 			if err != nil {
@@ -248,7 +319,12 @@ func GetCmdSignedTx(queryRoute string) *cobra.Command {
 				return err
 			}
 
-			path := fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QSignedTx, args[0])
+			chain, err := cmd.Flags().GetString(flagChain)
+			if err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("custom/%s/%s/%s/%s", queryRoute, keeper.QSignedTx, chain, args[0])
 
 			bz, _, err := clientCtx.Query(path)
 			if err != nil {
@@ -265,3 +341,92 @@ func GetCmdSignedTx(queryRoute string) *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdPsbt returns the unsigned consolidation transaction of the given tx type as a partially signed bitcoin transaction
+func GetCmdPsbt(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "psbt [txType]",
+		Short: "Returns the unsigned consolidation transaction of the given tx type as a base64 encoded partially signed bitcoin transaction (BIP-174)",
+		Args:  cobra.ExactArgs(1),
+	}
+	keyPaths := cmd.Flags().StringSlice("key-path", []string{}, "BIP-32 derivation of an external key for hardware wallets, formatted as [pubKeyHex]=[fingerprintHex]/[path] (e.g. 02ab..ef=d34db33f/m/48'/0'/0'/2')")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		chain, err := cmd.Flags().GetString(flagChain)
+		if err != nil {
+			return err
+		}
+
+		path := fmt.Sprintf("custom/%s/%s/%s/%s", queryRoute, keeper.QPsbtByTxType, chain, args[0])
+
+		bz, _, err := clientCtx.Query(path)
+		if err != nil {
+			return sdkerrors.Wrap(err, types.ErrPsbt)
+		}
+
+		var res types.QueryPsbtResponse
+		types.ModuleCdc.MustUnmarshalLengthPrefixed(bz, &res)
+
+		packet, err := types.DecodePsbt(res.Psbt)
+		if err != nil {
+			return sdkerrors.Wrap(err, types.ErrPsbt)
+		}
+
+		for _, keyPath := range *keyPaths {
+			pubKey, fingerprint, derivationPath, err := parseKeyPath(keyPath)
+			if err != nil {
+				return err
+			}
+
+			if err := types.AddPsbtKeyPath(packet, pubKey, fingerprint, derivationPath); err != nil {
+				return err
+			}
+		}
+
+		bz, err = types.EncodePsbt(packet)
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintString(base64.StdEncoding.EncodeToString(bz))
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseKeyPath parses a key path of the form [pubKeyHex]=[fingerprintHex]/[path]
+func parseKeyPath(keyPath string) ([]byte, uint32, []uint32, error) {
+	pubKeyAndDerivation := strings.SplitN(keyPath, "=", 2)
+	if len(pubKeyAndDerivation) != 2 {
+		return nil, 0, nil, fmt.Errorf("key path %s must be of the form [pubKeyHex]=[fingerprintHex]/[path]", keyPath)
+	}
+
+	pubKey, err := hex.DecodeString(pubKeyAndDerivation[0])
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	fingerprintAndPath := strings.SplitN(pubKeyAndDerivation[1], "/", 2)
+	if len(fingerprintAndPath) != 2 {
+		return nil, 0, nil, fmt.Errorf("key path %s must be of the form [pubKeyHex]=[fingerprintHex]/[path]", keyPath)
+	}
+
+	fingerprint, err := hex.DecodeString(fingerprintAndPath[0])
+	if err != nil || len(fingerprint) != 4 {
+		return nil, 0, nil, fmt.Errorf("master key fingerprint %s must be 4 bytes hex encoded", fingerprintAndPath[0])
+	}
+
+	derivationPath, err := types.ParseBip32Path(fingerprintAndPath[1])
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	// PSBT serializes the fingerprint in little endian byte order
+	return pubKey, binary.LittleEndian.Uint32(fingerprint), derivationPath, nil
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 
@@ -10,9 +11,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/spf13/cobra"
 
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
 	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
 )
 
+const flagChain = "chain"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	btcTxCmd := &cobra.Command{
@@ -32,7 +36,9 @@ func GetTxCmd() *cobra.Command {
 		GetCmdCreateRescueTx(),
 		GetCmdSignTx(),
 		GetCmdSubmitExternalSignature(),
+		GetCmdSubmitPsbt(),
 	)
+	btcTxCmd.PersistentFlags().String(flagChain, exported.Bitcoin.Name, "name of the UTXO chain the transaction is meant for")
 
 	return btcTxCmd
 }
@@ -50,6 +56,11 @@ func GetCmdConfirmTxOut() *cobra.Command {
 				return err
 			}
 
+			chain, err := cmd.Flags().GetString(flagChain)
+			if err != nil {
+				return err
+			}
+
 			outPoint, err := types.OutPointFromStr(args[0])
 			if err != nil {
 				return err
@@ -62,7 +73,7 @@ func GetCmdConfirmTxOut() *cobra.Command {
 
 			outInfo := types.NewOutPointInfo(outPoint, btcutil.Amount(satoshi.Amount.Int64()), args[2])
 
-			msg := types.NewConfirmOutpointRequest(clientCtx.GetFromAddress(), outInfo)
+			msg := types.NewConfirmOutpointRequest(clientCtx.GetFromAddress(), chain, outInfo)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -77,7 +88,7 @@ func GetCmdConfirmTxOut() *cobra.Command {
 // GetCmdLink links a cross chain address to a bitcoin address created by Axelar
 func GetCmdLink() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "link [chain] [address]",
+		Use:   "link [recipient chain] [address]",
 		Short: "Link a cross chain address to a bitcoin address created by Axelar",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -86,7 +97,12 @@ func GetCmdLink() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewLinkRequest(clientCtx.GetFromAddress(), args[1], args[0])
+			chain, err := cmd.Flags().GetString(flagChain)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewLinkRequest(clientCtx.GetFromAddress(), chain, args[1], args[0])
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -114,12 +130,17 @@ func GetCmdCreatePendingTransfersTx() *cobra.Command {
 			return err
 		}
 
+		chain, err := cmd.Flags().GetString(flagChain)
+		if err != nil {
+			return err
+		}
+
 		masterKeyAmount, err := types.ParseSatoshi(*masterKeyAmountStr)
 		if err != nil {
 			return err
 		}
 
-		msg := types.NewCreatePendingTransfersTxRequest(clientCtx.GetFromAddress(), args[0], btcutil.Amount(masterKeyAmount.Amount.Int64()))
+		msg := types.NewCreatePendingTransfersTxRequest(clientCtx.GetFromAddress(), chain, args[0], btcutil.Amount(masterKeyAmount.Amount.Int64()))
 		if err := msg.ValidateBasic(); err != nil {
 			return err
 		}
@@ -147,12 +168,17 @@ func GetCmdCreateMasterConsolidationTx() *cobra.Command {
 			return err
 		}
 
+		chain, err := cmd.Flags().GetString(flagChain)
+		if err != nil {
+			return err
+		}
+
 		secondaryKeyAmount, err := types.ParseSatoshi(*secondaryKeyAmountStr)
 		if err != nil {
 			return err
 		}
 
-		msg := types.NewCreateMasterTxRequest(clientCtx.GetFromAddress(), args[0], btcutil.Amount(secondaryKeyAmount.Amount.Int64()))
+		msg := types.NewCreateMasterTxRequest(clientCtx.GetFromAddress(), chain, args[0], btcutil.Amount(secondaryKeyAmount.Amount.Int64()))
 		if err := msg.ValidateBasic(); err != nil {
 			return err
 		}
@@ -178,7 +204,12 @@ func GetCmdCreateRescueTx() *cobra.Command {
 			return err
 		}
 
-		msg := types.NewCreateRescueTxRequest(clientCtx.GetFromAddress())
+		chain, err := cmd.Flags().GetString(flagChain)
+		if err != nil {
+			return err
+		}
+
+		msg := types.NewCreateRescueTxRequest(clientCtx.GetFromAddress(), chain)
 		if err := msg.ValidateBasic(); err != nil {
 			return err
 		}
@@ -202,12 +233,17 @@ func GetCmdSignTx() *cobra.Command {
 				return err
 			}
 
+			chain, err := cmd.Flags().GetString(flagChain)
+			if err != nil {
+				return err
+			}
+
 			txType, err := types.TxTypeFromSimpleStr(args[0])
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewSignTxRequest(clientCtx.FromAddress, txType)
+			msg := types.NewSignTxRequest(clientCtx.FromAddress, chain, txType)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -233,6 +269,11 @@ func GetCmdSubmitExternalSignature() *cobra.Command {
 				return err
 			}
 
+			chain, err := cmd.Flags().GetString(flagChain)
+			if err != nil {
+				return err
+			}
+
 			keyID := args[0]
 
 			signature, err := hex.DecodeString(args[1])
@@ -245,7 +286,42 @@ func GetCmdSubmitExternalSignature() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewSubmitExternalSignatureRequest(clientCtx.GetFromAddress(), keyID, signature, sigHash)
+			msg := types.NewSubmitExternalSignatureRequest(clientCtx.GetFromAddress(), chain, keyID, signature, sigHash)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSubmitPsbt returns the cli command to submit a partially signed bitcoin transaction containing signatures from external keys
+func GetCmdSubmitPsbt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-psbt [psbtBase64]",
+		Short: "Submit the signatures of external keys contained in the given base64 encoded partially signed bitcoin transaction (BIP-174)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chain, err := cmd.Flags().GetString(flagChain)
+			if err != nil {
+				return err
+			}
+
+			psbt, err := base64.StdEncoding.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewSubmitPsbtRequest(clientCtx.GetFromAddress(), chain, psbt)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -20,6 +20,7 @@ import (
 const (
 	QueryParamKeyRole = "key_role"
 	QueryParamKeyID   = "key_id"
+	QueryParamChain   = "chain"
 )
 
 // QueryHandlerDepositAddresses returns a handler to query the deposit address for a recipient address on another blockchain
@@ -31,9 +32,11 @@ func QueryHandlerDepositAddresses(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
+		chain := chainOrDefault(r.URL.Query().Get(QueryParamChain))
+
 		vars := mux.Vars(r)
 		params := types.DepositQueryParams{Chain: vars[utils.PathVarChain], Address: vars[utils.PathVarEthereumAddress]}
-		path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QDepositAddress)
+		path := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QDepositAddress, chain)
 
 		bz, _, err := cliCtx.QueryWithData(path, types.ModuleCdc.MustMarshalLengthPrefixed(&params))
 		if err != nil {
@@ -56,8 +59,10 @@ func QueryHandlerDepositStatus(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
+		chain := chainOrDefault(r.URL.Query().Get(QueryParamChain))
+
 		vars := mux.Vars(r)
-		path := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QDepositStatus, vars[utils.PathVarOutpoint])
+		path := fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, keeper.QDepositStatus, chain, vars[utils.PathVarOutpoint])
 
 		bz, _, err := cliCtx.Query(path)
 		if err != nil {
@@ -72,6 +77,32 @@ func QueryHandlerDepositStatus(cliCtx client.Context) http.HandlerFunc {
 	}
 }
 
+// QueryHandlerDepositAddressExpiry returns a handler to query the expiry of a given deposit address
+func QueryHandlerDepositAddressExpiry(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		chain := chainOrDefault(r.URL.Query().Get(QueryParamChain))
+
+		vars := mux.Vars(r)
+		path := fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, keeper.QDepositAddressExpiry, chain, vars[utils.PathVarLinkedAddress])
+
+		bz, _, err := cliCtx.Query(path)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, sdkerrors.Wrap(err, types.ErrDepositAddrExpiry).Error())
+			return
+		}
+
+		var res types.QueryDepositAddressExpiryResponse
+		types.ModuleCdc.MustUnmarshalLengthPrefixed(bz, &res)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // QueryHandlerConsolidationAddress returns a handler to query the consolidation address
 func QueryHandlerConsolidationAddress(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -81,6 +112,8 @@ func QueryHandlerConsolidationAddress(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
+		chain := chainOrDefault(r.URL.Query().Get(QueryParamChain))
+
 		keyID := r.URL.Query().Get(QueryParamKeyID)
 		keyRole := r.URL.Query().Get(QueryParamKeyRole)
 
@@ -98,7 +131,7 @@ func QueryHandlerConsolidationAddress(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		path := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, query, param)
+		path := fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, query, chain, param)
 
 		bz, _, err := cliCtx.Query(path)
 		if err != nil {
@@ -121,8 +154,10 @@ func QueryHandlerNextKeyID(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
+		chain := chainOrDefault(r.URL.Query().Get(QueryParamChain))
+
 		vars := mux.Vars(r)
-		path := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QNextKeyID, vars[utils.PathVarKeyRole])
+		path := fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, keeper.QNextKeyID, chain, vars[utils.PathVarKeyRole])
 
 		bz, _, err := cliCtx.Query(path)
 		if err != nil {
@@ -144,7 +179,9 @@ func QueryHandlerMinOutputAmount(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QMinOutputAmount)
+		chain := chainOrDefault(r.URL.Query().Get(QueryParamChain))
+
+		path := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QMinOutputAmount, chain)
 
 		bz, _, err := cliCtx.Query(path)
 		if err != nil {
@@ -166,8 +203,10 @@ func QueryHandlerLatestTx(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
+		chain := chainOrDefault(r.URL.Query().Get(QueryParamChain))
+
 		vars := mux.Vars(r)
-		path := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QLatestTxByTxType, vars[utils.PathVarTxType])
+		path := fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, keeper.QLatestTxByTxType, chain, vars[utils.PathVarTxType])
 
 		bz, _, err := cliCtx.Query(path)
 		if err != nil {
@@ -189,8 +228,10 @@ func QueryHandlerSignedTx(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
+		chain := chainOrDefault(r.URL.Query().Get(QueryParamChain))
+
 		vars := mux.Vars(r)
-		path := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QSignedTx, vars[utils.PathVarTxID])
+		path := fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, keeper.QSignedTx, chain, vars[utils.PathVarTxID])
 
 		bz, _, err := cliCtx.Query(path)
 		if err != nil {
@@ -202,3 +243,29 @@ func QueryHandlerSignedTx(cliCtx client.Context) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// QueryHandlerPsbt returns a handler to query the unsigned consolidation transaction of the given tx type as a partially signed bitcoin transaction
+func QueryHandlerPsbt(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		chain := chainOrDefault(r.URL.Query().Get(QueryParamChain))
+
+		vars := mux.Vars(r)
+		path := fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, keeper.QPsbtByTxType, chain, vars[utils.PathVarTxType])
+
+		bz, _, err := cliCtx.Query(path)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, sdkerrors.Wrap(err, types.ErrPsbt).Error())
+			return
+		}
+
+		var res types.QueryPsbtResponse
+		types.ModuleCdc.MustUnmarshalLengthPrefixed(bz, &res)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
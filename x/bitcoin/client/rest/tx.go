@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"encoding/base64"
 	"encoding/hex"
 	"net/http"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/gorilla/mux"
 
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
 	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
 
 	clientUtils "github.com/axelarnetwork/axelar-core/utils"
@@ -24,14 +26,17 @@ const (
 	TxCreateRescueTx              = "create-rescue-tx"
 	TxSignTx                      = "sign-tx"
 	TxSubmitExternalSignature     = "submit-external-signature"
+	TxSubmitPsbt                  = "submit-psbt"
 
 	QueryDepositAddresses     = "deposit-addresses"
 	QueryDepositStatus        = "deposit-status"
+	QueryDepositAddressExpiry = "deposit-address-expiry"
 	QueryConsolidationAddress = "consolidation-address"
 	QueryMinOutputAmount      = "min-output-amount"
 	QueryNextKeyID            = "next-key-id"
 	QueryLatestTx             = "latest-tx"
 	QuerySignedTx             = "signed-tx"
+	QueryPsbt                 = "psbt"
 )
 
 // RegisterRoutes registers this module's REST routes with the given router
@@ -44,32 +49,38 @@ func RegisterRoutes(cliCtx client.Context, r *mux.Router) {
 	registerTx(TxHandlerCreateRescueTx(cliCtx), TxCreateRescueTx)
 	registerTx(TxHandlerSignTx(cliCtx), TxSignTx)
 	registerTx(TxHandlerSubmitExternalSignature(cliCtx), TxSubmitExternalSignature)
+	registerTx(TxHandlerSubmitPsbt(cliCtx), TxSubmitPsbt)
 
 	registerQuery := clientUtils.RegisterQueryHandlerFn(r, types.RestRoute)
 	registerQuery(QueryHandlerDepositAddresses(cliCtx), QueryDepositAddresses, clientUtils.PathVarChain, clientUtils.PathVarEthereumAddress)
 	registerQuery(QueryHandlerDepositStatus(cliCtx), QueryDepositStatus, clientUtils.PathVarOutpoint)
+	registerQuery(QueryHandlerDepositAddressExpiry(cliCtx), QueryDepositAddressExpiry, clientUtils.PathVarLinkedAddress)
 	registerQuery(QueryHandlerConsolidationAddress(cliCtx), QueryConsolidationAddress)
 	registerQuery(QueryHandlerNextKeyID(cliCtx), QueryNextKeyID, clientUtils.PathVarKeyRole)
 	registerQuery(QueryHandlerMinOutputAmount(cliCtx), QueryMinOutputAmount)
 	registerQuery(QueryHandlerLatestTx(cliCtx), QueryLatestTx, clientUtils.PathVarTxType)
 	registerQuery(QueryHandlerSignedTx(cliCtx), QuerySignedTx, clientUtils.PathVarTxID)
+	registerQuery(QueryHandlerPsbt(cliCtx), QueryPsbt, clientUtils.PathVarTxType)
 }
 
 // ReqLink represents a request to link a cross-chain address to a Bitcoin address
 type ReqLink struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Chain   string       `json:"chain" yaml:"chain"`
 	Address string       `json:"address" yaml:"address"`
 }
 
 // ReqConfirmOutPoint represents a request to confirm a Bitcoin outpoint
 type ReqConfirmOutPoint struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Chain   string       `json:"chain" yaml:"chain"`
 	TxInfo  string       `json:"tx_info" yaml:"tx_info"`
 }
 
 // ReqCreatePendingTransfersTx represents a request to create a secondary key consolidation transaction handling all pending transfers
 type ReqCreatePendingTransfersTx struct {
 	BaseReq         rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Chain           string       `json:"chain" yaml:"chain"`
 	KeyID           string       `json:"key_id" yaml:"key_id"`
 	MasterKeyAmount string       `json:"master_key_amount" yaml:"master_key_amount"`
 }
@@ -77,6 +88,7 @@ type ReqCreatePendingTransfersTx struct {
 // ReqCreateMasterConsolidationTx represents a request to create a master key consolidation transaction
 type ReqCreateMasterConsolidationTx struct {
 	BaseReq            rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Chain              string       `json:"chain" yaml:"chain"`
 	KeyID              string       `json:"key_id" yaml:"key_id"`
 	SecondaryKeyAmount string       `json:"secondary_key_amount" yaml:"secondary_key_amount"`
 }
@@ -84,22 +96,32 @@ type ReqCreateMasterConsolidationTx struct {
 // ReqCreateRescueTx represents a request to create a rescue transaction
 type ReqCreateRescueTx struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Chain   string       `json:"chain" yaml:"chain"`
 }
 
 // ReqSignTx represents a request to sign a consolidation transaction
 type ReqSignTx struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Chain   string       `json:"chain" yaml:"chain"`
 	TxType  string       `json:"tx_type" yaml:"tx_type"`
 }
 
 // ReqSubmitExternalSignature represents a request to submit a signature from an external key
 type ReqSubmitExternalSignature struct {
 	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Chain     string       `json:"chain" yaml:"chain"`
 	KeyID     string       `json:"key_id" yaml:"key_id"`
 	Signature string       `json:"signature" yaml:"signature"`
 	SigHash   string       `json:"sig_hash" yaml:"sig_hash"`
 }
 
+// ReqSubmitPsbt represents a request to submit a partially signed bitcoin transaction containing signatures from external keys
+type ReqSubmitPsbt struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Chain   string       `json:"chain" yaml:"chain"`
+	Psbt    string       `json:"psbt" yaml:"psbt"`
+}
+
 // TxHandlerLink returns the handler to link a Bitcoin address to a cross-chain address
 func TxHandlerLink(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -118,7 +140,7 @@ func TxHandlerLink(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewLinkRequest(fromAddr, req.Address, mux.Vars(r)[clientUtils.PathVarChain])
+		msg := types.NewLinkRequest(fromAddr, chainOrDefault(req.Chain), req.Address, mux.Vars(r)[clientUtils.PathVarChain])
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -152,7 +174,7 @@ func TxHandlerConfirmTx(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewConfirmOutpointRequest(fromAddr, out)
+		msg := types.NewConfirmOutpointRequest(fromAddr, chainOrDefault(req.Chain), out)
 
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -187,7 +209,7 @@ func TxHandlerCreatePendingTransfersTx(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewCreatePendingTransfersTxRequest(fromAddr, req.KeyID, btcutil.Amount(masterKeyAmount.Amount.Int64()))
+		msg := types.NewCreatePendingTransfersTxRequest(fromAddr, chainOrDefault(req.Chain), req.KeyID, btcutil.Amount(masterKeyAmount.Amount.Int64()))
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -221,7 +243,7 @@ func TxHandlerCreateMasterConsolidationTx(cliCtx client.Context) http.HandlerFun
 			return
 		}
 
-		msg := types.NewCreateMasterTxRequest(fromAddr, req.KeyID, btcutil.Amount(secondaryKeyAmount.Amount.Int64()))
+		msg := types.NewCreateMasterTxRequest(fromAddr, chainOrDefault(req.Chain), req.KeyID, btcutil.Amount(secondaryKeyAmount.Amount.Int64()))
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -249,7 +271,7 @@ func TxHandlerCreateRescueTx(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewCreateRescueTxRequest(fromAddr)
+		msg := types.NewCreateRescueTxRequest(fromAddr, chainOrDefault(req.Chain))
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -283,7 +305,7 @@ func TxHandlerSignTx(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewSignTxRequest(fromAddr, txType)
+		msg := types.NewSignTxRequest(fromAddr, chainOrDefault(req.Chain), txType)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -323,7 +345,41 @@ func TxHandlerSubmitExternalSignature(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewSubmitExternalSignatureRequest(fromAddr, req.KeyID, signature, sigHash)
+		msg := types.NewSubmitExternalSignatureRequest(fromAddr, chainOrDefault(req.Chain), req.KeyID, signature, sigHash)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+// TxHandlerSubmitPsbt returns the handler to submit a partially signed bitcoin transaction containing signatures from external keys
+func TxHandlerSubmitPsbt(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ReqSubmitPsbt
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, ok := clientUtils.ExtractReqSender(w, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		psbt, err := base64.StdEncoding.DecodeString(req.Psbt)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewSubmitPsbtRequest(fromAddr, chainOrDefault(req.Chain), psbt)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -332,3 +388,12 @@ func TxHandlerSubmitExternalSignature(cliCtx client.Context) http.HandlerFunc {
 		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
 	}
 }
+
+// chainOrDefault returns the given UTXO chain or bitcoin if no chain is specified
+func chainOrDefault(chain string) string {
+	if chain == "" {
+		return exported.Bitcoin.Name
+	}
+
+	return chain
+}
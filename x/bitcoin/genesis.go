@@ -1,6 +1,7 @@
 package bitcoin
 
 import (
+	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -9,18 +10,25 @@ import (
 
 // InitGenesis initialize default parameters
 // from the genesis state
-func InitGenesis(ctx sdk.Context, k types.BTCKeeper, g types.GenesisState) {
-	k.SetParams(ctx, g.Params)
+func InitGenesis(ctx sdk.Context, k types.BaseKeeper, g types.GenesisState) {
+	for _, chain := range g.Chains {
+		chainKeeper := k.ForChain(chain.Params.Chain)
+		chainKeeper.SetParams(ctx, chain.Params)
 
-	// expose some parameters from genesis of btc module
-	minAmount := int64(k.GetMinOutputAmount(ctx))
-	telemetry.SetGauge(float32(minAmount), "btc", "min_withdrawal_mount")
+		// expose some parameters from genesis of btc module
+		minAmount := int64(chainKeeper.GetMinOutputAmount(ctx))
+		telemetry.SetGaugeWithLabels([]string{"btc", "min_withdrawal_mount"}, float32(minAmount), []metrics.Label{telemetry.NewLabel("chain", chain.Params.Chain)})
+	}
 }
 
 // ExportGenesis writes the current store values
 // to a genesis file, which can be imported again
 // with InitGenesis
-func ExportGenesis(ctx sdk.Context, k types.BTCKeeper) *types.GenesisState {
-	params := k.GetParams(ctx)
-	return &types.GenesisState{Params: params}
+func ExportGenesis(ctx sdk.Context, k types.BaseKeeper) *types.GenesisState {
+	var chains []types.GenesisState_Chain
+	for _, chain := range k.GetChains(ctx) {
+		chains = append(chains, types.GenesisState_Chain{Params: k.ForChain(chain).GetParams(ctx)})
+	}
+
+	return types.NewGenesisState(chains)
 }
@@ -2,14 +2,15 @@ package keeper
 
 import (
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
-	"github.com/btcsuite/btcutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
 )
 
-// NewAddressValidator returns the callback for validating bitcoin addresses
-func NewAddressValidator(k Keeper) nexus.AddressValidator {
+// NewAddressValidator returns the callback for validating addresses of UTXO chains
+func NewAddressValidator(k types.BaseKeeper) nexus.AddressValidator {
 	return func(ctx sdk.Context, address nexus.CrossChainAddress) error {
-		if _, err := btcutil.DecodeAddress(address.Address, k.GetNetwork(ctx).Params()); err != nil {
+		if _, err := k.ForChain(address.Chain.Name).GetNetwork(ctx).DecodeAddress(address.Address); err != nil {
 			return err
 		}
 
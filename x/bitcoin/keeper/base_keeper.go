@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	params "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+)
+
+var (
+	chainPrefix    = utils.KeyFromStr("chain")
+	subspacePrefix = utils.KeyFromStr("subspace")
+)
+
+var _ types.BaseKeeper = baseKeeper{}
+
+// baseKeeper provides access to the state shared by all UTXO chains and hands out keepers for individual chains
+type baseKeeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryCodec
+
+	// It is not safe to access subspaces directly (subspaces cannot be deleted so a subspace might exist for a chain that was deleted).
+	// Use getSubspace to access a subspace.
+	paramsKeeper types.ParamsKeeper
+	subspaces    map[string]params.Subspace
+}
+
+// NewKeeper returns a new bitcoin base keeper
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramsKeeper types.ParamsKeeper) types.BaseKeeper {
+	return baseKeeper{
+		cdc:          cdc,
+		storeKey:     storeKey,
+		paramsKeeper: paramsKeeper,
+		subspaces:    make(map[string]params.Subspace),
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k baseKeeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// ForChain returns the keeper associated to the given UTXO chain
+func (k baseKeeper) ForChain(chain string) types.BTCKeeper {
+	return Keeper{
+		baseKeeper:    k,
+		chainLowerKey: strings.ToLower(chain),
+	}
+}
+
+// GetChains returns the names of all UTXO chains the module has parameters for
+func (k baseKeeper) GetChains(ctx sdk.Context) []string {
+	iter := k.getBaseStore(ctx).Iterator(subspacePrefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	var chains []string
+	for ; iter.Valid(); iter.Next() {
+		chains = append(chains, string(iter.Value()))
+	}
+
+	return chains
+}
+
+func (k baseKeeper) getBaseStore(ctx sdk.Context) utils.KVStore {
+	return utils.NewNormalizedStore(ctx.KVStore(k.storeKey), k.cdc)
+}
+
+func (k baseKeeper) getStore(ctx sdk.Context, chain string) utils.KVStore {
+	pre := string(chainPrefix.Append(utils.LowerCaseKey(chain)).AsKey()) + "_"
+	return utils.NewNormalizedStore(prefix.NewStore(ctx.KVStore(k.storeKey), []byte(pre)), k.cdc)
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+)
+
+// RegisterInvariants registers all bitcoin module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k types.BaseKeeper) {
+	ir.RegisterRoute(types.ModuleName, "confirmed-outpoints", ConfirmedOutPointInvariant(k))
+}
+
+// ConfirmedOutPointInvariant checks for every UTXO chain that each confirmed outpoint holds a positive amount
+// at a known address, and that the amounts of the outpoints queued to be spent add up to the confirmed total
+func ConfirmedOutPointInvariant(k types.BaseKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		for _, chain := range k.GetChains(ctx) {
+			chainK := k.ForChain(chain)
+
+			queues := []utils.KVQueue{chainK.GetLateDepositOutpointInfoQueue(ctx)}
+			seenKeyIDs := make(map[tss.KeyID]bool)
+			amounts := make(map[string]int64)
+			confirmedTotal := int64(0)
+			for _, info := range chainK.GetConfirmedOutPointInfos(ctx) {
+				if info.Amount <= 0 {
+					broken = true
+					msg += fmt.Sprintf("\tconfirmed outpoint %s of chain %s has a non-positive amount %d\n", info.OutPoint, chain, info.Amount)
+				}
+
+				amounts[string(confirmedOutPointPrefix.Append(utils.LowerCaseKey(info.OutPoint)).AsKey())] = int64(info.Amount)
+				confirmedTotal += int64(info.Amount)
+
+				address, ok := chainK.GetAddressInfo(ctx, info.Address)
+				if !ok {
+					broken = true
+					msg += fmt.Sprintf("\tconfirmed outpoint %s of chain %s belongs to unknown address %s\n", info.OutPoint, chain, info.Address)
+					continue
+				}
+
+				if !seenKeyIDs[address.KeyID] {
+					seenKeyIDs[address.KeyID] = true
+					queues = append(queues, chainK.GetConfirmedOutpointInfoQueueForKey(ctx, address.KeyID))
+				}
+			}
+
+			// outpoints that are queued more than once or not at all make the sums diverge
+			queuedTotal := int64(0)
+			for _, queue := range queues {
+				for _, key := range queue.Keys() {
+					queuedTotal += amounts[string(key.AsKey())]
+				}
+			}
+
+			if queuedTotal != confirmedTotal {
+				broken = true
+				msg += fmt.Sprintf("\tconfirmed outpoints of chain %s hold %d in total, but the outpoints queued to be spent hold %d\n", chain, confirmedTotal, queuedTotal)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "confirmed outpoints", msg), broken
+	}
+}
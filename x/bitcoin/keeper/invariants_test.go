@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramsKeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	appParams "github.com/axelarnetwork/axelar-core/app/params"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
+	bitcoinKeeper "github.com/axelarnetwork/axelar-core/x/bitcoin/keeper"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	tssTestUtils "github.com/axelarnetwork/axelar-core/x/tss/exported/testutils"
+)
+
+func TestConfirmedOutPointInvariant(t *testing.T) {
+	var (
+		ctx        sdk.Context
+		baseKeeper types.BaseKeeper
+		keeper     types.BTCKeeper
+		address    types.AddressInfo
+	)
+
+	randOutPoint := func(address string) types.OutPointInfo {
+		hash, err := chainhash.NewHash(rand.Bytes(chainhash.HashSize))
+		if err != nil {
+			panic(err)
+		}
+
+		return types.NewOutPointInfo(wire.NewOutPoint(hash, uint32(rand.I64Between(0, 10))), btcutil.Amount(rand.I64Between(1, 100000000)), address)
+	}
+
+	setup := func() {
+		encCfg := appParams.MakeEncodingConfig()
+		paramsK := paramsKeeper.NewKeeper(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("params"), sdk.NewKVStoreKey("tparams"))
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{Height: rand.I64Between(1, 1000)}, false, log.TestingLogger())
+		baseKeeper = bitcoinKeeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("btc"), paramsK)
+		keeper = baseKeeper.ForChain(exported.Bitcoin.Name)
+		keeper.SetParams(ctx, types.DefaultParams())
+
+		addr, err := btcutil.NewAddressWitnessScriptHash(rand.Bytes(32), types.DefaultParams().Network.Params())
+		assert.NoError(t, err)
+		address = types.AddressInfo{
+			Address:      addr.EncodeAddress(),
+			Role:         types.Deposit,
+			RedeemScript: rand.Bytes(200),
+			KeyID:        tssTestUtils.RandKeyID(),
+		}
+		keeper.SetAddressInfo(ctx, address)
+
+		for i := 0; i < int(rand.I64Between(1, 20)); i++ {
+			keeper.SetConfirmedOutpointInfo(ctx, address.KeyID, randOutPoint(address.Address))
+		}
+		keeper.SetLateDepositOutpointInfo(ctx, randOutPoint(address.Address))
+	}
+
+	t.Run("should hold if every confirmed outpoint is queued once", func(t *testing.T) {
+		setup()
+
+		_, broken := bitcoinKeeper.ConfirmedOutPointInvariant(baseKeeper)(ctx)
+		assert.False(t, broken)
+	})
+
+	t.Run("should hold after outpoints are spent", func(t *testing.T) {
+		setup()
+
+		var info types.OutPointInfo
+		assert.True(t, keeper.GetConfirmedOutpointInfoQueueForKey(ctx, address.KeyID).Dequeue(&info))
+		keeper.DeleteOutpointInfo(ctx, info.GetOutPoint())
+		keeper.SetSpentOutpointInfo(ctx, info)
+
+		_, broken := bitcoinKeeper.ConfirmedOutPointInvariant(baseKeeper)(ctx)
+		assert.False(t, broken)
+	})
+
+	t.Run("should break if a confirmed outpoint is no longer queued", func(t *testing.T) {
+		setup()
+
+		var info types.OutPointInfo
+		assert.True(t, keeper.GetConfirmedOutpointInfoQueueForKey(ctx, address.KeyID).Dequeue(&info))
+
+		msg, broken := bitcoinKeeper.ConfirmedOutPointInvariant(baseKeeper)(ctx)
+		assert.True(t, broken)
+		assert.Contains(t, msg, exported.Bitcoin.Name)
+	})
+
+	t.Run("should break if a confirmed outpoint is queued twice", func(t *testing.T) {
+		setup()
+
+		info := randOutPoint(address.Address)
+		keeper.SetConfirmedOutpointInfo(ctx, address.KeyID, info)
+		keeper.SetLateDepositOutpointInfo(ctx, info)
+
+		msg, broken := bitcoinKeeper.ConfirmedOutPointInvariant(baseKeeper)(ctx)
+		assert.True(t, broken)
+		assert.Contains(t, msg, exported.Bitcoin.Name)
+	})
+
+	t.Run("should break if a confirmed outpoint belongs to an unknown address", func(t *testing.T) {
+		setup()
+
+		info := randOutPoint(rand.StrBetween(5, 20))
+		keeper.SetConfirmedOutpointInfo(ctx, address.KeyID, info)
+
+		msg, broken := bitcoinKeeper.ConfirmedOutPointInvariant(baseKeeper)(ctx)
+		assert.True(t, broken)
+		assert.Contains(t, msg, info.OutPoint)
+	})
+}
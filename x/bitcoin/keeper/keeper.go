@@ -7,11 +7,9 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
-	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	params "github.com/cosmos/cosmos-sdk/x/params/types"
 	gogoprototypes "github.com/gogo/protobuf/types"
-	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/axelarnetwork/axelar-core/utils"
 	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
@@ -30,46 +28,55 @@ var (
 	unsignedTxPrefix         = utils.KeyFromStr("unsigned_tx_")
 	latestSignedTxHashPrefix = utils.KeyFromStr("latest_signed_tx_hash_")
 	unconfirmedAmountPrefix  = utils.KeyFromStr("unconfirmed_amount_")
+	depositAddrExpiryPrefix  = utils.KeyFromStr("addr_expiry_")
 
 	externalKeyIDsKey        = utils.KeyFromStr("external_key_ids")
 	anyoneCanSpendAddressKey = utils.KeyFromStr("anyone_can_spend_address")
 
-	confirmedOutpointQueueName = "confirmed_outpoint"
+	confirmedOutpointQueueName    = "confirmed_outpoint"
+	lateDepositOutpointQueueName  = "late_deposit_outpoint"
+	depositAddressExpiryQueueName = "deposit_address_expiry"
 )
 
 var _ types.BTCKeeper = Keeper{}
 
-// Keeper provides access to all state changes regarding the Bitcoin module
+// Keeper provides access to all state changes regarding a single UTXO chain of the bitcoin module
 type Keeper struct {
-	storeKey sdk.StoreKey
-	cdc      codec.BinaryCodec
-	params   params.Subspace
+	baseKeeper
+	chainLowerKey string
 }
 
-// NewKeeper returns a new keeper object
-func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace params.Subspace) Keeper {
-	return Keeper{cdc: cdc, storeKey: storeKey, params: paramSpace.WithKeyTable(types.KeyTable())}
+// GetName returns the name of the UTXO chain this keeper is responsible for
+func (k Keeper) GetName() string {
+	return k.chainLowerKey
 }
 
-// SetParams sets the bitcoin module's parameters
+// SetParams sets the parameters of the UTXO chain
 func (k Keeper) SetParams(ctx sdk.Context, p types.Params) {
-	k.params.SetParamSet(ctx, &p)
+	// set the chain before calling the subspace so it is recognized as an existing chain
+	k.getBaseStore(ctx).SetRaw(subspacePrefix.AppendStr(k.chainLowerKey), []byte(p.Chain))
+	subspace, ok := k.getSubspace(ctx)
+	if !ok {
+		panic(fmt.Sprintf("param subspace for chain %s should exist", p.Chain))
+	}
+
+	subspace.SetParamSet(ctx, &p)
 	anyoneCanSpendAddress := types.NewAnyoneCanSpendAddress(p.Network)
 	k.getStore(ctx).Set(anyoneCanSpendAddressKey, &anyoneCanSpendAddress)
 }
 
-// GetParams gets the bitcoin module's parameters
+// GetParams gets the parameters of the UTXO chain
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	subspace, ok := k.getSubspace(ctx)
+	if !ok {
+		panic(fmt.Sprintf("params for chain %s not set", k.chainLowerKey))
+	}
+
 	var p types.Params
-	k.params.GetParamSet(ctx, &p)
+	subspace.GetParamSet(ctx, &p)
 	return p
 }
 
-// Logger returns a module-specific logger.
-func (k Keeper) Logger(ctx sdk.Context) log.Logger {
-	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
-}
-
 // GetAnyoneCanSpendAddress retrieves the anyone-can-spend address
 func (k Keeper) GetAnyoneCanSpendAddress(ctx sdk.Context) types.AddressInfo {
 	var address types.AddressInfo
@@ -81,18 +88,18 @@ func (k Keeper) GetAnyoneCanSpendAddress(ctx sdk.Context) types.AddressInfo {
 	return address
 }
 
-// GetRequiredConfirmationHeight returns the minimum number of confirmations a transaction must have on Bitcoin
+// GetRequiredConfirmationHeight returns the minimum number of confirmations a transaction must have on the UTXO chain
 // before axelar will accept it as confirmed.
 func (k Keeper) GetRequiredConfirmationHeight(ctx sdk.Context) uint64 {
 	var h uint64
-	k.params.Get(ctx, types.KeyConfirmationHeight, &h)
+	k.getParam(ctx, types.KeyConfirmationHeight, &h)
 	return h
 }
 
 // GetRevoteLockingPeriod returns the lock period for revoting
 func (k Keeper) GetRevoteLockingPeriod(ctx sdk.Context) int64 {
 	var result int64
-	k.params.Get(ctx, types.KeyRevoteLockingPeriod, &result)
+	k.getParam(ctx, types.KeyRevoteLockingPeriod, &result)
 
 	return result
 }
@@ -100,35 +107,35 @@ func (k Keeper) GetRevoteLockingPeriod(ctx sdk.Context) int64 {
 // GetSigCheckInterval returns the block interval after which to check for completed signatures
 func (k Keeper) GetSigCheckInterval(ctx sdk.Context) int64 {
 	var result int64
-	k.params.Get(ctx, types.KeySigCheckInterval, &result)
+	k.getParam(ctx, types.KeySigCheckInterval, &result)
 
 	return result
 }
 
-// GetNetwork returns the connected Bitcoin network (main, test, regtest)
+// GetNetwork returns the connected network of the UTXO chain
 func (k Keeper) GetNetwork(ctx sdk.Context) types.Network {
 	var network types.Network
-	k.params.Get(ctx, types.KeyNetwork, &network)
+	k.getParam(ctx, types.KeyNetwork, &network)
 	return network
 }
 
 // GetMinOutputAmount returns the minimum withdrawal threshold
 func (k Keeper) GetMinOutputAmount(ctx sdk.Context) btcutil.Amount {
 	var coin sdk.DecCoin
-	k.params.Get(ctx, types.KeyMinOutputAmount, &coin)
+	k.getParam(ctx, types.KeyMinOutputAmount, &coin)
 
-	satoshi, err := types.ToSatoshiCoin(coin)
+	base, err := types.ToBaseCoin(coin, k.GetBaseDenom(ctx))
 	if err != nil {
 		panic(err)
 	}
 
-	return btcutil.Amount(satoshi.Amount.Int64())
+	return btcutil.Amount(base.Amount.Int64())
 }
 
 // GetMaxInputCount returns the max input count
 func (k Keeper) GetMaxInputCount(ctx sdk.Context) int64 {
 	var result int64
-	k.params.Get(ctx, types.KeyMaxInputCount, &result)
+	k.getParam(ctx, types.KeyMaxInputCount, &result)
 
 	return result
 }
@@ -136,7 +143,7 @@ func (k Keeper) GetMaxInputCount(ctx sdk.Context) int64 {
 // GetVotingThreshold returns voting threshold
 func (k Keeper) GetVotingThreshold(ctx sdk.Context) utils.Threshold {
 	var threshold utils.Threshold
-	k.params.Get(ctx, types.KeyVotingThreshold, &threshold)
+	k.getParam(ctx, types.KeyVotingThreshold, &threshold)
 
 	return threshold
 }
@@ -144,7 +151,7 @@ func (k Keeper) GetVotingThreshold(ctx sdk.Context) utils.Threshold {
 // GetMinVoterCount returns minimum voter count for voting
 func (k Keeper) GetMinVoterCount(ctx sdk.Context) int64 {
 	var minVoterCount int64
-	k.params.Get(ctx, types.KeyMinVoterCount, &minVoterCount)
+	k.getParam(ctx, types.KeyMinVoterCount, &minVoterCount)
 
 	return minVoterCount
 }
@@ -152,20 +159,20 @@ func (k Keeper) GetMinVoterCount(ctx sdk.Context) int64 {
 // GetMaxSecondaryOutputAmount returns the max secondary output amount
 func (k Keeper) GetMaxSecondaryOutputAmount(ctx sdk.Context) btcutil.Amount {
 	var coin sdk.DecCoin
-	k.params.Get(ctx, types.KeyMaxSecondaryOutputAmount, &coin)
+	k.getParam(ctx, types.KeyMaxSecondaryOutputAmount, &coin)
 
-	satoshi, err := types.ToSatoshiCoin(coin)
+	base, err := types.ToBaseCoin(coin, k.GetBaseDenom(ctx))
 	if err != nil {
 		panic(err)
 	}
 
-	return btcutil.Amount(satoshi.Amount.Int64())
+	return btcutil.Amount(base.Amount.Int64())
 }
 
 // GetMasterKeyRetentionPeriod returns the prev master key cycle
 func (k Keeper) GetMasterKeyRetentionPeriod(ctx sdk.Context) int64 {
 	var result int64
-	k.params.Get(ctx, types.KeyMasterKeyRetentionPeriod, &result)
+	k.getParam(ctx, types.KeyMasterKeyRetentionPeriod, &result)
 
 	return result
 }
@@ -173,7 +180,7 @@ func (k Keeper) GetMasterKeyRetentionPeriod(ctx sdk.Context) int64 {
 // GetMasterAddressInternalKeyLockDuration returns the master address lock duration for internal key(s) only to spend
 func (k Keeper) GetMasterAddressInternalKeyLockDuration(ctx sdk.Context) time.Duration {
 	var result time.Duration
-	k.params.Get(ctx, types.KeyMasterAddressInternalKeyLockDuration, &result)
+	k.getParam(ctx, types.KeyMasterAddressInternalKeyLockDuration, &result)
 
 	return result
 }
@@ -181,7 +188,7 @@ func (k Keeper) GetMasterAddressInternalKeyLockDuration(ctx sdk.Context) time.Du
 // GetMasterAddressExternalKeyLockDuration returns the master address lock duration for external key(s) only to spend
 func (k Keeper) GetMasterAddressExternalKeyLockDuration(ctx sdk.Context) time.Duration {
 	var result time.Duration
-	k.params.Get(ctx, types.KeyMasterAddressExternalKeyLockDuration, &result)
+	k.getParam(ctx, types.KeyMasterAddressExternalKeyLockDuration, &result)
 
 	return result
 }
@@ -189,15 +196,55 @@ func (k Keeper) GetMasterAddressExternalKeyLockDuration(ctx sdk.Context) time.Du
 // GetMaxTxSize returns the max tx size allowed
 func (k Keeper) GetMaxTxSize(ctx sdk.Context) int64 {
 	var result int64
-	k.params.Get(ctx, types.KeyMaxTxSize, &result)
+	k.getParam(ctx, types.KeyMaxTxSize, &result)
 
 	return result
 }
 
-// GetTransactionFeeRate returns the transaction fee rate for bitcoin
+// GetTransactionFeeRate returns the transaction fee rate for the UTXO chain
 func (k Keeper) GetTransactionFeeRate(ctx sdk.Context) sdk.Dec {
 	var result sdk.Dec
-	k.params.Get(ctx, types.KeyTransactionFeeRate, &result)
+	k.getParam(ctx, types.KeyTransactionFeeRate, &result)
+
+	return result
+}
+
+// GetDepositAddressExpiryPeriod returns the number of blocks after which a deposit address expires
+func (k Keeper) GetDepositAddressExpiryPeriod(ctx sdk.Context) int64 {
+	var result int64
+	k.getParam(ctx, types.KeyDepositAddressExpiryPeriod, &result)
+
+	return result
+}
+
+// GetDepositAddressGracePeriod returns the number of blocks after expiry during which late deposits are still confirmed
+func (k Keeper) GetDepositAddressGracePeriod(ctx sdk.Context) int64 {
+	var result int64
+	k.getParam(ctx, types.KeyDepositAddressGracePeriod, &result)
+
+	return result
+}
+
+// GetBaseDenom returns the smallest unit of the UTXO chain's native currency
+func (k Keeper) GetBaseDenom(ctx sdk.Context) string {
+	var result string
+	k.getParam(ctx, types.KeyBaseDenom, &result)
+
+	return result
+}
+
+// GetDustLimit returns the minimum amount an output must have to be relayed by the UTXO chain
+func (k Keeper) GetDustLimit(ctx sdk.Context) btcutil.Amount {
+	var result int64
+	k.getParam(ctx, types.KeyDustLimit, &result)
+
+	return btcutil.Amount(result)
+}
+
+// GetMinRelayTxFeeRate returns the minimum fee rate in base denomination per byte for a transaction to be relayed by the UTXO chain
+func (k Keeper) GetMinRelayTxFeeRate(ctx sdk.Context) int64 {
+	var result int64
+	k.getParam(ctx, types.KeyMinRelayTxFeeRate, &result)
 
 	return result
 }
@@ -229,7 +276,7 @@ func (k Keeper) GetDepositAddress(ctx sdk.Context, recipient nexus.CrossChainAdd
 		return nil, fmt.Errorf("no deposit address found for recipient %s", recipient.String())
 
 	}
-	addr, err := btcutil.DecodeAddress(string(bz), k.GetNetwork(ctx).Params())
+	addr, err := k.GetNetwork(ctx).DecodeAddress(string(bz))
 	if err != nil {
 		return nil, err
 	}
@@ -237,6 +284,50 @@ func (k Keeper) GetDepositAddress(ctx sdk.Context, recipient nexus.CrossChainAdd
 	return addr, nil
 }
 
+// DeleteAddressInfo deletes the address information for the given encoded address
+func (k Keeper) DeleteAddressInfo(ctx sdk.Context, encodedAddress string) {
+	k.getStore(ctx).Delete(addrInfoPrefix.Append(utils.LowerCaseKey(encodedAddress)))
+}
+
+// DeleteDepositAddress deletes the deposit address associated with the specified recipient address
+func (k Keeper) DeleteDepositAddress(ctx sdk.Context, recipient nexus.CrossChainAddress) {
+	k.getStore(ctx).Delete(depositAddrPrefix.Append(utils.LowerCaseKey(recipient.String())))
+}
+
+// SetDepositAddressExpiry stores the expiry of a deposit address and schedules it for pruning
+func (k Keeper) SetDepositAddressExpiry(ctx sdk.Context, expiry types.DepositAddressExpiry) {
+	key := depositAddrExpiryPrefix.Append(utils.LowerCaseKey(expiry.Address))
+
+	// the queue is ordered by the height the deposit address should be pruned at, so the entry of a previous expiry
+	// must be removed, otherwise it would block the queue until the new height or outlive the pruned address
+	if existing, ok := k.GetDepositAddressExpiry(ctx, expiry.Address); ok {
+		k.getDepositAddressExpiryQueue(ctx, existing.PruneAt).Remove(key)
+	}
+
+	k.getDepositAddressExpiryQueue(ctx, expiry.PruneAt).Enqueue(key, &expiry)
+}
+
+// GetDepositAddressExpiry returns the expiry of the given deposit address
+func (k Keeper) GetDepositAddressExpiry(ctx sdk.Context, encodedAddress string) (types.DepositAddressExpiry, bool) {
+	var expiry types.DepositAddressExpiry
+	ok := k.getStore(ctx).Get(depositAddrExpiryPrefix.Append(utils.LowerCaseKey(encodedAddress)), &expiry)
+	return expiry, ok
+}
+
+// DeleteDepositAddressExpiry deletes the expiry of the given deposit address
+func (k Keeper) DeleteDepositAddressExpiry(ctx sdk.Context, encodedAddress string) {
+	k.getStore(ctx).Delete(depositAddrExpiryPrefix.Append(utils.LowerCaseKey(encodedAddress)))
+}
+
+// GetDepositAddressExpiryQueue retrieves the queue of deposit address expiries ordered by the height they should be pruned at
+func (k Keeper) GetDepositAddressExpiryQueue(ctx sdk.Context) utils.KVQueue {
+	return k.getDepositAddressExpiryQueue(ctx, ctx.BlockHeight())
+}
+
+func (k Keeper) getDepositAddressExpiryQueue(ctx sdk.Context, height int64) utils.BlockHeightKVQueue {
+	return utils.NewBlockHeightKVQueue(depositAddressExpiryQueueName, k.getStore(ctx), height, k.Logger(ctx))
+}
+
 // DeleteOutpointInfo deletes a the given outpoint if known
 func (k Keeper) DeleteOutpointInfo(ctx sdk.Context, outPoint wire.OutPoint) {
 	// delete is a noop if key does not exist
@@ -303,6 +394,35 @@ func (k Keeper) GetConfirmedOutpointInfoQueueForKey(ctx sdk.Context, keyID tss.K
 	return utils.NewBlockHeightKVQueue(queueName, k.getStore(ctx), ctx.BlockHeight(), k.Logger(ctx))
 }
 
+// GetConfirmedOutPointInfos returns all confirmed outpoints that have not been spent yet
+func (k Keeper) GetConfirmedOutPointInfos(ctx sdk.Context) []types.OutPointInfo {
+	iter := k.getStore(ctx).Iterator(confirmedOutPointPrefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	var infos []types.OutPointInfo
+	for ; iter.Valid(); iter.Next() {
+		var info types.OutPointInfo
+		iter.UnmarshalValue(&info)
+
+		infos = append(infos, info)
+	}
+
+	return infos
+}
+
+// SetLateDepositOutpointInfo stores the given outpoint info of a deposit to an expired deposit address as confirmed
+// and pushes it into the queue of outpoints to rescue
+func (k Keeper) SetLateDepositOutpointInfo(ctx sdk.Context, info types.OutPointInfo) {
+	key := utils.LowerCaseKey(info.OutPoint)
+
+	k.GetLateDepositOutpointInfoQueue(ctx).Enqueue(confirmedOutPointPrefix.Append(key), &info)
+}
+
+// GetLateDepositOutpointInfoQueue retrieves the queue of confirmed outpoints deposited to expired deposit addresses
+func (k Keeper) GetLateDepositOutpointInfoQueue(ctx sdk.Context) utils.KVQueue {
+	return utils.NewBlockHeightKVQueue(lateDepositOutpointQueueName, k.getStore(ctx), ctx.BlockHeight(), k.Logger(ctx))
+}
+
 // SetUnsignedTx stores an unsigned transaction
 func (k Keeper) SetUnsignedTx(ctx sdk.Context, tx types.UnsignedTx) {
 	k.getStore(ctx).Set(unsignedTxPrefix.AppendStr(tx.Type.SimpleString()), &tx)
@@ -411,6 +531,34 @@ func (k Keeper) GetExternalKeyIDs(ctx sdk.Context) ([]tss.KeyID, bool) {
 	return keyIDs, true
 }
 
+func (k Keeper) getParam(ctx sdk.Context, key []byte, value interface{}) {
+	subspace, ok := k.getSubspace(ctx)
+	// the subspace must exist, if not we have a catastrophic failure
+	if !ok {
+		panic(fmt.Sprintf("subspace for chain '%s' not set", k.chainLowerKey))
+	}
+
+	subspace.Get(ctx, key, value)
+}
+
+func (k Keeper) getSubspace(ctx sdk.Context) (params.Subspace, bool) {
+	// When a node restarts or joins the network after genesis, it might not have all UTXO chain subspaces initialized.
+	// The following check has to be done regardless, if we would only do it dependent on the existence of a subspace
+	// different nodes would consume different amounts of gas and it would result in a consensus failure
+	if !k.getBaseStore(ctx).Has(subspacePrefix.AppendStr(k.chainLowerKey)) {
+		return params.Subspace{}, false
+	}
+
+	chainKey := types.ModuleName + "_" + k.chainLowerKey
+	subspace, ok := k.subspaces[chainKey]
+	if !ok {
+		subspace = k.paramsKeeper.Subspace(chainKey).WithKeyTable(types.KeyTable())
+		k.subspaces[chainKey] = subspace
+	}
+
+	return subspace, true
+}
+
 func (k Keeper) getStore(ctx sdk.Context) utils.KVStore {
-	return utils.NewNormalizedStore(ctx.KVStore(k.storeKey), k.cdc)
+	return k.baseKeeper.getStore(ctx, k.chainLowerKey)
 }
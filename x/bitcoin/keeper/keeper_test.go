@@ -9,8 +9,9 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	params "github.com/cosmos/cosmos-sdk/x/params/types"
+	paramsKeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	"github.com/stretchr/testify/assert"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -19,6 +20,7 @@ import (
 	"github.com/axelarnetwork/axelar-core/testutils"
 	"github.com/axelarnetwork/axelar-core/testutils/fake"
 	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
 	bitcoinKeeper "github.com/axelarnetwork/axelar-core/x/bitcoin/keeper"
 	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
 	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
@@ -28,13 +30,13 @@ import (
 func TestKeeper_GetAddress(t *testing.T) {
 	var (
 		ctx    sdk.Context
-		keeper bitcoinKeeper.Keeper
+		keeper types.BTCKeeper
 	)
 	setup := func() {
 		encCfg := appParams.MakeEncodingConfig()
-		btcSubspace := params.NewSubspace(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("params"), sdk.NewKVStoreKey("tparams"), "btc")
+		paramsK := paramsKeeper.NewKeeper(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("params"), sdk.NewKVStoreKey("tparams"))
 		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
-		keeper = bitcoinKeeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("btc"), btcSubspace)
+		keeper = bitcoinKeeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("btc"), paramsK).ForChain(exported.Bitcoin.Name)
 	}
 	t.Run("case insensitive", testutils.Func(func(t *testing.T) {
 		setup()
@@ -62,13 +64,13 @@ func TestKeeper_GetAddress(t *testing.T) {
 func TestKeeper_GetOutPointInfo(t *testing.T) {
 	var (
 		ctx    sdk.Context
-		keeper bitcoinKeeper.Keeper
+		keeper types.BTCKeeper
 	)
 	setup := func() {
 		encCfg := appParams.MakeEncodingConfig()
-		btcSubspace := params.NewSubspace(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("params"), sdk.NewKVStoreKey("tparams"), "btc")
+		paramsK := paramsKeeper.NewKeeper(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("params"), sdk.NewKVStoreKey("tparams"))
 		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
-		keeper = bitcoinKeeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("btc"), btcSubspace)
+		keeper = bitcoinKeeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("btc"), paramsK).ForChain(exported.Bitcoin.Name)
 	}
 
 	t.Run("case insensitive", testutils.Func(func(t *testing.T) {
@@ -103,3 +105,160 @@ func TestKeeper_GetOutPointInfo(t *testing.T) {
 		assert.True(t, ok)
 	}).Repeat(20))
 }
+
+func TestKeeper_DepositAddressExpiryQueue(t *testing.T) {
+	var (
+		ctx    sdk.Context
+		keeper types.BTCKeeper
+	)
+	setup := func() {
+		encCfg := appParams.MakeEncodingConfig()
+		paramsK := paramsKeeper.NewKeeper(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("params"), sdk.NewKVStoreKey("tparams"))
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{Height: rand.I64Between(100, 1000)}, false, log.TestingLogger())
+		keeper = bitcoinKeeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("btc"), paramsK).ForChain(exported.Bitcoin.Name)
+	}
+
+	t.Run("should only dequeue prunable deposit addresses in order", testutils.Func(func(t *testing.T) {
+		setup()
+
+		expiries := make(map[string]types.DepositAddressExpiry)
+		prunableCount := 0
+		for i := 0; i < int(rand.I64Between(1, 50)); i++ {
+			addr, err := btcutil.NewAddressWitnessScriptHash(rand.Bytes(32), types.Mainnet.Params())
+			assert.NoError(t, err)
+
+			expiresAt := ctx.BlockHeight() + rand.I64Between(-100, 100)
+			expiry := types.DepositAddressExpiry{
+				Address:   addr.EncodeAddress(),
+				ExpiresAt: expiresAt,
+				PruneAt:   expiresAt + rand.I64Between(0, 100),
+			}
+			if expiry.IsPrunable(ctx.BlockHeight()) {
+				prunableCount++
+			}
+
+			keeper.SetDepositAddressExpiry(ctx, expiry)
+			expiries[expiry.Address] = expiry
+		}
+
+		for _, expiry := range expiries {
+			actual, ok := keeper.GetDepositAddressExpiry(ctx, expiry.Address)
+			assert.True(t, ok)
+			assert.Equal(t, expiry, actual)
+		}
+
+		queue := keeper.GetDepositAddressExpiryQueue(ctx)
+		isPrunable := func(value codec.ProtoMarshaler) bool {
+			return value.(*types.DepositAddressExpiry).IsPrunable(ctx.BlockHeight())
+		}
+
+		var dequeued []types.DepositAddressExpiry
+		for {
+			var expiry types.DepositAddressExpiry
+			if !queue.Dequeue(&expiry, isPrunable) {
+				break
+			}
+
+			dequeued = append(dequeued, expiry)
+		}
+
+		assert.Len(t, dequeued, prunableCount)
+		for i, expiry := range dequeued {
+			assert.Equal(t, expiries[expiry.Address], expiry)
+			if i > 0 {
+				assert.LessOrEqual(t, dequeued[i-1].PruneAt, expiry.PruneAt)
+			}
+		}
+	}).Repeat(20))
+
+	t.Run("should only dequeue re-set deposit addresses at their latest prune height", testutils.Func(func(t *testing.T) {
+		setup()
+
+		newAddress := func() string {
+			addr, err := btcutil.NewAddressWitnessScriptHash(rand.Bytes(32), types.Mainnet.Params())
+			assert.NoError(t, err)
+			return addr.EncodeAddress()
+		}
+
+		height := ctx.BlockHeight()
+		relinked := types.DepositAddressExpiry{Address: newAddress(), ExpiresAt: height + 1, PruneAt: height + 1}
+		other := types.DepositAddressExpiry{Address: newAddress(), ExpiresAt: height + 2, PruneAt: height + 2}
+		keeper.SetDepositAddressExpiry(ctx, relinked)
+		keeper.SetDepositAddressExpiry(ctx, other)
+
+		relinked.ExpiresAt = height + 3
+		relinked.PruneAt = height + 3
+		keeper.SetDepositAddressExpiry(ctx, relinked)
+
+		dequeueAt := func(height int64) []types.DepositAddressExpiry {
+			ctx := ctx.WithBlockHeight(height)
+			queue := keeper.GetDepositAddressExpiryQueue(ctx)
+			isPrunable := func(value codec.ProtoMarshaler) bool {
+				return value.(*types.DepositAddressExpiry).IsPrunable(ctx.BlockHeight())
+			}
+
+			var dequeued []types.DepositAddressExpiry
+			for {
+				var expiry types.DepositAddressExpiry
+				if !queue.Dequeue(&expiry, isPrunable) {
+					break
+				}
+
+				dequeued = append(dequeued, expiry)
+			}
+
+			return dequeued
+		}
+
+		assert.Empty(t, dequeueAt(height+1))
+		assert.Equal(t, []types.DepositAddressExpiry{other}, dequeueAt(height+2))
+		assert.Equal(t, []types.DepositAddressExpiry{relinked}, dequeueAt(height+3))
+		assert.True(t, keeper.GetDepositAddressExpiryQueue(ctx).IsEmpty())
+	}).Repeat(20))
+}
+
+func TestBaseKeeper_ForChain(t *testing.T) {
+	var (
+		ctx        sdk.Context
+		baseKeeper types.BaseKeeper
+	)
+	setup := func() {
+		encCfg := appParams.MakeEncodingConfig()
+		paramsK := paramsKeeper.NewKeeper(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("params"), sdk.NewKVStoreKey("tparams"))
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+		baseKeeper = bitcoinKeeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("btc"), paramsK)
+	}
+
+	t.Run("chains are isolated from each other", testutils.Func(func(t *testing.T) {
+		setup()
+		otherChain := rand.StrBetween(5, 20)
+
+		btcParams := types.DefaultParams()
+		otherParams := types.DefaultParams()
+		otherParams.Chain = otherChain
+		otherParams.Network = types.Testnet3
+		otherParams.ConfirmationHeight = btcParams.ConfirmationHeight + uint64(rand.I64Between(1, 100))
+
+		baseKeeper.ForChain(exported.Bitcoin.Name).SetParams(ctx, btcParams)
+		baseKeeper.ForChain(otherChain).SetParams(ctx, otherParams)
+
+		assert.ElementsMatch(t, []string{exported.Bitcoin.Name, otherChain}, baseKeeper.GetChains(ctx))
+		assert.Equal(t, btcParams, baseKeeper.ForChain(exported.Bitcoin.Name).GetParams(ctx))
+		assert.Equal(t, otherParams, baseKeeper.ForChain(strings.ToUpper(otherChain)).GetParams(ctx))
+
+		addr, err := btcutil.NewAddressWitnessScriptHash(rand.Bytes(32), types.Testnet3.Params())
+		assert.NoError(t, err)
+		info := types.AddressInfo{
+			Address:      addr.EncodeAddress(),
+			Role:         types.Deposit,
+			RedeemScript: rand.Bytes(200),
+			KeyID:        tss.KeyID(rand.StrBetween(5, 20)),
+		}
+		baseKeeper.ForChain(otherChain).SetAddressInfo(ctx, info)
+
+		_, ok := baseKeeper.ForChain(otherChain).GetAddressInfo(ctx, info.Address)
+		assert.True(t, ok)
+		_, ok = baseKeeper.ForChain(exported.Bitcoin.Name).GetAddressInfo(ctx, info.Address)
+		assert.False(t, ok)
+	}).Repeat(20))
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"crypto/ecdsa"
 	"encoding/hex"
@@ -19,7 +20,6 @@ import (
 	gogoprototypes "github.com/gogo/protobuf/types"
 
 	"github.com/axelarnetwork/axelar-core/utils"
-	"github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
 	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
@@ -29,7 +29,7 @@ import (
 var _ types.MsgServiceServer = msgServer{}
 
 type msgServer struct {
-	types.BTCKeeper
+	types.BaseKeeper
 	signer      types.Signer
 	nexus       types.Nexus
 	voter       types.Voter
@@ -38,9 +38,9 @@ type msgServer struct {
 
 // NewMsgServerImpl returns an implementation of the bitcoin MsgServiceServer interface
 // for the provided Keeper.
-func NewMsgServerImpl(keeper types.BTCKeeper, s types.Signer, n types.Nexus, v types.Voter, snap types.Snapshotter) types.MsgServiceServer {
+func NewMsgServerImpl(keeper types.BaseKeeper, s types.Signer, n types.Nexus, v types.Voter, snap types.Snapshotter) types.MsgServiceServer {
 	return msgServer{
-		BTCKeeper:   keeper,
+		BaseKeeper:  keeper,
 		signer:      s,
 		nexus:       n,
 		voter:       v,
@@ -51,73 +51,115 @@ func NewMsgServerImpl(keeper types.BTCKeeper, s types.Signer, n types.Nexus, v t
 func (s msgServer) SubmitExternalSignature(c context.Context, req *types.SubmitExternalSignatureRequest) (*types.SubmitExternalSignatureResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	if err := validateChainActivated(ctx, s.nexus, exported.Bitcoin); err != nil {
+	if _, err := getChain(ctx, s.nexus, req.Chain); err != nil {
 		return nil, err
 	}
 
-	externalKey, ok := s.signer.GetKey(ctx, req.KeyID)
-	if !ok || externalKey.Role != tss.ExternalKey {
-		return nil, fmt.Errorf("external key %s not found", req.KeyID)
+	if err := submitExternalSignature(ctx, s.signer, req.KeyID, req.Signature, req.SigHash); err != nil {
+		return nil, err
 	}
 
-	pk, err := externalKey.GetECDSAPubKey()
+	return &types.SubmitExternalSignatureResponse{}, nil
+}
+
+// SubmitPsbt handles the submission of a partially signed bitcoin transaction (BIP-174) signed by external keys
+func (s msgServer) SubmitPsbt(c context.Context, req *types.SubmitPsbtRequest) (*types.SubmitPsbtResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chain, err := getChain(ctx, s.nexus, req.Chain)
 	if err != nil {
 		return nil, err
 	}
 
-	sig, err := btcec.ParseDERSignature(req.Signature, btcec.S256())
+	keeper := s.ForChain(chain.Name)
+
+	packet, err := types.DecodePsbt(req.Psbt)
 	if err != nil {
 		return nil, err
 	}
-	if !ecdsa.Verify(&pk, req.SigHash, sig.R, sig.S) {
-		return nil, fmt.Errorf("invalid signature for external key %s received", req.KeyID)
+
+	var unsignedTx *types.UnsignedTx
+	for _, txType := range types.GetTxTypes() {
+		tx, ok := keeper.GetUnsignedTx(ctx, txType)
+		if !ok {
+			continue
+		}
+
+		if types.DisableTimelock(tx.GetTx()).TxHash() == packet.UnsignedTx.TxHash() {
+			unsignedTx = &tx
+			break
+		}
 	}
 
-	sigID := getSigID(req.SigHash, req.KeyID)
-	btcecPK := btcec.PublicKey(pk)
-	s.signer.SetSig(ctx, tss.Signature{
-		SigID: sigID,
-		Sig: &tss.Signature_SingleSig_{
-			SingleSig: &tss.Signature_SingleSig{
-				SigKeyPair: tss.SigKeyPair{
-					PubKey:    btcecPK.SerializeCompressed(),
-					Signature: req.Signature,
-				},
-			},
-		},
-		SigStatus: tss.SigStatus_Signed,
-	})
+	if unsignedTx == nil {
+		return nil, fmt.Errorf("no unsigned transaction %s found", packet.UnsignedTx.TxHash().String())
+	}
 
-	info := tss.SignInfo{
-		KeyID: req.KeyID,
-		SigID: sigID,
+	tx := types.DisableTimelock(unsignedTx.GetTx())
+	outPointsToSign, err := getOutPointsToSign(ctx, tx, keeper)
+	if err != nil {
+		return nil, err
 	}
-	s.signer.SetInfoForSig(ctx, sigID, info)
 
-	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeExternalSignature,
-		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueSubmitted),
-		sdk.NewAttribute(types.AttributeKeyKeyID, string(req.KeyID)),
-		sdk.NewAttribute(types.AttributeKeySigID, sigID),
-	))
+	network := keeper.GetNetwork(ctx)
+	sigCount := 0
+	for i, input := range packet.Inputs {
+		if len(input.PartialSigs) == 0 {
+			continue
+		}
 
-	return &types.SubmitExternalSignatureResponse{}, nil
+		outPointToSign := outPointsToSign[i]
+		sigHash, err := network.CalcSigHash(tx, i, outPointToSign.Amount, outPointToSign.RedeemScript)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, partialSig := range input.PartialSigs {
+			if len(partialSig.Signature) == 0 || txscript.SigHashType(partialSig.Signature[len(partialSig.Signature)-1]) != network.SigHashType() {
+				return nil, fmt.Errorf("signature for input %d must be of sighash type SIGHASH_ALL", i)
+			}
+
+			keyID, ok := getExternalKeyIDByPubKey(ctx, s.signer, outPointToSign.SpendingCondition.ExternalKeyIds, partialSig.PubKey)
+			if !ok {
+				return nil, fmt.Errorf("public key %s is not an external key of input %d", hex.EncodeToString(partialSig.PubKey), i)
+			}
+
+			// strip the sighash type from the DER encoded signature
+			signature := partialSig.Signature[:len(partialSig.Signature)-1]
+			if err := submitExternalSignature(ctx, s.signer, keyID, signature, sigHash); err != nil {
+				return nil, err
+			}
+
+			sigCount++
+		}
+	}
+
+	if sigCount == 0 {
+		return nil, fmt.Errorf("no external signature found in the partially signed transaction")
+	}
+
+	s.Logger(ctx).Debug(fmt.Sprintf("received %d external signatures for %s transaction", sigCount, unsignedTx.Type.SimpleString()))
+
+	return &types.SubmitPsbtResponse{}, nil
 }
 
 // Link handles address linking
 func (s msgServer) Link(c context.Context, req *types.LinkRequest) (*types.LinkResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	if err := validateChainActivated(ctx, s.nexus, exported.Bitcoin); err != nil {
+	chain, err := getChain(ctx, s.nexus, req.Chain)
+	if err != nil {
 		return nil, err
 	}
 
-	masterKey, ok := s.signer.GetCurrentKey(ctx, exported.Bitcoin, tss.MasterKey)
+	keeper := s.ForChain(chain.Name)
+
+	masterKey, ok := s.signer.GetCurrentKey(ctx, chain, tss.MasterKey)
 	if !ok {
 		return nil, fmt.Errorf("master key not set")
 	}
 
-	secondaryKey, ok := s.signer.GetCurrentKey(ctx, exported.Bitcoin, tss.SecondaryKey)
+	secondaryKey, ok := s.signer.GetCurrentKey(ctx, chain, tss.SecondaryKey)
 	if !ok {
 		return nil, fmt.Errorf("secondary key not set")
 	}
@@ -127,34 +169,48 @@ func (s msgServer) Link(c context.Context, req *types.LinkRequest) (*types.LinkR
 		return nil, fmt.Errorf("unknown recipient chain")
 	}
 
-	if !s.nexus.IsAssetRegistered(ctx, recipientChain, exported.Bitcoin.NativeAsset) {
-		return nil, fmt.Errorf("asset '%s' not registered for chain '%s'", exported.Bitcoin.NativeAsset, recipientChain.Name)
+	if !s.nexus.IsAssetRegistered(ctx, recipientChain, chain.NativeAsset) {
+		return nil, fmt.Errorf("asset '%s' not registered for chain '%s'", chain.NativeAsset, recipientChain.Name)
 	}
 
 	recipient := nexus.CrossChainAddress{Chain: recipientChain, Address: req.RecipientAddr}
 
-	depositAddressInfo, err := getDepositAddress(ctx, s.BTCKeeper, s.signer, secondaryKey, recipient)
+	depositAddressInfo, err := getDepositAddress(ctx, chain, keeper, s.signer, secondaryKey, recipient)
 	if err != nil {
 		return nil, err
 	}
 
-	addr, err := btcutil.DecodeAddress(depositAddressInfo.Address, s.GetNetwork(ctx).Params())
+	addr, err := keeper.GetNetwork(ctx).DecodeAddress(depositAddressInfo.Address)
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.nexus.LinkAddresses(ctx, depositAddressInfo.ToCrossChainAddr(), recipient)
+	err = s.nexus.LinkAddresses(ctx, depositAddressInfo.ToCrossChainAddr(chain), recipient)
 	if err != nil {
 		return nil, fmt.Errorf("could not link addresses: %s", err.Error())
 	}
 
-	s.SetAddressInfo(ctx, depositAddressInfo)
-	s.SetDepositAddress(ctx, recipient, addr)
+	keeper.SetAddressInfo(ctx, depositAddressInfo)
+	keeper.SetDepositAddress(ctx, recipient, addr)
+
+	if expiryPeriod := keeper.GetDepositAddressExpiryPeriod(ctx); expiryPeriod > 0 {
+		expiresAt := ctx.BlockHeight() + expiryPeriod
+		// re-linking the same deposit address must not forget that it holds outpoints already
+		previous, _ := keeper.GetDepositAddressExpiry(ctx, depositAddressInfo.Address)
+		keeper.SetDepositAddressExpiry(ctx, types.DepositAddressExpiry{
+			Address:   depositAddressInfo.Address,
+			Recipient: recipient,
+			ExpiresAt: expiresAt,
+			PruneAt:   expiresAt + keeper.GetDepositAddressGracePeriod(ctx),
+			Funded:    previous.Funded,
+		})
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeLink,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
 			sdk.NewAttribute(types.AttributeKeyMasterKeyID, string(masterKey.ID)),
 			sdk.NewAttribute(types.AttributeKeySecondaryKeyID, string(secondaryKey.ID)),
 			sdk.NewAttribute(types.AttributeKeyDepositAddress, depositAddressInfo.Address),
@@ -170,11 +226,14 @@ func (s msgServer) Link(c context.Context, req *types.LinkRequest) (*types.LinkR
 func (s msgServer) ConfirmOutpoint(c context.Context, req *types.ConfirmOutpointRequest) (*types.ConfirmOutpointResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	if err := validateChainActivated(ctx, s.nexus, exported.Bitcoin); err != nil {
+	chain, err := getChain(ctx, s.nexus, req.Chain)
+	if err != nil {
 		return nil, err
 	}
 
-	_, state, ok := s.GetOutPointInfo(ctx, req.OutPointInfo.GetOutPoint())
+	keeper := s.ForChain(chain.Name)
+
+	_, state, ok := keeper.GetOutPointInfo(ctx, req.OutPointInfo.GetOutPoint())
 	switch {
 	case !ok:
 		break
@@ -184,28 +243,33 @@ func (s msgServer) ConfirmOutpoint(c context.Context, req *types.ConfirmOutpoint
 		return nil, fmt.Errorf("already spent")
 	}
 
-	if _, ok := s.GetAddressInfo(ctx, req.OutPointInfo.Address); !ok {
+	if _, ok := keeper.GetAddressInfo(ctx, req.OutPointInfo.Address); !ok {
 		return nil, fmt.Errorf("outpoint address unknown, aborting deposit confirmation")
 	}
 
-	pollKey := vote.NewPollKey(types.ModuleName, fmt.Sprintf("%s_%s_%d", req.OutPointInfo.OutPoint, req.OutPointInfo.Address, req.OutPointInfo.Amount))
+	if expiry, ok := keeper.GetDepositAddressExpiry(ctx, req.OutPointInfo.Address); ok && expiry.IsPrunable(ctx.BlockHeight()) {
+		return nil, fmt.Errorf("deposit address %s expired at height %d and does not accept deposits anymore", expiry.Address, expiry.ExpiresAt)
+	}
+
+	pollKey := vote.NewPollKey(types.ModuleName, fmt.Sprintf("%s_%s_%s_%d", chain.Name, req.OutPointInfo.OutPoint, req.OutPointInfo.Address, req.OutPointInfo.Amount))
 	if err := s.voter.InitializePoll(
 		ctx,
 		pollKey,
-		s.nexus.GetChainMaintainers(ctx, exported.Bitcoin),
-		vote.ExpiryAt(ctx.BlockHeight()+s.BTCKeeper.GetRevoteLockingPeriod(ctx)),
-		vote.Threshold(s.GetVotingThreshold(ctx)),
-		vote.MinVoterCount(s.GetMinVoterCount(ctx)),
-		vote.RewardPool(exported.Bitcoin.Name),
+		s.nexus.GetChainMaintainers(ctx, chain),
+		vote.ExpiryAt(ctx.BlockHeight()+keeper.GetRevoteLockingPeriod(ctx)),
+		vote.Threshold(keeper.GetVotingThreshold(ctx)),
+		vote.MinVoterCount(keeper.GetMinVoterCount(ctx)),
+		vote.RewardPool(chain.Name),
 	); err != nil {
 		return nil, err
 	}
-	s.SetPendingOutpointInfo(ctx, pollKey, req.OutPointInfo)
+	keeper.SetPendingOutpointInfo(ctx, pollKey, req.OutPointInfo)
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeOutpointConfirmation,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
 		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueStart),
-		sdk.NewAttribute(types.AttributeKeyConfHeight, strconv.FormatUint(s.GetRequiredConfirmationHeight(ctx), 10)),
+		sdk.NewAttribute(types.AttributeKeyConfHeight, strconv.FormatUint(keeper.GetRequiredConfirmationHeight(ctx), 10)),
 		sdk.NewAttribute(types.AttributeKeyOutPointInfo, string(types.ModuleCdc.MustMarshalJSON(&req.OutPointInfo))),
 		sdk.NewAttribute(types.AttributeKeyPoll, string(types.ModuleCdc.MustMarshalJSON(&pollKey))),
 	))
@@ -217,20 +281,23 @@ func (s msgServer) ConfirmOutpoint(c context.Context, req *types.ConfirmOutpoint
 func (s msgServer) VoteConfirmOutpoint(c context.Context, req *types.VoteConfirmOutpointRequest) (*types.VoteConfirmOutpointResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	if err := validateChainActivated(ctx, s.nexus, exported.Bitcoin); err != nil {
+	chain, err := getChain(ctx, s.nexus, req.Chain)
+	if err != nil {
 		return nil, err
 	}
 
+	keeper := s.ForChain(chain.Name)
+
 	// has the outpoint been confirmed before?
-	confirmedOutPointInfo, state, confirmedBefore := s.GetOutPointInfo(ctx, *types.MustConvertOutPointFromStr(req.OutPoint))
+	confirmedOutPointInfo, state, confirmedBefore := keeper.GetOutPointInfo(ctx, *types.MustConvertOutPointFromStr(req.OutPoint))
 	// is there an ongoing poll?
-	pendingOutPointInfo, pollFound := s.GetPendingOutPointInfo(ctx, req.PollKey)
+	pendingOutPointInfo, pollFound := keeper.GetPendingOutPointInfo(ctx, req.PollKey)
 
 	switch {
 	// a malicious user could try to delete an ongoing poll by providing an already confirmed outpoint,
 	// so we need to check that it matches the poll before deleting
 	case confirmedBefore && pollFound && pendingOutPointInfo.OutPoint == confirmedOutPointInfo.OutPoint:
-		s.DeletePendingOutPointInfo(ctx, req.PollKey)
+		keeper.DeletePendingOutPointInfo(ctx, req.PollKey)
 		fallthrough
 	// If the voting threshold has been met and additional votes are received they should not return an error
 	case confirmedBefore:
@@ -264,6 +331,7 @@ func (s msgServer) VoteConfirmOutpoint(c context.Context, req *types.VoteConfirm
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeOutpointConfirmation,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
 		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueVoted),
 		sdk.NewAttribute(types.AttributeKeyValue, strconv.FormatBool(voteValue.Value)),
 	))
@@ -273,7 +341,7 @@ func (s msgServer) VoteConfirmOutpoint(c context.Context, req *types.VoteConfirm
 	}
 
 	if poll.Is(vote.Failed) {
-		s.DeletePendingOutPointInfo(ctx, req.PollKey)
+		keeper.DeletePendingOutPointInfo(ctx, req.PollKey)
 		return &types.VoteConfirmOutpointResponse{Status: fmt.Sprintf("poll %s failed", poll.GetKey())}, nil
 	}
 
@@ -282,11 +350,12 @@ func (s msgServer) VoteConfirmOutpoint(c context.Context, req *types.VoteConfirm
 		return nil, fmt.Errorf("result of poll %s has wrong type, expected bool, got %T", req.PollKey.String(), poll.GetResult())
 	}
 
-	s.DeletePendingOutPointInfo(ctx, req.PollKey)
+	keeper.DeletePendingOutPointInfo(ctx, req.PollKey)
 
 	// handle poll result
 	event := sdk.NewEvent(types.EventTypeOutpointConfirmation,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
 		sdk.NewAttribute(types.AttributeKeyPoll, string(types.ModuleCdc.MustMarshalJSON(&req.PollKey))),
 		sdk.NewAttribute(types.AttributeKeyOutPointInfo, string(types.ModuleCdc.MustMarshalJSON(&pendingOutPointInfo))))
 
@@ -305,12 +374,29 @@ func (s msgServer) VoteConfirmOutpoint(c context.Context, req *types.VoteConfirm
 	s.Logger(ctx).Info(fmt.Sprintf("outpoint %s was confirmed ", req.OutPoint))
 	event = event.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueConfirm))
 
-	addr, ok := s.GetAddressInfo(ctx, pendingOutPointInfo.Address)
+	addr, ok := keeper.GetAddressInfo(ctx, pendingOutPointInfo.Address)
 	if !ok {
 		return nil, fmt.Errorf("cannot confirm outpoint of unknown address")
 	}
 
-	s.SetConfirmedOutpointInfo(ctx, addr.KeyID, pendingOutPointInfo)
+	if expiry, ok := keeper.GetDepositAddressExpiry(ctx, addr.Address); ok && addr.Role == types.Deposit {
+		if !expiry.Funded {
+			expiry.Funded = true
+			keeper.SetDepositAddressExpiry(ctx, expiry)
+		}
+
+		// deposits to expired addresses are not transferred to the recipient but rescued instead
+		if expiry.IsExpired(ctx.BlockHeight()) {
+			keeper.SetLateDepositOutpointInfo(ctx, pendingOutPointInfo)
+			event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyDepositAddress, addr.Address))
+
+			return &types.VoteConfirmOutpointResponse{
+				Status: fmt.Sprintf("outpoint %s was deposited to expired address %s and will be rescued", req.OutPoint, addr.Address),
+			}, nil
+		}
+	}
+
+	keeper.SetConfirmedOutpointInfo(ctx, addr.KeyID, pendingOutPointInfo)
 
 	key, ok := s.signer.GetKey(ctx, addr.KeyID)
 	if !ok {
@@ -322,8 +408,8 @@ func (s msgServer) VoteConfirmOutpoint(c context.Context, req *types.VoteConfirm
 		return nil, fmt.Errorf("cannot find rotation count of key %s", addr.KeyID)
 	}
 
-	currRotationCount := s.signer.GetRotationCount(ctx, exported.Bitcoin, key.Role)
-	_, nextKeyFound := s.signer.GetNextKey(ctx, exported.Bitcoin, key.Role)
+	currRotationCount := s.signer.GetRotationCount(ctx, chain, key.Role)
+	_, nextKeyFound := s.signer.GetNextKey(ctx, chain, key.Role)
 	if nextKeyFound {
 		currRotationCount++
 	}
@@ -336,9 +422,9 @@ func (s msgServer) VoteConfirmOutpoint(c context.Context, req *types.VoteConfirm
 	switch addr.Role {
 	case types.Deposit:
 		// handle cross-chain transfer
-		depositAddr := nexus.CrossChainAddress{Address: pendingOutPointInfo.Address, Chain: exported.Bitcoin}
-		amount := sdk.NewInt64Coin(exported.Bitcoin.NativeAsset, int64(pendingOutPointInfo.Amount))
-		if err := s.nexus.EnqueueForTransfer(ctx, depositAddr, amount, s.GetTransactionFeeRate(ctx)); err != nil {
+		depositAddr := nexus.CrossChainAddress{Address: pendingOutPointInfo.Address, Chain: chain}
+		amount := sdk.NewInt64Coin(chain.NativeAsset, int64(pendingOutPointInfo.Amount))
+		if err := s.nexus.EnqueueForTransfer(ctx, depositAddr, amount, keeper.GetTransactionFeeRate(ctx)); err != nil {
 			return nil, sdkerrors.Wrap(err, "cross-chain transfer failed")
 		}
 
@@ -358,8 +444,8 @@ func (s msgServer) VoteConfirmOutpoint(c context.Context, req *types.VoteConfirm
 			Status: fmt.Sprintf("transfer of %s from {%s} successfully prepared", amount.Amount.String(), depositAddr.String()),
 		}, nil
 	case types.Consolidation:
-		unconfirmedAmount := s.BTCKeeper.GetUnconfirmedAmount(ctx, addr.KeyID)
-		s.BTCKeeper.SetUnconfirmedAmount(ctx, addr.KeyID, unconfirmedAmount-pendingOutPointInfo.Amount)
+		unconfirmedAmount := keeper.GetUnconfirmedAmount(ctx, addr.KeyID)
+		keeper.SetUnconfirmedAmount(ctx, addr.KeyID, unconfirmedAmount-pendingOutPointInfo.Amount)
 		// the outpoint simply deposits funds into a consolidation address. Simply confirm
 		return &types.VoteConfirmOutpointResponse{
 			Status: "confirmed top up of consolidation balance"}, nil
@@ -371,11 +457,14 @@ func (s msgServer) VoteConfirmOutpoint(c context.Context, req *types.VoteConfirm
 func (s msgServer) SignTx(c context.Context, req *types.SignTxRequest) (*types.SignTxResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	if err := validateChainActivated(ctx, s.nexus, exported.Bitcoin); err != nil {
+	chain, err := getChain(ctx, s.nexus, req.Chain)
+	if err != nil {
 		return nil, err
 	}
 
-	unsignedTx, ok := s.GetUnsignedTx(ctx, req.TxType)
+	keeper := s.ForChain(chain.Name)
+
+	unsignedTx, ok := keeper.GetUnsignedTx(ctx, req.TxType)
 	if !ok || (!unsignedTx.Is(types.Created) && !unsignedTx.Is(types.Aborted)) {
 		return nil, fmt.Errorf("no unsigned %s tx ready for signing", req.TxType.SimpleString())
 	}
@@ -387,12 +476,12 @@ func (s msgServer) SignTx(c context.Context, req *types.SignTxRequest) (*types.S
 
 	for _, txIn := range unsignedTx.GetTx().TxIn {
 		outPointStr := txIn.PreviousOutPoint.String()
-		outPointInfo, state, ok := s.BTCKeeper.GetOutPointInfo(ctx, txIn.PreviousOutPoint)
+		outPointInfo, state, ok := keeper.GetOutPointInfo(ctx, txIn.PreviousOutPoint)
 		if !ok || state != types.OutPointState_Spent {
 			return nil, fmt.Errorf("out point info %s is not found or not spent", outPointStr)
 		}
 
-		addressInfo, ok := s.BTCKeeper.GetAddressInfo(ctx, outPointInfo.Address)
+		addressInfo, ok := keeper.GetAddressInfo(ctx, outPointInfo.Address)
 		if !ok {
 			return nil, fmt.Errorf("address for outpoint %s must be known", outPointStr)
 		}
@@ -431,7 +520,7 @@ func (s msgServer) SignTx(c context.Context, req *types.SignTxRequest) (*types.S
 	unsignedTx.Info.InputInfos = []types.UnsignedTx_Info_InputInfo{}
 
 	for i, outPointToSign := range outPointsToSign {
-		sigHash, err := txscript.CalcWitnessSigHash(outPointToSign.RedeemScript, txscript.NewTxSigHashes(tx), txscript.SigHashAll, tx, i, int64(outPointToSign.Amount))
+		sigHash, err := keeper.GetNetwork(ctx).CalcSigHash(tx, i, outPointToSign.Amount, outPointToSign.RedeemScript)
 		if err != nil {
 			return nil, err
 		}
@@ -481,7 +570,6 @@ func (s msgServer) SignTx(c context.Context, req *types.SignTxRequest) (*types.S
 	}
 	// track sign info position in queue
 	pos := int64(0)
-	var err error
 	for _, inputInfo := range unsignedTx.Info.InputInfos {
 		for _, sigRequirement := range inputInfo.SigRequirements {
 			sigID := getSigID(sigRequirement.SigHash, sigRequirement.KeyID)
@@ -508,6 +596,8 @@ func (s msgServer) SignTx(c context.Context, req *types.SignTxRequest) (*types.S
 				SnapshotCounter: snapshot.Counter,
 				RequestModule:   types.ModuleName,
 				Metadata:        "",
+				Priority:        getSignPriority(unsignedTx),
+				Chain:           chain.Name,
 			}, s.snapshotter, s.voter)
 			if err != nil {
 				return nil, err
@@ -517,10 +607,11 @@ func (s msgServer) SignTx(c context.Context, req *types.SignTxRequest) (*types.S
 
 	unsignedTx.SetTx(tx)
 	unsignedTx.Status = types.Signing
-	s.SetUnsignedTx(ctx, unsignedTx)
+	keeper.SetUnsignedTx(ctx, unsignedTx)
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeConsolidationTx,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
 		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueSigning),
 		sdk.NewAttribute(types.AttributeTxType, req.TxType.SimpleString()),
 	))
@@ -532,25 +623,28 @@ func (s msgServer) SignTx(c context.Context, req *types.SignTxRequest) (*types.S
 func (s msgServer) CreateRescueTx(c context.Context, req *types.CreateRescueTxRequest) (*types.CreateRescueTxResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	if err := validateChainActivated(ctx, s.nexus, exported.Bitcoin); err != nil {
+	chain, err := getChain(ctx, s.nexus, req.Chain)
+	if err != nil {
 		return nil, err
 	}
 
-	if _, ok := s.GetUnsignedTx(ctx, types.Rescue); ok {
+	keeper := s.ForChain(chain.Name)
+
+	if _, ok := keeper.GetUnsignedTx(ctx, types.Rescue); ok {
 		return nil, fmt.Errorf("rescue in progress")
 	}
 
 	var latestSecondaryKey tss.Key
-	if nextKey, nextKeyFound := s.signer.GetNextKey(ctx, exported.Bitcoin, tss.SecondaryKey); nextKeyFound {
+	if nextKey, nextKeyFound := s.signer.GetNextKey(ctx, chain, tss.SecondaryKey); nextKeyFound {
 		latestSecondaryKey = nextKey
-	} else if currKey, currKeyFound := s.signer.GetCurrentKey(ctx, exported.Bitcoin, tss.SecondaryKey); currKeyFound {
+	} else if currKey, currKeyFound := s.signer.GetCurrentKey(ctx, chain, tss.SecondaryKey); currKeyFound {
 		latestSecondaryKey = currKey
 	} else {
 		return nil, fmt.Errorf("%s key not set", tss.SecondaryKey.SimpleString())
 	}
 
 	// always rescue to the latest secondary key
-	secondaryAddress, err := getSecondaryConsolidationAddress(ctx, s.BTCKeeper, latestSecondaryKey)
+	secondaryAddress, err := getSecondaryConsolidationAddress(ctx, keeper, latestSecondaryKey)
 	if err != nil {
 		return nil, err
 	}
@@ -559,13 +653,13 @@ func (s msgServer) CreateRescueTx(c context.Context, req *types.CreateRescueTxRe
 	inputsTotal := sdk.ZeroInt()
 
 	for _, keyRole := range tss.GetKeyRoles() {
-		oldActiveKeys, err := s.signer.GetOldActiveKeys(ctx, exported.Bitcoin, keyRole)
+		oldActiveKeys, err := s.signer.GetOldActiveKeys(ctx, chain, keyRole)
 		if err != nil {
 			return nil, err
 		}
 
 		for _, oldActiveKey := range oldActiveKeys {
-			total, err := addInputs(ctx, s.BTCKeeper, tx, oldActiveKey.ID)
+			total, err := addInputs(ctx, keeper, tx, oldActiveKey.ID)
 			if err != nil {
 				return nil, err
 			}
@@ -577,26 +671,37 @@ func (s msgServer) CreateRescueTx(c context.Context, req *types.CreateRescueTxRe
 		}
 	}
 
+	total, err := addInputsFromQueue(ctx, keeper, tx, keeper.GetLateDepositOutpointInfoQueue(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if total.IsPositive() {
+		s.Logger(ctx).Debug("rescuing UTXOs of late deposits to expired deposit addresses")
+		inputsTotal = inputsTotal.Add(total)
+	}
+
 	if len(tx.TxIn) == 0 {
 		return nil, fmt.Errorf("no rescue needed")
 	}
 
-	if err := types.AddOutput(tx, s.BTCKeeper.GetAnyoneCanSpendAddress(ctx).GetAddress(), s.BTCKeeper.GetMinOutputAmount(ctx)); err != nil {
+	if err := types.AddOutput(tx, keeper.GetAnyoneCanSpendAddress(ctx).GetAddress(), keeper.GetMinOutputAmount(ctx)); err != nil {
 		return nil, err
 	}
 	anyoneCanSpendVout := uint32(0)
 
-	txSizeUpperBound, err := estimateTxSizeWithOutputsTo(ctx, s, *tx, secondaryAddress.GetAddress())
+	txSizeUpperBound, err := estimateTxSizeWithOutputsTo(ctx, keeper, *tx, secondaryAddress.GetAddress())
 	if err != nil {
 		return nil, err
 	}
 
 	outputsTotal := types.GetOutputsTotal(*tx)
-	// rescue transactions always pay 1 satoshi/byte, which is the default minimum relay fee rate bitcoin-core sets
-	fee := sdk.NewInt(txSizeUpperBound).MulRaw(types.MinRelayTxFeeSatoshiPerByte)
+	// rescue transactions always pay the minimum relay fee rate of the chain
+	fee := sdk.NewInt(txSizeUpperBound).MulRaw(keeper.GetMinRelayTxFeeRate(ctx))
 	change := inputsTotal.SubRaw(int64(outputsTotal)).Sub(fee)
 
-	if !change.IsPositive() {
+	// the change output must not be considered dust by the chain
+	if change.LT(sdk.NewInt(int64(keeper.GetDustLimit(ctx)))) {
 		return nil, fmt.Errorf("not enough inputs (%d) to cover the fee (%d) for the %s transaction", inputsTotal.Int64(), fee.Int64(), types.Rescue.SimpleString())
 	}
 
@@ -604,15 +709,16 @@ func (s msgServer) CreateRescueTx(c context.Context, req *types.CreateRescueTxRe
 		return nil, err
 	}
 
-	s.SetAddressInfo(ctx, secondaryAddress)
+	keeper.SetAddressInfo(ctx, secondaryAddress)
 
 	tx = types.DisableTimelock(tx)
 	unsignedTx := types.NewUnsignedTx(types.Rescue, tx, anyoneCanSpendVout, btcutil.Amount(change.Int64()))
 
-	s.SetUnsignedTx(ctx, unsignedTx)
+	keeper.SetUnsignedTx(ctx, unsignedTx)
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeConsolidationTx,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
 		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueCreated),
 		sdk.NewAttribute(types.AttributeTxType, types.Rescue.SimpleString()),
 	))
@@ -626,101 +732,115 @@ func (s msgServer) CreateRescueTx(c context.Context, req *types.CreateRescueTxRe
 func (s msgServer) CreateMasterTx(c context.Context, req *types.CreateMasterTxRequest) (*types.CreateMasterTxResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	if err := validateChainActivated(ctx, s.nexus, exported.Bitcoin); err != nil {
+	chain, err := getChain(ctx, s.nexus, req.Chain)
+	if err != nil {
 		return nil, err
 	}
 
-	secondaryMin := s.GetMinOutputAmount(ctx)
+	keeper := s.ForChain(chain.Name)
+
+	secondaryMin := keeper.GetMinOutputAmount(ctx)
 	if req.SecondaryKeyAmount > 0 && req.SecondaryKeyAmount < secondaryMin {
 		return nil, fmt.Errorf("cannot transfer %d to secondary key, it is below the minimum of %d", req.SecondaryKeyAmount, secondaryMin)
 	}
 
-	secondaryMax := s.GetMaxSecondaryOutputAmount(ctx)
+	secondaryMax := keeper.GetMaxSecondaryOutputAmount(ctx)
 	if req.SecondaryKeyAmount > secondaryMax {
 		return nil, fmt.Errorf("cannot transfer %d to secondary key, it is above the maximum of %d", req.SecondaryKeyAmount, secondaryMax)
 	}
 
-	if _, ok := s.GetUnsignedTx(ctx, types.MasterConsolidation); ok {
-		return nil, fmt.Errorf("consolidation in progress")
+	if err := CreateMasterConsolidationTx(ctx, chain, keeper, s.signer, s.snapshotter, req.KeyID, req.SecondaryKeyAmount); err != nil {
+		return nil, err
+	}
+
+	return &types.CreateMasterTxResponse{}, nil
+}
+
+// CreateMasterConsolidationTx creates a master key consolidation transaction that sends the outpoints of the current master key
+// to the given key and rotates to it if it is not the current master key
+func CreateMasterConsolidationTx(ctx sdk.Context, chain nexus.Chain, keeper types.BTCKeeper, signer types.Signer, snapshotter types.Snapshotter, keyID tss.KeyID, secondaryKeyAmount btcutil.Amount) error {
+	if _, ok := keeper.GetUnsignedTx(ctx, types.MasterConsolidation); ok {
+		return fmt.Errorf("consolidation in progress")
 	}
 
-	externalMultisigThreshold := s.signer.GetExternalMultisigThreshold(ctx)
-	externalKeys, err := getExternalKeys(ctx, s.BTCKeeper, s.signer)
+	externalMultisigThreshold := signer.GetExternalMultisigThreshold(ctx)
+	externalKeys, err := getExternalKeys(ctx, chain, signer)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if len(externalKeys) != int(externalMultisigThreshold.Denominator) {
-		return nil, fmt.Errorf("number of external keys does not match the threshold and re-register is needed")
+		return fmt.Errorf("number of external keys does not match the threshold and re-register is needed")
 	}
 
-	consolidationKey, ok := s.signer.GetKey(ctx, req.KeyID)
+	consolidationKey, ok := signer.GetKey(ctx, keyID)
 	if !ok {
-		return nil, fmt.Errorf("unkown key %s", req.KeyID)
+		return fmt.Errorf("unkown key %s", keyID)
 	}
 
-	currMasterKey, ok := s.signer.GetCurrentKey(ctx, exported.Bitcoin, tss.MasterKey)
+	currMasterKey, ok := signer.GetCurrentKey(ctx, chain, tss.MasterKey)
 	if !ok {
-		return nil, fmt.Errorf("current %s key is not set", tss.MasterKey.SimpleString())
+		return fmt.Errorf("current %s key is not set", tss.MasterKey.SimpleString())
 	}
 
 	tx := types.CreateTx()
 
-	inputsTotal, err := addInputs(ctx, s.BTCKeeper, tx, currMasterKey.ID)
+	inputsTotal, err := addInputs(ctx, keeper, tx, currMasterKey.ID)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	if err := types.AddOutput(tx, s.BTCKeeper.GetAnyoneCanSpendAddress(ctx).GetAddress(), s.BTCKeeper.GetMinOutputAmount(ctx)); err != nil {
-		return nil, err
+	if err := types.AddOutput(tx, keeper.GetAnyoneCanSpendAddress(ctx).GetAddress(), keeper.GetMinOutputAmount(ctx)); err != nil {
+		return err
 	}
 	anyoneCanSpendVout := uint32(0)
 
-	if req.SecondaryKeyAmount > 0 {
+	if secondaryKeyAmount > 0 {
 		var key tss.Key
 
-		if nextKey, nextKeyFound := s.signer.GetNextKey(ctx, exported.Bitcoin, tss.SecondaryKey); nextKeyFound {
+		if nextKey, nextKeyFound := signer.GetNextKey(ctx, chain, tss.SecondaryKey); nextKeyFound {
 			key = nextKey
-		} else if currKey, currKeyFound := s.signer.GetCurrentKey(ctx, exported.Bitcoin, tss.SecondaryKey); currKeyFound {
+		} else if currKey, currKeyFound := signer.GetCurrentKey(ctx, chain, tss.SecondaryKey); currKeyFound {
 			key = currKey
 		} else {
-			return nil, fmt.Errorf("%s key not set", tss.SecondaryKey.SimpleString())
+			return fmt.Errorf("%s key not set", tss.SecondaryKey.SimpleString())
 		}
 
-		secondaryAddress, err := getSecondaryConsolidationAddress(ctx, s.BTCKeeper, key)
+		secondaryAddress, err := getSecondaryConsolidationAddress(ctx, keeper, key)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		if err := types.AddOutput(tx, secondaryAddress.GetAddress(), btcutil.Amount(req.SecondaryKeyAmount)); err != nil {
-			return nil, err
+		if err := types.AddOutput(tx, secondaryAddress.GetAddress(), secondaryKeyAmount); err != nil {
+			return err
 		}
-		s.SetAddressInfo(ctx, secondaryAddress)
+		keeper.SetAddressInfo(ctx, secondaryAddress)
 	}
 
-	consolidationAddress, err := getMasterConsolidationAddress(ctx, s.BTCKeeper, s.signer, consolidationKey)
+	consolidationAddress, err := getMasterConsolidationAddress(ctx, chain, keeper, signer, consolidationKey)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	txSizeUpperBound, err := estimateTxSizeWithOutputsTo(ctx, s, *tx, consolidationAddress.GetAddress())
+	txSizeUpperBound, err := estimateTxSizeWithOutputsTo(ctx, keeper, *tx, consolidationAddress.GetAddress())
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	outputsTotal := types.GetOutputsTotal(*tx)
-	// consolidation transactions always pay 1 satoshi/byte, which is the default minimum relay fee rate bitcoin-core sets
-	fee := sdk.NewInt(txSizeUpperBound).MulRaw(types.MinRelayTxFeeSatoshiPerByte)
+	// consolidation transactions always pay the minimum relay fee rate of the chain
+	fee := sdk.NewInt(txSizeUpperBound).MulRaw(keeper.GetMinRelayTxFeeRate(ctx))
 	change := inputsTotal.SubRaw(int64(outputsTotal)).Sub(fee)
 
-	if !change.IsPositive() {
-		return nil, fmt.Errorf("not enough inputs (%d) to cover the fee (%d) for master consolidation transaction", inputsTotal.Int64(), fee.Int64())
+	// the change output must not be considered dust by the chain
+	if change.LT(sdk.NewInt(int64(keeper.GetDustLimit(ctx)))) {
+		return fmt.Errorf("not enough inputs (%d) to cover the fee (%d) for master consolidation transaction", inputsTotal.Int64(), fee.Int64())
 	}
 
 	if err := types.AddOutput(tx, consolidationAddress.GetAddress(), btcutil.Amount(change.Int64())); err != nil {
-		return nil, err
+		return err
 	}
 
-	s.SetAddressInfo(ctx, consolidationAddress)
+	keeper.SetAddressInfo(ctx, consolidationAddress)
 	telemetry.SetGaugeWithLabels(
 		[]string{types.ModuleName, "secondary", "address", "balance"},
 		float32(change.Int64()),
@@ -730,128 +850,133 @@ func (s msgServer) CreateMasterTx(c context.Context, req *types.CreateMasterTxRe
 		})
 
 	tx = types.DisableTimelock(tx)
-	unsignedTx := types.NewUnsignedTx(types.MasterConsolidation, tx, anyoneCanSpendVout, req.SecondaryKeyAmount)
+	unsignedTx := types.NewUnsignedTx(types.MasterConsolidation, tx, anyoneCanSpendVout, secondaryKeyAmount)
 	// If consolidating to a new key, that key has to be eligible for the role
 	if currMasterKey.ID != consolidationKey.ID {
-		if err := validateKeyAssignment(ctx, s.BTCKeeper, s.signer, s.snapshotter, currMasterKey, consolidationKey); err != nil {
-			return nil, err
+		if err := assignNextKey(ctx, chain, keeper, signer, snapshotter, currMasterKey, consolidationKey); err != nil {
+			return err
 		}
 
 		unsignedTx.Info.RotateKey = true
-		if err := s.signer.AssignNextKey(ctx, exported.Bitcoin, consolidationKey.Role, consolidationKey.ID); err != nil {
-			return nil, err
-		}
-
-		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeKey,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueAssigned),
-			sdk.NewAttribute(types.AttributeKeyRole, consolidationKey.Role.SimpleString()),
-			sdk.NewAttribute(types.AttributeKeyKeyID, string(consolidationKey.ID)),
-		))
 	}
 
-	s.SetUnsignedTx(ctx, unsignedTx)
+	keeper.SetUnsignedTx(ctx, unsignedTx)
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeConsolidationTx,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
 		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueCreated),
 		sdk.NewAttribute(types.AttributeTxType, types.MasterConsolidation.SimpleString()),
 	))
 
-	return &types.CreateMasterTxResponse{}, nil
+	return nil
 }
 
 // CreatePendingTransfersTx creates a secondary key consolidation transaction
 func (s msgServer) CreatePendingTransfersTx(c context.Context, req *types.CreatePendingTransfersTxRequest) (*types.CreatePendingTransfersTxResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	if err := validateChainActivated(ctx, s.nexus, exported.Bitcoin); err != nil {
+	chain, err := getChain(ctx, s.nexus, req.Chain)
+	if err != nil {
 		return nil, err
 	}
 
-	masterMin := s.GetMinOutputAmount(ctx)
+	keeper := s.ForChain(chain.Name)
+
+	masterMin := keeper.GetMinOutputAmount(ctx)
 	if req.MasterKeyAmount > 0 && req.MasterKeyAmount < masterMin {
 		return nil, fmt.Errorf("cannot transfer %d to the master key, it is below the minimum amount of %d", req.MasterKeyAmount, masterMin)
 	}
 
-	if _, ok := s.GetUnsignedTx(ctx, types.SecondaryConsolidation); ok {
-		return nil, fmt.Errorf("consolidation in progress")
+	if err := CreateSecondaryConsolidationTx(ctx, chain, keeper, s.signer, s.nexus, s.snapshotter, req.KeyID, req.MasterKeyAmount); err != nil {
+		return nil, err
+	}
+
+	return &types.CreatePendingTransfersTxResponse{}, nil
+}
+
+// CreateSecondaryConsolidationTx creates a secondary key consolidation transaction that pays out pending transfers, sends the
+// remaining outpoints of the current secondary key to the given key and rotates to it if it is not the current secondary key
+func CreateSecondaryConsolidationTx(ctx sdk.Context, chain nexus.Chain, keeper types.BTCKeeper, signer types.Signer, n types.Nexus, snapshotter types.Snapshotter, keyID tss.KeyID, masterKeyAmount btcutil.Amount) error {
+	if _, ok := keeper.GetUnsignedTx(ctx, types.SecondaryConsolidation); ok {
+		return fmt.Errorf("consolidation in progress")
 	}
 
-	consolidationKey, ok := s.signer.GetKey(ctx, req.KeyID)
+	consolidationKey, ok := signer.GetKey(ctx, keyID)
 	if !ok {
-		return nil, fmt.Errorf("unkown key %s", req.KeyID)
+		return fmt.Errorf("unkown key %s", keyID)
 	}
 
-	currSecondaryKey, ok := s.signer.GetCurrentKey(ctx, exported.Bitcoin, tss.SecondaryKey)
+	currSecondaryKey, ok := signer.GetCurrentKey(ctx, chain, tss.SecondaryKey)
 	if !ok {
-		return nil, fmt.Errorf("current %s key is not set", tss.SecondaryKey.SimpleString())
+		return fmt.Errorf("current %s key is not set", tss.SecondaryKey.SimpleString())
 	}
 
 	tx := types.CreateTx()
 
-	inputsTotal, err := addInputs(ctx, s.BTCKeeper, tx, currSecondaryKey.ID)
+	inputsTotal, err := addInputs(ctx, keeper, tx, currSecondaryKey.ID)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	if err := types.AddOutput(tx, s.BTCKeeper.GetAnyoneCanSpendAddress(ctx).GetAddress(), s.BTCKeeper.GetMinOutputAmount(ctx)); err != nil {
-		return nil, err
+	if err := types.AddOutput(tx, keeper.GetAnyoneCanSpendAddress(ctx).GetAddress(), keeper.GetMinOutputAmount(ctx)); err != nil {
+		return err
 	}
 	anyoneCanSpendVout := uint32(0)
 
-	if req.MasterKeyAmount > 0 {
+	if masterKeyAmount > 0 {
 		var key tss.Key
 
-		if nextKey, nextKeyFound := s.signer.GetNextKey(ctx, exported.Bitcoin, tss.MasterKey); nextKeyFound {
+		if nextKey, nextKeyFound := signer.GetNextKey(ctx, chain, tss.MasterKey); nextKeyFound {
 			key = nextKey
-		} else if currKey, currKeyFound := s.signer.GetCurrentKey(ctx, exported.Bitcoin, tss.MasterKey); currKeyFound {
+		} else if currKey, currKeyFound := signer.GetCurrentKey(ctx, chain, tss.MasterKey); currKeyFound {
 			key = currKey
 		} else {
-			return nil, fmt.Errorf("%s key not set", tss.MasterKey.SimpleString())
+			return fmt.Errorf("%s key not set", tss.MasterKey.SimpleString())
 		}
 
-		masterAddress, err := getMasterConsolidationAddress(ctx, s.BTCKeeper, s.signer, key)
+		masterAddress, err := getMasterConsolidationAddress(ctx, chain, keeper, signer, key)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		if err := types.AddOutput(tx, masterAddress.GetAddress(), btcutil.Amount(req.MasterKeyAmount)); err != nil {
-			return nil, err
+		if err := types.AddOutput(tx, masterAddress.GetAddress(), masterKeyAmount); err != nil {
+			return err
 		}
-		s.SetAddressInfo(ctx, masterAddress)
+		keeper.SetAddressInfo(ctx, masterAddress)
 	}
 
-	consolidationAddress, err := getSecondaryConsolidationAddress(ctx, s.BTCKeeper, consolidationKey)
+	consolidationAddress, err := getSecondaryConsolidationAddress(ctx, keeper, consolidationKey)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	if err := addWithdrawalOutputs(ctx, s.BTCKeeper, s.nexus, tx, consolidationAddress.GetAddress()); err != nil {
-		return nil, err
+	if err := addWithdrawalOutputs(ctx, chain, keeper, n, tx, consolidationAddress.GetAddress()); err != nil {
+		return err
 	}
 
-	txSizeUpperBound, err := estimateTxSizeWithOutputsTo(ctx, s, *tx, consolidationAddress.GetAddress())
+	txSizeUpperBound, err := estimateTxSizeWithOutputsTo(ctx, keeper, *tx, consolidationAddress.GetAddress())
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	outputsTotal := types.GetOutputsTotal(*tx)
-	// consolidation transactions always pay 1 satoshi/byte, which is the default minimum relay fee rate bitcoin-core sets
-	fee := sdk.NewInt(txSizeUpperBound).MulRaw(types.MinRelayTxFeeSatoshiPerByte)
+	// consolidation transactions always pay the minimum relay fee rate of the chain
+	fee := sdk.NewInt(txSizeUpperBound).MulRaw(keeper.GetMinRelayTxFeeRate(ctx))
 	change := inputsTotal.SubRaw(int64(outputsTotal)).Sub(fee)
 
-	if !change.IsPositive() {
-		return nil, fmt.Errorf("not enough deposits (%s) to make all withdrawals (%s) with a transaction fee of %s",
+	// the change output must not be considered dust by the chain
+	if change.LT(sdk.NewInt(int64(keeper.GetDustLimit(ctx)))) {
+		return fmt.Errorf("not enough deposits (%s) to make all withdrawals (%s) with a transaction fee of %s",
 			inputsTotal.String(), outputsTotal.String(), btcutil.Amount(fee.Int64()).String(),
 		)
 	}
 
 	if err := types.AddOutput(tx, consolidationAddress.GetAddress(), btcutil.Amount(change.Int64())); err != nil {
-		return nil, err
+		return err
 	}
 
-	s.SetAddressInfo(ctx, consolidationAddress)
+	keeper.SetAddressInfo(ctx, consolidationAddress)
 	telemetry.SetGaugeWithLabels(
 		[]string{types.ModuleName, "secondary", "address", "balance"},
 		float32(change.Int64()),
@@ -861,39 +986,30 @@ func (s msgServer) CreatePendingTransfersTx(c context.Context, req *types.Create
 		})
 
 	tx = types.DisableTimelock(tx)
-	unsignedTx := types.NewUnsignedTx(types.SecondaryConsolidation, tx, anyoneCanSpendVout, req.MasterKeyAmount)
+	unsignedTx := types.NewUnsignedTx(types.SecondaryConsolidation, tx, anyoneCanSpendVout, masterKeyAmount)
 	// If consolidating to a new key, that key has to be eligible for the role
 	if currSecondaryKey.ID != consolidationKey.ID {
-		if err := validateKeyAssignment(ctx, s.BTCKeeper, s.signer, s.snapshotter, currSecondaryKey, consolidationKey); err != nil {
-			return nil, err
+		if err := assignNextKey(ctx, chain, keeper, signer, snapshotter, currSecondaryKey, consolidationKey); err != nil {
+			return err
 		}
 
 		unsignedTx.Info.RotateKey = true
-		if err := s.signer.AssignNextKey(ctx, exported.Bitcoin, consolidationKey.Role, consolidationKey.ID); err != nil {
-			return nil, err
-		}
-
-		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeKey,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueAssigned),
-			sdk.NewAttribute(types.AttributeKeyRole, consolidationKey.Role.SimpleString()),
-			sdk.NewAttribute(types.AttributeKeyKeyID, string(consolidationKey.ID)),
-		))
 	}
 
-	s.SetUnsignedTx(ctx, unsignedTx)
+	keeper.SetUnsignedTx(ctx, unsignedTx)
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeConsolidationTx,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
 		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueCreated),
 		sdk.NewAttribute(types.AttributeTxType, types.SecondaryConsolidation.SimpleString()),
 	))
 
-	return &types.CreatePendingTransfersTxResponse{}, nil
+	return nil
 }
 
-func getExternalKeys(ctx sdk.Context, k types.BTCKeeper, signer types.Signer) ([]tss.Key, error) {
-	externalKeyIDs, ok := signer.GetExternalKeyIDs(ctx, exported.Bitcoin)
+func getExternalKeys(ctx sdk.Context, chain nexus.Chain, signer types.Signer) ([]tss.Key, error) {
+	externalKeyIDs, ok := signer.GetExternalKeyIDs(ctx, chain)
 	if !ok {
 		return nil, fmt.Errorf("external keys not registered yet")
 	}
@@ -935,20 +1051,20 @@ func estimateTxSizeWithOutputsTo(ctx sdk.Context, k types.BTCKeeper, tx wire.Msg
 		}
 	}
 
-	return types.EstimateTxSize(tx, outPointsToSign), nil
+	return types.EstimateTxSize(tx, outPointsToSign, k.GetNetwork(ctx)), nil
 }
 
-func addWithdrawalOutputs(ctx sdk.Context, k types.BTCKeeper, n types.Nexus, tx *wire.MsgTx, changeAddress btcutil.Address) error {
+func addWithdrawalOutputs(ctx sdk.Context, chain nexus.Chain, k types.BTCKeeper, n types.Nexus, tx *wire.MsgTx, changeAddress btcutil.Address) error {
 	total := sdk.ZeroInt()
 	outputCount := 0
 	minAmount := sdk.NewInt(int64(k.GetMinOutputAmount(ctx)))
-	pendingTransfers := n.GetTransfersForChain(ctx, exported.Bitcoin, nexus.Pending)
-	network := k.GetNetwork(ctx).Params()
+	pendingTransfers := n.GetTransfersForChain(ctx, chain, nexus.Pending)
+	network := k.GetNetwork(ctx)
 	maxTxSize := k.GetMaxTxSize(ctx)
 
 	addressToTransfers := make(map[string][]nexus.CrossChainTransfer)
 	for _, transfer := range pendingTransfers {
-		recipient, err := btcutil.DecodeAddress(transfer.Recipient.Address, network)
+		recipient, err := network.DecodeAddress(transfer.Recipient.Address)
 		if err != nil {
 			continue
 		}
@@ -959,7 +1075,7 @@ func addWithdrawalOutputs(ctx sdk.Context, k types.BTCKeeper, n types.Nexus, tx
 
 	// Combine output to same destination address
 	for _, transfer := range pendingTransfers {
-		recipient, err := btcutil.DecodeAddress(transfer.Recipient.Address, network)
+		recipient, err := network.DecodeAddress(transfer.Recipient.Address)
 		if err != nil {
 			k.Logger(ctx).Error(fmt.Sprintf("%s is not a valid address", transfer.Recipient.Address))
 			continue
@@ -973,6 +1089,7 @@ func addWithdrawalOutputs(ctx sdk.Context, k types.BTCKeeper, n types.Nexus, tx
 			// Emit event and continue
 			ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeWithdrawal,
 				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
 				sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueFailed),
 				sdk.NewAttribute(types.AttributeKeyDestinationAddress, encodedAddress),
 				sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
@@ -1012,9 +1129,12 @@ func addWithdrawalOutputs(ctx sdk.Context, k types.BTCKeeper, n types.Nexus, tx
 }
 
 func addInputs(ctx sdk.Context, k types.BTCKeeper, tx *wire.MsgTx, keyID tss.KeyID) (sdk.Int, error) {
-	total := sdk.ZeroInt()
 	// TODO: the confirmed outpoint info queue should by ordered by value desc instead of block height asc
-	confirmedOutpointInfoQueue := k.GetConfirmedOutpointInfoQueueForKey(ctx, keyID)
+	return addInputsFromQueue(ctx, k, tx, k.GetConfirmedOutpointInfoQueueForKey(ctx, keyID))
+}
+
+func addInputsFromQueue(ctx sdk.Context, k types.BTCKeeper, tx *wire.MsgTx, confirmedOutpointInfoQueue utils.KVQueue) (sdk.Int, error) {
+	total := sdk.ZeroInt()
 	maxInputCount := k.GetMaxInputCount(ctx)
 
 	var info types.OutPointInfo
@@ -1032,11 +1152,116 @@ func addInputs(ctx sdk.Context, k types.BTCKeeper, tx *wire.MsgTx, keyID tss.Key
 	return total, nil
 }
 
+// getSignPriority ranks transactions that move the funds to the next key above routine consolidations
+func getSignPriority(unsignedTx types.UnsignedTx) tss.SignPriority {
+	if unsignedTx.Info.RotateKey {
+		return tss.SignPriority_High
+	}
+
+	return tss.SignPriority_Normal
+}
+
+func submitExternalSignature(ctx sdk.Context, signer types.Signer, keyID tss.KeyID, signature []byte, sigHash []byte) error {
+	externalKey, ok := signer.GetKey(ctx, keyID)
+	if !ok || externalKey.Role != tss.ExternalKey {
+		return fmt.Errorf("external key %s not found", keyID)
+	}
+
+	pk, err := externalKey.GetECDSAPubKey()
+	if err != nil {
+		return err
+	}
+
+	sig, err := btcec.ParseDERSignature(signature, btcec.S256())
+	if err != nil {
+		return err
+	}
+	if !ecdsa.Verify(&pk, sigHash, sig.R, sig.S) {
+		return fmt.Errorf("invalid signature for external key %s received", keyID)
+	}
+
+	sigID := getSigID(sigHash, keyID)
+	btcecPK := btcec.PublicKey(pk)
+	signer.SetSig(ctx, tss.Signature{
+		SigID: sigID,
+		Sig: &tss.Signature_SingleSig_{
+			SingleSig: &tss.Signature_SingleSig{
+				SigKeyPair: tss.SigKeyPair{
+					PubKey:    btcecPK.SerializeCompressed(),
+					Signature: signature,
+				},
+			},
+		},
+		SigStatus: tss.SigStatus_Signed,
+	})
+
+	info := tss.SignInfo{
+		KeyID: keyID,
+		SigID: sigID,
+	}
+	signer.SetInfoForSig(ctx, sigID, info)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeExternalSignature,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueSubmitted),
+		sdk.NewAttribute(types.AttributeKeyKeyID, string(keyID)),
+		sdk.NewAttribute(types.AttributeKeySigID, sigID),
+	))
+
+	return nil
+}
+
+func getExternalKeyIDByPubKey(ctx sdk.Context, signer types.Signer, externalKeyIDs []tss.KeyID, pubKey []byte) (tss.KeyID, bool) {
+	for _, keyID := range externalKeyIDs {
+		key, ok := signer.GetKey(ctx, keyID)
+		if !ok {
+			continue
+		}
+
+		pk, err := key.GetECDSAPubKey()
+		if err != nil {
+			continue
+		}
+
+		btcecPK := btcec.PublicKey(pk)
+		if bytes.Equal(btcecPK.SerializeCompressed(), pubKey) {
+			return keyID, true
+		}
+	}
+
+	return "", false
+}
+
 func getSigID(sigHash []byte, keyID tss.KeyID) string {
 	return fmt.Sprintf("%s-%s", hex.EncodeToString(sigHash), keyID)
 }
 
-func validateKeyAssignment(ctx sdk.Context, k types.BTCKeeper, signer types.Signer, snapshotter types.Snapshotter, currKey tss.Key, nextKey tss.Key) error {
+// assignNextKey assigns the given key as the next key of its role unless it has been assigned already, e.g. by a scheduled rotation
+func assignNextKey(ctx sdk.Context, chain nexus.Chain, k types.BTCKeeper, signer types.Signer, snapshotter types.Snapshotter, currKey tss.Key, nextKey tss.Key) error {
+	if err := validateKeyAssignment(ctx, chain, k, signer, snapshotter, currKey, nextKey); err != nil {
+		return err
+	}
+
+	if assignedKey, ok := signer.GetNextKey(ctx, chain, nextKey.Role); ok && assignedKey.ID == nextKey.ID {
+		return nil
+	}
+
+	if err := signer.AssignNextKey(ctx, chain, nextKey.Role, nextKey.ID); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeKey,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueAssigned),
+		sdk.NewAttribute(types.AttributeKeyRole, nextKey.Role.SimpleString()),
+		sdk.NewAttribute(types.AttributeKeyKeyID, string(nextKey.ID)),
+	))
+
+	return nil
+}
+
+func validateKeyAssignment(ctx sdk.Context, chain nexus.Chain, k types.BTCKeeper, signer types.Signer, snapshotter types.Snapshotter, currKey tss.Key, nextKey tss.Key) error {
 	// Validate that the other key is not in the process of sending coin to the key that is to be rotated out
 	var txType types.TxType
 
@@ -1059,10 +1284,10 @@ func validateKeyAssignment(ctx sdk.Context, k types.BTCKeeper, signer types.Sign
 
 	// If rotating secondary key, validate that the secondary key whose validators are about to be allowed to unbond does not have any unspent outpoint
 	if currKey.Role == tss.SecondaryKey {
-		rotationCount := signer.GetRotationCount(ctx, exported.Bitcoin, currKey.Role)
+		rotationCount := signer.GetRotationCount(ctx, chain, currKey.Role)
 		unbondingLockingKeyRotationCount := signer.GetKeyUnbondingLockingKeyRotationCount(ctx)
 		if rotationCount > unbondingLockingKeyRotationCount {
-			key, ok := signer.GetKeyByRotationCount(ctx, exported.Bitcoin, currKey.Role, rotationCount-unbondingLockingKeyRotationCount)
+			key, ok := signer.GetKeyByRotationCount(ctx, chain, currKey.Role, rotationCount-unbondingLockingKeyRotationCount)
 			if !ok {
 				return fmt.Errorf("cannot find the %s key of rotation count %d", currKey.Role, rotationCount-unbondingLockingKeyRotationCount)
 			}
@@ -1077,7 +1302,7 @@ func validateKeyAssignment(ctx sdk.Context, k types.BTCKeeper, signer types.Sign
 		}
 	}
 
-	if err := signer.AssertMatchesRequirements(ctx, snapshotter, exported.Bitcoin, nextKey.ID, currKey.Role); err != nil {
+	if err := signer.AssertMatchesRequirements(ctx, snapshotter, chain, nextKey.ID, currKey.Role); err != nil {
 		return sdkerrors.Wrapf(err, "key %s does not match requirements for role %s", nextKey.ID, currKey.Role.SimpleString())
 	}
 
@@ -1093,13 +1318,13 @@ func validateKeyAssignment(ctx sdk.Context, k types.BTCKeeper, signer types.Sign
 	return nil
 }
 
-func getDepositAddress(ctx sdk.Context, k types.BTCKeeper, s types.Signer, key tss.Key, recipient nexus.CrossChainAddress) (types.AddressInfo, error) {
+func getDepositAddress(ctx sdk.Context, chain nexus.Chain, k types.BTCKeeper, s types.Signer, key tss.Key, recipient nexus.CrossChainAddress) (types.AddressInfo, error) {
 	if key.Role != tss.SecondaryKey {
 		return types.AddressInfo{}, fmt.Errorf("given key %s is not for a %s key", key.ID, tss.SecondaryKey.SimpleString())
 	}
 
 	externalMultisigThreshold := s.GetExternalMultisigThreshold(ctx)
-	externalKeys, err := getExternalKeys(ctx, k, s)
+	externalKeys, err := getExternalKeys(ctx, chain, s)
 	if err != nil {
 		return types.AddressInfo{}, err
 	}
@@ -1136,23 +1361,23 @@ func getSecondaryConsolidationAddress(ctx sdk.Context, k types.BTCKeeper, key ts
 	)
 }
 
-func getMasterConsolidationAddress(ctx sdk.Context, k types.BTCKeeper, s types.Signer, key tss.Key) (types.AddressInfo, error) {
+func getMasterConsolidationAddress(ctx sdk.Context, chain nexus.Chain, k types.BTCKeeper, s types.Signer, key tss.Key) (types.AddressInfo, error) {
 	if key.Role != tss.MasterKey {
 		return types.AddressInfo{}, fmt.Errorf("given key %s is not for a %s key", key.ID, tss.MasterKey.SimpleString())
 	}
 
-	currMasterKey, ok := s.GetCurrentKey(ctx, exported.Bitcoin, tss.MasterKey)
+	currMasterKey, ok := s.GetCurrentKey(ctx, chain, tss.MasterKey)
 	if !ok {
 		return types.AddressInfo{}, fmt.Errorf("%s key not set", tss.MasterKey.SimpleString())
 	}
 
-	oldMasterKey, ok := getOldMasterKey(ctx, k, s)
+	oldMasterKey, ok := getOldMasterKey(ctx, chain, k, s)
 	if !ok {
 		return types.AddressInfo{}, fmt.Errorf("cannot find the old %s key", tss.MasterKey.SimpleString())
 	}
 
 	externalMultisigThreshold := s.GetExternalMultisigThreshold(ctx)
-	externalKeys, err := getExternalKeys(ctx, k, s)
+	externalKeys, err := getExternalKeys(ctx, chain, s)
 	if err != nil {
 		return types.AddressInfo{}, err
 	}
@@ -1174,14 +1399,32 @@ func getMasterConsolidationAddress(ctx sdk.Context, k types.BTCKeeper, s types.S
 	)
 }
 
-func getOldMasterKey(ctx sdk.Context, k types.BTCKeeper, signer types.Signer) (tss.Key, bool) {
-	currRotationCount := signer.GetRotationCount(ctx, exported.Bitcoin, tss.MasterKey)
+func getOldMasterKey(ctx sdk.Context, chain nexus.Chain, k types.BTCKeeper, signer types.Signer) (tss.Key, bool) {
+	currRotationCount := signer.GetRotationCount(ctx, chain, tss.MasterKey)
 	oldMasterKeyRotationCount := currRotationCount - k.GetMasterKeyRetentionPeriod(ctx)
 	if oldMasterKeyRotationCount < 1 {
 		oldMasterKeyRotationCount = 1
 	}
 
-	return signer.GetKeyByRotationCount(ctx, exported.Bitcoin, tss.MasterKey, oldMasterKeyRotationCount)
+	return signer.GetKeyByRotationCount(ctx, chain, tss.MasterKey, oldMasterKeyRotationCount)
+}
+
+// getChain returns the registered and activated UTXO chain of the given name
+func getChain(ctx sdk.Context, n types.Nexus, chainName string) (nexus.Chain, error) {
+	chain, ok := n.GetChain(ctx, chainName)
+	if !ok {
+		return nexus.Chain{}, fmt.Errorf("%s is not a registered chain", chainName)
+	}
+
+	if chain.Module != types.ModuleName {
+		return nexus.Chain{}, fmt.Errorf("%s is not a UTXO chain handled by module %s", chain.Name, types.ModuleName)
+	}
+
+	if err := validateChainActivated(ctx, n, chain); err != nil {
+		return nexus.Chain{}, err
+	}
+
+	return chain, nil
 }
 
 func validateChainActivated(ctx sdk.Context, n types.Nexus, chain nexus.Chain) error {
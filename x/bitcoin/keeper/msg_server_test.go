@@ -2,9 +2,11 @@ package keeper_test
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	mathRand "math/rand"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/psbt"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gogoprototypes "github.com/gogo/protobuf/types"
@@ -57,13 +60,21 @@ func TestHandleMsgLink(t *testing.T) {
 		}
 
 		btcKeeper = &mock.BTCKeeperMock{
-			GetNetworkFunc:        func(ctx sdk.Context) types.Network { return types.Mainnet },
-			SetAddressInfoFunc:    func(sdk.Context, types.AddressInfo) {},
-			SetDepositAddressFunc: func(sdk.Context, nexus.CrossChainAddress, btcutil.Address) {},
-			LoggerFunc:            func(sdk.Context) log.Logger { return log.TestingLogger() },
+			GetMinRelayTxFeeRateFunc: func(sdk.Context) int64 { return types.DefaultParams().MinRelayTxFeeRate },
+			GetDustLimitFunc:         func(sdk.Context) btcutil.Amount { return btcutil.Amount(types.DefaultParams().DustLimit) },
+			GetNetworkFunc:           func(ctx sdk.Context) types.Network { return types.Mainnet },
+			SetAddressInfoFunc:       func(sdk.Context, types.AddressInfo) {},
+			SetDepositAddressFunc:    func(sdk.Context, nexus.CrossChainAddress, btcutil.Address) {},
+			LoggerFunc:               func(sdk.Context) log.Logger { return log.TestingLogger() },
 			GetMasterAddressExternalKeyLockDurationFunc: func(ctx sdk.Context) time.Duration {
 				return types.DefaultParams().MasterAddressExternalKeyLockDuration
 			},
+			GetDepositAddressExpiryPeriodFunc: func(sdk.Context) int64 { return types.DefaultParams().DepositAddressExpiryPeriod },
+			GetDepositAddressGracePeriodFunc:  func(sdk.Context) int64 { return types.DefaultParams().DepositAddressGracePeriod },
+			SetDepositAddressExpiryFunc:       func(sdk.Context, types.DepositAddressExpiry) {},
+			GetDepositAddressExpiryFunc: func(sdk.Context, string) (types.DepositAddressExpiry, bool) {
+				return types.DepositAddressExpiry{}, false
+			},
 		}
 		signer = &mock.SignerMock{
 			GetExternalMultisigThresholdFunc: func(ctx sdk.Context) utils.Threshold { return tsstypes.DefaultParams().ExternalMultisigThreshold },
@@ -93,6 +104,10 @@ func TestHandleMsgLink(t *testing.T) {
 				return chain == exported.Bitcoin
 			},
 			GetChainFunc: func(_ sdk.Context, chain string) (nexus.Chain, bool) {
+				if strings.EqualFold(chain, exported.Bitcoin.Name) {
+					return exported.Bitcoin, true
+				}
+
 				return nexus.Chain{
 					Name:                  chain,
 					NativeAsset:           rand.StrBetween(5, 20),
@@ -105,7 +120,7 @@ func TestHandleMsgLink(t *testing.T) {
 		}
 		ctx = rand.Context(nil)
 		msg = randomMsgLink()
-		server = bitcoinKeeper.NewMsgServerImpl(btcKeeper, signer, nexusKeeper, &mock.VoterMock{}, &mock.SnapshotterMock{})
+		server = bitcoinKeeper.NewMsgServerImpl(newBaseKeeperMock(btcKeeper), signer, nexusKeeper, &mock.VoterMock{}, &mock.SnapshotterMock{})
 	}
 	repeatCount := 20
 
@@ -116,11 +131,56 @@ func TestHandleMsgLink(t *testing.T) {
 		assert.Len(t, btcKeeper.SetAddressInfoCalls(), 1)
 		assert.Len(t, nexusKeeper.LinkAddressesCalls(), 1)
 		assert.Equal(t, exported.Bitcoin, signer.GetCurrentKeyCalls()[0].Chain)
-		assert.Equal(t, msg.RecipientChain, nexusKeeper.GetChainCalls()[0].Chain)
+		assert.Equal(t, msg.RecipientChain, nexusKeeper.GetChainCalls()[1].Chain)
 		assert.Equal(t, btcKeeper.SetAddressInfoCalls()[0].Address.Address, res.DepositAddr)
 		assert.Equal(t, types.Deposit, btcKeeper.SetAddressInfoCalls()[0].Address.Role)
 	}).Repeat(repeatCount))
 
+	t.Run("happy path with deposit address expiry", testutils.Func(func(t *testing.T) {
+		setup()
+		expiryPeriod := rand.I64Between(1, 100000)
+		gracePeriod := rand.I64Between(0, 10000)
+		btcKeeper.GetDepositAddressExpiryPeriodFunc = func(sdk.Context) int64 { return expiryPeriod }
+		btcKeeper.GetDepositAddressGracePeriodFunc = func(sdk.Context) int64 { return gracePeriod }
+
+		res, err := server.Link(sdk.WrapSDKContext(ctx), msg)
+		assert.NoError(t, err)
+		assert.Len(t, btcKeeper.SetDepositAddressExpiryCalls(), 1)
+
+		expiry := btcKeeper.SetDepositAddressExpiryCalls()[0].Expiry
+		assert.Equal(t, res.DepositAddr, expiry.Address)
+		assert.Equal(t, msg.RecipientAddr, expiry.Recipient.Address)
+		assert.Equal(t, ctx.BlockHeight()+expiryPeriod, expiry.ExpiresAt)
+		assert.Equal(t, ctx.BlockHeight()+expiryPeriod+gracePeriod, expiry.PruneAt)
+		assert.False(t, expiry.Funded)
+	}).Repeat(repeatCount))
+
+	t.Run("happy path re-linking a funded deposit address", testutils.Func(func(t *testing.T) {
+		setup()
+		expiryPeriod := rand.I64Between(1, 100000)
+		btcKeeper.GetDepositAddressExpiryPeriodFunc = func(sdk.Context) int64 { return expiryPeriod }
+		btcKeeper.GetDepositAddressExpiryFunc = func(_ sdk.Context, address string) (types.DepositAddressExpiry, bool) {
+			return types.DepositAddressExpiry{Address: address, ExpiresAt: ctx.BlockHeight() - 1, PruneAt: ctx.BlockHeight(), Funded: true}, true
+		}
+
+		_, err := server.Link(sdk.WrapSDKContext(ctx), msg)
+		assert.NoError(t, err)
+		assert.Len(t, btcKeeper.SetDepositAddressExpiryCalls(), 1)
+
+		expiry := btcKeeper.SetDepositAddressExpiryCalls()[0].Expiry
+		assert.Equal(t, ctx.BlockHeight()+expiryPeriod, expiry.ExpiresAt)
+		assert.True(t, expiry.Funded)
+	}).Repeat(repeatCount))
+
+	t.Run("happy path without deposit address expiry", testutils.Func(func(t *testing.T) {
+		setup()
+		btcKeeper.GetDepositAddressExpiryPeriodFunc = func(sdk.Context) int64 { return 0 }
+
+		_, err := server.Link(sdk.WrapSDKContext(ctx), msg)
+		assert.NoError(t, err)
+		assert.Len(t, btcKeeper.SetDepositAddressExpiryCalls(), 0)
+	}).Repeat(repeatCount))
+
 	t.Run("no master key", testutils.Func(func(t *testing.T) {
 		setup()
 		signer.GetCurrentKeyFunc = func(sdk.Context, nexus.Chain, tss.KeyRole) (tss.Key, bool) { return tss.Key{}, false }
@@ -156,6 +216,8 @@ func TestHandleMsgConfirmOutpoint(t *testing.T) {
 	setup := func() {
 		address := randomAddress()
 		btcKeeper = &mock.BTCKeeperMock{
+			GetMinRelayTxFeeRateFunc: func(sdk.Context) int64 { return types.DefaultParams().MinRelayTxFeeRate },
+			GetDustLimitFunc:         func(sdk.Context) btcutil.Amount { return btcutil.Amount(types.DefaultParams().DustLimit) },
 			GetOutPointInfoFunc: func(sdk.Context, wire.OutPoint) (types.OutPointInfo, types.OutPointState, bool) {
 				return types.OutPointInfo{}, 0, false
 			},
@@ -172,6 +234,9 @@ func TestHandleMsgConfirmOutpoint(t *testing.T) {
 			SetPendingOutpointInfoFunc:        func(sdk.Context, vote.PollKey, types.OutPointInfo) {},
 			GetVotingThresholdFunc:            func(ctx sdk.Context) utils.Threshold { return types.DefaultParams().VotingThreshold },
 			GetMinVoterCountFunc:              func(ctx sdk.Context) int64 { return types.DefaultParams().MinVoterCount },
+			GetDepositAddressExpiryFunc: func(sdk.Context, string) (types.DepositAddressExpiry, bool) {
+				return types.DepositAddressExpiry{}, false
+			},
 		}
 		voter = &mock.VoterMock{
 			InitializePollFunc: func(sdk.Context, vote.PollKey, []sdk.ValAddress, ...vote.PollProperty) error { return nil },
@@ -190,6 +255,9 @@ func TestHandleMsgConfirmOutpoint(t *testing.T) {
 			IsChainActivatedFunc: func(ctx sdk.Context, chain nexus.Chain) bool {
 				return chain == exported.Bitcoin
 			},
+			GetChainFunc: func(_ sdk.Context, chain string) (nexus.Chain, bool) {
+				return exported.Bitcoin, strings.EqualFold(chain, exported.Bitcoin.Name)
+			},
 			GetChainMaintainersFunc: func(ctx sdk.Context, chain nexus.Chain) []sdk.ValAddress {
 				return []sdk.ValAddress{}
 			},
@@ -198,7 +266,7 @@ func TestHandleMsgConfirmOutpoint(t *testing.T) {
 		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
 		msg = randomMsgConfirmOutpoint()
 		msg.OutPointInfo.Address = address.EncodeAddress()
-		server = bitcoinKeeper.NewMsgServerImpl(btcKeeper, signer, nexusMock, voter, &mock.SnapshotterMock{})
+		server = bitcoinKeeper.NewMsgServerImpl(newBaseKeeperMock(btcKeeper), signer, nexusMock, voter, &mock.SnapshotterMock{})
 	}
 
 	repeatCount := 20
@@ -251,6 +319,28 @@ func TestHandleMsgConfirmOutpoint(t *testing.T) {
 		assert.Error(t, err)
 	}).Repeat(repeatCount))
 
+	t.Run("deposit address expired within grace period", testutils.Func(func(t *testing.T) {
+		setup()
+		btcKeeper.GetDepositAddressExpiryFunc = func(sdk.Context, string) (types.DepositAddressExpiry, bool) {
+			return types.DepositAddressExpiry{Address: msg.OutPointInfo.Address, ExpiresAt: ctx.BlockHeight(), PruneAt: ctx.BlockHeight() + 1}, true
+		}
+
+		_, err := server.ConfirmOutpoint(sdk.WrapSDKContext(ctx), msg)
+		assert.NoError(t, err)
+		assert.Len(t, voter.InitializePollCalls(), 1)
+	}).Repeat(repeatCount))
+
+	t.Run("deposit address expired after grace period", testutils.Func(func(t *testing.T) {
+		setup()
+		btcKeeper.GetDepositAddressExpiryFunc = func(sdk.Context, string) (types.DepositAddressExpiry, bool) {
+			return types.DepositAddressExpiry{Address: msg.OutPointInfo.Address, ExpiresAt: ctx.BlockHeight() - 1, PruneAt: ctx.BlockHeight()}, true
+		}
+
+		_, err := server.ConfirmOutpoint(sdk.WrapSDKContext(ctx), msg)
+		assert.Error(t, err)
+		assert.Len(t, voter.InitializePollCalls(), 0)
+	}).Repeat(repeatCount))
+
 	t.Run("init poll failed", testutils.Func(func(t *testing.T) {
 		setup()
 
@@ -289,6 +379,8 @@ func TestHandleMsgVoteConfirmOutpoint(t *testing.T) {
 			KeyID:        tssTestUtils.RandKeyID(),
 		}
 		btcKeeper = &mock.BTCKeeperMock{
+			GetMinRelayTxFeeRateFunc:  func(sdk.Context) int64 { return types.DefaultParams().MinRelayTxFeeRate },
+			GetDustLimitFunc:          func(sdk.Context) btcutil.Amount { return btcutil.Amount(types.DefaultParams().DustLimit) },
 			GetTransactionFeeRateFunc: func(sdk.Context) sdk.Dec { return sdk.NewDecWithPrec(25, 5) },
 			GetOutPointInfoFunc: func(sdk.Context, wire.OutPoint) (types.OutPointInfo, types.OutPointState, bool) {
 				return types.OutPointInfo{}, 0, false
@@ -303,6 +395,11 @@ func TestHandleMsgVoteConfirmOutpoint(t *testing.T) {
 			GetUnconfirmedAmountFunc: func(sdk.Context, tss.KeyID) btcutil.Amount { return 0 },
 			SetUnconfirmedAmountFunc: func(sdk.Context, tss.KeyID, btcutil.Amount) {},
 			LoggerFunc:               func(sdk.Context) log.Logger { return log.TestingLogger() },
+			GetDepositAddressExpiryFunc: func(sdk.Context, string) (types.DepositAddressExpiry, bool) {
+				return types.DepositAddressExpiry{}, false
+			},
+			SetDepositAddressExpiryFunc:    func(sdk.Context, types.DepositAddressExpiry) {},
+			SetLateDepositOutpointInfoFunc: func(sdk.Context, types.OutPointInfo) {},
 		}
 		voter = &mock.VoterMock{
 			GetPollFunc: func(sdk.Context, vote.PollKey) vote.Poll {
@@ -321,6 +418,9 @@ func TestHandleMsgVoteConfirmOutpoint(t *testing.T) {
 			IsChainActivatedFunc: func(ctx sdk.Context, chain nexus.Chain) bool {
 				return chain == exported.Bitcoin
 			},
+			GetChainFunc: func(_ sdk.Context, chain string) (nexus.Chain, bool) {
+				return exported.Bitcoin, strings.EqualFold(chain, exported.Bitcoin.Name)
+			},
 			EnqueueForTransferFunc: func(sdk.Context, nexus.CrossChainAddress, sdk.Coin, sdk.Dec) error { return nil },
 			GetRecipientFunc: func(ctx sdk.Context, sender nexus.CrossChainAddress) (nexus.CrossChainAddress, bool) {
 				return nexus.CrossChainAddress{Chain: nexus.Chain{}, Address: ""}, true
@@ -357,7 +457,7 @@ func TestHandleMsgVoteConfirmOutpoint(t *testing.T) {
 		snapshotter := &mock.SnapshotterMock{GetOperatorFunc: func(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
 			return rand.ValAddr()
 		}}
-		server = bitcoinKeeper.NewMsgServerImpl(btcKeeper, signerKeeper, nexusKeeper, voter, snapshotter)
+		server = bitcoinKeeper.NewMsgServerImpl(newBaseKeeperMock(btcKeeper), signerKeeper, nexusKeeper, voter, snapshotter)
 	}
 
 	repeats := 20
@@ -396,6 +496,34 @@ func TestHandleMsgVoteConfirmOutpoint(t *testing.T) {
 		}), 1)
 	}).Repeat(repeats))
 
+	t.Run("happy path confirm deposit to deposit address before expiry", testutils.Func(func(t *testing.T) {
+		setup()
+		expiry := types.DepositAddressExpiry{Address: info.Address, ExpiresAt: ctx.BlockHeight() + 1, PruneAt: ctx.BlockHeight() + 2}
+		btcKeeper.GetDepositAddressExpiryFunc = func(sdk.Context, string) (types.DepositAddressExpiry, bool) { return expiry, true }
+
+		_, err := server.VoteConfirmOutpoint(sdk.WrapSDKContext(ctx), msg)
+		assert.NoError(t, err)
+		assert.Len(t, btcKeeper.SetDepositAddressExpiryCalls(), 1)
+		assert.True(t, btcKeeper.SetDepositAddressExpiryCalls()[0].Expiry.Funded)
+		assert.Len(t, btcKeeper.SetLateDepositOutpointInfoCalls(), 0)
+		assert.Equal(t, info, btcKeeper.SetConfirmedOutpointInfoCalls()[0].Info)
+		assert.Len(t, nexusKeeper.EnqueueForTransferCalls(), 1)
+	}).Repeat(repeats))
+
+	t.Run("happy path confirm late deposit to expired deposit address", testutils.Func(func(t *testing.T) {
+		setup()
+		expiry := types.DepositAddressExpiry{Address: info.Address, ExpiresAt: ctx.BlockHeight(), PruneAt: ctx.BlockHeight() + 1, Funded: true}
+		btcKeeper.GetDepositAddressExpiryFunc = func(sdk.Context, string) (types.DepositAddressExpiry, bool) { return expiry, true }
+
+		_, err := server.VoteConfirmOutpoint(sdk.WrapSDKContext(ctx), msg)
+		assert.NoError(t, err)
+		assert.Len(t, btcKeeper.SetDepositAddressExpiryCalls(), 0)
+		assert.Len(t, btcKeeper.SetLateDepositOutpointInfoCalls(), 1)
+		assert.Equal(t, info, btcKeeper.SetLateDepositOutpointInfoCalls()[0].Info)
+		assert.Len(t, btcKeeper.SetConfirmedOutpointInfoCalls(), 0)
+		assert.Len(t, nexusKeeper.EnqueueForTransferCalls(), 0)
+	}).Repeat(repeats))
+
 	t.Run("happy path confirm deposit to consolidation address", testutils.Func(func(t *testing.T) {
 		setup()
 		addr, _ := btcKeeper.GetAddressInfo(ctx, info.Address)
@@ -606,7 +734,9 @@ func TestCreateRescueTx(t *testing.T) {
 		oldMasterKey = createRandomKey(tss.MasterKey)
 
 		btcKeeper = &mock.BTCKeeperMock{
-			LoggerFunc: func(ctx sdk.Context) log.Logger { return log.TestingLogger() },
+			GetMinRelayTxFeeRateFunc: func(sdk.Context) int64 { return types.DefaultParams().MinRelayTxFeeRate },
+			GetDustLimitFunc:         func(sdk.Context) btcutil.Amount { return btcutil.Amount(types.DefaultParams().DustLimit) },
+			LoggerFunc:               func(ctx sdk.Context) log.Logger { return log.TestingLogger() },
 			GetUnsignedTxFunc: func(ctx sdk.Context, txType types.TxType) (types.UnsignedTx, bool) {
 				return types.UnsignedTx{}, false
 			},
@@ -618,6 +748,14 @@ func TestCreateRescueTx(t *testing.T) {
 					},
 				}
 			},
+			GetLateDepositOutpointInfoQueueFunc: func(ctx sdk.Context) utils.KVQueue {
+				return &utilsmock.KVQueueMock{
+					IsEmptyFunc: func() bool { return true },
+					DequeueFunc: func(value codec.ProtoMarshaler, filter ...func(value codec.ProtoMarshaler) bool) bool {
+						return false
+					},
+				}
+			},
 			GetMaxInputCountFunc: func(ctx sdk.Context) int64 {
 				return types.DefaultParams().MaxInputCount
 			},
@@ -669,15 +807,18 @@ func TestCreateRescueTx(t *testing.T) {
 			IsChainActivatedFunc: func(ctx sdk.Context, chain nexus.Chain) bool {
 				return chain == exported.Bitcoin
 			},
+			GetChainFunc: func(_ sdk.Context, chain string) (nexus.Chain, bool) {
+				return exported.Bitcoin, strings.EqualFold(chain, exported.Bitcoin.Name)
+			},
 		}
 		snapshotter := &mock.SnapshotterMock{}
-		server = bitcoinKeeper.NewMsgServerImpl(btcKeeper, signerKeeper, nexusKeeper, voter, snapshotter)
+		server = bitcoinKeeper.NewMsgServerImpl(newBaseKeeperMock(btcKeeper), signerKeeper, nexusKeeper, voter, snapshotter)
 	}
 
 	t.Run("shoud return error when no UTXO require", testutils.Func(func(t *testing.T) {
 		setup()
 
-		req := types.NewCreateRescueTxRequest(rand.AccAddr())
+		req := types.NewCreateRescueTxRequest(rand.AccAddr(), exported.Bitcoin.Name)
 		_, err := server.CreateRescueTx(sdk.WrapSDKContext(ctx), req)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "no rescue needed")
@@ -740,7 +881,7 @@ func TestCreateRescueTx(t *testing.T) {
 			}, true
 		}
 
-		req := types.NewCreateRescueTxRequest(rand.AccAddr())
+		req := types.NewCreateRescueTxRequest(rand.AccAddr(), exported.Bitcoin.Name)
 		_, err := server.CreateRescueTx(sdk.WrapSDKContext(ctx), req)
 		assert.NoError(t, err)
 
@@ -781,6 +922,65 @@ func TestCreateRescueTx(t *testing.T) {
 		assert.Greater(t, actualUnsignedTx.InternalTransferAmount, btcutil.Amount(0))
 	}).Repeat(repeat))
 
+	t.Run("should rescue UTXOs of late deposits to expired deposit addresses", testutils.Func(func(t *testing.T) {
+		setup()
+
+		var inputs []types.OutPointInfo
+		for i := 0; i < int(rand.I64Between(1, types.DefaultParams().MaxInputCount)); i++ {
+			inputs = append(inputs, randomOutpointInfo())
+		}
+
+		dequeueCount := 0
+		btcKeeper.GetLateDepositOutpointInfoQueueFunc = func(ctx sdk.Context) utils.KVQueue {
+			return &utilsmock.KVQueueMock{
+				IsEmptyFunc: func() bool { return dequeueCount >= len(inputs) },
+				DequeueFunc: func(value codec.ProtoMarshaler, filter ...func(value codec.ProtoMarshaler) bool) bool {
+					if dequeueCount >= len(inputs) {
+						return false
+					}
+
+					types.ModuleCdc.MustUnmarshalLengthPrefixed(
+						types.ModuleCdc.MustMarshalLengthPrefixed(&inputs[dequeueCount]),
+						value,
+					)
+
+					dequeueCount++
+					return true
+				},
+			}
+		}
+		btcKeeper.GetOutPointInfoFunc = func(ctx sdk.Context, outPoint wire.OutPoint) (types.OutPointInfo, types.OutPointState, bool) {
+			for _, input := range inputs {
+				if input.OutPoint == outPoint.String() {
+					return input, types.OutPointState_Spent, true
+				}
+			}
+
+			return types.OutPointInfo{}, types.OutPointState_None, false
+		}
+		btcKeeper.GetAddressInfoFunc = func(_ sdk.Context, encodedAddress string) (types.AddressInfo, bool) {
+			return types.AddressInfo{
+				Address:      encodedAddress,
+				RedeemScript: nil,
+				Role:         types.Deposit,
+				KeyID:        oldMasterKey.ID,
+			}, true
+		}
+
+		req := types.NewCreateRescueTxRequest(rand.AccAddr(), exported.Bitcoin.Name)
+		_, err := server.CreateRescueTx(sdk.WrapSDKContext(ctx), req)
+		assert.NoError(t, err)
+
+		assert.Len(t, btcKeeper.SetUnsignedTxCalls(), 1)
+		assert.Len(t, btcKeeper.SetSpentOutpointInfoCalls(), len(inputs))
+		actualUnsignedTx := btcKeeper.SetUnsignedTxCalls()[0].Tx
+		assert.Equal(t, types.Rescue, actualUnsignedTx.Type)
+		assert.Len(t, actualUnsignedTx.GetTx().TxIn, len(inputs))
+		for i, txIn := range actualUnsignedTx.GetTx().TxIn {
+			assert.Equal(t, txIn.PreviousOutPoint.String(), inputs[i].OutPoint)
+		}
+	}).Repeat(repeat))
+
 	t.Run("should rescue UTXOs of an old secondary key", testutils.Func(func(t *testing.T) {
 		setup()
 
@@ -841,7 +1041,7 @@ func TestCreateRescueTx(t *testing.T) {
 			}, true
 		}
 
-		req := types.NewCreateRescueTxRequest(rand.AccAddr())
+		req := types.NewCreateRescueTxRequest(rand.AccAddr(), exported.Bitcoin.Name)
 		_, err := server.CreateRescueTx(sdk.WrapSDKContext(ctx), req)
 		assert.NoError(t, err)
 
@@ -928,6 +1128,8 @@ func TestCreateMasterTx(t *testing.T) {
 		}
 
 		btcKeeper = &mock.BTCKeeperMock{
+			GetMinRelayTxFeeRateFunc: func(sdk.Context) int64 { return types.DefaultParams().MinRelayTxFeeRate },
+			GetDustLimitFunc:         func(sdk.Context) btcutil.Amount { return btcutil.Amount(types.DefaultParams().DustLimit) },
 			GetOutPointInfoFunc: func(ctx sdk.Context, outPoint wire.OutPoint) (types.OutPointInfo, types.OutPointState, bool) {
 				for _, input := range inputs {
 					if input.OutPoint == outPoint.String() {
@@ -1017,6 +1219,9 @@ func TestCreateMasterTx(t *testing.T) {
 			IsChainActivatedFunc: func(ctx sdk.Context, chain nexus.Chain) bool {
 				return chain == exported.Bitcoin
 			},
+			GetChainFunc: func(_ sdk.Context, chain string) (nexus.Chain, bool) {
+				return exported.Bitcoin, strings.EqualFold(chain, exported.Bitcoin.Name)
+			},
 		}
 		signerKeeper = &mock.SignerMock{
 			GetExternalKeyIDsFunc: func(ctx sdk.Context, chain nexus.Chain) ([]tss.KeyID, bool) {
@@ -1085,13 +1290,13 @@ func TestCreateMasterTx(t *testing.T) {
 			},
 		}
 		snapshotter := &mock.SnapshotterMock{}
-		server = bitcoinKeeper.NewMsgServerImpl(btcKeeper, signerKeeper, nexusKeeper, voter, snapshotter)
+		server = bitcoinKeeper.NewMsgServerImpl(newBaseKeeperMock(btcKeeper), signerKeeper, nexusKeeper, voter, snapshotter)
 	}
 
 	t.Run("shoud create master consolidation transaction without key assignment when the consolidation key is the current master key", testutils.Func(func(t *testing.T) {
 		setup()
 
-		req := types.NewCreateMasterTxRequest(rand.AccAddr(), string(masterKey.ID), 0)
+		req := types.NewCreateMasterTxRequest(rand.AccAddr(), exported.Bitcoin.Name, string(masterKey.ID), 0)
 		_, err := server.CreateMasterTx(sdk.WrapSDKContext(ctx), req)
 		assert.NoError(t, err)
 
@@ -1136,7 +1341,7 @@ func TestCreateMasterTx(t *testing.T) {
 	t.Run("should create master consolidation transaction sending no coin to the secondary key when the amount is not set", testutils.Func(func(t *testing.T) {
 		setup()
 
-		req := types.NewCreateMasterTxRequest(rand.AccAddr(), string(consolidationKey.ID), 0)
+		req := types.NewCreateMasterTxRequest(rand.AccAddr(), exported.Bitcoin.Name, string(consolidationKey.ID), 0)
 		_, err := server.CreateMasterTx(sdk.WrapSDKContext(ctx), req)
 		assert.NoError(t, err)
 
@@ -1182,6 +1387,21 @@ func TestCreateMasterTx(t *testing.T) {
 		assert.Equal(t, consolidationKey.ID, actualAssignNextKeyCall.KeyID)
 	}))
 
+	t.Run("should create master consolidation transaction rotating to the consolidation key without assigning it again when it is already the next master key", testutils.Func(func(t *testing.T) {
+		setup()
+		signerKeeper.GetNextKeyFunc = func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.Key, bool) {
+			return consolidationKey, keyRole == tss.MasterKey
+		}
+
+		req := types.NewCreateMasterTxRequest(rand.AccAddr(), exported.Bitcoin.Name, string(consolidationKey.ID), 0)
+		_, err := server.CreateMasterTx(sdk.WrapSDKContext(ctx), req)
+		assert.NoError(t, err)
+
+		assert.Len(t, btcKeeper.SetUnsignedTxCalls(), 1)
+		assert.True(t, btcKeeper.SetUnsignedTxCalls()[0].Tx.Info.RotateKey)
+		assert.Len(t, signerKeeper.AssignNextKeyCalls(), 0)
+	}))
+
 	t.Run("should create master consolidation transaction sending coins to the next secondary key when the amount is set and the next secondary key is already assigned", testutils.Func(func(t *testing.T) {
 		setup()
 
@@ -1194,7 +1414,7 @@ func TestCreateMasterTx(t *testing.T) {
 		}
 
 		secondaryKeyAmount := btcutil.Amount(rand.I64Between(1000, 10000))
-		req := types.NewCreateMasterTxRequest(rand.AccAddr(), string(consolidationKey.ID), secondaryKeyAmount)
+		req := types.NewCreateMasterTxRequest(rand.AccAddr(), exported.Bitcoin.Name, string(consolidationKey.ID), secondaryKeyAmount)
 		_, err := server.CreateMasterTx(sdk.WrapSDKContext(ctx), req)
 		assert.NoError(t, err)
 
@@ -1252,7 +1472,7 @@ func TestCreateMasterTx(t *testing.T) {
 		setup()
 
 		secondaryKeyAmount := btcutil.Amount(rand.I64Between(1000, 10000))
-		req := types.NewCreateMasterTxRequest(rand.AccAddr(), string(consolidationKey.ID), secondaryKeyAmount)
+		req := types.NewCreateMasterTxRequest(rand.AccAddr(), exported.Bitcoin.Name, string(consolidationKey.ID), secondaryKeyAmount)
 		_, err := server.CreateMasterTx(sdk.WrapSDKContext(ctx), req)
 		assert.NoError(t, err)
 
@@ -1333,7 +1553,7 @@ func TestCreateMasterTx(t *testing.T) {
 			return &utilsmock.KVQueueMock{}
 		}
 
-		req := types.NewCreateMasterTxRequest(rand.AccAddr(), string(consolidationKey.ID), 0)
+		req := types.NewCreateMasterTxRequest(rand.AccAddr(), exported.Bitcoin.Name, string(consolidationKey.ID), 0)
 		_, err := server.CreateMasterTx(sdk.WrapSDKContext(ctx), req)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "still has confirmed outpoints to spend, and spend is required before key rotation is allowed")
@@ -1346,7 +1566,7 @@ func TestCreateMasterTx(t *testing.T) {
 			return btcutil.Amount(rand.I64Between(1, 100))
 		}
 
-		req := types.NewCreateMasterTxRequest(rand.AccAddr(), string(consolidationKey.ID), 0)
+		req := types.NewCreateMasterTxRequest(rand.AccAddr(), exported.Bitcoin.Name, string(consolidationKey.ID), 0)
 		_, err := server.CreateMasterTx(sdk.WrapSDKContext(ctx), req)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "still has unconfirmed outpoints to confirm, and confirm and spend is required before key rotation is allowed")
@@ -1363,7 +1583,7 @@ func TestCreateMasterTx(t *testing.T) {
 			return types.UnsignedTx{}, false
 		}
 
-		req := types.NewCreateMasterTxRequest(rand.AccAddr(), string(consolidationKey.ID), 0)
+		req := types.NewCreateMasterTxRequest(rand.AccAddr(), exported.Bitcoin.Name, string(consolidationKey.ID), 0)
 		_, err := server.CreateMasterTx(sdk.WrapSDKContext(ctx), req)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "cannot assign the next master key while a secondary transaction is sending coin to the current master address")
@@ -1428,7 +1648,9 @@ func TestCreatePendingTransfersTx(t *testing.T) {
 		}
 
 		btcKeeper = &mock.BTCKeeperMock{
-			LoggerFunc: func(ctx sdk.Context) log.Logger { return log.TestingLogger() },
+			GetMinRelayTxFeeRateFunc: func(sdk.Context) int64 { return types.DefaultParams().MinRelayTxFeeRate },
+			GetDustLimitFunc:         func(sdk.Context) btcutil.Amount { return btcutil.Amount(types.DefaultParams().DustLimit) },
+			LoggerFunc:               func(ctx sdk.Context) log.Logger { return log.TestingLogger() },
 			GetOutPointInfoFunc: func(ctx sdk.Context, outPoint wire.OutPoint) (types.OutPointInfo, types.OutPointState, bool) {
 				for _, input := range inputs {
 					if input.OutPoint == outPoint.String() {
@@ -1510,6 +1732,9 @@ func TestCreatePendingTransfersTx(t *testing.T) {
 			IsChainActivatedFunc: func(ctx sdk.Context, chain nexus.Chain) bool {
 				return chain == exported.Bitcoin
 			},
+			GetChainFunc: func(_ sdk.Context, chain string) (nexus.Chain, bool) {
+				return exported.Bitcoin, strings.EqualFold(chain, exported.Bitcoin.Name)
+			},
 			GetTransfersForChainFunc: func(ctx sdk.Context, chain nexus.Chain, state nexus.TransferState) []nexus.CrossChainTransfer {
 				if chain == exported.Bitcoin && state == nexus.Pending {
 					return transfers
@@ -1587,13 +1812,13 @@ func TestCreatePendingTransfersTx(t *testing.T) {
 			GetKeyUnbondingLockingKeyRotationCountFunc: func(ctx sdk.Context) int64 { return tsstypes.DefaultParams().UnbondingLockingKeyRotationCount },
 		}
 		snapshotter := &mock.SnapshotterMock{}
-		server = bitcoinKeeper.NewMsgServerImpl(btcKeeper, signerKeeper, nexusKeeper, voter, snapshotter)
+		server = bitcoinKeeper.NewMsgServerImpl(newBaseKeeperMock(btcKeeper), signerKeeper, nexusKeeper, voter, snapshotter)
 	}
 
 	t.Run("shoud create secondary consolidation transaction without key assignment when the consolidation key is the current secondary key", testutils.Func(func(t *testing.T) {
 		setup()
 
-		req := types.NewCreatePendingTransfersTxRequest(rand.AccAddr(), string(secondaryKey.ID), 0)
+		req := types.NewCreatePendingTransfersTxRequest(rand.AccAddr(), exported.Bitcoin.Name, string(secondaryKey.ID), 0)
 		_, err := server.CreatePendingTransfersTx(sdk.WrapSDKContext(ctx), req)
 		assert.NoError(t, err)
 
@@ -1644,7 +1869,7 @@ func TestCreatePendingTransfersTx(t *testing.T) {
 	t.Run("should create secondary consolidation transaction sending no coin to the master key when the amount is not set", func(t *testing.T) {
 		setup()
 
-		req := types.NewCreatePendingTransfersTxRequest(rand.AccAddr(), string(consolidationKey.ID), 0)
+		req := types.NewCreatePendingTransfersTxRequest(rand.AccAddr(), exported.Bitcoin.Name, string(consolidationKey.ID), 0)
 		_, err := server.CreatePendingTransfersTx(sdk.WrapSDKContext(ctx), req)
 		assert.NoError(t, err)
 
@@ -1710,7 +1935,7 @@ func TestCreatePendingTransfersTx(t *testing.T) {
 
 		masterKeyAmount := btcutil.Amount(transfers[len(transfers)-1].Asset.Amount.Int64())
 		transfers = transfers[:len(transfers)-1]
-		req := types.NewCreatePendingTransfersTxRequest(rand.AccAddr(), string(consolidationKey.ID), masterKeyAmount)
+		req := types.NewCreatePendingTransfersTxRequest(rand.AccAddr(), exported.Bitcoin.Name, string(consolidationKey.ID), masterKeyAmount)
 		_, err := server.CreatePendingTransfersTx(sdk.WrapSDKContext(ctx), req)
 		assert.NoError(t, err)
 
@@ -1775,7 +2000,7 @@ func TestCreatePendingTransfersTx(t *testing.T) {
 
 		masterKeyAmount := btcutil.Amount(transfers[len(transfers)-1].Asset.Amount.Int64())
 		transfers = transfers[:len(transfers)-1]
-		req := types.NewCreatePendingTransfersTxRequest(rand.AccAddr(), string(consolidationKey.ID), masterKeyAmount)
+		req := types.NewCreatePendingTransfersTxRequest(rand.AccAddr(), exported.Bitcoin.Name, string(consolidationKey.ID), masterKeyAmount)
 		_, err := server.CreatePendingTransfersTx(sdk.WrapSDKContext(ctx), req)
 		assert.NoError(t, err)
 
@@ -1864,7 +2089,7 @@ func TestCreatePendingTransfersTx(t *testing.T) {
 			return &utilsmock.KVQueueMock{}
 		}
 
-		req := types.NewCreatePendingTransfersTxRequest(rand.AccAddr(), string(consolidationKey.ID), 0)
+		req := types.NewCreatePendingTransfersTxRequest(rand.AccAddr(), exported.Bitcoin.Name, string(consolidationKey.ID), 0)
 		_, err := server.CreatePendingTransfersTx(sdk.WrapSDKContext(ctx), req)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "still has confirmed outpoints to spend, and spend is required before key rotation is allowed")
@@ -1877,7 +2102,7 @@ func TestCreatePendingTransfersTx(t *testing.T) {
 			return btcutil.Amount(rand.I64Between(1, 100))
 		}
 
-		req := types.NewCreatePendingTransfersTxRequest(rand.AccAddr(), string(consolidationKey.ID), 0)
+		req := types.NewCreatePendingTransfersTxRequest(rand.AccAddr(), exported.Bitcoin.Name, string(consolidationKey.ID), 0)
 		_, err := server.CreatePendingTransfersTx(sdk.WrapSDKContext(ctx), req)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "still has unconfirmed outpoints to confirm, and confirm and spend is required before key rotation is allowed")
@@ -1894,7 +2119,7 @@ func TestCreatePendingTransfersTx(t *testing.T) {
 			return types.UnsignedTx{}, false
 		}
 
-		req := types.NewCreatePendingTransfersTxRequest(rand.AccAddr(), string(consolidationKey.ID), 0)
+		req := types.NewCreatePendingTransfersTxRequest(rand.AccAddr(), exported.Bitcoin.Name, string(consolidationKey.ID), 0)
 		_, err := server.CreatePendingTransfersTx(sdk.WrapSDKContext(ctx), req)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "cannot assign the next secondary key while a master transaction is sending coin to the current secondary address")
@@ -1929,6 +2154,196 @@ func assertTxOutputs(t *testing.T, tx *wire.MsgTx, outputs ...types.Output) {
 	}
 }
 
+func TestSubmitPsbt(t *testing.T) {
+	var (
+		btcKeeper    *mock.BTCKeeperMock
+		signerKeeper *mock.SignerMock
+		server       types.MsgServiceServer
+
+		ctx              sdk.Context
+		externalKeys     []tss.Key
+		externalPrivKeys []*btcec.PrivateKey
+		outPointInfo     types.OutPointInfo
+		addressInfo      types.AddressInfo
+		unsignedTx       types.UnsignedTx
+	)
+
+	repeat := 20
+	externalKeyCount := 6
+	externalKeyThreshold := 3
+
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+
+		externalKeys = nil
+		externalPrivKeys = nil
+		for i := 0; i < externalKeyCount; i++ {
+			externalPrivKey, err := btcec.NewPrivateKey(btcec.S256())
+			if err != nil {
+				panic(err)
+			}
+
+			externalPrivKeys = append(externalPrivKeys, externalPrivKey)
+			externalKeys = append(externalKeys, tss.Key{
+				ID:        tssTestUtils.RandKeyID(),
+				PublicKey: &tss.Key_ECDSAKey_{ECDSAKey: &tss.Key_ECDSAKey{Value: externalPrivKey.PubKey().SerializeCompressed()}},
+				Role:      tss.ExternalKey,
+			})
+		}
+
+		var err error
+		addressInfo, err = types.NewMasterConsolidationAddress(
+			createRandomKey(tss.MasterKey),
+			createRandomKey(tss.MasterKey),
+			int64(externalKeyThreshold),
+			externalKeys,
+			time.Now().AddDate(0, 0, 7),
+			time.Now().AddDate(0, 0, 14),
+			types.DefaultParams().Network,
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		outPointInfo = randomOutpointInfo()
+		outPointInfo.Address = addressInfo.Address
+
+		tx := wire.NewMsgTx(wire.TxVersion)
+		outPoint := outPointInfo.GetOutPoint()
+		tx.AddTxIn(wire.NewTxIn(&outPoint, nil, nil))
+		tx.AddTxOut(wire.NewTxOut(int64(outPointInfo.Amount)/2, randomAddress().ScriptAddress()))
+		unsignedTx = types.NewUnsignedTx(types.MasterConsolidation, types.EnableTimelock(tx, uint32(time.Now().Unix())), 0, 0)
+
+		btcKeeper = &mock.BTCKeeperMock{
+			GetMinRelayTxFeeRateFunc: func(sdk.Context) int64 { return types.DefaultParams().MinRelayTxFeeRate },
+			GetDustLimitFunc:         func(sdk.Context) btcutil.Amount { return btcutil.Amount(types.DefaultParams().DustLimit) },
+			LoggerFunc:               func(ctx sdk.Context) log.Logger { return log.TestingLogger() },
+			GetNetworkFunc:           func(sdk.Context) types.Network { return types.DefaultParams().Network },
+			GetUnsignedTxFunc: func(ctx sdk.Context, txType types.TxType) (types.UnsignedTx, bool) {
+				if txType == types.MasterConsolidation {
+					return unsignedTx, true
+				}
+
+				return types.UnsignedTx{}, false
+			},
+			GetOutPointInfoFunc: func(ctx sdk.Context, outPoint wire.OutPoint) (types.OutPointInfo, types.OutPointState, bool) {
+				if outPoint.String() == outPointInfo.OutPoint {
+					return outPointInfo, types.OutPointState_Spent, true
+				}
+
+				return types.OutPointInfo{}, 0, false
+			},
+			GetAddressInfoFunc: func(ctx sdk.Context, encodedAddress string) (types.AddressInfo, bool) {
+				if encodedAddress == addressInfo.Address {
+					return addressInfo, true
+				}
+
+				return types.AddressInfo{}, false
+			},
+		}
+		signerKeeper = &mock.SignerMock{
+			GetKeyFunc: func(ctx sdk.Context, keyID tss.KeyID) (tss.Key, bool) {
+				for _, externalKey := range externalKeys {
+					if externalKey.ID == keyID {
+						return externalKey, true
+					}
+				}
+
+				return tss.Key{}, false
+			},
+			SetSigFunc:        func(ctx sdk.Context, signature tss.Signature) {},
+			SetInfoForSigFunc: func(ctx sdk.Context, sigID string, info tss.SignInfo) {},
+		}
+		nexusKeeper := &mock.NexusMock{
+			IsChainActivatedFunc: func(ctx sdk.Context, chain nexus.Chain) bool {
+				return chain == exported.Bitcoin
+			},
+			GetChainFunc: func(_ sdk.Context, chain string) (nexus.Chain, bool) {
+				return exported.Bitcoin, strings.EqualFold(chain, exported.Bitcoin.Name)
+			},
+		}
+		server = bitcoinKeeper.NewMsgServerImpl(newBaseKeeperMock(btcKeeper), signerKeeper, nexusKeeper, &mock.VoterMock{}, &mock.SnapshotterMock{})
+	}
+
+	signPsbt := func(privKeys []*btcec.PrivateKey) ([]byte, []byte) {
+		tx := types.DisableTimelock(unsignedTx.GetTx())
+		outPointsToSign := []types.OutPointToSign{{OutPointInfo: outPointInfo, AddressInfo: addressInfo}}
+		packet, err := types.NewPsbt(tx, outPointsToSign, types.DefaultParams().Network)
+		if err != nil {
+			panic(err)
+		}
+
+		sigHash, err := txscript.CalcWitnessSigHash(addressInfo.RedeemScript, txscript.NewTxSigHashes(tx), txscript.SigHashAll, tx, 0, int64(outPointInfo.Amount))
+		if err != nil {
+			panic(err)
+		}
+
+		for _, privKey := range privKeys {
+			sig, err := privKey.Sign(sigHash)
+			if err != nil {
+				panic(err)
+			}
+
+			packet.Inputs[0].PartialSigs = append(packet.Inputs[0].PartialSigs, &psbt.PartialSig{
+				PubKey:    privKey.PubKey().SerializeCompressed(),
+				Signature: append(sig.Serialize(), byte(txscript.SigHashAll)),
+			})
+		}
+
+		bz, err := types.EncodePsbt(packet)
+		if err != nil {
+			panic(err)
+		}
+
+		return bz, sigHash
+	}
+
+	t.Run("should store the signatures of external keys in the psbt", testutils.Func(func(t *testing.T) {
+		setup()
+
+		bz, sigHash := signPsbt(externalPrivKeys[:externalKeyThreshold])
+		_, err := server.SubmitPsbt(sdk.WrapSDKContext(ctx), types.NewSubmitPsbtRequest(rand.AccAddr(), exported.Bitcoin.Name, bz))
+		assert.NoError(t, err)
+
+		assert.Len(t, signerKeeper.SetSigCalls(), externalKeyThreshold)
+		assert.Len(t, signerKeeper.SetInfoForSigCalls(), externalKeyThreshold)
+		var expectedSigIDs, actualSigIDs []string
+		for i, call := range signerKeeper.SetSigCalls() {
+			expectedSigIDs = append(expectedSigIDs, fmt.Sprintf("%s-%s", hex.EncodeToString(sigHash), externalKeys[i].ID))
+			actualSigIDs = append(actualSigIDs, call.Signature.SigID)
+			assert.Equal(t, tss.SigStatus_Signed, call.Signature.SigStatus)
+		}
+		assert.ElementsMatch(t, expectedSigIDs, actualSigIDs)
+	}).Repeat(repeat))
+
+	t.Run("should return error if the psbt contains a signature of an unknown key", testutils.Func(func(t *testing.T) {
+		setup()
+
+		privKey, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			panic(err)
+		}
+
+		bz, _ := signPsbt([]*btcec.PrivateKey{privKey})
+		_, err = server.SubmitPsbt(sdk.WrapSDKContext(ctx), types.NewSubmitPsbtRequest(rand.AccAddr(), exported.Bitcoin.Name, bz))
+		assert.Error(t, err)
+		assert.Len(t, signerKeeper.SetSigCalls(), 0)
+	}).Repeat(repeat))
+
+	t.Run("should return error if the psbt does not match any unsigned transaction", testutils.Func(func(t *testing.T) {
+		setup()
+
+		bz, _ := signPsbt(externalPrivKeys[:externalKeyThreshold])
+		btcKeeper.GetUnsignedTxFunc = func(ctx sdk.Context, txType types.TxType) (types.UnsignedTx, bool) {
+			return types.UnsignedTx{}, false
+		}
+
+		_, err := server.SubmitPsbt(sdk.WrapSDKContext(ctx), types.NewSubmitPsbtRequest(rand.AccAddr(), exported.Bitcoin.Name, bz))
+		assert.Error(t, err)
+		assert.Len(t, signerKeeper.SetSigCalls(), 0)
+	}).Repeat(repeat))
+}
+
 func createRandomKey(keyRole tss.KeyRole, rotatedAt ...time.Time) tss.Key {
 	privKey, err := btcec.NewPrivateKey(btcec.S256())
 	if err != nil {
@@ -1953,20 +2368,30 @@ func createRandomKey(keyRole tss.KeyRole, rotatedAt ...time.Time) tss.Key {
 	return key
 }
 
+func newBaseKeeperMock(btcKeeper types.BTCKeeper) *mock.BaseKeeperMock {
+	return &mock.BaseKeeperMock{
+		LoggerFunc:    func(sdk.Context) log.Logger { return log.TestingLogger() },
+		ForChainFunc:  func(string) types.BTCKeeper { return btcKeeper },
+		GetChainsFunc: func(sdk.Context) []string { return []string{exported.Bitcoin.Name} },
+	}
+}
+
 func randomMsgLink() *types.LinkRequest {
 	return types.NewLinkRequest(
 		rand.AccAddr(),
+		exported.Bitcoin.Name,
 		rand.StrBetween(5, 100),
 		rand.StrBetween(5, 100))
 }
 
 func randomMsgConfirmOutpoint() *types.ConfirmOutpointRequest {
-	return types.NewConfirmOutpointRequest(rand.AccAddr(), randomOutpointInfo())
+	return types.NewConfirmOutpointRequest(rand.AccAddr(), exported.Bitcoin.Name, randomOutpointInfo())
 }
 
 func randomMsgVoteConfirmOutpoint() *types.VoteConfirmOutpointRequest {
 	return types.NewVoteConfirmOutpointRequest(
 		rand.AccAddr(),
+		exported.Bitcoin.Name,
 		vote.PollKey{
 			Module: types.ModuleName,
 			ID:     rand.StrBetween(5, 20),
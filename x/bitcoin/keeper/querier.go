@@ -10,7 +10,6 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
 
-	"github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
 	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
@@ -27,35 +26,51 @@ const (
 	QLatestTxByTxType              = "latestTxByKeyRole"
 	QSignedTx                      = "signedTx"
 	QDepositStatus                 = "depositStatus"
+	QPsbtByTxType                  = "psbtByTxType"
+	QDepositAddressExpiry          = "depositAddressExpiry"
 )
 
 // NewQuerier returns a new querier for the Bitcoin module
-func NewQuerier(k types.BTCKeeper, s types.Signer, n types.Nexus) sdk.Querier {
+func NewQuerier(k types.BaseKeeper, s types.Signer, n types.Nexus) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		if len(path) < 2 {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "missing chain in query path")
+		}
+
+		chain, ok := n.GetChain(ctx, path[1])
+		if !ok || chain.Module != types.ModuleName {
+			return nil, sdkerrors.Wrap(types.ErrBitcoin, fmt.Sprintf("%s is not a registered UTXO chain", path[1]))
+		}
+		chainKeeper := k.ForChain(chain.Name)
+
 		var res []byte
 		var err error
 		switch path[0] {
 		case QDepositAddress:
-			res, err = QueryDepositAddress(ctx, k, n, req.Data)
+			res, err = QueryDepositAddress(ctx, chainKeeper, n, req.Data)
 		case QDepositStatus:
-			res, err = QueryDepositStatus(ctx, k, path[1])
+			res, err = QueryDepositStatus(ctx, chainKeeper, path[2])
 		case QConsolidationAddressByKeyRole:
-			res, err = QueryConsolidationAddressByKeyRole(ctx, k, s, path[1])
+			res, err = QueryConsolidationAddressByKeyRole(ctx, chain, chainKeeper, s, path[2])
 		case QConsolidationAddressByKeyID:
-			keyID := tss.KeyID(path[1])
+			keyID := tss.KeyID(path[2])
 			err = keyID.Validate()
 			if err != nil {
 				break
 			}
-			res, err = QueryConsolidationAddressByKeyID(ctx, k, s, keyID)
+			res, err = QueryConsolidationAddressByKeyID(ctx, chain, chainKeeper, s, keyID)
 		case QNextKeyID:
-			res, err = QueryNextKeyID(ctx, s, path[1])
+			res, err = QueryNextKeyID(ctx, chain, s, path[2])
 		case QMinOutputAmount:
-			res = QueryMinOutputAmount(ctx, k)
+			res = QueryMinOutputAmount(ctx, chainKeeper)
 		case QLatestTxByTxType:
-			res, err = QueryLatestTxByTxType(ctx, k, path[1])
+			res, err = QueryLatestTxByTxType(ctx, chainKeeper, path[2])
 		case QSignedTx:
-			res, err = QuerySignedTx(ctx, k, path[1])
+			res, err = QuerySignedTx(ctx, chainKeeper, path[2])
+		case QPsbtByTxType:
+			res, err = QueryPsbtByTxType(ctx, chainKeeper, path[2])
+		case QDepositAddressExpiry:
+			res, err = QueryDepositAddressExpiry(ctx, chainKeeper, path[2])
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("unknown query endpoint: %s", path[0]))
 		}
@@ -98,6 +113,27 @@ func QueryDepositStatus(ctx sdk.Context, k types.BTCKeeper, outpointStr string)
 	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
 }
 
+// QueryDepositAddressExpiry returns the expiry of the given deposit address
+func QueryDepositAddressExpiry(ctx sdk.Context, k types.BTCKeeper, encodedAddress string) ([]byte, error) {
+	addressInfo, ok := k.GetAddressInfo(ctx, encodedAddress)
+	if !ok {
+		return nil, fmt.Errorf("deposit address %s is unknown", encodedAddress)
+	}
+
+	if addressInfo.Role != types.Deposit {
+		return nil, fmt.Errorf("address %s is not a deposit address", encodedAddress)
+	}
+
+	resp := types.QueryDepositAddressExpiryResponse{Address: encodedAddress}
+	if expiry, ok := k.GetDepositAddressExpiry(ctx, encodedAddress); ok {
+		resp.ExpiresAt = expiry.ExpiresAt
+		resp.PruneAt = expiry.PruneAt
+		resp.Expired = expiry.IsExpired(ctx.BlockHeight())
+	}
+
+	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
+}
+
 // QueryDepositAddress returns deposit address
 func QueryDepositAddress(ctx sdk.Context, k types.BTCKeeper, n types.Nexus, data []byte) ([]byte, error) {
 	var params types.DepositQueryParams
@@ -130,22 +166,22 @@ func QueryDepositAddress(ctx sdk.Context, k types.BTCKeeper, n types.Nexus, data
 }
 
 // QueryConsolidationAddressByKeyRole returns the current consolidation address of the given key role
-func QueryConsolidationAddressByKeyRole(ctx sdk.Context, k types.BTCKeeper, s types.Signer, keyRoleStr string) ([]byte, error) {
+func QueryConsolidationAddressByKeyRole(ctx sdk.Context, chain nexus.Chain, k types.BTCKeeper, s types.Signer, keyRoleStr string) ([]byte, error) {
 	keyRole, err := tss.KeyRoleFromSimpleStr(keyRoleStr)
 	if err != nil {
 		return nil, err
 	}
 
-	keyID, ok := s.GetCurrentKeyID(ctx, exported.Bitcoin, keyRole)
+	keyID, ok := s.GetCurrentKeyID(ctx, chain, keyRole)
 	if !ok {
 		return nil, fmt.Errorf("%s key not found", keyRoleStr)
 	}
 
-	return QueryConsolidationAddressByKeyID(ctx, k, s, keyID)
+	return QueryConsolidationAddressByKeyID(ctx, chain, k, s, keyID)
 }
 
 // QueryConsolidationAddressByKeyID returns the consolidation address of the given key ID
-func QueryConsolidationAddressByKeyID(ctx sdk.Context, k types.BTCKeeper, s types.Signer, keyID tss.KeyID) ([]byte, error) {
+func QueryConsolidationAddressByKeyID(ctx sdk.Context, chain nexus.Chain, k types.BTCKeeper, s types.Signer, keyID tss.KeyID) ([]byte, error) {
 
 	key, ok := s.GetKey(ctx, keyID)
 	if !ok {
@@ -157,7 +193,7 @@ func QueryConsolidationAddressByKeyID(ctx sdk.Context, k types.BTCKeeper, s type
 
 	switch key.Role {
 	case tss.MasterKey:
-		addressInfo, err = getMasterConsolidationAddress(ctx, k, s, key)
+		addressInfo, err = getMasterConsolidationAddress(ctx, chain, k, s, key)
 	case tss.SecondaryKey:
 		addressInfo, err = getSecondaryConsolidationAddress(ctx, k, key)
 	default:
@@ -174,13 +210,13 @@ func QueryConsolidationAddressByKeyID(ctx sdk.Context, k types.BTCKeeper, s type
 }
 
 // QueryNextKeyID returns the next key ID of the given key role
-func QueryNextKeyID(ctx sdk.Context, s types.Signer, keyRoleStr string) ([]byte, error) {
+func QueryNextKeyID(ctx sdk.Context, chain nexus.Chain, s types.Signer, keyRoleStr string) ([]byte, error) {
 	keyRole, err := tss.KeyRoleFromSimpleStr(keyRoleStr)
 	if err != nil {
 		return nil, err
 	}
 
-	next, nextAssigned := s.GetNextKey(ctx, exported.Bitcoin, keyRole)
+	next, nextAssigned := s.GetNextKey(ctx, chain, keyRole)
 	if !nextAssigned {
 		return []byte{}, nil
 	}
@@ -309,3 +345,40 @@ func QuerySignedTx(ctx sdk.Context, k types.BTCKeeper, txHashHex string) ([]byte
 
 	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
 }
+
+// QueryPsbtByTxType returns the unsigned transaction of the given tx type as a partially signed bitcoin transaction (BIP-174)
+// for external signers. Lock time is disabled because external signatures are only required when the timelock has not expired yet
+func QueryPsbtByTxType(ctx sdk.Context, k types.BTCKeeper, txTypeStr string) ([]byte, error) {
+	txType, err := types.TxTypeFromSimpleStr(txTypeStr)
+	if err != nil {
+		return nil, err
+	}
+
+	unsignedTx, ok := k.GetUnsignedTx(ctx, txType)
+	if !ok {
+		return nil, fmt.Errorf("no unsigned %s transaction exists", txType.SimpleString())
+	}
+
+	tx := types.DisableTimelock(unsignedTx.GetTx())
+	outPointsToSign, err := getOutPointsToSign(ctx, tx, k)
+	if err != nil {
+		return nil, err
+	}
+
+	packet, err := types.NewPsbt(tx, outPointsToSign, k.GetNetwork(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := types.EncodePsbt(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := types.QueryPsbtResponse{
+		Psbt:   bz,
+		Status: unsignedTx.Status,
+	}
+
+	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
@@ -16,6 +17,7 @@ import (
 	"github.com/axelarnetwork/axelar-core/testutils"
 	"github.com/axelarnetwork/axelar-core/testutils/rand"
 	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
 	"github.com/axelarnetwork/axelar-core/x/bitcoin/keeper"
 	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
 	"github.com/axelarnetwork/axelar-core/x/bitcoin/types/mock"
@@ -156,7 +158,7 @@ func TestQueryConsolidationAddressByKeyID(t *testing.T) {
 
 		signer.GetKeyFunc = func(ctx sdk.Context, keyID tss.KeyID) (tss.Key, bool) { return tss.Key{}, false }
 
-		_, err := keeper.QueryConsolidationAddressByKeyID(ctx, btcKeeper, signer, keyID)
+		_, err := keeper.QueryConsolidationAddressByKeyID(ctx, exported.Bitcoin, btcKeeper, signer, keyID)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), fmt.Sprintf("no key with keyID %s found", keyID))
@@ -255,7 +257,7 @@ func TestQueryConsolidationAddressByKeyID(t *testing.T) {
 			KeyID:   masterKey.ID,
 		}
 
-		bz, err := keeper.QueryConsolidationAddressByKeyID(ctx, btcKeeper, signer, keyID)
+		bz, err := keeper.QueryConsolidationAddressByKeyID(ctx, exported.Bitcoin, btcKeeper, signer, keyID)
 		assert.NoError(t, err)
 
 		var actual types.QueryAddressResponse
@@ -293,7 +295,7 @@ func TestQueryConsolidationAddressByKeyID(t *testing.T) {
 			KeyID:   secondaryKey.ID,
 		}
 
-		bz, err := keeper.QueryConsolidationAddressByKeyID(ctx, btcKeeper, signer, keyID)
+		bz, err := keeper.QueryConsolidationAddressByKeyID(ctx, exported.Bitcoin, btcKeeper, signer, keyID)
 
 		var actual types.QueryAddressResponse
 		types.ModuleCdc.MustUnmarshalLengthPrefixed(bz, &actual)
@@ -302,3 +304,152 @@ func TestQueryConsolidationAddressByKeyID(t *testing.T) {
 		assert.Equal(t, expected, actual)
 	}))
 }
+
+func TestQueryPsbtByTxType(t *testing.T) {
+	var (
+		btcKeeper *mock.BTCKeeperMock
+		ctx       sdk.Context
+
+		outPointInfo types.OutPointInfo
+		addressInfo  types.AddressInfo
+		unsignedTx   types.UnsignedTx
+	)
+
+	setup := func() {
+		ctx = rand.Context(nil)
+
+		var err error
+		addressInfo, err = types.NewSecondaryConsolidationAddress(createRandomKey(tss.SecondaryKey), types.DefaultParams().Network)
+		if err != nil {
+			panic(err)
+		}
+
+		outPointInfo = randomOutpointInfo()
+		outPointInfo.Address = addressInfo.Address
+
+		tx := wire.NewMsgTx(wire.TxVersion)
+		outPoint := outPointInfo.GetOutPoint()
+		tx.AddTxIn(wire.NewTxIn(&outPoint, nil, nil))
+		tx.AddTxOut(wire.NewTxOut(int64(outPointInfo.Amount)/2, randomAddress().ScriptAddress()))
+		unsignedTx = types.NewUnsignedTx(types.SecondaryConsolidation, types.EnableTimelock(tx, uint32(time.Now().Unix())), 0, 0)
+
+		btcKeeper = &mock.BTCKeeperMock{
+			GetUnsignedTxFunc: func(ctx sdk.Context, txType types.TxType) (types.UnsignedTx, bool) {
+				if txType == types.SecondaryConsolidation {
+					return unsignedTx, true
+				}
+
+				return types.UnsignedTx{}, false
+			},
+			GetOutPointInfoFunc: func(ctx sdk.Context, outPoint wire.OutPoint) (types.OutPointInfo, types.OutPointState, bool) {
+				return outPointInfo, types.OutPointState_Spent, true
+			},
+			GetAddressInfoFunc: func(ctx sdk.Context, encodedAddress string) (types.AddressInfo, bool) {
+				return addressInfo, true
+			},
+			GetNetworkFunc: func(sdk.Context) types.Network { return types.DefaultParams().Network },
+		}
+	}
+
+	t.Run("should return the psbt of the unsigned transaction with lock time disabled", testutils.Func(func(t *testing.T) {
+		setup()
+
+		bz, err := keeper.QueryPsbtByTxType(ctx, btcKeeper, types.SecondaryConsolidation.SimpleString())
+		assert.NoError(t, err)
+
+		var res types.QueryPsbtResponse
+		types.ModuleCdc.MustUnmarshalLengthPrefixed(bz, &res)
+		assert.Equal(t, types.Created, res.Status)
+
+		packet, err := types.DecodePsbt(res.Psbt)
+		assert.NoError(t, err)
+		assert.Equal(t, types.DisableTimelock(unsignedTx.GetTx()).TxHash(), packet.UnsignedTx.TxHash())
+		assert.Len(t, packet.Inputs, 1)
+		assert.Equal(t, []byte(addressInfo.RedeemScript), packet.Inputs[0].WitnessScript)
+		assert.Equal(t, int64(outPointInfo.Amount), packet.Inputs[0].WitnessUtxo.Value)
+	}).Repeat(20))
+
+	t.Run("should return error if no unsigned transaction exists", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := keeper.QueryPsbtByTxType(ctx, btcKeeper, types.MasterConsolidation.SimpleString())
+		assert.Error(t, err)
+	}))
+}
+
+func TestQueryDepositAddressExpiry(t *testing.T) {
+	var (
+		btcKeeper *mock.BTCKeeperMock
+		ctx       sdk.Context
+
+		addressInfo types.AddressInfo
+		expiry      types.DepositAddressExpiry
+		hasExpiry   bool
+	)
+
+	setup := func() {
+		ctx = rand.Context(nil)
+
+		addressInfo = types.AddressInfo{
+			Address: randomAddress().EncodeAddress(),
+			Role:    types.Deposit,
+			KeyID:   tss.KeyID(rand.StrBetween(5, 20)),
+		}
+		expiry = types.DepositAddressExpiry{
+			Address:   addressInfo.Address,
+			ExpiresAt: ctx.BlockHeight() + rand.I64Between(-100, 100),
+		}
+		expiry.PruneAt = expiry.ExpiresAt + rand.I64Between(0, 100)
+		hasExpiry = true
+
+		btcKeeper = &mock.BTCKeeperMock{
+			GetAddressInfoFunc: func(ctx sdk.Context, encodedAddress string) (types.AddressInfo, bool) {
+				return addressInfo, encodedAddress == addressInfo.Address
+			},
+			GetDepositAddressExpiryFunc: func(ctx sdk.Context, encodedAddress string) (types.DepositAddressExpiry, bool) {
+				return expiry, hasExpiry && encodedAddress == expiry.Address
+			},
+		}
+	}
+
+	t.Run("should return the expiry of the deposit address", testutils.Func(func(t *testing.T) {
+		setup()
+
+		bz, err := keeper.QueryDepositAddressExpiry(ctx, btcKeeper, addressInfo.Address)
+		assert.NoError(t, err)
+
+		var res types.QueryDepositAddressExpiryResponse
+		types.ModuleCdc.MustUnmarshalLengthPrefixed(bz, &res)
+		assert.Equal(t, addressInfo.Address, res.Address)
+		assert.Equal(t, expiry.ExpiresAt, res.ExpiresAt)
+		assert.Equal(t, expiry.PruneAt, res.PruneAt)
+		assert.Equal(t, ctx.BlockHeight() >= expiry.ExpiresAt, res.Expired)
+	}).Repeat(20))
+
+	t.Run("should return no expiry if the deposit address never expires", testutils.Func(func(t *testing.T) {
+		setup()
+		hasExpiry = false
+
+		bz, err := keeper.QueryDepositAddressExpiry(ctx, btcKeeper, addressInfo.Address)
+		assert.NoError(t, err)
+
+		var res types.QueryDepositAddressExpiryResponse
+		types.ModuleCdc.MustUnmarshalLengthPrefixed(bz, &res)
+		assert.Equal(t, types.QueryDepositAddressExpiryResponse{Address: addressInfo.Address}, res)
+	}))
+
+	t.Run("should return error if the address is unknown", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := keeper.QueryDepositAddressExpiry(ctx, btcKeeper, randomAddress().EncodeAddress())
+		assert.Error(t, err)
+	}))
+
+	t.Run("should return error if the address is not a deposit address", testutils.Func(func(t *testing.T) {
+		setup()
+		addressInfo.Role = types.Consolidation
+
+		_, err := keeper.QueryDepositAddressExpiry(ctx, btcKeeper, addressInfo.Address)
+		assert.Error(t, err)
+	}))
+}
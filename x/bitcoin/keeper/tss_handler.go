@@ -13,8 +13,8 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 
-	"github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
 	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 )
 
 type signingAbortError struct {
@@ -27,16 +27,24 @@ func (e *signingAbortError) Error() string {
 }
 
 // NewTssHandler returns the handler for processing signatures delivered by the tss module
-func NewTssHandler(keeper types.BTCKeeper, signer types.Signer) tss.Handler {
+func NewTssHandler(keeper types.BaseKeeper, nexus types.Nexus, signer types.Signer) tss.Handler {
 	return func(ctx sdk.Context, info tss.SignInfo) error {
-		for _, txType := range types.GetTxTypes() {
-			handleUnsignedTxForTxType(ctx, keeper, signer, txType)
+		for _, chainName := range keeper.GetChains(ctx) {
+			chain, ok := nexus.GetChain(ctx, chainName)
+			if !ok {
+				keeper.Logger(ctx).Error(fmt.Sprintf("%s is not a registered chain", chainName))
+				continue
+			}
+
+			for _, txType := range types.GetTxTypes() {
+				handleUnsignedTxForTxType(ctx, chain, keeper.ForChain(chain.Name), signer, txType)
+			}
 		}
 		return nil
 	}
 }
 
-func handleUnsignedTxForTxType(ctx sdk.Context, keeper types.BTCKeeper, signer types.Signer, txType types.TxType) {
+func handleUnsignedTxForTxType(ctx sdk.Context, chain nexus.Chain, keeper types.BTCKeeper, signer types.Signer, txType types.TxType) {
 	unsignedTx, ok := keeper.GetUnsignedTx(ctx, txType)
 	if !ok || !unsignedTx.Is(types.Signing) {
 		keeper.Logger(ctx).Debug(fmt.Sprintf("no unsigned %s transaction ready", txType.SimpleString()))
@@ -49,6 +57,7 @@ func handleUnsignedTxForTxType(ctx sdk.Context, keeper types.BTCKeeper, signer t
 		case *signingAbortError:
 			ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeConsolidationTx,
 				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
 				sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueSigningAborted),
 				sdk.NewAttribute(types.AttributeTxType, txType.SimpleString()),
 			))
@@ -84,6 +93,7 @@ func handleUnsignedTxForTxType(ctx sdk.Context, keeper types.BTCKeeper, signer t
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(types.EventTypeOutpointConfirmation,
 					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
 					sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueConfirm),
 					sdk.NewAttribute(types.AttributeKeyOutPointInfo, string(types.ModuleCdc.MustMarshalJSON(&outPoint))),
 				),
@@ -105,7 +115,7 @@ func handleUnsignedTxForTxType(ctx sdk.Context, keeper types.BTCKeeper, signer t
 			return
 		}
 
-		if err := signer.RotateKey(ctx, exported.Bitcoin, keyRole); err != nil {
+		if err := signer.RotateKey(ctx, chain, keyRole); err != nil {
 			keeper.Logger(ctx).Error(sdkerrors.Wrap(err, fmt.Sprintf("failed to rotate to the next %s key", keyRole.SimpleString())).Error())
 			return
 		}
@@ -118,6 +128,7 @@ func handleUnsignedTxForTxType(ctx sdk.Context, keeper types.BTCKeeper, signer t
 	// Notify that consolidation tx can be queried
 	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeConsolidationTx,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
 		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueSigned),
 		sdk.NewAttribute(types.AttributeTxType, txType.SimpleString()),
 	))
@@ -165,7 +176,7 @@ func assembleTx(ctx sdk.Context, k types.BTCKeeper, signer types.Signer, unsigne
 		sigs = append(sigs, sigsForOutPoint)
 	}
 
-	signedTx, err := types.AssembleBtcTx(tx, outPointsToSign, sigs)
+	signedTx, err := types.AssembleBtcTx(tx, outPointsToSign, sigs, k.GetNetwork(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -176,11 +187,7 @@ func assembleTx(ctx sdk.Context, k types.BTCKeeper, signer types.Signer, unsigne
 func getKnownOutPoints(ctx sdk.Context, k types.BTCKeeper, signedTx *wire.MsgTx) ([]types.OutPointInfo, error) {
 	var knownOutPoints []types.OutPointInfo
 
-	networkName := k.GetNetwork(ctx).Name
-	network, err := types.NetworkFromStr(networkName)
-	if err != nil {
-		return nil, sdkerrors.Wrap(err, fmt.Sprintf("failed to get network %s", networkName))
-	}
+	network := k.GetNetwork(ctx)
 
 	txHash := signedTx.TxHash()
 	// Find all outpoints that axelar controls the keys of
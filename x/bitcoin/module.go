@@ -80,7 +80,7 @@ func (AppModuleBasic) Name() string {
 // AppModule implements module.AppModule
 type AppModule struct {
 	AppModuleBasic
-	keeper      types.BTCKeeper
+	keeper      types.BaseKeeper
 	voter       types.Voter
 	signer      types.Signer
 	nexus       types.Nexus
@@ -88,7 +88,7 @@ type AppModule struct {
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(k types.BTCKeeper, voter types.Voter, signer types.Signer, nexus types.Nexus, snapshotter types.Snapshotter) AppModule {
+func NewAppModule(k types.BaseKeeper, voter types.Voter, signer types.Signer, nexus types.Nexus, snapshotter types.Snapshotter) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         k,
@@ -100,8 +100,8 @@ func NewAppModule(k types.BTCKeeper, voter types.Voter, signer types.Signer, nex
 }
 
 // RegisterInvariants registers this module's invariants
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
-	// No invariants yet
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // InitGenesis initializes the module's keeper from the given genesis state
@@ -143,7 +143,7 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 
 // EndBlock executes all state transitions this module requires at the end of each new block
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
-	return EndBlocker(ctx, req, am.keeper, am.signer)
+	return EndBlocker(ctx, req, am.keeper, am.nexus, am.signer, am.snapshotter)
 }
 
 // RegisterServices registers a GRPC query service to respond to the
@@ -10,4 +10,6 @@ const (
 	ErrMinOutputAmount   = "could not resolve the minimum output amount allowed"
 	ErrLatestTx          = "could not resolve the latest consolidation transaction"
 	ErrSignedTx          = "could not resolve the signed consolidation transaction"
+	ErrPsbt              = "could not resolve the partially signed consolidation transaction"
+	ErrDepositAddrExpiry = "could not resolve the deposit address expiry"
 )
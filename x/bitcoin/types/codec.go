@@ -19,6 +19,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&CreateRescueTxRequest{}, "bitcoin/CreateRescueTx", nil)
 	cdc.RegisterConcrete(&SignTxRequest{}, "bitcoin/SignTx", nil)
 	cdc.RegisterConcrete(&SubmitExternalSignatureRequest{}, "bitcoin/SubmitExternalSignature", nil)
+	cdc.RegisterConcrete(&SubmitPsbtRequest{}, "bitcoin/SubmitPsbt", nil)
 }
 
 // RegisterInterfaces registers types and interfaces with the given registry
@@ -32,6 +33,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&CreateRescueTxRequest{},
 		&SignTxRequest{},
 		&SubmitExternalSignatureRequest{},
+		&SubmitPsbtRequest{},
 	)
 	registry.RegisterImplementations((*codec.ProtoMarshaler)(nil),
 		&gogoprototypes.BoolValue{},
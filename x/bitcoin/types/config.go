@@ -2,10 +2,13 @@ package types
 
 import (
 	"time"
+
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
 )
 
 // BtcConfig - configuration for bitcoin client
 type BtcConfig struct {
+	Name           string        `mapstructure:"name"`
 	RPCAddr        string        `mapstructure:"rpc_addr"`
 	RPCUser        string        `mapstructure:"rpc_user"`
 	RPCPass        string        `mapstructure:"rpc_pass"`
@@ -17,6 +20,7 @@ type BtcConfig struct {
 // DefaultConfig returns a BtcConfig with default values
 func DefaultConfig() BtcConfig {
 	return BtcConfig{
+		Name:           exported.Bitcoin.Name,
 		RPCAddr:        "localhost:8332",
 		RPCTimeout:     60 * time.Second,
 		StartUpTimeout: 100 * time.Second,
@@ -8,6 +8,7 @@ const (
 	EventTypeOutpointConfirmation = "outpointConfirmation"
 	EventTypeLink                 = "link"
 	EventTypeWithdrawal           = "withdrawal"
+	EventTypeDepositAddress       = "depositAddress"
 )
 
 // Event attribute keys
@@ -24,8 +25,10 @@ const (
 	AttributeKeySecondaryKeyID     = "secondaryKeyId"
 	AttributeKeyDepositAddress     = "depositAddress"
 	AttributeKeyDestinationAddress = "destinationAddress"
+	AttributeKeyChain              = "chain"
 	AttributeKeyDestinationChain   = "destinationChain"
 	AttributeKeyValue              = "value"
+	AttributeKeyExpiresAt          = "expiresAt"
 )
 
 // Event attribute values
@@ -41,4 +44,5 @@ const (
 	AttributeValueReject         = "reject"
 	AttributeValueFailed         = "failed"
 	AttributeValueVoted          = "voted"
+	AttributeValuePruned         = "pruned"
 )
@@ -7,6 +7,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	params "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/axelarnetwork/axelar-core/utils"
@@ -17,11 +18,20 @@ import (
 	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
 )
 
-//go:generate moq -pkg mock -out ./mock/expected_keepers.go . Voter Signer Nexus Snapshotter BTCKeeper
+//go:generate moq -pkg mock -out ./mock/expected_keepers.go . Voter Signer Nexus Snapshotter BaseKeeper BTCKeeper
 
-// BTCKeeper is implemented by this module's keeper
+// BaseKeeper is implemented by this module's base keeper
+type BaseKeeper interface {
+	Logger(ctx sdk.Context) log.Logger
+
+	ForChain(chain string) BTCKeeper
+	GetChains(ctx sdk.Context) []string
+}
+
+// BTCKeeper is implemented by this module's chain keeper
 type BTCKeeper interface {
 	Logger(ctx sdk.Context) log.Logger
+	GetName() string
 	SetParams(ctx sdk.Context, p Params)
 	GetParams(ctx sdk.Context) Params
 
@@ -40,6 +50,11 @@ type BTCKeeper interface {
 	GetMinVoterCount(ctx sdk.Context) int64
 	GetMaxTxSize(ctx sdk.Context) int64
 	GetTransactionFeeRate(ctx sdk.Context) sdk.Dec
+	GetDepositAddressExpiryPeriod(ctx sdk.Context) int64
+	GetDepositAddressGracePeriod(ctx sdk.Context) int64
+	GetBaseDenom(ctx sdk.Context) string
+	GetDustLimit(ctx sdk.Context) btcutil.Amount
+	GetMinRelayTxFeeRate(ctx sdk.Context) int64
 
 	SetPendingOutpointInfo(ctx sdk.Context, key vote.PollKey, info OutPointInfo)
 	GetPendingOutPointInfo(ctx sdk.Context, key vote.PollKey) (OutPointInfo, bool)
@@ -49,6 +64,9 @@ type BTCKeeper interface {
 	SetSpentOutpointInfo(ctx sdk.Context, info OutPointInfo)
 	SetConfirmedOutpointInfo(ctx sdk.Context, keyID tss.KeyID, info OutPointInfo)
 	GetConfirmedOutpointInfoQueueForKey(ctx sdk.Context, keyID tss.KeyID) utils.KVQueue
+	GetConfirmedOutPointInfos(ctx sdk.Context) []OutPointInfo
+	SetLateDepositOutpointInfo(ctx sdk.Context, info OutPointInfo)
+	GetLateDepositOutpointInfoQueue(ctx sdk.Context) utils.KVQueue
 
 	SetUnsignedTx(ctx sdk.Context, tx UnsignedTx)
 	GetUnsignedTx(ctx sdk.Context, txType TxType) (UnsignedTx, bool)
@@ -60,9 +78,15 @@ type BTCKeeper interface {
 
 	SetAddressInfo(ctx sdk.Context, address AddressInfo)
 	GetAddressInfo(ctx sdk.Context, encodedAddress string) (AddressInfo, bool)
+	DeleteAddressInfo(ctx sdk.Context, encodedAddress string)
 
 	SetDepositAddress(ctx sdk.Context, recipient nexus.CrossChainAddress, address btcutil.Address)
 	GetDepositAddress(ctx sdk.Context, recipient nexus.CrossChainAddress) (btcutil.Address, error)
+	DeleteDepositAddress(ctx sdk.Context, recipient nexus.CrossChainAddress)
+	SetDepositAddressExpiry(ctx sdk.Context, expiry DepositAddressExpiry)
+	GetDepositAddressExpiry(ctx sdk.Context, encodedAddress string) (DepositAddressExpiry, bool)
+	DeleteDepositAddressExpiry(ctx sdk.Context, encodedAddress string)
+	GetDepositAddressExpiryQueue(ctx sdk.Context) utils.KVQueue
 
 	SetUnconfirmedAmount(ctx sdk.Context, keyID tss.KeyID, amount btcutil.Amount)
 	GetUnconfirmedAmount(ctx sdk.Context, keyID tss.KeyID) btcutil.Amount
@@ -122,5 +146,11 @@ type Nexus interface {
 	IsChainActivated(ctx sdk.Context, chain nexus.Chain) bool
 }
 
+// ParamsKeeper represents a global paramstore
+type ParamsKeeper interface {
+	Subspace(s string) params.Subspace
+	GetSubspace(s string) (params.Subspace, bool)
+}
+
 // Snapshotter provides snapshot functionality
 type Snapshotter = snapshot.Snapshotter
@@ -3,20 +3,35 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// NewGenesisState returns a new GenesisState instance
+func NewGenesisState(chains []GenesisState_Chain) *GenesisState {
+	return &GenesisState{Chains: chains}
+}
+
 // DefaultGenesisState represents the default genesis state
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{Params: DefaultParams()}
+	return NewGenesisState([]GenesisState_Chain{{Params: DefaultParams()}})
 }
 
 // Validate validates the genesis state
 func (m *GenesisState) Validate() error {
-	if err := m.Params.Validate(); err != nil {
-		return sdkerrors.Wrap(err, fmt.Sprintf("genesis state for module %s is invalid", ModuleName))
+	chains := make(map[string]bool)
+	for _, chain := range m.Chains {
+		if err := chain.Params.Validate(); err != nil {
+			return sdkerrors.Wrap(err, fmt.Sprintf("genesis state for module %s is invalid", ModuleName))
+		}
+
+		chainName := strings.ToLower(chain.Params.Chain)
+		if chains[chainName] {
+			return fmt.Errorf("genesis state for module %s is invalid: duplicate chain %s", ModuleName, chain.Params.Chain)
+		}
+		chains[chainName] = true
 	}
 
 	return nil
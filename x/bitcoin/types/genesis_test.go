@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -9,3 +10,11 @@ import (
 func TestDefaultGenesisState(t *testing.T) {
 	assert.NoError(t, DefaultGenesisState().Validate())
 }
+
+func TestGenesisState_Validate_DuplicateChains(t *testing.T) {
+	chain := GenesisState_Chain{Params: DefaultParams()}
+	duplicate := GenesisState_Chain{Params: DefaultParams()}
+	duplicate.Params.Chain = strings.ToUpper(duplicate.Params.Chain)
+
+	assert.Error(t, NewGenesisState([]GenesisState_Chain{chain, duplicate}).Validate())
+}
@@ -2168,6 +2168,157 @@ func (mock *SnapshotterMock) TakeSnapshotCalls() []struct {
 	return calls
 }
 
+// Ensure, that BaseKeeperMock does implement types.BaseKeeper.
+// If this is not the case, regenerate this file with moq.
+var _ types.BaseKeeper = &BaseKeeperMock{}
+
+// BaseKeeperMock is a mock implementation of types.BaseKeeper.
+//
+// 	func TestSomethingThatUsesBaseKeeper(t *testing.T) {
+//
+// 		// make and configure a mocked types.BaseKeeper
+// 		mockedBaseKeeper := &BaseKeeperMock{
+// 			ForChainFunc: func(chain string) types.BTCKeeper {
+// 				panic("mock out the ForChain method")
+// 			},
+// 			GetChainsFunc: func(ctx sdk.Context) []string {
+// 				panic("mock out the GetChains method")
+// 			},
+// 			LoggerFunc: func(ctx sdk.Context) log.Logger {
+// 				panic("mock out the Logger method")
+// 			},
+// 		}
+//
+// 		// use mockedBaseKeeper in code that requires types.BaseKeeper
+// 		// and then make assertions.
+//
+// 	}
+type BaseKeeperMock struct {
+	// ForChainFunc mocks the ForChain method.
+	ForChainFunc func(chain string) types.BTCKeeper
+
+	// GetChainsFunc mocks the GetChains method.
+	GetChainsFunc func(ctx sdk.Context) []string
+
+	// LoggerFunc mocks the Logger method.
+	LoggerFunc func(ctx sdk.Context) log.Logger
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ForChain holds details about calls to the ForChain method.
+		ForChain []struct {
+			// Chain is the chain argument value.
+			Chain string
+		}
+		// GetChains holds details about calls to the GetChains method.
+		GetChains []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+		}
+		// Logger holds details about calls to the Logger method.
+		Logger []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+		}
+	}
+	lockForChain  sync.RWMutex
+	lockGetChains sync.RWMutex
+	lockLogger    sync.RWMutex
+}
+
+// ForChain calls ForChainFunc.
+func (mock *BaseKeeperMock) ForChain(chain string) types.BTCKeeper {
+	if mock.ForChainFunc == nil {
+		panic("BaseKeeperMock.ForChainFunc: method is nil but BaseKeeper.ForChain was just called")
+	}
+	callInfo := struct {
+		Chain string
+	}{
+		Chain: chain,
+	}
+	mock.lockForChain.Lock()
+	mock.calls.ForChain = append(mock.calls.ForChain, callInfo)
+	mock.lockForChain.Unlock()
+	return mock.ForChainFunc(chain)
+}
+
+// ForChainCalls gets all the calls that were made to ForChain.
+// Check the length with:
+//     len(mockedBaseKeeper.ForChainCalls())
+func (mock *BaseKeeperMock) ForChainCalls() []struct {
+	Chain string
+} {
+	var calls []struct {
+		Chain string
+	}
+	mock.lockForChain.RLock()
+	calls = mock.calls.ForChain
+	mock.lockForChain.RUnlock()
+	return calls
+}
+
+// GetChains calls GetChainsFunc.
+func (mock *BaseKeeperMock) GetChains(ctx sdk.Context) []string {
+	if mock.GetChainsFunc == nil {
+		panic("BaseKeeperMock.GetChainsFunc: method is nil but BaseKeeper.GetChains was just called")
+	}
+	callInfo := struct {
+		Ctx sdk.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetChains.Lock()
+	mock.calls.GetChains = append(mock.calls.GetChains, callInfo)
+	mock.lockGetChains.Unlock()
+	return mock.GetChainsFunc(ctx)
+}
+
+// GetChainsCalls gets all the calls that were made to GetChains.
+// Check the length with:
+//     len(mockedBaseKeeper.GetChainsCalls())
+func (mock *BaseKeeperMock) GetChainsCalls() []struct {
+	Ctx sdk.Context
+} {
+	var calls []struct {
+		Ctx sdk.Context
+	}
+	mock.lockGetChains.RLock()
+	calls = mock.calls.GetChains
+	mock.lockGetChains.RUnlock()
+	return calls
+}
+
+// Logger calls LoggerFunc.
+func (mock *BaseKeeperMock) Logger(ctx sdk.Context) log.Logger {
+	if mock.LoggerFunc == nil {
+		panic("BaseKeeperMock.LoggerFunc: method is nil but BaseKeeper.Logger was just called")
+	}
+	callInfo := struct {
+		Ctx sdk.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockLogger.Lock()
+	mock.calls.Logger = append(mock.calls.Logger, callInfo)
+	mock.lockLogger.Unlock()
+	return mock.LoggerFunc(ctx)
+}
+
+// LoggerCalls gets all the calls that were made to Logger.
+// Check the length with:
+//     len(mockedBaseKeeper.LoggerCalls())
+func (mock *BaseKeeperMock) LoggerCalls() []struct {
+	Ctx sdk.Context
+} {
+	var calls []struct {
+		Ctx sdk.Context
+	}
+	mock.lockLogger.RLock()
+	calls = mock.calls.Logger
+	mock.lockLogger.RUnlock()
+	return calls
+}
+
 // Ensure, that BTCKeeperMock does implement types.BTCKeeper.
 // If this is not the case, regenerate this file with moq.
 var _ types.BTCKeeper = &BTCKeeperMock{}
@@ -2178,6 +2329,15 @@ var _ types.BTCKeeper = &BTCKeeperMock{}
 //
 // 		// make and configure a mocked types.BTCKeeper
 // 		mockedBTCKeeper := &BTCKeeperMock{
+// 			DeleteAddressInfoFunc: func(ctx sdk.Context, encodedAddress string)  {
+// 				panic("mock out the DeleteAddressInfo method")
+// 			},
+// 			DeleteDepositAddressFunc: func(ctx sdk.Context, recipient nexus.CrossChainAddress)  {
+// 				panic("mock out the DeleteDepositAddress method")
+// 			},
+// 			DeleteDepositAddressExpiryFunc: func(ctx sdk.Context, encodedAddress string)  {
+// 				panic("mock out the DeleteDepositAddressExpiry method")
+// 			},
 // 			DeleteOutpointInfoFunc: func(ctx sdk.Context, outPoint wire.OutPoint)  {
 // 				panic("mock out the DeleteOutpointInfo method")
 // 			},
@@ -2193,12 +2353,36 @@ var _ types.BTCKeeper = &BTCKeeperMock{}
 // 			GetAnyoneCanSpendAddressFunc: func(ctx sdk.Context) types.AddressInfo {
 // 				panic("mock out the GetAnyoneCanSpendAddress method")
 // 			},
+// 			GetBaseDenomFunc: func(ctx sdk.Context) string {
+// 				panic("mock out the GetBaseDenom method")
+// 			},
+// 			GetConfirmedOutPointInfosFunc: func(ctx sdk.Context) []types.OutPointInfo {
+// 				panic("mock out the GetConfirmedOutPointInfos method")
+// 			},
 // 			GetConfirmedOutpointInfoQueueForKeyFunc: func(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) utils.KVQueue {
 // 				panic("mock out the GetConfirmedOutpointInfoQueueForKey method")
 // 			},
 // 			GetDepositAddressFunc: func(ctx sdk.Context, recipient nexus.CrossChainAddress) (github_com_btcsuite_btcutil.Address, error) {
 // 				panic("mock out the GetDepositAddress method")
 // 			},
+// 			GetDepositAddressExpiryFunc: func(ctx sdk.Context, encodedAddress string) (types.DepositAddressExpiry, bool) {
+// 				panic("mock out the GetDepositAddressExpiry method")
+// 			},
+// 			GetDepositAddressExpiryPeriodFunc: func(ctx sdk.Context) int64 {
+// 				panic("mock out the GetDepositAddressExpiryPeriod method")
+// 			},
+// 			GetDepositAddressExpiryQueueFunc: func(ctx sdk.Context) utils.KVQueue {
+// 				panic("mock out the GetDepositAddressExpiryQueue method")
+// 			},
+// 			GetDepositAddressGracePeriodFunc: func(ctx sdk.Context) int64 {
+// 				panic("mock out the GetDepositAddressGracePeriod method")
+// 			},
+// 			GetDustLimitFunc: func(ctx sdk.Context) github_com_btcsuite_btcutil.Amount {
+// 				panic("mock out the GetDustLimit method")
+// 			},
+// 			GetLateDepositOutpointInfoQueueFunc: func(ctx sdk.Context) utils.KVQueue {
+// 				panic("mock out the GetLateDepositOutpointInfoQueue method")
+// 			},
 // 			GetLatestSignedTxHashFunc: func(ctx sdk.Context, txType types.TxType) (*chainhash.Hash, bool) {
 // 				panic("mock out the GetLatestSignedTxHash method")
 // 			},
@@ -2223,9 +2407,15 @@ var _ types.BTCKeeper = &BTCKeeperMock{}
 // 			GetMinOutputAmountFunc: func(ctx sdk.Context) github_com_btcsuite_btcutil.Amount {
 // 				panic("mock out the GetMinOutputAmount method")
 // 			},
+// 			GetMinRelayTxFeeRateFunc: func(ctx sdk.Context) int64 {
+// 				panic("mock out the GetMinRelayTxFeeRate method")
+// 			},
 // 			GetMinVoterCountFunc: func(ctx sdk.Context) int64 {
 // 				panic("mock out the GetMinVoterCount method")
 // 			},
+// 			GetNameFunc: func() string {
+// 				panic("mock out the GetName method")
+// 			},
 // 			GetNetworkFunc: func(ctx sdk.Context) types.Network {
 // 				panic("mock out the GetNetwork method")
 // 			},
@@ -2274,6 +2464,12 @@ var _ types.BTCKeeper = &BTCKeeperMock{}
 // 			SetDepositAddressFunc: func(ctx sdk.Context, recipient nexus.CrossChainAddress, address github_com_btcsuite_btcutil.Address)  {
 // 				panic("mock out the SetDepositAddress method")
 // 			},
+// 			SetDepositAddressExpiryFunc: func(ctx sdk.Context, expiry types.DepositAddressExpiry)  {
+// 				panic("mock out the SetDepositAddressExpiry method")
+// 			},
+// 			SetLateDepositOutpointInfoFunc: func(ctx sdk.Context, info types.OutPointInfo)  {
+// 				panic("mock out the SetLateDepositOutpointInfo method")
+// 			},
 // 			SetLatestSignedTxHashFunc: func(ctx sdk.Context, txType types.TxType, txHash chainhash.Hash)  {
 // 				panic("mock out the SetLatestSignedTxHash method")
 // 			},
@@ -2302,6 +2498,15 @@ var _ types.BTCKeeper = &BTCKeeperMock{}
 //
 // 	}
 type BTCKeeperMock struct {
+	// DeleteAddressInfoFunc mocks the DeleteAddressInfo method.
+	DeleteAddressInfoFunc func(ctx sdk.Context, encodedAddress string)
+
+	// DeleteDepositAddressFunc mocks the DeleteDepositAddress method.
+	DeleteDepositAddressFunc func(ctx sdk.Context, recipient nexus.CrossChainAddress)
+
+	// DeleteDepositAddressExpiryFunc mocks the DeleteDepositAddressExpiry method.
+	DeleteDepositAddressExpiryFunc func(ctx sdk.Context, encodedAddress string)
+
 	// DeleteOutpointInfoFunc mocks the DeleteOutpointInfo method.
 	DeleteOutpointInfoFunc func(ctx sdk.Context, outPoint wire.OutPoint)
 
@@ -2317,12 +2522,36 @@ type BTCKeeperMock struct {
 	// GetAnyoneCanSpendAddressFunc mocks the GetAnyoneCanSpendAddress method.
 	GetAnyoneCanSpendAddressFunc func(ctx sdk.Context) types.AddressInfo
 
+	// GetBaseDenomFunc mocks the GetBaseDenom method.
+	GetBaseDenomFunc func(ctx sdk.Context) string
+
+	// GetConfirmedOutPointInfosFunc mocks the GetConfirmedOutPointInfos method.
+	GetConfirmedOutPointInfosFunc func(ctx sdk.Context) []types.OutPointInfo
+
 	// GetConfirmedOutpointInfoQueueForKeyFunc mocks the GetConfirmedOutpointInfoQueueForKey method.
 	GetConfirmedOutpointInfoQueueForKeyFunc func(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) utils.KVQueue
 
 	// GetDepositAddressFunc mocks the GetDepositAddress method.
 	GetDepositAddressFunc func(ctx sdk.Context, recipient nexus.CrossChainAddress) (github_com_btcsuite_btcutil.Address, error)
 
+	// GetDepositAddressExpiryFunc mocks the GetDepositAddressExpiry method.
+	GetDepositAddressExpiryFunc func(ctx sdk.Context, encodedAddress string) (types.DepositAddressExpiry, bool)
+
+	// GetDepositAddressExpiryPeriodFunc mocks the GetDepositAddressExpiryPeriod method.
+	GetDepositAddressExpiryPeriodFunc func(ctx sdk.Context) int64
+
+	// GetDepositAddressExpiryQueueFunc mocks the GetDepositAddressExpiryQueue method.
+	GetDepositAddressExpiryQueueFunc func(ctx sdk.Context) utils.KVQueue
+
+	// GetDepositAddressGracePeriodFunc mocks the GetDepositAddressGracePeriod method.
+	GetDepositAddressGracePeriodFunc func(ctx sdk.Context) int64
+
+	// GetDustLimitFunc mocks the GetDustLimit method.
+	GetDustLimitFunc func(ctx sdk.Context) github_com_btcsuite_btcutil.Amount
+
+	// GetLateDepositOutpointInfoQueueFunc mocks the GetLateDepositOutpointInfoQueue method.
+	GetLateDepositOutpointInfoQueueFunc func(ctx sdk.Context) utils.KVQueue
+
 	// GetLatestSignedTxHashFunc mocks the GetLatestSignedTxHash method.
 	GetLatestSignedTxHashFunc func(ctx sdk.Context, txType types.TxType) (*chainhash.Hash, bool)
 
@@ -2347,9 +2576,15 @@ type BTCKeeperMock struct {
 	// GetMinOutputAmountFunc mocks the GetMinOutputAmount method.
 	GetMinOutputAmountFunc func(ctx sdk.Context) github_com_btcsuite_btcutil.Amount
 
+	// GetMinRelayTxFeeRateFunc mocks the GetMinRelayTxFeeRate method.
+	GetMinRelayTxFeeRateFunc func(ctx sdk.Context) int64
+
 	// GetMinVoterCountFunc mocks the GetMinVoterCount method.
 	GetMinVoterCountFunc func(ctx sdk.Context) int64
 
+	// GetNameFunc mocks the GetName method.
+	GetNameFunc func() string
+
 	// GetNetworkFunc mocks the GetNetwork method.
 	GetNetworkFunc func(ctx sdk.Context) types.Network
 
@@ -2398,6 +2633,12 @@ type BTCKeeperMock struct {
 	// SetDepositAddressFunc mocks the SetDepositAddress method.
 	SetDepositAddressFunc func(ctx sdk.Context, recipient nexus.CrossChainAddress, address github_com_btcsuite_btcutil.Address)
 
+	// SetDepositAddressExpiryFunc mocks the SetDepositAddressExpiry method.
+	SetDepositAddressExpiryFunc func(ctx sdk.Context, expiry types.DepositAddressExpiry)
+
+	// SetLateDepositOutpointInfoFunc mocks the SetLateDepositOutpointInfo method.
+	SetLateDepositOutpointInfoFunc func(ctx sdk.Context, info types.OutPointInfo)
+
 	// SetLatestSignedTxHashFunc mocks the SetLatestSignedTxHash method.
 	SetLatestSignedTxHashFunc func(ctx sdk.Context, txType types.TxType, txHash chainhash.Hash)
 
@@ -2421,6 +2662,27 @@ type BTCKeeperMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// DeleteAddressInfo holds details about calls to the DeleteAddressInfo method.
+		DeleteAddressInfo []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// EncodedAddress is the encodedAddress argument value.
+			EncodedAddress string
+		}
+		// DeleteDepositAddress holds details about calls to the DeleteDepositAddress method.
+		DeleteDepositAddress []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Recipient is the recipient argument value.
+			Recipient nexus.CrossChainAddress
+		}
+		// DeleteDepositAddressExpiry holds details about calls to the DeleteDepositAddressExpiry method.
+		DeleteDepositAddressExpiry []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// EncodedAddress is the encodedAddress argument value.
+			EncodedAddress string
+		}
 		// DeleteOutpointInfo holds details about calls to the DeleteOutpointInfo method.
 		DeleteOutpointInfo []struct {
 			// Ctx is the ctx argument value.
@@ -2454,6 +2716,16 @@ type BTCKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx sdk.Context
 		}
+		// GetBaseDenom holds details about calls to the GetBaseDenom method.
+		GetBaseDenom []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+		}
+		// GetConfirmedOutPointInfos holds details about calls to the GetConfirmedOutPointInfos method.
+		GetConfirmedOutPointInfos []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+		}
 		// GetConfirmedOutpointInfoQueueForKey holds details about calls to the GetConfirmedOutpointInfoQueueForKey method.
 		GetConfirmedOutpointInfoQueueForKey []struct {
 			// Ctx is the ctx argument value.
@@ -2468,6 +2740,38 @@ type BTCKeeperMock struct {
 			// Recipient is the recipient argument value.
 			Recipient nexus.CrossChainAddress
 		}
+		// GetDepositAddressExpiry holds details about calls to the GetDepositAddressExpiry method.
+		GetDepositAddressExpiry []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// EncodedAddress is the encodedAddress argument value.
+			EncodedAddress string
+		}
+		// GetDepositAddressExpiryPeriod holds details about calls to the GetDepositAddressExpiryPeriod method.
+		GetDepositAddressExpiryPeriod []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+		}
+		// GetDepositAddressExpiryQueue holds details about calls to the GetDepositAddressExpiryQueue method.
+		GetDepositAddressExpiryQueue []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+		}
+		// GetDepositAddressGracePeriod holds details about calls to the GetDepositAddressGracePeriod method.
+		GetDepositAddressGracePeriod []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+		}
+		// GetDustLimit holds details about calls to the GetDustLimit method.
+		GetDustLimit []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+		}
+		// GetLateDepositOutpointInfoQueue holds details about calls to the GetLateDepositOutpointInfoQueue method.
+		GetLateDepositOutpointInfoQueue []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+		}
 		// GetLatestSignedTxHash holds details about calls to the GetLatestSignedTxHash method.
 		GetLatestSignedTxHash []struct {
 			// Ctx is the ctx argument value.
@@ -2510,11 +2814,19 @@ type BTCKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx sdk.Context
 		}
+		// GetMinRelayTxFeeRate holds details about calls to the GetMinRelayTxFeeRate method.
+		GetMinRelayTxFeeRate []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+		}
 		// GetMinVoterCount holds details about calls to the GetMinVoterCount method.
 		GetMinVoterCount []struct {
 			// Ctx is the ctx argument value.
 			Ctx sdk.Context
 		}
+		// GetName holds details about calls to the GetName method.
+		GetName []struct {
+		}
 		// GetNetwork holds details about calls to the GetNetwork method.
 		GetNetwork []struct {
 			// Ctx is the ctx argument value.
@@ -2615,6 +2927,20 @@ type BTCKeeperMock struct {
 			// Address is the address argument value.
 			Address github_com_btcsuite_btcutil.Address
 		}
+		// SetDepositAddressExpiry holds details about calls to the SetDepositAddressExpiry method.
+		SetDepositAddressExpiry []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Expiry is the expiry argument value.
+			Expiry types.DepositAddressExpiry
+		}
+		// SetLateDepositOutpointInfo holds details about calls to the SetLateDepositOutpointInfo method.
+		SetLateDepositOutpointInfo []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Info is the info argument value.
+			Info types.OutPointInfo
+		}
 		// SetLatestSignedTxHash holds details about calls to the SetLatestSignedTxHash method.
 		SetLatestSignedTxHash []struct {
 			// Ctx is the ctx argument value.
@@ -2671,13 +2997,24 @@ type BTCKeeperMock struct {
 			Tx types.UnsignedTx
 		}
 	}
+	lockDeleteAddressInfo                       sync.RWMutex
+	lockDeleteDepositAddress                    sync.RWMutex
+	lockDeleteDepositAddressExpiry              sync.RWMutex
 	lockDeleteOutpointInfo                      sync.RWMutex
 	lockDeletePendingOutPointInfo               sync.RWMutex
 	lockDeleteUnsignedTx                        sync.RWMutex
 	lockGetAddressInfo                          sync.RWMutex
 	lockGetAnyoneCanSpendAddress                sync.RWMutex
+	lockGetBaseDenom                            sync.RWMutex
+	lockGetConfirmedOutPointInfos               sync.RWMutex
 	lockGetConfirmedOutpointInfoQueueForKey     sync.RWMutex
 	lockGetDepositAddress                       sync.RWMutex
+	lockGetDepositAddressExpiry                 sync.RWMutex
+	lockGetDepositAddressExpiryPeriod           sync.RWMutex
+	lockGetDepositAddressExpiryQueue            sync.RWMutex
+	lockGetDepositAddressGracePeriod            sync.RWMutex
+	lockGetDustLimit                            sync.RWMutex
+	lockGetLateDepositOutpointInfoQueue         sync.RWMutex
 	lockGetLatestSignedTxHash                   sync.RWMutex
 	lockGetMasterAddressExternalKeyLockDuration sync.RWMutex
 	lockGetMasterAddressInternalKeyLockDuration sync.RWMutex
@@ -2686,7 +3023,9 @@ type BTCKeeperMock struct {
 	lockGetMaxSecondaryOutputAmount             sync.RWMutex
 	lockGetMaxTxSize                            sync.RWMutex
 	lockGetMinOutputAmount                      sync.RWMutex
+	lockGetMinRelayTxFeeRate                    sync.RWMutex
 	lockGetMinVoterCount                        sync.RWMutex
+	lockGetName                                 sync.RWMutex
 	lockGetNetwork                              sync.RWMutex
 	lockGetOutPointInfo                         sync.RWMutex
 	lockGetParams                               sync.RWMutex
@@ -2703,6 +3042,8 @@ type BTCKeeperMock struct {
 	lockSetAddressInfo                          sync.RWMutex
 	lockSetConfirmedOutpointInfo                sync.RWMutex
 	lockSetDepositAddress                       sync.RWMutex
+	lockSetDepositAddressExpiry                 sync.RWMutex
+	lockSetLateDepositOutpointInfo              sync.RWMutex
 	lockSetLatestSignedTxHash                   sync.RWMutex
 	lockSetParams                               sync.RWMutex
 	lockSetPendingOutpointInfo                  sync.RWMutex
@@ -2712,6 +3053,111 @@ type BTCKeeperMock struct {
 	lockSetUnsignedTx                           sync.RWMutex
 }
 
+// DeleteAddressInfo calls DeleteAddressInfoFunc.
+func (mock *BTCKeeperMock) DeleteAddressInfo(ctx sdk.Context, encodedAddress string) {
+	if mock.DeleteAddressInfoFunc == nil {
+		panic("BTCKeeperMock.DeleteAddressInfoFunc: method is nil but BTCKeeper.DeleteAddressInfo was just called")
+	}
+	callInfo := struct {
+		Ctx            sdk.Context
+		EncodedAddress string
+	}{
+		Ctx:            ctx,
+		EncodedAddress: encodedAddress,
+	}
+	mock.lockDeleteAddressInfo.Lock()
+	mock.calls.DeleteAddressInfo = append(mock.calls.DeleteAddressInfo, callInfo)
+	mock.lockDeleteAddressInfo.Unlock()
+	mock.DeleteAddressInfoFunc(ctx, encodedAddress)
+}
+
+// DeleteAddressInfoCalls gets all the calls that were made to DeleteAddressInfo.
+// Check the length with:
+//     len(mockedBTCKeeper.DeleteAddressInfoCalls())
+func (mock *BTCKeeperMock) DeleteAddressInfoCalls() []struct {
+	Ctx            sdk.Context
+	EncodedAddress string
+} {
+	var calls []struct {
+		Ctx            sdk.Context
+		EncodedAddress string
+	}
+	mock.lockDeleteAddressInfo.RLock()
+	calls = mock.calls.DeleteAddressInfo
+	mock.lockDeleteAddressInfo.RUnlock()
+	return calls
+}
+
+// DeleteDepositAddress calls DeleteDepositAddressFunc.
+func (mock *BTCKeeperMock) DeleteDepositAddress(ctx sdk.Context, recipient nexus.CrossChainAddress) {
+	if mock.DeleteDepositAddressFunc == nil {
+		panic("BTCKeeperMock.DeleteDepositAddressFunc: method is nil but BTCKeeper.DeleteDepositAddress was just called")
+	}
+	callInfo := struct {
+		Ctx       sdk.Context
+		Recipient nexus.CrossChainAddress
+	}{
+		Ctx:       ctx,
+		Recipient: recipient,
+	}
+	mock.lockDeleteDepositAddress.Lock()
+	mock.calls.DeleteDepositAddress = append(mock.calls.DeleteDepositAddress, callInfo)
+	mock.lockDeleteDepositAddress.Unlock()
+	mock.DeleteDepositAddressFunc(ctx, recipient)
+}
+
+// DeleteDepositAddressCalls gets all the calls that were made to DeleteDepositAddress.
+// Check the length with:
+//     len(mockedBTCKeeper.DeleteDepositAddressCalls())
+func (mock *BTCKeeperMock) DeleteDepositAddressCalls() []struct {
+	Ctx       sdk.Context
+	Recipient nexus.CrossChainAddress
+} {
+	var calls []struct {
+		Ctx       sdk.Context
+		Recipient nexus.CrossChainAddress
+	}
+	mock.lockDeleteDepositAddress.RLock()
+	calls = mock.calls.DeleteDepositAddress
+	mock.lockDeleteDepositAddress.RUnlock()
+	return calls
+}
+
+// DeleteDepositAddressExpiry calls DeleteDepositAddressExpiryFunc.
+func (mock *BTCKeeperMock) DeleteDepositAddressExpiry(ctx sdk.Context, encodedAddress string) {
+	if mock.DeleteDepositAddressExpiryFunc == nil {
+		panic("BTCKeeperMock.DeleteDepositAddressExpiryFunc: method is nil but BTCKeeper.DeleteDepositAddressExpiry was just called")
+	}
+	callInfo := struct {
+		Ctx            sdk.Context
+		EncodedAddress string
+	}{
+		Ctx:            ctx,
+		EncodedAddress: encodedAddress,
+	}
+	mock.lockDeleteDepositAddressExpiry.Lock()
+	mock.calls.DeleteDepositAddressExpiry = append(mock.calls.DeleteDepositAddressExpiry, callInfo)
+	mock.lockDeleteDepositAddressExpiry.Unlock()
+	mock.DeleteDepositAddressExpiryFunc(ctx, encodedAddress)
+}
+
+// DeleteDepositAddressExpiryCalls gets all the calls that were made to DeleteDepositAddressExpiry.
+// Check the length with:
+//     len(mockedBTCKeeper.DeleteDepositAddressExpiryCalls())
+func (mock *BTCKeeperMock) DeleteDepositAddressExpiryCalls() []struct {
+	Ctx            sdk.Context
+	EncodedAddress string
+} {
+	var calls []struct {
+		Ctx            sdk.Context
+		EncodedAddress string
+	}
+	mock.lockDeleteDepositAddressExpiry.RLock()
+	calls = mock.calls.DeleteDepositAddressExpiry
+	mock.lockDeleteDepositAddressExpiry.RUnlock()
+	return calls
+}
+
 // DeleteOutpointInfo calls DeleteOutpointInfoFunc.
 func (mock *BTCKeeperMock) DeleteOutpointInfo(ctx sdk.Context, outPoint wire.OutPoint) {
 	if mock.DeleteOutpointInfoFunc == nil {
@@ -2883,6 +3329,68 @@ func (mock *BTCKeeperMock) GetAnyoneCanSpendAddressCalls() []struct {
 	return calls
 }
 
+// GetBaseDenom calls GetBaseDenomFunc.
+func (mock *BTCKeeperMock) GetBaseDenom(ctx sdk.Context) string {
+	if mock.GetBaseDenomFunc == nil {
+		panic("BTCKeeperMock.GetBaseDenomFunc: method is nil but BTCKeeper.GetBaseDenom was just called")
+	}
+	callInfo := struct {
+		Ctx sdk.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetBaseDenom.Lock()
+	mock.calls.GetBaseDenom = append(mock.calls.GetBaseDenom, callInfo)
+	mock.lockGetBaseDenom.Unlock()
+	return mock.GetBaseDenomFunc(ctx)
+}
+
+// GetBaseDenomCalls gets all the calls that were made to GetBaseDenom.
+// Check the length with:
+//     len(mockedBTCKeeper.GetBaseDenomCalls())
+func (mock *BTCKeeperMock) GetBaseDenomCalls() []struct {
+	Ctx sdk.Context
+} {
+	var calls []struct {
+		Ctx sdk.Context
+	}
+	mock.lockGetBaseDenom.RLock()
+	calls = mock.calls.GetBaseDenom
+	mock.lockGetBaseDenom.RUnlock()
+	return calls
+}
+
+// GetConfirmedOutPointInfos calls GetConfirmedOutPointInfosFunc.
+func (mock *BTCKeeperMock) GetConfirmedOutPointInfos(ctx sdk.Context) []types.OutPointInfo {
+	if mock.GetConfirmedOutPointInfosFunc == nil {
+		panic("BTCKeeperMock.GetConfirmedOutPointInfosFunc: method is nil but BTCKeeper.GetConfirmedOutPointInfos was just called")
+	}
+	callInfo := struct {
+		Ctx sdk.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetConfirmedOutPointInfos.Lock()
+	mock.calls.GetConfirmedOutPointInfos = append(mock.calls.GetConfirmedOutPointInfos, callInfo)
+	mock.lockGetConfirmedOutPointInfos.Unlock()
+	return mock.GetConfirmedOutPointInfosFunc(ctx)
+}
+
+// GetConfirmedOutPointInfosCalls gets all the calls that were made to GetConfirmedOutPointInfos.
+// Check the length with:
+//     len(mockedBTCKeeper.GetConfirmedOutPointInfosCalls())
+func (mock *BTCKeeperMock) GetConfirmedOutPointInfosCalls() []struct {
+	Ctx sdk.Context
+} {
+	var calls []struct {
+		Ctx sdk.Context
+	}
+	mock.lockGetConfirmedOutPointInfos.RLock()
+	calls = mock.calls.GetConfirmedOutPointInfos
+	mock.lockGetConfirmedOutPointInfos.RUnlock()
+	return calls
+}
+
 // GetConfirmedOutpointInfoQueueForKey calls GetConfirmedOutpointInfoQueueForKeyFunc.
 func (mock *BTCKeeperMock) GetConfirmedOutpointInfoQueueForKey(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) utils.KVQueue {
 	if mock.GetConfirmedOutpointInfoQueueForKeyFunc == nil {
@@ -2953,6 +3461,196 @@ func (mock *BTCKeeperMock) GetDepositAddressCalls() []struct {
 	return calls
 }
 
+// GetDepositAddressExpiry calls GetDepositAddressExpiryFunc.
+func (mock *BTCKeeperMock) GetDepositAddressExpiry(ctx sdk.Context, encodedAddress string) (types.DepositAddressExpiry, bool) {
+	if mock.GetDepositAddressExpiryFunc == nil {
+		panic("BTCKeeperMock.GetDepositAddressExpiryFunc: method is nil but BTCKeeper.GetDepositAddressExpiry was just called")
+	}
+	callInfo := struct {
+		Ctx            sdk.Context
+		EncodedAddress string
+	}{
+		Ctx:            ctx,
+		EncodedAddress: encodedAddress,
+	}
+	mock.lockGetDepositAddressExpiry.Lock()
+	mock.calls.GetDepositAddressExpiry = append(mock.calls.GetDepositAddressExpiry, callInfo)
+	mock.lockGetDepositAddressExpiry.Unlock()
+	return mock.GetDepositAddressExpiryFunc(ctx, encodedAddress)
+}
+
+// GetDepositAddressExpiryCalls gets all the calls that were made to GetDepositAddressExpiry.
+// Check the length with:
+//     len(mockedBTCKeeper.GetDepositAddressExpiryCalls())
+func (mock *BTCKeeperMock) GetDepositAddressExpiryCalls() []struct {
+	Ctx            sdk.Context
+	EncodedAddress string
+} {
+	var calls []struct {
+		Ctx            sdk.Context
+		EncodedAddress string
+	}
+	mock.lockGetDepositAddressExpiry.RLock()
+	calls = mock.calls.GetDepositAddressExpiry
+	mock.lockGetDepositAddressExpiry.RUnlock()
+	return calls
+}
+
+// GetDepositAddressExpiryPeriod calls GetDepositAddressExpiryPeriodFunc.
+func (mock *BTCKeeperMock) GetDepositAddressExpiryPeriod(ctx sdk.Context) int64 {
+	if mock.GetDepositAddressExpiryPeriodFunc == nil {
+		panic("BTCKeeperMock.GetDepositAddressExpiryPeriodFunc: method is nil but BTCKeeper.GetDepositAddressExpiryPeriod was just called")
+	}
+	callInfo := struct {
+		Ctx sdk.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDepositAddressExpiryPeriod.Lock()
+	mock.calls.GetDepositAddressExpiryPeriod = append(mock.calls.GetDepositAddressExpiryPeriod, callInfo)
+	mock.lockGetDepositAddressExpiryPeriod.Unlock()
+	return mock.GetDepositAddressExpiryPeriodFunc(ctx)
+}
+
+// GetDepositAddressExpiryPeriodCalls gets all the calls that were made to GetDepositAddressExpiryPeriod.
+// Check the length with:
+//     len(mockedBTCKeeper.GetDepositAddressExpiryPeriodCalls())
+func (mock *BTCKeeperMock) GetDepositAddressExpiryPeriodCalls() []struct {
+	Ctx sdk.Context
+} {
+	var calls []struct {
+		Ctx sdk.Context
+	}
+	mock.lockGetDepositAddressExpiryPeriod.RLock()
+	calls = mock.calls.GetDepositAddressExpiryPeriod
+	mock.lockGetDepositAddressExpiryPeriod.RUnlock()
+	return calls
+}
+
+// GetDepositAddressExpiryQueue calls GetDepositAddressExpiryQueueFunc.
+func (mock *BTCKeeperMock) GetDepositAddressExpiryQueue(ctx sdk.Context) utils.KVQueue {
+	if mock.GetDepositAddressExpiryQueueFunc == nil {
+		panic("BTCKeeperMock.GetDepositAddressExpiryQueueFunc: method is nil but BTCKeeper.GetDepositAddressExpiryQueue was just called")
+	}
+	callInfo := struct {
+		Ctx sdk.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDepositAddressExpiryQueue.Lock()
+	mock.calls.GetDepositAddressExpiryQueue = append(mock.calls.GetDepositAddressExpiryQueue, callInfo)
+	mock.lockGetDepositAddressExpiryQueue.Unlock()
+	return mock.GetDepositAddressExpiryQueueFunc(ctx)
+}
+
+// GetDepositAddressExpiryQueueCalls gets all the calls that were made to GetDepositAddressExpiryQueue.
+// Check the length with:
+//     len(mockedBTCKeeper.GetDepositAddressExpiryQueueCalls())
+func (mock *BTCKeeperMock) GetDepositAddressExpiryQueueCalls() []struct {
+	Ctx sdk.Context
+} {
+	var calls []struct {
+		Ctx sdk.Context
+	}
+	mock.lockGetDepositAddressExpiryQueue.RLock()
+	calls = mock.calls.GetDepositAddressExpiryQueue
+	mock.lockGetDepositAddressExpiryQueue.RUnlock()
+	return calls
+}
+
+// GetDepositAddressGracePeriod calls GetDepositAddressGracePeriodFunc.
+func (mock *BTCKeeperMock) GetDepositAddressGracePeriod(ctx sdk.Context) int64 {
+	if mock.GetDepositAddressGracePeriodFunc == nil {
+		panic("BTCKeeperMock.GetDepositAddressGracePeriodFunc: method is nil but BTCKeeper.GetDepositAddressGracePeriod was just called")
+	}
+	callInfo := struct {
+		Ctx sdk.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDepositAddressGracePeriod.Lock()
+	mock.calls.GetDepositAddressGracePeriod = append(mock.calls.GetDepositAddressGracePeriod, callInfo)
+	mock.lockGetDepositAddressGracePeriod.Unlock()
+	return mock.GetDepositAddressGracePeriodFunc(ctx)
+}
+
+// GetDepositAddressGracePeriodCalls gets all the calls that were made to GetDepositAddressGracePeriod.
+// Check the length with:
+//     len(mockedBTCKeeper.GetDepositAddressGracePeriodCalls())
+func (mock *BTCKeeperMock) GetDepositAddressGracePeriodCalls() []struct {
+	Ctx sdk.Context
+} {
+	var calls []struct {
+		Ctx sdk.Context
+	}
+	mock.lockGetDepositAddressGracePeriod.RLock()
+	calls = mock.calls.GetDepositAddressGracePeriod
+	mock.lockGetDepositAddressGracePeriod.RUnlock()
+	return calls
+}
+
+// GetDustLimit calls GetDustLimitFunc.
+func (mock *BTCKeeperMock) GetDustLimit(ctx sdk.Context) github_com_btcsuite_btcutil.Amount {
+	if mock.GetDustLimitFunc == nil {
+		panic("BTCKeeperMock.GetDustLimitFunc: method is nil but BTCKeeper.GetDustLimit was just called")
+	}
+	callInfo := struct {
+		Ctx sdk.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDustLimit.Lock()
+	mock.calls.GetDustLimit = append(mock.calls.GetDustLimit, callInfo)
+	mock.lockGetDustLimit.Unlock()
+	return mock.GetDustLimitFunc(ctx)
+}
+
+// GetDustLimitCalls gets all the calls that were made to GetDustLimit.
+// Check the length with:
+//     len(mockedBTCKeeper.GetDustLimitCalls())
+func (mock *BTCKeeperMock) GetDustLimitCalls() []struct {
+	Ctx sdk.Context
+} {
+	var calls []struct {
+		Ctx sdk.Context
+	}
+	mock.lockGetDustLimit.RLock()
+	calls = mock.calls.GetDustLimit
+	mock.lockGetDustLimit.RUnlock()
+	return calls
+}
+
+// GetLateDepositOutpointInfoQueue calls GetLateDepositOutpointInfoQueueFunc.
+func (mock *BTCKeeperMock) GetLateDepositOutpointInfoQueue(ctx sdk.Context) utils.KVQueue {
+	if mock.GetLateDepositOutpointInfoQueueFunc == nil {
+		panic("BTCKeeperMock.GetLateDepositOutpointInfoQueueFunc: method is nil but BTCKeeper.GetLateDepositOutpointInfoQueue was just called")
+	}
+	callInfo := struct {
+		Ctx sdk.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetLateDepositOutpointInfoQueue.Lock()
+	mock.calls.GetLateDepositOutpointInfoQueue = append(mock.calls.GetLateDepositOutpointInfoQueue, callInfo)
+	mock.lockGetLateDepositOutpointInfoQueue.Unlock()
+	return mock.GetLateDepositOutpointInfoQueueFunc(ctx)
+}
+
+// GetLateDepositOutpointInfoQueueCalls gets all the calls that were made to GetLateDepositOutpointInfoQueue.
+// Check the length with:
+//     len(mockedBTCKeeper.GetLateDepositOutpointInfoQueueCalls())
+func (mock *BTCKeeperMock) GetLateDepositOutpointInfoQueueCalls() []struct {
+	Ctx sdk.Context
+} {
+	var calls []struct {
+		Ctx sdk.Context
+	}
+	mock.lockGetLateDepositOutpointInfoQueue.RLock()
+	calls = mock.calls.GetLateDepositOutpointInfoQueue
+	mock.lockGetLateDepositOutpointInfoQueue.RUnlock()
+	return calls
+}
+
 // GetLatestSignedTxHash calls GetLatestSignedTxHashFunc.
 func (mock *BTCKeeperMock) GetLatestSignedTxHash(ctx sdk.Context, txType types.TxType) (*chainhash.Hash, bool) {
 	if mock.GetLatestSignedTxHashFunc == nil {
@@ -3205,6 +3903,37 @@ func (mock *BTCKeeperMock) GetMinOutputAmountCalls() []struct {
 	return calls
 }
 
+// GetMinRelayTxFeeRate calls GetMinRelayTxFeeRateFunc.
+func (mock *BTCKeeperMock) GetMinRelayTxFeeRate(ctx sdk.Context) int64 {
+	if mock.GetMinRelayTxFeeRateFunc == nil {
+		panic("BTCKeeperMock.GetMinRelayTxFeeRateFunc: method is nil but BTCKeeper.GetMinRelayTxFeeRate was just called")
+	}
+	callInfo := struct {
+		Ctx sdk.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetMinRelayTxFeeRate.Lock()
+	mock.calls.GetMinRelayTxFeeRate = append(mock.calls.GetMinRelayTxFeeRate, callInfo)
+	mock.lockGetMinRelayTxFeeRate.Unlock()
+	return mock.GetMinRelayTxFeeRateFunc(ctx)
+}
+
+// GetMinRelayTxFeeRateCalls gets all the calls that were made to GetMinRelayTxFeeRate.
+// Check the length with:
+//     len(mockedBTCKeeper.GetMinRelayTxFeeRateCalls())
+func (mock *BTCKeeperMock) GetMinRelayTxFeeRateCalls() []struct {
+	Ctx sdk.Context
+} {
+	var calls []struct {
+		Ctx sdk.Context
+	}
+	mock.lockGetMinRelayTxFeeRate.RLock()
+	calls = mock.calls.GetMinRelayTxFeeRate
+	mock.lockGetMinRelayTxFeeRate.RUnlock()
+	return calls
+}
+
 // GetMinVoterCount calls GetMinVoterCountFunc.
 func (mock *BTCKeeperMock) GetMinVoterCount(ctx sdk.Context) int64 {
 	if mock.GetMinVoterCountFunc == nil {
@@ -3236,6 +3965,32 @@ func (mock *BTCKeeperMock) GetMinVoterCountCalls() []struct {
 	return calls
 }
 
+// GetName calls GetNameFunc.
+func (mock *BTCKeeperMock) GetName() string {
+	if mock.GetNameFunc == nil {
+		panic("BTCKeeperMock.GetNameFunc: method is nil but BTCKeeper.GetName was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetName.Lock()
+	mock.calls.GetName = append(mock.calls.GetName, callInfo)
+	mock.lockGetName.Unlock()
+	return mock.GetNameFunc()
+}
+
+// GetNameCalls gets all the calls that were made to GetName.
+// Check the length with:
+//     len(mockedBTCKeeper.GetNameCalls())
+func (mock *BTCKeeperMock) GetNameCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetName.RLock()
+	calls = mock.calls.GetName
+	mock.lockGetName.RUnlock()
+	return calls
+}
+
 // GetNetwork calls GetNetworkFunc.
 func (mock *BTCKeeperMock) GetNetwork(ctx sdk.Context) types.Network {
 	if mock.GetNetworkFunc == nil {
@@ -3772,6 +4527,76 @@ func (mock *BTCKeeperMock) SetDepositAddressCalls() []struct {
 	return calls
 }
 
+// SetDepositAddressExpiry calls SetDepositAddressExpiryFunc.
+func (mock *BTCKeeperMock) SetDepositAddressExpiry(ctx sdk.Context, expiry types.DepositAddressExpiry) {
+	if mock.SetDepositAddressExpiryFunc == nil {
+		panic("BTCKeeperMock.SetDepositAddressExpiryFunc: method is nil but BTCKeeper.SetDepositAddressExpiry was just called")
+	}
+	callInfo := struct {
+		Ctx    sdk.Context
+		Expiry types.DepositAddressExpiry
+	}{
+		Ctx:    ctx,
+		Expiry: expiry,
+	}
+	mock.lockSetDepositAddressExpiry.Lock()
+	mock.calls.SetDepositAddressExpiry = append(mock.calls.SetDepositAddressExpiry, callInfo)
+	mock.lockSetDepositAddressExpiry.Unlock()
+	mock.SetDepositAddressExpiryFunc(ctx, expiry)
+}
+
+// SetDepositAddressExpiryCalls gets all the calls that were made to SetDepositAddressExpiry.
+// Check the length with:
+//     len(mockedBTCKeeper.SetDepositAddressExpiryCalls())
+func (mock *BTCKeeperMock) SetDepositAddressExpiryCalls() []struct {
+	Ctx    sdk.Context
+	Expiry types.DepositAddressExpiry
+} {
+	var calls []struct {
+		Ctx    sdk.Context
+		Expiry types.DepositAddressExpiry
+	}
+	mock.lockSetDepositAddressExpiry.RLock()
+	calls = mock.calls.SetDepositAddressExpiry
+	mock.lockSetDepositAddressExpiry.RUnlock()
+	return calls
+}
+
+// SetLateDepositOutpointInfo calls SetLateDepositOutpointInfoFunc.
+func (mock *BTCKeeperMock) SetLateDepositOutpointInfo(ctx sdk.Context, info types.OutPointInfo) {
+	if mock.SetLateDepositOutpointInfoFunc == nil {
+		panic("BTCKeeperMock.SetLateDepositOutpointInfoFunc: method is nil but BTCKeeper.SetLateDepositOutpointInfo was just called")
+	}
+	callInfo := struct {
+		Ctx  sdk.Context
+		Info types.OutPointInfo
+	}{
+		Ctx:  ctx,
+		Info: info,
+	}
+	mock.lockSetLateDepositOutpointInfo.Lock()
+	mock.calls.SetLateDepositOutpointInfo = append(mock.calls.SetLateDepositOutpointInfo, callInfo)
+	mock.lockSetLateDepositOutpointInfo.Unlock()
+	mock.SetLateDepositOutpointInfoFunc(ctx, info)
+}
+
+// SetLateDepositOutpointInfoCalls gets all the calls that were made to SetLateDepositOutpointInfo.
+// Check the length with:
+//     len(mockedBTCKeeper.SetLateDepositOutpointInfoCalls())
+func (mock *BTCKeeperMock) SetLateDepositOutpointInfoCalls() []struct {
+	Ctx  sdk.Context
+	Info types.OutPointInfo
+} {
+	var calls []struct {
+		Ctx  sdk.Context
+		Info types.OutPointInfo
+	}
+	mock.lockSetLateDepositOutpointInfo.RLock()
+	calls = mock.calls.SetLateDepositOutpointInfo
+	mock.lockSetLateDepositOutpointInfo.RUnlock()
+	return calls
+}
+
 // SetLatestSignedTxHash calls SetLatestSignedTxHashFunc.
 func (mock *BTCKeeperMock) SetLatestSignedTxHash(ctx sdk.Context, txType types.TxType, txHash chainhash.Hash) {
 	if mock.SetLatestSignedTxHashFunc == nil {
@@ -1,14 +1,17 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // NewConfirmOutpointRequest - ConfirmOutpointRequest constructor
-func NewConfirmOutpointRequest(sender sdk.AccAddress, out OutPointInfo) *ConfirmOutpointRequest {
+func NewConfirmOutpointRequest(sender sdk.AccAddress, chain string, out OutPointInfo) *ConfirmOutpointRequest {
 	return &ConfirmOutpointRequest{
 		Sender:       sender,
+		Chain:        chain,
 		OutPointInfo: out,
 	}
 }
@@ -29,6 +32,10 @@ func (m ConfirmOutpointRequest) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
 	}
 
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
 	if err := m.OutPointInfo.Validate(); err != nil {
 		return err
 	}
@@ -11,9 +11,10 @@ import (
 )
 
 // NewCreateMasterTxRequest is the constructor for CreateMasterTxRequest
-func NewCreateMasterTxRequest(sender sdk.AccAddress, keyID string, secondaryKeyAmount btcutil.Amount) *CreateMasterTxRequest {
+func NewCreateMasterTxRequest(sender sdk.AccAddress, chain string, keyID string, secondaryKeyAmount btcutil.Amount) *CreateMasterTxRequest {
 	return &CreateMasterTxRequest{
 		Sender:             sender,
+		Chain:              chain,
 		KeyID:              tss.KeyID(keyID),
 		SecondaryKeyAmount: secondaryKeyAmount,
 	}
@@ -35,6 +36,10 @@ func (m CreateMasterTxRequest) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
 	}
 
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
 	if err := m.KeyID.Validate(); err != nil {
 		return err
 	}
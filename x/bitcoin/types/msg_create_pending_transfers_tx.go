@@ -11,9 +11,10 @@ import (
 )
 
 // NewCreatePendingTransfersTxRequest - CreatePendingTransfersTxRequest constructor
-func NewCreatePendingTransfersTxRequest(sender sdk.AccAddress, keyID string, masterKeyAmount btcutil.Amount) *CreatePendingTransfersTxRequest {
+func NewCreatePendingTransfersTxRequest(sender sdk.AccAddress, chain string, keyID string, masterKeyAmount btcutil.Amount) *CreatePendingTransfersTxRequest {
 	return &CreatePendingTransfersTxRequest{
 		Sender:          sender,
+		Chain:           chain,
 		KeyID:           tss.KeyID(keyID),
 		MasterKeyAmount: masterKeyAmount,
 	}
@@ -35,6 +36,10 @@ func (m CreatePendingTransfersTxRequest) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
 	}
 
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
 	if err := m.KeyID.Validate(); err != nil {
 		return err
 	}
@@ -1,14 +1,17 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // NewCreateRescueTxRequest is the constructor for CreateRescueTxRequest
-func NewCreateRescueTxRequest(sender sdk.AccAddress) *CreateRescueTxRequest {
+func NewCreateRescueTxRequest(sender sdk.AccAddress, chain string) *CreateRescueTxRequest {
 	return &CreateRescueTxRequest{
 		Sender: sender,
+		Chain:  chain,
 	}
 }
 
@@ -28,6 +31,10 @@ func (m CreateRescueTxRequest) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
 	}
 
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
 	return nil
 }
 
@@ -8,9 +8,10 @@ import (
 )
 
 // NewLinkRequest - LinkRequest constructor
-func NewLinkRequest(sender sdk.AccAddress, recipientAddr string, recipientChain string) *LinkRequest {
+func NewLinkRequest(sender sdk.AccAddress, chain string, recipientAddr string, recipientChain string) *LinkRequest {
 	return &LinkRequest{
 		Sender:         sender,
+		Chain:          chain,
 		RecipientAddr:  recipientAddr,
 		RecipientChain: recipientChain,
 	}
@@ -32,6 +33,10 @@ func (m LinkRequest) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
 	}
 
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
 	if m.RecipientAddr == "" {
 		return fmt.Errorf("missing recipient address")
 	}
@@ -1,14 +1,17 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // NewSignTxRequest is the constructor for SignTxRequest
-func NewSignTxRequest(sender sdk.AccAddress, txType TxType) *SignTxRequest {
+func NewSignTxRequest(sender sdk.AccAddress, chain string, txType TxType) *SignTxRequest {
 	return &SignTxRequest{
 		Sender: sender,
+		Chain:  chain,
 		TxType: txType,
 	}
 }
@@ -29,6 +32,10 @@ func (m SignTxRequest) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
 	}
 
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
 	if err := m.TxType.Validate(); err != nil {
 		return sdkerrors.Wrap(ErrBitcoin, err.Error())
 	}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/btcsuite/btcd/btcec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -9,9 +11,10 @@ import (
 )
 
 // NewSubmitExternalSignatureRequest is the constructor for SubmitExternalSignatureRequest
-func NewSubmitExternalSignatureRequest(sender sdk.AccAddress, keyID string, signature []byte, sigHash []byte) *SubmitExternalSignatureRequest {
+func NewSubmitExternalSignatureRequest(sender sdk.AccAddress, chain string, keyID string, signature []byte, sigHash []byte) *SubmitExternalSignatureRequest {
 	return &SubmitExternalSignatureRequest{
 		Sender:    sender,
+		Chain:     chain,
 		KeyID:     tss.KeyID(keyID),
 		Signature: signature,
 		SigHash:   sigHash,
@@ -34,6 +37,10 @@ func (m SubmitExternalSignatureRequest) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
 	}
 
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
 	if err := m.KeyID.Validate(); err != nil {
 		return err
 	}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewSubmitPsbtRequest is the constructor for SubmitPsbtRequest
+func NewSubmitPsbtRequest(sender sdk.AccAddress, chain string, psbt []byte) *SubmitPsbtRequest {
+	return &SubmitPsbtRequest{
+		Sender: sender,
+		Chain:  chain,
+		Psbt:   psbt,
+	}
+}
+
+// Route returns the route for this message
+func (m SubmitPsbtRequest) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (m SubmitPsbtRequest) Type() string {
+	return "SubmitPsbt"
+}
+
+// ValidateBasic executes a stateless message validation
+func (m SubmitPsbtRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
+	packet, err := DecodePsbt(m.Psbt)
+	if err != nil {
+		return sdkerrors.Wrap(ErrBitcoin, err.Error())
+	}
+
+	for _, input := range packet.Inputs {
+		if len(input.PartialSigs) > 0 {
+			return nil
+		}
+	}
+
+	return sdkerrors.Wrap(ErrBitcoin, "partially signed transaction must contain at least one signature")
+}
+
+// GetSignBytes returns the message bytes that need to be signed
+func (m SubmitPsbtRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the set of signers for this message
+func (m SubmitPsbtRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
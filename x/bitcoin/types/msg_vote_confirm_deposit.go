@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/btcsuite/btcd/wire"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -9,9 +11,10 @@ import (
 )
 
 // NewVoteConfirmOutpointRequest - MsgVoteConfirmOutpoint constructor
-func NewVoteConfirmOutpointRequest(sender sdk.AccAddress, key exported.PollKey, outPoint wire.OutPoint, confirmed bool) *VoteConfirmOutpointRequest {
+func NewVoteConfirmOutpointRequest(sender sdk.AccAddress, chain string, key exported.PollKey, outPoint wire.OutPoint, confirmed bool) *VoteConfirmOutpointRequest {
 	return &VoteConfirmOutpointRequest{
 		Sender:    sender,
+		Chain:     chain,
 		PollKey:   key,
 		OutPoint:  outPoint.String(),
 		Confirmed: confirmed,
@@ -34,6 +37,10 @@ func (m VoteConfirmOutpointRequest) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
 	}
 
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
 	if _, err := OutPointFromStr(m.OutPoint); err != nil {
 		return sdkerrors.Wrap(err, "outpoint malformed")
 	}
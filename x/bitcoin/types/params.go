@@ -2,9 +2,11 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -12,7 +14,8 @@ import (
 )
 
 const (
-	dustLimit = 546
+	// bitcoin core's dust limit for P2PKH outputs
+	defaultDustLimit = 546
 )
 
 // Parameter keys
@@ -31,6 +34,12 @@ var (
 	KeyMinVoterCount                        = []byte("minVoterCount")
 	KeyMaxTxSize                            = []byte("maxTxSize")
 	KeyTransactionFeeRate                   = []byte("transactionFeeRate")
+	KeyDepositAddressExpiryPeriod           = []byte("depositAddressExpiryPeriod")
+	KeyDepositAddressGracePeriod            = []byte("depositAddressGracePeriod")
+	KeyChain                                = []byte("chain")
+	KeyBaseDenom                            = []byte("baseDenom")
+	KeyDustLimit                            = []byte("dustLimit")
+	KeyMinRelayTxFeeRate                    = []byte("minRelayTxFeeRate")
 )
 
 // KeyTable returns a subspace.KeyTable that has registered all parameter types in this module's parameter set
@@ -55,6 +64,12 @@ func DefaultParams() Params {
 		MinVoterCount:                        1,
 		MaxTxSize:                            1024 * 1024 / 3,                // 1/3 MiB
 		TransactionFeeRate:                   sdktypes.NewDecWithPrec(25, 5), // 0.025%
+		DepositAddressExpiryPeriod:           0,                              // never expire
+		DepositAddressGracePeriod:            1000,
+		Chain:                                exported.Bitcoin.Name,
+		BaseDenom:                            Satoshi,
+		DustLimit:                            defaultDustLimit,
+		MinRelayTxFeeRate:                    MinRelayTxFeeSatoshiPerByte,
 	}
 }
 
@@ -82,6 +97,12 @@ func (m *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMinVoterCount, &m.MinVoterCount, validateMinVoterCount),
 		paramtypes.NewParamSetPair(KeyMaxTxSize, &m.MaxTxSize, validateMaxTxSize),
 		paramtypes.NewParamSetPair(KeyTransactionFeeRate, &m.TransactionFeeRate, validateTransactionFeeRate),
+		paramtypes.NewParamSetPair(KeyDepositAddressExpiryPeriod, &m.DepositAddressExpiryPeriod, validateDepositAddressExpiryPeriod),
+		paramtypes.NewParamSetPair(KeyDepositAddressGracePeriod, &m.DepositAddressGracePeriod, validateDepositAddressGracePeriod),
+		paramtypes.NewParamSetPair(KeyChain, &m.Chain, validateChain),
+		paramtypes.NewParamSetPair(KeyBaseDenom, &m.BaseDenom, validateBaseDenom),
+		paramtypes.NewParamSetPair(KeyDustLimit, &m.DustLimit, validateDustLimit),
+		paramtypes.NewParamSetPair(KeyMinRelayTxFeeRate, &m.MinRelayTxFeeRate, validateMinRelayTxFeeRate),
 	}
 }
 
@@ -123,13 +144,8 @@ func validateMinOutputAmount(amount interface{}) error {
 		return fmt.Errorf("invalid parameter type for min output amount: %T", coin)
 	}
 
-	satoshi, err := ToSatoshiCoin(coin)
-	if err != nil {
-		return sdkerrors.Wrapf(types.ErrInvalidGenesis, "invalid min output amount with error %s", err.Error())
-	}
-
-	if satoshi.Amount.LT(sdktypes.NewInt(dustLimit)) {
-		return sdkerrors.Wrapf(types.ErrInvalidGenesis, "min output amount has to be greater than %d", dustLimit)
+	if err := coin.Validate(); err != nil || !coin.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrInvalidGenesis, "min output amount %s must be a positive coin", coin.String())
 	}
 
 	return nil
@@ -167,13 +183,23 @@ func validateMaxSecondaryOutputAmount(amount interface{}) error {
 		return fmt.Errorf("invalid parameter type for max secondary output amount: %T", coin)
 	}
 
-	satoshi, err := ToSatoshiCoin(coin)
+	if err := coin.Validate(); err != nil || !coin.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrInvalidGenesis, "max secondary output amount %s must be a positive coin", coin.String())
+	}
+
+	return nil
+}
+
+// validateOutputAmountAboveDustLimit checks that the given amount can be converted to the base denomination
+// and is not considered dust by the chain
+func validateOutputAmountAboveDustLimit(name string, amount sdktypes.DecCoin, baseDenom string, dustLimit int64) error {
+	coin, err := ToBaseCoin(amount, baseDenom)
 	if err != nil {
-		return sdkerrors.Wrapf(types.ErrInvalidGenesis, "invalid max secondary output amount with error %s", err.Error())
+		return sdkerrors.Wrapf(types.ErrInvalidGenesis, "invalid %s with error %s", name, err.Error())
 	}
 
-	if satoshi.Amount.LT(sdktypes.NewInt(dustLimit)) {
-		return sdkerrors.Wrapf(types.ErrInvalidGenesis, "max secondary output amount has to be greater than %d", dustLimit)
+	if coin.Amount.LT(sdktypes.NewInt(dustLimit)) {
+		return sdkerrors.Wrapf(types.ErrInvalidGenesis, "%s has to be greater than %d", name, dustLimit)
 	}
 
 	return nil
@@ -277,6 +303,84 @@ func validateTransactionFeeRate(i interface{}) error {
 	return nil
 }
 
+func validateDepositAddressExpiryPeriod(period interface{}) error {
+	p, ok := period.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for deposit address expiry period: %T", period)
+	}
+
+	if p < 0 {
+		return sdkerrors.Wrap(types.ErrInvalidGenesis, "deposit address expiry period must be >=0")
+	}
+
+	return nil
+}
+
+func validateDepositAddressGracePeriod(period interface{}) error {
+	p, ok := period.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for deposit address grace period: %T", period)
+	}
+
+	if p < 0 {
+		return sdkerrors.Wrap(types.ErrInvalidGenesis, "deposit address grace period must be >=0")
+	}
+
+	return nil
+}
+
+func validateChain(chain interface{}) error {
+	c, ok := chain.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for chain: %T", chain)
+	}
+
+	if strings.TrimSpace(c) == "" {
+		return sdkerrors.Wrap(types.ErrInvalidGenesis, "chain must not be empty")
+	}
+
+	return nil
+}
+
+func validateBaseDenom(denom interface{}) error {
+	d, ok := denom.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for base denom: %T", denom)
+	}
+
+	if err := sdktypes.ValidateDenom(d); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidGenesis, err.Error())
+	}
+
+	return nil
+}
+
+func validateDustLimit(limit interface{}) error {
+	l, ok := limit.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for dust limit: %T", limit)
+	}
+
+	if l <= 0 {
+		return sdkerrors.Wrap(types.ErrInvalidGenesis, "dust limit must be greater than 0")
+	}
+
+	return nil
+}
+
+func validateMinRelayTxFeeRate(rate interface{}) error {
+	r, ok := rate.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for min relay tx fee rate: %T", rate)
+	}
+
+	if r <= 0 {
+		return sdkerrors.Wrap(types.ErrInvalidGenesis, "min relay tx fee rate must be greater than 0")
+	}
+
+	return nil
+}
+
 // Validate checks the validity of the values of the parameter set
 func (m Params) Validate() error {
 	if err := validateConfirmationHeight(m.ConfirmationHeight); err != nil {
@@ -338,5 +442,37 @@ func (m Params) Validate() error {
 		return err
 	}
 
+	if err := validateDepositAddressExpiryPeriod(m.DepositAddressExpiryPeriod); err != nil {
+		return err
+	}
+
+	if err := validateDepositAddressGracePeriod(m.DepositAddressGracePeriod); err != nil {
+		return err
+	}
+
+	if err := validateChain(m.Chain); err != nil {
+		return err
+	}
+
+	if err := validateBaseDenom(m.BaseDenom); err != nil {
+		return err
+	}
+
+	if err := validateDustLimit(m.DustLimit); err != nil {
+		return err
+	}
+
+	if err := validateMinRelayTxFeeRate(m.MinRelayTxFeeRate); err != nil {
+		return err
+	}
+
+	if err := validateOutputAmountAboveDustLimit("min output amount", m.MinOutputAmount, m.BaseDenom, m.DustLimit); err != nil {
+		return err
+	}
+
+	if err := validateOutputAmountAboveDustLimit("max secondary output amount", m.MaxSecondaryOutputAmount, m.BaseDenom, m.DustLimit); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -43,6 +43,21 @@ type Params struct {
 	MinVoterCount                        int64                                  `protobuf:"varint,12,opt,name=min_voter_count,json=minVoterCount,proto3" json:"min_voter_count,omitempty"`
 	MaxTxSize                            int64                                  `protobuf:"varint,13,opt,name=max_tx_size,json=maxTxSize,proto3" json:"max_tx_size,omitempty"`
 	TransactionFeeRate                   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=transaction_fee_rate,json=transactionFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"transaction_fee_rate"`
+	// deposit_address_expiry_period is the number of blocks a deposit address
+	// stays valid after linking; 0 means deposit addresses never expire
+	DepositAddressExpiryPeriod int64 `protobuf:"varint,15,opt,name=deposit_address_expiry_period,json=depositAddressExpiryPeriod,proto3" json:"deposit_address_expiry_period,omitempty"`
+	// deposit_address_grace_period is the number of blocks after expiry during
+	// which late deposits are still confirmed and sent to rescue
+	DepositAddressGracePeriod int64 `protobuf:"varint,16,opt,name=deposit_address_grace_period,json=depositAddressGracePeriod,proto3" json:"deposit_address_grace_period,omitempty"`
+	// chain is the name of the UTXO chain the parameters apply to
+	Chain string `protobuf:"bytes,17,opt,name=chain,proto3" json:"chain,omitempty"`
+	// base_denom is the denomination of the chain's smallest unit, e.g. satoshi
+	BaseDenom string `protobuf:"bytes,18,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// dust_limit is the smallest output value in base_denom the chain relays
+	DustLimit int64 `protobuf:"varint,19,opt,name=dust_limit,json=dustLimit,proto3" json:"dust_limit,omitempty"`
+	// min_relay_tx_fee_rate is the fee rate in base_denom per virtual byte that
+	// consolidation and rescue transactions pay
+	MinRelayTxFeeRate int64 `protobuf:"varint,20,opt,name=min_relay_tx_fee_rate,json=minRelayTxFeeRate,proto3" json:"min_relay_tx_fee_rate,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -85,48 +100,56 @@ func init() {
 func init() { proto.RegisterFile("bitcoin/v1beta1/params.proto", fileDescriptor_c6ece90a3eaf5d5b) }
 
 var fileDescriptor_c6ece90a3eaf5d5b = []byte{
-	// 649 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xee, 0x0a, 0x82, 0x0c, 0x22, 0xb8, 0x40, 0xb2, 0x01, 0x5c, 0x1a, 0x63, 0x48, 0x4d, 0x64,
-	0x37, 0xa0, 0x07, 0x2f, 0x1e, 0x28, 0x68, 0x6c, 0x30, 0x48, 0x16, 0xe2, 0xc1, 0xcb, 0x38, 0xdd,
-	0x7d, 0x6c, 0x27, 0xed, 0xce, 0x34, 0x33, 0xd3, 0xba, 0xe5, 0xaf, 0xf0, 0x8f, 0xf2, 0xc0, 0x91,
-	0xa3, 0xf1, 0x40, 0x14, 0xfe, 0x11, 0x33, 0x3f, 0xb6, 0x22, 0xc1, 0x84, 0x53, 0xbb, 0xf3, 0x7d,
-	0xef, 0x7b, 0x6f, 0xde, 0xf7, 0xde, 0xa0, 0xb5, 0x36, 0x55, 0x29, 0xa7, 0x2c, 0x1e, 0x6e, 0xb5,
-	0x41, 0x91, 0xad, 0xb8, 0x4f, 0x04, 0x29, 0x64, 0xd4, 0x17, 0x5c, 0x71, 0x7f, 0xde, 0xa1, 0x91,
-	0x43, 0x57, 0x96, 0x72, 0x9e, 0x73, 0x83, 0xc5, 0xfa, 0x9f, 0xa5, 0xad, 0xac, 0xde, 0x14, 0x51,
-	0xa3, 0x3e, 0x38, 0x8d, 0x95, 0x30, 0xe5, 0xb2, 0xe0, 0x32, 0x6e, 0x13, 0x09, 0x63, 0x82, 0x11,
-	0xb5, 0xf8, 0x93, 0x81, 0xa2, 0x3d, 0xf9, 0x37, 0xb4, 0x23, 0x40, 0x76, 0x78, 0x2f, 0xb3, 0xf0,
-	0xd3, 0xef, 0xd3, 0x68, 0xea, 0xd0, 0xd4, 0xe4, 0xbf, 0x46, 0xd3, 0x0c, 0xd4, 0x57, 0x2e, 0xba,
-	0x81, 0x57, 0xf7, 0x1a, 0xb3, 0xdb, 0x41, 0x74, 0xa3, 0xbe, 0xe8, 0xc0, 0xe2, 0xcd, 0xc9, 0xb3,
-	0x8b, 0xf5, 0x5a, 0x52, 0xd1, 0xfd, 0x18, 0x2d, 0xa6, 0x9c, 0x9d, 0x50, 0x51, 0x10, 0x45, 0x39,
-	0xc3, 0x1d, 0xa0, 0x79, 0x47, 0x05, 0xf7, 0xea, 0x5e, 0x63, 0x32, 0xf1, 0xaf, 0x43, 0xef, 0x0d,
-	0xe2, 0x6f, 0xa3, 0x65, 0x01, 0x43, 0xae, 0x00, 0xf7, 0x78, 0xda, 0xa5, 0x2c, 0xc7, 0x7d, 0x10,
-	0x94, 0x67, 0xc1, 0x44, 0xdd, 0x6b, 0x4c, 0x24, 0x8b, 0x16, 0xfc, 0x60, 0xb1, 0x43, 0x03, 0xf9,
-	0x2f, 0x90, 0x2f, 0x69, 0x8e, 0xd3, 0x0e, 0xa4, 0x5d, 0x4c, 0x99, 0x02, 0x31, 0x24, 0xbd, 0x60,
-	0xd2, 0x04, 0x2c, 0x48, 0x9a, 0xef, 0x6a, 0xa0, 0xe5, 0xce, 0xfd, 0x03, 0xf4, 0xb8, 0xa0, 0x0c,
-	0xf3, 0x81, 0xea, 0x0f, 0x14, 0x26, 0x05, 0x1f, 0x30, 0x15, 0xdc, 0x37, 0xd7, 0x5a, 0x8b, 0x6c,
-	0xcb, 0x22, 0xdd, 0xb2, 0xf1, 0xd5, 0xf6, 0x20, 0xdd, 0xe5, 0x94, 0xb9, 0xab, 0xcd, 0x17, 0x94,
-	0x7d, 0x34, 0xb1, 0x3b, 0x26, 0xd4, 0xdf, 0x40, 0xf3, 0x05, 0x29, 0x31, 0x65, 0x5a, 0x2e, 0x35,
-	0x6a, 0x53, 0x26, 0xf5, 0x5c, 0x41, 0xca, 0x96, 0x3e, 0xdd, 0x35, 0x3c, 0x82, 0x56, 0x35, 0x4f,
-	0x42, 0xca, 0x59, 0x46, 0xc4, 0xe8, 0x46, 0x05, 0xd3, 0x77, 0xae, 0x20, 0x28, 0x48, 0x79, 0x54,
-	0xa9, 0xfc, 0x53, 0xca, 0x1b, 0x9d, 0x42, 0x2a, 0x10, 0xb8, 0x0b, 0x23, 0x2c, 0x40, 0x01, 0x33,
-	0x5d, 0x77, 0x2d, 0x7c, 0x60, 0xca, 0x0a, 0x2c, 0x65, 0x1f, 0x46, 0x49, 0x45, 0x70, 0x7d, 0x64,
-	0xe8, 0xb9, 0x0b, 0x27, 0x59, 0x26, 0x40, 0x4a, 0xdb, 0x4c, 0x46, 0x7a, 0x46, 0x4f, 0x1b, 0x82,
-	0xb3, 0x81, 0x30, 0x76, 0x05, 0x33, 0x5a, 0xac, 0xb9, 0xac, 0x2b, 0xfa, 0x79, 0xb1, 0x3e, 0xa7,
-	0x68, 0x01, 0xd1, 0x9e, 0x03, 0x93, 0x67, 0x56, 0x67, 0xc7, 0xca, 0xb4, 0x9c, 0xca, 0x3e, 0x8c,
-	0xb4, 0x71, 0x15, 0xeb, 0x96, 0x7c, 0x50, 0xfe, 0x37, 0x1f, 0xba, 0x7b, 0xbe, 0xb7, 0xe5, 0xed,
-	0xf9, 0x5a, 0x68, 0x61, 0xc8, 0x95, 0x9e, 0xa9, 0xf1, 0xac, 0x07, 0xb3, 0x6e, 0x9e, 0xcd, 0x2e,
-	0x8c, 0x1b, 0x7e, 0x5c, 0xe1, 0x95, 0xe9, 0x36, 0x6e, 0x7c, 0x6c, 0x4c, 0xa7, 0x0c, 0xeb, 0x59,
-	0x14, 0xce, 0xf4, 0x87, 0xce, 0x74, 0xca, 0x3e, 0xe9, 0x53, 0x6b, 0x7a, 0x88, 0x66, 0xb5, 0xe9,
-	0xaa, 0xc4, 0x92, 0x9e, 0x42, 0x30, 0x67, 0x38, 0x33, 0x05, 0x29, 0x8f, 0xcb, 0x23, 0x7a, 0x0a,
-	0xfe, 0x17, 0xb4, 0xa4, 0x04, 0x61, 0x92, 0xa4, 0xc6, 0xa8, 0x13, 0x00, 0x2c, 0x88, 0x82, 0xe0,
-	0x51, 0xdd, 0x6b, 0xcc, 0x34, 0x23, 0x77, 0xdb, 0x8d, 0x9c, 0xaa, 0xce, 0xa0, 0x1d, 0xa5, 0xbc,
-	0x88, 0xdd, 0x52, 0xdb, 0x9f, 0x4d, 0x99, 0x75, 0xdd, 0xce, 0xef, 0x41, 0x9a, 0xf8, 0xd7, 0xb4,
-	0xde, 0x01, 0x24, 0x44, 0x41, 0x33, 0x39, 0xfb, 0x1d, 0xd6, 0xce, 0x2e, 0x43, 0xef, 0xfc, 0x32,
-	0xf4, 0x7e, 0x5d, 0x86, 0xde, 0xb7, 0xab, 0xb0, 0x76, 0x7e, 0x15, 0xd6, 0x7e, 0x5c, 0x85, 0xb5,
-	0xcf, 0xaf, 0xae, 0x29, 0x93, 0x12, 0x7a, 0x44, 0xb8, 0xcd, 0x75, 0x5f, 0x9b, 0x29, 0x17, 0x10,
-	0x97, 0x71, 0xf5, 0xce, 0x98, 0x5c, 0xed, 0x29, 0xf3, 0x42, 0xbc, 0xfc, 0x13, 0x00, 0x00, 0xff,
-	0xff, 0xff, 0x74, 0x16, 0x3d, 0xc4, 0x04, 0x00, 0x00,
+	// 769 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5d, 0x6f, 0xe3, 0x44,
+	0x14, 0x8d, 0xd9, 0x6e, 0x97, 0x4c, 0x29, 0xe9, 0xba, 0xa9, 0x64, 0xb2, 0xad, 0x37, 0x42, 0x68,
+	0x15, 0x24, 0xd6, 0x66, 0x17, 0x1e, 0x78, 0x41, 0xa8, 0x69, 0xf8, 0x88, 0xb6, 0x2a, 0x95, 0x5b,
+	0xf1, 0xc0, 0xcb, 0x30, 0xb1, 0x6f, 0x9d, 0x51, 0xec, 0x99, 0x68, 0x66, 0x12, 0x9c, 0xfe, 0x0a,
+	0x7e, 0x56, 0x9f, 0x50, 0x1f, 0x11, 0x0f, 0x15, 0xb4, 0x7f, 0x04, 0xcd, 0x87, 0x43, 0x1a, 0x75,
+	0xa5, 0x3e, 0x25, 0xbe, 0xe7, 0xdc, 0x73, 0xef, 0x9c, 0x7b, 0x67, 0xd0, 0xfe, 0x88, 0xaa, 0x94,
+	0x53, 0x16, 0xcf, 0xdf, 0x8c, 0x40, 0x91, 0x37, 0xf1, 0x94, 0x08, 0x52, 0xca, 0x68, 0x2a, 0xb8,
+	0xe2, 0x7e, 0xcb, 0xa1, 0x91, 0x43, 0x3b, 0xed, 0x9c, 0xe7, 0xdc, 0x60, 0xb1, 0xfe, 0x67, 0x69,
+	0x9d, 0x17, 0xeb, 0x22, 0x6a, 0x31, 0x05, 0xa7, 0xd1, 0x09, 0x53, 0x2e, 0x4b, 0x2e, 0xe3, 0x11,
+	0x91, 0xb0, 0x24, 0x18, 0x51, 0x8b, 0x1f, 0xcc, 0x14, 0x2d, 0xe4, 0xff, 0xa9, 0x63, 0x01, 0x72,
+	0xcc, 0x8b, 0xcc, 0xc2, 0x9f, 0xfe, 0xd9, 0x44, 0x9b, 0xa7, 0xa6, 0x27, 0xff, 0x1b, 0xf4, 0x8c,
+	0x81, 0xfa, 0x9d, 0x8b, 0x49, 0xe0, 0x75, 0xbd, 0xde, 0xd6, 0xdb, 0x20, 0x5a, 0xeb, 0x2f, 0x3a,
+	0xb1, 0x78, 0x7f, 0xe3, 0xea, 0xe6, 0x65, 0x23, 0xa9, 0xe9, 0x7e, 0x8c, 0x76, 0x53, 0xce, 0x2e,
+	0xa8, 0x28, 0x89, 0xa2, 0x9c, 0xe1, 0x31, 0xd0, 0x7c, 0xac, 0x82, 0x0f, 0xba, 0x5e, 0x6f, 0x23,
+	0xf1, 0x57, 0xa1, 0x9f, 0x0c, 0xe2, 0xbf, 0x45, 0x7b, 0x02, 0xe6, 0x5c, 0x01, 0x2e, 0x78, 0x3a,
+	0xa1, 0x2c, 0xc7, 0x53, 0x10, 0x94, 0x67, 0xc1, 0x93, 0xae, 0xd7, 0x7b, 0x92, 0xec, 0x5a, 0xf0,
+	0xd8, 0x62, 0xa7, 0x06, 0xf2, 0xbf, 0x40, 0xbe, 0xa4, 0x39, 0x4e, 0xc7, 0x90, 0x4e, 0x30, 0x65,
+	0x0a, 0xc4, 0x9c, 0x14, 0xc1, 0x86, 0x49, 0xd8, 0x91, 0x34, 0x3f, 0xd2, 0xc0, 0xd0, 0xc5, 0xfd,
+	0x13, 0xf4, 0xbc, 0xa4, 0x0c, 0xf3, 0x99, 0x9a, 0xce, 0x14, 0x26, 0x25, 0x9f, 0x31, 0x15, 0x3c,
+	0x35, 0xc7, 0xda, 0x8f, 0xac, 0x65, 0x91, 0xb6, 0x6c, 0x79, 0xb4, 0x01, 0xa4, 0x47, 0x9c, 0x32,
+	0x77, 0xb4, 0x56, 0x49, 0xd9, 0xcf, 0x26, 0xf7, 0xd0, 0xa4, 0xfa, 0xaf, 0x50, 0xab, 0x24, 0x15,
+	0xa6, 0x4c, 0xcb, 0xa5, 0x46, 0x6d, 0xd3, 0x94, 0xde, 0x2e, 0x49, 0x35, 0xd4, 0xd1, 0x23, 0xc3,
+	0x23, 0xe8, 0x85, 0xe6, 0x49, 0x48, 0x39, 0xcb, 0x88, 0x58, 0xac, 0x75, 0xf0, 0xec, 0xd1, 0x1d,
+	0x04, 0x25, 0xa9, 0xce, 0x6a, 0x95, 0x7b, 0xad, 0x7c, 0xab, 0x4b, 0x48, 0x05, 0x02, 0x4f, 0x60,
+	0x81, 0x05, 0x28, 0x60, 0xc6, 0x75, 0x67, 0xe1, 0x87, 0xa6, 0xad, 0xc0, 0x52, 0xde, 0xc1, 0x22,
+	0xa9, 0x09, 0xce, 0x47, 0x86, 0x3e, 0x77, 0xe9, 0x24, 0xcb, 0x04, 0x48, 0x69, 0xcd, 0x64, 0xa4,
+	0x30, 0x7a, 0x7a, 0x20, 0x38, 0x9b, 0x09, 0x33, 0xae, 0xa0, 0xa9, 0xc5, 0xfa, 0x7b, 0xba, 0xa3,
+	0xbf, 0x6f, 0x5e, 0x6e, 0x2b, 0x5a, 0x42, 0x34, 0x70, 0x60, 0xf2, 0x99, 0xd5, 0x39, 0xb4, 0x32,
+	0x43, 0xa7, 0xf2, 0x0e, 0x16, 0x7a, 0x70, 0x35, 0xeb, 0x81, 0x7a, 0x50, 0xbd, 0xb7, 0x1e, 0x7a,
+	0x7c, 0xbd, 0xef, 0xab, 0x87, 0xeb, 0x0d, 0xd1, 0xce, 0x9c, 0x2b, 0xbd, 0x53, 0xcb, 0x5d, 0x0f,
+	0xb6, 0xdc, 0x3e, 0x9b, 0xbb, 0xb0, 0x34, 0xfc, 0xbc, 0xc6, 0xeb, 0xa1, 0xdb, 0xbc, 0x65, 0xd8,
+	0x0c, 0x9d, 0x32, 0xac, 0x77, 0x51, 0xb8, 0xa1, 0x7f, 0xe4, 0x86, 0x4e, 0xd9, 0x2f, 0x3a, 0x6a,
+	0x87, 0x1e, 0xa2, 0x2d, 0x3d, 0x74, 0x55, 0x61, 0x49, 0x2f, 0x21, 0xd8, 0x36, 0x9c, 0x66, 0x49,
+	0xaa, 0xf3, 0xea, 0x8c, 0x5e, 0x82, 0xff, 0x1b, 0x6a, 0x2b, 0x41, 0x98, 0x24, 0xa9, 0x19, 0xd4,
+	0x05, 0x00, 0x16, 0x44, 0x41, 0xf0, 0x71, 0xd7, 0xeb, 0x35, 0xfb, 0x91, 0x3b, 0xed, 0xab, 0x9c,
+	0xaa, 0xf1, 0x6c, 0x14, 0xa5, 0xbc, 0x8c, 0xdd, 0xa5, 0xb6, 0x3f, 0xaf, 0x65, 0x36, 0x71, 0x77,
+	0x7e, 0x00, 0x69, 0xe2, 0xaf, 0x68, 0xfd, 0x00, 0x90, 0x10, 0x05, 0xfe, 0x21, 0x3a, 0xc8, 0x60,
+	0xca, 0x25, 0x55, 0x2b, 0x2e, 0x4f, 0xa9, 0x58, 0xd4, 0x5b, 0xd1, 0x32, 0x3d, 0x75, 0x1c, 0x69,
+	0x69, 0xa1, 0xa6, 0xb8, 0xbd, 0xf8, 0x0e, 0xed, 0xaf, 0x4b, 0xe4, 0x82, 0xa4, 0x50, 0x2b, 0xec,
+	0x18, 0x85, 0x4f, 0xee, 0x2b, 0xfc, 0xa8, 0x19, 0x4e, 0xa0, 0x8d, 0x9e, 0xa6, 0x63, 0x42, 0x59,
+	0xf0, 0x5c, 0x1f, 0x2b, 0xb1, 0x1f, 0xfe, 0x01, 0x42, 0x7a, 0xcb, 0x71, 0x06, 0x8c, 0x97, 0x81,
+	0x6f, 0xa0, 0xa6, 0x8e, 0x0c, 0x74, 0x40, 0xc3, 0xd9, 0x4c, 0x2a, 0x5c, 0xd0, 0x92, 0xaa, 0x60,
+	0xd7, 0x3a, 0xa7, 0x23, 0xc7, 0x3a, 0xe0, 0x7f, 0x89, 0xf6, 0xf4, 0x04, 0x04, 0x14, 0x64, 0xa1,
+	0xfd, 0x5d, 0x5a, 0xd7, 0x36, 0x4c, 0x7d, 0xc7, 0x13, 0x8d, 0x9d, 0x57, 0xce, 0x89, 0x7e, 0x72,
+	0xf5, 0x6f, 0xd8, 0xb8, 0xba, 0x0d, 0xbd, 0xeb, 0xdb, 0xd0, 0xfb, 0xe7, 0x36, 0xf4, 0xfe, 0xb8,
+	0x0b, 0x1b, 0xd7, 0x77, 0x61, 0xe3, 0xaf, 0xbb, 0xb0, 0xf1, 0xeb, 0xd7, 0x2b, 0x1e, 0x93, 0x0a,
+	0x0a, 0x22, 0xdc, 0x1b, 0xe6, 0xbe, 0x5e, 0xa7, 0x5c, 0x40, 0x5c, 0xc5, 0xf5, 0x8b, 0x6b, 0x5c,
+	0x1f, 0x6d, 0x9a, 0xb7, 0xf2, 0xab, 0xff, 0x06, 0x00, 0x75, 0xb2, 0x77, 0x09, 0xce, 0x05, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -149,6 +172,50 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinRelayTxFeeRate != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinRelayTxFeeRate))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.DustLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DustLimit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.DepositAddressGracePeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DepositAddressGracePeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.DepositAddressExpiryPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DepositAddressExpiryPeriod))
+		i--
+		dAtA[i] = 0x78
+	}
 	{
 		size := m.TransactionFeeRate.Size()
 		i -= size
@@ -301,6 +368,26 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.TransactionFeeRate.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.DepositAddressExpiryPeriod != 0 {
+		n += 1 + sovParams(uint64(m.DepositAddressExpiryPeriod))
+	}
+	if m.DepositAddressGracePeriod != 0 {
+		n += 2 + sovParams(uint64(m.DepositAddressGracePeriod))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.DustLimit != 0 {
+		n += 2 + sovParams(uint64(m.DustLimit))
+	}
+	if m.MinRelayTxFeeRate != 0 {
+		n += 2 + sovParams(uint64(m.MinRelayTxFeeRate))
+	}
 	return n
 }
 
@@ -676,6 +763,146 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddressExpiryPeriod", wireType)
+			}
+			m.DepositAddressExpiryPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositAddressExpiryPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddressGracePeriod", wireType)
+			}
+			m.DepositAddressGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositAddressGracePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustLimit", wireType)
+			}
+			m.DustLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DustLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRelayTxFeeRate", wireType)
+			}
+			m.MinRelayTxFeeRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinRelayTxFeeRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
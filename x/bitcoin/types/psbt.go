@@ -0,0 +1,136 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/btcsuite/btcutil/psbt"
+)
+
+// NewPsbt returns a BIP-174 partially signed bitcoin transaction for the given unsigned transaction,
+// with the witness UTXO, witness script and sighash type set for every input
+func NewPsbt(tx *wire.MsgTx, outPointsToSign []OutPointToSign, network Network) (*psbt.Packet, error) {
+	// non-witness inputs would require the full previous transactions, which are not stored
+	if network.SegwitDisabled {
+		return nil, fmt.Errorf("partially signed transactions are not supported on network %s without segwit", network.Name)
+	}
+
+	if len(tx.TxIn) != len(outPointsToSign) {
+		return nil, fmt.Errorf("expected %d outpoints to sign, got %d", len(tx.TxIn), len(outPointsToSign))
+	}
+
+	packet, err := psbt.NewFromUnsignedTx(tx.Copy())
+	if err != nil {
+		return nil, err
+	}
+
+	updater, err := psbt.NewUpdater(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, outPointToSign := range outPointsToSign {
+		payScript, err := txscript.PayToAddrScript(outPointToSign.AddressInfo.GetAddress())
+		if err != nil {
+			return nil, err
+		}
+
+		if err := updater.AddInWitnessUtxo(wire.NewTxOut(int64(outPointToSign.OutPointInfo.Amount), payScript), i); err != nil {
+			return nil, err
+		}
+
+		if err := updater.AddInWitnessScript(outPointToSign.RedeemScript, i); err != nil {
+			return nil, err
+		}
+
+		if err := updater.AddInSighashType(txscript.SigHashAll, i); err != nil {
+			return nil, err
+		}
+	}
+
+	return packet, nil
+}
+
+// AddPsbtKeyPath adds the BIP-32 derivation of the given public key to every input whose witness script contains the key
+func AddPsbtKeyPath(packet *psbt.Packet, pubKey []byte, masterKeyFingerprint uint32, path []uint32) error {
+	updater, err := psbt.NewUpdater(packet)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for i, input := range packet.Inputs {
+		pushes, err := txscript.PushedData(input.WitnessScript)
+		if err != nil {
+			return err
+		}
+
+		for _, push := range pushes {
+			if !bytes.Equal(push, pubKey) {
+				continue
+			}
+
+			if err := updater.AddInBip32Derivation(masterKeyFingerprint, path, pubKey, i); err != nil {
+				return err
+			}
+
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("public key %x is not required by any input", pubKey)
+	}
+
+	return nil
+}
+
+// EncodePsbt serializes the given partially signed bitcoin transaction
+func EncodePsbt(packet *psbt.Packet) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := packet.Serialize(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// DecodePsbt deserializes the given bytes to a partially signed bitcoin transaction
+func DecodePsbt(bz []byte) (*psbt.Packet, error) {
+	return psbt.NewFromRawBytes(bytes.NewReader(bz), false)
+}
+
+// ParseBip32Path parses a BIP-32 derivation path of the form m/48'/0'/0'/2'
+func ParseBip32Path(str string) ([]uint32, error) {
+	segments := strings.Split(strings.TrimSpace(str), "/")
+	if len(segments) < 2 || segments[0] != "m" {
+		return nil, fmt.Errorf("derivation path %s must start with m/", str)
+	}
+
+	path := make([]uint32, len(segments)-1)
+	for i, segment := range segments[1:] {
+		offset := uint32(0)
+		if strings.HasSuffix(segment, "'") || strings.HasSuffix(segment, "h") {
+			offset = hdkeychain.HardenedKeyStart
+			segment = segment[:len(segment)-1]
+		}
+
+		index, err := strconv.ParseUint(segment, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid derivation path segment %s", segments[i+1])
+		}
+		if uint32(index) >= hdkeychain.HardenedKeyStart {
+			return nil, fmt.Errorf("derivation path index %d out of range", index)
+		}
+
+		path[i] = uint32(index) + offset
+	}
+
+	return path, nil
+}
@@ -220,52 +220,141 @@ func (m *QueryTxResponse_SigningInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTxResponse_SigningInfo proto.InternalMessageInfo
 
+// QueryPsbtResponse contains the BIP-174 partially signed bitcoin transaction
+// of an unsigned transaction that is waiting for external signatures
+type QueryPsbtResponse struct {
+	Psbt   []byte   `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	Status TxStatus `protobuf:"varint,2,opt,name=status,proto3,enum=bitcoin.v1beta1.TxStatus" json:"status,omitempty"`
+}
+
+func (m *QueryPsbtResponse) Reset()         { *m = QueryPsbtResponse{} }
+func (m *QueryPsbtResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPsbtResponse) ProtoMessage()    {}
+func (*QueryPsbtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47f1bd927442f8a6, []int{4}
+}
+func (m *QueryPsbtResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPsbtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPsbtResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPsbtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPsbtResponse.Merge(m, src)
+}
+func (m *QueryPsbtResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPsbtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPsbtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPsbtResponse proto.InternalMessageInfo
+
+// QueryDepositAddressExpiryResponse contains the expiry of a deposit address;
+// expires_at is 0 if the deposit address never expires
+type QueryDepositAddressExpiryResponse struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	PruneAt   int64  `protobuf:"varint,3,opt,name=prune_at,json=pruneAt,proto3" json:"prune_at,omitempty"`
+	Expired   bool   `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+}
+
+func (m *QueryDepositAddressExpiryResponse) Reset()         { *m = QueryDepositAddressExpiryResponse{} }
+func (m *QueryDepositAddressExpiryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositAddressExpiryResponse) ProtoMessage()    {}
+func (*QueryDepositAddressExpiryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47f1bd927442f8a6, []int{5}
+}
+func (m *QueryDepositAddressExpiryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositAddressExpiryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositAddressExpiryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDepositAddressExpiryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositAddressExpiryResponse.Merge(m, src)
+}
+func (m *QueryDepositAddressExpiryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositAddressExpiryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositAddressExpiryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepositAddressExpiryResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*DepositQueryParams)(nil), "bitcoin.v1beta1.DepositQueryParams")
 	proto.RegisterType((*QueryAddressResponse)(nil), "bitcoin.v1beta1.QueryAddressResponse")
 	proto.RegisterType((*QueryDepositStatusResponse)(nil), "bitcoin.v1beta1.QueryDepositStatusResponse")
 	proto.RegisterType((*QueryTxResponse)(nil), "bitcoin.v1beta1.QueryTxResponse")
 	proto.RegisterType((*QueryTxResponse_SigningInfo)(nil), "bitcoin.v1beta1.QueryTxResponse.SigningInfo")
+	proto.RegisterType((*QueryPsbtResponse)(nil), "bitcoin.v1beta1.QueryPsbtResponse")
+	proto.RegisterType((*QueryDepositAddressExpiryResponse)(nil), "bitcoin.v1beta1.QueryDepositAddressExpiryResponse")
 }
 
 func init() { proto.RegisterFile("bitcoin/v1beta1/query.proto", fileDescriptor_47f1bd927442f8a6) }
 
 var fileDescriptor_47f1bd927442f8a6 = []byte{
-	// 542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x4c,
-	0x14, 0xc5, 0xe3, 0xe6, 0x4b, 0x3e, 0x98, 0xfe, 0xd5, 0x90, 0x22, 0x13, 0x24, 0x37, 0x0a, 0x9b,
-	0x2c, 0xa8, 0xad, 0xb4, 0x08, 0x89, 0x25, 0x25, 0x42, 0x04, 0x16, 0xb4, 0x4e, 0xc4, 0x02, 0x09,
-	0x59, 0x13, 0xfb, 0xc6, 0x19, 0xa5, 0x99, 0x71, 0x67, 0xc6, 0xc1, 0x7e, 0x05, 0x36, 0xf0, 0x58,
-	0x5d, 0x76, 0xc9, 0xaa, 0x82, 0xe4, 0x2d, 0x58, 0x21, 0x8f, 0xa7, 0xb4, 0x4a, 0x24, 0xc4, 0x6e,
-	0xee, 0xfc, 0xce, 0x3d, 0x3e, 0x73, 0xe5, 0x8b, 0x1e, 0x8f, 0xa8, 0x0a, 0x39, 0x65, 0xde, 0xbc,
-	0x3b, 0x02, 0x45, 0xba, 0xde, 0x45, 0x0a, 0x22, 0x77, 0x13, 0xc1, 0x15, 0xc7, 0xbb, 0x06, 0xba,
-	0x06, 0x36, 0x1b, 0x31, 0x8f, 0xb9, 0x66, 0x5e, 0x71, 0x2a, 0x65, 0xcd, 0x35, 0x0f, 0x95, 0x27,
-	0x20, 0x4b, 0xd8, 0xee, 0x21, 0xdc, 0x83, 0x84, 0x4b, 0xaa, 0xce, 0x0a, 0xe7, 0x53, 0x22, 0xc8,
-	0x4c, 0x62, 0x1b, 0xfd, 0x4f, 0xa2, 0x48, 0x80, 0x94, 0xb6, 0xd5, 0xb2, 0x3a, 0xf7, 0xfd, 0x9b,
-	0x12, 0x37, 0x50, 0x2d, 0x9c, 0x10, 0xca, 0xec, 0x0d, 0x7d, 0x5f, 0x16, 0xed, 0xaf, 0x16, 0x6a,
-	0xe8, 0xfe, 0x97, 0xa5, 0xcc, 0x07, 0x99, 0x70, 0x26, 0xe1, 0x2f, 0x46, 0x9f, 0x50, 0x7d, 0x0a,
-	0x79, 0x40, 0xa3, 0xd2, 0xe9, 0xe4, 0xf5, 0xe2, 0xfa, 0xa0, 0xf6, 0x0e, 0xf2, 0x7e, 0xef, 0xd7,
-	0xf5, 0xc1, 0x8b, 0x98, 0xaa, 0x49, 0x3a, 0x72, 0x43, 0x3e, 0xf3, 0x48, 0x06, 0xe7, 0x44, 0x30,
-	0x50, 0x9f, 0xb9, 0x98, 0x9a, 0xea, 0x30, 0xe4, 0x02, 0xbc, 0xcc, 0x53, 0x52, 0x7a, 0x90, 0x25,
-	0x5c, 0x28, 0x88, 0x5c, 0xdd, 0xec, 0xd7, 0xa6, 0x90, 0xf7, 0xa3, 0xf6, 0x18, 0x35, 0x75, 0x20,
-	0xf3, 0xb8, 0x81, 0x22, 0x2a, 0xbd, 0x8d, 0xb5, 0x87, 0xaa, 0xe7, 0x3c, 0x36, 0x91, 0x8a, 0x23,
-	0x7e, 0x8e, 0xea, 0x52, 0x6b, 0x74, 0x9c, 0x9d, 0x23, 0xc7, 0x5d, 0x19, 0xae, 0xfb, 0x3e, 0x55,
-	0xa7, 0x9c, 0x32, 0x6d, 0x05, 0xbe, 0x51, 0xb7, 0xbf, 0x54, 0xd1, 0xae, 0xfe, 0xd0, 0x30, 0xfb,
-	0xe3, 0xbe, 0x83, 0x36, 0x54, 0x66, 0xcc, 0x37, 0x54, 0x86, 0xbb, 0x2b, 0xde, 0x8f, 0xd6, 0xbc,
-	0x87, 0x99, 0x09, 0x68, 0x84, 0xf8, 0x18, 0xed, 0x87, 0x9c, 0x8d, 0xa9, 0x98, 0x11, 0x45, 0x39,
-	0x0b, 0x04, 0x5c, 0xa4, 0x54, 0x40, 0x64, 0x57, 0x5b, 0x56, 0xe7, 0x9e, 0xdf, 0xb8, 0x0b, 0x7d,
-	0xc3, 0xf0, 0x21, 0x7a, 0x90, 0x08, 0x98, 0x07, 0x92, 0xc6, 0x0c, 0xa2, 0x40, 0x65, 0xc1, 0x84,
-	0xc8, 0x89, 0xfd, 0x9f, 0x0e, 0xb2, 0x57, 0xa0, 0x81, 0x26, 0xc3, 0xec, 0x0d, 0x91, 0x13, 0xdc,
-	0x45, 0xfb, 0x84, 0xe5, 0x9c, 0x41, 0x10, 0x12, 0x16, 0xc8, 0x04, 0x58, 0x14, 0xcc, 0x79, 0xaa,
-	0xec, 0x5a, 0xcb, 0xea, 0x6c, 0xfb, 0xb8, 0x84, 0xaf, 0x08, 0x1b, 0x14, 0xe8, 0x03, 0x4f, 0x15,
-	0x3e, 0x43, 0xdb, 0x85, 0x39, 0x65, 0x71, 0x40, 0xd9, 0x98, 0x4b, 0xbb, 0xde, 0xaa, 0x76, 0x36,
-	0x8f, 0x9e, 0xae, 0x3d, 0x68, 0x65, 0x24, 0xee, 0xa0, 0xec, 0xea, 0xb3, 0x31, 0xf7, 0xb7, 0xe4,
-	0x6d, 0x21, 0x9b, 0x6f, 0xd1, 0xe6, 0x1d, 0x88, 0x9f, 0xa0, 0x6d, 0x01, 0x11, 0xc0, 0x2c, 0x90,
-	0xa1, 0xa0, 0x89, 0x32, 0x63, 0xdc, 0x2a, 0x2f, 0x07, 0xfa, 0x0e, 0x3f, 0x44, 0x75, 0x32, 0xe3,
-	0x29, 0x53, 0x7a, 0xa0, 0x55, 0xdf, 0x54, 0x27, 0xfe, 0xe5, 0x4f, 0xa7, 0x72, 0xb9, 0x70, 0xac,
-	0xab, 0x85, 0x63, 0xfd, 0x58, 0x38, 0xd6, 0xb7, 0xa5, 0x53, 0xb9, 0x5a, 0x3a, 0x95, 0xef, 0x4b,
-	0xa7, 0xf2, 0xf1, 0xd9, 0x3f, 0xfe, 0x54, 0x37, 0xeb, 0xa2, 0xd7, 0x64, 0x54, 0xd7, 0x7b, 0x72,
-	0xfc, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x1e, 0x99, 0xe3, 0x8a, 0x03, 0x00, 0x00,
+	// 618 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4f, 0xdb, 0x30,
+	0x18, 0x6d, 0x28, 0x2d, 0x60, 0x7e, 0xce, 0x2b, 0x53, 0xe8, 0xb4, 0xd0, 0x75, 0x97, 0x1e, 0x46,
+	0xa2, 0xc2, 0x34, 0x69, 0x47, 0x18, 0x9b, 0xc6, 0x76, 0x18, 0xa4, 0x68, 0x07, 0xa4, 0x29, 0x72,
+	0x93, 0xaf, 0xad, 0x05, 0xb5, 0x83, 0xed, 0xb0, 0xe4, 0x5f, 0xd8, 0x65, 0xdb, 0x7f, 0xc5, 0x91,
+	0xe3, 0x4e, 0x68, 0x2b, 0xff, 0xc5, 0x4e, 0x53, 0x1c, 0x17, 0x10, 0x95, 0x26, 0xb4, 0x9b, 0xdf,
+	0xf7, 0x9e, 0x5f, 0x9e, 0x3f, 0x7f, 0x31, 0x7a, 0xdc, 0xa5, 0x2a, 0xe4, 0x94, 0x79, 0x67, 0xed,
+	0x2e, 0x28, 0xd2, 0xf6, 0x4e, 0x13, 0x10, 0x99, 0x1b, 0x0b, 0xae, 0x38, 0x5e, 0x36, 0xa4, 0x6b,
+	0xc8, 0x7a, 0xad, 0xcf, 0xfb, 0x5c, 0x73, 0x5e, 0xbe, 0x2a, 0x64, 0xf5, 0x09, 0x0f, 0x95, 0xc5,
+	0x20, 0x0b, 0xb2, 0xb9, 0x8b, 0xf0, 0x2e, 0xc4, 0x5c, 0x52, 0x75, 0x90, 0x3b, 0xef, 0x13, 0x41,
+	0x86, 0x12, 0xdb, 0x68, 0x86, 0x44, 0x91, 0x00, 0x29, 0x6d, 0xab, 0x61, 0xb5, 0xe6, 0xfc, 0x31,
+	0xc4, 0x35, 0x54, 0x09, 0x07, 0x84, 0x32, 0x7b, 0x4a, 0xd7, 0x0b, 0xd0, 0xfc, 0x66, 0xa1, 0x9a,
+	0xde, 0xbf, 0x5d, 0xc8, 0x7c, 0x90, 0x31, 0x67, 0x12, 0xfe, 0x61, 0xf4, 0x19, 0x55, 0x8f, 0x21,
+	0x0b, 0x68, 0x54, 0x38, 0xed, 0xbc, 0x1d, 0x5d, 0xae, 0x57, 0x3e, 0x40, 0xb6, 0xb7, 0xfb, 0xe7,
+	0x72, 0xfd, 0x55, 0x9f, 0xaa, 0x41, 0xd2, 0x75, 0x43, 0x3e, 0xf4, 0x48, 0x0a, 0x27, 0x44, 0x30,
+	0x50, 0x5f, 0xb8, 0x38, 0x36, 0x68, 0x23, 0xe4, 0x02, 0xbc, 0xd4, 0x53, 0x52, 0x7a, 0x90, 0xc6,
+	0x5c, 0x28, 0x88, 0x5c, 0xbd, 0xd9, 0xaf, 0x1c, 0x43, 0xb6, 0x17, 0x35, 0x7b, 0xa8, 0xae, 0x03,
+	0x99, 0xc3, 0x75, 0x14, 0x51, 0xc9, 0x4d, 0xac, 0x15, 0x54, 0x3e, 0xe1, 0x7d, 0x13, 0x29, 0x5f,
+	0xe2, 0x97, 0xa8, 0x2a, 0xb5, 0x46, 0xc7, 0x59, 0xda, 0x74, 0xdc, 0x3b, 0xcd, 0x75, 0x3f, 0x26,
+	0x6a, 0x9f, 0x53, 0xa6, 0xad, 0xc0, 0x37, 0xea, 0xe6, 0xd7, 0x32, 0x5a, 0xd6, 0x1f, 0x3a, 0x4c,
+	0xaf, 0xdd, 0x97, 0xd0, 0x94, 0x4a, 0x8d, 0xf9, 0x94, 0x4a, 0x71, 0xfb, 0x8e, 0xf7, 0xda, 0x84,
+	0xf7, 0x61, 0x6a, 0x02, 0x1a, 0x21, 0xde, 0x42, 0xab, 0x21, 0x67, 0x3d, 0x2a, 0x86, 0x44, 0x51,
+	0xce, 0x02, 0x01, 0xa7, 0x09, 0x15, 0x10, 0xd9, 0xe5, 0x86, 0xd5, 0x9a, 0xf5, 0x6b, 0xb7, 0x49,
+	0xdf, 0x70, 0x78, 0x03, 0x3d, 0x8c, 0x05, 0x9c, 0x05, 0x92, 0xf6, 0x19, 0x44, 0x81, 0x4a, 0x83,
+	0x01, 0x91, 0x03, 0x7b, 0x5a, 0x07, 0x59, 0xc9, 0xa9, 0x8e, 0x66, 0x0e, 0xd3, 0x77, 0x44, 0x0e,
+	0x70, 0x1b, 0xad, 0x12, 0x96, 0x71, 0x06, 0x41, 0x48, 0x58, 0x20, 0x63, 0x60, 0x51, 0x70, 0xc6,
+	0x13, 0x65, 0x57, 0x1a, 0x56, 0x6b, 0xd1, 0xc7, 0x05, 0xf9, 0x9a, 0xb0, 0x4e, 0x4e, 0x7d, 0xe2,
+	0x89, 0xc2, 0x07, 0x68, 0x31, 0x37, 0xa7, 0xac, 0x1f, 0x50, 0xd6, 0xe3, 0xd2, 0xae, 0x36, 0xca,
+	0xad, 0xf9, 0xcd, 0xe7, 0x13, 0x07, 0xba, 0xd3, 0x12, 0xb7, 0x53, 0xec, 0xda, 0x63, 0x3d, 0xee,
+	0x2f, 0xc8, 0x1b, 0x20, 0xeb, 0xef, 0xd1, 0xfc, 0x2d, 0x12, 0x3f, 0x43, 0x8b, 0x02, 0x22, 0x80,
+	0x61, 0x20, 0x43, 0x41, 0x63, 0x65, 0xda, 0xb8, 0x50, 0x14, 0x3b, 0xba, 0x86, 0x1f, 0xa1, 0x2a,
+	0x19, 0xf2, 0x84, 0x29, 0xdd, 0xd0, 0xb2, 0x6f, 0x50, 0xf3, 0x08, 0x3d, 0x28, 0xa6, 0x58, 0x76,
+	0xd5, 0xf5, 0x6d, 0x60, 0x34, 0x1d, 0xcb, 0x6e, 0x61, 0xb4, 0xe0, 0xeb, 0xf5, 0x7f, 0xdc, 0x48,
+	0xf3, 0x87, 0x85, 0x9e, 0xde, 0x9e, 0x28, 0x33, 0xe9, 0x6f, 0xd2, 0x98, 0x8a, 0xec, 0x1e, 0xf3,
+	0xfe, 0x04, 0x21, 0xc8, 0xb5, 0x20, 0x03, 0x32, 0xce, 0x3d, 0x67, 0x2a, 0xdb, 0x0a, 0xaf, 0xa1,
+	0xd9, 0x58, 0x24, 0x0c, 0x72, 0xb2, 0xac, 0xc9, 0x19, 0x8d, 0xb7, 0x55, 0xee, 0x59, 0xe8, 0x22,
+	0x7d, 0x95, 0xb3, 0xfe, 0x18, 0xee, 0xf8, 0xe7, 0xbf, 0x9d, 0xd2, 0xf9, 0xc8, 0xb1, 0x2e, 0x46,
+	0x8e, 0xf5, 0x6b, 0xe4, 0x58, 0xdf, 0xaf, 0x9c, 0xd2, 0xc5, 0x95, 0x53, 0xfa, 0x79, 0xe5, 0x94,
+	0x8e, 0x5e, 0xdc, 0xf3, 0x27, 0x1a, 0x3f, 0x0f, 0xfa, 0x59, 0xe8, 0x56, 0xf5, 0xbb, 0xb0, 0xf5,
+	0x77, 0x00, 0x20, 0x33, 0xee, 0xa2, 0x7a, 0x04, 0x00, 0x00,
 }
 
 func (m *DepositQueryParams) Marshal() (dAtA []byte, err error) {
@@ -483,6 +572,91 @@ func (m *QueryTxResponse_SigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPsbtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPsbtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPsbtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Psbt) > 0 {
+		i -= len(m.Psbt)
+		copy(dAtA[i:], m.Psbt)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Psbt)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositAddressExpiryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositAddressExpiryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositAddressExpiryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PruneAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PruneAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -592,6 +766,44 @@ func (m *QueryTxResponse_SigningInfo) Size() (n int) {
 	return n
 }
 
+func (m *QueryPsbtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Psbt)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryDepositAddressExpiryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovQuery(uint64(m.ExpiresAt))
+	}
+	if m.PruneAt != 0 {
+		n += 1 + sovQuery(uint64(m.PruneAt))
+	}
+	if m.Expired {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1234,6 +1446,249 @@ func (m *QueryTxResponse_SigningInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPsbtResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPsbtResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPsbtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Psbt = append(m.Psbt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Psbt == nil {
+				m.Psbt = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TxStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositAddressExpiryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositAddressExpiryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositAddressExpiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneAt", wireType)
+			}
+			m.PruneAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruneAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
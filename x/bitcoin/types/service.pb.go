@@ -35,41 +35,42 @@ func init() {
 }
 
 var fileDescriptor_6065c0ad9b83e388 = []byte{
-	// 530 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4d, 0x8b, 0xd3, 0x40,
-	0x18, 0xc7, 0x1b, 0x91, 0x05, 0x73, 0x70, 0x61, 0x14, 0x76, 0x29, 0xdd, 0x28, 0x01, 0x77, 0x75,
-	0x6b, 0x32, 0xad, 0x2e, 0x1e, 0xf6, 0xe8, 0xe2, 0xcd, 0x45, 0x69, 0x8b, 0x07, 0x2f, 0x32, 0x8d,
-	0xcf, 0x66, 0x87, 0xb6, 0x33, 0x71, 0xe6, 0x49, 0x8d, 0x88, 0x17, 0x4f, 0x7a, 0x11, 0xc1, 0x6f,
-	0xe2, 0xc5, 0xab, 0x47, 0x8f, 0x0b, 0x5e, 0x3c, 0x4a, 0xeb, 0x07, 0x91, 0x4e, 0x66, 0x14, 0x9a,
-	0x8d, 0xad, 0xb7, 0x24, 0xff, 0xb7, 0x5f, 0x86, 0x10, 0x7f, 0x67, 0xc8, 0x31, 0x91, 0x5c, 0xd0,
-	0x69, 0x77, 0x08, 0xc8, 0xba, 0x54, 0x83, 0x9a, 0xf2, 0x04, 0xe2, 0x4c, 0x49, 0x94, 0x64, 0xd3,
-	0xca, 0xb1, 0x95, 0x9b, 0x57, 0x53, 0x99, 0x4a, 0xa3, 0xd1, 0xc5, 0x55, 0x69, 0x6b, 0xb6, 0x52,
-	0x29, 0xd3, 0x31, 0x50, 0x96, 0x71, 0xca, 0x84, 0x90, 0xc8, 0x90, 0x4b, 0xa1, 0xad, 0xba, 0xbd,
-	0xbc, 0x81, 0x45, 0xa9, 0xdc, 0x79, 0x7f, 0xc9, 0xf7, 0x8f, 0x75, 0xda, 0x2f, 0x37, 0xc9, 0xd4,
-	0xbf, 0xf8, 0x90, 0x8b, 0x11, 0x69, 0xc5, 0x4b, 0xb3, 0xf1, 0xe2, 0x71, 0x0f, 0x5e, 0xe4, 0xa0,
-	0xb1, 0xb9, 0x53, 0xa3, 0xea, 0x4c, 0x0a, 0x0d, 0x61, 0xf7, 0xed, 0xf7, 0x5f, 0x9f, 0x2e, 0xb4,
-	0xc3, 0x5d, 0xca, 0x0a, 0x18, 0x33, 0x45, 0xdd, 0xfa, 0x98, 0x8b, 0x11, 0x7d, 0xad, 0x20, 0xe1,
-	0x19, 0x07, 0x81, 0xcf, 0x92, 0x53, 0xc6, 0xc5, 0x9b, 0x43, 0x6f, 0x9f, 0xbc, 0xf3, 0xfc, 0xcd,
-	0x23, 0x29, 0x4e, 0xb8, 0x9a, 0x3c, 0xca, 0x31, 0x93, 0x5c, 0x20, 0xd9, 0xab, 0xac, 0x2c, 0x39,
-	0x1c, 0xce, 0xcd, 0xd5, 0x46, 0x4b, 0x16, 0x1a, 0xb2, 0x56, 0xb8, 0xb5, 0x4c, 0x96, 0x94, 0x81,
-	0x05, 0x4a, 0xe1, 0x5f, 0x79, 0x22, 0x11, 0x96, 0x69, 0xda, 0x95, 0x91, 0x73, 0x5c, 0x8e, 0xe8,
-	0xf6, 0x7a, 0x66, 0x4b, 0xb5, 0x61, 0xa8, 0x1a, 0xe4, 0x8b, 0xe7, 0x6f, 0x1f, 0x29, 0x60, 0x08,
-	0x8f, 0x41, 0x3c, 0xe7, 0x22, 0x1d, 0x28, 0x26, 0xf4, 0x09, 0x28, 0x3d, 0x28, 0x48, 0xa7, 0xfa,
-	0x92, 0x35, 0x56, 0x07, 0xd1, 0xfd, 0x8f, 0x84, 0x25, 0xb9, 0x67, 0x48, 0x3a, 0x61, 0xbb, 0x72,
-	0x3e, 0x26, 0x19, 0x65, 0x65, 0x34, 0x42, 0x97, 0x8d, 0xb0, 0x58, 0x9c, 0xd9, 0x07, 0xcf, 0xbf,
-	0x5c, 0x96, 0x1f, 0x33, 0x8d, 0xa0, 0x06, 0x05, 0xd9, 0xad, 0x59, 0x77, 0x06, 0x47, 0xb9, 0xb7,
-	0xd2, 0x67, 0xd9, 0xda, 0x86, 0xed, 0x46, 0x78, 0xbd, 0x86, 0x6d, 0x62, 0x02, 0x15, 0xa0, 0x1e,
-	0xe8, 0x24, 0x87, 0x7f, 0x00, 0x39, 0xc3, 0x2a, 0xa0, 0xbf, 0xbe, 0x35, 0x81, 0x94, 0x09, 0x58,
-	0xa0, 0x89, 0xbf, 0xd1, 0xe7, 0xa9, 0x18, 0x14, 0x24, 0xa8, 0xf4, 0x97, 0x82, 0xdb, 0xbf, 0x56,
-	0xab, 0xaf, 0xfa, 0x88, 0x35, 0x4f, 0x85, 0x9d, 0xfb, 0xec, 0xf9, 0x5b, 0xfd, 0x7c, 0x38, 0xe1,
-	0xf8, 0xa0, 0x40, 0x50, 0x82, 0x8d, 0x17, 0x25, 0x0c, 0x73, 0x05, 0x84, 0x56, 0x07, 0xce, 0x77,
-	0x3a, 0xa2, 0xce, 0xfa, 0x01, 0x8b, 0x78, 0x60, 0x10, 0xe3, 0xf0, 0x56, 0x05, 0xd1, 0x04, 0x23,
-	0xb0, 0xc9, 0x48, 0xbb, 0xe8, 0xa1, 0xb7, 0x7f, 0xbf, 0xf7, 0x6d, 0x16, 0x78, 0x67, 0xb3, 0xc0,
-	0xfb, 0x39, 0x0b, 0xbc, 0x8f, 0xf3, 0xa0, 0xf1, 0x75, 0x1e, 0x78, 0x67, 0xf3, 0xa0, 0xf1, 0x63,
-	0x1e, 0x34, 0x9e, 0x1e, 0xa4, 0x1c, 0x4f, 0xf3, 0x61, 0x9c, 0xc8, 0x89, 0x6d, 0x15, 0x80, 0x2f,
-	0xa5, 0x1a, 0xd9, 0xbb, 0x28, 0x91, 0x0a, 0x68, 0xf1, 0x67, 0x0a, 0x5f, 0x65, 0xa0, 0x87, 0x1b,
-	0xe6, 0x37, 0x77, 0xf7, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x52, 0x69, 0xd8, 0x92, 0x66, 0x05,
-	0x00, 0x00,
+	// 558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x3f, 0x6f, 0xd3, 0x4c,
+	0x1c, 0xc7, 0xe3, 0x47, 0x8f, 0x3a, 0xdc, 0x40, 0x25, 0x83, 0xd4, 0xaa, 0xb4, 0xa6, 0x32, 0xa2,
+	0x85, 0x06, 0xdb, 0x09, 0x54, 0x0c, 0x1d, 0xa9, 0xd8, 0xa8, 0xa8, 0x92, 0x88, 0x81, 0x05, 0x9d,
+	0xcd, 0xaf, 0xee, 0x29, 0xc9, 0x9d, 0xb9, 0xfb, 0x39, 0x18, 0xa1, 0x2e, 0x4c, 0x9d, 0x10, 0x12,
+	0xef, 0x84, 0x85, 0x95, 0x91, 0xb1, 0x12, 0x0b, 0x23, 0x4a, 0x78, 0x21, 0xc8, 0xe7, 0x3b, 0x90,
+	0xe2, 0xba, 0x09, 0x9b, 0xed, 0xef, 0xbf, 0x8f, 0x4f, 0x96, 0xc9, 0x56, 0xcc, 0x30, 0x11, 0x8c,
+	0x47, 0x93, 0x6e, 0x0c, 0x48, 0xbb, 0x91, 0x02, 0x39, 0x61, 0x09, 0x84, 0x99, 0x14, 0x28, 0xdc,
+	0x55, 0x23, 0x87, 0x46, 0xde, 0xb8, 0x91, 0x8a, 0x54, 0x68, 0x2d, 0x2a, 0xaf, 0x2a, 0xdb, 0xc6,
+	0x66, 0x2a, 0x44, 0x3a, 0x82, 0x88, 0x66, 0x2c, 0xa2, 0x9c, 0x0b, 0xa4, 0xc8, 0x04, 0x57, 0x46,
+	0x5d, 0x9f, 0xdf, 0xc0, 0xa2, 0x52, 0x1e, 0x9c, 0x13, 0x42, 0x8e, 0x54, 0xda, 0xaf, 0x36, 0xdd,
+	0x09, 0xf9, 0xff, 0x29, 0xe3, 0x43, 0x77, 0x33, 0x9c, 0x9b, 0x0d, 0xcb, 0xc7, 0x3d, 0x78, 0x9d,
+	0x83, 0xc2, 0x8d, 0xad, 0x06, 0x55, 0x65, 0x82, 0x2b, 0xf0, 0xbb, 0xef, 0xbf, 0xff, 0xfa, 0xf4,
+	0x5f, 0xdb, 0xdf, 0x89, 0x68, 0x01, 0x23, 0x2a, 0x23, 0xbb, 0x3e, 0x62, 0x7c, 0x18, 0xbd, 0x93,
+	0x90, 0xb0, 0x8c, 0x01, 0xc7, 0x97, 0xc9, 0x29, 0x65, 0xfc, 0xec, 0xc0, 0xd9, 0x73, 0xcf, 0x1d,
+	0xb2, 0x7a, 0x28, 0xf8, 0x09, 0x93, 0xe3, 0x67, 0x39, 0x66, 0x82, 0x71, 0x74, 0x77, 0x6b, 0x2b,
+	0x73, 0x0e, 0x8b, 0x73, 0x77, 0xb1, 0xd1, 0x90, 0xf9, 0x9a, 0x6c, 0xd3, 0x5f, 0x9b, 0x27, 0x4b,
+	0xaa, 0x40, 0x89, 0x52, 0x90, 0xeb, 0xcf, 0x05, 0xc2, 0x3c, 0x4d, 0xbb, 0x36, 0x72, 0x89, 0xcb,
+	0x12, 0xdd, 0x5f, 0xce, 0x6c, 0xa8, 0x56, 0x34, 0x55, 0xcb, 0xfd, 0xe2, 0x90, 0xf5, 0x43, 0x09,
+	0x14, 0xe1, 0x18, 0xf8, 0x2b, 0xc6, 0xd3, 0x81, 0xa4, 0x5c, 0x9d, 0x80, 0x54, 0x83, 0xc2, 0xed,
+	0xd4, 0x5f, 0xb2, 0xc1, 0x6a, 0x21, 0xba, 0xff, 0x90, 0x30, 0x24, 0x8f, 0x34, 0x49, 0xc7, 0x6f,
+	0xd7, 0xce, 0x47, 0x27, 0x83, 0xac, 0x8a, 0x06, 0x68, 0xb3, 0x01, 0x16, 0xe5, 0x99, 0x7d, 0x70,
+	0xc8, 0xb5, 0xaa, 0xfc, 0x88, 0x2a, 0x04, 0x39, 0x28, 0xdc, 0x9d, 0x86, 0x75, 0x6b, 0xb0, 0x94,
+	0xbb, 0x0b, 0x7d, 0x86, 0xad, 0xad, 0xd9, 0xee, 0xf8, 0xdb, 0x0d, 0x6c, 0x63, 0x1d, 0xa8, 0x01,
+	0xf5, 0x40, 0x25, 0x39, 0x5c, 0x01, 0x64, 0x0d, 0x8b, 0x80, 0xfe, 0xfa, 0x96, 0x04, 0x92, 0x3a,
+	0x60, 0x80, 0xc6, 0x64, 0xa5, 0xcf, 0x52, 0x3e, 0x28, 0x5c, 0xaf, 0xd6, 0x5f, 0x09, 0x76, 0xff,
+	0x56, 0xa3, 0xbe, 0xe8, 0x23, 0x56, 0x2c, 0xe5, 0x66, 0xee, 0xb3, 0x43, 0xd6, 0xfa, 0x79, 0x3c,
+	0x66, 0xf8, 0xa4, 0x40, 0x90, 0x9c, 0x8e, 0xca, 0x12, 0x8a, 0xb9, 0x04, 0x37, 0xaa, 0x0f, 0x5c,
+	0xee, 0xb4, 0x44, 0x9d, 0xe5, 0x03, 0x06, 0x71, 0x5f, 0x23, 0x86, 0xfe, 0xbd, 0x1a, 0xa2, 0x0e,
+	0x06, 0x60, 0x92, 0x81, 0xb2, 0xd1, 0x12, 0xfa, 0x8c, 0x90, 0xaa, 0xf8, 0x58, 0xc5, 0xe8, 0xfa,
+	0x0d, 0xab, 0xa5, 0x68, 0xc9, 0x6e, 0x5f, 0xe9, 0x31, 0x30, 0x3b, 0x1a, 0x66, 0xdb, 0xbf, 0xd9,
+	0x00, 0x93, 0xa9, 0x18, 0x0f, 0x9c, 0xbd, 0xc7, 0xbd, 0x6f, 0x53, 0xcf, 0xb9, 0x98, 0x7a, 0xce,
+	0xcf, 0xa9, 0xe7, 0x7c, 0x9c, 0x79, 0xad, 0xaf, 0x33, 0xcf, 0xb9, 0x98, 0x79, 0xad, 0x1f, 0x33,
+	0xaf, 0xf5, 0x62, 0x3f, 0x65, 0x78, 0x9a, 0xc7, 0x61, 0x22, 0xc6, 0xa6, 0x87, 0x03, 0xbe, 0x11,
+	0x72, 0x68, 0xee, 0x82, 0x44, 0x48, 0x88, 0x8a, 0x3f, 0xe5, 0xf8, 0x36, 0x03, 0x15, 0xaf, 0xe8,
+	0xbf, 0xec, 0xc3, 0xdf, 0x03, 0x00, 0xd9, 0x2e, 0xdb, 0x07, 0xe5, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -92,6 +93,7 @@ type MsgServiceClient interface {
 	CreateRescueTx(ctx context.Context, in *CreateRescueTxRequest, opts ...grpc.CallOption) (*CreateRescueTxResponse, error)
 	SignTx(ctx context.Context, in *SignTxRequest, opts ...grpc.CallOption) (*SignTxResponse, error)
 	SubmitExternalSignature(ctx context.Context, in *SubmitExternalSignatureRequest, opts ...grpc.CallOption) (*SubmitExternalSignatureResponse, error)
+	SubmitPsbt(ctx context.Context, in *SubmitPsbtRequest, opts ...grpc.CallOption) (*SubmitPsbtResponse, error)
 }
 
 type msgServiceClient struct {
@@ -174,6 +176,15 @@ func (c *msgServiceClient) SubmitExternalSignature(ctx context.Context, in *Subm
 	return out, nil
 }
 
+func (c *msgServiceClient) SubmitPsbt(ctx context.Context, in *SubmitPsbtRequest, opts ...grpc.CallOption) (*SubmitPsbtResponse, error) {
+	out := new(SubmitPsbtResponse)
+	err := c.cc.Invoke(ctx, "/bitcoin.v1beta1.MsgService/SubmitPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 type MsgServiceServer interface {
 	Link(context.Context, *LinkRequest) (*LinkResponse, error)
@@ -184,6 +195,7 @@ type MsgServiceServer interface {
 	CreateRescueTx(context.Context, *CreateRescueTxRequest) (*CreateRescueTxResponse, error)
 	SignTx(context.Context, *SignTxRequest) (*SignTxResponse, error)
 	SubmitExternalSignature(context.Context, *SubmitExternalSignatureRequest) (*SubmitExternalSignatureResponse, error)
+	SubmitPsbt(context.Context, *SubmitPsbtRequest) (*SubmitPsbtResponse, error)
 }
 
 // UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
@@ -214,6 +226,9 @@ func (*UnimplementedMsgServiceServer) SignTx(ctx context.Context, req *SignTxReq
 func (*UnimplementedMsgServiceServer) SubmitExternalSignature(ctx context.Context, req *SubmitExternalSignatureRequest) (*SubmitExternalSignatureResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitExternalSignature not implemented")
 }
+func (*UnimplementedMsgServiceServer) SubmitPsbt(ctx context.Context, req *SubmitPsbtRequest) (*SubmitPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPsbt not implemented")
+}
 
 func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
 	s.RegisterService(&_MsgService_serviceDesc, srv)
@@ -363,6 +378,24 @@ func _MsgService_SubmitExternalSignature_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_SubmitPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).SubmitPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitcoin.v1beta1.MsgService/SubmitPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).SubmitPsbt(ctx, req.(*SubmitPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MsgService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "bitcoin.v1beta1.MsgService",
 	HandlerType: (*MsgServiceServer)(nil),
@@ -399,6 +432,10 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitExternalSignature",
 			Handler:    _MsgService_SubmitExternalSignature_Handler,
 		},
+		{
+			MethodName: "SubmitPsbt",
+			Handler:    _MsgService_SubmitPsbt_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "bitcoin/v1beta1/service.proto",
@@ -16,6 +16,7 @@ import (
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
 	tssTestUtils "github.com/axelarnetwork/axelar-core/x/tss/exported/testutils"
 
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
 	evm "github.com/axelarnetwork/axelar-core/x/evm/exported"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
@@ -33,6 +35,12 @@ const (
 	ethereumAddress = "0xE3deF8C6b7E357bf38eC701Ce631f78F2532987A"
 )
 
+var (
+	litecoin    = types.Network{Name: "litecoin", Net: 0xdbb6c0fb, Bech32HRP: "ltc", PubKeyHashAddrID: 0x30, ScriptHashAddrID: 0x32}
+	dogecoin    = types.Network{Name: "dogecoin", Net: 0xc0c0c0c0, PubKeyHashAddrID: 0x1e, ScriptHashAddrID: 0x16, SegwitDisabled: true}
+	bitcoinCash = types.Network{Name: "bitcoin-cash", Net: 0xe8f3e1e3, PubKeyHashAddrID: 0x00, ScriptHashAddrID: 0x05, SegwitDisabled: true, ForkIDSigHash: true}
+)
+
 func TestOutPointInfo_Equals(t *testing.T) {
 	// Take care to have identical slices with different pointers
 	var bz1, bz2 []byte
@@ -149,34 +157,34 @@ func TestNewMasterConsolidationAddress(t *testing.T) {
 		assert.NoError(t, err)
 		externalSigs := signWithExternalKeys(sigHash)
 
-		_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{{*internalSig1, *internalSig2}})
+		_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{{*internalSig1, *internalSig2}}, types.Testnet3)
 		assert.Error(t, err)
-		_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{{*internalSig2, *internalSig1}})
+		_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{{*internalSig2, *internalSig1}}, types.Testnet3)
 		assert.Error(t, err)
 
 		for i := externalKeyThreshold + 1; i <= externalKeyCount; i++ {
 			for _, sigs := range getSigCombinations(externalSigs, i) {
-				_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{sigs})
+				_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{sigs}, types.Testnet3)
 				assert.Error(t, err)
 			}
 		}
 
 		for i := externalKeyThreshold + 2; i <= externalKeyCount; i++ {
 			for _, sigs := range getSigCombinations(append([]btcec.Signature{*internalSig1, *internalSig2}, externalSigs...), i) {
-				_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{sigs})
+				_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{sigs}, types.Testnet3)
 				assert.Error(t, err)
 			}
 		}
 
 		for i := 1; i <= externalKeyCount; i++ {
 			for _, sigs := range getSigCombinations(externalSigs, i) {
-				_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{append(sigs, *internalSig1)})
+				_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{append(sigs, *internalSig1)}, types.Testnet3)
 				assert.Error(t, err)
-				_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{append(sigs, *internalSig2)})
+				_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{append(sigs, *internalSig2)}, types.Testnet3)
 				assert.Error(t, err)
-				_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{append(sigs, *internalSig1, *internalSig2)})
+				_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{append(sigs, *internalSig1, *internalSig2)}, types.Testnet3)
 				assert.Error(t, err)
-				_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{append(sigs, *internalSig2, *internalSig1)})
+				_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{append(sigs, *internalSig2, *internalSig1)}, types.Testnet3)
 				assert.Error(t, err)
 			}
 		}
@@ -213,9 +221,9 @@ func TestNewMasterConsolidationAddress(t *testing.T) {
 		internalSig2, err := internalPrivKey2.Sign(sigHash)
 		assert.NoError(t, err)
 
-		_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{{*internalSig1}})
+		_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{{*internalSig1}}, types.Testnet3)
 		assert.Error(t, err)
-		_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{{*internalSig2}})
+		_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{{*internalSig2}}, types.Testnet3)
 		assert.Error(t, err)
 	}).Repeat(repeat))
 
@@ -248,7 +256,7 @@ func TestNewMasterConsolidationAddress(t *testing.T) {
 		externalSigs := signWithExternalKeys(sigHash)
 
 		for _, sigs := range getSigCombinations(externalSigs, externalKeyThreshold) {
-			_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{sigs})
+			_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{sigs}, types.Testnet3)
 			assert.Error(t, err)
 		}
 	}).Repeat(repeat))
@@ -286,9 +294,9 @@ func TestNewMasterConsolidationAddress(t *testing.T) {
 		externalSigs := signWithExternalKeys(sigHash)
 
 		for _, sigs := range getSigCombinations(externalSigs, externalKeyThreshold) {
-			_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{append([]btcec.Signature{*internalSig1}, sigs...)})
+			_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{append([]btcec.Signature{*internalSig1}, sigs...)}, types.Testnet3)
 			assert.NoError(t, err)
-			_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{append([]btcec.Signature{*internalSig2}, sigs...)})
+			_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{append([]btcec.Signature{*internalSig2}, sigs...)}, types.Testnet3)
 			assert.NoError(t, err)
 		}
 	}).Repeat(repeat))
@@ -324,9 +332,9 @@ func TestNewMasterConsolidationAddress(t *testing.T) {
 		internalSig2, err := internalPrivKey2.Sign(sigHash)
 		assert.NoError(t, err)
 
-		_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{{*internalSig1}})
+		_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{{*internalSig1}}, types.Testnet3)
 		assert.NoError(t, err)
-		_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{{*internalSig2}})
+		_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{{*internalSig2}}, types.Testnet3)
 		assert.NoError(t, err)
 	}).Repeat(repeat))
 
@@ -359,7 +367,7 @@ func TestNewMasterConsolidationAddress(t *testing.T) {
 		externalSigs := signWithExternalKeys(sigHash)
 
 		for _, sigs := range getSigCombinations(externalSigs, externalKeyThreshold) {
-			_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{sigs})
+			_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{sigs}, types.Testnet3)
 			assert.NoError(t, err)
 		}
 	}).Repeat(repeat))
@@ -460,8 +468,48 @@ func TestNewDepositAddress(t *testing.T) {
 		sig, err := secondaryPrivKey.Sign(sigHash)
 		assert.NoError(t, err)
 
-		_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{{*sig}})
+		_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{{*sig}}, types.Testnet3)
+		assert.NoError(t, err)
+	}).Repeat(repeat))
+
+	t.Run("should be spendable by the secondary key on networks without segwit", testutils.Func(func(t *testing.T) {
+		externalKeyLockTime := time.Now().AddDate(0, 0, int(rand.I64Between(1, 100)))
+
+		recipient := nexus.CrossChainAddress{Chain: evm.Ethereum, Address: ethereumAddress}
+		nonce := rand.Bytes(sha256.Size)
+		scriptNonce := btcutil.Hash160([]byte(recipient.String() + hex.EncodeToString(nonce[:])))
+		address, _ := types.NewDepositAddress(secondaryPubKey, int64(externalKeyThreshold), externalKeys, externalKeyLockTime, scriptNonce, dogecoin)
+		_, isP2SH := address.GetAddress().(*btcutil.AddressScriptHash)
+		assert.True(t, isP2SH)
+
+		inputs := []types.OutPointToSign{
+			{
+				AddressInfo: address,
+				OutPointInfo: types.NewOutPointInfo(
+					outPoint,
+					inputAmount,
+					address.Address,
+				),
+			},
+		}
+
+		tx := types.CreateTx()
+		for _, input := range inputs {
+			assert.NoError(t, types.AddInput(tx, input.OutPointInfo.OutPoint))
+		}
+		types.AddOutput(tx, address.GetAddress(), outputAmount)
+		tx = types.EnableTimelock(tx, uint32(externalKeyLockTime.AddDate(0, 0, int(rand.I64Between(-1000, 1000))).Unix()))
+
+		sigHash, err := dogecoin.CalcSigHash(tx, 0, inputAmount, address.RedeemScript)
+		assert.NoError(t, err)
+
+		sig, err := secondaryPrivKey.Sign(sigHash)
+		assert.NoError(t, err)
+
+		signedTx, err := types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{{*sig}}, dogecoin)
 		assert.NoError(t, err)
+		assert.Empty(t, signedTx.TxIn[0].Witness)
+		assert.NotEmpty(t, signedTx.TxIn[0].SignatureScript)
 	}).Repeat(repeat))
 
 	t.Run("should not be spendable by the external keys before the external timelock elapses", testutils.Func(func(t *testing.T) {
@@ -496,7 +544,7 @@ func TestNewDepositAddress(t *testing.T) {
 		externalSigs := signWithExternalKeys(sigHash)
 
 		for _, sigs := range getSigCombinations(externalSigs, externalKeyThreshold) {
-			_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{sigs})
+			_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{sigs}, types.Testnet3)
 			assert.Error(t, err)
 		}
 	}).Repeat(repeat))
@@ -533,7 +581,7 @@ func TestNewDepositAddress(t *testing.T) {
 		externalSigs := signWithExternalKeys(sigHash)
 
 		for _, sigs := range getSigCombinations(externalSigs, externalKeyThreshold) {
-			_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{sigs})
+			_, err = types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{sigs}, types.Testnet3)
 			assert.NoError(t, err)
 		}
 	}).Repeat(repeat))
@@ -654,11 +702,11 @@ func TestEstimateTxSize(t *testing.T) {
 			signatures = append(signatures, []btcec.Signature{*signature})
 		}
 
-		signedTx, err := types.AssembleBtcTx(tx, inputs, signatures)
+		signedTx, err := types.AssembleBtcTx(tx, inputs, signatures, types.Testnet3)
 		assert.NoError(t, err)
 
 		expected := mempool.GetTxVirtualSize(btcutil.NewTx(signedTx))
-		actual := types.EstimateTxSize(*tx, inputs)
+		actual := types.EstimateTxSize(*tx, inputs, types.Testnet3)
 
 		// expected - 1 * inputCount <= actual <= expected because a bitcoin signature can either contain 71 or 72 bytes
 		// https://transactionfee.info/charts/bitcoin-script-ecdsa-length/#:~:text=The%20ECDSA%20signatures%20used%20in,normally%20taking%20up%2032%20bytes
@@ -672,8 +720,8 @@ func TestConfirmOutpointRequest_GetOutPoint(t *testing.T) {
 		hash, _ := chainhash.NewHash(rand.Bytes(chainhash.HashSize))
 		outpoint := wire.NewOutPoint(hash, mathRand.Uint32())
 		info := types.NewOutPointInfo(outpoint, btcutil.Amount(rand.PosI64()), rand.StrBetween(5, 100))
-		req1 := types.NewConfirmOutpointRequest(rand.AccAddr(), info)
-		req2 := types.NewConfirmOutpointRequest(req1.Sender, info)
+		req1 := types.NewConfirmOutpointRequest(rand.AccAddr(), exported.Bitcoin.Name, info)
+		req2 := types.NewConfirmOutpointRequest(req1.Sender, req1.Chain, info)
 
 		var runes []rune
 		flipDistr := rand.Bools(0.5)
@@ -708,6 +756,101 @@ func TestAddress(t *testing.T) {
 	}).Repeat(20))
 }
 
+func TestNetwork(t *testing.T) {
+	t.Run("should validate custom networks", testutils.Func(func(t *testing.T) {
+		assert.NoError(t, litecoin.Validate())
+		assert.NoError(t, dogecoin.Validate())
+		assert.NoError(t, bitcoinCash.Validate())
+
+		withoutHRP := litecoin
+		withoutHRP.Bech32HRP = ""
+		assert.Error(t, withoutHRP.Validate())
+
+		forkIDWithSegwit := litecoin
+		forkIDWithSegwit.ForkIDSigHash = true
+		assert.Error(t, forkIDWithSegwit.Validate())
+
+		redefined := types.Mainnet
+		redefined.SegwitDisabled = true
+		assert.Error(t, redefined.Validate())
+	}))
+
+	t.Run("should derive the parameters from the network", testutils.Func(func(t *testing.T) {
+		changed := litecoin
+		changed.Bech32HRP = rand.StrBetween(2, 5)
+		changed.ScriptHashAddrID = uint32(rand.I64Between(0x40, 0xff))
+
+		assert.Equal(t, litecoin.Bech32HRP, litecoin.Params().Bech32HRPSegwit)
+		assert.Equal(t, changed.Bech32HRP, changed.Params().Bech32HRPSegwit)
+		assert.Equal(t, byte(changed.ScriptHashAddrID), changed.Params().ScriptHashAddrID)
+	}).Repeat(20))
+
+	t.Run("should only decode addresses of the network", testutils.Func(func(t *testing.T) {
+		for _, network := range []types.Network{litecoin, dogecoin, types.Mainnet} {
+			var addr btcutil.Address
+			var err error
+			if network.SegwitDisabled {
+				addr, err = btcutil.NewAddressScriptHash(rand.Bytes(int(rand.I64Between(1, 100))), network.Params())
+			} else {
+				addr, err = btcutil.NewAddressWitnessScriptHash(rand.Bytes(32), network.Params())
+			}
+			assert.NoError(t, err)
+
+			decoded, err := network.DecodeAddress(addr.EncodeAddress())
+			assert.NoError(t, err)
+			assert.Equal(t, addr.EncodeAddress(), decoded.EncodeAddress())
+
+			for _, other := range []types.Network{litecoin, dogecoin, types.Mainnet} {
+				if other.Name == network.Name {
+					continue
+				}
+
+				_, err := other.DecodeAddress(addr.EncodeAddress())
+				assert.Error(t, err)
+			}
+		}
+	}).Repeat(20))
+
+	t.Run("should sign with replay protection on fork id networks", testutils.Func(func(t *testing.T) {
+		assert.Equal(t, txscript.SigHashAll, dogecoin.SigHashType())
+		assert.Equal(t, txscript.SigHashType(0x41), bitcoinCash.SigHashType())
+
+		privKey, err := btcec.NewPrivateKey(btcec.S256())
+		assert.NoError(t, err)
+		key := tss.Key{ID: tssTestUtils.RandKeyID(), PublicKey: &tss.Key_ECDSAKey_{ECDSAKey: &tss.Key_ECDSAKey{Value: privKey.PubKey().SerializeCompressed()}}, Role: tss.SecondaryKey}
+
+		address, err := types.NewSecondaryConsolidationAddress(key, bitcoinCash)
+		assert.NoError(t, err)
+
+		outPoint, err := types.OutPointFromStr(fmt.Sprintf("%s:0", rand.HexStr(64)))
+		assert.NoError(t, err)
+		amount := btcutil.Amount(rand.I64Between(1000, 100000000))
+
+		tx := types.CreateTx()
+		assert.NoError(t, types.AddInput(tx, outPoint.String()))
+		assert.NoError(t, types.AddOutput(tx, address.GetAddress(), amount/2))
+
+		sigHash, err := bitcoinCash.CalcSigHash(tx, 0, amount, address.RedeemScript)
+		assert.NoError(t, err)
+		legacySigHash, err := dogecoin.CalcSigHash(tx, 0, amount, address.RedeemScript)
+		assert.NoError(t, err)
+		assert.NotEqual(t, legacySigHash, sigHash)
+
+		sig, err := privKey.Sign(sigHash)
+		assert.NoError(t, err)
+
+		inputs := []types.OutPointToSign{{AddressInfo: address, OutPointInfo: types.NewOutPointInfo(outPoint, amount, address.Address)}}
+		signedTx, err := types.AssembleBtcTx(tx, inputs, [][]btcec.Signature{{*sig}}, bitcoinCash)
+		assert.NoError(t, err)
+
+		pushes, err := txscript.PushedData(signedTx.TxIn[0].SignatureScript)
+		assert.NoError(t, err)
+		assert.Len(t, pushes, 2)
+		assert.Equal(t, byte(0x41), pushes[0][len(pushes[0])-1])
+		assert.Equal(t, []byte(address.RedeemScript), pushes[1])
+	}).Repeat(20))
+}
+
 func getSigCombinations(sigs []btcec.Signature, size int) [][]btcec.Signature {
 	if size > len(sigs) {
 		panic("size must be less than or equal to len(sigs)")
@@ -737,3 +880,18 @@ func getSigCombinations(sigs []btcec.Signature, size int) [][]btcec.Signature {
 
 	return append(results, getSigCombinations(sigs[1:], size)...)
 }
+
+func TestParseBip32Path(t *testing.T) {
+	path, err := types.ParseBip32Path("m/48'/0'/0'/2'")
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{48 + hdkeychain.HardenedKeyStart, hdkeychain.HardenedKeyStart, hdkeychain.HardenedKeyStart, 2 + hdkeychain.HardenedKeyStart}, path)
+
+	path, err = types.ParseBip32Path("m/84h/1/7")
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{84 + hdkeychain.HardenedKeyStart, 1, 7}, path)
+
+	for _, invalid := range []string{"", "m", "48'/0'", "m/abc", "m/-1", "m/2147483648"} {
+		_, err := types.ParseBip32Path(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
@@ -32,6 +32,7 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type ConfirmOutpointRequest struct {
 	Sender       github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
 	OutPointInfo OutPointInfo                                  `protobuf:"bytes,2,opt,name=out_point_info,json=outPointInfo,proto3" json:"out_point_info"`
+	Chain        string                                        `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
 }
 
 func (m *ConfirmOutpointRequest) Reset()         { *m = ConfirmOutpointRequest{} }
@@ -109,6 +110,7 @@ type LinkRequest struct {
 	Sender         github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
 	RecipientAddr  string                                        `protobuf:"bytes,2,opt,name=recipient_addr,json=recipientAddr,proto3" json:"recipient_addr,omitempty"`
 	RecipientChain string                                        `protobuf:"bytes,3,opt,name=recipient_chain,json=recipientChain,proto3" json:"recipient_chain,omitempty"`
+	Chain          string                                        `protobuf:"bytes,4,opt,name=chain,proto3" json:"chain,omitempty"`
 }
 
 func (m *LinkRequest) Reset()         { *m = LinkRequest{} }
@@ -187,6 +189,7 @@ type CreatePendingTransfersTxRequest struct {
 	Sender          github_com_cosmos_cosmos_sdk_types.AccAddress             `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
 	KeyID           github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
 	MasterKeyAmount github_com_btcsuite_btcutil.Amount                        `protobuf:"varint,3,opt,name=master_key_amount,json=masterKeyAmount,proto3,casttype=github.com/btcsuite/btcutil.Amount" json:"master_key_amount,omitempty"`
+	Chain           string                                                    `protobuf:"bytes,4,opt,name=chain,proto3" json:"chain,omitempty"`
 }
 
 func (m *CreatePendingTransfersTxRequest) Reset()         { *m = CreatePendingTransfersTxRequest{} }
@@ -264,6 +267,7 @@ type VoteConfirmOutpointRequest struct {
 	PollKey   exported.PollKey                              `protobuf:"bytes,2,opt,name=poll_key,json=pollKey,proto3" json:"poll_key"`
 	OutPoint  string                                        `protobuf:"bytes,3,opt,name=out_point,json=outPoint,proto3" json:"out_point,omitempty"`
 	Confirmed bool                                          `protobuf:"varint,4,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	Chain     string                                        `protobuf:"bytes,5,opt,name=chain,proto3" json:"chain,omitempty"`
 }
 
 func (m *VoteConfirmOutpointRequest) Reset()         { *m = VoteConfirmOutpointRequest{} }
@@ -341,6 +345,7 @@ type SubmitExternalSignatureRequest struct {
 	KeyID     github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
 	Signature []byte                                                    `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 	SigHash   []byte                                                    `protobuf:"bytes,4,opt,name=sig_hash,json=sigHash,proto3" json:"sig_hash,omitempty"`
+	Chain     string                                                    `protobuf:"bytes,5,opt,name=chain,proto3" json:"chain,omitempty"`
 }
 
 func (m *SubmitExternalSignatureRequest) Reset()         { *m = SubmitExternalSignatureRequest{} }
@@ -416,6 +421,7 @@ type CreateMasterTxRequest struct {
 	Sender             github_com_cosmos_cosmos_sdk_types.AccAddress             `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
 	KeyID              github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
 	SecondaryKeyAmount github_com_btcsuite_btcutil.Amount                        `protobuf:"varint,3,opt,name=secondary_key_amount,json=secondaryKeyAmount,proto3,casttype=github.com/btcsuite/btcutil.Amount" json:"secondary_key_amount,omitempty"`
+	Chain              string                                                    `protobuf:"bytes,4,opt,name=chain,proto3" json:"chain,omitempty"`
 }
 
 func (m *CreateMasterTxRequest) Reset()         { *m = CreateMasterTxRequest{} }
@@ -489,6 +495,7 @@ var xxx_messageInfo_CreateRescueTxResponse proto.InternalMessageInfo
 
 type CreateRescueTxRequest struct {
 	Sender github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	Chain  string                                        `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
 }
 
 func (m *CreateRescueTxRequest) Reset()         { *m = CreateRescueTxRequest{} }
@@ -563,6 +570,7 @@ var xxx_messageInfo_CreateMasterTxResponse proto.InternalMessageInfo
 type SignTxRequest struct {
 	Sender github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
 	TxType TxType                                        `protobuf:"varint,2,opt,name=tx_type,json=txType,proto3,enum=bitcoin.v1beta1.TxType" json:"tx_type,omitempty"`
+	Chain  string                                        `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
 }
 
 func (m *SignTxRequest) Reset()         { *m = SignTxRequest{} }
@@ -635,6 +643,83 @@ func (m *SignTxResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SignTxResponse proto.InternalMessageInfo
 
+// SubmitPsbtRequest represents a message to submit the signatures of external
+// keys contained in a BIP-174 partially signed bitcoin transaction
+type SubmitPsbtRequest struct {
+	Sender github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	Psbt   []byte                                        `protobuf:"bytes,2,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	Chain  string                                        `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
+}
+
+func (m *SubmitPsbtRequest) Reset()         { *m = SubmitPsbtRequest{} }
+func (m *SubmitPsbtRequest) String() string { return proto.CompactTextString(m) }
+func (*SubmitPsbtRequest) ProtoMessage()    {}
+func (*SubmitPsbtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f5c2c0447d15a63, []int{16}
+}
+func (m *SubmitPsbtRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmitPsbtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmitPsbtRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmitPsbtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitPsbtRequest.Merge(m, src)
+}
+func (m *SubmitPsbtRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmitPsbtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitPsbtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitPsbtRequest proto.InternalMessageInfo
+
+type SubmitPsbtResponse struct {
+}
+
+func (m *SubmitPsbtResponse) Reset()         { *m = SubmitPsbtResponse{} }
+func (m *SubmitPsbtResponse) String() string { return proto.CompactTextString(m) }
+func (*SubmitPsbtResponse) ProtoMessage()    {}
+func (*SubmitPsbtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f5c2c0447d15a63, []int{17}
+}
+func (m *SubmitPsbtResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmitPsbtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmitPsbtResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmitPsbtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitPsbtResponse.Merge(m, src)
+}
+func (m *SubmitPsbtResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmitPsbtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitPsbtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitPsbtResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ConfirmOutpointRequest)(nil), "bitcoin.v1beta1.ConfirmOutpointRequest")
 	proto.RegisterType((*ConfirmOutpointResponse)(nil), "bitcoin.v1beta1.ConfirmOutpointResponse")
@@ -652,61 +737,67 @@ func init() {
 	proto.RegisterType((*CreateMasterTxResponse)(nil), "bitcoin.v1beta1.CreateMasterTxResponse")
 	proto.RegisterType((*SignTxRequest)(nil), "bitcoin.v1beta1.SignTxRequest")
 	proto.RegisterType((*SignTxResponse)(nil), "bitcoin.v1beta1.SignTxResponse")
+	proto.RegisterType((*SubmitPsbtRequest)(nil), "bitcoin.v1beta1.SubmitPsbtRequest")
+	proto.RegisterType((*SubmitPsbtResponse)(nil), "bitcoin.v1beta1.SubmitPsbtResponse")
 }
 
 func init() { proto.RegisterFile("bitcoin/v1beta1/tx.proto", fileDescriptor_5f5c2c0447d15a63) }
 
 var fileDescriptor_5f5c2c0447d15a63 = []byte{
-	// 778 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xc1, 0x6f, 0x32, 0x45,
-	0x14, 0x67, 0x69, 0x4b, 0x61, 0x4a, 0x69, 0xdc, 0xd4, 0x96, 0xd2, 0xba, 0xd0, 0x4d, 0xd4, 0x1e,
-	0xec, 0x22, 0x55, 0x0f, 0x9e, 0x4c, 0xa9, 0x1a, 0x49, 0x35, 0x6d, 0xb6, 0xc4, 0x18, 0x13, 0x43,
-	0x96, 0xdd, 0x07, 0x4c, 0x80, 0x99, 0x75, 0x66, 0xb6, 0xee, 0x9e, 0xbd, 0x7a, 0xf0, 0xee, 0xc1,
-	0xab, 0x27, 0xff, 0x8e, 0x1e, 0x7b, 0xf4, 0x44, 0x14, 0xfe, 0x8b, 0x9e, 0xcc, 0xce, 0x0e, 0x2c,
-	0xda, 0x36, 0xf9, 0xf2, 0xe5, 0xe3, 0xd0, 0xd3, 0xce, 0xbc, 0x79, 0xf3, 0xde, 0xfb, 0xfd, 0xde,
-	0xef, 0xc1, 0xa0, 0x72, 0x17, 0x0b, 0x97, 0x62, 0x52, 0xbf, 0x6d, 0x74, 0x41, 0x38, 0x8d, 0xba,
-	0x08, 0x2d, 0x9f, 0x51, 0x41, 0xf5, 0x1d, 0x75, 0x62, 0xa9, 0x93, 0xca, 0xe1, 0x23, 0xd7, 0xc8,
-	0x07, 0x9e, 0x78, 0x57, 0x8e, 0x6f, 0xa9, 0x80, 0x3a, 0x84, 0x3e, 0x65, 0x02, 0xbc, 0x27, 0x5d,
-	0x76, 0xfb, 0xb4, 0x4f, 0xe5, 0xb2, 0x1e, 0xaf, 0x12, 0xab, 0xf9, 0xa7, 0x86, 0xf6, 0x2e, 0x28,
-	0xe9, 0x61, 0x36, 0xbe, 0x0a, 0x84, 0x4f, 0x31, 0x11, 0x36, 0xfc, 0x18, 0x00, 0x17, 0x7a, 0x0b,
-	0xe5, 0x38, 0x10, 0x0f, 0x58, 0x59, 0xab, 0x69, 0x27, 0xc5, 0x66, 0xe3, 0x61, 0x52, 0x3d, 0xed,
-	0x63, 0x31, 0x08, 0xba, 0x96, 0x4b, 0xc7, 0x75, 0x97, 0xf2, 0x31, 0xe5, 0xea, 0x73, 0xca, 0xbd,
-	0xa1, 0x4a, 0x77, 0xee, 0xba, 0xe7, 0x9e, 0xc7, 0x80, 0x73, 0x5b, 0x05, 0xd0, 0x5b, 0xa8, 0x44,
-	0x03, 0xd1, 0x91, 0xe1, 0x3b, 0x98, 0xf4, 0x68, 0x39, 0x5b, 0xd3, 0x4e, 0xb6, 0xce, 0xde, 0xb1,
-	0xfe, 0x87, 0xd2, 0xba, 0x0a, 0xc4, 0x75, 0xec, 0xd5, 0x22, 0x3d, 0xda, 0x5c, 0xbf, 0x9b, 0x54,
-	0x33, 0x76, 0x91, 0x2e, 0xd9, 0xcc, 0x03, 0xb4, 0xff, 0xa8, 0x5e, 0xee, 0x53, 0xc2, 0xc1, 0xfc,
-	0x43, 0x43, 0x5b, 0x5f, 0x63, 0x32, 0x5c, 0x01, 0x80, 0x77, 0x51, 0x89, 0x81, 0x8b, 0x7d, 0x0c,
-	0x44, 0x74, 0x1c, 0xcf, 0x63, 0x12, 0x40, 0xc1, 0xde, 0x5e, 0x58, 0xe3, 0x1b, 0xfa, 0xfb, 0x68,
-	0x27, 0x75, 0x73, 0x07, 0x0e, 0x26, 0xe5, 0x35, 0xe9, 0x97, 0xde, 0xbe, 0x88, 0xad, 0x66, 0x03,
-	0x15, 0x93, 0x4a, 0x93, 0xd2, 0xf5, 0x63, 0x54, 0xf4, 0xc0, 0xa7, 0x1c, 0xff, 0x27, 0xfa, 0x96,
-	0xb2, 0xc5, 0xb1, 0xcd, 0xdf, 0xb3, 0xa8, 0x7a, 0xc1, 0xc0, 0x11, 0x70, 0x0d, 0xc4, 0xc3, 0xa4,
-	0xdf, 0x66, 0x0e, 0xe1, 0x3d, 0x60, 0xbc, 0x1d, 0xae, 0x00, 0xf1, 0x0f, 0x28, 0x37, 0x84, 0xa8,
-	0x83, 0xbd, 0xa4, 0x96, 0xe6, 0x97, 0xd3, 0x49, 0x75, 0xe3, 0x12, 0xa2, 0xd6, 0xe7, 0x0f, 0x93,
-	0xea, 0xa7, 0x4b, 0x31, 0x9d, 0x10, 0x46, 0x0e, 0x23, 0x20, 0x7e, 0xa2, 0x6c, 0xa8, 0x76, 0xa7,
-	0x2e, 0x65, 0x50, 0x0f, 0xeb, 0x82, 0xf3, 0x85, 0x28, 0x2d, 0x79, 0xd9, 0xde, 0x18, 0x42, 0xd4,
-	0xf2, 0x74, 0x1b, 0xbd, 0x35, 0x76, 0xb8, 0x00, 0xd6, 0x89, 0xb3, 0x38, 0x63, 0x1a, 0x10, 0x21,
-	0xb9, 0x5a, 0x6b, 0xbe, 0xf7, 0x30, 0xa9, 0x9a, 0x4b, 0x09, 0xba, 0xc2, 0xe5, 0x01, 0x16, 0x10,
-	0x2f, 0x02, 0x81, 0x47, 0xd6, 0xb9, 0xf4, 0xb6, 0x77, 0x92, 0x00, 0x97, 0x10, 0x25, 0x06, 0xd3,
-	0x44, 0xb5, 0xe7, 0x09, 0x52, 0x1a, 0x99, 0x69, 0xa8, 0xf2, 0x2d, 0x15, 0xb0, 0x7a, 0xcd, 0x7f,
-	0x86, 0xf2, 0x3e, 0x1d, 0x8d, 0x62, 0x7c, 0x4a, 0xed, 0x86, 0x15, 0x4f, 0xa9, 0xb5, 0x20, 0x64,
-	0xae, 0xf9, 0x6b, 0x3a, 0x1a, 0x5d, 0x42, 0xa4, 0xe4, 0xbe, 0xe9, 0x27, 0x5b, 0xfd, 0x10, 0x15,
-	0x16, 0x43, 0xa3, 0x64, 0x94, 0x9f, 0x8f, 0x82, 0x7e, 0x84, 0x0a, 0x6e, 0x02, 0x01, 0xbc, 0xf2,
-	0x7a, 0x4d, 0x3b, 0xc9, 0xdb, 0xa9, 0xc1, 0xfc, 0x04, 0x1d, 0x3e, 0x09, 0x52, 0xa9, 0x6d, 0x0f,
-	0xe5, 0xb8, 0x70, 0x44, 0xc0, 0x25, 0xca, 0x82, 0xad, 0x76, 0xe6, 0xcf, 0x59, 0x64, 0xdc, 0x04,
-	0xdd, 0x31, 0x16, 0x5f, 0x84, 0x02, 0x18, 0x71, 0x46, 0x37, 0xb8, 0x4f, 0x1c, 0x11, 0x30, 0x78,
-	0x79, 0x0a, 0x3b, 0x42, 0x05, 0x3e, 0xaf, 0x5e, 0xd2, 0x57, 0xb4, 0x53, 0x83, 0x7e, 0x80, 0xf2,
-	0x1c, 0xf7, 0x3b, 0x03, 0x87, 0x0f, 0x24, 0x7d, 0x45, 0x7b, 0x93, 0xe3, 0xfe, 0x57, 0x0e, 0x1f,
-	0x98, 0xc7, 0xa8, 0xfa, 0x2c, 0x09, 0x4a, 0x45, 0xbf, 0x65, 0xd1, 0xdb, 0x89, 0xd4, 0xbe, 0x91,
-	0x1a, 0x7c, 0x89, 0x13, 0xf8, 0x1d, 0xda, 0xe5, 0xe0, 0x52, 0xe2, 0x39, 0x2c, 0x7a, 0xfd, 0x21,
-	0xd4, 0x17, 0x31, 0xd2, 0x39, 0x2c, 0xa3, 0xbd, 0x84, 0x1c, 0x1b, 0xb8, 0x1b, 0xc0, 0xd2, 0xf4,
-	0x75, 0xe7, 0xb4, 0xa5, 0x27, 0x6f, 0x9a, 0xb6, 0x34, 0x7b, 0xda, 0x1a, 0x95, 0xfd, 0x17, 0x0d,
-	0x6d, 0xc7, 0xbd, 0x5c, 0x49, 0xb7, 0x3e, 0x44, 0x9b, 0x22, 0xec, 0xc4, 0xc7, 0xb2, 0x5d, 0xa5,
-	0xb3, 0xfd, 0x47, 0xff, 0x6d, 0xed, 0xb0, 0x1d, 0xf9, 0x60, 0xe7, 0x84, 0xfc, 0x9a, 0x1f, 0xa0,
-	0xd2, 0xbc, 0x1a, 0x35, 0x97, 0x95, 0xf8, 0x27, 0x83, 0x63, 0x81, 0x29, 0x91, 0x05, 0xad, 0xd9,
-	0x8b, 0x7d, 0xd3, 0xbe, 0xfb, 0xc7, 0xc8, 0xdc, 0x4d, 0x0d, 0xed, 0x7e, 0x6a, 0x68, 0x7f, 0x4f,
-	0x0d, 0xed, 0xd7, 0x99, 0x91, 0xb9, 0x9f, 0x19, 0x99, 0xbf, 0x66, 0x46, 0xe6, 0xfb, 0x8f, 0x5f,
-	0x51, 0x0e, 0xf3, 0x37, 0x84, 0x84, 0xd1, 0xcd, 0xc9, 0x37, 0xc0, 0x47, 0xff, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0x21, 0x2e, 0x6b, 0xe1, 0x86, 0x08, 0x00, 0x00,
+	// 836 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x18, 0x8d, 0xd3, 0x36, 0x6d, 0xbe, 0x66, 0x5b, 0xad, 0xd5, 0xed, 0x66, 0xdb, 0xc5, 0x69, 0x23,
+	0x01, 0x3d, 0x50, 0x87, 0x2e, 0x70, 0xe0, 0x84, 0x9a, 0x02, 0x22, 0x2a, 0x68, 0xab, 0xd9, 0x0a,
+	0x21, 0x24, 0x14, 0xf9, 0xc7, 0xd7, 0x64, 0x94, 0x64, 0xc6, 0xcc, 0x8c, 0x17, 0xfb, 0x3f, 0xe0,
+	0xc8, 0x95, 0x2b, 0xff, 0x01, 0x07, 0xae, 0x9c, 0x38, 0xf4, 0xb8, 0x47, 0x4e, 0x11, 0xb4, 0x7f,
+	0x05, 0x3d, 0x21, 0x8f, 0x27, 0x89, 0xd9, 0x36, 0x02, 0xa1, 0xcd, 0x61, 0x4f, 0x9e, 0xf9, 0xe6,
+	0xf3, 0xcc, 0x7b, 0xcf, 0xef, 0xd9, 0x86, 0xba, 0x4f, 0x55, 0xc0, 0x29, 0x6b, 0x3d, 0x3f, 0xf2,
+	0x51, 0x79, 0x47, 0x2d, 0x95, 0xb8, 0x91, 0xe0, 0x8a, 0xdb, 0x9b, 0x66, 0xc5, 0x35, 0x2b, 0x3b,
+	0xbb, 0xb7, 0x5a, 0xd3, 0x08, 0x65, 0xde, 0xbd, 0xb3, 0xff, 0x9c, 0x2b, 0x6c, 0x61, 0x12, 0x71,
+	0xa1, 0x30, 0xbc, 0xb3, 0x65, 0xab, 0xc7, 0x7b, 0x5c, 0x0f, 0x5b, 0xd9, 0x28, 0xaf, 0x36, 0x7f,
+	0xb3, 0x60, 0xfb, 0x84, 0xb3, 0x0b, 0x2a, 0x46, 0x4f, 0x63, 0x15, 0x71, 0xca, 0x14, 0xc1, 0x6f,
+	0x63, 0x94, 0xca, 0xee, 0x40, 0x45, 0x22, 0x0b, 0x51, 0xd4, 0xad, 0x3d, 0xeb, 0xa0, 0xd6, 0x3e,
+	0xba, 0x19, 0x37, 0x0e, 0x7b, 0x54, 0xf5, 0x63, 0xdf, 0x0d, 0xf8, 0xa8, 0x15, 0x70, 0x39, 0xe2,
+	0xd2, 0x5c, 0x0e, 0x65, 0x38, 0x30, 0xc7, 0x1d, 0x07, 0xc1, 0x71, 0x18, 0x0a, 0x94, 0x92, 0x98,
+	0x0d, 0xec, 0x0e, 0x6c, 0xf0, 0x58, 0x75, 0xf5, 0xf6, 0x5d, 0xca, 0x2e, 0x78, 0xbd, 0xbc, 0x67,
+	0x1d, 0xac, 0x3f, 0x79, 0xc3, 0x7d, 0x89, 0xa5, 0xfb, 0x34, 0x56, 0x67, 0x59, 0x57, 0x87, 0x5d,
+	0xf0, 0xf6, 0xf2, 0xe5, 0xb8, 0x51, 0x22, 0x35, 0x5e, 0xa8, 0xd9, 0x5b, 0xb0, 0x12, 0xf4, 0x3d,
+	0xca, 0xea, 0x4b, 0x7b, 0xd6, 0x41, 0x95, 0xe4, 0x93, 0xe6, 0x23, 0x78, 0x78, 0x8b, 0x85, 0x8c,
+	0x38, 0x93, 0xd8, 0xfc, 0xd5, 0x82, 0xf5, 0xcf, 0x29, 0x1b, 0x2c, 0x80, 0xd6, 0x9b, 0xb0, 0x21,
+	0x30, 0xa0, 0x11, 0x45, 0xa6, 0xba, 0x5e, 0x18, 0x0a, 0x4d, 0xab, 0x4a, 0xee, 0x4d, 0xab, 0xd9,
+	0x1d, 0xf6, 0xdb, 0xb0, 0x39, 0x6b, 0x2b, 0x82, 0x9f, 0xdd, 0x7d, 0x92, 0x55, 0x67, 0xdc, 0x96,
+	0x8b, 0xdc, 0x8e, 0xa0, 0x96, 0xe3, 0xcf, 0x09, 0xd9, 0xfb, 0x50, 0x0b, 0x31, 0xe2, 0x92, 0xfe,
+	0xe3, 0xcc, 0x75, 0x53, 0xcb, 0x4e, 0x6c, 0xfe, 0x52, 0x86, 0xc6, 0x89, 0x40, 0x4f, 0xe1, 0x19,
+	0xb2, 0x90, 0xb2, 0xde, 0xb9, 0xf0, 0x98, 0xbc, 0x40, 0x21, 0xcf, 0x93, 0x05, 0xe8, 0xf0, 0x0d,
+	0x54, 0x06, 0x98, 0x76, 0x69, 0x98, 0x63, 0x69, 0x7f, 0x7a, 0x35, 0x6e, 0xac, 0x9c, 0x62, 0xda,
+	0xf9, 0xf8, 0x66, 0xdc, 0xf8, 0xb0, 0xb0, 0xa7, 0x97, 0xe0, 0xd0, 0x13, 0x0c, 0xd5, 0x77, 0x5c,
+	0x0c, 0xcc, 0xec, 0x30, 0xe0, 0x02, 0x5b, 0x49, 0x4b, 0x49, 0x39, 0x35, 0xb0, 0xab, 0x6f, 0x26,
+	0x2b, 0x03, 0x4c, 0x3b, 0xa1, 0x4d, 0xe0, 0xfe, 0xc8, 0x93, 0x0a, 0x45, 0x37, 0x3b, 0xc5, 0x1b,
+	0xf1, 0x98, 0x29, 0xad, 0xe0, 0x52, 0xfb, 0xad, 0x9b, 0x71, 0xa3, 0x59, 0x38, 0xc0, 0x57, 0x81,
+	0x8c, 0xa9, 0xc2, 0x6c, 0x10, 0x2b, 0x3a, 0x74, 0x8f, 0x75, 0x37, 0xd9, 0xcc, 0x37, 0x38, 0xc5,
+	0x34, 0x2f, 0xcc, 0x91, 0xba, 0x09, 0x7b, 0xf3, 0x65, 0x33, 0x7e, 0xfa, 0xcb, 0x82, 0x9d, 0x2f,
+	0xb9, 0xc2, 0xc5, 0xa7, 0xe6, 0x23, 0x58, 0x8b, 0xf8, 0x70, 0x98, 0xb1, 0x36, 0x79, 0x71, 0xdc,
+	0x2c, 0xe7, 0xee, 0x54, 0xa6, 0x49, 0x6a, 0xce, 0xf8, 0x70, 0x78, 0x8a, 0xa9, 0x09, 0xcc, 0x6a,
+	0x94, 0x4f, 0xed, 0x5d, 0xa8, 0x4e, 0x63, 0x67, 0x2c, 0xb7, 0x36, 0x09, 0x93, 0xfd, 0x18, 0xaa,
+	0x41, 0x4e, 0x01, 0x43, 0xad, 0xc2, 0x1a, 0x99, 0x15, 0x66, 0xfa, 0xac, 0x14, 0xf5, 0xf9, 0x00,
+	0x76, 0xef, 0xa4, 0x6e, 0x9c, 0xb9, 0x0d, 0x15, 0xa9, 0x3c, 0x15, 0x4b, 0xcd, 0xbd, 0x4a, 0xcc,
+	0xac, 0xf9, 0x63, 0x19, 0x9c, 0x67, 0xb1, 0x3f, 0xa2, 0xea, 0x93, 0x44, 0xa1, 0x60, 0xde, 0xf0,
+	0x19, 0xed, 0x31, 0x4f, 0xc5, 0x02, 0x5f, 0x3f, 0x37, 0x3e, 0x86, 0xaa, 0x9c, 0xa0, 0xd7, 0xa2,
+	0xd6, 0xc8, 0xac, 0x60, 0x3f, 0x82, 0x35, 0x49, 0x7b, 0xdd, 0xbe, 0x27, 0xfb, 0x5a, 0xd4, 0x1a,
+	0x59, 0x95, 0xb4, 0xf7, 0x99, 0x27, 0xfb, 0x73, 0x24, 0xdd, 0x87, 0xc6, 0x5c, 0x69, 0x8c, 0xe3,
+	0x7e, 0x2e, 0xc3, 0x83, 0xdc, 0x96, 0x5f, 0x68, 0x17, 0xbf, 0x8e, 0x19, 0xfe, 0x0a, 0xb6, 0x24,
+	0x06, 0x9c, 0x85, 0x9e, 0x48, 0xff, 0x7f, 0x8c, 0xed, 0xe9, 0x1e, 0xff, 0x96, 0xe4, 0x3a, 0x6c,
+	0xe7, 0x92, 0x11, 0x94, 0x41, 0x8c, 0x85, 0xfc, 0x26, 0xf0, 0xe0, 0xe5, 0x95, 0x57, 0x2e, 0xe6,
+	0x14, 0x53, 0xf9, 0x4e, 0x4c, 0xb3, 0xc7, 0x68, 0x30, 0xfd, 0x64, 0xc1, 0xbd, 0xec, 0xb9, 0x2f,
+	0x04, 0xcc, 0xbb, 0xb0, 0xaa, 0x92, 0x6e, 0xb6, 0xac, 0xe1, 0x6c, 0x3c, 0x79, 0x78, 0xeb, 0xab,
+	0x7b, 0x9e, 0x9c, 0xa7, 0x11, 0x92, 0x8a, 0xd2, 0xd7, 0x39, 0xdf, 0xd8, 0x77, 0x60, 0x63, 0x82,
+	0xd1, 0xe4, 0x7d, 0x27, 0x7b, 0x41, 0x49, 0xaa, 0x28, 0x67, 0x1a, 0xe6, 0x12, 0x99, 0xce, 0x9b,
+	0xdf, 0x5b, 0x70, 0x3f, 0x37, 0xf6, 0x99, 0xf4, 0x17, 0xf1, 0x76, 0xb4, 0x61, 0x39, 0x92, 0xbe,
+	0xd2, 0x9c, 0x6a, 0x44, 0x8f, 0xe7, 0x00, 0xdf, 0x02, 0xbb, 0x88, 0x24, 0x07, 0xdf, 0x26, 0x97,
+	0x7f, 0x3a, 0xa5, 0xcb, 0x2b, 0xc7, 0x7a, 0x71, 0xe5, 0x58, 0x7f, 0x5c, 0x39, 0xd6, 0x0f, 0xd7,
+	0x4e, 0xe9, 0xc5, 0xb5, 0x53, 0xfa, 0xfd, 0xda, 0x29, 0x7d, 0xfd, 0xfe, 0x7f, 0x74, 0xfc, 0xe4,
+	0xa7, 0x4c, 0xc3, 0xf4, 0x2b, 0xfa, 0xa7, 0xea, 0xbd, 0xbf, 0x07, 0x00, 0xe0, 0x2a, 0xcc, 0x26,
+	0xd7, 0x09, 0x00, 0x00,
 }
 
 func (m *ConfirmOutpointRequest) Marshal() (dAtA []byte, err error) {
@@ -729,6 +820,13 @@ func (m *ConfirmOutpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.OutPointInfo.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -792,6 +890,13 @@ func (m *LinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.RecipientChain) > 0 {
 		i -= len(m.RecipientChain)
 		copy(dAtA[i:], m.RecipientChain)
@@ -866,6 +971,13 @@ func (m *CreatePendingTransfersTxRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.MasterKeyAmount != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.MasterKeyAmount))
 		i--
@@ -931,6 +1043,13 @@ func (m *VoteConfirmOutpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Confirmed {
 		i--
 		if m.Confirmed {
@@ -1018,6 +1137,13 @@ func (m *SubmitExternalSignatureRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.SigHash) > 0 {
 		i -= len(m.SigHash)
 		copy(dAtA[i:], m.SigHash)
@@ -1092,6 +1218,13 @@ func (m *CreateMasterTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.SecondaryKeyAmount != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.SecondaryKeyAmount))
 		i--
@@ -1157,6 +1290,13 @@ func (m *CreateRescueTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -1210,6 +1350,13 @@ func (m *SignTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TxType != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TxType))
 		i--
@@ -1253,6 +1400,73 @@ func (m *SignTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SubmitPsbtRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitPsbtRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmitPsbtRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Psbt) > 0 {
+		i -= len(m.Psbt)
+		copy(dAtA[i:], m.Psbt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Psbt)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmitPsbtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitPsbtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmitPsbtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1276,6 +1490,10 @@ func (m *ConfirmOutpointRequest) Size() (n int) {
 	}
 	l = m.OutPointInfo.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1306,6 +1524,10 @@ func (m *LinkRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1339,6 +1561,10 @@ func (m *CreatePendingTransfersTxRequest) Size() (n int) {
 	if m.MasterKeyAmount != 0 {
 		n += 1 + sovTx(uint64(m.MasterKeyAmount))
 	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1370,6 +1596,10 @@ func (m *VoteConfirmOutpointRequest) Size() (n int) {
 	if m.Confirmed {
 		n += 2
 	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1408,6 +1638,10 @@ func (m *SubmitExternalSignatureRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1437,6 +1671,10 @@ func (m *CreateMasterTxRequest) Size() (n int) {
 	if m.SecondaryKeyAmount != 0 {
 		n += 1 + sovTx(uint64(m.SecondaryKeyAmount))
 	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1459,6 +1697,10 @@ func (m *CreateRescueTxRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1484,6 +1726,10 @@ func (m *SignTxRequest) Size() (n int) {
 	if m.TxType != 0 {
 		n += 1 + sovTx(uint64(m.TxType))
 	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1499,6 +1745,36 @@ func (m *SignTxResponse) Size() (n int) {
 	return n
 }
 
+func (m *SubmitPsbtRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Psbt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SubmitPsbtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1601,6 +1877,38 @@ func (m *ConfirmOutpointRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1799,6 +2107,38 @@ func (m *LinkRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.RecipientChain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2016,17 +2356,49 @@ func (m *CreatePendingTransfersTxRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -2235,6 +2607,38 @@ func (m *VoteConfirmOutpointRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Confirmed = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2501,6 +2905,38 @@ func (m *SubmitExternalSignatureRequest) Unmarshal(dAtA []byte) error {
 				m.SigHash = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2686,6 +3122,38 @@ func (m *CreateMasterTxRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2820,6 +3288,38 @@ func (m *CreateRescueTxRequest) Unmarshal(dAtA []byte) error {
 				m.Sender = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2973,6 +3473,38 @@ func (m *SignTxRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3063,6 +3595,206 @@ func (m *SignTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SubmitPsbtRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmitPsbtRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmitPsbtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Psbt = append(m.Psbt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Psbt == nil {
+				m.Psbt = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmitPsbtResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmitPsbtResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmitPsbtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -15,10 +16,11 @@ import (
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/btcsuite/btcutil/bech32"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
-	"github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
 )
@@ -43,6 +45,9 @@ const maxDerSigLength = 72
 // MinRelayTxFeeSatoshiPerByte defines bitcoin's default minimum relay fee in satoshi/byte
 const MinRelayTxFeeSatoshiPerByte = int64(mempool.DefaultMinRelayTxFee / 1000)
 
+// sigHashForkID is the sighash flag of replay protected signatures (SIGHASH_FORKID)
+const sigHashForkID txscript.SigHashType = 0x40
+
 // Params returns the network parameters
 func (m Network) Params() *chaincfg.Params {
 	switch m.Name {
@@ -53,10 +58,72 @@ func (m Network) Params() *chaincfg.Params {
 	case regtest:
 		return &chaincfg.RegressionNetParams
 	default:
-		panic("invalid network")
+		if !m.IsCustom() {
+			panic("invalid network")
+		}
+
+		// custom parameters are derived from the network every time, so they always match the chain's current state
+		return &chaincfg.Params{
+			Name:             m.Name,
+			Net:              wire.BitcoinNet(m.Net),
+			Bech32HRPSegwit:  m.Bech32HRP,
+			PubKeyHashAddrID: byte(m.PubKeyHashAddrID),
+			ScriptHashAddrID: byte(m.ScriptHashAddrID),
+		}
+	}
+}
+
+// IsCustom returns true if the network is not one of bitcoin's networks natively known to btcd
+func (m Network) IsCustom() bool {
+	return m.Net != 0 || m.Bech32HRP != "" || m.PubKeyHashAddrID != 0 || m.ScriptHashAddrID != 0 || m.SegwitDisabled || m.ForkIDSigHash
+}
+
+// SigHashType returns the sighash type of the signatures on the network
+func (m Network) SigHashType() txscript.SigHashType {
+	if m.ForkIDSigHash {
+		return txscript.SigHashAll | sigHashForkID
+	}
+
+	return txscript.SigHashAll
+}
+
+// CalcSigHash returns the hash the given input of the transaction has to be signed over
+func (m Network) CalcSigHash(tx *wire.MsgTx, idx int, amount btcutil.Amount, redeemScript RedeemScript) ([]byte, error) {
+	switch {
+	// replay protected signatures commit to the amount with the BIP-143 digest algorithm even without segwit
+	case m.ForkIDSigHash:
+		return txscript.CalcWitnessSigHash(redeemScript, txscript.NewTxSigHashes(tx), m.SigHashType(), tx, idx, int64(amount))
+	case m.SegwitDisabled:
+		return txscript.CalcSignatureHash(redeemScript, m.SigHashType(), tx, idx)
+	default:
+		return txscript.CalcWitnessSigHash(redeemScript, txscript.NewTxSigHashes(tx), m.SigHashType(), tx, idx, int64(amount))
 	}
 }
 
+// DecodeAddress decodes the given address and returns an error if it does not belong to the network
+func (m Network) DecodeAddress(address string) (btcutil.Address, error) {
+	params := m.Params()
+
+	// btcd only decodes bech32 addresses of registered networks, so they are decoded independently of btcd's registry
+	var decoded btcutil.Address
+	var err error
+	if hrp, _, bech32Err := bech32.Decode(address); bech32Err == nil && params.Bech32HRPSegwit != "" && hrp == params.Bech32HRPSegwit {
+		decoded, err = decodeWitnessAddress(address)
+	} else {
+		decoded, err = btcutil.DecodeAddress(address, params)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !decoded.IsForNet(params) {
+		return nil, fmt.Errorf("address %s does not belong to network %s", address, m.Name)
+	}
+
+	return decoded, nil
+}
+
 // NetworkFromStr returns network given string
 func NetworkFromStr(networkName string) (Network, error) {
 	switch networkName {
@@ -75,10 +142,44 @@ func NetworkFromStr(networkName string) (Network, error) {
 func (m *Network) Validate() error {
 	switch m.Name {
 	case main, test, regtest:
+		if m.IsCustom() {
+			return fmt.Errorf("network %s must not redefine its parameters", m.Name)
+		}
+
 		return nil
-	default:
+	}
+
+	if !m.IsCustom() {
 		return fmt.Errorf("unknown network: %s", m)
 	}
+
+	if strings.TrimSpace(m.Name) == "" {
+		return fmt.Errorf("network name must not be empty")
+	}
+
+	for _, known := range []*chaincfg.Params{&chaincfg.MainNetParams, &chaincfg.TestNet3Params, &chaincfg.RegressionNetParams, &chaincfg.SimNetParams} {
+		if wire.BitcoinNet(m.Net) == known.Net {
+			return fmt.Errorf("network %s must not use the magic number of bitcoin's %s network", m.Name, known.Name)
+		}
+	}
+
+	if !m.SegwitDisabled && m.Bech32HRP == "" {
+		return fmt.Errorf("network %s must define the human-readable part of its bech32 addresses", m.Name)
+	}
+
+	if m.ForkIDSigHash && !m.SegwitDisabled {
+		return fmt.Errorf("network %s must disable segwit to use replay protected signatures", m.Name)
+	}
+
+	if m.PubKeyHashAddrID > math.MaxUint8 || m.ScriptHashAddrID > math.MaxUint8 {
+		return fmt.Errorf("address version bytes of network %s must fit into a single byte", m.Name)
+	}
+
+	if m.PubKeyHashAddrID == m.ScriptHashAddrID {
+		return fmt.Errorf("network %s must use different version bytes for P2PKH and P2SH addresses", m.Name)
+	}
+
+	return nil
 }
 
 // NewOutPointInfo returns a new OutPointInfo instance
@@ -406,6 +507,19 @@ func createP2wshAddress(script RedeemScript, network Network) *btcutil.AddressWi
 	return addr
 }
 
+// createScriptAddress creates a P2WSH address based on a redeem script, or a P2SH address if the network does not support segwit
+func createScriptAddress(script RedeemScript, network Network) btcutil.Address {
+	if !network.SegwitDisabled {
+		return createP2wshAddress(script, network)
+	}
+
+	addr, err := btcutil.NewAddressScriptHash(script, network.Params())
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 // NewMasterConsolidationAddress returns a p2wsh-wrapped address that is
 // 1) spendable by the ((currMasterKey or oldMasterKey) and externalMultiSigThreshold/len(externalKeys) externalKeys) before the timelock elapses
 // 2) spendable by the (currMasterKey or oldMasterKey) after the timelock elapses
@@ -430,7 +544,7 @@ func NewMasterConsolidationAddress(currMasterKey tss.Key, oldMasterKey tss.Key,
 	}
 
 	script := createMasterAddressScript(btcec.PublicKey(currMasterPk), btcec.PublicKey(oldMasterPk), externalMultiSigThreshold, externalPubKeys, internalKeysOnlyLockTime, externalKeysOnlyLockTime)
-	address := createP2wshAddress(script, network)
+	address := createScriptAddress(script, network)
 
 	externalKeyIDs := make([]tss.KeyID, len(externalKeys))
 	for i, externalKey := range externalKeys {
@@ -459,7 +573,7 @@ func NewSecondaryConsolidationAddress(secondaryKey tss.Key, network Network) (Ad
 		return AddressInfo{}, err
 	}
 	script := createP2pkScript(btcec.PublicKey(secondaryPk))
-	address := createP2wshAddress(script, network)
+	address := createScriptAddress(script, network)
 
 	return AddressInfo{
 		RedeemScript: script,
@@ -500,7 +614,7 @@ func NewDepositAddress(secondaryKey tss.Key, externalMultiSigThreshold int64, ex
 		externalKeysOnlyLockTime,
 		nonce,
 	)
-	address := createP2wshAddress(script, network)
+	address := createScriptAddress(script, network)
 
 	return AddressInfo{
 		RedeemScript: script,
@@ -520,7 +634,7 @@ func NewDepositAddress(secondaryKey tss.Key, externalMultiSigThreshold int64, ex
 // NewAnyoneCanSpendAddress returns a p2wsh-wrapped anyone-can-spend address
 func NewAnyoneCanSpendAddress(network Network) AddressInfo {
 	script := createAnyoneCanSpendRedeemScript()
-	address := createP2wshAddress(script, network)
+	address := createScriptAddress(script, network)
 
 	return AddressInfo{
 		RedeemScript:      script,
@@ -534,7 +648,7 @@ func NewAnyoneCanSpendAddress(network Network) AddressInfo {
 
 // GetAddress returns the encoded bitcoin address
 func (m AddressInfo) GetAddress() btcutil.Address {
-	address, err := btcutil.DecodeAddress(m.Address, nil)
+	address, err := decodeScriptAddress(m.Address)
 	if err != nil {
 		panic(fmt.Errorf("invalid bitcoin address %s found", m.Address))
 	}
@@ -542,14 +656,70 @@ func (m AddressInfo) GetAddress() btcutil.Address {
 	return address
 }
 
-// ToCrossChainAddr returns the corresponding cross-chain address
-func (m AddressInfo) ToCrossChainAddr() nexus.CrossChainAddress {
+// ToCrossChainAddr returns the corresponding cross-chain address on the given chain
+func (m AddressInfo) ToCrossChainAddr(chain nexus.Chain) nexus.CrossChainAddress {
 	return nexus.CrossChainAddress{
-		Chain:   exported.Bitcoin,
+		Chain:   chain,
 		Address: m.Address,
 	}
 }
 
+// PayToScriptAddrScript returns the script paying to the given P2WSH or P2SH address of any network
+func PayToScriptAddrScript(address string) ([]byte, error) {
+	decoded, err := decodeScriptAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return txscript.PayToAddrScript(decoded)
+}
+
+// decodeScriptAddress decodes the given P2WSH or P2SH address independently of the network it belongs to
+func decodeScriptAddress(address string) (btcutil.Address, error) {
+	if decoded, err := decodeWitnessAddress(address); err == nil {
+		if _, ok := decoded.(*btcutil.AddressWitnessScriptHash); !ok {
+			return nil, fmt.Errorf("address %s is not a script address", address)
+		}
+
+		return decoded, nil
+	}
+
+	hash, netID, err := base58.CheckDecode(address)
+	if err != nil {
+		return nil, fmt.Errorf("address %s is neither a bech32 nor a base58 address", address)
+	}
+
+	return btcutil.NewAddressScriptHashFromHash(hash, &chaincfg.Params{ScriptHashAddrID: netID})
+}
+
+// decodeWitnessAddress decodes the given version 0 witness address independently of the network it belongs to,
+// because btcd only knows the human-readable part of networks that have been registered with it
+func decodeWitnessAddress(address string) (btcutil.Address, error) {
+	hrp, data, err := bech32.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 || data[0] != 0 {
+		return nil, fmt.Errorf("address %s is not a version 0 witness address", address)
+	}
+
+	program, err := bech32.ConvertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &chaincfg.Params{Bech32HRPSegwit: hrp}
+	switch len(program) {
+	case 20:
+		return btcutil.NewAddressWitnessPubKeyHash(program, params)
+	case 32:
+		return btcutil.NewAddressWitnessScriptHash(program, params)
+	default:
+		return nil, fmt.Errorf("address %s has a witness program of invalid length %d", address, len(program))
+	}
+}
+
 // validateTxScript checks if the input at the given index can be spent with the given script
 func validateTxScript(tx *wire.MsgTx, idx int, amount int64, payScript []byte) error {
 	// make sure the tx is considered standard to increase its chance to be mined
@@ -574,14 +744,21 @@ type OutPointToSign struct {
 
 // AssembleBtcTx assembles the unsigned transaction and given signature.
 // Returns an error if the resulting signed Bitcoin transaction is invalid.
-func AssembleBtcTx(rawTx *wire.MsgTx, outpointsToSign []OutPointToSign, sigs [][]btcec.Signature) (*wire.MsgTx, error) {
+func AssembleBtcTx(rawTx *wire.MsgTx, outpointsToSign []OutPointToSign, sigs [][]btcec.Signature, network Network) (*wire.MsgTx, error) {
 	for i, in := range outpointsToSign {
-		witness := wire.TxWitness{}
-
+		var sigData [][]byte
 		for _, sig := range sigs[i] {
-			witness = append(witness, append(sig.Serialize(), byte(txscript.SigHashAll)))
+			sigData = append(sigData, append(sig.Serialize(), byte(network.SigHashType())))
+		}
+
+		if err := setInputScript(rawTx.TxIn[i], sigData, in.RedeemScript, network); err != nil {
+			return nil, err
+		}
+
+		// btcd's script engine cannot verify replay protected signatures
+		if network.ForkIDSigHash {
+			continue
 		}
-		rawTx.TxIn[i].Witness = append(witness, in.RedeemScript)
 
 		payScript, err := txscript.PayToAddrScript(in.AddressInfo.GetAddress())
 		if err != nil {
@@ -596,6 +773,28 @@ func AssembleBtcTx(rawTx *wire.MsgTx, outpointsToSign []OutPointToSign, sigs [][
 	return rawTx, nil
 }
 
+// setInputScript sets the given signatures and redeem script as the witness of the given input,
+// or as its signature script if the network does not support segwit
+func setInputScript(txIn *wire.TxIn, sigs [][]byte, redeemScript RedeemScript, network Network) error {
+	if !network.SegwitDisabled {
+		txIn.Witness = append(wire.TxWitness(sigs), redeemScript)
+		return nil
+	}
+
+	builder := txscript.NewScriptBuilder()
+	for _, sig := range sigs {
+		builder = builder.AddData(sig)
+	}
+
+	script, err := builder.AddData(redeemScript).Script()
+	if err != nil {
+		return err
+	}
+
+	txIn.SignatureScript = script
+	return nil
+}
+
 // MustEncodeTx serializes a given bitcoin transaction; panic if error
 func MustEncodeTx(tx *wire.MsgTx) []byte {
 	var buf bytes.Buffer
@@ -620,7 +819,7 @@ func MustDecodeTx(bz []byte) wire.MsgTx {
 
 // MustDecodeAddress decodes the given address; panic if error
 func MustDecodeAddress(address string, network Network) btcutil.Address {
-	decoded, err := btcutil.DecodeAddress(address, network.Params())
+	decoded, err := network.DecodeAddress(address)
 	if err != nil {
 		panic(err)
 	}
@@ -629,17 +828,20 @@ func MustDecodeAddress(address string, network Network) btcutil.Address {
 }
 
 // EstimateTxSize calculates the upper limit of the size in byte of given transaction after all witness data is attached
-func EstimateTxSize(tx wire.MsgTx, outpointsToSign []OutPointToSign) int64 {
+func EstimateTxSize(tx wire.MsgTx, outpointsToSign []OutPointToSign, network Network) int64 {
 	zeroSigBytes := make([]byte, maxDerSigLength)
+	// the inputs are shared with the given transaction, and signature scripts would change its hash
+	tx = *tx.Copy()
 
 	for i, input := range outpointsToSign {
-		var witness wire.TxWitness
+		var sigs [][]byte
 
 		for j := 0; j < int(input.MaxSigCount); j++ {
-			witness = append(witness, zeroSigBytes)
+			sigs = append(sigs, zeroSigBytes)
 		}
 
-		tx.TxIn[i].Witness = append(witness, input.RedeemScript)
+		// the script only fails to build if it exceeds the maximum script size, which is not relevant for the estimate
+		_ = setInputScript(tx.TxIn[i], sigs, input.RedeemScript, network)
 	}
 
 	return mempool.GetTxVirtualSize(btcutil.NewTx(&tx))
@@ -672,6 +874,25 @@ func ToSatoshiCoin(coin sdk.DecCoin) (sdk.Coin, error) {
 	return sat, nil
 }
 
+// ToBaseCoin converts the given dec coin to the equivalent coin in the given base denomination.
+// Amounts of chains other than bitcoin must already be denominated in the chain's base denomination
+func ToBaseCoin(coin sdk.DecCoin, baseDenom string) (sdk.Coin, error) {
+	if baseDenom == Satoshi {
+		return ToSatoshiCoin(coin)
+	}
+
+	if coin.Denom != baseDenom {
+		return sdk.Coin{}, fmt.Errorf("expected denomination %s, got %s", baseDenom, coin.Denom)
+	}
+
+	base, remainder := coin.TruncateDecimal()
+	if !remainder.IsZero() {
+		return sdk.Coin{}, fmt.Errorf("amount in %s must be an integer value", baseDenom)
+	}
+
+	return base, nil
+}
+
 // ParseSatoshi parses a string to Satoshi, returning errors if invalid. Inputs in Bitcoin are automatically converted.
 // This returns an error on an empty string as well.
 func ParseSatoshi(rawCoin string) (sdk.Coin, error) {
@@ -807,3 +1028,13 @@ func (t TxType) Validate() error {
 
 	return nil
 }
+
+// IsExpired returns true if the deposit address is expired at the given block height; otherwise, false
+func (m DepositAddressExpiry) IsExpired(blockHeight int64) bool {
+	return blockHeight >= m.ExpiresAt
+}
+
+// IsPrunable returns true if the grace period of the deposit address is over at the given block height; otherwise, false
+func (m DepositAddressExpiry) IsPrunable(blockHeight int64) bool {
+	return blockHeight >= m.PruneAt
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	_ "github.com/axelarnetwork/axelar-core/x/tss/exported"
 	github_com_axelarnetwork_axelar_core_x_tss_exported "github.com/axelarnetwork/axelar-core/x/tss/exported"
 	github_com_btcsuite_btcutil "github.com/btcsuite/btcutil"
@@ -483,15 +484,75 @@ func (m *AddressInfo_SpendingCondition) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AddressInfo_SpendingCondition proto.InternalMessageInfo
 
+// DepositAddressExpiry tracks the block heights at which a deposit address
+// expires and at which it is pruned from the state after its grace period
+type DepositAddressExpiry struct {
+	Address   string                     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Recipient exported.CrossChainAddress `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient"`
+	ExpiresAt int64                      `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	PruneAt   int64                      `protobuf:"varint,4,opt,name=prune_at,json=pruneAt,proto3" json:"prune_at,omitempty"`
+	// funded is set once a deposit to the address has been confirmed
+	Funded bool `protobuf:"varint,5,opt,name=funded,proto3" json:"funded,omitempty"`
+}
+
+func (m *DepositAddressExpiry) Reset()         { *m = DepositAddressExpiry{} }
+func (m *DepositAddressExpiry) String() string { return proto.CompactTextString(m) }
+func (*DepositAddressExpiry) ProtoMessage()    {}
+func (*DepositAddressExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca561ce6167cd5e4, []int{4}
+}
+func (m *DepositAddressExpiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositAddressExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositAddressExpiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositAddressExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositAddressExpiry.Merge(m, src)
+}
+func (m *DepositAddressExpiry) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositAddressExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositAddressExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositAddressExpiry proto.InternalMessageInfo
+
 type Network struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the following fields are only set for networks btcd does not know
+	// natively, e.g. litecoin's main network
+	// net is the magic number identifying the network's messages
+	Net uint32 `protobuf:"varint,2,opt,name=net,proto3" json:"net,omitempty"`
+	// bech32_hrp is the human-readable part of bech32 segwit addresses
+	Bech32HRP string `protobuf:"bytes,3,opt,name=bech32_hrp,json=bech32Hrp,proto3" json:"bech32_hrp,omitempty"`
+	// pub_key_hash_addr_id is the version byte of base58 encoded P2PKH addresses
+	PubKeyHashAddrID uint32 `protobuf:"varint,4,opt,name=pub_key_hash_addr_id,json=pubKeyHashAddrId,proto3" json:"pub_key_hash_addr_id,omitempty"`
+	// script_hash_addr_id is the version byte of base58 encoded P2SH addresses
+	ScriptHashAddrID uint32 `protobuf:"varint,5,opt,name=script_hash_addr_id,json=scriptHashAddrId,proto3" json:"script_hash_addr_id,omitempty"`
+	// segwit_disabled must be set for networks without segregated witness
+	// support, e.g. dogecoin, so P2SH addresses are used instead of P2WSH
+	SegwitDisabled bool `protobuf:"varint,6,opt,name=segwit_disabled,json=segwitDisabled,proto3" json:"segwit_disabled,omitempty"`
+	// fork_id_sig_hash must be set for networks that require replay protected
+	// signatures (SIGHASH_FORKID), e.g. bitcoin cash
+	ForkIDSigHash bool `protobuf:"varint,7,opt,name=fork_id_sig_hash,json=forkIdSigHash,proto3" json:"fork_id_sig_hash,omitempty"`
 }
 
 func (m *Network) Reset()         { *m = Network{} }
 func (m *Network) String() string { return proto.CompactTextString(m) }
 func (*Network) ProtoMessage()    {}
 func (*Network) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca561ce6167cd5e4, []int{4}
+	return fileDescriptor_ca561ce6167cd5e4, []int{5}
 }
 func (m *Network) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -533,99 +594,116 @@ func init() {
 	proto.RegisterType((*OutPointInfo)(nil), "bitcoin.v1beta1.OutPointInfo")
 	proto.RegisterType((*AddressInfo)(nil), "bitcoin.v1beta1.AddressInfo")
 	proto.RegisterType((*AddressInfo_SpendingCondition)(nil), "bitcoin.v1beta1.AddressInfo.SpendingCondition")
+	proto.RegisterType((*DepositAddressExpiry)(nil), "bitcoin.v1beta1.DepositAddressExpiry")
 	proto.RegisterType((*Network)(nil), "bitcoin.v1beta1.Network")
 }
 
 func init() { proto.RegisterFile("bitcoin/v1beta1/types.proto", fileDescriptor_ca561ce6167cd5e4) }
 
 var fileDescriptor_ca561ce6167cd5e4 = []byte{
-	// 1367 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0xda, 0x8e, 0x63, 0x8f, 0x9b, 0xd4, 0x99, 0x36, 0xad, 0xb3, 0x69, 0xed, 0x55, 0xfe,
-	0x7f, 0x2a, 0x53, 0xe8, 0x9a, 0xa4, 0x5c, 0x5a, 0xa9, 0x45, 0x8e, 0xbd, 0x2d, 0x56, 0x1b, 0xdb,
-	0xcc, 0x6e, 0x50, 0x8b, 0x54, 0x2d, 0x1b, 0x7b, 0xe2, 0x0c, 0xb1, 0x77, 0xcc, 0xce, 0xb8, 0xd8,
-	0x77, 0x0e, 0xc8, 0x5c, 0x7a, 0x84, 0x83, 0x25, 0x24, 0x38, 0xf0, 0x39, 0xb8, 0xd0, 0x63, 0x4f,
-	0x88, 0x53, 0x80, 0x94, 0x4f, 0xc0, 0xb1, 0x17, 0xd0, 0xcc, 0xee, 0x26, 0xb6, 0xdb, 0x88, 0x42,
-	0xcb, 0x6d, 0xe7, 0xbd, 0xdf, 0x7b, 0x6f, 0xde, 0xfb, 0xbd, 0xf7, 0x66, 0xc1, 0xea, 0x0e, 0xe1,
-	0x4d, 0x4a, 0xdc, 0xe2, 0xc3, 0xf5, 0x1d, 0xcc, 0x9d, 0xf5, 0x22, 0x1f, 0xf6, 0x30, 0xd3, 0x7b,
-	0x1e, 0xe5, 0x14, 0x9e, 0x0e, 0x94, 0x7a, 0xa0, 0x54, 0xcf, 0xb6, 0x69, 0x9b, 0x4a, 0x5d, 0x51,
-	0x7c, 0xf9, 0x30, 0x55, 0xe3, 0x8c, 0x15, 0xf1, 0xa0, 0x47, 0x3d, 0x8e, 0x5b, 0x2f, 0x72, 0xa4,
-	0xe6, 0xdb, 0x94, 0xb6, 0x3b, 0xb8, 0x28, 0x4f, 0x3b, 0xfd, 0xdd, 0x22, 0x27, 0x5d, 0xcc, 0xb8,
-	0xd3, 0xed, 0xf9, 0x80, 0xb5, 0x3f, 0x12, 0x00, 0x6c, 0xbb, 0x8c, 0xb4, 0x5d, 0xdc, 0xb2, 0x06,
-	0xf0, 0x2d, 0x10, 0x17, 0xe6, 0x59, 0x45, 0x53, 0x0a, 0x8b, 0x1b, 0xe7, 0xf5, 0x99, 0x7b, 0xe8,
-	0xd6, 0xc0, 0x1a, 0xf6, 0x30, 0x92, 0x20, 0xb8, 0x08, 0xa2, 0x7c, 0x90, 0x8d, 0x6a, 0x4a, 0xe1,
-	0x14, 0x8a, 0xf2, 0x01, 0xbc, 0x0e, 0xe2, 0xc4, 0xdd, 0xa5, 0xd9, 0x98, 0xa6, 0x14, 0xd2, 0x1b,
-	0xda, 0x73, 0xc6, 0xc7, 0x71, 0xf4, 0xaa, 0xbb, 0x4b, 0x37, 0xe3, 0x8f, 0x0f, 0xf2, 0x11, 0x24,
-	0x6d, 0xe0, 0x3a, 0x48, 0x30, 0xee, 0xf0, 0x3e, 0xcb, 0xc6, 0x65, 0xe8, 0x95, 0x17, 0x84, 0x36,
-	0x25, 0x00, 0x05, 0x40, 0x78, 0x15, 0x2c, 0x37, 0xa9, 0xbb, 0x4b, 0xbc, 0xae, 0xc3, 0x09, 0x75,
-	0x6d, 0x0f, 0x7f, 0xda, 0x27, 0x1e, 0x6e, 0x65, 0xe7, 0x34, 0xa5, 0x90, 0x44, 0x67, 0x27, 0x95,
-	0x28, 0xd0, 0xc1, 0x75, 0xb0, 0xec, 0xb8, 0x43, 0xea, 0x62, 0xbb, 0xe9, 0xb8, 0x36, 0xeb, 0x61,
-	0xb7, 0x65, 0x3f, 0xa4, 0x7d, 0x9e, 0x4d, 0x68, 0x4a, 0x61, 0x01, 0x41, 0x5f, 0x59, 0x76, 0x5c,
-	0x53, 0xa8, 0x3e, 0xa4, 0x7d, 0x0e, 0x3b, 0xe0, 0x4c, 0xcf, 0xc3, 0x0f, 0x6d, 0x67, 0x47, 0xd6,
-	0xd9, 0xde, 0xc7, 0x43, 0x9b, 0xb4, 0xb2, 0xf3, 0x9a, 0x52, 0x48, 0x6d, 0xde, 0x78, 0x76, 0x90,
-	0xbf, 0xd6, 0x26, 0x7c, 0xaf, 0xbf, 0xa3, 0x37, 0x69, 0xb7, 0xe8, 0x0c, 0x70, 0xc7, 0xf1, 0x5c,
-	0xcc, 0x3f, 0xa3, 0xde, 0x7e, 0x70, 0xba, 0xd2, 0xa4, 0x1e, 0x2e, 0x0e, 0x8a, 0x93, 0x6c, 0xe9,
-	0x77, 0xf0, 0xb0, 0x5a, 0x41, 0x19, 0xe1, 0xb9, 0xe4, 0x3b, 0x16, 0x92, 0x16, 0xfc, 0x18, 0x64,
-	0x89, 0xcb, 0xb1, 0xe7, 0x3a, 0x1d, 0x9b, 0x7b, 0x8e, 0xcb, 0x76, 0xb1, 0x67, 0x3b, 0x5d, 0xda,
-	0x77, 0x79, 0x36, 0xa9, 0x29, 0x85, 0xd8, 0xe6, 0xa5, 0x67, 0x07, 0xf9, 0xb5, 0x89, 0x90, 0x3b,
-	0xbc, 0xc9, 0xfa, 0x84, 0x63, 0xf1, 0xd1, 0xe7, 0xa4, 0xa3, 0x97, 0x24, 0x1a, 0x9d, 0x0b, 0xfd,
-	0x58, 0x81, 0x1b, 0x5f, 0xae, 0xfe, 0x19, 0x05, 0x71, 0x51, 0x7f, 0x78, 0x11, 0x00, 0x8f, 0x72,
-	0x87, 0x63, 0x91, 0x92, 0xa4, 0x3c, 0x89, 0x52, 0xbe, 0xe4, 0x0e, 0x1e, 0xc2, 0x0f, 0x40, 0x9a,
-	0xb8, 0xbd, 0x3e, 0xb7, 0x05, 0x41, 0x2c, 0x1b, 0xd5, 0x62, 0x85, 0xf4, 0xc6, 0xe5, 0xbf, 0x63,
-	0x55, 0xaf, 0x0a, 0x9b, 0x09, 0x7e, 0x01, 0x09, 0x05, 0x4c, 0xfd, 0x3c, 0x0a, 0x52, 0x47, 0x7a,
-	0xf8, 0x09, 0xc8, 0x30, 0xd2, 0x0e, 0x79, 0xeb, 0x62, 0x97, 0xb3, 0xac, 0x22, 0xa3, 0x5c, 0x7b,
-	0xf9, 0x28, 0xba, 0x49, 0xda, 0xe8, 0xd8, 0x43, 0x10, 0xf4, 0x34, 0x9b, 0x92, 0x32, 0x75, 0xa4,
-	0x80, 0xc5, 0x69, 0x24, 0x7c, 0x00, 0x12, 0x01, 0x95, 0x8a, 0xa4, 0xf2, 0xd6, 0xe1, 0x41, 0x7e,
-	0x4e, 0xd2, 0xf2, 0x6a, 0x9c, 0xce, 0xed, 0x4b, 0x22, 0x57, 0x40, 0x52, 0x64, 0xb7, 0xe7, 0xb0,
-	0xbd, 0x60, 0x46, 0xe6, 0x19, 0x69, 0xbf, 0xef, 0xb0, 0xbd, 0xb5, 0x03, 0x05, 0x24, 0xcd, 0xd7,
-	0x32, 0x72, 0x57, 0x82, 0xde, 0xf4, 0x8b, 0x63, 0xf3, 0x81, 0x1f, 0x2f, 0x26, 0x01, 0xb2, 0xb9,
-	0xc2, 0x38, 0x22, 0xf0, 0xc9, 0x23, 0x13, 0xff, 0x37, 0x23, 0x33, 0x77, 0xd2, 0xc8, 0xac, 0x7d,
-	0xa9, 0x80, 0x53, 0xf5, 0x3e, 0x6f, 0x50, 0xe2, 0xfa, 0x54, 0xaf, 0x82, 0x14, 0xed, 0x73, 0xbb,
-	0x27, 0x04, 0x7e, 0xb9, 0x51, 0x92, 0x06, 0x00, 0x78, 0x13, 0x24, 0x82, 0x06, 0x8f, 0xfe, 0xa3,
-	0x06, 0x0f, 0xac, 0x60, 0x16, 0xcc, 0x3b, 0xad, 0x96, 0x87, 0x19, 0x93, 0x89, 0xa7, 0x50, 0x78,
-	0xbc, 0x1e, 0xff, 0xea, 0x9b, 0x7c, 0x64, 0xed, 0xc7, 0x39, 0x90, 0x2e, 0xf9, 0x12, 0x79, 0x99,
-	0x09, 0xbc, 0x32, 0x85, 0x87, 0xef, 0x80, 0xb8, 0x47, 0x3b, 0x58, 0xde, 0x63, 0x71, 0xe3, 0xc2,
-	0x73, 0x5c, 0x04, 0x5e, 0x10, 0xed, 0x60, 0x24, 0x91, 0xf0, 0x7f, 0x60, 0xc1, 0xc3, 0x2d, 0x8c,
-	0xbb, 0x36, 0x6b, 0x7a, 0xa4, 0xc7, 0x83, 0xd2, 0x9f, 0xf2, 0x85, 0xa6, 0x94, 0x4d, 0x74, 0x5a,
-	0xfc, 0xbf, 0xe8, 0xb4, 0x35, 0xb0, 0xd0, 0x75, 0x06, 0xa2, 0x07, 0xec, 0xa6, 0x2c, 0xa3, 0x4f,
-	0x4c, 0xba, 0xeb, 0x0c, 0x4c, 0xd2, 0x2e, 0xcb, 0x1a, 0x3d, 0x00, 0x50, 0x32, 0x47, 0x5c, 0x01,
-	0x72, 0x5b, 0x44, 0x50, 0x2c, 0x97, 0x5e, 0x7a, 0x43, 0x3f, 0x29, 0x4f, 0x7f, 0xbe, 0x02, 0xb3,
-	0x72, 0x68, 0x85, 0x96, 0xd8, 0xac, 0x48, 0xfd, 0x3d, 0x0a, 0x96, 0x9e, 0x03, 0xc2, 0x36, 0xc8,
-	0x1c, 0xed, 0x32, 0xbf, 0x00, 0xfe, 0x80, 0xbf, 0xf2, 0xda, 0x5c, 0x0c, 0xdd, 0xca, 0x9d, 0xc9,
-	0x44, 0x20, 0x3c, 0x98, 0x09, 0x14, 0x7d, 0x2d, 0x81, 0x42, 0xb7, 0x41, 0xa0, 0x9b, 0x60, 0xf5,
-	0x28, 0x50, 0xb7, 0xdf, 0xe1, 0x44, 0x14, 0x9d, 0xef, 0x79, 0x98, 0xed, 0xd1, 0x4e, 0x4b, 0x92,
-	0x1f, 0x43, 0x2b, 0x21, 0x64, 0x2b, 0x40, 0x58, 0x21, 0x00, 0xde, 0x00, 0xa9, 0x0e, 0x6d, 0xee,
-	0xdb, 0xe2, 0x19, 0x96, 0xcd, 0x90, 0xde, 0x50, 0x75, 0xff, 0x8d, 0xd6, 0xc3, 0x37, 0x5a, 0xb7,
-	0xc2, 0x37, 0x7a, 0x33, 0xfe, 0xe8, 0x97, 0xbc, 0x82, 0x92, 0xc2, 0x44, 0x08, 0xd7, 0x2e, 0x82,
-	0xf9, 0x9a, 0x7f, 0x75, 0x08, 0x41, 0xdc, 0x75, 0xba, 0x38, 0xe8, 0x60, 0xf9, 0x7d, 0xf9, 0x27,
-	0x05, 0x24, 0xc3, 0x67, 0x12, 0x6e, 0x80, 0x65, 0xeb, 0x9e, 0x6d, 0x5a, 0x25, 0x6b, 0xdb, 0xb4,
-	0xb7, 0x6b, 0x66, 0xc3, 0x28, 0x57, 0x6f, 0x55, 0x8d, 0x4a, 0x26, 0xa2, 0x9e, 0x1f, 0x8d, 0xb5,
-	0x33, 0x21, 0x70, 0xdb, 0x65, 0x3d, 0xdc, 0x24, 0xbb, 0x04, 0x8b, 0x4e, 0x5a, 0x3a, 0xb6, 0x29,
-	0x23, 0xa3, 0x64, 0x19, 0x95, 0x8c, 0xa2, 0xa6, 0x47, 0x63, 0x6d, 0xbe, 0xec, 0x61, 0x87, 0xcf,
-	0x62, 0xcc, 0xea, 0xed, 0x5a, 0xb5, 0x76, 0x3b, 0x13, 0xf5, 0x31, 0x62, 0xd9, 0x10, 0xb7, 0x3d,
-	0x8d, 0x29, 0x6d, 0xd6, 0x91, 0xf0, 0x13, 0xf3, 0x31, 0xc1, 0x6b, 0x07, 0x35, 0x90, 0x99, 0xf6,
-	0x63, 0x54, 0x32, 0x71, 0x15, 0x8c, 0xc6, 0x5a, 0xc2, 0xdf, 0x59, 0x6a, 0xf2, 0x8b, 0x6f, 0x73,
-	0x91, 0xef, 0xbf, 0xcb, 0x29, 0x97, 0x0f, 0x14, 0x90, 0xf0, 0xf7, 0x20, 0xd4, 0xc1, 0x19, 0xeb,
-	0x9e, 0x6d, 0xdd, 0x6f, 0x18, 0x33, 0x49, 0x2d, 0x8f, 0xc6, 0xda, 0x92, 0x0f, 0x9a, 0x4c, 0xe9,
-	0x1a, 0xb8, 0x10, 0xe2, 0xb7, 0x4a, 0xa6, 0x65, 0x20, 0xbb, 0x5c, 0xaf, 0x99, 0xf5, 0xbb, 0xd5,
-	0x4a, 0xc9, 0xaa, 0xd6, 0x6b, 0x19, 0xc5, 0xaf, 0xc6, 0x96, 0xc3, 0x38, 0xf6, 0xca, 0xd4, 0x65,
-	0xb4, 0x43, 0x5a, 0x72, 0x01, 0xc2, 0xf7, 0x40, 0x3e, 0x34, 0x35, 0x8d, 0x72, 0xbd, 0x56, 0x29,
-	0xa1, 0xfb, 0x33, 0xd6, 0x51, 0x55, 0x1d, 0x8d, 0xb5, 0x73, 0x26, 0x16, 0x33, 0xe5, 0x78, 0xc3,
-	0x69, 0x07, 0x39, 0xb0, 0x18, 0x3a, 0x40, 0x86, 0x59, 0xde, 0x36, 0x32, 0x31, 0x3f, 0x41, 0x84,
-	0x59, 0xb3, 0x8f, 0x27, 0x12, 0xfc, 0x41, 0x01, 0x0b, 0xe1, 0xc2, 0x14, 0xb4, 0x60, 0xf8, 0x26,
-	0x58, 0xad, 0x6f, 0x5b, 0x76, 0xa3, 0x5e, 0xad, 0x59, 0xb2, 0x4a, 0xb3, 0xf9, 0x26, 0x47, 0x63,
-	0x2d, 0x5e, 0xa3, 0x2e, 0x86, 0x05, 0x70, 0x7e, 0x16, 0xda, 0x30, 0x6a, 0x15, 0xc1, 0x4b, 0xc0,
-	0x5d, 0xc3, 0x9f, 0x4c, 0xf8, 0x36, 0x58, 0x99, 0x45, 0x96, 0xeb, 0xb5, 0x5b, 0x55, 0xb4, 0x65,
-	0x54, 0x32, 0x51, 0x75, 0x61, 0x34, 0xd6, 0x52, 0x65, 0xff, 0x0d, 0xc0, 0x2d, 0xf8, 0x7f, 0xb0,
-	0x3c, 0x8b, 0x36, 0x1b, 0x46, 0xcd, 0xca, 0xc4, 0xd4, 0xd4, 0x68, 0xac, 0xcd, 0x89, 0x81, 0xe7,
-	0x32, 0x09, 0x45, 0x26, 0xf1, 0xb5, 0x72, 0xb4, 0x67, 0xc5, 0x86, 0x84, 0x97, 0x40, 0xb6, 0x54,
-	0xa9, 0x20, 0xc3, 0x34, 0x6d, 0x54, 0xbf, 0x7b, 0xf2, 0xfd, 0xdf, 0x00, 0x67, 0xa7, 0x70, 0x15,
-	0xa3, 0x51, 0x37, 0xab, 0x56, 0x78, 0xf9, 0x0a, 0xee, 0x51, 0x46, 0x38, 0x5c, 0x07, 0xea, 0x14,
-	0x6c, 0x96, 0x89, 0xa5, 0xd1, 0x58, 0x5b, 0x98, 0x22, 0xe0, 0xb8, 0xc0, 0x9b, 0xe8, 0xf1, 0x6f,
-	0xb9, 0xc8, 0xe3, 0xc3, 0x9c, 0xf2, 0xe4, 0x30, 0xa7, 0xfc, 0x7a, 0x98, 0x53, 0x1e, 0x3d, 0xcd,
-	0x45, 0x9e, 0x3c, 0xcd, 0x45, 0x7e, 0x7e, 0x9a, 0x8b, 0x7c, 0xf4, 0xee, 0x4b, 0x6e, 0x88, 0xf0,
-	0x9f, 0x5d, 0xfe, 0x62, 0xef, 0x24, 0xe4, 0xc4, 0x5e, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xcc,
-	0xbe, 0xf2, 0x8f, 0xcb, 0x0b, 0x00, 0x00,
+	// 1624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x93, 0x1b, 0x47,
+	0x15, 0xdf, 0xd1, 0x6a, 0xb5, 0x52, 0xcb, 0x5a, 0x6b, 0xdb, 0xbb, 0xb6, 0x3c, 0x0e, 0xd2, 0x94,
+	0x80, 0xa0, 0x98, 0x64, 0xc4, 0xca, 0x5c, 0xec, 0xaa, 0x84, 0xd2, 0x3f, 0xc7, 0x2a, 0x67, 0x25,
+	0x31, 0x33, 0x4b, 0x25, 0x54, 0xa5, 0x86, 0x91, 0xa6, 0x57, 0x6a, 0x56, 0x9a, 0x1e, 0xa6, 0x7b,
+	0x1c, 0xe9, 0xce, 0x81, 0x12, 0x97, 0x1c, 0xe1, 0xa0, 0x2a, 0xaa, 0xe0, 0xc0, 0xe7, 0xe0, 0x82,
+	0x2f, 0x54, 0x85, 0x0b, 0xc5, 0x49, 0xc0, 0x9a, 0x4f, 0xc0, 0x31, 0x17, 0xa8, 0xee, 0x9e, 0xd9,
+	0x95, 0xe4, 0x98, 0x04, 0x92, 0xdc, 0xa6, 0x5f, 0xff, 0xde, 0x7b, 0xfd, 0xde, 0xaf, 0xdf, 0x7b,
+	0x3d, 0xe0, 0xde, 0x00, 0xb3, 0x21, 0xc1, 0x5e, 0xf5, 0xd9, 0xc9, 0x00, 0x31, 0xe7, 0xa4, 0xca,
+	0xe6, 0x3e, 0xa2, 0xba, 0x1f, 0x10, 0x46, 0xe0, 0xcd, 0x68, 0x53, 0x8f, 0x36, 0xd5, 0xa3, 0x11,
+	0x19, 0x11, 0xb1, 0x57, 0xe5, 0x5f, 0x12, 0xa6, 0x6a, 0x8c, 0xd2, 0x2a, 0x9a, 0xf9, 0x24, 0x60,
+	0xc8, 0xfd, 0x2c, 0x43, 0x6a, 0xd9, 0x43, 0xb3, 0xf0, 0x73, 0x30, 0xa5, 0x11, 0x21, 0xa3, 0x09,
+	0xaa, 0x8a, 0xd5, 0x20, 0x3c, 0xaf, 0x32, 0x3c, 0x45, 0x94, 0x39, 0x53, 0x5f, 0x02, 0xca, 0xff,
+	0x4a, 0x01, 0x70, 0xe6, 0x51, 0x3c, 0xf2, 0x90, 0x6b, 0xcd, 0xe0, 0x77, 0x41, 0x92, 0xab, 0x17,
+	0x14, 0x4d, 0xa9, 0x1c, 0xd4, 0xee, 0xe8, 0x5b, 0x67, 0xd5, 0xad, 0x99, 0x35, 0xf7, 0x91, 0x21,
+	0x40, 0xf0, 0x00, 0x24, 0xd8, 0xac, 0x90, 0xd0, 0x94, 0xca, 0x0d, 0x23, 0xc1, 0x66, 0xf0, 0x11,
+	0x48, 0x62, 0xef, 0x9c, 0x14, 0x76, 0x35, 0xa5, 0x92, 0xad, 0x69, 0x2f, 0x29, 0x5f, 0xfb, 0xd1,
+	0x3b, 0xde, 0x39, 0x69, 0x24, 0x9f, 0xaf, 0x4a, 0x3b, 0x86, 0xd0, 0x81, 0x27, 0x20, 0x45, 0x99,
+	0xc3, 0x42, 0x5a, 0x48, 0x0a, 0xd7, 0x77, 0x3f, 0xc3, 0xb5, 0x29, 0x00, 0x46, 0x04, 0x84, 0x0f,
+	0xc0, 0xf1, 0x90, 0x78, 0xe7, 0x38, 0x98, 0x3a, 0x0c, 0x13, 0xcf, 0x0e, 0xd0, 0xcf, 0x42, 0x1c,
+	0x20, 0xb7, 0xb0, 0xa7, 0x29, 0x95, 0xb4, 0x71, 0xb4, 0xbe, 0x69, 0x44, 0x7b, 0xf0, 0x04, 0x1c,
+	0x3b, 0xde, 0x9c, 0x78, 0xc8, 0x1e, 0x3a, 0x9e, 0x4d, 0x7d, 0xe4, 0xb9, 0xf6, 0x33, 0x12, 0xb2,
+	0x42, 0x4a, 0x53, 0x2a, 0x39, 0x03, 0xca, 0xcd, 0xa6, 0xe3, 0x99, 0x7c, 0xeb, 0x47, 0x24, 0x64,
+	0x70, 0x02, 0x6e, 0xf9, 0x01, 0x7a, 0x66, 0x3b, 0x03, 0x91, 0x67, 0xfb, 0x02, 0xcd, 0x6d, 0xec,
+	0x16, 0xf6, 0x35, 0xa5, 0x92, 0x69, 0xbc, 0xfd, 0xe9, 0xaa, 0xf4, 0x70, 0x84, 0xd9, 0x38, 0x1c,
+	0xe8, 0x43, 0x32, 0xad, 0x3a, 0x33, 0x34, 0x71, 0x02, 0x0f, 0xb1, 0x8f, 0x48, 0x70, 0x11, 0xad,
+	0xde, 0x1a, 0x92, 0x00, 0x55, 0x67, 0xd5, 0x75, 0x46, 0xf5, 0xa7, 0x68, 0xde, 0x69, 0x19, 0x79,
+	0x6e, 0xb9, 0x2e, 0x0d, 0x73, 0x89, 0x0b, 0x7f, 0x02, 0x0a, 0xd8, 0x63, 0x28, 0xf0, 0x9c, 0x89,
+	0xcd, 0x02, 0xc7, 0xa3, 0xe7, 0x28, 0xb0, 0x9d, 0x29, 0x09, 0x3d, 0x56, 0x48, 0x6b, 0x4a, 0x65,
+	0xb7, 0xf1, 0xfa, 0xa7, 0xab, 0x52, 0x79, 0xcd, 0xe5, 0x80, 0x0d, 0x69, 0x88, 0x19, 0xe2, 0x1f,
+	0x21, 0xc3, 0x13, 0xbd, 0x2e, 0xd0, 0xc6, 0xed, 0xd8, 0x8e, 0x15, 0x99, 0x91, 0x72, 0xf5, 0xdf,
+	0x09, 0x90, 0xe4, 0xf9, 0x87, 0xdf, 0x00, 0x20, 0x20, 0xcc, 0x61, 0x88, 0x87, 0x24, 0x28, 0x4f,
+	0x1b, 0x19, 0x29, 0x79, 0x8a, 0xe6, 0xf0, 0x87, 0x20, 0x8b, 0x3d, 0x3f, 0x64, 0x36, 0x27, 0x88,
+	0x16, 0x12, 0xda, 0x6e, 0x25, 0x5b, 0xbb, 0xff, 0x79, 0xac, 0xea, 0x1d, 0xae, 0xb3, 0xc6, 0x2f,
+	0xc0, 0xb1, 0x80, 0xaa, 0x3f, 0x4f, 0x80, 0xcc, 0xd5, 0x3e, 0xfc, 0x29, 0xc8, 0x53, 0x3c, 0x8a,
+	0x79, 0x9b, 0x22, 0x8f, 0xd1, 0x82, 0x22, 0xbc, 0x3c, 0xfc, 0xe2, 0x5e, 0x74, 0x13, 0x8f, 0x8c,
+	0x6b, 0x0b, 0x91, 0xd3, 0x9b, 0x74, 0x43, 0x4a, 0xd5, 0x85, 0x02, 0x0e, 0x36, 0x91, 0xf0, 0x43,
+	0x90, 0x8a, 0xa8, 0x54, 0x04, 0x95, 0x8f, 0x2f, 0x57, 0xa5, 0x3d, 0x41, 0xcb, 0x97, 0xe3, 0x74,
+	0xef, 0x42, 0x10, 0x79, 0x17, 0xa4, 0x79, 0x74, 0x63, 0x87, 0x8e, 0xa3, 0x1a, 0xd9, 0xa7, 0x78,
+	0xf4, 0xc4, 0xa1, 0xe3, 0xf2, 0x4a, 0x01, 0x69, 0xf3, 0x2b, 0x29, 0xb9, 0xb7, 0xa2, 0xbb, 0x29,
+	0x93, 0x63, 0xb3, 0x99, 0xf4, 0xb7, 0x2b, 0x00, 0xe2, 0x72, 0xc5, 0x7e, 0xb8, 0xe3, 0x57, 0x97,
+	0x4c, 0xf2, 0xff, 0x29, 0x99, 0xbd, 0x57, 0x95, 0x4c, 0xf9, 0x97, 0x0a, 0xb8, 0xd1, 0x0b, 0x59,
+	0x9f, 0x60, 0x4f, 0x52, 0x7d, 0x0f, 0x64, 0x48, 0xc8, 0x6c, 0x9f, 0x0b, 0x64, 0xba, 0x8d, 0x34,
+	0x89, 0x00, 0xf0, 0x1d, 0x90, 0x8a, 0x2e, 0x78, 0xe2, 0x7f, 0xba, 0xe0, 0x91, 0x16, 0x2c, 0x80,
+	0x7d, 0xc7, 0x75, 0x03, 0x44, 0xa9, 0x08, 0x3c, 0x63, 0xc4, 0xcb, 0x47, 0xc9, 0x5f, 0xfd, 0xa6,
+	0xb4, 0x53, 0xfe, 0xe3, 0x1e, 0xc8, 0xd6, 0xa5, 0x44, 0x1c, 0x66, 0x0d, 0xaf, 0x6c, 0xe0, 0xe1,
+	0xf7, 0x40, 0x32, 0x20, 0x13, 0x24, 0xce, 0x71, 0x50, 0x7b, 0xed, 0x25, 0x2e, 0x22, 0x2b, 0x06,
+	0x99, 0x20, 0x43, 0x20, 0xe1, 0x37, 0x41, 0x2e, 0x40, 0x2e, 0x42, 0x53, 0x9b, 0x0e, 0x03, 0xec,
+	0xb3, 0x28, 0xf5, 0x37, 0xa4, 0xd0, 0x14, 0xb2, 0xb5, 0x9b, 0x96, 0xfc, 0x3a, 0x6e, 0x5a, 0x19,
+	0xe4, 0xa6, 0xce, 0x8c, 0xdf, 0x01, 0x7b, 0x28, 0xd2, 0x28, 0x89, 0xc9, 0x4e, 0x9d, 0x99, 0x89,
+	0x47, 0x4d, 0x91, 0xa3, 0x0f, 0x01, 0x14, 0xcc, 0x61, 0x8f, 0x83, 0x3c, 0x17, 0x73, 0x8a, 0x45,
+	0xd3, 0xcb, 0xd6, 0xf4, 0x57, 0xc5, 0x29, 0xeb, 0x2b, 0x52, 0x6b, 0xc6, 0x5a, 0xc6, 0x21, 0xdd,
+	0x16, 0xa9, 0xff, 0x4c, 0x80, 0xc3, 0x97, 0x80, 0x70, 0x04, 0xf2, 0x57, 0xbd, 0x4c, 0x26, 0x40,
+	0x16, 0xf8, 0x97, 0x6e, 0x9b, 0x07, 0xb1, 0x59, 0xbe, 0x74, 0x29, 0x77, 0x84, 0x66, 0x5b, 0x8e,
+	0x12, 0x5f, 0x89, 0xa3, 0xd8, 0x6c, 0xe4, 0xe8, 0x1d, 0x70, 0xef, 0xca, 0xd1, 0x34, 0x9c, 0x30,
+	0xcc, 0x93, 0xce, 0xc6, 0x01, 0xa2, 0x63, 0x32, 0x71, 0x05, 0xf9, 0xbb, 0xc6, 0xdd, 0x18, 0x72,
+	0x1a, 0x21, 0xac, 0x18, 0x00, 0xdf, 0x06, 0x99, 0x09, 0x19, 0x5e, 0xd8, 0x7c, 0x0c, 0x8b, 0xcb,
+	0x90, 0xad, 0xa9, 0xba, 0x9c, 0xd1, 0x7a, 0x3c, 0xa3, 0x75, 0x2b, 0x9e, 0xd1, 0x8d, 0xe4, 0xc7,
+	0x7f, 0x2b, 0x29, 0x46, 0x9a, 0xab, 0x70, 0x61, 0xf9, 0xcf, 0x0a, 0x38, 0x6a, 0x21, 0x9f, 0x50,
+	0xcc, 0x22, 0x8a, 0xda, 0x33, 0x1f, 0x07, 0xf3, 0xff, 0x72, 0xa5, 0x4f, 0x41, 0x26, 0x40, 0x43,
+	0xec, 0x63, 0x14, 0xd5, 0x57, 0xb6, 0xf6, 0x86, 0x2e, 0x5e, 0x0e, 0xfa, 0x55, 0xac, 0x31, 0xed,
+	0xcd, 0x80, 0x50, 0xda, 0x1c, 0x3b, 0xd8, 0x8b, 0xac, 0x47, 0xdd, 0xf4, 0xda, 0x02, 0x9f, 0x19,
+	0x88, 0xbb, 0x44, 0xd4, 0x76, 0x58, 0x14, 0x6f, 0x26, 0x92, 0xd4, 0x19, 0x6f, 0x7a, 0x7e, 0x10,
+	0x7a, 0x88, 0x6f, 0x26, 0xc5, 0xe6, 0xbe, 0x58, 0xd7, 0x19, 0xbc, 0x0d, 0x52, 0xe7, 0xa1, 0xe7,
+	0x5e, 0xcd, 0xe7, 0x68, 0x55, 0xfe, 0x53, 0x02, 0xec, 0x77, 0x25, 0x1f, 0x10, 0x82, 0xa4, 0xe7,
+	0x4c, 0x51, 0x14, 0x83, 0xf8, 0x86, 0x79, 0xb0, 0xeb, 0x21, 0x79, 0xf4, 0x9c, 0xc1, 0x3f, 0xe1,
+	0x9b, 0x00, 0x0c, 0xd0, 0x70, 0xfc, 0xa0, 0x66, 0x8f, 0x03, 0x5f, 0x96, 0x7c, 0x23, 0x77, 0xb9,
+	0x2a, 0x65, 0x1a, 0x42, 0xfa, 0xc4, 0xe8, 0x1b, 0x19, 0x09, 0x78, 0x12, 0xf8, 0xb0, 0x05, 0x8e,
+	0xfc, 0x70, 0x20, 0xae, 0x05, 0xef, 0x8d, 0x36, 0x4f, 0x4c, 0x5c, 0x8a, 0xb9, 0xc6, 0xd1, 0xe5,
+	0xaa, 0x94, 0xef, 0x87, 0x83, 0xa7, 0x68, 0xce, 0x3b, 0x24, 0x8f, 0x5b, 0x8c, 0xe5, 0x4d, 0x89,
+	0x0b, 0x9b, 0xe0, 0x96, 0x2c, 0xf0, 0x4d, 0x23, 0x7b, 0xd7, 0x46, 0x64, 0xad, 0xaf, 0x1b, 0xa1,
+	0x9b, 0x12, 0x17, 0x7e, 0x07, 0xdc, 0xa4, 0x68, 0xf4, 0x11, 0x66, 0xb6, 0x8b, 0xa9, 0x33, 0x98,
+	0x20, 0x57, 0x54, 0x60, 0xda, 0x38, 0x90, 0xe2, 0x56, 0x24, 0x85, 0x8f, 0x40, 0xfe, 0x9c, 0x04,
+	0x17, 0x36, 0x76, 0xed, 0xab, 0x19, 0xc2, 0xdf, 0x1b, 0xe9, 0xc6, 0xe1, 0xe5, 0xaa, 0x94, 0x7b,
+	0x4c, 0x82, 0x8b, 0x4e, 0xcb, 0x94, 0xd3, 0xc4, 0xc8, 0x71, 0x68, 0xc7, 0x8d, 0x96, 0xf7, 0xff,
+	0xa2, 0x80, 0x74, 0xfc, 0x56, 0x82, 0x35, 0x70, 0x6c, 0xbd, 0x6f, 0x9b, 0x56, 0xdd, 0x3a, 0x33,
+	0xed, 0xb3, 0xae, 0xd9, 0x6f, 0x37, 0x3b, 0x8f, 0x3b, 0xed, 0x56, 0x7e, 0x47, 0xbd, 0xb3, 0x58,
+	0x6a, 0xb7, 0x62, 0xe0, 0x99, 0x47, 0x7d, 0x34, 0xc4, 0xe7, 0x18, 0xf1, 0x76, 0x72, 0x78, 0xad,
+	0xd3, 0x34, 0xda, 0x75, 0xab, 0xdd, 0xca, 0x2b, 0x6a, 0x76, 0xb1, 0xd4, 0xf6, 0x9b, 0x01, 0x72,
+	0xd8, 0x36, 0xc6, 0xec, 0xbc, 0xdb, 0xed, 0x74, 0xdf, 0xcd, 0x27, 0x24, 0x86, 0x4f, 0x1c, 0xec,
+	0x8d, 0x36, 0x31, 0xf5, 0x46, 0xcf, 0xe0, 0x76, 0x76, 0x25, 0x26, 0x7a, 0xf2, 0x40, 0x0d, 0xe4,
+	0x37, 0xed, 0xb4, 0x5b, 0xf9, 0xa4, 0x0a, 0x16, 0x4b, 0x2d, 0x25, 0x07, 0x97, 0x9a, 0xfe, 0xc5,
+	0x6f, 0x8b, 0x3b, 0xbf, 0xff, 0x5d, 0x51, 0xb9, 0xbf, 0x52, 0x40, 0x4a, 0x0e, 0x43, 0xa8, 0x83,
+	0x5b, 0xd6, 0xfb, 0xb6, 0xf5, 0x41, 0xbf, 0xbd, 0x15, 0xd4, 0xf1, 0x62, 0xa9, 0x1d, 0x4a, 0xd0,
+	0x7a, 0x48, 0x0f, 0xc1, 0x6b, 0x31, 0xfe, 0xb4, 0x6e, 0x5a, 0x6d, 0xc3, 0x6e, 0xf6, 0xba, 0x66,
+	0xef, 0xbd, 0x4e, 0xab, 0x6e, 0x75, 0x7a, 0xdd, 0xbc, 0x22, 0xb3, 0x71, 0xea, 0x50, 0x86, 0x82,
+	0x26, 0xf1, 0x28, 0x99, 0x60, 0x57, 0x4c, 0x41, 0xf8, 0x03, 0x50, 0x8a, 0x55, 0xcd, 0x76, 0xb3,
+	0xd7, 0x6d, 0xd5, 0x8d, 0x0f, 0xb6, 0xb4, 0x13, 0xaa, 0xba, 0x58, 0x6a, 0xb7, 0x4d, 0xc4, 0x1b,
+	0xab, 0x13, 0xcc, 0x37, 0x0d, 0x14, 0xc1, 0x41, 0x6c, 0xc0, 0x68, 0x9b, 0xcd, 0xb3, 0x76, 0x7e,
+	0x57, 0x06, 0x68, 0x20, 0x3a, 0x0c, 0xd1, 0x5a, 0x80, 0x7f, 0x50, 0x40, 0x2e, 0x9e, 0x9a, 0x9c,
+	0x16, 0x04, 0xdf, 0x00, 0xf7, 0x7a, 0x67, 0x96, 0xdd, 0xef, 0x75, 0xba, 0x96, 0xc8, 0xd2, 0x76,
+	0xbc, 0xe9, 0xc5, 0x52, 0x4b, 0x76, 0x89, 0x87, 0x60, 0x05, 0xdc, 0xd9, 0x86, 0xf6, 0xdb, 0xdd,
+	0x16, 0xe7, 0x25, 0xe2, 0xae, 0x2f, 0xdb, 0x33, 0x7c, 0x13, 0xdc, 0xdd, 0x46, 0x36, 0x7b, 0xdd,
+	0xc7, 0x1d, 0xe3, 0xb4, 0xdd, 0xca, 0x27, 0xd4, 0xdc, 0x62, 0xa9, 0x65, 0x9a, 0xf2, 0x21, 0x80,
+	0x5c, 0xf8, 0x2d, 0x70, 0xbc, 0x8d, 0x36, 0xfb, 0xed, 0xae, 0x95, 0xdf, 0x55, 0x33, 0x8b, 0xa5,
+	0xb6, 0xc7, 0xbb, 0x3e, 0x13, 0x41, 0x28, 0x22, 0x88, 0x5f, 0x2b, 0x20, 0xbb, 0x36, 0x26, 0xe1,
+	0xeb, 0xa0, 0x50, 0x6f, 0xb5, 0x8c, 0xb6, 0x69, 0xda, 0x46, 0xef, 0xbd, 0x57, 0x9f, 0xff, 0xdb,
+	0xe0, 0x68, 0x03, 0xd7, 0x6a, 0xf7, 0x7b, 0x66, 0xc7, 0x8a, 0x0f, 0x1f, 0x75, 0x3d, 0x78, 0x02,
+	0xd4, 0x0d, 0xd8, 0x36, 0x13, 0x87, 0x8b, 0xa5, 0x96, 0xdb, 0x20, 0xe0, 0x3a, 0xc1, 0x0d, 0xe3,
+	0xf9, 0x3f, 0x8a, 0x3b, 0xcf, 0x2f, 0x8b, 0xca, 0x27, 0x97, 0x45, 0xe5, 0xef, 0x97, 0x45, 0xe5,
+	0xe3, 0x17, 0xc5, 0x9d, 0x4f, 0x5e, 0x14, 0x77, 0xfe, 0xfa, 0xa2, 0xb8, 0xf3, 0xe3, 0xef, 0x7f,
+	0xc1, 0x31, 0x11, 0xff, 0xdc, 0x89, 0xff, 0xac, 0x41, 0x4a, 0xb4, 0xed, 0x07, 0xff, 0x19, 0x00,
+	0x72, 0x62, 0x76, 0xa1, 0xf4, 0x0d, 0x00, 0x00,
 }
 
 func (m *UnsignedTx) Marshal() (dAtA []byte, err error) {
@@ -1047,6 +1125,66 @@ func (m *AddressInfo_SpendingCondition) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *DepositAddressExpiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositAddressExpiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositAddressExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Funded {
+		i--
+		if m.Funded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PruneAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PruneAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Recipient.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Network) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1067,6 +1205,48 @@ func (m *Network) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ForkIDSigHash {
+		i--
+		if m.ForkIDSigHash {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SegwitDisabled {
+		i--
+		if m.SegwitDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ScriptHashAddrID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ScriptHashAddrID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PubKeyHashAddrID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PubKeyHashAddrID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Bech32HRP) > 0 {
+		i -= len(m.Bech32HRP)
+		copy(dAtA[i:], m.Bech32HRP)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Bech32HRP)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Net != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Net))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
@@ -1277,6 +1457,30 @@ func (m *AddressInfo_SpendingCondition) Size() (n int) {
 	return n
 }
 
+func (m *DepositAddressExpiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Recipient.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiresAt))
+	}
+	if m.PruneAt != 0 {
+		n += 1 + sovTypes(uint64(m.PruneAt))
+	}
+	if m.Funded {
+		n += 2
+	}
+	return n
+}
+
 func (m *Network) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1287,6 +1491,25 @@ func (m *Network) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Net != 0 {
+		n += 1 + sovTypes(uint64(m.Net))
+	}
+	l = len(m.Bech32HRP)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.PubKeyHashAddrID != 0 {
+		n += 1 + sovTypes(uint64(m.PubKeyHashAddrID))
+	}
+	if m.ScriptHashAddrID != 0 {
+		n += 1 + sovTypes(uint64(m.ScriptHashAddrID))
+	}
+	if m.SegwitDisabled {
+		n += 2
+	}
+	if m.ForkIDSigHash {
+		n += 2
+	}
 	return n
 }
 
@@ -2545,6 +2768,179 @@ func (m *AddressInfo_SpendingCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DepositAddressExpiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositAddressExpiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositAddressExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Recipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneAt", wireType)
+			}
+			m.PruneAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruneAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Funded = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Network) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2606,6 +3002,135 @@ func (m *Network) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Net", wireType)
+			}
+			m.Net = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Net |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bech32HRP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bech32HRP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyHashAddrID", wireType)
+			}
+			m.PubKeyHashAddrID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PubKeyHashAddrID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptHashAddrID", wireType)
+			}
+			m.ScriptHashAddrID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScriptHashAddrID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegwitDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SegwitDisabled = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkIDSigHash", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForkIDSigHash = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
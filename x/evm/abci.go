@@ -1,10 +1,15 @@
 package evm
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/axelarnetwork/axelar-core/x/evm/keeper"
 	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
 )
 
 // BeginBlocker check for infraction evidence or downtime of validators
@@ -12,7 +17,54 @@ import (
 func BeginBlocker(_ sdk.Context, _ abci.RequestBeginBlock, _ types.BaseKeeper) {}
 
 // EndBlocker called every block, process inflation, update validator set.
-func EndBlocker(ctx sdk.Context, _ abci.RequestEndBlock, k types.BaseKeeper, nexus types.Nexus, signer types.Signer) []abci.ValidatorUpdate {
+func EndBlocker(ctx sdk.Context, _ abci.RequestEndBlock, k types.BaseKeeper, n types.Nexus, signer types.Signer) []abci.ValidatorUpdate {
+	for _, chain := range n.GetChains(ctx) {
+		if chain.Module != types.ModuleName || !n.IsChainActivated(ctx, chain) {
+			continue
+		}
+
+		createTransferKeyCommands(ctx, k.ForChain(chain.Name), signer, chain)
+	}
 
 	return nil
 }
+
+// createTransferKeyCommands transfers the control over the gateway to keys that have been assigned as next keys without a transfer command
+func createTransferKeyCommands(ctx sdk.Context, k types.ChainKeeper, signer types.Signer, chain nexus.Chain) {
+	if _, ok := k.GetGatewayAddress(ctx); !ok {
+		return
+	}
+
+	// iterate in a fixed order, the command queue must be deterministic
+	for _, transferKeyType := range []types.TransferKeyType{types.Ownership, types.Operatorship} {
+		keyRole := tss.MasterKey
+		if transferKeyType == types.Operatorship {
+			keyRole = tss.SecondaryKey
+		}
+
+		nextKeyID, ok := signer.GetNextKeyID(ctx, chain, keyRole)
+		if !ok {
+			continue
+		}
+
+		cmd, err := keeper.CreateTransferKeyCommand(ctx, k, signer, chain, transferKeyType, nextKeyID)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("cannot create %s command for the next %s key %s of chain %s: %s",
+				transferKeyType.SimpleString(), keyRole.SimpleString(), nextKeyID, chain.Name, err))
+			continue
+		}
+
+		// the command ID is derived from the next key, so it only exists if the transfer has been created already
+		if _, ok := k.GetCommand(ctx, cmd.ID); ok {
+			continue
+		}
+
+		if err := k.EnqueueCommand(ctx, cmd); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("cannot enqueue %s command for chain %s: %s", transferKeyType.SimpleString(), chain.Name, err))
+			continue
+		}
+
+		k.Logger(ctx).Info(fmt.Sprintf("created %s command %s for the next %s key %s of chain %s",
+			transferKeyType.SimpleString(), cmd.ID.Hex(), keyRole.SimpleString(), nextKeyID, chain.Name))
+	}
+}
@@ -0,0 +1,160 @@
+package evm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	"github.com/axelarnetwork/axelar-core/x/evm/types/mock"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+)
+
+func TestEndBlocker_CreateTransferKeyCommands(t *testing.T) {
+	var (
+		ctx         sdk.Context
+		baseKeeper  *mock.BaseKeeperMock
+		chainKeeper *mock.ChainKeeperMock
+		nexusKeeper *mock.NexusMock
+		signer      *mock.SignerMock
+
+		chain      nexus.Chain
+		keys       map[tss.KeyID]tss.Key
+		nextKeyIDs map[tss.KeyRole]tss.KeyID
+		commands   map[types.CommandID]types.Command
+		hasGateway bool
+	)
+
+	newKey := func(role tss.KeyRole) tss.Key {
+		privKey, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			panic(err)
+		}
+
+		key := tss.Key{
+			ID:        tss.KeyID(rand.StrBetween(5, 20)),
+			PublicKey: &tss.Key_ECDSAKey_{ECDSAKey: &tss.Key_ECDSAKey{Value: privKey.PubKey().SerializeCompressed()}},
+			Role:      role,
+		}
+		keys[key.ID] = key
+
+		return key
+	}
+
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+		chain = nexus.Chain{Name: rand.StrBetween(5, 20), KeyType: tss.Threshold, Module: types.ModuleName}
+		keys = map[tss.KeyID]tss.Key{}
+		nextKeyIDs = map[tss.KeyRole]tss.KeyID{}
+		commands = map[types.CommandID]types.Command{}
+		hasGateway = true
+		currMasterKey := newKey(tss.MasterKey)
+
+		chainKeeper = &mock.ChainKeeperMock{
+			LoggerFunc: func(sdk.Context) log.Logger { return log.TestingLogger() },
+			GetGatewayAddressFunc: func(sdk.Context) (common.Address, bool) {
+				return common.BytesToAddress(rand.Bytes(common.AddressLength)), hasGateway
+			},
+			GetChainIDFunc: func(sdk.Context) (*big.Int, bool) { return big.NewInt(1), true },
+			GetCommandFunc: func(_ sdk.Context, id types.CommandID) (types.Command, bool) {
+				cmd, ok := commands[id]
+				return cmd, ok
+			},
+			EnqueueCommandFunc: func(_ sdk.Context, cmd types.Command) error {
+				commands[cmd.ID] = cmd
+				return nil
+			},
+		}
+		baseKeeper = &mock.BaseKeeperMock{
+			ForChainFunc: func(string) types.ChainKeeper { return chainKeeper },
+		}
+		nexusKeeper = &mock.NexusMock{
+			GetChainsFunc: func(sdk.Context) []nexus.Chain {
+				return []nexus.Chain{chain, {Name: rand.StrBetween(5, 20), Module: rand.StrBetween(5, 20)}}
+			},
+			IsChainActivatedFunc: func(sdk.Context, nexus.Chain) bool { return true },
+		}
+		signer = &mock.SignerMock{
+			GetNextKeyIDFunc: func(_ sdk.Context, _ nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool) {
+				keyID, ok := nextKeyIDs[keyRole]
+				return keyID, ok
+			},
+			GetCurrentKeyIDFunc: func(_ sdk.Context, _ nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool) {
+				return currMasterKey.ID, keyRole == tss.MasterKey
+			},
+			GetKeyFunc: func(_ sdk.Context, keyID tss.KeyID) (tss.Key, bool) {
+				key, ok := keys[keyID]
+				return key, ok
+			},
+		}
+	}
+
+	repeats := 20
+	t.Run("should create transfer commands for assigned next keys once", testutils.Func(func(t *testing.T) {
+		setup()
+		nextMasterKey := newKey(tss.MasterKey)
+		nextSecondaryKey := newKey(tss.SecondaryKey)
+		nextKeyIDs[tss.MasterKey] = nextMasterKey.ID
+		nextKeyIDs[tss.SecondaryKey] = nextSecondaryKey.ID
+
+		EndBlocker(ctx, abci.RequestEndBlock{}, baseKeeper, nexusKeeper, signer)
+
+		assert.Len(t, chainKeeper.EnqueueCommandCalls(), 2)
+		ownership := chainKeeper.EnqueueCommandCalls()[0].Cmd
+		operatorship := chainKeeper.EnqueueCommandCalls()[1].Cmd
+		assert.Equal(t, types.AxelarGatewayCommandTransferOwnership, ownership.Command)
+		assert.Equal(t, types.AxelarGatewayCommandTransferOperatorship, operatorship.Command)
+
+		pk, err := nextMasterKey.GetECDSAPubKey()
+		assert.NoError(t, err)
+		assert.Equal(t, types.NewCommandID(crypto.PubkeyToAddress(pk).Bytes(), big.NewInt(1)), ownership.ID)
+
+		EndBlocker(ctx, abci.RequestEndBlock{}, baseKeeper, nexusKeeper, signer)
+		assert.Len(t, chainKeeper.EnqueueCommandCalls(), 2)
+	}).Repeat(repeats))
+
+	t.Run("should not create transfer commands without assigned next keys", testutils.Func(func(t *testing.T) {
+		setup()
+
+		EndBlocker(ctx, abci.RequestEndBlock{}, baseKeeper, nexusKeeper, signer)
+
+		assert.Len(t, chainKeeper.EnqueueCommandCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should not create transfer commands if the transfer was created manually", testutils.Func(func(t *testing.T) {
+		setup()
+		nextMasterKey := newKey(tss.MasterKey)
+		nextKeyIDs[tss.MasterKey] = nextMasterKey.ID
+
+		pk, err := nextMasterKey.GetECDSAPubKey()
+		assert.NoError(t, err)
+		cmd, err := types.CreateSinglesigTransferCommand(types.Ownership, big.NewInt(1), tss.KeyID(rand.Str(10)), crypto.PubkeyToAddress(pk))
+		assert.NoError(t, err)
+		commands[cmd.ID] = cmd
+
+		EndBlocker(ctx, abci.RequestEndBlock{}, baseKeeper, nexusKeeper, signer)
+
+		assert.Len(t, chainKeeper.EnqueueCommandCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should not create transfer commands without a gateway", testutils.Func(func(t *testing.T) {
+		setup()
+		nextKeyIDs[tss.MasterKey] = newKey(tss.MasterKey).ID
+		hasGateway = false
+
+		EndBlocker(ctx, abci.RequestEndBlock{}, baseKeeper, nexusKeeper, signer)
+
+		assert.Len(t, chainKeeper.EnqueueCommandCalls(), 0)
+	}).Repeat(repeats))
+}
@@ -35,6 +35,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetCmdQueryBatchedCommands(queryRoute),
 		GetCmdLatestBatchedCommands(queryRoute),
 		GetCmdPendingCommands(queryRoute),
+		GetCmdBatchExecutionCost(queryRoute),
 		GetCmdCommand(queryRoute),
 		GetCmdChains(queryRoute),
 	)
@@ -311,6 +312,30 @@ func GetCmdPendingCommands(queryRoute string) *cobra.Command {
 	return cmd
 }
 
+// GetCmdBatchExecutionCost returns the query to get the expected cost of executing the pending command batch
+func GetCmdBatchExecutionCost(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-execution-cost [chain]",
+		Short: "Get the expected cost in wei of executing the pending command batch based on the chain's confirmed gas info",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := evmclient.QueryBatchExecutionCost(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdCommand returns the query to get the command with the given ID on the specified chain
 func GetCmdCommand(queryRoute string) *cobra.Command {
 	cmd := &cobra.Command{
@@ -1,10 +1,12 @@
 package cli
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -35,13 +37,19 @@ func GetTxCmd() *cobra.Command {
 		GetCmdConfirmGatewayDeployment(),
 		GetCmdConfirmERC20TokenDeployment(),
 		GetCmdConfirmERC20Deposit(),
+		GetCmdReverifyERC20Deposit(),
 		GetCmdConfirmTransferOwnership(),
 		GetCmdConfirmTransferOperatorship(),
+		GetCmdConfirmBatchExecution(),
+		GetCmdConfirmGasInfo(),
+		GetCmdConfirmGatewayUpgrade(),
 		GetCmdCreatePendingTransfers(),
 		GetCmdCreateDeployToken(),
+		GetCmdCreateRegisterExternalToken(),
 		GetCmdCreateBurnTokens(),
 		GetCmdCreateTransferOwnership(),
 		GetCmdCreateTransferOperatorship(),
+		GetCmdCreateUpgradeGateway(),
 		GetCmdSignCommands(),
 		GetCmdAddChain(),
 	)
@@ -189,6 +197,34 @@ func GetCmdConfirmERC20Deposit() *cobra.Command {
 	return cmd
 }
 
+// GetCmdReverifyERC20Deposit returns the cli command to check that a confirmed ERC20 deposit is still part of the canonical chain
+func GetCmdReverifyERC20Deposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reverify-erc20-deposit [chain] [txID] [burnerAddr]",
+		Short: "Reverify that a confirmed ERC20 deposit to a burner address has not been reorged out of the EVM chain",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chain := args[0]
+			txID := common.HexToHash(args[1])
+			burnerAddr := common.HexToAddress(args[2])
+
+			msg := types.NewReverifyDepositRequest(cliCtx.GetFromAddress(), chain, txID, burnerAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdConfirmTransferOwnership returns the cli command to confirm a transfer ownership for the gateway contract
 func GetCmdConfirmTransferOwnership() *cobra.Command {
 	cmd := &cobra.Command{
@@ -243,6 +279,93 @@ func GetCmdConfirmTransferOperatorship() *cobra.Command {
 	return cmd
 }
 
+// GetCmdConfirmBatchExecution returns the cli command to confirm the execution of a signed command batch by the gateway contract
+func GetCmdConfirmBatchExecution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm-batch-execution [chain] [batchedCommandsID] [txID]",
+		Short: "Confirm the execution of a signed command batch in an EVM chain transaction",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chain := args[0]
+			batchID, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid batched commands ID: %v", err)
+			}
+			txID := common.HexToHash(args[2])
+			msg := types.NewConfirmBatchExecutionRequest(cliCtx.GetFromAddress(), chain, batchID, txID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdConfirmGasInfo returns the cli command to confirm the gas fees and block gas limit of an EVM chain at a given block
+func GetCmdConfirmGasInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm-gas-info [chain] [blockNumber]",
+		Short: "Confirm the base fee, priority fee and block gas limit of an EVM chain at the given block",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chain := args[0]
+			blockNumber, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid block number: %v", err)
+			}
+
+			msg := types.NewConfirmGasInfoRequest(cliCtx.GetFromAddress(), chain, blockNumber)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdConfirmGatewayUpgrade returns the cli command to confirm the upgrade of the gateway contract
+func GetCmdConfirmGatewayUpgrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm-gateway-upgrade [chain] [txID]",
+		Short: "Confirm the upgrade of the gateway contract in an EVM chain transaction",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chain := args[0]
+			txID := common.HexToHash(args[1])
+
+			msg := types.NewConfirmGatewayUpgradeRequest(cliCtx.GetFromAddress(), chain, txID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdCreatePendingTransfers returns the cli command to create commands for handling all pending token transfers to an EVM chain
 func GetCmdCreatePendingTransfers() *cobra.Command {
 	cmd := &cobra.Command{
@@ -312,6 +435,54 @@ func GetCmdCreateDeployToken() *cobra.Command {
 	return cmd
 }
 
+// GetCmdCreateRegisterExternalToken returns the cli command to create register-external-token command for an EVM chain
+func GetCmdCreateRegisterExternalToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-register-external-token [evm chain] [asset] [token name] [symbol] [decimals] [capacity] [min deposit] [token address]",
+		Short: "Create a command to register an existing token contract with the AxelarGateway contract",
+		Args:  cobra.ExactArgs(8),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chain := args[0]
+			asset := args[1]
+			tokenName := args[2]
+			symbol := args[3]
+			decs, err := strconv.ParseUint(args[4], 10, 8)
+			if err != nil {
+				return fmt.Errorf("could not parse decimals")
+			}
+			capacity, ok := sdk.NewIntFromString(args[5])
+			if !ok {
+				return fmt.Errorf("could not parse capacity")
+			}
+
+			minAmount, ok := sdk.NewIntFromString(args[6])
+			if !ok {
+				return fmt.Errorf("could not parse minimum deposit amount")
+			}
+
+			if !common.IsHexAddress(args[7]) {
+				return fmt.Errorf("invalid token address")
+			}
+			tokenAddr := types.Address(common.HexToAddress(args[7]))
+
+			tokenDetails := types.NewTokenDetails(tokenName, symbol, uint8(decs), capacity)
+			msg := types.NewCreateRegisterExternalTokenRequest(cliCtx.GetFromAddress(), chain, asset, tokenDetails, minAmount, tokenAddr)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdCreateBurnTokens returns the cli command to create burn commands for all confirmed token deposits in an EVM chain
 func GetCmdCreateBurnTokens() *cobra.Command {
 	cmd := &cobra.Command{
@@ -384,6 +555,45 @@ func GetCmdCreateTransferOperatorship() *cobra.Command {
 	return cmd
 }
 
+// GetCmdCreateUpgradeGateway returns the cli command to create an upgrade command for the gateway contract of an EVM chain
+func GetCmdCreateUpgradeGateway() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-upgrade-gateway [chain] [new implementation] [new implementation code hash]",
+		Short: "Create a command to upgrade the gateway contract of an EVM chain to a new implementation",
+		Args:  cobra.ExactArgs(3),
+	}
+	setupParams := cmd.Flags().String("setup-params", "", "hex encoded parameters passed to the setup function of the new implementation")
+	commands := cmd.Flags().StringSlice("commands", types.AllGatewayCommands(), "commands the new implementation is able to execute")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		cliCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		chain := args[0]
+		if !common.IsHexAddress(args[1]) {
+			return fmt.Errorf("invalid new implementation address %s", args[1])
+		}
+		newImplementation := common.HexToAddress(args[1])
+		newImplementationCodeHash := common.HexToHash(args[2])
+
+		params, err := hex.DecodeString(strings.TrimPrefix(*setupParams, "0x"))
+		if err != nil {
+			return fmt.Errorf("invalid setup params: %v", err)
+		}
+
+		msg := types.NewCreateUpgradeGatewayRequest(cliCtx.GetFromAddress(), chain, newImplementation, newImplementationCodeHash, params, *commands)
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdSignCommands returns the cli command to sign pending commands for an EVM chain contract
 func GetCmdSignCommands() *cobra.Command {
 	cmd := &cobra.Command{
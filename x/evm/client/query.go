@@ -41,6 +41,22 @@ func QueryPendingCommands(clientCtx client.Context, chain string) (types.QueryPe
 	return res, nil
 }
 
+// QueryBatchExecutionCost returns the expected cost of executing the pending command batch of the given chain
+func QueryBatchExecutionCost(clientCtx client.Context, chain string) (types.QueryBatchExecutionCostResponse, error) {
+	path := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QBatchExecutionCost, chain)
+	bz, _, err := clientCtx.Query(path)
+	if err != nil {
+		return types.QueryBatchExecutionCostResponse{}, sdkerrors.Wrapf(err, "could not get the batch execution cost for chain %s", chain)
+	}
+
+	var res types.QueryBatchExecutionCostResponse
+	err = res.Unmarshal(bz)
+	if err != nil {
+		return types.QueryBatchExecutionCostResponse{}, sdkerrors.Wrap(err, "could not get batch execution cost")
+	}
+	return res, nil
+}
+
 // QueryCommand returns the specified command for the given chain
 func QueryCommand(clientCtx client.Context, chain, id string) (types.QueryCommandResponse, error) {
 	path := fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, keeper.QCommand, chain, id)
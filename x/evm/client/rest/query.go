@@ -69,6 +69,26 @@ func GetHandlerQueryPendingCommands(cliCtx client.Context) http.HandlerFunc {
 	}
 }
 
+// GetHandlerQueryBatchExecutionCost returns a handler to get the expected cost of executing the pending command batch
+func GetHandlerQueryBatchExecutionCost(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		chain := mux.Vars(r)[utils.PathVarChain]
+		res, err := evmclient.QueryBatchExecutionCost(cliCtx, chain)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // GetHandlerQueryCommand returns a handler to get the command with the given ID on the specified chain
 func GetHandlerQueryCommand(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -1,9 +1,12 @@
 package rest
 
 import (
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -26,13 +29,19 @@ const (
 	TxLink                        = "link"
 	TxConfirmTokenDeploy          = "confirm-erc20-deploy"
 	TxConfirmDeposit              = "confirm-erc20-deposit"
+	TxReverifyDeposit             = "reverify-erc20-deposit"
 	TxConfirmTransferOwnership    = "confirm-transfer-ownership"
 	TxConfirmTransferOperatorship = "confirm-transfer-operatorship"
+	TxConfirmBatchExecution       = "confirm-batch-execution"
+	TxConfirmGasInfo              = "confirm-gas-info"
+	TxConfirmGatewayUpgrade       = "confirm-gateway-upgrade"
 	TxCreatePendingTransfers      = "create-pending-transfers"
 	TxCreateDeployToken           = "create-deploy-token"
+	TxCreateRegisterExternalToken = "create-register-external-token"
 	TxCreateBurnTokens            = "create-burn-token"
 	TxCreateTransferOwnership     = "create-transfer-ownership"
 	TxCreateTransferOperatorship  = "create-transfer-operatorship"
+	TxCreateUpgradeGateway        = "create-upgrade-gateway"
 	TxSignCommands                = "sign-commands"
 	TxAddChain                    = "add-chain"
 
@@ -40,6 +49,7 @@ const (
 	QueryBatchedCommands      = "batched-commands"
 	QueryTokenAddress         = "token-address"
 	QueryPendingCommands      = keeper.QPendingCommands
+	QueryBatchExecutionCost   = keeper.QBatchExecutionCost
 	QueryCommand              = keeper.QCommand
 	QueryNextMasterAddress    = keeper.QNextMasterAddress
 	QueryAxelarGatewayAddress = keeper.QAxelarGatewayAddress
@@ -54,13 +64,19 @@ func RegisterRoutes(cliCtx client.Context, r *mux.Router) {
 	registerTx(GetHandlerLink(cliCtx), TxLink, clientUtils.PathVarChain)
 	registerTx(GetHandlerConfirmTokenDeploy(cliCtx), TxConfirmTokenDeploy, clientUtils.PathVarChain)
 	registerTx(GetHandlerConfirmDeposit(cliCtx), TxConfirmDeposit, clientUtils.PathVarChain)
+	registerTx(GetHandlerReverifyDeposit(cliCtx), TxReverifyDeposit, clientUtils.PathVarChain)
 	registerTx(GetHandlerConfirmTransferKey(cliCtx, types.Ownership), TxConfirmTransferOwnership, clientUtils.PathVarChain)
 	registerTx(GetHandlerConfirmTransferKey(cliCtx, types.Operatorship), TxConfirmTransferOperatorship, clientUtils.PathVarChain)
+	registerTx(GetHandlerConfirmBatchExecution(cliCtx), TxConfirmBatchExecution, clientUtils.PathVarChain)
+	registerTx(GetHandlerConfirmGasInfo(cliCtx), TxConfirmGasInfo, clientUtils.PathVarChain)
+	registerTx(GetHandlerConfirmGatewayUpgrade(cliCtx), TxConfirmGatewayUpgrade, clientUtils.PathVarChain)
 	registerTx(GetHandlerCreatePendingTransfers(cliCtx), TxCreatePendingTransfers, clientUtils.PathVarChain)
 	registerTx(GetHandlerCreateDeployToken(cliCtx), TxCreateDeployToken, clientUtils.PathVarChain)
+	registerTx(GetHandlerCreateRegisterExternalToken(cliCtx), TxCreateRegisterExternalToken, clientUtils.PathVarChain)
 	registerTx(GetHandlerCreateBurnTokens(cliCtx), TxCreateBurnTokens, clientUtils.PathVarChain)
 	registerTx(GetHandlerCreateTransferOwnership(cliCtx), TxCreateTransferOwnership, clientUtils.PathVarChain)
 	registerTx(GetHandlerCreateTransferOperatorship(cliCtx), TxCreateTransferOperatorship, clientUtils.PathVarChain)
+	registerTx(GetHandlerCreateUpgradeGateway(cliCtx), TxCreateUpgradeGateway, clientUtils.PathVarChain)
 	registerTx(GetHandlerSignCommands(cliCtx), TxSignCommands, clientUtils.PathVarChain)
 	registerTx(GetHandlerConfirmChain(cliCtx), TxConfirmChain)
 	registerTx(GetHandlerConfirmGatewayDeployment(cliCtx), TxConfirmGatewayDeployment)
@@ -70,6 +86,7 @@ func RegisterRoutes(cliCtx client.Context, r *mux.Router) {
 	registerQuery(GetHandlerQueryBatchedCommands(cliCtx), QueryBatchedCommands, clientUtils.PathVarChain, clientUtils.PathVarBatchedCommandsID)
 	registerQuery(GetHandlerQueryLatestBatchedCommands(cliCtx), QueryBatchedCommands, clientUtils.PathVarChain)
 	registerQuery(GetHandlerQueryPendingCommands(cliCtx), QueryPendingCommands, clientUtils.PathVarChain)
+	registerQuery(GetHandlerQueryBatchExecutionCost(cliCtx), QueryBatchExecutionCost, clientUtils.PathVarChain)
 	registerQuery(GetHandlerQueryCommand(cliCtx), QueryCommand, clientUtils.PathVarChain, clientUtils.PathVarCommandID)
 	registerQuery(GetHandlerQueryAddress(cliCtx), QueryAddress, clientUtils.PathVarChain)
 	registerQuery(GetHandlerQueryTokenAddress(cliCtx), QueryTokenAddress, clientUtils.PathVarChain)
@@ -118,6 +135,13 @@ type ReqConfirmDeposit struct {
 	BurnerAddress string       `json:"burner_address" yaml:"burner_address"`
 }
 
+// ReqReverifyDeposit represents a request to reverify a confirmed deposit
+type ReqReverifyDeposit struct {
+	BaseReq       rest.BaseReq `json:"base_req" yaml:"base_req"`
+	TxID          string       `json:"tx_id" yaml:"tx_id"`
+	BurnerAddress string       `json:"burner_address" yaml:"burner_address"`
+}
+
 // ReqConfirmTransferKey represents a request to confirm a transfer ownership
 type ReqConfirmTransferKey struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
@@ -125,6 +149,25 @@ type ReqConfirmTransferKey struct {
 	KeyID   string       `json:"key_id" yaml:"key_id"`
 }
 
+// ReqConfirmBatchExecution represents a request to confirm the execution of a command batch
+type ReqConfirmBatchExecution struct {
+	BaseReq           rest.BaseReq `json:"base_req" yaml:"base_req"`
+	BatchedCommandsID string       `json:"batched_commands_id" yaml:"batched_commands_id"`
+	TxID              string       `json:"tx_id" yaml:"tx_id"`
+}
+
+// ReqConfirmGasInfo represents a request to confirm the gas info of a chain at a given block
+type ReqConfirmGasInfo struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	BlockNumber uint64       `json:"block_number" yaml:"block_number"`
+}
+
+// ReqConfirmGatewayUpgrade represents a request to confirm the upgrade of the gateway contract
+type ReqConfirmGatewayUpgrade struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	TxID    string       `json:"tx_id" yaml:"tx_id"`
+}
+
 // ReqCreatePendingTransfers represents a request to create commands for all pending transfers
 type ReqCreatePendingTransfers struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
@@ -142,6 +185,18 @@ type ReqCreateDeployToken struct {
 	MinDeposit  string       `json:"min_deposit" yaml:"min_deposit"`
 }
 
+// ReqCreateRegisterExternalToken represents a request to create a register external token command
+type ReqCreateRegisterExternalToken struct {
+	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Asset        string       `json:"asset" yaml:"asset"`
+	Symbol       string       `json:"symbol" yaml:"symbol"`
+	TokenName    string       `json:"token_name" yaml:"token_name"`
+	Decimals     string       `json:"decimals" yaml:"decimals"`
+	Capacity     string       `json:"capacity" yaml:"capacity"`
+	MinDeposit   string       `json:"min_deposit" yaml:"min_deposit"`
+	TokenAddress string       `json:"token_address" yaml:"token_address"`
+}
+
 // ReqCreateBurnTokens represents a request to create commands for all outstanding burns
 type ReqCreateBurnTokens struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
@@ -159,6 +214,15 @@ type ReqCreateTransferOperatorship struct {
 	KeyID   string       `json:"key_id" yaml:"key_id"`
 }
 
+// ReqCreateUpgradeGateway represents a request to create a gateway upgrade command
+type ReqCreateUpgradeGateway struct {
+	BaseReq                   rest.BaseReq `json:"base_req" yaml:"base_req"`
+	NewImplementation         string       `json:"new_implementation" yaml:"new_implementation"`
+	NewImplementationCodeHash string       `json:"new_implementation_code_hash" yaml:"new_implementation_code_hash"`
+	SetupParams               string       `json:"setup_params" yaml:"setup_params"`
+	NewImplementationCommands []string     `json:"new_implementation_commands" yaml:"new_implementation_commands"`
+}
+
 // ReqSignCommands represents a request to sign pending commands
 type ReqSignCommands struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
@@ -321,6 +385,63 @@ func GetHandlerConfirmDeposit(cliCtx client.Context) http.HandlerFunc {
 	}
 }
 
+// GetHandlerReverifyDeposit returns a handler to reverify a confirmed deposit
+func GetHandlerReverifyDeposit(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ReqReverifyDeposit
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, ok := clientUtils.ExtractReqSender(w, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		txID := common.HexToHash(req.TxID)
+		burnerAddr := common.HexToAddress(req.BurnerAddress)
+
+		msg := types.NewReverifyDepositRequest(fromAddr, mux.Vars(r)[clientUtils.PathVarChain], txID, burnerAddr)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+// GetHandlerConfirmGatewayUpgrade returns a handler to confirm the upgrade of the gateway contract
+func GetHandlerConfirmGatewayUpgrade(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ReqConfirmGatewayUpgrade
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, ok := clientUtils.ExtractReqSender(w, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		txID := common.HexToHash(req.TxID)
+
+		msg := types.NewConfirmGatewayUpgradeRequest(fromAddr, mux.Vars(r)[clientUtils.PathVarChain], txID)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
 // GetHandlerConfirmTransferKey returns a handler to confirm a transfer ownership
 func GetHandlerConfirmTransferKey(cliCtx client.Context, transferKeyType types.TransferKeyType) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -349,6 +470,65 @@ func GetHandlerConfirmTransferKey(cliCtx client.Context, transferKeyType types.T
 	}
 }
 
+// GetHandlerConfirmBatchExecution returns a handler to confirm the execution of a command batch
+func GetHandlerConfirmBatchExecution(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ReqConfirmBatchExecution
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, ok := clientUtils.ExtractReqSender(w, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		batchID, err := hex.DecodeString(req.BatchedCommandsID)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		txID := common.HexToHash(req.TxID)
+
+		msg := types.NewConfirmBatchExecutionRequest(fromAddr, mux.Vars(r)[clientUtils.PathVarChain], batchID, txID)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+// GetHandlerConfirmGasInfo returns a handler to confirm the gas info of a chain at a given block
+func GetHandlerConfirmGasInfo(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ReqConfirmGasInfo
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, ok := clientUtils.ExtractReqSender(w, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := types.NewConfirmGasInfoRequest(fromAddr, mux.Vars(r)[clientUtils.PathVarChain], req.BlockNumber)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
 // GetHandlerCreatePendingTransfers returns a handler to create commands for all pending transfers
 func GetHandlerCreatePendingTransfers(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -414,6 +594,52 @@ func GetHandlerCreateDeployToken(cliCtx client.Context) http.HandlerFunc {
 	}
 }
 
+// GetHandlerCreateRegisterExternalToken returns a handler to create a register external token command
+func GetHandlerCreateRegisterExternalToken(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ReqCreateRegisterExternalToken
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, ok := clientUtils.ExtractReqSender(w, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		decs, err := strconv.ParseUint(req.Decimals, 10, 8)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, errors.New("could not parse decimals").Error())
+			return
+		}
+		capacity, ok := sdk.NewIntFromString(req.Capacity)
+		if !ok {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, errors.New("could not parse capacity").Error())
+			return
+		}
+		minDeposit, ok := sdk.NewIntFromString(req.MinDeposit)
+		if !ok {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, errors.New("could not parse minimum deposit amount").Error())
+			return
+		}
+		if !common.IsHexAddress(req.TokenAddress) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, errors.New("invalid token address").Error())
+			return
+		}
+
+		tokenDetails := types.NewTokenDetails(req.TokenName, req.Symbol, uint8(decs), capacity)
+		msg := types.NewCreateRegisterExternalTokenRequest(fromAddr, mux.Vars(r)[clientUtils.PathVarChain], req.Asset, tokenDetails, minDeposit, types.Address(common.HexToAddress(req.TokenAddress)))
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
 // GetHandlerCreateBurnTokens returns a handler to create commands for all outstanding burns
 func GetHandlerCreateBurnTokens(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -487,6 +713,42 @@ func GetHandlerCreateTransferOperatorship(cliCtx client.Context) http.HandlerFun
 	}
 }
 
+// GetHandlerCreateUpgradeGateway returns a handler to create a gateway upgrade command
+func GetHandlerCreateUpgradeGateway(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ReqCreateUpgradeGateway
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, ok := clientUtils.ExtractReqSender(w, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		if !common.IsHexAddress(req.NewImplementation) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid new implementation address %s", req.NewImplementation))
+			return
+		}
+
+		setupParams, err := hex.DecodeString(strings.TrimPrefix(req.SetupParams, "0x"))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewCreateUpgradeGatewayRequest(fromAddr, mux.Vars(r)[clientUtils.PathVarChain], common.HexToAddress(req.NewImplementation), common.HexToHash(req.NewImplementationCodeHash), setupParams, req.NewImplementationCommands)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
 // GetHandlerSignCommands returns a handler to sign pending commands
 func GetHandlerSignCommands(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
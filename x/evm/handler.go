@@ -59,6 +59,27 @@ func NewHandler(k types.BaseKeeper, t types.TSS, v types.Voter, s types.Signer,
 				result.Log = fmt.Sprintf("votes on confirmation of transfer ownership %s started", msg.TxID.Hex())
 			}
 			return result, err
+		case *types.ConfirmBatchExecutionRequest:
+			res, err := server.ConfirmBatchExecution(sdk.WrapSDKContext(ctx), msg)
+			result, err := sdk.WrapServiceResult(ctx, res, err)
+			if err == nil {
+				result.Log = fmt.Sprintf("votes on execution of batch %s in transaction %s started", hex.EncodeToString(msg.BatchID), msg.TxID.Hex())
+			}
+			return result, err
+		case *types.ConfirmGasInfoRequest:
+			res, err := server.ConfirmGasInfo(sdk.WrapSDKContext(ctx), msg)
+			result, err := sdk.WrapServiceResult(ctx, res, err)
+			if err == nil {
+				result.Log = fmt.Sprintf("votes on gas info of chain %s at block %d started", msg.Chain, msg.BlockNumber)
+			}
+			return result, err
+		case *types.ReverifyDepositRequest:
+			res, err := server.ReverifyDeposit(sdk.WrapSDKContext(ctx), msg)
+			result, err := sdk.WrapServiceResult(ctx, res, err)
+			if err == nil {
+				result.Log = fmt.Sprintf("votes on reverification of deposit %s to %s started", msg.TxID.Hex(), msg.BurnerAddress.Hex())
+			}
+			return result, err
 		case *types.VoteConfirmChainRequest:
 			res, err := server.VoteConfirmChain(sdk.WrapSDKContext(ctx), msg)
 			result, err := sdk.WrapServiceResult(ctx, res, err)
@@ -73,6 +94,41 @@ func NewHandler(k types.BaseKeeper, t types.TSS, v types.Voter, s types.Signer,
 				result.Log = res.Log
 			}
 			return result, err
+		case *types.VoteConfirmBatchExecutionRequest:
+			res, err := server.VoteConfirmBatchExecution(sdk.WrapSDKContext(ctx), msg)
+			result, err := sdk.WrapServiceResult(ctx, res, err)
+			if err == nil {
+				result.Log = res.Log
+			}
+			return result, err
+		case *types.VoteConfirmGasInfoRequest:
+			res, err := server.VoteConfirmGasInfo(sdk.WrapSDKContext(ctx), msg)
+			result, err := sdk.WrapServiceResult(ctx, res, err)
+			if err == nil {
+				result.Log = res.Log
+			}
+			return result, err
+		case *types.VoteReverifyDepositRequest:
+			res, err := server.VoteReverifyDeposit(sdk.WrapSDKContext(ctx), msg)
+			result, err := sdk.WrapServiceResult(ctx, res, err)
+			if err == nil {
+				result.Log = res.Log
+			}
+			return result, err
+		case *types.ConfirmGatewayUpgradeRequest:
+			res, err := server.ConfirmGatewayUpgrade(sdk.WrapSDKContext(ctx), msg)
+			result, err := sdk.WrapServiceResult(ctx, res, err)
+			if err == nil {
+				result.Log = fmt.Sprintf("votes on confirmation of gateway upgrade in %s started", msg.TxID.Hex())
+			}
+			return result, err
+		case *types.VoteConfirmGatewayUpgradeRequest:
+			res, err := server.VoteConfirmGatewayUpgrade(sdk.WrapSDKContext(ctx), msg)
+			result, err := sdk.WrapServiceResult(ctx, res, err)
+			if err == nil {
+				result.Log = res.Log
+			}
+			return result, err
 		case *types.VoteConfirmDepositRequest:
 			res, err := server.VoteConfirmDeposit(sdk.WrapSDKContext(ctx), msg)
 			result, err := sdk.WrapServiceResult(ctx, res, err)
@@ -97,6 +153,9 @@ func NewHandler(k types.BaseKeeper, t types.TSS, v types.Voter, s types.Signer,
 		case *types.CreateDeployTokenRequest:
 			res, err := server.CreateDeployToken(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.CreateRegisterExternalTokenRequest:
+			res, err := server.CreateRegisterExternalToken(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.CreateBurnTokensRequest:
 			res, err := server.CreateBurnTokens(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -109,6 +168,9 @@ func NewHandler(k types.BaseKeeper, t types.TSS, v types.Voter, s types.Signer,
 		case *types.CreateTransferOperatorshipRequest:
 			res, err := server.CreateTransferOperatorship(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.CreateUpgradeGatewayRequest:
+			res, err := server.CreateUpgradeGateway(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.SignCommandsRequest:
 			res, err := server.SignCommands(sdk.WrapSDKContext(ctx), msg)
 			result, err := sdk.WrapServiceResult(ctx, res, err)
@@ -18,6 +18,7 @@ import (
 
 	"github.com/axelarnetwork/axelar-core/utils"
 	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	"github.com/axelarnetwork/axelar-core/x/vote/exported"
 )
 
@@ -25,17 +26,23 @@ var (
 	gatewayKey             = utils.KeyFromStr("gateway")
 	unsignedBatchIDKey     = utils.KeyFromStr("unsigned_command_batch_id")
 	latestSignedBatchIDKey = utils.KeyFromStr("latest_signed_command_batch_id")
+	gasInfoKey             = utils.KeyFromStr("gas_info")
 
 	tokenMetadataByAssetPrefix  = utils.KeyFromStr("token_deployment_by_asset")
 	tokenMetadataBySymbolPrefix = utils.KeyFromStr("token_deployment_by_symbol")
 	pendingDepositPrefix        = utils.KeyFromStr("pending_deposit")
 	confirmedDepositPrefix      = utils.KeyFromStr("confirmed_deposit")
 	burnedDepositPrefix         = utils.KeyFromStr("burned_deposit")
+	reorgedDepositPrefix        = utils.KeyFromStr("reorged_deposit")
 	commandBatchPrefix          = utils.KeyFromStr("batched_commands")
 	commandPrefix               = utils.KeyFromStr("command")
 	burnerAddrPrefix            = utils.KeyFromStr("burnerAddr")
+	recipientBurnerPrefix       = utils.KeyFromStr("recipient_burner")
 	pendingTransferKeyPrefix    = utils.KeyFromStr("pending_transfer_key")
 	archivedTransferKeyPrefix   = utils.KeyFromStr("archived_transfer_key")
+	pendingBatchExecutionPrefix = utils.KeyFromStr("pending_batch_execution")
+	pendingGasInfoPrefix        = utils.KeyFromStr("pending_gas_info")
+	pendingReverificationPrefix = utils.KeyFromStr("pending_deposit_reverification")
 
 	commandQueueName = "cmd_queue"
 )
@@ -90,6 +97,28 @@ func (k chainKeeper) getCommandsGasLimit(ctx sdk.Context) uint32 {
 	return commandsGasLimit
 }
 
+// GetBatchGasLimit returns the maximum gas a command batch may consume, which is further bounded by
+// the block gas limit of the chain if its gas info is recent enough
+func (k chainKeeper) GetBatchGasLimit(ctx sdk.Context) uint32 {
+	gasLimit := k.getCommandsGasLimit(ctx)
+
+	gasInfo, ok := k.GetGasInfo(ctx)
+	if !ok {
+		return gasLimit
+	}
+
+	maxAge, _ := k.GetGasInfoMaxAge(ctx)
+	if gasInfo.IsStale(ctx.BlockHeight(), maxAge) {
+		return gasLimit
+	}
+
+	if gasInfo.BlockGasLimit < uint64(gasLimit) {
+		return uint32(gasInfo.BlockGasLimit)
+	}
+
+	return gasLimit
+}
+
 func (k chainKeeper) GetChainID(ctx sdk.Context) (*big.Int, bool) {
 	network, ok := k.GetNetwork(ctx)
 	if !ok {
@@ -175,6 +204,58 @@ func (k chainKeeper) GetTransactionFeeRate(ctx sdk.Context) (sdk.Dec, bool) {
 	return feeRate, true
 }
 
+// GetCommandBatchExecutionTimeout returns the number of blocks a signed command batch may remain unexecuted before it is reported as overdue
+func (k chainKeeper) GetCommandBatchExecutionTimeout(ctx sdk.Context) (int64, bool) {
+	var timeout int64
+
+	subspace, ok := k.getSubspace(ctx)
+	if !ok {
+		return timeout, false
+	}
+
+	subspace.Get(ctx, types.KeyCommandBatchExecutionTimeout, &timeout)
+	return timeout, true
+}
+
+// GetGasInfoMaxAge returns the number of blocks after which confirmed gas info is considered stale
+func (k chainKeeper) GetGasInfoMaxAge(ctx sdk.Context) (int64, bool) {
+	var maxAge int64
+
+	subspace, ok := k.getSubspace(ctx)
+	if !ok {
+		return maxAge, false
+	}
+
+	subspace.Get(ctx, types.KeyGasInfoMaxAge, &maxAge)
+	return maxAge, true
+}
+
+// GetFinalityMode returns the mode that determines when a block of the chain is considered final
+func (k chainKeeper) GetFinalityMode(ctx sdk.Context) (types.FinalityMode, bool) {
+	var mode types.FinalityMode
+
+	subspace, ok := k.getSubspace(ctx)
+	if !ok {
+		return mode, false
+	}
+
+	subspace.Get(ctx, types.KeyFinalityMode, &mode)
+	return mode, true
+}
+
+// GetGatewayCommands returns the commands the gateway of the chain is able to execute
+func (k chainKeeper) GetGatewayCommands(ctx sdk.Context) ([]string, bool) {
+	var commands []string
+
+	subspace, ok := k.getSubspace(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	subspace.Get(ctx, types.KeyGatewayCommands, &commands)
+	return commands, true
+}
+
 // SetBurnerInfo saves the burner info for a given address
 func (k chainKeeper) SetBurnerInfo(ctx sdk.Context, burnerInfo types.BurnerInfo) {
 	key := burnerAddrPrefix.AppendStr(burnerInfo.BurnerAddress.Hex())
@@ -192,6 +273,25 @@ func (k chainKeeper) GetBurnerInfo(ctx sdk.Context, burnerAddr common.Address) *
 	return &result
 }
 
+// SetBurnerAddressForRecipient records the burner address that deposits of the given asset for the given recipient are sent to
+func (k chainKeeper) SetBurnerAddressForRecipient(ctx sdk.Context, asset string, recipient nexus.CrossChainAddress, burnerAddr common.Address) {
+	k.getStore(ctx, k.chainLowerKey).SetRaw(getRecipientBurnerKey(asset, recipient), burnerAddr.Bytes())
+}
+
+// GetBurnerAddressForRecipient returns the burner address that deposits of the given asset for the given recipient are sent to
+func (k chainKeeper) GetBurnerAddressForRecipient(ctx sdk.Context, asset string, recipient nexus.CrossChainAddress) (common.Address, bool) {
+	bz := k.getStore(ctx, k.chainLowerKey).GetRaw(getRecipientBurnerKey(asset, recipient))
+	if bz == nil {
+		return common.Address{}, false
+	}
+
+	return common.BytesToAddress(bz), true
+}
+
+func getRecipientBurnerKey(asset string, recipient nexus.CrossChainAddress) utils.Key {
+	return recipientBurnerPrefix.AppendStr(asset).AppendStr(strings.ToLower(recipient.Chain.Name)).AppendStr(recipient.Address)
+}
+
 func (k chainKeeper) getBurnerInfos(ctx sdk.Context) []types.BurnerInfo {
 	iter := k.getStore(ctx, k.chainLowerKey).Iterator(burnerAddrPrefix)
 	defer utils.CloseLogError(iter, k.Logger(ctx))
@@ -256,10 +356,7 @@ func (k chainKeeper) GetBurnerAddressAndSalt(ctx sdk.Context, tokenAddr types.Ad
 		return common.Address{}, common.Hash{}, err
 	}
 
-	nonce := utils.GetNonce(ctx.HeaderHash(), ctx.BlockGasMeter())
-	bz := []byte(recipient)
-	bz = append(bz, nonce[:]...)
-	saltBurn := common.BytesToHash(crypto.Keccak256Hash(bz).Bytes())
+	saltBurn := getBurnerSalt(ctx, recipient)
 
 	arguments := abi.Arguments{{Type: addressType}, {Type: bytes32Type}}
 	packed, err := arguments.Pack(tokenAddr, saltBurn)
@@ -278,6 +375,22 @@ func (k chainKeeper) GetBurnerAddressAndSalt(ctx sdk.Context, tokenAddr types.Ad
 	return crypto.CreateAddress2(gatewayAddr, saltBurn, burnerInitCodeHash.Bytes()), saltBurn, nil
 }
 
+// GetNativeBurnerAddressAndSalt calculates a deposit address for the chain's native currency and the corresponding salt for the given recipient and axelar gateway address
+func (k chainKeeper) GetNativeBurnerAddressAndSalt(ctx sdk.Context, recipient string, gatewayAddr common.Address) (common.Address, common.Hash) {
+	saltBurn := getBurnerSalt(ctx, recipient)
+	burnerInitCodeHash := crypto.Keccak256Hash(types.GetNativeBurnerCode())
+
+	return crypto.CreateAddress2(gatewayAddr, saltBurn, burnerInitCodeHash.Bytes()), saltBurn
+}
+
+func getBurnerSalt(ctx sdk.Context, recipient string) common.Hash {
+	nonce := utils.GetNonce(ctx.HeaderHash(), ctx.BlockGasMeter())
+	bz := []byte(recipient)
+	bz = append(bz, nonce[:]...)
+
+	return common.BytesToHash(crypto.Keccak256Hash(bz).Bytes())
+}
+
 // GetBurnerByteCodes returns the bytecodes for the burner contract
 func (k chainKeeper) GetBurnerByteCodes(ctx sdk.Context) ([]byte, bool) {
 	var b []byte
@@ -323,6 +436,18 @@ func (k chainKeeper) CreateERC20Token(ctx sdk.Context, asset string, details typ
 	}, metadata), nil
 }
 
+// CreateExternalERC20Token registers the pre-existing token at the given address as the origin token of the given asset
+func (k chainKeeper) CreateExternalERC20Token(ctx sdk.Context, asset string, details types.TokenDetails, minDeposit sdk.Int, tokenAddr types.Address) (types.ERC20Token, error) {
+	metadata, err := k.initExternalTokenMetadata(ctx, asset, details, minDeposit, tokenAddr)
+	if err != nil {
+		return types.NilToken, err
+	}
+
+	return types.CreateERC20Token(func(m types.ERC20TokenMetadata) {
+		k.setTokenMetadata(ctx, m)
+	}, metadata), nil
+}
+
 func (k chainKeeper) GetERC20TokenByAsset(ctx sdk.Context, asset string) types.ERC20Token {
 	metadata, ok := k.getTokenMetadataByAsset(ctx, asset)
 	if !ok {
@@ -386,7 +511,7 @@ func (k chainKeeper) SetPendingDeposit(ctx sdk.Context, key exported.PollKey, de
 	k.getStore(ctx, k.chainLowerKey).Set(pendingDepositPrefix.AppendStr(key.String()), deposit)
 }
 
-// GetDeposit retrieves a confirmed/burned deposit
+// GetDeposit retrieves a confirmed/burned/reorged deposit
 func (k chainKeeper) GetDeposit(ctx sdk.Context, txID common.Hash, burnAddr common.Address) (types.ERC20Deposit, types.DepositStatus, bool) {
 	var deposit types.ERC20Deposit
 
@@ -396,6 +521,9 @@ func (k chainKeeper) GetDeposit(ctx sdk.Context, txID common.Hash, burnAddr comm
 	if k.getStore(ctx, k.chainLowerKey).Get(burnedDepositPrefix.AppendStr(txID.Hex()).AppendStr(burnAddr.Hex()), &deposit) {
 		return deposit, types.DepositStatus_Burned, true
 	}
+	if k.getStore(ctx, k.chainLowerKey).Get(reorgedDepositPrefix.AppendStr(txID.Hex()).AppendStr(burnAddr.Hex()), &deposit) {
+		return deposit, types.DepositStatus_Reorged, true
+	}
 
 	return types.ERC20Deposit{}, 0, false
 }
@@ -430,6 +558,21 @@ func (k chainKeeper) getBurnedDeposits(ctx sdk.Context) []types.ERC20Deposit {
 	return deposits
 }
 
+// getReorgedDeposits retrieves all the ERC20 deposits that are no longer part of the canonical chain
+func (k chainKeeper) getReorgedDeposits(ctx sdk.Context) []types.ERC20Deposit {
+	var deposits []types.ERC20Deposit
+	iter := k.getStore(ctx, k.chainLowerKey).Iterator(reorgedDepositPrefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var deposit types.ERC20Deposit
+		iter.UnmarshalValue(&deposit)
+		deposits = append(deposits, deposit)
+	}
+
+	return deposits
+}
+
 func (k chainKeeper) getSigner(ctx sdk.Context) evmTypes.EIP155Signer {
 	// both chain, subspace, and network must be valid if the chain keeper was instantiated,
 	// so a nil value here must be a catastrophic failure
@@ -462,13 +605,15 @@ func (k chainKeeper) GetPendingDeposit(ctx sdk.Context, key exported.PollKey) (t
 	return deposit, found
 }
 
-// SetDeposit stores confirmed or burned deposits
+// SetDeposit stores confirmed, burned or reorged deposits
 func (k chainKeeper) SetDeposit(ctx sdk.Context, deposit types.ERC20Deposit, state types.DepositStatus) {
 	switch state {
 	case types.DepositStatus_Confirmed:
 		k.getStore(ctx, k.chainLowerKey).Set(confirmedDepositPrefix.AppendStr(deposit.TxID.Hex()).AppendStr(deposit.BurnerAddress.Hex()), &deposit)
 	case types.DepositStatus_Burned:
 		k.getStore(ctx, k.chainLowerKey).Set(burnedDepositPrefix.AppendStr(deposit.TxID.Hex()).AppendStr(deposit.BurnerAddress.Hex()), &deposit)
+	case types.DepositStatus_Reorged:
+		k.getStore(ctx, k.chainLowerKey).Set(reorgedDepositPrefix.AppendStr(deposit.TxID.Hex()).AppendStr(deposit.BurnerAddress.Hex()), &deposit)
 	default:
 		panic("invalid deposit state")
 	}
@@ -478,6 +623,25 @@ func (k chainKeeper) SetDeposit(ctx sdk.Context, deposit types.ERC20Deposit, sta
 func (k chainKeeper) DeleteDeposit(ctx sdk.Context, deposit types.ERC20Deposit) {
 	k.getStore(ctx, k.chainLowerKey).Delete(confirmedDepositPrefix.AppendStr(deposit.TxID.Hex()).AppendStr(deposit.BurnerAddress.Hex()))
 	k.getStore(ctx, k.chainLowerKey).Delete(burnedDepositPrefix.AppendStr(deposit.TxID.Hex()).AppendStr(deposit.BurnerAddress.Hex()))
+	k.getStore(ctx, k.chainLowerKey).Delete(reorgedDepositPrefix.AppendStr(deposit.TxID.Hex()).AppendStr(deposit.BurnerAddress.Hex()))
+}
+
+// SetPendingReverification stores a confirmed deposit that is awaiting reverification
+func (k chainKeeper) SetPendingReverification(ctx sdk.Context, key exported.PollKey, deposit *types.ERC20Deposit) {
+	k.getStore(ctx, k.chainLowerKey).Set(pendingReverificationPrefix.AppendStr(key.String()), deposit)
+}
+
+// GetPendingReverification returns the deposit being reverified in the given poll
+func (k chainKeeper) GetPendingReverification(ctx sdk.Context, key exported.PollKey) (types.ERC20Deposit, bool) {
+	var deposit types.ERC20Deposit
+	found := k.getStore(ctx, k.chainLowerKey).Get(pendingReverificationPrefix.AppendStr(key.String()), &deposit)
+
+	return deposit, found
+}
+
+// DeletePendingReverification deletes the deposit being reverified in the given poll
+func (k chainKeeper) DeletePendingReverification(ctx sdk.Context, key exported.PollKey) {
+	k.getStore(ctx, k.chainLowerKey).Delete(pendingReverificationPrefix.AppendStr(key.String()))
 }
 
 // SetPendingTransferKey stores a pending transfer ownership/operatorship
@@ -515,6 +679,55 @@ func (k chainKeeper) GetPendingTransferKey(ctx sdk.Context, key exported.PollKey
 	return transferKey, found
 }
 
+// SetPendingBatchExecution stores a batch execution that is awaiting confirmation
+func (k chainKeeper) SetPendingBatchExecution(ctx sdk.Context, key exported.PollKey, execution *types.PendingBatchExecution) {
+	k.getStore(ctx, k.chainLowerKey).Set(pendingBatchExecutionPrefix.AppendStr(key.String()), execution)
+}
+
+// GetPendingBatchExecution returns the batch execution associated with the given poll
+func (k chainKeeper) GetPendingBatchExecution(ctx sdk.Context, key exported.PollKey) (types.PendingBatchExecution, bool) {
+	var execution types.PendingBatchExecution
+	found := k.getStore(ctx, k.chainLowerKey).Get(pendingBatchExecutionPrefix.AppendStr(key.String()), &execution)
+
+	return execution, found
+}
+
+// DeletePendingBatchExecution deletes the batch execution associated with the given poll
+func (k chainKeeper) DeletePendingBatchExecution(ctx sdk.Context, key exported.PollKey) {
+	k.getStore(ctx, k.chainLowerKey).Delete(pendingBatchExecutionPrefix.AppendStr(key.String()))
+}
+
+// SetPendingGasInfo stores the block number of a gas info request that is awaiting confirmation
+func (k chainKeeper) SetPendingGasInfo(ctx sdk.Context, key exported.PollKey, blockNumber uint64) {
+	k.getStore(ctx, k.chainLowerKey).Set(pendingGasInfoPrefix.AppendStr(key.String()), &types.GasInfo{BlockNumber: blockNumber})
+}
+
+// GetPendingGasInfo returns the block number of the gas info request associated with the given poll
+func (k chainKeeper) GetPendingGasInfo(ctx sdk.Context, key exported.PollKey) (uint64, bool) {
+	var pending types.GasInfo
+	found := k.getStore(ctx, k.chainLowerKey).Get(pendingGasInfoPrefix.AppendStr(key.String()), &pending)
+
+	return pending.BlockNumber, found
+}
+
+// DeletePendingGasInfo deletes the gas info request associated with the given poll
+func (k chainKeeper) DeletePendingGasInfo(ctx sdk.Context, key exported.PollKey) {
+	k.getStore(ctx, k.chainLowerKey).Delete(pendingGasInfoPrefix.AppendStr(key.String()))
+}
+
+// SetGasInfo stores the latest confirmed gas info of the chain
+func (k chainKeeper) SetGasInfo(ctx sdk.Context, gasInfo types.GasInfo) {
+	k.getStore(ctx, k.chainLowerKey).Set(gasInfoKey, &gasInfo)
+}
+
+// GetGasInfo returns the latest confirmed gas info of the chain
+func (k chainKeeper) GetGasInfo(ctx sdk.Context) (types.GasInfo, bool) {
+	var gasInfo types.GasInfo
+	found := k.getStore(ctx, k.chainLowerKey).Get(gasInfoKey, &gasInfo)
+
+	return gasInfo, found
+}
+
 // GetNetworkByID returns the network name for a given chain and network ID
 func (k chainKeeper) GetNetworkByID(ctx sdk.Context, id *big.Int) (string, bool) {
 	if id == nil {
@@ -651,7 +864,7 @@ func (k chainKeeper) CreateNewBatchToSign(ctx sdk.Context) (types.CommandBatch,
 	}
 
 	chainID := sdk.NewIntFromBigInt(k.getSigner(ctx).ChainID())
-	gasLimit := k.getCommandsGasLimit(ctx)
+	gasLimit := k.GetBatchGasLimit(ctx)
 	gasCost := uint32(command.MaxGasCost)
 	keyID := command.KeyID
 	filter := func(value codec.ProtoMarshaler) bool {
@@ -782,28 +995,16 @@ func (k chainKeeper) getTokensMetadata(ctx sdk.Context) []types.ERC20TokenMetada
 
 func (k chainKeeper) initTokenMetadata(ctx sdk.Context, asset string, details types.TokenDetails, minAmount sdk.Int) (types.ERC20TokenMetadata, error) {
 	// perform a few checks now, so that it is impossible to get errors later
-	if token := k.GetERC20TokenByAsset(ctx, asset); !token.Is(types.NonExistent) {
-		return types.ERC20TokenMetadata{}, fmt.Errorf("token for asset '%s' already set", asset)
-	}
-
-	if token := k.GetERC20TokenBySymbol(ctx, details.Symbol); !token.Is(types.NonExistent) {
-		return types.ERC20TokenMetadata{}, fmt.Errorf("token with symbol '%s' already set", details.Symbol)
-	}
-
-	gatewayAddr, found := k.GetGatewayAddress(ctx)
-	if !found {
-		return types.ERC20TokenMetadata{}, fmt.Errorf("axelar gateway address for chain '%s' not set", k.chainLowerKey)
+	if err := k.validateTokenMetadata(ctx, asset, details); err != nil {
+		return types.ERC20TokenMetadata{}, err
 	}
 
-	_, found = k.GetTokenByteCodes(ctx)
+	_, found := k.GetTokenByteCodes(ctx)
 	if !found {
 		return types.ERC20TokenMetadata{}, fmt.Errorf("bytecodes for token contract for chain '%s' not found", k.chainLowerKey)
 	}
 
-	if err := details.Validate(); err != nil {
-		return types.ERC20TokenMetadata{}, err
-	}
-
+	gatewayAddr, _ := k.GetGatewayAddress(ctx)
 	chainID := k.getSigner(ctx).ChainID()
 	tokenAddr, err := k.getTokenAddress(ctx, details, gatewayAddr)
 	if err != nil {
@@ -823,6 +1024,44 @@ func (k chainKeeper) initTokenMetadata(ctx sdk.Context, asset string, details ty
 	return meta, nil
 }
 
+func (k chainKeeper) initExternalTokenMetadata(ctx sdk.Context, asset string, details types.TokenDetails, minAmount sdk.Int, tokenAddr types.Address) (types.ERC20TokenMetadata, error) {
+	if err := k.validateTokenMetadata(ctx, asset, details); err != nil {
+		return types.ERC20TokenMetadata{}, err
+	}
+
+	if tokenAddr == (types.Address{}) {
+		return types.ERC20TokenMetadata{}, fmt.Errorf("missing address for external token '%s'", details.Symbol)
+	}
+
+	meta := types.ERC20TokenMetadata{
+		Asset:        asset,
+		Details:      details,
+		TokenAddress: tokenAddr,
+		ChainID:      sdk.NewIntFromBigInt(k.getSigner(ctx).ChainID()),
+		MinAmount:    minAmount,
+		Status:       types.Initialized,
+		IsExternal:   true,
+	}
+	k.setTokenMetadata(ctx, meta)
+	return meta, nil
+}
+
+func (k chainKeeper) validateTokenMetadata(ctx sdk.Context, asset string, details types.TokenDetails) error {
+	if token := k.GetERC20TokenByAsset(ctx, asset); !token.Is(types.NonExistent) {
+		return fmt.Errorf("token for asset '%s' already set", asset)
+	}
+
+	if token := k.GetERC20TokenBySymbol(ctx, details.Symbol); !token.Is(types.NonExistent) {
+		return fmt.Errorf("token with symbol '%s' already set", details.Symbol)
+	}
+
+	if _, found := k.GetGatewayAddress(ctx); !found {
+		return fmt.Errorf("axelar gateway address for chain '%s' not set", k.chainLowerKey)
+	}
+
+	return details.Validate()
+}
+
 // SetPendingGateway sets the pending gateway
 func (k chainKeeper) SetPendingGateway(ctx sdk.Context, address common.Address) {
 	gateway := types.Gateway{Address: types.Address(address), Status: types.GatewayStatusPending}
@@ -880,6 +1119,55 @@ func (k chainKeeper) GetGatewayAddress(ctx sdk.Context) (common.Address, bool) {
 	return common.Address{}, false
 }
 
+// SetPendingGatewayUpgrade records the implementation the confirmed gateway is being upgraded to and the commands it is able to execute,
+// replacing any previous upgrade that was never confirmed
+func (k chainKeeper) SetPendingGatewayUpgrade(ctx sdk.Context, implementation common.Address, commands []string) error {
+	gateway := k.getGateway(ctx)
+	if gateway.Status != types.GatewayStatusConfirmed {
+		return fmt.Errorf("no confirmed gateway found for chain %s", k.chainLowerKey)
+	}
+
+	gateway.PendingImplementation = types.Address(implementation)
+	gateway.PendingCommands = commands
+	k.setGateway(ctx, gateway)
+
+	return nil
+}
+
+// GetPendingGatewayUpgrade returns the implementation the gateway is being upgraded to
+func (k chainKeeper) GetPendingGatewayUpgrade(ctx sdk.Context) (common.Address, bool) {
+	gateway := k.getGateway(ctx)
+	if gateway.Status != types.GatewayStatusConfirmed || gateway.PendingImplementation == (types.Address{}) {
+		return common.Address{}, false
+	}
+
+	return common.Address(gateway.PendingImplementation), true
+}
+
+// ConfirmPendingGatewayUpgrade sets the pending implementation as the current implementation of the gateway
+// and replaces the gateway commands of the chain with the commands of the new implementation
+func (k chainKeeper) ConfirmPendingGatewayUpgrade(ctx sdk.Context) error {
+	implementation, ok := k.GetPendingGatewayUpgrade(ctx)
+	if !ok {
+		return fmt.Errorf("no pending gateway upgrade found for chain %s", k.chainLowerKey)
+	}
+
+	subspace, ok := k.getSubspace(ctx)
+	if !ok {
+		return fmt.Errorf("params for chain %s not set", k.chainLowerKey)
+	}
+
+	gateway := k.getGateway(ctx)
+	subspace.Set(ctx, types.KeyGatewayCommands, gateway.PendingCommands)
+
+	gateway.Implementation = types.Address(implementation)
+	gateway.PendingImplementation = types.Address{}
+	gateway.PendingCommands = nil
+	k.setGateway(ctx, gateway)
+
+	return nil
+}
+
 func (k chainKeeper) getSubspace(ctx sdk.Context) (params.Subspace, bool) {
 	// When a node restarts or joins the network after genesis, it might not have all EVM subspaces initialized.
 	// The following check has to be done regardless, if we would only do it dependent on the existence of a subspace
@@ -26,6 +26,10 @@ func (k baseKeeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 			ck.SetDeposit(ctx, deposit, types.DepositStatus_Burned)
 		}
 
+		for _, deposit := range chain.ReorgedDeposits {
+			ck.SetDeposit(ctx, deposit, types.DepositStatus_Reorged)
+		}
+
 		var latestBatch types.CommandBatchMetadata
 		for _, batch := range chain.CommandBatches {
 			ck.setCommandBatchMetadata(ctx, batch)
@@ -66,6 +70,7 @@ func (k baseKeeper) getChains(ctx sdk.Context) []types.GenesisState_Chain {
 			CommandBatches:    ck.getCommandBatchesMetadata(ctx),
 			Gateway:           ck.getGateway(ctx),
 			Tokens:            ck.getTokensMetadata(ctx),
+			ReorgedDeposits:   ck.getReorgedDeposits(ctx),
 		}
 		chains = append(chains, chain)
 	}
@@ -64,6 +64,7 @@ func assertChainsEqual(t *testing.T, initial types.GenesisState, exported types.
 		assert.ElementsMatch(t, initial.Chains[i].BurnerInfos, exported.Chains[i].BurnerInfos)
 		assert.ElementsMatch(t, initial.Chains[i].ConfirmedDeposits, exported.Chains[i].ConfirmedDeposits)
 		assert.ElementsMatch(t, initial.Chains[i].BurnedDeposits, exported.Chains[i].BurnedDeposits)
+		assert.ElementsMatch(t, initial.Chains[i].ReorgedDeposits, exported.Chains[i].ReorgedDeposits)
 		assert.ElementsMatch(t, initial.Chains[i].Tokens, exported.Chains[i].Tokens)
 		assert.ElementsMatch(t, initial.Chains[i].CommandBatches, exported.Chains[i].CommandBatches)
 		assert.Equal(t, initial.Chains[i].Gateway, exported.Chains[i].Gateway)
@@ -114,6 +114,70 @@ func TestSetBurnerInfoGetBurnerInfo(t *testing.T) {
 
 }
 
+func TestGatewayUpgrade(t *testing.T) {
+	var (
+		ctx         sdk.Context
+		chainKeeper types.ChainKeeper
+	)
+
+	setup := func() {
+		encCfg := params.MakeEncodingConfig()
+		paramsK := paramsKeeper.NewKeeper(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("params"), sdk.NewKVStoreKey("tparams"))
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+		chainKeeper = evmKeeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("evm"), paramsK).ForChain("Ethereum")
+		chainKeeper.SetParams(ctx, types.DefaultParams()[0])
+	}
+
+	t.Run("should support all gateway commands with the default params", testutils.Func(func(t *testing.T) {
+		setup()
+
+		actual, ok := chainKeeper.GetGatewayCommands(ctx)
+		assert.True(t, ok)
+		assert.ElementsMatch(t, types.AllGatewayCommands(), actual)
+		assert.Contains(t, actual, types.AxelarGatewayCommandUpgrade)
+	}))
+
+	t.Run("should not allow an upgrade before the gateway is confirmed", testutils.Func(func(t *testing.T) {
+		setup()
+		chainKeeper.SetPendingGateway(ctx, common.BytesToAddress(rand.Bytes(common.AddressLength)))
+
+		assert.Error(t, chainKeeper.SetPendingGatewayUpgrade(ctx, common.BytesToAddress(rand.Bytes(common.AddressLength)), types.AllGatewayCommands()))
+		_, ok := chainKeeper.GetPendingGatewayUpgrade(ctx)
+		assert.False(t, ok)
+	}).Repeat(20))
+
+	t.Run("should confirm the pending upgrade, keep the gateway address and update the gateway commands", testutils.Func(func(t *testing.T) {
+		setup()
+		gatewayAddr := common.BytesToAddress(rand.Bytes(common.AddressLength))
+		chainKeeper.SetPendingGateway(ctx, gatewayAddr)
+		assert.NoError(t, chainKeeper.ConfirmPendingGateway(ctx))
+		assert.Error(t, chainKeeper.ConfirmPendingGatewayUpgrade(ctx))
+
+		implementation := common.BytesToAddress(rand.Bytes(common.AddressLength))
+		allCommands := types.AllGatewayCommands()
+		commands := allCommands[:rand.I64Between(1, int64(len(allCommands)))]
+		assert.NoError(t, chainKeeper.SetPendingGatewayUpgrade(ctx, common.BytesToAddress(rand.Bytes(common.AddressLength)), allCommands))
+		assert.NoError(t, chainKeeper.SetPendingGatewayUpgrade(ctx, implementation, commands))
+		actual, ok := chainKeeper.GetPendingGatewayUpgrade(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, implementation, actual)
+
+		actualCommands, ok := chainKeeper.GetGatewayCommands(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, allCommands, actualCommands)
+
+		assert.NoError(t, chainKeeper.ConfirmPendingGatewayUpgrade(ctx))
+		_, ok = chainKeeper.GetPendingGatewayUpgrade(ctx)
+		assert.False(t, ok)
+		actualGatewayAddr, ok := chainKeeper.GetGatewayAddress(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, gatewayAddr, actualGatewayAddr)
+		actualCommands, ok = chainKeeper.GetGatewayCommands(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, commands, actualCommands)
+	}).Repeat(20))
+}
+
 func TestKeeper_GetParams(t *testing.T) {
 	var (
 		keeperWithSubspace    types.ChainKeeper
@@ -5,6 +5,7 @@ import (
 	"crypto/ecdsa"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 
@@ -112,6 +113,7 @@ func (s msgServer) ConfirmGatewayDeployment(c context.Context, req *types.Confir
 	}
 
 	height, _ := keeper.GetRequiredConfirmationHeight(ctx)
+	finalityMode, _ := keeper.GetFinalityMode(ctx)
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.EventTypeGatewayDeploymentConfirmation,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -121,6 +123,7 @@ func (s msgServer) ConfirmGatewayDeployment(c context.Context, req *types.Confir
 			sdk.NewAttribute(types.AttributeKeyAddress, req.Address.Hex()),
 			sdk.NewAttribute(types.AttributeKeyBytecodeHash, hex.EncodeToString(crypto.Keccak256(deploymentBytecode))),
 			sdk.NewAttribute(types.AttributeKeyConfHeight, strconv.FormatUint(height, 10)),
+			sdk.NewAttribute(types.AttributeKeyFinalityMode, finalityMode.String()),
 			sdk.NewAttribute(types.AttributeKeyPoll, string(types.ModuleCdc.MustMarshalJSON(&pollKey))),
 		),
 	)
@@ -242,20 +245,17 @@ func (s msgServer) Link(c context.Context, req *types.LinkRequest) (*types.LinkR
 		return nil, fmt.Errorf("unknown recipient chain")
 	}
 
-	token := keeper.GetERC20TokenByAsset(ctx, req.Asset)
-	found := s.nexus.IsAssetRegistered(ctx, recipientChain, req.Asset)
-	if !found || !token.Is(types.Confirmed) {
+	if !s.nexus.IsAssetRegistered(ctx, recipientChain, req.Asset) {
 		return nil, fmt.Errorf("asset '%s' not registered for chain '%s'", req.Asset, recipientChain.Name)
 	}
 
-	tokenAddr := token.GetAddress()
-	burnerAddr, salt, err := keeper.GetBurnerAddressAndSalt(ctx, tokenAddr, req.RecipientAddr, gatewayAddr)
+	recipient := nexus.CrossChainAddress{Chain: recipientChain, Address: req.RecipientAddr}
+
+	burnerInfo, err := getBurnerInfoForRecipient(ctx, keeper, senderChain, req.Asset, recipient, gatewayAddr)
 	if err != nil {
 		return nil, err
 	}
-
-	symbol := token.GetDetails().Symbol
-	recipient := nexus.CrossChainAddress{Chain: recipientChain, Address: req.RecipientAddr}
+	burnerAddr := common.Address(burnerInfo.BurnerAddress)
 
 	err = s.nexus.LinkAddresses(ctx,
 		nexus.CrossChainAddress{Chain: senderChain, Address: burnerAddr.Hex()},
@@ -264,15 +264,8 @@ func (s msgServer) Link(c context.Context, req *types.LinkRequest) (*types.LinkR
 		return nil, fmt.Errorf("could not link addresses: %s", err.Error())
 	}
 
-	burnerInfo := types.BurnerInfo{
-		BurnerAddress:    types.Address(burnerAddr),
-		TokenAddress:     tokenAddr,
-		DestinationChain: req.RecipientChain,
-		Symbol:           symbol,
-		Asset:            req.Asset,
-		Salt:             types.Hash(salt),
-	}
 	keeper.SetBurnerInfo(ctx, burnerInfo)
+	keeper.SetBurnerAddressForRecipient(ctx, req.Asset, recipient, burnerAddr)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -282,13 +275,61 @@ func (s msgServer) Link(c context.Context, req *types.LinkRequest) (*types.LinkR
 			sdk.NewAttribute(types.AttributeKeyDepositAddress, burnerAddr.Hex()),
 			sdk.NewAttribute(types.AttributeKeyDestinationAddress, req.RecipientAddr),
 			sdk.NewAttribute(types.AttributeKeyDestinationChain, recipientChain.Name),
-			sdk.NewAttribute(types.AttributeKeyTokenAddress, tokenAddr.Hex()),
+			sdk.NewAttribute(types.AttributeKeyTokenAddress, burnerInfo.TokenAddress.Hex()),
 		),
 	)
 
 	return &types.LinkResponse{DepositAddr: burnerAddr.Hex()}, nil
 }
 
+// getBurnerInfoForRecipient returns the burner already linked to the given recipient for the given asset, if there is one,
+// so repeated deposits for the same recipient go to the same address and can be swept together.
+// Otherwise, it calculates a new burner
+func getBurnerInfoForRecipient(ctx sdk.Context, keeper types.ChainKeeper, senderChain nexus.Chain, asset string, recipient nexus.CrossChainAddress, gatewayAddr common.Address) (types.BurnerInfo, error) {
+	if burnerAddr, ok := keeper.GetBurnerAddressForRecipient(ctx, asset, recipient); ok {
+		if burnerInfo := keeper.GetBurnerInfo(ctx, burnerAddr); burnerInfo != nil {
+			return *burnerInfo, nil
+		}
+	}
+
+	// deposits of the chain's native currency are forwarded to the gateway instead of being burned,
+	// so they don't need a token on the sender chain
+	if asset == senderChain.NativeAsset {
+		if !isGatewayCommandSupported(ctx, keeper, types.AxelarGatewayCommandLockNative) {
+			return types.BurnerInfo{}, fmt.Errorf("gateway of chain %s does not support deposits of the native asset '%s'", senderChain.Name, asset)
+		}
+
+		burnerAddr, salt := keeper.GetNativeBurnerAddressAndSalt(ctx, recipient.Address, gatewayAddr)
+
+		return types.BurnerInfo{
+			BurnerAddress:    types.Address(burnerAddr),
+			DestinationChain: recipient.Chain.Name,
+			Symbol:           senderChain.NativeAsset,
+			Asset:            asset,
+			Salt:             types.Hash(salt),
+		}, nil
+	}
+
+	token := keeper.GetERC20TokenByAsset(ctx, asset)
+	if !token.Is(types.Confirmed) {
+		return types.BurnerInfo{}, fmt.Errorf("asset '%s' not registered for chain '%s'", asset, recipient.Chain.Name)
+	}
+
+	burnerAddr, salt, err := keeper.GetBurnerAddressAndSalt(ctx, token.GetAddress(), recipient.Address, gatewayAddr)
+	if err != nil {
+		return types.BurnerInfo{}, err
+	}
+
+	return types.BurnerInfo{
+		BurnerAddress:    types.Address(burnerAddr),
+		TokenAddress:     token.GetAddress(),
+		DestinationChain: recipient.Chain.Name,
+		Symbol:           token.GetDetails().Symbol,
+		Asset:            asset,
+		Salt:             types.Hash(salt),
+	}, nil
+}
+
 // ConfirmToken handles token deployment confirmation
 func (s msgServer) ConfirmToken(c context.Context, req *types.ConfirmTokenRequest) (*types.ConfirmTokenResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -346,6 +387,7 @@ func (s msgServer) ConfirmToken(c context.Context, req *types.ConfirmTokenReques
 	tokenAddr := token.GetAddress()
 	gatewayAddr, _ := keeper.GetGatewayAddress(ctx)
 	height, _ := keeper.GetRequiredConfirmationHeight(ctx)
+	finalityMode, _ := keeper.GetFinalityMode(ctx)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.EventTypeTokenConfirmation,
@@ -356,8 +398,10 @@ func (s msgServer) ConfirmToken(c context.Context, req *types.ConfirmTokenReques
 			sdk.NewAttribute(types.AttributeKeyGatewayAddress, gatewayAddr.Hex()),
 			sdk.NewAttribute(types.AttributeKeyTokenAddress, tokenAddr.Hex()),
 			sdk.NewAttribute(types.AttributeKeySymbol, token.GetDetails().Symbol),
+			sdk.NewAttribute(types.AttributeKeyDecimals, strconv.FormatUint(uint64(token.GetDetails().Decimals), 10)),
 			sdk.NewAttribute(types.AttributeKeyAsset, req.Asset.Name),
 			sdk.NewAttribute(types.AttributeKeyConfHeight, strconv.FormatUint(height, 10)),
+			sdk.NewAttribute(types.AttributeKeyFinalityMode, finalityMode.String()),
 			sdk.NewAttribute(types.AttributeKeyPoll, string(types.ModuleCdc.MustMarshalJSON(&pollKey))),
 		),
 	)
@@ -440,6 +484,8 @@ func (s msgServer) ConfirmDeposit(c context.Context, req *types.ConfirmDepositRe
 		return nil, fmt.Errorf("already confirmed")
 	case state == types.DepositStatus_Burned:
 		return nil, fmt.Errorf("already burned")
+	case state == types.DepositStatus_Reorged:
+		return nil, fmt.Errorf("deposit was reorged out of the canonical chain")
 	}
 
 	burnerInfo := keeper.GetBurnerInfo(ctx, common.Address(req.BurnerAddress))
@@ -462,7 +508,7 @@ func (s msgServer) ConfirmDeposit(c context.Context, req *types.ConfirmDepositRe
 		return nil, fmt.Errorf("min voter count for chain %s not found", chain.Name)
 	}
 
-	pollKey := vote.NewPollKey(types.ModuleName, fmt.Sprintf("%s_%s_%d", req.TxID.Hex(), req.BurnerAddress.Hex(), req.Amount.Uint64()))
+	pollKey := vote.NewPollKey(types.ModuleName, fmt.Sprintf("%s_%s_%s", req.TxID.Hex(), req.BurnerAddress.Hex(), req.Amount.String()))
 	if err := s.voter.InitializePoll(
 		ctx,
 		pollKey,
@@ -485,6 +531,7 @@ func (s msgServer) ConfirmDeposit(c context.Context, req *types.ConfirmDepositRe
 	keeper.SetPendingDeposit(ctx, pollKey, &erc20Deposit)
 
 	height, _ := keeper.GetRequiredConfirmationHeight(ctx)
+	finalityMode, _ := keeper.GetFinalityMode(ctx)
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.EventTypeDepositConfirmation,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -495,6 +542,7 @@ func (s msgServer) ConfirmDeposit(c context.Context, req *types.ConfirmDepositRe
 			sdk.NewAttribute(types.AttributeKeyDepositAddress, req.BurnerAddress.Hex()),
 			sdk.NewAttribute(types.AttributeKeyTokenAddress, burnerInfo.TokenAddress.Hex()),
 			sdk.NewAttribute(types.AttributeKeyConfHeight, strconv.FormatUint(height, 10)),
+			sdk.NewAttribute(types.AttributeKeyFinalityMode, finalityMode.String()),
 			sdk.NewAttribute(types.AttributeKeyPoll, string(types.ModuleCdc.MustMarshalJSON(&pollKey))),
 		),
 	)
@@ -572,6 +620,7 @@ func (s msgServer) ConfirmTransferKey(c context.Context, req *types.ConfirmTrans
 	keeper.SetPendingTransferKey(ctx, pollKey, &transferKey)
 
 	height, _ := keeper.GetRequiredConfirmationHeight(ctx)
+	finalityMode, _ := keeper.GetFinalityMode(ctx)
 
 	event := sdk.NewEvent(types.EventTypeTransferKeyConfirmation,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -582,6 +631,7 @@ func (s msgServer) ConfirmTransferKey(c context.Context, req *types.ConfirmTrans
 		sdk.NewAttribute(types.AttributeKeyKeyType, chain.KeyType.SimpleString()),
 		sdk.NewAttribute(types.AttributeKeyGatewayAddress, gatewayAddr.Hex()),
 		sdk.NewAttribute(types.AttributeKeyConfHeight, strconv.FormatUint(height, 10)),
+		sdk.NewAttribute(types.AttributeKeyFinalityMode, finalityMode.String()),
 		sdk.NewAttribute(types.AttributeKeyPoll, string(types.ModuleCdc.MustMarshalJSON(&pollKey))),
 	)
 	defer func() { ctx.EventManager().EmitEvent(event) }()
@@ -624,6 +674,82 @@ func (s msgServer) ConfirmTransferKey(c context.Context, req *types.ConfirmTrans
 	return &types.ConfirmTransferKeyResponse{}, nil
 }
 
+func (s msgServer) ConfirmBatchExecution(c context.Context, req *types.ConfirmBatchExecutionRequest) (*types.ConfirmBatchExecutionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chain, ok := s.nexus.GetChain(ctx, req.Chain)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a registered chain", req.Chain)
+	}
+
+	if err := validateChainActivated(ctx, s.nexus, chain); err != nil {
+		return nil, err
+	}
+
+	keeper := s.ForChain(chain.Name)
+
+	batch := keeper.GetBatchByID(ctx, req.BatchID)
+	if !batch.Is(types.BatchSigned) {
+		return nil, fmt.Errorf("command batch %s for chain %s is not signed", hex.EncodeToString(req.BatchID), chain.Name)
+	}
+
+	if batch.IsExecuted() {
+		return nil, fmt.Errorf("command batch %s for chain %s is already executed", hex.EncodeToString(req.BatchID), chain.Name)
+	}
+
+	gatewayAddr, ok := keeper.GetGatewayAddress(ctx)
+	if !ok {
+		return nil, fmt.Errorf("axelar gateway address not set")
+	}
+
+	period, ok := keeper.GetRevoteLockingPeriod(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not retrieve revote locking period for chain %s", chain.Name)
+	}
+
+	votingThreshold, ok := keeper.GetVotingThreshold(ctx)
+	if !ok {
+		return nil, fmt.Errorf("voting threshold for chain %s not found", chain.Name)
+	}
+
+	minVoterCount, ok := keeper.GetMinVoterCount(ctx)
+	if !ok {
+		return nil, fmt.Errorf("min voter count for chain %s not found", chain.Name)
+	}
+
+	pollKey := types.GetConfirmBatchExecutionPollKey(chain, req.BatchID, req.TxID)
+	if err := s.voter.InitializePoll(
+		ctx,
+		pollKey,
+		s.nexus.GetChainMaintainers(ctx, chain),
+		vote.ExpiryAt(ctx.BlockHeight()+period),
+		vote.Threshold(votingThreshold),
+		vote.MinVoterCount(minVoterCount),
+		vote.RewardPool(chain.Name),
+	); err != nil {
+		return nil, err
+	}
+
+	keeper.SetPendingBatchExecution(ctx, pollKey, &types.PendingBatchExecution{BatchID: req.BatchID, TxID: req.TxID})
+
+	height, _ := keeper.GetRequiredConfirmationHeight(ctx)
+	finalityMode, _ := keeper.GetFinalityMode(ctx)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeBatchExecutionConfirmation,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueStart),
+			sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
+			sdk.NewAttribute(types.AttributeKeyTxID, req.TxID.Hex()),
+			sdk.NewAttribute(types.AttributeKeyGatewayAddress, gatewayAddr.Hex()),
+			sdk.NewAttribute(types.AttributeKeyBatchedCommandsID, hex.EncodeToString(req.BatchID)),
+			sdk.NewAttribute(types.AttributeKeyConfHeight, strconv.FormatUint(height, 10)),
+			sdk.NewAttribute(types.AttributeKeyFinalityMode, finalityMode.String()),
+			sdk.NewAttribute(types.AttributeKeyPoll, string(types.ModuleCdc.MustMarshalJSON(&pollKey))),
+		),
+	)
+
+	return &types.ConfirmBatchExecutionResponse{}, nil
+}
+
 func (s msgServer) VoteConfirmChain(c context.Context, req *types.VoteConfirmChainRequest) (*types.VoteConfirmChainResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -700,6 +826,215 @@ func (s msgServer) VoteConfirmChain(c context.Context, req *types.VoteConfirmCha
 	return &types.VoteConfirmChainResponse{}, nil
 }
 
+// ReverifyDeposit starts a poll to check that a confirmed deposit is still part of the canonical chain
+func (s msgServer) ReverifyDeposit(c context.Context, req *types.ReverifyDepositRequest) (*types.ReverifyDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chain, ok := s.nexus.GetChain(ctx, req.Chain)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a registered chain", req.Chain)
+	}
+
+	if err := validateChainActivated(ctx, s.nexus, chain); err != nil {
+		return nil, err
+	}
+
+	keeper := s.ForChain(chain.Name)
+
+	deposit, state, ok := keeper.GetDeposit(ctx, common.Hash(req.TxID), common.Address(req.BurnerAddress))
+	switch {
+	case !ok:
+		return nil, fmt.Errorf("deposit in %s to %s not found", req.TxID.Hex(), req.BurnerAddress.Hex())
+	case state != types.DepositStatus_Confirmed:
+		return nil, fmt.Errorf("only confirmed deposits can be reverified, deposit in %s to %s is %s", req.TxID.Hex(), req.BurnerAddress.Hex(), state.String())
+	}
+
+	burnerInfo := keeper.GetBurnerInfo(ctx, common.Address(req.BurnerAddress))
+	if burnerInfo == nil {
+		return nil, fmt.Errorf("no burner info found for address %s", req.BurnerAddress.Hex())
+	}
+
+	period, ok := keeper.GetRevoteLockingPeriod(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not retrieve revote locking period for chain %s", req.Chain)
+	}
+
+	votingThreshold, ok := keeper.GetVotingThreshold(ctx)
+	if !ok {
+		return nil, fmt.Errorf("voting threshold for chain %s not found", chain.Name)
+	}
+
+	minVoterCount, ok := keeper.GetMinVoterCount(ctx)
+	if !ok {
+		return nil, fmt.Errorf("min voter count for chain %s not found", chain.Name)
+	}
+
+	// only one reverification per deposit can be in progress, a new one can be started once the previous poll is decided or expired
+	pollKey := types.GetReverifyDepositPollKey(chain, req.TxID, req.BurnerAddress)
+	if _, ok := keeper.GetPendingReverification(ctx, pollKey); ok && !s.voter.GetPoll(ctx, pollKey).Is(vote.Expired) {
+		return nil, fmt.Errorf("reverification of deposit in %s to %s is already in progress", req.TxID.Hex(), req.BurnerAddress.Hex())
+	}
+
+	if err := s.voter.InitializePoll(
+		ctx,
+		pollKey,
+		s.nexus.GetChainMaintainers(ctx, chain),
+		vote.ExpiryAt(ctx.BlockHeight()+period),
+		vote.Threshold(votingThreshold),
+		vote.MinVoterCount(minVoterCount),
+		vote.RewardPool(chain.Name),
+	); err != nil {
+		return nil, err
+	}
+
+	keeper.SetPendingReverification(ctx, pollKey, &deposit)
+
+	height, _ := keeper.GetRequiredConfirmationHeight(ctx)
+	finalityMode, _ := keeper.GetFinalityMode(ctx)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeDepositReverification,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueStart),
+			sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
+			sdk.NewAttribute(types.AttributeKeyTxID, req.TxID.Hex()),
+			sdk.NewAttribute(types.AttributeKeyAmount, deposit.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositAddress, req.BurnerAddress.Hex()),
+			sdk.NewAttribute(types.AttributeKeyTokenAddress, burnerInfo.TokenAddress.Hex()),
+			sdk.NewAttribute(types.AttributeKeyConfHeight, strconv.FormatUint(height, 10)),
+			sdk.NewAttribute(types.AttributeKeyFinalityMode, finalityMode.String()),
+			sdk.NewAttribute(types.AttributeKeyPoll, string(types.ModuleCdc.MustMarshalJSON(&pollKey))),
+		),
+	)
+
+	return &types.ReverifyDepositResponse{}, nil
+}
+
+// VoteReverifyDeposit handles votes for deposit reverifications
+func (s msgServer) VoteReverifyDeposit(c context.Context, req *types.VoteReverifyDepositRequest) (*types.VoteReverifyDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chain, ok := s.nexus.GetChain(ctx, req.Chain)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a registered chain", req.Chain)
+	}
+
+	if err := validateChainActivated(ctx, s.nexus, chain); err != nil {
+		return nil, err
+	}
+
+	voter := s.snapshotter.GetOperator(ctx, req.Sender)
+	if voter == nil {
+		return nil, fmt.Errorf("account %v is not registered as a validator proxy", req.Sender.String())
+	}
+
+	poll := s.voter.GetPoll(ctx, req.PollKey)
+	switch {
+	case poll.Is(vote.Expired):
+		return &types.VoteReverifyDepositResponse{Log: fmt.Sprintf("vote for poll %s already %s", req.PollKey, vote.Expired.String())}, nil
+	case poll.Is(vote.Failed), poll.Is(vote.Completed):
+		// If the voting threshold has been met and additional votes are received they should not return an error
+		return &types.VoteReverifyDepositResponse{Log: fmt.Sprintf("vote for poll %s already %s", req.PollKey, vote.Completed.String())}, nil
+	default:
+	}
+
+	keeper := s.ForChain(chain.Name)
+	deposit, ok := keeper.GetPendingReverification(ctx, req.PollKey)
+	if !ok {
+		return nil, fmt.Errorf("no deposit reverification found for poll %s", req.PollKey.String())
+	}
+
+	if deposit.BurnerAddress != req.BurnAddress || deposit.TxID != req.TxID {
+		return nil, fmt.Errorf("deposit in %s to address %s does not match poll %s", req.TxID.Hex(), req.BurnAddress.Hex(), req.PollKey.String())
+	}
+
+	voteValue := &gogoprototypes.BoolValue{Value: req.Canonical}
+	if err := poll.Vote(voter, voteValue); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDepositReverification,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueVote),
+		sdk.NewAttribute(types.AttributeKeyValue, strconv.FormatBool(voteValue.Value)),
+	))
+
+	if poll.Is(vote.Pending) {
+		return &types.VoteReverifyDepositResponse{Log: fmt.Sprintf("not enough votes to reverify deposit in %s to %s yet", req.TxID.Hex(), req.BurnAddress.Hex())}, nil
+	}
+
+	if poll.Is(vote.Failed) {
+		keeper.DeletePendingReverification(ctx, req.PollKey)
+		return &types.VoteReverifyDepositResponse{Log: fmt.Sprintf("poll %s failed", poll.GetKey())}, nil
+	}
+
+	canonical, ok := poll.GetResult().(*gogoprototypes.BoolValue)
+	if !ok {
+		return nil, fmt.Errorf("result of poll %s has wrong type, expected bool, got %T", req.PollKey.String(), poll.GetResult())
+	}
+
+	s.Logger(ctx).Info(fmt.Sprintf("%s deposit reverification result is %s", chain.Name, poll.GetResult()))
+	keeper.DeletePendingReverification(ctx, req.PollKey)
+
+	if canonical.Value {
+		poll.AllowOverride()
+		return &types.VoteReverifyDepositResponse{
+			Log: fmt.Sprintf("deposit in %s to %s is still part of the canonical chain", req.TxID.Hex(), req.BurnAddress.Hex()),
+		}, nil
+	}
+
+	// the deposit may have been burned while the poll was running, in which case it has to be handled manually as well
+	_, state, ok := keeper.GetDeposit(ctx, common.Hash(req.TxID), common.Address(req.BurnAddress))
+	if !ok {
+		return nil, fmt.Errorf("deposit in %s to %s not found", req.TxID.Hex(), req.BurnAddress.Hex())
+	}
+
+	keeper.DeleteDeposit(ctx, deposit)
+	keeper.SetDeposit(ctx, deposit, types.DepositStatus_Reorged)
+
+	feeRate, ok := keeper.GetTransactionFeeRate(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not retrieve transaction fee rate")
+	}
+
+	// take back whatever is still pending for the recipient, only the rest needs to be handled manually
+	depositAddr := nexus.CrossChainAddress{Address: deposit.BurnerAddress.Hex(), Chain: chain}
+	amount := sdk.NewCoin(deposit.Asset, sdk.NewIntFromBigInt(deposit.Amount.BigInt()))
+	unrecovered, err := s.nexus.RevertTransfer(ctx, depositAddr, amount, feeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	event := sdk.NewEvent(types.EventTypeDepositReverification,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueReorg),
+		sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
+		sdk.NewAttribute(types.AttributeKeyTxID, req.TxID.Hex()),
+		sdk.NewAttribute(types.AttributeKeyAmount, deposit.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyAsset, deposit.Asset),
+		sdk.NewAttribute(types.AttributeKeyDepositAddress, deposit.BurnerAddress.Hex()),
+		sdk.NewAttribute(types.AttributeKeyUnrecoveredAmount, unrecovered.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyPoll, string(types.ModuleCdc.MustMarshalJSON(&req.PollKey))))
+
+	if recipient, ok := s.nexus.GetRecipient(ctx, depositAddr); ok {
+		event = event.AppendAttributes(
+			sdk.NewAttribute(types.AttributeKeyDestinationChain, recipient.Chain.Name),
+			sdk.NewAttribute(types.AttributeKeyDestinationAddress, recipient.Address),
+		)
+	}
+	ctx.EventManager().EmitEvent(event)
+
+	if unrecovered.IsPositive() {
+		s.Logger(ctx).Error(fmt.Sprintf("%s deposit in %s to %s (previously %s) is no longer part of the canonical chain and %s were released already, which requires manual handling",
+			chain.Name, req.TxID.Hex(), req.BurnAddress.Hex(), state.String(), unrecovered.String()))
+	} else {
+		s.Logger(ctx).Info(fmt.Sprintf("%s deposit in %s to %s (previously %s) is no longer part of the canonical chain and its transfer was reverted",
+			chain.Name, req.TxID.Hex(), req.BurnAddress.Hex(), state.String()))
+	}
+
+	return &types.VoteReverifyDepositResponse{
+		Log: fmt.Sprintf("deposit in %s to %s was reorged out of the canonical chain", req.TxID.Hex(), req.BurnAddress.Hex()),
+	}, nil
+}
+
 // VoteConfirmDeposit handles votes for deposit confirmations
 func (s msgServer) VoteConfirmDeposit(c context.Context, req *types.VoteConfirmDepositRequest) (*types.VoteConfirmDepositResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -801,7 +1136,7 @@ func (s msgServer) VoteConfirmDeposit(c context.Context, req *types.VoteConfirmD
 	}
 	event = event.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueConfirm))
 
-	amount := sdk.NewInt64Coin(pendingDeposit.Asset, pendingDeposit.Amount.BigInt().Int64())
+	amount := sdk.NewCoin(pendingDeposit.Asset, sdk.NewIntFromBigInt(pendingDeposit.Amount.BigInt()))
 
 	feeRate, ok := keeper.GetTransactionFeeRate(ctx)
 	if !ok {
@@ -893,10 +1228,36 @@ func (s msgServer) VoteConfirmToken(c context.Context, req *types.VoteConfirmTok
 		}, nil
 	}
 
+	// only the origin chain of an asset defines its precision, so the decimals of any other token cannot be converted without it
+	if _, ok := s.nexus.GetAssetPrecision(ctx, req.Asset); !token.IsExternal() && !ok {
+		token.RejectDeployment()
+		ctx.EventManager().EmitEvent(
+			event.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueReject)))
+		return &types.VoteConfirmTokenResponse{
+			Log: fmt.Sprintf("token %s was discarded because its origin chain has not defined a precision", req.Asset),
+		}, nil
+	}
+
 	ctx.EventManager().EmitEvent(
 		event.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueConfirm)))
 
-	s.nexus.RegisterAsset(ctx, chain, req.Asset)
+	decimals := uint32(token.GetDetails().Decimals)
+	if token.IsExternal() {
+		if err := s.nexus.RegisterNativeAsset(ctx, chain, req.Asset); err != nil {
+			return nil, err
+		}
+
+		// the origin token defines the precision nexus accounts for the asset with
+		if err := s.nexus.SetAssetPrecision(ctx, req.Asset, decimals); err != nil {
+			return nil, err
+		}
+	} else {
+		s.nexus.RegisterAsset(ctx, chain, req.Asset)
+	}
+
+	if err := s.nexus.RegisterAssetDecimals(ctx, chain, req.Asset, decimals); err != nil {
+		return nil, err
+	}
 	token.ConfirmDeployment()
 
 	return &types.VoteConfirmTokenResponse{
@@ -996,7 +1357,7 @@ func (s msgServer) VoteConfirmTransferKey(c context.Context, req *types.VoteConf
 	return &types.VoteConfirmTransferKeyResponse{}, nil
 }
 
-func (s msgServer) CreateDeployToken(c context.Context, req *types.CreateDeployTokenRequest) (*types.CreateDeployTokenResponse, error) {
+func (s msgServer) VoteConfirmBatchExecution(c context.Context, req *types.VoteConfirmBatchExecutionRequest) (*types.VoteConfirmBatchExecutionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	chain, ok := s.nexus.GetChain(ctx, req.Chain)
 	if !ok {
@@ -1007,7 +1368,273 @@ func (s msgServer) CreateDeployToken(c context.Context, req *types.CreateDeployT
 		return nil, err
 	}
 
-	keeper := s.ForChain(req.Chain)
+	voter := s.snapshotter.GetOperator(ctx, req.Sender)
+	if voter == nil {
+		return nil, fmt.Errorf("account %v is not registered as a validator proxy", req.Sender.String())
+	}
+
+	poll := s.voter.GetPoll(ctx, req.PollKey)
+	switch {
+	case poll.Is(vote.Expired):
+		return &types.VoteConfirmBatchExecutionResponse{Log: fmt.Sprintf("vote for poll %s already expired", req.PollKey)}, nil
+	case poll.Is(vote.Failed), poll.Is(vote.Completed):
+		// If the voting threshold has been met and additional votes are received they should not return an error
+		return &types.VoteConfirmBatchExecutionResponse{Log: fmt.Sprintf("vote for poll %s already decided", req.PollKey)}, nil
+	}
+
+	keeper := s.ForChain(chain.Name)
+	pendingExecution, ok := keeper.GetPendingBatchExecution(ctx, req.PollKey)
+	if !ok {
+		return nil, fmt.Errorf("no batch execution found for poll %s", req.PollKey.String())
+	}
+
+	voteValue := &types.ExecutedCommands{CommandIDs: req.ExecutedCommandIDs}
+	if err := poll.Vote(voter, voteValue); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBatchExecutionConfirmation,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueVote),
+		sdk.NewAttribute(types.AttributeKeyValue, strconv.Itoa(len(voteValue.CommandIDs))),
+	))
+
+	if poll.Is(vote.Pending) {
+		return &types.VoteConfirmBatchExecutionResponse{Log: fmt.Sprintf("not enough votes to confirm batch execution in poll %s yet", req.PollKey.String())}, nil
+	}
+
+	if poll.Is(vote.Failed) {
+		keeper.DeletePendingBatchExecution(ctx, req.PollKey)
+		return &types.VoteConfirmBatchExecutionResponse{Log: fmt.Sprintf("poll %s failed", poll.GetKey())}, nil
+	}
+
+	executed, ok := poll.GetResult().(*types.ExecutedCommands)
+	if !ok {
+		return nil, fmt.Errorf("result of poll %s has wrong type, expected executed commands, got %T", req.PollKey.String(), poll.GetResult())
+	}
+
+	keeper.DeletePendingBatchExecution(ctx, req.PollKey)
+
+	batch := keeper.GetBatchByID(ctx, pendingExecution.BatchID)
+	recorded := batch.SetCommandsExecuted(pendingExecution.TxID, ctx.BlockHeight(), executed.CommandIDs...)
+
+	// handle poll result
+	event := sdk.NewEvent(types.EventTypeBatchExecutionConfirmation,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
+		sdk.NewAttribute(types.AttributeKeyTxID, pendingExecution.TxID.Hex()),
+		sdk.NewAttribute(types.AttributeKeyBatchedCommandsID, hex.EncodeToString(pendingExecution.BatchID)),
+		sdk.NewAttribute(types.AttributeKeyPoll, string(types.ModuleCdc.MustMarshalJSON(&req.PollKey))))
+
+	if len(recorded) == 0 {
+		poll.AllowOverride()
+		ctx.EventManager().EmitEvent(
+			event.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueReject)))
+
+		return &types.VoteConfirmBatchExecutionResponse{
+			Log: fmt.Sprintf("no command of batch %s was executed in transaction %s", hex.EncodeToString(pendingExecution.BatchID), pendingExecution.TxID.Hex()),
+		}, nil
+	}
+
+	commandIDStrs := make([]string, len(recorded))
+	for i, commandID := range recorded {
+		commandIDStrs[i] = commandID.Hex()
+	}
+
+	ctx.EventManager().EmitEvent(
+		event.AppendAttributes(
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueConfirm),
+			sdk.NewAttribute(types.AttributeKeyCommandIDs, strings.Join(commandIDStrs, ",")),
+		))
+
+	s.Logger(ctx).Info(fmt.Sprintf("confirmed execution of %d command(s) of batch %s for chain %s", len(recorded), hex.EncodeToString(pendingExecution.BatchID), chain.Name),
+		"txID", pendingExecution.TxID.Hex(), "fully executed", batch.IsExecuted())
+
+	return &types.VoteConfirmBatchExecutionResponse{}, nil
+}
+
+// ConfirmGasInfo handles requests to confirm the gas fees and block gas limit of a chain at a given block
+func (s msgServer) ConfirmGasInfo(c context.Context, req *types.ConfirmGasInfoRequest) (*types.ConfirmGasInfoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chain, ok := s.nexus.GetChain(ctx, req.Chain)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a registered chain", req.Chain)
+	}
+
+	if err := validateChainActivated(ctx, s.nexus, chain); err != nil {
+		return nil, err
+	}
+
+	keeper := s.ForChain(chain.Name)
+
+	if gasInfo, ok := keeper.GetGasInfo(ctx); ok && gasInfo.BlockNumber >= req.BlockNumber {
+		return nil, fmt.Errorf("gas info for chain %s is already confirmed at block %d", chain.Name, gasInfo.BlockNumber)
+	}
+
+	period, ok := keeper.GetRevoteLockingPeriod(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not retrieve revote locking period for chain %s", chain.Name)
+	}
+
+	votingThreshold, ok := keeper.GetVotingThreshold(ctx)
+	if !ok {
+		return nil, fmt.Errorf("voting threshold for chain %s not found", chain.Name)
+	}
+
+	minVoterCount, ok := keeper.GetMinVoterCount(ctx)
+	if !ok {
+		return nil, fmt.Errorf("min voter count for chain %s not found", chain.Name)
+	}
+
+	pollKey := types.GetConfirmGasInfoPollKey(chain, req.BlockNumber)
+	if err := s.voter.InitializePoll(
+		ctx,
+		pollKey,
+		s.nexus.GetChainMaintainers(ctx, chain),
+		vote.ExpiryAt(ctx.BlockHeight()+period),
+		vote.Threshold(votingThreshold),
+		vote.MinVoterCount(minVoterCount),
+		vote.RewardPool(chain.Name),
+	); err != nil {
+		return nil, err
+	}
+
+	keeper.SetPendingGasInfo(ctx, pollKey, req.BlockNumber)
+
+	height, _ := keeper.GetRequiredConfirmationHeight(ctx)
+	finalityMode, _ := keeper.GetFinalityMode(ctx)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeGasInfoConfirmation,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueStart),
+			sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
+			sdk.NewAttribute(types.AttributeKeyBlockNumber, strconv.FormatUint(req.BlockNumber, 10)),
+			sdk.NewAttribute(types.AttributeKeyConfHeight, strconv.FormatUint(height, 10)),
+			sdk.NewAttribute(types.AttributeKeyFinalityMode, finalityMode.String()),
+			sdk.NewAttribute(types.AttributeKeyPoll, string(types.ModuleCdc.MustMarshalJSON(&pollKey))),
+		),
+	)
+
+	return &types.ConfirmGasInfoResponse{}, nil
+}
+
+// VoteConfirmGasInfo handles votes for gas info confirmations
+func (s msgServer) VoteConfirmGasInfo(c context.Context, req *types.VoteConfirmGasInfoRequest) (*types.VoteConfirmGasInfoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chain, ok := s.nexus.GetChain(ctx, req.Chain)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a registered chain", req.Chain)
+	}
+
+	if err := validateChainActivated(ctx, s.nexus, chain); err != nil {
+		return nil, err
+	}
+
+	voter := s.snapshotter.GetOperator(ctx, req.Sender)
+	if voter == nil {
+		return nil, fmt.Errorf("account %v is not registered as a validator proxy", req.Sender.String())
+	}
+
+	poll := s.voter.GetPoll(ctx, req.PollKey)
+	switch {
+	case poll.Is(vote.Expired):
+		return &types.VoteConfirmGasInfoResponse{Log: fmt.Sprintf("vote for poll %s already expired", req.PollKey)}, nil
+	case poll.Is(vote.Failed), poll.Is(vote.Completed):
+		// If the voting threshold has been met and additional votes are received they should not return an error
+		return &types.VoteConfirmGasInfoResponse{Log: fmt.Sprintf("vote for poll %s already decided", req.PollKey)}, nil
+	}
+
+	keeper := s.ForChain(chain.Name)
+	blockNumber, ok := keeper.GetPendingGasInfo(ctx, req.PollKey)
+	if !ok {
+		return nil, fmt.Errorf("no gas info request found for poll %s", req.PollKey.String())
+	}
+
+	if req.GasInfo.BlockNumber != 0 && req.GasInfo.BlockNumber != blockNumber {
+		return nil, fmt.Errorf("gas info for block %d does not match poll %s", req.GasInfo.BlockNumber, req.PollKey.String())
+	}
+
+	voteValue := &req.GasInfo
+	if err := poll.Vote(voter, voteValue); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeGasInfoConfirmation,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueVote),
+		sdk.NewAttribute(types.AttributeKeyValue, strconv.FormatBool(voteValue.BlockNumber != 0)),
+	))
+
+	if poll.Is(vote.Pending) {
+		return &types.VoteConfirmGasInfoResponse{Log: fmt.Sprintf("not enough votes to confirm gas info in poll %s yet", req.PollKey.String())}, nil
+	}
+
+	if poll.Is(vote.Failed) {
+		keeper.DeletePendingGasInfo(ctx, req.PollKey)
+		return &types.VoteConfirmGasInfoResponse{Log: fmt.Sprintf("poll %s failed", poll.GetKey())}, nil
+	}
+
+	gasInfo, ok := poll.GetResult().(*types.GasInfo)
+	if !ok {
+		return nil, fmt.Errorf("result of poll %s has wrong type, expected gas info, got %T", req.PollKey.String(), poll.GetResult())
+	}
+
+	keeper.DeletePendingGasInfo(ctx, req.PollKey)
+
+	// handle poll result
+	event := sdk.NewEvent(types.EventTypeGasInfoConfirmation,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
+		sdk.NewAttribute(types.AttributeKeyBlockNumber, strconv.FormatUint(blockNumber, 10)),
+		sdk.NewAttribute(types.AttributeKeyPoll, string(types.ModuleCdc.MustMarshalJSON(&req.PollKey))))
+
+	if gasInfo.BlockNumber == 0 {
+		poll.AllowOverride()
+		ctx.EventManager().EmitEvent(
+			event.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueReject)))
+
+		return &types.VoteConfirmGasInfoResponse{
+			Log: fmt.Sprintf("gas info of chain %s at block %d was rejected", chain.Name, blockNumber),
+		}, nil
+	}
+
+	ctx.EventManager().EmitEvent(
+		event.AppendAttributes(
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueConfirm),
+			sdk.NewAttribute(types.AttributeKeyBaseFee, gasInfo.BaseFee.String()),
+			sdk.NewAttribute(types.AttributeKeyPriorityFee, gasInfo.PriorityFee.String()),
+		))
+
+	// polls for different blocks may complete out of order, only ever move forward
+	if latest, ok := keeper.GetGasInfo(ctx); ok && latest.BlockNumber >= gasInfo.BlockNumber {
+		return &types.VoteConfirmGasInfoResponse{
+			Log: fmt.Sprintf("gas info of chain %s at block %d is outdated", chain.Name, blockNumber),
+		}, nil
+	}
+
+	gasInfo.ConfirmedAtHeight = ctx.BlockHeight()
+	keeper.SetGasInfo(ctx, *gasInfo)
+
+	s.Logger(ctx).Info(fmt.Sprintf("confirmed gas info of chain %s at block %d", chain.Name, gasInfo.BlockNumber),
+		"baseFee", gasInfo.BaseFee.String(), "priorityFee", gasInfo.PriorityFee.String(), "blockGasLimit", gasInfo.BlockGasLimit)
+
+	return &types.VoteConfirmGasInfoResponse{}, nil
+}
+
+func (s msgServer) CreateDeployToken(c context.Context, req *types.CreateDeployTokenRequest) (*types.CreateDeployTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chain, ok := s.nexus.GetChain(ctx, req.Chain)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a registered chain", req.Chain)
+	}
+
+	if err := validateChainActivated(ctx, s.nexus, chain); err != nil {
+		return nil, err
+	}
+
+	keeper := s.ForChain(req.Chain)
 
 	originChain, found := s.nexus.GetChain(ctx, req.Asset.Chain)
 	if !found {
@@ -1018,6 +1645,11 @@ func (s msgServer) CreateDeployToken(c context.Context, req *types.CreateDeployT
 		return nil, fmt.Errorf("asset %s is not registered on the origin chain %s", originChain.NativeAsset, originChain.Name)
 	}
 
+	// the token's decimals are converted from the precision defined by the origin chain, it is never inferred from the token itself
+	if _, ok := s.nexus.GetAssetPrecision(ctx, req.Asset.Name); !ok {
+		return nil, fmt.Errorf("origin chain %s has not defined a precision for asset %s", originChain.Name, req.Asset.Name)
+	}
+
 	if _, nextMasterKeyAssigned := s.signer.GetNextKeyID(ctx, chain, tss.MasterKey); nextMasterKeyAssigned {
 		return nil, fmt.Errorf("next %s key already assigned for chain %s, rotate key first", tss.MasterKey.SimpleString(), chain.Name)
 	}
@@ -1044,6 +1676,55 @@ func (s msgServer) CreateDeployToken(c context.Context, req *types.CreateDeployT
 	return &types.CreateDeployTokenResponse{}, nil
 }
 
+func (s msgServer) CreateRegisterExternalToken(c context.Context, req *types.CreateRegisterExternalTokenRequest) (*types.CreateRegisterExternalTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chain, ok := s.nexus.GetChain(ctx, req.Chain)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a registered chain", req.Chain)
+	}
+
+	if err := validateChainActivated(ctx, s.nexus, chain); err != nil {
+		return nil, err
+	}
+
+	keeper := s.ForChain(req.Chain)
+
+	// a gateway that cannot lock external tokens would silently skip the command
+	if !isGatewayCommandSupported(ctx, keeper, types.AxelarGatewayCommandRegisterToken) {
+		return nil, fmt.Errorf("gateway of chain %s does not support external tokens", chain.Name)
+	}
+
+	// an external token is the origin of its asset, so the asset cannot be known to any chain already
+	if chains := s.nexus.GetChainsWithAsset(ctx, req.Asset); len(chains) > 0 {
+		return nil, fmt.Errorf("asset %s is already registered for chain %s", req.Asset, chains[0].Name)
+	}
+
+	if _, nextMasterKeyAssigned := s.signer.GetNextKeyID(ctx, chain, tss.MasterKey); nextMasterKeyAssigned {
+		return nil, fmt.Errorf("next %s key already assigned for chain %s, rotate key first", tss.MasterKey.SimpleString(), chain.Name)
+	}
+
+	masterKeyID, ok := s.signer.GetCurrentKeyID(ctx, chain, tss.MasterKey)
+	if !ok {
+		return nil, fmt.Errorf("no master key for chain %s found", chain.Name)
+	}
+
+	token, err := keeper.CreateExternalERC20Token(ctx, req.Asset, req.TokenDetails, req.MinAmount, req.TokenAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to register external token %s(%s) for chain %s", req.TokenDetails.TokenName, req.TokenDetails.Symbol, chain.Name)
+	}
+
+	cmd, err := token.CreateDeployCommand(masterKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := keeper.EnqueueCommand(ctx, cmd); err != nil {
+		return nil, err
+	}
+
+	return &types.CreateRegisterExternalTokenResponse{}, nil
+}
+
 func (s msgServer) CreateBurnTokens(c context.Context, req *types.CreateBurnTokensRequest) (*types.CreateBurnTokensResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	keeper := s.ForChain(req.Chain)
@@ -1076,6 +1757,8 @@ func (s msgServer) CreateBurnTokens(c context.Context, req *types.CreateBurnToke
 		return nil, fmt.Errorf("no %s key for chain %s found", tss.SecondaryKey.SimpleString(), chain.Name)
 	}
 
+	var assets []string
+	burnerInfosByAsset := make(map[string][]types.BurnerInfo)
 	seen := map[string]bool{}
 	for _, deposit := range deposits {
 		keeper.DeleteDeposit(ctx, deposit)
@@ -1092,21 +1775,90 @@ func (s msgServer) CreateBurnTokens(c context.Context, req *types.CreateBurnToke
 			return nil, fmt.Errorf("no burner info found for address %s", burnerAddressHex)
 		}
 
-		cmd, err := types.CreateBurnTokenCommand(chainID, secondaryKeyID, ctx.BlockHeight(), *burnerInfo)
-		if err != nil {
-			return nil, sdkerrors.Wrapf(err, "failed to create burn-token command to burn token at address %s for chain %s", burnerAddressHex, chain.Name)
+		if _, ok := burnerInfosByAsset[burnerInfo.Asset]; !ok {
+			assets = append(assets, burnerInfo.Asset)
 		}
+		burnerInfosByAsset[burnerInfo.Asset] = append(burnerInfosByAsset[burnerInfo.Asset], *burnerInfo)
 
-		if err := keeper.EnqueueCommand(ctx, cmd); err != nil {
-			return nil, err
+		seen[burnerAddressHex] = true
+	}
+
+	// burners of the same asset are swept together, so the number of commands grows with the number of assets rather than deposits
+	for _, asset := range assets {
+		token := keeper.GetERC20TokenByAsset(ctx, asset)
+		burnerInfos := burnerInfosByAsset[asset]
+
+		// the gateway would skip batch commands it does not know while the deposits are already marked as burned,
+		// so burners are swept one by one until the gateway supports batches
+		maxBatchSize := 1
+		if isGatewayCommandSupported(ctx, keeper, getBurnBatchCommand(token, burnerInfos[0])) {
+			maxBatchSize = types.MaxBurnerBatchSize
 		}
 
-		seen[burnerAddressHex] = true
+		for len(burnerInfos) > 0 {
+			batchSize := len(burnerInfos)
+			if batchSize > maxBatchSize {
+				batchSize = maxBatchSize
+			}
+
+			cmd, err := createBurnCommand(chainID, secondaryKeyID, ctx.BlockHeight(), token, burnerInfos[:batchSize])
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to create burn command for asset %s on chain %s", asset, chain.Name)
+			}
+
+			if err := keeper.EnqueueCommand(ctx, cmd); err != nil {
+				return nil, err
+			}
+
+			burnerInfos = burnerInfos[batchSize:]
+		}
 	}
 
 	return &types.CreateBurnTokensResponse{}, nil
 }
 
+// createBurnCommand creates the command that sweeps the given burners of the given token.
+// A single burner is swept with the regular per-burner command
+func createBurnCommand(chainID *big.Int, keyID tss.KeyID, height int64, token types.ERC20Token, burnerInfos []types.BurnerInfo) (types.Command, error) {
+	if len(burnerInfos) == 1 {
+		switch {
+		case burnerInfos[0].IsNative():
+			return types.CreateLockNativeCommand(chainID, keyID, height, burnerInfos[0])
+		case token.IsExternal():
+			return types.CreateLockTokenCommand(chainID, keyID, height, burnerInfos[0])
+		default:
+			return types.CreateBurnTokenCommand(chainID, keyID, height, burnerInfos[0])
+		}
+	}
+
+	switch {
+	case burnerInfos[0].IsNative():
+		return types.CreateLockNativeBatchCommand(chainID, keyID, height, burnerInfos)
+	case token.IsExternal():
+		return types.CreateLockTokenBatchCommand(chainID, keyID, height, burnerInfos)
+	default:
+		return types.CreateBurnTokenBatchCommand(chainID, keyID, height, burnerInfos)
+	}
+}
+
+// getBurnBatchCommand returns the batch command that sweeps burners like the given one
+func getBurnBatchCommand(token types.ERC20Token, burnerInfo types.BurnerInfo) string {
+	switch {
+	case burnerInfo.IsNative():
+		return types.AxelarGatewayCommandLockNativeBatch
+	case token.IsExternal():
+		return types.AxelarGatewayCommandLockTokenBatch
+	default:
+		return types.AxelarGatewayCommandBurnTokenBatch
+	}
+}
+
+// isGatewayCommandSupported returns true if the gateway of the given chain is able to execute the given command
+func isGatewayCommandSupported(ctx sdk.Context, keeper types.ChainKeeper, command string) bool {
+	commands, ok := keeper.GetGatewayCommands(ctx)
+	return ok && utils.IndexOf(commands, command) >= 0
+}
+
 func getMultisigThreshold(keyCount int, threshold utils.Threshold) uint8 {
 	return uint8(
 		sdk.NewDec(int64(keyCount)).
@@ -1236,14 +1988,46 @@ func (s msgServer) CreatePendingTransfers(c context.Context, req *types.CreatePe
 
 	var transfersToArchive []nexus.CrossChainTransfer
 	for _, transfer := range pendingTransfers {
+		// nexus accounts for assets in their canonical precision, so the amount needs to be converted into the token's decimals
+		amount, _, err := s.nexus.ToChainAmount(ctx, chain, transfer.Asset)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to convert amount of transfer %d", transfer.ID)
+		}
+
+		// the chain's native currency is held by the gateway, so it is sent to the recipient directly
+		if transfer.Asset.Denom == chain.NativeAsset {
+			// the gateway would skip the command while the transfer is archived, so the transfer stays pending instead
+			if !isGatewayCommandSupported(ctx, keeper, types.AxelarGatewayCommandUnlockNative) {
+				s.Logger(ctx).Debug(fmt.Sprintf("skipping transfer %d of native asset %s because the gateway of chain %s does not support it", transfer.ID, chain.NativeAsset, chain.Name))
+				continue
+			}
+
+			cmd, err := types.CreateUnlockNativeCommand(secondaryKeyID, types.NewCommandIDFromTransferID(transfer.ID), common.HexToAddress(transfer.Recipient.Address), amount.BigInt())
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed create unlock-native command for transfer %d", transfer.ID)
+			}
+
+			s.Logger(ctx).Info(fmt.Sprintf("storing data for unlock native command %s", cmd.ID.Hex()))
+
+			transfersToArchive = append(transfersToArchive, transfer)
+			if err := keeper.EnqueueCommand(ctx, cmd); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
 		token := keeper.GetERC20TokenByAsset(ctx, transfer.Asset.Denom)
-		if transfer.Asset.Amount.LT(token.GetMinAmount()) {
+
+		if amount.LT(token.GetMinAmount()) {
 			s.Logger(ctx).Debug(fmt.Sprintf("skipping deposit for chain %s from recipient %s due to deposited amount being below "+
 				"minimum amount for token %s (%s)", chain.Name, transfer.Recipient.Address, token.GetDetails().TokenName, token.GetDetails().Symbol))
 			continue
 		}
 
-		cmd, err := token.CreateMintCommand(secondaryKeyID, transfer)
+		scaledTransfer := transfer
+		scaledTransfer.Asset.Amount = amount
+		cmd, err := token.CreateMintCommand(secondaryKeyID, scaledTransfer)
 
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "failed create mint-token command for transfer %d", transfer.ID)
@@ -1276,8 +2060,7 @@ func (s msgServer) createTransferKeyCommand(ctx sdk.Context, keeper types.ChainK
 		return types.Command{}, err
 	}
 
-	chainID, ok := keeper.GetChainID(ctx)
-	if !ok {
+	if _, ok := keeper.GetChainID(ctx); !ok {
 		return types.Command{}, fmt.Errorf("could not find chain ID for '%s'", chainStr)
 	}
 
@@ -1307,12 +2090,22 @@ func (s msgServer) createTransferKeyCommand(ctx sdk.Context, keeper types.ChainK
 		return types.Command{}, err
 	}
 
-	currMasterKeyID, ok := s.signer.GetCurrentKeyID(ctx, chain, tss.MasterKey)
+	return CreateTransferKeyCommand(ctx, keeper, s.signer, chain, transferKeyType, nextKeyID)
+}
+
+// CreateTransferKeyCommand creates the command to transfer the ownership or operatorship of the given chain's gateway to the given key
+func CreateTransferKeyCommand(ctx sdk.Context, keeper types.ChainKeeper, signer types.Signer, chain nexus.Chain, transferKeyType types.TransferKeyType, nextKeyID tss.KeyID) (types.Command, error) {
+	chainID, ok := keeper.GetChainID(ctx)
+	if !ok {
+		return types.Command{}, fmt.Errorf("could not find chain ID for '%s'", chain.Name)
+	}
+
+	currMasterKeyID, ok := signer.GetCurrentKeyID(ctx, chain, tss.MasterKey)
 	if !ok {
 		return types.Command{}, fmt.Errorf("current %s key not set for chain %s", tss.MasterKey, chain.Name)
 	}
 
-	nextKey, ok := s.signer.GetKey(ctx, nextKeyID)
+	nextKey, ok := signer.GetKey(ctx, nextKeyID)
 	if !ok {
 		return types.Command{}, fmt.Errorf("could not find threshold key '%s'", nextKeyID)
 	}
@@ -1325,7 +2118,7 @@ func (s msgServer) createTransferKeyCommand(ctx sdk.Context, keeper types.ChainK
 		}
 
 		address := crypto.PubkeyToAddress(pk)
-		s.Logger(ctx).Debug(fmt.Sprintf("creating command %s for chain %s to transfer to address %s", transferKeyType.SimpleString(), chain.Name, address))
+		keeper.Logger(ctx).Debug(fmt.Sprintf("creating command %s for chain %s to transfer to address %s", transferKeyType.SimpleString(), chain.Name, address))
 
 		return types.CreateSinglesigTransferCommand(transferKeyType, chainID, currMasterKeyID, crypto.PubkeyToAddress(pk))
 	case tss.Multisig:
@@ -1339,7 +2132,7 @@ func (s msgServer) createTransferKeyCommand(ctx sdk.Context, keeper types.ChainK
 			addressStrs[i] = address.Hex()
 		}
 
-		s.Logger(ctx).Debug(fmt.Sprintf("creating command %s for chain %s to transfer to addresses %s", transferKeyType.SimpleString(), chain.Name, strings.Join(addressStrs, ",")))
+		keeper.Logger(ctx).Debug(fmt.Sprintf("creating command %s for chain %s to transfer to addresses %s", transferKeyType.SimpleString(), chain.Name, strings.Join(addressStrs, ",")))
 
 		return types.CreateMultisigTransferCommand(transferKeyType, chainID, currMasterKeyID, threshold, addresses...)
 	default:
@@ -1387,6 +2180,219 @@ func (s msgServer) CreateTransferOperatorship(c context.Context, req *types.Crea
 	return &types.CreateTransferOperatorshipResponse{}, nil
 }
 
+// CreateUpgradeGateway enqueues a command to point the gateway proxy to a new implementation
+func (s msgServer) CreateUpgradeGateway(c context.Context, req *types.CreateUpgradeGatewayRequest) (*types.CreateUpgradeGatewayResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chain, ok := s.nexus.GetChain(ctx, req.Chain)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a registered chain", req.Chain)
+	}
+
+	if err := validateChainActivated(ctx, s.nexus, chain); err != nil {
+		return nil, err
+	}
+
+	keeper := s.ForChain(chain.Name)
+
+	if _, ok := keeper.GetGatewayAddress(ctx); !ok {
+		return nil, fmt.Errorf("axelar gateway address not set")
+	}
+
+	// a gateway that is not upgradable would silently skip the command
+	if !isGatewayCommandSupported(ctx, keeper, types.AxelarGatewayCommandUpgrade) {
+		return nil, fmt.Errorf("gateway of chain %s does not support upgrades", chain.Name)
+	}
+
+	chainID, ok := keeper.GetChainID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not find chain ID for '%s'", chain.Name)
+	}
+
+	currMasterKeyID, ok := s.signer.GetCurrentKeyID(ctx, chain, tss.MasterKey)
+	if !ok {
+		return nil, fmt.Errorf("current %s key not set for chain %s", tss.MasterKey.SimpleString(), chain.Name)
+	}
+
+	cmd, err := types.CreateUpgradeCommand(chainID, currMasterKeyID, common.Address(req.NewImplementation), common.Hash(req.NewImplementationCodeHash), req.SetupParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := keeper.SetPendingGatewayUpgrade(ctx, common.Address(req.NewImplementation), req.NewImplementationCommands); err != nil {
+		return nil, err
+	}
+
+	if err := keeper.EnqueueCommand(ctx, cmd); err != nil {
+		return nil, err
+	}
+
+	s.Logger(ctx).Info(fmt.Sprintf("created command to upgrade the gateway of chain %s to implementation %s", chain.Name, req.NewImplementation.Hex()))
+
+	return &types.CreateUpgradeGatewayResponse{}, nil
+}
+
+// ConfirmGatewayUpgrade handles the confirmation of a gateway upgrade
+func (s msgServer) ConfirmGatewayUpgrade(c context.Context, req *types.ConfirmGatewayUpgradeRequest) (*types.ConfirmGatewayUpgradeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chain, ok := s.nexus.GetChain(ctx, req.Chain)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a registered chain", req.Chain)
+	}
+
+	if err := validateChainActivated(ctx, s.nexus, chain); err != nil {
+		return nil, err
+	}
+
+	keeper := s.ForChain(chain.Name)
+
+	gatewayAddr, ok := keeper.GetGatewayAddress(ctx)
+	if !ok {
+		return nil, fmt.Errorf("axelar gateway address not set")
+	}
+
+	implementation, ok := keeper.GetPendingGatewayUpgrade(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no pending gateway upgrade for chain %s", chain.Name)
+	}
+
+	period, ok := keeper.GetRevoteLockingPeriod(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not retrieve revote locking period for chain %s", chain.Name)
+	}
+
+	votingThreshold, ok := keeper.GetVotingThreshold(ctx)
+	if !ok {
+		return nil, fmt.Errorf("voting threshold for chain %s not found", chain.Name)
+	}
+
+	minVoterCount, ok := keeper.GetMinVoterCount(ctx)
+	if !ok {
+		return nil, fmt.Errorf("min voter count for chain %s not found", chain.Name)
+	}
+
+	pollKey := types.GetConfirmGatewayUpgradePollKey(chain, req.TxID, types.Address(implementation))
+	if err := s.voter.InitializePoll(
+		ctx,
+		pollKey,
+		s.nexus.GetChainMaintainers(ctx, chain),
+		vote.ExpiryAt(ctx.BlockHeight()+period),
+		vote.Threshold(votingThreshold),
+		vote.MinVoterCount(minVoterCount),
+		vote.RewardPool(chain.Name),
+	); err != nil {
+		return nil, err
+	}
+
+	height, _ := keeper.GetRequiredConfirmationHeight(ctx)
+	finalityMode, _ := keeper.GetFinalityMode(ctx)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeGatewayUpgradeConfirmation,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueStart),
+			sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
+			sdk.NewAttribute(types.AttributeKeyTxID, req.TxID.Hex()),
+			sdk.NewAttribute(types.AttributeKeyGatewayAddress, gatewayAddr.Hex()),
+			sdk.NewAttribute(types.AttributeKeyAddress, implementation.Hex()),
+			sdk.NewAttribute(types.AttributeKeyConfHeight, strconv.FormatUint(height, 10)),
+			sdk.NewAttribute(types.AttributeKeyFinalityMode, finalityMode.String()),
+			sdk.NewAttribute(types.AttributeKeyPoll, string(types.ModuleCdc.MustMarshalJSON(&pollKey))),
+		),
+	)
+
+	return &types.ConfirmGatewayUpgradeResponse{}, nil
+}
+
+// VoteConfirmGatewayUpgrade handles votes for gateway upgrade confirmations
+func (s msgServer) VoteConfirmGatewayUpgrade(c context.Context, req *types.VoteConfirmGatewayUpgradeRequest) (*types.VoteConfirmGatewayUpgradeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chain, ok := s.nexus.GetChain(ctx, req.Chain)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a registered chain", req.Chain)
+	}
+
+	if err := validateChainActivated(ctx, s.nexus, chain); err != nil {
+		return nil, err
+	}
+
+	voter := s.snapshotter.GetOperator(ctx, req.Sender)
+	if voter == nil {
+		return nil, fmt.Errorf("account %v is not registered as a validator proxy", req.Sender.String())
+	}
+
+	poll := s.voter.GetPoll(ctx, req.PollKey)
+	switch {
+	case poll.Is(vote.Expired):
+		return &types.VoteConfirmGatewayUpgradeResponse{Log: fmt.Sprintf("vote for poll %s already expired", req.PollKey)}, nil
+	case poll.Is(vote.Failed), poll.Is(vote.Completed):
+		// If the voting threshold has been met and additional votes are received they should not return an error
+		return &types.VoteConfirmGatewayUpgradeResponse{Log: fmt.Sprintf("vote for poll %s already decided", req.PollKey)}, nil
+	default:
+	}
+
+	keeper := s.ForChain(chain.Name)
+	implementation, ok := keeper.GetPendingGatewayUpgrade(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no pending gateway upgrade for chain %s", chain.Name)
+	}
+
+	if pollKey := types.GetConfirmGatewayUpgradePollKey(chain, req.TxID, types.Address(implementation)); pollKey != req.PollKey {
+		return nil, fmt.Errorf("gateway upgrade in %s to implementation %s does not match poll %s", req.TxID.Hex(), implementation.Hex(), req.PollKey.String())
+	}
+
+	voteValue := &gogoprototypes.BoolValue{Value: req.Confirmed}
+	if err := poll.Vote(voter, voteValue); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeGatewayUpgradeConfirmation,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueVote),
+		sdk.NewAttribute(types.AttributeKeyValue, strconv.FormatBool(voteValue.Value)),
+	))
+
+	if poll.Is(vote.Pending) {
+		return &types.VoteConfirmGatewayUpgradeResponse{Log: fmt.Sprintf("not enough votes to confirm gateway upgrade in %s yet", req.TxID.Hex())}, nil
+	}
+
+	if poll.Is(vote.Failed) {
+		return &types.VoteConfirmGatewayUpgradeResponse{Log: fmt.Sprintf("poll %s failed", poll.GetKey())}, nil
+	}
+
+	confirmed, ok := poll.GetResult().(*gogoprototypes.BoolValue)
+	if !ok {
+		return nil, fmt.Errorf("result of poll %s has wrong type, expected bool, got %T", req.PollKey.String(), poll.GetResult())
+	}
+
+	event := sdk.NewEvent(types.EventTypeGatewayUpgradeConfirmation,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
+		sdk.NewAttribute(types.AttributeKeyTxID, req.TxID.Hex()),
+		sdk.NewAttribute(types.AttributeKeyAddress, implementation.Hex()),
+		sdk.NewAttribute(types.AttributeKeyPoll, string(types.ModuleCdc.MustMarshalJSON(&req.PollKey))))
+
+	if !confirmed.Value {
+		poll.AllowOverride()
+		ctx.EventManager().EmitEvent(
+			event.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueReject)))
+
+		return &types.VoteConfirmGatewayUpgradeResponse{
+			Log: fmt.Sprintf("gateway upgrade in %s to implementation %s could not be confirmed", req.TxID.Hex(), implementation.Hex()),
+		}, nil
+	}
+
+	if err := keeper.ConfirmPendingGatewayUpgrade(ctx); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		event.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueConfirm)))
+
+	s.Logger(ctx).Info(fmt.Sprintf("gateway of chain %s upgraded to implementation %s", chain.Name, implementation.Hex()), "txID", req.TxID.Hex())
+
+	return &types.VoteConfirmGatewayUpgradeResponse{}, nil
+}
+
 func getCommandBatchToSign(ctx sdk.Context, keeper types.ChainKeeper) (types.CommandBatch, error) {
 	latest := keeper.GetLatestCommandBatch(ctx)
 
@@ -1400,6 +2406,23 @@ func getCommandBatchToSign(ctx sdk.Context, keeper types.ChainKeeper) (types.Com
 	}
 }
 
+// getSignPriority ranks batches that transfer the gateway to another key above routine batches
+func getSignPriority(ctx sdk.Context, keeper types.ChainKeeper, batch types.CommandBatch) tss.SignPriority {
+	for _, commandID := range batch.GetCommandIDs() {
+		command, ok := keeper.GetCommand(ctx, commandID)
+		if !ok {
+			continue
+		}
+
+		switch command.Command {
+		case types.AxelarGatewayCommandTransferOwnership, types.AxelarGatewayCommandTransferOperatorship:
+			return tss.SignPriority_High
+		}
+	}
+
+	return tss.SignPriority_Normal
+}
+
 func (s msgServer) SignCommands(c context.Context, req *types.SignCommandsRequest) (*types.SignCommandsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	chain, ok := s.nexus.GetChain(ctx, req.Chain)
@@ -1443,6 +2466,8 @@ func (s msgServer) SignCommands(c context.Context, req *types.SignCommandsReques
 		SnapshotCounter: counter,
 		RequestModule:   types.ModuleName,
 		Metadata:        string(types.ModuleCdc.MustMarshalJSON(&sigMetadata)),
+		Priority:        getSignPriority(ctx, keeper, commandBatch),
+		Chain:           chain.Name,
 	}, s.snapshotter, s.voter)
 	if err != nil {
 		return nil, err
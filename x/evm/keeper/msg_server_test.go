@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
 	mathRand "math/rand"
@@ -16,6 +17,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	evmCrypto "github.com/ethereum/go-ethereum/crypto"
+	gogoprototypes "github.com/gogo/protobuf/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
@@ -90,6 +92,12 @@ func TestCreateBurnTokens(t *testing.T) {
 			GetChainIDFunc: func(sdk.Context) (*big.Int, bool) {
 				return big.NewInt(rand.PosI64()), true
 			},
+			GetERC20TokenByAssetFunc: func(ctx sdk.Context, asset string) types.ERC20Token {
+				return types.NilToken
+			},
+			GetGatewayCommandsFunc: func(sdk.Context) ([]string, bool) {
+				return types.DefaultParams()[0].GatewayCommands, true
+			},
 		}
 		evmBaseKeeper = &mock.BaseKeeperMock{
 			ForChainFunc: func(string) types.ChainKeeper {
@@ -206,6 +214,253 @@ func TestCreateBurnTokens(t *testing.T) {
 		}
 	}).Repeat(repeats))
 
+	t.Run("should create lock commands for external tokens", testutils.Func(func(t *testing.T) {
+		setup()
+
+		deposit := types.ERC20Deposit{
+			TxID:             types.Hash(common.HexToHash(rand.HexStr(common.HashLength))),
+			Amount:           sdk.NewUint(uint64(rand.I64Between(1000, 1000000))),
+			Asset:            rand.Str(5),
+			DestinationChain: btc.Bitcoin.Name,
+			BurnerAddress:    types.Address(common.HexToAddress(rand.HexStr(common.AddressLength))),
+		}
+		burnerInfo := types.BurnerInfo{
+			TokenAddress:     types.Address(common.HexToAddress(rand.HexStr(common.AddressLength))),
+			DestinationChain: deposit.DestinationChain,
+			Symbol:           deposit.Asset,
+			Asset:            deposit.Asset,
+			Salt:             types.Hash(common.HexToHash(rand.HexStr(common.HashLength))),
+		}
+
+		evmChainKeeper.GetConfirmedDepositsFunc = func(ctx sdk.Context) []types.ERC20Deposit {
+			return []types.ERC20Deposit{deposit}
+		}
+		evmChainKeeper.GetBurnerInfoFunc = func(ctx sdk.Context, address common.Address) *types.BurnerInfo {
+			return &burnerInfo
+		}
+		evmChainKeeper.GetERC20TokenByAssetFunc = func(ctx sdk.Context, asset string) types.ERC20Token {
+			return types.CreateERC20Token(func(types.ERC20TokenMetadata) {}, types.ERC20TokenMetadata{
+				Asset:        asset,
+				Status:       types.Confirmed,
+				TokenAddress: burnerInfo.TokenAddress,
+				IsExternal:   true,
+			})
+		}
+
+		_, err := server.CreateBurnTokens(sdk.WrapSDKContext(ctx), req)
+
+		assert.NoError(t, err)
+		assert.Len(t, evmChainKeeper.EnqueueCommandCalls(), 1)
+		assert.Equal(t, types.AxelarGatewayCommandLockToken, evmChainKeeper.EnqueueCommandCalls()[0].Cmd.Command)
+	}).Repeat(repeats))
+
+	t.Run("should create lock native commands for native deposits", testutils.Func(func(t *testing.T) {
+		setup()
+
+		deposit := types.ERC20Deposit{
+			TxID:             types.Hash(common.HexToHash(rand.HexStr(common.HashLength))),
+			Amount:           sdk.NewUint(uint64(rand.I64Between(1000, 1000000))),
+			Asset:            exported.Ethereum.NativeAsset,
+			DestinationChain: btc.Bitcoin.Name,
+			BurnerAddress:    types.Address(common.HexToAddress(rand.HexStr(common.AddressLength))),
+		}
+		burnerInfo := types.BurnerInfo{
+			BurnerAddress:    deposit.BurnerAddress,
+			DestinationChain: deposit.DestinationChain,
+			Symbol:           deposit.Asset,
+			Asset:            deposit.Asset,
+			Salt:             types.Hash(common.HexToHash(rand.HexStr(common.HashLength))),
+		}
+
+		evmChainKeeper.GetConfirmedDepositsFunc = func(ctx sdk.Context) []types.ERC20Deposit {
+			return []types.ERC20Deposit{deposit}
+		}
+		evmChainKeeper.GetBurnerInfoFunc = func(ctx sdk.Context, address common.Address) *types.BurnerInfo {
+			return &burnerInfo
+		}
+
+		_, err := server.CreateBurnTokens(sdk.WrapSDKContext(ctx), req)
+
+		assert.NoError(t, err)
+		assert.Len(t, evmChainKeeper.EnqueueCommandCalls(), 1)
+
+		cmd := evmChainKeeper.EnqueueCommandCalls()[0].Cmd
+		assert.Equal(t, types.AxelarGatewayCommandLockNative, cmd.Command)
+		salt, err := types.DecodeLockNativeParams(cmd.Params)
+		assert.NoError(t, err)
+		assert.Equal(t, common.Hash(burnerInfo.Salt), salt)
+	}).Repeat(repeats))
+
+	t.Run("should sweep burners of the same asset in batches", testutils.Func(func(t *testing.T) {
+		setup()
+
+		var deposits []types.ERC20Deposit
+		burnerInfos := make(map[string]types.BurnerInfo)
+		assets := []string{rand.Str(5), exported.Ethereum.NativeAsset}
+		burnerCounts := map[string]int{
+			assets[0]: int(rand.I64Between(types.MaxBurnerBatchSize+1, 3*types.MaxBurnerBatchSize)),
+			assets[1]: int(rand.I64Between(2, types.MaxBurnerBatchSize+1)),
+		}
+		for _, asset := range assets {
+			for i := 0; i < burnerCounts[asset]; i++ {
+				deposit := types.ERC20Deposit{
+					TxID:             types.Hash(common.HexToHash(rand.HexStr(common.HashLength))),
+					Amount:           sdk.NewUint(uint64(rand.I64Between(1000, 1000000))),
+					Asset:            asset,
+					DestinationChain: btc.Bitcoin.Name,
+					BurnerAddress:    types.Address(common.HexToAddress(rand.HexStr(common.AddressLength))),
+				}
+				deposits = append(deposits, deposit)
+
+				burnerInfo := types.BurnerInfo{
+					BurnerAddress:    deposit.BurnerAddress,
+					DestinationChain: deposit.DestinationChain,
+					Symbol:           deposit.Asset,
+					Asset:            deposit.Asset,
+					Salt:             types.Hash(common.HexToHash(rand.HexStr(common.HashLength))),
+				}
+				if asset != exported.Ethereum.NativeAsset {
+					burnerInfo.TokenAddress = types.Address(common.HexToAddress(rand.HexStr(common.AddressLength)))
+				}
+				burnerInfos[deposit.BurnerAddress.Hex()] = burnerInfo
+			}
+		}
+
+		evmChainKeeper.GetConfirmedDepositsFunc = func(ctx sdk.Context) []types.ERC20Deposit {
+			return deposits
+		}
+		evmChainKeeper.GetBurnerInfoFunc = func(ctx sdk.Context, address common.Address) *types.BurnerInfo {
+			if burnerInfo, ok := burnerInfos[address.Hex()]; ok {
+				return &burnerInfo
+			}
+
+			return nil
+		}
+		_, err := server.CreateBurnTokens(sdk.WrapSDKContext(ctx), req)
+
+		assert.NoError(t, err)
+		assert.Len(t, evmChainKeeper.SetDepositCalls(), len(deposits))
+
+		sweptSalts := make(map[common.Hash]bool)
+		for _, call := range evmChainKeeper.EnqueueCommandCalls() {
+			var salts []common.Hash
+			switch call.Cmd.Command {
+			case types.AxelarGatewayCommandBurnTokenBatch:
+				symbol, decoded, err := types.DecodeBurnTokenBatchParams(call.Cmd.Params)
+				assert.NoError(t, err)
+				assert.Equal(t, assets[0], symbol)
+				salts = decoded
+			case types.AxelarGatewayCommandLockNativeBatch:
+				decoded, err := types.DecodeLockNativeBatchParams(call.Cmd.Params)
+				assert.NoError(t, err)
+				salts = decoded
+			case types.AxelarGatewayCommandBurnToken:
+				symbol, salt, err := types.DecodeBurnTokenParams(call.Cmd.Params)
+				assert.NoError(t, err)
+				assert.Equal(t, assets[0], symbol)
+				salts = []common.Hash{salt}
+			default:
+				assert.Failf(t, "unexpected command", call.Cmd.Command)
+			}
+
+			assert.LessOrEqual(t, len(salts), types.MaxBurnerBatchSize)
+			for _, salt := range salts {
+				assert.False(t, sweptSalts[salt])
+				sweptSalts[salt] = true
+			}
+		}
+
+		assert.Len(t, sweptSalts, len(deposits))
+		expectedCommands := (burnerCounts[assets[0]]+types.MaxBurnerBatchSize-1)/types.MaxBurnerBatchSize + 1
+		assert.Len(t, evmChainKeeper.EnqueueCommandCalls(), expectedCommands)
+	}).Repeat(repeats))
+
+	t.Run("should sweep burners in batches with the default params", testutils.Func(func(t *testing.T) {
+		setup()
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+		k := newKeeperWithDefaultParams(ctx)
+		server = keeper.NewMsgServerImpl(k, tssKeeper, nexusKeeper, signerKeeper, voteKeeper, snapshotKeeper)
+
+		burnerCount := int(rand.I64Between(2, types.MaxBurnerBatchSize+1))
+		for i := 0; i < burnerCount; i++ {
+			burnerInfo := types.BurnerInfo{
+				BurnerAddress:    types.Address(common.BytesToAddress(rand.Bytes(common.AddressLength))),
+				DestinationChain: btc.Bitcoin.Name,
+				Symbol:           exported.Ethereum.NativeAsset,
+				Asset:            exported.Ethereum.NativeAsset,
+				Salt:             types.Hash(common.BytesToHash(rand.Bytes(common.HashLength))),
+			}
+			k.ForChain(exported.Ethereum.Name).SetBurnerInfo(ctx, burnerInfo)
+			k.ForChain(exported.Ethereum.Name).SetDeposit(ctx, types.ERC20Deposit{
+				TxID:             types.Hash(common.BytesToHash(rand.Bytes(common.HashLength))),
+				Amount:           sdk.NewUint(uint64(rand.I64Between(1000, 1000000))),
+				Asset:            exported.Ethereum.NativeAsset,
+				DestinationChain: btc.Bitcoin.Name,
+				BurnerAddress:    burnerInfo.BurnerAddress,
+			}, types.DepositStatus_Confirmed)
+		}
+
+		_, err := server.CreateBurnTokens(sdk.WrapSDKContext(ctx), req)
+
+		assert.NoError(t, err)
+		commands := k.ForChain(exported.Ethereum.Name).GetPendingCommands(ctx)
+		assert.Len(t, commands, 1)
+		assert.Equal(t, types.AxelarGatewayCommandLockNativeBatch, commands[0].Command)
+		salts, err := types.DecodeLockNativeBatchParams(commands[0].Params)
+		assert.NoError(t, err)
+		assert.Len(t, salts, burnerCount)
+	}).Repeat(repeats))
+
+	t.Run("should sweep burners one by one if the gateway does not support batches", testutils.Func(func(t *testing.T) {
+		setup()
+
+		asset := rand.Str(5)
+		var deposits []types.ERC20Deposit
+		burnerInfos := make(map[string]types.BurnerInfo)
+		for i := 0; i < int(rand.I64Between(2, 3*types.MaxBurnerBatchSize)); i++ {
+			deposit := types.ERC20Deposit{
+				TxID:             types.Hash(common.HexToHash(rand.HexStr(common.HashLength))),
+				Amount:           sdk.NewUint(uint64(rand.I64Between(1000, 1000000))),
+				Asset:            asset,
+				DestinationChain: btc.Bitcoin.Name,
+				BurnerAddress:    types.Address(common.HexToAddress(rand.HexStr(common.AddressLength))),
+			}
+			deposits = append(deposits, deposit)
+
+			burnerInfos[deposit.BurnerAddress.Hex()] = types.BurnerInfo{
+				BurnerAddress:    deposit.BurnerAddress,
+				TokenAddress:     types.Address(common.HexToAddress(rand.HexStr(common.AddressLength))),
+				DestinationChain: deposit.DestinationChain,
+				Symbol:           deposit.Asset,
+				Asset:            deposit.Asset,
+				Salt:             types.Hash(common.HexToHash(rand.HexStr(common.HashLength))),
+			}
+		}
+
+		evmChainKeeper.GetConfirmedDepositsFunc = func(ctx sdk.Context) []types.ERC20Deposit {
+			return deposits
+		}
+		evmChainKeeper.GetBurnerInfoFunc = func(ctx sdk.Context, address common.Address) *types.BurnerInfo {
+			if burnerInfo, ok := burnerInfos[address.Hex()]; ok {
+				return &burnerInfo
+			}
+
+			return nil
+		}
+
+		evmChainKeeper.GetGatewayCommandsFunc = func(sdk.Context) ([]string, bool) {
+			return gatewayCommandsWithout(types.AxelarGatewayCommandBurnTokenBatch), true
+		}
+
+		_, err := server.CreateBurnTokens(sdk.WrapSDKContext(ctx), req)
+
+		assert.NoError(t, err)
+		assert.Len(t, evmChainKeeper.EnqueueCommandCalls(), len(deposits))
+		for _, call := range evmChainKeeper.EnqueueCommandCalls() {
+			assert.Equal(t, types.AxelarGatewayCommandBurnToken, call.Cmd.Command)
+		}
+	}).Repeat(repeats))
+
 	t.Run("should not burn the same address multiple times", testutils.Func(func(t *testing.T) {
 		setup()
 
@@ -254,80 +509,365 @@ func TestCreateBurnTokens(t *testing.T) {
 	}).Repeat(repeats))
 }
 
-func TestLink_UnknownChain(t *testing.T) {
-	minConfHeight := rand.I64Between(1, 10)
-	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
-	encCfg := app.MakeEncodingConfig()
+func TestCreatePendingTransfers(t *testing.T) {
+	var (
+		evmChainKeeper *mock.ChainKeeperMock
+		nexusKeeper    *mock.NexusMock
+		signerKeeper   *mock.SignerMock
+		server         types.MsgServiceServer
 
-	paramsK := paramsKeeper.NewKeeper(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("subspace"), sdk.NewKVStoreKey("tsubspace"))
-	k := keeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("testKey"), paramsK)
-	k.ForChain(exported.Ethereum.Name).SetParams(ctx, types.Params{
-		Chain:               exported.Ethereum.Name,
-		Network:             network,
-		ConfirmationHeight:  uint64(minConfHeight),
-		GatewayCode:         bytecodes,
-		TokenCode:           tokenBC,
-		Burnable:            burnerBC,
-		RevoteLockingPeriod: 50,
-		VotingThreshold:     utils.Threshold{Numerator: 15, Denominator: 100},
-		MinVoterCount:       15,
-		CommandsGasLimit:    5000000,
-		TransactionFeeRate:  sdk.NewDecWithPrec(25, 5),
-	})
+		ctx            sdk.Context
+		req            *types.CreatePendingTransfersRequest
+		secondaryKeyID tss.KeyID
+	)
 
-	recipient := nexus.CrossChainAddress{Address: "1KDeqnsTRzFeXRaENA6XLN1EwdTujchr4L", Chain: btc.Bitcoin}
-	asset := rand.Str(3)
+	repeats := 20
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+		req = types.NewCreatePendingTransfersRequest(rand.AccAddr(), exported.Ethereum.Name)
+		secondaryKeyID = tssTestUtils.RandKeyID()
 
-	n := &mock.NexusMock{
-		IsChainActivatedFunc: func(ctx sdk.Context, chain nexus.Chain) bool { return true },
-		GetChainFunc:         func(sdk.Context, string) (nexus.Chain, bool) { return nexus.Chain{}, false },
+		evmChainKeeper = &mock.ChainKeeperMock{
+			EnqueueCommandFunc: func(ctx sdk.Context, cmd types.Command) error { return nil },
+			GetERC20TokenByAssetFunc: func(ctx sdk.Context, asset string) types.ERC20Token {
+				return types.NilToken
+			},
+			GetGatewayCommandsFunc: func(sdk.Context) ([]string, bool) {
+				return types.DefaultParams()[0].GatewayCommands, true
+			},
+		}
+		evmBaseKeeper := &mock.BaseKeeperMock{
+			ForChainFunc: func(string) types.ChainKeeper {
+				return evmChainKeeper
+			},
+			LoggerFunc: func(sdk.Context) log.Logger { return log.TestingLogger() },
+		}
+		nexusKeeper = &mock.NexusMock{
+			IsChainActivatedFunc: func(ctx sdk.Context, chain nexus.Chain) bool { return true },
+			GetChainFunc: func(ctx sdk.Context, chain string) (nexus.Chain, bool) {
+				if chain == req.Chain {
+					return exported.Ethereum, true
+				}
+
+				return nexus.Chain{}, false
+			},
+			GetTransfersForChainFunc: func(sdk.Context, nexus.Chain, nexus.TransferState) []nexus.CrossChainTransfer {
+				return nil
+			},
+			ToChainAmountFunc: func(_ sdk.Context, _ nexus.Chain, coin sdk.Coin) (sdk.Int, sdk.Int, error) {
+				return coin.Amount, sdk.ZeroInt(), nil
+			},
+			ArchivePendingTransferFunc: func(sdk.Context, nexus.CrossChainTransfer) {},
+		}
+		signerKeeper = &mock.SignerMock{
+			GetNextKeyIDFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool) {
+				return "", false
+			},
+			GetCurrentKeyIDFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool) {
+				return secondaryKeyID, true
+			},
+		}
+
+		server = keeper.NewMsgServerImpl(evmBaseKeeper, &mock.TSSMock{}, nexusKeeper, signerKeeper, &mock.VoterMock{}, &mock.SnapshotterMock{})
 	}
-	server := keeper.NewMsgServerImpl(k, &mock.TSSMock{}, n, &mock.SignerMock{}, &mock.VoterMock{}, &mock.SnapshotterMock{})
-	_, err := server.Link(sdk.WrapSDKContext(ctx), &types.LinkRequest{Sender: rand.AccAddr(), Chain: evmChain, RecipientAddr: recipient.Address, RecipientChain: recipient.Chain.Name, Asset: asset})
 
-	assert.Error(t, err)
-	assert.Equal(t, 0, len(n.IsAssetRegisteredCalls()))
-	assert.Equal(t, 1, len(n.GetChainCalls()))
-	assert.Equal(t, 0, len(n.LinkAddressesCalls()))
-}
+	t.Run("should do nothing if no pending transfers exist", testutils.Func(func(t *testing.T) {
+		setup()
 
-func TestLink_NoGateway(t *testing.T) {
-	minConfHeight := rand.I64Between(1, 10)
-	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
-	encCfg := app.MakeEncodingConfig()
+		_, err := server.CreatePendingTransfers(sdk.WrapSDKContext(ctx), req)
 
-	paramsK := paramsKeeper.NewKeeper(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("subspace"), sdk.NewKVStoreKey("tsubspace"))
-	k := keeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("testKey"), paramsK)
-	k.ForChain(exported.Ethereum.Name).SetParams(ctx, types.Params{
-		Chain:               exported.Ethereum.Name,
-		Network:             network,
-		ConfirmationHeight:  uint64(minConfHeight),
-		GatewayCode:         bytecodes,
-		TokenCode:           tokenBC,
-		Burnable:            burnerBC,
-		RevoteLockingPeriod: 50,
-		VotingThreshold:     utils.Threshold{Numerator: 15, Denominator: 100},
-		MinVoterCount:       15,
-		CommandsGasLimit:    5000000,
-		TransactionFeeRate:  sdk.NewDecWithPrec(25, 5),
-	})
+		assert.NoError(t, err)
+		assert.Len(t, evmChainKeeper.EnqueueCommandCalls(), 0)
+		assert.Len(t, nexusKeeper.ArchivePendingTransferCalls(), 0)
+	}).Repeat(repeats))
 
-	recipient := nexus.CrossChainAddress{Address: "bcrt1q4reak3gj7xynnuc70gpeut8wxslqczhpsxhd5q8avda6m428hddqgkntss", Chain: btc.Bitcoin}
-	asset := rand.Str(3)
+	t.Run("should create unlock native commands for transfers of the native asset", testutils.Func(func(t *testing.T) {
+		setup()
 
-	chains := map[string]nexus.Chain{exported.Ethereum.Name: exported.Ethereum}
-	n := &mock.NexusMock{
-		IsChainActivatedFunc: func(ctx sdk.Context, chain nexus.Chain) bool { return true },
-		GetChainFunc: func(ctx sdk.Context, chain string) (nexus.Chain, bool) {
-			c, ok := chains[chain]
-			return c, ok
-		},
-	}
-	signer := &mock.SignerMock{
-		GetCurrentKeyIDFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool) {
-			return tssTestUtils.RandKeyID(), true
-		},
-		GetSnapshotCounterForKeyIDFunc: func(sdk.Context, tss.KeyID) (int64, bool) {
+		transfer := nexus.CrossChainTransfer{
+			ID:        uint64(rand.PosI64()),
+			Recipient: nexus.CrossChainAddress{Chain: exported.Ethereum, Address: common.BytesToAddress(rand.Bytes(common.AddressLength)).Hex()},
+			Asset:     sdk.NewCoin(exported.Ethereum.NativeAsset, sdk.NewIntFromBigInt(new(big.Int).Mul(big.NewInt(rand.PosI64()), big.NewInt(1e18)))),
+		}
+		nexusKeeper.GetTransfersForChainFunc = func(sdk.Context, nexus.Chain, nexus.TransferState) []nexus.CrossChainTransfer {
+			return []nexus.CrossChainTransfer{transfer}
+		}
+
+		_, err := server.CreatePendingTransfers(sdk.WrapSDKContext(ctx), req)
+
+		assert.NoError(t, err)
+		assert.Len(t, evmChainKeeper.GetERC20TokenByAssetCalls(), 0)
+		assert.Len(t, evmChainKeeper.EnqueueCommandCalls(), 1)
+		assert.Len(t, nexusKeeper.ArchivePendingTransferCalls(), 1)
+
+		cmd := evmChainKeeper.EnqueueCommandCalls()[0].Cmd
+		assert.Equal(t, types.AxelarGatewayCommandUnlockNative, cmd.Command)
+		assert.Equal(t, types.NewCommandIDFromTransferID(transfer.ID), cmd.ID)
+		assert.Equal(t, secondaryKeyID, cmd.KeyID)
+
+		recipient, amount, err := types.DecodeUnlockNativeParams(cmd.Params)
+		assert.NoError(t, err)
+		assert.Equal(t, common.HexToAddress(transfer.Recipient.Address), recipient)
+		assert.Equal(t, transfer.Asset.Amount.BigInt(), amount)
+	}).Repeat(repeats))
+
+	t.Run("should create unlock native commands with the default params", testutils.Func(func(t *testing.T) {
+		setup()
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+		k := newKeeperWithDefaultParams(ctx)
+		server = keeper.NewMsgServerImpl(k, &mock.TSSMock{}, nexusKeeper, signerKeeper, &mock.VoterMock{}, &mock.SnapshotterMock{})
+
+		nexusKeeper.GetTransfersForChainFunc = func(sdk.Context, nexus.Chain, nexus.TransferState) []nexus.CrossChainTransfer {
+			return []nexus.CrossChainTransfer{{
+				ID:        uint64(rand.PosI64()),
+				Recipient: nexus.CrossChainAddress{Chain: exported.Ethereum, Address: common.BytesToAddress(rand.Bytes(common.AddressLength)).Hex()},
+				Asset:     sdk.NewInt64Coin(exported.Ethereum.NativeAsset, rand.PosI64()),
+			}}
+		}
+
+		_, err := server.CreatePendingTransfers(sdk.WrapSDKContext(ctx), req)
+
+		assert.NoError(t, err)
+		assert.Len(t, nexusKeeper.ArchivePendingTransferCalls(), 1)
+		commands := k.ForChain(exported.Ethereum.Name).GetPendingCommands(ctx)
+		assert.Len(t, commands, 1)
+		assert.Equal(t, types.AxelarGatewayCommandUnlockNative, commands[0].Command)
+	}).Repeat(repeats))
+
+	t.Run("should keep transfers of the native asset pending if the gateway does not support them", testutils.Func(func(t *testing.T) {
+		setup()
+
+		evmChainKeeper.GetGatewayCommandsFunc = func(sdk.Context) ([]string, bool) {
+			return gatewayCommandsWithout(types.AxelarGatewayCommandUnlockNative), true
+		}
+		nexusKeeper.GetTransfersForChainFunc = func(sdk.Context, nexus.Chain, nexus.TransferState) []nexus.CrossChainTransfer {
+			return []nexus.CrossChainTransfer{{
+				ID:        uint64(rand.PosI64()),
+				Recipient: nexus.CrossChainAddress{Chain: exported.Ethereum, Address: common.BytesToAddress(rand.Bytes(common.AddressLength)).Hex()},
+				Asset:     sdk.NewInt64Coin(exported.Ethereum.NativeAsset, rand.PosI64()),
+			}}
+		}
+
+		_, err := server.CreatePendingTransfers(sdk.WrapSDKContext(ctx), req)
+
+		assert.NoError(t, err)
+		assert.Len(t, evmChainKeeper.EnqueueCommandCalls(), 0)
+		assert.Len(t, nexusKeeper.ArchivePendingTransferCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should return error if the next secondary key is assigned", testutils.Func(func(t *testing.T) {
+		setup()
+
+		nexusKeeper.GetTransfersForChainFunc = func(sdk.Context, nexus.Chain, nexus.TransferState) []nexus.CrossChainTransfer {
+			return []nexus.CrossChainTransfer{{}}
+		}
+		signerKeeper.GetNextKeyIDFunc = func(sdk.Context, nexus.Chain, tss.KeyRole) (tss.KeyID, bool) {
+			return tssTestUtils.RandKeyID(), true
+		}
+
+		_, err := server.CreatePendingTransfers(sdk.WrapSDKContext(ctx), req)
+
+		assert.Error(t, err)
+		assert.Len(t, evmChainKeeper.EnqueueCommandCalls(), 0)
+	}).Repeat(repeats))
+}
+
+func TestLink_NativeAsset(t *testing.T) {
+	ctx := rand.Context(fake.NewMultiStore())
+	chain := "Ethereum"
+	k := newKeeperWithDefaultParams(ctx)
+
+	k.ForChain(chain).SetPendingGateway(ctx, common.HexToAddress(gateway))
+	k.ForChain(chain).ConfirmPendingGateway(ctx)
+
+	recipient := nexus.CrossChainAddress{Address: "1KDeqnsTRzFeXRaENA6XLN1EwdTujchr4L", Chain: btc.Bitcoin}
+	burnAddr, salt := k.ForChain(chain).GetNativeBurnerAddressAndSalt(ctx, recipient.Address, common.HexToAddress(gateway))
+	sender := nexus.CrossChainAddress{Address: burnAddr.String(), Chain: exported.Ethereum}
+
+	chains := map[string]nexus.Chain{btc.Bitcoin.Name: btc.Bitcoin, exported.Ethereum.Name: exported.Ethereum}
+	n := &mock.NexusMock{
+		IsChainActivatedFunc: func(ctx sdk.Context, chain nexus.Chain) bool { return true },
+		LinkAddressesFunc:    func(ctx sdk.Context, s nexus.CrossChainAddress, r nexus.CrossChainAddress) error { return nil },
+		GetChainFunc: func(ctx sdk.Context, chain string) (nexus.Chain, bool) {
+			c, ok := chains[chain]
+			return c, ok
+		},
+		IsAssetRegisteredFunc: func(sdk.Context, nexus.Chain, string) bool { return true },
+	}
+	signer := &mock.SignerMock{
+		GetCurrentKeyIDFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool) {
+			return tssTestUtils.RandKeyID(), true
+		},
+		GetSnapshotCounterForKeyIDFunc: func(sdk.Context, tss.KeyID) (int64, bool) {
+			return rand.PosI64(), true
+		},
+	}
+	server := keeper.NewMsgServerImpl(k, &mock.TSSMock{}, n, signer, &mock.VoterMock{}, &mock.SnapshotterMock{})
+	_, err := server.Link(sdk.WrapSDKContext(ctx), &types.LinkRequest{Sender: rand.AccAddr(), Chain: evmChain, RecipientAddr: recipient.Address, RecipientChain: recipient.Chain.Name, Asset: exported.Ethereum.NativeAsset})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(n.LinkAddressesCalls()))
+	assert.Equal(t, sender, n.LinkAddressesCalls()[0].Sender)
+	assert.Equal(t, recipient, n.LinkAddressesCalls()[0].Recipient)
+
+	expected := types.BurnerInfo{BurnerAddress: types.Address(burnAddr), DestinationChain: recipient.Chain.Name, Symbol: exported.Ethereum.NativeAsset, Asset: exported.Ethereum.NativeAsset, Salt: types.Hash(salt)}
+	actual := *k.ForChain(chain).GetBurnerInfo(ctx, burnAddr)
+	assert.Equal(t, expected, actual)
+	assert.True(t, actual.IsNative())
+}
+
+func TestLink_NativeAssetNotSupportedByGateway(t *testing.T) {
+	ctx := rand.Context(fake.NewMultiStore())
+	chain := "Ethereum"
+	k := newKeeper(ctx, chain, rand.I64Between(1, 10))
+	params := k.ForChain(chain).GetParams(ctx)
+	params.GatewayCommands = gatewayCommandsWithout(types.AxelarGatewayCommandLockNative)
+	k.ForChain(chain).SetParams(ctx, params)
+
+	chains := map[string]nexus.Chain{btc.Bitcoin.Name: btc.Bitcoin, exported.Ethereum.Name: exported.Ethereum}
+	n := &mock.NexusMock{
+		IsChainActivatedFunc: func(ctx sdk.Context, chain nexus.Chain) bool { return true },
+		LinkAddressesFunc:    func(ctx sdk.Context, s nexus.CrossChainAddress, r nexus.CrossChainAddress) error { return nil },
+		GetChainFunc: func(ctx sdk.Context, chain string) (nexus.Chain, bool) {
+			c, ok := chains[chain]
+			return c, ok
+		},
+		IsAssetRegisteredFunc: func(sdk.Context, nexus.Chain, string) bool { return true },
+	}
+	server := keeper.NewMsgServerImpl(k, &mock.TSSMock{}, n, &mock.SignerMock{}, &mock.VoterMock{}, &mock.SnapshotterMock{})
+	_, err := server.Link(sdk.WrapSDKContext(ctx), &types.LinkRequest{Sender: rand.AccAddr(), Chain: evmChain, RecipientAddr: "1KDeqnsTRzFeXRaENA6XLN1EwdTujchr4L", RecipientChain: btc.Bitcoin.Name, Asset: exported.Ethereum.NativeAsset})
+
+	assert.Error(t, err)
+	assert.Len(t, n.LinkAddressesCalls(), 0)
+}
+
+func TestLink_ReuseBurnerForRecipient(t *testing.T) {
+	minConfHeight := rand.I64Between(1, 10)
+	ctx := rand.Context(fake.NewMultiStore())
+	chain := "Ethereum"
+	k := newKeeper(ctx, chain, minConfHeight)
+
+	k.ForChain(chain).SetPendingGateway(ctx, common.HexToAddress(gateway))
+	k.ForChain(chain).ConfirmPendingGateway(ctx)
+
+	chains := map[string]nexus.Chain{btc.Bitcoin.Name: btc.Bitcoin, exported.Ethereum.Name: exported.Ethereum}
+	n := &mock.NexusMock{
+		IsChainActivatedFunc: func(ctx sdk.Context, chain nexus.Chain) bool { return true },
+		LinkAddressesFunc:    func(ctx sdk.Context, s nexus.CrossChainAddress, r nexus.CrossChainAddress) error { return nil },
+		GetChainFunc: func(ctx sdk.Context, chain string) (nexus.Chain, bool) {
+			c, ok := chains[chain]
+			return c, ok
+		},
+		IsAssetRegisteredFunc: func(sdk.Context, nexus.Chain, string) bool { return true },
+	}
+	server := keeper.NewMsgServerImpl(k, &mock.TSSMock{}, n, &mock.SignerMock{}, &mock.VoterMock{}, &mock.SnapshotterMock{})
+
+	link := func(ctx sdk.Context, recipientAddr string) string {
+		res, err := server.Link(sdk.WrapSDKContext(ctx), &types.LinkRequest{Sender: rand.AccAddr(), Chain: evmChain, RecipientAddr: recipientAddr, RecipientChain: btc.Bitcoin.Name, Asset: exported.Ethereum.NativeAsset})
+		assert.NoError(t, err)
+		return res.DepositAddr
+	}
+
+	recipientAddr := "1KDeqnsTRzFeXRaENA6XLN1EwdTujchr4L"
+	depositAddr := link(ctx, recipientAddr)
+	// a different block results in a different salt for new burners
+	laterCtx := ctx.WithHeaderHash(rand.Bytes(32))
+	newBurnerAddr, _ := k.ForChain(chain).GetNativeBurnerAddressAndSalt(laterCtx, recipientAddr, common.HexToAddress(gateway))
+	reusedDepositAddr := link(laterCtx, recipientAddr)
+	otherDepositAddr := link(ctx.WithHeaderHash(rand.Bytes(32)), "bcrt1q4reak3gj7xynnuc70gpeut8wxslqczhpsxhd5q8avda6m428hddqgkntss")
+
+	assert.NotEqual(t, depositAddr, newBurnerAddr.Hex())
+	assert.Equal(t, depositAddr, reusedDepositAddr)
+	assert.NotEqual(t, depositAddr, otherDepositAddr)
+	assert.Len(t, n.LinkAddressesCalls(), 3)
+
+	burnerAddr, ok := k.ForChain(chain).GetBurnerAddressForRecipient(ctx, exported.Ethereum.NativeAsset, nexus.CrossChainAddress{Chain: btc.Bitcoin, Address: recipientAddr})
+	assert.True(t, ok)
+	assert.Equal(t, depositAddr, burnerAddr.Hex())
+}
+
+func TestLink_UnknownChain(t *testing.T) {
+	minConfHeight := rand.I64Between(1, 10)
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	encCfg := app.MakeEncodingConfig()
+
+	paramsK := paramsKeeper.NewKeeper(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("subspace"), sdk.NewKVStoreKey("tsubspace"))
+	k := keeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("testKey"), paramsK)
+	k.ForChain(exported.Ethereum.Name).SetParams(ctx, types.Params{
+		Chain:               exported.Ethereum.Name,
+		Network:             network,
+		ConfirmationHeight:  uint64(minConfHeight),
+		GatewayCode:         bytecodes,
+		TokenCode:           tokenBC,
+		Burnable:            burnerBC,
+		RevoteLockingPeriod: 50,
+		VotingThreshold:     utils.Threshold{Numerator: 15, Denominator: 100},
+		MinVoterCount:       15,
+		CommandsGasLimit:    5000000,
+		TransactionFeeRate:  sdk.NewDecWithPrec(25, 5),
+
+		CommandBatchExecutionTimeout: 100,
+		GasInfoMaxAge:                100,
+		FinalityMode:                 types.FinalityDepth,
+	})
+
+	recipient := nexus.CrossChainAddress{Address: "1KDeqnsTRzFeXRaENA6XLN1EwdTujchr4L", Chain: btc.Bitcoin}
+	asset := rand.Str(3)
+
+	n := &mock.NexusMock{
+		IsChainActivatedFunc: func(ctx sdk.Context, chain nexus.Chain) bool { return true },
+		GetChainFunc:         func(sdk.Context, string) (nexus.Chain, bool) { return nexus.Chain{}, false },
+	}
+	server := keeper.NewMsgServerImpl(k, &mock.TSSMock{}, n, &mock.SignerMock{}, &mock.VoterMock{}, &mock.SnapshotterMock{})
+	_, err := server.Link(sdk.WrapSDKContext(ctx), &types.LinkRequest{Sender: rand.AccAddr(), Chain: evmChain, RecipientAddr: recipient.Address, RecipientChain: recipient.Chain.Name, Asset: asset})
+
+	assert.Error(t, err)
+	assert.Equal(t, 0, len(n.IsAssetRegisteredCalls()))
+	assert.Equal(t, 1, len(n.GetChainCalls()))
+	assert.Equal(t, 0, len(n.LinkAddressesCalls()))
+}
+
+func TestLink_NoGateway(t *testing.T) {
+	minConfHeight := rand.I64Between(1, 10)
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	encCfg := app.MakeEncodingConfig()
+
+	paramsK := paramsKeeper.NewKeeper(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("subspace"), sdk.NewKVStoreKey("tsubspace"))
+	k := keeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("testKey"), paramsK)
+	k.ForChain(exported.Ethereum.Name).SetParams(ctx, types.Params{
+		Chain:               exported.Ethereum.Name,
+		Network:             network,
+		ConfirmationHeight:  uint64(minConfHeight),
+		GatewayCode:         bytecodes,
+		TokenCode:           tokenBC,
+		Burnable:            burnerBC,
+		RevoteLockingPeriod: 50,
+		VotingThreshold:     utils.Threshold{Numerator: 15, Denominator: 100},
+		MinVoterCount:       15,
+		CommandsGasLimit:    5000000,
+		TransactionFeeRate:  sdk.NewDecWithPrec(25, 5),
+
+		CommandBatchExecutionTimeout: 100,
+		GasInfoMaxAge:                100,
+		FinalityMode:                 types.FinalityDepth,
+	})
+
+	recipient := nexus.CrossChainAddress{Address: "bcrt1q4reak3gj7xynnuc70gpeut8wxslqczhpsxhd5q8avda6m428hddqgkntss", Chain: btc.Bitcoin}
+	asset := rand.Str(3)
+
+	chains := map[string]nexus.Chain{exported.Ethereum.Name: exported.Ethereum}
+	n := &mock.NexusMock{
+		IsChainActivatedFunc: func(ctx sdk.Context, chain nexus.Chain) bool { return true },
+		GetChainFunc: func(ctx sdk.Context, chain string) (nexus.Chain, bool) {
+			c, ok := chains[chain]
+			return c, ok
+		},
+	}
+	signer := &mock.SignerMock{
+		GetCurrentKeyIDFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool) {
+			return tssTestUtils.RandKeyID(), true
+		},
+		GetSnapshotCounterForKeyIDFunc: func(sdk.Context, tss.KeyID) (int64, bool) {
 			return rand.PosI64(), true
 		},
 	}
@@ -781,6 +1321,7 @@ func TestHandleMsgConfirmTokenDeploy(t *testing.T) {
 			},
 			GetRevoteLockingPeriodFunc:        func(sdk.Context) (int64, bool) { return rand.PosI64(), true },
 			GetRequiredConfirmationHeightFunc: func(sdk.Context) (uint64, bool) { return mathRand.Uint64(), true },
+			GetFinalityModeFunc:               func(sdk.Context) (types.FinalityMode, bool) { return types.FinalityDepth, true },
 			GetERC20TokenByAssetFunc: func(ctx sdk.Context, asset string) types.ERC20Token {
 				if asset == msg.Asset.Name {
 					return token
@@ -886,6 +1427,53 @@ func TestHandleMsgConfirmTokenDeploy(t *testing.T) {
 		}), 1)
 	}).Repeat(repeats))
 
+	// confirmTokenDeployment records the token deployment and makes the next vote complete its confirmation poll
+	confirmTokenDeployment := func() {
+		hash := types.Hash(common.BytesToHash(rand.Bytes(common.HashLength)))
+		if err := token.RecordDeployment(hash); err != nil {
+			panic(err)
+		}
+		voteReq.Asset = btc.Bitcoin.NativeAsset
+		voteReq.PollKey = types.GetConfirmTokenKey(hash, btc.Bitcoin.NativeAsset)
+		v.GetPollFunc = func(sdk.Context, vote.PollKey) vote.Poll {
+			return &voteMock.PollMock{
+				VoteFunc:      func(sdk.ValAddress, codec.ProtoMarshaler) error { return nil },
+				IsFunc:        func(vote.PollState) bool { return false },
+				GetResultFunc: func() codec.ProtoMarshaler { return &gogoprototypes.BoolValue{Value: true} },
+			}
+		}
+		basek.LoggerFunc = func(sdk.Context) log.Logger { return log.TestingLogger() }
+		n.RegisterAssetFunc = func(sdk.Context, nexus.Chain, string) {}
+		n.SetAssetPrecisionFunc = func(sdk.Context, string, uint32) error { return nil }
+		n.RegisterAssetDecimalsFunc = func(sdk.Context, nexus.Chain, string, uint32) error { return nil }
+	}
+
+	t.Run("should discard the token if the origin chain did not define a precision", testutils.Func(func(t *testing.T) {
+		setup()
+		confirmTokenDeployment()
+		n.GetAssetPrecisionFunc = func(sdk.Context, string) (uint32, bool) { return 0, false }
+
+		_, err := server.VoteConfirmToken(sdk.WrapSDKContext(ctx), voteReq)
+
+		assert.NoError(t, err)
+		assert.True(t, token.Is(types.Initialized))
+		assert.Len(t, n.RegisterAssetCalls(), 0)
+		assert.Len(t, n.SetAssetPrecisionCalls(), 0)
+		assert.Len(t, n.RegisterAssetDecimalsCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should keep the asset precision defined by the origin chain", testutils.Func(func(t *testing.T) {
+		setup()
+		confirmTokenDeployment()
+		n.GetAssetPrecisionFunc = func(sdk.Context, string) (uint32, bool) { return 8, true }
+
+		_, err := server.VoteConfirmToken(sdk.WrapSDKContext(ctx), voteReq)
+
+		assert.NoError(t, err)
+		assert.Len(t, n.SetAssetPrecisionCalls(), 0)
+		assert.Len(t, n.RegisterAssetDecimalsCalls(), 1)
+	}).Repeat(repeats))
+
 	t.Run("unknown chain", testutils.Func(func(t *testing.T) {
 		setup()
 		msg.Chain = rand.StrBetween(5, 20)
@@ -929,40 +1517,365 @@ func TestHandleMsgConfirmTokenDeploy(t *testing.T) {
 	}).Repeat(repeats))
 }
 
-func TestAddChain(t *testing.T) {
+func TestHandleMsgConfirmGasInfo(t *testing.T) {
 	var (
-		ctx         sdk.Context
-		basek       *mock.BaseKeeperMock
-		tssMock     *mock.TSSMock
-		n           *mock.NexusMock
-		msg         *types.AddChainRequest
-		server      types.MsgServiceServer
-		name        string
-		nativeAsset string
+		ctx       sdk.Context
+		chaink    *mock.ChainKeeperMock
+		v         *mock.VoterMock
+		poll      *voteMock.PollMock
+		server    types.MsgServiceServer
+		msg       *types.ConfirmGasInfoRequest
+		voteReq   *types.VoteConfirmGasInfoRequest
+		stored    *types.GasInfo
+		gasResult types.GasInfo
 	)
 
 	setup := func() {
-		ctx = sdk.NewContext(nil, tmproto.Header{}, false, log.TestingLogger())
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.I64Between(1, 1000000)}, false, log.TestingLogger())
+		stored = nil
+
+		msg = types.NewConfirmGasInfoRequest(rand.AccAddr(), evmChain, uint64(rand.I64Between(1000, 1000000)))
+		gasResult = types.GasInfo{
+			BlockNumber:   msg.BlockNumber,
+			BaseFee:       sdk.NewInt(rand.I64Between(1, 1000000000)),
+			PriorityFee:   sdk.NewInt(rand.I64Between(1, 1000000000)),
+			BlockGasLimit: uint64(rand.I64Between(1000000, 30000000)),
+		}
+		voteReq = types.NewVoteConfirmGasInfoRequest(rand.AccAddr(), evmChain, types.GetConfirmGasInfoPollKey(exported.Ethereum, msg.BlockNumber), gasResult)
 
-		chains := map[string]nexus.Chain{
-			exported.Ethereum.Name: exported.Ethereum,
-			btc.Bitcoin.Name:       btc.Bitcoin,
+		chaink = &mock.ChainKeeperMock{
+			GetVotingThresholdFunc: func(sdk.Context) (utils.Threshold, bool) {
+				return utils.Threshold{Numerator: 15, Denominator: 100}, true
+			},
+			GetMinVoterCountFunc:              func(sdk.Context) (int64, bool) { return 15, true },
+			GetRevoteLockingPeriodFunc:        func(sdk.Context) (int64, bool) { return rand.PosI64(), true },
+			GetRequiredConfirmationHeightFunc: func(sdk.Context) (uint64, bool) { return mathRand.Uint64(), true },
+			GetFinalityModeFunc:               func(sdk.Context) (types.FinalityMode, bool) { return types.FinalityDepth, true },
+			GetGasInfoFunc: func(sdk.Context) (types.GasInfo, bool) {
+				if stored == nil {
+					return types.GasInfo{}, false
+				}
+				return *stored, true
+			},
+			SetGasInfoFunc:           func(_ sdk.Context, gasInfo types.GasInfo) { stored = &gasInfo },
+			SetPendingGasInfoFunc:    func(sdk.Context, vote.PollKey, uint64) {},
+			GetPendingGasInfoFunc:    func(sdk.Context, vote.PollKey) (uint64, bool) { return msg.BlockNumber, true },
+			DeletePendingGasInfoFunc: func(sdk.Context, vote.PollKey) {},
 		}
-		basek = &mock.BaseKeeperMock{
-			SetPendingChainFunc: func(sdk.Context, nexus.Chain, types.Params) {},
+		basek := &mock.BaseKeeperMock{
+			ForChainFunc: func(chain string) types.ChainKeeper {
+				if strings.EqualFold(chain, evmChain) {
+					return chaink
+				}
+				return nil
+			},
+			LoggerFunc: func(sdk.Context) log.Logger { return log.TestingLogger() },
 		}
-
-		tssMock = &mock.TSSMock{}
-
-		n = &mock.NexusMock{
-			IsChainActivatedFunc: func(ctx sdk.Context, chain nexus.Chain) bool { return true },
-			GetChainFunc: func(ctx sdk.Context, chain string) (nexus.Chain, bool) {
-				c, ok := chains[chain]
-				return c, ok
+		poll = &voteMock.PollMock{
+			VoteFunc: func(sdk.ValAddress, codec.ProtoMarshaler) error { return nil },
+			// the poll completes with the first vote
+			IsFunc: func(state vote.PollState) bool {
+				if len(poll.VoteCalls()) == 0 {
+					return state == vote.Pending
+				}
+				return state == vote.Completed
 			},
+			GetResultFunc:     func() codec.ProtoMarshaler { return &gasResult },
+			AllowOverrideFunc: func() {},
 		}
-
-		name = rand.StrBetween(5, 20)
+		v = &mock.VoterMock{
+			InitializePollFunc: func(sdk.Context, vote.PollKey, []sdk.ValAddress, ...vote.PollProperty) error { return nil },
+			GetPollFunc:        func(sdk.Context, vote.PollKey) vote.Poll { return poll },
+		}
+		chains := map[string]nexus.Chain{exported.Ethereum.Name: exported.Ethereum}
+		n := &mock.NexusMock{
+			GetChainMaintainersFunc: func(sdk.Context, nexus.Chain) []sdk.ValAddress { return []sdk.ValAddress{} },
+			IsChainActivatedFunc:    func(sdk.Context, nexus.Chain) bool { return true },
+			GetChainFunc: func(_ sdk.Context, chain string) (nexus.Chain, bool) {
+				c, ok := chains[chain]
+				return c, ok
+			},
+		}
+
+		server = keeper.NewMsgServerImpl(basek, &mock.TSSMock{}, n, &mock.SignerMock{}, v, &mock.SnapshotterMock{
+			GetOperatorFunc: func(sdk.Context, sdk.AccAddress) sdk.ValAddress { return rand.ValAddr() },
+		})
+	}
+
+	repeats := 20
+	t.Run("should start a poll for the gas info", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := server.ConfirmGasInfo(sdk.WrapSDKContext(ctx), msg)
+
+		assert.NoError(t, err)
+		assert.Len(t, testutils.Events(ctx.EventManager().ABCIEvents()).Filter(func(event abci.Event) bool { return event.Type == types.EventTypeGasInfoConfirmation }), 1)
+		assert.Equal(t, types.GetConfirmGasInfoPollKey(exported.Ethereum, msg.BlockNumber), v.InitializePollCalls()[0].Key)
+		assert.Len(t, chaink.SetPendingGasInfoCalls(), 1)
+	}).Repeat(repeats))
+
+	t.Run("should return error when gas info of a later block is already confirmed", testutils.Func(func(t *testing.T) {
+		setup()
+		stored = &types.GasInfo{BlockNumber: msg.BlockNumber + uint64(rand.I64Between(0, 100))}
+
+		_, err := server.ConfirmGasInfo(sdk.WrapSDKContext(ctx), msg)
+
+		assert.Error(t, err)
+		assert.Len(t, v.InitializePollCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should store confirmed gas info", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := server.VoteConfirmGasInfo(sdk.WrapSDKContext(ctx), voteReq)
+
+		assert.NoError(t, err)
+		assert.Len(t, chaink.DeletePendingGasInfoCalls(), 1)
+		assert.NotNil(t, stored)
+		assert.Equal(t, gasResult.BlockNumber, stored.BlockNumber)
+		assert.Equal(t, gasResult.BaseFee, stored.BaseFee)
+		assert.Equal(t, gasResult.PriorityFee, stored.PriorityFee)
+		assert.Equal(t, ctx.BlockHeight(), stored.ConfirmedAtHeight)
+	}).Repeat(repeats))
+
+	t.Run("should not overwrite gas info of a later block", testutils.Func(func(t *testing.T) {
+		setup()
+		later := types.GasInfo{BlockNumber: msg.BlockNumber + 1}
+		stored = &later
+
+		_, err := server.VoteConfirmGasInfo(sdk.WrapSDKContext(ctx), voteReq)
+
+		assert.NoError(t, err)
+		assert.Len(t, chaink.SetGasInfoCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should reject when the gas info could not be observed", testutils.Func(func(t *testing.T) {
+		setup()
+		gasResult = types.GasInfo{BaseFee: sdk.ZeroInt(), PriorityFee: sdk.ZeroInt()}
+		voteReq.GasInfo = gasResult
+
+		_, err := server.VoteConfirmGasInfo(sdk.WrapSDKContext(ctx), voteReq)
+
+		assert.NoError(t, err)
+		assert.Len(t, chaink.SetGasInfoCalls(), 0)
+		assert.Len(t, poll.AllowOverrideCalls(), 1)
+	}).Repeat(repeats))
+
+	t.Run("should return error when the voted block does not match the poll", testutils.Func(func(t *testing.T) {
+		setup()
+		voteReq.GasInfo.BlockNumber = msg.BlockNumber + 1
+
+		_, err := server.VoteConfirmGasInfo(sdk.WrapSDKContext(ctx), voteReq)
+
+		assert.Error(t, err)
+	}).Repeat(repeats))
+}
+
+func TestHandleMsgConfirmBatchExecution(t *testing.T) {
+	var (
+		ctx      sdk.Context
+		chaink   *mock.ChainKeeperMock
+		v        *mock.VoterMock
+		poll     *voteMock.PollMock
+		server   types.MsgServiceServer
+		msg      *types.ConfirmBatchExecutionRequest
+		voteReq  *types.VoteConfirmBatchExecutionRequest
+		metadata types.CommandBatchMetadata
+		executed types.ExecutedCommands
+	)
+
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.I64Between(1, 1000000)}, false, log.TestingLogger())
+
+		metadata = evmTestUtils.RandomBatch()
+		metadata.Status = types.BatchSigned
+		metadata.Executions = nil
+		executed = types.ExecutedCommands{CommandIDs: append([]types.CommandID{}, metadata.CommandIDs...)}
+
+		msg = types.NewConfirmBatchExecutionRequest(rand.AccAddr(), evmChain, metadata.ID, common.Hash(evmTestUtils.RandomHash()))
+		pollKey := types.GetConfirmBatchExecutionPollKey(exported.Ethereum, msg.BatchID, msg.TxID)
+		voteReq = types.NewVoteConfirmBatchExecutionRequest(rand.AccAddr(), evmChain, pollKey, executed.CommandIDs)
+
+		chaink = &mock.ChainKeeperMock{
+			GetVotingThresholdFunc: func(sdk.Context) (utils.Threshold, bool) {
+				return utils.Threshold{Numerator: 15, Denominator: 100}, true
+			},
+			GetMinVoterCountFunc:              func(sdk.Context) (int64, bool) { return 15, true },
+			GetRevoteLockingPeriodFunc:        func(sdk.Context) (int64, bool) { return rand.PosI64(), true },
+			GetRequiredConfirmationHeightFunc: func(sdk.Context) (uint64, bool) { return mathRand.Uint64(), true },
+			GetGatewayAddressFunc:             func(sdk.Context) (common.Address, bool) { return common.HexToAddress(gateway), true },
+			GetFinalityModeFunc:               func(sdk.Context) (types.FinalityMode, bool) { return types.FinalityDepth, true },
+			GetBatchByIDFunc: func(_ sdk.Context, id []byte) types.CommandBatch {
+				if !bytes.Equal(id, metadata.ID) {
+					return types.NonExistentCommand
+				}
+				return types.NewCommandBatch(metadata, func(batch types.CommandBatchMetadata) { metadata = batch })
+			},
+			SetPendingBatchExecutionFunc: func(sdk.Context, vote.PollKey, *types.PendingBatchExecution) {},
+			GetPendingBatchExecutionFunc: func(_ sdk.Context, key vote.PollKey) (types.PendingBatchExecution, bool) {
+				return types.PendingBatchExecution{BatchID: msg.BatchID, TxID: msg.TxID}, key == pollKey
+			},
+			DeletePendingBatchExecutionFunc: func(sdk.Context, vote.PollKey) {},
+		}
+		basek := &mock.BaseKeeperMock{
+			ForChainFunc: func(chain string) types.ChainKeeper {
+				if strings.EqualFold(chain, evmChain) {
+					return chaink
+				}
+				return nil
+			},
+			LoggerFunc: func(sdk.Context) log.Logger { return log.TestingLogger() },
+		}
+		poll = &voteMock.PollMock{
+			VoteFunc: func(sdk.ValAddress, codec.ProtoMarshaler) error { return nil },
+			// the poll completes with the first vote
+			IsFunc: func(state vote.PollState) bool {
+				if len(poll.VoteCalls()) == 0 {
+					return state == vote.Pending
+				}
+				return state == vote.Completed
+			},
+			GetResultFunc:     func() codec.ProtoMarshaler { return &executed },
+			GetKeyFunc:        func() vote.PollKey { return pollKey },
+			AllowOverrideFunc: func() {},
+		}
+		v = &mock.VoterMock{
+			InitializePollFunc: func(sdk.Context, vote.PollKey, []sdk.ValAddress, ...vote.PollProperty) error { return nil },
+			GetPollFunc:        func(sdk.Context, vote.PollKey) vote.Poll { return poll },
+		}
+		chains := map[string]nexus.Chain{exported.Ethereum.Name: exported.Ethereum}
+		n := &mock.NexusMock{
+			GetChainMaintainersFunc: func(sdk.Context, nexus.Chain) []sdk.ValAddress { return []sdk.ValAddress{} },
+			IsChainActivatedFunc:    func(sdk.Context, nexus.Chain) bool { return true },
+			GetChainFunc: func(_ sdk.Context, chain string) (nexus.Chain, bool) {
+				c, ok := chains[chain]
+				return c, ok
+			},
+		}
+
+		server = keeper.NewMsgServerImpl(basek, &mock.TSSMock{}, n, &mock.SignerMock{}, v, &mock.SnapshotterMock{
+			GetOperatorFunc: func(sdk.Context, sdk.AccAddress) sdk.ValAddress { return rand.ValAddr() },
+		})
+	}
+
+	repeats := 20
+	t.Run("should start a poll for the batch execution", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := server.ConfirmBatchExecution(sdk.WrapSDKContext(ctx), msg)
+
+		assert.NoError(t, err)
+		assert.Len(t, testutils.Events(ctx.EventManager().ABCIEvents()).Filter(func(event abci.Event) bool { return event.Type == types.EventTypeBatchExecutionConfirmation }), 1)
+		assert.Equal(t, voteReq.PollKey, v.InitializePollCalls()[0].Key)
+		assert.Len(t, chaink.SetPendingBatchExecutionCalls(), 1)
+		assert.Equal(t, msg.BatchID, chaink.SetPendingBatchExecutionCalls()[0].Execution.BatchID)
+		assert.Equal(t, msg.TxID, chaink.SetPendingBatchExecutionCalls()[0].Execution.TxID)
+	}).Repeat(repeats))
+
+	t.Run("should return error when the batch is not signed", testutils.Func(func(t *testing.T) {
+		setup()
+		metadata.Status = types.BatchSigning
+
+		_, err := server.ConfirmBatchExecution(sdk.WrapSDKContext(ctx), msg)
+
+		assert.Error(t, err)
+		assert.Len(t, v.InitializePollCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should return error when the batch is already executed", testutils.Func(func(t *testing.T) {
+		setup()
+		batch := types.NewCommandBatch(metadata, func(batch types.CommandBatchMetadata) { metadata = batch })
+		batch.SetCommandsExecuted(types.Hash(common.BytesToHash(rand.Bytes(common.HashLength))), ctx.BlockHeight(), metadata.CommandIDs...)
+
+		_, err := server.ConfirmBatchExecution(sdk.WrapSDKContext(ctx), msg)
+
+		assert.Error(t, err)
+		assert.Len(t, v.InitializePollCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should record the executed commands of the batch", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := server.VoteConfirmBatchExecution(sdk.WrapSDKContext(ctx), voteReq)
+
+		assert.NoError(t, err)
+		assert.Len(t, chaink.DeletePendingBatchExecutionCalls(), 1)
+		assert.Len(t, metadata.Executions, len(metadata.CommandIDs))
+		for _, execution := range metadata.Executions {
+			assert.Equal(t, msg.TxID, execution.TxID)
+			assert.Equal(t, ctx.BlockHeight(), execution.Height)
+		}
+		assert.True(t, types.NewCommandBatch(metadata, func(types.CommandBatchMetadata) {}).IsExecuted())
+		assert.Len(t, poll.AllowOverrideCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should only record commands that are part of the batch", testutils.Func(func(t *testing.T) {
+		setup()
+		executed.CommandIDs = append(executed.CommandIDs[1:], evmTestUtils.RandomCommandID())
+
+		_, err := server.VoteConfirmBatchExecution(sdk.WrapSDKContext(ctx), voteReq)
+
+		assert.NoError(t, err)
+		assert.Len(t, metadata.Executions, len(metadata.CommandIDs)-1)
+		assert.False(t, types.NewCommandBatch(metadata, func(types.CommandBatchMetadata) {}).IsExecuted())
+	}).Repeat(repeats))
+
+	t.Run("should reject when no command of the batch was executed", testutils.Func(func(t *testing.T) {
+		setup()
+		executed.CommandIDs = nil
+
+		_, err := server.VoteConfirmBatchExecution(sdk.WrapSDKContext(ctx), voteReq)
+
+		assert.NoError(t, err)
+		assert.Len(t, metadata.Executions, 0)
+		assert.Len(t, poll.AllowOverrideCalls(), 1)
+	}).Repeat(repeats))
+
+	t.Run("should return error when there is no pending batch execution for the poll", testutils.Func(func(t *testing.T) {
+		setup()
+		voteReq.PollKey = vote.NewPollKey(types.ModuleName, rand.Str(20))
+
+		_, err := server.VoteConfirmBatchExecution(sdk.WrapSDKContext(ctx), voteReq)
+
+		assert.Error(t, err)
+		assert.Len(t, poll.VoteCalls(), 0)
+	}).Repeat(repeats))
+}
+
+func TestAddChain(t *testing.T) {
+	var (
+		ctx         sdk.Context
+		basek       *mock.BaseKeeperMock
+		tssMock     *mock.TSSMock
+		n           *mock.NexusMock
+		msg         *types.AddChainRequest
+		server      types.MsgServiceServer
+		name        string
+		nativeAsset string
+	)
+
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{}, false, log.TestingLogger())
+
+		chains := map[string]nexus.Chain{
+			exported.Ethereum.Name: exported.Ethereum,
+			btc.Bitcoin.Name:       btc.Bitcoin,
+		}
+		basek = &mock.BaseKeeperMock{
+			SetPendingChainFunc: func(sdk.Context, nexus.Chain, types.Params) {},
+		}
+
+		tssMock = &mock.TSSMock{}
+
+		n = &mock.NexusMock{
+			IsChainActivatedFunc: func(ctx sdk.Context, chain nexus.Chain) bool { return true },
+			GetChainFunc: func(ctx sdk.Context, chain string) (nexus.Chain, bool) {
+				c, ok := chains[chain]
+				return c, ok
+			},
+		}
+
+		name = rand.StrBetween(5, 20)
 		nativeAsset = rand.StrBetween(3, 10)
 		params := types.DefaultParams()[0]
 		params.Chain = name
@@ -1041,6 +1954,7 @@ func TestHandleMsgConfirmDeposit(t *testing.T) {
 			GetRevoteLockingPeriodFunc:        func(sdk.Context) (int64, bool) { return rand.PosI64(), true },
 			SetPendingDepositFunc:             func(sdk.Context, vote.PollKey, *types.ERC20Deposit) {},
 			GetRequiredConfirmationHeightFunc: func(sdk.Context) (uint64, bool) { return mathRand.Uint64(), true },
+			GetFinalityModeFunc:               func(sdk.Context) (types.FinalityMode, bool) { return types.FinalityDepth, true },
 			GetVotingThresholdFunc: func(sdk.Context) (utils.Threshold, bool) {
 				return utils.Threshold{Numerator: 15, Denominator: 100}, true
 			},
@@ -1051,158 +1965,555 @@ func TestHandleMsgConfirmDeposit(t *testing.T) {
 				}, true
 			},
 		}
-		v = &mock.VoterMock{
-			InitializePollFunc: func(sdk.Context, vote.PollKey, []sdk.ValAddress, ...vote.PollProperty) error { return nil },
-			GetPollFunc: func(sdk.Context, vote.PollKey) vote.Poll {
-				return &voteMock.PollMock{
-					VoteFunc: func(sdk.ValAddress, codec.ProtoMarshaler) error {
-						return nil
-					},
-					IsFunc: func(state vote.PollState) bool {
-						switch state {
-						case vote.Pending:
-							return true
-						default:
-							return false
-						}
-					},
+		v = &mock.VoterMock{
+			InitializePollFunc: func(sdk.Context, vote.PollKey, []sdk.ValAddress, ...vote.PollProperty) error { return nil },
+			GetPollFunc: func(sdk.Context, vote.PollKey) vote.Poll {
+				return &voteMock.PollMock{
+					VoteFunc: func(sdk.ValAddress, codec.ProtoMarshaler) error {
+						return nil
+					},
+					IsFunc: func(state vote.PollState) bool {
+						switch state {
+						case vote.Pending:
+							return true
+						default:
+							return false
+						}
+					},
+				}
+			},
+		}
+		s = &mock.SignerMock{
+			GetCurrentKeyIDFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool) {
+				return tssTestUtils.RandKeyID(), true
+			},
+			GetSnapshotCounterForKeyIDFunc: func(sdk.Context, tss.KeyID) (int64, bool) {
+				return rand.PosI64(), true
+			},
+		}
+		chains := map[string]nexus.Chain{
+			exported.Ethereum.Name: exported.Ethereum,
+			btc.Bitcoin.Name:       btc.Bitcoin,
+		}
+		n = &mock.NexusMock{
+			GetChainMaintainersFunc: func(ctx sdk.Context, chain nexus.Chain) []sdk.ValAddress { return []sdk.ValAddress{} },
+			IsChainActivatedFunc:    func(ctx sdk.Context, chain nexus.Chain) bool { return true },
+			GetChainFunc: func(ctx sdk.Context, chain string) (nexus.Chain, bool) {
+				c, ok := chains[chain]
+				return c, ok
+			},
+		}
+
+		msg = &types.ConfirmDepositRequest{
+			Sender:        rand.AccAddr(),
+			Chain:         evmChain,
+			TxID:          types.Hash(common.BytesToHash(rand.Bytes(common.HashLength))),
+			Amount:        sdk.NewUint(mathRand.Uint64()),
+			BurnerAddress: types.Address(common.BytesToAddress(rand.Bytes(common.AddressLength))),
+		}
+		server = keeper.NewMsgServerImpl(basek, &mock.TSSMock{}, n, s, v, &mock.SnapshotterMock{
+			GetOperatorFunc: func(sdk.Context, sdk.AccAddress) sdk.ValAddress {
+				return rand.ValAddr()
+			},
+		})
+	}
+
+	repeats := 20
+	t.Run("happy path confirm", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := server.ConfirmDeposit(sdk.WrapSDKContext(ctx), msg)
+
+		assert.NoError(t, err)
+		assert.Len(t, testutils.Events(ctx.EventManager().ABCIEvents()).Filter(func(event abci.Event) bool { return event.Type == types.EventTypeDepositConfirmation }), 1)
+		assert.Equal(t, v.InitializePollCalls()[0].Key, chaink.SetPendingDepositCalls()[0].Key)
+	}).Repeat(repeats))
+
+	t.Run("GIVEN a valid vote WHEN voting THEN event is emitted that captures vote value", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := server.VoteConfirmDeposit(sdk.WrapSDKContext(ctx), voteReq)
+
+		assert.NoError(t, err)
+		assert.Len(t, testutils.Events(ctx.EventManager().ABCIEvents()).Filter(func(event abci.Event) bool {
+			isValidType := event.GetType() == types.EventTypeDepositConfirmation
+			if !isValidType {
+				return false
+			}
+			isVoteAction := len(testutils.Attributes(event.GetAttributes()).Filter(func(attribute abci.EventAttribute) bool {
+				return string(attribute.GetKey()) == sdk.AttributeKeyAction &&
+					string(attribute.GetValue()) == types.AttributeValueVote
+			})) == 1
+			if !isVoteAction {
+				return false
+			}
+			hasCorrectValue := len(testutils.Attributes(event.GetAttributes()).Filter(func(attribute abci.EventAttribute) bool {
+				if string(attribute.GetKey()) != types.AttributeKeyValue {
+					return false
+				}
+				return string(attribute.GetValue()) == "false"
+			})) == 1
+			return hasCorrectValue
+		}), 1)
+
+	}).Repeat(repeats))
+
+	t.Run("unknown chain", testutils.Func(func(t *testing.T) {
+		setup()
+		msg.Chain = rand.StrBetween(5, 20)
+
+		_, err := server.ConfirmDeposit(sdk.WrapSDKContext(ctx), msg)
+
+		assert.Error(t, err)
+	}).Repeat(repeats))
+
+	t.Run("deposit confirmed", testutils.Func(func(t *testing.T) {
+		setup()
+		chaink.GetDepositFunc = func(sdk.Context, common.Hash, common.Address) (types.ERC20Deposit, types.DepositStatus, bool) {
+			return types.ERC20Deposit{
+				TxID:             types.Hash(common.BytesToHash(rand.Bytes(common.HashLength))),
+				Amount:           sdk.NewUint(mathRand.Uint64()),
+				DestinationChain: btc.Bitcoin.Name,
+				BurnerAddress:    types.Address(common.BytesToAddress(rand.Bytes(common.AddressLength))),
+			}, types.DepositStatus_Confirmed, true
+		}
+
+		_, err := server.ConfirmDeposit(sdk.WrapSDKContext(ctx), msg)
+
+		assert.Error(t, err)
+	}).Repeat(repeats))
+
+	t.Run("deposit burned", testutils.Func(func(t *testing.T) {
+		setup()
+		chaink.GetDepositFunc = func(sdk.Context, common.Hash, common.Address) (types.ERC20Deposit, types.DepositStatus, bool) {
+			return types.ERC20Deposit{
+				TxID:             types.Hash(common.BytesToHash(rand.Bytes(common.HashLength))),
+				Amount:           sdk.NewUint(mathRand.Uint64()),
+				DestinationChain: btc.Bitcoin.Name,
+				BurnerAddress:    types.Address(common.BytesToAddress(rand.Bytes(common.AddressLength))),
+			}, types.DepositStatus_Burned, true
+		}
+
+		_, err := server.ConfirmDeposit(sdk.WrapSDKContext(ctx), msg)
+
+		assert.Error(t, err)
+	}).Repeat(repeats))
+
+	t.Run("burner address unknown", testutils.Func(func(t *testing.T) {
+		setup()
+		chaink.GetBurnerInfoFunc = func(sdk.Context, common.Address) *types.BurnerInfo { return nil }
+
+		_, err := server.ConfirmDeposit(sdk.WrapSDKContext(ctx), msg)
+
+		assert.Error(t, err)
+	}).Repeat(repeats))
+
+	t.Run("init poll failed", testutils.Func(func(t *testing.T) {
+		setup()
+		v.InitializePollFunc = func(sdk.Context, vote.PollKey, []sdk.ValAddress, ...vote.PollProperty) error {
+			return fmt.Errorf("failed")
+		}
+
+		_, err := server.ConfirmDeposit(sdk.WrapSDKContext(ctx), msg)
+
+		assert.Error(t, err)
+	}).Repeat(repeats))
+}
+
+func TestHandleMsgReverifyDeposit(t *testing.T) {
+	var (
+		ctx       sdk.Context
+		chaink    *mock.ChainKeeperMock
+		v         *mock.VoterMock
+		poll      *voteMock.PollMock
+		n         *mock.NexusMock
+		server    types.MsgServiceServer
+		msg       *types.ReverifyDepositRequest
+		voteReq   *types.VoteReverifyDepositRequest
+		deposit   types.ERC20Deposit
+		state     types.DepositStatus
+		canonical bool
+	)
+
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.I64Between(1, 1000000)}, false, log.TestingLogger())
+		deposit = types.ERC20Deposit{
+			TxID:             types.Hash(common.BytesToHash(rand.Bytes(common.HashLength))),
+			Amount:           sdk.NewUint(mathRand.Uint64()),
+			Asset:            "uusdc",
+			DestinationChain: btc.Bitcoin.Name,
+			BurnerAddress:    types.Address(common.BytesToAddress(rand.Bytes(common.AddressLength))),
+		}
+		state = types.DepositStatus_Confirmed
+		canonical = false
+
+		msg = types.NewReverifyDepositRequest(rand.AccAddr(), evmChain, common.Hash(deposit.TxID), common.Address(deposit.BurnerAddress))
+		pollKey := types.GetReverifyDepositPollKey(exported.Ethereum, deposit.TxID, deposit.BurnerAddress)
+		voteReq = types.NewVoteReverifyDepositRequest(rand.AccAddr(), evmChain, pollKey, common.Hash(deposit.TxID), deposit.BurnerAddress, canonical)
+
+		chaink = &mock.ChainKeeperMock{
+			GetDepositFunc: func(sdk.Context, common.Hash, common.Address) (types.ERC20Deposit, types.DepositStatus, bool) {
+				return deposit, state, true
+			},
+			GetBurnerInfoFunc: func(sdk.Context, common.Address) *types.BurnerInfo {
+				return &types.BurnerInfo{TokenAddress: types.Address(common.BytesToAddress(rand.Bytes(common.AddressLength)))}
+			},
+			GetVotingThresholdFunc: func(sdk.Context) (utils.Threshold, bool) {
+				return utils.Threshold{Numerator: 15, Denominator: 100}, true
+			},
+			GetMinVoterCountFunc:              func(sdk.Context) (int64, bool) { return 15, true },
+			GetRevoteLockingPeriodFunc:        func(sdk.Context) (int64, bool) { return rand.PosI64(), true },
+			GetRequiredConfirmationHeightFunc: func(sdk.Context) (uint64, bool) { return mathRand.Uint64(), true },
+			GetFinalityModeFunc:               func(sdk.Context) (types.FinalityMode, bool) { return types.FinalityFinalized, true },
+			SetPendingReverificationFunc:      func(sdk.Context, vote.PollKey, *types.ERC20Deposit) {},
+			GetPendingReverificationFunc:      func(sdk.Context, vote.PollKey) (types.ERC20Deposit, bool) { return deposit, true },
+			DeletePendingReverificationFunc:   func(sdk.Context, vote.PollKey) {},
+			GetTransactionFeeRateFunc:         func(sdk.Context) (sdk.Dec, bool) { return sdk.NewDecWithPrec(25, 5), true },
+			DeleteDepositFunc:                 func(sdk.Context, types.ERC20Deposit) {},
+			SetDepositFunc:                    func(sdk.Context, types.ERC20Deposit, types.DepositStatus) {},
+		}
+		basek := &mock.BaseKeeperMock{
+			ForChainFunc: func(chain string) types.ChainKeeper {
+				if strings.EqualFold(chain, evmChain) {
+					return chaink
+				}
+				return nil
+			},
+			LoggerFunc: func(sdk.Context) log.Logger { return log.TestingLogger() },
+		}
+		poll = &voteMock.PollMock{
+			VoteFunc: func(sdk.ValAddress, codec.ProtoMarshaler) error { return nil },
+			// the poll completes with the first vote
+			IsFunc: func(state vote.PollState) bool {
+				if len(poll.VoteCalls()) == 0 {
+					return state == vote.Pending
+				}
+				return state == vote.Completed
+			},
+			GetResultFunc:     func() codec.ProtoMarshaler { return &gogoprototypes.BoolValue{Value: canonical} },
+			AllowOverrideFunc: func() {},
+		}
+		v = &mock.VoterMock{
+			InitializePollFunc: func(sdk.Context, vote.PollKey, []sdk.ValAddress, ...vote.PollProperty) error { return nil },
+			GetPollFunc:        func(sdk.Context, vote.PollKey) vote.Poll { return poll },
+		}
+		chains := map[string]nexus.Chain{exported.Ethereum.Name: exported.Ethereum}
+		n = &mock.NexusMock{
+			GetChainMaintainersFunc: func(sdk.Context, nexus.Chain) []sdk.ValAddress { return []sdk.ValAddress{} },
+			IsChainActivatedFunc:    func(sdk.Context, nexus.Chain) bool { return true },
+			GetChainFunc: func(_ sdk.Context, chain string) (nexus.Chain, bool) {
+				c, ok := chains[chain]
+				return c, ok
+			},
+			GetRecipientFunc: func(sdk.Context, nexus.CrossChainAddress) (nexus.CrossChainAddress, bool) {
+				return nexus.CrossChainAddress{Chain: btc.Bitcoin, Address: rand.StrBetween(20, 40)}, true
+			},
+			RevertTransferFunc: func(_ sdk.Context, _ nexus.CrossChainAddress, asset sdk.Coin, _ sdk.Dec) (sdk.Coin, error) {
+				return sdk.NewCoin(asset.Denom, sdk.ZeroInt()), nil
+			},
+		}
+
+		server = keeper.NewMsgServerImpl(basek, &mock.TSSMock{}, n, &mock.SignerMock{}, v, &mock.SnapshotterMock{
+			GetOperatorFunc: func(sdk.Context, sdk.AccAddress) sdk.ValAddress { return rand.ValAddr() },
+		})
+	}
+
+	repeats := 20
+	t.Run("should start a reverification poll for a confirmed deposit", testutils.Func(func(t *testing.T) {
+		setup()
+		chaink.GetPendingReverificationFunc = func(sdk.Context, vote.PollKey) (types.ERC20Deposit, bool) { return types.ERC20Deposit{}, false }
+
+		_, err := server.ReverifyDeposit(sdk.WrapSDKContext(ctx), msg)
+
+		assert.NoError(t, err)
+		events := testutils.Events(ctx.EventManager().ABCIEvents()).Filter(func(event abci.Event) bool { return event.Type == types.EventTypeDepositReverification })
+		assert.Len(t, events, 1)
+		assert.Len(t, testutils.Attributes(events[0].GetAttributes()).Filter(func(attribute abci.EventAttribute) bool {
+			return string(attribute.GetKey()) == types.AttributeKeyFinalityMode && string(attribute.GetValue()) == types.FinalityFinalized.String()
+		}), 1)
+		assert.Equal(t, voteReq.PollKey, v.InitializePollCalls()[0].Key)
+		assert.Equal(t, voteReq.PollKey, chaink.SetPendingReverificationCalls()[0].Key)
+	}).Repeat(repeats))
+
+	t.Run("should return error when a reverification of the deposit is already in progress", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := server.ReverifyDeposit(sdk.WrapSDKContext(ctx), msg)
+
+		assert.Error(t, err)
+		assert.Len(t, v.InitializePollCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should start a new reverification poll when the previous one expired", testutils.Func(func(t *testing.T) {
+		setup()
+		poll.IsFunc = func(state vote.PollState) bool { return state == vote.Expired }
+
+		_, err := server.ReverifyDeposit(sdk.WrapSDKContext(ctx), msg)
+
+		assert.NoError(t, err)
+		assert.Len(t, v.InitializePollCalls(), 1)
+	}).Repeat(repeats))
+
+	t.Run("should return error when the deposit is not confirmed", testutils.Func(func(t *testing.T) {
+		setup()
+		state = []types.DepositStatus{types.DepositStatus_Burned, types.DepositStatus_Reorged}[rand.I64Between(0, 2)]
+
+		_, err := server.ReverifyDeposit(sdk.WrapSDKContext(ctx), msg)
+
+		assert.Error(t, err)
+		assert.Len(t, v.InitializePollCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should mark the deposit as reorged when it is no longer canonical", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := server.VoteReverifyDeposit(sdk.WrapSDKContext(ctx), voteReq)
+
+		assert.NoError(t, err)
+		assert.Len(t, chaink.DeletePendingReverificationCalls(), 1)
+		assert.Len(t, chaink.DeleteDepositCalls(), 1)
+		assert.Len(t, chaink.SetDepositCalls(), 1)
+		assert.Equal(t, types.DepositStatus_Reorged, chaink.SetDepositCalls()[0].State)
+		assert.Len(t, n.RevertTransferCalls(), 1)
+		assert.Equal(t, deposit.BurnerAddress.Hex(), n.RevertTransferCalls()[0].Sender.Address)
+		assert.Equal(t, sdk.NewCoin(deposit.Asset, sdk.NewIntFromBigInt(deposit.Amount.BigInt())), n.RevertTransferCalls()[0].Asset)
+		assert.Len(t, testutils.Events(ctx.EventManager().ABCIEvents()).Filter(func(event abci.Event) bool {
+			return event.Type == types.EventTypeDepositReverification &&
+				len(testutils.Attributes(event.GetAttributes()).Filter(func(attribute abci.EventAttribute) bool {
+					return string(attribute.GetKey()) == sdk.AttributeKeyAction && string(attribute.GetValue()) == types.AttributeValueReorg
+				})) == 1
+		}), 1)
+	}).Repeat(repeats))
+
+	t.Run("should keep the deposit when it is still canonical", testutils.Func(func(t *testing.T) {
+		setup()
+		canonical = true
+		voteReq.Canonical = canonical
+
+		_, err := server.VoteReverifyDeposit(sdk.WrapSDKContext(ctx), voteReq)
+
+		assert.NoError(t, err)
+		assert.Len(t, chaink.DeletePendingReverificationCalls(), 1)
+		assert.Len(t, chaink.DeleteDepositCalls(), 0)
+		assert.Len(t, chaink.SetDepositCalls(), 0)
+		assert.Len(t, n.RevertTransferCalls(), 0)
+		assert.Len(t, poll.AllowOverrideCalls(), 1)
+	}).Repeat(repeats))
+
+	t.Run("should return error when the vote does not match the poll", testutils.Func(func(t *testing.T) {
+		setup()
+		voteReq.TxID = types.Hash(common.BytesToHash(rand.Bytes(common.HashLength)))
+
+		_, err := server.VoteReverifyDeposit(sdk.WrapSDKContext(ctx), voteReq)
+
+		assert.Error(t, err)
+		assert.Len(t, poll.VoteCalls(), 0)
+	}).Repeat(repeats))
+}
+
+func TestHandleMsgUpgradeGateway(t *testing.T) {
+	var (
+		ctx            sdk.Context
+		chaink         *mock.ChainKeeperMock
+		v              *mock.VoterMock
+		poll           *voteMock.PollMock
+		server         types.MsgServiceServer
+		createReq      *types.CreateUpgradeGatewayRequest
+		confirmReq     *types.ConfirmGatewayUpgradeRequest
+		voteReq        *types.VoteConfirmGatewayUpgradeRequest
+		pending        common.Address
+		hasPending     bool
+		hasGateway     bool
+		implementation common.Address
+		confirmed      bool
+	)
+
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.I64Between(1, 1000000)}, false, log.TestingLogger())
+		implementation = common.BytesToAddress(rand.Bytes(common.AddressLength))
+		pending = implementation
+		hasPending = true
+		hasGateway = true
+		confirmed = true
+
+		txID := common.BytesToHash(rand.Bytes(common.HashLength))
+		createReq = types.NewCreateUpgradeGatewayRequest(rand.AccAddr(), evmChain, implementation, common.BytesToHash(rand.Bytes(common.HashLength)), rand.Bytes(int(rand.I64Between(0, 100))), types.AllGatewayCommands())
+		confirmReq = types.NewConfirmGatewayUpgradeRequest(rand.AccAddr(), evmChain, txID)
+		pollKey := types.GetConfirmGatewayUpgradePollKey(exported.Ethereum, types.Hash(txID), types.Address(implementation))
+		voteReq = types.NewVoteConfirmGatewayUpgradeRequest(rand.AccAddr(), evmChain, pollKey, txID, confirmed)
+
+		chaink = &mock.ChainKeeperMock{
+			GetGatewayAddressFunc: func(sdk.Context) (common.Address, bool) {
+				return common.HexToAddress(gateway), hasGateway
+			},
+			GetGatewayCommandsFunc: func(sdk.Context) ([]string, bool) {
+				return types.DefaultParams()[0].GatewayCommands, true
+			},
+			GetChainIDFunc:     func(sdk.Context) (*big.Int, bool) { return big.NewInt(rand.I64Between(1, 1000)), true },
+			EnqueueCommandFunc: func(sdk.Context, types.Command) error { return nil },
+			SetPendingGatewayUpgradeFunc: func(_ sdk.Context, impl common.Address, _ []string) error {
+				pending = impl
+				hasPending = true
+				return nil
+			},
+			GetPendingGatewayUpgradeFunc: func(sdk.Context) (common.Address, bool) { return pending, hasPending },
+			ConfirmPendingGatewayUpgradeFunc: func(sdk.Context) error {
+				hasPending = false
+				return nil
+			},
+			GetVotingThresholdFunc: func(sdk.Context) (utils.Threshold, bool) {
+				return utils.Threshold{Numerator: 15, Denominator: 100}, true
+			},
+			GetMinVoterCountFunc:              func(sdk.Context) (int64, bool) { return 15, true },
+			GetRevoteLockingPeriodFunc:        func(sdk.Context) (int64, bool) { return rand.PosI64(), true },
+			GetRequiredConfirmationHeightFunc: func(sdk.Context) (uint64, bool) { return mathRand.Uint64(), true },
+			GetFinalityModeFunc:               func(sdk.Context) (types.FinalityMode, bool) { return types.FinalityFinalized, true },
+		}
+		basek := &mock.BaseKeeperMock{
+			ForChainFunc: func(chain string) types.ChainKeeper {
+				if strings.EqualFold(chain, evmChain) {
+					return chaink
 				}
+				return nil
 			},
+			LoggerFunc: func(sdk.Context) log.Logger { return log.TestingLogger() },
 		}
-		s = &mock.SignerMock{
-			GetCurrentKeyIDFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool) {
-				return tssTestUtils.RandKeyID(), true
-			},
-			GetSnapshotCounterForKeyIDFunc: func(sdk.Context, tss.KeyID) (int64, bool) {
-				return rand.PosI64(), true
+		poll = &voteMock.PollMock{
+			VoteFunc: func(sdk.ValAddress, codec.ProtoMarshaler) error { return nil },
+			// the poll completes with the first vote
+			IsFunc: func(state vote.PollState) bool {
+				if len(poll.VoteCalls()) == 0 {
+					return state == vote.Pending
+				}
+				return state == vote.Completed
 			},
+			GetResultFunc:     func() codec.ProtoMarshaler { return &gogoprototypes.BoolValue{Value: confirmed} },
+			AllowOverrideFunc: func() {},
 		}
-		chains := map[string]nexus.Chain{
-			exported.Ethereum.Name: exported.Ethereum,
-			btc.Bitcoin.Name:       btc.Bitcoin,
+		v = &mock.VoterMock{
+			InitializePollFunc: func(sdk.Context, vote.PollKey, []sdk.ValAddress, ...vote.PollProperty) error { return nil },
+			GetPollFunc:        func(sdk.Context, vote.PollKey) vote.Poll { return poll },
 		}
-		n = &mock.NexusMock{
-			GetChainMaintainersFunc: func(ctx sdk.Context, chain nexus.Chain) []sdk.ValAddress { return []sdk.ValAddress{} },
-			IsChainActivatedFunc:    func(ctx sdk.Context, chain nexus.Chain) bool { return true },
-			GetChainFunc: func(ctx sdk.Context, chain string) (nexus.Chain, bool) {
+		chains := map[string]nexus.Chain{exported.Ethereum.Name: exported.Ethereum}
+		n := &mock.NexusMock{
+			GetChainMaintainersFunc: func(sdk.Context, nexus.Chain) []sdk.ValAddress { return []sdk.ValAddress{} },
+			IsChainActivatedFunc:    func(sdk.Context, nexus.Chain) bool { return true },
+			GetChainFunc: func(_ sdk.Context, chain string) (nexus.Chain, bool) {
 				c, ok := chains[chain]
 				return c, ok
 			},
 		}
-
-		msg = &types.ConfirmDepositRequest{
-			Sender:        rand.AccAddr(),
-			Chain:         evmChain,
-			TxID:          types.Hash(common.BytesToHash(rand.Bytes(common.HashLength))),
-			Amount:        sdk.NewUint(mathRand.Uint64()),
-			BurnerAddress: types.Address(common.BytesToAddress(rand.Bytes(common.AddressLength))),
-		}
-		server = keeper.NewMsgServerImpl(basek, &mock.TSSMock{}, n, s, v, &mock.SnapshotterMock{
-			GetOperatorFunc: func(sdk.Context, sdk.AccAddress) sdk.ValAddress {
-				return rand.ValAddr()
+		signer := &mock.SignerMock{
+			GetCurrentKeyIDFunc: func(sdk.Context, nexus.Chain, tss.KeyRole) (tss.KeyID, bool) {
+				return tssTestUtils.RandKeyID(), true
 			},
+		}
+
+		server = keeper.NewMsgServerImpl(basek, &mock.TSSMock{}, n, signer, v, &mock.SnapshotterMock{
+			GetOperatorFunc: func(sdk.Context, sdk.AccAddress) sdk.ValAddress { return rand.ValAddr() },
 		})
 	}
 
 	repeats := 20
-	t.Run("happy path confirm", testutils.Func(func(t *testing.T) {
+	t.Run("should enqueue an upgrade command and record the pending upgrade", testutils.Func(func(t *testing.T) {
 		setup()
+		hasPending = false
 
-		_, err := server.ConfirmDeposit(sdk.WrapSDKContext(ctx), msg)
+		_, err := server.CreateUpgradeGateway(sdk.WrapSDKContext(ctx), createReq)
 
 		assert.NoError(t, err)
-		assert.Len(t, testutils.Events(ctx.EventManager().ABCIEvents()).Filter(func(event abci.Event) bool { return event.Type == types.EventTypeDepositConfirmation }), 1)
-		assert.Equal(t, v.InitializePollCalls()[0].Key, chaink.SetPendingDepositCalls()[0].Key)
+		assert.Len(t, chaink.EnqueueCommandCalls(), 1)
+		cmd := chaink.EnqueueCommandCalls()[0].Cmd
+		assert.Equal(t, types.AxelarGatewayCommandUpgrade, cmd.Command)
+		actualImplementation, actualCodeHash, actualSetupParams, err := types.DecodeUpgradeParams(cmd.Params)
+		assert.NoError(t, err)
+		assert.Equal(t, implementation, actualImplementation)
+		assert.Equal(t, common.Hash(createReq.NewImplementationCodeHash), actualCodeHash)
+		assert.Equal(t, createReq.SetupParams, actualSetupParams)
+		assert.Len(t, chaink.SetPendingGatewayUpgradeCalls(), 1)
+		assert.Equal(t, createReq.NewImplementationCommands, chaink.SetPendingGatewayUpgradeCalls()[0].Commands)
+		assert.True(t, hasPending)
+		assert.Equal(t, implementation, pending)
 	}).Repeat(repeats))
 
-	t.Run("GIVEN a valid vote WHEN voting THEN event is emitted that captures vote value", testutils.Func(func(t *testing.T) {
+	t.Run("should return error when the gateway is not set", testutils.Func(func(t *testing.T) {
 		setup()
+		hasGateway = false
 
-		_, err := server.VoteConfirmDeposit(sdk.WrapSDKContext(ctx), voteReq)
-
-		assert.NoError(t, err)
-		assert.Len(t, testutils.Events(ctx.EventManager().ABCIEvents()).Filter(func(event abci.Event) bool {
-			isValidType := event.GetType() == types.EventTypeDepositConfirmation
-			if !isValidType {
-				return false
-			}
-			isVoteAction := len(testutils.Attributes(event.GetAttributes()).Filter(func(attribute abci.EventAttribute) bool {
-				return string(attribute.GetKey()) == sdk.AttributeKeyAction &&
-					string(attribute.GetValue()) == types.AttributeValueVote
-			})) == 1
-			if !isVoteAction {
-				return false
-			}
-			hasCorrectValue := len(testutils.Attributes(event.GetAttributes()).Filter(func(attribute abci.EventAttribute) bool {
-				if string(attribute.GetKey()) != types.AttributeKeyValue {
-					return false
-				}
-				return string(attribute.GetValue()) == "false"
-			})) == 1
-			return hasCorrectValue
-		}), 1)
+		_, err := server.CreateUpgradeGateway(sdk.WrapSDKContext(ctx), createReq)
 
+		assert.Error(t, err)
+		assert.Len(t, chaink.EnqueueCommandCalls(), 0)
 	}).Repeat(repeats))
 
-	t.Run("unknown chain", testutils.Func(func(t *testing.T) {
+	t.Run("should return error when the gateway does not support upgrades", testutils.Func(func(t *testing.T) {
 		setup()
-		msg.Chain = rand.StrBetween(5, 20)
+		hasPending = false
+		chaink.GetGatewayCommandsFunc = func(sdk.Context) ([]string, bool) {
+			return gatewayCommandsWithout(types.AxelarGatewayCommandUpgrade), true
+		}
 
-		_, err := server.ConfirmDeposit(sdk.WrapSDKContext(ctx), msg)
+		_, err := server.CreateUpgradeGateway(sdk.WrapSDKContext(ctx), createReq)
 
 		assert.Error(t, err)
+		assert.Len(t, chaink.EnqueueCommandCalls(), 0)
+		assert.Len(t, chaink.SetPendingGatewayUpgradeCalls(), 0)
 	}).Repeat(repeats))
 
-	t.Run("deposit confirmed", testutils.Func(func(t *testing.T) {
+	t.Run("should start a poll for the pending upgrade", testutils.Func(func(t *testing.T) {
 		setup()
-		chaink.GetDepositFunc = func(sdk.Context, common.Hash, common.Address) (types.ERC20Deposit, types.DepositStatus, bool) {
-			return types.ERC20Deposit{
-				TxID:             types.Hash(common.BytesToHash(rand.Bytes(common.HashLength))),
-				Amount:           sdk.NewUint(mathRand.Uint64()),
-				DestinationChain: btc.Bitcoin.Name,
-				BurnerAddress:    types.Address(common.BytesToAddress(rand.Bytes(common.AddressLength))),
-			}, types.DepositStatus_Confirmed, true
-		}
 
-		_, err := server.ConfirmDeposit(sdk.WrapSDKContext(ctx), msg)
+		_, err := server.ConfirmGatewayUpgrade(sdk.WrapSDKContext(ctx), confirmReq)
 
-		assert.Error(t, err)
+		assert.NoError(t, err)
+		assert.Len(t, testutils.Events(ctx.EventManager().ABCIEvents()).Filter(func(event abci.Event) bool {
+			return event.Type == types.EventTypeGatewayUpgradeConfirmation
+		}), 1)
+		assert.Len(t, v.InitializePollCalls(), 1)
+		assert.Equal(t, voteReq.PollKey, v.InitializePollCalls()[0].Key)
 	}).Repeat(repeats))
 
-	t.Run("deposit burned", testutils.Func(func(t *testing.T) {
+	t.Run("should return error when there is no pending upgrade", testutils.Func(func(t *testing.T) {
 		setup()
-		chaink.GetDepositFunc = func(sdk.Context, common.Hash, common.Address) (types.ERC20Deposit, types.DepositStatus, bool) {
-			return types.ERC20Deposit{
-				TxID:             types.Hash(common.BytesToHash(rand.Bytes(common.HashLength))),
-				Amount:           sdk.NewUint(mathRand.Uint64()),
-				DestinationChain: btc.Bitcoin.Name,
-				BurnerAddress:    types.Address(common.BytesToAddress(rand.Bytes(common.AddressLength))),
-			}, types.DepositStatus_Burned, true
-		}
+		hasPending = false
 
-		_, err := server.ConfirmDeposit(sdk.WrapSDKContext(ctx), msg)
+		_, err := server.ConfirmGatewayUpgrade(sdk.WrapSDKContext(ctx), confirmReq)
 
 		assert.Error(t, err)
+		assert.Len(t, v.InitializePollCalls(), 0)
 	}).Repeat(repeats))
 
-	t.Run("burner address unknown", testutils.Func(func(t *testing.T) {
+	t.Run("should confirm the pending upgrade", testutils.Func(func(t *testing.T) {
 		setup()
-		chaink.GetBurnerInfoFunc = func(sdk.Context, common.Address) *types.BurnerInfo { return nil }
 
-		_, err := server.ConfirmDeposit(sdk.WrapSDKContext(ctx), msg)
+		_, err := server.VoteConfirmGatewayUpgrade(sdk.WrapSDKContext(ctx), voteReq)
 
-		assert.Error(t, err)
+		assert.NoError(t, err)
+		assert.Len(t, chaink.ConfirmPendingGatewayUpgradeCalls(), 1)
+		assert.False(t, hasPending)
 	}).Repeat(repeats))
 
-	t.Run("init poll failed", testutils.Func(func(t *testing.T) {
+	t.Run("should keep the pending upgrade when rejected", testutils.Func(func(t *testing.T) {
 		setup()
-		v.InitializePollFunc = func(sdk.Context, vote.PollKey, []sdk.ValAddress, ...vote.PollProperty) error {
-			return fmt.Errorf("failed")
-		}
+		confirmed = false
+		voteReq.Confirmed = confirmed
 
-		_, err := server.ConfirmDeposit(sdk.WrapSDKContext(ctx), msg)
+		_, err := server.VoteConfirmGatewayUpgrade(sdk.WrapSDKContext(ctx), voteReq)
+
+		assert.NoError(t, err)
+		assert.Len(t, chaink.ConfirmPendingGatewayUpgradeCalls(), 0)
+		assert.Len(t, poll.AllowOverrideCalls(), 1)
+		assert.True(t, hasPending)
+	}).Repeat(repeats))
+
+	t.Run("should return error when the vote does not match the pending upgrade", testutils.Func(func(t *testing.T) {
+		setup()
+		pending = common.BytesToAddress(rand.Bytes(common.AddressLength))
+
+		_, err := server.VoteConfirmGatewayUpgrade(sdk.WrapSDKContext(ctx), voteReq)
 
 		assert.Error(t, err)
+		assert.Len(t, poll.VoteCalls(), 0)
 	}).Repeat(repeats))
 }
 
@@ -1269,6 +2580,7 @@ func TestHandleMsgCreateDeployToken(t *testing.T) {
 				return c, ok
 			},
 			IsAssetRegisteredFunc: func(sdk.Context, nexus.Chain, string) bool { return true },
+			GetAssetPrecisionFunc: func(sdk.Context, string) (uint32, bool) { return uint32(rand.I64Between(0, 19)), true },
 		}
 		s = &mock.SignerMock{
 			GetCurrentKeyIDFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool) {
@@ -1327,6 +2639,16 @@ func TestHandleMsgCreateDeployToken(t *testing.T) {
 		assert.Error(t, err)
 	}).Repeat(repeats))
 
+	t.Run("should return error when the origin chain has not defined a precision for the asset", testutils.Func(func(t *testing.T) {
+		setup()
+		n.GetAssetPrecisionFunc = func(sdk.Context, string) (uint32, bool) { return 0, false }
+
+		_, err := server.CreateDeployToken(sdk.WrapSDKContext(ctx), msg)
+
+		assert.Error(t, err)
+		assert.Len(t, chaink.EnqueueCommandCalls(), 0)
+	}).Repeat(repeats))
+
 	t.Run("should return error when next master key is set", testutils.Func(func(t *testing.T) {
 		setup()
 		s.GetNextKeyIDFunc = func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool) {
@@ -1349,6 +2671,141 @@ func TestHandleMsgCreateDeployToken(t *testing.T) {
 
 }
 
+func TestHandleMsgCreateRegisterExternalToken(t *testing.T) {
+	var (
+		ctx    sdk.Context
+		chaink *mock.ChainKeeperMock
+		n      *mock.NexusMock
+		s      *mock.SignerMock
+		msg    *types.CreateRegisterExternalTokenRequest
+		server types.MsgServiceServer
+	)
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{}, false, log.TestingLogger())
+		msg = types.NewCreateRegisterExternalTokenRequest(rand.AccAddr(), exported.Ethereum.Name, rand.Str(5), evmTestUtils.RandomTokenDetails(), sdk.NewInt(rand.PosI64()), evmTestUtils.RandomAddress())
+
+		basek := &mock.BaseKeeperMock{
+			ForChainFunc: func(chain string) types.ChainKeeper {
+				if strings.EqualFold(chain, evmChain) {
+					return chaink
+				}
+				return nil
+			},
+		}
+		chaink = &mock.ChainKeeperMock{
+			GetGatewayCommandsFunc: func(sdk.Context) ([]string, bool) {
+				return types.DefaultParams()[0].GatewayCommands, true
+			},
+			CreateExternalERC20TokenFunc: func(ctx sdk.Context, asset string, details types.TokenDetails, minDeposit sdk.Int, tokenAddr types.Address) (types.ERC20Token, error) {
+				return types.CreateERC20Token(func(types.ERC20TokenMetadata) {}, types.ERC20TokenMetadata{
+					Asset:        asset,
+					Details:      details,
+					TokenAddress: tokenAddr,
+					ChainID:      sdk.NewInt(rand.PosI64()),
+					MinAmount:    minDeposit,
+					Status:       types.Initialized,
+					IsExternal:   true,
+				}), nil
+			},
+			EnqueueCommandFunc: func(ctx sdk.Context, cmd types.Command) error { return nil },
+		}
+
+		chains := map[string]nexus.Chain{btc.Bitcoin.Name: btc.Bitcoin, exported.Ethereum.Name: exported.Ethereum}
+		n = &mock.NexusMock{
+			IsChainActivatedFunc: func(ctx sdk.Context, chain nexus.Chain) bool { return true },
+			GetChainFunc: func(ctx sdk.Context, chain string) (nexus.Chain, bool) {
+				c, ok := chains[chain]
+				return c, ok
+			},
+			GetChainsWithAssetFunc: func(sdk.Context, string) []nexus.Chain { return nil },
+		}
+		s = &mock.SignerMock{
+			GetCurrentKeyIDFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool) {
+				return tssTestUtils.RandKeyID(), true
+			},
+			GetNextKeyIDFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool) {
+				return "", false
+			},
+		}
+
+		server = keeper.NewMsgServerImpl(basek, &mock.TSSMock{}, n, s, &mock.VoterMock{}, &mock.SnapshotterMock{})
+	}
+
+	repeats := 20
+	t.Run("should enqueue the register command for a new asset", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := server.CreateRegisterExternalToken(sdk.WrapSDKContext(ctx), msg)
+
+		assert.NoError(t, err)
+		assert.Len(t, chaink.EnqueueCommandCalls(), 1)
+		assert.Equal(t, types.AxelarGatewayCommandRegisterToken, chaink.EnqueueCommandCalls()[0].Cmd.Command)
+	}).Repeat(repeats))
+
+	t.Run("should enqueue the register command with the default params", testutils.Func(func(t *testing.T) {
+		setup()
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+		k := newKeeperWithDefaultParams(ctx)
+		server = keeper.NewMsgServerImpl(k, &mock.TSSMock{}, n, s, &mock.VoterMock{}, &mock.SnapshotterMock{})
+
+		_, err := server.CreateRegisterExternalToken(sdk.WrapSDKContext(ctx), msg)
+
+		assert.NoError(t, err)
+		commands := k.ForChain(exported.Ethereum.Name).GetPendingCommands(ctx)
+		assert.Len(t, commands, 1)
+		assert.Equal(t, types.AxelarGatewayCommandRegisterToken, commands[0].Command)
+	}).Repeat(repeats))
+
+	t.Run("should return error when the asset is already registered for any chain", testutils.Func(func(t *testing.T) {
+		setup()
+		n.GetChainsWithAssetFunc = func(sdk.Context, string) []nexus.Chain {
+			return []nexus.Chain{[]nexus.Chain{btc.Bitcoin, exported.Ethereum}[rand.I64Between(0, 2)]}
+		}
+
+		_, err := server.CreateRegisterExternalToken(sdk.WrapSDKContext(ctx), msg)
+
+		assert.Error(t, err)
+		assert.Len(t, chaink.CreateExternalERC20TokenCalls(), 0)
+		assert.Len(t, chaink.EnqueueCommandCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should return error when the gateway does not support external tokens", testutils.Func(func(t *testing.T) {
+		setup()
+		chaink.GetGatewayCommandsFunc = func(sdk.Context) ([]string, bool) {
+			return gatewayCommandsWithout(types.AxelarGatewayCommandRegisterToken), true
+		}
+
+		_, err := server.CreateRegisterExternalToken(sdk.WrapSDKContext(ctx), msg)
+
+		assert.Error(t, err)
+		assert.Len(t, chaink.EnqueueCommandCalls(), 0)
+	}).Repeat(repeats))
+}
+
+// newKeeperWithDefaultParams returns a keeper with the unmodified default params and a confirmed gateway set for ethereum
+func newKeeperWithDefaultParams(ctx sdk.Context) types.BaseKeeper {
+	encCfg := app.MakeEncodingConfig()
+	paramsK := paramsKeeper.NewKeeper(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("subspace"), sdk.NewKVStoreKey("tsubspace"))
+	k := keeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("testKey"), paramsK)
+	k.ForChain(exported.Ethereum.Name).SetParams(ctx, types.DefaultParams()[0])
+	k.ForChain(exported.Ethereum.Name).SetPendingGateway(ctx, common.HexToAddress(gateway))
+	k.ForChain(exported.Ethereum.Name).ConfirmPendingGateway(ctx)
+
+	return k
+}
+
+// gatewayCommandsWithout returns the default gateway commands except for the given ones
+func gatewayCommandsWithout(commands ...string) []string {
+	var result []string
+	for _, command := range types.DefaultParams()[0].GatewayCommands {
+		if utils.IndexOf(commands, command) < 0 {
+			result = append(result, command)
+		}
+	}
+
+	return result
+}
+
 func createSignedDeployTx() *evmTypes.Transaction {
 	generator := rand.PInt64Gen()
 
@@ -1404,7 +2861,11 @@ func newKeeper(ctx sdk.Context, chain string, confHeight int64) types.BaseKeeper
 			Name: network,
 			Id:   sdk.NewIntFromUint64(uint64(rand.I64Between(1, 10))),
 		}},
-		TransactionFeeRate: sdk.NewDecWithPrec(25, 5),
+		TransactionFeeRate:           sdk.NewDecWithPrec(25, 5),
+		CommandBatchExecutionTimeout: 100,
+		GasInfoMaxAge:                100,
+		FinalityMode:                 types.FinalityDepth,
+		GatewayCommands:              types.AllGatewayCommands(),
 	})
 	k.ForChain(chain).SetPendingGateway(ctx, common.HexToAddress(gateway))
 	k.ForChain(chain).ConfirmPendingGateway(ctx)
@@ -1440,3 +2901,83 @@ func createMockERC20Token(asset string, details types.TokenDetails, minAmount sd
 		meta,
 	)
 }
+
+func TestHandleMsgSignCommands_Priority(t *testing.T) {
+	var (
+		ctx      sdk.Context
+		chaink   *mock.ChainKeeperMock
+		signer   *mock.SignerMock
+		server   types.MsgServiceServer
+		metadata types.CommandBatchMetadata
+		commands map[string]types.Command
+	)
+
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.I64Between(1, 1000000)}, false, log.TestingLogger())
+
+		metadata = evmTestUtils.RandomBatch()
+		metadata.Status = types.BatchSigning
+		commands = make(map[string]types.Command)
+		for _, commandID := range metadata.CommandIDs {
+			commands[commandID.Hex()] = types.Command{ID: commandID, Command: rand.StrBetween(5, 20), KeyID: metadata.KeyID}
+		}
+
+		chaink = &mock.ChainKeeperMock{
+			GetChainIDFunc:            func(sdk.Context) (*big.Int, bool) { return big.NewInt(rand.PosI64()), true },
+			GetLatestCommandBatchFunc: func(sdk.Context) types.CommandBatch { return types.NonExistentCommand },
+			CreateNewBatchToSignFunc: func(sdk.Context) (types.CommandBatch, error) {
+				return types.NewCommandBatch(metadata, func(batch types.CommandBatchMetadata) { metadata = batch }), nil
+			},
+			GetCommandFunc: func(_ sdk.Context, id types.CommandID) (types.Command, bool) {
+				command, ok := commands[id.Hex()]
+				return command, ok
+			},
+		}
+		basek := &mock.BaseKeeperMock{
+			ForChainFunc: func(chain string) types.ChainKeeper {
+				if strings.EqualFold(chain, evmChain) {
+					return chaink
+				}
+				return nil
+			},
+			LoggerFunc: func(sdk.Context) log.Logger { return log.TestingLogger() },
+		}
+		n := &mock.NexusMock{
+			IsChainActivatedFunc: func(sdk.Context, nexus.Chain) bool { return true },
+			GetChainFunc: func(_ sdk.Context, chain string) (nexus.Chain, bool) {
+				return exported.Ethereum, strings.EqualFold(chain, exported.Ethereum.Name)
+			},
+		}
+		signer = &mock.SignerMock{
+			GetSnapshotCounterForKeyIDFunc: func(sdk.Context, tss.KeyID) (int64, bool) { return rand.PosI64(), true },
+			StartSignFunc:                  func(sdk.Context, tss.SignInfo, types.Snapshotter, types.InitPoller) error { return nil },
+		}
+
+		server = keeper.NewMsgServerImpl(basek, &mock.TSSMock{}, n, signer, &mock.VoterMock{}, &mock.SnapshotterMock{})
+	}
+
+	repeats := 20
+	t.Run("should sign routine batches with normal priority", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := server.SignCommands(sdk.WrapSDKContext(ctx), types.NewSignCommandsRequest(rand.AccAddr(), evmChain))
+
+		assert.NoError(t, err)
+		assert.Len(t, signer.StartSignCalls(), 1)
+		assert.Equal(t, tss.SignPriority_Normal, signer.StartSignCalls()[0].Info.Priority)
+	}).Repeat(repeats))
+
+	t.Run("should sign batches that transfer the gateway to another key with high priority", testutils.Func(func(t *testing.T) {
+		setup()
+		transfer := metadata.CommandIDs[rand.I64Between(0, int64(len(metadata.CommandIDs)))]
+		command := commands[transfer.Hex()]
+		command.Command = []string{types.AxelarGatewayCommandTransferOwnership, types.AxelarGatewayCommandTransferOperatorship}[rand.I64Between(0, 2)]
+		commands[transfer.Hex()] = command
+
+		_, err := server.SignCommands(sdk.WrapSDKContext(ctx), types.NewSignCommandsRequest(rand.AccAddr(), evmChain))
+
+		assert.NoError(t, err)
+		assert.Len(t, signer.StartSignCalls(), 1)
+		assert.Equal(t, tss.SignPriority_High, signer.StartSignCalls()[0].Info.Priority)
+	}).Repeat(repeats))
+}
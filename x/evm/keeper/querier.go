@@ -31,6 +31,7 @@ const (
 	QLatestBatchedCommands = "latest-batched-commands"
 	QBatchedCommands       = "batched-commands"
 	QPendingCommands       = "pending-commands"
+	QBatchExecutionCost    = "batch-execution-cost"
 	QCommand               = "command"
 	QChains                = "chains"
 )
@@ -83,6 +84,8 @@ func NewQuerier(k types.BaseKeeper, s types.Signer, n types.Nexus) sdk.Querier {
 			return QueryLatestBatchedCommands(ctx, chainKeeper, s)
 		case QPendingCommands:
 			return QueryPendingCommands(ctx, chainKeeper, n)
+		case QBatchExecutionCost:
+			return QueryBatchExecutionCost(ctx, chainKeeper)
 		case QCommand:
 			return queryCommand(ctx, chainKeeper, n, path[2])
 		case QBytecode:
@@ -112,6 +115,72 @@ func QueryPendingCommands(ctx sdk.Context, keeper types.ChainKeeper, n types.Nex
 	return resp.Marshal()
 }
 
+// QueryBatchExecutionCost quotes the cost in wei of executing the latest command batch if it has not been fully executed yet,
+// or of the batch that would be created next from the pending commands otherwise
+func QueryBatchExecutionCost(ctx sdk.Context, keeper types.ChainKeeper) ([]byte, error) {
+	gasInfo, ok := keeper.GetGasInfo(ctx)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("no gas info confirmed for chain %s", keeper.GetName()))
+	}
+
+	maxAge, _ := keeper.GetGasInfoMaxAge(ctx)
+	resp := types.QueryBatchExecutionCostResponse{
+		GasInfo:      gasInfo,
+		GasInfoStale: gasInfo.IsStale(ctx.BlockHeight(), maxAge),
+	}
+
+	var commands []types.Command
+	latest := keeper.GetLatestCommandBatch(ctx)
+	if !latest.Is(types.BatchNonExistent) && !latest.IsExecuted() {
+		resp.BatchID = hex.EncodeToString(latest.GetID())
+
+		for _, id := range latest.GetCommandIDs() {
+			if latest.IsCommandExecuted(id) {
+				continue
+			}
+
+			cmd, ok := keeper.GetCommand(ctx, id)
+			if !ok {
+				return nil, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("could not find command '%s'", id.Hex()))
+			}
+			commands = append(commands, cmd)
+		}
+	} else {
+		commands = nextBatchCommands(keeper.GetPendingCommands(ctx), keeper.GetBatchGasLimit(ctx))
+	}
+
+	for _, cmd := range commands {
+		resp.CommandIDs = append(resp.CommandIDs, cmd.ID.Hex())
+		resp.GasLimit += uint64(cmd.MaxGasCost)
+	}
+
+	resp.ExpectedCost = gasInfo.GasPrice().Mul(sdk.NewIntFromUint64(resp.GasLimit))
+	resp.MaxCost = gasInfo.MaxGasPrice().Mul(sdk.NewIntFromUint64(resp.GasLimit))
+
+	return resp.Marshal()
+}
+
+// nextBatchCommands mirrors the selection of commands for a new batch,
+// i.e. the longest prefix of the queue that shares the same key and fits within the gas limit
+func nextBatchCommands(pending []types.Command, gasLimit uint32) []types.Command {
+	if len(pending) == 0 {
+		return nil
+	}
+
+	commands := []types.Command{pending[0]}
+	gasCost := pending[0].MaxGasCost
+	for _, cmd := range pending[1:] {
+		gasCost += cmd.MaxGasCost
+		if cmd.KeyID != pending[0].KeyID || gasCost > gasLimit {
+			break
+		}
+
+		commands = append(commands, cmd)
+	}
+
+	return commands
+}
+
 func queryCommand(ctx sdk.Context, keeper types.ChainKeeper, n types.Nexus, id string) ([]byte, error) {
 	cmdID, err := types.HexToCommandID(id)
 	if err != nil {
@@ -147,7 +216,16 @@ func GetCommandResponse(ctx sdk.Context, chainName string, n types.Nexus, cmd ty
 		params["decimals"] = strconv.FormatUint(uint64(decs), 10)
 		params["cap"] = cap.String()
 
-	case types.AxelarGatewayCommandMintToken:
+	case types.AxelarGatewayCommandRegisterToken:
+		symbol, tokenAddr, err := types.DecodeRegisterTokenParams(cmd.Params)
+		if err != nil {
+			return types.QueryCommandResponse{}, err
+		}
+
+		params["symbol"] = symbol
+		params["tokenAddress"] = tokenAddr.Hex()
+
+	case types.AxelarGatewayCommandMintToken, types.AxelarGatewayCommandUnlockToken:
 		symbol, addr, amount, err := types.DecodeMintTokenParams(cmd.Params)
 		if err != nil {
 			return types.QueryCommandResponse{}, err
@@ -157,7 +235,7 @@ func GetCommandResponse(ctx sdk.Context, chainName string, n types.Nexus, cmd ty
 		params["account"] = addr.Hex()
 		params["amount"] = amount.String()
 
-	case types.AxelarGatewayCommandBurnToken:
+	case types.AxelarGatewayCommandBurnToken, types.AxelarGatewayCommandLockToken:
 		symbol, salt, err := types.DecodeBurnTokenParams(cmd.Params)
 		if err != nil {
 			return types.QueryCommandResponse{}, err
@@ -166,6 +244,50 @@ func GetCommandResponse(ctx sdk.Context, chainName string, n types.Nexus, cmd ty
 		params["symbol"] = symbol
 		params["salt"] = salt.Hex()
 
+	case types.AxelarGatewayCommandLockNative:
+		salt, err := types.DecodeLockNativeParams(cmd.Params)
+		if err != nil {
+			return types.QueryCommandResponse{}, err
+		}
+
+		params["salt"] = salt.Hex()
+
+	case types.AxelarGatewayCommandBurnTokenBatch, types.AxelarGatewayCommandLockTokenBatch:
+		symbol, salts, err := types.DecodeBurnTokenBatchParams(cmd.Params)
+		if err != nil {
+			return types.QueryCommandResponse{}, err
+		}
+
+		params["symbol"] = symbol
+		params["salts"] = joinHashes(salts)
+
+	case types.AxelarGatewayCommandLockNativeBatch:
+		salts, err := types.DecodeLockNativeBatchParams(cmd.Params)
+		if err != nil {
+			return types.QueryCommandResponse{}, err
+		}
+
+		params["salts"] = joinHashes(salts)
+
+	case types.AxelarGatewayCommandUnlockNative:
+		addr, amount, err := types.DecodeUnlockNativeParams(cmd.Params)
+		if err != nil {
+			return types.QueryCommandResponse{}, err
+		}
+
+		params["account"] = addr.Hex()
+		params["amount"] = amount.String()
+
+	case types.AxelarGatewayCommandUpgrade:
+		newImplementation, newImplementationCodeHash, setupParams, err := types.DecodeUpgradeParams(cmd.Params)
+		if err != nil {
+			return types.QueryCommandResponse{}, err
+		}
+
+		params["newImplementation"] = newImplementation.Hex()
+		params["newImplementationCodeHash"] = newImplementationCodeHash.Hex()
+		params["setupParams"] = common.Bytes2Hex(setupParams)
+
 	case types.AxelarGatewayCommandTransferOwnership, types.AxelarGatewayCommandTransferOperatorship:
 		chain, ok := n.GetChain(ctx, chainName)
 		if !ok {
@@ -220,6 +342,15 @@ func GetCommandResponse(ctx sdk.Context, chainName string, n types.Nexus, cmd ty
 	}, nil
 }
 
+func joinHashes(hashes []common.Hash) string {
+	var hexs []string
+	for _, hash := range hashes {
+		hexs = append(hexs, hash.Hex())
+	}
+
+	return strings.Join(hexs, ";")
+}
+
 // QueryLatestBatchedCommands returns the latest batched commands
 func QueryLatestBatchedCommands(ctx sdk.Context, keeper types.ChainKeeper, s types.Signer) ([]byte, error) {
 
@@ -228,7 +359,7 @@ func QueryLatestBatchedCommands(ctx sdk.Context, keeper types.ChainKeeper, s typ
 		return nil, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("cannot find the latest signed batched commands for chain %s", keeper.GetName()))
 	}
 
-	resp, err := batchedCommandsToQueryResp(ctx, batchedCommands, s)
+	resp, err := batchedCommandsToQueryResp(ctx, batchedCommands, keeper, s)
 	if err != nil {
 		return nil, err
 	}
@@ -236,7 +367,7 @@ func QueryLatestBatchedCommands(ctx sdk.Context, keeper types.ChainKeeper, s typ
 	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
 }
 
-func batchedCommandsToQueryResp(ctx sdk.Context, batchedCommands types.CommandBatch, s types.Signer) (types.QueryBatchedCommandsResponse, error) {
+func batchedCommandsToQueryResp(ctx sdk.Context, batchedCommands types.CommandBatch, k types.ChainKeeper, s types.Signer) (types.QueryBatchedCommandsResponse, error) {
 	batchedCommandsIDHex := hex.EncodeToString(batchedCommands.GetID())
 	prevBatchedCommandsIDHex := ""
 	if batchedCommands.GetPrevBatchedCommandsID() != nil {
@@ -248,6 +379,8 @@ func batchedCommandsToQueryResp(ctx sdk.Context, batchedCommands types.CommandBa
 		commandIDs = append(commandIDs, id.Hex())
 	}
 
+	timeout, _ := k.GetCommandBatchExecutionTimeout(ctx)
+
 	var resp types.QueryBatchedCommandsResponse
 
 	switch {
@@ -300,6 +433,8 @@ func batchedCommandsToQueryResp(ctx sdk.Context, batchedCommands types.CommandBa
 			ExecuteData:           hex.EncodeToString(executeData),
 			PrevBatchedCommandsID: prevBatchedCommandsIDHex,
 			CommandIDs:            commandIDs,
+			Executions:            batchedCommands.GetExecutions(),
+			ExecutionOverdue:      batchedCommands.IsExecutionOverdue(ctx.BlockHeight(), timeout),
 		}
 	default:
 		resp = types.QueryBatchedCommandsResponse{
@@ -428,7 +563,7 @@ func QueryTokenAddressByAsset(ctx sdk.Context, k types.ChainKeeper, n types.Nexu
 		return nil, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("token for asset '%s' non-existent", asset))
 	}
 
-	resp := types.QueryTokenAddressResponse{Address: token.GetAddress().Hex()}
+	resp := types.QueryTokenAddressResponse{Address: token.GetAddress().Hex(), IsExternal: token.IsExternal()}
 	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
 }
 
@@ -444,7 +579,7 @@ func QueryTokenAddressBySymbol(ctx sdk.Context, k types.ChainKeeper, n types.Nex
 		return nil, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("token for symbol '%s' non-existent", symbol))
 	}
 
-	resp := types.QueryTokenAddressResponse{Address: token.GetAddress().Hex()}
+	resp := types.QueryTokenAddressResponse{Address: token.GetAddress().Hex(), IsExternal: token.IsExternal()}
 	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
 }
 
@@ -474,6 +609,8 @@ func QueryDepositState(ctx sdk.Context, k types.ChainKeeper, n types.Nexus, data
 		depositState = types.QueryDepositStateResponse{Status: types.DepositStatus_Confirmed, Log: "deposit transaction is confirmed"}
 	case state == types.DepositStatus_Burned:
 		depositState = types.QueryDepositStateResponse{Status: types.DepositStatus_Burned, Log: "deposit has been transferred to the destination chain"}
+	case state == types.DepositStatus_Reorged:
+		depositState = types.QueryDepositStateResponse{Status: types.DepositStatus_Reorged, Log: "deposit transaction is no longer part of the canonical chain"}
 	default:
 		return nil, sdkerrors.Wrap(types.ErrEVM, "deposit is in an unexpected state")
 	}
@@ -528,7 +665,7 @@ func QueryBatchedCommands(ctx sdk.Context, k types.ChainKeeper, s types.Signer,
 		return nil, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("batched commands with ID %s not found", batchedCommandsIDHex))
 	}
 
-	resp, err := batchedCommandsToQueryResp(ctx, batchedCommands, s)
+	resp, err := batchedCommandsToQueryResp(ctx, batchedCommands, k, s)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	evmTest "github.com/axelarnetwork/axelar-core/x/evm/types/testutils"
 	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
 	tssTestUtils "github.com/axelarnetwork/axelar-core/x/tss/exported/testutils"
+	"github.com/btcsuite/btcd/btcec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -380,3 +382,204 @@ func createMockConfirmedERC20Token(asset string, addr types.Address, details typ
 		meta,
 	)
 }
+
+func TestQueryBatchExecutionCost(t *testing.T) {
+	var (
+		chainKeeper *mock.ChainKeeperMock
+		ctx         sdk.Context
+		gasInfo     types.GasInfo
+		keyID       tss.KeyID
+		cmds        []types.Command
+	)
+
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.I64Between(1000, 100000)}, false, log.TestingLogger())
+		chainID := big.NewInt(1)
+		keyID = tssTestUtils.RandKeyID()
+
+		cmds = nil
+		for i := 0; i < 3; i++ {
+			cmd, err := types.CreateMintTokenCommand(keyID, types.NewCommandID(rand.Bytes(10), chainID), rand.Str(5), common.BytesToAddress(rand.Bytes(common.AddressLength)), big.NewInt(rand.I64Between(1000, 100000)))
+			assert.NoError(t, err)
+			cmds = append(cmds, cmd)
+		}
+		otherKeyCmd, err := types.CreateMintTokenCommand(tssTestUtils.RandKeyID(), types.NewCommandID(rand.Bytes(10), chainID), rand.Str(5), common.BytesToAddress(rand.Bytes(common.AddressLength)), big.NewInt(rand.I64Between(1000, 100000)))
+		assert.NoError(t, err)
+		cmds = append(cmds, otherKeyCmd)
+
+		gasInfo = types.GasInfo{
+			BlockNumber:       uint64(rand.PosI64()),
+			BaseFee:           sdk.NewInt(rand.I64Between(1, 1000000000)),
+			PriorityFee:       sdk.NewInt(rand.I64Between(1, 1000000000)),
+			BlockGasLimit:     uint64(rand.I64Between(1000000, 30000000)),
+			ConfirmedAtHeight: ctx.BlockHeight() - 10,
+		}
+
+		chainKeeper = &mock.ChainKeeperMock{
+			GetNameFunc:          func() string { return rand.StrBetween(5, 10) },
+			GetGasInfoFunc:       func(sdk.Context) (types.GasInfo, bool) { return gasInfo, true },
+			GetGasInfoMaxAgeFunc: func(sdk.Context) (int64, bool) { return 100, true },
+			GetLatestCommandBatchFunc: func(sdk.Context) types.CommandBatch {
+				return types.NewCommandBatch(types.CommandBatchMetadata{}, func(types.CommandBatchMetadata) {})
+			},
+			GetPendingCommandsFunc: func(sdk.Context) []types.Command { return cmds },
+			GetBatchGasLimitFunc:   func(sdk.Context) uint32 { return 5000000 },
+		}
+	}
+
+	repeatCount := 20
+
+	t.Run("should quote the commands of the next batch", testutils.Func(func(t *testing.T) {
+		setup()
+
+		bz, err := evmKeeper.QueryBatchExecutionCost(ctx, chainKeeper)
+		assert.NoError(t, err)
+
+		var res types.QueryBatchExecutionCostResponse
+		assert.NoError(t, res.Unmarshal(bz))
+
+		expectedGas := uint64(0)
+		var expectedIDs []string
+		for _, cmd := range cmds[:3] {
+			expectedGas += uint64(cmd.MaxGasCost)
+			expectedIDs = append(expectedIDs, cmd.ID.Hex())
+		}
+
+		assert.Empty(t, res.BatchID)
+		assert.Equal(t, expectedIDs, res.CommandIDs)
+		assert.Equal(t, expectedGas, res.GasLimit)
+		assert.False(t, res.GasInfoStale)
+		assert.Equal(t, gasInfo.BaseFee.Add(gasInfo.PriorityFee).MulRaw(int64(expectedGas)), res.ExpectedCost)
+		assert.Equal(t, gasInfo.BaseFee.MulRaw(2).Add(gasInfo.PriorityFee).MulRaw(int64(expectedGas)), res.MaxCost)
+	}).Repeat(repeatCount))
+
+	t.Run("should respect the batch gas limit", testutils.Func(func(t *testing.T) {
+		setup()
+		chainKeeper.GetBatchGasLimitFunc = func(sdk.Context) uint32 { return cmds[0].MaxGasCost }
+
+		bz, err := evmKeeper.QueryBatchExecutionCost(ctx, chainKeeper)
+		assert.NoError(t, err)
+
+		var res types.QueryBatchExecutionCostResponse
+		assert.NoError(t, res.Unmarshal(bz))
+		assert.Equal(t, []string{cmds[0].ID.Hex()}, res.CommandIDs)
+	}).Repeat(repeatCount))
+
+	t.Run("should flag stale gas info", testutils.Func(func(t *testing.T) {
+		setup()
+		gasInfo.ConfirmedAtHeight = ctx.BlockHeight() - 101
+
+		bz, err := evmKeeper.QueryBatchExecutionCost(ctx, chainKeeper)
+		assert.NoError(t, err)
+
+		var res types.QueryBatchExecutionCostResponse
+		assert.NoError(t, res.Unmarshal(bz))
+		assert.True(t, res.GasInfoStale)
+	}).Repeat(repeatCount))
+
+	t.Run("should return error when no gas info is confirmed", testutils.Func(func(t *testing.T) {
+		setup()
+		chainKeeper.GetGasInfoFunc = func(sdk.Context) (types.GasInfo, bool) { return types.GasInfo{}, false }
+
+		_, err := evmKeeper.QueryBatchExecutionCost(ctx, chainKeeper)
+		assert.Error(t, err)
+	}).Repeat(repeatCount))
+}
+
+func TestQueryBatchedCommands_ExecutionOverdue(t *testing.T) {
+	var (
+		chainKeeper *mock.ChainKeeperMock
+		signer      *mock.SignerMock
+		n           *mock.NexusMock
+		ctx         sdk.Context
+		metadata    types.CommandBatchMetadata
+		timeout     int64
+	)
+
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.I64Between(1000, 100000)}, false, log.TestingLogger())
+		timeout = rand.I64Between(10, 100)
+
+		metadata = evmTest.RandomBatch()
+		metadata.Status = types.BatchSigned
+		metadata.Executions = nil
+
+		privKey, err := btcec.NewPrivateKey(btcec.S256())
+		assert.NoError(t, err)
+		sig, err := privKey.Sign(metadata.SigHash.Bytes())
+		assert.NoError(t, err)
+		pk := btcec.PublicKey(privKey.PublicKey)
+
+		chainKeeper = &mock.ChainKeeperMock{
+			GetNameFunc: func() string { return exported.Ethereum.Name },
+			GetBatchByIDFunc: func(sdk.Context, []byte) types.CommandBatch {
+				return types.NewCommandBatch(metadata, func(batch types.CommandBatchMetadata) { metadata = batch })
+			},
+			GetCommandBatchExecutionTimeoutFunc: func(sdk.Context) (int64, bool) { return timeout, true },
+		}
+		signer = &mock.SignerMock{
+			GetSigFunc: func(sdk.Context, string) (tss.Signature, tss.SigStatus) {
+				return tss.Signature{Sig: &tss.Signature_SingleSig_{SingleSig: &tss.Signature_SingleSig{
+					SigKeyPair: tss.SigKeyPair{PubKey: pk.SerializeCompressed(), Signature: sig.Serialize()},
+				}}}, tss.SigStatus_Signed
+			},
+		}
+		n = &mock.NexusMock{
+			GetChainFunc: func(sdk.Context, string) (nexus.Chain, bool) { return exported.Ethereum, true },
+		}
+	}
+
+	query := func() types.QueryBatchedCommandsResponse {
+		bz, err := evmKeeper.QueryBatchedCommands(ctx, chainKeeper, signer, n, hex.EncodeToString(metadata.ID))
+		assert.NoError(t, err)
+
+		var res types.QueryBatchedCommandsResponse
+		types.ModuleCdc.MustUnmarshalLengthPrefixed(bz, &res)
+		return res
+	}
+
+	repeatCount := 20
+
+	t.Run("should flag a signed batch that is not executed within the timeout", testutils.Func(func(t *testing.T) {
+		setup()
+		metadata.SignedAtHeight = ctx.BlockHeight() - timeout
+
+		res := query()
+		assert.True(t, res.ExecutionOverdue)
+		assert.Empty(t, res.Executions)
+	}).Repeat(repeatCount))
+
+	t.Run("should not flag a signed batch before the timeout", testutils.Func(func(t *testing.T) {
+		setup()
+		metadata.SignedAtHeight = ctx.BlockHeight() - timeout + 1
+
+		assert.False(t, query().ExecutionOverdue)
+	}).Repeat(repeatCount))
+
+	t.Run("should not flag a partially executed batch until all commands are executed", testutils.Func(func(t *testing.T) {
+		setup()
+		metadata.SignedAtHeight = ctx.BlockHeight() - timeout
+		txID := evmTest.RandomHash()
+		batch := chainKeeper.GetBatchByID(ctx, metadata.ID)
+		batch.SetCommandsExecuted(txID, ctx.BlockHeight(), metadata.CommandIDs[0])
+
+		res := query()
+		assert.Equal(t, len(metadata.CommandIDs) > 1, res.ExecutionOverdue)
+		assert.Len(t, res.Executions, 1)
+		assert.Equal(t, metadata.CommandIDs[0], res.Executions[0].CommandID)
+		assert.Equal(t, txID, res.Executions[0].TxID)
+
+		batch = chainKeeper.GetBatchByID(ctx, metadata.ID)
+		batch.SetCommandsExecuted(txID, ctx.BlockHeight(), metadata.CommandIDs...)
+
+		assert.False(t, query().ExecutionOverdue)
+	}).Repeat(repeatCount))
+
+	t.Run("should not flag batches without an execution timeout", testutils.Func(func(t *testing.T) {
+		setup()
+		metadata.SignedAtHeight = 1
+		timeout = 0
+
+		assert.False(t, query().ExecutionOverdue)
+	}).Repeat(repeatCount))
+}
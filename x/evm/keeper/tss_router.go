@@ -43,6 +43,7 @@ func handleUnsignedBatchedCommands(ctx sdk.Context, keeper types.ChainKeeper, si
 	switch sigStatus {
 	case tss.SigStatus_Signed:
 		commandBatch.SetStatus(types.BatchSigned)
+		commandBatch.SetSignedAtHeight(ctx.BlockHeight())
 		keeper.DeleteUnsignedCommandBatchID(ctx)
 		keeper.SetLatestSignedCommandBatchID(ctx, commandBatch.GetID())
 
@@ -24,11 +24,21 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&ConfirmTransferKeyRequest{}, "evm/ConfirmTransferKey", nil)
 	cdc.RegisterConcrete(&CreatePendingTransfersRequest{}, "evm/CreatePendingTransfers", nil)
 	cdc.RegisterConcrete(&CreateDeployTokenRequest{}, "evm/CreateDeployToken", nil)
+	cdc.RegisterConcrete(&CreateRegisterExternalTokenRequest{}, "evm/CreateRegisterExternalToken", nil)
 	cdc.RegisterConcrete(&CreateBurnTokensRequest{}, "evm/CreateBurnTokens", nil)
 	cdc.RegisterConcrete(&CreateTransferOwnershipRequest{}, "evm/CreateTransferOwnership", nil)
 	cdc.RegisterConcrete(&CreateTransferOperatorshipRequest{}, "evm/CreateTransferOperatorship", nil)
 	cdc.RegisterConcrete(&SignCommandsRequest{}, "evm/SignCommands", nil)
 	cdc.RegisterConcrete(&AddChainRequest{}, "evm/AddChainRequest", nil)
+	cdc.RegisterConcrete(&ConfirmBatchExecutionRequest{}, "evm/ConfirmBatchExecution", nil)
+	cdc.RegisterConcrete(&VoteConfirmBatchExecutionRequest{}, "evm/VoteConfirmBatchExecution", nil)
+	cdc.RegisterConcrete(&ConfirmGasInfoRequest{}, "evm/ConfirmGasInfo", nil)
+	cdc.RegisterConcrete(&VoteConfirmGasInfoRequest{}, "evm/VoteConfirmGasInfo", nil)
+	cdc.RegisterConcrete(&ReverifyDepositRequest{}, "evm/ReverifyDeposit", nil)
+	cdc.RegisterConcrete(&VoteReverifyDepositRequest{}, "evm/VoteReverifyDeposit", nil)
+	cdc.RegisterConcrete(&CreateUpgradeGatewayRequest{}, "evm/CreateUpgradeGateway", nil)
+	cdc.RegisterConcrete(&ConfirmGatewayUpgradeRequest{}, "evm/ConfirmGatewayUpgrade", nil)
+	cdc.RegisterConcrete(&VoteConfirmGatewayUpgradeRequest{}, "evm/VoteConfirmGatewayUpgrade", nil)
 }
 
 // RegisterInterfaces registers types and interfaces with the given registry
@@ -47,14 +57,26 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&ConfirmTransferKeyRequest{},
 		&CreatePendingTransfersRequest{},
 		&CreateDeployTokenRequest{},
+		&CreateRegisterExternalTokenRequest{},
 		&CreateBurnTokensRequest{},
 		&CreateTransferOwnershipRequest{},
 		&CreateTransferOperatorshipRequest{},
 		&SignCommandsRequest{},
 		&AddChainRequest{},
+		&ConfirmBatchExecutionRequest{},
+		&VoteConfirmBatchExecutionRequest{},
+		&ConfirmGasInfoRequest{},
+		&VoteConfirmGasInfoRequest{},
+		&ReverifyDepositRequest{},
+		&VoteReverifyDepositRequest{},
+		&CreateUpgradeGatewayRequest{},
+		&ConfirmGatewayUpgradeRequest{},
+		&VoteConfirmGatewayUpgradeRequest{},
 	)
 	registry.RegisterImplementations((*codec.ProtoMarshaler)(nil),
 		&gogoprototypes.BoolValue{},
+		&ExecutedCommands{},
+		&GasInfo{},
 	)
 
 	registry.RegisterImplementations((*reward.Refundable)(nil),
@@ -63,6 +85,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&VoteConfirmChainRequest{},
 		&VoteConfirmTransferKeyRequest{},
 		&VoteConfirmGatewayDeploymentRequest{},
+		&VoteConfirmBatchExecutionRequest{},
+		&VoteConfirmGasInfoRequest{},
+		&VoteReverifyDepositRequest{},
+		&VoteConfirmGatewayUpgradeRequest{},
 	)
 }
 
@@ -9,6 +9,10 @@ const (
 	EventTypeTokenConfirmation             = "tokenConfirmation"
 	EventTypeTransferKeyConfirmation       = "transferKeyConfirmation"
 	EventTypeLink                          = "link"
+	EventTypeBatchExecutionConfirmation    = "batchExecutionConfirmation"
+	EventTypeGasInfoConfirmation           = "gasInfoConfirmation"
+	EventTypeDepositReverification         = "depositReverification"
+	EventTypeGatewayUpgradeConfirmation    = "gatewayUpgradeConfirmation"
 )
 
 // Event attribute keys
@@ -22,6 +26,7 @@ const (
 	AttributeKeyTransferKeyType    = "transferKeyType"
 	AttributeKeyKeyType            = "keyType"
 	AttributeKeyAmount             = "amount"
+	AttributeKeyUnrecoveredAmount  = "unrecoveredAmount"
 	AttributeKeyDepositAddress     = "depositAddress"
 	AttributeKeyTokenAddress       = "tokenAddress"
 	AttributeKeyGatewayAddress     = "gatewayAddress"
@@ -29,10 +34,16 @@ const (
 	AttributeKeyConfHeight         = "confHeight"
 	AttributeKeyAsset              = "asset"
 	AttributeKeySymbol             = "symbol"
+	AttributeKeyDecimals           = "decimals"
 	AttributeKeyNativeAsset        = "nativeAsset"
 	AttributeKeyDestinationChain   = "destinationChain"
 	AttributeKeyDestinationAddress = "destinationAddress"
 	AttributeKeyValue              = "value"
+	AttributeKeyCommandIDs         = "commandIDs"
+	AttributeKeyBlockNumber        = "blockNumber"
+	AttributeKeyBaseFee            = "baseFee"
+	AttributeKeyPriorityFee        = "priorityFee"
+	AttributeKeyFinalityMode       = "finalityMode"
 )
 
 // Event attribute values
@@ -42,4 +53,5 @@ const (
 	AttributeValueReject  = "reject"
 	AttributeValueConfirm = "confirm"
 	AttributeValueVote    = "vote"
+	AttributeValueReorg   = "reorg"
 )
@@ -48,32 +48,55 @@ type ChainKeeper interface {
 	GetBurnerByteCodes(ctx sdk.Context) ([]byte, bool)
 	GetTokenByteCodes(ctx sdk.Context) ([]byte, bool)
 	GetTransactionFeeRate(ctx sdk.Context) (sdk.Dec, bool)
+	GetCommandBatchExecutionTimeout(ctx sdk.Context) (int64, bool)
+	GetGasInfoMaxAge(ctx sdk.Context) (int64, bool)
+	GetBatchGasLimit(ctx sdk.Context) uint32
+	GetFinalityMode(ctx sdk.Context) (FinalityMode, bool)
+	GetGatewayCommands(ctx sdk.Context) ([]string, bool)
 	SetPendingGateway(ctx sdk.Context, address common.Address)
 	ConfirmPendingGateway(ctx sdk.Context) error
 	DeletePendingGateway(ctx sdk.Context) error
 	GetPendingGatewayAddress(ctx sdk.Context) (common.Address, bool)
 	GetGatewayAddress(ctx sdk.Context) (common.Address, bool)
+	SetPendingGatewayUpgrade(ctx sdk.Context, implementation common.Address, commands []string) error
+	GetPendingGatewayUpgrade(ctx sdk.Context) (common.Address, bool)
+	ConfirmPendingGatewayUpgrade(ctx sdk.Context) error
 	GetDeposit(ctx sdk.Context, txID common.Hash, burnerAddr common.Address) (ERC20Deposit, DepositStatus, bool)
 	GetBurnerInfo(ctx sdk.Context, address common.Address) *BurnerInfo
 	SetPendingDeposit(ctx sdk.Context, key vote.PollKey, deposit *ERC20Deposit)
 	GetBurnerAddressAndSalt(ctx sdk.Context, tokenAddr Address, recipient string, gatewayAddr common.Address) (common.Address, common.Hash, error)
+	GetNativeBurnerAddressAndSalt(ctx sdk.Context, recipient string, gatewayAddr common.Address) (common.Address, common.Hash)
 	SetBurnerInfo(ctx sdk.Context, burnerInfo BurnerInfo)
+	SetBurnerAddressForRecipient(ctx sdk.Context, asset string, recipient nexus.CrossChainAddress, burnerAddr common.Address)
+	GetBurnerAddressForRecipient(ctx sdk.Context, asset string, recipient nexus.CrossChainAddress) (common.Address, bool)
 	GetPendingDeposit(ctx sdk.Context, key vote.PollKey) (ERC20Deposit, bool)
 	DeletePendingDeposit(ctx sdk.Context, key vote.PollKey)
 	DeleteDeposit(ctx sdk.Context, deposit ERC20Deposit)
 	SetDeposit(ctx sdk.Context, deposit ERC20Deposit, state DepositStatus)
+	SetPendingReverification(ctx sdk.Context, key vote.PollKey, deposit *ERC20Deposit)
+	GetPendingReverification(ctx sdk.Context, key vote.PollKey) (ERC20Deposit, bool)
+	DeletePendingReverification(ctx sdk.Context, key vote.PollKey)
 	GetConfirmedDeposits(ctx sdk.Context) []ERC20Deposit
 	GetPendingTransferKey(ctx sdk.Context, key vote.PollKey) (TransferKey, bool)
 	SetPendingTransferKey(ctx sdk.Context, key vote.PollKey, transferOwnership *TransferKey)
 	GetArchivedTransferKey(ctx sdk.Context, key vote.PollKey) (TransferKey, bool)
 	ArchiveTransferKey(ctx sdk.Context, key vote.PollKey)
 	DeletePendingTransferKey(ctx sdk.Context, key vote.PollKey)
+	SetPendingBatchExecution(ctx sdk.Context, key vote.PollKey, execution *PendingBatchExecution)
+	GetPendingBatchExecution(ctx sdk.Context, key vote.PollKey) (PendingBatchExecution, bool)
+	DeletePendingBatchExecution(ctx sdk.Context, key vote.PollKey)
+	SetPendingGasInfo(ctx sdk.Context, key vote.PollKey, blockNumber uint64)
+	GetPendingGasInfo(ctx sdk.Context, key vote.PollKey) (uint64, bool)
+	DeletePendingGasInfo(ctx sdk.Context, key vote.PollKey)
+	SetGasInfo(ctx sdk.Context, gasInfo GasInfo)
+	GetGasInfo(ctx sdk.Context) (GasInfo, bool)
 	GetNetworkByID(ctx sdk.Context, id *big.Int) (string, bool)
 	GetChainIDByNetwork(ctx sdk.Context, network string) *big.Int
 	GetVotingThreshold(ctx sdk.Context) (utils.Threshold, bool)
 	GetMinVoterCount(ctx sdk.Context) (int64, bool)
 
 	CreateERC20Token(ctx sdk.Context, asset string, details TokenDetails, minDeposit sdk.Int) (ERC20Token, error)
+	CreateExternalERC20Token(ctx sdk.Context, asset string, details TokenDetails, minDeposit sdk.Int, tokenAddr Address) (ERC20Token, error)
 	GetERC20TokenByAsset(ctx sdk.Context, asset string) ERC20Token
 	GetERC20TokenBySymbol(ctx sdk.Context, symbol string) ERC20Token
 
@@ -118,7 +141,14 @@ type Nexus interface {
 	GetChains(ctx sdk.Context) []nexus.Chain
 	GetChain(ctx sdk.Context, chain string) (nexus.Chain, bool)
 	IsAssetRegistered(ctx sdk.Context, chain nexus.Chain, denom string) bool
+	RevertTransfer(ctx sdk.Context, sender nexus.CrossChainAddress, asset sdk.Coin, feeRate sdk.Dec) (sdk.Coin, error)
+	GetChainsWithAsset(ctx sdk.Context, denom string) []nexus.Chain
 	RegisterAsset(ctx sdk.Context, chain nexus.Chain, denom string)
+	RegisterNativeAsset(ctx sdk.Context, chain nexus.Chain, denom string) error
+	SetAssetPrecision(ctx sdk.Context, asset string, decimals uint32) error
+	GetAssetPrecision(ctx sdk.Context, asset string) (uint32, bool)
+	RegisterAssetDecimals(ctx sdk.Context, chain nexus.Chain, asset string, decimals uint32) error
+	ToChainAmount(ctx sdk.Context, chain nexus.Chain, coin sdk.Coin) (sdk.Int, sdk.Int, error)
 	GetChainMaintainers(ctx sdk.Context, chain nexus.Chain) []sdk.ValAddress
 	IsChainActivated(ctx sdk.Context, chain nexus.Chain) bool
 }
@@ -45,6 +45,7 @@ func DefaultChains() []GenesisState_Chain {
 			CommandBatches:    nil,
 			Gateway:           Gateway{},
 			Tokens:            nil,
+			ReorgedDeposits:   nil,
 		}
 		chains = append(chains, chain)
 	}
@@ -77,6 +78,10 @@ func (m GenesisState) Validate() error {
 				return getValidateError(j, sdkerrors.Wrap(fmt.Errorf("cannot have burned deposits"), errStr))
 			}
 
+			if len(chain.ReorgedDeposits) > 0 {
+				return getValidateError(j, sdkerrors.Wrap(fmt.Errorf("cannot have reorged deposits"), errStr))
+			}
+
 			if len(chain.BurnerInfos) > 0 {
 				return getValidateError(j, sdkerrors.Wrap(fmt.Errorf("cannot have burned deposits"), errStr))
 			}
@@ -118,6 +123,16 @@ func (m GenesisState) Validate() error {
 			}
 		}
 
+		for i, deposit := range chain.ReorgedDeposits {
+			if err := deposit.ValidateBasic(); err != nil {
+				return getValidateError(j, sdkerrors.Wrapf(err, "invalid reorged deposit %d", i))
+			}
+
+			if err := checkBurnerInfo(deposit, chain.BurnerInfos); err != nil {
+				return getValidateError(j, sdkerrors.Wrapf(err, "invalid reorged deposit %d", i))
+			}
+		}
+
 		if err := validateCommandBatches(chain.CommandBatches); err != nil {
 			return getValidateError(j, sdkerrors.Wrapf(err, "invalid command batches"))
 		}
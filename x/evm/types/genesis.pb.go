@@ -66,6 +66,7 @@ type GenesisState_Chain struct {
 	CommandBatches    []CommandBatchMetadata `protobuf:"bytes,8,rep,name=command_batches,json=commandBatches,proto3" json:"command_batches"`
 	Gateway           Gateway                `protobuf:"bytes,9,opt,name=gateway,proto3" json:"gateway"`
 	Tokens            []ERC20TokenMetadata   `protobuf:"bytes,10,rep,name=tokens,proto3" json:"tokens"`
+	ReorgedDeposits   []ERC20Deposit         `protobuf:"bytes,11,rep,name=reorged_deposits,json=reorgedDeposits,proto3" json:"reorged_deposits"`
 }
 
 func (m *GenesisState_Chain) Reset()         { *m = GenesisState_Chain{} }
@@ -110,39 +111,40 @@ func init() {
 func init() { proto.RegisterFile("evm/v1beta1/genesis.proto", fileDescriptor_b9e6f17f1815805e) }
 
 var fileDescriptor_b9e6f17f1815805e = []byte{
-	// 509 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x14, 0x85, 0xed, 0x24, 0xce, 0xff, 0x77, 0x12, 0x28, 0x1d, 0x2a, 0xd5, 0xf5, 0xc2, 0x29, 0xac,
-	0x2a, 0x24, 0xec, 0x26, 0xb0, 0x40, 0x95, 0x90, 0x50, 0x42, 0x05, 0x2c, 0xa8, 0x4a, 0x80, 0x0d,
-	0x42, 0x8a, 0xc6, 0xce, 0x8d, 0x63, 0xa5, 0x9e, 0x09, 0x9e, 0x71, 0xda, 0xbc, 0x05, 0x62, 0xc5,
-	0x96, 0xb7, 0xc9, 0xb2, 0x4b, 0x56, 0x08, 0x92, 0x0d, 0x8f, 0x81, 0x3c, 0x33, 0x89, 0x6c, 0xa8,
-	0x04, 0x3b, 0xe7, 0x9e, 0xf3, 0x9d, 0x7b, 0x6f, 0x66, 0x06, 0xed, 0xc3, 0x2c, 0xf1, 0x67, 0xed,
-	0x00, 0x04, 0x69, 0xfb, 0x11, 0x50, 0xe0, 0x31, 0xf7, 0xa6, 0x29, 0x13, 0x0c, 0x37, 0x60, 0x96,
-	0x78, 0x5a, 0x72, 0x76, 0x23, 0x16, 0x31, 0x59, 0xf7, 0xf3, 0x2f, 0x65, 0x71, 0xec, 0x22, 0x3d,
-	0x25, 0x29, 0x49, 0x34, 0xec, 0xec, 0x15, 0x15, 0x31, 0x9f, 0x82, 0x16, 0xee, 0xfe, 0xb4, 0x50,
-	0xf3, 0x99, 0xea, 0xf3, 0x5a, 0x10, 0x01, 0xf8, 0x31, 0xaa, 0x87, 0x63, 0x12, 0x53, 0x6e, 0x57,
-	0x0f, 0xaa, 0x87, 0x8d, 0x4e, 0xcb, 0x2b, 0xf4, 0xf5, 0x8a, 0x56, 0xaf, 0x97, 0xfb, 0xba, 0xb5,
-	0xc5, 0xb7, 0x96, 0xd1, 0xd7, 0x90, 0xf3, 0xc9, 0x42, 0x96, 0xac, 0xe3, 0x36, 0xaa, 0xab, 0x11,
-	0x6c, 0xf3, 0xc0, 0x3c, 0x6c, 0x74, 0x6e, 0x97, 0x82, 0xce, 0xa4, 0xb4, 0x86, 0x95, 0x11, 0x3f,
-	0x41, 0xcd, 0x20, 0x4b, 0x29, 0xa4, 0x83, 0x98, 0x8e, 0x18, 0xb7, 0x2b, 0x72, 0x82, 0xbd, 0x12,
-	0xd8, 0x95, 0x86, 0x17, 0x74, 0xc4, 0x34, 0xdc, 0x08, 0x36, 0x15, 0x8e, 0xdf, 0xa3, 0x1b, 0x21,
-	0x4b, 0x12, 0x42, 0x87, 0x83, 0x0f, 0x19, 0x64, 0xa0, 0x97, 0x68, 0xff, 0x65, 0x09, 0xaf, 0xa7,
-	0xa0, 0x57, 0x39, 0x73, 0x42, 0x45, 0x3a, 0xd7, 0xe1, 0xcd, 0xb0, 0x20, 0xe0, 0x53, 0x84, 0x43,
-	0x46, 0x47, 0x71, 0x9a, 0xc0, 0x70, 0x30, 0x84, 0x29, 0xe3, 0xb1, 0xe0, 0x76, 0x4d, 0xb6, 0xd8,
-	0x2f, 0xb5, 0x38, 0xe9, 0xf7, 0x3a, 0x47, 0x4f, 0x95, 0x43, 0x47, 0xed, 0x6c, 0x50, 0x5d, 0xe7,
-	0xf8, 0x39, 0xda, 0x96, 0xc3, 0x17, 0xc2, 0xac, 0x7f, 0x0b, 0xbb, 0xa9, 0xb8, 0x4d, 0xd2, 0x19,
-	0xda, 0x5e, 0xef, 0x1d, 0x10, 0x11, 0x8e, 0x81, 0xdb, 0xff, 0xcb, 0xa4, 0x3b, 0xa5, 0x24, 0xbd,
-	0x66, 0x37, 0xb7, 0xbc, 0x04, 0x41, 0x86, 0x44, 0x90, 0x75, 0x62, 0x58, 0xd0, 0x80, 0xe3, 0x87,
-	0xe8, 0xbf, 0x88, 0x08, 0xb8, 0x20, 0x73, 0x7b, 0x4b, 0x9e, 0xdf, 0x6e, 0xf9, 0x3f, 0x54, 0x9a,
-	0x86, 0xd7, 0xd6, 0xfc, 0xf6, 0x08, 0x36, 0x01, 0xca, 0x6d, 0x74, 0xcd, 0xed, 0x91, 0x8b, 0xbc,
-	0xc9, 0xf5, 0xdf, 0x9a, 0x6b, 0xc8, 0x79, 0x8b, 0x76, 0xfe, 0x38, 0x09, 0x7c, 0x0b, 0x55, 0x27,
-	0x30, 0x97, 0xb7, 0x68, 0xab, 0x9f, 0x7f, 0xe2, 0x7b, 0xc8, 0x9a, 0x91, 0xf3, 0x0c, 0xec, 0xca,
-	0x35, 0x93, 0xe9, 0x80, 0xbe, 0xb2, 0x1c, 0x57, 0x1e, 0x99, 0xc7, 0xb5, 0xcf, 0x5f, 0x5a, 0x66,
-	0xf7, 0x74, 0xf1, 0xc3, 0x35, 0x16, 0x4b, 0xd7, 0xbc, 0x5a, 0xba, 0xe6, 0xf7, 0xa5, 0x6b, 0x7e,
-	0x5c, 0xb9, 0xc6, 0xd5, 0xca, 0x35, 0xbe, 0xae, 0x5c, 0xe3, 0xdd, 0x51, 0x14, 0x8b, 0x71, 0x16,
-	0x78, 0x21, 0x4b, 0x7c, 0x72, 0x09, 0xe7, 0x24, 0xa5, 0x20, 0x2e, 0x58, 0x3a, 0xd1, 0xbf, 0xee,
-	0x87, 0x2c, 0x05, 0xff, 0xd2, 0xcf, 0x1f, 0x92, 0x7c, 0x40, 0x41, 0x5d, 0xbe, 0xa0, 0x07, 0xbf,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xf4, 0x65, 0xc1, 0x6b, 0xb4, 0x03, 0x00, 0x00,
+	// 523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x9b, 0xf5, 0x0f, 0xcc, 0x2d, 0x74, 0x33, 0x93, 0x96, 0xe5, 0x90, 0x0e, 0x4e, 0x13,
+	0x12, 0xc9, 0x5a, 0x38, 0xa0, 0x49, 0x48, 0xa8, 0x65, 0xe2, 0x8f, 0xc4, 0x34, 0x0a, 0x5c, 0x10,
+	0x52, 0xe5, 0xa4, 0x6f, 0xd3, 0xa8, 0x8b, 0x5d, 0x6c, 0xa7, 0x5b, 0xbf, 0x05, 0x47, 0xae, 0x7c,
+	0x07, 0x3e, 0x44, 0x8f, 0x3b, 0x72, 0x9a, 0xa0, 0xfd, 0x22, 0x28, 0x8e, 0x53, 0x25, 0x30, 0x89,
+	0xdd, 0x12, 0x3f, 0xcf, 0xef, 0x79, 0xfd, 0xbe, 0xb6, 0xd1, 0x1e, 0xcc, 0x22, 0x77, 0xd6, 0xf6,
+	0x40, 0x92, 0xb6, 0x1b, 0x00, 0x05, 0x11, 0x0a, 0x67, 0xca, 0x99, 0x64, 0xb8, 0x0e, 0xb3, 0xc8,
+	0xd1, 0x92, 0xb5, 0x13, 0xb0, 0x80, 0xa9, 0x75, 0x37, 0xf9, 0x4a, 0x2d, 0x96, 0x99, 0xa7, 0xa7,
+	0x84, 0x93, 0x48, 0xc3, 0xd6, 0x6e, 0x5e, 0x91, 0xf3, 0x29, 0x68, 0xe1, 0xc1, 0x8f, 0x1a, 0x6a,
+	0xbc, 0x4c, 0xeb, 0xbc, 0x97, 0x44, 0x02, 0x7e, 0x86, 0x6a, 0xfe, 0x98, 0x84, 0x54, 0x98, 0xe5,
+	0xfd, 0xf2, 0x41, 0xbd, 0xd3, 0x72, 0x72, 0x75, 0x9d, 0xbc, 0xd5, 0xe9, 0x25, 0xbe, 0x6e, 0x65,
+	0x71, 0xd5, 0x2a, 0xf5, 0x35, 0x64, 0x5d, 0x55, 0x51, 0x55, 0xad, 0xe3, 0x36, 0xaa, 0xa5, 0x5b,
+	0x30, 0x8d, 0x7d, 0xe3, 0xa0, 0xde, 0xb9, 0x57, 0x08, 0x3a, 0x55, 0x52, 0x06, 0xa7, 0x46, 0xfc,
+	0x1c, 0x35, 0xbc, 0x98, 0x53, 0xe0, 0x83, 0x90, 0x8e, 0x98, 0x30, 0x37, 0xd4, 0x0e, 0x76, 0x0b,
+	0x60, 0x57, 0x19, 0x5e, 0xd3, 0x11, 0xd3, 0x70, 0xdd, 0x5b, 0xaf, 0x08, 0xfc, 0x19, 0xdd, 0xf1,
+	0x59, 0x14, 0x11, 0x3a, 0x1c, 0x7c, 0x89, 0x21, 0x06, 0xdd, 0x44, 0xfb, 0x3f, 0x4d, 0x38, 0xbd,
+	0x14, 0x7a, 0x97, 0x30, 0xc7, 0x54, 0xf2, 0xb9, 0x0e, 0x6f, 0xf8, 0x39, 0x01, 0x9f, 0x20, 0xec,
+	0x33, 0x3a, 0x0a, 0x79, 0x04, 0xc3, 0xc1, 0x10, 0xa6, 0x4c, 0x84, 0x52, 0x98, 0x15, 0x55, 0x62,
+	0xaf, 0x50, 0xe2, 0xb8, 0xdf, 0xeb, 0x1c, 0xbe, 0x48, 0x1d, 0x3a, 0x6a, 0x7b, 0x8d, 0xea, 0x75,
+	0x81, 0x5f, 0xa1, 0xa6, 0xda, 0x7c, 0x2e, 0xac, 0x7a, 0xb3, 0xb0, 0xbb, 0x29, 0xb7, 0x4e, 0x3a,
+	0x45, 0xcd, 0xac, 0x6f, 0x8f, 0x48, 0x7f, 0x0c, 0xc2, 0xbc, 0xad, 0x92, 0xee, 0x17, 0x92, 0x74,
+	0x9b, 0xdd, 0xc4, 0xf2, 0x16, 0x24, 0x19, 0x12, 0x49, 0xb2, 0x44, 0x3f, 0xa7, 0x81, 0xc0, 0x4f,
+	0xd0, 0xad, 0x80, 0x48, 0x38, 0x27, 0x73, 0x73, 0x53, 0x9d, 0xdf, 0x4e, 0x71, 0x86, 0xa9, 0xa6,
+	0xe1, 0xcc, 0x9a, 0xdc, 0x1e, 0xc9, 0x26, 0x40, 0x85, 0x89, 0xae, 0xb9, 0x3d, 0xaa, 0x91, 0x0f,
+	0x89, 0xfe, 0x57, 0x71, 0x0d, 0xe1, 0x37, 0x68, 0x8b, 0x03, 0xe3, 0x41, 0x7e, 0x22, 0xf5, 0x9b,
+	0x4d, 0xa4, 0xa9, 0xc1, 0x6c, 0x24, 0xd6, 0x47, 0xb4, 0xfd, 0xcf, 0xa9, 0xe2, 0x2d, 0x54, 0x9e,
+	0xc0, 0x5c, 0xdd, 0xc8, 0xcd, 0x7e, 0xf2, 0x89, 0x1f, 0xa2, 0xea, 0x8c, 0x9c, 0xc5, 0x60, 0x6e,
+	0x5c, 0xd3, 0xa5, 0x0e, 0xe8, 0xa7, 0x96, 0xa3, 0x8d, 0xa7, 0xc6, 0x51, 0xe5, 0xdb, 0xf7, 0x96,
+	0xd1, 0x3d, 0x59, 0xfc, 0xb6, 0x4b, 0x8b, 0xa5, 0x6d, 0x5c, 0x2e, 0x6d, 0xe3, 0xd7, 0xd2, 0x36,
+	0xbe, 0xae, 0xec, 0xd2, 0xe5, 0xca, 0x2e, 0xfd, 0x5c, 0xd9, 0xa5, 0x4f, 0x87, 0x41, 0x28, 0xc7,
+	0xb1, 0xe7, 0xf8, 0x2c, 0x72, 0xc9, 0x05, 0x9c, 0x11, 0x4e, 0x41, 0x9e, 0x33, 0x3e, 0xd1, 0x7f,
+	0x8f, 0x7c, 0xc6, 0xc1, 0xbd, 0x70, 0x93, 0x47, 0xa9, 0x1e, 0xa3, 0x57, 0x53, 0xaf, 0xf1, 0xf1,
+	0x9f, 0x01, 0x00, 0xe3, 0xd2, 0x40, 0x7a, 0x00, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -202,6 +204,20 @@ func (m *GenesisState_Chain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReorgedDeposits) > 0 {
+		for iNdEx := len(m.ReorgedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReorgedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.Tokens) > 0 {
 		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -394,6 +410,12 @@ func (m *GenesisState_Chain) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ReorgedDeposits) > 0 {
+		for _, e := range m.ReorgedDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -881,6 +903,40 @@ func (m *GenesisState_Chain) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReorgedDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReorgedDeposits = append(m.ReorgedDeposits, ERC20Deposit{})
+			if err := m.ReorgedDeposits[len(m.ReorgedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1193,6 +1193,9 @@ var _ types.Nexus = &NexusMock{}
 // 			EnqueueForTransferFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress, amount github_com_cosmos_cosmos_sdk_types.Coin, feeRate github_com_cosmos_cosmos_sdk_types.Dec) error {
 // 				panic("mock out the EnqueueForTransfer method")
 // 			},
+// 			GetAssetPrecisionFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, asset string) (uint32, bool) {
+// 				panic("mock out the GetAssetPrecision method")
+// 			},
 // 			GetChainFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string) (nexus.Chain, bool) {
 // 				panic("mock out the GetChain method")
 // 			},
@@ -1202,6 +1205,9 @@ var _ types.Nexus = &NexusMock{}
 // 			GetChainsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) []nexus.Chain {
 // 				panic("mock out the GetChains method")
 // 			},
+// 			GetChainsWithAssetFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, denom string) []nexus.Chain {
+// 				panic("mock out the GetChainsWithAsset method")
+// 			},
 // 			GetRecipientFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress) (nexus.CrossChainAddress, bool) {
 // 				panic("mock out the GetRecipient method")
 // 			},
@@ -1220,9 +1226,24 @@ var _ types.Nexus = &NexusMock{}
 // 			RegisterAssetFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, denom string)  {
 // 				panic("mock out the RegisterAsset method")
 // 			},
+// 			RegisterAssetDecimalsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, asset string, decimals uint32) error {
+// 				panic("mock out the RegisterAssetDecimals method")
+// 			},
+// 			RegisterNativeAssetFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, denom string) error {
+// 				panic("mock out the RegisterNativeAsset method")
+// 			},
+// 			RevertTransferFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress, asset github_com_cosmos_cosmos_sdk_types.Coin, feeRate github_com_cosmos_cosmos_sdk_types.Dec) (github_com_cosmos_cosmos_sdk_types.Coin, error) {
+// 				panic("mock out the RevertTransfer method")
+// 			},
+// 			SetAssetPrecisionFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, asset string, decimals uint32) error {
+// 				panic("mock out the SetAssetPrecision method")
+// 			},
 // 			SetChainFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain)  {
 // 				panic("mock out the SetChain method")
 // 			},
+// 			ToChainAmountFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, coin github_com_cosmos_cosmos_sdk_types.Coin) (github_com_cosmos_cosmos_sdk_types.Int, github_com_cosmos_cosmos_sdk_types.Int, error) {
+// 				panic("mock out the ToChainAmount method")
+// 			},
 // 		}
 //
 // 		// use mockedNexus in code that requires types.Nexus
@@ -1236,6 +1257,9 @@ type NexusMock struct {
 	// EnqueueForTransferFunc mocks the EnqueueForTransfer method.
 	EnqueueForTransferFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress, amount github_com_cosmos_cosmos_sdk_types.Coin, feeRate github_com_cosmos_cosmos_sdk_types.Dec) error
 
+	// GetAssetPrecisionFunc mocks the GetAssetPrecision method.
+	GetAssetPrecisionFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, asset string) (uint32, bool)
+
 	// GetChainFunc mocks the GetChain method.
 	GetChainFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string) (nexus.Chain, bool)
 
@@ -1245,6 +1269,9 @@ type NexusMock struct {
 	// GetChainsFunc mocks the GetChains method.
 	GetChainsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) []nexus.Chain
 
+	// GetChainsWithAssetFunc mocks the GetChainsWithAsset method.
+	GetChainsWithAssetFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, denom string) []nexus.Chain
+
 	// GetRecipientFunc mocks the GetRecipient method.
 	GetRecipientFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress) (nexus.CrossChainAddress, bool)
 
@@ -1263,9 +1290,24 @@ type NexusMock struct {
 	// RegisterAssetFunc mocks the RegisterAsset method.
 	RegisterAssetFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, denom string)
 
+	// RegisterAssetDecimalsFunc mocks the RegisterAssetDecimals method.
+	RegisterAssetDecimalsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, asset string, decimals uint32) error
+
+	// RegisterNativeAssetFunc mocks the RegisterNativeAsset method.
+	RegisterNativeAssetFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, denom string) error
+
+	// RevertTransferFunc mocks the RevertTransfer method.
+	RevertTransferFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress, asset github_com_cosmos_cosmos_sdk_types.Coin, feeRate github_com_cosmos_cosmos_sdk_types.Dec) (github_com_cosmos_cosmos_sdk_types.Coin, error)
+
+	// SetAssetPrecisionFunc mocks the SetAssetPrecision method.
+	SetAssetPrecisionFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, asset string, decimals uint32) error
+
 	// SetChainFunc mocks the SetChain method.
 	SetChainFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain)
 
+	// ToChainAmountFunc mocks the ToChainAmount method.
+	ToChainAmountFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, coin github_com_cosmos_cosmos_sdk_types.Coin) (github_com_cosmos_cosmos_sdk_types.Int, github_com_cosmos_cosmos_sdk_types.Int, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// ArchivePendingTransfer holds details about calls to the ArchivePendingTransfer method.
@@ -1286,6 +1328,13 @@ type NexusMock struct {
 			// FeeRate is the feeRate argument value.
 			FeeRate github_com_cosmos_cosmos_sdk_types.Dec
 		}
+		// GetAssetPrecision holds details about calls to the GetAssetPrecision method.
+		GetAssetPrecision []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Asset is the asset argument value.
+			Asset string
+		}
 		// GetChain holds details about calls to the GetChain method.
 		GetChain []struct {
 			// Ctx is the ctx argument value.
@@ -1305,6 +1354,13 @@ type NexusMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
+		// GetChainsWithAsset holds details about calls to the GetChainsWithAsset method.
+		GetChainsWithAsset []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Denom is the denom argument value.
+			Denom string
+		}
 		// GetRecipient holds details about calls to the GetRecipient method.
 		GetRecipient []struct {
 			// Ctx is the ctx argument value.
@@ -1355,6 +1411,46 @@ type NexusMock struct {
 			// Denom is the denom argument value.
 			Denom string
 		}
+		// RegisterAssetDecimals holds details about calls to the RegisterAssetDecimals method.
+		RegisterAssetDecimals []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Chain is the chain argument value.
+			Chain nexus.Chain
+			// Asset is the asset argument value.
+			Asset string
+			// Decimals is the decimals argument value.
+			Decimals uint32
+		}
+		// RegisterNativeAsset holds details about calls to the RegisterNativeAsset method.
+		RegisterNativeAsset []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Chain is the chain argument value.
+			Chain nexus.Chain
+			// Denom is the denom argument value.
+			Denom string
+		}
+		// RevertTransfer holds details about calls to the RevertTransfer method.
+		RevertTransfer []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Sender is the sender argument value.
+			Sender nexus.CrossChainAddress
+			// Asset is the asset argument value.
+			Asset github_com_cosmos_cosmos_sdk_types.Coin
+			// FeeRate is the feeRate argument value.
+			FeeRate github_com_cosmos_cosmos_sdk_types.Dec
+		}
+		// SetAssetPrecision holds details about calls to the SetAssetPrecision method.
+		SetAssetPrecision []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Asset is the asset argument value.
+			Asset string
+			// Decimals is the decimals argument value.
+			Decimals uint32
+		}
 		// SetChain holds details about calls to the SetChain method.
 		SetChain []struct {
 			// Ctx is the ctx argument value.
@@ -1362,19 +1458,35 @@ type NexusMock struct {
 			// Chain is the chain argument value.
 			Chain nexus.Chain
 		}
+		// ToChainAmount holds details about calls to the ToChainAmount method.
+		ToChainAmount []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Chain is the chain argument value.
+			Chain nexus.Chain
+			// Coin is the coin argument value.
+			Coin github_com_cosmos_cosmos_sdk_types.Coin
+		}
 	}
 	lockArchivePendingTransfer sync.RWMutex
 	lockEnqueueForTransfer     sync.RWMutex
+	lockGetAssetPrecision      sync.RWMutex
 	lockGetChain               sync.RWMutex
 	lockGetChainMaintainers    sync.RWMutex
 	lockGetChains              sync.RWMutex
+	lockGetChainsWithAsset     sync.RWMutex
 	lockGetRecipient           sync.RWMutex
 	lockGetTransfersForChain   sync.RWMutex
 	lockIsAssetRegistered      sync.RWMutex
 	lockIsChainActivated       sync.RWMutex
 	lockLinkAddresses          sync.RWMutex
 	lockRegisterAsset          sync.RWMutex
+	lockRegisterAssetDecimals  sync.RWMutex
+	lockRegisterNativeAsset    sync.RWMutex
+	lockRevertTransfer         sync.RWMutex
+	lockSetAssetPrecision      sync.RWMutex
 	lockSetChain               sync.RWMutex
+	lockToChainAmount          sync.RWMutex
 }
 
 // ArchivePendingTransfer calls ArchivePendingTransferFunc.
@@ -1455,6 +1567,41 @@ func (mock *NexusMock) EnqueueForTransferCalls() []struct {
 	return calls
 }
 
+// GetAssetPrecision calls GetAssetPrecisionFunc.
+func (mock *NexusMock) GetAssetPrecision(ctx github_com_cosmos_cosmos_sdk_types.Context, asset string) (uint32, bool) {
+	if mock.GetAssetPrecisionFunc == nil {
+		panic("NexusMock.GetAssetPrecisionFunc: method is nil but Nexus.GetAssetPrecision was just called")
+	}
+	callInfo := struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Asset string
+	}{
+		Ctx:   ctx,
+		Asset: asset,
+	}
+	mock.lockGetAssetPrecision.Lock()
+	mock.calls.GetAssetPrecision = append(mock.calls.GetAssetPrecision, callInfo)
+	mock.lockGetAssetPrecision.Unlock()
+	return mock.GetAssetPrecisionFunc(ctx, asset)
+}
+
+// GetAssetPrecisionCalls gets all the calls that were made to GetAssetPrecision.
+// Check the length with:
+//     len(mockedNexus.GetAssetPrecisionCalls())
+func (mock *NexusMock) GetAssetPrecisionCalls() []struct {
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
+	Asset string
+} {
+	var calls []struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Asset string
+	}
+	mock.lockGetAssetPrecision.RLock()
+	calls = mock.calls.GetAssetPrecision
+	mock.lockGetAssetPrecision.RUnlock()
+	return calls
+}
+
 // GetChain calls GetChainFunc.
 func (mock *NexusMock) GetChain(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string) (nexus.Chain, bool) {
 	if mock.GetChainFunc == nil {
@@ -1556,6 +1703,41 @@ func (mock *NexusMock) GetChainsCalls() []struct {
 	return calls
 }
 
+// GetChainsWithAsset calls GetChainsWithAssetFunc.
+func (mock *NexusMock) GetChainsWithAsset(ctx github_com_cosmos_cosmos_sdk_types.Context, denom string) []nexus.Chain {
+	if mock.GetChainsWithAssetFunc == nil {
+		panic("NexusMock.GetChainsWithAssetFunc: method is nil but Nexus.GetChainsWithAsset was just called")
+	}
+	callInfo := struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Denom string
+	}{
+		Ctx:   ctx,
+		Denom: denom,
+	}
+	mock.lockGetChainsWithAsset.Lock()
+	mock.calls.GetChainsWithAsset = append(mock.calls.GetChainsWithAsset, callInfo)
+	mock.lockGetChainsWithAsset.Unlock()
+	return mock.GetChainsWithAssetFunc(ctx, denom)
+}
+
+// GetChainsWithAssetCalls gets all the calls that were made to GetChainsWithAsset.
+// Check the length with:
+//     len(mockedNexus.GetChainsWithAssetCalls())
+func (mock *NexusMock) GetChainsWithAssetCalls() []struct {
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
+	Denom string
+} {
+	var calls []struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Denom string
+	}
+	mock.lockGetChainsWithAsset.RLock()
+	calls = mock.calls.GetChainsWithAsset
+	mock.lockGetChainsWithAsset.RUnlock()
+	return calls
+}
+
 // GetRecipient calls GetRecipientFunc.
 func (mock *NexusMock) GetRecipient(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress) (nexus.CrossChainAddress, bool) {
 	if mock.GetRecipientFunc == nil {
@@ -1782,6 +1964,170 @@ func (mock *NexusMock) RegisterAssetCalls() []struct {
 	return calls
 }
 
+// RegisterAssetDecimals calls RegisterAssetDecimalsFunc.
+func (mock *NexusMock) RegisterAssetDecimals(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, asset string, decimals uint32) error {
+	if mock.RegisterAssetDecimalsFunc == nil {
+		panic("NexusMock.RegisterAssetDecimalsFunc: method is nil but Nexus.RegisterAssetDecimals was just called")
+	}
+	callInfo := struct {
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
+		Chain    nexus.Chain
+		Asset    string
+		Decimals uint32
+	}{
+		Ctx:      ctx,
+		Chain:    chain,
+		Asset:    asset,
+		Decimals: decimals,
+	}
+	mock.lockRegisterAssetDecimals.Lock()
+	mock.calls.RegisterAssetDecimals = append(mock.calls.RegisterAssetDecimals, callInfo)
+	mock.lockRegisterAssetDecimals.Unlock()
+	return mock.RegisterAssetDecimalsFunc(ctx, chain, asset, decimals)
+}
+
+// RegisterAssetDecimalsCalls gets all the calls that were made to RegisterAssetDecimals.
+// Check the length with:
+//     len(mockedNexus.RegisterAssetDecimalsCalls())
+func (mock *NexusMock) RegisterAssetDecimalsCalls() []struct {
+	Ctx      github_com_cosmos_cosmos_sdk_types.Context
+	Chain    nexus.Chain
+	Asset    string
+	Decimals uint32
+} {
+	var calls []struct {
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
+		Chain    nexus.Chain
+		Asset    string
+		Decimals uint32
+	}
+	mock.lockRegisterAssetDecimals.RLock()
+	calls = mock.calls.RegisterAssetDecimals
+	mock.lockRegisterAssetDecimals.RUnlock()
+	return calls
+}
+
+// RegisterNativeAsset calls RegisterNativeAssetFunc.
+func (mock *NexusMock) RegisterNativeAsset(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, denom string) error {
+	if mock.RegisterNativeAssetFunc == nil {
+		panic("NexusMock.RegisterNativeAssetFunc: method is nil but Nexus.RegisterNativeAsset was just called")
+	}
+	callInfo := struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Chain nexus.Chain
+		Denom string
+	}{
+		Ctx:   ctx,
+		Chain: chain,
+		Denom: denom,
+	}
+	mock.lockRegisterNativeAsset.Lock()
+	mock.calls.RegisterNativeAsset = append(mock.calls.RegisterNativeAsset, callInfo)
+	mock.lockRegisterNativeAsset.Unlock()
+	return mock.RegisterNativeAssetFunc(ctx, chain, denom)
+}
+
+// RegisterNativeAssetCalls gets all the calls that were made to RegisterNativeAsset.
+// Check the length with:
+//     len(mockedNexus.RegisterNativeAssetCalls())
+func (mock *NexusMock) RegisterNativeAssetCalls() []struct {
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
+	Chain nexus.Chain
+	Denom string
+} {
+	var calls []struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Chain nexus.Chain
+		Denom string
+	}
+	mock.lockRegisterNativeAsset.RLock()
+	calls = mock.calls.RegisterNativeAsset
+	mock.lockRegisterNativeAsset.RUnlock()
+	return calls
+}
+
+// RevertTransfer calls RevertTransferFunc.
+func (mock *NexusMock) RevertTransfer(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress, asset github_com_cosmos_cosmos_sdk_types.Coin, feeRate github_com_cosmos_cosmos_sdk_types.Dec) (github_com_cosmos_cosmos_sdk_types.Coin, error) {
+	if mock.RevertTransferFunc == nil {
+		panic("NexusMock.RevertTransferFunc: method is nil but Nexus.RevertTransfer was just called")
+	}
+	callInfo := struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		Sender  nexus.CrossChainAddress
+		Asset   github_com_cosmos_cosmos_sdk_types.Coin
+		FeeRate github_com_cosmos_cosmos_sdk_types.Dec
+	}{
+		Ctx:     ctx,
+		Sender:  sender,
+		Asset:   asset,
+		FeeRate: feeRate,
+	}
+	mock.lockRevertTransfer.Lock()
+	mock.calls.RevertTransfer = append(mock.calls.RevertTransfer, callInfo)
+	mock.lockRevertTransfer.Unlock()
+	return mock.RevertTransferFunc(ctx, sender, asset, feeRate)
+}
+
+// RevertTransferCalls gets all the calls that were made to RevertTransfer.
+// Check the length with:
+//     len(mockedNexus.RevertTransferCalls())
+func (mock *NexusMock) RevertTransferCalls() []struct {
+	Ctx     github_com_cosmos_cosmos_sdk_types.Context
+	Sender  nexus.CrossChainAddress
+	Asset   github_com_cosmos_cosmos_sdk_types.Coin
+	FeeRate github_com_cosmos_cosmos_sdk_types.Dec
+} {
+	var calls []struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		Sender  nexus.CrossChainAddress
+		Asset   github_com_cosmos_cosmos_sdk_types.Coin
+		FeeRate github_com_cosmos_cosmos_sdk_types.Dec
+	}
+	mock.lockRevertTransfer.RLock()
+	calls = mock.calls.RevertTransfer
+	mock.lockRevertTransfer.RUnlock()
+	return calls
+}
+
+// SetAssetPrecision calls SetAssetPrecisionFunc.
+func (mock *NexusMock) SetAssetPrecision(ctx github_com_cosmos_cosmos_sdk_types.Context, asset string, decimals uint32) error {
+	if mock.SetAssetPrecisionFunc == nil {
+		panic("NexusMock.SetAssetPrecisionFunc: method is nil but Nexus.SetAssetPrecision was just called")
+	}
+	callInfo := struct {
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
+		Asset    string
+		Decimals uint32
+	}{
+		Ctx:      ctx,
+		Asset:    asset,
+		Decimals: decimals,
+	}
+	mock.lockSetAssetPrecision.Lock()
+	mock.calls.SetAssetPrecision = append(mock.calls.SetAssetPrecision, callInfo)
+	mock.lockSetAssetPrecision.Unlock()
+	return mock.SetAssetPrecisionFunc(ctx, asset, decimals)
+}
+
+// SetAssetPrecisionCalls gets all the calls that were made to SetAssetPrecision.
+// Check the length with:
+//     len(mockedNexus.SetAssetPrecisionCalls())
+func (mock *NexusMock) SetAssetPrecisionCalls() []struct {
+	Ctx      github_com_cosmos_cosmos_sdk_types.Context
+	Asset    string
+	Decimals uint32
+} {
+	var calls []struct {
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
+		Asset    string
+		Decimals uint32
+	}
+	mock.lockSetAssetPrecision.RLock()
+	calls = mock.calls.SetAssetPrecision
+	mock.lockSetAssetPrecision.RUnlock()
+	return calls
+}
+
 // SetChain calls SetChainFunc.
 func (mock *NexusMock) SetChain(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain) {
 	if mock.SetChainFunc == nil {
@@ -1817,6 +2163,45 @@ func (mock *NexusMock) SetChainCalls() []struct {
 	return calls
 }
 
+// ToChainAmount calls ToChainAmountFunc.
+func (mock *NexusMock) ToChainAmount(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, coin github_com_cosmos_cosmos_sdk_types.Coin) (github_com_cosmos_cosmos_sdk_types.Int, github_com_cosmos_cosmos_sdk_types.Int, error) {
+	if mock.ToChainAmountFunc == nil {
+		panic("NexusMock.ToChainAmountFunc: method is nil but Nexus.ToChainAmount was just called")
+	}
+	callInfo := struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Chain nexus.Chain
+		Coin  github_com_cosmos_cosmos_sdk_types.Coin
+	}{
+		Ctx:   ctx,
+		Chain: chain,
+		Coin:  coin,
+	}
+	mock.lockToChainAmount.Lock()
+	mock.calls.ToChainAmount = append(mock.calls.ToChainAmount, callInfo)
+	mock.lockToChainAmount.Unlock()
+	return mock.ToChainAmountFunc(ctx, chain, coin)
+}
+
+// ToChainAmountCalls gets all the calls that were made to ToChainAmount.
+// Check the length with:
+//     len(mockedNexus.ToChainAmountCalls())
+func (mock *NexusMock) ToChainAmountCalls() []struct {
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
+	Chain nexus.Chain
+	Coin  github_com_cosmos_cosmos_sdk_types.Coin
+} {
+	var calls []struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Chain nexus.Chain
+		Coin  github_com_cosmos_cosmos_sdk_types.Coin
+	}
+	mock.lockToChainAmount.RLock()
+	calls = mock.calls.ToChainAmount
+	mock.lockToChainAmount.RUnlock()
+	return calls
+}
+
 // Ensure, that SnapshotterMock does implement types.Snapshotter.
 // If this is not the case, regenerate this file with moq.
 var _ types.Snapshotter = &SnapshotterMock{}
@@ -2496,21 +2881,36 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 // 			ConfirmPendingGatewayFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) error {
 // 				panic("mock out the ConfirmPendingGateway method")
 // 			},
+// 			ConfirmPendingGatewayUpgradeFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) error {
+// 				panic("mock out the ConfirmPendingGatewayUpgrade method")
+// 			},
 // 			CreateERC20TokenFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, asset string, details types.TokenDetails, minDeposit github_com_cosmos_cosmos_sdk_types.Int) (types.ERC20Token, error) {
 // 				panic("mock out the CreateERC20Token method")
 // 			},
+// 			CreateExternalERC20TokenFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, asset string, details types.TokenDetails, minDeposit github_com_cosmos_cosmos_sdk_types.Int, tokenAddr types.Address) (types.ERC20Token, error) {
+// 				panic("mock out the CreateExternalERC20Token method")
+// 			},
 // 			CreateNewBatchToSignFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) (types.CommandBatch, error) {
 // 				panic("mock out the CreateNewBatchToSign method")
 // 			},
 // 			DeleteDepositFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, deposit types.ERC20Deposit)  {
 // 				panic("mock out the DeleteDeposit method")
 // 			},
+// 			DeletePendingBatchExecutionFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey)  {
+// 				panic("mock out the DeletePendingBatchExecution method")
+// 			},
 // 			DeletePendingDepositFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey)  {
 // 				panic("mock out the DeletePendingDeposit method")
 // 			},
+// 			DeletePendingGasInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey)  {
+// 				panic("mock out the DeletePendingGasInfo method")
+// 			},
 // 			DeletePendingGatewayFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) error {
 // 				panic("mock out the DeletePendingGateway method")
 // 			},
+// 			DeletePendingReverificationFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey)  {
+// 				panic("mock out the DeletePendingReverification method")
+// 			},
 // 			DeletePendingTransferKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey)  {
 // 				panic("mock out the DeletePendingTransferKey method")
 // 			},
@@ -2526,9 +2926,15 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 // 			GetBatchByIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, id []byte) types.CommandBatch {
 // 				panic("mock out the GetBatchByID method")
 // 			},
+// 			GetBatchGasLimitFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) uint32 {
+// 				panic("mock out the GetBatchGasLimit method")
+// 			},
 // 			GetBurnerAddressAndSaltFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, tokenAddr types.Address, recipient string, gatewayAddr common.Address) (common.Address, common.Hash, error) {
 // 				panic("mock out the GetBurnerAddressAndSalt method")
 // 			},
+// 			GetBurnerAddressForRecipientFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, asset string, recipient nexus.CrossChainAddress) (common.Address, bool) {
+// 				panic("mock out the GetBurnerAddressForRecipient method")
+// 			},
 // 			GetBurnerByteCodesFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) ([]byte, bool) {
 // 				panic("mock out the GetBurnerByteCodes method")
 // 			},
@@ -2544,6 +2950,9 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 // 			GetCommandFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, id types.CommandID) (types.Command, bool) {
 // 				panic("mock out the GetCommand method")
 // 			},
+// 			GetCommandBatchExecutionTimeoutFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) (int64, bool) {
+// 				panic("mock out the GetCommandBatchExecutionTimeout method")
+// 			},
 // 			GetConfirmedDepositsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.ERC20Deposit {
 // 				panic("mock out the GetConfirmedDeposits method")
 // 			},
@@ -2556,12 +2965,24 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 // 			GetERC20TokenBySymbolFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, symbol string) types.ERC20Token {
 // 				panic("mock out the GetERC20TokenBySymbol method")
 // 			},
+// 			GetFinalityModeFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) (types.FinalityMode, bool) {
+// 				panic("mock out the GetFinalityMode method")
+// 			},
+// 			GetGasInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) (types.GasInfo, bool) {
+// 				panic("mock out the GetGasInfo method")
+// 			},
+// 			GetGasInfoMaxAgeFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) (int64, bool) {
+// 				panic("mock out the GetGasInfoMaxAge method")
+// 			},
 // 			GetGatewayAddressFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) (common.Address, bool) {
 // 				panic("mock out the GetGatewayAddress method")
 // 			},
 // 			GetGatewayByteCodesFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) ([]byte, bool) {
 // 				panic("mock out the GetGatewayByteCodes method")
 // 			},
+// 			GetGatewayCommandsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) ([]string, bool) {
+// 				panic("mock out the GetGatewayCommands method")
+// 			},
 // 			GetLatestCommandBatchFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) types.CommandBatch {
 // 				panic("mock out the GetLatestCommandBatch method")
 // 			},
@@ -2571,6 +2992,9 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 // 			GetNameFunc: func() string {
 // 				panic("mock out the GetName method")
 // 			},
+// 			GetNativeBurnerAddressAndSaltFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, recipient string, gatewayAddr common.Address) (common.Address, common.Hash) {
+// 				panic("mock out the GetNativeBurnerAddressAndSalt method")
+// 			},
 // 			GetNetworkFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) (string, bool) {
 // 				panic("mock out the GetNetwork method")
 // 			},
@@ -2580,15 +3004,27 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 // 			GetParamsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) types.Params {
 // 				panic("mock out the GetParams method")
 // 			},
+// 			GetPendingBatchExecutionFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (types.PendingBatchExecution, bool) {
+// 				panic("mock out the GetPendingBatchExecution method")
+// 			},
 // 			GetPendingCommandsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.Command {
 // 				panic("mock out the GetPendingCommands method")
 // 			},
 // 			GetPendingDepositFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (types.ERC20Deposit, bool) {
 // 				panic("mock out the GetPendingDeposit method")
 // 			},
+// 			GetPendingGasInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (uint64, bool) {
+// 				panic("mock out the GetPendingGasInfo method")
+// 			},
 // 			GetPendingGatewayAddressFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) (common.Address, bool) {
 // 				panic("mock out the GetPendingGatewayAddress method")
 // 			},
+// 			GetPendingGatewayUpgradeFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) (common.Address, bool) {
+// 				panic("mock out the GetPendingGatewayUpgrade method")
+// 			},
+// 			GetPendingReverificationFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (types.ERC20Deposit, bool) {
+// 				panic("mock out the GetPendingReverification method")
+// 			},
 // 			GetPendingTransferKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (types.TransferKey, bool) {
 // 				panic("mock out the GetPendingTransferKey method")
 // 			},
@@ -2610,24 +3046,42 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 // 			LoggerFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) log.Logger {
 // 				panic("mock out the Logger method")
 // 			},
+// 			SetBurnerAddressForRecipientFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, asset string, recipient nexus.CrossChainAddress, burnerAddr common.Address)  {
+// 				panic("mock out the SetBurnerAddressForRecipient method")
+// 			},
 // 			SetBurnerInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, burnerInfo types.BurnerInfo)  {
 // 				panic("mock out the SetBurnerInfo method")
 // 			},
 // 			SetDepositFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, deposit types.ERC20Deposit, state types.DepositStatus)  {
 // 				panic("mock out the SetDeposit method")
 // 			},
+// 			SetGasInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, gasInfo types.GasInfo)  {
+// 				panic("mock out the SetGasInfo method")
+// 			},
 // 			SetLatestSignedCommandBatchIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, id []byte)  {
 // 				panic("mock out the SetLatestSignedCommandBatchID method")
 // 			},
 // 			SetParamsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, p types.Params)  {
 // 				panic("mock out the SetParams method")
 // 			},
+// 			SetPendingBatchExecutionFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, execution *types.PendingBatchExecution)  {
+// 				panic("mock out the SetPendingBatchExecution method")
+// 			},
 // 			SetPendingDepositFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, deposit *types.ERC20Deposit)  {
 // 				panic("mock out the SetPendingDeposit method")
 // 			},
+// 			SetPendingGasInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, blockNumber uint64)  {
+// 				panic("mock out the SetPendingGasInfo method")
+// 			},
 // 			SetPendingGatewayFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, address common.Address)  {
 // 				panic("mock out the SetPendingGateway method")
 // 			},
+// 			SetPendingGatewayUpgradeFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, implementation common.Address, commands []string) error {
+// 				panic("mock out the SetPendingGatewayUpgrade method")
+// 			},
+// 			SetPendingReverificationFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, deposit *types.ERC20Deposit)  {
+// 				panic("mock out the SetPendingReverification method")
+// 			},
 // 			SetPendingTransferKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, transferOwnership *types.TransferKey)  {
 // 				panic("mock out the SetPendingTransferKey method")
 // 			},
@@ -2644,21 +3098,36 @@ type ChainKeeperMock struct {
 	// ConfirmPendingGatewayFunc mocks the ConfirmPendingGateway method.
 	ConfirmPendingGatewayFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) error
 
+	// ConfirmPendingGatewayUpgradeFunc mocks the ConfirmPendingGatewayUpgrade method.
+	ConfirmPendingGatewayUpgradeFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) error
+
 	// CreateERC20TokenFunc mocks the CreateERC20Token method.
 	CreateERC20TokenFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, asset string, details types.TokenDetails, minDeposit github_com_cosmos_cosmos_sdk_types.Int) (types.ERC20Token, error)
 
+	// CreateExternalERC20TokenFunc mocks the CreateExternalERC20Token method.
+	CreateExternalERC20TokenFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, asset string, details types.TokenDetails, minDeposit github_com_cosmos_cosmos_sdk_types.Int, tokenAddr types.Address) (types.ERC20Token, error)
+
 	// CreateNewBatchToSignFunc mocks the CreateNewBatchToSign method.
 	CreateNewBatchToSignFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) (types.CommandBatch, error)
 
 	// DeleteDepositFunc mocks the DeleteDeposit method.
 	DeleteDepositFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, deposit types.ERC20Deposit)
 
+	// DeletePendingBatchExecutionFunc mocks the DeletePendingBatchExecution method.
+	DeletePendingBatchExecutionFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey)
+
 	// DeletePendingDepositFunc mocks the DeletePendingDeposit method.
 	DeletePendingDepositFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey)
 
+	// DeletePendingGasInfoFunc mocks the DeletePendingGasInfo method.
+	DeletePendingGasInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey)
+
 	// DeletePendingGatewayFunc mocks the DeletePendingGateway method.
 	DeletePendingGatewayFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) error
 
+	// DeletePendingReverificationFunc mocks the DeletePendingReverification method.
+	DeletePendingReverificationFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey)
+
 	// DeletePendingTransferKeyFunc mocks the DeletePendingTransferKey method.
 	DeletePendingTransferKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey)
 
@@ -2674,9 +3143,15 @@ type ChainKeeperMock struct {
 	// GetBatchByIDFunc mocks the GetBatchByID method.
 	GetBatchByIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, id []byte) types.CommandBatch
 
+	// GetBatchGasLimitFunc mocks the GetBatchGasLimit method.
+	GetBatchGasLimitFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) uint32
+
 	// GetBurnerAddressAndSaltFunc mocks the GetBurnerAddressAndSalt method.
 	GetBurnerAddressAndSaltFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, tokenAddr types.Address, recipient string, gatewayAddr common.Address) (common.Address, common.Hash, error)
 
+	// GetBurnerAddressForRecipientFunc mocks the GetBurnerAddressForRecipient method.
+	GetBurnerAddressForRecipientFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, asset string, recipient nexus.CrossChainAddress) (common.Address, bool)
+
 	// GetBurnerByteCodesFunc mocks the GetBurnerByteCodes method.
 	GetBurnerByteCodesFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) ([]byte, bool)
 
@@ -2692,6 +3167,9 @@ type ChainKeeperMock struct {
 	// GetCommandFunc mocks the GetCommand method.
 	GetCommandFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, id types.CommandID) (types.Command, bool)
 
+	// GetCommandBatchExecutionTimeoutFunc mocks the GetCommandBatchExecutionTimeout method.
+	GetCommandBatchExecutionTimeoutFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) (int64, bool)
+
 	// GetConfirmedDepositsFunc mocks the GetConfirmedDeposits method.
 	GetConfirmedDepositsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.ERC20Deposit
 
@@ -2704,12 +3182,24 @@ type ChainKeeperMock struct {
 	// GetERC20TokenBySymbolFunc mocks the GetERC20TokenBySymbol method.
 	GetERC20TokenBySymbolFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, symbol string) types.ERC20Token
 
+	// GetFinalityModeFunc mocks the GetFinalityMode method.
+	GetFinalityModeFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) (types.FinalityMode, bool)
+
+	// GetGasInfoFunc mocks the GetGasInfo method.
+	GetGasInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) (types.GasInfo, bool)
+
+	// GetGasInfoMaxAgeFunc mocks the GetGasInfoMaxAge method.
+	GetGasInfoMaxAgeFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) (int64, bool)
+
 	// GetGatewayAddressFunc mocks the GetGatewayAddress method.
 	GetGatewayAddressFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) (common.Address, bool)
 
 	// GetGatewayByteCodesFunc mocks the GetGatewayByteCodes method.
 	GetGatewayByteCodesFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) ([]byte, bool)
 
+	// GetGatewayCommandsFunc mocks the GetGatewayCommands method.
+	GetGatewayCommandsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) ([]string, bool)
+
 	// GetLatestCommandBatchFunc mocks the GetLatestCommandBatch method.
 	GetLatestCommandBatchFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) types.CommandBatch
 
@@ -2719,6 +3209,9 @@ type ChainKeeperMock struct {
 	// GetNameFunc mocks the GetName method.
 	GetNameFunc func() string
 
+	// GetNativeBurnerAddressAndSaltFunc mocks the GetNativeBurnerAddressAndSalt method.
+	GetNativeBurnerAddressAndSaltFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, recipient string, gatewayAddr common.Address) (common.Address, common.Hash)
+
 	// GetNetworkFunc mocks the GetNetwork method.
 	GetNetworkFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) (string, bool)
 
@@ -2728,15 +3221,27 @@ type ChainKeeperMock struct {
 	// GetParamsFunc mocks the GetParams method.
 	GetParamsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) types.Params
 
+	// GetPendingBatchExecutionFunc mocks the GetPendingBatchExecution method.
+	GetPendingBatchExecutionFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (types.PendingBatchExecution, bool)
+
 	// GetPendingCommandsFunc mocks the GetPendingCommands method.
 	GetPendingCommandsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.Command
 
 	// GetPendingDepositFunc mocks the GetPendingDeposit method.
 	GetPendingDepositFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (types.ERC20Deposit, bool)
 
+	// GetPendingGasInfoFunc mocks the GetPendingGasInfo method.
+	GetPendingGasInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (uint64, bool)
+
 	// GetPendingGatewayAddressFunc mocks the GetPendingGatewayAddress method.
 	GetPendingGatewayAddressFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) (common.Address, bool)
 
+	// GetPendingGatewayUpgradeFunc mocks the GetPendingGatewayUpgrade method.
+	GetPendingGatewayUpgradeFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) (common.Address, bool)
+
+	// GetPendingReverificationFunc mocks the GetPendingReverification method.
+	GetPendingReverificationFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (types.ERC20Deposit, bool)
+
 	// GetPendingTransferKeyFunc mocks the GetPendingTransferKey method.
 	GetPendingTransferKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (types.TransferKey, bool)
 
@@ -2758,24 +3263,42 @@ type ChainKeeperMock struct {
 	// LoggerFunc mocks the Logger method.
 	LoggerFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) log.Logger
 
+	// SetBurnerAddressForRecipientFunc mocks the SetBurnerAddressForRecipient method.
+	SetBurnerAddressForRecipientFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, asset string, recipient nexus.CrossChainAddress, burnerAddr common.Address)
+
 	// SetBurnerInfoFunc mocks the SetBurnerInfo method.
 	SetBurnerInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, burnerInfo types.BurnerInfo)
 
 	// SetDepositFunc mocks the SetDeposit method.
 	SetDepositFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, deposit types.ERC20Deposit, state types.DepositStatus)
 
+	// SetGasInfoFunc mocks the SetGasInfo method.
+	SetGasInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, gasInfo types.GasInfo)
+
 	// SetLatestSignedCommandBatchIDFunc mocks the SetLatestSignedCommandBatchID method.
 	SetLatestSignedCommandBatchIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, id []byte)
 
 	// SetParamsFunc mocks the SetParams method.
 	SetParamsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, p types.Params)
 
+	// SetPendingBatchExecutionFunc mocks the SetPendingBatchExecution method.
+	SetPendingBatchExecutionFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, execution *types.PendingBatchExecution)
+
 	// SetPendingDepositFunc mocks the SetPendingDeposit method.
 	SetPendingDepositFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, deposit *types.ERC20Deposit)
 
+	// SetPendingGasInfoFunc mocks the SetPendingGasInfo method.
+	SetPendingGasInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, blockNumber uint64)
+
 	// SetPendingGatewayFunc mocks the SetPendingGateway method.
 	SetPendingGatewayFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, address common.Address)
 
+	// SetPendingGatewayUpgradeFunc mocks the SetPendingGatewayUpgrade method.
+	SetPendingGatewayUpgradeFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, implementation common.Address, commands []string) error
+
+	// SetPendingReverificationFunc mocks the SetPendingReverification method.
+	SetPendingReverificationFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, deposit *types.ERC20Deposit)
+
 	// SetPendingTransferKeyFunc mocks the SetPendingTransferKey method.
 	SetPendingTransferKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, transferOwnership *types.TransferKey)
 
@@ -2793,6 +3316,11 @@ type ChainKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
+		// ConfirmPendingGatewayUpgrade holds details about calls to the ConfirmPendingGatewayUpgrade method.
+		ConfirmPendingGatewayUpgrade []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
 		// CreateERC20Token holds details about calls to the CreateERC20Token method.
 		CreateERC20Token []struct {
 			// Ctx is the ctx argument value.
@@ -2804,18 +3332,38 @@ type ChainKeeperMock struct {
 			// MinDeposit is the minDeposit argument value.
 			MinDeposit github_com_cosmos_cosmos_sdk_types.Int
 		}
-		// CreateNewBatchToSign holds details about calls to the CreateNewBatchToSign method.
-		CreateNewBatchToSign []struct {
+		// CreateExternalERC20Token holds details about calls to the CreateExternalERC20Token method.
+		CreateExternalERC20Token []struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
-		}
-		// DeleteDeposit holds details about calls to the DeleteDeposit method.
+			// Asset is the asset argument value.
+			Asset string
+			// Details is the details argument value.
+			Details types.TokenDetails
+			// MinDeposit is the minDeposit argument value.
+			MinDeposit github_com_cosmos_cosmos_sdk_types.Int
+			// TokenAddr is the tokenAddr argument value.
+			TokenAddr types.Address
+		}
+		// CreateNewBatchToSign holds details about calls to the CreateNewBatchToSign method.
+		CreateNewBatchToSign []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
+		// DeleteDeposit holds details about calls to the DeleteDeposit method.
 		DeleteDeposit []struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Deposit is the deposit argument value.
 			Deposit types.ERC20Deposit
 		}
+		// DeletePendingBatchExecution holds details about calls to the DeletePendingBatchExecution method.
+		DeletePendingBatchExecution []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Key is the key argument value.
+			Key vote.PollKey
+		}
 		// DeletePendingDeposit holds details about calls to the DeletePendingDeposit method.
 		DeletePendingDeposit []struct {
 			// Ctx is the ctx argument value.
@@ -2823,11 +3371,25 @@ type ChainKeeperMock struct {
 			// Key is the key argument value.
 			Key vote.PollKey
 		}
+		// DeletePendingGasInfo holds details about calls to the DeletePendingGasInfo method.
+		DeletePendingGasInfo []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Key is the key argument value.
+			Key vote.PollKey
+		}
 		// DeletePendingGateway holds details about calls to the DeletePendingGateway method.
 		DeletePendingGateway []struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
+		// DeletePendingReverification holds details about calls to the DeletePendingReverification method.
+		DeletePendingReverification []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Key is the key argument value.
+			Key vote.PollKey
+		}
 		// DeletePendingTransferKey holds details about calls to the DeletePendingTransferKey method.
 		DeletePendingTransferKey []struct {
 			// Ctx is the ctx argument value.
@@ -2861,6 +3423,11 @@ type ChainKeeperMock struct {
 			// ID is the id argument value.
 			ID []byte
 		}
+		// GetBatchGasLimit holds details about calls to the GetBatchGasLimit method.
+		GetBatchGasLimit []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
 		// GetBurnerAddressAndSalt holds details about calls to the GetBurnerAddressAndSalt method.
 		GetBurnerAddressAndSalt []struct {
 			// Ctx is the ctx argument value.
@@ -2872,6 +3439,15 @@ type ChainKeeperMock struct {
 			// GatewayAddr is the gatewayAddr argument value.
 			GatewayAddr common.Address
 		}
+		// GetBurnerAddressForRecipient holds details about calls to the GetBurnerAddressForRecipient method.
+		GetBurnerAddressForRecipient []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Asset is the asset argument value.
+			Asset string
+			// Recipient is the recipient argument value.
+			Recipient nexus.CrossChainAddress
+		}
 		// GetBurnerByteCodes holds details about calls to the GetBurnerByteCodes method.
 		GetBurnerByteCodes []struct {
 			// Ctx is the ctx argument value.
@@ -2903,6 +3479,11 @@ type ChainKeeperMock struct {
 			// ID is the id argument value.
 			ID types.CommandID
 		}
+		// GetCommandBatchExecutionTimeout holds details about calls to the GetCommandBatchExecutionTimeout method.
+		GetCommandBatchExecutionTimeout []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
 		// GetConfirmedDeposits holds details about calls to the GetConfirmedDeposits method.
 		GetConfirmedDeposits []struct {
 			// Ctx is the ctx argument value.
@@ -2931,6 +3512,21 @@ type ChainKeeperMock struct {
 			// Symbol is the symbol argument value.
 			Symbol string
 		}
+		// GetFinalityMode holds details about calls to the GetFinalityMode method.
+		GetFinalityMode []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
+		// GetGasInfo holds details about calls to the GetGasInfo method.
+		GetGasInfo []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
+		// GetGasInfoMaxAge holds details about calls to the GetGasInfoMaxAge method.
+		GetGasInfoMaxAge []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
 		// GetGatewayAddress holds details about calls to the GetGatewayAddress method.
 		GetGatewayAddress []struct {
 			// Ctx is the ctx argument value.
@@ -2941,6 +3537,11 @@ type ChainKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
+		// GetGatewayCommands holds details about calls to the GetGatewayCommands method.
+		GetGatewayCommands []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
 		// GetLatestCommandBatch holds details about calls to the GetLatestCommandBatch method.
 		GetLatestCommandBatch []struct {
 			// Ctx is the ctx argument value.
@@ -2954,6 +3555,15 @@ type ChainKeeperMock struct {
 		// GetName holds details about calls to the GetName method.
 		GetName []struct {
 		}
+		// GetNativeBurnerAddressAndSalt holds details about calls to the GetNativeBurnerAddressAndSalt method.
+		GetNativeBurnerAddressAndSalt []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Recipient is the recipient argument value.
+			Recipient string
+			// GatewayAddr is the gatewayAddr argument value.
+			GatewayAddr common.Address
+		}
 		// GetNetwork holds details about calls to the GetNetwork method.
 		GetNetwork []struct {
 			// Ctx is the ctx argument value.
@@ -2971,6 +3581,13 @@ type ChainKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
+		// GetPendingBatchExecution holds details about calls to the GetPendingBatchExecution method.
+		GetPendingBatchExecution []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Key is the key argument value.
+			Key vote.PollKey
+		}
 		// GetPendingCommands holds details about calls to the GetPendingCommands method.
 		GetPendingCommands []struct {
 			// Ctx is the ctx argument value.
@@ -2983,11 +3600,30 @@ type ChainKeeperMock struct {
 			// Key is the key argument value.
 			Key vote.PollKey
 		}
+		// GetPendingGasInfo holds details about calls to the GetPendingGasInfo method.
+		GetPendingGasInfo []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Key is the key argument value.
+			Key vote.PollKey
+		}
 		// GetPendingGatewayAddress holds details about calls to the GetPendingGatewayAddress method.
 		GetPendingGatewayAddress []struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
+		// GetPendingGatewayUpgrade holds details about calls to the GetPendingGatewayUpgrade method.
+		GetPendingGatewayUpgrade []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
+		// GetPendingReverification holds details about calls to the GetPendingReverification method.
+		GetPendingReverification []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Key is the key argument value.
+			Key vote.PollKey
+		}
 		// GetPendingTransferKey holds details about calls to the GetPendingTransferKey method.
 		GetPendingTransferKey []struct {
 			// Ctx is the ctx argument value.
@@ -3025,6 +3661,17 @@ type ChainKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
+		// SetBurnerAddressForRecipient holds details about calls to the SetBurnerAddressForRecipient method.
+		SetBurnerAddressForRecipient []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Asset is the asset argument value.
+			Asset string
+			// Recipient is the recipient argument value.
+			Recipient nexus.CrossChainAddress
+			// BurnerAddr is the burnerAddr argument value.
+			BurnerAddr common.Address
+		}
 		// SetBurnerInfo holds details about calls to the SetBurnerInfo method.
 		SetBurnerInfo []struct {
 			// Ctx is the ctx argument value.
@@ -3041,6 +3688,13 @@ type ChainKeeperMock struct {
 			// State is the state argument value.
 			State types.DepositStatus
 		}
+		// SetGasInfo holds details about calls to the SetGasInfo method.
+		SetGasInfo []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// GasInfo is the gasInfo argument value.
+			GasInfo types.GasInfo
+		}
 		// SetLatestSignedCommandBatchID holds details about calls to the SetLatestSignedCommandBatchID method.
 		SetLatestSignedCommandBatchID []struct {
 			// Ctx is the ctx argument value.
@@ -3055,6 +3709,15 @@ type ChainKeeperMock struct {
 			// P is the p argument value.
 			P types.Params
 		}
+		// SetPendingBatchExecution holds details about calls to the SetPendingBatchExecution method.
+		SetPendingBatchExecution []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Key is the key argument value.
+			Key vote.PollKey
+			// Execution is the execution argument value.
+			Execution *types.PendingBatchExecution
+		}
 		// SetPendingDeposit holds details about calls to the SetPendingDeposit method.
 		SetPendingDeposit []struct {
 			// Ctx is the ctx argument value.
@@ -3064,6 +3727,15 @@ type ChainKeeperMock struct {
 			// Deposit is the deposit argument value.
 			Deposit *types.ERC20Deposit
 		}
+		// SetPendingGasInfo holds details about calls to the SetPendingGasInfo method.
+		SetPendingGasInfo []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Key is the key argument value.
+			Key vote.PollKey
+			// BlockNumber is the blockNumber argument value.
+			BlockNumber uint64
+		}
 		// SetPendingGateway holds details about calls to the SetPendingGateway method.
 		SetPendingGateway []struct {
 			// Ctx is the ctx argument value.
@@ -3071,6 +3743,24 @@ type ChainKeeperMock struct {
 			// Address is the address argument value.
 			Address common.Address
 		}
+		// SetPendingGatewayUpgrade holds details about calls to the SetPendingGatewayUpgrade method.
+		SetPendingGatewayUpgrade []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Implementation is the implementation argument value.
+			Implementation common.Address
+			// Commands is the commands argument value.
+			Commands []string
+		}
+		// SetPendingReverification holds details about calls to the SetPendingReverification method.
+		SetPendingReverification []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Key is the key argument value.
+			Key vote.PollKey
+			// Deposit is the deposit argument value.
+			Deposit *types.ERC20Deposit
+		}
 		// SetPendingTransferKey holds details about calls to the SetPendingTransferKey method.
 		SetPendingTransferKey []struct {
 			// Ctx is the ctx argument value.
@@ -3081,53 +3771,76 @@ type ChainKeeperMock struct {
 			TransferOwnership *types.TransferKey
 		}
 	}
-	lockArchiveTransferKey            sync.RWMutex
-	lockConfirmPendingGateway         sync.RWMutex
-	lockCreateERC20Token              sync.RWMutex
-	lockCreateNewBatchToSign          sync.RWMutex
-	lockDeleteDeposit                 sync.RWMutex
-	lockDeletePendingDeposit          sync.RWMutex
-	lockDeletePendingGateway          sync.RWMutex
-	lockDeletePendingTransferKey      sync.RWMutex
-	lockDeleteUnsignedCommandBatchID  sync.RWMutex
-	lockEnqueueCommand                sync.RWMutex
-	lockGetArchivedTransferKey        sync.RWMutex
-	lockGetBatchByID                  sync.RWMutex
-	lockGetBurnerAddressAndSalt       sync.RWMutex
-	lockGetBurnerByteCodes            sync.RWMutex
-	lockGetBurnerInfo                 sync.RWMutex
-	lockGetChainID                    sync.RWMutex
-	lockGetChainIDByNetwork           sync.RWMutex
-	lockGetCommand                    sync.RWMutex
-	lockGetConfirmedDeposits          sync.RWMutex
-	lockGetDeposit                    sync.RWMutex
-	lockGetERC20TokenByAsset          sync.RWMutex
-	lockGetERC20TokenBySymbol         sync.RWMutex
-	lockGetGatewayAddress             sync.RWMutex
-	lockGetGatewayByteCodes           sync.RWMutex
-	lockGetLatestCommandBatch         sync.RWMutex
-	lockGetMinVoterCount              sync.RWMutex
-	lockGetName                       sync.RWMutex
-	lockGetNetwork                    sync.RWMutex
-	lockGetNetworkByID                sync.RWMutex
-	lockGetParams                     sync.RWMutex
-	lockGetPendingCommands            sync.RWMutex
-	lockGetPendingDeposit             sync.RWMutex
-	lockGetPendingGatewayAddress      sync.RWMutex
-	lockGetPendingTransferKey         sync.RWMutex
-	lockGetRequiredConfirmationHeight sync.RWMutex
-	lockGetRevoteLockingPeriod        sync.RWMutex
-	lockGetTokenByteCodes             sync.RWMutex
-	lockGetTransactionFeeRate         sync.RWMutex
-	lockGetVotingThreshold            sync.RWMutex
-	lockLogger                        sync.RWMutex
-	lockSetBurnerInfo                 sync.RWMutex
-	lockSetDeposit                    sync.RWMutex
-	lockSetLatestSignedCommandBatchID sync.RWMutex
-	lockSetParams                     sync.RWMutex
-	lockSetPendingDeposit             sync.RWMutex
-	lockSetPendingGateway             sync.RWMutex
-	lockSetPendingTransferKey         sync.RWMutex
+	lockArchiveTransferKey              sync.RWMutex
+	lockConfirmPendingGateway           sync.RWMutex
+	lockConfirmPendingGatewayUpgrade    sync.RWMutex
+	lockCreateERC20Token                sync.RWMutex
+	lockCreateExternalERC20Token        sync.RWMutex
+	lockCreateNewBatchToSign            sync.RWMutex
+	lockDeleteDeposit                   sync.RWMutex
+	lockDeletePendingBatchExecution     sync.RWMutex
+	lockDeletePendingDeposit            sync.RWMutex
+	lockDeletePendingGasInfo            sync.RWMutex
+	lockDeletePendingGateway            sync.RWMutex
+	lockDeletePendingReverification     sync.RWMutex
+	lockDeletePendingTransferKey        sync.RWMutex
+	lockDeleteUnsignedCommandBatchID    sync.RWMutex
+	lockEnqueueCommand                  sync.RWMutex
+	lockGetArchivedTransferKey          sync.RWMutex
+	lockGetBatchByID                    sync.RWMutex
+	lockGetBatchGasLimit                sync.RWMutex
+	lockGetBurnerAddressAndSalt         sync.RWMutex
+	lockGetBurnerAddressForRecipient    sync.RWMutex
+	lockGetBurnerByteCodes              sync.RWMutex
+	lockGetBurnerInfo                   sync.RWMutex
+	lockGetChainID                      sync.RWMutex
+	lockGetChainIDByNetwork             sync.RWMutex
+	lockGetCommand                      sync.RWMutex
+	lockGetCommandBatchExecutionTimeout sync.RWMutex
+	lockGetConfirmedDeposits            sync.RWMutex
+	lockGetDeposit                      sync.RWMutex
+	lockGetERC20TokenByAsset            sync.RWMutex
+	lockGetERC20TokenBySymbol           sync.RWMutex
+	lockGetFinalityMode                 sync.RWMutex
+	lockGetGasInfo                      sync.RWMutex
+	lockGetGasInfoMaxAge                sync.RWMutex
+	lockGetGatewayAddress               sync.RWMutex
+	lockGetGatewayByteCodes             sync.RWMutex
+	lockGetGatewayCommands              sync.RWMutex
+	lockGetLatestCommandBatch           sync.RWMutex
+	lockGetMinVoterCount                sync.RWMutex
+	lockGetName                         sync.RWMutex
+	lockGetNativeBurnerAddressAndSalt   sync.RWMutex
+	lockGetNetwork                      sync.RWMutex
+	lockGetNetworkByID                  sync.RWMutex
+	lockGetParams                       sync.RWMutex
+	lockGetPendingBatchExecution        sync.RWMutex
+	lockGetPendingCommands              sync.RWMutex
+	lockGetPendingDeposit               sync.RWMutex
+	lockGetPendingGasInfo               sync.RWMutex
+	lockGetPendingGatewayAddress        sync.RWMutex
+	lockGetPendingGatewayUpgrade        sync.RWMutex
+	lockGetPendingReverification        sync.RWMutex
+	lockGetPendingTransferKey           sync.RWMutex
+	lockGetRequiredConfirmationHeight   sync.RWMutex
+	lockGetRevoteLockingPeriod          sync.RWMutex
+	lockGetTokenByteCodes               sync.RWMutex
+	lockGetTransactionFeeRate           sync.RWMutex
+	lockGetVotingThreshold              sync.RWMutex
+	lockLogger                          sync.RWMutex
+	lockSetBurnerAddressForRecipient    sync.RWMutex
+	lockSetBurnerInfo                   sync.RWMutex
+	lockSetDeposit                      sync.RWMutex
+	lockSetGasInfo                      sync.RWMutex
+	lockSetLatestSignedCommandBatchID   sync.RWMutex
+	lockSetParams                       sync.RWMutex
+	lockSetPendingBatchExecution        sync.RWMutex
+	lockSetPendingDeposit               sync.RWMutex
+	lockSetPendingGasInfo               sync.RWMutex
+	lockSetPendingGateway               sync.RWMutex
+	lockSetPendingGatewayUpgrade        sync.RWMutex
+	lockSetPendingReverification        sync.RWMutex
+	lockSetPendingTransferKey           sync.RWMutex
 }
 
 // ArchiveTransferKey calls ArchiveTransferKeyFunc.
@@ -3196,6 +3909,37 @@ func (mock *ChainKeeperMock) ConfirmPendingGatewayCalls() []struct {
 	return calls
 }
 
+// ConfirmPendingGatewayUpgrade calls ConfirmPendingGatewayUpgradeFunc.
+func (mock *ChainKeeperMock) ConfirmPendingGatewayUpgrade(ctx github_com_cosmos_cosmos_sdk_types.Context) error {
+	if mock.ConfirmPendingGatewayUpgradeFunc == nil {
+		panic("ChainKeeperMock.ConfirmPendingGatewayUpgradeFunc: method is nil but ChainKeeper.ConfirmPendingGatewayUpgrade was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockConfirmPendingGatewayUpgrade.Lock()
+	mock.calls.ConfirmPendingGatewayUpgrade = append(mock.calls.ConfirmPendingGatewayUpgrade, callInfo)
+	mock.lockConfirmPendingGatewayUpgrade.Unlock()
+	return mock.ConfirmPendingGatewayUpgradeFunc(ctx)
+}
+
+// ConfirmPendingGatewayUpgradeCalls gets all the calls that were made to ConfirmPendingGatewayUpgrade.
+// Check the length with:
+//     len(mockedChainKeeper.ConfirmPendingGatewayUpgradeCalls())
+func (mock *ChainKeeperMock) ConfirmPendingGatewayUpgradeCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockConfirmPendingGatewayUpgrade.RLock()
+	calls = mock.calls.ConfirmPendingGatewayUpgrade
+	mock.lockConfirmPendingGatewayUpgrade.RUnlock()
+	return calls
+}
+
 // CreateERC20Token calls CreateERC20TokenFunc.
 func (mock *ChainKeeperMock) CreateERC20Token(ctx github_com_cosmos_cosmos_sdk_types.Context, asset string, details types.TokenDetails, minDeposit github_com_cosmos_cosmos_sdk_types.Int) (types.ERC20Token, error) {
 	if mock.CreateERC20TokenFunc == nil {
@@ -3239,6 +3983,53 @@ func (mock *ChainKeeperMock) CreateERC20TokenCalls() []struct {
 	return calls
 }
 
+// CreateExternalERC20Token calls CreateExternalERC20TokenFunc.
+func (mock *ChainKeeperMock) CreateExternalERC20Token(ctx github_com_cosmos_cosmos_sdk_types.Context, asset string, details types.TokenDetails, minDeposit github_com_cosmos_cosmos_sdk_types.Int, tokenAddr types.Address) (types.ERC20Token, error) {
+	if mock.CreateExternalERC20TokenFunc == nil {
+		panic("ChainKeeperMock.CreateExternalERC20TokenFunc: method is nil but ChainKeeper.CreateExternalERC20Token was just called")
+	}
+	callInfo := struct {
+		Ctx        github_com_cosmos_cosmos_sdk_types.Context
+		Asset      string
+		Details    types.TokenDetails
+		MinDeposit github_com_cosmos_cosmos_sdk_types.Int
+		TokenAddr  types.Address
+	}{
+		Ctx:        ctx,
+		Asset:      asset,
+		Details:    details,
+		MinDeposit: minDeposit,
+		TokenAddr:  tokenAddr,
+	}
+	mock.lockCreateExternalERC20Token.Lock()
+	mock.calls.CreateExternalERC20Token = append(mock.calls.CreateExternalERC20Token, callInfo)
+	mock.lockCreateExternalERC20Token.Unlock()
+	return mock.CreateExternalERC20TokenFunc(ctx, asset, details, minDeposit, tokenAddr)
+}
+
+// CreateExternalERC20TokenCalls gets all the calls that were made to CreateExternalERC20Token.
+// Check the length with:
+//     len(mockedChainKeeper.CreateExternalERC20TokenCalls())
+func (mock *ChainKeeperMock) CreateExternalERC20TokenCalls() []struct {
+	Ctx        github_com_cosmos_cosmos_sdk_types.Context
+	Asset      string
+	Details    types.TokenDetails
+	MinDeposit github_com_cosmos_cosmos_sdk_types.Int
+	TokenAddr  types.Address
+} {
+	var calls []struct {
+		Ctx        github_com_cosmos_cosmos_sdk_types.Context
+		Asset      string
+		Details    types.TokenDetails
+		MinDeposit github_com_cosmos_cosmos_sdk_types.Int
+		TokenAddr  types.Address
+	}
+	mock.lockCreateExternalERC20Token.RLock()
+	calls = mock.calls.CreateExternalERC20Token
+	mock.lockCreateExternalERC20Token.RUnlock()
+	return calls
+}
+
 // CreateNewBatchToSign calls CreateNewBatchToSignFunc.
 func (mock *ChainKeeperMock) CreateNewBatchToSign(ctx github_com_cosmos_cosmos_sdk_types.Context) (types.CommandBatch, error) {
 	if mock.CreateNewBatchToSignFunc == nil {
@@ -3305,6 +4096,41 @@ func (mock *ChainKeeperMock) DeleteDepositCalls() []struct {
 	return calls
 }
 
+// DeletePendingBatchExecution calls DeletePendingBatchExecutionFunc.
+func (mock *ChainKeeperMock) DeletePendingBatchExecution(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) {
+	if mock.DeletePendingBatchExecutionFunc == nil {
+		panic("ChainKeeperMock.DeletePendingBatchExecutionFunc: method is nil but ChainKeeper.DeletePendingBatchExecution was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		Key vote.PollKey
+	}{
+		Ctx: ctx,
+		Key: key,
+	}
+	mock.lockDeletePendingBatchExecution.Lock()
+	mock.calls.DeletePendingBatchExecution = append(mock.calls.DeletePendingBatchExecution, callInfo)
+	mock.lockDeletePendingBatchExecution.Unlock()
+	mock.DeletePendingBatchExecutionFunc(ctx, key)
+}
+
+// DeletePendingBatchExecutionCalls gets all the calls that were made to DeletePendingBatchExecution.
+// Check the length with:
+//     len(mockedChainKeeper.DeletePendingBatchExecutionCalls())
+func (mock *ChainKeeperMock) DeletePendingBatchExecutionCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+	Key vote.PollKey
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		Key vote.PollKey
+	}
+	mock.lockDeletePendingBatchExecution.RLock()
+	calls = mock.calls.DeletePendingBatchExecution
+	mock.lockDeletePendingBatchExecution.RUnlock()
+	return calls
+}
+
 // DeletePendingDeposit calls DeletePendingDepositFunc.
 func (mock *ChainKeeperMock) DeletePendingDeposit(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) {
 	if mock.DeletePendingDepositFunc == nil {
@@ -3340,6 +4166,41 @@ func (mock *ChainKeeperMock) DeletePendingDepositCalls() []struct {
 	return calls
 }
 
+// DeletePendingGasInfo calls DeletePendingGasInfoFunc.
+func (mock *ChainKeeperMock) DeletePendingGasInfo(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) {
+	if mock.DeletePendingGasInfoFunc == nil {
+		panic("ChainKeeperMock.DeletePendingGasInfoFunc: method is nil but ChainKeeper.DeletePendingGasInfo was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		Key vote.PollKey
+	}{
+		Ctx: ctx,
+		Key: key,
+	}
+	mock.lockDeletePendingGasInfo.Lock()
+	mock.calls.DeletePendingGasInfo = append(mock.calls.DeletePendingGasInfo, callInfo)
+	mock.lockDeletePendingGasInfo.Unlock()
+	mock.DeletePendingGasInfoFunc(ctx, key)
+}
+
+// DeletePendingGasInfoCalls gets all the calls that were made to DeletePendingGasInfo.
+// Check the length with:
+//     len(mockedChainKeeper.DeletePendingGasInfoCalls())
+func (mock *ChainKeeperMock) DeletePendingGasInfoCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+	Key vote.PollKey
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		Key vote.PollKey
+	}
+	mock.lockDeletePendingGasInfo.RLock()
+	calls = mock.calls.DeletePendingGasInfo
+	mock.lockDeletePendingGasInfo.RUnlock()
+	return calls
+}
+
 // DeletePendingGateway calls DeletePendingGatewayFunc.
 func (mock *ChainKeeperMock) DeletePendingGateway(ctx github_com_cosmos_cosmos_sdk_types.Context) error {
 	if mock.DeletePendingGatewayFunc == nil {
@@ -3371,6 +4232,41 @@ func (mock *ChainKeeperMock) DeletePendingGatewayCalls() []struct {
 	return calls
 }
 
+// DeletePendingReverification calls DeletePendingReverificationFunc.
+func (mock *ChainKeeperMock) DeletePendingReverification(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) {
+	if mock.DeletePendingReverificationFunc == nil {
+		panic("ChainKeeperMock.DeletePendingReverificationFunc: method is nil but ChainKeeper.DeletePendingReverification was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		Key vote.PollKey
+	}{
+		Ctx: ctx,
+		Key: key,
+	}
+	mock.lockDeletePendingReverification.Lock()
+	mock.calls.DeletePendingReverification = append(mock.calls.DeletePendingReverification, callInfo)
+	mock.lockDeletePendingReverification.Unlock()
+	mock.DeletePendingReverificationFunc(ctx, key)
+}
+
+// DeletePendingReverificationCalls gets all the calls that were made to DeletePendingReverification.
+// Check the length with:
+//     len(mockedChainKeeper.DeletePendingReverificationCalls())
+func (mock *ChainKeeperMock) DeletePendingReverificationCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+	Key vote.PollKey
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		Key vote.PollKey
+	}
+	mock.lockDeletePendingReverification.RLock()
+	calls = mock.calls.DeletePendingReverification
+	mock.lockDeletePendingReverification.RUnlock()
+	return calls
+}
+
 // DeletePendingTransferKey calls DeletePendingTransferKeyFunc.
 func (mock *ChainKeeperMock) DeletePendingTransferKey(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) {
 	if mock.DeletePendingTransferKeyFunc == nil {
@@ -3542,6 +4438,37 @@ func (mock *ChainKeeperMock) GetBatchByIDCalls() []struct {
 	return calls
 }
 
+// GetBatchGasLimit calls GetBatchGasLimitFunc.
+func (mock *ChainKeeperMock) GetBatchGasLimit(ctx github_com_cosmos_cosmos_sdk_types.Context) uint32 {
+	if mock.GetBatchGasLimitFunc == nil {
+		panic("ChainKeeperMock.GetBatchGasLimitFunc: method is nil but ChainKeeper.GetBatchGasLimit was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetBatchGasLimit.Lock()
+	mock.calls.GetBatchGasLimit = append(mock.calls.GetBatchGasLimit, callInfo)
+	mock.lockGetBatchGasLimit.Unlock()
+	return mock.GetBatchGasLimitFunc(ctx)
+}
+
+// GetBatchGasLimitCalls gets all the calls that were made to GetBatchGasLimit.
+// Check the length with:
+//     len(mockedChainKeeper.GetBatchGasLimitCalls())
+func (mock *ChainKeeperMock) GetBatchGasLimitCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetBatchGasLimit.RLock()
+	calls = mock.calls.GetBatchGasLimit
+	mock.lockGetBatchGasLimit.RUnlock()
+	return calls
+}
+
 // GetBurnerAddressAndSalt calls GetBurnerAddressAndSaltFunc.
 func (mock *ChainKeeperMock) GetBurnerAddressAndSalt(ctx github_com_cosmos_cosmos_sdk_types.Context, tokenAddr types.Address, recipient string, gatewayAddr common.Address) (common.Address, common.Hash, error) {
 	if mock.GetBurnerAddressAndSaltFunc == nil {
@@ -3585,6 +4512,45 @@ func (mock *ChainKeeperMock) GetBurnerAddressAndSaltCalls() []struct {
 	return calls
 }
 
+// GetBurnerAddressForRecipient calls GetBurnerAddressForRecipientFunc.
+func (mock *ChainKeeperMock) GetBurnerAddressForRecipient(ctx github_com_cosmos_cosmos_sdk_types.Context, asset string, recipient nexus.CrossChainAddress) (common.Address, bool) {
+	if mock.GetBurnerAddressForRecipientFunc == nil {
+		panic("ChainKeeperMock.GetBurnerAddressForRecipientFunc: method is nil but ChainKeeper.GetBurnerAddressForRecipient was just called")
+	}
+	callInfo := struct {
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		Asset     string
+		Recipient nexus.CrossChainAddress
+	}{
+		Ctx:       ctx,
+		Asset:     asset,
+		Recipient: recipient,
+	}
+	mock.lockGetBurnerAddressForRecipient.Lock()
+	mock.calls.GetBurnerAddressForRecipient = append(mock.calls.GetBurnerAddressForRecipient, callInfo)
+	mock.lockGetBurnerAddressForRecipient.Unlock()
+	return mock.GetBurnerAddressForRecipientFunc(ctx, asset, recipient)
+}
+
+// GetBurnerAddressForRecipientCalls gets all the calls that were made to GetBurnerAddressForRecipient.
+// Check the length with:
+//     len(mockedChainKeeper.GetBurnerAddressForRecipientCalls())
+func (mock *ChainKeeperMock) GetBurnerAddressForRecipientCalls() []struct {
+	Ctx       github_com_cosmos_cosmos_sdk_types.Context
+	Asset     string
+	Recipient nexus.CrossChainAddress
+} {
+	var calls []struct {
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		Asset     string
+		Recipient nexus.CrossChainAddress
+	}
+	mock.lockGetBurnerAddressForRecipient.RLock()
+	calls = mock.calls.GetBurnerAddressForRecipient
+	mock.lockGetBurnerAddressForRecipient.RUnlock()
+	return calls
+}
+
 // GetBurnerByteCodes calls GetBurnerByteCodesFunc.
 func (mock *ChainKeeperMock) GetBurnerByteCodes(ctx github_com_cosmos_cosmos_sdk_types.Context) ([]byte, bool) {
 	if mock.GetBurnerByteCodesFunc == nil {
@@ -3752,6 +4718,37 @@ func (mock *ChainKeeperMock) GetCommandCalls() []struct {
 	return calls
 }
 
+// GetCommandBatchExecutionTimeout calls GetCommandBatchExecutionTimeoutFunc.
+func (mock *ChainKeeperMock) GetCommandBatchExecutionTimeout(ctx github_com_cosmos_cosmos_sdk_types.Context) (int64, bool) {
+	if mock.GetCommandBatchExecutionTimeoutFunc == nil {
+		panic("ChainKeeperMock.GetCommandBatchExecutionTimeoutFunc: method is nil but ChainKeeper.GetCommandBatchExecutionTimeout was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetCommandBatchExecutionTimeout.Lock()
+	mock.calls.GetCommandBatchExecutionTimeout = append(mock.calls.GetCommandBatchExecutionTimeout, callInfo)
+	mock.lockGetCommandBatchExecutionTimeout.Unlock()
+	return mock.GetCommandBatchExecutionTimeoutFunc(ctx)
+}
+
+// GetCommandBatchExecutionTimeoutCalls gets all the calls that were made to GetCommandBatchExecutionTimeout.
+// Check the length with:
+//     len(mockedChainKeeper.GetCommandBatchExecutionTimeoutCalls())
+func (mock *ChainKeeperMock) GetCommandBatchExecutionTimeoutCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetCommandBatchExecutionTimeout.RLock()
+	calls = mock.calls.GetCommandBatchExecutionTimeout
+	mock.lockGetCommandBatchExecutionTimeout.RUnlock()
+	return calls
+}
+
 // GetConfirmedDeposits calls GetConfirmedDepositsFunc.
 func (mock *ChainKeeperMock) GetConfirmedDeposits(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.ERC20Deposit {
 	if mock.GetConfirmedDepositsFunc == nil {
@@ -3869,26 +4866,119 @@ func (mock *ChainKeeperMock) GetERC20TokenBySymbol(ctx github_com_cosmos_cosmos_
 		Ctx:    ctx,
 		Symbol: symbol,
 	}
-	mock.lockGetERC20TokenBySymbol.Lock()
-	mock.calls.GetERC20TokenBySymbol = append(mock.calls.GetERC20TokenBySymbol, callInfo)
-	mock.lockGetERC20TokenBySymbol.Unlock()
-	return mock.GetERC20TokenBySymbolFunc(ctx, symbol)
+	mock.lockGetERC20TokenBySymbol.Lock()
+	mock.calls.GetERC20TokenBySymbol = append(mock.calls.GetERC20TokenBySymbol, callInfo)
+	mock.lockGetERC20TokenBySymbol.Unlock()
+	return mock.GetERC20TokenBySymbolFunc(ctx, symbol)
+}
+
+// GetERC20TokenBySymbolCalls gets all the calls that were made to GetERC20TokenBySymbol.
+// Check the length with:
+//     len(mockedChainKeeper.GetERC20TokenBySymbolCalls())
+func (mock *ChainKeeperMock) GetERC20TokenBySymbolCalls() []struct {
+	Ctx    github_com_cosmos_cosmos_sdk_types.Context
+	Symbol string
+} {
+	var calls []struct {
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
+		Symbol string
+	}
+	mock.lockGetERC20TokenBySymbol.RLock()
+	calls = mock.calls.GetERC20TokenBySymbol
+	mock.lockGetERC20TokenBySymbol.RUnlock()
+	return calls
+}
+
+// GetFinalityMode calls GetFinalityModeFunc.
+func (mock *ChainKeeperMock) GetFinalityMode(ctx github_com_cosmos_cosmos_sdk_types.Context) (types.FinalityMode, bool) {
+	if mock.GetFinalityModeFunc == nil {
+		panic("ChainKeeperMock.GetFinalityModeFunc: method is nil but ChainKeeper.GetFinalityMode was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetFinalityMode.Lock()
+	mock.calls.GetFinalityMode = append(mock.calls.GetFinalityMode, callInfo)
+	mock.lockGetFinalityMode.Unlock()
+	return mock.GetFinalityModeFunc(ctx)
+}
+
+// GetFinalityModeCalls gets all the calls that were made to GetFinalityMode.
+// Check the length with:
+//     len(mockedChainKeeper.GetFinalityModeCalls())
+func (mock *ChainKeeperMock) GetFinalityModeCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetFinalityMode.RLock()
+	calls = mock.calls.GetFinalityMode
+	mock.lockGetFinalityMode.RUnlock()
+	return calls
+}
+
+// GetGasInfo calls GetGasInfoFunc.
+func (mock *ChainKeeperMock) GetGasInfo(ctx github_com_cosmos_cosmos_sdk_types.Context) (types.GasInfo, bool) {
+	if mock.GetGasInfoFunc == nil {
+		panic("ChainKeeperMock.GetGasInfoFunc: method is nil but ChainKeeper.GetGasInfo was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetGasInfo.Lock()
+	mock.calls.GetGasInfo = append(mock.calls.GetGasInfo, callInfo)
+	mock.lockGetGasInfo.Unlock()
+	return mock.GetGasInfoFunc(ctx)
+}
+
+// GetGasInfoCalls gets all the calls that were made to GetGasInfo.
+// Check the length with:
+//     len(mockedChainKeeper.GetGasInfoCalls())
+func (mock *ChainKeeperMock) GetGasInfoCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetGasInfo.RLock()
+	calls = mock.calls.GetGasInfo
+	mock.lockGetGasInfo.RUnlock()
+	return calls
+}
+
+// GetGasInfoMaxAge calls GetGasInfoMaxAgeFunc.
+func (mock *ChainKeeperMock) GetGasInfoMaxAge(ctx github_com_cosmos_cosmos_sdk_types.Context) (int64, bool) {
+	if mock.GetGasInfoMaxAgeFunc == nil {
+		panic("ChainKeeperMock.GetGasInfoMaxAgeFunc: method is nil but ChainKeeper.GetGasInfoMaxAge was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetGasInfoMaxAge.Lock()
+	mock.calls.GetGasInfoMaxAge = append(mock.calls.GetGasInfoMaxAge, callInfo)
+	mock.lockGetGasInfoMaxAge.Unlock()
+	return mock.GetGasInfoMaxAgeFunc(ctx)
 }
 
-// GetERC20TokenBySymbolCalls gets all the calls that were made to GetERC20TokenBySymbol.
+// GetGasInfoMaxAgeCalls gets all the calls that were made to GetGasInfoMaxAge.
 // Check the length with:
-//     len(mockedChainKeeper.GetERC20TokenBySymbolCalls())
-func (mock *ChainKeeperMock) GetERC20TokenBySymbolCalls() []struct {
-	Ctx    github_com_cosmos_cosmos_sdk_types.Context
-	Symbol string
+//     len(mockedChainKeeper.GetGasInfoMaxAgeCalls())
+func (mock *ChainKeeperMock) GetGasInfoMaxAgeCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx    github_com_cosmos_cosmos_sdk_types.Context
-		Symbol string
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
-	mock.lockGetERC20TokenBySymbol.RLock()
-	calls = mock.calls.GetERC20TokenBySymbol
-	mock.lockGetERC20TokenBySymbol.RUnlock()
+	mock.lockGetGasInfoMaxAge.RLock()
+	calls = mock.calls.GetGasInfoMaxAge
+	mock.lockGetGasInfoMaxAge.RUnlock()
 	return calls
 }
 
@@ -3954,6 +5044,37 @@ func (mock *ChainKeeperMock) GetGatewayByteCodesCalls() []struct {
 	return calls
 }
 
+// GetGatewayCommands calls GetGatewayCommandsFunc.
+func (mock *ChainKeeperMock) GetGatewayCommands(ctx github_com_cosmos_cosmos_sdk_types.Context) ([]string, bool) {
+	if mock.GetGatewayCommandsFunc == nil {
+		panic("ChainKeeperMock.GetGatewayCommandsFunc: method is nil but ChainKeeper.GetGatewayCommands was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetGatewayCommands.Lock()
+	mock.calls.GetGatewayCommands = append(mock.calls.GetGatewayCommands, callInfo)
+	mock.lockGetGatewayCommands.Unlock()
+	return mock.GetGatewayCommandsFunc(ctx)
+}
+
+// GetGatewayCommandsCalls gets all the calls that were made to GetGatewayCommands.
+// Check the length with:
+//     len(mockedChainKeeper.GetGatewayCommandsCalls())
+func (mock *ChainKeeperMock) GetGatewayCommandsCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetGatewayCommands.RLock()
+	calls = mock.calls.GetGatewayCommands
+	mock.lockGetGatewayCommands.RUnlock()
+	return calls
+}
+
 // GetLatestCommandBatch calls GetLatestCommandBatchFunc.
 func (mock *ChainKeeperMock) GetLatestCommandBatch(ctx github_com_cosmos_cosmos_sdk_types.Context) types.CommandBatch {
 	if mock.GetLatestCommandBatchFunc == nil {
@@ -4042,6 +5163,45 @@ func (mock *ChainKeeperMock) GetNameCalls() []struct {
 	return calls
 }
 
+// GetNativeBurnerAddressAndSalt calls GetNativeBurnerAddressAndSaltFunc.
+func (mock *ChainKeeperMock) GetNativeBurnerAddressAndSalt(ctx github_com_cosmos_cosmos_sdk_types.Context, recipient string, gatewayAddr common.Address) (common.Address, common.Hash) {
+	if mock.GetNativeBurnerAddressAndSaltFunc == nil {
+		panic("ChainKeeperMock.GetNativeBurnerAddressAndSaltFunc: method is nil but ChainKeeper.GetNativeBurnerAddressAndSalt was just called")
+	}
+	callInfo := struct {
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Recipient   string
+		GatewayAddr common.Address
+	}{
+		Ctx:         ctx,
+		Recipient:   recipient,
+		GatewayAddr: gatewayAddr,
+	}
+	mock.lockGetNativeBurnerAddressAndSalt.Lock()
+	mock.calls.GetNativeBurnerAddressAndSalt = append(mock.calls.GetNativeBurnerAddressAndSalt, callInfo)
+	mock.lockGetNativeBurnerAddressAndSalt.Unlock()
+	return mock.GetNativeBurnerAddressAndSaltFunc(ctx, recipient, gatewayAddr)
+}
+
+// GetNativeBurnerAddressAndSaltCalls gets all the calls that were made to GetNativeBurnerAddressAndSalt.
+// Check the length with:
+//     len(mockedChainKeeper.GetNativeBurnerAddressAndSaltCalls())
+func (mock *ChainKeeperMock) GetNativeBurnerAddressAndSaltCalls() []struct {
+	Ctx         github_com_cosmos_cosmos_sdk_types.Context
+	Recipient   string
+	GatewayAddr common.Address
+} {
+	var calls []struct {
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Recipient   string
+		GatewayAddr common.Address
+	}
+	mock.lockGetNativeBurnerAddressAndSalt.RLock()
+	calls = mock.calls.GetNativeBurnerAddressAndSalt
+	mock.lockGetNativeBurnerAddressAndSalt.RUnlock()
+	return calls
+}
+
 // GetNetwork calls GetNetworkFunc.
 func (mock *ChainKeeperMock) GetNetwork(ctx github_com_cosmos_cosmos_sdk_types.Context) (string, bool) {
 	if mock.GetNetworkFunc == nil {
@@ -4139,6 +5299,41 @@ func (mock *ChainKeeperMock) GetParamsCalls() []struct {
 	return calls
 }
 
+// GetPendingBatchExecution calls GetPendingBatchExecutionFunc.
+func (mock *ChainKeeperMock) GetPendingBatchExecution(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (types.PendingBatchExecution, bool) {
+	if mock.GetPendingBatchExecutionFunc == nil {
+		panic("ChainKeeperMock.GetPendingBatchExecutionFunc: method is nil but ChainKeeper.GetPendingBatchExecution was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		Key vote.PollKey
+	}{
+		Ctx: ctx,
+		Key: key,
+	}
+	mock.lockGetPendingBatchExecution.Lock()
+	mock.calls.GetPendingBatchExecution = append(mock.calls.GetPendingBatchExecution, callInfo)
+	mock.lockGetPendingBatchExecution.Unlock()
+	return mock.GetPendingBatchExecutionFunc(ctx, key)
+}
+
+// GetPendingBatchExecutionCalls gets all the calls that were made to GetPendingBatchExecution.
+// Check the length with:
+//     len(mockedChainKeeper.GetPendingBatchExecutionCalls())
+func (mock *ChainKeeperMock) GetPendingBatchExecutionCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+	Key vote.PollKey
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		Key vote.PollKey
+	}
+	mock.lockGetPendingBatchExecution.RLock()
+	calls = mock.calls.GetPendingBatchExecution
+	mock.lockGetPendingBatchExecution.RUnlock()
+	return calls
+}
+
 // GetPendingCommands calls GetPendingCommandsFunc.
 func (mock *ChainKeeperMock) GetPendingCommands(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.Command {
 	if mock.GetPendingCommandsFunc == nil {
@@ -4205,6 +5400,41 @@ func (mock *ChainKeeperMock) GetPendingDepositCalls() []struct {
 	return calls
 }
 
+// GetPendingGasInfo calls GetPendingGasInfoFunc.
+func (mock *ChainKeeperMock) GetPendingGasInfo(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (uint64, bool) {
+	if mock.GetPendingGasInfoFunc == nil {
+		panic("ChainKeeperMock.GetPendingGasInfoFunc: method is nil but ChainKeeper.GetPendingGasInfo was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		Key vote.PollKey
+	}{
+		Ctx: ctx,
+		Key: key,
+	}
+	mock.lockGetPendingGasInfo.Lock()
+	mock.calls.GetPendingGasInfo = append(mock.calls.GetPendingGasInfo, callInfo)
+	mock.lockGetPendingGasInfo.Unlock()
+	return mock.GetPendingGasInfoFunc(ctx, key)
+}
+
+// GetPendingGasInfoCalls gets all the calls that were made to GetPendingGasInfo.
+// Check the length with:
+//     len(mockedChainKeeper.GetPendingGasInfoCalls())
+func (mock *ChainKeeperMock) GetPendingGasInfoCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+	Key vote.PollKey
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		Key vote.PollKey
+	}
+	mock.lockGetPendingGasInfo.RLock()
+	calls = mock.calls.GetPendingGasInfo
+	mock.lockGetPendingGasInfo.RUnlock()
+	return calls
+}
+
 // GetPendingGatewayAddress calls GetPendingGatewayAddressFunc.
 func (mock *ChainKeeperMock) GetPendingGatewayAddress(ctx github_com_cosmos_cosmos_sdk_types.Context) (common.Address, bool) {
 	if mock.GetPendingGatewayAddressFunc == nil {
@@ -4236,6 +5466,72 @@ func (mock *ChainKeeperMock) GetPendingGatewayAddressCalls() []struct {
 	return calls
 }
 
+// GetPendingGatewayUpgrade calls GetPendingGatewayUpgradeFunc.
+func (mock *ChainKeeperMock) GetPendingGatewayUpgrade(ctx github_com_cosmos_cosmos_sdk_types.Context) (common.Address, bool) {
+	if mock.GetPendingGatewayUpgradeFunc == nil {
+		panic("ChainKeeperMock.GetPendingGatewayUpgradeFunc: method is nil but ChainKeeper.GetPendingGatewayUpgrade was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetPendingGatewayUpgrade.Lock()
+	mock.calls.GetPendingGatewayUpgrade = append(mock.calls.GetPendingGatewayUpgrade, callInfo)
+	mock.lockGetPendingGatewayUpgrade.Unlock()
+	return mock.GetPendingGatewayUpgradeFunc(ctx)
+}
+
+// GetPendingGatewayUpgradeCalls gets all the calls that were made to GetPendingGatewayUpgrade.
+// Check the length with:
+//     len(mockedChainKeeper.GetPendingGatewayUpgradeCalls())
+func (mock *ChainKeeperMock) GetPendingGatewayUpgradeCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetPendingGatewayUpgrade.RLock()
+	calls = mock.calls.GetPendingGatewayUpgrade
+	mock.lockGetPendingGatewayUpgrade.RUnlock()
+	return calls
+}
+
+// GetPendingReverification calls GetPendingReverificationFunc.
+func (mock *ChainKeeperMock) GetPendingReverification(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (types.ERC20Deposit, bool) {
+	if mock.GetPendingReverificationFunc == nil {
+		panic("ChainKeeperMock.GetPendingReverificationFunc: method is nil but ChainKeeper.GetPendingReverification was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		Key vote.PollKey
+	}{
+		Ctx: ctx,
+		Key: key,
+	}
+	mock.lockGetPendingReverification.Lock()
+	mock.calls.GetPendingReverification = append(mock.calls.GetPendingReverification, callInfo)
+	mock.lockGetPendingReverification.Unlock()
+	return mock.GetPendingReverificationFunc(ctx, key)
+}
+
+// GetPendingReverificationCalls gets all the calls that were made to GetPendingReverification.
+// Check the length with:
+//     len(mockedChainKeeper.GetPendingReverificationCalls())
+func (mock *ChainKeeperMock) GetPendingReverificationCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+	Key vote.PollKey
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		Key vote.PollKey
+	}
+	mock.lockGetPendingReverification.RLock()
+	calls = mock.calls.GetPendingReverification
+	mock.lockGetPendingReverification.RUnlock()
+	return calls
+}
+
 // GetPendingTransferKey calls GetPendingTransferKeyFunc.
 func (mock *ChainKeeperMock) GetPendingTransferKey(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (types.TransferKey, bool) {
 	if mock.GetPendingTransferKeyFunc == nil {
@@ -4457,6 +5753,49 @@ func (mock *ChainKeeperMock) LoggerCalls() []struct {
 	return calls
 }
 
+// SetBurnerAddressForRecipient calls SetBurnerAddressForRecipientFunc.
+func (mock *ChainKeeperMock) SetBurnerAddressForRecipient(ctx github_com_cosmos_cosmos_sdk_types.Context, asset string, recipient nexus.CrossChainAddress, burnerAddr common.Address) {
+	if mock.SetBurnerAddressForRecipientFunc == nil {
+		panic("ChainKeeperMock.SetBurnerAddressForRecipientFunc: method is nil but ChainKeeper.SetBurnerAddressForRecipient was just called")
+	}
+	callInfo := struct {
+		Ctx        github_com_cosmos_cosmos_sdk_types.Context
+		Asset      string
+		Recipient  nexus.CrossChainAddress
+		BurnerAddr common.Address
+	}{
+		Ctx:        ctx,
+		Asset:      asset,
+		Recipient:  recipient,
+		BurnerAddr: burnerAddr,
+	}
+	mock.lockSetBurnerAddressForRecipient.Lock()
+	mock.calls.SetBurnerAddressForRecipient = append(mock.calls.SetBurnerAddressForRecipient, callInfo)
+	mock.lockSetBurnerAddressForRecipient.Unlock()
+	mock.SetBurnerAddressForRecipientFunc(ctx, asset, recipient, burnerAddr)
+}
+
+// SetBurnerAddressForRecipientCalls gets all the calls that were made to SetBurnerAddressForRecipient.
+// Check the length with:
+//     len(mockedChainKeeper.SetBurnerAddressForRecipientCalls())
+func (mock *ChainKeeperMock) SetBurnerAddressForRecipientCalls() []struct {
+	Ctx        github_com_cosmos_cosmos_sdk_types.Context
+	Asset      string
+	Recipient  nexus.CrossChainAddress
+	BurnerAddr common.Address
+} {
+	var calls []struct {
+		Ctx        github_com_cosmos_cosmos_sdk_types.Context
+		Asset      string
+		Recipient  nexus.CrossChainAddress
+		BurnerAddr common.Address
+	}
+	mock.lockSetBurnerAddressForRecipient.RLock()
+	calls = mock.calls.SetBurnerAddressForRecipient
+	mock.lockSetBurnerAddressForRecipient.RUnlock()
+	return calls
+}
+
 // SetBurnerInfo calls SetBurnerInfoFunc.
 func (mock *ChainKeeperMock) SetBurnerInfo(ctx github_com_cosmos_cosmos_sdk_types.Context, burnerInfo types.BurnerInfo) {
 	if mock.SetBurnerInfoFunc == nil {
@@ -4531,6 +5870,41 @@ func (mock *ChainKeeperMock) SetDepositCalls() []struct {
 	return calls
 }
 
+// SetGasInfo calls SetGasInfoFunc.
+func (mock *ChainKeeperMock) SetGasInfo(ctx github_com_cosmos_cosmos_sdk_types.Context, gasInfo types.GasInfo) {
+	if mock.SetGasInfoFunc == nil {
+		panic("ChainKeeperMock.SetGasInfoFunc: method is nil but ChainKeeper.SetGasInfo was just called")
+	}
+	callInfo := struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		GasInfo types.GasInfo
+	}{
+		Ctx:     ctx,
+		GasInfo: gasInfo,
+	}
+	mock.lockSetGasInfo.Lock()
+	mock.calls.SetGasInfo = append(mock.calls.SetGasInfo, callInfo)
+	mock.lockSetGasInfo.Unlock()
+	mock.SetGasInfoFunc(ctx, gasInfo)
+}
+
+// SetGasInfoCalls gets all the calls that were made to SetGasInfo.
+// Check the length with:
+//     len(mockedChainKeeper.SetGasInfoCalls())
+func (mock *ChainKeeperMock) SetGasInfoCalls() []struct {
+	Ctx     github_com_cosmos_cosmos_sdk_types.Context
+	GasInfo types.GasInfo
+} {
+	var calls []struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		GasInfo types.GasInfo
+	}
+	mock.lockSetGasInfo.RLock()
+	calls = mock.calls.SetGasInfo
+	mock.lockSetGasInfo.RUnlock()
+	return calls
+}
+
 // SetLatestSignedCommandBatchID calls SetLatestSignedCommandBatchIDFunc.
 func (mock *ChainKeeperMock) SetLatestSignedCommandBatchID(ctx github_com_cosmos_cosmos_sdk_types.Context, id []byte) {
 	if mock.SetLatestSignedCommandBatchIDFunc == nil {
@@ -4601,6 +5975,45 @@ func (mock *ChainKeeperMock) SetParamsCalls() []struct {
 	return calls
 }
 
+// SetPendingBatchExecution calls SetPendingBatchExecutionFunc.
+func (mock *ChainKeeperMock) SetPendingBatchExecution(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, execution *types.PendingBatchExecution) {
+	if mock.SetPendingBatchExecutionFunc == nil {
+		panic("ChainKeeperMock.SetPendingBatchExecutionFunc: method is nil but ChainKeeper.SetPendingBatchExecution was just called")
+	}
+	callInfo := struct {
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		Key       vote.PollKey
+		Execution *types.PendingBatchExecution
+	}{
+		Ctx:       ctx,
+		Key:       key,
+		Execution: execution,
+	}
+	mock.lockSetPendingBatchExecution.Lock()
+	mock.calls.SetPendingBatchExecution = append(mock.calls.SetPendingBatchExecution, callInfo)
+	mock.lockSetPendingBatchExecution.Unlock()
+	mock.SetPendingBatchExecutionFunc(ctx, key, execution)
+}
+
+// SetPendingBatchExecutionCalls gets all the calls that were made to SetPendingBatchExecution.
+// Check the length with:
+//     len(mockedChainKeeper.SetPendingBatchExecutionCalls())
+func (mock *ChainKeeperMock) SetPendingBatchExecutionCalls() []struct {
+	Ctx       github_com_cosmos_cosmos_sdk_types.Context
+	Key       vote.PollKey
+	Execution *types.PendingBatchExecution
+} {
+	var calls []struct {
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		Key       vote.PollKey
+		Execution *types.PendingBatchExecution
+	}
+	mock.lockSetPendingBatchExecution.RLock()
+	calls = mock.calls.SetPendingBatchExecution
+	mock.lockSetPendingBatchExecution.RUnlock()
+	return calls
+}
+
 // SetPendingDeposit calls SetPendingDepositFunc.
 func (mock *ChainKeeperMock) SetPendingDeposit(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, deposit *types.ERC20Deposit) {
 	if mock.SetPendingDepositFunc == nil {
@@ -4640,6 +6053,45 @@ func (mock *ChainKeeperMock) SetPendingDepositCalls() []struct {
 	return calls
 }
 
+// SetPendingGasInfo calls SetPendingGasInfoFunc.
+func (mock *ChainKeeperMock) SetPendingGasInfo(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, blockNumber uint64) {
+	if mock.SetPendingGasInfoFunc == nil {
+		panic("ChainKeeperMock.SetPendingGasInfoFunc: method is nil but ChainKeeper.SetPendingGasInfo was just called")
+	}
+	callInfo := struct {
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Key         vote.PollKey
+		BlockNumber uint64
+	}{
+		Ctx:         ctx,
+		Key:         key,
+		BlockNumber: blockNumber,
+	}
+	mock.lockSetPendingGasInfo.Lock()
+	mock.calls.SetPendingGasInfo = append(mock.calls.SetPendingGasInfo, callInfo)
+	mock.lockSetPendingGasInfo.Unlock()
+	mock.SetPendingGasInfoFunc(ctx, key, blockNumber)
+}
+
+// SetPendingGasInfoCalls gets all the calls that were made to SetPendingGasInfo.
+// Check the length with:
+//     len(mockedChainKeeper.SetPendingGasInfoCalls())
+func (mock *ChainKeeperMock) SetPendingGasInfoCalls() []struct {
+	Ctx         github_com_cosmos_cosmos_sdk_types.Context
+	Key         vote.PollKey
+	BlockNumber uint64
+} {
+	var calls []struct {
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Key         vote.PollKey
+		BlockNumber uint64
+	}
+	mock.lockSetPendingGasInfo.RLock()
+	calls = mock.calls.SetPendingGasInfo
+	mock.lockSetPendingGasInfo.RUnlock()
+	return calls
+}
+
 // SetPendingGateway calls SetPendingGatewayFunc.
 func (mock *ChainKeeperMock) SetPendingGateway(ctx github_com_cosmos_cosmos_sdk_types.Context, address common.Address) {
 	if mock.SetPendingGatewayFunc == nil {
@@ -4675,6 +6127,84 @@ func (mock *ChainKeeperMock) SetPendingGatewayCalls() []struct {
 	return calls
 }
 
+// SetPendingGatewayUpgrade calls SetPendingGatewayUpgradeFunc.
+func (mock *ChainKeeperMock) SetPendingGatewayUpgrade(ctx github_com_cosmos_cosmos_sdk_types.Context, implementation common.Address, commands []string) error {
+	if mock.SetPendingGatewayUpgradeFunc == nil {
+		panic("ChainKeeperMock.SetPendingGatewayUpgradeFunc: method is nil but ChainKeeper.SetPendingGatewayUpgrade was just called")
+	}
+	callInfo := struct {
+		Ctx            github_com_cosmos_cosmos_sdk_types.Context
+		Implementation common.Address
+		Commands       []string
+	}{
+		Ctx:            ctx,
+		Implementation: implementation,
+		Commands:       commands,
+	}
+	mock.lockSetPendingGatewayUpgrade.Lock()
+	mock.calls.SetPendingGatewayUpgrade = append(mock.calls.SetPendingGatewayUpgrade, callInfo)
+	mock.lockSetPendingGatewayUpgrade.Unlock()
+	return mock.SetPendingGatewayUpgradeFunc(ctx, implementation, commands)
+}
+
+// SetPendingGatewayUpgradeCalls gets all the calls that were made to SetPendingGatewayUpgrade.
+// Check the length with:
+//     len(mockedChainKeeper.SetPendingGatewayUpgradeCalls())
+func (mock *ChainKeeperMock) SetPendingGatewayUpgradeCalls() []struct {
+	Ctx            github_com_cosmos_cosmos_sdk_types.Context
+	Implementation common.Address
+	Commands       []string
+} {
+	var calls []struct {
+		Ctx            github_com_cosmos_cosmos_sdk_types.Context
+		Implementation common.Address
+		Commands       []string
+	}
+	mock.lockSetPendingGatewayUpgrade.RLock()
+	calls = mock.calls.SetPendingGatewayUpgrade
+	mock.lockSetPendingGatewayUpgrade.RUnlock()
+	return calls
+}
+
+// SetPendingReverification calls SetPendingReverificationFunc.
+func (mock *ChainKeeperMock) SetPendingReverification(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, deposit *types.ERC20Deposit) {
+	if mock.SetPendingReverificationFunc == nil {
+		panic("ChainKeeperMock.SetPendingReverificationFunc: method is nil but ChainKeeper.SetPendingReverification was just called")
+	}
+	callInfo := struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		Key     vote.PollKey
+		Deposit *types.ERC20Deposit
+	}{
+		Ctx:     ctx,
+		Key:     key,
+		Deposit: deposit,
+	}
+	mock.lockSetPendingReverification.Lock()
+	mock.calls.SetPendingReverification = append(mock.calls.SetPendingReverification, callInfo)
+	mock.lockSetPendingReverification.Unlock()
+	mock.SetPendingReverificationFunc(ctx, key, deposit)
+}
+
+// SetPendingReverificationCalls gets all the calls that were made to SetPendingReverification.
+// Check the length with:
+//     len(mockedChainKeeper.SetPendingReverificationCalls())
+func (mock *ChainKeeperMock) SetPendingReverificationCalls() []struct {
+	Ctx     github_com_cosmos_cosmos_sdk_types.Context
+	Key     vote.PollKey
+	Deposit *types.ERC20Deposit
+} {
+	var calls []struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		Key     vote.PollKey
+		Deposit *types.ERC20Deposit
+	}
+	mock.lockSetPendingReverification.RLock()
+	calls = mock.calls.SetPendingReverification
+	mock.lockSetPendingReverification.RUnlock()
+	return calls
+}
+
 // SetPendingTransferKey calls SetPendingTransferKeyFunc.
 func (mock *ChainKeeperMock) SetPendingTransferKey(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, transferOwnership *types.TransferKey) {
 	if mock.SetPendingTransferKeyFunc == nil {
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewConfirmBatchExecutionRequest creates a message of type ConfirmBatchExecutionRequest
+func NewConfirmBatchExecutionRequest(sender sdk.AccAddress, chain string, batchID []byte, txID common.Hash) *ConfirmBatchExecutionRequest {
+	return &ConfirmBatchExecutionRequest{
+		Sender:  sender,
+		Chain:   chain,
+		BatchID: batchID,
+		TxID:    Hash(txID),
+	}
+}
+
+// Route implements sdk.Msg
+func (m ConfirmBatchExecutionRequest) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m ConfirmBatchExecutionRequest) Type() string {
+	return "ConfirmBatchExecution"
+}
+
+// ValidateBasic implements sdk.Msg
+func (m ConfirmBatchExecutionRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
+	if len(m.BatchID) == 0 {
+		return fmt.Errorf("missing batch ID")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m ConfirmBatchExecutionRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements sdk.Msg
+func (m ConfirmBatchExecutionRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewConfirmGasInfoRequest creates a message of type ConfirmGasInfoRequest
+func NewConfirmGasInfoRequest(sender sdk.AccAddress, chain string, blockNumber uint64) *ConfirmGasInfoRequest {
+	return &ConfirmGasInfoRequest{
+		Sender:      sender,
+		Chain:       chain,
+		BlockNumber: blockNumber,
+	}
+}
+
+// Route implements sdk.Msg
+func (m ConfirmGasInfoRequest) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m ConfirmGasInfoRequest) Type() string {
+	return "ConfirmGasInfo"
+}
+
+// ValidateBasic implements sdk.Msg
+func (m ConfirmGasInfoRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
+	if m.BlockNumber == 0 {
+		return fmt.Errorf("block number must be >0")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m ConfirmGasInfoRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements sdk.Msg
+func (m ConfirmGasInfoRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewConfirmGatewayUpgradeRequest creates a message of type ConfirmGatewayUpgradeRequest
+func NewConfirmGatewayUpgradeRequest(sender sdk.AccAddress, chain string, txID common.Hash) *ConfirmGatewayUpgradeRequest {
+	return &ConfirmGatewayUpgradeRequest{
+		Sender: sender,
+		Chain:  chain,
+		TxID:   Hash(txID),
+	}
+}
+
+// Route implements sdk.Msg
+func (m ConfirmGatewayUpgradeRequest) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m ConfirmGatewayUpgradeRequest) Type() string {
+	return "ConfirmGatewayUpgrade"
+}
+
+// ValidateBasic implements sdk.Msg
+func (m ConfirmGatewayUpgradeRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m ConfirmGatewayUpgradeRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements sdk.Msg
+func (m ConfirmGatewayUpgradeRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewCreateRegisterExternalTokenRequest is the constructor for CreateRegisterExternalTokenRequest
+func NewCreateRegisterExternalTokenRequest(sender sdk.AccAddress, chain string, asset string, tokenDetails TokenDetails, minAmount sdk.Int, tokenAddress Address) *CreateRegisterExternalTokenRequest {
+	return &CreateRegisterExternalTokenRequest{
+		Sender:       sender,
+		Chain:        chain,
+		Asset:        asset,
+		TokenDetails: tokenDetails,
+		MinAmount:    minAmount,
+		TokenAddress: tokenAddress,
+	}
+}
+
+// Route implements sdk.Msg
+func (m CreateRegisterExternalTokenRequest) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m CreateRegisterExternalTokenRequest) Type() string {
+	return "CreateRegisterExternalToken"
+}
+
+// GetSignBytes  implements sdk.Msg
+func (m CreateRegisterExternalTokenRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements sdk.Msg
+func (m CreateRegisterExternalTokenRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m CreateRegisterExternalTokenRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
+	if err := sdk.ValidateDenom(m.Asset); err != nil {
+		return sdkerrors.Wrap(err, "invalid asset")
+	}
+	if err := m.TokenDetails.Validate(); err != nil {
+		return err
+	}
+
+	if m.MinAmount.LTE(sdk.ZeroInt()) {
+		return fmt.Errorf("minimum amount for unlock/withdrawals must be greater than zero")
+	}
+
+	if m.TokenAddress == (Address{}) {
+		return fmt.Errorf("missing token address")
+	}
+
+	return nil
+}
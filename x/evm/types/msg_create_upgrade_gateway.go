@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewCreateUpgradeGatewayRequest is the constructor for CreateUpgradeGatewayRequest
+func NewCreateUpgradeGatewayRequest(sender sdk.AccAddress, chain string, newImplementation common.Address, newImplementationCodeHash common.Hash, setupParams []byte, newImplementationCommands []string) *CreateUpgradeGatewayRequest {
+	return &CreateUpgradeGatewayRequest{
+		Sender:                    sender,
+		Chain:                     chain,
+		NewImplementation:         Address(newImplementation),
+		NewImplementationCodeHash: Hash(newImplementationCodeHash),
+		SetupParams:               setupParams,
+		NewImplementationCommands: newImplementationCommands,
+	}
+}
+
+// Route implements sdk.Msg
+func (m CreateUpgradeGatewayRequest) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m CreateUpgradeGatewayRequest) Type() string {
+	return "CreateUpgradeGateway"
+}
+
+// GetSignBytes  implements sdk.Msg
+func (m CreateUpgradeGatewayRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements sdk.Msg
+func (m CreateUpgradeGatewayRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m CreateUpgradeGatewayRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
+	if m.NewImplementation == (Address{}) {
+		return fmt.Errorf("missing new implementation address")
+	}
+
+	if m.NewImplementationCodeHash == (Hash{}) {
+		return fmt.Errorf("missing new implementation code hash")
+	}
+
+	if len(m.NewImplementationCommands) == 0 {
+		return fmt.Errorf("missing new implementation commands")
+	}
+
+	if err := ValidateGatewayCommands(m.NewImplementationCommands); err != nil {
+		return sdkerrors.Wrap(ErrEVM, err.Error())
+	}
+
+	return nil
+}
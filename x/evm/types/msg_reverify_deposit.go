@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewReverifyDepositRequest creates a message of type ReverifyDepositRequest
+func NewReverifyDepositRequest(sender sdk.AccAddress, chain string, txID common.Hash, burnerAddr common.Address) *ReverifyDepositRequest {
+	return &ReverifyDepositRequest{
+		Sender:        sender,
+		Chain:         chain,
+		TxID:          Hash(txID),
+		BurnerAddress: Address(burnerAddr),
+	}
+}
+
+// Route implements sdk.Msg
+func (m ReverifyDepositRequest) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m ReverifyDepositRequest) Type() string {
+	return "ReverifyERC20Deposit"
+}
+
+// ValidateBasic implements sdk.Msg
+func (m ReverifyDepositRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m ReverifyDepositRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements sdk.Msg
+func (m ReverifyDepositRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewVoteConfirmBatchExecutionRequest creates a message of type VoteConfirmBatchExecutionRequest
+func NewVoteConfirmBatchExecutionRequest(sender sdk.AccAddress, chain string, key vote.PollKey, executedCommandIDs []CommandID) *VoteConfirmBatchExecutionRequest {
+	return &VoteConfirmBatchExecutionRequest{
+		Sender:             sender,
+		Chain:              chain,
+		PollKey:            key,
+		ExecutedCommandIDs: executedCommandIDs,
+	}
+}
+
+// Route implements sdk.Msg
+func (m VoteConfirmBatchExecutionRequest) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m VoteConfirmBatchExecutionRequest) Type() string {
+	return "VoteConfirmBatchExecution"
+}
+
+// ValidateBasic implements sdk.Msg
+func (m VoteConfirmBatchExecutionRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
+	if err := m.PollKey.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, commandID := range m.ExecutedCommandIDs {
+		if seen[commandID.Hex()] {
+			return fmt.Errorf("duplicate executed command ID %s", commandID.Hex())
+		}
+		seen[commandID.Hex()] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m VoteConfirmBatchExecutionRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements sdk.Msg
+func (m VoteConfirmBatchExecutionRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewVoteConfirmGasInfoRequest creates a message of type VoteConfirmGasInfoRequest
+func NewVoteConfirmGasInfoRequest(sender sdk.AccAddress, chain string, key vote.PollKey, gasInfo GasInfo) *VoteConfirmGasInfoRequest {
+	return &VoteConfirmGasInfoRequest{
+		Sender:  sender,
+		Chain:   chain,
+		PollKey: key,
+		GasInfo: gasInfo,
+	}
+}
+
+// Route implements sdk.Msg
+func (m VoteConfirmGasInfoRequest) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m VoteConfirmGasInfoRequest) Type() string {
+	return "VoteConfirmGasInfo"
+}
+
+// ValidateBasic implements sdk.Msg
+func (m VoteConfirmGasInfoRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
+	if err := m.PollKey.Validate(); err != nil {
+		return err
+	}
+
+	if m.GasInfo.ConfirmedAtHeight != 0 {
+		return fmt.Errorf("confirmation height of gas info must not be set by voters")
+	}
+
+	// an empty gas info signals that the voter could not observe the block
+	if m.GasInfo.BlockNumber == 0 {
+		return nil
+	}
+
+	return m.GasInfo.Validate()
+}
+
+// GetSignBytes implements sdk.Msg
+func (m VoteConfirmGasInfoRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements sdk.Msg
+func (m VoteConfirmGasInfoRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+)
+
+// NewVoteConfirmGatewayUpgradeRequest creates a message of type VoteConfirmGatewayUpgradeRequest
+func NewVoteConfirmGatewayUpgradeRequest(sender sdk.AccAddress, chain string, key vote.PollKey, txID common.Hash, confirmed bool) *VoteConfirmGatewayUpgradeRequest {
+	return &VoteConfirmGatewayUpgradeRequest{
+		Sender:    sender,
+		Chain:     chain,
+		PollKey:   key,
+		TxID:      Hash(txID),
+		Confirmed: confirmed,
+	}
+}
+
+// Route returns the route for this message
+func (m VoteConfirmGatewayUpgradeRequest) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (m VoteConfirmGatewayUpgradeRequest) Type() string {
+	return "VoteConfirmGatewayUpgrade"
+}
+
+// ValidateBasic executes a stateless message validation
+func (m VoteConfirmGatewayUpgradeRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
+	return m.PollKey.Validate()
+}
+
+// GetSignBytes returns the message bytes that need to be signed
+func (m VoteConfirmGatewayUpgradeRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the set of signers for this message
+func (m VoteConfirmGatewayUpgradeRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
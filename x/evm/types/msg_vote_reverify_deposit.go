@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+)
+
+// NewVoteReverifyDepositRequest creates a message of type VoteReverifyDepositRequest
+func NewVoteReverifyDepositRequest(
+	sender sdk.AccAddress,
+	chain string,
+	key vote.PollKey,
+	txID common.Hash,
+	burnAddr Address,
+	canonical bool) *VoteReverifyDepositRequest {
+	return &VoteReverifyDepositRequest{
+		Sender:      sender,
+		Chain:       chain,
+		PollKey:     key,
+		TxID:        Hash(txID),
+		BurnAddress: burnAddr,
+		Canonical:   canonical,
+	}
+}
+
+// Route returns the route for this message
+func (m VoteReverifyDepositRequest) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (m VoteReverifyDepositRequest) Type() string {
+	return "VoteReverifyDeposit"
+}
+
+// ValidateBasic executes a stateless message validation
+func (m VoteReverifyDepositRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
+	return m.PollKey.Validate()
+}
+
+// GetSignBytes returns the message bytes that need to be signed
+func (m VoteReverifyDepositRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the set of signers for this message
+func (m VoteReverifyDepositRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
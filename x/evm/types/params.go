@@ -16,18 +16,22 @@ import (
 
 // Parameter keys
 var (
-	KeyChain               = []byte("chain")
-	KeyConfirmationHeight  = []byte("confirmationHeight")
-	KeyNetwork             = []byte("network")
-	KeyRevoteLockingPeriod = []byte("revoteLockingPeriod")
-	KeyNetworks            = []byte("networks")
-	KeyVotingThreshold     = []byte("votingThreshold")
-	KeyGateway             = []byte("gateway")
-	KeyToken               = []byte("token")
-	KeyBurnable            = []byte("burnable")
-	KeyMinVoterCount       = []byte("minVoterCount")
-	KeyCommandsGasLimit    = []byte("commandsGasLimit")
-	KeyTransactionFeeRate  = []byte("transactionFeeRate")
+	KeyChain                        = []byte("chain")
+	KeyConfirmationHeight           = []byte("confirmationHeight")
+	KeyNetwork                      = []byte("network")
+	KeyRevoteLockingPeriod          = []byte("revoteLockingPeriod")
+	KeyNetworks                     = []byte("networks")
+	KeyVotingThreshold              = []byte("votingThreshold")
+	KeyGateway                      = []byte("gateway")
+	KeyToken                        = []byte("token")
+	KeyBurnable                     = []byte("burnable")
+	KeyMinVoterCount                = []byte("minVoterCount")
+	KeyCommandsGasLimit             = []byte("commandsGasLimit")
+	KeyTransactionFeeRate           = []byte("transactionFeeRate")
+	KeyCommandBatchExecutionTimeout = []byte("commandBatchExecutionTimeout")
+	KeyGasInfoMaxAge                = []byte("gasInfoMaxAge")
+	KeyFinalityMode                 = []byte("finalityMode")
+	KeyGatewayCommands              = []byte("gatewayCommands")
 )
 
 // KeyTable returns a subspace.KeyTable that has registered all parameter types in this module's parameter set
@@ -80,10 +84,14 @@ func DefaultParams() []Params {
 				Id:   sdk.NewIntFromBigInt(gethParams.AllCliqueProtocolChanges.ChainID),
 			},
 		},
-		VotingThreshold:    utils.Threshold{Numerator: 51, Denominator: 100},
-		MinVoterCount:      1,
-		CommandsGasLimit:   5000000,
-		TransactionFeeRate: sdk.NewDecWithPrec(1, 3), // 0.1%
+		VotingThreshold:              utils.Threshold{Numerator: 51, Denominator: 100},
+		MinVoterCount:                1,
+		CommandsGasLimit:             5000000,
+		TransactionFeeRate:           sdk.NewDecWithPrec(1, 3), // 0.1%
+		CommandBatchExecutionTimeout: 1000,
+		GasInfoMaxAge:                500,
+		FinalityMode:                 FinalityDepth,
+		GatewayCommands:              AllGatewayCommands(),
 	}}
 }
 
@@ -109,6 +117,10 @@ func (m *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyMinVoterCount, &m.MinVoterCount, validateMinVoterCount),
 		params.NewParamSetPair(KeyCommandsGasLimit, &m.CommandsGasLimit, validateCommandsGasLimit),
 		params.NewParamSetPair(KeyTransactionFeeRate, &m.TransactionFeeRate, validateTransactionFeeRate),
+		params.NewParamSetPair(KeyCommandBatchExecutionTimeout, &m.CommandBatchExecutionTimeout, validateCommandBatchExecutionTimeout),
+		params.NewParamSetPair(KeyGasInfoMaxAge, &m.GasInfoMaxAge, validateGasInfoMaxAge),
+		params.NewParamSetPair(KeyFinalityMode, &m.FinalityMode, validateFinalityMode),
+		params.NewParamSetPair(KeyGatewayCommands, &m.GatewayCommands, validateGatewayCommands),
 	}
 }
 
@@ -249,6 +261,55 @@ func validateTransactionFeeRate(i interface{}) error {
 	return nil
 }
 
+func validateCommandBatchExecutionTimeout(i interface{}) error {
+	val, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for command batch execution timeout: %T", i)
+	}
+
+	if val <= 0 {
+		return fmt.Errorf("command batch execution timeout must be >0")
+	}
+
+	return nil
+}
+
+func validateGasInfoMaxAge(i interface{}) error {
+	val, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for gas info max age: %T", i)
+	}
+
+	if val <= 0 {
+		return fmt.Errorf("gas info max age must be >0")
+	}
+
+	return nil
+}
+
+func validateFinalityMode(i interface{}) error {
+	val, ok := i.(FinalityMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for finality mode: %T", i)
+	}
+
+	switch val {
+	case FinalityDepth, FinalityFinalized, FinalitySafe:
+		return nil
+	default:
+		return fmt.Errorf("invalid finality mode %s", val.String())
+	}
+}
+
+func validateGatewayCommands(i interface{}) error {
+	val, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for gateway commands: %T", i)
+	}
+
+	return ValidateGatewayCommands(val)
+}
+
 // Validate checks the validity of the values of the parameter set
 func (m Params) Validate() error {
 	if err := validateConfirmationHeight(m.ConfirmationHeight); err != nil {
@@ -279,6 +340,22 @@ func (m Params) Validate() error {
 		return err
 	}
 
+	if err := validateCommandBatchExecutionTimeout(m.CommandBatchExecutionTimeout); err != nil {
+		return err
+	}
+
+	if err := validateGasInfoMaxAge(m.GasInfoMaxAge); err != nil {
+		return err
+	}
+
+	if err := validateFinalityMode(m.FinalityMode); err != nil {
+		return err
+	}
+
+	if err := validateGatewayCommands(m.GatewayCommands); err != nil {
+		return err
+	}
+
 	// ensure that the network is one of the supported ones
 	for _, n := range m.Networks {
 		if n.Name == m.Network {
@@ -40,6 +40,16 @@ type Params struct {
 	MinVoterCount       int64                                  `protobuf:"varint,10,opt,name=min_voter_count,json=minVoterCount,proto3" json:"min_voter_count,omitempty"`
 	CommandsGasLimit    uint32                                 `protobuf:"varint,11,opt,name=commands_gas_limit,json=commandsGasLimit,proto3" json:"commands_gas_limit,omitempty"`
 	TransactionFeeRate  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=transaction_fee_rate,json=transactionFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"transaction_fee_rate"`
+	// number of blocks after signing a command batch is expected to be executed
+	CommandBatchExecutionTimeout int64 `protobuf:"varint,13,opt,name=command_batch_execution_timeout,json=commandBatchExecutionTimeout,proto3" json:"command_batch_execution_timeout,omitempty"`
+	// number of blocks after which confirmed gas info is considered stale
+	GasInfoMaxAge int64 `protobuf:"varint,14,opt,name=gas_info_max_age,json=gasInfoMaxAge,proto3" json:"gas_info_max_age,omitempty"`
+	// determines when a block is considered final, confirmation_height only
+	// applies to the depth mode
+	FinalityMode FinalityMode `protobuf:"varint,15,opt,name=finality_mode,json=finalityMode,proto3,enum=evm.v1beta1.FinalityMode" json:"finality_mode,omitempty"`
+	// commands the gateway deployed on the chain is able to execute, commands
+	// outside of this set are never issued to it
+	GatewayCommands []string `protobuf:"bytes,16,rep,name=gateway_commands,json=gatewayCommands,proto3" json:"gateway_commands,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -121,44 +131,51 @@ func init() {
 func init() { proto.RegisterFile("evm/v1beta1/params.proto", fileDescriptor_f93e40c01ed2cb88) }
 
 var fileDescriptor_f93e40c01ed2cb88 = []byte{
-	// 578 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x41, 0x4f, 0xdb, 0x30,
-	0x14, 0x6e, 0xa0, 0x14, 0x70, 0x8b, 0x40, 0x86, 0x69, 0x56, 0x25, 0x42, 0xc6, 0x01, 0xe5, 0x30,
-	0x92, 0xc1, 0x4e, 0xdb, 0x11, 0xa6, 0x6d, 0x48, 0x08, 0xa1, 0x68, 0xda, 0x61, 0x97, 0xcc, 0x4d,
-	0x1e, 0x89, 0xd5, 0xc4, 0xae, 0x6c, 0xb7, 0x14, 0x69, 0x3f, 0x62, 0xbf, 0x67, 0xbf, 0xa0, 0x47,
-	0x8e, 0xd3, 0x0e, 0x68, 0x6b, 0xff, 0xc8, 0x14, 0xc7, 0x8d, 0x3a, 0x69, 0x27, 0xfb, 0x7d, 0xdf,
-	0x7b, 0x9f, 0x9f, 0xfd, 0x3e, 0x23, 0x02, 0x93, 0x32, 0x9c, 0x9c, 0x0d, 0x40, 0xd3, 0xb3, 0x70,
-	0x44, 0x25, 0x2d, 0x55, 0x30, 0x92, 0x42, 0x0b, 0xdc, 0x85, 0x49, 0x19, 0x58, 0xa6, 0x7f, 0x38,
-	0xd6, 0xac, 0x50, 0x4d, 0xa2, 0xce, 0x25, 0xa8, 0x5c, 0x14, 0x69, 0x9d, 0xdb, 0x7f, 0xbe, 0xaa,
-	0xa2, 0x1f, 0x46, 0x60, 0x45, 0xfa, 0x07, 0x99, 0xc8, 0x84, 0xd9, 0x86, 0xd5, 0xce, 0xa2, 0xc7,
-	0x1c, 0xa6, 0x63, 0x15, 0xc2, 0x74, 0x24, 0xa4, 0x86, 0xf4, 0x7f, 0x95, 0xc7, 0x3f, 0xda, 0xa8,
-	0x73, 0x6b, 0xfa, 0xc1, 0x07, 0x68, 0x23, 0xc9, 0x29, 0xe3, 0xc4, 0xf1, 0x1c, 0x7f, 0x3b, 0xaa,
-	0x03, 0x1c, 0xa2, 0xfd, 0x44, 0xf0, 0x3b, 0x26, 0x4b, 0xaa, 0x99, 0xe0, 0x71, 0x0e, 0x2c, 0xcb,
-	0x35, 0x59, 0xf3, 0x1c, 0xbf, 0x1d, 0xe1, 0x55, 0xea, 0xa3, 0x61, 0x30, 0x41, 0x9b, 0x1c, 0xf4,
-	0xbd, 0x90, 0x43, 0xb2, 0x6e, 0x84, 0x96, 0x21, 0x7e, 0x81, 0x7a, 0x19, 0xd5, 0x70, 0x4f, 0x1f,
-	0xe2, 0x44, 0xa4, 0x40, 0xda, 0x9e, 0xe3, 0xf7, 0xa2, 0xae, 0xc5, 0x2e, 0x45, 0x0a, 0xf8, 0x10,
-	0x21, 0x2d, 0x86, 0xc0, 0xeb, 0x84, 0x0d, 0x93, 0xb0, 0x6d, 0x10, 0x43, 0xf7, 0xd1, 0xd6, 0x60,
-	0x2c, 0x39, 0x1d, 0x14, 0x40, 0x3a, 0x86, 0x6c, 0x62, 0x7c, 0x8e, 0x9e, 0x49, 0x98, 0x08, 0x0d,
-	0x71, 0x21, 0x92, 0x21, 0xe3, 0x59, 0x3c, 0x02, 0xc9, 0x44, 0x4a, 0x36, 0x3d, 0xc7, 0x5f, 0x8f,
-	0xf6, 0x6b, 0xf2, 0xba, 0xe6, 0x6e, 0x0d, 0x85, 0xdf, 0xa2, 0x2d, 0xdb, 0x9c, 0x22, 0x5b, 0xde,
-	0xba, 0xdf, 0x3d, 0x27, 0xc1, 0xca, 0x3c, 0x82, 0x9b, 0x9a, 0xbc, 0xe2, 0x77, 0xe2, 0xa2, 0x3d,
-	0x7b, 0x3a, 0x6a, 0x45, 0x4d, 0x3e, 0xbe, 0x42, 0x7b, 0x13, 0xa1, 0xab, 0x73, 0x9a, 0x31, 0x91,
-	0x6d, 0xcf, 0x31, 0x1a, 0x66, 0x8c, 0x8d, 0xca, 0xa7, 0x25, 0x6f, 0x35, 0x76, 0xeb, 0xba, 0x06,
-	0xc6, 0x27, 0x68, 0xb7, 0x64, 0x3c, 0xae, 0xfa, 0x93, 0x71, 0x22, 0xc6, 0x5c, 0x13, 0x64, 0x9a,
-	0xde, 0x29, 0x19, 0xff, 0x5c, 0xa1, 0x97, 0x15, 0x88, 0x5f, 0x22, 0x9c, 0x88, 0xb2, 0xa4, 0x3c,
-	0x55, 0x71, 0x46, 0x55, 0x5c, 0xb0, 0x92, 0x69, 0xd2, 0xf5, 0x1c, 0x7f, 0x27, 0xda, 0x5b, 0x32,
-	0x1f, 0xa8, 0xba, 0xae, 0x70, 0xfc, 0x15, 0x1d, 0x68, 0x49, 0xb9, 0xa2, 0x89, 0x19, 0xdc, 0x1d,
-	0x40, 0x2c, 0xa9, 0x06, 0xd2, 0xab, 0xa6, 0x72, 0x11, 0x54, 0xad, 0xfc, 0x7a, 0x3a, 0x3a, 0xc9,
-	0x98, 0xce, 0xc7, 0x83, 0x20, 0x11, 0x65, 0x98, 0x08, 0x55, 0x0a, 0x65, 0x97, 0x53, 0x95, 0x0e,
-	0xad, 0x55, 0xde, 0x41, 0x12, 0xe1, 0x15, 0xad, 0xf7, 0x00, 0x11, 0xd5, 0x70, 0xfc, 0x0d, 0xf5,
-	0x6e, 0x81, 0xa7, 0x8c, 0x67, 0x97, 0xc6, 0x2b, 0x67, 0xa8, 0x53, 0x7b, 0xdb, 0x58, 0xa8, 0x7b,
-	0xbe, 0xff, 0xcf, 0x63, 0xd6, 0x36, 0xb3, 0x6f, 0x60, 0x13, 0xf1, 0x9b, 0xa5, 0xe9, 0xd6, 0x4c,
-	0xc5, 0x61, 0x60, 0x3c, 0x1b, 0x2c, 0x3d, 0xdb, 0x14, 0x9b, 0x03, 0x6c, 0x6d, 0x5d, 0x71, 0x71,
-	0x33, 0xfb, 0xe3, 0xb6, 0x66, 0x73, 0xd7, 0x79, 0x9c, 0xbb, 0xce, 0xef, 0xb9, 0xeb, 0x7c, 0x5f,
-	0xb8, 0xad, 0xc7, 0x85, 0xdb, 0xfa, 0xb9, 0x70, 0x5b, 0x5f, 0x5e, 0xad, 0xdc, 0x8b, 0x4e, 0xa1,
-	0xa0, 0xd2, 0x4e, 0xce, 0x46, 0xa7, 0x89, 0x90, 0x10, 0x4e, 0xc3, 0xea, 0x4b, 0x99, 0x5b, 0x0e,
-	0x3a, 0xe6, 0x47, 0xbc, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xdd, 0xd8, 0x39, 0x13, 0xac, 0x03,
-	0x00, 0x00,
+	// 693 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8e, 0x09, 0x04, 0x32, 0x49, 0x48, 0x34, 0x70, 0x75, 0xe7, 0x46, 0x97, 0xe0, 0xcb, 0x82,
+	0xeb, 0x4a, 0xc5, 0x2e, 0xe9, 0xaa, 0x5d, 0x54, 0x6a, 0x28, 0xb4, 0x48, 0x80, 0x90, 0x85, 0xba,
+	0xe8, 0x66, 0x3a, 0xb1, 0x4f, 0x9c, 0x51, 0xe2, 0x99, 0xc8, 0x9e, 0x84, 0x20, 0xf5, 0x21, 0xba,
+	0xe9, 0x3b, 0xb1, 0x64, 0x59, 0x75, 0x81, 0x5a, 0x78, 0x91, 0xca, 0xe3, 0x89, 0x15, 0xa4, 0xae,
+	0x3c, 0xe7, 0x7c, 0xdf, 0xf9, 0x99, 0xf9, 0xce, 0x31, 0x22, 0x30, 0x8b, 0xbd, 0xd9, 0x61, 0x1f,
+	0x14, 0x3b, 0xf4, 0x26, 0x2c, 0x61, 0x71, 0xea, 0x4e, 0x12, 0xa9, 0x24, 0xae, 0xc1, 0x2c, 0x76,
+	0x0d, 0xd2, 0xde, 0x99, 0x2a, 0x3e, 0x4e, 0x0b, 0xa2, 0x1a, 0x26, 0x90, 0x0e, 0xe5, 0x38, 0xcc,
+	0xb9, 0xed, 0xbf, 0x97, 0xb3, 0xa8, 0x9b, 0x09, 0x98, 0x24, 0xed, 0xed, 0x48, 0x46, 0x52, 0x1f,
+	0xbd, 0xec, 0x64, 0xbc, 0x7b, 0x02, 0xe6, 0xd3, 0xd4, 0x83, 0xf9, 0x44, 0x26, 0x0a, 0xc2, 0x3f,
+	0x45, 0xee, 0x7d, 0xab, 0xa0, 0xca, 0xa5, 0xee, 0x07, 0x6f, 0xa3, 0xb5, 0x60, 0xc8, 0xb8, 0x20,
+	0x96, 0x6d, 0x39, 0x55, 0x3f, 0x37, 0xb0, 0x87, 0xb6, 0x02, 0x29, 0x06, 0x3c, 0x89, 0x99, 0xe2,
+	0x52, 0xd0, 0x21, 0xf0, 0x68, 0xa8, 0xc8, 0x8a, 0x6d, 0x39, 0xab, 0x3e, 0x5e, 0x86, 0x3e, 0x68,
+	0x04, 0x13, 0xb4, 0x2e, 0x40, 0x5d, 0xcb, 0x64, 0x44, 0xca, 0x3a, 0xd1, 0xc2, 0xc4, 0xff, 0xa1,
+	0x7a, 0xc4, 0x14, 0x5c, 0xb3, 0x1b, 0x1a, 0xc8, 0x10, 0xc8, 0xaa, 0x6d, 0x39, 0x75, 0xbf, 0x66,
+	0x7c, 0x47, 0x32, 0x04, 0xbc, 0x83, 0x90, 0x92, 0x23, 0x10, 0x39, 0x61, 0x4d, 0x13, 0xaa, 0xda,
+	0xa3, 0xe1, 0x36, 0xda, 0xe8, 0x4f, 0x13, 0xc1, 0xfa, 0x63, 0x20, 0x15, 0x0d, 0x16, 0x36, 0xee,
+	0xa2, 0xbf, 0x12, 0x98, 0x49, 0x05, 0x74, 0x2c, 0x83, 0x11, 0x17, 0x11, 0x9d, 0x40, 0xc2, 0x65,
+	0x48, 0xd6, 0x6d, 0xcb, 0x29, 0xfb, 0x5b, 0x39, 0x78, 0x96, 0x63, 0x97, 0x1a, 0xc2, 0xaf, 0xd1,
+	0x86, 0x69, 0x2e, 0x25, 0x1b, 0x76, 0xd9, 0xa9, 0x75, 0x89, 0xbb, 0xa4, 0x87, 0x7b, 0x91, 0x83,
+	0xa7, 0x62, 0x20, 0x7b, 0xab, 0xb7, 0xf7, 0xbb, 0x25, 0xbf, 0xe0, 0xe3, 0x53, 0xd4, 0x9a, 0x49,
+	0x95, 0xd5, 0x29, 0x64, 0x22, 0x55, 0xdb, 0xd2, 0x39, 0xb4, 0x8c, 0x45, 0x96, 0xab, 0x05, 0x6e,
+	0x72, 0x34, 0xf3, 0xb8, 0xc2, 0x8d, 0xf7, 0x51, 0x33, 0xe6, 0x82, 0x66, 0xfd, 0x25, 0x34, 0x90,
+	0x53, 0xa1, 0x08, 0xd2, 0x4d, 0x37, 0x62, 0x2e, 0x3e, 0x66, 0xde, 0xa3, 0xcc, 0x89, 0x9f, 0x23,
+	0x1c, 0xc8, 0x38, 0x66, 0x22, 0x4c, 0x69, 0xc4, 0x52, 0x3a, 0xe6, 0x31, 0x57, 0xa4, 0x66, 0x5b,
+	0x4e, 0xc3, 0x6f, 0x2d, 0x90, 0xf7, 0x2c, 0x3d, 0xcb, 0xfc, 0xf8, 0x33, 0xda, 0x56, 0x09, 0x13,
+	0x29, 0x0b, 0xb4, 0x70, 0x03, 0x00, 0x9a, 0x30, 0x05, 0xa4, 0x9e, 0xa9, 0xd2, 0x73, 0xb3, 0x56,
+	0x7e, 0xdc, 0xef, 0xee, 0x47, 0x5c, 0x0d, 0xa7, 0x7d, 0x37, 0x90, 0xb1, 0x17, 0xc8, 0x34, 0x96,
+	0xa9, 0xf9, 0x1c, 0xa4, 0xe1, 0xc8, 0x8c, 0xca, 0x3b, 0x08, 0x7c, 0xbc, 0x94, 0xeb, 0x04, 0xc0,
+	0x67, 0x0a, 0xf0, 0x31, 0xda, 0x35, 0x55, 0x69, 0x9f, 0xa9, 0x60, 0x48, 0x61, 0x0e, 0xc1, 0x54,
+	0x57, 0x53, 0x3c, 0x06, 0x39, 0x55, 0xa4, 0xa1, 0xef, 0xf1, 0xaf, 0xa1, 0xf5, 0x32, 0xd6, 0xf1,
+	0x82, 0x74, 0x95, 0x73, 0xf0, 0xff, 0xa8, 0x95, 0xdd, 0x86, 0x8b, 0x81, 0xa4, 0x31, 0x9b, 0x53,
+	0x16, 0x01, 0xd9, 0xcc, 0xef, 0x1f, 0xb1, 0x34, 0x7b, 0xfc, 0x73, 0x36, 0x7f, 0x1b, 0x01, 0x7e,
+	0x83, 0x1a, 0x03, 0x2e, 0xd8, 0x98, 0xab, 0x1b, 0x1a, 0x67, 0x03, 0xd2, 0xb4, 0x2d, 0x67, 0xb3,
+	0xfb, 0xcf, 0x13, 0xcd, 0x4e, 0x0c, 0xe3, 0x5c, 0x86, 0xe0, 0xd7, 0x07, 0x4b, 0x16, 0x7e, 0x86,
+	0x5a, 0x66, 0xd8, 0xe8, 0xe2, 0xb5, 0x48, 0xcb, 0x2e, 0x3b, 0x55, 0xbf, 0x59, 0x0c, 0x61, 0xee,
+	0xde, 0xfb, 0x82, 0xea, 0x97, 0x20, 0x42, 0x2e, 0xa2, 0x23, 0xbd, 0x06, 0x87, 0xa8, 0x92, 0xaf,
+	0xad, 0xde, 0x8e, 0x5a, 0x77, 0xeb, 0x49, 0xcd, 0x7c, 0x83, 0x8c, 0xbc, 0x86, 0x88, 0x5f, 0x2d,
+	0xf6, 0x69, 0x45, 0x47, 0xec, 0xb8, 0x7a, 0x1d, 0xdd, 0xc5, 0x3a, 0x16, 0xc1, 0xba, 0x80, 0x89,
+	0xcd, 0x23, 0x7a, 0x17, 0xb7, 0xbf, 0x3a, 0xa5, 0xdb, 0x87, 0x8e, 0x75, 0xf7, 0xd0, 0xb1, 0x7e,
+	0x3e, 0x74, 0xac, 0xaf, 0x8f, 0x9d, 0xd2, 0xdd, 0x63, 0xa7, 0xf4, 0xfd, 0xb1, 0x53, 0xfa, 0xf4,
+	0x62, 0x49, 0x32, 0x36, 0x87, 0x31, 0x4b, 0xcc, 0x50, 0x1a, 0xeb, 0x20, 0x90, 0x09, 0x78, 0x73,
+	0x2f, 0xfb, 0x5b, 0x68, 0x01, 0xfb, 0x15, 0xbd, 0xec, 0x2f, 0x7f, 0x0f, 0x00, 0x92, 0x09, 0x74,
+	0xcd, 0x87, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -181,6 +198,32 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GatewayCommands) > 0 {
+		for iNdEx := len(m.GatewayCommands) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GatewayCommands[iNdEx])
+			copy(dAtA[i:], m.GatewayCommands[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.GatewayCommands[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.FinalityMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FinalityMode))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.GasInfoMaxAge != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GasInfoMaxAge))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.CommandBatchExecutionTimeout != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CommandBatchExecutionTimeout))
+		i--
+		dAtA[i] = 0x68
+	}
 	{
 		size := m.TransactionFeeRate.Size()
 		i -= size
@@ -375,6 +418,21 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.TransactionFeeRate.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.CommandBatchExecutionTimeout != 0 {
+		n += 1 + sovParams(uint64(m.CommandBatchExecutionTimeout))
+	}
+	if m.GasInfoMaxAge != 0 {
+		n += 1 + sovParams(uint64(m.GasInfoMaxAge))
+	}
+	if m.FinalityMode != 0 {
+		n += 1 + sovParams(uint64(m.FinalityMode))
+	}
+	if len(m.GatewayCommands) > 0 {
+		for _, s := range m.GatewayCommands {
+			l = len(s)
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -769,6 +827,95 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandBatchExecutionTimeout", wireType)
+			}
+			m.CommandBatchExecutionTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommandBatchExecutionTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInfoMaxAge", wireType)
+			}
+			m.GasInfoMaxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasInfoMaxAge |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityMode", wireType)
+			}
+			m.FinalityMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalityMode |= FinalityMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayCommands", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayCommands = append(m.GatewayCommands, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_axelarnetwork_axelar_core_x_tss_exported "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -74,6 +75,10 @@ type QueryBatchedCommandsResponse struct {
 	ExecuteData           string                                                    `protobuf:"bytes,6,opt,name=execute_data,json=executeData,proto3" json:"execute_data,omitempty"`
 	PrevBatchedCommandsID string                                                    `protobuf:"bytes,7,opt,name=prev_batched_commands_id,json=prevBatchedCommandsId,proto3" json:"prev_batched_commands_id,omitempty"`
 	CommandIDs            []string                                                  `protobuf:"bytes,8,rep,name=command_ids,json=commandIds,proto3" json:"command_ids,omitempty"`
+	Executions            []CommandExecution                                        `protobuf:"bytes,9,rep,name=executions,proto3" json:"executions"`
+	// true if the batch has been signed but not fully executed within the
+	// execution timeout
+	ExecutionOverdue bool `protobuf:"varint,10,opt,name=execution_overdue,json=executionOverdue,proto3" json:"execution_overdue,omitempty"`
 }
 
 func (m *QueryBatchedCommandsResponse) Reset()         { *m = QueryBatchedCommandsResponse{} }
@@ -109,6 +114,54 @@ func (m *QueryBatchedCommandsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBatchedCommandsResponse proto.InternalMessageInfo
 
+type QueryBatchExecutionCostResponse struct {
+	// ID of the batch the quote is for, empty if the quote is for the commands
+	// waiting to be batched
+	BatchID    string   `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	CommandIDs []string `protobuf:"bytes,2,rep,name=command_ids,json=commandIds,proto3" json:"command_ids,omitempty"`
+	GasLimit   uint64   `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	GasInfo    GasInfo  `protobuf:"bytes,4,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info"`
+	// true if the gas info is older than the configured max age
+	GasInfoStale bool `protobuf:"varint,5,opt,name=gas_info_stale,json=gasInfoStale,proto3" json:"gas_info_stale,omitempty"`
+	// expected cost in wei at the confirmed base fee and priority fee
+	ExpectedCost github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=expected_cost,json=expectedCost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"expected_cost"`
+	// maximum cost in wei when the base fee doubles before execution
+	MaxCost github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=max_cost,json=maxCost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_cost"`
+}
+
+func (m *QueryBatchExecutionCostResponse) Reset()         { *m = QueryBatchExecutionCostResponse{} }
+func (m *QueryBatchExecutionCostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBatchExecutionCostResponse) ProtoMessage()    {}
+func (*QueryBatchExecutionCostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78a1f61c7ae3396c, []int{2}
+}
+func (m *QueryBatchExecutionCostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBatchExecutionCostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBatchExecutionCostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBatchExecutionCostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBatchExecutionCostResponse.Merge(m, src)
+}
+func (m *QueryBatchExecutionCostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBatchExecutionCostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBatchExecutionCostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBatchExecutionCostResponse proto.InternalMessageInfo
+
 type QueryAddressResponse struct {
 	KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
 	// Types that are valid to be assigned to Address:
@@ -121,7 +174,7 @@ func (m *QueryAddressResponse) Reset()         { *m = QueryAddressResponse{} }
 func (m *QueryAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAddressResponse) ProtoMessage()    {}
 func (*QueryAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_78a1f61c7ae3396c, []int{2}
+	return fileDescriptor_78a1f61c7ae3396c, []int{3}
 }
 func (m *QueryAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,7 +259,7 @@ func (m *QueryAddressResponse_MultisigAddresses) Reset() {
 func (m *QueryAddressResponse_MultisigAddresses) String() string { return proto.CompactTextString(m) }
 func (*QueryAddressResponse_MultisigAddresses) ProtoMessage()    {}
 func (*QueryAddressResponse_MultisigAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_78a1f61c7ae3396c, []int{2, 0}
+	return fileDescriptor_78a1f61c7ae3396c, []int{3, 0}
 }
 func (m *QueryAddressResponse_MultisigAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,7 +296,7 @@ func (m *QueryAddressResponse_ThresholdAddress) Reset()         { *m = QueryAddr
 func (m *QueryAddressResponse_ThresholdAddress) String() string { return proto.CompactTextString(m) }
 func (*QueryAddressResponse_ThresholdAddress) ProtoMessage()    {}
 func (*QueryAddressResponse_ThresholdAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_78a1f61c7ae3396c, []int{2, 1}
+	return fileDescriptor_78a1f61c7ae3396c, []int{3, 1}
 }
 func (m *QueryAddressResponse_ThresholdAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -273,14 +326,15 @@ func (m *QueryAddressResponse_ThresholdAddress) XXX_DiscardUnknown() {
 var xxx_messageInfo_QueryAddressResponse_ThresholdAddress proto.InternalMessageInfo
 
 type QueryTokenAddressResponse struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	IsExternal bool   `protobuf:"varint,2,opt,name=is_external,json=isExternal,proto3" json:"is_external,omitempty"`
 }
 
 func (m *QueryTokenAddressResponse) Reset()         { *m = QueryTokenAddressResponse{} }
 func (m *QueryTokenAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTokenAddressResponse) ProtoMessage()    {}
 func (*QueryTokenAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_78a1f61c7ae3396c, []int{3}
+	return fileDescriptor_78a1f61c7ae3396c, []int{4}
 }
 func (m *QueryTokenAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +373,7 @@ func (m *QueryDepositStateParams) Reset()         { *m = QueryDepositStateParams
 func (m *QueryDepositStateParams) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositStateParams) ProtoMessage()    {}
 func (*QueryDepositStateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_78a1f61c7ae3396c, []int{4}
+	return fileDescriptor_78a1f61c7ae3396c, []int{5}
 }
 func (m *QueryDepositStateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +411,7 @@ func (m *QueryDepositStateResponse) Reset()         { *m = QueryDepositStateResp
 func (m *QueryDepositStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositStateResponse) ProtoMessage()    {}
 func (*QueryDepositStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_78a1f61c7ae3396c, []int{5}
+	return fileDescriptor_78a1f61c7ae3396c, []int{6}
 }
 func (m *QueryDepositStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,7 +448,7 @@ func (m *QueryBurnerAddressResponse) Reset()         { *m = QueryBurnerAddressRe
 func (m *QueryBurnerAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBurnerAddressResponse) ProtoMessage()    {}
 func (*QueryBurnerAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_78a1f61c7ae3396c, []int{6}
+	return fileDescriptor_78a1f61c7ae3396c, []int{7}
 }
 func (m *QueryBurnerAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -431,7 +485,7 @@ func (m *QueryChainsResponse) Reset()         { *m = QueryChainsResponse{} }
 func (m *QueryChainsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryChainsResponse) ProtoMessage()    {}
 func (*QueryChainsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_78a1f61c7ae3396c, []int{7}
+	return fileDescriptor_78a1f61c7ae3396c, []int{8}
 }
 func (m *QueryChainsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,7 +522,7 @@ func (m *QueryPendingCommandsResponse) Reset()         { *m = QueryPendingComman
 func (m *QueryPendingCommandsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPendingCommandsResponse) ProtoMessage()    {}
 func (*QueryPendingCommandsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_78a1f61c7ae3396c, []int{8}
+	return fileDescriptor_78a1f61c7ae3396c, []int{9}
 }
 func (m *QueryPendingCommandsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -509,7 +563,7 @@ func (m *QueryCommandResponse) Reset()         { *m = QueryCommandResponse{} }
 func (m *QueryCommandResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommandResponse) ProtoMessage()    {}
 func (*QueryCommandResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_78a1f61c7ae3396c, []int{9}
+	return fileDescriptor_78a1f61c7ae3396c, []int{10}
 }
 func (m *QueryCommandResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -541,6 +595,7 @@ var xxx_messageInfo_QueryCommandResponse proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*DepositQueryParams)(nil), "evm.v1beta1.DepositQueryParams")
 	proto.RegisterType((*QueryBatchedCommandsResponse)(nil), "evm.v1beta1.QueryBatchedCommandsResponse")
+	proto.RegisterType((*QueryBatchExecutionCostResponse)(nil), "evm.v1beta1.QueryBatchExecutionCostResponse")
 	proto.RegisterType((*QueryAddressResponse)(nil), "evm.v1beta1.QueryAddressResponse")
 	proto.RegisterType((*QueryAddressResponse_MultisigAddresses)(nil), "evm.v1beta1.QueryAddressResponse.MultisigAddresses")
 	proto.RegisterType((*QueryAddressResponse_ThresholdAddress)(nil), "evm.v1beta1.QueryAddressResponse.ThresholdAddress")
@@ -557,62 +612,76 @@ func init() {
 func init() { proto.RegisterFile("evm/v1beta1/query.proto", fileDescriptor_78a1f61c7ae3396c) }
 
 var fileDescriptor_78a1f61c7ae3396c = []byte{
-	// 877 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0x1b, 0x37,
-	0x10, 0xd6, 0xea, 0xcf, 0xd1, 0xc8, 0x71, 0x6d, 0xd6, 0x49, 0x36, 0x42, 0x20, 0x39, 0x7b, 0x4a,
-	0x81, 0x5a, 0x6a, 0x14, 0x34, 0x68, 0x72, 0x8b, 0xac, 0xb6, 0x16, 0x82, 0xb6, 0xee, 0xd6, 0xa7,
-	0x00, 0x85, 0x40, 0x89, 0x03, 0x69, 0x21, 0xed, 0x52, 0x5d, 0x72, 0xd5, 0xd5, 0x4b, 0x14, 0x3d,
-	0xf4, 0x2d, 0xfa, 0x22, 0x3a, 0xe6, 0x58, 0xf4, 0x20, 0xb4, 0xf2, 0x5b, 0xf4, 0x54, 0x90, 0xcb,
-	0x95, 0xe4, 0x75, 0xd2, 0xf8, 0xd2, 0x1b, 0x67, 0xc8, 0x6f, 0x66, 0x3e, 0xce, 0xf0, 0x23, 0x3c,
-	0xc0, 0xb9, 0xdf, 0x9a, 0x3f, 0x1d, 0xa0, 0xa4, 0x4f, 0x5b, 0x3f, 0x45, 0x18, 0x2e, 0x9a, 0xb3,
-	0x90, 0x4b, 0x4e, 0xaa, 0x38, 0xf7, 0x9b, 0x66, 0xa3, 0x76, 0x3c, 0xe2, 0x23, 0xae, 0xfd, 0x2d,
-	0xb5, 0x4a, 0x8e, 0xd4, 0xae, 0x61, 0xe5, 0x62, 0x86, 0x22, 0xd9, 0x70, 0xde, 0x00, 0xe9, 0xe2,
-	0x8c, 0x0b, 0x4f, 0x7e, 0xaf, 0x22, 0x5e, 0xd0, 0x90, 0xfa, 0x82, 0xd8, 0xb0, 0x47, 0x19, 0x0b,
-	0x51, 0x08, 0xdb, 0x3a, 0xb1, 0x9e, 0x54, 0xdc, 0xd4, 0x24, 0xc7, 0x50, 0xa2, 0x42, 0xa0, 0xb4,
-	0xf3, 0xda, 0x9f, 0x18, 0xca, 0x3b, 0x1c, 0x53, 0x2f, 0xb0, 0x0b, 0x89, 0x57, 0x1b, 0xce, 0xef,
-	0x05, 0x78, 0xa4, 0xa3, 0x76, 0xa8, 0x1c, 0x8e, 0x91, 0x9d, 0x71, 0xdf, 0xa7, 0x01, 0x13, 0x2e,
-	0x8a, 0x19, 0x0f, 0x04, 0x92, 0xfb, 0x90, 0xf7, 0x58, 0x92, 0xa1, 0x53, 0x5e, 0xaf, 0x1a, 0xf9,
-	0x5e, 0xd7, 0xcd, 0x7b, 0x8c, 0x10, 0x28, 0x32, 0x2a, 0xa9, 0xc9, 0xa1, 0xd7, 0xe4, 0x25, 0x94,
-	0x85, 0xa4, 0x32, 0x12, 0x3a, 0xc7, 0x41, 0xdb, 0x69, 0xee, 0xb0, 0x6e, 0x66, 0x32, 0xfc, 0xa0,
-	0x4f, 0xba, 0x06, 0x41, 0x7e, 0x84, 0xf2, 0x04, 0x17, 0x7d, 0x8f, 0xd9, 0x45, 0x9d, 0xeb, 0xab,
-	0xf5, 0xaa, 0x51, 0x7a, 0x8d, 0x8b, 0x5e, 0xf7, 0x9f, 0x55, 0xe3, 0xc5, 0xc8, 0x93, 0xe3, 0x68,
-	0xd0, 0x1c, 0x72, 0xbf, 0x45, 0x63, 0x9c, 0xd2, 0x30, 0x40, 0xf9, 0x33, 0x0f, 0x27, 0xc6, 0x3a,
-	0x1d, 0xf2, 0x10, 0x5b, 0x71, 0x4b, 0x0a, 0xd1, 0xc2, 0x78, 0xc6, 0x43, 0x89, 0xac, 0xa9, 0xc1,
-	0x6e, 0x69, 0x82, 0x8b, 0x1e, 0x23, 0x8f, 0xa0, 0x22, 0xbc, 0x51, 0x40, 0x65, 0x14, 0xa2, 0x5d,
-	0x3a, 0x29, 0x3c, 0xa9, 0xb8, 0x5b, 0x07, 0x79, 0x0c, 0xfb, 0x18, 0xe3, 0x30, 0x92, 0xd8, 0xd7,
-	0xa4, 0xca, 0x9a, 0x54, 0xd5, 0xf8, 0xba, 0x8a, 0x9b, 0x0b, 0xf6, 0x2c, 0xc4, 0x79, 0x7f, 0x90,
-	0xb0, 0xe8, 0x0f, 0x0d, 0x0d, 0x55, 0xf1, 0x9e, 0xae, 0xf8, 0xe1, 0x7a, 0xd5, 0xb8, 0x77, 0x11,
-	0xe2, 0x3c, 0x43, 0xb4, 0xd7, 0x75, 0xef, 0xcd, 0xde, 0xe1, 0x66, 0xa4, 0x05, 0x55, 0x13, 0xa6,
-	0xef, 0x31, 0x61, 0xdf, 0x51, 0x65, 0x75, 0x0e, 0xd6, 0xab, 0x06, 0x98, 0x43, 0xbd, 0xae, 0x70,
-	0xc1, 0x1c, 0xe9, 0x31, 0xe1, 0x2c, 0x0b, 0x70, 0xac, 0xbb, 0xf5, 0x2a, 0x69, 0xf5, 0xa6, 0x4b,
-	0xdb, 0xdb, 0xb3, 0xfe, 0x8f, 0xdb, 0x63, 0x40, 0xfc, 0x68, 0x2a, 0x3d, 0xe1, 0x8d, 0xfa, 0x66,
-	0xca, 0x50, 0xe8, 0xd6, 0x57, 0xdb, 0xcf, 0xae, 0x35, 0xf9, 0x5d, 0xd5, 0x35, 0xbf, 0x31, 0xd8,
-	0x57, 0x29, 0xf4, 0x3c, 0xe7, 0x1e, 0xf9, 0x59, 0x27, 0xa1, 0x70, 0x24, 0xc7, 0x21, 0x8a, 0x31,
-	0x9f, 0xb2, 0x34, 0x8d, 0x9e, 0xa4, 0x6a, 0xbb, 0xfd, 0xe1, 0x24, 0x97, 0x29, 0xd4, 0x6c, 0x9c,
-	0xe7, 0xdc, 0x43, 0x99, 0xf1, 0xd5, 0xbe, 0x83, 0xa3, 0x1b, 0xc5, 0xa8, 0xd9, 0xd8, 0x92, 0xb2,
-	0x92, 0xd9, 0xa0, 0xbb, 0xbb, 0x9b, 0x30, 0x9a, 0xf2, 0x5d, 0x77, 0xeb, 0xa8, 0x7d, 0x0a, 0x87,
-	0xd9, 0xc4, 0xef, 0x7f, 0x99, 0x9d, 0xca, 0x66, 0xc7, 0xf9, 0x1c, 0x1e, 0x6a, 0x1a, 0x97, 0x7c,
-	0x82, 0x41, 0xb6, 0x9d, 0xef, 0x8d, 0xe0, 0xfc, 0x66, 0xc1, 0x03, 0x8d, 0x33, 0x8a, 0xa0, 0x5e,
-	0x11, 0x1a, 0x45, 0xf8, 0x04, 0x4a, 0x32, 0x4e, 0x67, 0x60, 0xbf, 0x73, 0xbc, 0x5c, 0x35, 0x72,
-	0x7f, 0xae, 0x1a, 0xc5, 0x73, 0x2a, 0xc6, 0xeb, 0x55, 0xa3, 0x78, 0x19, 0xf7, 0xba, 0x6e, 0x51,
-	0xc6, 0x3d, 0x46, 0x9e, 0xc3, 0xc1, 0x20, 0x0a, 0x03, 0x0c, 0x37, 0xf7, 0x9c, 0xd7, 0x98, 0x8f,
-	0x0c, 0x66, 0x2f, 0xad, 0xe8, 0x6e, 0x72, 0x2c, 0xa5, 0x76, 0x1f, 0xca, 0xd4, 0xe7, 0x51, 0x20,
-	0x75, 0x5f, 0x8a, 0xae, 0xb1, 0x1c, 0x6a, 0xd8, 0xec, 0x56, 0xb5, 0x61, 0x73, 0x08, 0x85, 0x29,
-	0x1f, 0x19, 0x26, 0x6a, 0x49, 0xda, 0x1b, 0xa1, 0xc8, 0x6b, 0xa1, 0xa8, 0x5d, 0x6b, 0xef, 0x4e,
-	0x90, 0xad, 0x40, 0x38, 0xcf, 0xa1, 0x96, 0x08, 0xd5, 0x6e, 0x41, 0xb7, 0xb8, 0xb1, 0x53, 0xf8,
-	0x58, 0xe3, 0xce, 0x94, 0xde, 0xed, 0xea, 0x5a, 0x59, 0x2b, 0x60, 0xda, 0x71, 0x63, 0x39, 0x43,
-	0xa3, 0x87, 0x17, 0x18, 0x30, 0x2f, 0x18, 0xdd, 0xd0, 0xc3, 0x33, 0xb8, 0x93, 0x3e, 0x7d, 0x8d,
-	0xac, 0xb6, 0x1f, 0xdf, 0x9c, 0x4d, 0x83, 0x4a, 0x41, 0x9d, 0xa2, 0xba, 0x56, 0x77, 0x03, 0x74,
-	0x7e, 0xc9, 0x9b, 0x77, 0x9c, 0x39, 0xf8, 0x5f, 0x6a, 0xab, 0x7e, 0x84, 0x54, 0x6d, 0xd5, 0x9a,
-	0xbc, 0x86, 0xf2, 0x4c, 0x37, 0xde, 0x2e, 0xe8, 0x3a, 0x4e, 0x3f, 0x58, 0x47, 0x33, 0x19, 0x94,
-	0x2f, 0x03, 0x19, 0x2e, 0x4c, 0x4d, 0x26, 0x04, 0x39, 0xc9, 0xc8, 0x6f, 0x65, 0x23, 0x20, 0xa9,
-	0x06, 0x9c, 0xc0, 0xbe, 0x4f, 0xe3, 0xfe, 0x88, 0x8a, 0xfe, 0x90, 0x0b, 0x69, 0x97, 0xf4, 0x53,
-	0x00, 0x9f, 0xc6, 0x5f, 0x53, 0x71, 0xc6, 0x85, 0xac, 0xbd, 0x80, 0xea, 0x4e, 0x02, 0xd5, 0xf6,
-	0x09, 0x2e, 0xd2, 0xb6, 0x4f, 0x70, 0xa1, 0xbe, 0xa0, 0x39, 0x9d, 0x46, 0x29, 0x8d, 0xc4, 0x78,
-	0x99, 0xff, 0xc2, 0xea, 0x7c, 0xbb, 0xfc, 0xbb, 0x9e, 0x5b, 0xae, 0xeb, 0xd6, 0xdb, 0x75, 0xdd,
-	0xfa, 0x6b, 0x5d, 0xb7, 0x7e, 0xbd, 0xaa, 0xe7, 0xde, 0x5e, 0xd5, 0x73, 0x7f, 0x5c, 0xd5, 0x73,
-	0x6f, 0x3e, 0xbb, 0xa5, 0x80, 0xa9, 0x0f, 0x54, 0x7f, 0x9c, 0x83, 0xb2, 0xfe, 0x39, 0x9f, 0xfd,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x5d, 0x3c, 0x21, 0x90, 0x07, 0x00, 0x00,
+	// 1098 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0xd6, 0xf7, 0xc7, 0x48, 0xf6, 0x6b, 0xef, 0xab, 0x24, 0xb4, 0x9a, 0x8a, 0x0a, 0x51, 0x04,
+	0x2e, 0x5a, 0x4b, 0x8d, 0x82, 0x06, 0x4d, 0x6e, 0x91, 0xe5, 0xc6, 0x44, 0xda, 0xc6, 0xa5, 0x8d,
+	0x1e, 0x02, 0x14, 0xc4, 0x5a, 0xdc, 0x48, 0x84, 0x44, 0xae, 0xca, 0x5d, 0xa9, 0xd4, 0x9f, 0x28,
+	0x7a, 0xc8, 0xb5, 0xff, 0xc7, 0xc7, 0x1c, 0x8b, 0x1e, 0x84, 0x56, 0xfe, 0x17, 0x3d, 0x15, 0xbb,
+	0x5c, 0x52, 0xb2, 0xec, 0xd4, 0x45, 0x81, 0x9e, 0xb8, 0x33, 0xbb, 0xf3, 0xf1, 0xec, 0xcc, 0x3e,
+	0x43, 0xb8, 0x47, 0x66, 0x5e, 0x7b, 0xf6, 0xe8, 0x9c, 0x70, 0xfc, 0xa8, 0xfd, 0xc3, 0x94, 0x04,
+	0xf3, 0xd6, 0x24, 0xa0, 0x9c, 0xa2, 0x0a, 0x99, 0x79, 0x2d, 0xb5, 0x51, 0xaf, 0x0d, 0xe8, 0x80,
+	0x4a, 0x7d, 0x5b, 0xac, 0xa2, 0x23, 0xf5, 0x2b, 0xb6, 0x7c, 0x3e, 0x21, 0x2c, 0xda, 0x30, 0x5e,
+	0x03, 0xea, 0x91, 0x09, 0x65, 0x2e, 0xff, 0x56, 0x78, 0x3c, 0xc1, 0x01, 0xf6, 0x18, 0xd2, 0xa0,
+	0x88, 0x1d, 0x27, 0x20, 0x8c, 0x69, 0xe9, 0x66, 0x7a, 0xbf, 0x6c, 0xc5, 0x22, 0xaa, 0x41, 0x1e,
+	0x33, 0x46, 0xb8, 0x96, 0x91, 0xfa, 0x48, 0x10, 0xda, 0xfe, 0x10, 0xbb, 0xbe, 0x96, 0x8d, 0xb4,
+	0x52, 0x30, 0x7e, 0xc9, 0xc1, 0x7d, 0xe9, 0xb5, 0x8b, 0x79, 0x7f, 0x48, 0x9c, 0x43, 0xea, 0x79,
+	0xd8, 0x77, 0x98, 0x45, 0xd8, 0x84, 0xfa, 0x8c, 0xa0, 0xbb, 0x90, 0x71, 0x9d, 0x28, 0x42, 0xb7,
+	0xb0, 0x5c, 0xe8, 0x19, 0xb3, 0x67, 0x65, 0x5c, 0x07, 0x21, 0xc8, 0x39, 0x98, 0x63, 0x15, 0x43,
+	0xae, 0xd1, 0x33, 0x28, 0x30, 0x8e, 0xf9, 0x94, 0xc9, 0x18, 0xdb, 0x1d, 0xa3, 0xb5, 0x86, 0xba,
+	0xb5, 0x11, 0xe1, 0x54, 0x9e, 0xb4, 0x94, 0x05, 0xfa, 0x1e, 0x0a, 0x23, 0x32, 0xb7, 0x5d, 0x47,
+	0xcb, 0xc9, 0x58, 0x5f, 0x2e, 0x17, 0x7a, 0xfe, 0x25, 0x99, 0x9b, 0xbd, 0x3f, 0x17, 0xfa, 0xd3,
+	0x81, 0xcb, 0x87, 0xd3, 0xf3, 0x56, 0x9f, 0x7a, 0x6d, 0x1c, 0x92, 0x31, 0x0e, 0x7c, 0xc2, 0x7f,
+	0xa4, 0xc1, 0x48, 0x49, 0x07, 0x7d, 0x1a, 0x90, 0x76, 0xd8, 0xe6, 0x8c, 0xb5, 0x49, 0x38, 0xa1,
+	0x01, 0x27, 0x4e, 0x4b, 0x1a, 0x5b, 0xf9, 0x11, 0x99, 0x9b, 0x0e, 0xba, 0x0f, 0x65, 0xe6, 0x0e,
+	0x7c, 0xcc, 0xa7, 0x01, 0xd1, 0xf2, 0xcd, 0xec, 0x7e, 0xd9, 0x5a, 0x29, 0xd0, 0x03, 0xa8, 0x92,
+	0x90, 0xf4, 0xa7, 0x9c, 0xd8, 0x12, 0x54, 0x41, 0x82, 0xaa, 0x28, 0x5d, 0x4f, 0x60, 0xb3, 0x40,
+	0x9b, 0x04, 0x64, 0x66, 0x9f, 0x47, 0x28, 0xec, 0xbe, 0x82, 0x21, 0x32, 0x2e, 0xca, 0x8c, 0xf7,
+	0x96, 0x0b, 0xfd, 0xce, 0x49, 0x40, 0x66, 0x1b, 0x40, 0xcd, 0x9e, 0x75, 0x67, 0x72, 0x83, 0xda,
+	0x41, 0x6d, 0xa8, 0x28, 0x37, 0xb6, 0xeb, 0x30, 0xad, 0x24, 0xd2, 0xea, 0x6e, 0x2f, 0x17, 0x3a,
+	0xa8, 0x43, 0x66, 0x8f, 0x59, 0xa0, 0x8e, 0x98, 0x0e, 0x43, 0x87, 0x00, 0x51, 0x4e, 0x2e, 0xf5,
+	0x99, 0x56, 0x6e, 0x66, 0xf7, 0x2b, 0x9d, 0x0f, 0xaf, 0x5c, 0xb2, 0x32, 0x3c, 0x8a, 0x4f, 0x75,
+	0x73, 0x17, 0x0b, 0x3d, 0x65, 0xad, 0x99, 0xa1, 0x4f, 0x60, 0x37, 0x91, 0x6c, 0x3a, 0x23, 0x81,
+	0x33, 0x25, 0x1a, 0x34, 0xd3, 0xfb, 0x25, 0x6b, 0x27, 0xd9, 0x78, 0x15, 0xe9, 0x8d, 0xb7, 0x59,
+	0xd0, 0x57, 0xfd, 0x91, 0xb8, 0x3d, 0xa4, 0x8c, 0x27, 0x2d, 0xf2, 0x10, 0x4a, 0xf2, 0x56, 0xec,
+	0xa4, 0x51, 0x2a, 0xcb, 0x85, 0x5e, 0x94, 0x16, 0x66, 0xcf, 0x2a, 0xca, 0xcd, 0xeb, 0x70, 0x33,
+	0xb7, 0xc2, 0xfd, 0x00, 0xca, 0x03, 0xcc, 0xec, 0xb1, 0xeb, 0xb9, 0x5c, 0xb6, 0x54, 0xce, 0x2a,
+	0x0d, 0x30, 0xfb, 0x4a, 0xc8, 0xe8, 0x73, 0x10, 0x6b, 0xdb, 0xf5, 0xdf, 0x50, 0xd9, 0x32, 0x95,
+	0x4e, 0xed, 0xca, 0x4d, 0xbc, 0xc0, 0xcc, 0xf4, 0xdf, 0x50, 0x75, 0x01, 0xc5, 0x41, 0x24, 0xa2,
+	0x8f, 0x60, 0x3b, 0x36, 0xb3, 0x19, 0xc7, 0x63, 0xd1, 0x0d, 0x02, 0x7a, 0x55, 0x1d, 0x38, 0x15,
+	0x3a, 0x74, 0x0a, 0x5b, 0x24, 0x9c, 0x90, 0x3e, 0x97, 0x95, 0x66, 0x5c, 0x76, 0x44, 0xb5, 0xdb,
+	0x12, 0xbe, 0x7e, 0x5b, 0xe8, 0x0f, 0xd7, 0xfa, 0xb1, 0x4f, 0x99, 0x47, 0x99, 0xfa, 0x1c, 0x30,
+	0x67, 0xa4, 0xde, 0xae, 0xe9, 0x73, 0xab, 0x1a, 0x3b, 0x11, 0xf7, 0x85, 0x4c, 0x28, 0x79, 0x38,
+	0x8c, 0xfc, 0x15, 0xff, 0x95, 0xbf, 0xa2, 0x87, 0x43, 0xe1, 0xca, 0xb8, 0xc8, 0x42, 0x4d, 0x96,
+	0xe5, 0x79, 0xf4, 0xe6, 0x93, 0x5a, 0xac, 0x9e, 0x51, 0xfa, 0xbf, 0x78, 0x46, 0x0e, 0x20, 0x6f,
+	0x3a, 0xe6, 0x2e, 0x73, 0x07, 0xb6, 0xa2, 0x1b, 0xc2, 0x24, 0x07, 0x54, 0x3a, 0x8f, 0xaf, 0x5c,
+	0xff, 0x4d, 0xd9, 0xb5, 0xbe, 0x56, 0xb6, 0xcf, 0x63, 0xd3, 0xe3, 0x94, 0xb5, 0xeb, 0x6d, 0x2a,
+	0x11, 0x86, 0x5d, 0x3e, 0x0c, 0x08, 0x1b, 0xd2, 0xb1, 0x13, 0x87, 0x91, 0xf5, 0xaf, 0x74, 0x3a,
+	0xb7, 0x07, 0x39, 0x8b, 0x4d, 0xd5, 0xc6, 0x71, 0xca, 0xda, 0xe1, 0x1b, 0xba, 0xfa, 0x2b, 0xd8,
+	0xbd, 0x96, 0x8c, 0x20, 0x89, 0x15, 0xa8, 0x74, 0x44, 0x12, 0x78, 0x7d, 0x37, 0x71, 0x23, 0x21,
+	0x6f, 0x59, 0x2b, 0x45, 0xfd, 0x53, 0xd8, 0xd9, 0x0c, 0xfc, 0x7e, 0x8a, 0xee, 0x96, 0x93, 0x1d,
+	0xe3, 0x3b, 0xd8, 0x93, 0x30, 0xce, 0xe8, 0x88, 0xf8, 0x9b, 0xe5, 0x7c, 0x3f, 0xc9, 0xeb, 0x50,
+	0x71, 0x99, 0x4d, 0x42, 0x4e, 0x02, 0x1f, 0x8f, 0x65, 0x3e, 0x25, 0x0b, 0x5c, 0x76, 0xa4, 0x34,
+	0xc6, 0xdb, 0x34, 0xdc, 0x93, 0x8e, 0xd5, 0xec, 0x10, 0x7c, 0x4b, 0xd4, 0xec, 0xf8, 0x18, 0xf2,
+	0x3c, 0x8c, 0x9b, 0xa4, 0xda, 0xad, 0xa9, 0x36, 0xcc, 0x1d, 0x63, 0x36, 0x5c, 0x2e, 0xf4, 0xdc,
+	0x59, 0x68, 0xf6, 0xac, 0x1c, 0x0f, 0x4d, 0x07, 0x3d, 0x81, 0xed, 0xf3, 0x69, 0xe0, 0x93, 0x20,
+	0x29, 0x44, 0x46, 0xda, 0xfc, 0x4f, 0xd9, 0x14, 0xe3, 0x94, 0xb7, 0xa2, 0x63, 0x31, 0xf6, 0xbb,
+	0x50, 0xc0, 0x1e, 0x9d, 0xfa, 0xf1, 0xc3, 0x55, 0x92, 0x81, 0x61, 0xef, 0x5a, 0x56, 0x09, 0xdc,
+	0x1d, 0xc8, 0x8e, 0xe9, 0x40, 0x41, 0x15, 0x4b, 0xd4, 0x49, 0x46, 0x4a, 0x46, 0x8e, 0x94, 0xfa,
+	0x95, 0xfa, 0xaf, 0x39, 0x59, 0x8d, 0x12, 0xe3, 0x09, 0xd4, 0x23, 0xca, 0x5a, 0x4f, 0xe8, 0xf6,
+	0x2b, 0x35, 0x0e, 0xe0, 0xff, 0xd2, 0xee, 0x50, 0x4c, 0xc6, 0xf5, 0x09, 0x58, 0x90, 0xb3, 0x32,
+	0x6e, 0x09, 0x25, 0x19, 0x7d, 0x35, 0x39, 0x4f, 0x88, 0xef, 0xb8, 0xfe, 0xe0, 0xda, 0xe4, 0x3c,
+	0x84, 0x52, 0x3c, 0x24, 0xa4, 0x65, 0xa5, 0xf3, 0xe0, 0x7a, 0xf3, 0x2a, 0xab, 0xd8, 0x48, 0xb1,
+	0x55, 0x62, 0x68, 0xfc, 0x94, 0x81, 0xda, 0x4d, 0x07, 0xff, 0x6e, 0x2e, 0x0b, 0xbe, 0x88, 0xe7,
+	0xb2, 0x58, 0xa3, 0x97, 0x50, 0x98, 0xc8, 0xc2, 0x6b, 0x59, 0x99, 0xc7, 0xc1, 0xad, 0x79, 0xb4,
+	0xa2, 0x46, 0x39, 0xf2, 0x79, 0x30, 0x57, 0x39, 0x29, 0x17, 0xa8, 0xb9, 0x31, 0xa8, 0xcb, 0x09,
+	0xc3, 0xc4, 0x24, 0xd1, 0x84, 0xaa, 0xe0, 0x39, 0x41, 0xb3, 0x92, 0xeb, 0xf2, 0xf2, 0xad, 0x80,
+	0x87, 0xc3, 0x17, 0x98, 0x09, 0xfa, 0xaa, 0x3f, 0x85, 0xca, 0x5a, 0x00, 0x51, 0xf6, 0x11, 0x99,
+	0xc7, 0x65, 0x1f, 0x91, 0xb9, 0xf8, 0x59, 0x99, 0xe1, 0xf1, 0x34, 0x86, 0x11, 0x09, 0xcf, 0x32,
+	0x5f, 0xa4, 0xbb, 0xdf, 0x5c, 0xfc, 0xd1, 0x48, 0x5d, 0x2c, 0x1b, 0xe9, 0x77, 0xcb, 0x46, 0xfa,
+	0xf7, 0x65, 0x23, 0xfd, 0xf3, 0x65, 0x23, 0xf5, 0xee, 0xb2, 0x91, 0xfa, 0xf5, 0xb2, 0x91, 0x7a,
+	0xfd, 0xd9, 0x3f, 0x64, 0x38, 0xf1, 0xab, 0x25, 0x69, 0xf5, 0xbc, 0x20, 0xff, 0xb1, 0x1e, 0xff,
+	0x35, 0x00, 0xfe, 0xce, 0xb3, 0x36, 0xba, 0x09, 0x00, 0x00,
 }
 
 func (m *DepositQueryParams) Marshal() (dAtA []byte, err error) {
@@ -679,6 +748,30 @@ func (m *QueryBatchedCommandsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.ExecutionOverdue {
+		i--
+		if m.ExecutionOverdue {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Executions) > 0 {
+		for iNdEx := len(m.Executions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Executions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.CommandIDs) > 0 {
 		for iNdEx := len(m.CommandIDs) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.CommandIDs[iNdEx])
@@ -740,6 +833,90 @@ func (m *QueryBatchedCommandsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBatchExecutionCostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBatchExecutionCostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBatchExecutionCostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxCost.Size()
+		i -= size
+		if _, err := m.MaxCost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ExpectedCost.Size()
+		i -= size
+		if _, err := m.ExpectedCost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.GasInfoStale {
+		i--
+		if m.GasInfoStale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.GasInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CommandIDs) > 0 {
+		for iNdEx := len(m.CommandIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CommandIDs[iNdEx])
+			copy(dAtA[i:], m.CommandIDs[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.CommandIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BatchID) > 0 {
+		i -= len(m.BatchID)
+		copy(dAtA[i:], m.BatchID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BatchID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryAddressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -908,6 +1085,16 @@ func (m *QueryTokenAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.IsExternal {
+		i--
+		if m.IsExternal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -1241,6 +1428,46 @@ func (m *QueryBatchedCommandsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.Executions) > 0 {
+		for _, e := range m.Executions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ExecutionOverdue {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryBatchExecutionCostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BatchID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CommandIDs) > 0 {
+		for _, s := range m.CommandIDs {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	l = m.GasInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.GasInfoStale {
+		n += 2
+	}
+	l = m.ExpectedCost.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxCost.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -1325,6 +1552,9 @@ func (m *QueryTokenAddressResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.IsExternal {
+		n += 2
+	}
 	return n
 }
 
@@ -1859,6 +2089,312 @@ func (m *QueryBatchedCommandsResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.CommandIDs = append(m.CommandIDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executions = append(m.Executions, CommandExecution{})
+			if err := m.Executions[len(m.Executions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionOverdue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExecutionOverdue = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBatchExecutionCostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBatchExecutionCostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBatchExecutionCostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommandIDs = append(m.CommandIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInfoStale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GasInfoStale = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedCost", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpectedCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCost", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2276,6 +2812,26 @@ func (m *QueryTokenAddressResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsExternal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsExternal = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
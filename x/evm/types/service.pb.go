@@ -33,61 +33,80 @@ func init() { proto.RegisterFile("evm/v1beta1/service.proto", fileDescriptor_e67
 func init() { golang_proto.RegisterFile("evm/v1beta1/service.proto", fileDescriptor_e674b0c159b5e0b5) }
 
 var fileDescriptor_e674b0c159b5e0b5 = []byte{
-	// 855 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xbf, 0x6f, 0xdb, 0x46,
-	0x14, 0xc7, 0x7d, 0x45, 0x51, 0x14, 0xd7, 0xa2, 0x70, 0x09, 0xd7, 0xb5, 0x05, 0x97, 0xb5, 0x69,
-	0x49, 0xae, 0x25, 0x53, 0x94, 0x6c, 0xa0, 0x43, 0xb7, 0x5a, 0x06, 0x3a, 0xf4, 0x27, 0xea, 0xa2,
-	0x43, 0x97, 0x82, 0xa2, 0x9e, 0xe9, 0xab, 0xa4, 0x3b, 0x96, 0x3c, 0xc9, 0x16, 0x82, 0x00, 0x49,
-	0x96, 0x00, 0x19, 0x82, 0x20, 0x59, 0x32, 0x27, 0x40, 0x86, 0x8c, 0x59, 0xb3, 0x64, 0xcc, 0x68,
-	0x20, 0x4b, 0xb2, 0x05, 0x56, 0xfe, 0x90, 0x80, 0xc7, 0x3b, 0x99, 0xa4, 0x48, 0x4a, 0xd9, 0xec,
-	0x7b, 0xdf, 0xf7, 0xbe, 0x1f, 0xbd, 0xf7, 0x8e, 0x24, 0x5e, 0x87, 0xd1, 0xc0, 0x1a, 0xb5, 0x3a,
-	0xc0, 0xed, 0x96, 0x15, 0x80, 0x3f, 0x22, 0x0e, 0x34, 0x3c, 0x9f, 0x71, 0xa6, 0x7d, 0x06, 0xa3,
-	0x41, 0x43, 0x86, 0x4a, 0x2b, 0x2e, 0x73, 0x99, 0x38, 0xb7, 0xc2, 0xbf, 0x22, 0x49, 0x69, 0xc3,
-	0x65, 0xcc, 0xed, 0x83, 0x65, 0x7b, 0xc4, 0xb2, 0x29, 0x65, 0xdc, 0xe6, 0x84, 0xd1, 0x40, 0x46,
-	0x57, 0xe2, 0xb5, 0xf9, 0x79, 0x74, 0xba, 0xff, 0x66, 0x05, 0xe3, 0x5f, 0x03, 0xf7, 0x38, 0xf2,
-	0xd2, 0xfe, 0xc3, 0x1f, 0xff, 0x42, 0x68, 0x4f, 0x5b, 0x6b, 0xc4, 0xec, 0x1a, 0xe1, 0xd1, 0x9f,
-	0xf0, 0xff, 0x10, 0x02, 0x5e, 0x5a, 0xcf, 0x88, 0x04, 0x1e, 0xa3, 0x01, 0x18, 0xe6, 0xad, 0x57,
-	0xef, 0x1e, 0x7c, 0xb4, 0x63, 0x18, 0x96, 0x7d, 0x0e, 0x7d, 0xdb, 0xb7, 0x42, 0xc7, 0x3e, 0xa1,
-	0x3d, 0xeb, 0x9a, 0x0f, 0x0e, 0xf1, 0x08, 0x50, 0xfe, 0xaf, 0x73, 0x6a, 0x13, 0x7a, 0xfd, 0x07,
-	0x54, 0xd3, 0xc6, 0xf8, 0xf3, 0x36, 0xa3, 0x27, 0xc4, 0x1f, 0xb4, 0xc3, 0x33, 0x6d, 0x33, 0x51,
-	0x39, 0x1e, 0x52, 0xde, 0x5b, 0x05, 0x0a, 0xc9, 0x50, 0x16, 0x0c, 0xba, 0xb1, 0x1e, 0x67, 0x70,
-	0x22, 0xa5, 0x29, 0xbc, 0x43, 0xeb, 0xa7, 0x08, 0xaf, 0xc9, 0xf4, 0x9f, 0x6c, 0x0e, 0x67, 0xf6,
-	0xf8, 0x08, 0xbc, 0x3e, 0x1b, 0x0f, 0x80, 0x72, 0x6d, 0x2f, 0xcb, 0x65, 0x46, 0xa6, 0x98, 0xcc,
-	0x05, 0xd5, 0x92, 0xaf, 0x25, 0xf8, 0xea, 0x46, 0x35, 0x8b, 0xcf, 0x8d, 0xd2, 0xcc, 0xee, 0x34,
-	0x2f, 0x84, 0xbd, 0x81, 0xa6, 0x8d, 0xfa, 0x8b, 0xf5, 0x20, 0xa7, 0x51, 0x22, 0x54, 0xd8, 0x28,
-	0xa9, 0x90, 0x20, 0x75, 0x01, 0x52, 0x31, 0x36, 0xb3, 0x40, 0xc0, 0x77, 0xf6, 0x9b, 0x12, 0x23,
-	0x44, 0xb8, 0x8d, 0xf0, 0x17, 0xb2, 0xca, 0x11, 0x78, 0x2c, 0x20, 0x5c, 0x33, 0xb2, 0x2c, 0x64,
-	0x50, 0x61, 0x6c, 0x17, 0x6a, 0x24, 0xc8, 0x9e, 0x00, 0xa9, 0x1a, 0x5b, 0x85, 0x20, 0x61, 0x4a,
-	0x48, 0xf2, 0x10, 0x61, 0x4d, 0xfd, 0x1e, 0xdf, 0xa6, 0xc1, 0x09, 0xf8, 0x3f, 0xc3, 0x58, 0xab,
-	0x66, 0xfe, 0xe0, 0x2b, 0x81, 0x22, 0xda, 0x99, 0xab, 0x5b, 0x64, 0x4e, 0x5c, 0x26, 0x98, 0xec,
-	0x8c, 0x82, 0x1f, 0x9c, 0x12, 0x2f, 0x44, 0xbb, 0x83, 0xf0, 0xf2, 0xdf, 0x8c, 0x43, 0x62, 0xa9,
-	0xcb, 0x09, 0xc3, 0x74, 0x58, 0x61, 0x55, 0xe6, 0xa8, 0x24, 0xd4, 0xae, 0x80, 0xda, 0x36, 0xf4,
-	0x38, 0xd4, 0x88, 0x71, 0x30, 0x67, 0x36, 0xfc, 0x39, 0xc2, 0x1b, 0xb1, 0x3a, 0xb3, 0x5b, 0xde,
-	0xcc, 0xb3, 0xcc, 0xdd, 0xf4, 0xd6, 0x07, 0x64, 0x48, 0xe0, 0xef, 0x05, 0x70, 0xd3, 0xa8, 0xe7,
-	0x02, 0x67, 0xaf, 0xfc, 0x7d, 0x84, 0xb5, 0x98, 0x81, 0xda, 0xb9, 0x6a, 0x1e, 0x41, 0x6a, 0xef,
-	0x76, 0xe6, 0xea, 0x8a, 0x2e, 0x41, 0x82, 0x2f, 0xb6, 0x7a, 0xa9, 0xf9, 0x46, 0x77, 0x31, 0x77,
-	0xbe, 0x89, 0xfb, 0x58, 0x99, 0xa3, 0x5a, 0x78, 0xbe, 0x3c, 0xd4, 0x87, 0x30, 0x8f, 0x11, 0x5e,
-	0x8d, 0xd7, 0x89, 0xdd, 0x85, 0x5a, 0xae, 0xd9, 0xec, 0x7d, 0xa8, 0x2f, 0xa4, 0x95, 0x78, 0x4d,
-	0x81, 0x57, 0x33, 0x2a, 0xf9, 0x78, 0xea, 0x62, 0xf4, 0x40, 0x3c, 0x37, 0xee, 0x22, 0xfc, 0x65,
-	0xdb, 0x07, 0x9b, 0x43, 0xb4, 0x1c, 0x51, 0xcf, 0x92, 0xdd, 0x98, 0x89, 0x2b, 0xb6, 0xea, 0x3c,
-	0x99, 0xc4, 0xaa, 0x09, 0xac, 0xb2, 0xf1, 0x6d, 0xe2, 0xaa, 0x0a, 0xb9, 0x5c, 0xab, 0xab, 0xb6,
-	0xdd, 0x44, 0x78, 0x39, 0xaa, 0x74, 0x38, 0xf4, 0xa9, 0xa8, 0x13, 0xa4, 0x66, 0x98, 0x0e, 0x67,
-	0xcf, 0x70, 0x56, 0x25, 0x69, 0x36, 0x05, 0x4d, 0xc9, 0xf8, 0x2a, 0x4e, 0x13, 0x10, 0x97, 0x9a,
-	0x9d, 0xa1, 0x2f, 0x18, 0x1e, 0x21, 0xbc, 0x1a, 0xa5, 0xff, 0x01, 0xb4, 0x4b, 0xa8, 0xab, 0x7a,
-	0x1d, 0xa4, 0x46, 0x97, 0x2d, 0xca, 0x1e, 0x5d, 0x9e, 0x56, 0x52, 0x59, 0x82, 0x6a, 0xd7, 0x28,
-	0x67, 0xf4, 0xc8, 0x8b, 0x92, 0xa6, 0xc3, 0x0b, 0x42, 0xc8, 0x27, 0x08, 0x7f, 0x1d, 0xd5, 0x54,
-	0xc5, 0x7e, 0x57, 0xcf, 0x3a, 0x2d, 0xcb, 0x79, 0x46, 0xa5, 0x30, 0xf7, 0x16, 0x13, 0x17, 0xad,
-	0x98, 0xe4, 0xcc, 0x7e, 0xea, 0x3e, 0x43, 0xb8, 0x94, 0xaa, 0xea, 0x81, 0x6f, 0x73, 0x16, 0xb1,
-	0x36, 0x8a, 0xec, 0x63, 0x42, 0x85, 0x6b, 0x2d, 0xac, 0x97, 0xc4, 0x07, 0x82, 0xd8, 0x34, 0xbe,
-	0x2b, 0x24, 0x8e, 0x65, 0xca, 0x4f, 0x9f, 0x63, 0xe2, 0xd2, 0x36, 0x1b, 0x0c, 0x6c, 0xda, 0x0d,
-	0x52, 0x6f, 0xf4, 0x78, 0x28, 0xfb, 0x8d, 0x9e, 0x54, 0x14, 0x7d, 0xfa, 0x88, 0xcd, 0x73, 0xa4,
-	0x34, 0xb4, 0x26, 0xf8, 0xd3, 0x1f, 0xbb, 0xdd, 0xe8, 0xe5, 0xb4, 0x91, 0x28, 0xaa, 0x8e, 0x95,
-	0xe5, 0x37, 0x39, 0xd1, 0xa2, 0x45, 0xb7, 0xbb, 0xdd, 0xe9, 0x3b, 0xe8, 0xf0, 0xb7, 0x97, 0x97,
-	0x3a, 0xba, 0xb8, 0xd4, 0xd1, 0xdb, 0x4b, 0x1d, 0xdd, 0x9b, 0xe8, 0x4b, 0x2f, 0x26, 0x3a, 0xba,
-	0x98, 0xe8, 0x4b, 0xaf, 0x27, 0xfa, 0xd2, 0x3f, 0x4d, 0x97, 0xf0, 0xd3, 0x61, 0xa7, 0xe1, 0xb0,
-	0x81, 0xac, 0x40, 0x81, 0x9f, 0x31, 0xbf, 0x27, 0xff, 0x33, 0x1d, 0xe6, 0x83, 0x75, 0x2e, 0xca,
-	0xf2, 0xb1, 0x07, 0x41, 0xe7, 0x13, 0xf1, 0xc9, 0x7a, 0xf0, 0x3e, 0x00, 0x00, 0xff, 0xff, 0xb0,
-	0xef, 0x69, 0xc0, 0x26, 0x0b, 0x00, 0x00,
+	// 1155 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0x3b, 0x08, 0x21, 0x34, 0x20, 0x58, 0x4c, 0x77, 0xd9, 0x86, 0x12, 0x5a, 0x37, 0x4d,
+	0xda, 0xb4, 0x8e, 0xd3, 0xae, 0xe0, 0xc0, 0x8d, 0x76, 0x57, 0x2b, 0xc4, 0x4f, 0x75, 0x81, 0x03,
+	0x17, 0xe4, 0x38, 0x2f, 0xae, 0x49, 0x32, 0x63, 0xc6, 0x93, 0x34, 0x11, 0x42, 0x82, 0xbd, 0x20,
+	0x81, 0x84, 0x10, 0x7b, 0x41, 0x08, 0xed, 0x01, 0x24, 0x0e, 0x5c, 0x90, 0xb8, 0x70, 0xe0, 0xc2,
+	0x91, 0xe3, 0x4a, 0x5c, 0x38, 0xa2, 0x96, 0x3f, 0x04, 0x79, 0x3c, 0xd3, 0xf8, 0xc7, 0xd8, 0xf1,
+	0xde, 0x5a, 0xbf, 0xef, 0x7b, 0xef, 0x33, 0xcf, 0x6f, 0x9e, 0x5f, 0x8b, 0xd7, 0x60, 0x3a, 0xb6,
+	0xa7, 0x07, 0x3d, 0xe0, 0xce, 0x81, 0x1d, 0x02, 0x9b, 0xfa, 0x2e, 0x74, 0x02, 0x46, 0x39, 0x35,
+	0x9e, 0x80, 0xe9, 0xb8, 0x23, 0x4d, 0xb5, 0x55, 0x8f, 0x7a, 0x54, 0x3c, 0xb7, 0xa3, 0x9f, 0x62,
+	0x49, 0x6d, 0xdd, 0xa3, 0xd4, 0x1b, 0x81, 0xed, 0x04, 0xbe, 0xed, 0x10, 0x42, 0xb9, 0xc3, 0x7d,
+	0x4a, 0x42, 0x69, 0x5d, 0x4d, 0xc6, 0xe6, 0xb3, 0xf8, 0xe9, 0xe1, 0xfd, 0x4d, 0x8c, 0xdf, 0x0c,
+	0xbd, 0x3b, 0x71, 0x2e, 0xe3, 0x23, 0xfc, 0xe8, 0x1b, 0x3e, 0x19, 0x1a, 0xd7, 0x3b, 0x89, 0x74,
+	0x9d, 0xe8, 0xd1, 0x09, 0x7c, 0x3c, 0x81, 0x90, 0xd7, 0xd6, 0x34, 0x96, 0x30, 0xa0, 0x24, 0x04,
+	0xd3, 0xba, 0xfb, 0xf7, 0x7f, 0xf7, 0x1e, 0x69, 0x99, 0xa6, 0xed, 0xcc, 0x60, 0xe4, 0x30, 0x3b,
+	0xca, 0x38, 0xf2, 0xc9, 0xd0, 0xfe, 0x84, 0x81, 0xeb, 0x07, 0x3e, 0x10, 0xfe, 0xa1, 0x7b, 0xea,
+	0xf8, 0xe4, 0xd3, 0x57, 0x50, 0xdb, 0x98, 0xe3, 0x27, 0x8f, 0x29, 0x19, 0xf8, 0x6c, 0x7c, 0x1c,
+	0x3d, 0x33, 0x36, 0x52, 0x91, 0x93, 0x26, 0x95, 0x7b, 0xb3, 0x44, 0x21, 0x19, 0x1a, 0x82, 0xa1,
+	0x6e, 0xae, 0x25, 0x19, 0xdc, 0x58, 0x69, 0x89, 0xdc, 0x51, 0xea, 0x5f, 0x10, 0xbe, 0x2e, 0xdd,
+	0x6f, 0x3b, 0x1c, 0xce, 0x9c, 0xf9, 0x4d, 0x08, 0x46, 0x74, 0x3e, 0x06, 0xc2, 0x8d, 0x7d, 0x5d,
+	0x96, 0x9c, 0x4c, 0x31, 0x59, 0x15, 0xd5, 0x92, 0xef, 0x40, 0xf0, 0xed, 0x99, 0x4d, 0x1d, 0x9f,
+	0x17, 0xbb, 0x59, 0xfd, 0x4b, 0xbf, 0x08, 0xf6, 0x33, 0x74, 0x59, 0xa8, 0x77, 0xe9, 0x10, 0x0a,
+	0x0a, 0x25, 0x4c, 0xa5, 0x85, 0x92, 0x0a, 0x09, 0xb2, 0x27, 0x40, 0xb6, 0xcd, 0x0d, 0x1d, 0x08,
+	0x30, 0xf7, 0xb0, 0x2b, 0x31, 0x22, 0x84, 0x2f, 0x10, 0x7e, 0x4a, 0x46, 0xb9, 0x09, 0x01, 0x0d,
+	0x7d, 0x6e, 0x98, 0xba, 0x14, 0xd2, 0xa8, 0x30, 0xb6, 0x4a, 0x35, 0x12, 0x64, 0x5f, 0x80, 0x34,
+	0xcd, 0xcd, 0x52, 0x90, 0xc8, 0x25, 0x22, 0xf9, 0x0e, 0x61, 0x43, 0x9d, 0x87, 0x39, 0x24, 0x1c,
+	0x00, 0x7b, 0x1d, 0xe6, 0x46, 0x53, 0x7b, 0xe0, 0x85, 0x40, 0x11, 0xb5, 0x96, 0xea, 0xaa, 0xbc,
+	0x27, 0x2e, 0x1d, 0x2c, 0x7a, 0x46, 0x80, 0x85, 0xa7, 0x7e, 0x10, 0xa1, 0x7d, 0x89, 0xf0, 0x95,
+	0xf7, 0x29, 0x87, 0x54, 0x53, 0x37, 0x52, 0x09, 0xb3, 0x66, 0x85, 0xb5, 0xbd, 0x44, 0x25, 0xa1,
+	0x76, 0x05, 0xd4, 0x96, 0x59, 0x4f, 0x42, 0x4d, 0x29, 0x07, 0x2b, 0xd7, 0xe1, 0x7f, 0x20, 0xbc,
+	0x9e, 0x88, 0x93, 0xef, 0xf2, 0x6e, 0x51, 0xca, 0xc2, 0x4e, 0x3f, 0x78, 0x08, 0x0f, 0x09, 0xfc,
+	0xb2, 0x00, 0xee, 0x9a, 0x7b, 0x85, 0xc0, 0xfa, 0x96, 0xff, 0x16, 0x61, 0x23, 0x91, 0x40, 0xf5,
+	0x5c, 0xb3, 0x88, 0x20, 0xd3, 0x77, 0xad, 0xa5, 0xba, 0xb2, 0x4b, 0x90, 0xe2, 0x4b, 0xb4, 0x5e,
+	0xe6, 0xfd, 0xc6, 0x77, 0xb1, 0xf0, 0xfd, 0xa6, 0xee, 0xe3, 0xf6, 0x12, 0x55, 0xe5, 0xf7, 0xcb,
+	0x23, 0x7d, 0x04, 0xf3, 0x13, 0xc2, 0xd7, 0x92, 0x71, 0x12, 0x77, 0xa1, 0x5d, 0x98, 0x2c, 0x7f,
+	0x1f, 0xf6, 0x2a, 0x69, 0x25, 0x5e, 0x57, 0xe0, 0xb5, 0xcd, 0xed, 0x62, 0x3c, 0x75, 0x31, 0x86,
+	0x20, 0xe6, 0xc6, 0xd7, 0x08, 0x3f, 0x73, 0xcc, 0xc0, 0xe1, 0x10, 0x37, 0x47, 0x5c, 0xb3, 0x74,
+	0x35, 0x72, 0x76, 0xc5, 0xd6, 0x5c, 0x26, 0x93, 0x58, 0x6d, 0x81, 0xd5, 0x30, 0x5f, 0x4c, 0x5d,
+	0x55, 0x21, 0x97, 0x6d, 0xb5, 0x28, 0xdb, 0xef, 0x08, 0x3f, 0x1f, 0x47, 0x3a, 0x01, 0xcf, 0x0f,
+	0x39, 0xb0, 0x5b, 0x33, 0x0e, 0x8c, 0x38, 0xa3, 0x18, 0xcd, 0xd6, 0xe4, 0xd4, 0x2a, 0x15, 0x64,
+	0xb7, 0xba, 0x83, 0xc4, 0x7d, 0x49, 0xe0, 0xda, 0x66, 0x5b, 0x83, 0xcb, 0xa4, 0xa7, 0x05, 0xd2,
+	0x75, 0x41, 0xfe, 0x39, 0xc2, 0x57, 0xe2, 0xf0, 0x47, 0x13, 0x46, 0x44, 0xc8, 0x30, 0xd3, 0x7d,
+	0x59, 0xb3, 0xbe, 0xfb, 0xf2, 0x2a, 0x09, 0xb6, 0x21, 0xc0, 0x6a, 0xe6, 0xd5, 0x24, 0x58, 0xe8,
+	0x7b, 0xc4, 0xea, 0x4d, 0x98, 0x60, 0xf8, 0x11, 0xe1, 0x6b, 0xb1, 0xfb, 0x3b, 0x40, 0xfa, 0x3e,
+	0xf1, 0x54, 0x97, 0x84, 0x99, 0xa6, 0xd3, 0x8b, 0xf4, 0x4d, 0x57, 0xa4, 0x95, 0x54, 0xb6, 0xa0,
+	0xda, 0x35, 0x1b, 0x9a, 0x72, 0x05, 0xb1, 0xd3, 0x65, 0xdb, 0x85, 0x11, 0xe4, 0xcf, 0x08, 0x3f,
+	0x17, 0xc7, 0x54, 0xc1, 0xde, 0x56, 0x53, 0xda, 0xd0, 0x65, 0xce, 0xa9, 0x14, 0xe6, 0x7e, 0x35,
+	0x71, 0xd9, 0xe5, 0x90, 0x9c, 0xfa, 0xef, 0xc5, 0x6f, 0x08, 0xd7, 0x32, 0x51, 0x03, 0x60, 0x0e,
+	0xa7, 0x31, 0x6b, 0xa7, 0x2c, 0x7d, 0x42, 0xa8, 0x70, 0xed, 0xca, 0x7a, 0x49, 0x7c, 0x43, 0x10,
+	0x5b, 0xe6, 0x4e, 0x29, 0x71, 0xc2, 0x53, 0x2e, 0x6d, 0x77, 0x7c, 0x8f, 0x1c, 0xd3, 0xf1, 0xd8,
+	0x21, 0xfd, 0x30, 0xb3, 0x8b, 0x24, 0x4d, 0xfa, 0x5d, 0x24, 0xad, 0x28, 0x5b, 0xda, 0x44, 0xe7,
+	0xb9, 0x52, 0x1a, 0xa5, 0xf6, 0xf1, 0xe3, 0xaf, 0xf6, 0xfb, 0xf1, 0x67, 0x75, 0x3d, 0x15, 0x54,
+	0x3d, 0x56, 0x29, 0x5f, 0x28, 0xb0, 0x96, 0x35, 0xba, 0xd3, 0xef, 0x2f, 0xbe, 0x9e, 0xf7, 0x11,
+	0xbe, 0x2a, 0x07, 0xe1, 0x91, 0xc3, 0xdd, 0xd3, 0x5b, 0x33, 0x70, 0x27, 0xd1, 0x32, 0x6d, 0xec,
+	0xea, 0x16, 0x88, 0xb4, 0x46, 0x51, 0xb4, 0xab, 0x48, 0x25, 0x52, 0x47, 0x20, 0xed, 0x98, 0x5b,
+	0xba, 0x75, 0xa3, 0x17, 0xf9, 0x58, 0xa0, 0x9c, 0x22, 0xc0, 0x5f, 0x11, 0x5e, 0x4b, 0x4c, 0xeb,
+	0x0c, 0xa4, 0x55, 0x34, 0xd5, 0xf5, 0xa0, 0x9d, 0xaa, 0xf2, 0xb2, 0xc6, 0x49, 0x7d, 0x07, 0x34,
+	0xc4, 0x77, 0x17, 0x2b, 0xe4, 0x6d, 0x27, 0x7c, 0x8d, 0x0c, 0xa8, 0x7e, 0x85, 0x94, 0xc6, 0xd2,
+	0x15, 0xf2, 0x52, 0x23, 0x81, 0x5a, 0x02, 0x68, 0xd3, 0x5c, 0xd7, 0x2f, 0xd5, 0xa1, 0xe5, 0x93,
+	0x01, 0x8d, 0x20, 0xee, 0xa5, 0xf7, 0x0a, 0x05, 0xd2, 0x2c, 0xde, 0x6c, 0x52, 0x30, 0xad, 0xa5,
+	0xba, 0xb2, 0x9d, 0x36, 0xb3, 0xf7, 0x2c, 0xa8, 0xbe, 0x42, 0xf8, 0xe9, 0x13, 0x98, 0x02, 0xf3,
+	0x07, 0x73, 0xb5, 0xea, 0xa4, 0xcf, 0x9d, 0xb1, 0x2a, 0x9e, 0x46, 0xb9, 0xa8, 0xec, 0xcf, 0x32,
+	0x26, 0xc5, 0xf9, 0x0d, 0xfb, 0x07, 0x84, 0x9f, 0x8d, 0x8e, 0x96, 0x25, 0xca, 0x1f, 0xbe, 0x80,
+	0x6a, 0x67, 0xb9, 0x50, 0x92, 0x1d, 0x0a, 0xb2, 0x7d, 0xb3, 0x95, 0x2b, 0x53, 0x31, 0xde, 0xf7,
+	0x08, 0xaf, 0xc6, 0xc3, 0xed, 0xbd, 0xc0, 0x63, 0x4e, 0x1f, 0xe4, 0xf6, 0x69, 0xec, 0x68, 0xe6,
+	0x5f, 0x5a, 0xa2, 0x00, 0x77, 0x2b, 0x28, 0xcb, 0x6a, 0x27, 0x67, 0xe4, 0x24, 0x76, 0x51, 0x2b,
+	0x6c, 0x66, 0x6e, 0xc8, 0x48, 0x32, 0xae, 0x7e, 0x6e, 0xa4, 0x35, 0xa5, 0x73, 0x23, 0x2b, 0xad,
+	0x32, 0x37, 0xd4, 0x6e, 0x2d, 0x41, 0x35, 0x73, 0x23, 0x03, 0x69, 0x2d, 0xd9, 0xf0, 0x33, 0xa0,
+	0x9d, 0xaa, 0xf2, 0xca, 0x73, 0x23, 0x4f, 0x7c, 0xf4, 0xd6, 0x5f, 0xe7, 0x75, 0xf4, 0xe0, 0xbc,
+	0x8e, 0xfe, 0x3d, 0xaf, 0xa3, 0x6f, 0x2e, 0xea, 0x2b, 0x7f, 0x5e, 0xd4, 0xd1, 0x83, 0x8b, 0xfa,
+	0xca, 0x3f, 0x17, 0xf5, 0x95, 0x0f, 0xba, 0x9e, 0xcf, 0x4f, 0x27, 0xbd, 0x8e, 0x4b, 0xc7, 0x32,
+	0x28, 0x01, 0x7e, 0x46, 0xd9, 0x50, 0xfe, 0x66, 0xb9, 0x94, 0x81, 0x3d, 0x13, 0x99, 0xf8, 0x3c,
+	0x80, 0xb0, 0xf7, 0x98, 0xf8, 0xbf, 0xc7, 0x8d, 0xff, 0x07, 0x00, 0xc2, 0xdd, 0x26, 0xe1, 0x6b,
+	0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -114,12 +133,22 @@ type MsgServiceClient interface {
 	VoteConfirmToken(ctx context.Context, in *VoteConfirmTokenRequest, opts ...grpc.CallOption) (*VoteConfirmTokenResponse, error)
 	VoteConfirmTransferKey(ctx context.Context, in *VoteConfirmTransferKeyRequest, opts ...grpc.CallOption) (*VoteConfirmTransferKeyResponse, error)
 	CreateDeployToken(ctx context.Context, in *CreateDeployTokenRequest, opts ...grpc.CallOption) (*CreateDeployTokenResponse, error)
+	CreateRegisterExternalToken(ctx context.Context, in *CreateRegisterExternalTokenRequest, opts ...grpc.CallOption) (*CreateRegisterExternalTokenResponse, error)
 	CreateBurnTokens(ctx context.Context, in *CreateBurnTokensRequest, opts ...grpc.CallOption) (*CreateBurnTokensResponse, error)
 	CreatePendingTransfers(ctx context.Context, in *CreatePendingTransfersRequest, opts ...grpc.CallOption) (*CreatePendingTransfersResponse, error)
 	CreateTransferOwnership(ctx context.Context, in *CreateTransferOwnershipRequest, opts ...grpc.CallOption) (*CreateTransferOwnershipResponse, error)
 	CreateTransferOperatorship(ctx context.Context, in *CreateTransferOperatorshipRequest, opts ...grpc.CallOption) (*CreateTransferOperatorshipResponse, error)
 	SignCommands(ctx context.Context, in *SignCommandsRequest, opts ...grpc.CallOption) (*SignCommandsResponse, error)
 	AddChain(ctx context.Context, in *AddChainRequest, opts ...grpc.CallOption) (*AddChainResponse, error)
+	ConfirmBatchExecution(ctx context.Context, in *ConfirmBatchExecutionRequest, opts ...grpc.CallOption) (*ConfirmBatchExecutionResponse, error)
+	VoteConfirmBatchExecution(ctx context.Context, in *VoteConfirmBatchExecutionRequest, opts ...grpc.CallOption) (*VoteConfirmBatchExecutionResponse, error)
+	ConfirmGasInfo(ctx context.Context, in *ConfirmGasInfoRequest, opts ...grpc.CallOption) (*ConfirmGasInfoResponse, error)
+	VoteConfirmGasInfo(ctx context.Context, in *VoteConfirmGasInfoRequest, opts ...grpc.CallOption) (*VoteConfirmGasInfoResponse, error)
+	ReverifyDeposit(ctx context.Context, in *ReverifyDepositRequest, opts ...grpc.CallOption) (*ReverifyDepositResponse, error)
+	VoteReverifyDeposit(ctx context.Context, in *VoteReverifyDepositRequest, opts ...grpc.CallOption) (*VoteReverifyDepositResponse, error)
+	CreateUpgradeGateway(ctx context.Context, in *CreateUpgradeGatewayRequest, opts ...grpc.CallOption) (*CreateUpgradeGatewayResponse, error)
+	ConfirmGatewayUpgrade(ctx context.Context, in *ConfirmGatewayUpgradeRequest, opts ...grpc.CallOption) (*ConfirmGatewayUpgradeResponse, error)
+	VoteConfirmGatewayUpgrade(ctx context.Context, in *VoteConfirmGatewayUpgradeRequest, opts ...grpc.CallOption) (*VoteConfirmGatewayUpgradeResponse, error)
 }
 
 type msgServiceClient struct {
@@ -238,6 +267,15 @@ func (c *msgServiceClient) CreateDeployToken(ctx context.Context, in *CreateDepl
 	return out, nil
 }
 
+func (c *msgServiceClient) CreateRegisterExternalToken(ctx context.Context, in *CreateRegisterExternalTokenRequest, opts ...grpc.CallOption) (*CreateRegisterExternalTokenResponse, error) {
+	out := new(CreateRegisterExternalTokenResponse)
+	err := c.cc.Invoke(ctx, "/evm.v1beta1.MsgService/CreateRegisterExternalToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) CreateBurnTokens(ctx context.Context, in *CreateBurnTokensRequest, opts ...grpc.CallOption) (*CreateBurnTokensResponse, error) {
 	out := new(CreateBurnTokensResponse)
 	err := c.cc.Invoke(ctx, "/evm.v1beta1.MsgService/CreateBurnTokens", in, out, opts...)
@@ -292,6 +330,87 @@ func (c *msgServiceClient) AddChain(ctx context.Context, in *AddChainRequest, op
 	return out, nil
 }
 
+func (c *msgServiceClient) ConfirmBatchExecution(ctx context.Context, in *ConfirmBatchExecutionRequest, opts ...grpc.CallOption) (*ConfirmBatchExecutionResponse, error) {
+	out := new(ConfirmBatchExecutionResponse)
+	err := c.cc.Invoke(ctx, "/evm.v1beta1.MsgService/ConfirmBatchExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) VoteConfirmBatchExecution(ctx context.Context, in *VoteConfirmBatchExecutionRequest, opts ...grpc.CallOption) (*VoteConfirmBatchExecutionResponse, error) {
+	out := new(VoteConfirmBatchExecutionResponse)
+	err := c.cc.Invoke(ctx, "/evm.v1beta1.MsgService/VoteConfirmBatchExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) ConfirmGasInfo(ctx context.Context, in *ConfirmGasInfoRequest, opts ...grpc.CallOption) (*ConfirmGasInfoResponse, error) {
+	out := new(ConfirmGasInfoResponse)
+	err := c.cc.Invoke(ctx, "/evm.v1beta1.MsgService/ConfirmGasInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) VoteConfirmGasInfo(ctx context.Context, in *VoteConfirmGasInfoRequest, opts ...grpc.CallOption) (*VoteConfirmGasInfoResponse, error) {
+	out := new(VoteConfirmGasInfoResponse)
+	err := c.cc.Invoke(ctx, "/evm.v1beta1.MsgService/VoteConfirmGasInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) ReverifyDeposit(ctx context.Context, in *ReverifyDepositRequest, opts ...grpc.CallOption) (*ReverifyDepositResponse, error) {
+	out := new(ReverifyDepositResponse)
+	err := c.cc.Invoke(ctx, "/evm.v1beta1.MsgService/ReverifyDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) VoteReverifyDeposit(ctx context.Context, in *VoteReverifyDepositRequest, opts ...grpc.CallOption) (*VoteReverifyDepositResponse, error) {
+	out := new(VoteReverifyDepositResponse)
+	err := c.cc.Invoke(ctx, "/evm.v1beta1.MsgService/VoteReverifyDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) CreateUpgradeGateway(ctx context.Context, in *CreateUpgradeGatewayRequest, opts ...grpc.CallOption) (*CreateUpgradeGatewayResponse, error) {
+	out := new(CreateUpgradeGatewayResponse)
+	err := c.cc.Invoke(ctx, "/evm.v1beta1.MsgService/CreateUpgradeGateway", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) ConfirmGatewayUpgrade(ctx context.Context, in *ConfirmGatewayUpgradeRequest, opts ...grpc.CallOption) (*ConfirmGatewayUpgradeResponse, error) {
+	out := new(ConfirmGatewayUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/evm.v1beta1.MsgService/ConfirmGatewayUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) VoteConfirmGatewayUpgrade(ctx context.Context, in *VoteConfirmGatewayUpgradeRequest, opts ...grpc.CallOption) (*VoteConfirmGatewayUpgradeResponse, error) {
+	out := new(VoteConfirmGatewayUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/evm.v1beta1.MsgService/VoteConfirmGatewayUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 type MsgServiceServer interface {
 	Link(context.Context, *LinkRequest) (*LinkResponse, error)
@@ -306,12 +425,22 @@ type MsgServiceServer interface {
 	VoteConfirmToken(context.Context, *VoteConfirmTokenRequest) (*VoteConfirmTokenResponse, error)
 	VoteConfirmTransferKey(context.Context, *VoteConfirmTransferKeyRequest) (*VoteConfirmTransferKeyResponse, error)
 	CreateDeployToken(context.Context, *CreateDeployTokenRequest) (*CreateDeployTokenResponse, error)
+	CreateRegisterExternalToken(context.Context, *CreateRegisterExternalTokenRequest) (*CreateRegisterExternalTokenResponse, error)
 	CreateBurnTokens(context.Context, *CreateBurnTokensRequest) (*CreateBurnTokensResponse, error)
 	CreatePendingTransfers(context.Context, *CreatePendingTransfersRequest) (*CreatePendingTransfersResponse, error)
 	CreateTransferOwnership(context.Context, *CreateTransferOwnershipRequest) (*CreateTransferOwnershipResponse, error)
 	CreateTransferOperatorship(context.Context, *CreateTransferOperatorshipRequest) (*CreateTransferOperatorshipResponse, error)
 	SignCommands(context.Context, *SignCommandsRequest) (*SignCommandsResponse, error)
 	AddChain(context.Context, *AddChainRequest) (*AddChainResponse, error)
+	ConfirmBatchExecution(context.Context, *ConfirmBatchExecutionRequest) (*ConfirmBatchExecutionResponse, error)
+	VoteConfirmBatchExecution(context.Context, *VoteConfirmBatchExecutionRequest) (*VoteConfirmBatchExecutionResponse, error)
+	ConfirmGasInfo(context.Context, *ConfirmGasInfoRequest) (*ConfirmGasInfoResponse, error)
+	VoteConfirmGasInfo(context.Context, *VoteConfirmGasInfoRequest) (*VoteConfirmGasInfoResponse, error)
+	ReverifyDeposit(context.Context, *ReverifyDepositRequest) (*ReverifyDepositResponse, error)
+	VoteReverifyDeposit(context.Context, *VoteReverifyDepositRequest) (*VoteReverifyDepositResponse, error)
+	CreateUpgradeGateway(context.Context, *CreateUpgradeGatewayRequest) (*CreateUpgradeGatewayResponse, error)
+	ConfirmGatewayUpgrade(context.Context, *ConfirmGatewayUpgradeRequest) (*ConfirmGatewayUpgradeResponse, error)
+	VoteConfirmGatewayUpgrade(context.Context, *VoteConfirmGatewayUpgradeRequest) (*VoteConfirmGatewayUpgradeResponse, error)
 }
 
 // UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
@@ -354,6 +483,9 @@ func (*UnimplementedMsgServiceServer) VoteConfirmTransferKey(ctx context.Context
 func (*UnimplementedMsgServiceServer) CreateDeployToken(ctx context.Context, req *CreateDeployTokenRequest) (*CreateDeployTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDeployToken not implemented")
 }
+func (*UnimplementedMsgServiceServer) CreateRegisterExternalToken(ctx context.Context, req *CreateRegisterExternalTokenRequest) (*CreateRegisterExternalTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRegisterExternalToken not implemented")
+}
 func (*UnimplementedMsgServiceServer) CreateBurnTokens(ctx context.Context, req *CreateBurnTokensRequest) (*CreateBurnTokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBurnTokens not implemented")
 }
@@ -372,6 +504,33 @@ func (*UnimplementedMsgServiceServer) SignCommands(ctx context.Context, req *Sig
 func (*UnimplementedMsgServiceServer) AddChain(ctx context.Context, req *AddChainRequest) (*AddChainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddChain not implemented")
 }
+func (*UnimplementedMsgServiceServer) ConfirmBatchExecution(ctx context.Context, req *ConfirmBatchExecutionRequest) (*ConfirmBatchExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmBatchExecution not implemented")
+}
+func (*UnimplementedMsgServiceServer) VoteConfirmBatchExecution(ctx context.Context, req *VoteConfirmBatchExecutionRequest) (*VoteConfirmBatchExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteConfirmBatchExecution not implemented")
+}
+func (*UnimplementedMsgServiceServer) ConfirmGasInfo(ctx context.Context, req *ConfirmGasInfoRequest) (*ConfirmGasInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmGasInfo not implemented")
+}
+func (*UnimplementedMsgServiceServer) VoteConfirmGasInfo(ctx context.Context, req *VoteConfirmGasInfoRequest) (*VoteConfirmGasInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteConfirmGasInfo not implemented")
+}
+func (*UnimplementedMsgServiceServer) ReverifyDeposit(ctx context.Context, req *ReverifyDepositRequest) (*ReverifyDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverifyDeposit not implemented")
+}
+func (*UnimplementedMsgServiceServer) VoteReverifyDeposit(ctx context.Context, req *VoteReverifyDepositRequest) (*VoteReverifyDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteReverifyDeposit not implemented")
+}
+func (*UnimplementedMsgServiceServer) CreateUpgradeGateway(ctx context.Context, req *CreateUpgradeGatewayRequest) (*CreateUpgradeGatewayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUpgradeGateway not implemented")
+}
+func (*UnimplementedMsgServiceServer) ConfirmGatewayUpgrade(ctx context.Context, req *ConfirmGatewayUpgradeRequest) (*ConfirmGatewayUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmGatewayUpgrade not implemented")
+}
+func (*UnimplementedMsgServiceServer) VoteConfirmGatewayUpgrade(ctx context.Context, req *VoteConfirmGatewayUpgradeRequest) (*VoteConfirmGatewayUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteConfirmGatewayUpgrade not implemented")
+}
 
 func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
 	s.RegisterService(&_MsgService_serviceDesc, srv)
@@ -593,6 +752,24 @@ func _MsgService_CreateDeployToken_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_CreateRegisterExternalToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRegisterExternalTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CreateRegisterExternalToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evm.v1beta1.MsgService/CreateRegisterExternalToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CreateRegisterExternalToken(ctx, req.(*CreateRegisterExternalTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_CreateBurnTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateBurnTokensRequest)
 	if err := dec(in); err != nil {
@@ -701,6 +878,168 @@ func _MsgService_AddChain_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_ConfirmBatchExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmBatchExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ConfirmBatchExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evm.v1beta1.MsgService/ConfirmBatchExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ConfirmBatchExecution(ctx, req.(*ConfirmBatchExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_VoteConfirmBatchExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteConfirmBatchExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).VoteConfirmBatchExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evm.v1beta1.MsgService/VoteConfirmBatchExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).VoteConfirmBatchExecution(ctx, req.(*VoteConfirmBatchExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_ConfirmGasInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmGasInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ConfirmGasInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evm.v1beta1.MsgService/ConfirmGasInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ConfirmGasInfo(ctx, req.(*ConfirmGasInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_VoteConfirmGasInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteConfirmGasInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).VoteConfirmGasInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evm.v1beta1.MsgService/VoteConfirmGasInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).VoteConfirmGasInfo(ctx, req.(*VoteConfirmGasInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_ReverifyDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverifyDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ReverifyDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evm.v1beta1.MsgService/ReverifyDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ReverifyDeposit(ctx, req.(*ReverifyDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_VoteReverifyDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteReverifyDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).VoteReverifyDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evm.v1beta1.MsgService/VoteReverifyDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).VoteReverifyDeposit(ctx, req.(*VoteReverifyDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_CreateUpgradeGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUpgradeGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CreateUpgradeGateway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evm.v1beta1.MsgService/CreateUpgradeGateway",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CreateUpgradeGateway(ctx, req.(*CreateUpgradeGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_ConfirmGatewayUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmGatewayUpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ConfirmGatewayUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evm.v1beta1.MsgService/ConfirmGatewayUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ConfirmGatewayUpgrade(ctx, req.(*ConfirmGatewayUpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_VoteConfirmGatewayUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteConfirmGatewayUpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).VoteConfirmGatewayUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evm.v1beta1.MsgService/VoteConfirmGatewayUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).VoteConfirmGatewayUpgrade(ctx, req.(*VoteConfirmGatewayUpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MsgService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evm.v1beta1.MsgService",
 	HandlerType: (*MsgServiceServer)(nil),
@@ -753,6 +1092,10 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateDeployToken",
 			Handler:    _MsgService_CreateDeployToken_Handler,
 		},
+		{
+			MethodName: "CreateRegisterExternalToken",
+			Handler:    _MsgService_CreateRegisterExternalToken_Handler,
+		},
 		{
 			MethodName: "CreateBurnTokens",
 			Handler:    _MsgService_CreateBurnTokens_Handler,
@@ -777,6 +1120,42 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddChain",
 			Handler:    _MsgService_AddChain_Handler,
 		},
+		{
+			MethodName: "ConfirmBatchExecution",
+			Handler:    _MsgService_ConfirmBatchExecution_Handler,
+		},
+		{
+			MethodName: "VoteConfirmBatchExecution",
+			Handler:    _MsgService_VoteConfirmBatchExecution_Handler,
+		},
+		{
+			MethodName: "ConfirmGasInfo",
+			Handler:    _MsgService_ConfirmGasInfo_Handler,
+		},
+		{
+			MethodName: "VoteConfirmGasInfo",
+			Handler:    _MsgService_VoteConfirmGasInfo_Handler,
+		},
+		{
+			MethodName: "ReverifyDeposit",
+			Handler:    _MsgService_ReverifyDeposit_Handler,
+		},
+		{
+			MethodName: "VoteReverifyDeposit",
+			Handler:    _MsgService_VoteReverifyDeposit_Handler,
+		},
+		{
+			MethodName: "CreateUpgradeGateway",
+			Handler:    _MsgService_CreateUpgradeGateway_Handler,
+		},
+		{
+			MethodName: "ConfirmGatewayUpgrade",
+			Handler:    _MsgService_ConfirmGatewayUpgrade_Handler,
+		},
+		{
+			MethodName: "VoteConfirmGatewayUpgrade",
+			Handler:    _MsgService_VoteConfirmGatewayUpgrade_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evm/v1beta1/service.proto",
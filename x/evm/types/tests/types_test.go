@@ -18,11 +18,14 @@ import (
 	paramsKeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 
 	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/testutils"
 	"github.com/axelarnetwork/axelar-core/testutils/fake"
 	"github.com/axelarnetwork/axelar-core/testutils/rand"
 	"github.com/axelarnetwork/axelar-core/x/evm/exported"
 	"github.com/axelarnetwork/axelar-core/x/evm/keeper"
 	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	evmTestUtils "github.com/axelarnetwork/axelar-core/x/evm/types/testutils"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	tssTestUtils "github.com/axelarnetwork/axelar-core/x/tss/exported/testutils"
 )
 
@@ -118,6 +121,88 @@ func TestCreateBurnTokenCommand(t *testing.T) {
 	assert.Equal(t, salt, decodedSalt)
 }
 
+func TestCreateBurnTokenBatchCommand(t *testing.T) {
+	chainID := big.NewInt(1)
+	keyID := tssTestUtils.RandKeyID()
+	asset := rand.Str(5)
+	symbol := rand.Str(3)
+	salt1 := common.BytesToHash(rand.Bytes(common.HashLength))
+	salt2 := common.BytesToHash(rand.Bytes(common.HashLength))
+	height := rand.I64Between(100, 10000)
+	burnerInfos := []types.BurnerInfo{
+		{Asset: asset, Symbol: symbol, Salt: types.Hash(salt1)},
+		{Asset: asset, Symbol: symbol, Salt: types.Hash(salt2)},
+	}
+
+	expectedParams := fmt.Sprintf("000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000000000000000000003%s00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002%s%s",
+		hex.EncodeToString([]byte(symbol)),
+		hex.EncodeToString(salt1.Bytes()),
+		hex.EncodeToString(salt2.Bytes()),
+	)
+	actual, err := types.CreateBurnTokenBatchCommand(chainID, keyID, height, burnerInfos)
+
+	assert.NoError(t, err)
+	assert.Equal(t, types.AxelarGatewayCommandBurnTokenBatch, actual.Command)
+	assert.Equal(t, expectedParams, hex.EncodeToString(actual.Params))
+
+	decodedSymbol, decodedSalts, err := types.DecodeBurnTokenBatchParams(actual.Params)
+	assert.NoError(t, err)
+	assert.Equal(t, symbol, decodedSymbol)
+	assert.Equal(t, []common.Hash{salt1, salt2}, decodedSalts)
+
+	var singleCommandsGasCost uint32
+	for _, burnerInfo := range burnerInfos {
+		cmd, err := types.CreateBurnTokenCommand(chainID, keyID, height, burnerInfo)
+		assert.NoError(t, err)
+		assert.NotEqual(t, cmd.ID, actual.ID)
+		singleCommandsGasCost += cmd.MaxGasCost
+	}
+	assert.Less(t, actual.MaxGasCost, singleCommandsGasCost)
+
+	lockCmd, err := types.CreateLockTokenBatchCommand(chainID, keyID, height, burnerInfos)
+	assert.NoError(t, err)
+	assert.Equal(t, types.AxelarGatewayCommandLockTokenBatch, lockCmd.Command)
+	assert.Equal(t, actual.Params, lockCmd.Params)
+
+	nativeCmd, err := types.CreateLockNativeBatchCommand(chainID, keyID, height, burnerInfos)
+	assert.NoError(t, err)
+	assert.Equal(t, types.AxelarGatewayCommandLockNativeBatch, nativeCmd.Command)
+	decodedSalts, err = types.DecodeLockNativeBatchParams(nativeCmd.Params)
+	assert.NoError(t, err)
+	assert.Equal(t, []common.Hash{salt1, salt2}, decodedSalts)
+}
+
+func TestCreateBurnTokenBatchCommand_InvalidBatch(t *testing.T) {
+	chainID := big.NewInt(1)
+	keyID := tssTestUtils.RandKeyID()
+	height := rand.I64Between(100, 10000)
+
+	_, err := types.CreateBurnTokenBatchCommand(chainID, keyID, height, nil)
+	assert.Error(t, err)
+
+	mixedAssets := []types.BurnerInfo{
+		{Asset: rand.Str(5), Salt: types.Hash(common.BytesToHash(rand.Bytes(common.HashLength)))},
+		{Asset: rand.Str(6), Salt: types.Hash(common.BytesToHash(rand.Bytes(common.HashLength)))},
+	}
+	_, err = types.CreateBurnTokenBatchCommand(chainID, keyID, height, mixedAssets)
+	assert.Error(t, err)
+
+	asset := rand.Str(5)
+	tooMany := make([]types.BurnerInfo, types.MaxBurnerBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = types.BurnerInfo{Asset: asset, Salt: types.Hash(common.BytesToHash(rand.Bytes(common.HashLength)))}
+	}
+	_, err = types.CreateLockNativeBatchCommand(chainID, keyID, height, tooMany)
+	assert.Error(t, err)
+}
+
+func TestValidateGatewayCommands(t *testing.T) {
+	assert.NoError(t, types.ValidateGatewayCommands(types.DefaultParams()[0].GatewayCommands))
+	assert.NoError(t, types.ValidateGatewayCommands([]string{types.AxelarGatewayCommandMintToken, types.AxelarGatewayCommandBurnToken}))
+	assert.Error(t, types.ValidateGatewayCommands([]string{types.AxelarGatewayCommandMintToken, rand.StrBetween(5, 10)}))
+	assert.Error(t, types.ValidateGatewayCommands([]string{types.AxelarGatewayCommandMintToken, types.AxelarGatewayCommandMintToken}))
+}
+
 func TestCreateSinglesigTransferCommand_Ownership(t *testing.T) {
 	chainID := big.NewInt(1)
 	keyID := tssTestUtils.RandKeyID()
@@ -277,6 +362,72 @@ func TestGetTokenAddress(t *testing.T) {
 	assert.Equal(t, expected, token.GetAddress())
 }
 
+func TestExternalToken(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	paramsK := paramsKeeper.NewKeeper(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("subspace"), sdk.NewKVStoreKey("tsubspace"))
+	k := keeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("testKey"), paramsK)
+
+	asset := "uusdc"
+	tokenDetails := types.NewTokenDetails("usd coin", "USDC", 6, sdk.NewInt(rand.PosI64()))
+	tokenAddr := types.Address(common.BytesToAddress(rand.Bytes(common.AddressLength)))
+	keyID := tssTestUtils.RandKeyID()
+
+	chainKeeper := k.ForChain(exported.Ethereum.Name)
+	chainKeeper.SetParams(ctx, types.DefaultParams()[0])
+	chainKeeper.SetPendingGateway(ctx, common.BytesToAddress(rand.Bytes(common.AddressLength)))
+	chainKeeper.ConfirmPendingGateway(ctx)
+
+	token, err := chainKeeper.CreateExternalERC20Token(ctx, asset, tokenDetails, sdk.NewInt(1000000), tokenAddr)
+	assert.NoError(t, err)
+	assert.True(t, token.IsExternal())
+	assert.Equal(t, tokenAddr, token.GetAddress())
+
+	_, err = chainKeeper.CreateERC20Token(ctx, asset, tokenDetails, sdk.NewInt(1000000))
+	assert.Error(t, err)
+
+	cmd, err := token.CreateDeployCommand(keyID)
+	assert.NoError(t, err)
+	assert.Equal(t, types.AxelarGatewayCommandRegisterToken, cmd.Command)
+
+	decodedSymbol, decodedAddr, err := types.DecodeRegisterTokenParams(cmd.Params)
+	assert.NoError(t, err)
+	assert.Equal(t, tokenDetails.Symbol, decodedSymbol)
+	assert.Equal(t, common.Address(tokenAddr), decodedAddr)
+
+	assert.NoError(t, token.RecordDeployment(evmTestUtils.RandomHash()))
+	assert.NoError(t, token.ConfirmDeployment())
+
+	token = chainKeeper.GetERC20TokenByAsset(ctx, asset)
+	assert.True(t, token.IsExternal())
+
+	recipient := common.BytesToAddress(rand.Bytes(common.AddressLength))
+	amount := sdk.NewInt(rand.I64Between(1, 100000))
+	cmd, err = token.CreateMintCommand(keyID, nexus.CrossChainTransfer{
+		Recipient: nexus.CrossChainAddress{Chain: exported.Ethereum, Address: recipient.Hex()},
+		Asset:     sdk.NewCoin(asset, amount),
+		ID:        uint64(rand.PosI64()),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, types.AxelarGatewayCommandUnlockToken, cmd.Command)
+
+	decodedSymbol, decodedRecipient, decodedAmount, err := types.DecodeMintTokenParams(cmd.Params)
+	assert.NoError(t, err)
+	assert.Equal(t, tokenDetails.Symbol, decodedSymbol)
+	assert.Equal(t, recipient, decodedRecipient)
+	assert.Equal(t, amount.BigInt(), decodedAmount)
+
+	salt := evmTestUtils.RandomHash()
+	cmd, err = types.CreateLockTokenCommand(big.NewInt(1), keyID, rand.PosI64(), types.BurnerInfo{Symbol: tokenDetails.Symbol, Salt: salt})
+	assert.NoError(t, err)
+	assert.Equal(t, types.AxelarGatewayCommandLockToken, cmd.Command)
+
+	decodedSymbol, decodedSalt, err := types.DecodeBurnTokenParams(cmd.Params)
+	assert.NoError(t, err)
+	assert.Equal(t, tokenDetails.Symbol, decodedSymbol)
+	assert.Equal(t, common.Hash(salt), decodedSalt)
+}
+
 func TestGetBurnerAddressAndSalt(t *testing.T) {
 	encCfg := app.MakeEncodingConfig()
 	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
@@ -300,3 +451,49 @@ func TestGetBurnerAddressAndSalt(t *testing.T) {
 	assert.Equal(t, expectedBurnerAddr, actualburnerAddr)
 	assert.Equal(t, common.Bytes2Hex(expectedSalt), common.Bytes2Hex(actualSalt[:]))
 }
+
+func TestCommandBatch_SetCommandsExecuted(t *testing.T) {
+	setup := func() (types.CommandBatch, *types.CommandBatchMetadata) {
+		metadata := evmTestUtils.RandomBatch()
+		metadata.Status = types.BatchSigned
+		metadata.SignedAtHeight = rand.I64Between(1, 1000)
+		metadata.Executions = nil
+
+		stored := &metadata
+		batch := types.NewCommandBatch(metadata, func(batch types.CommandBatchMetadata) { *stored = batch })
+
+		return batch, stored
+	}
+
+	t.Run("should record executed commands of the batch and ignore unknown ones", testutils.Func(func(t *testing.T) {
+		batch, stored := setup()
+		commandIDs := batch.GetCommandIDs()
+		txID := evmTestUtils.RandomHash()
+		height := rand.PosI64()
+
+		executed := append([]types.CommandID{evmTestUtils.RandomCommandID()}, commandIDs[0])
+		recorded := batch.SetCommandsExecuted(txID, height, executed...)
+
+		assert.Equal(t, []types.CommandID{commandIDs[0]}, recorded)
+		assert.Equal(t, []types.CommandExecution{{CommandID: commandIDs[0], TxID: txID, Height: height}}, stored.Executions)
+		assert.True(t, batch.IsCommandExecuted(commandIDs[0]))
+		assert.Equal(t, len(commandIDs) == 1, batch.IsExecuted())
+
+		assert.Empty(t, batch.SetCommandsExecuted(evmTestUtils.RandomHash(), height+1, commandIDs[0]))
+		assert.Len(t, stored.Executions, 1)
+	}).Repeat(20))
+
+	t.Run("should mark the batch as executed once all commands are recorded", testutils.Func(func(t *testing.T) {
+		batch, _ := setup()
+		timeout := rand.I64Between(1, 100)
+		overdueHeight := batch.GetSignedAtHeight() + timeout
+
+		assert.False(t, batch.IsExecutionOverdue(overdueHeight-1, timeout))
+		assert.True(t, batch.IsExecutionOverdue(overdueHeight, timeout))
+
+		batch.SetCommandsExecuted(evmTestUtils.RandomHash(), overdueHeight, batch.GetCommandIDs()...)
+
+		assert.True(t, batch.IsExecuted())
+		assert.False(t, batch.IsExecutionOverdue(overdueHeight, timeout))
+	}).Repeat(20))
+}
@@ -46,11 +46,13 @@ func RandomChain() types.GenesisState_Chain {
 
 	chain.ConfirmedDeposits = RandomDeposits()
 	chain.BurnedDeposits = RandomDeposits()
+	chain.ReorgedDeposits = RandomDeposits()
 
-	chain.BurnerInfos = RandomBurnerInfos(len(chain.ConfirmedDeposits) + len(chain.BurnedDeposits))
+	chain.BurnerInfos = RandomBurnerInfos(len(chain.ConfirmedDeposits) + len(chain.BurnedDeposits) + len(chain.ReorgedDeposits))
 
 	correctDepositsAndBurnerInfos(confirmedTokens, chain.ConfirmedDeposits, chain.BurnerInfos)
 	correctDepositsAndBurnerInfos(confirmedTokens, chain.BurnedDeposits, chain.BurnerInfos[len(chain.ConfirmedDeposits):])
+	correctDepositsAndBurnerInfos(confirmedTokens, chain.ReorgedDeposits, chain.BurnerInfos[len(chain.ConfirmedDeposits)+len(chain.BurnedDeposits):])
 
 	return chain
 }
@@ -286,6 +288,10 @@ func RandomParams() types.Params {
 		MinVoterCount:       rand.PosI64(),
 		CommandsGasLimit:    uint32(rand.I64Between(0, 10000000)),
 		TransactionFeeRate:  RandomTransactionFeeRate(),
+
+		CommandBatchExecutionTimeout: rand.PosI64(),
+		GasInfoMaxAge:                rand.PosI64(),
+		FinalityMode:                 types.FinalityMode(rand.I64Between(1, 4)),
 	}
 
 	params.Network = params.Networks[int(rand.I64Between(0, int64(len(params.Networks))))].Name
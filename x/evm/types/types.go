@@ -15,6 +15,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 
+	"github.com/axelarnetwork/axelar-core/utils"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
 	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
@@ -53,10 +54,27 @@ const (
 	deployTokenMaxGasCost                    = 1500000
 	AxelarGatewayCommandBurnToken            = "burnToken"
 	burnTokenMaxGasCost                      = 200000
+	AxelarGatewayCommandRegisterToken        = "registerExternalToken"
+	registerTokenMaxGasCost                  = 150000
+	AxelarGatewayCommandUnlockToken          = "unlockToken"
+	unlockTokenMaxGasCost                    = 200000
+	AxelarGatewayCommandLockToken            = "lockToken"
+	lockTokenMaxGasCost                      = 200000
 	AxelarGatewayCommandTransferOwnership    = "transferOwnership"
 	transferOwnershipMaxGasCost              = 150000
 	AxelarGatewayCommandTransferOperatorship = "transferOperatorship"
 	transferOperatorshipMaxGasCost           = 150000
+	AxelarGatewayCommandUpgrade              = "upgrade"
+	upgradeMaxGasCost                        = 300000
+	AxelarGatewayCommandLockNative           = "lockNative"
+	lockNativeMaxGasCost                     = 100000
+	AxelarGatewayCommandUnlockNative         = "unlockNative"
+	unlockNativeMaxGasCost                   = 100000
+	AxelarGatewayCommandBurnTokenBatch       = "burnTokenBatch"
+	AxelarGatewayCommandLockTokenBatch       = "lockTokenBatch"
+	AxelarGatewayCommandLockNativeBatch      = "lockNativeBatch"
+	burnerBatchBaseGasCost                   = 100000
+	burnerBatchPerBurnerGasCost              = 100000
 	axelarGatewayFuncExecute                 = "execute"
 )
 
@@ -96,6 +114,11 @@ func (t *ERC20Token) GetMinAmount() sdk.Int {
 	return t.metadata.MinAmount
 }
 
+// IsExternal returns true if the token is a pre-existing contract that is locked and unlocked by the gateway
+func (t *ERC20Token) IsExternal() bool {
+	return t.metadata.IsExternal
+}
+
 // Is returns true if the given status matches the token's status
 func (t *ERC20Token) Is(status Status) bool {
 	// this special case check is needed, because 0 & x == 0 is true for any x
@@ -117,6 +140,15 @@ func (t *ERC20Token) CreateDeployCommand(key tss.KeyID) (Command, error) {
 		return Command{}, err
 	}
 
+	if t.IsExternal() {
+		return CreateRegisterTokenCommand(
+			t.metadata.ChainID.BigInt(),
+			key,
+			t.metadata.Details.Symbol,
+			common.Address(t.metadata.TokenAddress),
+		)
+	}
+
 	return CreateDeployTokenCommand(
 		t.metadata.ChainID.BigInt(),
 		key,
@@ -124,7 +156,7 @@ func (t *ERC20Token) CreateDeployCommand(key tss.KeyID) (Command, error) {
 	)
 }
 
-// CreateMintCommand returns a mint deployment command for the token
+// CreateMintCommand returns a mint deployment command for the token, or an unlock command if the token is external
 func (t *ERC20Token) CreateMintCommand(key tss.KeyID, transfer nexus.CrossChainTransfer) (Command, error) {
 	if !t.Is(Confirmed) {
 		return Command{}, fmt.Errorf("token %s not confirmed (current status: %s)",
@@ -134,10 +166,15 @@ func (t *ERC20Token) CreateMintCommand(key tss.KeyID, transfer nexus.CrossChainT
 		return Command{}, err
 	}
 
-	return CreateMintTokenCommand(key, transferIDtoCommandID(transfer.ID), t.metadata.Details.Symbol, common.HexToAddress(transfer.Recipient.Address), transfer.Asset.Amount.BigInt())
+	if t.IsExternal() {
+		return CreateUnlockTokenCommand(key, NewCommandIDFromTransferID(transfer.ID), t.metadata.Details.Symbol, common.HexToAddress(transfer.Recipient.Address), transfer.Asset.Amount.BigInt())
+	}
+
+	return CreateMintTokenCommand(key, NewCommandIDFromTransferID(transfer.ID), t.metadata.Details.Symbol, common.HexToAddress(transfer.Recipient.Address), transfer.Asset.Amount.BigInt())
 }
 
-func transferIDtoCommandID(transferID uint64) CommandID {
+// NewCommandIDFromTransferID returns the command ID of the command that completes the cross-chain transfer with the given ID
+func NewCommandIDFromTransferID(transferID uint64) CommandID {
 	var commandID CommandID
 
 	bz := make([]byte, 8)
@@ -205,11 +242,31 @@ func (t *ERC20Token) ConfirmDeployment() error {
 // NilToken is a nil erc20 token
 var NilToken = ERC20Token{}
 
+// GetConfirmGatewayUpgradePollKey creates a poll key for the gateway upgrade
+func GetConfirmGatewayUpgradePollKey(chain nexus.Chain, txID Hash, implementation Address) vote.PollKey {
+	return vote.NewPollKey(ModuleName, fmt.Sprintf("%s_upgrade_%s_%s", chain.Name, txID.Hex(), implementation.Hex()))
+}
+
 // GetConfirmGatewayDeploymentPollKey creates a poll key for the gateway deployment
 func GetConfirmGatewayDeploymentPollKey(chain nexus.Chain, txID Hash, address Address) vote.PollKey {
 	return vote.NewPollKey(ModuleName, fmt.Sprintf("%s_%s_%s", chain.Name, txID.Hex(), address.Hex()))
 }
 
+// GetConfirmBatchExecutionPollKey creates a poll key for the execution of a command batch
+func GetConfirmBatchExecutionPollKey(chain nexus.Chain, batchID []byte, txID Hash) vote.PollKey {
+	return vote.NewPollKey(ModuleName, fmt.Sprintf("%s_%s_%s", chain.Name, hex.EncodeToString(batchID), txID.Hex()))
+}
+
+// GetConfirmGasInfoPollKey creates a poll key for the gas info of a chain at the given block
+func GetConfirmGasInfoPollKey(chain nexus.Chain, blockNumber uint64) vote.PollKey {
+	return vote.NewPollKey(ModuleName, fmt.Sprintf("%s_gas_info_%d", chain.Name, blockNumber))
+}
+
+// GetReverifyDepositPollKey creates a poll key for the reverification of a confirmed deposit
+func GetReverifyDepositPollKey(chain nexus.Chain, txID Hash, burnerAddress Address) vote.PollKey {
+	return vote.NewPollKey(ModuleName, fmt.Sprintf("%s_reverify_%s_%s", chain.Name, txID.Hex(), burnerAddress.Hex()))
+}
+
 // GetConfirmTokenKey creates a poll key for token confirmation
 func GetConfirmTokenKey(txID Hash, asset string) vote.PollKey {
 	return vote.NewPollKey(ModuleName, txID.Hex()+"_"+strings.ToLower(asset))
@@ -423,6 +480,44 @@ func CreateExecuteDataMultisig(data []byte, sigs ...Signature) ([]byte, error) {
 	return result, nil
 }
 
+// AllGatewayCommands returns all commands that can be issued to a gateway
+func AllGatewayCommands() []string {
+	return []string{
+		AxelarGatewayCommandDeployToken,
+		AxelarGatewayCommandMintToken,
+		AxelarGatewayCommandBurnToken,
+		AxelarGatewayCommandRegisterToken,
+		AxelarGatewayCommandLockToken,
+		AxelarGatewayCommandUnlockToken,
+		AxelarGatewayCommandTransferOwnership,
+		AxelarGatewayCommandTransferOperatorship,
+		AxelarGatewayCommandUpgrade,
+		AxelarGatewayCommandLockNative,
+		AxelarGatewayCommandUnlockNative,
+		AxelarGatewayCommandBurnTokenBatch,
+		AxelarGatewayCommandLockTokenBatch,
+		AxelarGatewayCommandLockNativeBatch,
+	}
+}
+
+// ValidateGatewayCommands returns an error if the given gateway commands contain unknown or duplicate commands
+func ValidateGatewayCommands(commands []string) error {
+	seen := make(map[string]bool)
+	for _, command := range commands {
+		if utils.IndexOf(AllGatewayCommands(), command) < 0 {
+			return fmt.Errorf("unknown gateway command %s", command)
+		}
+
+		if seen[command] {
+			return fmt.Errorf("duplicate gateway command %s", command)
+		}
+
+		seen[command] = true
+	}
+
+	return nil
+}
+
 // GetSignHash returns the hash that needs to be signed so AxelarGateway accepts the given command
 func GetSignHash(commandData []byte) common.Hash {
 	hash := crypto.Keccak256(commandData)
@@ -452,6 +547,172 @@ func CreateBurnTokenCommand(chainID *big.Int, keyID tss.KeyID, height int64, bur
 	}, nil
 }
 
+// CreateLockTokenCommand creates a command to lock external tokens sent to the deposit address of the given burner's information
+func CreateLockTokenCommand(chainID *big.Int, keyID tss.KeyID, height int64, burnerInfo BurnerInfo) (Command, error) {
+	cmd, err := CreateBurnTokenCommand(chainID, keyID, height, burnerInfo)
+	if err != nil {
+		return Command{}, err
+	}
+
+	cmd.Command = AxelarGatewayCommandLockToken
+	cmd.MaxGasCost = lockTokenMaxGasCost
+	return cmd, nil
+}
+
+// CreateLockNativeCommand creates a command to deploy the native currency deposit address of the given burner's information,
+// which forwards the deposited value to the gateway
+func CreateLockNativeCommand(chainID *big.Int, keyID tss.KeyID, height int64, burnerInfo BurnerInfo) (Command, error) {
+	params, err := createLockNativeParams(common.Hash(burnerInfo.Salt))
+	if err != nil {
+		return Command{}, err
+	}
+
+	heightBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(heightBytes, uint64(height))
+
+	return Command{
+		ID:         NewCommandID(append(burnerInfo.Salt.Bytes(), heightBytes...), chainID),
+		Command:    AxelarGatewayCommandLockNative,
+		Params:     params,
+		KeyID:      keyID,
+		MaxGasCost: lockNativeMaxGasCost,
+	}, nil
+}
+
+// MaxBurnerBatchSize is the maximum number of burners a single batched burn command sweeps
+const MaxBurnerBatchSize = 20
+
+// CreateBurnTokenBatchCommand creates a command to burn the tokens deposited to all of the given burners, which must share the same asset
+func CreateBurnTokenBatchCommand(chainID *big.Int, keyID tss.KeyID, height int64, burnerInfos []BurnerInfo) (Command, error) {
+	if err := validateBurnerBatch(burnerInfos); err != nil {
+		return Command{}, err
+	}
+
+	params, err := createBurnTokenBatchParams(burnerInfos[0].Symbol, getBurnerSalts(burnerInfos))
+	if err != nil {
+		return Command{}, err
+	}
+
+	return newBurnerBatchCommand(chainID, keyID, height, AxelarGatewayCommandBurnTokenBatch, params, burnerInfos), nil
+}
+
+// CreateLockTokenBatchCommand creates a command to lock the external tokens deposited to all of the given burners, which must share the same asset
+func CreateLockTokenBatchCommand(chainID *big.Int, keyID tss.KeyID, height int64, burnerInfos []BurnerInfo) (Command, error) {
+	cmd, err := CreateBurnTokenBatchCommand(chainID, keyID, height, burnerInfos)
+	if err != nil {
+		return Command{}, err
+	}
+
+	cmd.Command = AxelarGatewayCommandLockTokenBatch
+	return cmd, nil
+}
+
+// CreateLockNativeBatchCommand creates a command to deploy all of the given native currency deposit addresses,
+// which forward the deposited value to the gateway
+func CreateLockNativeBatchCommand(chainID *big.Int, keyID tss.KeyID, height int64, burnerInfos []BurnerInfo) (Command, error) {
+	if err := validateBurnerBatch(burnerInfos); err != nil {
+		return Command{}, err
+	}
+
+	params, err := createLockNativeBatchParams(getBurnerSalts(burnerInfos))
+	if err != nil {
+		return Command{}, err
+	}
+
+	return newBurnerBatchCommand(chainID, keyID, height, AxelarGatewayCommandLockNativeBatch, params, burnerInfos), nil
+}
+
+func validateBurnerBatch(burnerInfos []BurnerInfo) error {
+	if len(burnerInfos) == 0 {
+		return fmt.Errorf("no burners given")
+	}
+
+	if len(burnerInfos) > MaxBurnerBatchSize {
+		return fmt.Errorf("cannot sweep more than %d burners in one command", MaxBurnerBatchSize)
+	}
+
+	for _, burnerInfo := range burnerInfos {
+		if burnerInfo.Asset != burnerInfos[0].Asset {
+			return fmt.Errorf("burners of different assets %s and %s cannot be swept in one command", burnerInfos[0].Asset, burnerInfo.Asset)
+		}
+	}
+
+	return nil
+}
+
+func getBurnerSalts(burnerInfos []BurnerInfo) []common.Hash {
+	salts := make([]common.Hash, len(burnerInfos))
+	for i, burnerInfo := range burnerInfos {
+		salts[i] = common.Hash(burnerInfo.Salt)
+	}
+
+	return salts
+}
+
+// newBurnerBatchCommand derives the command ID from all burner salts, so the ID is unique as long as the batch contains a burner not swept before at this height.
+// The gas cost of a batch grows with each burner, but the command overhead is only paid once
+func newBurnerBatchCommand(chainID *big.Int, keyID tss.KeyID, height int64, command string, params []byte, burnerInfos []BurnerInfo) Command {
+	var idBytes []byte
+	for _, burnerInfo := range burnerInfos {
+		idBytes = append(idBytes, burnerInfo.Salt.Bytes()...)
+	}
+
+	heightBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(heightBytes, uint64(height))
+
+	return Command{
+		ID:         NewCommandID(append(idBytes, heightBytes...), chainID),
+		Command:    command,
+		Params:     params,
+		KeyID:      keyID,
+		MaxGasCost: uint32(burnerBatchBaseGasCost + burnerBatchPerBurnerGasCost*len(burnerInfos)),
+	}
+}
+
+// CreateUnlockNativeCommand creates a command to send native currency held by the gateway to the given address
+func CreateUnlockNativeCommand(keyID tss.KeyID, id CommandID, address common.Address, amount *big.Int) (Command, error) {
+	params, err := createUnlockNativeParams(address, amount)
+	if err != nil {
+		return Command{}, err
+	}
+
+	return Command{
+		ID:         id,
+		Command:    AxelarGatewayCommandUnlockNative,
+		Params:     params,
+		KeyID:      keyID,
+		MaxGasCost: unlockNativeMaxGasCost,
+	}, nil
+}
+
+// CreateRegisterTokenCommand creates a command to register the pre-existing token at the given address with the gateway
+func CreateRegisterTokenCommand(chainID *big.Int, keyID tss.KeyID, symbol string, tokenAddr common.Address) (Command, error) {
+	params, err := createRegisterTokenParams(symbol, tokenAddr)
+	if err != nil {
+		return Command{}, err
+	}
+
+	return Command{
+		ID:         NewCommandID([]byte(symbol), chainID),
+		Command:    AxelarGatewayCommandRegisterToken,
+		Params:     params,
+		KeyID:      keyID,
+		MaxGasCost: registerTokenMaxGasCost,
+	}, nil
+}
+
+// CreateUnlockTokenCommand creates a command to unlock external tokens held by the gateway to the given address
+func CreateUnlockTokenCommand(keyID tss.KeyID, id CommandID, symbol string, address common.Address, amount *big.Int) (Command, error) {
+	cmd, err := CreateMintTokenCommand(keyID, id, symbol, address, amount)
+	if err != nil {
+		return Command{}, err
+	}
+
+	cmd.Command = AxelarGatewayCommandUnlockToken
+	cmd.MaxGasCost = unlockTokenMaxGasCost
+	return cmd, nil
+}
+
 // CreateDeployTokenCommand creates a command to deploy a token
 func CreateDeployTokenCommand(chainID *big.Int, keyID tss.KeyID, tokenDetails TokenDetails) (Command, error) {
 	params, err := createDeployTokenParams(tokenDetails.TokenName, tokenDetails.Symbol, tokenDetails.Decimals, tokenDetails.Capacity.BigInt())
@@ -546,6 +807,22 @@ func createTransferCmd(id CommandID, params []byte, keyID tss.KeyID, transferTyp
 	}
 }
 
+// CreateUpgradeCommand creates a command to upgrade the implementation behind the gateway proxy
+func CreateUpgradeCommand(chainID *big.Int, keyID tss.KeyID, newImplementation common.Address, newImplementationCodeHash common.Hash, setupParams []byte) (Command, error) {
+	params, err := createUpgradeParams(newImplementation, newImplementationCodeHash, setupParams)
+	if err != nil {
+		return Command{}, err
+	}
+
+	return Command{
+		ID:         NewCommandID(append(newImplementation.Bytes(), newImplementationCodeHash.Bytes()...), chainID),
+		Command:    AxelarGatewayCommandUpgrade,
+		Params:     params,
+		KeyID:      keyID,
+		MaxGasCost: upgradeMaxGasCost,
+	}, nil
+}
+
 // GetSinglesigGatewayDeploymentBytecode returns the deployment bytecode for the singlesig gateway contract
 func GetSinglesigGatewayDeploymentBytecode(contractBytecode []byte, admins []common.Address, threshold uint8, owner common.Address, operator common.Address) ([]byte, error) {
 	if len(contractBytecode) == 0 {
@@ -737,6 +1014,79 @@ func (b *CommandBatch) SetStatus(status BatchedCommandsStatus) bool {
 	return false
 }
 
+// GetSignedAtHeight returns the block height at which the batch was signed
+func (b CommandBatch) GetSignedAtHeight() int64 {
+	return b.metadata.SignedAtHeight
+}
+
+// SetSignedAtHeight records the block height at which the batch was signed
+func (b *CommandBatch) SetSignedAtHeight(height int64) {
+	b.metadata.SignedAtHeight = height
+	b.setter(b.metadata)
+}
+
+// GetExecutions returns the recorded executions of the batch's commands
+func (b CommandBatch) GetExecutions() []CommandExecution {
+	return b.metadata.Executions
+}
+
+// IsCommandExecuted returns true if the execution of the given command has been recorded
+func (b CommandBatch) IsCommandExecuted(commandID CommandID) bool {
+	for _, execution := range b.metadata.Executions {
+		if execution.CommandID == commandID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsExecuted returns true if the execution of all of the batch's commands has been recorded
+func (b CommandBatch) IsExecuted() bool {
+	for _, commandID := range b.metadata.CommandIDs {
+		if !b.IsCommandExecuted(commandID) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsExecutionOverdue returns true if the batch has been signed but not fully executed within the given timeout
+func (b CommandBatch) IsExecutionOverdue(height int64, timeout int64) bool {
+	if !b.Is(BatchSigned) || b.metadata.SignedAtHeight == 0 || timeout <= 0 {
+		return false
+	}
+
+	return height >= b.metadata.SignedAtHeight+timeout && !b.IsExecuted()
+}
+
+// SetCommandsExecuted records the execution of the given commands in the given transaction.
+// Commands that are not part of the batch or whose execution has already been recorded are ignored.
+// Returns the IDs of the commands whose execution was newly recorded
+func (b *CommandBatch) SetCommandsExecuted(txID Hash, height int64, commandIDs ...CommandID) []CommandID {
+	inBatch := make(map[CommandID]bool)
+	for _, commandID := range b.metadata.CommandIDs {
+		inBatch[commandID] = true
+	}
+
+	var recorded []CommandID
+	for _, commandID := range commandIDs {
+		if !inBatch[commandID] || b.IsCommandExecuted(commandID) {
+			continue
+		}
+
+		b.metadata.Executions = append(b.metadata.Executions, CommandExecution{CommandID: commandID, TxID: txID, Height: height})
+		recorded = append(recorded, commandID)
+	}
+
+	if len(recorded) > 0 {
+		b.setter(b.metadata)
+	}
+
+	return recorded
+}
+
 // NewCommandBatchMetadata assembles a CommandBatchMetadata struct from the provided arguments
 func NewCommandBatchMetadata(chainID *big.Int, keyID tss.KeyID, cmds []Command) (CommandBatchMetadata, error) {
 	var commandIDs []CommandID
@@ -1049,6 +1399,47 @@ func DecodeDeployTokenParams(bz []byte) (string, string, uint8, *big.Int, error)
 	return params[0].(string), params[1].(string), params[2].(uint8), params[3].(*big.Int), nil
 }
 
+func createRegisterTokenParams(symbol string, tokenAddr common.Address) ([]byte, error) {
+	stringType, err := abi.NewType("string", "string", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	addressType, err := abi.NewType("address", "address", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	arguments := abi.Arguments{{Type: stringType}, {Type: addressType}}
+	result, err := arguments.Pack(symbol, tokenAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DecodeRegisterTokenParams unpacks the parameters of a register external token command
+func DecodeRegisterTokenParams(bz []byte) (string, common.Address, error) {
+	stringType, err := abi.NewType("string", "string", nil)
+	if err != nil {
+		return "", common.Address{}, err
+	}
+
+	addressType, err := abi.NewType("address", "address", nil)
+	if err != nil {
+		return "", common.Address{}, err
+	}
+
+	arguments := abi.Arguments{{Type: stringType}, {Type: addressType}}
+	params, err := arguments.Unpack(bz)
+	if err != nil {
+		return "", common.Address{}, err
+	}
+
+	return params[0].(string), params[1].(common.Address), nil
+}
+
 func createBurnTokenParams(symbol string, salt common.Hash) ([]byte, error) {
 	stringType, err := abi.NewType("string", "string", nil)
 	if err != nil {
@@ -1090,6 +1481,219 @@ func DecodeBurnTokenParams(bz []byte) (string, common.Hash, error) {
 	return params[0].(string), params[1].([common.HashLength]byte), nil
 }
 
+func createLockNativeParams(salt common.Hash) ([]byte, error) {
+	bytes32Type, err := abi.NewType("bytes32", "bytes32", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	arguments := abi.Arguments{{Type: bytes32Type}}
+	result, err := arguments.Pack(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DecodeLockNativeParams unpacks the parameters of a lock native command
+func DecodeLockNativeParams(bz []byte) (common.Hash, error) {
+	bytes32Type, err := abi.NewType("bytes32", "bytes32", nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	arguments := abi.Arguments{{Type: bytes32Type}}
+	params, err := arguments.Unpack(bz)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return params[0].([common.HashLength]byte), nil
+}
+
+func createBurnTokenBatchParams(symbol string, salts []common.Hash) ([]byte, error) {
+	stringType, err := abi.NewType("string", "string", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	bytes32ArrayType, err := abi.NewType("bytes32[]", "bytes32[]", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	arguments := abi.Arguments{{Type: stringType}, {Type: bytes32ArrayType}}
+	result, err := arguments.Pack(symbol, hashesToBytes32s(salts))
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DecodeBurnTokenBatchParams unpacks the parameters of a batched burn token command
+func DecodeBurnTokenBatchParams(bz []byte) (string, []common.Hash, error) {
+	stringType, err := abi.NewType("string", "string", nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	bytes32ArrayType, err := abi.NewType("bytes32[]", "bytes32[]", nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	arguments := abi.Arguments{{Type: stringType}, {Type: bytes32ArrayType}}
+	params, err := arguments.Unpack(bz)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return params[0].(string), bytes32sToHashes(params[1].([][common.HashLength]byte)), nil
+}
+
+func createLockNativeBatchParams(salts []common.Hash) ([]byte, error) {
+	bytes32ArrayType, err := abi.NewType("bytes32[]", "bytes32[]", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	arguments := abi.Arguments{{Type: bytes32ArrayType}}
+	result, err := arguments.Pack(hashesToBytes32s(salts))
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DecodeLockNativeBatchParams unpacks the parameters of a batched lock native command
+func DecodeLockNativeBatchParams(bz []byte) ([]common.Hash, error) {
+	bytes32ArrayType, err := abi.NewType("bytes32[]", "bytes32[]", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	arguments := abi.Arguments{{Type: bytes32ArrayType}}
+	params, err := arguments.Unpack(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes32sToHashes(params[0].([][common.HashLength]byte)), nil
+}
+
+func hashesToBytes32s(hashes []common.Hash) [][common.HashLength]byte {
+	result := make([][common.HashLength]byte, len(hashes))
+	for i, hash := range hashes {
+		result[i] = hash
+	}
+
+	return result
+}
+
+func bytes32sToHashes(bz [][common.HashLength]byte) []common.Hash {
+	result := make([]common.Hash, len(bz))
+	for i, b := range bz {
+		result[i] = b
+	}
+
+	return result
+}
+
+func createUnlockNativeParams(address common.Address, amount *big.Int) ([]byte, error) {
+	addressType, err := abi.NewType("address", "address", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	uint256Type, err := abi.NewType("uint256", "uint256", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	arguments := abi.Arguments{{Type: addressType}, {Type: uint256Type}}
+	result, err := arguments.Pack(address, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DecodeUnlockNativeParams unpacks the parameters of an unlock native command
+func DecodeUnlockNativeParams(bz []byte) (common.Address, *big.Int, error) {
+	addressType, err := abi.NewType("address", "address", nil)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	uint256Type, err := abi.NewType("uint256", "uint256", nil)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	arguments := abi.Arguments{{Type: addressType}, {Type: uint256Type}}
+	params, err := arguments.Unpack(bz)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	return params[0].(common.Address), params[1].(*big.Int), nil
+}
+
+func createUpgradeParams(newImplementation common.Address, newImplementationCodeHash common.Hash, setupParams []byte) ([]byte, error) {
+	addressType, err := abi.NewType("address", "address", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	bytes32Type, err := abi.NewType("bytes32", "bytes32", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	bytesType, err := abi.NewType("bytes", "bytes", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	arguments := abi.Arguments{{Type: addressType}, {Type: bytes32Type}, {Type: bytesType}}
+	result, err := arguments.Pack(newImplementation, newImplementationCodeHash, setupParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DecodeUpgradeParams unpacks the parameters of an upgrade command
+func DecodeUpgradeParams(bz []byte) (common.Address, common.Hash, []byte, error) {
+	addressType, err := abi.NewType("address", "address", nil)
+	if err != nil {
+		return common.Address{}, common.Hash{}, nil, err
+	}
+
+	bytes32Type, err := abi.NewType("bytes32", "bytes32", nil)
+	if err != nil {
+		return common.Address{}, common.Hash{}, nil, err
+	}
+
+	bytesType, err := abi.NewType("bytes", "bytes", nil)
+	if err != nil {
+		return common.Address{}, common.Hash{}, nil, err
+	}
+
+	arguments := abi.Arguments{{Type: addressType}, {Type: bytes32Type}, {Type: bytesType}}
+	params, err := arguments.Unpack(bz)
+	if err != nil {
+		return common.Address{}, common.Hash{}, nil, err
+	}
+
+	return params[0].(common.Address), params[1].([common.HashLength]byte), params[2].([]byte), nil
+}
+
 func createTransferSinglesigParams(addr common.Address) ([]byte, error) {
 	addressType, err := abi.NewType("address", "address", nil)
 	if err != nil {
@@ -1162,6 +1766,16 @@ func DecodeTransferMultisigParams(bz []byte) ([]common.Address, uint8, error) {
 	return params[0].([]common.Address), uint8(params[1].(*big.Int).Uint64()), nil
 }
 
+// IsNative returns true if the burner is a deposit address for the chain's native currency rather than for a token
+func (m *BurnerInfo) IsNative() bool {
+	return m.TokenAddress == Address{}
+}
+
+// GetNativeBurnerCode returns the init code of deposit addresses for a chain's native currency
+func GetNativeBurnerCode() []byte {
+	return common.FromHex(nativeBurner)
+}
+
 // ValidateBasic does stateless validation of the object
 func (m *BurnerInfo) ValidateBasic() error {
 	if m.DestinationChain == "" {
@@ -1214,3 +1828,40 @@ func (m *ERC20Deposit) ValidateBasic() error {
 
 	return nil
 }
+
+// Validate returns an error if the gas info is invalid
+func (m GasInfo) Validate() error {
+	if m.BlockNumber == 0 {
+		return fmt.Errorf("block number must be >0")
+	}
+
+	if m.BaseFee.IsNil() || m.BaseFee.IsNegative() {
+		return fmt.Errorf("base fee must be >=0")
+	}
+
+	if m.PriorityFee.IsNil() || m.PriorityFee.IsNegative() {
+		return fmt.Errorf("priority fee must be >=0")
+	}
+
+	if m.BlockGasLimit == 0 {
+		return fmt.Errorf("block gas limit must be >0")
+	}
+
+	return nil
+}
+
+// GasPrice returns the expected gas price in wei, i.e. the sum of base fee and priority fee
+func (m GasInfo) GasPrice() sdk.Int {
+	return m.BaseFee.Add(m.PriorityFee)
+}
+
+// MaxGasPrice returns the maximum gas price in wei a transaction should be willing to pay,
+// allowing the base fee to double before the transaction is included
+func (m GasInfo) MaxGasPrice() sdk.Int {
+	return m.BaseFee.MulRaw(2).Add(m.PriorityFee)
+}
+
+// IsStale returns true if the gas info was confirmed more than maxAge blocks before the given height
+func (m GasInfo) IsStale(height int64, maxAge int64) bool {
+	return height-m.ConfirmedAtHeight > maxAge
+}
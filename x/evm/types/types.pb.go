@@ -154,6 +154,9 @@ const (
 	DepositStatus_Pending   DepositStatus = 1
 	DepositStatus_Confirmed DepositStatus = 2
 	DepositStatus_Burned    DepositStatus = 3
+	// the deposit was confirmed, but its transaction was later found to be no
+	// longer part of the canonical chain
+	DepositStatus_Reorged DepositStatus = 4
 )
 
 var DepositStatus_name = map[int32]string{
@@ -161,6 +164,7 @@ var DepositStatus_name = map[int32]string{
 	1: "DEPOSIT_STATUS_PENDING",
 	2: "DEPOSIT_STATUS_CONFIRMED",
 	3: "DEPOSIT_STATUS_BURNED",
+	4: "DEPOSIT_STATUS_REORGED",
 }
 
 var DepositStatus_value = map[string]int32{
@@ -168,6 +172,7 @@ var DepositStatus_value = map[string]int32{
 	"DEPOSIT_STATUS_PENDING":     1,
 	"DEPOSIT_STATUS_CONFIRMED":   2,
 	"DEPOSIT_STATUS_BURNED":      3,
+	"DEPOSIT_STATUS_REORGED":     4,
 }
 
 func (x DepositStatus) String() string {
@@ -178,6 +183,41 @@ func (DepositStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_af2cf809b4baed32, []int{4}
 }
 
+// FinalityMode determines when a block of an EVM chain is considered final
+type FinalityMode int32
+
+const (
+	FinalityUnspecified FinalityMode = 0
+	// a block is final once it is buried under confirmation_height blocks
+	FinalityDepth FinalityMode = 1
+	// a block is final once it is at or below the block tagged as finalized
+	FinalityFinalized FinalityMode = 2
+	// a block is final once it is at or below the block tagged as safe
+	FinalitySafe FinalityMode = 3
+)
+
+var FinalityMode_name = map[int32]string{
+	0: "FINALITY_MODE_UNSPECIFIED",
+	1: "FINALITY_MODE_DEPTH",
+	2: "FINALITY_MODE_FINALIZED",
+	3: "FINALITY_MODE_SAFE",
+}
+
+var FinalityMode_value = map[string]int32{
+	"FINALITY_MODE_UNSPECIFIED": 0,
+	"FINALITY_MODE_DEPTH":       1,
+	"FINALITY_MODE_FINALIZED":   2,
+	"FINALITY_MODE_SAFE":        3,
+}
+
+func (x FinalityMode) String() string {
+	return proto.EnumName(FinalityMode_name, int32(x))
+}
+
+func (FinalityMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_af2cf809b4baed32, []int{5}
+}
+
 type Gateway_Status int32
 
 const (
@@ -203,7 +243,7 @@ func (x Gateway_Status) String() string {
 }
 
 func (Gateway_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_af2cf809b4baed32, []int{11, 0}
+	return fileDescriptor_af2cf809b4baed32, []int{14, 0}
 }
 
 // NetworkInfo describes information about a network
@@ -340,6 +380,9 @@ type ERC20TokenMetadata struct {
 	TxHash       Hash                                   `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3,customtype=Hash" json:"tx_hash"`
 	MinAmount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=min_amount,json=minAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_amount"`
 	Status       Status                                 `protobuf:"varint,7,opt,name=status,proto3,enum=evm.v1beta1.Status" json:"status,omitempty"`
+	// true if the token is a pre-existing contract that the gateway locks and
+	// unlocks instead of an Axelar-minted token
+	IsExternal bool `protobuf:"varint,8,opt,name=is_external,json=isExternal,proto3" json:"is_external,omitempty"`
 }
 
 func (m *ERC20TokenMetadata) Reset()         { *m = ERC20TokenMetadata{} }
@@ -462,6 +505,8 @@ type CommandBatchMetadata struct {
 	Status                BatchedCommandsStatus                                     `protobuf:"varint,5,opt,name=status,proto3,enum=evm.v1beta1.BatchedCommandsStatus" json:"status,omitempty"`
 	KeyID                 github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,6,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
 	PrevBatchedCommandsID []byte                                                    `protobuf:"bytes,7,opt,name=prev_batched_commands_id,json=prevBatchedCommandsId,proto3" json:"prev_batched_commands_id,omitempty"`
+	SignedAtHeight        int64                                                     `protobuf:"varint,8,opt,name=signed_at_height,json=signedAtHeight,proto3" json:"signed_at_height,omitempty"`
+	Executions            []CommandExecution                                        `protobuf:"bytes,9,rep,name=executions,proto3" json:"executions"`
 }
 
 func (m *CommandBatchMetadata) Reset()         { *m = CommandBatchMetadata{} }
@@ -497,6 +542,122 @@ func (m *CommandBatchMetadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommandBatchMetadata proto.InternalMessageInfo
 
+// CommandExecution records the gateway transaction that executed a command
+type CommandExecution struct {
+	CommandID CommandID `protobuf:"bytes,1,opt,name=command_id,json=commandId,proto3,customtype=CommandID" json:"command_id"`
+	TxID      Hash      `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3,customtype=Hash" json:"tx_id"`
+	Height    int64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *CommandExecution) Reset()         { *m = CommandExecution{} }
+func (m *CommandExecution) String() string { return proto.CompactTextString(m) }
+func (*CommandExecution) ProtoMessage()    {}
+func (*CommandExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2cf809b4baed32, []int{7}
+}
+func (m *CommandExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommandExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommandExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommandExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommandExecution.Merge(m, src)
+}
+func (m *CommandExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommandExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommandExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommandExecution proto.InternalMessageInfo
+
+type PendingBatchExecution struct {
+	BatchID []byte `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	TxID    Hash   `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3,customtype=Hash" json:"tx_id"`
+}
+
+func (m *PendingBatchExecution) Reset()         { *m = PendingBatchExecution{} }
+func (m *PendingBatchExecution) String() string { return proto.CompactTextString(m) }
+func (*PendingBatchExecution) ProtoMessage()    {}
+func (*PendingBatchExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2cf809b4baed32, []int{8}
+}
+func (m *PendingBatchExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingBatchExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingBatchExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingBatchExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingBatchExecution.Merge(m, src)
+}
+func (m *PendingBatchExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingBatchExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingBatchExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingBatchExecution proto.InternalMessageInfo
+
+// ExecutedCommands is the vote value of a batch execution poll
+type ExecutedCommands struct {
+	CommandIDs []CommandID `protobuf:"bytes,1,rep,name=command_ids,json=commandIds,proto3,customtype=CommandID" json:"command_ids"`
+}
+
+func (m *ExecutedCommands) Reset()         { *m = ExecutedCommands{} }
+func (m *ExecutedCommands) String() string { return proto.CompactTextString(m) }
+func (*ExecutedCommands) ProtoMessage()    {}
+func (*ExecutedCommands) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2cf809b4baed32, []int{9}
+}
+func (m *ExecutedCommands) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutedCommands) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutedCommands.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutedCommands) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutedCommands.Merge(m, src)
+}
+func (m *ExecutedCommands) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutedCommands) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutedCommands.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutedCommands proto.InternalMessageInfo
+
 // SigMetadata stores necessary information for external apps to map signature
 // results to evm relay transaction types
 type SigMetadata struct {
@@ -508,7 +669,7 @@ func (m *SigMetadata) Reset()         { *m = SigMetadata{} }
 func (m *SigMetadata) String() string { return proto.CompactTextString(m) }
 func (*SigMetadata) ProtoMessage()    {}
 func (*SigMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af2cf809b4baed32, []int{7}
+	return fileDescriptor_af2cf809b4baed32, []int{10}
 }
 func (m *SigMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -548,7 +709,7 @@ func (m *TransferKey) Reset()         { *m = TransferKey{} }
 func (m *TransferKey) String() string { return proto.CompactTextString(m) }
 func (*TransferKey) ProtoMessage()    {}
 func (*TransferKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af2cf809b4baed32, []int{8}
+	return fileDescriptor_af2cf809b4baed32, []int{11}
 }
 func (m *TransferKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -586,7 +747,7 @@ func (m *Asset) Reset()         { *m = Asset{} }
 func (m *Asset) String() string { return proto.CompactTextString(m) }
 func (*Asset) ProtoMessage()    {}
 func (*Asset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af2cf809b4baed32, []int{9}
+	return fileDescriptor_af2cf809b4baed32, []int{12}
 }
 func (m *Asset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -626,7 +787,7 @@ func (m *TokenDetails) Reset()         { *m = TokenDetails{} }
 func (m *TokenDetails) String() string { return proto.CompactTextString(m) }
 func (*TokenDetails) ProtoMessage()    {}
 func (*TokenDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af2cf809b4baed32, []int{10}
+	return fileDescriptor_af2cf809b4baed32, []int{13}
 }
 func (m *TokenDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -658,13 +819,21 @@ var xxx_messageInfo_TokenDetails proto.InternalMessageInfo
 type Gateway struct {
 	Address Address        `protobuf:"bytes,1,opt,name=address,proto3,customtype=Address" json:"address"`
 	Status  Gateway_Status `protobuf:"varint,2,opt,name=status,proto3,enum=evm.v1beta1.Gateway_Status" json:"status,omitempty"`
+	// implementation behind the gateway proxy, set once an upgrade has been
+	// confirmed
+	Implementation Address `protobuf:"bytes,3,opt,name=implementation,proto3,customtype=Address" json:"implementation"`
+	// implementation the gateway is being upgraded to, zero if no upgrade is
+	// in progress
+	PendingImplementation Address `protobuf:"bytes,4,opt,name=pending_implementation,json=pendingImplementation,proto3,customtype=Address" json:"pending_implementation"`
+	// commands the pending implementation is able to execute
+	PendingCommands []string `protobuf:"bytes,5,rep,name=pending_commands,json=pendingCommands,proto3" json:"pending_commands,omitempty"`
 }
 
 func (m *Gateway) Reset()         { *m = Gateway{} }
 func (m *Gateway) String() string { return proto.CompactTextString(m) }
 func (*Gateway) ProtoMessage()    {}
 func (*Gateway) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af2cf809b4baed32, []int{11}
+	return fileDescriptor_af2cf809b4baed32, []int{14}
 }
 func (m *Gateway) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -693,12 +862,58 @@ func (m *Gateway) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Gateway proto.InternalMessageInfo
 
+// GasInfo describes the gas market of an EVM chain as observed at a block
+type GasInfo struct {
+	BlockNumber uint64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// base fee per gas in wei, zero if the chain does not support EIP-1559
+	BaseFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_fee"`
+	// median priority fee per gas in wei paid by the transactions of the block
+	PriorityFee   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=priority_fee,json=priorityFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"priority_fee"`
+	BlockGasLimit uint64                                 `protobuf:"varint,4,opt,name=block_gas_limit,json=blockGasLimit,proto3" json:"block_gas_limit,omitempty"`
+	// axelar block height at which the gas info was confirmed
+	ConfirmedAtHeight int64 `protobuf:"varint,5,opt,name=confirmed_at_height,json=confirmedAtHeight,proto3" json:"confirmed_at_height,omitempty"`
+}
+
+func (m *GasInfo) Reset()         { *m = GasInfo{} }
+func (m *GasInfo) String() string { return proto.CompactTextString(m) }
+func (*GasInfo) ProtoMessage()    {}
+func (*GasInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2cf809b4baed32, []int{15}
+}
+func (m *GasInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasInfo.Merge(m, src)
+}
+func (m *GasInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasInfo proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("evm.v1beta1.Status", Status_name, Status_value)
 	proto.RegisterEnum("evm.v1beta1.BatchedCommandsStatus", BatchedCommandsStatus_name, BatchedCommandsStatus_value)
 	proto.RegisterEnum("evm.v1beta1.TransferKeyType", TransferKeyType_name, TransferKeyType_value)
 	proto.RegisterEnum("evm.v1beta1.SigType", SigType_name, SigType_value)
 	proto.RegisterEnum("evm.v1beta1.DepositStatus", DepositStatus_name, DepositStatus_value)
+	proto.RegisterEnum("evm.v1beta1.FinalityMode", FinalityMode_name, FinalityMode_value)
 	proto.RegisterEnum("evm.v1beta1.Gateway_Status", Gateway_Status_name, Gateway_Status_value)
 	proto.RegisterType((*NetworkInfo)(nil), "evm.v1beta1.NetworkInfo")
 	proto.RegisterType((*BurnerInfo)(nil), "evm.v1beta1.BurnerInfo")
@@ -707,118 +922,149 @@ func init() {
 	proto.RegisterType((*TransactionMetadata)(nil), "evm.v1beta1.TransactionMetadata")
 	proto.RegisterType((*Command)(nil), "evm.v1beta1.Command")
 	proto.RegisterType((*CommandBatchMetadata)(nil), "evm.v1beta1.CommandBatchMetadata")
+	proto.RegisterType((*CommandExecution)(nil), "evm.v1beta1.CommandExecution")
+	proto.RegisterType((*PendingBatchExecution)(nil), "evm.v1beta1.PendingBatchExecution")
+	proto.RegisterType((*ExecutedCommands)(nil), "evm.v1beta1.ExecutedCommands")
 	proto.RegisterType((*SigMetadata)(nil), "evm.v1beta1.SigMetadata")
 	proto.RegisterType((*TransferKey)(nil), "evm.v1beta1.TransferKey")
 	proto.RegisterType((*Asset)(nil), "evm.v1beta1.Asset")
 	proto.RegisterType((*TokenDetails)(nil), "evm.v1beta1.TokenDetails")
 	proto.RegisterType((*Gateway)(nil), "evm.v1beta1.Gateway")
+	proto.RegisterType((*GasInfo)(nil), "evm.v1beta1.GasInfo")
 }
 
 func init() { proto.RegisterFile("evm/v1beta1/types.proto", fileDescriptor_af2cf809b4baed32) }
 
 var fileDescriptor_af2cf809b4baed32 = []byte{
-	// 1607 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6f, 0xe3, 0x58,
-	0x15, 0xaf, 0xd3, 0x7c, 0x34, 0x27, 0x69, 0x27, 0x7b, 0xb7, 0x9d, 0xc9, 0x7a, 0xd9, 0xc4, 0xca,
-	0x32, 0xb4, 0xec, 0x30, 0xed, 0x7c, 0x2c, 0x88, 0x41, 0x02, 0x91, 0xc4, 0x99, 0x8e, 0x77, 0x68,
-	0x1a, 0x39, 0xae, 0xd8, 0x5d, 0x09, 0x59, 0x37, 0xf1, 0x9d, 0xd4, 0xb4, 0xb6, 0x23, 0xdf, 0x9b,
-	0xd6, 0xe1, 0x2f, 0x40, 0x91, 0x90, 0x78, 0xe0, 0x91, 0x3c, 0x20, 0x78, 0x40, 0xfc, 0x05, 0x3c,
-	0xee, 0xe3, 0x3c, 0xee, 0x13, 0x42, 0x3c, 0x44, 0x90, 0x11, 0x7f, 0x03, 0xd2, 0x4a, 0x08, 0xe4,
-	0xeb, 0xdb, 0xc4, 0x49, 0x33, 0x5f, 0x88, 0x7d, 0xb2, 0xef, 0xbd, 0xe7, 0xeb, 0x77, 0xce, 0xef,
-	0x1c, 0x5f, 0xc3, 0x2d, 0x72, 0xe1, 0x1c, 0x5c, 0xdc, 0xef, 0x10, 0x86, 0xef, 0x1f, 0xb0, 0x61,
-	0x9f, 0xd0, 0xfd, 0xbe, 0xef, 0x31, 0x0f, 0xe5, 0xc8, 0x85, 0xb3, 0x2f, 0x0e, 0xe4, 0xed, 0x9e,
-	0xd7, 0xf3, 0xf8, 0xfe, 0x41, 0xf8, 0x16, 0x89, 0xc8, 0x15, 0x97, 0x04, 0x03, 0x7a, 0x40, 0x82,
-	0xbe, 0xe7, 0x33, 0x62, 0xad, 0x32, 0x23, 0x2b, 0x8c, 0xbe, 0x5a, 0xa2, 0x82, 0x21, 0xd7, 0x24,
-	0xec, 0xd2, 0xf3, 0xcf, 0x34, 0xf7, 0x99, 0x87, 0x10, 0x24, 0x5d, 0xec, 0x90, 0xa2, 0xa4, 0x48,
-	0x7b, 0x59, 0x9d, 0xbf, 0xa3, 0x1f, 0x41, 0xc2, 0xb6, 0x8a, 0x09, 0x45, 0xda, 0xcb, 0xd7, 0xf6,
-	0x9f, 0x4f, 0xca, 0x6b, 0x7f, 0x9b, 0x94, 0xbf, 0xd5, 0xb3, 0xd9, 0xe9, 0xa0, 0xb3, 0xdf, 0xf5,
-	0x9c, 0x83, 0xae, 0x47, 0x1d, 0x8f, 0x8a, 0xc7, 0x5d, 0x6a, 0x9d, 0x09, 0x07, 0x9a, 0xcb, 0xf4,
-	0x84, 0x6d, 0x55, 0xfe, 0x25, 0x01, 0xd4, 0x06, 0xbe, 0x4b, 0x7c, 0xee, 0xe2, 0x7b, 0xb0, 0xd5,
-	0xe1, 0x2b, 0x13, 0x5b, 0x96, 0x4f, 0x28, 0xe5, 0xce, 0xf2, 0xb5, 0x1b, 0xc2, 0x74, 0xa6, 0x1a,
-	0x6d, 0xeb, 0x9b, 0x91, 0x98, 0x58, 0xa2, 0x8f, 0x61, 0x93, 0x79, 0x67, 0xc4, 0x9d, 0xa9, 0x25,
-	0x56, 0xab, 0xe5, 0xb9, 0xd4, 0x95, 0xd6, 0x1d, 0x78, 0xc7, 0x22, 0x94, 0xd9, 0x2e, 0x66, 0xb6,
-	0xe7, 0x9a, 0xdd, 0x53, 0x6c, 0xbb, 0xc5, 0x75, 0x8e, 0xae, 0x10, 0x3b, 0xa8, 0x87, 0xfb, 0xe8,
-	0x26, 0xa4, 0xe9, 0xd0, 0xe9, 0x78, 0xe7, 0xc5, 0x24, 0x97, 0x10, 0x2b, 0xb4, 0x0d, 0x29, 0x4c,
-	0x29, 0x61, 0xc5, 0x14, 0xdf, 0x8e, 0x16, 0x48, 0x81, 0x24, 0xc5, 0xe7, 0xac, 0x98, 0xe6, 0x71,
-	0xe4, 0x45, 0x1c, 0xc9, 0x27, 0x98, 0x9e, 0xea, 0xfc, 0xa4, 0xf2, 0x6f, 0x09, 0xf2, 0x0d, 0xbd,
-	0xfe, 0xe0, 0x9e, 0x4a, 0xfa, 0x1e, 0xb5, 0x19, 0xfa, 0x36, 0xa4, 0x58, 0x60, 0xda, 0x96, 0x80,
-	0xbc, 0x1d, 0xd7, 0x99, 0x4e, 0xca, 0x49, 0x23, 0xd0, 0x54, 0x3d, 0xc9, 0x02, 0xcd, 0x42, 0x87,
-	0x90, 0xc6, 0x8e, 0x37, 0x70, 0x99, 0xc0, 0x79, 0x20, 0x64, 0x77, 0xdf, 0x20, 0xf3, 0x27, 0xb6,
-	0xcb, 0x74, 0xa1, 0x3e, 0x0f, 0x7e, 0x3d, 0x1e, 0xfc, 0xca, 0xbc, 0x24, 0x5f, 0x92, 0x97, 0xeb,
-	0x25, 0x4b, 0xbd, 0x49, 0xc9, 0x2a, 0xbf, 0x59, 0x07, 0xc4, 0xf1, 0x1b, 0x61, 0x49, 0x8e, 0x08,
-	0xc3, 0x16, 0x66, 0x78, 0x1e, 0x91, 0x14, 0x8f, 0xc8, 0x80, 0x0d, 0x1e, 0x85, 0x39, 0x23, 0xdb,
-	0xa3, 0xb7, 0x23, 0xdb, 0x74, 0x52, 0xce, 0xf0, 0x78, 0x35, 0x55, 0xcf, 0x70, 0x53, 0x9a, 0x85,
-	0x1e, 0x41, 0xc6, 0x22, 0x0c, 0xdb, 0xe7, 0x94, 0xe3, 0xcf, 0x3d, 0x78, 0x6f, 0x3f, 0xd6, 0x5a,
-	0xfb, 0x3c, 0x30, 0x35, 0x12, 0xa8, 0x25, 0x43, 0x7f, 0xfa, 0x95, 0xfc, 0x75, 0xc2, 0xf1, 0xf4,
-	0xbc, 0x8e, 0x70, 0xb7, 0x21, 0xc3, 0x02, 0xf3, 0x14, 0xd3, 0xd3, 0x88, 0x2d, 0x4b, 0xc4, 0x48,
-	0xb3, 0x20, 0x7c, 0xa2, 0x23, 0x00, 0xc7, 0x76, 0x4d, 0x51, 0xe2, 0xf4, 0xff, 0xd4, 0x5c, 0x59,
-	0xc7, 0x76, 0xab, 0x51, 0x91, 0xef, 0x40, 0x9a, 0x32, 0xcc, 0x06, 0xb4, 0x98, 0x51, 0xa4, 0xbd,
-	0xad, 0x07, 0xef, 0x2e, 0xa0, 0x6c, 0xf3, 0x23, 0x5d, 0x88, 0x54, 0x5a, 0xf0, 0xae, 0xe1, 0x63,
-	0x97, 0xe2, 0x6e, 0x58, 0xe2, 0x59, 0x59, 0x14, 0x48, 0xfb, 0xf8, 0xd2, 0x64, 0x81, 0x60, 0x67,
-	0x76, 0x3a, 0x29, 0xa7, 0x74, 0x7c, 0x69, 0x7c, 0xaa, 0xa7, 0x7c, 0x7c, 0x69, 0x04, 0xe8, 0x16,
-	0x64, 0xfa, 0x83, 0x8e, 0x79, 0x46, 0x86, 0x51, 0x85, 0xf4, 0x74, 0x7f, 0xd0, 0x79, 0x4a, 0x86,
-	0x95, 0x7f, 0x4a, 0x90, 0xa9, 0x7b, 0x8e, 0x83, 0x5d, 0x0b, 0xed, 0xf2, 0x71, 0x11, 0x99, 0xb8,
-	0x25, 0x10, 0x65, 0xc5, 0xa1, 0xa6, 0x4e, 0x27, 0xe5, 0x84, 0xa6, 0x86, 0x73, 0x01, 0x15, 0x21,
-	0xd3, 0x8d, 0xb6, 0xb9, 0xb5, 0xac, 0x7e, 0xb5, 0x0c, 0xfb, 0xb0, 0x8f, 0x7d, 0xec, 0x44, 0x35,
-	0x0b, 0xdd, 0xf0, 0x15, 0xfa, 0x19, 0xa4, 0xcf, 0xc8, 0x30, 0x24, 0x48, 0x54, 0x8a, 0xc7, 0x61,
-	0x84, 0x4f, 0xc9, 0x50, 0x53, 0xbf, 0x9a, 0x94, 0x1f, 0xc5, 0xb2, 0x86, 0x03, 0x72, 0x8e, 0x7d,
-	0x37, 0x1a, 0x6c, 0x62, 0x75, 0xb7, 0xeb, 0xf9, 0xe4, 0x20, 0x38, 0x88, 0x8f, 0xc4, 0x7d, 0xae,
-	0xac, 0xa7, 0xce, 0xc8, 0x50, 0xb3, 0x90, 0x02, 0x79, 0x07, 0x07, 0x66, 0x0f, 0x53, 0xb3, 0xeb,
-	0xd1, 0xa8, 0xdb, 0x37, 0x75, 0x70, 0x70, 0x70, 0x88, 0x69, 0xdd, 0xa3, 0xac, 0xf2, 0xdb, 0x75,
-	0xd8, 0x16, 0x50, 0x6a, 0x98, 0x75, 0x4f, 0x67, 0xb9, 0xbb, 0x19, 0x03, 0x9d, 0x8e, 0x61, 0xfc,
-	0x31, 0xe4, 0x04, 0x28, 0xd3, 0xb6, 0xc2, 0x91, 0xb5, 0xbe, 0x97, 0xaf, 0x95, 0x57, 0x65, 0x05,
-	0x66, 0x0b, 0xaa, 0x83, 0xd0, 0xd1, 0x2c, 0x1a, 0x4e, 0xe4, 0xd0, 0x83, 0xc8, 0x04, 0x7f, 0x47,
-	0xbb, 0xb0, 0x41, 0xed, 0x5e, 0x44, 0xb2, 0xe4, 0x8a, 0xe9, 0x93, 0xa1, 0x76, 0x8f, 0xb3, 0xec,
-	0x07, 0x33, 0x5a, 0xa4, 0x38, 0x2d, 0x2a, 0x0b, 0xb4, 0xe0, 0x10, 0x88, 0x25, 0x1c, 0xd3, 0x45,
-	0x96, 0xc4, 0x92, 0x9d, 0xfe, 0x3a, 0x92, 0xad, 0x43, 0xb1, 0xef, 0x93, 0x0b, 0xb3, 0x13, 0x05,
-	0x61, 0x0a, 0xc8, 0x34, 0x74, 0x98, 0xe1, 0x98, 0xde, 0x9b, 0x4e, 0xca, 0x3b, 0x2d, 0x9f, 0x5c,
-	0x2c, 0xc5, 0xa9, 0xa9, 0xfa, 0x4e, 0x7f, 0xc5, 0xb6, 0x55, 0x39, 0x82, 0x5c, 0xdb, 0xee, 0xcd,
-	0x8a, 0xb2, 0x07, 0xc9, 0xb0, 0x59, 0x78, 0x59, 0xb6, 0x1e, 0x6c, 0x2f, 0xb6, 0x84, 0xdd, 0x33,
-	0x86, 0x7d, 0xa2, 0x73, 0x89, 0x70, 0x22, 0x45, 0x13, 0x30, 0x22, 0x62, 0xb4, 0xa8, 0xfc, 0x45,
-	0x82, 0x1c, 0x6f, 0x94, 0x67, 0xc4, 0x7f, 0x4a, 0x86, 0x6f, 0x33, 0xbd, 0xef, 0x09, 0xd7, 0x09,
-	0xee, 0xfa, 0x1b, 0x8b, 0x33, 0x67, 0x6e, 0x32, 0x16, 0xc2, 0xcf, 0x21, 0xe7, 0x92, 0x80, 0x99,
-	0x22, 0xe7, 0x7c, 0x58, 0xd7, 0x3e, 0x99, 0x4e, 0xca, 0xd9, 0x26, 0x09, 0xd8, 0xff, 0x21, 0xef,
-	0x59, 0x57, 0xd8, 0xb1, 0x2a, 0xf7, 0x21, 0x55, 0xe5, 0x33, 0x77, 0x86, 0x5b, 0x8a, 0xe1, 0x9e,
-	0x5d, 0x02, 0x12, 0xf3, 0x4b, 0x40, 0xe5, 0xcf, 0x12, 0xe4, 0xe3, 0xc3, 0x12, 0x7d, 0x00, 0x10,
-	0x4d, 0xc7, 0xd8, 0x7d, 0x21, 0xcb, 0x77, 0x9a, 0xe1, 0xa5, 0x61, 0xfe, 0x29, 0x4d, 0x2c, 0x7c,
-	0x4a, 0x6f, 0xc3, 0x86, 0x45, 0xba, 0xb6, 0x83, 0xc5, 0x40, 0xde, 0xac, 0x65, 0xbf, 0x9a, 0x94,
-	0x53, 0x03, 0xdb, 0x65, 0xdf, 0xd7, 0x67, 0x47, 0xe8, 0x13, 0xd8, 0xe8, 0xe2, 0x3e, 0xee, 0xda,
-	0x6c, 0x28, 0x18, 0xfe, 0xb6, 0xc3, 0x71, 0xa6, 0x5f, 0xf9, 0x55, 0x02, 0x32, 0x87, 0x98, 0x91,
-	0x4b, 0x1c, 0x96, 0x30, 0xf3, 0x9a, 0x5b, 0xc7, 0xd5, 0x39, 0x7a, 0x38, 0xeb, 0x9d, 0xa8, 0x88,
-	0xef, 0x2f, 0x14, 0x51, 0x18, 0x5c, 0x1e, 0xad, 0xbf, 0x93, 0x20, 0x1d, 0x6d, 0xa1, 0xbb, 0x80,
-	0xda, 0x46, 0xd5, 0x38, 0x69, 0x9b, 0x27, 0xcd, 0x76, 0xab, 0x51, 0xd7, 0x1e, 0x6b, 0x0d, 0xb5,
-	0xb0, 0x26, 0xef, 0x8c, 0xc6, 0xca, 0x3b, 0x42, 0x3d, 0x12, 0x6d, 0x7a, 0x2e, 0x41, 0xdf, 0x81,
-	0x2d, 0x21, 0xde, 0x6a, 0x34, 0x55, 0xad, 0x79, 0x58, 0x90, 0xe4, 0xe2, 0x68, 0xac, 0x6c, 0x2f,
-	0x88, 0xb6, 0x88, 0x6b, 0xd9, 0x6e, 0x0f, 0xdd, 0x83, 0x82, 0x90, 0xae, 0x1f, 0x37, 0x1f, 0x6b,
-	0xfa, 0x51, 0x43, 0x2d, 0x24, 0x64, 0x79, 0x34, 0x56, 0x6e, 0x2e, 0xc8, 0xd7, 0x3d, 0xf7, 0x99,
-	0xed, 0x3b, 0xc4, 0x92, 0x37, 0x7e, 0xf9, 0xfb, 0xd2, 0xda, 0x1f, 0xff, 0x50, 0x92, 0x3e, 0xfa,
-	0xd3, 0x3c, 0xc6, 0xdd, 0x97, 0xc4, 0x78, 0x63, 0x34, 0x56, 0x72, 0x4d, 0xcf, 0x6d, 0x04, 0x36,
-	0x65, 0xc4, 0x65, 0x31, 0x41, 0xad, 0xa9, 0x19, 0x5a, 0xf5, 0x27, 0xda, 0xe7, 0x0d, 0xb5, 0x20,
-	0x45, 0x82, 0x9a, 0x6b, 0x33, 0x1b, 0x9f, 0xdb, 0xbf, 0x20, 0x16, 0x2a, 0x5f, 0x83, 0x91, 0x90,
-	0x73, 0xa3, 0xb1, 0x92, 0xb9, 0x8a, 0xfc, 0xc3, 0x15, 0x91, 0x27, 0xe5, 0xcd, 0xd1, 0x58, 0xc9,
-	0xae, 0x0a, 0xf6, 0x3f, 0x12, 0xec, 0xac, 0x9c, 0x53, 0xe8, 0x87, 0xf0, 0x61, 0xad, 0x6a, 0xd4,
-	0x9f, 0x34, 0x54, 0xb3, 0x7e, 0x7c, 0x74, 0x54, 0x6d, 0xaa, 0x6d, 0x73, 0x25, 0x98, 0xed, 0xd1,
-	0x58, 0x29, 0x70, 0x1b, 0x71, 0x44, 0xdf, 0x85, 0xf2, 0xcb, 0xd4, 0xdb, 0xda, 0x61, 0x33, 0x2a,
-	0x40, 0x61, 0x34, 0x56, 0xf2, 0x5c, 0xb5, 0x6d, 0xf7, 0xdc, 0x30, 0xfc, 0x57, 0xa8, 0x55, 0x6b,
-	0xc7, 0xba, 0xc1, 0xeb, 0x30, 0x57, 0xab, 0x76, 0x78, 0x0b, 0xa2, 0x87, 0x50, 0x7a, 0x95, 0xb7,
-	0x86, 0x5a, 0x58, 0x8f, 0x72, 0x39, 0x73, 0x46, 0x2c, 0x39, 0x19, 0x66, 0xe1, 0xa3, 0x2f, 0x24,
-	0xb8, 0xb1, 0x34, 0x32, 0x50, 0x15, 0x3e, 0x30, 0xf4, 0x6a, 0xb3, 0xfd, 0xb8, 0xa1, 0x9b, 0x4f,
-	0x1b, 0x9f, 0x99, 0xc6, 0x67, 0xad, 0xc6, 0x12, 0xea, 0xd2, 0x68, 0xac, 0xc8, 0x27, 0x2e, 0xed,
-	0x93, 0xae, 0xfd, 0xcc, 0x26, 0xd6, 0xb2, 0x89, 0x7d, 0x78, 0xff, 0xba, 0x89, 0xe3, 0x9f, 0x36,
-	0x1b, 0x7a, 0xfb, 0x89, 0xd6, 0x2a, 0x48, 0x51, 0x49, 0x8e, 0x2f, 0x5d, 0xe2, 0xd3, 0x53, 0xbb,
-	0x8f, 0x3e, 0x86, 0xd2, 0x0a, 0xf9, 0x56, 0x43, 0xaf, 0x1a, 0xc7, 0x91, 0x8a, 0xc0, 0x7d, 0xdc,
-	0x27, 0x3e, 0x66, 0x1e, 0xd7, 0x12, 0x10, 0x86, 0x90, 0x11, 0xf3, 0x16, 0x55, 0x60, 0xbb, 0xad,
-	0x1d, 0xae, 0x0a, 0x78, 0x63, 0x34, 0x56, 0x92, 0xbc, 0x15, 0x64, 0xc8, 0xcd, 0x64, 0x8c, 0x4f,
-	0x0b, 0x92, 0x9c, 0x1d, 0x8d, 0x95, 0x54, 0x68, 0x21, 0x40, 0xdf, 0x84, 0xc2, 0xec, 0x4c, 0x64,
-	0xb2, 0x90, 0x90, 0xb7, 0x46, 0x63, 0x05, 0xda, 0x76, 0x4f, 0x50, 0x24, 0xc6, 0x9f, 0x2f, 0x24,
-	0xd8, 0x14, 0xb7, 0x6f, 0xc1, 0x9b, 0x3d, 0x90, 0xd5, 0x46, 0xeb, 0xb8, 0xad, 0x19, 0xab, 0xe9,
-	0x32, 0x8f, 0x63, 0x17, 0x6e, 0x2e, 0x49, 0xce, 0x5b, 0x73, 0x81, 0xd3, 0x77, 0xa0, 0xb8, 0x24,
-	0x18, 0xef, 0xca, 0x45, 0x6e, 0xa3, 0xdb, 0xb0, 0xb3, 0x24, 0x5c, 0x3b, 0xd1, 0x23, 0x06, 0xc0,
-	0x68, 0xac, 0xa4, 0xf9, 0xaf, 0x52, 0x04, 0x41, 0x0a, 0x21, 0xd4, 0x9a, 0xcf, 0xff, 0x51, 0x5a,
-	0x7b, 0x3e, 0x2d, 0x49, 0x5f, 0x4e, 0x4b, 0xd2, 0xdf, 0xa7, 0x25, 0xe9, 0xd7, 0x2f, 0x4a, 0x6b,
-	0x5f, 0xbe, 0x28, 0xad, 0xfd, 0xf5, 0x45, 0x69, 0xed, 0xf3, 0x7b, 0x6f, 0xf8, 0x45, 0x08, 0xff,
-	0x34, 0xf9, 0x74, 0xec, 0xa4, 0xf9, 0x9f, 0xdf, 0xc3, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x19,
-	0x7a, 0xc7, 0x69, 0x7d, 0x0e, 0x00, 0x00,
+	// 2039 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4b, 0x6f, 0x1b, 0xc9,
+	0x11, 0xd6, 0xf0, 0x29, 0x16, 0x29, 0x99, 0x6e, 0x4b, 0x32, 0xcd, 0x8d, 0xc9, 0x09, 0x37, 0xb6,
+	0xb9, 0x76, 0x2c, 0xd9, 0xf2, 0x66, 0x13, 0x07, 0xd8, 0x20, 0xa4, 0x48, 0xc9, 0xb3, 0xb6, 0x28,
+	0x65, 0x38, 0x46, 0xd6, 0x06, 0x82, 0x41, 0x93, 0xd3, 0xa2, 0x7a, 0xa5, 0x99, 0x21, 0xa6, 0x5b,
+	0x16, 0x99, 0x5f, 0x10, 0x30, 0x97, 0x1c, 0x72, 0xe5, 0x21, 0x8f, 0x43, 0x90, 0x7b, 0x80, 0xe4,
+	0x96, 0xa3, 0x8f, 0x7b, 0x0a, 0x82, 0x3d, 0x08, 0x89, 0x8c, 0x1c, 0xf2, 0x0b, 0x02, 0x2c, 0x10,
+	0x24, 0x98, 0x9e, 0x26, 0x39, 0xa4, 0xe9, 0x27, 0x92, 0x13, 0xd9, 0xd5, 0x5f, 0x55, 0xd7, 0xe3,
+	0xeb, 0xaa, 0x26, 0xe1, 0x32, 0x79, 0x66, 0x6f, 0x3c, 0xbb, 0xdb, 0x22, 0x1c, 0xdf, 0xdd, 0xe0,
+	0xfd, 0x2e, 0x61, 0xeb, 0x5d, 0xcf, 0xe5, 0x2e, 0x4a, 0x93, 0x67, 0xf6, 0xba, 0xdc, 0xc8, 0xaf,
+	0x74, 0xdc, 0x8e, 0x2b, 0xe4, 0x1b, 0xfe, 0xb7, 0x00, 0x92, 0x2f, 0x39, 0xa4, 0x77, 0xc2, 0x36,
+	0x48, 0xaf, 0xeb, 0x7a, 0x9c, 0x58, 0xf3, 0xcc, 0xe4, 0x55, 0xce, 0x5e, 0x8f, 0x28, 0x61, 0x48,
+	0x37, 0x08, 0x3f, 0x75, 0xbd, 0x23, 0xcd, 0x39, 0x70, 0x11, 0x82, 0x98, 0x83, 0x6d, 0x92, 0x53,
+	0x54, 0xa5, 0x9c, 0xd2, 0xc5, 0x77, 0xf4, 0x03, 0x88, 0x50, 0x2b, 0x17, 0x51, 0x95, 0x72, 0xa6,
+	0xba, 0xfe, 0xfc, 0xac, 0xb8, 0xf0, 0xd5, 0x59, 0xf1, 0x7a, 0x87, 0xf2, 0xc3, 0x93, 0xd6, 0x7a,
+	0xdb, 0xb5, 0x37, 0xda, 0x2e, 0xb3, 0x5d, 0x26, 0x3f, 0x6e, 0x33, 0xeb, 0x48, 0x1e, 0xa0, 0x39,
+	0x5c, 0x8f, 0x50, 0xab, 0xf4, 0x2f, 0x05, 0xa0, 0x7a, 0xe2, 0x39, 0xc4, 0x13, 0x47, 0x7c, 0x02,
+	0xcb, 0x2d, 0xb1, 0x32, 0xb1, 0x65, 0x79, 0x84, 0x31, 0x71, 0x58, 0xa6, 0x7a, 0x41, 0x9a, 0x4e,
+	0x56, 0x02, 0xb1, 0xbe, 0x14, 0xc0, 0xe4, 0x12, 0x7d, 0x0c, 0x4b, 0xdc, 0x3d, 0x22, 0xce, 0x58,
+	0x2d, 0x32, 0x5f, 0x2d, 0x23, 0x50, 0x23, 0xad, 0x5b, 0x70, 0xd1, 0x22, 0x8c, 0x53, 0x07, 0x73,
+	0xea, 0x3a, 0x66, 0xfb, 0x10, 0x53, 0x27, 0x17, 0x15, 0xd1, 0x65, 0x43, 0x1b, 0x5b, 0xbe, 0x1c,
+	0xad, 0x41, 0x82, 0xf5, 0xed, 0x96, 0x7b, 0x9c, 0x8b, 0x09, 0x84, 0x5c, 0xa1, 0x15, 0x88, 0x63,
+	0xc6, 0x08, 0xcf, 0xc5, 0x85, 0x38, 0x58, 0x20, 0x15, 0x62, 0x0c, 0x1f, 0xf3, 0x5c, 0x42, 0xf8,
+	0x91, 0x91, 0x7e, 0xc4, 0x1e, 0x60, 0x76, 0xa8, 0x8b, 0x9d, 0xd2, 0xbf, 0x15, 0xc8, 0xd4, 0xf5,
+	0xad, 0xcd, 0x3b, 0x35, 0xd2, 0x75, 0x19, 0xe5, 0xe8, 0x23, 0x88, 0xf3, 0x9e, 0x49, 0x2d, 0x19,
+	0xf2, 0x4a, 0x58, 0xe7, 0xfc, 0xac, 0x18, 0x33, 0x7a, 0x5a, 0x4d, 0x8f, 0xf1, 0x9e, 0x66, 0xa1,
+	0x1d, 0x48, 0x60, 0xdb, 0x3d, 0x71, 0xb8, 0x8c, 0x73, 0x43, 0x62, 0x6f, 0xbc, 0x45, 0xe6, 0x1f,
+	0x53, 0x87, 0xeb, 0x52, 0x7d, 0xe2, 0x7c, 0x34, 0xec, 0xfc, 0xdc, 0xbc, 0xc4, 0x5e, 0x91, 0x97,
+	0x97, 0x4b, 0x16, 0x7f, 0x9b, 0x92, 0x95, 0xfe, 0x10, 0x05, 0x24, 0xe2, 0x37, 0xfc, 0x92, 0xec,
+	0x12, 0x8e, 0x2d, 0xcc, 0xf1, 0xc4, 0x23, 0x25, 0xec, 0x91, 0x01, 0x8b, 0xc2, 0x0b, 0x73, 0x4c,
+	0xb6, 0xfb, 0xef, 0x46, 0xb6, 0xf3, 0xb3, 0x62, 0x52, 0xf8, 0xab, 0xd5, 0xf4, 0xa4, 0x30, 0xa5,
+	0x59, 0xe8, 0x3e, 0x24, 0x2d, 0xc2, 0x31, 0x3d, 0x66, 0x22, 0xfe, 0xf4, 0xe6, 0x95, 0xf5, 0xd0,
+	0xd5, 0x5a, 0x17, 0x8e, 0xd5, 0x02, 0x40, 0x35, 0xe6, 0x9f, 0xa7, 0x8f, 0xf0, 0x2f, 0x13, 0x4e,
+	0xa4, 0xe7, 0x4d, 0x84, 0xbb, 0x06, 0x49, 0xde, 0x33, 0x0f, 0x31, 0x3b, 0x0c, 0xd8, 0x32, 0x43,
+	0x8c, 0x04, 0xef, 0xf9, 0x9f, 0x68, 0x17, 0xc0, 0xa6, 0x8e, 0x29, 0x4b, 0x9c, 0x78, 0xaf, 0xcb,
+	0x95, 0xb2, 0xa9, 0x53, 0x09, 0x8a, 0x7c, 0x0b, 0x12, 0x8c, 0x63, 0x7e, 0xc2, 0x72, 0x49, 0x55,
+	0x29, 0x2f, 0x6f, 0x5e, 0x9a, 0x8a, 0xb2, 0x29, 0xb6, 0x74, 0x09, 0x41, 0x45, 0x48, 0x53, 0x66,
+	0x92, 0x1e, 0x27, 0x9e, 0x83, 0x8f, 0x73, 0x8b, 0xaa, 0x52, 0x5e, 0xd4, 0x81, 0xb2, 0xba, 0x94,
+	0x94, 0xf6, 0xe1, 0x92, 0xe1, 0x61, 0x87, 0xe1, 0xb6, 0xcf, 0x81, 0x71, 0xdd, 0x54, 0x48, 0x78,
+	0xf8, 0xd4, 0xe4, 0x3d, 0x49, 0xdf, 0xd4, 0xf9, 0x59, 0x31, 0xae, 0xe3, 0x53, 0xe3, 0x73, 0x3d,
+	0xee, 0xe1, 0x53, 0xa3, 0x87, 0x2e, 0x43, 0xb2, 0x7b, 0xd2, 0x32, 0x8f, 0x48, 0x3f, 0x28, 0xa1,
+	0x9e, 0xe8, 0x9e, 0xb4, 0x1e, 0x92, 0x7e, 0xe9, 0x1f, 0x0a, 0x24, 0xb7, 0x5c, 0xdb, 0xc6, 0x8e,
+	0x85, 0x6e, 0x88, 0x7e, 0x12, 0x98, 0xb8, 0x2c, 0x43, 0x4e, 0xc9, 0x4d, 0xad, 0x76, 0x7e, 0x56,
+	0x8c, 0x68, 0x35, 0xbf, 0x71, 0xa0, 0x1c, 0x24, 0xdb, 0x81, 0x58, 0x58, 0x4b, 0xe9, 0xa3, 0xa5,
+	0x7f, 0x51, 0xbb, 0xd8, 0xc3, 0x76, 0x50, 0xd4, 0x8c, 0x2e, 0x57, 0xe8, 0x27, 0x90, 0x38, 0x22,
+	0x7d, 0x9f, 0x41, 0x41, 0xad, 0xb6, 0x7d, 0x0f, 0x1f, 0x92, 0xbe, 0x56, 0xfb, 0xfa, 0xac, 0x78,
+	0x3f, 0x94, 0x56, 0xdc, 0x23, 0xc7, 0xd8, 0x73, 0x82, 0xce, 0x27, 0x57, 0xb7, 0xdb, 0xae, 0x47,
+	0x36, 0x7a, 0x1b, 0xe1, 0x9e, 0xb9, 0x2e, 0x94, 0xf5, 0xf8, 0x11, 0xe9, 0x6b, 0x16, 0x52, 0x21,
+	0x63, 0xe3, 0x9e, 0xd9, 0xc1, 0xcc, 0x6c, 0xbb, 0x2c, 0x68, 0x07, 0x4b, 0x3a, 0xd8, 0xb8, 0xb7,
+	0x83, 0xd9, 0x96, 0xcb, 0x78, 0xe9, 0xe7, 0x31, 0x58, 0x91, 0xa1, 0x54, 0x31, 0x6f, 0x1f, 0x8e,
+	0x73, 0xb7, 0x16, 0x0a, 0x3a, 0x11, 0x8a, 0xf1, 0x87, 0x90, 0x96, 0x41, 0x99, 0xd4, 0xf2, 0x7b,
+	0x5a, 0xb4, 0x9c, 0xa9, 0x16, 0xe7, 0x65, 0x05, 0xc6, 0x0b, 0xa6, 0x83, 0xd4, 0xd1, 0x2c, 0xe6,
+	0xb7, 0x6c, 0xff, 0x04, 0x99, 0x09, 0xf1, 0x1d, 0xdd, 0x80, 0x45, 0x46, 0x3b, 0x01, 0x0b, 0x63,
+	0x73, 0xda, 0x53, 0x92, 0xd1, 0x8e, 0xff, 0x05, 0x7d, 0x7f, 0xcc, 0x9b, 0xb8, 0xe0, 0x4d, 0x69,
+	0x8a, 0x37, 0x22, 0x04, 0x62, 0xc9, 0x83, 0xd9, 0x0c, 0x8d, 0x26, 0xc9, 0x4e, 0xfc, 0x3f, 0x92,
+	0xad, 0x43, 0xae, 0xeb, 0x91, 0x67, 0x66, 0x2b, 0x70, 0xc2, 0x94, 0x21, 0x33, 0xff, 0xc0, 0xa4,
+	0x88, 0xe9, 0xca, 0xf9, 0x59, 0x71, 0x75, 0xdf, 0x23, 0xcf, 0x66, 0xfc, 0xd4, 0x6a, 0xfa, 0x6a,
+	0x77, 0x8e, 0xd8, 0x42, 0x65, 0xc8, 0x32, 0xda, 0x71, 0x88, 0x65, 0x62, 0x6e, 0x1e, 0x12, 0xda,
+	0x39, 0xe4, 0x82, 0xfe, 0x51, 0x7d, 0x39, 0x90, 0x57, 0xf8, 0x03, 0x21, 0x45, 0x5b, 0x00, 0xa4,
+	0x47, 0xda, 0x27, 0xfe, 0x05, 0x60, 0xb9, 0x94, 0x1a, 0x2d, 0xa7, 0x37, 0xaf, 0x4e, 0x25, 0x47,
+	0x9a, 0xad, 0x8f, 0x50, 0xb2, 0x7d, 0x84, 0xd4, 0x4a, 0xbf, 0x54, 0x20, 0x3b, 0x0b, 0x43, 0x9f,
+	0x02, 0x4c, 0x2a, 0x2e, 0x19, 0x51, 0x98, 0x57, 0xf0, 0xc9, 0x42, 0x4f, 0x8d, 0xeb, 0x3d, 0x19,
+	0x21, 0x91, 0x37, 0x8e, 0x90, 0x35, 0x48, 0xc8, 0x18, 0xa3, 0x22, 0x46, 0xb9, 0x2a, 0x7d, 0x01,
+	0xab, 0xfb, 0xc4, 0xb1, 0xa8, 0xd3, 0x11, 0x19, 0x9a, 0xb8, 0x76, 0x1d, 0x16, 0x45, 0xb6, 0x27,
+	0x8e, 0xa5, 0xfd, 0xa6, 0x2a, 0x50, 0x7e, 0x53, 0x15, 0x9b, 0xef, 0xe4, 0x43, 0xc9, 0x80, 0x6c,
+	0x60, 0x7f, 0x52, 0x87, 0x59, 0xce, 0x2b, 0xef, 0xcc, 0xf9, 0xd2, 0x2e, 0xa4, 0x9b, 0xb4, 0x33,
+	0xbe, 0x5c, 0x65, 0x88, 0xf9, 0x5d, 0x51, 0xf8, 0xbc, 0xbc, 0xb9, 0x32, 0xdd, 0xfb, 0x68, 0xc7,
+	0xe8, 0x77, 0x89, 0x2e, 0x10, 0xfe, 0xe8, 0x09, 0x46, 0x5d, 0xd0, 0x50, 0x82, 0x45, 0xe9, 0x2f,
+	0x0a, 0xa4, 0x45, 0xc3, 0x3b, 0x20, 0xde, 0x43, 0xd2, 0x7f, 0x97, 0x31, 0x7d, 0x47, 0x1e, 0x1d,
+	0x11, 0x47, 0x7f, 0x63, 0x7a, 0xb8, 0x4c, 0x4c, 0x86, 0x5c, 0xf8, 0x02, 0xd2, 0x0e, 0xe9, 0x71,
+	0x53, 0xde, 0x1d, 0x31, 0x95, 0xab, 0x9f, 0xf9, 0xf5, 0x6e, 0x90, 0x1e, 0xff, 0x1f, 0xdc, 0x9f,
+	0x94, 0x23, 0xed, 0x58, 0xa5, 0xbb, 0x10, 0xaf, 0x88, 0xe1, 0x3a, 0x8e, 0x5b, 0x09, 0xc5, 0x3d,
+	0x7e, 0xed, 0x45, 0x26, 0xaf, 0xbd, 0xd2, 0x1f, 0x15, 0xc8, 0x84, 0xa7, 0x22, 0xba, 0x0a, 0x10,
+	0x8c, 0xc1, 0xd0, 0xc3, 0x30, 0x25, 0x24, 0x0d, 0xff, 0x75, 0x38, 0x79, 0x33, 0x45, 0xa6, 0xde,
+	0x4c, 0xd7, 0x60, 0xd1, 0x22, 0x6d, 0x6a, 0x63, 0x39, 0x79, 0x97, 0xaa, 0xa9, 0xaf, 0xcf, 0x8a,
+	0xf1, 0x13, 0xea, 0xf0, 0xef, 0xe9, 0xe3, 0x2d, 0xf4, 0x19, 0x2c, 0xb6, 0x71, 0x17, 0xb7, 0x29,
+	0xef, 0xe7, 0x62, 0xef, 0x35, 0x05, 0xc7, 0xfa, 0xa5, 0x3f, 0x45, 0x21, 0xb9, 0x83, 0x39, 0x39,
+	0xc5, 0x7e, 0x09, 0x93, 0x6f, 0x78, 0x5e, 0x8e, 0xf6, 0xd1, 0xbd, 0x71, 0x0f, 0x0c, 0x8a, 0xf8,
+	0xc1, 0x54, 0x11, 0xa5, 0xc1, 0xd9, 0x19, 0xfa, 0x5d, 0x58, 0xa6, 0x76, 0xf7, 0x98, 0xd8, 0xc4,
+	0xe1, 0xe2, 0xa5, 0x94, 0x8b, 0xce, 0x3f, 0x66, 0x06, 0x86, 0xb6, 0x61, 0xad, 0x1b, 0x5c, 0x3e,
+	0x73, 0xc6, 0x40, 0x6c, 0xbe, 0x81, 0x55, 0x09, 0xd7, 0xa6, 0xed, 0x7c, 0x04, 0xd9, 0x91, 0x9d,
+	0x51, 0x67, 0xcc, 0xc5, 0xd5, 0x68, 0x39, 0xa5, 0x5f, 0x90, 0xf2, 0xd1, 0x7d, 0x2b, 0xfd, 0x4a,
+	0x81, 0x44, 0xe0, 0x3e, 0xba, 0x0d, 0xa8, 0x69, 0x54, 0x8c, 0xc7, 0x4d, 0xf3, 0x71, 0xa3, 0xb9,
+	0x5f, 0xdf, 0xd2, 0xb6, 0xb5, 0x7a, 0x2d, 0xbb, 0x90, 0x5f, 0x1d, 0x0c, 0xd5, 0x8b, 0x32, 0xd4,
+	0x00, 0xda, 0x70, 0x1d, 0x82, 0xbe, 0x0d, 0xcb, 0x12, 0xbe, 0x5f, 0x6f, 0xd4, 0xb4, 0xc6, 0x4e,
+	0x56, 0xc9, 0xe7, 0x06, 0x43, 0x75, 0x65, 0x0a, 0x2a, 0x9b, 0x09, 0xba, 0x03, 0x59, 0x89, 0xde,
+	0xda, 0x6b, 0x6c, 0x6b, 0xfa, 0x6e, 0xbd, 0x96, 0x8d, 0xe4, 0xf3, 0x83, 0xa1, 0xba, 0x36, 0x85,
+	0xdf, 0x72, 0x9d, 0x03, 0xea, 0xd9, 0xc4, 0xca, 0x2f, 0xfe, 0xec, 0x37, 0x85, 0x85, 0xdf, 0xfd,
+	0xb6, 0xa0, 0x94, 0x7e, 0x1d, 0xf1, 0x6b, 0xc7, 0xc4, 0x2f, 0x84, 0x6f, 0x42, 0xa6, 0x75, 0xec,
+	0xb6, 0x8f, 0x4c, 0xe7, 0xc4, 0x6e, 0x11, 0x4f, 0x14, 0x30, 0xa6, 0xa7, 0x85, 0xac, 0x21, 0x44,
+	0x48, 0xf3, 0x3b, 0x15, 0x23, 0xe6, 0x01, 0x21, 0xef, 0xf9, 0xcb, 0x24, 0xe9, 0xeb, 0x6f, 0x13,
+	0x82, 0x7e, 0x04, 0x99, 0xae, 0x47, 0x5d, 0x8f, 0xf2, 0xbe, 0x30, 0x17, 0x7d, 0x2f, 0x73, 0xe9,
+	0x91, 0x0d, 0xdf, 0xe4, 0x75, 0xb8, 0x10, 0x04, 0xe0, 0xbf, 0x14, 0x8e, 0xa9, 0x4d, 0xb9, 0x28,
+	0x6e, 0x4c, 0x5f, 0x12, 0xe2, 0x1d, 0xcc, 0x1e, 0xf9, 0x42, 0xb4, 0x0e, 0x97, 0xda, 0xa3, 0x5c,
+	0x84, 0x26, 0x52, 0x5c, 0x74, 0xeb, 0x8b, 0xe3, 0xad, 0xd1, 0x50, 0xba, 0xf9, 0xfb, 0x49, 0x21,
+	0x6f, 0xbc, 0xa2, 0x90, 0x17, 0x06, 0x43, 0x35, 0xdd, 0x70, 0x9d, 0x7a, 0x8f, 0x32, 0x4e, 0x1c,
+	0x1e, 0x02, 0x6a, 0x0d, 0xcd, 0xd0, 0x2a, 0x8f, 0xb4, 0xa7, 0xf5, 0x5a, 0x56, 0x09, 0x80, 0x9a,
+	0x43, 0x39, 0xc5, 0xc7, 0xf4, 0xa7, 0xc4, 0x42, 0xc5, 0x97, 0x6a, 0x1d, 0xc9, 0xa7, 0x07, 0x43,
+	0x35, 0x39, 0x2a, 0xef, 0x87, 0x73, 0xca, 0x1b, 0xcb, 0x2f, 0x0d, 0x86, 0x6a, 0x6a, 0x4e, 0x45,
+	0x6f, 0xfe, 0x47, 0x81, 0xd5, 0xb9, 0x0f, 0x08, 0xf4, 0x29, 0x7c, 0x58, 0xad, 0x18, 0x5b, 0x0f,
+	0xea, 0x35, 0x73, 0x6b, 0x6f, 0x77, 0xb7, 0xd2, 0xa8, 0x35, 0xcd, 0xb9, 0xc1, 0xac, 0x0c, 0x86,
+	0x6a, 0x56, 0xd8, 0x08, 0x47, 0xf4, 0x1d, 0x28, 0xbe, 0x4a, 0xbd, 0xa9, 0xed, 0x34, 0x02, 0x96,
+	0x66, 0x07, 0x43, 0x35, 0x23, 0x54, 0x9b, 0xb4, 0xe3, 0xf8, 0xee, 0xbf, 0x46, 0xad, 0x52, 0xdd,
+	0xd3, 0x0d, 0x41, 0xd6, 0x89, 0x5a, 0xa5, 0x25, 0x7a, 0x2a, 0xba, 0x07, 0x85, 0xd7, 0x9d, 0x56,
+	0xaf, 0x65, 0xa3, 0x41, 0x2e, 0xc7, 0x87, 0x11, 0x2b, 0x1f, 0xf3, 0xb3, 0x70, 0xf3, 0xcf, 0x0a,
+	0x5c, 0x98, 0x99, 0x01, 0xa8, 0x02, 0x57, 0x0d, 0xbd, 0xd2, 0x68, 0x6e, 0xd7, 0x75, 0xf3, 0x61,
+	0xfd, 0x89, 0x69, 0x3c, 0xd9, 0xaf, 0xcf, 0x44, 0x5d, 0x18, 0x0c, 0xd5, 0xfc, 0x63, 0x87, 0x75,
+	0x49, 0x9b, 0x1e, 0x50, 0x62, 0xcd, 0x9a, 0x58, 0x87, 0x0f, 0x5e, 0x36, 0xb1, 0xf7, 0xe3, 0x46,
+	0x5d, 0x6f, 0x3e, 0xd0, 0xf6, 0xb3, 0x4a, 0x50, 0x92, 0xbd, 0x53, 0x87, 0x78, 0xec, 0x90, 0x76,
+	0xd1, 0xc7, 0x50, 0x98, 0x83, 0xdf, 0xaf, 0xeb, 0x15, 0x63, 0x2f, 0x50, 0x91, 0x71, 0xef, 0x75,
+	0x89, 0x87, 0xb9, 0x2b, 0xb4, 0x64, 0x08, 0x7d, 0x48, 0xca, 0x01, 0x8a, 0x4a, 0xb0, 0xd2, 0xd4,
+	0x76, 0xe6, 0x39, 0xbc, 0x38, 0x18, 0xaa, 0x31, 0xd1, 0x2f, 0xf2, 0x90, 0x1e, 0x63, 0x8c, 0xcf,
+	0xb3, 0x4a, 0x3e, 0x35, 0x18, 0xaa, 0x71, 0xdf, 0x42, 0x0f, 0x7d, 0x0b, 0xb2, 0xe3, 0x3d, 0x99,
+	0xc9, 0x6c, 0x24, 0xbf, 0x3c, 0x18, 0xaa, 0xd0, 0xa4, 0xa3, 0x66, 0x15, 0xe2, 0xcf, 0x3f, 0x15,
+	0x58, 0x92, 0xbf, 0x9b, 0x25, 0x6f, 0xca, 0x90, 0xaf, 0xd5, 0xf7, 0xf7, 0x9a, 0x9a, 0x31, 0x9f,
+	0x2e, 0x13, 0x3f, 0x6e, 0xc0, 0xda, 0x0c, 0x72, 0xd2, 0xbf, 0xa6, 0x38, 0x7d, 0x0b, 0x72, 0x33,
+	0xc0, 0x70, 0xeb, 0x9a, 0xe6, 0x36, 0xba, 0x06, 0xab, 0x33, 0xe0, 0xea, 0x63, 0x3d, 0x60, 0x00,
+	0x0c, 0x86, 0x6a, 0x42, 0xfc, 0xc9, 0x61, 0xcd, 0x39, 0x5c, 0xaf, 0xef, 0xe9, 0x3b, 0xe2, 0xb6,
+	0x88, 0xc3, 0x75, 0xe2, 0x7a, 0x1d, 0x79, 0x57, 0x14, 0x11, 0xeb, 0x57, 0x0a, 0x64, 0xb6, 0xa9,
+	0x83, 0x8f, 0x29, 0xef, 0xef, 0xba, 0x16, 0x41, 0x9f, 0xc0, 0x95, 0x6d, 0xad, 0x51, 0x79, 0xa4,
+	0x19, 0x4f, 0xcc, 0xdd, 0xbd, 0xda, 0x6c, 0xc6, 0x2f, 0x0f, 0x86, 0xea, 0xa5, 0x91, 0x42, 0x88,
+	0x2a, 0xe8, 0x26, 0x5c, 0x9a, 0xd6, 0xab, 0xd5, 0xf7, 0x8d, 0x07, 0x59, 0x25, 0x7f, 0x71, 0x30,
+	0x54, 0x97, 0x46, 0x1a, 0x35, 0xd2, 0xe5, 0x87, 0x68, 0x13, 0x2e, 0x4f, 0x63, 0x83, 0xd5, 0x53,
+	0x11, 0xba, 0x18, 0x08, 0x23, 0x7c, 0xf0, 0xe9, 0x37, 0x89, 0x32, 0xa0, 0x69, 0x9d, 0x66, 0x65,
+	0xbb, 0x9e, 0x8d, 0x06, 0xfc, 0x19, 0xc1, 0x9b, 0xf8, 0x80, 0x4c, 0x0a, 0x59, 0x6d, 0x3c, 0xff,
+	0x7b, 0x61, 0xe1, 0xf9, 0x79, 0x41, 0xf9, 0xf2, 0xbc, 0xa0, 0xfc, 0xed, 0xbc, 0xa0, 0xfc, 0xe2,
+	0x45, 0x61, 0xe1, 0xcb, 0x17, 0x85, 0x85, 0xbf, 0xbe, 0x28, 0x2c, 0x3c, 0xbd, 0xf3, 0x96, 0x0f,
+	0x1d, 0xff, 0x9f, 0x32, 0xd1, 0x6e, 0x5b, 0x09, 0xf1, 0xcf, 0xd5, 0xbd, 0xff, 0x0e, 0x00, 0x89,
+	0x2f, 0xc4, 0xe2, 0x3d, 0x13, 0x00, 0x00,
 }
 
 func (m *NetworkInfo) Marshal() (dAtA []byte, err error) {
@@ -1022,6 +1268,16 @@ func (m *ERC20TokenMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsExternal {
+		i--
+		if m.IsExternal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.Status != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
 		i--
@@ -1203,6 +1459,25 @@ func (m *CommandBatchMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Executions) > 0 {
+		for iNdEx := len(m.Executions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Executions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.SignedAtHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SignedAtHeight))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.PrevBatchedCommandsID) > 0 {
 		i -= len(m.PrevBatchedCommandsID)
 		copy(dAtA[i:], m.PrevBatchedCommandsID)
@@ -1263,6 +1538,131 @@ func (m *CommandBatchMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CommandExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommandExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommandExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.TxID.Size()
+		i -= size
+		if _, err := m.TxID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CommandID.Size()
+		i -= size
+		if _, err := m.CommandID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingBatchExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingBatchExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingBatchExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TxID.Size()
+		i -= size
+		if _, err := m.TxID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.BatchID) > 0 {
+		i -= len(m.BatchID)
+		copy(dAtA[i:], m.BatchID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BatchID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutedCommands) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutedCommands) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutedCommands) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommandIDs) > 0 {
+		for iNdEx := len(m.CommandIDs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CommandIDs[iNdEx].Size()
+				i -= size
+				if _, err := m.CommandIDs[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SigMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1452,6 +1852,35 @@ func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingCommands) > 0 {
+		for iNdEx := len(m.PendingCommands) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PendingCommands[iNdEx])
+			copy(dAtA[i:], m.PendingCommands[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.PendingCommands[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.PendingImplementation.Size()
+		i -= size
+		if _, err := m.PendingImplementation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Implementation.Size()
+		i -= size
+		if _, err := m.Implementation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.Status != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
 		i--
@@ -1470,17 +1899,75 @@ func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
+func (m *GasInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConfirmedAtHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ConfirmedAtHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlockGasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockGasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.PriorityFee.Size()
+		i -= size
+		if _, err := m.PriorityFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BlockNumber != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
 func (m *NetworkInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1569,6 +2056,9 @@ func (m *ERC20TokenMetadata) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovTypes(uint64(m.Status))
 	}
+	if m.IsExternal {
+		n += 2
+	}
 	return n
 }
 
@@ -1648,6 +2138,61 @@ func (m *CommandBatchMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.SignedAtHeight != 0 {
+		n += 1 + sovTypes(uint64(m.SignedAtHeight))
+	}
+	if len(m.Executions) > 0 {
+		for _, e := range m.Executions {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommandExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommandID.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.TxID.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *PendingBatchExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BatchID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.TxID.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ExecutedCommands) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CommandIDs) > 0 {
+		for _, e := range m.CommandIDs {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1735,6 +2280,38 @@ func (m *Gateway) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovTypes(uint64(m.Status))
 	}
+	l = m.Implementation.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.PendingImplementation.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.PendingCommands) > 0 {
+		for _, s := range m.PendingCommands {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockNumber != 0 {
+		n += 1 + sovTypes(uint64(m.BlockNumber))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.PriorityFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.BlockGasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.BlockGasLimit))
+	}
+	if m.ConfirmedAtHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ConfirmedAtHeight))
+	}
 	return n
 }
 
@@ -2564,6 +3141,26 @@ func (m *ERC20TokenMetadata) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsExternal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsExternal = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3153,61 +3750,11 @@ func (m *CommandBatchMetadata) Unmarshal(dAtA []byte) error {
 				m.PrevBatchedCommandsID = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SigMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SigMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SigMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedAtHeight", wireType)
 			}
-			m.Type = 0
+			m.SignedAtHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3217,16 +3764,16 @@ func (m *SigMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= SigType(b&0x7F) << shift
+				m.SignedAtHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3236,23 +3783,25 @@ func (m *SigMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = string(dAtA[iNdEx:postIndex])
+			m.Executions = append(m.Executions, CommandExecution{})
+			if err := m.Executions[len(m.Executions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3275,7 +3824,7 @@ func (m *SigMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferKey) Unmarshal(dAtA []byte) error {
+func (m *CommandExecution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3298,15 +3847,15 @@ func (m *TransferKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommandExecution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommandExecution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3333,15 +3882,15 @@ func (m *TransferKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TxID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CommandID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
-			m.Type = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3351,16 +3900,30 @@ func (m *TransferKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= TransferKeyType(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextKeyID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3370,24 +3933,11 @@ func (m *TransferKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextKeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3409,7 +3959,7 @@ func (m *TransferKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Asset) Unmarshal(dAtA []byte) error {
+func (m *PendingBatchExecution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3432,17 +3982,17 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Asset: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingBatchExecution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Asset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingBatchExecution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchID", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3452,13 +4002,450 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchID = append(m.BatchID[:0], dAtA[iNdEx:postIndex]...)
+			if m.BatchID == nil {
+				m.BatchID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutedCommands) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutedCommands: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutedCommands: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandIDs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v CommandID
+			m.CommandIDs = append(m.CommandIDs, v)
+			if err := m.CommandIDs[len(m.CommandIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= SigType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= TransferKeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Asset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Asset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Asset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
 			postIndex := iNdEx + intStringLen
@@ -3770,6 +4757,277 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Implementation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Implementation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingImplementation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingImplementation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommands", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingCommands = append(m.PendingCommands, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriorityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasLimit", wireType)
+			}
+			m.BlockGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmedAtHeight", wireType)
+			}
+			m.ConfirmedAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmedAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 )
 
@@ -24,6 +25,7 @@ func GetTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		GetCmdRegisterChainMaintainer(),
 		GetCmdDeregisterChainMaintainer(),
+		GetCmdConfirmExternalDeposit(),
 	)
 
 	return txCmd
@@ -80,3 +82,34 @@ func GetCmdDeregisterChainMaintainer() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdConfirmExternalDeposit returns the cli command to confirm a deposit on an external chain
+func GetCmdConfirmExternalDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm-external-deposit [chain] [txID] [depositAddress] [amount]",
+		Short: "Confirm a deposit to the given deposit address on an external chain",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewConfirmExternalDepositRequest(cliCtx.GetFromAddress(), args[0], args[1], args[2], amount)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,81 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mock
+
+import (
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	types "github.com/cosmos/cosmos-sdk/types"
+	"sync"
+)
+
+// Ensure, that ChainAdapterMock does implement exported.ChainAdapter.
+// If this is not the case, regenerate this file with moq.
+var _ exported.ChainAdapter = &ChainAdapterMock{}
+
+// ChainAdapterMock is a mock implementation of exported.ChainAdapter.
+//
+// 	func TestSomethingThatUsesChainAdapter(t *testing.T) {
+//
+// 		// make and configure a mocked exported.ChainAdapter
+// 		mockedChainAdapter := &ChainAdapterMock{
+// 			ValidateAddressFunc: func(ctx types.Context, address exported.CrossChainAddress) error {
+// 				panic("mock out the ValidateAddress method")
+// 			},
+// 		}
+//
+// 		// use mockedChainAdapter in code that requires exported.ChainAdapter
+// 		// and then make assertions.
+//
+// 	}
+type ChainAdapterMock struct {
+	// ValidateAddressFunc mocks the ValidateAddress method.
+	ValidateAddressFunc func(ctx types.Context, address exported.CrossChainAddress) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ValidateAddress holds details about calls to the ValidateAddress method.
+		ValidateAddress []struct {
+			// Ctx is the ctx argument value.
+			Ctx types.Context
+			// Address is the address argument value.
+			Address exported.CrossChainAddress
+		}
+	}
+	lockValidateAddress sync.RWMutex
+}
+
+// ValidateAddress calls ValidateAddressFunc.
+func (mock *ChainAdapterMock) ValidateAddress(ctx types.Context, address exported.CrossChainAddress) error {
+	if mock.ValidateAddressFunc == nil {
+		panic("ChainAdapterMock.ValidateAddressFunc: method is nil but ChainAdapter.ValidateAddress was just called")
+	}
+	callInfo := struct {
+		Ctx     types.Context
+		Address exported.CrossChainAddress
+	}{
+		Ctx:     ctx,
+		Address: address,
+	}
+	mock.lockValidateAddress.Lock()
+	mock.calls.ValidateAddress = append(mock.calls.ValidateAddress, callInfo)
+	mock.lockValidateAddress.Unlock()
+	return mock.ValidateAddressFunc(ctx, address)
+}
+
+// ValidateAddressCalls gets all the calls that were made to ValidateAddress.
+// Check the length with:
+//     len(mockedChainAdapter.ValidateAddressCalls())
+func (mock *ChainAdapterMock) ValidateAddressCalls() []struct {
+	Ctx     types.Context
+	Address exported.CrossChainAddress
+} {
+	var calls []struct {
+		Ctx     types.Context
+		Address exported.CrossChainAddress
+	}
+	mock.lockValidateAddress.RLock()
+	calls = mock.calls.ValidateAddress
+	mock.lockValidateAddress.RUnlock()
+	return calls
+}
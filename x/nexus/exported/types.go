@@ -6,9 +6,18 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+//go:generate moq -out ./mock/types.go -pkg mock . ChainAdapter
+
 // AddressValidator defines a function that implements address verification upon a request to link addresses
 type AddressValidator func(ctx sdk.Context, address CrossChainAddress) error
 
+// ChainAdapter defines the chain specific logic that is needed to accept deposits from an external chain without a dedicated module.
+// Outbound transfers to external chains are not supported, because their signing keys are managed by chain modules
+type ChainAdapter interface {
+	// ValidateAddress checks if the given address is well-formed on the adapter's chain
+	ValidateAddress(ctx sdk.Context, address CrossChainAddress) error
+}
+
 // Validate validates the TransferState
 func (m TransferState) Validate() error {
 	_, ok := TransferState_name[int32(m)]
@@ -11,8 +11,8 @@ import (
 )
 
 // NewHandler returns the handler of the nexus module
-func NewHandler(k types.Nexus, snapshotter types.Snapshotter) sdk.Handler {
-	server := keeper.NewMsgServerImpl(k, snapshotter)
+func NewHandler(k types.Nexus, snapshotter types.Snapshotter, voter types.Voter) sdk.Handler {
+	server := keeper.NewMsgServerImpl(k, snapshotter, voter)
 	h := func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 		switch msg := msg.(type) {
@@ -22,6 +22,16 @@ func NewHandler(k types.Nexus, snapshotter types.Snapshotter) sdk.Handler {
 		case *types.DeregisterChainMaintainerRequest:
 			res, err := server.DeregisterChainMaintainer(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.ConfirmExternalDepositRequest:
+			res, err := server.ConfirmExternalDeposit(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.VoteConfirmExternalDepositRequest:
+			res, err := server.VoteConfirmExternalDeposit(sdk.WrapSDKContext(ctx), msg)
+			result, err := sdk.WrapServiceResult(ctx, res, err)
+			if err == nil {
+				result.Log = res.Log
+			}
+			return result, err
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
 				fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg))
@@ -41,15 +41,11 @@ func (k Keeper) getAllLinkedAddresses(ctx sdk.Context) (results []types.LinkedAd
 
 // LinkAddresses links a sender address to a cross-chain recipient address
 func (k Keeper) LinkAddresses(ctx sdk.Context, depositAddress exported.CrossChainAddress, recipientAddress exported.CrossChainAddress) error {
-	if validator := k.GetRouter().GetAddressValidator(depositAddress.Chain.Module); validator == nil {
-		return fmt.Errorf("unknown module for sender's chain %s", depositAddress.Chain.String())
-	} else if err := validator(ctx, depositAddress); err != nil {
+	if err := k.validateAddress(ctx, depositAddress); err != nil {
 		return err
 	}
 
-	if validator := k.GetRouter().GetAddressValidator(recipientAddress.Chain.Module); validator == nil {
-		return fmt.Errorf("unknown module for recipient's chain %s", recipientAddress.Chain.String())
-	} else if err := validator(ctx, recipientAddress); err != nil {
+	if err := k.validateAddress(ctx, recipientAddress); err != nil {
 		return err
 	}
 
@@ -61,6 +57,21 @@ func (k Keeper) LinkAddresses(ctx sdk.Context, depositAddress exported.CrossChai
 	return nil
 }
 
+// validateAddress validates the given address with the adapter of its chain if the chain is an external chain,
+// and with the address validator of the chain's module otherwise
+func (k Keeper) validateAddress(ctx sdk.Context, address exported.CrossChainAddress) error {
+	router := k.GetRouter()
+	if router.HasChainAdapter(address.Chain.Name) {
+		return router.GetChainAdapter(address.Chain.Name).ValidateAddress(ctx, address)
+	}
+
+	if !router.HasAddressValidator(address.Chain.Module) {
+		return fmt.Errorf("unknown module for chain %s", address.Chain.String())
+	}
+
+	return router.GetAddressValidator(address.Chain.Module)(ctx, address)
+}
+
 // GetRecipient retrieves the cross chain recipient associated to the specified sender
 func (k Keeper) GetRecipient(ctx sdk.Context, depositAddress exported.CrossChainAddress) (exported.CrossChainAddress, bool) {
 	if linkedAddresses, ok := k.getLinkedAddresses(ctx, depositAddress); ok {
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/axelarnetwork/axelar-core/utils"
@@ -34,11 +37,23 @@ func (k Keeper) subtractFromChainTotal(ctx sdk.Context, chain exported.Chain, co
 	chainState, _ := k.getChainState(ctx, chain)
 	chainState.Chain = chain
 	chainState.Total = chainState.Total.Sub(sdk.NewCoins(coin))
+	chainState.Burned = chainState.Burned.Add(coin)
+
+	k.setChainState(ctx, chainState)
+}
+
+// restoreChainTotal takes back an amount that was subtracted from the chain total but never left the chain
+func (k Keeper) restoreChainTotal(ctx sdk.Context, chain exported.Chain, coin sdk.Coin) {
+	chainState, _ := k.getChainState(ctx, chain)
+	chainState.Chain = chain
+	chainState.Total = chainState.Total.Add(coin)
+	chainState.Burned = chainState.Burned.Sub(sdk.NewCoins(coin))
 
 	k.setChainState(ctx, chainState)
 }
 
-func (k Keeper) getChainTotal(ctx sdk.Context, chain exported.Chain, denom string) sdk.Coin {
+// GetChainTotal returns the total amount of the given asset the chain is known to hold
+func (k Keeper) GetChainTotal(ctx sdk.Context, chain exported.Chain, denom string) sdk.Coin {
 	chainState, ok := k.getChainState(ctx, chain)
 	if !ok {
 		return sdk.NewCoin(denom, sdk.ZeroInt())
@@ -62,6 +77,35 @@ func (k Keeper) RegisterAsset(ctx sdk.Context, chain exported.Chain, denom strin
 	k.setChainState(ctx, chainState)
 }
 
+// RegisterNativeAsset indicates that the specified asset is supported by and originates on the given chain.
+// An asset can only originate on one chain, so it must not be known to any other chain yet
+func (k Keeper) RegisterNativeAsset(ctx sdk.Context, chain exported.Chain, denom string) error {
+	for _, other := range k.GetChainsWithAsset(ctx, denom) {
+		if !strings.EqualFold(other.Name, chain.Name) {
+			return fmt.Errorf("asset %s is already registered for chain %s", denom, other.Name)
+		}
+	}
+
+	chainState, _ := k.getChainState(ctx, chain)
+	chainState.Chain = chain
+	if err := chainState.AddNativeAsset(denom); err != nil {
+		return err
+	}
+
+	k.setChainState(ctx, chainState)
+	return nil
+}
+
+// IsNativeAsset returns true if the specified asset originates on the given chain
+func (k Keeper) IsNativeAsset(ctx sdk.Context, chain exported.Chain, denom string) bool {
+	chainState, ok := k.getChainState(ctx, chain)
+	if !ok {
+		return chain.NativeAsset == denom
+	}
+
+	return chainState.IsNativeAsset(denom)
+}
+
 // IsAssetRegistered returns true if the specified asset is supported by the given chain
 func (k Keeper) IsAssetRegistered(ctx sdk.Context, chain exported.Chain, denom string) bool {
 	chainState, ok := k.getChainState(ctx, chain)
@@ -72,11 +116,24 @@ func (k Keeper) IsAssetRegistered(ctx sdk.Context, chain exported.Chain, denom s
 	return chainState.HasAsset(denom)
 }
 
+// GetChainsWithAsset returns all chains the specified asset is registered for or native to
+func (k Keeper) GetChainsWithAsset(ctx sdk.Context, denom string) []exported.Chain {
+	var chains []exported.Chain
+	for _, chain := range k.GetChains(ctx) {
+		if k.IsAssetRegistered(ctx, chain, denom) || k.IsNativeAsset(ctx, chain, denom) {
+			chains = append(chains, chain)
+		}
+	}
+
+	return chains
+}
+
 // AddToChainTotal add balance for an asset for a chain
 func (k Keeper) AddToChainTotal(ctx sdk.Context, chain exported.Chain, coin sdk.Coin) {
 	chainState, _ := k.getChainState(ctx, chain)
 	chainState.Chain = chain
 	chainState.Total = chainState.Total.Add(coin)
+	chainState.Minted = chainState.Minted.Add(coin)
 
 	k.setChainState(ctx, chainState)
 }
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+)
+
+func getExternalDepositKey(deposit types.ExternalDeposit) utils.Key {
+	return utils.LowerCaseKey(fmt.Sprintf("%s_%s_%s", deposit.DepositAddress.Chain.Name, deposit.TxID, deposit.DepositAddress.Address))
+}
+
+// GetChainAdapter returns the adapter of the given external chain
+func (k Keeper) GetChainAdapter(_ sdk.Context, chain exported.Chain) (exported.ChainAdapter, bool) {
+	router := k.GetRouter()
+	if !router.HasChainAdapter(chain.Name) {
+		return nil, false
+	}
+
+	return router.GetChainAdapter(chain.Name), true
+}
+
+// SetPendingExternalDeposit stores a deposit on an external chain that is awaiting confirmation
+func (k Keeper) SetPendingExternalDeposit(ctx sdk.Context, pollKey vote.PollKey, deposit types.ExternalDeposit) {
+	k.getStore(ctx).Set(pendingExternalDepositPrefix.Append(utils.KeyFromStr(pollKey.String())), &deposit)
+}
+
+// GetPendingExternalDeposit returns the external deposit associated with the given poll key
+func (k Keeper) GetPendingExternalDeposit(ctx sdk.Context, pollKey vote.PollKey) (deposit types.ExternalDeposit, ok bool) {
+	return deposit, k.getStore(ctx).Get(pendingExternalDepositPrefix.Append(utils.KeyFromStr(pollKey.String())), &deposit)
+}
+
+// DeletePendingExternalDeposit deletes the external deposit associated with the given poll key
+func (k Keeper) DeletePendingExternalDeposit(ctx sdk.Context, pollKey vote.PollKey) {
+	k.getStore(ctx).Delete(pendingExternalDepositPrefix.Append(utils.KeyFromStr(pollKey.String())))
+}
+
+// SetExternalDepositConfirmed marks the given external deposit as confirmed
+func (k Keeper) SetExternalDepositConfirmed(ctx sdk.Context, deposit types.ExternalDeposit) {
+	k.getStore(ctx).Set(confirmedExternalDepositPrefix.Append(getExternalDepositKey(deposit)), &deposit)
+}
+
+// IsExternalDepositConfirmed returns true if the given external deposit has been confirmed already
+func (k Keeper) IsExternalDepositConfirmed(ctx sdk.Context, deposit types.ExternalDeposit) bool {
+	return k.getStore(ctx).Has(confirmedExternalDepositPrefix.Append(getExternalDepositKey(deposit)))
+}
+
+func (k Keeper) getConfirmedExternalDeposits(ctx sdk.Context) (deposits []types.ExternalDeposit) {
+	iter := k.getStore(ctx).Iterator(confirmedExternalDepositPrefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var deposit types.ExternalDeposit
+		iter.UnmarshalValue(&deposit)
+
+		deposits = append(deposits, deposit)
+	}
+
+	return deposits
+}
@@ -18,6 +18,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 			panic(fmt.Errorf("chain state %s already set", chainState.Chain.Name))
 		}
 
+		// chain states exported before minted and burned amounts were tracked account for their whole total as minted
+		if chainState.Minted.Empty() && chainState.Burned.Empty() {
+			chainState.Minted = chainState.Total
+		}
+
 		k.setChainState(ctx, chainState)
 	}
 
@@ -47,6 +52,22 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 		k.setTransfer(ctx, transfer)
 		transferSeen[transfer.ID] = true
 	}
+
+	for _, precision := range genState.AssetPrecisions {
+		if _, ok := k.GetAssetPrecision(ctx, precision.Asset); ok {
+			panic(fmt.Errorf("precision for asset %s already set", precision.Asset))
+		}
+
+		k.setAssetPrecision(ctx, precision)
+	}
+
+	for _, deposit := range genState.ConfirmedExternalDeposits {
+		if k.IsExternalDepositConfirmed(ctx, deposit) {
+			panic(fmt.Errorf("external deposit %s on chain %s already confirmed", deposit.TxID, deposit.DepositAddress.Chain.Name))
+		}
+
+		k.SetExternalDepositConfirmed(ctx, deposit)
+	}
 }
 
 // ExportGenesis returns the reward module's genesis state.
@@ -58,5 +79,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.getChainStates(ctx),
 		k.getAllLinkedAddresses(ctx),
 		k.getTransfers(ctx),
+		k.getAssetPrecisions(ctx),
+		k.getConfirmedExternalDeposits(ctx),
 	)
 }
@@ -65,6 +65,8 @@ func assertChainStatesEqual(t *testing.T, expected, actual *types.GenesisState)
 	assert.ElementsMatch(t, expected.ChainStates, actual.ChainStates)
 	assert.ElementsMatch(t, expected.LinkedAddresses, actual.LinkedAddresses)
 	assert.ElementsMatch(t, expected.Transfers, actual.Transfers)
+	assert.ElementsMatch(t, expected.AssetPrecisions, actual.AssetPrecisions)
+	assert.ElementsMatch(t, expected.ConfirmedExternalDeposits, actual.ConfirmedExternalDeposits)
 }
 
 func TestExportGenesisInitGenesis(t *testing.T) {
@@ -85,6 +87,10 @@ func TestExportGenesisInitGenesis(t *testing.T) {
 	keeper.RegisterAsset(ctx, bitcoin.Bitcoin, bitcoin.Bitcoin.NativeAsset)
 	expected.Chains = append(expected.Chains, bitcoin.Bitcoin)
 
+	for _, precision := range expected.AssetPrecisions {
+		assert.NoError(t, keeper.SetAssetPrecision(ctx, precision.Asset, precision.Decimals))
+	}
+
 	linkedAddressesCount := rand.I64Between(100, 1000)
 	expectedLinkedAddresses := make([]types.LinkedAddresses, linkedAddressesCount)
 	for i := 0; i < int(linkedAddressesCount); i++ {
@@ -120,6 +126,13 @@ func TestExportGenesisInitGenesis(t *testing.T) {
 		expected.Transfers = append(expected.Transfers, expectedTransfer)
 	}
 
+	confirmedExternalDeposits := make([]types.ExternalDeposit, rand.I64Between(1, 20))
+	for i := range confirmedExternalDeposits {
+		confirmedExternalDeposits[i] = types.NewExternalDeposit(rand.HexStr(64), getRandomAxelarnetAddress(), sdk.NewCoin(axelarnet.Axelarnet.NativeAsset, sdk.NewInt(rand.PosI64())))
+		keeper.SetExternalDepositConfirmed(ctx, confirmedExternalDeposits[i])
+	}
+	expected.ConfirmedExternalDeposits = confirmedExternalDeposits
+
 	expected.ChainStates = []types.ChainState{
 		{
 			Chain:  axelarnet.Axelarnet,
@@ -129,6 +142,7 @@ func TestExportGenesisInitGenesis(t *testing.T) {
 			Chain:  evm.Ethereum,
 			Assets: []string{evm.Ethereum.NativeAsset},
 			Total:  expectedEthereumTotal,
+			Minted: expectedEthereumTotal,
 		},
 		{
 			Chain:  bitcoin.Bitcoin,
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+)
+
+// RegisterInvariants registers all nexus module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "chain-totals", ChainTotalInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "pending-transfers", PendingTransferInvariant(k))
+}
+
+// ChainTotalInvariant checks that every chain total only tracks foreign assets
+// and equals the amount minted on the chain minus the amount burned on it
+func ChainTotalInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		for _, chainState := range k.getChainStates(ctx) {
+			chain := chainState.Chain
+
+			if err := validateChainAmounts(chainState); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tchain %s has invalid amounts: %s\n", chain.Name, err)
+				continue
+			}
+
+			for _, coin := range chainState.Total {
+				if chainState.IsNativeAsset(coin.Denom) {
+					broken = true
+					msg += fmt.Sprintf("\tchain %s tracks its native asset %s in its total\n", chain.Name, coin.Denom)
+				}
+			}
+
+			for _, coin := range chainState.Total.Add(chainState.Minted...).Add(chainState.Burned...) {
+				minted := chainState.Minted.AmountOf(coin.Denom)
+				burned := chainState.Burned.AmountOf(coin.Denom)
+				if total := chainState.Total.AmountOf(coin.Denom); !total.Equal(minted.Sub(burned)) {
+					broken = true
+					msg += fmt.Sprintf("\tchain %s has a total of %s%s, but minted %s%s and burned %s%s\n",
+						chain.Name, total, coin.Denom, minted, coin.Denom, burned, coin.Denom)
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "chain totals", msg), broken
+	}
+}
+
+// PendingTransferInvariant checks that every pending transfer holds a positive amount
+// of an asset the recipient chain is able to receive,
+// and that the pending transfers to the origin chain of an asset are covered by the amounts burned on other chains
+func PendingTransferInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		unlocking := sdk.NewCoins()
+		for _, chain := range k.GetChains(ctx) {
+			for _, transfer := range k.GetTransfersForChain(ctx, chain, exported.Pending) {
+				switch {
+				case !transfer.Asset.IsValid() || transfer.Asset.IsZero():
+					broken = true
+					msg += fmt.Sprintf("\tpending transfer %d to chain %s has an invalid amount %s\n", transfer.ID, chain.Name, transfer.Asset)
+				case transfer.Recipient.Chain.Name != chain.Name:
+					broken = true
+					msg += fmt.Sprintf("\tpending transfer %d is stored for chain %s but sends to chain %s\n", transfer.ID, chain.Name, transfer.Recipient.Chain.Name)
+				case !chain.SupportsForeignAssets && transfer.Asset.Denom != chain.NativeAsset:
+					broken = true
+					msg += fmt.Sprintf("\tpending transfer %d sends foreign asset %s to chain %s\n", transfer.ID, transfer.Asset.Denom, chain.Name)
+				case k.IsNativeAsset(ctx, chain, transfer.Asset.Denom):
+					unlocking = unlocking.Add(transfer.Asset)
+				}
+			}
+		}
+
+		burned := sdk.NewCoins()
+		for _, chainState := range k.getChainStates(ctx) {
+			if chainState.Burned.Validate() == nil {
+				burned = burned.Add(chainState.Burned...)
+			}
+		}
+
+		for _, coin := range unlocking {
+			if coin.Amount.GT(burned.AmountOf(coin.Denom)) {
+				broken = true
+				msg += fmt.Sprintf("\tpending transfers to the origin chain of %s add up to %s, but only %s%s were burned on other chains\n",
+					coin.Denom, coin, burned.AmountOf(coin.Denom), coin.Denom)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "pending transfers", msg), broken
+	}
+}
+
+func validateChainAmounts(chainState types.ChainState) error {
+	for _, amounts := range []sdk.Coins{chainState.Total, chainState.Minted, chainState.Burned} {
+		if err := amounts.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	btc "github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
+	btcTypes "github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	nexusKeeper "github.com/axelarnetwork/axelar-core/x/nexus/keeper"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+)
+
+func TestInvariants(t *testing.T) {
+	setup := func() sdk.Context {
+		ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+		keeper.SetParams(ctx, types.DefaultParams())
+		keeper.SetChain(ctx, btc.Bitcoin)
+		keeper.SetChain(ctx, evm.Ethereum)
+
+		for i := 0; i < 10; i++ {
+			sender, recipient := makeRandAddressesForChain(btc.Bitcoin, evm.Ethereum)
+			assert.NoError(t, keeper.LinkAddresses(ctx, sender, recipient))
+			assert.NoError(t, keeper.EnqueueForTransfer(ctx, sender, makeRandAmount(btcTypes.Satoshi), feeRate))
+		}
+
+		transfers := keeper.GetTransfersForChain(ctx, evm.Ethereum, exported.Pending)
+		for _, transfer := range transfers[:len(transfers)/2] {
+			keeper.ArchivePendingTransfer(ctx, transfer)
+		}
+
+		// send part of the released amount back to its origin
+		sender, recipient := makeRandAddressesForChain(evm.Ethereum, btc.Bitcoin)
+		assert.NoError(t, keeper.LinkAddresses(ctx, sender, recipient))
+		released := keeper.GetChainTotal(ctx, evm.Ethereum, btcTypes.Satoshi)
+		assert.NoError(t, keeper.EnqueueForTransfer(ctx, sender, sdk.NewCoin(btcTypes.Satoshi, released.Amount.QuoRaw(2)), feeRate))
+
+		return ctx
+	}
+
+	// reinitializes the exported state of the given context after applying the given changes
+	reinit := func(ctx sdk.Context, change func(*types.GenesisState)) sdk.Context {
+		genState := keeper.ExportGenesis(ctx)
+		change(genState)
+
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+		keeper.InitGenesis(ctx, genState)
+
+		return ctx
+	}
+
+	t.Run("should hold for totals and transfers created by nexus", func(t *testing.T) {
+		ctx := setup()
+
+		_, broken := nexusKeeper.ChainTotalInvariant(keeper)(ctx)
+		assert.False(t, broken)
+
+		_, broken = nexusKeeper.PendingTransferInvariant(keeper)(ctx)
+		assert.False(t, broken)
+	})
+
+	t.Run("should hold after re-initializing the exported state", func(t *testing.T) {
+		ctx := reinit(setup(), func(*types.GenesisState) {})
+
+		_, broken := nexusKeeper.ChainTotalInvariant(keeper)(ctx)
+		assert.False(t, broken)
+
+		_, broken = nexusKeeper.PendingTransferInvariant(keeper)(ctx)
+		assert.False(t, broken)
+	})
+
+	t.Run("should break if a chain total does not equal the minted minus the burned amount", func(t *testing.T) {
+		ctx := reinit(setup(), func(genState *types.GenesisState) {
+			for i, chainState := range genState.ChainStates {
+				if chainState.Chain.Name == evm.Ethereum.Name {
+					genState.ChainStates[i].Total = chainState.Total.Add(sdk.NewInt64Coin(btcTypes.Satoshi, 1))
+				}
+			}
+		})
+
+		msg, broken := nexusKeeper.ChainTotalInvariant(keeper)(ctx)
+		assert.True(t, broken)
+		assert.Contains(t, msg, evm.Ethereum.Name)
+	})
+
+	t.Run("should break if pending transfers to the origin chain exceed the burned amount", func(t *testing.T) {
+		ctx := reinit(setup(), func(genState *types.GenesisState) {
+			_, recipient := makeRandAddressesForChain(evm.Ethereum, btc.Bitcoin)
+			transfer := exported.NewPendingCrossChainTransfer(uint64(len(genState.Transfers)), recipient, makeRandAmount(btcTypes.Satoshi))
+			for _, chainState := range genState.ChainStates {
+				transfer.Asset = transfer.Asset.Add(sdk.NewCoin(btcTypes.Satoshi, chainState.Burned.AmountOf(btcTypes.Satoshi)))
+			}
+
+			genState.Transfers = append(genState.Transfers, transfer)
+		})
+
+		_, broken := nexusKeeper.ChainTotalInvariant(keeper)(ctx)
+		assert.False(t, broken)
+
+		msg, broken := nexusKeeper.PendingTransferInvariant(keeper)(ctx)
+		assert.True(t, broken)
+		assert.Contains(t, msg, btcTypes.Satoshi)
+	})
+
+	t.Run("should break if a chain total tracks the native asset", func(t *testing.T) {
+		ctx := setup()
+
+		keeper.AddToChainTotal(ctx, btc.Bitcoin, sdk.NewInt64Coin(btcTypes.Satoshi, 1))
+
+		msg, broken := nexusKeeper.ChainTotalInvariant(keeper)(ctx)
+		assert.True(t, broken)
+		assert.Contains(t, msg, btc.Bitcoin.Name)
+	})
+}
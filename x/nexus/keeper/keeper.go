@@ -19,6 +19,10 @@ var (
 	chainStatePrefix      = utils.KeyFromStr("state")
 	linkedAddressesPrefix = utils.KeyFromStr("linked_addresses")
 	transferPrefix        = utils.KeyFromStr("transfer")
+	assetPrecisionPrefix  = utils.KeyFromStr("asset_precision")
+
+	pendingExternalDepositPrefix   = utils.KeyFromStr("pending_external_deposit")
+	confirmedExternalDepositPrefix = utils.KeyFromStr("confirmed_external_deposit")
 	// temporary
 	latestDepositAddressPrefix = utils.KeyFromStr("latest_deposit_address")
 )
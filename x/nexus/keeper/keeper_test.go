@@ -21,9 +21,11 @@ import (
 	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
 	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	nexusMock "github.com/axelarnetwork/axelar-core/x/nexus/exported/mock"
 	nexusKeeper "github.com/axelarnetwork/axelar-core/x/nexus/keeper"
 	"github.com/axelarnetwork/axelar-core/x/nexus/types"
 	"github.com/axelarnetwork/axelar-core/x/nexus/types/mock"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
 
 	"github.com/stretchr/testify/assert"
 
@@ -37,6 +39,13 @@ const (
 
 var keeper nexusKeeper.Keeper
 var feeRate = sdk.NewDecWithPrec(25, 5)
+var externalChain = exported.Chain{
+	Name:                  "External",
+	NativeAsset:           "uext",
+	SupportsForeignAssets: true,
+	KeyType:               tss.Multisig,
+	Module:                "external",
+}
 
 func init() {
 	encCfg := app.MakeEncodingConfig()
@@ -71,6 +80,14 @@ func init() {
 		}
 
 		return nil
+	}).AddChainAdapter(externalChain.Name, &nexusMock.ChainAdapterMock{
+		ValidateAddressFunc: func(_ sdk.Context, addr nexus.CrossChainAddress) error {
+			if !strings.HasPrefix(addr.Address, "ext1") {
+				return fmt.Errorf("not an external chain address")
+			}
+
+			return nil
+		},
 	})
 	keeper.SetRouter(nexusRouter)
 }
@@ -134,6 +151,41 @@ func TestInvalidAddresses(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestExternalChainAddresses(t *testing.T) {
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	err := keeper.LinkAddresses(ctx,
+		exported.CrossChainAddress{Chain: externalChain, Address: "ext1" + rand.HexStr(38)},
+		exported.CrossChainAddress{Chain: evm.Ethereum, Address: "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8"},
+	)
+
+	assert.NoError(t, err)
+
+	err = keeper.LinkAddresses(ctx,
+		exported.CrossChainAddress{Chain: evm.Ethereum, Address: "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8"},
+		exported.CrossChainAddress{Chain: externalChain, Address: "ext1" + rand.HexStr(38)},
+	)
+
+	assert.NoError(t, err)
+
+	err = keeper.LinkAddresses(ctx,
+		exported.CrossChainAddress{Chain: externalChain, Address: "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8"},
+		exported.CrossChainAddress{Chain: evm.Ethereum, Address: "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8"},
+	)
+
+	assert.Error(t, err)
+
+	unknownChain := externalChain
+	unknownChain.Name = rand.StrBetween(5, 20)
+	err = keeper.LinkAddresses(ctx,
+		exported.CrossChainAddress{Chain: unknownChain, Address: "ext1" + rand.HexStr(38)},
+		exported.CrossChainAddress{Chain: evm.Ethereum, Address: "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8"},
+	)
+
+	assert.Error(t, err)
+}
+
 func TestLinkNoForeignAssetSupport(t *testing.T) {
 	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
 	keeper.SetParams(ctx, types.DefaultParams())
@@ -325,6 +377,241 @@ func TestTotalSucess(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestTotal_NativeAsset(t *testing.T) {
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	polygon := evm.Ethereum
+	polygon.Name = "polygon"
+	polygon.NativeAsset = "matic"
+	asset := "usdc"
+
+	assert.NoError(t, keeper.RegisterNativeAsset(ctx, evm.Ethereum, asset))
+	assert.Error(t, keeper.RegisterNativeAsset(ctx, evm.Ethereum, asset))
+	assert.True(t, keeper.IsAssetRegistered(ctx, evm.Ethereum, asset))
+	assert.True(t, keeper.IsNativeAsset(ctx, evm.Ethereum, asset))
+	assert.False(t, keeper.IsNativeAsset(ctx, polygon, asset))
+
+	ethSender, polygonRecipient := makeRandAddressesForChain(evm.Ethereum, polygon)
+	assert.NoError(t, keeper.LinkAddresses(ctx, ethSender, polygonRecipient))
+	polygonSender, ethRecipient := makeRandAddressesForChain(polygon, evm.Ethereum)
+	assert.NoError(t, keeper.LinkAddresses(ctx, polygonSender, ethRecipient))
+
+	// the origin chain does not need a total to send its native asset
+	assert.NoError(t, keeper.EnqueueForTransfer(ctx, ethSender, makeRandAmount(asset), feeRate))
+	transfer := keeper.GetTransfersForChain(ctx, polygon, exported.Pending)[0]
+	keeper.ArchivePendingTransfer(ctx, transfer)
+	assert.Equal(t, transfer.Asset, keeper.GetChainTotal(ctx, polygon, asset))
+
+	total := transfer.Asset.Amount.Int64()
+	assert.Error(t, keeper.EnqueueForTransfer(ctx, polygonSender, sdk.NewCoin(asset, sdk.NewInt(total+rand.I64Between(1, 100000))), feeRate))
+	assert.NoError(t, keeper.EnqueueForTransfer(ctx, polygonSender, sdk.NewCoin(asset, sdk.NewInt(total)), feeRate))
+
+	transfer = keeper.GetTransfersForChain(ctx, evm.Ethereum, exported.Pending)[0]
+	keeper.ArchivePendingTransfer(ctx, transfer)
+	assert.True(t, keeper.GetChainTotal(ctx, evm.Ethereum, asset).IsZero())
+}
+
+func TestEnqueueForTransfer_DecimalNormalization(t *testing.T) {
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	polygon := evm.Ethereum
+	polygon.Name = "polygon"
+	polygon.NativeAsset = "matic"
+	asset := "usdc"
+	noFee := sdk.ZeroDec()
+
+	assert.NoError(t, keeper.RegisterNativeAsset(ctx, evm.Ethereum, asset))
+	assert.NoError(t, keeper.SetAssetPrecision(ctx, asset, 6))
+	assert.Error(t, keeper.SetAssetPrecision(ctx, asset, 18))
+	assert.NoError(t, keeper.RegisterAssetDecimals(ctx, evm.Ethereum, asset, 6))
+	keeper.RegisterAsset(ctx, polygon, asset)
+	assert.NoError(t, keeper.RegisterAssetDecimals(ctx, polygon, asset, 18))
+
+	ethSender, polygonRecipient := makeRandAddressesForChain(evm.Ethereum, polygon)
+	assert.NoError(t, keeper.LinkAddresses(ctx, ethSender, polygonRecipient))
+	polygonSender, ethRecipient := makeRandAddressesForChain(polygon, evm.Ethereum)
+	assert.NoError(t, keeper.LinkAddresses(ctx, polygonSender, ethRecipient))
+
+	// 1 USDC is sent from the chain with 6 decimals to the chain with 18 decimals
+	assert.NoError(t, keeper.EnqueueForTransfer(ctx, ethSender, sdk.NewInt64Coin(asset, 1000000), noFee))
+	transfer := keeper.GetTransfersForChain(ctx, polygon, exported.Pending)[0]
+	assert.Equal(t, sdk.NewInt64Coin(asset, 1000000), transfer.Asset)
+
+	amount, dust, err := keeper.ToChainAmount(ctx, polygon, transfer.Asset)
+	assert.NoError(t, err)
+	assert.Equal(t, sdk.NewIntWithDecimal(1, 18), amount)
+	assert.True(t, dust.IsZero())
+
+	keeper.ArchivePendingTransfer(ctx, transfer)
+	assert.Equal(t, sdk.NewInt64Coin(asset, 1000000), keeper.GetChainTotal(ctx, polygon, asset))
+
+	// 0.5 USDC plus some dust is sent back
+	dustAmount := sdk.NewInt(rand.I64Between(1, 1000000000000))
+	assert.NoError(t, keeper.EnqueueForTransfer(ctx, polygonSender, sdk.NewCoin(asset, sdk.NewIntWithDecimal(5, 17).Add(dustAmount)), noFee))
+	transfer = keeper.GetTransfersForChain(ctx, evm.Ethereum, exported.Pending)[0]
+	assert.Equal(t, sdk.NewInt64Coin(asset, 500000), transfer.Asset)
+	assert.Equal(t, sdk.NewInt64Coin(asset, 500000), keeper.GetChainTotal(ctx, polygon, asset))
+	assert.Empty(t, keeper.GetTransfersForChain(ctx, axelarnet.Axelarnet, exported.Pending))
+
+	// once the dust adds up to one unit in canonical precision it is sent to the fee collector
+	assert.NoError(t, keeper.EnqueueForTransfer(ctx, polygonSender, sdk.NewCoin(asset, sdk.NewIntWithDecimal(1, 12).Sub(dustAmount)), noFee))
+	assert.Equal(t, sdk.NewInt64Coin(asset, 500000), keeper.GetTransfersForChain(ctx, evm.Ethereum, exported.Pending)[0].Asset)
+	feeTransfers := keeper.GetTransfersForChain(ctx, axelarnet.Axelarnet, exported.Pending)
+	assert.Len(t, feeTransfers, 1)
+	assert.Equal(t, sdk.NewInt64Coin(asset, 1), feeTransfers[0].Asset)
+	assert.Equal(t, sdk.NewInt64Coin(asset, 499999), keeper.GetChainTotal(ctx, polygon, asset))
+
+	// amounts that cannot be represented on the recipient chain are sent to the fee collector as well
+	lowPrecision := evm.Ethereum
+	lowPrecision.Name = "lowprecision"
+	lowPrecision.NativeAsset = "lp"
+	keeper.RegisterAsset(ctx, lowPrecision, asset)
+	assert.NoError(t, keeper.RegisterAssetDecimals(ctx, lowPrecision, asset, 2))
+
+	ethSender, lowPrecisionRecipient := makeRandAddressesForChain(evm.Ethereum, lowPrecision)
+	assert.NoError(t, keeper.LinkAddresses(ctx, ethSender, lowPrecisionRecipient))
+	assert.NoError(t, keeper.EnqueueForTransfer(ctx, ethSender, sdk.NewInt64Coin(asset, 1234567), noFee))
+	transfer = keeper.GetTransfersForChain(ctx, lowPrecision, exported.Pending)[0]
+
+	amount, dust, err = keeper.ToChainAmount(ctx, lowPrecision, transfer.Asset)
+	assert.NoError(t, err)
+	assert.Equal(t, sdk.NewInt(123), amount)
+	assert.Equal(t, sdk.NewInt(4567), dust)
+
+	keeper.ArchivePendingTransfer(ctx, transfer)
+	assert.Equal(t, sdk.NewInt64Coin(asset, 1230000), keeper.GetChainTotal(ctx, lowPrecision, asset))
+	feeTransfers = keeper.GetTransfersForChain(ctx, axelarnet.Axelarnet, exported.Pending)
+	assert.Len(t, feeTransfers, 2)
+}
+
+func TestEnqueueForTransfer_DustWithoutFeeCollector(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+	nexusSubspace := params.NewSubspace(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("nexusKey"), sdk.NewKVStoreKey("tNexusKey"), "nexus")
+	axelarnetKeeper := &mock.AxelarnetKeeperMock{
+		GetFeeCollectorFunc: func(sdk.Context) (sdk.AccAddress, bool) { return nil, false },
+	}
+	k := nexusKeeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("nexus"), nexusSubspace, axelarnetKeeper)
+	k.SetRouter(types.NewRouter().AddAddressValidator("evm", func(_ sdk.Context, addr nexus.CrossChainAddress) error {
+		if !evmUtil.IsHexAddress(addr.Address) {
+			return fmt.Errorf("not an hex address")
+		}
+
+		return nil
+	}))
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	k.SetParams(ctx, types.DefaultParams())
+
+	polygon := evm.Ethereum
+	polygon.Name = "polygon"
+	polygon.NativeAsset = "matic"
+	lowPrecision := evm.Ethereum
+	lowPrecision.Name = "lowprecision"
+	lowPrecision.NativeAsset = "lp"
+	asset := "usdc"
+	noFee := sdk.ZeroDec()
+
+	assert.NoError(t, k.RegisterNativeAsset(ctx, evm.Ethereum, asset))
+	assert.NoError(t, k.SetAssetPrecision(ctx, asset, 6))
+	assert.NoError(t, k.RegisterAssetDecimals(ctx, evm.Ethereum, asset, 6))
+	k.RegisterAsset(ctx, polygon, asset)
+	assert.NoError(t, k.RegisterAssetDecimals(ctx, polygon, asset, 18))
+	k.RegisterAsset(ctx, lowPrecision, asset)
+	assert.NoError(t, k.RegisterAssetDecimals(ctx, lowPrecision, asset, 2))
+
+	ethSender, polygonRecipient := makeRandAddressesForChain(evm.Ethereum, polygon)
+	assert.NoError(t, k.LinkAddresses(ctx, ethSender, polygonRecipient))
+	polygonSender, ethRecipient := makeRandAddressesForChain(polygon, evm.Ethereum)
+	assert.NoError(t, k.LinkAddresses(ctx, polygonSender, ethRecipient))
+	ethSender2, lowPrecisionRecipient := makeRandAddressesForChain(evm.Ethereum, lowPrecision)
+	assert.NoError(t, k.LinkAddresses(ctx, ethSender2, lowPrecisionRecipient))
+
+	assert.NoError(t, k.EnqueueForTransfer(ctx, ethSender, sdk.NewInt64Coin(asset, 1000000), noFee))
+	k.ArchivePendingTransfer(ctx, k.GetTransfersForChain(ctx, polygon, exported.Pending)[0])
+
+	// dust that adds up to one unit in canonical precision is burned instead of being credited to the recipient
+	dustAmount := sdk.NewInt(rand.I64Between(1, 1000000000000))
+	assert.NoError(t, k.EnqueueForTransfer(ctx, polygonSender, sdk.NewCoin(asset, sdk.NewIntWithDecimal(5, 17).Add(dustAmount)), noFee))
+	assert.NoError(t, k.EnqueueForTransfer(ctx, polygonSender, sdk.NewCoin(asset, sdk.NewIntWithDecimal(1, 12).Sub(dustAmount)), noFee))
+	assert.Equal(t, sdk.NewInt64Coin(asset, 500000), k.GetTransfersForChain(ctx, evm.Ethereum, exported.Pending)[0].Asset)
+	assert.Equal(t, sdk.NewInt64Coin(asset, 499999), k.GetChainTotal(ctx, polygon, asset))
+	assert.Empty(t, k.GetTransfersForChain(ctx, axelarnet.Axelarnet, exported.Pending))
+
+	// amounts that cannot be represented on the recipient chain are not counted towards its total
+	assert.NoError(t, k.EnqueueForTransfer(ctx, ethSender2, sdk.NewInt64Coin(asset, 1234567), noFee))
+	k.ArchivePendingTransfer(ctx, k.GetTransfersForChain(ctx, lowPrecision, exported.Pending)[0])
+	assert.Equal(t, sdk.NewInt64Coin(asset, 1230000), k.GetChainTotal(ctx, lowPrecision, asset))
+	assert.Empty(t, k.GetTransfersForChain(ctx, axelarnet.Axelarnet, exported.Pending))
+}
+
+func TestRegisterNativeAsset_KnownToOtherChain(t *testing.T) {
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	polygon := evm.Ethereum
+	polygon.Name = "polygon"
+	polygon.NativeAsset = "matic"
+	keeper.SetChain(ctx, evm.Ethereum)
+	keeper.SetChain(ctx, polygon)
+
+	registered := rand.Str(5)
+	keeper.RegisterAsset(ctx, polygon, registered)
+	assert.Error(t, keeper.RegisterNativeAsset(ctx, evm.Ethereum, registered))
+	assert.False(t, keeper.IsNativeAsset(ctx, evm.Ethereum, registered))
+
+	assert.Error(t, keeper.RegisterNativeAsset(ctx, evm.Ethereum, polygon.NativeAsset))
+	assert.False(t, keeper.IsNativeAsset(ctx, evm.Ethereum, polygon.NativeAsset))
+
+	native := rand.Str(5)
+	assert.NoError(t, keeper.RegisterNativeAsset(ctx, polygon, native))
+	assert.Error(t, keeper.RegisterNativeAsset(ctx, evm.Ethereum, native))
+	assert.ElementsMatch(t, []exported.Chain{polygon}, keeper.GetChainsWithAsset(ctx, native))
+}
+
+func TestRevertTransfer(t *testing.T) {
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	polygon := evm.Ethereum
+	polygon.Name = "polygon"
+	polygon.NativeAsset = "matic"
+	asset := "usdc"
+	assert.NoError(t, keeper.RegisterNativeAsset(ctx, evm.Ethereum, asset))
+
+	sender, recipient := makeRandAddressesForChain(evm.Ethereum, polygon)
+	assert.NoError(t, keeper.LinkAddresses(ctx, sender, recipient))
+	otherSender, _ := makeRandAddressesForChain(evm.Ethereum, polygon)
+	assert.NoError(t, keeper.LinkAddresses(ctx, otherSender, recipient))
+
+	deposit, otherDeposit := makeRandAmount(asset), makeRandAmount(asset)
+	assert.NoError(t, keeper.EnqueueForTransfer(ctx, sender, deposit, feeRate))
+	assert.NoError(t, keeper.EnqueueForTransfer(ctx, otherSender, otherDeposit, feeRate))
+
+	fee := sdk.NewDecFromInt(deposit.Amount).Mul(feeRate).TruncateInt()
+	otherFee := sdk.NewDecFromInt(otherDeposit.Amount).Mul(feeRate).TruncateInt()
+
+	// only the share of the reverted deposit is taken back from the merged transfer
+	unrecovered, err := keeper.RevertTransfer(ctx, sender, deposit, feeRate)
+	assert.NoError(t, err)
+	assert.Equal(t, sdk.NewCoin(asset, fee), unrecovered)
+
+	transfers := keeper.GetTransfersForChain(ctx, polygon, exported.Pending)
+	assert.Len(t, transfers, 1)
+	assert.Equal(t, otherDeposit.SubAmount(otherFee), transfers[0].Asset)
+
+	// released transfers cannot be taken back
+	keeper.ArchivePendingTransfer(ctx, transfers[0])
+	unrecovered, err = keeper.RevertTransfer(ctx, otherSender, otherDeposit, feeRate)
+	assert.NoError(t, err)
+	assert.Equal(t, otherDeposit, unrecovered)
+	assert.Equal(t, otherDeposit.SubAmount(otherFee), keeper.GetChainTotal(ctx, polygon, asset))
+
+	unlinked, _ := makeRandAddressesForChain(evm.Ethereum, polygon)
+	_, err = keeper.RevertTransfer(ctx, unlinked, deposit, feeRate)
+	assert.Error(t, err)
+}
+
 func TestSetChainGetChain_MixCaseChainName(t *testing.T) {
 	chainName := strings.ToUpper(rand.StrBetween(5, 10)) + strings.ToLower(rand.StrBetween(5, 10))
 	chain := exported.Chain{
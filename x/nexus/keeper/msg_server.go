@@ -3,10 +3,14 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoprototypes "github.com/gogo/protobuf/types"
 
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
 )
 
 var _ types.MsgServiceServer = msgServer{}
@@ -14,13 +18,15 @@ var _ types.MsgServiceServer = msgServer{}
 type msgServer struct {
 	types.Nexus
 	snapshotter types.Snapshotter
+	voter       types.Voter
 }
 
 // NewMsgServerImpl returns an implementation of the nexus MsgServiceServer interface for the provided Keeper.
-func NewMsgServerImpl(k types.Nexus, snapshotter types.Snapshotter) types.MsgServiceServer {
+func NewMsgServerImpl(k types.Nexus, snapshotter types.Snapshotter, voter types.Voter) types.MsgServiceServer {
 	return msgServer{
 		Nexus:       k,
 		snapshotter: snapshotter,
+		voter:       voter,
 	}
 }
 
@@ -97,3 +103,149 @@ func (s msgServer) DeregisterChainMaintainer(c context.Context, req *types.Dereg
 
 	return &types.DeregisterChainMaintainerResponse{}, nil
 }
+
+func (s msgServer) ConfirmExternalDeposit(c context.Context, req *types.ConfirmExternalDepositRequest) (*types.ConfirmExternalDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chain, ok := s.GetChain(ctx, req.Chain)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a registered chain", req.Chain)
+	}
+
+	if !s.IsChainActivated(ctx, chain) {
+		return nil, fmt.Errorf("chain %s is not activated yet", chain.Name)
+	}
+
+	adapter, ok := s.GetChainAdapter(ctx, chain)
+	if !ok {
+		return nil, fmt.Errorf("chain %s is not an external chain", chain.Name)
+	}
+
+	depositAddress := exported.CrossChainAddress{Chain: chain, Address: req.DepositAddress}
+	if err := adapter.ValidateAddress(ctx, depositAddress); err != nil {
+		return nil, err
+	}
+
+	if _, ok := s.GetRecipient(ctx, depositAddress); !ok {
+		return nil, fmt.Errorf("no recipient linked to deposit address %s on chain %s", depositAddress.Address, chain.Name)
+	}
+
+	deposit := types.NewExternalDeposit(req.TxID, depositAddress, req.Amount)
+	if s.IsExternalDepositConfirmed(ctx, deposit) {
+		return nil, fmt.Errorf("deposit %s to address %s on chain %s is already confirmed", req.TxID, req.DepositAddress, chain.Name)
+	}
+
+	params := s.GetParams(ctx)
+	pollKey := types.GetConfirmExternalDepositPollKey(deposit)
+	if err := s.voter.InitializePoll(
+		ctx,
+		pollKey,
+		s.GetChainMaintainers(ctx, chain),
+		vote.ExpiryAt(ctx.BlockHeight()+params.ExternalChainRevoteLockingPeriod),
+		vote.Threshold(params.ExternalChainVotingThreshold),
+		vote.MinVoterCount(params.ExternalChainMinVoterCount),
+		vote.RewardPool(chain.Name),
+	); err != nil {
+		return nil, err
+	}
+
+	s.SetPendingExternalDeposit(ctx, pollKey, deposit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeExternalDepositConfirmation,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueStart),
+			sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
+			sdk.NewAttribute(types.AttributeKeyTxID, req.TxID),
+			sdk.NewAttribute(types.AttributeKeyDepositAddress, req.DepositAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, req.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyPoll, string(types.ModuleCdc.MustMarshalJSON(&pollKey))),
+		),
+	)
+
+	return &types.ConfirmExternalDepositResponse{}, nil
+}
+
+func (s msgServer) VoteConfirmExternalDeposit(c context.Context, req *types.VoteConfirmExternalDepositRequest) (*types.VoteConfirmExternalDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	voter := s.snapshotter.GetOperator(ctx, req.Sender)
+	if voter.Empty() {
+		return nil, fmt.Errorf("account %v is not registered as a validator proxy", req.Sender.String())
+	}
+
+	poll := s.voter.GetPoll(ctx, req.PollKey)
+	switch {
+	case poll.Is(vote.Expired):
+		return &types.VoteConfirmExternalDepositResponse{Log: fmt.Sprintf("vote for poll %s already %s", req.PollKey, vote.Expired.String())}, nil
+	case poll.Is(vote.Failed), poll.Is(vote.Completed):
+		// If the voting threshold has been met and additional votes are received they should not return an error
+		return &types.VoteConfirmExternalDepositResponse{Log: fmt.Sprintf("vote for poll %s already %s", req.PollKey, vote.Completed.String())}, nil
+	default:
+	}
+
+	pendingDeposit, ok := s.GetPendingExternalDeposit(ctx, req.PollKey)
+	if !ok {
+		return nil, fmt.Errorf("no external deposit found for poll %s", req.PollKey.String())
+	}
+
+	voteValue := &gogoprototypes.BoolValue{Value: req.Confirmed}
+	if err := poll.Vote(voter, voteValue); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeExternalDepositConfirmation,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueVote),
+		sdk.NewAttribute(types.AttributeKeyValue, strconv.FormatBool(voteValue.Value)),
+	))
+
+	if poll.Is(vote.Pending) {
+		return &types.VoteConfirmExternalDepositResponse{Log: fmt.Sprintf("not enough votes to confirm deposit %s to %s yet", pendingDeposit.TxID, pendingDeposit.DepositAddress.Address)}, nil
+	}
+
+	if poll.Is(vote.Failed) {
+		s.DeletePendingExternalDeposit(ctx, req.PollKey)
+		return &types.VoteConfirmExternalDepositResponse{Log: fmt.Sprintf("poll %s failed", poll.GetKey())}, nil
+	}
+
+	confirmed, ok := poll.GetResult().(*gogoprototypes.BoolValue)
+	if !ok {
+		return nil, fmt.Errorf("result of poll %s has wrong type, expected bool, got %T", req.PollKey.String(), poll.GetResult())
+	}
+
+	s.Logger(ctx).Info(fmt.Sprintf("%s external deposit confirmation result is %s", pendingDeposit.DepositAddress.Chain.Name, poll.GetResult()))
+	s.DeletePendingExternalDeposit(ctx, req.PollKey)
+
+	event := sdk.NewEvent(types.EventTypeExternalDepositConfirmation,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyChain, pendingDeposit.DepositAddress.Chain.Name),
+		sdk.NewAttribute(types.AttributeKeyTxID, pendingDeposit.TxID),
+		sdk.NewAttribute(types.AttributeKeyDepositAddress, pendingDeposit.DepositAddress.Address),
+		sdk.NewAttribute(types.AttributeKeyAmount, pendingDeposit.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyPoll, string(types.ModuleCdc.MustMarshalJSON(&req.PollKey))))
+	defer func() { ctx.EventManager().EmitEvent(event) }()
+
+	if !confirmed.Value {
+		poll.AllowOverride()
+		event = event.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueReject))
+		return &types.VoteConfirmExternalDepositResponse{
+			Log: fmt.Sprintf("deposit %s to %s was discarded", pendingDeposit.TxID, pendingDeposit.DepositAddress.Address),
+		}, nil
+	}
+	event = event.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueConfirm))
+
+	if s.IsExternalDepositConfirmed(ctx, pendingDeposit) {
+		return nil, fmt.Errorf("deposit %s to address %s is already confirmed", pendingDeposit.TxID, pendingDeposit.DepositAddress.Address)
+	}
+
+	if err := s.EnqueueForTransfer(ctx, pendingDeposit.DepositAddress, pendingDeposit.Amount, s.GetParams(ctx).ExternalChainTransactionFeeRate); err != nil {
+		return nil, err
+	}
+	s.SetExternalDepositConfirmed(ctx, pendingDeposit)
+
+	return &types.VoteConfirmExternalDepositResponse{
+		Log: fmt.Sprintf("deposit %s to %s was confirmed", pendingDeposit.TxID, pendingDeposit.DepositAddress.Address),
+	}, nil
+}
@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoprototypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	nexusMock "github.com/axelarnetwork/axelar-core/x/nexus/exported/mock"
+	nexusKeeper "github.com/axelarnetwork/axelar-core/x/nexus/keeper"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types/mock"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	voteMock "github.com/axelarnetwork/axelar-core/x/vote/exported/mock"
+)
+
+func TestExternalDepositConfirmation(t *testing.T) {
+	var (
+		ctx       sdk.Context
+		n         *mock.NexusMock
+		v         *mock.VoterMock
+		poll      *voteMock.PollMock
+		adapter   *nexusMock.ChainAdapterMock
+		server    types.MsgServiceServer
+		msg       *types.ConfirmExternalDepositRequest
+		voteReq   *types.VoteConfirmExternalDepositRequest
+		confirmed map[string]bool
+		pending   *types.ExternalDeposit
+		result    bool
+	)
+
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+		confirmed = make(map[string]bool)
+		pending = nil
+		result = true
+
+		msg = types.NewConfirmExternalDepositRequest(
+			rand.AccAddr(),
+			externalChain.Name,
+			rand.HexStr(64),
+			"ext1"+rand.HexStr(38),
+			sdk.NewInt64Coin(externalChain.NativeAsset, rand.PosI64()),
+		)
+		deposit := types.NewExternalDeposit(msg.TxID, exported.CrossChainAddress{Chain: externalChain, Address: msg.DepositAddress}, msg.Amount)
+		voteReq = types.NewVoteConfirmExternalDepositRequest(rand.AccAddr(), types.GetConfirmExternalDepositPollKey(deposit), true)
+
+		adapter = &nexusMock.ChainAdapterMock{
+			ValidateAddressFunc: func(_ sdk.Context, addr exported.CrossChainAddress) error {
+				if !strings.HasPrefix(addr.Address, "ext1") {
+					return fmt.Errorf("not an external chain address")
+				}
+
+				return nil
+			},
+		}
+		poll = &voteMock.PollMock{
+			VoteFunc: func(sdk.ValAddress, codec.ProtoMarshaler) error { return nil },
+			// the poll completes with the first vote
+			IsFunc: func(state vote.PollState) bool {
+				if len(poll.VoteCalls()) == 0 {
+					return state == vote.Pending
+				}
+				return state == vote.Completed
+			},
+			GetResultFunc:     func() codec.ProtoMarshaler { return &gogoprototypes.BoolValue{Value: result} },
+			AllowOverrideFunc: func() {},
+		}
+		v = &mock.VoterMock{
+			InitializePollFunc: func(sdk.Context, vote.PollKey, []sdk.ValAddress, ...vote.PollProperty) error { return nil },
+			GetPollFunc:        func(sdk.Context, vote.PollKey) vote.Poll { return poll },
+		}
+
+		chains := map[string]exported.Chain{externalChain.Name: externalChain, evm.Ethereum.Name: evm.Ethereum}
+		n = &mock.NexusMock{
+			LoggerFunc:              func(sdk.Context) log.Logger { return log.TestingLogger() },
+			GetParamsFunc:           func(sdk.Context) types.Params { return types.DefaultParams() },
+			GetChainMaintainersFunc: func(sdk.Context, exported.Chain) []sdk.ValAddress { return []sdk.ValAddress{} },
+			IsChainActivatedFunc:    func(sdk.Context, exported.Chain) bool { return true },
+			GetChainFunc: func(_ sdk.Context, chain string) (exported.Chain, bool) {
+				c, ok := chains[chain]
+				return c, ok
+			},
+			GetChainAdapterFunc: func(_ sdk.Context, chain exported.Chain) (exported.ChainAdapter, bool) {
+				if chain.Name != externalChain.Name {
+					return nil, false
+				}
+				return adapter, true
+			},
+			GetRecipientFunc: func(sdk.Context, exported.CrossChainAddress) (exported.CrossChainAddress, bool) {
+				return exported.CrossChainAddress{Chain: evm.Ethereum, Address: "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8"}, true
+			},
+			EnqueueForTransferFunc: func(sdk.Context, exported.CrossChainAddress, sdk.Coin, sdk.Dec) error { return nil },
+			SetPendingExternalDepositFunc: func(_ sdk.Context, _ vote.PollKey, deposit types.ExternalDeposit) {
+				pending = &deposit
+			},
+			GetPendingExternalDepositFunc: func(sdk.Context, vote.PollKey) (types.ExternalDeposit, bool) {
+				return deposit, true
+			},
+			DeletePendingExternalDepositFunc: func(sdk.Context, vote.PollKey) {},
+			SetExternalDepositConfirmedFunc: func(_ sdk.Context, deposit types.ExternalDeposit) {
+				confirmed[deposit.TxID] = true
+			},
+			IsExternalDepositConfirmedFunc: func(_ sdk.Context, deposit types.ExternalDeposit) bool {
+				return confirmed[deposit.TxID]
+			},
+		}
+
+		server = nexusKeeper.NewMsgServerImpl(n, &mock.SnapshotterMock{
+			GetOperatorFunc: func(sdk.Context, sdk.AccAddress) sdk.ValAddress { return rand.ValAddr() },
+		}, v)
+	}
+
+	repeats := 20
+	t.Run("should start a poll for the external deposit", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := server.ConfirmExternalDeposit(sdk.WrapSDKContext(ctx), msg)
+
+		assert.NoError(t, err)
+		assert.Len(t, testutils.Events(ctx.EventManager().ABCIEvents()).Filter(func(event abci.Event) bool {
+			return event.Type == types.EventTypeExternalDepositConfirmation
+		}), 1)
+		assert.Len(t, v.InitializePollCalls(), 1)
+		assert.Equal(t, voteReq.PollKey, v.InitializePollCalls()[0].Key)
+		assert.NotNil(t, pending)
+		assert.Equal(t, msg.TxID, pending.TxID)
+	}).Repeat(repeats))
+
+	t.Run("should return error when the chain is not an external chain", testutils.Func(func(t *testing.T) {
+		setup()
+		msg.Chain = evm.Ethereum.Name
+
+		_, err := server.ConfirmExternalDeposit(sdk.WrapSDKContext(ctx), msg)
+
+		assert.Error(t, err)
+		assert.Len(t, v.InitializePollCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should return error when the adapter rejects the deposit address", testutils.Func(func(t *testing.T) {
+		setup()
+		msg.DepositAddress = rand.HexStr(42)
+
+		_, err := server.ConfirmExternalDeposit(sdk.WrapSDKContext(ctx), msg)
+
+		assert.Error(t, err)
+		assert.Len(t, v.InitializePollCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should return error when the deposit is already confirmed", testutils.Func(func(t *testing.T) {
+		setup()
+		confirmed[msg.TxID] = true
+
+		_, err := server.ConfirmExternalDeposit(sdk.WrapSDKContext(ctx), msg)
+
+		assert.Error(t, err)
+		assert.Len(t, v.InitializePollCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should enqueue the deposit for transfer when confirmed", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := server.VoteConfirmExternalDeposit(sdk.WrapSDKContext(ctx), voteReq)
+
+		assert.NoError(t, err)
+		assert.Len(t, n.DeletePendingExternalDepositCalls(), 1)
+		assert.Len(t, n.EnqueueForTransferCalls(), 1)
+		assert.Equal(t, msg.Amount, n.EnqueueForTransferCalls()[0].Asset)
+		assert.Equal(t, types.DefaultParams().ExternalChainTransactionFeeRate, n.EnqueueForTransferCalls()[0].FeeRate)
+		assert.True(t, confirmed[msg.TxID])
+	}).Repeat(repeats))
+
+	t.Run("should discard the deposit when rejected", testutils.Func(func(t *testing.T) {
+		setup()
+		result = false
+
+		_, err := server.VoteConfirmExternalDeposit(sdk.WrapSDKContext(ctx), voteReq)
+
+		assert.NoError(t, err)
+		assert.Len(t, n.EnqueueForTransferCalls(), 0)
+		assert.Len(t, poll.AllowOverrideCalls(), 1)
+		assert.False(t, confirmed[msg.TxID])
+	}).Repeat(repeats))
+}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+)
+
+// SetAssetPrecision sets the canonical precision nexus uses to account for the given asset
+func (k Keeper) SetAssetPrecision(ctx sdk.Context, asset string, decimals uint32) error {
+	precision := types.AssetPrecision{Asset: asset, Decimals: decimals}
+	if err := precision.Validate(); err != nil {
+		return err
+	}
+
+	if registered, ok := k.GetAssetPrecision(ctx, asset); ok {
+		if registered != decimals {
+			return fmt.Errorf("asset %s already has a precision of %d decimals", asset, registered)
+		}
+
+		return nil
+	}
+
+	k.setAssetPrecision(ctx, precision)
+	return nil
+}
+
+func (k Keeper) setAssetPrecision(ctx sdk.Context, precision types.AssetPrecision) {
+	k.getStore(ctx).Set(assetPrecisionPrefix.Append(utils.KeyFromStr(precision.Asset)), &precision)
+}
+
+// GetAssetPrecision returns the canonical precision of the given asset
+func (k Keeper) GetAssetPrecision(ctx sdk.Context, asset string) (uint32, bool) {
+	var precision types.AssetPrecision
+	if !k.getStore(ctx).Get(assetPrecisionPrefix.Append(utils.KeyFromStr(asset)), &precision) {
+		return 0, false
+	}
+
+	return precision.Decimals, true
+}
+
+func (k Keeper) getAssetPrecisions(ctx sdk.Context) []types.AssetPrecision {
+	iter := k.getStore(ctx).Iterator(assetPrecisionPrefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	var precisions []types.AssetPrecision
+	for ; iter.Valid(); iter.Next() {
+		var precision types.AssetPrecision
+		iter.UnmarshalValue(&precision)
+
+		precisions = append(precisions, precision)
+	}
+
+	return precisions
+}
+
+// RegisterAssetDecimals sets the decimals the given asset is represented with on the given chain
+func (k Keeper) RegisterAssetDecimals(ctx sdk.Context, chain exported.Chain, asset string, decimals uint32) error {
+	chainState, _ := k.getChainState(ctx, chain)
+	chainState.Chain = chain
+	if err := chainState.SetAssetDecimals(asset, decimals); err != nil {
+		return err
+	}
+
+	k.setChainState(ctx, chainState)
+	return nil
+}
+
+// getPrecisions returns the decimals of the asset on the given chain and its canonical precision.
+// Assets without registered precisions are transferred without conversion
+func (k Keeper) getPrecisions(ctx sdk.Context, chain exported.Chain, asset string) (uint32, uint32, bool) {
+	canonical, ok := k.GetAssetPrecision(ctx, asset)
+	if !ok {
+		return 0, 0, false
+	}
+
+	chainState, ok := k.getChainState(ctx, chain)
+	if !ok {
+		return 0, 0, false
+	}
+
+	decimals, ok := chainState.GetAssetDecimals(asset)
+	if !ok {
+		return 0, 0, false
+	}
+
+	return decimals, canonical, true
+}
+
+// ToChainAmount converts the given amount in canonical precision into the precision of the given chain.
+// It returns the converted amount and the dust lost to rounding in canonical precision
+func (k Keeper) ToChainAmount(ctx sdk.Context, chain exported.Chain, coin sdk.Coin) (sdk.Int, sdk.Int, error) {
+	decimals, canonical, ok := k.getPrecisions(ctx, chain, coin.Denom)
+	if !ok {
+		return coin.Amount, sdk.ZeroInt(), nil
+	}
+
+	return types.ScaleAmount(coin.Amount, canonical, decimals)
+}
+
+// toCanonicalAmount converts the given amount in the precision of the given chain into canonical precision.
+// It returns the converted amount and the dust lost to rounding in the chain's precision
+func (k Keeper) toCanonicalAmount(ctx sdk.Context, chain exported.Chain, coin sdk.Coin) (sdk.Coin, sdk.Int, error) {
+	decimals, canonical, ok := k.getPrecisions(ctx, chain, coin.Denom)
+	if !ok {
+		return coin, sdk.ZeroInt(), nil
+	}
+
+	amount, dust, err := types.ScaleAmount(coin.Amount, decimals, canonical)
+	if err != nil {
+		return sdk.Coin{}, sdk.Int{}, err
+	}
+
+	return sdk.NewCoin(coin.Denom, amount), dust, nil
+}
+
+// collectDust adds the given dust in the chain's precision to the chain's accumulated dust,
+// and returns the amount in canonical precision that the accumulated dust has grown into
+func (k Keeper) collectDust(ctx sdk.Context, chain exported.Chain, dust sdk.Coin) (sdk.Int, error) {
+	decimals, canonical, ok := k.getPrecisions(ctx, chain, dust.Denom)
+	if !ok || !dust.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+
+	chainState, _ := k.getChainState(ctx, chain)
+	accumulated := chainState.Dust.Add(dust)
+
+	collected, remainder, err := types.ScaleAmount(accumulated.AmountOf(dust.Denom), decimals, canonical)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	// only keep the remainder that is still too small to be represented in canonical precision
+	chainState.Dust = accumulated.Sub(sdk.NewCoins(sdk.NewCoin(dust.Denom, accumulated.AmountOf(dust.Denom).Sub(remainder))))
+	k.setChainState(ctx, chainState)
+
+	return collected, nil
+}
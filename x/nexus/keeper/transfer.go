@@ -50,14 +50,17 @@ func (k Keeper) setNewPendingTransfer(ctx sdk.Context, recipient exported.CrossC
 	k.setNonce(ctx, id+1)
 }
 
-// EnqueueForTransfer appoints the amount of tokens to be transfered/minted to the recipient previously linked to the specified sender
+// EnqueueForTransfer appoints the amount of tokens to be transfered/minted to the recipient previously linked to the specified sender.
+// The amount is given in the sender chain's precision of the asset and gets converted into the asset's canonical precision
 func (k Keeper) EnqueueForTransfer(ctx sdk.Context, sender exported.CrossChainAddress, asset sdk.Coin, feeRate sdk.Dec) error {
-	if !sender.Chain.SupportsForeignAssets && sender.Chain.NativeAsset != asset.Denom {
+	senderIsOrigin := k.IsNativeAsset(ctx, sender.Chain, asset.Denom)
+	if !sender.Chain.SupportsForeignAssets && !senderIsOrigin {
 		return fmt.Errorf("sender's chain %s does not support foreign assets", sender.Chain.Name)
 	}
 
-	if sender.Chain.NativeAsset != asset.Denom && k.getChainTotal(ctx, sender.Chain, asset.Denom).IsLT(asset) {
-		return fmt.Errorf("not enough funds available for asset '%s' in chain %s", asset.Denom, sender.Chain.Name)
+	asset, dust, err := k.toCanonicalAmount(ctx, sender.Chain, asset)
+	if err != nil {
+		return err
 	}
 
 	recipient, ok := k.GetRecipient(ctx, sender)
@@ -69,19 +72,40 @@ func (k Keeper) EnqueueForTransfer(ctx sdk.Context, sender exported.CrossChainAd
 		return fmt.Errorf("recipient's chain %s does not support foreign assets", recipient.Chain.Name)
 	}
 
+	// rounding dust is collected as a fee once it adds up to at least one unit in canonical precision
+	collectedDust, err := k.collectDust(ctx, sender.Chain, sdk.NewCoin(asset.Denom, dust))
+	if err != nil {
+		return err
+	}
+
+	// the collected dust leaves the sender chain together with the transferred asset
+	if !senderIsOrigin && k.GetChainTotal(ctx, sender.Chain, asset.Denom).IsLT(asset.AddAmount(collectedDust)) {
+		return fmt.Errorf("not enough funds available for asset '%s' in chain %s", asset.Denom, sender.Chain.Name)
+	}
+	// the fee is deducted from the asset below, but leaves the sender chain as well
+	burned := asset.AddAmount(collectedDust)
+
 	// collect fee
 	// TODO: this should be now done upon mint/withdrawl rather than per individual transfer
 	feeCollector, ok := k.axelarnetKeeper.GetFeeCollector(ctx)
 	feeDue := sdk.NewDecFromInt(asset.Amount).Mul(feeRate).TruncateInt()
-	if ok && feeDue.IsPositive() {
+	// without a fee collector the collected dust is burned and never reaches the recipient
+	if ok && feeDue.Add(collectedDust).IsPositive() {
 		asset.Amount = asset.Amount.Sub(feeDue)
-		fee := sdk.NewCoin(asset.Denom, feeDue)
+		fee := sdk.NewCoin(asset.Denom, feeDue.Add(collectedDust))
 		feeRecipient := exported.CrossChainAddress{Chain: axelarnet.Axelarnet, Address: feeCollector.String()}
 		k.setNewPendingTransfer(ctx, feeRecipient, fee)
 	}
 
-	if sender.Chain.NativeAsset != asset.Denom {
-		k.subtractFromChainTotal(ctx, sender.Chain, asset)
+	if !senderIsOrigin {
+		k.subtractFromChainTotal(ctx, sender.Chain, burned)
+	}
+
+	// the whole amount was lost to rounding
+	if asset.IsZero() {
+		k.Logger(ctx).Info(fmt.Sprintf("deposit from %s in %s is too small to be transferred and was collected as dust",
+			sender.Address, sender.Chain.Name))
+		return nil
 	}
 
 	// merging transfers for the specified recipient
@@ -98,6 +122,47 @@ func (k Keeper) EnqueueForTransfer(ctx sdk.Context, sender exported.CrossChainAd
 	return nil
 }
 
+// RevertTransfer takes back the share of a deposit from the given sender that is still pending for the linked recipient,
+// e.g. because the deposit was reorged out of the sender chain after it had been enqueued.
+// The amount is given in the sender chain's precision of the asset. Returns the amount in canonical precision
+// that could not be taken back, because it has been released or collected as a fee already
+func (k Keeper) RevertTransfer(ctx sdk.Context, sender exported.CrossChainAddress, asset sdk.Coin, feeRate sdk.Dec) (sdk.Coin, error) {
+	asset, _, err := k.toCanonicalAmount(ctx, sender.Chain, asset)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	recipient, ok := k.GetRecipient(ctx, sender)
+	if !ok {
+		return sdk.Coin{}, fmt.Errorf("no recipient linked to sender %s", sender.String())
+	}
+
+	// the recipient was only credited with the amount left after the fee
+	credited := asset
+	if _, ok := k.axelarnetKeeper.GetFeeCollector(ctx); ok {
+		credited = credited.SubAmount(sdk.NewDecFromInt(asset.Amount).Mul(feeRate).TruncateInt())
+	}
+
+	reverted := sdk.NewCoin(asset.Denom, sdk.ZeroInt())
+	if transfer, found := k.getPendingTransferForRecipientAndAsset(ctx, recipient, asset.Denom); found {
+		reverted = sdk.NewCoin(asset.Denom, sdk.MinInt(credited.Amount, transfer.Asset.Amount))
+
+		// pending transfers merge all deposits for the recipient, so only the deposit's share is taken back
+		k.deleteTransfer(ctx, transfer)
+		if remaining := transfer.Asset.Sub(reverted); remaining.IsPositive() {
+			transfer.Asset = remaining
+			k.setTransfer(ctx, transfer)
+		}
+	}
+
+	// the reverted amount never left the sender chain
+	if !k.IsNativeAsset(ctx, sender.Chain, asset.Denom) && reverted.IsPositive() {
+		k.restoreChainTotal(ctx, sender.Chain, reverted)
+	}
+
+	return asset.Sub(reverted), nil
+}
+
 func (k Keeper) getPendingTransferForRecipientAndAsset(ctx sdk.Context, recipient exported.CrossChainAddress, denom string) (exported.CrossChainTransfer, bool) {
 	iter := k.getStore(ctx).Iterator(getTransferPrefix(recipient.Chain.Name, exported.Pending))
 	defer utils.CloseLogError(iter, k.Logger(ctx))
@@ -121,10 +186,20 @@ func (k Keeper) ArchivePendingTransfer(ctx sdk.Context, transfer exported.CrossC
 	transfer.State = exported.Archived
 	k.setTransfer(ctx, transfer)
 
+	// the part of the amount that cannot be represented in the recipient chain's precision is collected as a fee
+	released := transfer.Asset
+	// without a fee collector the dust is burned, it cannot be released to the recipient chain either way
+	if _, dust, err := k.ToChainAmount(ctx, transfer.Recipient.Chain, transfer.Asset); err == nil && dust.IsPositive() {
+		released = released.SubAmount(dust)
+		if feeCollector, ok := k.axelarnetKeeper.GetFeeCollector(ctx); ok {
+			feeRecipient := exported.CrossChainAddress{Chain: axelarnet.Axelarnet, Address: feeCollector.String()}
+			k.setNewPendingTransfer(ctx, feeRecipient, sdk.NewCoin(transfer.Asset.Denom, dust))
+		}
+	}
+
 	// Update the total nexus for the chain if it is a foreign asset
-	info, _ := k.GetChain(ctx, transfer.Recipient.Chain.Name)
-	if info.NativeAsset != transfer.Asset.Denom {
-		k.AddToChainTotal(ctx, transfer.Recipient.Chain, transfer.Asset)
+	if !k.IsNativeAsset(ctx, transfer.Recipient.Chain, transfer.Asset.Denom) && released.IsPositive() {
+		k.AddToChainTotal(ctx, transfer.Recipient.Chain, released)
 	}
 }
 
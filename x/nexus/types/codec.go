@@ -5,12 +5,17 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoprototypes "github.com/gogo/protobuf/types"
+
+	reward "github.com/axelarnetwork/axelar-core/x/reward/exported"
 )
 
 // RegisterLegacyAminoCodec registers concrete types on codec
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&RegisterChainMaintainerRequest{}, "nexus/RegisterChainMaintainer", nil)
 	cdc.RegisterConcrete(&DeregisterChainMaintainerRequest{}, "nexus/DeregisterChainMaintainer", nil)
+	cdc.RegisterConcrete(&ConfirmExternalDepositRequest{}, "nexus/ConfirmExternalDeposit", nil)
+	cdc.RegisterConcrete(&VoteConfirmExternalDepositRequest{}, "nexus/VoteConfirmExternalDeposit", nil)
 }
 
 // RegisterInterfaces registers types and interfaces with the given registry
@@ -18,6 +23,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&RegisterChainMaintainerRequest{},
 		&DeregisterChainMaintainerRequest{},
+		&ConfirmExternalDepositRequest{},
+		&VoteConfirmExternalDepositRequest{},
+	)
+	registry.RegisterImplementations((*codec.ProtoMarshaler)(nil),
+		&gogoprototypes.BoolValue{},
+	)
+
+	registry.RegisterImplementations((*reward.Refundable)(nil),
+		&VoteConfirmExternalDepositRequest{},
 	)
 }
 
@@ -4,12 +4,19 @@ package types
 const (
 	EventTypeChain           = "chain"
 	EventTypeChainMaintainer = "chainMaintainer"
+
+	EventTypeExternalDepositConfirmation = "externalDepositConfirmation"
 )
 
 // Event attribute keys
 const (
 	AttributeKeyChain                  = "chain"
 	AttributeKeyChainMaintainerAddress = "chainMaintainerAddress"
+	AttributeKeyTxID                   = "txID"
+	AttributeKeyDepositAddress         = "depositAddress"
+	AttributeKeyAmount                 = "amount"
+	AttributeKeyPoll                   = "poll"
+	AttributeKeyValue                  = "value"
 )
 
 // Event attribute values
@@ -17,4 +24,8 @@ const (
 	AttributeValueRegister   = "register"
 	AttributeValueDeregister = "deregister"
 	AttributeValueActivated  = "activated"
+	AttributeValueStart      = "start"
+	AttributeValueVote       = "vote"
+	AttributeValueConfirm    = "confirm"
+	AttributeValueReject     = "reject"
 )
@@ -8,9 +8,10 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
 )
 
-//go:generate moq -out ./mock/expected_keepers.go -pkg mock . Nexus Snapshotter AxelarnetKeeper
+//go:generate moq -out ./mock/expected_keepers.go -pkg mock . Nexus Snapshotter AxelarnetKeeper Voter
 
 // Nexus provides functionality to manage cross-chain transfers
 type Nexus interface {
@@ -32,6 +33,14 @@ type Nexus interface {
 	GetChainMaintainers(ctx sdk.Context, chain exported.Chain) []sdk.ValAddress
 	LatestDepositAddress(c context.Context, req *LatestDepositAddressRequest) (*LatestDepositAddressResponse, error)
 	LinkAddresses(ctx sdk.Context, sender exported.CrossChainAddress, recipient exported.CrossChainAddress) error
+	GetRecipient(ctx sdk.Context, depositAddress exported.CrossChainAddress) (exported.CrossChainAddress, bool)
+	EnqueueForTransfer(ctx sdk.Context, sender exported.CrossChainAddress, asset sdk.Coin, feeRate sdk.Dec) error
+	GetChainAdapter(ctx sdk.Context, chain exported.Chain) (exported.ChainAdapter, bool)
+	SetPendingExternalDeposit(ctx sdk.Context, pollKey vote.PollKey, deposit ExternalDeposit)
+	GetPendingExternalDeposit(ctx sdk.Context, pollKey vote.PollKey) (ExternalDeposit, bool)
+	DeletePendingExternalDeposit(ctx sdk.Context, pollKey vote.PollKey)
+	SetExternalDepositConfirmed(ctx sdk.Context, deposit ExternalDeposit)
+	IsExternalDepositConfirmed(ctx sdk.Context, deposit ExternalDeposit) bool
 }
 
 // Snapshotter provides functionality to the snapshot module
@@ -39,6 +48,12 @@ type Snapshotter interface {
 	GetOperator(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress
 }
 
+// Voter provides functionality to manage polls
+type Voter interface {
+	InitializePoll(ctx sdk.Context, key vote.PollKey, voters []sdk.ValAddress, pollProperties ...vote.PollProperty) error
+	GetPoll(ctx sdk.Context, pollKey vote.PollKey) vote.Poll
+}
+
 // StakingKeeper provides functionality to the staking module
 type StakingKeeper interface {
 	Validator(ctx sdk.Context, addr sdk.ValAddress) stakingtypes.ValidatorI
@@ -2,10 +2,13 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 
 	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
+	bitcoin "github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
 	evm "github.com/axelarnetwork/axelar-core/x/evm/exported"
 	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -19,6 +22,8 @@ func NewGenesisState(
 	chainStates []ChainState,
 	linkedAddresses []LinkedAddresses,
 	transfers []exported.CrossChainTransfer,
+	assetPrecisions []AssetPrecision,
+	confirmedExternalDeposits []ExternalDeposit,
 ) *GenesisState {
 	return &GenesisState{
 		Params:          params,
@@ -27,6 +32,9 @@ func NewGenesisState(
 		ChainStates:     chainStates,
 		LinkedAddresses: linkedAddresses,
 		Transfers:       transfers,
+		AssetPrecisions: assetPrecisions,
+
+		ConfirmedExternalDeposits: confirmedExternalDeposits,
 	}
 }
 
@@ -39,6 +47,13 @@ func DefaultGenesisState() *GenesisState {
 		[]ChainState{},
 		[]LinkedAddresses{},
 		[]exported.CrossChainTransfer{},
+		// the precisions of the native assets of the default chains, tokens on other chains are converted from them
+		[]AssetPrecision{
+			{Asset: evm.Ethereum.NativeAsset, Decimals: 18},
+			{Asset: axelarnet.Axelarnet.NativeAsset, Decimals: 6},
+			{Asset: bitcoin.Bitcoin.NativeAsset, Decimals: 8},
+		},
+		[]ExternalDeposit{},
 	)
 }
 
@@ -72,6 +87,32 @@ func (m GenesisState) Validate() error {
 		}
 	}
 
+	assetSeen := make(map[string]bool)
+	for _, precision := range m.AssetPrecisions {
+		if err := precision.Validate(); err != nil {
+			return getValidateError(err)
+		}
+
+		if assetSeen[precision.Asset] {
+			return getValidateError(fmt.Errorf("duplicate precision for asset %s", precision.Asset))
+		}
+		assetSeen[precision.Asset] = true
+	}
+
+	depositSeen := make(map[string]bool)
+	for _, deposit := range m.ConfirmedExternalDeposits {
+		if err := deposit.Validate(); err != nil {
+			return getValidateError(err)
+		}
+
+		key := strings.ToLower(fmt.Sprintf("%s_%s_%s", deposit.DepositAddress.Chain.Name, deposit.TxID, deposit.DepositAddress.Address))
+		if depositSeen[key] {
+			return getValidateError(fmt.Errorf("duplicate external deposit %s on chain %s", deposit.TxID, deposit.DepositAddress.Chain.Name))
+		}
+
+		depositSeen[key] = true
+	}
+
 	return nil
 }
 
@@ -32,6 +32,11 @@ type GenesisState struct {
 	ChainStates     []ChainState                  `protobuf:"bytes,4,rep,name=chain_states,json=chainStates,proto3" json:"chain_states"`
 	LinkedAddresses []LinkedAddresses             `protobuf:"bytes,5,rep,name=linked_addresses,json=linkedAddresses,proto3" json:"linked_addresses"`
 	Transfers       []exported.CrossChainTransfer `protobuf:"bytes,6,rep,name=transfers,proto3" json:"transfers"`
+	// canonical precisions of the assets transferred through nexus
+	AssetPrecisions []AssetPrecision `protobuf:"bytes,7,rep,name=asset_precisions,json=assetPrecisions,proto3" json:"asset_precisions"`
+	// deposits on chains connected through a chain adapter that have been
+	// confirmed already
+	ConfirmedExternalDeposits []ExternalDeposit `protobuf:"bytes,8,rep,name=confirmed_external_deposits,json=confirmedExternalDeposits,proto3" json:"confirmed_external_deposits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -74,31 +79,35 @@ func init() {
 func init() { proto.RegisterFile("nexus/v1beta1/genesis.proto", fileDescriptor_d58ead19bb1ba601) }
 
 var fileDescriptor_d58ead19bb1ba601 = []byte{
-	// 371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x4f, 0x4f, 0xe2, 0x40,
-	0x18, 0xc6, 0x5b, 0xfe, 0x34, 0xd9, 0x81, 0xcd, 0x6e, 0x1a, 0x36, 0x29, 0xdd, 0xec, 0x2c, 0xe1,
-	0x44, 0x4c, 0x6c, 0x03, 0x1c, 0x3d, 0x89, 0x07, 0x2f, 0x46, 0x09, 0x7a, 0xf2, 0x42, 0x86, 0xf6,
-	0xb5, 0x34, 0xc0, 0x4c, 0x33, 0x33, 0x68, 0xfd, 0x16, 0x7e, 0x2c, 0x8e, 0x1c, 0x3d, 0x19, 0x85,
-	0x8f, 0xe1, 0xc5, 0x30, 0x9d, 0xa2, 0x25, 0xf1, 0x36, 0xef, 0xfb, 0x3c, 0xcf, 0x2f, 0xcf, 0xe4,
-	0x45, 0x7f, 0x29, 0xa4, 0x4b, 0xe1, 0xdf, 0x77, 0x27, 0x20, 0x49, 0xd7, 0x8f, 0x80, 0x82, 0x88,
-	0x85, 0x97, 0x70, 0x26, 0x99, 0xfd, 0x53, 0x89, 0x9e, 0x16, 0xdd, 0x46, 0xc4, 0x22, 0xa6, 0x14,
-	0x7f, 0xf7, 0xca, 0x4c, 0xae, 0x5b, 0x24, 0x24, 0x84, 0x93, 0x85, 0x06, 0xb8, 0xed, 0x4c, 0x83,
-	0x34, 0x61, 0x5c, 0x42, 0xb8, 0x37, 0xc9, 0xc7, 0x04, 0x72, 0x4f, 0xb3, 0x98, 0xff, 0x22, 0xb5,
-	0xdf, 0x4b, 0xa8, 0x7e, 0x9e, 0x35, 0xba, 0x96, 0x44, 0x82, 0xdd, 0x47, 0x56, 0xc6, 0x77, 0xcc,
-	0x96, 0xd9, 0xa9, 0xf5, 0xfe, 0x78, 0x85, 0x86, 0xde, 0x50, 0x89, 0x83, 0xca, 0xea, 0xe5, 0xbf,
-	0x31, 0xd2, 0x56, 0xbb, 0x81, 0xaa, 0x94, 0xd1, 0x00, 0x9c, 0x52, 0xcb, 0xec, 0x54, 0x46, 0xd9,
-	0x60, 0x9f, 0x20, 0x2b, 0x98, 0x92, 0x98, 0x0a, 0xa7, 0xdc, 0x2a, 0x77, 0x6a, 0xbd, 0x7f, 0x1a,
-	0x95, 0x77, 0xdd, 0x33, 0xcf, 0x76, 0xae, 0x1c, 0x99, 0x45, 0xec, 0x01, 0xaa, 0xab, 0xd7, 0x58,
-	0xec, 0x6a, 0x09, 0xa7, 0xa2, 0x10, 0xcd, 0x83, 0x36, 0x2a, 0xa9, 0x8a, 0xeb, 0x78, 0x2d, 0xd8,
-	0x6f, 0x84, 0x7d, 0x85, 0x7e, 0xcf, 0x63, 0x3a, 0x83, 0x70, 0x4c, 0xc2, 0x90, 0x83, 0x10, 0x20,
-	0x9c, 0xaa, 0xe2, 0xe0, 0x03, 0xce, 0x85, 0xb2, 0x9d, 0xe6, 0x2e, 0x0d, 0xfb, 0x35, 0x2f, 0xae,
-	0xed, 0x4b, 0xf4, 0x43, 0x72, 0x42, 0xc5, 0x1d, 0x70, 0xe1, 0x58, 0x8a, 0x74, 0xf4, 0xed, 0xa7,
-	0x38, 0x13, 0x42, 0xf5, 0xbb, 0xd1, 0x11, 0x4d, 0xfd, 0x44, 0x0c, 0x86, 0xab, 0x37, 0x6c, 0xac,
-	0x36, 0xd8, 0x5c, 0x6f, 0xb0, 0xf9, 0xba, 0xc1, 0xe6, 0xd3, 0x16, 0x1b, 0xeb, 0x2d, 0x36, 0x9e,
-	0xb7, 0xd8, 0xb8, 0xed, 0x45, 0xb1, 0x9c, 0x2e, 0x27, 0x5e, 0xc0, 0x16, 0x3e, 0x49, 0x61, 0x4e,
-	0x38, 0x05, 0xf9, 0xc0, 0xf8, 0x4c, 0x4f, 0xc7, 0x01, 0xe3, 0xe0, 0xa7, 0x7e, 0x76, 0x5d, 0x75,
-	0xd5, 0x89, 0xa5, 0xce, 0xda, 0xff, 0x08, 0x00, 0x00, 0xff, 0xff, 0x06, 0x78, 0xde, 0xd8, 0x75,
-	0x02, 0x00, 0x00,
+	// 437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x13, 0xd6, 0x15, 0x70, 0x87, 0x98, 0xac, 0x21, 0xb9, 0x9d, 0x30, 0xd5, 0x4e, 0x15,
+	0x12, 0x89, 0xd6, 0x1d, 0x39, 0xad, 0x80, 0xb8, 0xa0, 0x51, 0x0d, 0x4e, 0x5c, 0x22, 0x37, 0xf9,
+	0xd6, 0x59, 0x6b, 0xed, 0xc8, 0x9f, 0x07, 0xe1, 0x2d, 0x78, 0x15, 0xde, 0xa2, 0xc7, 0x1e, 0x39,
+	0x21, 0x68, 0x5f, 0x04, 0xd5, 0x76, 0x0a, 0xa9, 0xd8, 0x2d, 0xf6, 0xff, 0xf7, 0xfd, 0xf4, 0x77,
+	0xf4, 0x91, 0x63, 0x05, 0xd5, 0x2d, 0xa6, 0x9f, 0x4f, 0x27, 0x60, 0xc5, 0x69, 0x3a, 0x05, 0x05,
+	0x28, 0x31, 0x29, 0x8d, 0xb6, 0x9a, 0x3e, 0x72, 0x61, 0x12, 0xc2, 0xde, 0xd1, 0x54, 0x4f, 0xb5,
+	0x4b, 0xd2, 0xcd, 0x97, 0x87, 0x7a, 0xbd, 0xa6, 0xa1, 0x14, 0x46, 0xcc, 0x83, 0xa0, 0x77, 0xe2,
+	0x33, 0xa8, 0x4a, 0x6d, 0x2c, 0x14, 0x5b, 0xc8, 0x7e, 0x2d, 0xa1, 0x66, 0xba, 0xcd, 0xf9, 0x7f,
+	0xa2, 0x93, 0xef, 0x2d, 0x72, 0xf0, 0xd6, 0x37, 0xfa, 0x60, 0x85, 0x05, 0x7a, 0x46, 0xda, 0xde,
+	0xcf, 0xe2, 0x7e, 0x3c, 0xe8, 0x0c, 0x9f, 0x24, 0x8d, 0x86, 0xc9, 0xd8, 0x85, 0xa3, 0xd6, 0xe2,
+	0xe7, 0xb3, 0xe8, 0x32, 0xa0, 0xf4, 0x88, 0xec, 0x2b, 0xad, 0x72, 0x60, 0xf7, 0xfa, 0xf1, 0xa0,
+	0x75, 0xe9, 0x0f, 0xf4, 0x25, 0x69, 0xe7, 0xd7, 0x42, 0x2a, 0x64, 0x7b, 0xfd, 0xbd, 0x41, 0x67,
+	0xf8, 0x34, 0xa8, 0xea, 0xae, 0x5b, 0xe7, 0xab, 0x0d, 0x55, 0x2b, 0xfd, 0x08, 0x1d, 0x91, 0x03,
+	0xf7, 0x95, 0xe1, 0xa6, 0x16, 0xb2, 0x96, 0x53, 0x74, 0x77, 0xda, 0xb8, 0x49, 0x57, 0x3c, 0x8c,
+	0x77, 0xf2, 0xed, 0x0d, 0xd2, 0xf7, 0xe4, 0x70, 0x26, 0xd5, 0x0d, 0x14, 0x99, 0x28, 0x0a, 0x03,
+	0x88, 0x80, 0x6c, 0xdf, 0x79, 0xf8, 0x8e, 0xe7, 0x9d, 0xc3, 0xce, 0x6b, 0x2a, 0xc8, 0x1e, 0xcf,
+	0x9a, 0xd7, 0xf4, 0x82, 0x3c, 0xb4, 0x46, 0x28, 0xbc, 0x02, 0x83, 0xac, 0xed, 0x4c, 0xcf, 0xef,
+	0x7c, 0x94, 0xd1, 0x88, 0xae, 0xdf, 0xc7, 0x30, 0x12, 0xac, 0x7f, 0x15, 0xf4, 0x82, 0x1c, 0x0a,
+	0x44, 0xb0, 0x59, 0x69, 0x20, 0x97, 0x28, 0xb5, 0x42, 0x76, 0xbf, 0xf1, 0xaf, 0x6a, 0xdb, 0xf9,
+	0x06, 0x1b, 0xd7, 0x54, 0xdd, 0x4f, 0x34, 0x6e, 0x91, 0x16, 0xe4, 0x38, 0xd7, 0xea, 0x4a, 0x9a,
+	0x39, 0x14, 0x19, 0x54, 0x16, 0x8c, 0x12, 0xb3, 0xac, 0x80, 0x52, 0xa3, 0xb4, 0xc8, 0x1e, 0xfc,
+	0xf7, 0xed, 0x6f, 0x02, 0xf7, 0xda, 0x63, 0xc1, 0xdd, 0xdd, 0x8a, 0x76, 0x72, 0x1c, 0x8d, 0x17,
+	0xbf, 0x79, 0xb4, 0x58, 0xf1, 0x78, 0xb9, 0xe2, 0xf1, 0xaf, 0x15, 0x8f, 0xbf, 0xad, 0x79, 0xb4,
+	0x5c, 0xf3, 0xe8, 0xc7, 0x9a, 0x47, 0x9f, 0x86, 0x53, 0x69, 0xaf, 0x6f, 0x27, 0x49, 0xae, 0xe7,
+	0xa9, 0xa8, 0x60, 0x26, 0x8c, 0x02, 0xfb, 0x45, 0x9b, 0x9b, 0x70, 0x7a, 0x91, 0x6b, 0x03, 0x69,
+	0x95, 0xfa, 0x9d, 0x74, 0xbb, 0x38, 0x69, 0xbb, 0x65, 0x3c, 0xfb, 0x33, 0x00, 0xb6, 0xda, 0x2d,
+	0x9a, 0x2b, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -121,6 +130,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConfirmedExternalDeposits) > 0 {
+		for iNdEx := len(m.ConfirmedExternalDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConfirmedExternalDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AssetPrecisions) > 0 {
+		for iNdEx := len(m.AssetPrecisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetPrecisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Transfers) > 0 {
 		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -241,6 +278,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AssetPrecisions) > 0 {
+		for _, e := range m.AssetPrecisions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConfirmedExternalDeposits) > 0 {
+		for _, e := range m.ConfirmedExternalDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -467,6 +516,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetPrecisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetPrecisions = append(m.AssetPrecisions, AssetPrecision{})
+			if err := m.AssetPrecisions[len(m.AssetPrecisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmedExternalDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfirmedExternalDeposits = append(m.ConfirmedExternalDeposits, ExternalDeposit{})
+			if err := m.ConfirmedExternalDeposits[len(m.ConfirmedExternalDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -7,6 +7,7 @@ import (
 	context "context"
 	exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	nexustypes "github.com/axelarnetwork/axelar-core/x/nexus/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
 	cosmossdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
 	"sync"
@@ -28,12 +29,21 @@ var _ nexustypes.Nexus = &NexusMock{}
 // 			AddChainMaintainerFunc: func(ctx cosmossdktypes.Context, chain exported.Chain, validator cosmossdktypes.ValAddress) error {
 // 				panic("mock out the AddChainMaintainer method")
 // 			},
+// 			DeletePendingExternalDepositFunc: func(ctx cosmossdktypes.Context, pollKey vote.PollKey)  {
+// 				panic("mock out the DeletePendingExternalDeposit method")
+// 			},
+// 			EnqueueForTransferFunc: func(ctx cosmossdktypes.Context, sender exported.CrossChainAddress, asset cosmossdktypes.Coin, feeRate cosmossdktypes.Dec) error {
+// 				panic("mock out the EnqueueForTransfer method")
+// 			},
 // 			ExportGenesisFunc: func(ctx cosmossdktypes.Context) *nexustypes.GenesisState {
 // 				panic("mock out the ExportGenesis method")
 // 			},
 // 			GetChainFunc: func(ctx cosmossdktypes.Context, chain string) (exported.Chain, bool) {
 // 				panic("mock out the GetChain method")
 // 			},
+// 			GetChainAdapterFunc: func(ctx cosmossdktypes.Context, chain exported.Chain) (exported.ChainAdapter, bool) {
+// 				panic("mock out the GetChainAdapter method")
+// 			},
 // 			GetChainMaintainersFunc: func(ctx cosmossdktypes.Context, chain exported.Chain) []cosmossdktypes.ValAddress {
 // 				panic("mock out the GetChainMaintainers method")
 // 			},
@@ -43,6 +53,12 @@ var _ nexustypes.Nexus = &NexusMock{}
 // 			GetParamsFunc: func(ctx cosmossdktypes.Context) nexustypes.Params {
 // 				panic("mock out the GetParams method")
 // 			},
+// 			GetPendingExternalDepositFunc: func(ctx cosmossdktypes.Context, pollKey vote.PollKey) (nexustypes.ExternalDeposit, bool) {
+// 				panic("mock out the GetPendingExternalDeposit method")
+// 			},
+// 			GetRecipientFunc: func(ctx cosmossdktypes.Context, depositAddress exported.CrossChainAddress) (exported.CrossChainAddress, bool) {
+// 				panic("mock out the GetRecipient method")
+// 			},
 // 			InitGenesisFunc: func(ctx cosmossdktypes.Context, genState *nexustypes.GenesisState)  {
 // 				panic("mock out the InitGenesis method")
 // 			},
@@ -52,6 +68,9 @@ var _ nexustypes.Nexus = &NexusMock{}
 // 			IsChainMaintainerFunc: func(ctx cosmossdktypes.Context, chain exported.Chain, maintainer cosmossdktypes.ValAddress) bool {
 // 				panic("mock out the IsChainMaintainer method")
 // 			},
+// 			IsExternalDepositConfirmedFunc: func(ctx cosmossdktypes.Context, deposit nexustypes.ExternalDeposit) bool {
+// 				panic("mock out the IsExternalDepositConfirmed method")
+// 			},
 // 			LatestDepositAddressFunc: func(c context.Context, req *nexustypes.LatestDepositAddressRequest) (*nexustypes.LatestDepositAddressResponse, error) {
 // 				panic("mock out the LatestDepositAddress method")
 // 			},
@@ -64,9 +83,15 @@ var _ nexustypes.Nexus = &NexusMock{}
 // 			RemoveChainMaintainerFunc: func(ctx cosmossdktypes.Context, chain exported.Chain, validator cosmossdktypes.ValAddress) error {
 // 				panic("mock out the RemoveChainMaintainer method")
 // 			},
+// 			SetExternalDepositConfirmedFunc: func(ctx cosmossdktypes.Context, deposit nexustypes.ExternalDeposit)  {
+// 				panic("mock out the SetExternalDepositConfirmed method")
+// 			},
 // 			SetParamsFunc: func(ctx cosmossdktypes.Context, p nexustypes.Params)  {
 // 				panic("mock out the SetParams method")
 // 			},
+// 			SetPendingExternalDepositFunc: func(ctx cosmossdktypes.Context, pollKey vote.PollKey, deposit nexustypes.ExternalDeposit)  {
+// 				panic("mock out the SetPendingExternalDeposit method")
+// 			},
 // 		}
 //
 // 		// use mockedNexus in code that requires nexustypes.Nexus
@@ -80,12 +105,21 @@ type NexusMock struct {
 	// AddChainMaintainerFunc mocks the AddChainMaintainer method.
 	AddChainMaintainerFunc func(ctx cosmossdktypes.Context, chain exported.Chain, validator cosmossdktypes.ValAddress) error
 
+	// DeletePendingExternalDepositFunc mocks the DeletePendingExternalDeposit method.
+	DeletePendingExternalDepositFunc func(ctx cosmossdktypes.Context, pollKey vote.PollKey)
+
+	// EnqueueForTransferFunc mocks the EnqueueForTransfer method.
+	EnqueueForTransferFunc func(ctx cosmossdktypes.Context, sender exported.CrossChainAddress, asset cosmossdktypes.Coin, feeRate cosmossdktypes.Dec) error
+
 	// ExportGenesisFunc mocks the ExportGenesis method.
 	ExportGenesisFunc func(ctx cosmossdktypes.Context) *nexustypes.GenesisState
 
 	// GetChainFunc mocks the GetChain method.
 	GetChainFunc func(ctx cosmossdktypes.Context, chain string) (exported.Chain, bool)
 
+	// GetChainAdapterFunc mocks the GetChainAdapter method.
+	GetChainAdapterFunc func(ctx cosmossdktypes.Context, chain exported.Chain) (exported.ChainAdapter, bool)
+
 	// GetChainMaintainersFunc mocks the GetChainMaintainers method.
 	GetChainMaintainersFunc func(ctx cosmossdktypes.Context, chain exported.Chain) []cosmossdktypes.ValAddress
 
@@ -95,6 +129,12 @@ type NexusMock struct {
 	// GetParamsFunc mocks the GetParams method.
 	GetParamsFunc func(ctx cosmossdktypes.Context) nexustypes.Params
 
+	// GetPendingExternalDepositFunc mocks the GetPendingExternalDeposit method.
+	GetPendingExternalDepositFunc func(ctx cosmossdktypes.Context, pollKey vote.PollKey) (nexustypes.ExternalDeposit, bool)
+
+	// GetRecipientFunc mocks the GetRecipient method.
+	GetRecipientFunc func(ctx cosmossdktypes.Context, depositAddress exported.CrossChainAddress) (exported.CrossChainAddress, bool)
+
 	// InitGenesisFunc mocks the InitGenesis method.
 	InitGenesisFunc func(ctx cosmossdktypes.Context, genState *nexustypes.GenesisState)
 
@@ -104,6 +144,9 @@ type NexusMock struct {
 	// IsChainMaintainerFunc mocks the IsChainMaintainer method.
 	IsChainMaintainerFunc func(ctx cosmossdktypes.Context, chain exported.Chain, maintainer cosmossdktypes.ValAddress) bool
 
+	// IsExternalDepositConfirmedFunc mocks the IsExternalDepositConfirmed method.
+	IsExternalDepositConfirmedFunc func(ctx cosmossdktypes.Context, deposit nexustypes.ExternalDeposit) bool
+
 	// LatestDepositAddressFunc mocks the LatestDepositAddress method.
 	LatestDepositAddressFunc func(c context.Context, req *nexustypes.LatestDepositAddressRequest) (*nexustypes.LatestDepositAddressResponse, error)
 
@@ -116,9 +159,15 @@ type NexusMock struct {
 	// RemoveChainMaintainerFunc mocks the RemoveChainMaintainer method.
 	RemoveChainMaintainerFunc func(ctx cosmossdktypes.Context, chain exported.Chain, validator cosmossdktypes.ValAddress) error
 
+	// SetExternalDepositConfirmedFunc mocks the SetExternalDepositConfirmed method.
+	SetExternalDepositConfirmedFunc func(ctx cosmossdktypes.Context, deposit nexustypes.ExternalDeposit)
+
 	// SetParamsFunc mocks the SetParams method.
 	SetParamsFunc func(ctx cosmossdktypes.Context, p nexustypes.Params)
 
+	// SetPendingExternalDepositFunc mocks the SetPendingExternalDeposit method.
+	SetPendingExternalDepositFunc func(ctx cosmossdktypes.Context, pollKey vote.PollKey, deposit nexustypes.ExternalDeposit)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// ActivateChain holds details about calls to the ActivateChain method.
@@ -137,6 +186,24 @@ type NexusMock struct {
 			// Validator is the validator argument value.
 			Validator cosmossdktypes.ValAddress
 		}
+		// DeletePendingExternalDeposit holds details about calls to the DeletePendingExternalDeposit method.
+		DeletePendingExternalDeposit []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// PollKey is the pollKey argument value.
+			PollKey vote.PollKey
+		}
+		// EnqueueForTransfer holds details about calls to the EnqueueForTransfer method.
+		EnqueueForTransfer []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Sender is the sender argument value.
+			Sender exported.CrossChainAddress
+			// Asset is the asset argument value.
+			Asset cosmossdktypes.Coin
+			// FeeRate is the feeRate argument value.
+			FeeRate cosmossdktypes.Dec
+		}
 		// ExportGenesis holds details about calls to the ExportGenesis method.
 		ExportGenesis []struct {
 			// Ctx is the ctx argument value.
@@ -149,6 +216,13 @@ type NexusMock struct {
 			// Chain is the chain argument value.
 			Chain string
 		}
+		// GetChainAdapter holds details about calls to the GetChainAdapter method.
+		GetChainAdapter []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Chain is the chain argument value.
+			Chain exported.Chain
+		}
 		// GetChainMaintainers holds details about calls to the GetChainMaintainers method.
 		GetChainMaintainers []struct {
 			// Ctx is the ctx argument value.
@@ -166,6 +240,20 @@ type NexusMock struct {
 			// Ctx is the ctx argument value.
 			Ctx cosmossdktypes.Context
 		}
+		// GetPendingExternalDeposit holds details about calls to the GetPendingExternalDeposit method.
+		GetPendingExternalDeposit []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// PollKey is the pollKey argument value.
+			PollKey vote.PollKey
+		}
+		// GetRecipient holds details about calls to the GetRecipient method.
+		GetRecipient []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// DepositAddress is the depositAddress argument value.
+			DepositAddress exported.CrossChainAddress
+		}
 		// InitGenesis holds details about calls to the InitGenesis method.
 		InitGenesis []struct {
 			// Ctx is the ctx argument value.
@@ -189,6 +277,13 @@ type NexusMock struct {
 			// Maintainer is the maintainer argument value.
 			Maintainer cosmossdktypes.ValAddress
 		}
+		// IsExternalDepositConfirmed holds details about calls to the IsExternalDepositConfirmed method.
+		IsExternalDepositConfirmed []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Deposit is the deposit argument value.
+			Deposit nexustypes.ExternalDeposit
+		}
 		// LatestDepositAddress holds details about calls to the LatestDepositAddress method.
 		LatestDepositAddress []struct {
 			// C is the c argument value.
@@ -219,6 +314,13 @@ type NexusMock struct {
 			// Validator is the validator argument value.
 			Validator cosmossdktypes.ValAddress
 		}
+		// SetExternalDepositConfirmed holds details about calls to the SetExternalDepositConfirmed method.
+		SetExternalDepositConfirmed []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Deposit is the deposit argument value.
+			Deposit nexustypes.ExternalDeposit
+		}
 		// SetParams holds details about calls to the SetParams method.
 		SetParams []struct {
 			// Ctx is the ctx argument value.
@@ -226,22 +328,39 @@ type NexusMock struct {
 			// P is the p argument value.
 			P nexustypes.Params
 		}
+		// SetPendingExternalDeposit holds details about calls to the SetPendingExternalDeposit method.
+		SetPendingExternalDeposit []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// PollKey is the pollKey argument value.
+			PollKey vote.PollKey
+			// Deposit is the deposit argument value.
+			Deposit nexustypes.ExternalDeposit
+		}
 	}
-	lockActivateChain         sync.RWMutex
-	lockAddChainMaintainer    sync.RWMutex
-	lockExportGenesis         sync.RWMutex
-	lockGetChain              sync.RWMutex
-	lockGetChainMaintainers   sync.RWMutex
-	lockGetChains             sync.RWMutex
-	lockGetParams             sync.RWMutex
-	lockInitGenesis           sync.RWMutex
-	lockIsChainActivated      sync.RWMutex
-	lockIsChainMaintainer     sync.RWMutex
-	lockLatestDepositAddress  sync.RWMutex
-	lockLinkAddresses         sync.RWMutex
-	lockLogger                sync.RWMutex
-	lockRemoveChainMaintainer sync.RWMutex
-	lockSetParams             sync.RWMutex
+	lockActivateChain                sync.RWMutex
+	lockAddChainMaintainer           sync.RWMutex
+	lockDeletePendingExternalDeposit sync.RWMutex
+	lockEnqueueForTransfer           sync.RWMutex
+	lockExportGenesis                sync.RWMutex
+	lockGetChain                     sync.RWMutex
+	lockGetChainAdapter              sync.RWMutex
+	lockGetChainMaintainers          sync.RWMutex
+	lockGetChains                    sync.RWMutex
+	lockGetParams                    sync.RWMutex
+	lockGetPendingExternalDeposit    sync.RWMutex
+	lockGetRecipient                 sync.RWMutex
+	lockInitGenesis                  sync.RWMutex
+	lockIsChainActivated             sync.RWMutex
+	lockIsChainMaintainer            sync.RWMutex
+	lockIsExternalDepositConfirmed   sync.RWMutex
+	lockLatestDepositAddress         sync.RWMutex
+	lockLinkAddresses                sync.RWMutex
+	lockLogger                       sync.RWMutex
+	lockRemoveChainMaintainer        sync.RWMutex
+	lockSetExternalDepositConfirmed  sync.RWMutex
+	lockSetParams                    sync.RWMutex
+	lockSetPendingExternalDeposit    sync.RWMutex
 }
 
 // ActivateChain calls ActivateChainFunc.
@@ -318,6 +437,84 @@ func (mock *NexusMock) AddChainMaintainerCalls() []struct {
 	return calls
 }
 
+// DeletePendingExternalDeposit calls DeletePendingExternalDepositFunc.
+func (mock *NexusMock) DeletePendingExternalDeposit(ctx cosmossdktypes.Context, pollKey vote.PollKey) {
+	if mock.DeletePendingExternalDepositFunc == nil {
+		panic("NexusMock.DeletePendingExternalDepositFunc: method is nil but Nexus.DeletePendingExternalDeposit was just called")
+	}
+	callInfo := struct {
+		Ctx     cosmossdktypes.Context
+		PollKey vote.PollKey
+	}{
+		Ctx:     ctx,
+		PollKey: pollKey,
+	}
+	mock.lockDeletePendingExternalDeposit.Lock()
+	mock.calls.DeletePendingExternalDeposit = append(mock.calls.DeletePendingExternalDeposit, callInfo)
+	mock.lockDeletePendingExternalDeposit.Unlock()
+	mock.DeletePendingExternalDepositFunc(ctx, pollKey)
+}
+
+// DeletePendingExternalDepositCalls gets all the calls that were made to DeletePendingExternalDeposit.
+// Check the length with:
+//     len(mockedNexus.DeletePendingExternalDepositCalls())
+func (mock *NexusMock) DeletePendingExternalDepositCalls() []struct {
+	Ctx     cosmossdktypes.Context
+	PollKey vote.PollKey
+} {
+	var calls []struct {
+		Ctx     cosmossdktypes.Context
+		PollKey vote.PollKey
+	}
+	mock.lockDeletePendingExternalDeposit.RLock()
+	calls = mock.calls.DeletePendingExternalDeposit
+	mock.lockDeletePendingExternalDeposit.RUnlock()
+	return calls
+}
+
+// EnqueueForTransfer calls EnqueueForTransferFunc.
+func (mock *NexusMock) EnqueueForTransfer(ctx cosmossdktypes.Context, sender exported.CrossChainAddress, asset cosmossdktypes.Coin, feeRate cosmossdktypes.Dec) error {
+	if mock.EnqueueForTransferFunc == nil {
+		panic("NexusMock.EnqueueForTransferFunc: method is nil but Nexus.EnqueueForTransfer was just called")
+	}
+	callInfo := struct {
+		Ctx     cosmossdktypes.Context
+		Sender  exported.CrossChainAddress
+		Asset   cosmossdktypes.Coin
+		FeeRate cosmossdktypes.Dec
+	}{
+		Ctx:     ctx,
+		Sender:  sender,
+		Asset:   asset,
+		FeeRate: feeRate,
+	}
+	mock.lockEnqueueForTransfer.Lock()
+	mock.calls.EnqueueForTransfer = append(mock.calls.EnqueueForTransfer, callInfo)
+	mock.lockEnqueueForTransfer.Unlock()
+	return mock.EnqueueForTransferFunc(ctx, sender, asset, feeRate)
+}
+
+// EnqueueForTransferCalls gets all the calls that were made to EnqueueForTransfer.
+// Check the length with:
+//     len(mockedNexus.EnqueueForTransferCalls())
+func (mock *NexusMock) EnqueueForTransferCalls() []struct {
+	Ctx     cosmossdktypes.Context
+	Sender  exported.CrossChainAddress
+	Asset   cosmossdktypes.Coin
+	FeeRate cosmossdktypes.Dec
+} {
+	var calls []struct {
+		Ctx     cosmossdktypes.Context
+		Sender  exported.CrossChainAddress
+		Asset   cosmossdktypes.Coin
+		FeeRate cosmossdktypes.Dec
+	}
+	mock.lockEnqueueForTransfer.RLock()
+	calls = mock.calls.EnqueueForTransfer
+	mock.lockEnqueueForTransfer.RUnlock()
+	return calls
+}
+
 // ExportGenesis calls ExportGenesisFunc.
 func (mock *NexusMock) ExportGenesis(ctx cosmossdktypes.Context) *nexustypes.GenesisState {
 	if mock.ExportGenesisFunc == nil {
@@ -384,6 +581,41 @@ func (mock *NexusMock) GetChainCalls() []struct {
 	return calls
 }
 
+// GetChainAdapter calls GetChainAdapterFunc.
+func (mock *NexusMock) GetChainAdapter(ctx cosmossdktypes.Context, chain exported.Chain) (exported.ChainAdapter, bool) {
+	if mock.GetChainAdapterFunc == nil {
+		panic("NexusMock.GetChainAdapterFunc: method is nil but Nexus.GetChainAdapter was just called")
+	}
+	callInfo := struct {
+		Ctx   cosmossdktypes.Context
+		Chain exported.Chain
+	}{
+		Ctx:   ctx,
+		Chain: chain,
+	}
+	mock.lockGetChainAdapter.Lock()
+	mock.calls.GetChainAdapter = append(mock.calls.GetChainAdapter, callInfo)
+	mock.lockGetChainAdapter.Unlock()
+	return mock.GetChainAdapterFunc(ctx, chain)
+}
+
+// GetChainAdapterCalls gets all the calls that were made to GetChainAdapter.
+// Check the length with:
+//     len(mockedNexus.GetChainAdapterCalls())
+func (mock *NexusMock) GetChainAdapterCalls() []struct {
+	Ctx   cosmossdktypes.Context
+	Chain exported.Chain
+} {
+	var calls []struct {
+		Ctx   cosmossdktypes.Context
+		Chain exported.Chain
+	}
+	mock.lockGetChainAdapter.RLock()
+	calls = mock.calls.GetChainAdapter
+	mock.lockGetChainAdapter.RUnlock()
+	return calls
+}
+
 // GetChainMaintainers calls GetChainMaintainersFunc.
 func (mock *NexusMock) GetChainMaintainers(ctx cosmossdktypes.Context, chain exported.Chain) []cosmossdktypes.ValAddress {
 	if mock.GetChainMaintainersFunc == nil {
@@ -481,6 +713,76 @@ func (mock *NexusMock) GetParamsCalls() []struct {
 	return calls
 }
 
+// GetPendingExternalDeposit calls GetPendingExternalDepositFunc.
+func (mock *NexusMock) GetPendingExternalDeposit(ctx cosmossdktypes.Context, pollKey vote.PollKey) (nexustypes.ExternalDeposit, bool) {
+	if mock.GetPendingExternalDepositFunc == nil {
+		panic("NexusMock.GetPendingExternalDepositFunc: method is nil but Nexus.GetPendingExternalDeposit was just called")
+	}
+	callInfo := struct {
+		Ctx     cosmossdktypes.Context
+		PollKey vote.PollKey
+	}{
+		Ctx:     ctx,
+		PollKey: pollKey,
+	}
+	mock.lockGetPendingExternalDeposit.Lock()
+	mock.calls.GetPendingExternalDeposit = append(mock.calls.GetPendingExternalDeposit, callInfo)
+	mock.lockGetPendingExternalDeposit.Unlock()
+	return mock.GetPendingExternalDepositFunc(ctx, pollKey)
+}
+
+// GetPendingExternalDepositCalls gets all the calls that were made to GetPendingExternalDeposit.
+// Check the length with:
+//     len(mockedNexus.GetPendingExternalDepositCalls())
+func (mock *NexusMock) GetPendingExternalDepositCalls() []struct {
+	Ctx     cosmossdktypes.Context
+	PollKey vote.PollKey
+} {
+	var calls []struct {
+		Ctx     cosmossdktypes.Context
+		PollKey vote.PollKey
+	}
+	mock.lockGetPendingExternalDeposit.RLock()
+	calls = mock.calls.GetPendingExternalDeposit
+	mock.lockGetPendingExternalDeposit.RUnlock()
+	return calls
+}
+
+// GetRecipient calls GetRecipientFunc.
+func (mock *NexusMock) GetRecipient(ctx cosmossdktypes.Context, depositAddress exported.CrossChainAddress) (exported.CrossChainAddress, bool) {
+	if mock.GetRecipientFunc == nil {
+		panic("NexusMock.GetRecipientFunc: method is nil but Nexus.GetRecipient was just called")
+	}
+	callInfo := struct {
+		Ctx            cosmossdktypes.Context
+		DepositAddress exported.CrossChainAddress
+	}{
+		Ctx:            ctx,
+		DepositAddress: depositAddress,
+	}
+	mock.lockGetRecipient.Lock()
+	mock.calls.GetRecipient = append(mock.calls.GetRecipient, callInfo)
+	mock.lockGetRecipient.Unlock()
+	return mock.GetRecipientFunc(ctx, depositAddress)
+}
+
+// GetRecipientCalls gets all the calls that were made to GetRecipient.
+// Check the length with:
+//     len(mockedNexus.GetRecipientCalls())
+func (mock *NexusMock) GetRecipientCalls() []struct {
+	Ctx            cosmossdktypes.Context
+	DepositAddress exported.CrossChainAddress
+} {
+	var calls []struct {
+		Ctx            cosmossdktypes.Context
+		DepositAddress exported.CrossChainAddress
+	}
+	mock.lockGetRecipient.RLock()
+	calls = mock.calls.GetRecipient
+	mock.lockGetRecipient.RUnlock()
+	return calls
+}
+
 // InitGenesis calls InitGenesisFunc.
 func (mock *NexusMock) InitGenesis(ctx cosmossdktypes.Context, genState *nexustypes.GenesisState) {
 	if mock.InitGenesisFunc == nil {
@@ -590,6 +892,41 @@ func (mock *NexusMock) IsChainMaintainerCalls() []struct {
 	return calls
 }
 
+// IsExternalDepositConfirmed calls IsExternalDepositConfirmedFunc.
+func (mock *NexusMock) IsExternalDepositConfirmed(ctx cosmossdktypes.Context, deposit nexustypes.ExternalDeposit) bool {
+	if mock.IsExternalDepositConfirmedFunc == nil {
+		panic("NexusMock.IsExternalDepositConfirmedFunc: method is nil but Nexus.IsExternalDepositConfirmed was just called")
+	}
+	callInfo := struct {
+		Ctx     cosmossdktypes.Context
+		Deposit nexustypes.ExternalDeposit
+	}{
+		Ctx:     ctx,
+		Deposit: deposit,
+	}
+	mock.lockIsExternalDepositConfirmed.Lock()
+	mock.calls.IsExternalDepositConfirmed = append(mock.calls.IsExternalDepositConfirmed, callInfo)
+	mock.lockIsExternalDepositConfirmed.Unlock()
+	return mock.IsExternalDepositConfirmedFunc(ctx, deposit)
+}
+
+// IsExternalDepositConfirmedCalls gets all the calls that were made to IsExternalDepositConfirmed.
+// Check the length with:
+//     len(mockedNexus.IsExternalDepositConfirmedCalls())
+func (mock *NexusMock) IsExternalDepositConfirmedCalls() []struct {
+	Ctx     cosmossdktypes.Context
+	Deposit nexustypes.ExternalDeposit
+} {
+	var calls []struct {
+		Ctx     cosmossdktypes.Context
+		Deposit nexustypes.ExternalDeposit
+	}
+	mock.lockIsExternalDepositConfirmed.RLock()
+	calls = mock.calls.IsExternalDepositConfirmed
+	mock.lockIsExternalDepositConfirmed.RUnlock()
+	return calls
+}
+
 // LatestDepositAddress calls LatestDepositAddressFunc.
 func (mock *NexusMock) LatestDepositAddress(c context.Context, req *nexustypes.LatestDepositAddressRequest) (*nexustypes.LatestDepositAddressResponse, error) {
 	if mock.LatestDepositAddressFunc == nil {
@@ -734,6 +1071,41 @@ func (mock *NexusMock) RemoveChainMaintainerCalls() []struct {
 	return calls
 }
 
+// SetExternalDepositConfirmed calls SetExternalDepositConfirmedFunc.
+func (mock *NexusMock) SetExternalDepositConfirmed(ctx cosmossdktypes.Context, deposit nexustypes.ExternalDeposit) {
+	if mock.SetExternalDepositConfirmedFunc == nil {
+		panic("NexusMock.SetExternalDepositConfirmedFunc: method is nil but Nexus.SetExternalDepositConfirmed was just called")
+	}
+	callInfo := struct {
+		Ctx     cosmossdktypes.Context
+		Deposit nexustypes.ExternalDeposit
+	}{
+		Ctx:     ctx,
+		Deposit: deposit,
+	}
+	mock.lockSetExternalDepositConfirmed.Lock()
+	mock.calls.SetExternalDepositConfirmed = append(mock.calls.SetExternalDepositConfirmed, callInfo)
+	mock.lockSetExternalDepositConfirmed.Unlock()
+	mock.SetExternalDepositConfirmedFunc(ctx, deposit)
+}
+
+// SetExternalDepositConfirmedCalls gets all the calls that were made to SetExternalDepositConfirmed.
+// Check the length with:
+//     len(mockedNexus.SetExternalDepositConfirmedCalls())
+func (mock *NexusMock) SetExternalDepositConfirmedCalls() []struct {
+	Ctx     cosmossdktypes.Context
+	Deposit nexustypes.ExternalDeposit
+} {
+	var calls []struct {
+		Ctx     cosmossdktypes.Context
+		Deposit nexustypes.ExternalDeposit
+	}
+	mock.lockSetExternalDepositConfirmed.RLock()
+	calls = mock.calls.SetExternalDepositConfirmed
+	mock.lockSetExternalDepositConfirmed.RUnlock()
+	return calls
+}
+
 // SetParams calls SetParamsFunc.
 func (mock *NexusMock) SetParams(ctx cosmossdktypes.Context, p nexustypes.Params) {
 	if mock.SetParamsFunc == nil {
@@ -769,6 +1141,45 @@ func (mock *NexusMock) SetParamsCalls() []struct {
 	return calls
 }
 
+// SetPendingExternalDeposit calls SetPendingExternalDepositFunc.
+func (mock *NexusMock) SetPendingExternalDeposit(ctx cosmossdktypes.Context, pollKey vote.PollKey, deposit nexustypes.ExternalDeposit) {
+	if mock.SetPendingExternalDepositFunc == nil {
+		panic("NexusMock.SetPendingExternalDepositFunc: method is nil but Nexus.SetPendingExternalDeposit was just called")
+	}
+	callInfo := struct {
+		Ctx     cosmossdktypes.Context
+		PollKey vote.PollKey
+		Deposit nexustypes.ExternalDeposit
+	}{
+		Ctx:     ctx,
+		PollKey: pollKey,
+		Deposit: deposit,
+	}
+	mock.lockSetPendingExternalDeposit.Lock()
+	mock.calls.SetPendingExternalDeposit = append(mock.calls.SetPendingExternalDeposit, callInfo)
+	mock.lockSetPendingExternalDeposit.Unlock()
+	mock.SetPendingExternalDepositFunc(ctx, pollKey, deposit)
+}
+
+// SetPendingExternalDepositCalls gets all the calls that were made to SetPendingExternalDeposit.
+// Check the length with:
+//     len(mockedNexus.SetPendingExternalDepositCalls())
+func (mock *NexusMock) SetPendingExternalDepositCalls() []struct {
+	Ctx     cosmossdktypes.Context
+	PollKey vote.PollKey
+	Deposit nexustypes.ExternalDeposit
+} {
+	var calls []struct {
+		Ctx     cosmossdktypes.Context
+		PollKey vote.PollKey
+		Deposit nexustypes.ExternalDeposit
+	}
+	mock.lockSetPendingExternalDeposit.RLock()
+	calls = mock.calls.SetPendingExternalDeposit
+	mock.lockSetPendingExternalDeposit.RUnlock()
+	return calls
+}
+
 // Ensure, that SnapshotterMock does implement nexustypes.Snapshotter.
 // If this is not the case, regenerate this file with moq.
 var _ nexustypes.Snapshotter = &SnapshotterMock{}
@@ -904,3 +1315,135 @@ func (mock *AxelarnetKeeperMock) GetFeeCollectorCalls() []struct {
 	mock.lockGetFeeCollector.RUnlock()
 	return calls
 }
+
+// Ensure, that VoterMock does implement nexustypes.Voter.
+// If this is not the case, regenerate this file with moq.
+var _ nexustypes.Voter = &VoterMock{}
+
+// VoterMock is a mock implementation of nexustypes.Voter.
+//
+// 	func TestSomethingThatUsesVoter(t *testing.T) {
+//
+// 		// make and configure a mocked nexustypes.Voter
+// 		mockedVoter := &VoterMock{
+// 			GetPollFunc: func(ctx cosmossdktypes.Context, pollKey vote.PollKey) vote.Poll {
+// 				panic("mock out the GetPoll method")
+// 			},
+// 			InitializePollFunc: func(ctx cosmossdktypes.Context, key vote.PollKey, voters []cosmossdktypes.ValAddress, pollProperties ...vote.PollProperty) error {
+// 				panic("mock out the InitializePoll method")
+// 			},
+// 		}
+//
+// 		// use mockedVoter in code that requires nexustypes.Voter
+// 		// and then make assertions.
+//
+// 	}
+type VoterMock struct {
+	// GetPollFunc mocks the GetPoll method.
+	GetPollFunc func(ctx cosmossdktypes.Context, pollKey vote.PollKey) vote.Poll
+
+	// InitializePollFunc mocks the InitializePoll method.
+	InitializePollFunc func(ctx cosmossdktypes.Context, key vote.PollKey, voters []cosmossdktypes.ValAddress, pollProperties ...vote.PollProperty) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetPoll holds details about calls to the GetPoll method.
+		GetPoll []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// PollKey is the pollKey argument value.
+			PollKey vote.PollKey
+		}
+		// InitializePoll holds details about calls to the InitializePoll method.
+		InitializePoll []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Key is the key argument value.
+			Key vote.PollKey
+			// Voters is the voters argument value.
+			Voters []cosmossdktypes.ValAddress
+			// PollProperties is the pollProperties argument value.
+			PollProperties []vote.PollProperty
+		}
+	}
+	lockGetPoll        sync.RWMutex
+	lockInitializePoll sync.RWMutex
+}
+
+// GetPoll calls GetPollFunc.
+func (mock *VoterMock) GetPoll(ctx cosmossdktypes.Context, pollKey vote.PollKey) vote.Poll {
+	if mock.GetPollFunc == nil {
+		panic("VoterMock.GetPollFunc: method is nil but Voter.GetPoll was just called")
+	}
+	callInfo := struct {
+		Ctx     cosmossdktypes.Context
+		PollKey vote.PollKey
+	}{
+		Ctx:     ctx,
+		PollKey: pollKey,
+	}
+	mock.lockGetPoll.Lock()
+	mock.calls.GetPoll = append(mock.calls.GetPoll, callInfo)
+	mock.lockGetPoll.Unlock()
+	return mock.GetPollFunc(ctx, pollKey)
+}
+
+// GetPollCalls gets all the calls that were made to GetPoll.
+// Check the length with:
+//     len(mockedVoter.GetPollCalls())
+func (mock *VoterMock) GetPollCalls() []struct {
+	Ctx     cosmossdktypes.Context
+	PollKey vote.PollKey
+} {
+	var calls []struct {
+		Ctx     cosmossdktypes.Context
+		PollKey vote.PollKey
+	}
+	mock.lockGetPoll.RLock()
+	calls = mock.calls.GetPoll
+	mock.lockGetPoll.RUnlock()
+	return calls
+}
+
+// InitializePoll calls InitializePollFunc.
+func (mock *VoterMock) InitializePoll(ctx cosmossdktypes.Context, key vote.PollKey, voters []cosmossdktypes.ValAddress, pollProperties ...vote.PollProperty) error {
+	if mock.InitializePollFunc == nil {
+		panic("VoterMock.InitializePollFunc: method is nil but Voter.InitializePoll was just called")
+	}
+	callInfo := struct {
+		Ctx            cosmossdktypes.Context
+		Key            vote.PollKey
+		Voters         []cosmossdktypes.ValAddress
+		PollProperties []vote.PollProperty
+	}{
+		Ctx:            ctx,
+		Key:            key,
+		Voters:         voters,
+		PollProperties: pollProperties,
+	}
+	mock.lockInitializePoll.Lock()
+	mock.calls.InitializePoll = append(mock.calls.InitializePoll, callInfo)
+	mock.lockInitializePoll.Unlock()
+	return mock.InitializePollFunc(ctx, key, voters, pollProperties...)
+}
+
+// InitializePollCalls gets all the calls that were made to InitializePoll.
+// Check the length with:
+//     len(mockedVoter.InitializePollCalls())
+func (mock *VoterMock) InitializePollCalls() []struct {
+	Ctx            cosmossdktypes.Context
+	Key            vote.PollKey
+	Voters         []cosmossdktypes.ValAddress
+	PollProperties []vote.PollProperty
+} {
+	var calls []struct {
+		Ctx            cosmossdktypes.Context
+		Key            vote.PollKey
+		Voters         []cosmossdktypes.ValAddress
+		PollProperties []vote.PollProperty
+	}
+	mock.lockInitializePoll.RLock()
+	calls = mock.calls.InitializePoll
+	mock.lockInitializePoll.RUnlock()
+	return calls
+}
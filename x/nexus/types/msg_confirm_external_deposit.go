@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewConfirmExternalDepositRequest creates a message of type ConfirmExternalDepositRequest
+func NewConfirmExternalDepositRequest(sender sdk.AccAddress, chain string, txID string, depositAddress string, amount sdk.Coin) *ConfirmExternalDepositRequest {
+	return &ConfirmExternalDepositRequest{
+		Sender:         sender,
+		Chain:          chain,
+		TxID:           txID,
+		DepositAddress: depositAddress,
+		Amount:         amount,
+	}
+}
+
+// Route implements sdk.Msg
+func (m ConfirmExternalDepositRequest) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m ConfirmExternalDepositRequest) Type() string {
+	return "ConfirmExternalDeposit"
+}
+
+// ValidateBasic implements sdk.Msg
+func (m ConfirmExternalDepositRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
+	if m.TxID == "" {
+		return fmt.Errorf("missing tx id")
+	}
+
+	if m.DepositAddress == "" {
+		return fmt.Errorf("missing deposit address")
+	}
+
+	if err := m.Amount.Validate(); err != nil {
+		return err
+	}
+
+	if !m.Amount.IsPositive() {
+		return fmt.Errorf("deposit amount must be positive")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m ConfirmExternalDepositRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements sdk.Msg
+func (m ConfirmExternalDepositRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+)
+
+// NewVoteConfirmExternalDepositRequest creates a message of type VoteConfirmExternalDepositRequest
+func NewVoteConfirmExternalDepositRequest(sender sdk.AccAddress, poll vote.PollKey, confirmed bool) *VoteConfirmExternalDepositRequest {
+	return &VoteConfirmExternalDepositRequest{
+		Sender:    sender,
+		PollKey:   poll,
+		Confirmed: confirmed,
+	}
+}
+
+// Route implements sdk.Msg
+func (m VoteConfirmExternalDepositRequest) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m VoteConfirmExternalDepositRequest) Type() string {
+	return "VoteConfirmExternalDeposit"
+}
+
+// ValidateBasic implements sdk.Msg
+func (m VoteConfirmExternalDepositRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+
+	if err := m.PollKey.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m VoteConfirmExternalDepositRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements sdk.Msg
+func (m VoteConfirmExternalDepositRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
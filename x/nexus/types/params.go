@@ -3,6 +3,7 @@ package types
 import (
 	fmt "fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	params "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/axelarnetwork/axelar-core/utils"
@@ -12,6 +13,18 @@ var (
 
 	// KeyChainActivationThreshold represents the key for chain activation threshold
 	KeyChainActivationThreshold = []byte("chainActivationThreshold")
+
+	// KeyExternalChainVotingThreshold represents the key for the voting threshold of external chain deposit confirmations
+	KeyExternalChainVotingThreshold = []byte("externalChainVotingThreshold")
+
+	// KeyExternalChainMinVoterCount represents the key for the min voter count of external chain deposit confirmations
+	KeyExternalChainMinVoterCount = []byte("externalChainMinVoterCount")
+
+	// KeyExternalChainRevoteLockingPeriod represents the key for the revote locking period of external chain deposit confirmations
+	KeyExternalChainRevoteLockingPeriod = []byte("externalChainRevoteLockingPeriod")
+
+	// KeyExternalChainTransactionFeeRate represents the key for the fee rate of transfers from external chains
+	KeyExternalChainTransactionFeeRate = []byte("externalChainTransactionFeeRate")
 )
 
 // KeyTable retrieves a subspace table for the module
@@ -22,7 +35,11 @@ func KeyTable() params.KeyTable {
 // DefaultParams creates the default genesis parameters
 func DefaultParams() Params {
 	return Params{
-		ChainActivationThreshold: utils.NewThreshold(55, 100),
+		ChainActivationThreshold:         utils.NewThreshold(55, 100),
+		ExternalChainVotingThreshold:     utils.NewThreshold(51, 100),
+		ExternalChainMinVoterCount:       1,
+		ExternalChainRevoteLockingPeriod: 50,
+		ExternalChainTransactionFeeRate:  sdk.NewDecWithPrec(1, 3), // 0.1%
 	}
 }
 
@@ -37,6 +54,10 @@ func (m *Params) ParamSetPairs() params.ParamSetPairs {
 	*/
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyChainActivationThreshold, &m.ChainActivationThreshold, validateChainActivationThreshold),
+		params.NewParamSetPair(KeyExternalChainVotingThreshold, &m.ExternalChainVotingThreshold, validateExternalChainVotingThreshold),
+		params.NewParamSetPair(KeyExternalChainMinVoterCount, &m.ExternalChainMinVoterCount, validateExternalChainMinVoterCount),
+		params.NewParamSetPair(KeyExternalChainRevoteLockingPeriod, &m.ExternalChainRevoteLockingPeriod, validateExternalChainRevoteLockingPeriod),
+		params.NewParamSetPair(KeyExternalChainTransactionFeeRate, &m.ExternalChainTransactionFeeRate, validateExternalChainTransactionFeeRate),
 	}
 }
 
@@ -46,6 +67,22 @@ func (m Params) Validate() error {
 		return err
 	}
 
+	if err := validateExternalChainVotingThreshold(m.ExternalChainVotingThreshold); err != nil {
+		return err
+	}
+
+	if err := validateExternalChainMinVoterCount(m.ExternalChainMinVoterCount); err != nil {
+		return err
+	}
+
+	if err := validateExternalChainRevoteLockingPeriod(m.ExternalChainRevoteLockingPeriod); err != nil {
+		return err
+	}
+
+	if err := validateExternalChainTransactionFeeRate(m.ExternalChainTransactionFeeRate); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -61,3 +98,55 @@ func validateChainActivationThreshold(chainActivationThreshold interface{}) erro
 
 	return nil
 }
+
+func validateExternalChainVotingThreshold(votingThreshold interface{}) error {
+	val, ok := votingThreshold.(utils.Threshold)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for ExternalChainVotingThreshold: %T", votingThreshold)
+	}
+
+	if val.LTE(utils.NewThreshold(0, 1)) || val.GT(utils.NewThreshold(1, 1)) {
+		return fmt.Errorf("threshold must be >0 and <=1 for ExternalChainVotingThreshold")
+	}
+
+	return nil
+}
+
+func validateExternalChainMinVoterCount(minVoterCount interface{}) error {
+	val, ok := minVoterCount.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for ExternalChainMinVoterCount: %T", minVoterCount)
+	}
+
+	if val < 0 {
+		return fmt.Errorf("min voter count must be >=0")
+	}
+
+	return nil
+}
+
+func validateExternalChainRevoteLockingPeriod(revoteLockingPeriod interface{}) error {
+	val, ok := revoteLockingPeriod.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for ExternalChainRevoteLockingPeriod: %T", revoteLockingPeriod)
+	}
+
+	if val <= 0 {
+		return fmt.Errorf("revote locking period must be >0")
+	}
+
+	return nil
+}
+
+func validateExternalChainTransactionFeeRate(feeRate interface{}) error {
+	val, ok := feeRate.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for ExternalChainTransactionFeeRate: %T", feeRate)
+	}
+
+	if val.IsNil() || val.IsNegative() || val.GT(sdk.OneDec()) {
+		return fmt.Errorf("transaction fee rate must be >=0 and <=1")
+	}
+
+	return nil
+}
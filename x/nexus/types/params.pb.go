@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	utils "github.com/axelarnetwork/axelar-core/utils"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -27,6 +28,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params represent the genesis parameters for the module
 type Params struct {
 	ChainActivationThreshold utils.Threshold `protobuf:"bytes,1,opt,name=chain_activation_threshold,json=chainActivationThreshold,proto3" json:"chain_activation_threshold"`
+	// parameters of the polls that confirm deposits on chains that are
+	// connected through a chain adapter
+	ExternalChainVotingThreshold     utils.Threshold                        `protobuf:"bytes,2,opt,name=external_chain_voting_threshold,json=externalChainVotingThreshold,proto3" json:"external_chain_voting_threshold"`
+	ExternalChainMinVoterCount       int64                                  `protobuf:"varint,3,opt,name=external_chain_min_voter_count,json=externalChainMinVoterCount,proto3" json:"external_chain_min_voter_count,omitempty"`
+	ExternalChainRevoteLockingPeriod int64                                  `protobuf:"varint,4,opt,name=external_chain_revote_locking_period,json=externalChainRevoteLockingPeriod,proto3" json:"external_chain_revote_locking_period,omitempty"`
+	ExternalChainTransactionFeeRate  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=external_chain_transaction_fee_rate,json=externalChainTransactionFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"external_chain_transaction_fee_rate"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,22 +76,32 @@ func init() {
 func init() { proto.RegisterFile("nexus/v1beta1/params.proto", fileDescriptor_d5f543f4e48d22e3) }
 
 var fileDescriptor_d5f543f4e48d22e3 = []byte{
-	// 232 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0x4b, 0xad, 0x28,
-	0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x05, 0xcb, 0xe9, 0x41, 0xe5, 0xa4, 0x44, 0xd2,
-	0xf3, 0xd3, 0xf3, 0xc1, 0x32, 0xfa, 0x20, 0x16, 0x44, 0x91, 0x94, 0x6c, 0x69, 0x49, 0x66, 0x0e,
-	0xc2, 0x80, 0x92, 0x8c, 0xa2, 0xd4, 0xe2, 0x8c, 0xfc, 0x9c, 0x14, 0x88, 0xb4, 0x52, 0x1a, 0x17,
-	0x5b, 0x00, 0xd8, 0x4c, 0xa1, 0x18, 0x2e, 0xa9, 0xe4, 0x8c, 0xc4, 0xcc, 0xbc, 0xf8, 0xc4, 0xe4,
-	0x92, 0xcc, 0xb2, 0xc4, 0x92, 0xcc, 0xfc, 0xbc, 0x78, 0xb8, 0x6a, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x6e, 0x23, 0x09, 0x3d, 0xb0, 0x69, 0x30, 0x2b, 0xf5, 0x42, 0x60, 0xf2, 0x4e, 0x2c, 0x27, 0xee,
-	0xc9, 0x33, 0x04, 0x49, 0x80, 0x4d, 0x70, 0x84, 0x1b, 0x80, 0x90, 0x0f, 0x38, 0xf1, 0x50, 0x8e,
-	0xe1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x8c, 0xd2, 0x33, 0x4b, 0x32,
-	0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x13, 0x2b, 0x52, 0x73, 0x12, 0x8b, 0xf2, 0x52, 0x4b,
-	0xca, 0xf3, 0x8b, 0xb2, 0xa1, 0x3c, 0xdd, 0xe4, 0xfc, 0xa2, 0x54, 0xfd, 0x0a, 0x7d, 0x48, 0x60,
-	0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x3d, 0x60, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff,
-	0xad, 0x98, 0x5e, 0x6c, 0x22, 0x01, 0x00, 0x00,
+	// 392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0xeb, 0xd3, 0x30,
+	0x18, 0xc7, 0x5b, 0xf7, 0x73, 0x60, 0xc5, 0x4b, 0xf1, 0x50, 0x8a, 0xa6, 0x45, 0x45, 0x76, 0x59,
+	0xcb, 0xe6, 0x2b, 0xb0, 0x13, 0x4f, 0x2a, 0xa3, 0x0c, 0x0f, 0x22, 0x94, 0x2c, 0x7b, 0x6c, 0xcb,
+	0xda, 0x3c, 0x25, 0x49, 0x67, 0x05, 0x5f, 0x84, 0x2f, 0x6b, 0xc7, 0x1d, 0xc5, 0xc3, 0xd4, 0xed,
+	0x8d, 0x48, 0xd3, 0xfd, 0x3f, 0x79, 0x4a, 0xc2, 0xf7, 0xcb, 0xe7, 0x93, 0x84, 0xc7, 0x72, 0x39,
+	0x34, 0xb5, 0x0c, 0x57, 0xa3, 0x39, 0x28, 0x3a, 0x0a, 0x2b, 0x2a, 0x68, 0x29, 0x83, 0x4a, 0xa0,
+	0x42, 0xfb, 0x91, 0xce, 0x82, 0x43, 0xe6, 0x3e, 0x4e, 0x31, 0x45, 0x9d, 0x84, 0xed, 0xae, 0x2b,
+	0xb9, 0x4f, 0x6b, 0x95, 0x17, 0x67, 0x80, 0xca, 0x04, 0xc8, 0x0c, 0x8b, 0x45, 0x17, 0x3f, 0xfb,
+	0xdd, 0xb3, 0xfa, 0x53, 0x0d, 0xb5, 0x3f, 0x5b, 0x2e, 0xcb, 0x68, 0xce, 0x13, 0xca, 0x54, 0xbe,
+	0xa2, 0x2a, 0x47, 0x9e, 0x9c, 0xea, 0x8e, 0xe9, 0x9b, 0x83, 0x87, 0x63, 0x27, 0xd0, 0xb8, 0xa3,
+	0x33, 0x98, 0x1d, 0xf3, 0xe8, 0x6e, 0xbd, 0xf5, 0x8c, 0xd8, 0xd1, 0x84, 0xd7, 0x27, 0xc0, 0x29,
+	0xb7, 0xc1, 0xf2, 0xa0, 0x51, 0x20, 0x38, 0x2d, 0x92, 0x4e, 0xb3, 0x42, 0x95, 0xf3, 0xf4, 0x42,
+	0x71, 0xef, 0xbf, 0x14, 0x4f, 0x8e, 0x98, 0x49, 0x4b, 0xf9, 0xa8, 0x21, 0x67, 0x4d, 0x64, 0x91,
+	0x1b, 0x4d, 0xd9, 0xa9, 0x40, 0x24, 0x0c, 0x6b, 0xae, 0x9c, 0x9e, 0x6f, 0x0e, 0x7a, 0xb1, 0x7b,
+	0x45, 0x79, 0xaf, 0x41, 0x20, 0x26, 0x6d, 0xc3, 0xfe, 0x60, 0xbd, 0xb8, 0x61, 0x08, 0x68, 0x09,
+	0x49, 0x81, 0x6c, 0xd9, 0x5e, 0xb9, 0x02, 0x91, 0xe3, 0xc2, 0xb9, 0xd3, 0x24, 0xff, 0x8a, 0x14,
+	0xeb, 0xe6, 0xbb, 0xae, 0x38, 0xd5, 0x3d, 0xfb, 0xbb, 0xf5, 0xfc, 0x86, 0xa7, 0x04, 0xe5, 0xb2,
+	0xfd, 0x66, 0xe4, 0xc9, 0x17, 0x80, 0x44, 0x50, 0x05, 0xce, 0x7d, 0xdf, 0x1c, 0x3c, 0x88, 0x82,
+	0xf6, 0x91, 0xbf, 0xb6, 0xde, 0xcb, 0x34, 0x57, 0x59, 0x3d, 0x0f, 0x18, 0x96, 0x21, 0x43, 0x59,
+	0xa2, 0x3c, 0x2c, 0x43, 0xb9, 0x58, 0x86, 0xea, 0x5b, 0x05, 0x32, 0x78, 0x03, 0x2c, 0xf6, 0xae,
+	0xf4, 0xb3, 0x33, 0xf8, 0x2d, 0x40, 0x4c, 0x15, 0x44, 0xd3, 0xf5, 0x5f, 0x62, 0xac, 0x77, 0xc4,
+	0xdc, 0xec, 0x88, 0xf9, 0x67, 0x47, 0xcc, 0x1f, 0x7b, 0x62, 0x6c, 0xf6, 0xc4, 0xf8, 0xb9, 0x27,
+	0xc6, 0xa7, 0xf1, 0x85, 0x86, 0x36, 0x50, 0x50, 0xc1, 0x41, 0x7d, 0x45, 0xb1, 0x3c, 0x9c, 0x86,
+	0x0c, 0x05, 0x84, 0x4d, 0xd8, 0x8d, 0xa1, 0xd6, 0xce, 0xfb, 0x7a, 0x74, 0x5e, 0xfd, 0x1b, 0x00,
+	0xfd, 0xf0, 0x3c, 0x5f, 0x9c, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -107,6 +124,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ExternalChainTransactionFeeRate.Size()
+		i -= size
+		if _, err := m.ExternalChainTransactionFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.ExternalChainRevoteLockingPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ExternalChainRevoteLockingPeriod))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExternalChainMinVoterCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ExternalChainMinVoterCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.ExternalChainVotingThreshold.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.ChainActivationThreshold.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -139,6 +186,16 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.ChainActivationThreshold.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.ExternalChainVotingThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ExternalChainMinVoterCount != 0 {
+		n += 1 + sovParams(uint64(m.ExternalChainMinVoterCount))
+	}
+	if m.ExternalChainRevoteLockingPeriod != 0 {
+		n += 1 + sovParams(uint64(m.ExternalChainRevoteLockingPeriod))
+	}
+	l = m.ExternalChainTransactionFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -210,6 +267,111 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalChainVotingThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalChainVotingThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalChainMinVoterCount", wireType)
+			}
+			m.ExternalChainMinVoterCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalChainMinVoterCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalChainRevoteLockingPeriod", wireType)
+			}
+			m.ExternalChainRevoteLockingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalChainRevoteLockingPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalChainTransactionFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalChainTransactionFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
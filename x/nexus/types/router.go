@@ -2,29 +2,35 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
 )
 
-// Router implements a AddressValidator router based on module name.
+// Router implements a AddressValidator router based on module name and a ChainAdapter router based on chain name.
 type Router interface {
 	AddAddressValidator(module string, handler exported.AddressValidator) Router
 	HasAddressValidator(module string) bool
 	GetAddressValidator(module string) exported.AddressValidator
+	AddChainAdapter(chain string, adapter exported.ChainAdapter) Router
+	HasChainAdapter(chain string) bool
+	GetChainAdapter(chain string) exported.ChainAdapter
 	Seal()
 }
 
 var _ Router = (*router)(nil)
 
 type router struct {
-	routes map[string]exported.AddressValidator
-	sealed bool
+	routes   map[string]exported.AddressValidator
+	adapters map[string]exported.ChainAdapter
+	sealed   bool
 }
 
 // NewRouter creates a new Router interface instance
 func NewRouter() Router {
 	return &router{
-		routes: make(map[string]exported.AddressValidator),
+		routes:   make(map[string]exported.AddressValidator),
+		adapters: make(map[string]exported.ChainAdapter),
 	}
 }
 
@@ -65,3 +71,36 @@ func (r *router) GetAddressValidator(module string) exported.AddressValidator {
 
 	return r.routes[module]
 }
+
+// AddChainAdapter registers the adapter of an external chain and returns the router.
+// Panics if the router is sealed, chain is an empty string, or if an adapter for the chain has been registered already.
+func (r *router) AddChainAdapter(chain string, adapter exported.ChainAdapter) Router {
+	if r.sealed {
+		panic("cannot add chain adapter (router sealed)")
+	}
+
+	if chain == "" {
+		panic("chain name cannot be an empty string")
+	}
+
+	if r.HasChainAdapter(chain) {
+		panic(fmt.Sprintf("adapter for chain %s has already been registered", chain))
+	}
+
+	r.adapters[strings.ToLower(chain)] = adapter
+	return r
+}
+
+// HasChainAdapter returns true if the router has an adapter registered for the given chain
+func (r *router) HasChainAdapter(chain string) bool {
+	return r.adapters[strings.ToLower(chain)] != nil
+}
+
+// GetChainAdapter returns the adapter for a given chain.
+func (r *router) GetChainAdapter(chain string) exported.ChainAdapter {
+	if !r.HasChainAdapter(chain) {
+		panic(fmt.Sprintf("adapter for chain \"%s\" not registered", chain))
+	}
+
+	return r.adapters[strings.ToLower(chain)]
+}
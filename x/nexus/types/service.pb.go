@@ -35,32 +35,37 @@ func init() {
 }
 
 var fileDescriptor_e8a22d972057ace6 = []byte{
-	// 400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x4a, 0xe3, 0x40,
-	0x18, 0xc7, 0x3b, 0x3d, 0xec, 0x21, 0xec, 0x5e, 0x42, 0xd9, 0xa5, 0xdd, 0x25, 0x87, 0xc2, 0xb2,
-	0xd0, 0xdd, 0x66, 0xda, 0xec, 0xcd, 0x9b, 0xda, 0x83, 0x88, 0x85, 0x5a, 0x6f, 0x5e, 0xca, 0x34,
-	0xf9, 0x48, 0x07, 0xeb, 0x4c, 0x3a, 0x33, 0xa9, 0x29, 0xd2, 0x8b, 0x4f, 0x20, 0xf8, 0x16, 0x1e,
-	0x7d, 0x02, 0xbd, 0x79, 0x2c, 0x7a, 0xf1, 0x28, 0x8d, 0x67, 0x9f, 0x41, 0x92, 0x4c, 0xc1, 0x94,
-	0x06, 0x7a, 0x4b, 0xbe, 0xff, 0xef, 0x9f, 0xfc, 0x32, 0xf9, 0x8c, 0x9f, 0x0c, 0xa2, 0x50, 0xe2,
-	0x69, 0x7b, 0x08, 0x8a, 0xb4, 0xb1, 0x04, 0x31, 0xa5, 0x2e, 0xd8, 0x81, 0xe0, 0x8a, 0x9b, 0xdf,
-	0xd2, 0xd0, 0xd6, 0x61, 0xad, 0xe2, 0x73, 0x9f, 0xa7, 0x09, 0x4e, 0xae, 0x32, 0xa8, 0xf6, 0xcb,
-	0xe7, 0xdc, 0x1f, 0x03, 0x26, 0x01, 0xc5, 0x84, 0x31, 0xae, 0x88, 0xa2, 0x9c, 0x49, 0x9d, 0x7e,
-	0xcf, 0x3f, 0x5f, 0x45, 0x7a, 0x5e, 0xcd, 0xcf, 0x27, 0x21, 0x88, 0x59, 0x16, 0x39, 0xef, 0x65,
-	0xc3, 0xe8, 0x4a, 0xff, 0x24, 0x53, 0x31, 0x6f, 0x91, 0xf1, 0xa3, 0x0f, 0x3e, 0x95, 0x0a, 0xc4,
-	0xfe, 0x88, 0x50, 0xd6, 0x25, 0x94, 0x29, 0x42, 0x19, 0x08, 0xb3, 0x69, 0xe7, 0x0c, 0xed, 0x02,
-	0xae, 0x0f, 0x93, 0x10, 0xa4, 0xaa, 0xd9, 0xdb, 0xe2, 0x32, 0xe0, 0x4c, 0x42, 0xbd, 0x75, 0xf5,
-	0xfc, 0x76, 0x53, 0x6e, 0xd4, 0x7f, 0x63, 0x12, 0xc1, 0x98, 0x08, 0x9c, 0x49, 0x8b, 0xcd, 0xb5,
-	0x1d, 0xd4, 0x30, 0xef, 0x90, 0x51, 0xed, 0x40, 0x01, 0x60, 0xe2, 0xb5, 0xf7, 0x17, 0x92, 0x2b,
-	0xe1, 0xd6, 0xf6, 0x05, 0xad, 0xec, 0xa4, 0xca, 0xff, 0xea, 0x7f, 0xf2, 0xca, 0x1e, 0x14, 0x4b,
-	0x3b, 0x4f, 0xc8, 0xf8, 0x7a, 0x9c, 0xfc, 0x80, 0xd5, 0x91, 0x3f, 0x20, 0xa3, 0x72, 0x44, 0x14,
-	0x48, 0xd5, 0x81, 0x80, 0x4b, 0xaa, 0x76, 0x3d, 0x4f, 0x80, 0x94, 0x66, 0x63, 0xcd, 0x67, 0x13,
-	0xb4, 0x72, 0xff, 0xbb, 0x15, 0xab, 0xb5, 0x7b, 0xa9, 0xf6, 0xa1, 0x79, 0x80, 0xf3, 0x7b, 0x31,
-	0x4e, 0x4b, 0x03, 0x2f, 0x6b, 0x0d, 0x48, 0x56, 0xc3, 0x97, 0x02, 0x5c, 0x1a, 0x50, 0x60, 0x6a,
-	0xe0, 0x26, 0xdf, 0x33, 0xff, 0x3c, 0x49, 0xa0, 0xf9, 0x5e, 0xef, 0x71, 0x69, 0xa1, 0xc5, 0xd2,
-	0x42, 0xaf, 0x4b, 0x0b, 0x5d, 0xc7, 0x56, 0xe9, 0x3e, 0xb6, 0xd0, 0x22, 0xb6, 0x4a, 0x2f, 0xb1,
-	0x55, 0x3a, 0x75, 0x7c, 0xaa, 0x46, 0xe1, 0xd0, 0x76, 0xf9, 0xb9, 0x3e, 0x28, 0x06, 0xea, 0x82,
-	0x8b, 0x33, 0x7d, 0xd7, 0x74, 0xb9, 0x00, 0x1c, 0x69, 0x1b, 0x35, 0x0b, 0x40, 0x0e, 0xbf, 0xa4,
-	0xeb, 0xf9, 0xff, 0x23, 0x00, 0x00, 0xff, 0xff, 0x48, 0x6a, 0x98, 0x4e, 0x33, 0x03, 0x00, 0x00,
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0xd4, 0xb1, 0x6e, 0xd3, 0x40,
+	0x18, 0x07, 0xf0, 0x1c, 0x12, 0x0c, 0x27, 0x58, 0x4e, 0x55, 0x51, 0x03, 0xf2, 0x60, 0x81, 0x80,
+	0xd0, 0xf8, 0x9a, 0x74, 0xeb, 0x06, 0x2d, 0x12, 0x42, 0x54, 0x2a, 0x45, 0x62, 0x60, 0x89, 0x2e,
+	0xce, 0x87, 0x7b, 0x22, 0xbd, 0x73, 0xef, 0xbe, 0x04, 0x57, 0x28, 0x0b, 0x4f, 0x80, 0xc4, 0x3b,
+	0x30, 0x30, 0x32, 0x30, 0xc3, 0xc6, 0x18, 0xc1, 0xc2, 0x88, 0x12, 0x1e, 0x04, 0xf9, 0x7c, 0x96,
+	0x70, 0x14, 0x23, 0x77, 0xb3, 0xef, 0xfb, 0xff, 0xed, 0x9f, 0x4e, 0x67, 0xd3, 0x1b, 0x0a, 0xb2,
+	0x89, 0xe5, 0xd3, 0xde, 0x10, 0x50, 0xf4, 0xb8, 0x05, 0x33, 0x95, 0x31, 0x44, 0xa9, 0xd1, 0xa8,
+	0xd9, 0x35, 0x37, 0x8c, 0xfc, 0xb0, 0xbd, 0x91, 0xe8, 0x44, 0xbb, 0x09, 0xcf, 0xaf, 0x8a, 0x50,
+	0xfb, 0x66, 0xa2, 0x75, 0x32, 0x06, 0x2e, 0x52, 0xc9, 0x85, 0x52, 0x1a, 0x05, 0x4a, 0xad, 0xac,
+	0x9f, 0x6e, 0x56, 0x9f, 0x8f, 0x99, 0x5f, 0xdf, 0xaa, 0xae, 0x9f, 0x4d, 0xc0, 0x9c, 0x17, 0xa3,
+	0xfe, 0xfc, 0x32, 0xa5, 0x87, 0x36, 0x79, 0x5e, 0x50, 0xd8, 0x27, 0x42, 0xaf, 0x1f, 0x43, 0x22,
+	0x2d, 0x82, 0xd9, 0x3f, 0x11, 0x52, 0x1d, 0x0a, 0xa9, 0x50, 0x48, 0x05, 0x86, 0x75, 0xa3, 0x8a,
+	0x30, 0xaa, 0xc9, 0x1d, 0xc3, 0xd9, 0x04, 0x2c, 0xb6, 0xa3, 0xa6, 0x71, 0x9b, 0x6a, 0x65, 0x21,
+	0xdc, 0x79, 0xf7, 0xf3, 0xcf, 0x87, 0x4b, 0x9d, 0xf0, 0x36, 0x17, 0x19, 0x8c, 0x85, 0xe1, 0x05,
+	0xda, 0xac, 0xaf, 0xed, 0x91, 0x0e, 0xfb, 0x4c, 0xe8, 0xd6, 0x01, 0xd4, 0x04, 0x18, 0x5f, 0x79,
+	0x7f, 0x6d, 0xb2, 0x04, 0xef, 0x34, 0x2f, 0x78, 0x72, 0xdf, 0x91, 0xb7, 0xc3, 0x3b, 0x55, 0xf2,
+	0x08, 0xfe, 0x83, 0xfe, 0x48, 0xe8, 0xe6, 0xbe, 0x56, 0xaf, 0xa4, 0x39, 0x7d, 0x94, 0x21, 0x18,
+	0x25, 0xc6, 0x07, 0x90, 0x6a, 0x2b, 0x91, 0x6d, 0xaf, 0x00, 0xd6, 0xc7, 0x4a, 0x6e, 0xb7, 0x61,
+	0xda, 0x5b, 0xb9, 0xb3, 0xde, 0x0b, 0x6f, 0x55, 0xad, 0xf1, 0xda, 0x56, 0x0e, 0xfd, 0x42, 0x68,
+	0xfb, 0x85, 0x46, 0xa8, 0xc1, 0xae, 0xee, 0x56, 0x7d, 0xb4, 0x04, 0xf7, 0x2e, 0xd0, 0xf0, 0xe8,
+	0x5d, 0x87, 0xee, 0x86, 0x77, 0xab, 0xe8, 0x69, 0x6d, 0x73, 0x8f, 0x74, 0xfa, 0x3f, 0x08, 0xbd,
+	0xfa, 0x2c, 0x3f, 0xe2, 0xe5, 0xa1, 0xfe, 0x46, 0xe8, 0xc6, 0x53, 0x81, 0x60, 0xd1, 0xa7, 0x1e,
+	0x8c, 0x46, 0x06, 0xac, 0x65, 0x9d, 0x15, 0xd1, 0xba, 0x50, 0xa9, 0xbf, 0xdf, 0x28, 0xeb, 0xdd,
+	0x47, 0xce, 0xfd, 0x84, 0x3d, 0xe6, 0xd5, 0x2f, 0x6f, 0xec, 0x4a, 0x83, 0x51, 0xd1, 0x1a, 0x88,
+	0xa2, 0xc6, 0xdf, 0x1a, 0x88, 0x65, 0x2a, 0x41, 0xe1, 0x20, 0xce, 0x4f, 0xcc, 0xec, 0xdf, 0x95,
+	0x3c, 0x34, 0x7b, 0x78, 0xf4, 0x7d, 0x11, 0x90, 0xf9, 0x22, 0x20, 0xbf, 0x17, 0x01, 0x79, 0xbf,
+	0x0c, 0x5a, 0x5f, 0x97, 0x01, 0x99, 0x2f, 0x83, 0xd6, 0xaf, 0x65, 0xd0, 0x7a, 0xd9, 0x4f, 0x24,
+	0x9e, 0x4c, 0x86, 0x51, 0xac, 0x4f, 0xfd, 0x4e, 0x29, 0xc0, 0x37, 0xda, 0xbc, 0xf6, 0x77, 0xdd,
+	0x58, 0x1b, 0xe0, 0x99, 0xd7, 0xe0, 0x79, 0x0a, 0x76, 0x78, 0xc5, 0xfd, 0x00, 0x76, 0xff, 0x0e,
+	0x00, 0x2b, 0x96, 0x43, 0x7e, 0x95, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -77,6 +82,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgServiceClient interface {
 	RegisterChainMaintainer(ctx context.Context, in *RegisterChainMaintainerRequest, opts ...grpc.CallOption) (*RegisterChainMaintainerResponse, error)
 	DeregisterChainMaintainer(ctx context.Context, in *DeregisterChainMaintainerRequest, opts ...grpc.CallOption) (*DeregisterChainMaintainerResponse, error)
+	ConfirmExternalDeposit(ctx context.Context, in *ConfirmExternalDepositRequest, opts ...grpc.CallOption) (*ConfirmExternalDepositResponse, error)
+	VoteConfirmExternalDeposit(ctx context.Context, in *VoteConfirmExternalDepositRequest, opts ...grpc.CallOption) (*VoteConfirmExternalDepositResponse, error)
 }
 
 type msgServiceClient struct {
@@ -105,10 +112,30 @@ func (c *msgServiceClient) DeregisterChainMaintainer(ctx context.Context, in *De
 	return out, nil
 }
 
+func (c *msgServiceClient) ConfirmExternalDeposit(ctx context.Context, in *ConfirmExternalDepositRequest, opts ...grpc.CallOption) (*ConfirmExternalDepositResponse, error) {
+	out := new(ConfirmExternalDepositResponse)
+	err := c.cc.Invoke(ctx, "/nexus.v1beta1.MsgService/ConfirmExternalDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) VoteConfirmExternalDeposit(ctx context.Context, in *VoteConfirmExternalDepositRequest, opts ...grpc.CallOption) (*VoteConfirmExternalDepositResponse, error) {
+	out := new(VoteConfirmExternalDepositResponse)
+	err := c.cc.Invoke(ctx, "/nexus.v1beta1.MsgService/VoteConfirmExternalDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 type MsgServiceServer interface {
 	RegisterChainMaintainer(context.Context, *RegisterChainMaintainerRequest) (*RegisterChainMaintainerResponse, error)
 	DeregisterChainMaintainer(context.Context, *DeregisterChainMaintainerRequest) (*DeregisterChainMaintainerResponse, error)
+	ConfirmExternalDeposit(context.Context, *ConfirmExternalDepositRequest) (*ConfirmExternalDepositResponse, error)
+	VoteConfirmExternalDeposit(context.Context, *VoteConfirmExternalDepositRequest) (*VoteConfirmExternalDepositResponse, error)
 }
 
 // UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
@@ -121,6 +148,12 @@ func (*UnimplementedMsgServiceServer) RegisterChainMaintainer(ctx context.Contex
 func (*UnimplementedMsgServiceServer) DeregisterChainMaintainer(ctx context.Context, req *DeregisterChainMaintainerRequest) (*DeregisterChainMaintainerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeregisterChainMaintainer not implemented")
 }
+func (*UnimplementedMsgServiceServer) ConfirmExternalDeposit(ctx context.Context, req *ConfirmExternalDepositRequest) (*ConfirmExternalDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmExternalDeposit not implemented")
+}
+func (*UnimplementedMsgServiceServer) VoteConfirmExternalDeposit(ctx context.Context, req *VoteConfirmExternalDepositRequest) (*VoteConfirmExternalDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteConfirmExternalDeposit not implemented")
+}
 
 func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
 	s.RegisterService(&_MsgService_serviceDesc, srv)
@@ -162,6 +195,42 @@ func _MsgService_DeregisterChainMaintainer_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_ConfirmExternalDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmExternalDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ConfirmExternalDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexus.v1beta1.MsgService/ConfirmExternalDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ConfirmExternalDeposit(ctx, req.(*ConfirmExternalDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_VoteConfirmExternalDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteConfirmExternalDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).VoteConfirmExternalDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexus.v1beta1.MsgService/VoteConfirmExternalDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).VoteConfirmExternalDeposit(ctx, req.(*VoteConfirmExternalDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MsgService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nexus.v1beta1.MsgService",
 	HandlerType: (*MsgServiceServer)(nil),
@@ -174,6 +243,14 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeregisterChainMaintainer",
 			Handler:    _MsgService_DeregisterChainMaintainer_Handler,
 		},
+		{
+			MethodName: "ConfirmExternalDeposit",
+			Handler:    _MsgService_ConfirmExternalDeposit_Handler,
+		},
+		{
+			MethodName: "VoteConfirmExternalDeposit",
+			Handler:    _MsgService_VoteConfirmExternalDeposit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nexus/v1beta1/service.proto",
@@ -5,7 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	exported "github.com/axelarnetwork/axelar-core/x/vote/exported"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -173,36 +175,209 @@ func (m *DeregisterChainMaintainerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeregisterChainMaintainerResponse proto.InternalMessageInfo
 
+// ConfirmExternalDepositRequest starts a poll to confirm a deposit on a chain
+// that is connected through a chain adapter
+type ConfirmExternalDepositRequest struct {
+	Sender         github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	Chain          string                                        `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	TxID           string                                        `protobuf:"bytes,3,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	DepositAddress string                                        `protobuf:"bytes,4,opt,name=deposit_address,json=depositAddress,proto3" json:"deposit_address,omitempty"`
+	Amount         types.Coin                                    `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *ConfirmExternalDepositRequest) Reset()         { *m = ConfirmExternalDepositRequest{} }
+func (m *ConfirmExternalDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfirmExternalDepositRequest) ProtoMessage()    {}
+func (*ConfirmExternalDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af3d47209cda0b3, []int{4}
+}
+func (m *ConfirmExternalDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfirmExternalDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfirmExternalDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfirmExternalDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmExternalDepositRequest.Merge(m, src)
+}
+func (m *ConfirmExternalDepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfirmExternalDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmExternalDepositRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmExternalDepositRequest proto.InternalMessageInfo
+
+type ConfirmExternalDepositResponse struct {
+}
+
+func (m *ConfirmExternalDepositResponse) Reset()         { *m = ConfirmExternalDepositResponse{} }
+func (m *ConfirmExternalDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*ConfirmExternalDepositResponse) ProtoMessage()    {}
+func (*ConfirmExternalDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af3d47209cda0b3, []int{5}
+}
+func (m *ConfirmExternalDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfirmExternalDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfirmExternalDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfirmExternalDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmExternalDepositResponse.Merge(m, src)
+}
+func (m *ConfirmExternalDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfirmExternalDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmExternalDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmExternalDepositResponse proto.InternalMessageInfo
+
+type VoteConfirmExternalDepositRequest struct {
+	Sender    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	PollKey   exported.PollKey                              `protobuf:"bytes,2,opt,name=poll_key,json=pollKey,proto3" json:"poll_key"`
+	Confirmed bool                                          `protobuf:"varint,3,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+}
+
+func (m *VoteConfirmExternalDepositRequest) Reset()         { *m = VoteConfirmExternalDepositRequest{} }
+func (m *VoteConfirmExternalDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*VoteConfirmExternalDepositRequest) ProtoMessage()    {}
+func (*VoteConfirmExternalDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af3d47209cda0b3, []int{6}
+}
+func (m *VoteConfirmExternalDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteConfirmExternalDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteConfirmExternalDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteConfirmExternalDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteConfirmExternalDepositRequest.Merge(m, src)
+}
+func (m *VoteConfirmExternalDepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteConfirmExternalDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteConfirmExternalDepositRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteConfirmExternalDepositRequest proto.InternalMessageInfo
+
+type VoteConfirmExternalDepositResponse struct {
+	Log string `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+}
+
+func (m *VoteConfirmExternalDepositResponse) Reset()         { *m = VoteConfirmExternalDepositResponse{} }
+func (m *VoteConfirmExternalDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*VoteConfirmExternalDepositResponse) ProtoMessage()    {}
+func (*VoteConfirmExternalDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af3d47209cda0b3, []int{7}
+}
+func (m *VoteConfirmExternalDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteConfirmExternalDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteConfirmExternalDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteConfirmExternalDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteConfirmExternalDepositResponse.Merge(m, src)
+}
+func (m *VoteConfirmExternalDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteConfirmExternalDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteConfirmExternalDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteConfirmExternalDepositResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*RegisterChainMaintainerRequest)(nil), "nexus.v1beta1.RegisterChainMaintainerRequest")
 	proto.RegisterType((*RegisterChainMaintainerResponse)(nil), "nexus.v1beta1.RegisterChainMaintainerResponse")
 	proto.RegisterType((*DeregisterChainMaintainerRequest)(nil), "nexus.v1beta1.DeregisterChainMaintainerRequest")
 	proto.RegisterType((*DeregisterChainMaintainerResponse)(nil), "nexus.v1beta1.DeregisterChainMaintainerResponse")
+	proto.RegisterType((*ConfirmExternalDepositRequest)(nil), "nexus.v1beta1.ConfirmExternalDepositRequest")
+	proto.RegisterType((*ConfirmExternalDepositResponse)(nil), "nexus.v1beta1.ConfirmExternalDepositResponse")
+	proto.RegisterType((*VoteConfirmExternalDepositRequest)(nil), "nexus.v1beta1.VoteConfirmExternalDepositRequest")
+	proto.RegisterType((*VoteConfirmExternalDepositResponse)(nil), "nexus.v1beta1.VoteConfirmExternalDepositResponse")
 }
 
 func init() { proto.RegisterFile("nexus/v1beta1/tx.proto", fileDescriptor_7af3d47209cda0b3) }
 
 var fileDescriptor_7af3d47209cda0b3 = []byte{
-	// 301 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x91, 0xbf, 0x4a, 0x33, 0x41,
-	0x14, 0xc5, 0x77, 0xbe, 0x0f, 0x02, 0x0e, 0xda, 0x04, 0x09, 0x21, 0xc8, 0xe4, 0x8f, 0x4d, 0x9a,
-	0xec, 0x10, 0x7d, 0x82, 0x44, 0x1b, 0x0b, 0x41, 0xb6, 0xb4, 0x9b, 0xcc, 0x5e, 0x26, 0x43, 0x92,
-	0xb9, 0xeb, 0xdc, 0x89, 0xae, 0xb5, 0xd8, 0xfb, 0x58, 0x29, 0x53, 0x5a, 0x89, 0x66, 0xdf, 0xc2,
-	0x4a, 0xb2, 0xbb, 0x85, 0x8d, 0xb6, 0x56, 0x33, 0xe7, 0x9c, 0xcb, 0xbd, 0x3f, 0x38, 0xbc, 0xe5,
-	0x20, 0x5f, 0x93, 0xbc, 0x1f, 0xcf, 0x20, 0xa8, 0xb1, 0x0c, 0x79, 0x9c, 0x79, 0x0c, 0xd8, 0x3c,
-	0x2a, 0xfd, 0xb8, 0xf6, 0x3b, 0xc7, 0x06, 0x0d, 0x96, 0x89, 0xdc, 0xff, 0xaa, 0xa1, 0xce, 0x89,
-	0x41, 0x34, 0x4b, 0x90, 0x2a, 0xb3, 0x52, 0x39, 0x87, 0x41, 0x05, 0x8b, 0x8e, 0xaa, 0x74, 0xf0,
-	0xc4, 0xb8, 0x48, 0xc0, 0x58, 0x0a, 0xe0, 0x2f, 0xe6, 0xca, 0xba, 0x6b, 0x65, 0x5d, 0x50, 0xd6,
-	0x81, 0x4f, 0xe0, 0x6e, 0x0d, 0x14, 0x9a, 0x57, 0xbc, 0x41, 0xe0, 0x52, 0xf0, 0x6d, 0xd6, 0x63,
-	0xc3, 0xc3, 0xe9, 0xf8, 0xf3, 0xad, 0x3b, 0x32, 0x36, 0xcc, 0xd7, 0xb3, 0x58, 0xe3, 0x4a, 0x6a,
-	0xa4, 0x15, 0x52, 0xfd, 0x8c, 0x28, 0x5d, 0xc8, 0xf0, 0x98, 0x01, 0xc5, 0x13, 0xad, 0x27, 0x69,
-	0xea, 0x81, 0x28, 0xa9, 0x17, 0x34, 0x5b, 0xbc, 0xa1, 0xf7, 0x47, 0xa8, 0xfd, 0xaf, 0xf7, 0x7f,
-	0x78, 0x90, 0xd4, 0x6a, 0xd0, 0xe7, 0xdd, 0x1f, 0x21, 0x28, 0x43, 0x47, 0x30, 0x78, 0x66, 0xbc,
-	0x77, 0x09, 0xfe, 0xcf, 0x51, 0x4f, 0x79, 0xff, 0x17, 0x8c, 0x0a, 0x76, 0x7a, 0xb3, 0xf9, 0x10,
-	0xd1, 0x66, 0x27, 0xd8, 0x76, 0x27, 0xd8, 0xfb, 0x4e, 0xb0, 0x97, 0x42, 0x44, 0xdb, 0x42, 0x44,
-	0xaf, 0x85, 0x88, 0x6e, 0xcf, 0xbe, 0x11, 0xa9, 0x1c, 0x96, 0xca, 0x3b, 0x08, 0x0f, 0xe8, 0x17,
-	0xb5, 0x1a, 0x69, 0xf4, 0x20, 0x73, 0x59, 0xb5, 0x5e, 0x12, 0xce, 0x1a, 0x65, 0x5d, 0xe7, 0x5f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x46, 0xd6, 0x4a, 0x0b, 0x02, 0x00, 0x00,
+	// 528 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xe3, 0x36, 0x09, 0xc9, 0x95, 0x5f, 0xb2, 0xaa, 0xca, 0x54, 0xad, 0xe3, 0x98, 0x81,
+	0x2c, 0xb1, 0x95, 0x20, 0xc1, 0x88, 0x9a, 0x84, 0x21, 0x42, 0x48, 0x95, 0x85, 0x18, 0x58, 0xa2,
+	0x8b, 0xfd, 0x70, 0x4f, 0x71, 0xee, 0x99, 0xbb, 0x4b, 0x71, 0x46, 0x84, 0xd8, 0xf9, 0xb3, 0xb2,
+	0x20, 0x75, 0x64, 0x8a, 0x20, 0xf9, 0x2f, 0x98, 0x90, 0xed, 0x6b, 0xcb, 0xd2, 0x6e, 0xc0, 0x94,
+	0x7b, 0xef, 0x5d, 0xde, 0xfb, 0x7c, 0xbf, 0xba, 0x67, 0x72, 0xc0, 0x21, 0x5b, 0x48, 0xff, 0xbc,
+	0x37, 0x05, 0x45, 0x7b, 0xbe, 0xca, 0xbc, 0x54, 0xa0, 0x42, 0xf3, 0x5e, 0x91, 0xf7, 0x74, 0xfe,
+	0x70, 0x3f, 0xc6, 0x18, 0x8b, 0x8a, 0x9f, 0x9f, 0xca, 0x4b, 0x87, 0x47, 0x31, 0x62, 0x9c, 0x80,
+	0x4f, 0x53, 0xe6, 0x53, 0xce, 0x51, 0x51, 0xc5, 0x90, 0x4b, 0x5d, 0xb5, 0x43, 0x94, 0x73, 0x94,
+	0xfe, 0x94, 0x4a, 0xb8, 0x1a, 0x10, 0x22, 0xe3, 0xba, 0xde, 0x3e, 0x47, 0x05, 0x3e, 0x64, 0x29,
+	0x0a, 0x05, 0xd1, 0x35, 0xc2, 0x32, 0x05, 0xdd, 0xc2, 0xfd, 0x6c, 0x10, 0x3b, 0x80, 0x98, 0x49,
+	0x05, 0x62, 0x78, 0x46, 0x19, 0x7f, 0x4d, 0x19, 0x57, 0x94, 0x71, 0x10, 0x01, 0x7c, 0x58, 0x80,
+	0x54, 0xe6, 0x98, 0xd4, 0x25, 0xf0, 0x08, 0x84, 0x65, 0x38, 0x46, 0xe7, 0xee, 0xa0, 0xf7, 0x6b,
+	0xdd, 0xea, 0xc6, 0x4c, 0x9d, 0x2d, 0xa6, 0x5e, 0x88, 0x73, 0x5f, 0x43, 0x94, 0x3f, 0x5d, 0x19,
+	0xcd, 0xf4, 0x80, 0x93, 0x30, 0x3c, 0x89, 0x22, 0x01, 0x52, 0x06, 0xba, 0x81, 0x79, 0x40, 0xea,
+	0x61, 0x3e, 0x44, 0x5a, 0x3b, 0xce, 0x6e, 0xa7, 0x19, 0xe8, 0xc8, 0x6d, 0x93, 0xd6, 0x8d, 0x10,
+	0x32, 0x45, 0x2e, 0xc1, 0xfd, 0x62, 0x10, 0x67, 0x04, 0xe2, 0xbf, 0xa3, 0x3e, 0x26, 0xed, 0x5b,
+	0x30, 0x34, 0xec, 0xa7, 0x1d, 0x72, 0x3c, 0x44, 0xfe, 0x9e, 0x89, 0xf9, 0xcb, 0x4c, 0x81, 0xe0,
+	0x34, 0x19, 0x41, 0x8a, 0x92, 0xa9, 0xbf, 0x40, 0xba, 0x4f, 0x6a, 0x05, 0x9b, 0xb5, 0xe3, 0x18,
+	0x9d, 0x66, 0x50, 0x06, 0xe6, 0x31, 0xa9, 0xa9, 0x6c, 0xc2, 0x22, 0x6b, 0x37, 0xcf, 0x0e, 0x1a,
+	0x9b, 0x75, 0xab, 0xfa, 0x26, 0x1b, 0x8f, 0x82, 0xaa, 0xca, 0xc6, 0x91, 0xf9, 0x84, 0x3c, 0x88,
+	0x4a, 0xa2, 0x09, 0x2d, 0xfb, 0x59, 0xd5, 0xe2, 0xef, 0xf7, 0x75, 0x5a, 0x4f, 0x31, 0x9f, 0x93,
+	0x3a, 0x9d, 0xe3, 0x82, 0x2b, 0xab, 0xe6, 0x18, 0x9d, 0xbd, 0xfe, 0x23, 0xaf, 0x64, 0xf2, 0xf2,
+	0x47, 0x77, 0xf9, 0x7a, 0xbd, 0x21, 0x32, 0x3e, 0xa8, 0xae, 0xd6, 0xad, 0x4a, 0xa0, 0xaf, 0xbb,
+	0x0e, 0xb1, 0x6f, 0xb2, 0x40, 0xbb, 0xf4, 0xcd, 0x20, 0xed, 0xb7, 0xa8, 0xe0, 0x9f, 0x39, 0xf5,
+	0x82, 0x34, 0x52, 0x4c, 0x92, 0xc9, 0x0c, 0x96, 0x85, 0x59, 0x7b, 0x7d, 0xdb, 0xcb, 0x57, 0xc4,
+	0xbb, 0x5c, 0x91, 0x2b, 0x3d, 0xa7, 0x98, 0x24, 0xaf, 0x60, 0xa9, 0x25, 0xdd, 0x49, 0xcb, 0xd0,
+	0x3c, 0x22, 0xcd, 0xb0, 0x84, 0x85, 0xd2, 0xd8, 0x46, 0x70, 0x9d, 0x70, 0x9f, 0x11, 0xf7, 0x36,
+	0x39, 0xa5, 0x6a, 0xf3, 0x21, 0xd9, 0x4d, 0x30, 0x2e, 0xc4, 0x34, 0x83, 0xfc, 0x38, 0x38, 0x5d,
+	0xfd, 0xb4, 0x2b, 0xab, 0x8d, 0x6d, 0x5c, 0x6c, 0x6c, 0xe3, 0xc7, 0xc6, 0x36, 0xbe, 0x6e, 0xed,
+	0xca, 0xc5, 0xd6, 0xae, 0x7c, 0xdf, 0xda, 0x95, 0x77, 0xfd, 0x3f, 0xb4, 0xd2, 0x0c, 0x12, 0x2a,
+	0x38, 0xa8, 0x8f, 0x28, 0x66, 0x3a, 0xea, 0x86, 0x28, 0xc0, 0xcf, 0xfc, 0xf2, 0x33, 0x53, 0x68,
+	0x9f, 0xd6, 0x8b, 0xe5, 0x7e, 0xfa, 0x7b, 0x00, 0x3e, 0xff, 0xa6, 0x12, 0x7c, 0x04, 0x00, 0x00,
 }
 
 func (m *RegisterChainMaintainerRequest) Marshal() (dAtA []byte, err error) {
@@ -329,6 +504,170 @@ func (m *DeregisterChainMaintainerResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *ConfirmExternalDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfirmExternalDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfirmExternalDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DepositAddress) > 0 {
+		i -= len(m.DepositAddress)
+		copy(dAtA[i:], m.DepositAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DepositAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfirmExternalDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfirmExternalDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfirmExternalDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteConfirmExternalDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteConfirmExternalDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteConfirmExternalDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Confirmed {
+		i--
+		if m.Confirmed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.PollKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteConfirmExternalDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteConfirmExternalDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteConfirmExternalDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Log) > 0 {
+		i -= len(m.Log)
+		copy(dAtA[i:], m.Log)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Log)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -396,6 +735,73 @@ func (m *DeregisterChainMaintainerResponse) Size() (n int) {
 	return n
 }
 
+func (m *ConfirmExternalDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DepositAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *ConfirmExternalDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *VoteConfirmExternalDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PollKey.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Confirmed {
+		n += 2
+	}
+	return n
+}
+
+func (m *VoteConfirmExternalDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -734,6 +1140,488 @@ func (m *DeregisterChainMaintainerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConfirmExternalDepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfirmExternalDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfirmExternalDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfirmExternalDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfirmExternalDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfirmExternalDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteConfirmExternalDepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteConfirmExternalDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteConfirmExternalDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PollKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Confirmed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteConfirmExternalDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteConfirmExternalDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteConfirmExternalDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Log = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
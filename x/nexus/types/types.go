@@ -2,9 +2,11 @@ package types
 
 import (
 	fmt "fmt"
+	"math/big"
 
 	"github.com/axelarnetwork/axelar-core/utils"
 	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -61,6 +63,60 @@ func (m ChainState) Validate() error {
 		}
 	}
 
+	for _, asset := range m.NativeAssets {
+		if !m.HasAsset(asset) {
+			return fmt.Errorf("native asset %s is not registered for chain %s", asset, m.Chain.Name)
+		}
+	}
+
+	for _, precision := range m.AssetDecimals {
+		if err := precision.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := m.Dust.Validate(); err != nil {
+		return err
+	}
+
+	if err := m.Minted.Validate(); err != nil {
+		return err
+	}
+
+	if err := m.Burned.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetAssetDecimals returns the decimals of the given asset on the chain, if they are registered
+func (m ChainState) GetAssetDecimals(asset string) (uint32, bool) {
+	for _, precision := range m.AssetDecimals {
+		if precision.Asset == asset {
+			return precision.Decimals, true
+		}
+	}
+
+	return 0, false
+}
+
+// SetAssetDecimals registers the decimals of the given asset on the chain
+func (m *ChainState) SetAssetDecimals(asset string, decimals uint32) error {
+	precision := AssetPrecision{Asset: asset, Decimals: decimals}
+	if err := precision.Validate(); err != nil {
+		return err
+	}
+
+	if registered, ok := m.GetAssetDecimals(asset); ok {
+		if registered != decimals {
+			return fmt.Errorf("asset %s is already registered with %d decimals for chain %s", asset, registered, m.Chain.Name)
+		}
+
+		return nil
+	}
+
+	m.AssetDecimals = append(m.AssetDecimals, precision)
 	return nil
 }
 
@@ -80,6 +136,66 @@ func (m *ChainState) AddAsset(asset string) error {
 	return nil
 }
 
+// IsNativeAsset returns true if the given asset originates on the chain; false otherwise
+func (m ChainState) IsNativeAsset(asset string) bool {
+	return m.Chain.NativeAsset == asset || utils.IndexOf(m.NativeAssets, asset) != -1
+}
+
+// AddNativeAsset registers the given asset in chain state as originating on the chain
+func (m *ChainState) AddNativeAsset(asset string) error {
+	if m.IsNativeAsset(asset) {
+		return fmt.Errorf("asset %s is already native to chain %s", asset, m.Chain.Name)
+	}
+
+	if !m.HasAsset(asset) {
+		if err := m.AddAsset(asset); err != nil {
+			return err
+		}
+	}
+	m.NativeAssets = append(m.NativeAssets, asset)
+
+	return nil
+}
+
+// MaxDecimals is the highest precision an asset can be registered with
+const MaxDecimals = 36
+
+// Validate validates the AssetPrecision
+func (m AssetPrecision) Validate() error {
+	if err := sdk.ValidateDenom(m.Asset); err != nil {
+		return err
+	}
+
+	if m.Decimals > MaxDecimals {
+		return fmt.Errorf("asset %s cannot have more than %d decimals", m.Asset, MaxDecimals)
+	}
+
+	return nil
+}
+
+// ScaleAmount converts the given amount from one precision to another. It returns the converted amount
+// and the remainder that is lost to rounding, denominated in the original precision
+func ScaleAmount(amount sdk.Int, fromDecimals, toDecimals uint32) (sdk.Int, sdk.Int, error) {
+	if fromDecimals > MaxDecimals || toDecimals > MaxDecimals {
+		return sdk.Int{}, sdk.Int{}, fmt.Errorf("precision cannot exceed %d decimals", MaxDecimals)
+	}
+
+	if fromDecimals <= toDecimals {
+		factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(toDecimals-fromDecimals)), nil)
+		scaled := new(big.Int).Mul(amount.BigInt(), factor)
+		if scaled.BitLen() > 255 {
+			return sdk.Int{}, sdk.Int{}, fmt.Errorf("amount %s is too large to convert to %d decimals", amount, toDecimals)
+		}
+
+		return sdk.NewIntFromBigInt(scaled), sdk.ZeroInt(), nil
+	}
+
+	factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(fromDecimals-toDecimals)), nil)
+	scaled, remainder := new(big.Int).QuoRem(amount.BigInt(), factor, new(big.Int))
+
+	return sdk.NewIntFromBigInt(scaled), sdk.NewIntFromBigInt(remainder), nil
+}
+
 // HasMaintainer returns true if the given maintainer is registered for the chain; false otherwise
 func (m ChainState) HasMaintainer(maintainer sdk.ValAddress) bool {
 	return m.indexOfMaintainer(maintainer) != -1
@@ -108,3 +224,38 @@ func (m *ChainState) RemoveMaintainer(maintainer sdk.ValAddress) error {
 
 	return nil
 }
+
+// NewExternalDeposit is the constructor of ExternalDeposit
+func NewExternalDeposit(txID string, depositAddress exported.CrossChainAddress, amount sdk.Coin) ExternalDeposit {
+	return ExternalDeposit{
+		TxID:           txID,
+		DepositAddress: depositAddress,
+		Amount:         amount,
+	}
+}
+
+// Validate validates the ExternalDeposit
+func (m ExternalDeposit) Validate() error {
+	if m.TxID == "" {
+		return fmt.Errorf("missing tx id")
+	}
+
+	if err := m.DepositAddress.Validate(); err != nil {
+		return err
+	}
+
+	if err := m.Amount.Validate(); err != nil {
+		return err
+	}
+
+	if !m.Amount.IsPositive() {
+		return fmt.Errorf("deposit amount must be positive")
+	}
+
+	return nil
+}
+
+// GetConfirmExternalDepositPollKey creates a poll key for the confirmation of the given deposit
+func GetConfirmExternalDepositPollKey(deposit ExternalDeposit) vote.PollKey {
+	return vote.NewPollKey(ModuleName, fmt.Sprintf("%s_%s_%s_%s", deposit.DepositAddress.Chain.Name, deposit.TxID, deposit.DepositAddress.Address, deposit.Amount.String()))
+}
@@ -33,6 +33,17 @@ type ChainState struct {
 	Activated   bool                                            `protobuf:"varint,3,opt,name=activated,proto3" json:"activated,omitempty"`
 	Total       github_com_cosmos_cosmos_sdk_types.Coins        `protobuf:"bytes,4,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
 	Assets      []string                                        `protobuf:"bytes,5,rep,name=assets,proto3" json:"assets,omitempty"`
+	// assets other than the chain's native asset that originate on the chain
+	NativeAssets []string `protobuf:"bytes,6,rep,name=native_assets,json=nativeAssets,proto3" json:"native_assets,omitempty"`
+	// decimals of the assets as represented on the chain
+	AssetDecimals []AssetPrecision `protobuf:"bytes,7,rep,name=asset_decimals,json=assetDecimals,proto3" json:"asset_decimals"`
+	// rounding remainders of deposits in the chain's precision that have not
+	// been sent to the fee collector yet
+	Dust github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=dust,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"dust"`
+	// accumulated amounts of foreign assets released to the chain
+	Minted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=minted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"minted"`
+	// accumulated amounts of foreign assets that left the chain
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
 }
 
 func (m *ChainState) Reset()         { *m = ChainState{} }
@@ -68,6 +79,45 @@ func (m *ChainState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ChainState proto.InternalMessageInfo
 
+// AssetPrecision represents the number of decimals of an asset
+type AssetPrecision struct {
+	Asset    string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Decimals uint32 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (m *AssetPrecision) Reset()         { *m = AssetPrecision{} }
+func (m *AssetPrecision) String() string { return proto.CompactTextString(m) }
+func (*AssetPrecision) ProtoMessage()    {}
+func (*AssetPrecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1651b8508c88d62f, []int{1}
+}
+func (m *AssetPrecision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetPrecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetPrecision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetPrecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetPrecision.Merge(m, src)
+}
+func (m *AssetPrecision) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetPrecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetPrecision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetPrecision proto.InternalMessageInfo
+
 type LinkedAddresses struct {
 	DepositAddress   exported.CrossChainAddress `protobuf:"bytes,1,opt,name=deposit_address,json=depositAddress,proto3" json:"deposit_address"`
 	RecipientAddress exported.CrossChainAddress `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address"`
@@ -77,7 +127,7 @@ func (m *LinkedAddresses) Reset()         { *m = LinkedAddresses{} }
 func (m *LinkedAddresses) String() string { return proto.CompactTextString(m) }
 func (*LinkedAddresses) ProtoMessage()    {}
 func (*LinkedAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1651b8508c88d62f, []int{1}
+	return fileDescriptor_1651b8508c88d62f, []int{2}
 }
 func (m *LinkedAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -106,42 +156,96 @@ func (m *LinkedAddresses) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LinkedAddresses proto.InternalMessageInfo
 
+// ExternalDeposit represents a deposit to a deposit address on a chain that is
+// connected through a chain adapter
+type ExternalDeposit struct {
+	TxID           string                     `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	DepositAddress exported.CrossChainAddress `protobuf:"bytes,2,opt,name=deposit_address,json=depositAddress,proto3" json:"deposit_address"`
+	Amount         types.Coin                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *ExternalDeposit) Reset()         { *m = ExternalDeposit{} }
+func (m *ExternalDeposit) String() string { return proto.CompactTextString(m) }
+func (*ExternalDeposit) ProtoMessage()    {}
+func (*ExternalDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1651b8508c88d62f, []int{3}
+}
+func (m *ExternalDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExternalDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExternalDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExternalDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalDeposit.Merge(m, src)
+}
+func (m *ExternalDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExternalDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalDeposit proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ChainState)(nil), "nexus.v1beta1.ChainState")
+	proto.RegisterType((*AssetPrecision)(nil), "nexus.v1beta1.AssetPrecision")
 	proto.RegisterType((*LinkedAddresses)(nil), "nexus.v1beta1.LinkedAddresses")
+	proto.RegisterType((*ExternalDeposit)(nil), "nexus.v1beta1.ExternalDeposit")
 }
 
 func init() { proto.RegisterFile("nexus/v1beta1/types.proto", fileDescriptor_1651b8508c88d62f) }
 
 var fileDescriptor_1651b8508c88d62f = []byte{
-	// 425 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xbf, 0x8e, 0xd3, 0x40,
-	0x10, 0x87, 0xed, 0xf8, 0x72, 0xe2, 0x36, 0xc0, 0xc1, 0x0a, 0x21, 0xdf, 0x09, 0x36, 0x56, 0x2a,
-	0x53, 0xc4, 0x26, 0xa1, 0xa2, 0x24, 0xb4, 0x14, 0xc8, 0x27, 0x21, 0x84, 0x90, 0xd0, 0xda, 0x1e,
-	0xe5, 0x56, 0x49, 0x76, 0xad, 0x9d, 0xbd, 0xc3, 0xbc, 0x05, 0xcf, 0x41, 0xcf, 0x3b, 0x84, 0xee,
-	0x4a, 0xaa, 0x03, 0x92, 0xb7, 0xa0, 0x42, 0xf6, 0x6e, 0xfe, 0x14, 0x20, 0xa1, 0xab, 0xec, 0x99,
-	0xf1, 0x7c, 0xfe, 0xf4, 0xd3, 0x90, 0x13, 0x09, 0xf5, 0x05, 0xa6, 0x97, 0xa3, 0x1c, 0x0c, 0x1f,
-	0xa5, 0xe6, 0x53, 0x05, 0x98, 0x54, 0x5a, 0x19, 0x45, 0xef, 0xb4, 0xa3, 0xc4, 0x8d, 0x4e, 0x1f,
-	0x4c, 0xd5, 0x54, 0xb5, 0x93, 0xb4, 0x79, 0xb3, 0x1f, 0x9d, 0xb2, 0x42, 0xe1, 0x42, 0x61, 0x9a,
-	0x73, 0x84, 0x2d, 0xa5, 0x50, 0x42, 0xba, 0xf9, 0xc0, 0xf2, 0xa1, 0xae, 0x94, 0x36, 0x50, 0xfe,
-	0xed, 0x47, 0x83, 0xaf, 0x1d, 0x42, 0x5e, 0x9e, 0x73, 0x21, 0xcf, 0x0c, 0x37, 0x40, 0x9f, 0x93,
-	0x6e, 0xd1, 0x54, 0xa1, 0x1f, 0xf9, 0x71, 0x6f, 0xfc, 0x38, 0xb1, 0x1e, 0x1b, 0xc4, 0x46, 0x28,
-	0x69, 0x57, 0x26, 0x07, 0xcb, 0xeb, 0xbe, 0x97, 0xd9, 0x0d, 0x7a, 0x46, 0x7a, 0x0b, 0x2e, 0xa4,
-	0xe1, 0x42, 0x82, 0xc6, 0xb0, 0x13, 0x05, 0xf1, 0xed, 0xc9, 0xe8, 0xf7, 0x75, 0x7f, 0x38, 0x15,
-	0xe6, 0xfc, 0x22, 0x4f, 0x0a, 0xb5, 0x48, 0x9d, 0xb1, 0x7d, 0x0c, 0xb1, 0x9c, 0x39, 0x99, 0x37,
-	0x7c, 0xfe, 0xa2, 0x2c, 0x35, 0x20, 0x66, 0xfb, 0x14, 0xfa, 0x88, 0x1c, 0xf1, 0xc2, 0x88, 0x4b,
-	0x6e, 0xa0, 0x0c, 0x83, 0xc8, 0x8f, 0x6f, 0x65, 0xbb, 0x06, 0xe5, 0xa4, 0x6b, 0x94, 0xe1, 0xf3,
-	0xf0, 0x20, 0x0a, 0xe2, 0xde, 0xf8, 0x24, 0xb1, 0xdc, 0xa4, 0x09, 0x64, 0xa7, 0xaa, 0x84, 0x9c,
-	0x3c, 0x6d, 0x4c, 0xbf, 0xfc, 0xe8, 0xc7, 0xff, 0xe1, 0xd2, 0x2c, 0x60, 0x66, 0xc9, 0xf4, 0x21,
-	0x39, 0xe4, 0x88, 0x60, 0x30, 0xec, 0x46, 0x41, 0x7c, 0x94, 0xb9, 0x6a, 0xf0, 0xcd, 0x27, 0xc7,
-	0xaf, 0x84, 0x9c, 0x41, 0xe9, 0xbc, 0x01, 0xe9, 0x5b, 0x72, 0x5c, 0x42, 0xa5, 0x50, 0x98, 0x0f,
-	0xdc, 0x36, 0x5d, 0x8c, 0x4f, 0xfe, 0x19, 0xa3, 0x56, 0x88, 0x6d, 0x96, 0x8e, 0xe2, 0x22, 0xbd,
-	0xeb, 0x38, 0xae, 0x4b, 0xdf, 0x93, 0xfb, 0x1a, 0x0a, 0x51, 0x09, 0x90, 0x3b, 0x76, 0xe7, 0x66,
-	0xec, 0x7b, 0x5b, 0xd2, 0xa6, 0xff, 0x7a, 0xf9, 0x8b, 0x79, 0xcb, 0x15, 0xf3, 0xaf, 0x56, 0xcc,
-	0xff, 0xb9, 0x62, 0xfe, 0xe7, 0x35, 0xf3, 0xae, 0xd6, 0xcc, 0xfb, 0xbe, 0x66, 0xde, 0xbb, 0xf1,
-	0x5e, 0x64, 0xbc, 0x86, 0x39, 0xd7, 0x12, 0xcc, 0x47, 0xa5, 0x67, 0xae, 0x1a, 0x16, 0x4a, 0x43,
-	0x5a, 0xa7, 0xf6, 0xd8, 0xda, 0x08, 0xf3, 0xc3, 0xf6, 0xb8, 0x9e, 0xfd, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0x01, 0x95, 0xc0, 0xdd, 0xe2, 0x02, 0x00, 0x00,
+	// 607 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0xe3, 0x24, 0xce, 0x2f, 0xd9, 0x36, 0xed, 0x8f, 0x55, 0x85, 0xdc, 0x8a, 0x3a, 0x56,
+	0xb8, 0x98, 0x43, 0x6d, 0x5a, 0x0e, 0x88, 0x63, 0x4d, 0x39, 0x14, 0x71, 0xa8, 0x5c, 0x84, 0x10,
+	0x42, 0x8a, 0x36, 0xde, 0x55, 0xba, 0x4a, 0xbc, 0x1b, 0x79, 0x37, 0xc1, 0xbc, 0x05, 0xcf, 0xc1,
+	0x6b, 0x70, 0x09, 0xb7, 0x72, 0xe3, 0x14, 0x20, 0x79, 0x0b, 0x4e, 0xc8, 0xbb, 0x9b, 0x3f, 0x48,
+	0x05, 0x21, 0x14, 0x4e, 0xc9, 0xcc, 0xec, 0x7c, 0xe6, 0xbb, 0xe3, 0x99, 0x05, 0xfb, 0x8c, 0xe4,
+	0x23, 0x11, 0x8e, 0x8f, 0xbb, 0x44, 0xa2, 0xe3, 0x50, 0xbe, 0x1d, 0x12, 0x11, 0x0c, 0x33, 0x2e,
+	0x39, 0x6c, 0xaa, 0x50, 0x60, 0x42, 0x07, 0x7b, 0x3d, 0xde, 0xe3, 0x2a, 0x12, 0x16, 0xff, 0xf4,
+	0xa1, 0x03, 0x37, 0xe1, 0x22, 0xe5, 0x22, 0xec, 0x22, 0x41, 0x96, 0x94, 0x84, 0x53, 0x66, 0xe2,
+	0x6d, 0xcd, 0x27, 0xf9, 0x90, 0x67, 0x92, 0xe0, 0x9b, 0x0a, 0xb5, 0x3f, 0xd9, 0x00, 0x3c, 0xbe,
+	0x42, 0x94, 0x5d, 0x4a, 0x24, 0x09, 0x7c, 0x04, 0xec, 0xa4, 0xb0, 0x1c, 0xcb, 0xb3, 0xfc, 0xad,
+	0x93, 0xc3, 0x40, 0xeb, 0x58, 0x20, 0x16, 0x82, 0x02, 0x95, 0x12, 0x55, 0x27, 0xd3, 0x56, 0x29,
+	0xd6, 0x19, 0xf0, 0x12, 0x6c, 0xa5, 0x88, 0x32, 0x89, 0x28, 0x23, 0x99, 0x70, 0xca, 0x5e, 0xc5,
+	0xdf, 0x8e, 0x8e, 0xbf, 0x4f, 0x5b, 0x47, 0x3d, 0x2a, 0xaf, 0x46, 0xdd, 0x20, 0xe1, 0x69, 0x68,
+	0x14, 0xeb, 0x9f, 0x23, 0x81, 0xfb, 0x46, 0xcc, 0x0b, 0x34, 0x38, 0xc5, 0x38, 0x23, 0x42, 0xc4,
+	0xeb, 0x14, 0x78, 0x07, 0x34, 0x50, 0x22, 0xe9, 0x18, 0x49, 0x82, 0x9d, 0x8a, 0x67, 0xf9, 0xf5,
+	0x78, 0xe5, 0x80, 0x08, 0xd8, 0x92, 0x4b, 0x34, 0x70, 0xaa, 0x5e, 0xc5, 0xdf, 0x3a, 0xd9, 0x0f,
+	0x34, 0x37, 0x28, 0x1a, 0xb2, 0x92, 0xca, 0x29, 0x8b, 0xee, 0x17, 0x4a, 0xdf, 0x7f, 0x69, 0xf9,
+	0x7f, 0xa0, 0xa5, 0x48, 0x10, 0xb1, 0x26, 0xc3, 0xdb, 0xa0, 0x86, 0x84, 0x20, 0x52, 0x38, 0xb6,
+	0x57, 0xf1, 0x1b, 0xb1, 0xb1, 0xe0, 0x5d, 0xd0, 0x64, 0x48, 0xd2, 0x31, 0xe9, 0x98, 0x70, 0x4d,
+	0x85, 0xb7, 0xb5, 0xf3, 0x54, 0x1f, 0x7a, 0x0a, 0x76, 0x54, 0xb4, 0x83, 0x49, 0x42, 0x53, 0x34,
+	0x10, 0xce, 0x7f, 0x5e, 0x65, 0xad, 0xad, 0x0b, 0x89, 0xea, 0xf8, 0x45, 0x46, 0x12, 0x2a, 0x28,
+	0x5f, 0xb4, 0xb5, 0xa9, 0x52, 0xcf, 0x4c, 0x26, 0xec, 0x80, 0x2a, 0x1e, 0x09, 0xe9, 0xd4, 0x37,
+	0x7f, 0x55, 0x05, 0x86, 0x09, 0xa8, 0xa5, 0x94, 0x15, 0x7d, 0x6e, 0x6c, 0xbe, 0x84, 0x41, 0x17,
+	0x45, 0xba, 0xa3, 0x8c, 0x11, 0xec, 0x80, 0x7f, 0x50, 0x44, 0xa3, 0xdb, 0x11, 0xd8, 0xf9, 0xb9,
+	0xa3, 0x70, 0x0f, 0xd8, 0xaa, 0x9b, 0x6a, 0xac, 0x1b, 0xb1, 0x36, 0xe0, 0x01, 0xa8, 0x2f, 0x3f,
+	0x4c, 0xd9, 0xb3, 0xfc, 0x66, 0xbc, 0xb4, 0xdb, 0x1f, 0x2d, 0xb0, 0xfb, 0x8c, 0xb2, 0x3e, 0xc1,
+	0x66, 0x2e, 0x89, 0x80, 0x2f, 0xc1, 0x2e, 0x26, 0x43, 0x2e, 0xa8, 0xec, 0x20, 0xed, 0x34, 0x6b,
+	0x72, 0xef, 0x97, 0x6b, 0x92, 0x71, 0x21, 0xd4, 0xae, 0x18, 0x8a, 0xf9, 0xb6, 0x3b, 0x86, 0x63,
+	0xbc, 0xf0, 0x35, 0xb8, 0x55, 0x68, 0x1d, 0x52, 0xc2, 0x56, 0xec, 0xf2, 0xdf, 0xb1, 0xff, 0x5f,
+	0x92, 0x8c, 0xbf, 0xfd, 0xc1, 0x02, 0xbb, 0x4f, 0x72, 0x49, 0x32, 0x86, 0x06, 0x67, 0xba, 0x30,
+	0x3c, 0x04, 0xb6, 0xcc, 0x3b, 0x14, 0xeb, 0x8e, 0x44, 0xf5, 0xd9, 0xb4, 0x55, 0x7d, 0x9e, 0x9f,
+	0x9f, 0xc5, 0x55, 0x99, 0x9f, 0xe3, 0x9b, 0xae, 0x5a, 0xde, 0xcc, 0x55, 0x1f, 0x82, 0x1a, 0x4a,
+	0xf9, 0x88, 0x49, 0xb5, 0xce, 0xbf, 0x9d, 0x00, 0x0d, 0x30, 0xc7, 0xa3, 0x8b, 0xc9, 0x37, 0xb7,
+	0x34, 0x99, 0xb9, 0xd6, 0xf5, 0xcc, 0xb5, 0xbe, 0xce, 0x5c, 0xeb, 0xdd, 0xdc, 0x2d, 0x5d, 0xcf,
+	0xdd, 0xd2, 0xe7, 0xb9, 0x5b, 0x7a, 0x75, 0xb2, 0x36, 0x25, 0x28, 0x27, 0x03, 0x94, 0x31, 0x22,
+	0xdf, 0xf0, 0xac, 0x6f, 0xac, 0xa3, 0x84, 0x67, 0x24, 0xcc, 0x43, 0xfd, 0x24, 0xaa, 0xa9, 0xe9,
+	0xd6, 0xd4, 0x13, 0xf8, 0xe0, 0xc7, 0x00, 0x63, 0xa2, 0x33, 0x00, 0x88, 0x05, 0x00, 0x00,
 }
 
 func (m *ChainState) Marshal() (dAtA []byte, err error) {
@@ -164,6 +268,71 @@ func (m *ChainState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Minted) > 0 {
+		for iNdEx := len(m.Minted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Dust) > 0 {
+		for iNdEx := len(m.Dust) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dust[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AssetDecimals) > 0 {
+		for iNdEx := len(m.AssetDecimals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetDecimals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.NativeAssets) > 0 {
+		for iNdEx := len(m.NativeAssets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NativeAssets[iNdEx])
+			copy(dAtA[i:], m.NativeAssets[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.NativeAssets[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Assets) > 0 {
 		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Assets[iNdEx])
@@ -219,6 +388,41 @@ func (m *ChainState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AssetPrecision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetPrecision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetPrecision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LinkedAddresses) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -262,6 +466,56 @@ func (m *LinkedAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExternalDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExternalDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExternalDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.DepositAddress.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -302,6 +556,52 @@ func (m *ChainState) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if len(m.NativeAssets) > 0 {
+		for _, s := range m.NativeAssets {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.AssetDecimals) > 0 {
+		for _, e := range m.AssetDecimals {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Dust) > 0 {
+		for _, e := range m.Dust {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Minted) > 0 {
+		for _, e := range m.Minted {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AssetPrecision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovTypes(uint64(m.Decimals))
+	}
 	return n
 }
 
@@ -318,6 +618,23 @@ func (m *LinkedAddresses) Size() (n int) {
 	return n
 }
 
+func (m *ExternalDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.DepositAddress.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -504,61 +821,11 @@ func (m *ChainState) Unmarshal(dAtA []byte) error {
 			}
 			m.Assets = append(m.Assets, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LinkedAddresses) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LinkedAddresses: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinkedAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssets", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -568,23 +835,342 @@ func (m *LinkedAddresses) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DepositAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NativeAssets = append(m.NativeAssets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDecimals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDecimals = append(m.AssetDecimals, AssetPrecision{})
+			if err := m.AssetDecimals[len(m.AssetDecimals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dust = append(m.Dust, types.Coin{})
+			if err := m.Dust[len(m.Dust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minted = append(m.Minted, types.Coin{})
+			if err := m.Minted[len(m.Minted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetPrecision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetPrecision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetPrecision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LinkedAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinkedAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinkedAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
@@ -641,6 +1227,154 @@ func (m *LinkedAddresses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExternalDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExternalDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExternalDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,9 +11,15 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/spf13/cobra"
 
+	"github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 	"github.com/axelarnetwork/axelar-core/x/snapshot/types"
 )
 
+const (
+	flagRole         = "role"
+	flagFeeAllowance = "fee-allowance"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	snapshotTxCmd := &cobra.Command{
@@ -27,6 +33,7 @@ func GetTxCmd() *cobra.Command {
 	snapshotTxCmd.AddCommand(
 		GetCmdRegisterProxy(),
 		GetCmdDeregisterProxy(),
+		GetCmdRotateProxy(),
 		GetCmdSendTokens(),
 	)
 
@@ -39,19 +46,34 @@ func GetCmdRegisterProxy() *cobra.Command {
 		Use:   "register-proxy [proxy address]",
 		Short: "Register a proxy account for a specific validator principal to broadcast transactions in its stead",
 		Args:  cobra.ExactArgs(1),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, err := client.GetClientTxContext(cmd)
+	}
+	roleStr := cmd.Flags().String(flagRole, exported.ProxyAll.SimpleString(), "role of the proxy, one of [all, tss, vote]")
+	feeAllowanceStr := cmd.Flags().String(flagFeeAllowance, "", "fee allowance the proxy can draw from the sender's account to pay for transactions (revoke with the feegrant module)")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+		addr, err := sdk.AccAddressFromBech32(args[0])
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrSnapshot, "proxy invalid")
+		}
+
+		role, err := exported.ProxyRoleFromSimpleStr(*roleStr)
+		if err != nil {
+			return err
+		}
+
+		var feeAllowance sdk.Coins
+		if *feeAllowanceStr != "" {
+			feeAllowance, err = sdk.ParseCoinsNormalized(*feeAllowanceStr)
 			if err != nil {
 				return err
 			}
-			addr, err := sdk.AccAddressFromBech32(args[0])
-			if err != nil {
-				return sdkerrors.Wrap(types.ErrSnapshot, "proxy invalid")
-			}
+		}
 
-			msg := types.NewRegisterProxyRequest(sdk.ValAddress(clientCtx.FromAddress), addr)
-			return legacyTx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
-		},
+		msg := types.NewRegisterProxyRequest(sdk.ValAddress(clientCtx.FromAddress), addr, role, feeAllowance)
+		return legacyTx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 	}
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
@@ -63,13 +85,47 @@ func GetCmdDeregisterProxy() *cobra.Command {
 		Use:   "deactivate-proxy",
 		Short: "Deactivate the proxy account of the sender",
 		Args:  cobra.ExactArgs(0),
+	}
+	roleStr := cmd.Flags().String(flagRole, exported.ProxyAll.SimpleString(), "role of the proxy to deactivate, one of [all, tss, vote]")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		role, err := exported.ProxyRoleFromSimpleStr(*roleStr)
+		if err != nil {
+			return err
+		}
+
+		msg := types.NewDeactivateProxyRequest(sdk.ValAddress(clientCtx.FromAddress), role)
+		return legacyTx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRotateProxy returns the command to rotate a proxy
+func GetCmdRotateProxy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-proxy [old proxy address] [new proxy address]",
+		Short: "Replace a proxy account of the sender, the old proxy remains valid until the rotation window has passed",
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
+			oldAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(types.ErrSnapshot, "old proxy invalid")
+			}
+			newAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(types.ErrSnapshot, "new proxy invalid")
+			}
 
-			msg := types.NewDeactivateProxyRequest(sdk.ValAddress(clientCtx.FromAddress))
+			msg := types.NewRotateProxyRequest(sdk.ValAddress(clientCtx.FromAddress), oldAddr, newAddr)
 			return legacyTx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
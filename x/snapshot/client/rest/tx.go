@@ -11,24 +11,35 @@ import (
 	"github.com/gorilla/mux"
 
 	clientUtils "github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 	"github.com/axelarnetwork/axelar-core/x/snapshot/keeper"
 	"github.com/axelarnetwork/axelar-core/x/snapshot/types"
 )
 
 // ReqRegisterProxy defines the properties of a tx request's body
 type ReqRegisterProxy struct {
-	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Role         string       `json:"role" yaml:"role"`
+	FeeAllowance sdk.Coins    `json:"fee_allowance" yaml:"fee_allowance"`
 }
 
 // ReqDeactivateProxy defines the properties of a tx request's body
 type ReqDeactivateProxy struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Role    string       `json:"role" yaml:"role"`
+}
+
+// ReqRotateProxy defines the properties of a tx request's body
+type ReqRotateProxy struct {
+	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
+	OldProxyAddr string       `json:"old_proxy_addr" yaml:"old_proxy_addr"`
 }
 
 // RegisterRoutes registers rest routes for this module
 func RegisterRoutes(cliCtx client.Context, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/tx/%s/registerProxy/{voter}", types.ModuleName), registerProxyHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/tx/%s/deactivateProxy", types.ModuleName), deactivateProxyHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/tx/%s/rotateProxy/{voter}", types.ModuleName), rotateProxyHandlerFn(cliCtx)).Methods("POST")
 
 	registerQuery := clientUtils.RegisterQueryHandlerFn(r, types.RestRoute)
 	registerQuery(GetHandlerQueryProxy(cliCtx), keeper.QProxy, clientUtils.PathVarCosmosAddress)
@@ -61,7 +72,13 @@ func registerProxyHandlerFn(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewRegisterProxyRequest(sdk.ValAddress(fromAddr), voter)
+		role, err := parseProxyRole(req.Role)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewRegisterProxyRequest(sdk.ValAddress(fromAddr), voter, role, req.FeeAllowance)
 		tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
 	}
 }
@@ -82,7 +99,57 @@ func deactivateProxyHandlerFn(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewDeactivateProxyRequest(sdk.ValAddress(fromAddr))
+		role, err := parseProxyRole(req.Role)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewDeactivateProxyRequest(sdk.ValAddress(fromAddr), role)
 		tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
 	}
 }
+
+func rotateProxyHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// extract voter address path variable
+		vars := mux.Vars(r)
+		newProxy, err := sdk.AccAddressFromBech32(vars["voter"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req ReqRotateProxy
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, ok := clientUtils.ExtractReqSender(w, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		oldProxy, err := sdk.AccAddressFromBech32(req.OldProxyAddr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewRotateProxyRequest(sdk.ValAddress(fromAddr), oldProxy, newProxy)
+		tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
+	}
+}
+
+// parseProxyRole defaults to a proxy without a dedicated role if none is given
+func parseProxyRole(role string) (exported.ProxyRole, error) {
+	if role == "" {
+		return exported.ProxyAll, nil
+	}
+
+	return exported.ProxyRoleFromSimpleStr(role)
+}
@@ -289,3 +289,46 @@ type validator struct {
 	Validator  string `json:"validator"`
 	ShareCount int64  `json:"share_count"`
 }
+
+// ProxyRoleFromSimpleStr creates a ProxyRole from string
+func ProxyRoleFromSimpleStr(str string) (ProxyRole, error) {
+	switch strings.ToLower(str) {
+	case ProxyAll.SimpleString():
+		return ProxyAll, nil
+	case ProxyTss.SimpleString():
+		return ProxyTss, nil
+	case ProxyVote.SimpleString():
+		return ProxyVote, nil
+	default:
+		return -1, fmt.Errorf("invalid proxy role %s", str)
+	}
+}
+
+// SimpleString returns a human-readable string
+func (x ProxyRole) SimpleString() string {
+	switch x {
+	case ProxyAll:
+		return "all"
+	case ProxyTss:
+		return "tss"
+	case ProxyVote:
+		return "vote"
+	default:
+		return "unknown"
+	}
+}
+
+// Validate validates the ProxyRole
+func (x ProxyRole) Validate() error {
+	switch x {
+	case ProxyAll, ProxyTss, ProxyVote:
+		return nil
+	default:
+		return fmt.Errorf("invalid proxy role %d", x)
+	}
+}
+
+// CanBroadcast returns true if a proxy of this role can broadcast messages that require the given role
+func (x ProxyRole) CanBroadcast(required ProxyRole) bool {
+	return x == ProxyAll || x == required
+}
@@ -71,6 +71,36 @@ func (ValidatorIllegibility) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_d7425dedf1b9aad3, []int{0}
 }
 
+type ProxyRole int32
+
+const (
+	// a proxy without a dedicated role can broadcast all messages of its
+	// validator
+	ProxyAll  ProxyRole = 0
+	ProxyTss  ProxyRole = 1
+	ProxyVote ProxyRole = 2
+)
+
+var ProxyRole_name = map[int32]string{
+	0: "PROXY_ROLE_UNSPECIFIED",
+	1: "PROXY_ROLE_TSS",
+	2: "PROXY_ROLE_VOTE",
+}
+
+var ProxyRole_value = map[string]int32{
+	"PROXY_ROLE_UNSPECIFIED": 0,
+	"PROXY_ROLE_TSS":         1,
+	"PROXY_ROLE_VOTE":        2,
+}
+
+func (x ProxyRole) String() string {
+	return proto.EnumName(ProxyRole_name, int32(x))
+}
+
+func (ProxyRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d7425dedf1b9aad3, []int{1}
+}
+
 type Validator struct {
 	SDKValidator *types.Any `protobuf:"bytes,1,opt,name=sdk_validator,json=sdkValidator,proto3" json:"sdk_validator,omitempty"`
 	ShareCount   int64      `protobuf:"varint,2,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`
@@ -154,6 +184,7 @@ var xxx_messageInfo_Snapshot proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterEnum("snapshot.exported.v1beta1.ValidatorIllegibility", ValidatorIllegibility_name, ValidatorIllegibility_value)
+	proto.RegisterEnum("snapshot.exported.v1beta1.ProxyRole", ProxyRole_name, ProxyRole_value)
 	proto.RegisterType((*Validator)(nil), "snapshot.exported.v1beta1.Validator")
 	proto.RegisterType((*Snapshot)(nil), "snapshot.exported.v1beta1.Snapshot")
 }
@@ -163,60 +194,64 @@ func init() {
 }
 
 var fileDescriptor_d7425dedf1b9aad3 = []byte{
-	// 844 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcf, 0x6e, 0xa3, 0x46,
-	0x18, 0x37, 0xeb, 0x34, 0xeb, 0x9d, 0xa4, 0x5b, 0x96, 0x4d, 0x5a, 0x07, 0x69, 0x31, 0x8d, 0xd2,
-	0xca, 0x5d, 0xc9, 0xb0, 0x49, 0x55, 0xa9, 0xea, 0xcd, 0x04, 0xb2, 0x22, 0xb1, 0xb1, 0x05, 0x24,
-	0x6a, 0xf6, 0x82, 0x30, 0xcc, 0xe2, 0x91, 0xf1, 0x8c, 0xc5, 0x8c, 0xb7, 0xe1, 0x0d, 0x2a, 0x9f,
-	0x56, 0xbd, 0xfb, 0xd4, 0x1e, 0xfa, 0x00, 0x7d, 0x88, 0xa8, 0xa7, 0xdc, 0x5a, 0xf5, 0x90, 0xb6,
-	0xc9, 0x8b, 0x54, 0xc1, 0x98, 0x58, 0x8d, 0xd8, 0x93, 0xfd, 0xcd, 0xf7, 0xfb, 0xf7, 0xcd, 0x07,
-	0x80, 0x2f, 0x28, 0xf6, 0x27, 0x74, 0x48, 0x98, 0x0a, 0x2f, 0x26, 0x24, 0x61, 0x30, 0x54, 0xdf,
-	0xed, 0x0f, 0x20, 0xf3, 0xf7, 0x55, 0x96, 0x4e, 0x20, 0x55, 0x26, 0x09, 0x61, 0x44, 0xd8, 0x59,
-	0xc2, 0x94, 0x25, 0x4c, 0xc9, 0x61, 0xe2, 0x56, 0x44, 0x22, 0x92, 0xa1, 0xd4, 0xbb, 0x7f, 0x0b,
-	0x82, 0xd8, 0x88, 0x08, 0x89, 0x62, 0xa8, 0x66, 0xd5, 0x60, 0xfa, 0x56, 0x65, 0x68, 0x0c, 0x29,
-	0xf3, 0xc7, 0x93, 0x1c, 0xb0, 0xf3, 0x7f, 0x80, 0x8f, 0xd3, 0xbc, 0x25, 0x05, 0x84, 0x8e, 0x09,
-	0x55, 0x07, 0x3e, 0x85, 0x45, 0x9a, 0x80, 0x20, 0x9c, 0xf7, 0x65, 0x46, 0xe9, 0x07, 0xe3, 0x8a,
-	0x3b, 0x0b, 0x05, 0x6f, 0x11, 0x6b, 0x51, 0xe4, 0xad, 0xbd, 0x5c, 0x9c, 0x32, 0x7f, 0x84, 0x70,
-	0x54, 0xd0, 0xf3, 0x7a, 0x81, 0xda, 0xfd, 0x89, 0x03, 0x4f, 0xce, 0xfc, 0x18, 0x85, 0x3e, 0x23,
-	0x89, 0x10, 0x82, 0x8f, 0x69, 0x38, 0xf2, 0xde, 0x2d, 0x0f, 0xea, 0x9c, 0xcc, 0x35, 0x37, 0x0e,
-	0xb6, 0x94, 0xc5, 0x0c, 0xca, 0x72, 0x06, 0xa5, 0x8d, 0x53, 0xed, 0xab, 0x9b, 0xeb, 0xc6, 0xa6,
-	0xa3, 0x9f, 0x14, 0xf4, 0xdf, 0x7f, 0x6b, 0x6d, 0x17, 0xb7, 0xb6, 0xda, 0xb0, 0x37, 0x69, 0x38,
-	0xba, 0x77, 0x69, 0x80, 0x0d, 0x3a, 0xf4, 0x13, 0xe8, 0x05, 0x64, 0x8a, 0x59, 0xfd, 0x91, 0xcc,
-	0x35, 0xab, 0x36, 0xc8, 0x8e, 0x0e, 0xef, 0x4e, 0x76, 0xaf, 0xaa, 0xa0, 0xe6, 0xe4, 0x7b, 0x10,
-	0x8e, 0x01, 0x28, 0xf2, 0xd0, 0x3a, 0x27, 0x57, 0x9b, 0x1b, 0x07, 0x7b, 0x4a, 0xe9, 0x9a, 0x94,
-	0xc2, 0x47, 0x5b, 0xbb, 0xbc, 0x6e, 0x54, 0xec, 0x15, 0xb6, 0xa0, 0x81, 0x27, 0xc5, 0x7a, 0x32,
-	0xdf, 0x8d, 0x03, 0xf1, 0xc1, 0x6c, 0xee, 0x12, 0xa1, 0xd5, 0xee, 0x04, 0xde, 0xff, 0xdd, 0xe0,
-	0xec, 0x7b, 0x9a, 0xf0, 0x29, 0x58, 0x1f, 0x42, 0x14, 0x0d, 0x59, 0xbd, 0x9a, 0x05, 0xcf, 0x2b,
-	0xe1, 0x0d, 0x78, 0xc6, 0x08, 0xf3, 0x63, 0x6f, 0x75, 0xb6, 0x35, 0x99, 0x6b, 0x6e, 0x6a, 0xca,
-	0x9d, 0xce, 0x5f, 0xd7, 0x8d, 0x2f, 0x23, 0xc4, 0x86, 0xd3, 0x81, 0x12, 0x90, 0x71, 0xbe, 0xab,
-	0xfc, 0xa7, 0x45, 0xc3, 0x51, 0xbe, 0x57, 0x13, 0x33, 0xfb, 0x93, 0x4c, 0xc8, 0x29, 0x2e, 0x44,
-	0xa8, 0x83, 0xc7, 0x99, 0x1e, 0x4c, 0xea, 0x1f, 0x65, 0xa6, 0xcb, 0x52, 0xa0, 0xe0, 0xc5, 0x08,
-	0xa6, 0xb9, 0x67, 0x88, 0x28, 0x4b, 0xd0, 0x60, 0xca, 0x10, 0xc1, 0xde, 0x84, 0xc4, 0x28, 0x48,
-	0xeb, 0xeb, 0x32, 0xd7, 0x7c, 0x7a, 0xf0, 0x4a, 0x61, 0x94, 0x3e, 0xbc, 0xab, 0x13, 0x98, 0x66,
-	0x2e, 0xfa, 0x0a, 0xb1, 0x9f, 0xf1, 0x6c, 0x71, 0x54, 0xda, 0x13, 0xf6, 0xc1, 0x56, 0x40, 0x92,
-	0x64, 0x3a, 0xc9, 0x8c, 0xd8, 0x30, 0x81, 0x74, 0x48, 0xe2, 0xb0, 0xfe, 0x38, 0xcb, 0xf6, 0xfc,
-	0xbe, 0xe7, 0x2e, 0x5b, 0x2f, 0xff, 0xa8, 0x82, 0xed, 0x62, 0x33, 0x66, 0x1c, 0xc3, 0x08, 0x0d,
-	0x50, 0x8c, 0x58, 0x2a, 0xbc, 0x02, 0xbb, 0x67, 0xed, 0x8e, 0xa9, 0xb7, 0xdd, 0x9e, 0xed, 0x99,
-	0x9d, 0x8e, 0xf1, 0xda, 0xd4, 0xcc, 0x8e, 0xe9, 0x9e, 0x7b, 0xa7, 0x96, 0xd3, 0x37, 0x0e, 0xcd,
-	0x23, 0xd3, 0xd0, 0xf9, 0x8a, 0x58, 0x9b, 0xcd, 0xe5, 0x35, 0x8b, 0x60, 0x28, 0x7c, 0x03, 0x3e,
-	0x2f, 0x61, 0xb8, 0xbd, 0xae, 0xe6, 0xb8, 0x3d, 0xcb, 0xd0, 0x79, 0x4e, 0x7c, 0x3a, 0x9b, 0xcb,
-	0xc0, 0x25, 0xe3, 0x01, 0x65, 0x04, 0xc3, 0x50, 0x68, 0x81, 0x17, 0x25, 0xb4, 0xe3, 0xb6, 0xd9,
-	0x31, 0x74, 0xfe, 0x91, 0x08, 0x66, 0x73, 0x79, 0xfd, 0xd8, 0x47, 0x31, 0x0c, 0x85, 0x63, 0xd0,
-	0x2a, 0x81, 0x77, 0x4d, 0xc7, 0x31, 0x74, 0xcf, 0xed, 0xf5, 0xbc, 0x6e, 0xdb, 0x3a, 0xf7, 0xb4,
-	0x4e, 0xef, 0xf0, 0xc4, 0xe1, 0xd7, 0xc4, 0xcf, 0x66, 0x73, 0xf9, 0x79, 0x17, 0x51, 0x0a, 0x43,
-	0x97, 0x90, 0xae, 0x8f, 0x53, 0x2d, 0x26, 0xc1, 0x88, 0x0a, 0x06, 0x78, 0x59, 0xa2, 0x65, 0xf5,
-	0xbc, 0xbe, 0xdd, 0xfb, 0xfe, 0xdc, 0xb3, 0x8d, 0xd7, 0xa6, 0xe3, 0x1a, 0xb6, 0xa1, 0xf3, 0x35,
-	0x71, 0x7b, 0x36, 0x97, 0x9f, 0x59, 0xa4, 0x9f, 0x90, 0x8b, 0xd4, 0x86, 0x11, 0xa2, 0x0c, 0x26,
-	0x30, 0x14, 0xbe, 0x03, 0x7b, 0x65, 0x83, 0x3b, 0x8e, 0xe7, 0x9c, 0x3a, 0x7d, 0xc3, 0xd2, 0x0d,
-	0x9d, 0xe7, 0x45, 0x7e, 0x36, 0x97, 0x37, 0x5d, 0x4a, 0x9d, 0x29, 0x9d, 0x40, 0x1c, 0xc2, 0x50,
-	0xe8, 0x02, 0xa5, 0x84, 0xbb, 0xf0, 0x37, 0x2d, 0xe7, 0xf4, 0xc8, 0x3c, 0x34, 0x0d, 0xcb, 0xf5,
-	0x8e, 0x4e, 0x2d, 0xdd, 0xe1, 0x65, 0x71, 0x67, 0x36, 0x97, 0xb7, 0xb3, 0x10, 0x26, 0xa6, 0xd3,
-	0xb7, 0x28, 0x40, 0x10, 0xb3, 0xa3, 0x29, 0x0e, 0xa9, 0x58, 0xfb, 0xf1, 0x67, 0xa9, 0xf2, 0xeb,
-	0x2f, 0x52, 0x45, 0x3b, 0xbb, 0xfc, 0x57, 0xaa, 0x5c, 0xde, 0x48, 0xdc, 0xd5, 0x8d, 0xc4, 0xfd,
-	0x73, 0x23, 0x71, 0xef, 0x6f, 0xa5, 0xca, 0xd5, 0xad, 0x54, 0xf9, 0xf3, 0x56, 0xaa, 0xbc, 0xf9,
-	0x76, 0xe5, 0x91, 0xf7, 0x2f, 0x60, 0xec, 0x27, 0x18, 0xb2, 0x1f, 0x48, 0x32, 0xca, 0xab, 0x56,
-	0x40, 0x12, 0xa8, 0x5e, 0xa8, 0x0f, 0xbe, 0xce, 0x83, 0xf5, 0xec, 0x85, 0xfc, 0xfa, 0xbf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x10, 0x11, 0x8a, 0xc4, 0xb9, 0x05, 0x00, 0x00,
+	// 906 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0x41, 0x4f, 0xe3, 0x46,
+	0x18, 0x8d, 0x81, 0xb2, 0x61, 0xc8, 0xb2, 0xde, 0x59, 0xd8, 0x06, 0x4b, 0xeb, 0xb8, 0x88, 0x56,
+	0x29, 0x52, 0x9c, 0x85, 0xaa, 0x52, 0xd5, 0x5b, 0x8c, 0xcd, 0xca, 0x90, 0xd8, 0x91, 0xc7, 0x44,
+	0x65, 0x2f, 0x96, 0x13, 0xcf, 0x26, 0xa3, 0x38, 0x9e, 0xc8, 0x33, 0xd9, 0x92, 0x1f, 0x50, 0xa9,
+	0xca, 0x69, 0xd5, 0x7b, 0x4e, 0xed, 0xa1, 0x3f, 0xa0, 0x3f, 0x02, 0xf5, 0xc4, 0xad, 0x55, 0x0f,
+	0xb4, 0x85, 0x3f, 0x52, 0xe1, 0x38, 0x26, 0x5a, 0x94, 0x9e, 0x92, 0x6f, 0xbe, 0xf7, 0xbe, 0xf7,
+	0xbe, 0x79, 0xb2, 0x0d, 0x3e, 0x67, 0x91, 0x3f, 0x64, 0x3d, 0xca, 0xab, 0xf8, 0x72, 0x48, 0x63,
+	0x8e, 0x83, 0xea, 0xfb, 0xc3, 0x36, 0xe6, 0xfe, 0x61, 0x95, 0x8f, 0x87, 0x98, 0xa9, 0xc3, 0x98,
+	0x72, 0x0a, 0x77, 0xe7, 0x30, 0x75, 0x0e, 0x53, 0x53, 0x98, 0xb4, 0xdd, 0xa5, 0x5d, 0x9a, 0xa0,
+	0xaa, 0xf7, 0xff, 0x66, 0x04, 0xa9, 0xd4, 0xa5, 0xb4, 0x1b, 0xe2, 0x6a, 0x52, 0xb5, 0x47, 0xef,
+	0xaa, 0x9c, 0x0c, 0x30, 0xe3, 0xfe, 0x60, 0x98, 0x02, 0x76, 0x3f, 0x06, 0xf8, 0xd1, 0x38, 0x6d,
+	0xc9, 0x1d, 0xca, 0x06, 0x94, 0x55, 0xdb, 0x3e, 0xc3, 0x99, 0x9b, 0x0e, 0x25, 0x51, 0xda, 0x57,
+	0x38, 0x63, 0xff, 0x6b, 0x57, 0xda, 0x9d, 0x4d, 0xf0, 0x66, 0xb6, 0x66, 0x45, 0xda, 0xda, 0x4f,
+	0x87, 0x33, 0xee, 0xf7, 0x49, 0xd4, 0xcd, 0xe8, 0x69, 0x3d, 0x43, 0xed, 0xfd, 0x24, 0x80, 0x8d,
+	0x96, 0x1f, 0x92, 0xc0, 0xe7, 0x34, 0x86, 0x01, 0x78, 0xca, 0x82, 0xbe, 0xf7, 0x7e, 0x7e, 0x50,
+	0x14, 0x14, 0xa1, 0xbc, 0x79, 0xb4, 0xad, 0xce, 0x76, 0x50, 0xe7, 0x3b, 0xa8, 0xb5, 0x68, 0xac,
+	0x7d, 0x79, 0x7b, 0x53, 0x2a, 0x20, 0xfd, 0x2c, 0xa3, 0xff, 0xfe, 0x5b, 0x65, 0x27, 0xbb, 0xb5,
+	0xc5, 0x86, 0x53, 0x60, 0x41, 0xff, 0x41, 0xa5, 0x04, 0x36, 0x59, 0xcf, 0x8f, 0xb1, 0xd7, 0xa1,
+	0xa3, 0x88, 0x17, 0x57, 0x14, 0xa1, 0xbc, 0xea, 0x80, 0xe4, 0xe8, 0xf8, 0xfe, 0x64, 0xef, 0x7a,
+	0x15, 0xe4, 0x51, 0x9a, 0x03, 0x3c, 0x05, 0x20, 0xf3, 0xc3, 0x8a, 0x82, 0xb2, 0x5a, 0xde, 0x3c,
+	0xda, 0x57, 0x97, 0xc6, 0xa4, 0x66, 0x3a, 0xda, 0xda, 0xd5, 0x4d, 0x29, 0xe7, 0x2c, 0xb0, 0xa1,
+	0x06, 0x36, 0xb2, 0x78, 0x12, 0xdd, 0xcd, 0x23, 0xe9, 0xd1, 0x6e, 0xee, 0x1c, 0xa1, 0xe5, 0xef,
+	0x07, 0x7c, 0xf8, 0xbb, 0x24, 0x38, 0x0f, 0x34, 0xf8, 0x12, 0xac, 0xf7, 0x30, 0xe9, 0xf6, 0x78,
+	0x71, 0x35, 0x31, 0x9e, 0x56, 0xf0, 0x2d, 0x78, 0xce, 0x29, 0xf7, 0x43, 0x6f, 0x71, 0xb7, 0x35,
+	0x45, 0x28, 0x17, 0x34, 0xf5, 0x7e, 0xce, 0x5f, 0x37, 0xa5, 0x2f, 0xba, 0x84, 0xf7, 0x46, 0x6d,
+	0xb5, 0x43, 0x07, 0x69, 0x56, 0xe9, 0x4f, 0x85, 0x05, 0xfd, 0x34, 0x57, 0x33, 0xe2, 0xce, 0xb3,
+	0x64, 0x10, 0xca, 0x2e, 0x04, 0x16, 0xc1, 0x93, 0x64, 0x1e, 0x8e, 0x8b, 0x9f, 0x24, 0xa2, 0xf3,
+	0x12, 0x32, 0xf0, 0xaa, 0x8f, 0xc7, 0xa9, 0x66, 0x40, 0x18, 0x8f, 0x49, 0x7b, 0xc4, 0x09, 0x8d,
+	0xbc, 0x21, 0x0d, 0x49, 0x67, 0x5c, 0x5c, 0x57, 0x84, 0xf2, 0xd6, 0xd1, 0x6b, 0x95, 0x33, 0xf6,
+	0xf8, 0xae, 0xce, 0xf0, 0x38, 0x51, 0xd1, 0x17, 0x88, 0xcd, 0x84, 0xe7, 0x48, 0xfd, 0xa5, 0x3d,
+	0x78, 0x08, 0xb6, 0x3b, 0x34, 0x8e, 0x47, 0xc3, 0x44, 0x88, 0xf7, 0x62, 0xcc, 0x7a, 0x34, 0x0c,
+	0x8a, 0x4f, 0x12, 0x6f, 0x2f, 0x1e, 0x7a, 0xee, 0xbc, 0x75, 0xf0, 0xc7, 0x2a, 0xd8, 0xc9, 0x92,
+	0x31, 0xc3, 0x10, 0x77, 0x49, 0x9b, 0x84, 0x84, 0x8f, 0xe1, 0x6b, 0xb0, 0xd7, 0xaa, 0xd5, 0x4d,
+	0xbd, 0xe6, 0xda, 0x8e, 0x67, 0xd6, 0xeb, 0xc6, 0x1b, 0x53, 0x33, 0xeb, 0xa6, 0x7b, 0xe1, 0x9d,
+	0x5b, 0xa8, 0x69, 0x1c, 0x9b, 0x27, 0xa6, 0xa1, 0x8b, 0x39, 0x29, 0x3f, 0x99, 0x2a, 0x6b, 0x16,
+	0x8d, 0x30, 0xfc, 0x1a, 0x7c, 0xb6, 0x84, 0xe1, 0xda, 0x0d, 0x0d, 0xb9, 0xb6, 0x65, 0xe8, 0xa2,
+	0x20, 0x6d, 0x4d, 0xa6, 0x0a, 0x70, 0xe9, 0xa0, 0xcd, 0x38, 0x8d, 0x70, 0x00, 0x2b, 0xe0, 0xd5,
+	0x12, 0xda, 0x69, 0xcd, 0xac, 0x1b, 0xba, 0xb8, 0x22, 0x81, 0xc9, 0x54, 0x59, 0x3f, 0xf5, 0x49,
+	0x88, 0x03, 0x78, 0x0a, 0x2a, 0x4b, 0xe0, 0x0d, 0x13, 0x21, 0x43, 0xf7, 0x5c, 0xdb, 0xf6, 0x1a,
+	0x35, 0xeb, 0xc2, 0xd3, 0xea, 0xf6, 0xf1, 0x19, 0x12, 0xd7, 0xa4, 0x4f, 0x27, 0x53, 0xe5, 0x45,
+	0x83, 0x30, 0x86, 0x03, 0x97, 0xd2, 0x86, 0x1f, 0x8d, 0xb5, 0x90, 0x76, 0xfa, 0x0c, 0x1a, 0xe0,
+	0x60, 0xc9, 0x2c, 0xcb, 0xf6, 0x9a, 0x8e, 0xfd, 0xdd, 0x85, 0xe7, 0x18, 0x6f, 0x4c, 0xe4, 0x1a,
+	0x8e, 0xa1, 0x8b, 0x79, 0x69, 0x67, 0x32, 0x55, 0x9e, 0x5b, 0xb4, 0x19, 0xd3, 0xcb, 0xb1, 0x83,
+	0xbb, 0x84, 0x71, 0x1c, 0xe3, 0x00, 0x7e, 0x0b, 0xf6, 0x97, 0x2d, 0x8e, 0x90, 0x87, 0xce, 0x51,
+	0xd3, 0xb0, 0x74, 0x43, 0x17, 0x45, 0x49, 0x9c, 0x4c, 0x95, 0x82, 0xcb, 0x18, 0x1a, 0xb1, 0x21,
+	0x8e, 0x02, 0x1c, 0xc0, 0x06, 0x50, 0x97, 0x70, 0x67, 0xfa, 0xa6, 0x85, 0xce, 0x4f, 0xcc, 0x63,
+	0xd3, 0xb0, 0x5c, 0xef, 0xe4, 0xdc, 0xd2, 0x91, 0xa8, 0x48, 0xbb, 0x93, 0xa9, 0xb2, 0x93, 0x98,
+	0x30, 0x23, 0x36, 0x7a, 0x47, 0x3a, 0x04, 0x47, 0xfc, 0x64, 0x14, 0x05, 0x4c, 0xca, 0xff, 0xf8,
+	0xb3, 0x9c, 0xfb, 0xf5, 0x17, 0x39, 0x77, 0xf0, 0x83, 0x00, 0x36, 0x66, 0x46, 0x69, 0x88, 0x61,
+	0x19, 0xbc, 0x4c, 0xf7, 0xb1, 0xeb, 0xc6, 0x47, 0x09, 0x16, 0x26, 0x53, 0x25, 0x9f, 0x40, 0x6b,
+	0x61, 0x08, 0x15, 0xb0, 0xb5, 0x80, 0x74, 0x11, 0x12, 0x85, 0x05, 0x84, 0xcb, 0x18, 0xdc, 0x03,
+	0xcf, 0x16, 0x10, 0x2d, 0xdb, 0x35, 0xc4, 0x15, 0xe9, 0xe9, 0x64, 0xaa, 0xcc, 0xf4, 0x5a, 0x94,
+	0xe3, 0xcc, 0x87, 0xa0, 0xb5, 0xae, 0xfe, 0x95, 0x73, 0x57, 0xb7, 0xb2, 0x70, 0x7d, 0x2b, 0x0b,
+	0xff, 0xdc, 0xca, 0xc2, 0x87, 0x3b, 0x39, 0x77, 0x7d, 0x27, 0xe7, 0xfe, 0xbc, 0x93, 0x73, 0x6f,
+	0xbf, 0x59, 0x78, 0xf4, 0xfc, 0x4b, 0x1c, 0xfa, 0x71, 0x84, 0xf9, 0xf7, 0x34, 0xee, 0xa7, 0x55,
+	0xa5, 0x43, 0x63, 0x5c, 0xbd, 0xac, 0x3e, 0xfa, 0x4a, 0xb4, 0xd7, 0x93, 0x17, 0xc3, 0x57, 0xff,
+	0x0d, 0x00, 0xfa, 0xfe, 0xdc, 0x0e, 0x41, 0x06, 0x00, 0x00,
 }
 
 func (m *Validator) Marshal() (dAtA []byte, err error) {
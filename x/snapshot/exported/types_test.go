@@ -35,3 +35,15 @@ func TestFilterIllegibilityForSigning(t *testing.T) {
 		}
 	}
 }
+
+func TestProxyRole_CanBroadcast(t *testing.T) {
+	roles := []exported.ProxyRole{exported.ProxyTss, exported.ProxyVote}
+
+	for _, required := range roles {
+		assert.True(t, exported.ProxyAll.CanBroadcast(required))
+
+		for _, role := range roles {
+			assert.Equal(t, role == required, role.CanBroadcast(required))
+		}
+	}
+}
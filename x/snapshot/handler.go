@@ -22,6 +22,9 @@ func NewHandler(k keeper.Keeper, n types.Nexus) sdk.Handler {
 		case *types.DeactivateProxyRequest:
 			res, err := server.DeactivateProxy(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.RotateProxyRequest:
+			res, err := server.RotateProxy(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
 				fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg))
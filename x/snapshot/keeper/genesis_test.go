@@ -1,10 +1,12 @@
 package keeper
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/assert"
@@ -27,6 +29,11 @@ const bondDenom = "test"
 func setup() (sdk.Context, Keeper, *mock.StakingKeeperMock, *mock.BankKeeperMock, *exportedmock.SlasherMock, *exportedmock.TssMock) {
 	staking := mock.StakingKeeperMock{}
 	bank := mock.BankKeeperMock{}
+	feegrant := mock.FeeGrantKeeperMock{
+		GetAllowanceFunc: func(sdk.Context, sdk.AccAddress, sdk.AccAddress) (feegranttypes.FeeAllowanceI, error) {
+			return nil, fmt.Errorf("fee-grant not found")
+		},
+	}
 	slasher := exportedmock.SlasherMock{}
 	tss := exportedmock.TssMock{}
 
@@ -41,6 +48,7 @@ func setup() (sdk.Context, Keeper, *mock.StakingKeeperMock, *mock.BankKeeperMock
 		subspace,
 		&staking,
 		&bank,
+		&feegrant,
 		&slasher,
 		&tss,
 	)
@@ -95,13 +103,13 @@ func TestExportGenesis(t *testing.T) {
 		proxies[i] = rand.AccAddr()
 
 		active := rand.Bools(0.5).Next()
-		expectedProxiedValidators[i] = types.NewProxiedValidator(validators[i], proxies[i], active)
+		expectedProxiedValidators[i] = types.NewProxiedValidator(validators[i], proxies[i], active, exported.ProxyAll)
 
-		err := keeper.ActivateProxy(ctx, validators[i], proxies[i])
+		err := keeper.ActivateProxy(ctx, validators[i], proxies[i], exported.ProxyAll)
 		assert.NoError(t, err)
 
 		if !active {
-			err := keeper.DeactivateProxy(ctx, validators[i])
+			err := keeper.DeactivateProxy(ctx, validators[i], exported.ProxyAll)
 			assert.NoError(t, err)
 		}
 	}
@@ -137,7 +145,7 @@ func TestInitGenesis(t *testing.T) {
 	expectedProxiedValidators := make([]types.ProxiedValidator, proxiedValidatorCount)
 	for i := 0; i < int(proxiedValidatorCount); i++ {
 		active := rand.Bools(0.5).Next()
-		expectedProxiedValidators[i] = types.NewProxiedValidator(rand.ValAddr(), rand.AccAddr(), active)
+		expectedProxiedValidators[i] = types.NewProxiedValidator(rand.ValAddr(), rand.AccAddr(), active, exported.ProxyAll)
 	}
 
 	expected := types.NewGenesisState(types.DefaultParams(), expectedSnapshots, expectedProxiedValidators)
@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	params "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -33,6 +35,7 @@ type Keeper struct {
 	storeKey sdk.StoreKey
 	staking  types.StakingKeeper
 	bank     types.BankKeeper
+	feegrant types.FeeGrantKeeper
 	slasher  exported.Slasher
 	tss      exported.Tss
 	cdc      codec.BinaryCodec
@@ -40,12 +43,13 @@ type Keeper struct {
 }
 
 // NewKeeper creates a new keeper for the staking module
-func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace params.Subspace, staking types.StakingKeeper, bank types.BankKeeper, slasher exported.Slasher, tss exported.Tss) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace params.Subspace, staking types.StakingKeeper, bank types.BankKeeper, feegrant types.FeeGrantKeeper, slasher exported.Slasher, tss exported.Tss) Keeper {
 	return Keeper{
 		storeKey: key,
 		cdc:      cdc,
 		staking:  staking,
 		bank:     bank,
+		feegrant: feegrant,
 		params:   paramSpace.WithKeyTable(types.KeyTable()),
 		slasher:  slasher,
 		tss:      tss,
@@ -307,14 +311,15 @@ func (k Keeper) executeSnapshot(ctx sdk.Context, counter int64, keyRequirement t
 	return snapshot, nil
 }
 
-// ActivateProxy registers a proxy address for a given operator, which can broadcast messages in the principal's name
-// The proxy will be marked as active and to be included in the next snapshot by default
-func (k Keeper) ActivateProxy(ctx sdk.Context, operator sdk.ValAddress, proxy sdk.AccAddress) error {
+// ActivateProxy registers a proxy address for a given operator, which can broadcast messages in the principal's name.
+// Each validator can have one current proxy per role. The proxy will be marked as active and, if it has no dedicated role,
+// its validator is to be included in the next snapshot by default
+func (k Keeper) ActivateProxy(ctx sdk.Context, operator sdk.ValAddress, proxy sdk.AccAddress, role exported.ProxyRole) error {
 	if bytes.Equal(operator, proxy) {
 		return fmt.Errorf("proxy address cannot be the same as the operator address")
 	}
 
-	if existing, ok := k.getProxiedValidator(ctx, operator); ok && !existing.Proxy.Equals(proxy) {
+	if existing, ok := k.getCurrentProxy(ctx, operator, role); ok && !existing.Proxy.Equals(proxy) {
 		return fmt.Errorf(
 			"proxy mismatch, expected %s, got %s",
 			existing.Proxy.String(),
@@ -322,7 +327,8 @@ func (k Keeper) ActivateProxy(ctx sdk.Context, operator sdk.ValAddress, proxy sd
 		)
 	}
 
-	if existing, ok := k.getProxiedValidator(ctx, proxy); ok && !existing.Validator.Equals(operator) {
+	existing, ok := k.getProxiedValidator(ctx, proxy)
+	if ok && !existing.Validator.Equals(operator) {
 		return fmt.Errorf(
 			"validator mismatch, expected %s, got %s",
 			existing.Validator.String(),
@@ -330,28 +336,66 @@ func (k Keeper) ActivateProxy(ctx sdk.Context, operator sdk.ValAddress, proxy sd
 		)
 	}
 
-	minBalance := k.GetMinProxyBalance(ctx)
-	denom := k.staking.BondDenom(ctx)
-	if balance := k.bank.GetBalance(ctx, proxy, denom); balance.Amount.LT(minBalance) {
-		return fmt.Errorf("account %s does not have sufficient funds to become a proxy (minimum %s%s, actual %s)",
-			proxy.String(), minBalance.String(), denom, balance.String())
+	if ok && (existing.Role != role || existing.ExpiresAt != 0) {
+		return fmt.Errorf("proxy %s cannot be registered again as %s proxy", proxy.String(), role.SimpleString())
+	}
+
+	if err := k.validateProxyBalance(ctx, operator, proxy); err != nil {
+		return err
 	}
 
-	k.setProxiedValidator(ctx, types.NewProxiedValidator(operator, proxy, true))
+	k.setProxiedValidator(ctx, types.NewProxiedValidator(operator, proxy, true, role))
 
 	return nil
 }
 
-// DeactivateProxy deactivates the proxy address for a given operator
-func (k Keeper) DeactivateProxy(ctx sdk.Context, operator sdk.ValAddress) error {
+// RotateProxy replaces a current proxy of the given operator with a new proxy of the same role.
+// The old proxy can still act for the operator until the proxy rotation window has passed, so the validator stays eligible during the rotation
+func (k Keeper) RotateProxy(ctx sdk.Context, operator sdk.ValAddress, oldProxy sdk.AccAddress, newProxy sdk.AccAddress) (types.ProxiedValidator, error) {
+	if bytes.Equal(operator, newProxy) {
+		return types.ProxiedValidator{}, fmt.Errorf("proxy address cannot be the same as the operator address")
+	}
+
+	old, ok := k.getProxiedValidator(ctx, oldProxy)
+	if !ok || !old.Validator.Equals(operator) {
+		return types.ProxiedValidator{}, fmt.Errorf("%s is not a proxy of validator %s", oldProxy.String(), operator.String())
+	}
+
+	if old.ExpiresAt != 0 {
+		return types.ProxiedValidator{}, fmt.Errorf("proxy %s has already been rotated out", oldProxy.String())
+	}
+
+	if _, ok := k.getProxiedValidator(ctx, newProxy); ok {
+		return types.ProxiedValidator{}, fmt.Errorf("account %s is already registered as a proxy", newProxy.String())
+	}
+
+	// the fee allowance goes with the role, so a rotated out proxy cannot keep drawing fees from the operator's account
+	if err := k.moveProxyFeeAllowance(ctx, operator, oldProxy, newProxy); err != nil {
+		return types.ProxiedValidator{}, err
+	}
+
+	if err := k.validateProxyBalance(ctx, operator, newProxy); err != nil {
+		return types.ProxiedValidator{}, err
+	}
+
+	// the old proxy must be stored first, because the replacement takes over its operator key
+	old.ExpiresAt = ctx.BlockHeight() + k.GetParams(ctx).ProxyRotationWindow
+	k.setProxiedValidator(ctx, old)
+	k.setProxiedValidator(ctx, types.NewProxiedValidator(operator, newProxy, old.Active, old.Role))
+
+	return old, nil
+}
+
+// DeactivateProxy deactivates the current proxy of the given role for a given operator
+func (k Keeper) DeactivateProxy(ctx sdk.Context, operator sdk.ValAddress, role exported.ProxyRole) error {
 	val := k.staking.Validator(ctx, operator)
 	if val == nil {
 		return fmt.Errorf("validator %s is unknown", operator.String())
 	}
 
-	proxiedValidator, ok := k.getProxiedValidator(ctx, operator)
+	proxiedValidator, ok := k.getCurrentProxy(ctx, operator, role)
 	if !ok {
-		return fmt.Errorf("validator %s has no proxy registered", operator.String())
+		return fmt.Errorf("validator %s has no %s proxy registered", operator.String(), role.SimpleString())
 	}
 
 	proxiedValidator.Active = false
@@ -360,6 +404,116 @@ func (k Keeper) DeactivateProxy(ctx sdk.Context, operator sdk.ValAddress) error
 	return nil
 }
 
+func (k Keeper) validateProxyBalance(ctx sdk.Context, operator sdk.ValAddress, proxy sdk.AccAddress) error {
+	minBalance := k.GetMinProxyBalance(ctx)
+	denom := k.staking.BondDenom(ctx)
+	if funds := k.getProxyFunds(ctx, operator, proxy); funds.LT(minBalance) {
+		return fmt.Errorf("account %s does not have sufficient funds to become a proxy (minimum %s%s, actual %s%s including fee allowance)",
+			proxy.String(), minBalance.String(), denom, funds.String(), denom)
+	}
+
+	return nil
+}
+
+// getProxyFunds returns the proxy's balance plus whatever it can still draw from the operator's account for fees
+func (k Keeper) getProxyFunds(ctx sdk.Context, operator sdk.ValAddress, proxy sdk.AccAddress) sdk.Int {
+	funds := k.bank.GetBalance(ctx, proxy, k.staking.BondDenom(ctx)).Amount
+	if allowance, ok := k.GetProxyFeeAllowance(ctx, operator, proxy); ok {
+		funds = funds.Add(allowance)
+	}
+
+	return funds
+}
+
+// SetProxyFeeAllowance lets the given proxy pay its transaction fees from the operator's account up to the given allowance
+func (k Keeper) SetProxyFeeAllowance(ctx sdk.Context, operator sdk.ValAddress, proxy sdk.AccAddress, allowance sdk.Coins) error {
+	return k.feegrant.GrantAllowance(ctx, sdk.AccAddress(operator), proxy, &feegrant.BasicAllowance{SpendLimit: allowance})
+}
+
+// moveProxyFeeAllowance grants the fee allowance the operator gave to the old proxy to the new proxy instead.
+// The feegrant keeper does not expose revoking allowances, so the old proxy's allowance is replaced by an expired one,
+// which is rejected and removed on its next use
+func (k Keeper) moveProxyFeeAllowance(ctx sdk.Context, operator sdk.ValAddress, oldProxy sdk.AccAddress, newProxy sdk.AccAddress) error {
+	granter := sdk.AccAddress(operator)
+	allowance, err := k.feegrant.GetAllowance(ctx, granter, oldProxy)
+	if err != nil || allowance == nil {
+		return nil
+	}
+
+	if err := k.feegrant.GrantAllowance(ctx, granter, newProxy, allowance); err != nil {
+		return err
+	}
+
+	expired := ctx.BlockTime().Add(-time.Second)
+	return k.feegrant.GrantAllowance(ctx, granter, oldProxy, &feegrant.BasicAllowance{Expiration: &expired})
+}
+
+// GetProxyFeeAllowance returns the amount of the bond denomination the given proxy can still draw from the operator's account for fees.
+// Returns false if the operator has not granted a usable fee allowance to the proxy
+func (k Keeper) GetProxyFeeAllowance(ctx sdk.Context, operator sdk.ValAddress, proxy sdk.AccAddress) (sdk.Int, bool) {
+	allowance, err := k.feegrant.GetAllowance(ctx, sdk.AccAddress(operator), proxy)
+	if err != nil || allowance == nil {
+		return sdk.ZeroInt(), false
+	}
+
+	var basic feegrant.BasicAllowance
+	switch allowance := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		basic = *allowance
+	case *feegrant.PeriodicAllowance:
+		basic = allowance.Basic
+	default:
+		return sdk.ZeroInt(), false
+	}
+
+	if basic.Expiration != nil && basic.Expiration.Before(ctx.BlockTime()) {
+		return sdk.ZeroInt(), false
+	}
+
+	// the proxy can never draw more than what is left on the operator's account
+	denom := k.staking.BondDenom(ctx)
+	available := k.bank.GetBalance(ctx, sdk.AccAddress(operator), denom).Amount
+	if basic.SpendLimit.Empty() {
+		return available, true
+	}
+
+	return sdk.MinInt(basic.SpendLimit.AmountOf(denom), available), true
+}
+
+// IsProxyFeeAllowanceLow returns true if the fee allowance granted to the given proxy has dropped below the minimum proxy balance
+func (k Keeper) IsProxyFeeAllowanceLow(ctx sdk.Context, operator sdk.ValAddress, proxy sdk.AccAddress) bool {
+	allowance, ok := k.GetProxyFeeAllowance(ctx, operator, proxy)
+
+	return ok && allowance.LT(k.GetMinProxyBalance(ctx))
+}
+
+// CheckProxyFeeAllowance emits a warning event if the fee allowance the granter gave to the given proxy is nearly used up
+func (k Keeper) CheckProxyFeeAllowance(ctx sdk.Context, granter sdk.AccAddress, proxy sdk.AccAddress) {
+	operator := k.GetOperator(ctx, proxy)
+	if operator == nil || !granter.Equals(sdk.AccAddress(operator)) {
+		return
+	}
+
+	if !k.IsProxyFeeAllowanceLow(ctx, operator, proxy) {
+		return
+	}
+
+	allowance, _ := k.GetProxyFeeAllowance(ctx, operator, proxy)
+	denom := k.staking.BondDenom(ctx)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeProxyFeeAllowanceLow,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeModule),
+			sdk.NewAttribute(types.AttributeGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeAddress, proxy.String()),
+			sdk.NewAttribute(types.AttributeFeeAllowance, sdk.NewCoin(denom, allowance).String()),
+		),
+	)
+
+	k.Logger(ctx).Info(fmt.Sprintf("fee allowance of proxy %s granted by validator %s is nearly used up (%s%s left)",
+		proxy.String(), operator.String(), allowance.String(), denom))
+}
+
 func (k Keeper) getProxiedValidator(ctx sdk.Context, addr sdk.Address) (types.ProxiedValidator, bool) {
 	var proxiedValidator types.ProxiedValidator
 
@@ -374,6 +528,19 @@ func (k Keeper) getProxiedValidator(ctx sdk.Context, addr sdk.Address) (types.Pr
 	}
 }
 
+// getCurrentProxy returns the proxy of the given role that has not been rotated out
+func (k Keeper) getCurrentProxy(ctx sdk.Context, operator sdk.ValAddress, role exported.ProxyRole) (types.ProxiedValidator, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(getOperatorKey(operator, role))
+	if bz == nil {
+		return types.ProxiedValidator{}, false
+	}
+
+	var proxiedValidator types.ProxiedValidator
+	k.cdc.MustUnmarshalLengthPrefixed(bz, &proxiedValidator)
+
+	return proxiedValidator, true
+}
+
 func (k Keeper) getProxiedValidators(ctx sdk.Context) []types.ProxiedValidator {
 	var proxiedValidators []types.ProxiedValidator
 
@@ -390,26 +557,61 @@ func (k Keeper) getProxiedValidators(ctx sdk.Context) []types.ProxiedValidator {
 	return proxiedValidators
 }
 
+// GetProxies returns all proxies registered for the given operator, including the ones that have been rotated out
+func (k Keeper) GetProxies(ctx sdk.Context, operator sdk.ValAddress) []types.ProxiedValidator {
+	var proxies []types.ProxiedValidator
+	for _, proxiedValidator := range k.getProxiedValidators(ctx) {
+		if proxiedValidator.Validator.Equals(operator) {
+			proxies = append(proxies, proxiedValidator)
+		}
+	}
+
+	return proxies
+}
+
 func (k Keeper) setProxiedValidator(ctx sdk.Context, proxiedValidator types.ProxiedValidator) {
 	bz := k.cdc.MustMarshalLengthPrefixed(&proxiedValidator)
 
-	ctx.KVStore(k.storeKey).Set([]byte(operatorPrefix+proxiedValidator.Validator.String()), bz)
+	// only the current proxy of each role is found through the operator
+	if proxiedValidator.ExpiresAt == 0 {
+		ctx.KVStore(k.storeKey).Set(getOperatorKey(proxiedValidator.Validator, proxiedValidator.Role), bz)
+	}
 	ctx.KVStore(k.storeKey).Set([]byte(proxyPrefix+proxiedValidator.Proxy.String()), bz)
 }
 
-// GetOperator returns the principal address for a given proxy address. Returns nil if not set.
+func getOperatorKey(operator sdk.ValAddress, role exported.ProxyRole) []byte {
+	// proxies without a dedicated role keep the key they were stored under before roles existed
+	if role == exported.ProxyAll {
+		return []byte(operatorPrefix + operator.String())
+	}
+
+	return []byte(fmt.Sprintf("%s%s_%s", operatorPrefix, operator.String(), role.SimpleString()))
+}
+
+// GetOperator returns the principal address for a given proxy address. Returns nil if not set,
+// or if the proxy is inactive or has been rotated out
 func (k Keeper) GetOperator(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
-	if proxiedValidator, ok := k.getProxiedValidator(ctx, proxy); ok && proxiedValidator.Active {
+	if proxiedValidator, ok := k.getProxiedValidator(ctx, proxy); ok && proxiedValidator.Active && !proxiedValidator.IsExpired(ctx.BlockHeight()) {
 		return proxiedValidator.Validator
 	}
 
 	return nil
 }
 
-// GetProxy returns the proxy address for a given operator address. Returns nil if not set.
+// GetProxyRole returns the role of the given proxy address. Returns false if the address is not a proxy
+func (k Keeper) GetProxyRole(ctx sdk.Context, proxy sdk.AccAddress) (exported.ProxyRole, bool) {
+	proxiedValidator, ok := k.getProxiedValidator(ctx, proxy)
+	if !ok {
+		return exported.ProxyAll, false
+	}
+
+	return proxiedValidator.Role, true
+}
+
+// GetProxy returns the current proxy address without a dedicated role for a given operator address. Returns nil if not set.
 // The bool value denotes wether or not the proxy is active and to be included in the next snapshot
 func (k Keeper) GetProxy(ctx sdk.Context, operator sdk.ValAddress) (addr sdk.AccAddress, active bool) {
-	if proxiedValidator, ok := k.getProxiedValidator(ctx, operator); ok {
+	if proxiedValidator, ok := k.getCurrentProxy(ctx, operator, exported.ProxyAll); ok {
 		return proxiedValidator.Proxy, proxiedValidator.Active
 	}
 
@@ -450,9 +652,7 @@ func (k Keeper) GetValidatorIllegibility(ctx sdk.Context, validator exported.SDK
 		illegibility |= exported.TssSuspended
 	}
 
-	minBalance := k.GetMinProxyBalance(ctx)
-	denom := k.staking.BondDenom(ctx)
-	if balance := k.bank.GetBalance(ctx, proxy, denom); balance.Amount.LT(minBalance) {
+	if k.getProxyFunds(ctx, validator.GetOperator(), proxy).LT(k.GetMinProxyBalance(ctx)) {
 		illegibility |= exported.ProxyInsuficientFunds
 	}
 
@@ -8,6 +8,7 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	params "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/tendermint/tendermint/crypto/ed25519"
@@ -19,6 +20,7 @@ import (
 	"github.com/axelarnetwork/axelar-core/testutils/fake"
 	"github.com/axelarnetwork/axelar-core/testutils/rand"
 	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 	"github.com/axelarnetwork/axelar-core/x/snapshot/keeper"
 	"github.com/axelarnetwork/axelar-core/x/snapshot/types"
 	"github.com/axelarnetwork/axelar-core/x/snapshot/types/mock"
@@ -122,11 +124,11 @@ func TestSnapshots(t *testing.T) {
 				},
 			}
 
-			snapshotKeeper := keeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("staking"), snapSubspace, staker, bank, slashingKeeper, tssMock)
+			snapshotKeeper := keeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("staking"), snapSubspace, staker, bank, newNoFeeGrantKeeper(), slashingKeeper, tssMock)
 			snapshotKeeper.SetParams(ctx, types.DefaultParams())
 			for _, v := range validators {
 				addr := rand.AccAddr()
-				_ = snapshotKeeper.ActivateProxy(ctx, v.GetOperator(), addr)
+				_ = snapshotKeeper.ActivateProxy(ctx, v.GetOperator(), addr, exported.ProxyAll)
 			}
 
 			_, ok := snapshotKeeper.GetSnapshot(ctx, 0)
@@ -185,13 +187,13 @@ func TestKeeper_RegisterProxy(t *testing.T) {
 			},
 		}
 
-		snapshotKeeper = keeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("staking"), snapSubspace, staker, bank, &snapshotMock.SlasherMock{}, &snapshotMock.TssMock{})
+		snapshotKeeper = keeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("staking"), snapSubspace, staker, bank, newNoFeeGrantKeeper(), &snapshotMock.SlasherMock{}, &snapshotMock.TssMock{})
 		snapshotKeeper.SetParams(ctx, types.DefaultParams())
 	}
 	t.Run("happy path", testutils.Func(func(t *testing.T) {
 		setup()
 
-		err := snapshotKeeper.ActivateProxy(ctx, principalAddress, expectedProxy)
+		err := snapshotKeeper.ActivateProxy(ctx, principalAddress, expectedProxy, exported.ProxyAll)
 
 		assert.NoError(t, err)
 		proxy, active := snapshotKeeper.GetProxy(ctx, principalAddress)
@@ -203,7 +205,7 @@ func TestKeeper_RegisterProxy(t *testing.T) {
 	t.Run("same addresses", testutils.Func(func(t *testing.T) {
 		setup()
 
-		err := snapshotKeeper.ActivateProxy(ctx, expectedProxy.Bytes(), expectedProxy)
+		err := snapshotKeeper.ActivateProxy(ctx, expectedProxy.Bytes(), expectedProxy, exported.ProxyAll)
 
 		assert.Error(t, err)
 	}).Repeat(20))
@@ -213,7 +215,7 @@ func TestKeeper_RegisterProxy(t *testing.T) {
 
 		address := rand.ValAddr()
 		proxy := rand.AccAddr()
-		err := snapshotKeeper.ActivateProxy(ctx, address, proxy)
+		err := snapshotKeeper.ActivateProxy(ctx, address, proxy, exported.ProxyAll)
 
 		assert.Error(t, err)
 
@@ -229,7 +231,7 @@ func TestKeeper_RegisterProxy(t *testing.T) {
 			return sdk.NewCoin("uaxl", sdk.ZeroInt())
 		}
 
-		err := snapshotKeeper.ActivateProxy(ctx, principalAddress, expectedProxy)
+		err := snapshotKeeper.ActivateProxy(ctx, principalAddress, expectedProxy, exported.ProxyAll)
 
 		assert.Error(t, err)
 
@@ -261,17 +263,17 @@ func TestKeeper_DeregisterProxy(t *testing.T) {
 			},
 		}
 
-		snapshotKeeper = keeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("staking"), snapSubspace, staker, bank, &snapshotMock.SlasherMock{}, &snapshotMock.TssMock{})
+		snapshotKeeper = keeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("staking"), snapSubspace, staker, bank, newNoFeeGrantKeeper(), &snapshotMock.SlasherMock{}, &snapshotMock.TssMock{})
 		snapshotKeeper.SetParams(ctx, types.DefaultParams())
 
-		if err := snapshotKeeper.ActivateProxy(ctx, principalAddress, expectedProxy); err != nil {
+		if err := snapshotKeeper.ActivateProxy(ctx, principalAddress, expectedProxy, exported.ProxyAll); err != nil {
 			panic(fmt.Sprintf("setup failed for unit test: %v", err))
 		}
 	}
 	t.Run("happy path", testutils.Func(func(t *testing.T) {
 		setup()
 
-		err := snapshotKeeper.DeactivateProxy(ctx, principalAddress)
+		err := snapshotKeeper.DeactivateProxy(ctx, principalAddress, exported.ProxyAll)
 
 		assert.NoError(t, err)
 		proxy, active := snapshotKeeper.GetProxy(ctx, principalAddress)
@@ -284,7 +286,7 @@ func TestKeeper_DeregisterProxy(t *testing.T) {
 		setup()
 
 		address := rand.ValAddr()
-		err := snapshotKeeper.DeactivateProxy(ctx, address)
+		err := snapshotKeeper.DeactivateProxy(ctx, address, exported.ProxyAll)
 
 		assert.Error(t, err)
 
@@ -302,13 +304,236 @@ func TestKeeper_DeregisterProxy(t *testing.T) {
 		}
 
 		principalAddress = address
-		err := snapshotKeeper.DeactivateProxy(ctx, principalAddress)
+		err := snapshotKeeper.DeactivateProxy(ctx, principalAddress, exported.ProxyAll)
 
 		assert.Error(t, err)
 
 	}).Repeat(20))
 }
 
+func TestKeeper_ProxyRoles(t *testing.T) {
+	var (
+		ctx              sdk.Context
+		snapshotKeeper   keeper.Keeper
+		principalAddress sdk.ValAddress
+	)
+
+	setup := func() {
+		encCfg := appParams.MakeEncodingConfig()
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{Height: rand.I64Between(1, 1000)}, false, log.TestingLogger())
+		snapSubspace := params.NewSubspace(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("paramsKey"), sdk.NewKVStoreKey("tparamsKey"), "snap")
+		validators := genValidators(t, 10, 100)
+		staker := newMockStaker(validators...)
+		principalAddress = validators[rand.I64Between(0, 10)].GetOperator()
+
+		bank := &mock.BankKeeperMock{
+			GetBalanceFunc: func(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+				return sdk.NewCoin("uaxl", sdk.NewInt(5000000))
+			},
+		}
+
+		snapshotKeeper = keeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("staking"), snapSubspace, staker, bank, newNoFeeGrantKeeper(), &snapshotMock.SlasherMock{}, &snapshotMock.TssMock{})
+		snapshotKeeper.SetParams(ctx, types.DefaultParams())
+	}
+
+	t.Run("one proxy per role", testutils.Func(func(t *testing.T) {
+		setup()
+
+		allProxy, tssProxy, voteProxy := rand.AccAddr(), rand.AccAddr(), rand.AccAddr()
+		assert.NoError(t, snapshotKeeper.ActivateProxy(ctx, principalAddress, allProxy, exported.ProxyAll))
+		assert.NoError(t, snapshotKeeper.ActivateProxy(ctx, principalAddress, tssProxy, exported.ProxyTss))
+		assert.NoError(t, snapshotKeeper.ActivateProxy(ctx, principalAddress, voteProxy, exported.ProxyVote))
+		assert.Error(t, snapshotKeeper.ActivateProxy(ctx, principalAddress, rand.AccAddr(), exported.ProxyTss))
+		assert.Error(t, snapshotKeeper.ActivateProxy(ctx, principalAddress, tssProxy, exported.ProxyVote))
+
+		proxy, active := snapshotKeeper.GetProxy(ctx, principalAddress)
+		assert.True(t, active)
+		assert.Equal(t, allProxy, proxy)
+		assert.Len(t, snapshotKeeper.GetProxies(ctx, principalAddress), 3)
+
+		for proxy, expectedRole := range map[string]exported.ProxyRole{allProxy.String(): exported.ProxyAll, tssProxy.String(): exported.ProxyTss, voteProxy.String(): exported.ProxyVote} {
+			addr, _ := sdk.AccAddressFromBech32(proxy)
+			role, ok := snapshotKeeper.GetProxyRole(ctx, addr)
+			assert.True(t, ok)
+			assert.Equal(t, expectedRole, role)
+			assert.Equal(t, principalAddress, snapshotKeeper.GetOperator(ctx, addr))
+		}
+
+		assert.NoError(t, snapshotKeeper.DeactivateProxy(ctx, principalAddress, exported.ProxyVote))
+		assert.Nil(t, snapshotKeeper.GetOperator(ctx, voteProxy))
+		assert.Equal(t, principalAddress, snapshotKeeper.GetOperator(ctx, tssProxy))
+		_, active = snapshotKeeper.GetProxy(ctx, principalAddress)
+		assert.True(t, active)
+	}).Repeat(20))
+
+	t.Run("rotate proxy", testutils.Func(func(t *testing.T) {
+		setup()
+
+		oldProxy, newProxy := rand.AccAddr(), rand.AccAddr()
+		assert.NoError(t, snapshotKeeper.ActivateProxy(ctx, principalAddress, oldProxy, exported.ProxyTss))
+
+		old, err := snapshotKeeper.RotateProxy(ctx, principalAddress, oldProxy, newProxy)
+		assert.NoError(t, err)
+
+		window := snapshotKeeper.GetParams(ctx).ProxyRotationWindow
+		assert.Equal(t, ctx.BlockHeight()+window, old.ExpiresAt)
+		assert.Equal(t, exported.ProxyTss, old.Role)
+
+		// both proxies can act for the validator during the rotation window
+		assert.Equal(t, principalAddress, snapshotKeeper.GetOperator(ctx, oldProxy))
+		assert.Equal(t, principalAddress, snapshotKeeper.GetOperator(ctx, newProxy))
+		role, ok := snapshotKeeper.GetProxyRole(ctx, newProxy)
+		assert.True(t, ok)
+		assert.Equal(t, exported.ProxyTss, role)
+
+		_, err = snapshotKeeper.RotateProxy(ctx, principalAddress, oldProxy, rand.AccAddr())
+		assert.Error(t, err)
+
+		ctx = ctx.WithBlockHeight(old.ExpiresAt)
+		assert.Nil(t, snapshotKeeper.GetOperator(ctx, oldProxy))
+		assert.Equal(t, principalAddress, snapshotKeeper.GetOperator(ctx, newProxy))
+	}).Repeat(20))
+
+	t.Run("rotate proxy of another validator", testutils.Func(func(t *testing.T) {
+		setup()
+
+		oldProxy := rand.AccAddr()
+		assert.NoError(t, snapshotKeeper.ActivateProxy(ctx, principalAddress, oldProxy, exported.ProxyAll))
+
+		_, err := snapshotKeeper.RotateProxy(ctx, rand.ValAddr(), oldProxy, rand.AccAddr())
+		assert.Error(t, err)
+	}).Repeat(20))
+
+	t.Run("rotate to registered proxy", testutils.Func(func(t *testing.T) {
+		setup()
+
+		oldProxy, otherProxy := rand.AccAddr(), rand.AccAddr()
+		assert.NoError(t, snapshotKeeper.ActivateProxy(ctx, principalAddress, oldProxy, exported.ProxyAll))
+		assert.NoError(t, snapshotKeeper.ActivateProxy(ctx, principalAddress, otherProxy, exported.ProxyVote))
+
+		_, err := snapshotKeeper.RotateProxy(ctx, principalAddress, oldProxy, otherProxy)
+		assert.Error(t, err)
+	}).Repeat(20))
+}
+
+func TestKeeper_ProxyFeeAllowance(t *testing.T) {
+	var (
+		ctx              sdk.Context
+		snapshotKeeper   keeper.Keeper
+		principalAddress sdk.ValAddress
+		proxy            sdk.AccAddress
+		operatorBalance  sdk.Int
+		allowances       map[string]feegrant.FeeAllowanceI
+	)
+
+	setup := func() {
+		encCfg := appParams.MakeEncodingConfig()
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{Height: rand.I64Between(1, 1000)}, false, log.TestingLogger())
+		snapSubspace := params.NewSubspace(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("paramsKey"), sdk.NewKVStoreKey("tparamsKey"), "snap")
+		validators := genValidators(t, 10, 100)
+		staker := newMockStaker(validators...)
+		principalAddress = validators[rand.I64Between(0, 10)].GetOperator()
+		proxy = rand.AccAddr()
+		operatorBalance = sdk.NewInt(rand.I64Between(100000000, 1000000000))
+		allowances = make(map[string]feegrant.FeeAllowanceI)
+
+		bank := &mock.BankKeeperMock{
+			GetBalanceFunc: func(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+				if addr.Equals(sdk.AccAddress(principalAddress)) {
+					return sdk.NewCoin(denom, operatorBalance)
+				}
+				return sdk.NewCoin(denom, sdk.ZeroInt())
+			},
+		}
+		feegrantKeeper := &mock.FeeGrantKeeperMock{
+			GrantAllowanceFunc: func(_ sdk.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
+				allowances[granter.String()+grantee.String()] = feeAllowance
+				return nil
+			},
+			GetAllowanceFunc: func(_ sdk.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error) {
+				allowance, ok := allowances[granter.String()+grantee.String()]
+				if !ok {
+					return nil, fmt.Errorf("fee-grant not found")
+				}
+				return allowance, nil
+			},
+		}
+
+		snapshotKeeper = keeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("staking"), snapSubspace, staker, bank, feegrantKeeper, &snapshotMock.SlasherMock{}, &snapshotMock.TssMock{})
+		snapshotKeeper.SetParams(ctx, types.DefaultParams())
+	}
+
+	t.Run("no allowance", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, ok := snapshotKeeper.GetProxyFeeAllowance(ctx, principalAddress, proxy)
+		assert.False(t, ok)
+		assert.Error(t, snapshotKeeper.ActivateProxy(ctx, principalAddress, proxy, exported.ProxyAll))
+	}).Repeat(20))
+
+	t.Run("allowance counts towards proxy funds", testutils.Func(func(t *testing.T) {
+		setup()
+
+		amount := sdk.NewInt(rand.I64Between(types.DefaultParams().MinProxyBalance, 100000000))
+		assert.NoError(t, snapshotKeeper.SetProxyFeeAllowance(ctx, principalAddress, proxy, sdk.NewCoins(sdk.NewCoin("uaxl", amount))))
+
+		allowance, ok := snapshotKeeper.GetProxyFeeAllowance(ctx, principalAddress, proxy)
+		assert.True(t, ok)
+		assert.Equal(t, amount, allowance)
+		assert.False(t, snapshotKeeper.IsProxyFeeAllowanceLow(ctx, principalAddress, proxy))
+		assert.NoError(t, snapshotKeeper.ActivateProxy(ctx, principalAddress, proxy, exported.ProxyAll))
+	}).Repeat(20))
+
+	t.Run("allowance moves to the new proxy on rotation", testutils.Func(func(t *testing.T) {
+		setup()
+		ctx = ctx.WithBlockTime(time.Unix(rand.I64Between(1000000, 2000000), 0))
+
+		amount := sdk.NewInt(rand.I64Between(types.DefaultParams().MinProxyBalance, 100000000))
+		assert.NoError(t, snapshotKeeper.SetProxyFeeAllowance(ctx, principalAddress, proxy, sdk.NewCoins(sdk.NewCoin("uaxl", amount))))
+		assert.NoError(t, snapshotKeeper.ActivateProxy(ctx, principalAddress, proxy, exported.ProxyAll))
+
+		// the new proxy has no funds of its own, so it can only become a proxy with the moved allowance
+		newProxy := rand.AccAddr()
+		_, err := snapshotKeeper.RotateProxy(ctx, principalAddress, proxy, newProxy)
+		assert.NoError(t, err)
+
+		allowance, ok := snapshotKeeper.GetProxyFeeAllowance(ctx, principalAddress, newProxy)
+		assert.True(t, ok)
+		assert.Equal(t, amount, allowance)
+
+		_, ok = snapshotKeeper.GetProxyFeeAllowance(ctx, principalAddress, proxy)
+		assert.False(t, ok)
+		old := allowances[sdk.AccAddress(principalAddress).String()+proxy.String()]
+		_, err = old.Accept(ctx, sdk.NewCoins(sdk.NewInt64Coin("uaxl", 1)), nil)
+		assert.Error(t, err)
+	}).Repeat(20))
+
+	t.Run("allowance is capped by operator balance", testutils.Func(func(t *testing.T) {
+		setup()
+
+		assert.NoError(t, snapshotKeeper.SetProxyFeeAllowance(ctx, principalAddress, proxy, sdk.NewCoins(sdk.NewCoin("uaxl", operatorBalance.AddRaw(1)))))
+		assert.NoError(t, snapshotKeeper.ActivateProxy(ctx, principalAddress, proxy, exported.ProxyAll))
+
+		operatorBalance = sdk.NewInt(rand.I64Between(0, types.DefaultParams().MinProxyBalance))
+		allowance, ok := snapshotKeeper.GetProxyFeeAllowance(ctx, principalAddress, proxy)
+		assert.True(t, ok)
+		assert.Equal(t, operatorBalance, allowance)
+		assert.True(t, snapshotKeeper.IsProxyFeeAllowanceLow(ctx, principalAddress, proxy))
+
+		snapshotKeeper.CheckProxyFeeAllowance(ctx, sdk.AccAddress(principalAddress), proxy)
+		assert.Len(t, ctx.EventManager().Events(), 1)
+		assert.Equal(t, types.EventTypeProxyFeeAllowanceLow, ctx.EventManager().Events()[0].Type)
+	}).Repeat(20))
+}
+
+func newNoFeeGrantKeeper() *mock.FeeGrantKeeperMock {
+	return &mock.FeeGrantKeeperMock{
+		GetAllowanceFunc: func(sdk.Context, sdk.AccAddress, sdk.AccAddress) (feegrant.FeeAllowanceI, error) {
+			return nil, fmt.Errorf("fee-grant not found")
+		},
+	}
+}
+
 // This function returns a set of validators whose voting power adds up to the specified total power
 func genValidators(t *testing.T, numValidators, totalConsPower int) []stakingtypes.ValidatorI {
 	t.Logf("Total Power: %v", totalConsPower)
@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 	"github.com/axelarnetwork/axelar-core/x/snapshot/types"
 )
 
@@ -32,7 +33,14 @@ func NewMsgServerImpl(keeper Keeper, nexus types.Nexus) types.MsgServiceServer {
 func (s msgServer) RegisterProxy(c context.Context, req *types.RegisterProxyRequest) (*types.RegisterProxyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	if err := s.Keeper.ActivateProxy(ctx, req.Sender, req.ProxyAddr); err != nil {
+	// the allowance must be granted first so it counts towards the proxy's funds
+	if !req.FeeAllowance.Empty() {
+		if err := s.Keeper.SetProxyFeeAllowance(ctx, req.Sender, req.ProxyAddr, req.FeeAllowance); err != nil {
+			return nil, sdkerrors.Wrap(types.ErrSnapshot, err.Error())
+		}
+	}
+
+	if err := s.Keeper.ActivateProxy(ctx, req.Sender, req.ProxyAddr, req.Role); err != nil {
 		return nil, sdkerrors.Wrap(types.ErrSnapshot, err.Error())
 	}
 
@@ -43,6 +51,8 @@ func (s msgServer) RegisterProxy(c context.Context, req *types.RegisterProxyRequ
 			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeRegisterProxy),
 			sdk.NewAttribute(sdk.AttributeKeySender, req.Sender.String()),
 			sdk.NewAttribute(types.AttributeAddress, req.ProxyAddr.String()),
+			sdk.NewAttribute(types.AttributeRole, req.Role.SimpleString()),
+			sdk.NewAttribute(types.AttributeFeeAllowance, req.FeeAllowance.String()),
 		),
 	)
 
@@ -53,17 +63,21 @@ func (s msgServer) RegisterProxy(c context.Context, req *types.RegisterProxyRequ
 			telemetry.NewLabel("timestamp", strconv.FormatInt(ctx.BlockTime().Unix(), 10)),
 			telemetry.NewLabel("principal_address", req.Sender.String()),
 			telemetry.NewLabel("proxy_address", req.ProxyAddr.String()),
+			telemetry.NewLabel("role", req.Role.SimpleString()),
 		})
 
-	s.Keeper.Logger(ctx).Info(fmt.Sprintf("validator %s registered proxy %s", req.Sender.String(), req.ProxyAddr.String()))
+	s.Keeper.Logger(ctx).Info(fmt.Sprintf("validator %s registered %s proxy %s", req.Sender.String(), req.Role.SimpleString(), req.ProxyAddr.String()))
 	return &types.RegisterProxyResponse{}, nil
 }
 
 func (s msgServer) DeactivateProxy(c context.Context, req *types.DeactivateProxyRequest) (*types.DeactivateProxyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	proxy, _ := s.Keeper.GetProxy(ctx, req.Sender)
+	var proxy sdk.AccAddress
+	if proxiedValidator, ok := s.Keeper.getCurrentProxy(ctx, req.Sender, req.Role); ok {
+		proxy = proxiedValidator.Proxy
+	}
 
-	if err := s.Keeper.DeactivateProxy(ctx, req.Sender); err != nil {
+	if err := s.Keeper.DeactivateProxy(ctx, req.Sender, req.Role); err != nil {
 		return nil, sdkerrors.Wrap(types.ErrSnapshot, err.Error())
 	}
 
@@ -74,6 +88,7 @@ func (s msgServer) DeactivateProxy(c context.Context, req *types.DeactivateProxy
 			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeDeactivateProxy),
 			sdk.NewAttribute(sdk.AttributeKeySender, req.Sender.String()),
 			sdk.NewAttribute(types.AttributeAddress, proxy.String()),
+			sdk.NewAttribute(types.AttributeRole, req.Role.SimpleString()),
 		),
 	)
 
@@ -86,7 +101,12 @@ func (s msgServer) DeactivateProxy(c context.Context, req *types.DeactivateProxy
 			telemetry.NewLabel("proxy_address", proxy.String()),
 		})
 
-	s.Keeper.Logger(ctx).Info(fmt.Sprintf("validator %s has de-activated proxy %s", req.Sender.String(), proxy.String()))
+	s.Keeper.Logger(ctx).Info(fmt.Sprintf("validator %s has de-activated %s proxy %s", req.Sender.String(), req.Role.SimpleString(), proxy.String()))
+
+	// proxies with a dedicated role do not decide the validator's eligibility
+	if req.Role != exported.ProxyAll {
+		return &types.DeactivateProxyResponse{}, nil
+	}
 
 	// remove validator as chain maintainer since it can no longer vote
 	for _, chain := range s.nexus.GetChains(ctx) {
@@ -103,3 +123,39 @@ func (s msgServer) DeactivateProxy(c context.Context, req *types.DeactivateProxy
 
 	return &types.DeactivateProxyResponse{}, nil
 }
+
+func (s msgServer) RotateProxy(c context.Context, req *types.RotateProxyRequest) (*types.RotateProxyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	old, err := s.Keeper.RotateProxy(ctx, req.Sender, req.OldProxyAddr, req.NewProxyAddr)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrSnapshot, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeModule),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeRotateProxy),
+			sdk.NewAttribute(sdk.AttributeKeySender, req.Sender.String()),
+			sdk.NewAttribute(types.AttributeOldAddress, req.OldProxyAddr.String()),
+			sdk.NewAttribute(types.AttributeAddress, req.NewProxyAddr.String()),
+			sdk.NewAttribute(types.AttributeRole, old.Role.SimpleString()),
+			sdk.NewAttribute(types.AttributeExpiresAt, strconv.FormatInt(old.ExpiresAt, 10)),
+		),
+	)
+
+	telemetry.SetGaugeWithLabels(
+		[]string{types.ModuleName, "rotate", "proxy"},
+		0,
+		[]metrics.Label{
+			telemetry.NewLabel("timestamp", strconv.FormatInt(ctx.BlockTime().Unix(), 10)),
+			telemetry.NewLabel("principal_address", req.Sender.String()),
+			telemetry.NewLabel("old_proxy_address", req.OldProxyAddr.String()),
+			telemetry.NewLabel("proxy_address", req.NewProxyAddr.String()),
+		})
+
+	s.Keeper.Logger(ctx).Info(fmt.Sprintf("validator %s rotated %s proxy %s to %s, old proxy expires at block height %d",
+		req.Sender.String(), old.Role.SimpleString(), req.OldProxyAddr.String(), req.NewProxyAddr.String(), old.ExpiresAt))
+	return &types.RotateProxyResponse{}, nil
+}
@@ -46,22 +46,47 @@ func queryProxy(ctx sdk.Context, k Keeper, address string) ([]byte, error) {
 		return nil, sdkerrors.Wrap(types.ErrSnapshot, "address invalid")
 	}
 
-	proxy, active := k.GetProxy(ctx, addr)
-	if proxy == nil {
+	proxiedValidators := k.GetProxies(ctx, addr)
+	if len(proxiedValidators) == 0 {
 		return nil, sdkerrors.Wrap(types.ErrSnapshot, "no proxy set for operator address")
 	}
 
-	statusStr := "inactive"
-	if active {
-		statusStr = "active"
+	type proxyReply struct {
+		Address         string `json:"address"`
+		Role            string `json:"role"`
+		Status          string `json:"status"`
+		ExpiresAt       int64  `json:"expires_at,omitempty"`
+		FeeAllowance    string `json:"fee_allowance,omitempty"`
+		FeeAllowanceLow bool   `json:"fee_allowance_low,omitempty"`
+	}
+
+	denom := k.staking.BondDenom(ctx)
+	proxies := make([]proxyReply, len(proxiedValidators))
+	for i, proxiedValidator := range proxiedValidators {
+		proxies[i] = proxyReply{
+			Address:   proxiedValidator.Proxy.String(),
+			Role:      proxiedValidator.Role.SimpleString(),
+			Status:    getProxyStatus(proxiedValidator.Active),
+			ExpiresAt: proxiedValidator.ExpiresAt,
+		}
+
+		if allowance, ok := k.GetProxyFeeAllowance(ctx, addr, proxiedValidator.Proxy); ok {
+			proxies[i].FeeAllowance = sdk.NewCoin(denom, allowance).String()
+			proxies[i].FeeAllowanceLow = k.IsProxyFeeAllowanceLow(ctx, addr, proxiedValidator.Proxy)
+		}
 	}
 
+	// address and status refer to the proxy without a dedicated role for backwards compatibility
+	proxy, active := k.GetProxy(ctx, addr)
+
 	reply := struct {
-		Address string `json:"address"`
-		Status  string `json:"status"`
+		Address string       `json:"address"`
+		Status  string       `json:"status"`
+		Proxies []proxyReply `json:"proxies"`
 	}{
 		Address: proxy.String(),
-		Status:  statusStr,
+		Status:  getProxyStatus(active),
+		Proxies: proxies,
 	}
 
 	bz, err := json.Marshal(reply)
@@ -72,6 +97,14 @@ func queryProxy(ctx sdk.Context, k Keeper, address string) ([]byte, error) {
 	return bz, nil
 }
 
+func getProxyStatus(active bool) string {
+	if active {
+		return "active"
+	}
+
+	return "inactive"
+}
+
 func queryOperator(ctx sdk.Context, k Keeper, proxy string) ([]byte, error) {
 	proxyAddr, err := sdk.AccAddressFromBech32(proxy)
 	if err != nil {
@@ -3,12 +3,15 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 )
 
 // NewDeactivateProxyRequest - DeregisterProxyRequest constructor
-func NewDeactivateProxyRequest(sender sdk.ValAddress) *DeactivateProxyRequest {
+func NewDeactivateProxyRequest(sender sdk.ValAddress, role exported.ProxyRole) *DeactivateProxyRequest {
 	return &DeactivateProxyRequest{
 		Sender: sender,
+		Role:   role,
 	}
 }
 
@@ -27,6 +30,9 @@ func (m DeactivateProxyRequest) ValidateBasic() error {
 	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "principal").Error())
 	}
+	if err := m.Role.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
 
 	return nil
 }
@@ -3,13 +3,17 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 )
 
 // NewRegisterProxyRequest - RegisterProxyRequest constructor
-func NewRegisterProxyRequest(sender sdk.ValAddress, proxy sdk.AccAddress) *RegisterProxyRequest {
+func NewRegisterProxyRequest(sender sdk.ValAddress, proxy sdk.AccAddress, role exported.ProxyRole, feeAllowance sdk.Coins) *RegisterProxyRequest {
 	return &RegisterProxyRequest{
-		Sender:    sender,
-		ProxyAddr: proxy,
+		Sender:       sender,
+		ProxyAddr:    proxy,
+		Role:         role,
+		FeeAllowance: feeAllowance,
 	}
 }
 
@@ -31,6 +35,12 @@ func (m RegisterProxyRequest) ValidateBasic() error {
 	if err := sdk.VerifyAddressFormat(m.ProxyAddr); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "proxy").Error())
 	}
+	if err := m.Role.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := m.FeeAllowance.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, sdkerrors.Wrap(err, "fee allowance").Error())
+	}
 
 	return nil
 }
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewRotateProxyRequest - RotateProxyRequest constructor
+func NewRotateProxyRequest(sender sdk.ValAddress, oldProxy sdk.AccAddress, newProxy sdk.AccAddress) *RotateProxyRequest {
+	return &RotateProxyRequest{
+		Sender:       sender,
+		OldProxyAddr: oldProxy,
+		NewProxyAddr: newProxy,
+	}
+}
+
+// Route returns the route for this message
+func (m RotateProxyRequest) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (m RotateProxyRequest) Type() string {
+	return "RotateProxy"
+}
+
+// ValidateBasic executes a stateless message validation
+func (m RotateProxyRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "principal").Error())
+	}
+	if err := sdk.VerifyAddressFormat(m.OldProxyAddr); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "old proxy").Error())
+	}
+	if err := sdk.VerifyAddressFormat(m.NewProxyAddr); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "new proxy").Error())
+	}
+	if m.OldProxyAddr.Equals(m.NewProxyAddr) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("cannot rotate proxy %s to itself", m.OldProxyAddr.String()))
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes that need to be signed
+func (m RotateProxyRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the set of signers for this message
+func (m RotateProxyRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(m.Sender)}
+}
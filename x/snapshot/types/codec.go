@@ -15,6 +15,7 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&RegisterProxyRequest{}, "snapshot/RegisterProxy", nil)
 	cdc.RegisterConcrete(&DeactivateProxyRequest{}, "snapshot/DeactivateProxy", nil)
+	cdc.RegisterConcrete(&RotateProxyRequest{}, "snapshot/RotateProxy", nil)
 }
 
 // RegisterInterfaces registers types and interfaces with the given registry
@@ -25,6 +26,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil), &RegisterProxyRequest{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &DeactivateProxyRequest{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &RotateProxyRequest{})
 
 	msgservice.RegisterMsgServiceDesc(registry, &_MsgService_serviceDesc)
 }
@@ -2,7 +2,8 @@ package types
 
 // Event types
 const (
-	EventTypeCreateSnapshot = "createSnapshot"
+	EventTypeCreateSnapshot       = "createSnapshot"
+	EventTypeProxyFeeAllowanceLow = "proxyFeeAllowanceLow"
 )
 
 // Event attribute keys
@@ -11,6 +12,12 @@ const (
 	AttributeAddress        		= "address"
 	AttributeRegisterProxy  		= "registerProxy"
 	AttributeDeactivateProxy 		= "deactivateProxy"
+	AttributeRotateProxy     		= "rotateProxy"
+	AttributeRole            		= "role"
+	AttributeOldAddress      		= "oldAddress"
+	AttributeExpiresAt       		= "expiresAt"
+	AttributeFeeAllowance    		= "feeAllowance"
+	AttributeGranter         		= "granter"
 	AttributeParticipants      		= "participants"
 	AttributeParticipantsStake 		= "participantsStake"
 	AttributeNonParticipants      	= "nonParticipants"
@@ -4,10 +4,11 @@ import (
 	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-//go:generate moq -pkg mock -out ./mock/expected_keepers.go . StakingKeeper BankKeeper
+//go:generate moq -pkg mock -out ./mock/expected_keepers.go . StakingKeeper BankKeeper FeeGrantKeeper
 
 // StakingKeeper adopts the methods from "github.com/cosmos/cosmos-sdk/x/staking/exported" that are
 // actually used by this module
@@ -24,6 +25,12 @@ type BankKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
 
+// FeeGrantKeeper adopts the methods of the feegrant keeper that are used by this module
+type FeeGrantKeeper interface {
+	GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error
+	GetAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+}
+
 // Nexus provides functionality to manage cross-chain transfers
 type Nexus interface {
 	GetChains(ctx sdk.Context) []nexus.Chain
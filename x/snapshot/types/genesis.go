@@ -39,10 +39,28 @@ func (m GenesisState) Validate() error {
 		}
 	}
 
+	proxySeen := make(map[string]bool)
+	currentProxySeen := make(map[string]bool)
 	for _, proxiedValidator := range m.ProxiedValidators {
 		if err := proxiedValidator.Validate(); err != nil {
 			return getValidateError(err)
 		}
+
+		if proxySeen[proxiedValidator.Proxy.String()] {
+			return getValidateError(fmt.Errorf("duplicate proxy %s", proxiedValidator.Proxy.String()))
+		}
+		proxySeen[proxiedValidator.Proxy.String()] = true
+
+		if proxiedValidator.ExpiresAt != 0 {
+			continue
+		}
+
+		// each validator can only have one proxy per role that has not been rotated out
+		currentKey := fmt.Sprintf("%s_%s", proxiedValidator.Validator.String(), proxiedValidator.Role.SimpleString())
+		if currentProxySeen[currentKey] {
+			return getValidateError(fmt.Errorf("validator %s has multiple current %s proxies", proxiedValidator.Validator.String(), proxiedValidator.Role.SimpleString()))
+		}
+		currentProxySeen[currentKey] = true
 	}
 
 	return nil
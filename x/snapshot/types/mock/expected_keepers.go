@@ -6,6 +6,7 @@ package mock
 import (
 	"github.com/axelarnetwork/axelar-core/x/snapshot/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"sync"
 )
@@ -335,3 +336,141 @@ func (mock *BankKeeperMock) GetBalanceCalls() []struct {
 	mock.lockGetBalance.RUnlock()
 	return calls
 }
+
+// Ensure, that FeeGrantKeeperMock does implement types.FeeGrantKeeper.
+// If this is not the case, regenerate this file with moq.
+var _ types.FeeGrantKeeper = &FeeGrantKeeperMock{}
+
+// FeeGrantKeeperMock is a mock implementation of types.FeeGrantKeeper.
+//
+// 	func TestSomethingThatUsesFeeGrantKeeper(t *testing.T) {
+//
+// 		// make and configure a mocked types.FeeGrantKeeper
+// 		mockedFeeGrantKeeper := &FeeGrantKeeperMock{
+// 			GetAllowanceFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, granter github_com_cosmos_cosmos_sdk_types.AccAddress, grantee github_com_cosmos_cosmos_sdk_types.AccAddress) (feegrant.FeeAllowanceI, error) {
+// 				panic("mock out the GetAllowance method")
+// 			},
+// 			GrantAllowanceFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, granter github_com_cosmos_cosmos_sdk_types.AccAddress, grantee github_com_cosmos_cosmos_sdk_types.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
+// 				panic("mock out the GrantAllowance method")
+// 			},
+// 		}
+//
+// 		// use mockedFeeGrantKeeper in code that requires types.FeeGrantKeeper
+// 		// and then make assertions.
+//
+// 	}
+type FeeGrantKeeperMock struct {
+	// GetAllowanceFunc mocks the GetAllowance method.
+	GetAllowanceFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, granter github_com_cosmos_cosmos_sdk_types.AccAddress, grantee github_com_cosmos_cosmos_sdk_types.AccAddress) (feegrant.FeeAllowanceI, error)
+
+	// GrantAllowanceFunc mocks the GrantAllowance method.
+	GrantAllowanceFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, granter github_com_cosmos_cosmos_sdk_types.AccAddress, grantee github_com_cosmos_cosmos_sdk_types.AccAddress, feeAllowance feegrant.FeeAllowanceI) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetAllowance holds details about calls to the GetAllowance method.
+		GetAllowance []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Granter is the granter argument value.
+			Granter github_com_cosmos_cosmos_sdk_types.AccAddress
+			// Grantee is the grantee argument value.
+			Grantee github_com_cosmos_cosmos_sdk_types.AccAddress
+		}
+		// GrantAllowance holds details about calls to the GrantAllowance method.
+		GrantAllowance []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Granter is the granter argument value.
+			Granter github_com_cosmos_cosmos_sdk_types.AccAddress
+			// Grantee is the grantee argument value.
+			Grantee github_com_cosmos_cosmos_sdk_types.AccAddress
+			// FeeAllowance is the feeAllowance argument value.
+			FeeAllowance feegrant.FeeAllowanceI
+		}
+	}
+	lockGetAllowance   sync.RWMutex
+	lockGrantAllowance sync.RWMutex
+}
+
+// GetAllowance calls GetAllowanceFunc.
+func (mock *FeeGrantKeeperMock) GetAllowance(ctx github_com_cosmos_cosmos_sdk_types.Context, granter github_com_cosmos_cosmos_sdk_types.AccAddress, grantee github_com_cosmos_cosmos_sdk_types.AccAddress) (feegrant.FeeAllowanceI, error) {
+	if mock.GetAllowanceFunc == nil {
+		panic("FeeGrantKeeperMock.GetAllowanceFunc: method is nil but FeeGrantKeeper.GetAllowance was just called")
+	}
+	callInfo := struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		Granter github_com_cosmos_cosmos_sdk_types.AccAddress
+		Grantee github_com_cosmos_cosmos_sdk_types.AccAddress
+	}{
+		Ctx:     ctx,
+		Granter: granter,
+		Grantee: grantee,
+	}
+	mock.lockGetAllowance.Lock()
+	mock.calls.GetAllowance = append(mock.calls.GetAllowance, callInfo)
+	mock.lockGetAllowance.Unlock()
+	return mock.GetAllowanceFunc(ctx, granter, grantee)
+}
+
+// GetAllowanceCalls gets all the calls that were made to GetAllowance.
+// Check the length with:
+//     len(mockedFeeGrantKeeper.GetAllowanceCalls())
+func (mock *FeeGrantKeeperMock) GetAllowanceCalls() []struct {
+	Ctx     github_com_cosmos_cosmos_sdk_types.Context
+	Granter github_com_cosmos_cosmos_sdk_types.AccAddress
+	Grantee github_com_cosmos_cosmos_sdk_types.AccAddress
+} {
+	var calls []struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		Granter github_com_cosmos_cosmos_sdk_types.AccAddress
+		Grantee github_com_cosmos_cosmos_sdk_types.AccAddress
+	}
+	mock.lockGetAllowance.RLock()
+	calls = mock.calls.GetAllowance
+	mock.lockGetAllowance.RUnlock()
+	return calls
+}
+
+// GrantAllowance calls GrantAllowanceFunc.
+func (mock *FeeGrantKeeperMock) GrantAllowance(ctx github_com_cosmos_cosmos_sdk_types.Context, granter github_com_cosmos_cosmos_sdk_types.AccAddress, grantee github_com_cosmos_cosmos_sdk_types.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
+	if mock.GrantAllowanceFunc == nil {
+		panic("FeeGrantKeeperMock.GrantAllowanceFunc: method is nil but FeeGrantKeeper.GrantAllowance was just called")
+	}
+	callInfo := struct {
+		Ctx          github_com_cosmos_cosmos_sdk_types.Context
+		Granter      github_com_cosmos_cosmos_sdk_types.AccAddress
+		Grantee      github_com_cosmos_cosmos_sdk_types.AccAddress
+		FeeAllowance feegrant.FeeAllowanceI
+	}{
+		Ctx:          ctx,
+		Granter:      granter,
+		Grantee:      grantee,
+		FeeAllowance: feeAllowance,
+	}
+	mock.lockGrantAllowance.Lock()
+	mock.calls.GrantAllowance = append(mock.calls.GrantAllowance, callInfo)
+	mock.lockGrantAllowance.Unlock()
+	return mock.GrantAllowanceFunc(ctx, granter, grantee, feeAllowance)
+}
+
+// GrantAllowanceCalls gets all the calls that were made to GrantAllowance.
+// Check the length with:
+//     len(mockedFeeGrantKeeper.GrantAllowanceCalls())
+func (mock *FeeGrantKeeperMock) GrantAllowanceCalls() []struct {
+	Ctx          github_com_cosmos_cosmos_sdk_types.Context
+	Granter      github_com_cosmos_cosmos_sdk_types.AccAddress
+	Grantee      github_com_cosmos_cosmos_sdk_types.AccAddress
+	FeeAllowance feegrant.FeeAllowanceI
+} {
+	var calls []struct {
+		Ctx          github_com_cosmos_cosmos_sdk_types.Context
+		Granter      github_com_cosmos_cosmos_sdk_types.AccAddress
+		Grantee      github_com_cosmos_cosmos_sdk_types.AccAddress
+		FeeAllowance feegrant.FeeAllowanceI
+	}
+	mock.lockGrantAllowance.RLock()
+	calls = mock.calls.GrantAllowance
+	mock.lockGrantAllowance.RUnlock()
+	return calls
+}
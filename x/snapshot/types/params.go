@@ -11,6 +11,9 @@ import (
 var (
 	// KeyMinProxyBalance is the key for the minimum proxy balance
 	KeyMinProxyBalance = []byte("minproxybalance")
+
+	// KeyProxyRotationWindow is the key for the number of blocks a rotated out proxy stays valid
+	KeyProxyRotationWindow = []byte("proxyrotationwindow")
 )
 
 // KeyTable retrieves a subspace table for the module
@@ -21,7 +24,8 @@ func KeyTable() params.KeyTable {
 // DefaultParams - the module's default parameters
 func DefaultParams() Params {
 	return Params{
-		MinProxyBalance: 5000000,
+		MinProxyBalance:     5000000,
+		ProxyRotationWindow: 100,
 	}
 }
 
@@ -36,6 +40,7 @@ func (m *Params) ParamSetPairs() params.ParamSetPairs {
 	*/
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyMinProxyBalance, &m.MinProxyBalance, validateProxyBalance),
+		params.NewParamSetPair(KeyProxyRotationWindow, &m.ProxyRotationWindow, validateProxyRotationWindow),
 	}
 }
 
@@ -50,11 +55,26 @@ func validateProxyBalance(balance interface{}) error {
 	return nil
 }
 
+func validateProxyRotationWindow(window interface{}) error {
+	value, ok := window.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for proxy rotation window: %T", window)
+	}
+	if value < 0 {
+		return sdkerrors.Wrap(types.ErrInvalidGenesis, "proxy rotation window must not be negative")
+	}
+	return nil
+}
+
 // Validate performs a validation check on the parameters
 func (m Params) Validate() error {
 	if err := validateProxyBalance(m.MinProxyBalance); err != nil {
 		return err
 	}
 
+	if err := validateProxyRotationWindow(m.ProxyRotationWindow); err != nil {
+		return err
+	}
+
 	return nil
 }
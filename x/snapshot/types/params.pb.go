@@ -27,6 +27,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params represent the genesis parameters for the module
 type Params struct {
 	MinProxyBalance int64 `protobuf:"varint,1,opt,name=min_proxy_balance,json=minProxyBalance,proto3" json:"min_proxy_balance,omitempty"`
+	// number of blocks a rotated out proxy stays valid next to its replacement
+	ProxyRotationWindow int64 `protobuf:"varint,2,opt,name=proxy_rotation_window,json=proxyRotationWindow,proto3" json:"proxy_rotation_window,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,21 +71,23 @@ func init() {
 func init() { proto.RegisterFile("snapshot/v1beta1/params.proto", fileDescriptor_3b6745ce21542358) }
 
 var fileDescriptor_3b6745ce21542358 = []byte{
-	// 224 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2d, 0xce, 0x4b, 0x2c,
-	0x28, 0xce, 0xc8, 0x2f, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c,
-	0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x49, 0xeb, 0x41, 0xa5,
-	0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94, 0x5c, 0x7a,
-	0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x3e, 0x98, 0x97, 0x54, 0x9a, 0xa6, 0x9f, 0x52, 0x5a, 0x94, 0x58,
-	0x92, 0x99, 0x9f, 0x07, 0x91, 0x57, 0x32, 0xe1, 0x62, 0x0b, 0x00, 0x9b, 0x2b, 0xa4, 0xc5, 0x25,
-	0x98, 0x9b, 0x99, 0x17, 0x5f, 0x50, 0x94, 0x5f, 0x51, 0x19, 0x9f, 0x94, 0x98, 0x93, 0x98, 0x97,
-	0x9c, 0x2a, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x1c, 0xc4, 0x9f, 0x9b, 0x99, 0x17, 0x00, 0x12, 0x77,
-	0x82, 0x08, 0x3b, 0x05, 0x9f, 0x78, 0x28, 0xc7, 0x70, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0xa6, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x89,
-	0x15, 0xa9, 0x39, 0x89, 0x45, 0x79, 0xa9, 0x25, 0xe5, 0xf9, 0x45, 0xd9, 0x50, 0x9e, 0x6e, 0x72,
-	0x7e, 0x51, 0xaa, 0x7e, 0x85, 0x3e, 0xdc, 0x87, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60,
-	0x17, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x95, 0xc6, 0x44, 0xa8, 0xfa, 0x00, 0x00, 0x00,
+	// 248 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0xd0, 0x41, 0x4a, 0xc4, 0x30,
+	0x14, 0x06, 0xe0, 0x46, 0x61, 0x16, 0xdd, 0xa8, 0x55, 0x61, 0x18, 0x30, 0x88, 0x2b, 0x11, 0x6c,
+	0x18, 0xc5, 0x0b, 0xcc, 0x09, 0x86, 0x71, 0x21, 0xb8, 0x29, 0x2f, 0x9d, 0x98, 0x06, 0xdb, 0xbc,
+	0x90, 0xa6, 0xb6, 0x73, 0x0b, 0x8f, 0x35, 0xcb, 0x59, 0xba, 0xd4, 0xf6, 0x22, 0xd2, 0xa4, 0xba,
+	0xcb, 0x7b, 0xdf, 0xcf, 0x0f, 0x79, 0xf1, 0x55, 0xad, 0xc1, 0xd4, 0x05, 0x3a, 0xf6, 0xb1, 0xe4,
+	0xc2, 0xc1, 0x92, 0x19, 0xb0, 0x50, 0xd5, 0xa9, 0xb1, 0xe8, 0x30, 0x39, 0xfd, 0xe3, 0x74, 0xe2,
+	0xc5, 0x85, 0x44, 0x89, 0x1e, 0xd9, 0xf8, 0x0a, 0xb9, 0x05, 0x95, 0x88, 0xb2, 0x14, 0xcc, 0x4f,
+	0xbc, 0x79, 0x63, 0xdb, 0xc6, 0x82, 0x53, 0xa8, 0x83, 0xdf, 0x14, 0xf1, 0x6c, 0xed, 0x7b, 0x93,
+	0xbb, 0xf8, 0xac, 0x52, 0x3a, 0x33, 0x16, 0xbb, 0x5d, 0xc6, 0xa1, 0x04, 0x9d, 0x8b, 0x39, 0xb9,
+	0x26, 0xb7, 0xc7, 0x9b, 0x93, 0x4a, 0xe9, 0xf5, 0xb8, 0x5f, 0x85, 0x75, 0xf2, 0x10, 0x5f, 0x86,
+	0x9c, 0x45, 0xe7, 0xdb, 0xb2, 0x56, 0xe9, 0x2d, 0xb6, 0xf3, 0x23, 0x9f, 0x3f, 0xf7, 0xb8, 0x99,
+	0xec, 0xc5, 0xd3, 0xea, 0x79, 0xff, 0x43, 0xa3, 0x7d, 0x4f, 0xc9, 0xa1, 0xa7, 0xe4, 0xbb, 0xa7,
+	0xe4, 0x73, 0xa0, 0xd1, 0x61, 0xa0, 0xd1, 0xd7, 0x40, 0xa3, 0xd7, 0x27, 0xa9, 0x5c, 0xd1, 0xf0,
+	0x34, 0xc7, 0x8a, 0x41, 0x27, 0x4a, 0xb0, 0x5a, 0xb8, 0x16, 0xed, 0xfb, 0x34, 0xdd, 0xe7, 0x68,
+	0x05, 0xeb, 0xd8, 0xff, 0x55, 0xdc, 0xce, 0x88, 0x9a, 0xcf, 0xfc, 0x2f, 0x1e, 0x7f, 0x07, 0x00,
+	0x92, 0xf7, 0xaa, 0x81, 0x2e, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -106,6 +110,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProxyRotationWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ProxyRotationWindow))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.MinProxyBalance != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MinProxyBalance))
 		i--
@@ -134,6 +143,9 @@ func (m *Params) Size() (n int) {
 	if m.MinProxyBalance != 0 {
 		n += 1 + sovParams(uint64(m.MinProxyBalance))
 	}
+	if m.ProxyRotationWindow != 0 {
+		n += 1 + sovParams(uint64(m.ProxyRotationWindow))
+	}
 	return n
 }
 
@@ -191,6 +203,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyRotationWindow", wireType)
+			}
+			m.ProxyRotationWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProxyRotationWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -35,27 +35,30 @@ func init() {
 }
 
 var fileDescriptor_f6341aa52566ee00 = []byte{
-	// 320 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xbd, 0x4a, 0x33, 0x41,
-	0x14, 0x86, 0x33, 0x29, 0xbe, 0x62, 0xe1, 0x43, 0x59, 0x6c, 0x0c, 0x32, 0x48, 0xc0, 0xbf, 0x04,
-	0x77, 0x8c, 0xa2, 0x45, 0x4a, 0xb1, 0x15, 0x24, 0xe9, 0x6c, 0x64, 0xb2, 0x39, 0x4c, 0x16, 0xe3,
-	0x9c, 0x71, 0xe6, 0x24, 0x6e, 0x10, 0x1b, 0xaf, 0x40, 0x48, 0xe3, 0xcd, 0x08, 0x96, 0x96, 0x01,
-	0x1b, 0x4b, 0xc9, 0x7a, 0x21, 0x92, 0xec, 0x46, 0x4d, 0xb6, 0xd0, 0x6a, 0x66, 0x78, 0x9f, 0x39,
-	0xef, 0x03, 0xc7, 0xe3, 0x4e, 0x4b, 0xe3, 0x3a, 0x48, 0xa2, 0x5f, 0x6b, 0x01, 0xc9, 0x9a, 0x70,
-	0x60, 0xfb, 0x51, 0x08, 0x81, 0xb1, 0x48, 0xe8, 0x2f, 0xcf, 0xf2, 0x20, 0xcb, 0x4b, 0x2b, 0x0a,
-	0x15, 0x4e, 0x43, 0x31, 0xb9, 0xa5, 0x5c, 0x69, 0x4d, 0x21, 0xaa, 0x2e, 0x08, 0x69, 0x22, 0x21,
-	0xb5, 0x46, 0x92, 0x14, 0xa1, 0x76, 0x59, 0xba, 0x9a, 0x6b, 0xa1, 0x38, 0x8d, 0xf6, 0x9f, 0x8a,
-	0x9e, 0x77, 0xea, 0x54, 0x33, 0x6d, 0xf5, 0x1f, 0x99, 0xf7, 0xbf, 0x01, 0x2a, 0x72, 0x04, 0xf6,
-	0xcc, 0x62, 0x3c, 0xf0, 0x37, 0x83, 0x45, 0x85, 0x60, 0x0e, 0x68, 0xc0, 0x75, 0x0f, 0x1c, 0x95,
-	0xb6, 0x7e, 0xe5, 0x9c, 0x41, 0xed, 0xa0, 0x7c, 0x74, 0xff, 0xfa, 0x31, 0x2c, 0xee, 0x95, 0xab,
-	0x42, 0xc6, 0xd0, 0x95, 0x56, 0x7c, 0xc9, 0xd9, 0x9f, 0xbc, 0xb8, 0x35, 0x93, 0xe3, 0x42, 0xb6,
-	0xdb, 0xf6, 0xae, 0xce, 0x2a, 0xfe, 0x90, 0x79, 0x4b, 0x27, 0x20, 0x43, 0x8a, 0xfa, 0x92, 0x20,
-	0x95, 0xdb, 0xce, 0x97, 0x2e, 0x20, 0x33, 0xbd, 0x9d, 0x3f, 0x90, 0x99, 0x60, 0x75, 0x2a, 0xb8,
-	0x51, 0x5e, 0xcf, 0x09, 0xb6, 0xe7, 0x7f, 0xd4, 0x59, 0xe5, 0xb8, 0xf9, 0x32, 0xe6, 0x6c, 0x34,
-	0xe6, 0xec, 0x7d, 0xcc, 0xd9, 0x43, 0xc2, 0x0b, 0xcf, 0x09, 0x67, 0xa3, 0x84, 0x17, 0xde, 0x12,
-	0x5e, 0x38, 0x3f, 0x54, 0x11, 0x75, 0x7a, 0xad, 0x20, 0xc4, 0xab, 0x6c, 0x98, 0x06, 0xba, 0x41,
-	0x7b, 0x99, 0xbd, 0x76, 0x43, 0xb4, 0x20, 0xe2, 0xef, 0x06, 0x1a, 0x18, 0x70, 0xad, 0x7f, 0xd3,
-	0xdd, 0x1c, 0x7c, 0x06, 0x00, 0x00, 0xff, 0xff, 0xd4, 0xa1, 0x1c, 0xdd, 0x1e, 0x02, 0x00, 0x00,
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x4b, 0xe3, 0x40,
+	0x18, 0xc6, 0x3b, 0xbb, 0xb0, 0x87, 0x2c, 0xcb, 0x2e, 0x61, 0x2f, 0x16, 0x19, 0x24, 0x58, 0xff,
+	0x95, 0x66, 0x6c, 0x45, 0x11, 0x8f, 0xe2, 0x55, 0x90, 0xf6, 0xe6, 0xa5, 0x4c, 0xd3, 0x97, 0x34,
+	0x58, 0xf3, 0xc6, 0x99, 0xb7, 0x6d, 0x4a, 0xf1, 0xe2, 0x27, 0x10, 0xea, 0xc1, 0x8f, 0xe3, 0xd1,
+	0x63, 0x41, 0x0f, 0x1e, 0xa5, 0xf1, 0x83, 0x48, 0x93, 0xd4, 0xda, 0x46, 0xa8, 0xa7, 0x64, 0xe6,
+	0xf9, 0xcd, 0x3c, 0x3f, 0x5e, 0xc6, 0xe0, 0xda, 0x97, 0x81, 0x6e, 0x21, 0x89, 0x6e, 0xb9, 0x01,
+	0x24, 0xcb, 0x42, 0x83, 0xea, 0x7a, 0x0e, 0xd8, 0x81, 0x42, 0x42, 0xf3, 0xdf, 0x34, 0xb7, 0xd3,
+	0x3c, 0xff, 0xdf, 0x45, 0x17, 0xe3, 0x50, 0x4c, 0xfe, 0x12, 0x2e, 0xbf, 0xea, 0x22, 0xba, 0x6d,
+	0x10, 0x32, 0xf0, 0x84, 0xf4, 0x7d, 0x24, 0x49, 0x1e, 0xfa, 0x3a, 0x4d, 0x57, 0x32, 0x2d, 0x14,
+	0x26, 0x51, 0xe5, 0xf9, 0xa7, 0x61, 0x9c, 0x6a, 0xb7, 0x96, 0xb4, 0x9a, 0xf7, 0xcc, 0xf8, 0x53,
+	0x05, 0xd7, 0xd3, 0x04, 0xea, 0x4c, 0x61, 0xd8, 0x37, 0x37, 0xec, 0x45, 0x05, 0x7b, 0x0e, 0xa8,
+	0xc2, 0x55, 0x07, 0x34, 0xe5, 0x37, 0x97, 0x72, 0x3a, 0x40, 0x5f, 0x83, 0x75, 0x70, 0xf3, 0xf4,
+	0x36, 0xfc, 0xb1, 0x6b, 0x15, 0x85, 0x0c, 0xa1, 0x2d, 0x95, 0xf8, 0x90, 0x53, 0x9f, 0x79, 0x31,
+	0x08, 0x26, 0x9f, 0xba, 0x6c, 0x36, 0xd5, 0xf5, 0x11, 0xdb, 0x31, 0x87, 0xcc, 0xf8, 0x7b, 0x02,
+	0xd2, 0x21, 0xaf, 0x2b, 0x09, 0x12, 0xb9, 0xad, 0x6c, 0xe9, 0x02, 0x32, 0xd5, 0xdb, 0xfe, 0x06,
+	0x99, 0x0a, 0x16, 0x63, 0xc1, 0x82, 0xb5, 0x96, 0x11, 0x6c, 0xce, 0x9f, 0x98, 0x58, 0xdd, 0x31,
+	0xe3, 0x77, 0x15, 0x69, 0xba, 0x65, 0xae, 0x7f, 0x31, 0x06, 0xa4, 0x45, 0x9b, 0xc2, 0x12, 0x2a,
+	0x35, 0x39, 0x8c, 0x4d, 0x2a, 0x56, 0x29, 0x3b, 0xaa, 0x19, 0x2d, 0x06, 0x3e, 0xf4, 0xea, 0xf3,
+	0xc3, 0x3a, 0xae, 0x3d, 0x8e, 0x39, 0x1b, 0x8d, 0x39, 0x7b, 0x1d, 0x73, 0x76, 0x1b, 0xf1, 0xdc,
+	0x43, 0xc4, 0xd9, 0x28, 0xe2, 0xb9, 0x97, 0x88, 0xe7, 0xce, 0xf7, 0x5d, 0x8f, 0x5a, 0x9d, 0x86,
+	0xed, 0xe0, 0x65, 0x7a, 0xb3, 0x0f, 0xd4, 0x43, 0x75, 0x91, 0xae, 0x4a, 0x0e, 0x2a, 0x10, 0xe1,
+	0xac, 0x8e, 0xfa, 0x01, 0xe8, 0xc6, 0xaf, 0xf8, 0xc9, 0xec, 0xbd, 0x0f, 0x00, 0xe3, 0xf3, 0xcb,
+	0xec, 0xb5, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -75,6 +78,9 @@ type MsgServiceClient interface {
 	RegisterProxy(ctx context.Context, in *RegisterProxyRequest, opts ...grpc.CallOption) (*RegisterProxyResponse, error)
 	// DeactivateProxy defines a method for deregistering a proxy account.
 	DeactivateProxy(ctx context.Context, in *DeactivateProxyRequest, opts ...grpc.CallOption) (*DeactivateProxyResponse, error)
+	// RotateProxy defines a method for replacing a proxy account of a validator
+	// by a new one, keeping the old one valid during an overlap window
+	RotateProxy(ctx context.Context, in *RotateProxyRequest, opts ...grpc.CallOption) (*RotateProxyResponse, error)
 }
 
 type msgServiceClient struct {
@@ -103,6 +109,15 @@ func (c *msgServiceClient) DeactivateProxy(ctx context.Context, in *DeactivatePr
 	return out, nil
 }
 
+func (c *msgServiceClient) RotateProxy(ctx context.Context, in *RotateProxyRequest, opts ...grpc.CallOption) (*RotateProxyResponse, error) {
+	out := new(RotateProxyResponse)
+	err := c.cc.Invoke(ctx, "/snapshot.v1beta1.MsgService/RotateProxy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 type MsgServiceServer interface {
 	// RegisterProxy defines a method for registering a proxy account that can act
@@ -110,6 +125,9 @@ type MsgServiceServer interface {
 	RegisterProxy(context.Context, *RegisterProxyRequest) (*RegisterProxyResponse, error)
 	// DeactivateProxy defines a method for deregistering a proxy account.
 	DeactivateProxy(context.Context, *DeactivateProxyRequest) (*DeactivateProxyResponse, error)
+	// RotateProxy defines a method for replacing a proxy account of a validator
+	// by a new one, keeping the old one valid during an overlap window
+	RotateProxy(context.Context, *RotateProxyRequest) (*RotateProxyResponse, error)
 }
 
 // UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
@@ -122,6 +140,9 @@ func (*UnimplementedMsgServiceServer) RegisterProxy(ctx context.Context, req *Re
 func (*UnimplementedMsgServiceServer) DeactivateProxy(ctx context.Context, req *DeactivateProxyRequest) (*DeactivateProxyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeactivateProxy not implemented")
 }
+func (*UnimplementedMsgServiceServer) RotateProxy(ctx context.Context, req *RotateProxyRequest) (*RotateProxyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateProxy not implemented")
+}
 
 func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
 	s.RegisterService(&_MsgService_serviceDesc, srv)
@@ -163,6 +184,24 @@ func _MsgService_DeactivateProxy_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_RotateProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateProxyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RotateProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snapshot.v1beta1.MsgService/RotateProxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RotateProxy(ctx, req.(*RotateProxyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MsgService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "snapshot.v1beta1.MsgService",
 	HandlerType: (*MsgServiceServer)(nil),
@@ -175,6 +214,10 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeactivateProxy",
 			Handler:    _MsgService_DeactivateProxy_Handler,
 		},
+		{
+			MethodName: "RotateProxy",
+			Handler:    _MsgService_RotateProxy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "snapshot/v1beta1/service.proto",
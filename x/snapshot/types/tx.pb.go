@@ -5,7 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	exported "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -28,6 +30,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type RegisterProxyRequest struct {
 	Sender    github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"sender,omitempty"`
 	ProxyAddr github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=proxy_addr,json=proxyAddr,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proxy_addr,omitempty"`
+	Role      exported.ProxyRole                            `protobuf:"varint,3,opt,name=role,proto3,enum=snapshot.exported.v1beta1.ProxyRole" json:"role,omitempty"`
+	// optional fee allowance the operator grants to the proxy
+	FeeAllowance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=fee_allowance,json=feeAllowance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_allowance"`
 }
 
 func (m *RegisterProxyRequest) Reset()         { *m = RegisterProxyRequest{} }
@@ -101,6 +106,7 @@ var xxx_messageInfo_RegisterProxyResponse proto.InternalMessageInfo
 
 type DeactivateProxyRequest struct {
 	Sender github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"sender,omitempty"`
+	Role   exported.ProxyRole                            `protobuf:"varint,2,opt,name=role,proto3,enum=snapshot.exported.v1beta1.ProxyRole" json:"role,omitempty"`
 }
 
 func (m *DeactivateProxyRequest) Reset()         { *m = DeactivateProxyRequest{} }
@@ -172,37 +178,124 @@ func (m *DeactivateProxyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeactivateProxyResponse proto.InternalMessageInfo
 
+type RotateProxyRequest struct {
+	Sender       github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"sender,omitempty"`
+	OldProxyAddr github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=old_proxy_addr,json=oldProxyAddr,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"old_proxy_addr,omitempty"`
+	NewProxyAddr github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=new_proxy_addr,json=newProxyAddr,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"new_proxy_addr,omitempty"`
+}
+
+func (m *RotateProxyRequest) Reset()         { *m = RotateProxyRequest{} }
+func (m *RotateProxyRequest) String() string { return proto.CompactTextString(m) }
+func (*RotateProxyRequest) ProtoMessage()    {}
+func (*RotateProxyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4923e082b209674, []int{4}
+}
+func (m *RotateProxyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotateProxyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotateProxyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RotateProxyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateProxyRequest.Merge(m, src)
+}
+func (m *RotateProxyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateProxyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateProxyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateProxyRequest proto.InternalMessageInfo
+
+type RotateProxyResponse struct {
+}
+
+func (m *RotateProxyResponse) Reset()         { *m = RotateProxyResponse{} }
+func (m *RotateProxyResponse) String() string { return proto.CompactTextString(m) }
+func (*RotateProxyResponse) ProtoMessage()    {}
+func (*RotateProxyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4923e082b209674, []int{5}
+}
+func (m *RotateProxyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotateProxyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotateProxyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RotateProxyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateProxyResponse.Merge(m, src)
+}
+func (m *RotateProxyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateProxyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateProxyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateProxyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*RegisterProxyRequest)(nil), "snapshot.v1beta1.RegisterProxyRequest")
 	proto.RegisterType((*RegisterProxyResponse)(nil), "snapshot.v1beta1.RegisterProxyResponse")
 	proto.RegisterType((*DeactivateProxyRequest)(nil), "snapshot.v1beta1.DeactivateProxyRequest")
 	proto.RegisterType((*DeactivateProxyResponse)(nil), "snapshot.v1beta1.DeactivateProxyResponse")
+	proto.RegisterType((*RotateProxyRequest)(nil), "snapshot.v1beta1.RotateProxyRequest")
+	proto.RegisterType((*RotateProxyResponse)(nil), "snapshot.v1beta1.RotateProxyResponse")
 }
 
 func init() { proto.RegisterFile("snapshot/v1beta1/tx.proto", fileDescriptor_a4923e082b209674) }
 
 var fileDescriptor_a4923e082b209674 = []byte{
-	// 311 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x91, 0xbf, 0x4a, 0x03, 0x41,
-	0x10, 0xc6, 0xef, 0x2c, 0x02, 0x2e, 0x16, 0x12, 0xa2, 0xf9, 0x83, 0xac, 0x72, 0x95, 0x4d, 0x6e,
-	0x09, 0xe2, 0x03, 0x24, 0xd8, 0xd8, 0x85, 0x08, 0x16, 0x36, 0xb2, 0xd9, 0x1b, 0x2e, 0x47, 0x92,
-	0x9d, 0x73, 0x67, 0x12, 0x2f, 0x6f, 0xe1, 0xbb, 0xf8, 0x12, 0x29, 0x53, 0x5a, 0x89, 0x26, 0x6f,
-	0x61, 0x25, 0xb9, 0xbb, 0x88, 0x68, 0x65, 0x61, 0xb5, 0x3b, 0xfc, 0x96, 0xdf, 0x37, 0xcb, 0x27,
-	0x9a, 0x64, 0x75, 0x4a, 0x23, 0x64, 0x35, 0xef, 0x0c, 0x81, 0x75, 0x47, 0x71, 0x16, 0xa6, 0x0e,
-	0x19, 0xab, 0x87, 0x3b, 0x14, 0x96, 0xa8, 0x55, 0x8b, 0x31, 0xc6, 0x1c, 0xaa, 0xed, 0xad, 0x78,
-	0xd7, 0x3a, 0x89, 0x11, 0xe3, 0x09, 0x28, 0x9d, 0x26, 0x4a, 0x5b, 0x8b, 0xac, 0x39, 0x41, 0x4b,
-	0x05, 0x0d, 0x9e, 0x7d, 0x51, 0x1b, 0x40, 0x9c, 0x10, 0x83, 0xeb, 0x3b, 0xcc, 0x16, 0x03, 0x78,
-	0x98, 0x01, 0x71, 0xf5, 0x5a, 0x54, 0x08, 0x6c, 0x04, 0xae, 0xe1, 0x9f, 0xf9, 0xe7, 0x07, 0xbd,
-	0xce, 0xc7, 0xeb, 0x69, 0x3b, 0x4e, 0x78, 0x34, 0x1b, 0x86, 0x06, 0xa7, 0xca, 0x20, 0x4d, 0x91,
-	0xca, 0xa3, 0x4d, 0xd1, 0x58, 0xf1, 0x22, 0x05, 0x0a, 0x6f, 0xf5, 0xa4, 0x1b, 0x45, 0x0e, 0x88,
-	0x06, 0xa5, 0xa0, 0xda, 0x17, 0x22, 0xdd, 0xaa, 0xef, 0x75, 0x14, 0xb9, 0xc6, 0xde, 0x9f, 0x74,
-	0x5d, 0x63, 0x76, 0xba, 0xfd, 0x5c, 0xb2, 0x9d, 0x82, 0xba, 0x38, 0xfa, 0xb1, 0x34, 0xa5, 0x68,
-	0x09, 0x02, 0x23, 0x8e, 0xaf, 0x40, 0x1b, 0x4e, 0xe6, 0x9a, 0xe1, 0x9f, 0xfe, 0x13, 0x34, 0x45,
-	0xfd, 0x57, 0x48, 0x91, 0xdf, 0xbb, 0x59, 0xbe, 0x4b, 0x6f, 0xb9, 0x96, 0xfe, 0x6a, 0x2d, 0xfd,
-	0xb7, 0xb5, 0xf4, 0x9f, 0x36, 0xd2, 0x5b, 0x6d, 0xa4, 0xf7, 0xb2, 0x91, 0xde, 0xdd, 0xe5, 0xb7,
-	0x3c, 0x9d, 0xc1, 0x44, 0x3b, 0x0b, 0xfc, 0x88, 0x6e, 0x5c, 0x4e, 0x6d, 0x83, 0x0e, 0x54, 0xa6,
-	0xbe, 0x4a, 0xcf, 0x57, 0x18, 0x56, 0xf2, 0xaa, 0x2e, 0x3e, 0x03, 0x00, 0x00, 0xff, 0xff, 0x16,
-	0x7b, 0x3c, 0x5e, 0x0d, 0x02, 0x00, 0x00,
+	// 477 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x93, 0x76, 0x9a, 0x84, 0x29, 0x15, 0x0a, 0x1b, 0x6b, 0x27, 0x94, 0x56, 0x11, 0x48,
+	0xbd, 0x34, 0xa1, 0x43, 0x48, 0x5c, 0x5b, 0xb8, 0x70, 0xab, 0x82, 0xc4, 0x24, 0x2e, 0x95, 0x1b,
+	0x7f, 0xcb, 0xa2, 0x79, 0xfe, 0x82, 0xed, 0xad, 0xd9, 0x5b, 0xc0, 0x03, 0xf0, 0x02, 0x3c, 0x49,
+	0x8f, 0x3b, 0x72, 0xda, 0xa0, 0x7d, 0x0b, 0x4e, 0x28, 0x89, 0x93, 0x0d, 0xb8, 0x30, 0xb4, 0x9d,
+	0x12, 0xfb, 0xef, 0xef, 0xf7, 0xfd, 0xfd, 0x97, 0x3f, 0xd2, 0x55, 0x82, 0xa6, 0xea, 0x10, 0x75,
+	0x70, 0x3a, 0x9a, 0x83, 0xa6, 0xa3, 0x40, 0x67, 0x7e, 0x2a, 0x51, 0xa3, 0xf3, 0xb0, 0x92, 0x7c,
+	0x23, 0xed, 0x6e, 0xc5, 0x18, 0x63, 0x21, 0x06, 0xf9, 0x5f, 0x79, 0x6e, 0xd7, 0x8d, 0x50, 0x1d,
+	0xa3, 0x0a, 0xe6, 0x54, 0x41, 0x4d, 0x89, 0x30, 0x11, 0x46, 0x7f, 0x12, 0x23, 0xc6, 0x1c, 0x02,
+	0x9a, 0x26, 0x01, 0x15, 0x02, 0x35, 0xd5, 0x09, 0x0a, 0x65, 0xd4, 0x67, 0xb5, 0x01, 0xc8, 0x52,
+	0x94, 0x1a, 0xd8, 0x95, 0x93, 0xb3, 0x14, 0xcc, 0x31, 0xef, 0xb2, 0x41, 0xb6, 0x42, 0x88, 0x13,
+	0xa5, 0x41, 0x4e, 0x25, 0x66, 0x67, 0x21, 0x7c, 0x3c, 0x01, 0xa5, 0x9d, 0xb7, 0x64, 0x53, 0x81,
+	0x60, 0x20, 0x3b, 0x76, 0xdf, 0x1e, 0xb4, 0x26, 0xa3, 0x9f, 0x17, 0xbd, 0x61, 0x9c, 0xe8, 0xc3,
+	0x93, 0xb9, 0x1f, 0xe1, 0x71, 0x60, 0xcc, 0x95, 0x9f, 0xa1, 0x62, 0x47, 0x06, 0xfb, 0x9e, 0xf2,
+	0x31, 0x63, 0x12, 0x94, 0x0a, 0x0d, 0xc0, 0x99, 0x12, 0x92, 0xe6, 0xe8, 0x19, 0x65, 0x4c, 0x76,
+	0x1a, 0x37, 0xc2, 0x8d, 0xa3, 0xa8, 0xc2, 0xdd, 0x2b, 0x20, 0xf9, 0xca, 0x79, 0x45, 0x36, 0x24,
+	0x72, 0xe8, 0x34, 0xfb, 0xf6, 0xa0, 0xbd, 0xf7, 0xd4, 0xaf, 0x13, 0xad, 0xee, 0x5a, 0x45, 0xeb,
+	0x97, 0x77, 0x42, 0x0e, 0x61, 0x51, 0xe1, 0xa4, 0xe4, 0xc1, 0x01, 0xc0, 0x8c, 0x72, 0x8e, 0x0b,
+	0x2a, 0x22, 0xe8, 0x6c, 0xf4, 0x9b, 0x83, 0xfb, 0x7b, 0x5d, 0xbf, 0xec, 0xec, 0xe7, 0x61, 0xd7,
+	0xc5, 0xaf, 0x31, 0x11, 0x93, 0xe7, 0xcb, 0x8b, 0x9e, 0xf5, 0xf5, 0xb2, 0x37, 0xf8, 0x07, 0xb7,
+	0x79, 0x81, 0x0a, 0x5b, 0x07, 0x00, 0xe3, 0xaa, 0x81, 0xb7, 0x43, 0xb6, 0xff, 0x08, 0x58, 0xa5,
+	0x28, 0x14, 0x78, 0x5f, 0x6c, 0xf2, 0xf8, 0x0d, 0xd0, 0x48, 0x27, 0xa7, 0x54, 0xc3, 0x5d, 0x85,
+	0x5f, 0x45, 0xd5, 0xb8, 0x69, 0x54, 0x5e, 0x97, 0xec, 0xfc, 0x65, 0xcf, 0x58, 0xff, 0xdc, 0x20,
+	0x4e, 0x88, 0xfa, 0x6a, 0xff, 0xd6, 0x6d, 0xef, 0x93, 0x36, 0x72, 0x36, 0xbb, 0x8d, 0x77, 0xd3,
+	0x42, 0xce, 0xa6, 0xf5, 0xd3, 0xd9, 0x27, 0x6d, 0x01, 0x8b, 0xeb, 0xe0, 0xe6, 0x7f, 0x83, 0x05,
+	0x2c, 0x6a, 0xb0, 0xb7, 0x4d, 0x1e, 0xfd, 0x16, 0x49, 0x19, 0xd5, 0xe4, 0xdd, 0xf2, 0x87, 0x6b,
+	0x2d, 0x57, 0xae, 0x7d, 0xbe, 0x72, 0xed, 0xef, 0x2b, 0xd7, 0xfe, 0xb4, 0x76, 0xad, 0xf3, 0xb5,
+	0x6b, 0x7d, 0x5b, 0xbb, 0xd6, 0x87, 0x97, 0xd7, 0x3a, 0xd2, 0x0c, 0x38, 0x95, 0x02, 0xf4, 0x02,
+	0xe5, 0x91, 0x59, 0x0d, 0x23, 0x94, 0x10, 0x64, 0x41, 0x3d, 0xcc, 0x85, 0x89, 0xf9, 0x66, 0x31,
+	0xbc, 0x2f, 0x7e, 0x0d, 0x00, 0x1f, 0xc8, 0x69, 0x45, 0x66, 0x04, 0x00, 0x00,
 }
 
 func (m *RegisterProxyRequest) Marshal() (dAtA []byte, err error) {
@@ -225,6 +318,25 @@ func (m *RegisterProxyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeAllowance) > 0 {
+		for iNdEx := len(m.FeeAllowance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeAllowance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.ProxyAddr) > 0 {
 		i -= len(m.ProxyAddr)
 		copy(dAtA[i:], m.ProxyAddr)
@@ -285,6 +397,11 @@ func (m *DeactivateProxyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -318,6 +435,73 @@ func (m *DeactivateProxyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *RotateProxyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateProxyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotateProxyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewProxyAddr) > 0 {
+		i -= len(m.NewProxyAddr)
+		copy(dAtA[i:], m.NewProxyAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewProxyAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldProxyAddr) > 0 {
+		i -= len(m.OldProxyAddr)
+		copy(dAtA[i:], m.OldProxyAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OldProxyAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RotateProxyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateProxyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotateProxyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -343,6 +527,15 @@ func (m *RegisterProxyRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	if len(m.FeeAllowance) > 0 {
+		for _, e := range m.FeeAllowance {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -365,6 +558,9 @@ func (m *DeactivateProxyRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
 	return n
 }
 
@@ -377,6 +573,36 @@ func (m *DeactivateProxyResponse) Size() (n int) {
 	return n
 }
 
+func (m *RotateProxyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OldProxyAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewProxyAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RotateProxyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -480,6 +706,59 @@ func (m *RegisterProxyRequest) Unmarshal(dAtA []byte) error {
 				m.ProxyAddr = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= exported.ProxyRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeAllowance = append(m.FeeAllowance, types.Coin{})
+			if err := m.FeeAllowance[len(m.FeeAllowance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -614,6 +893,25 @@ func (m *DeactivateProxyRequest) Unmarshal(dAtA []byte) error {
 				m.Sender = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= exported.ProxyRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -685,6 +983,208 @@ func (m *DeactivateProxyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RotateProxyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotateProxyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotateProxyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldProxyAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldProxyAddr = append(m.OldProxyAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldProxyAddr == nil {
+				m.OldProxyAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewProxyAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewProxyAddr = append(m.NewProxyAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewProxyAddr == nil {
+				m.NewProxyAddr = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RotateProxyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotateProxyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotateProxyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,17 +5,25 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 )
 
 // NewProxiedValidator is the constructor of ProxiedValidator
-func NewProxiedValidator(validator sdk.ValAddress, proxy sdk.AccAddress, active bool) ProxiedValidator {
+func NewProxiedValidator(validator sdk.ValAddress, proxy sdk.AccAddress, active bool, role exported.ProxyRole) ProxiedValidator {
 	return ProxiedValidator{
 		Validator: validator,
 		Proxy:     proxy,
 		Active:    active,
+		Role:      role,
 	}
 }
 
+// IsExpired returns true if the proxy has been rotated out and its overlap window has passed at the given height
+func (m ProxiedValidator) IsExpired(height int64) bool {
+	return m.ExpiresAt != 0 && height >= m.ExpiresAt
+}
+
 // Validate returns an error if the validator proxy is not valid; nil otherwise
 func (m ProxiedValidator) Validate() error {
 	if err := sdk.VerifyAddressFormat(m.Validator); err != nil {
@@ -30,5 +38,13 @@ func (m ProxiedValidator) Validate() error {
 		return fmt.Errorf("validator cannot be the same as proxy")
 	}
 
+	if err := m.Role.Validate(); err != nil {
+		return err
+	}
+
+	if m.ExpiresAt < 0 {
+		return fmt.Errorf("expiry height must not be negative")
+	}
+
 	return nil
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	exported "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -29,6 +30,10 @@ type ProxiedValidator struct {
 	Validator github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=validator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator,omitempty"`
 	Proxy     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=proxy,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proxy,omitempty"`
 	Active    bool                                          `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	Role      exported.ProxyRole                            `protobuf:"varint,4,opt,name=role,proto3,enum=snapshot.exported.v1beta1.ProxyRole" json:"role,omitempty"`
+	// block height from which a rotated out proxy can no longer act for its
+	// validator, 0 if the proxy is current
+	ExpiresAt int64 `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (m *ProxiedValidator) Reset()         { *m = ProxiedValidator{} }
@@ -71,25 +76,29 @@ func init() {
 func init() { proto.RegisterFile("snapshot/v1beta1/types.proto", fileDescriptor_299d0fdbe3a31474) }
 
 var fileDescriptor_299d0fdbe3a31474 = []byte{
-	// 282 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x63, 0x10, 0x15, 0x44, 0x0c, 0x55, 0x84, 0x50, 0x55, 0x55, 0xa6, 0x62, 0xea, 0x92,
-	0x58, 0x11, 0xe2, 0x01, 0xda, 0x85, 0x11, 0x54, 0xa4, 0x0e, 0x6c, 0x17, 0xc7, 0x4a, 0xad, 0xa6,
-	0x39, 0xcb, 0x36, 0x21, 0x7d, 0x0b, 0x1e, 0x8a, 0xa1, 0x63, 0x47, 0x26, 0x04, 0xc9, 0x5b, 0x30,
-	0x21, 0x52, 0x07, 0x58, 0x99, 0xec, 0x5f, 0xff, 0xdd, 0xf7, 0x9f, 0x7e, 0x7f, 0x64, 0x0a, 0x50,
-	0x66, 0x89, 0x96, 0x95, 0x71, 0x22, 0x2c, 0xc4, 0xcc, 0x6e, 0x94, 0x30, 0x91, 0xd2, 0x68, 0x31,
-	0xe8, 0x77, 0x6e, 0xe4, 0xdc, 0xe1, 0x59, 0x86, 0x19, 0xb6, 0x26, 0xfb, 0xfe, 0xed, 0xe7, 0x86,
-	0xa3, 0x0c, 0x31, 0xcb, 0x05, 0x03, 0x25, 0x19, 0x14, 0x05, 0x5a, 0xb0, 0x12, 0x0b, 0x47, 0xb9,
-	0x7c, 0x21, 0x7e, 0xff, 0x4e, 0x63, 0x25, 0x45, 0xba, 0x80, 0x5c, 0xa6, 0x60, 0x51, 0x07, 0xb7,
-	0xfe, 0x49, 0xd9, 0x89, 0x01, 0x19, 0x93, 0xc9, 0xe9, 0x2c, 0xfe, 0x7c, 0xbb, 0x08, 0x33, 0x69,
-	0x97, 0x8f, 0x49, 0xc4, 0x71, 0xcd, 0x38, 0x9a, 0x35, 0x1a, 0xf7, 0x84, 0x26, 0x5d, 0xb9, 0xdb,
-	0x16, 0x90, 0x4f, 0xd3, 0x54, 0x0b, 0x63, 0xe6, 0xbf, 0x8c, 0xe0, 0xc6, 0x3f, 0x52, 0x1a, 0xab,
-	0xcd, 0xe0, 0xe0, 0x5f, 0xb0, 0x29, 0xe7, 0x1d, 0x6c, 0xbf, 0x1f, 0x9c, 0xfb, 0x3d, 0xe0, 0x56,
-	0x96, 0x62, 0x70, 0x38, 0x26, 0x93, 0xe3, 0xb9, 0x53, 0xb3, 0xfb, 0xed, 0x07, 0xf5, 0xb6, 0x35,
-	0x25, 0xbb, 0x9a, 0x92, 0xf7, 0x9a, 0x92, 0xe7, 0x86, 0x7a, 0xbb, 0x86, 0x7a, 0xaf, 0x0d, 0xf5,
-	0x1e, 0xae, 0xff, 0x64, 0x41, 0x25, 0x72, 0xd0, 0x85, 0xb0, 0x4f, 0xa8, 0x57, 0x4e, 0x85, 0x1c,
-	0xb5, 0x60, 0x15, 0xfb, 0xe9, 0xbb, 0x8d, 0x4f, 0x7a, 0x6d, 0x45, 0x57, 0x5f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x3a, 0x16, 0xec, 0x2b, 0x88, 0x01, 0x00, 0x00,
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xcd, 0x4a, 0xc3, 0x40,
+	0x14, 0x85, 0x33, 0xfd, 0xc3, 0x0e, 0x22, 0x25, 0x88, 0x84, 0x52, 0x63, 0x10, 0x85, 0x6c, 0x9a,
+	0xa1, 0x8a, 0xe0, 0xb6, 0xdd, 0xb8, 0x54, 0x22, 0x74, 0xe1, 0x46, 0xa6, 0xc9, 0x25, 0x0d, 0x4d,
+	0x73, 0xc3, 0xcc, 0x58, 0xd3, 0xb7, 0xf0, 0x05, 0x7c, 0x9f, 0x2e, 0xbb, 0x74, 0x25, 0xda, 0xbe,
+	0x85, 0x2b, 0x69, 0x3a, 0xa9, 0x2e, 0xdc, 0xb8, 0x4a, 0xce, 0x9c, 0xb9, 0xdf, 0xe5, 0x9c, 0xa1,
+	0x1d, 0x99, 0xf2, 0x4c, 0x8e, 0x51, 0xb1, 0x59, 0x6f, 0x04, 0x8a, 0xf7, 0x98, 0x9a, 0x67, 0x20,
+	0xbd, 0x4c, 0xa0, 0x42, 0xb3, 0x55, 0xba, 0x9e, 0x76, 0xdb, 0x87, 0x11, 0x46, 0x58, 0x98, 0x6c,
+	0xf3, 0xb7, 0xbd, 0xd7, 0xee, 0x44, 0x88, 0x51, 0x02, 0x8c, 0x67, 0x31, 0xe3, 0x69, 0x8a, 0x8a,
+	0xab, 0x18, 0x53, 0x4d, 0x69, 0x9f, 0xef, 0x76, 0x40, 0x9e, 0xa1, 0x50, 0x10, 0xfe, 0xb5, 0xec,
+	0xf4, 0xb5, 0x42, 0x5b, 0x77, 0x02, 0xf3, 0x18, 0xc2, 0x21, 0x4f, 0xe2, 0x90, 0x2b, 0x14, 0xe6,
+	0x2d, 0x6d, 0xce, 0x4a, 0x61, 0x11, 0x87, 0xb8, 0xfb, 0x83, 0xde, 0xd7, 0xfb, 0x49, 0x37, 0x8a,
+	0xd5, 0xf8, 0x69, 0xe4, 0x05, 0x38, 0x65, 0x01, 0xca, 0x29, 0x4a, 0xfd, 0xe9, 0xca, 0x70, 0xa2,
+	0xa9, 0x43, 0x9e, 0xf4, 0xc3, 0x50, 0x80, 0x94, 0xfe, 0x0f, 0xc3, 0xbc, 0xa1, 0xf5, 0x4c, 0x60,
+	0x3e, 0xb7, 0x2a, 0xff, 0x82, 0xf5, 0x83, 0xa0, 0x84, 0x6d, 0xe7, 0xcd, 0x23, 0xda, 0xe0, 0x81,
+	0x8a, 0x67, 0x60, 0x55, 0x1d, 0xe2, 0xee, 0xf9, 0x5a, 0x99, 0xd7, 0xb4, 0x26, 0x30, 0x01, 0xab,
+	0xe6, 0x10, 0xf7, 0xe0, 0xe2, 0xcc, 0xdb, 0x55, 0x58, 0x86, 0x2f, 0xbb, 0xf4, 0x36, 0x61, 0xe7,
+	0x3e, 0x26, 0xe0, 0x17, 0x13, 0xe6, 0x31, 0xa5, 0x90, 0x67, 0xb1, 0x00, 0xf9, 0xc8, 0x95, 0x55,
+	0x77, 0x88, 0x5b, 0xf5, 0x9b, 0xfa, 0xa4, 0xaf, 0x06, 0xf7, 0x8b, 0x4f, 0xdb, 0x58, 0xac, 0x6c,
+	0xb2, 0x5c, 0xd9, 0xe4, 0x63, 0x65, 0x93, 0x97, 0xb5, 0x6d, 0x2c, 0xd7, 0xb6, 0xf1, 0xb6, 0xb6,
+	0x8d, 0x87, 0xab, 0x5f, 0x21, 0x78, 0x0e, 0x09, 0x17, 0x29, 0xa8, 0x67, 0x14, 0x13, 0xad, 0xba,
+	0x01, 0x0a, 0x60, 0x39, 0xdb, 0xbd, 0x45, 0x91, 0x6b, 0xd4, 0x28, 0xba, 0xbf, 0xfc, 0x1e, 0x00,
+	0x48, 0x0a, 0xad, 0x05, 0x08, 0x02, 0x00, 0x00,
 }
 
 func (m *ProxiedValidator) Marshal() (dAtA []byte, err error) {
@@ -112,6 +121,16 @@ func (m *ProxiedValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Role != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Active {
 		i--
 		if m.Active {
@@ -167,6 +186,12 @@ func (m *ProxiedValidator) Size() (n int) {
 	if m.Active {
 		n += 2
 	}
+	if m.Role != 0 {
+		n += 1 + sovTypes(uint64(m.Role))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiresAt))
+	}
 	return n
 }
 
@@ -293,6 +318,44 @@ func (m *ProxiedValidator) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Active = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= exported.ProxyRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
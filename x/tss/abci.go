@@ -3,6 +3,7 @@ package tss
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -12,6 +13,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/axelarnetwork/axelar-core/utils"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 	"github.com/axelarnetwork/axelar-core/x/tss/exported"
 	"github.com/axelarnetwork/axelar-core/x/tss/keeper"
@@ -24,11 +26,14 @@ import (
 func BeginBlocker(_ sdk.Context, _ abci.RequestBeginBlock, _ keeper.Keeper) {}
 
 // EndBlocker called every block, process inflation, update validator set.
-func EndBlocker(ctx sdk.Context, req abci.RequestEndBlock, keeper keeper.Keeper, voter types.Voter, nexus types.Nexus, snapshotter types.Snapshotter) []abci.ValidatorUpdate {
+func EndBlocker(ctx sdk.Context, req abci.RequestEndBlock, keeper keeper.Keeper, voter types.Voter, nexus types.Nexus, snapshotter types.Snapshotter, staker types.StakingKeeper) []abci.ValidatorUpdate {
 	emitHeartbeatEvent(ctx, keeper, nexus)
+	scheduleKeyRotations(ctx, keeper, voter, nexus, snapshotter, staker)
 	sequentialSign(ctx, keeper.GetSignQueue(ctx), keeper, snapshotter, voter)
 	timeoutMultisigKeygen(ctx, keeper.GetMultisigKeygenQueue(ctx), keeper, snapshotter)
-	handleMultisigSigns(ctx, keeper.GetMultisigSignQueue(ctx), keeper)
+	timeoutReshares(ctx, keeper)
+	handleMultisigSigns(ctx, keeper.GetMultisigSignQueue(ctx), keeper, snapshotter, voter)
+	keeper.PruneStaleTrafficHashes(ctx)
 
 	return nil
 }
@@ -65,44 +70,62 @@ func emitHeartbeatEvent(ctx sdk.Context, keeper keeper.Keeper, nexus types.Nexus
 
 }
 
-// sequentialSign limits tss sign within max signing shares
+// sequentialSign limits tss sign within max signing shares and starts queued signs in the order decided by the sign scheduler
 func sequentialSign(ctx sdk.Context, signQueue utils.SequenceKVQueue, k types.TSSKeeper, s types.Snapshotter, voter types.Voter) {
-	i := uint64(0)
 	signShares := int64(0)
-	var signInfo exported.SignInfo
+	scheduler := types.NewSignScheduler(k.GetSignQueueWeights(ctx))
 
 	defer func() {
 		ctx.Logger().Debug(fmt.Sprintf("%d active sign shares, %d signatures in queue", signShares, signQueue.Size()))
 	}()
 
-	maxSignShares := k.GetMaxSimultaneousSignShares(ctx)
-	for signShares < maxSignShares && signQueue.Peek(i, &signInfo) {
+	for i := uint64(0); ; {
+		var signInfo exported.SignInfo
+		if !signQueue.Peek(i, &signInfo) {
+			break
+		}
+
 		_, sigStatus := k.GetSig(ctx, signInfo.SigID)
 		// no need to check if snapshot exists again, sanity check for that passed at this point
 		snap, _ := s.GetSnapshot(ctx, signInfo.SnapshotCounter)
 
 		switch sigStatus {
 		case exported.SigStatus_Queued:
-			signShares += snap.CorruptionThreshold + 1
-			if signShares > maxSignShares {
-				return
-			}
-			emitSignStartEvent(ctx, k, voter, signInfo, snap)
-			k.SetInfoForSig(ctx, signInfo.SigID, signInfo)
-			k.SetSigStatus(ctx, signInfo.SigID, exported.SigStatus_Signing)
-			ctx.Logger().Debug(fmt.Sprintf("starting sign %s", signInfo.SigID))
+			scheduler.Enqueue(types.QueuedSign{Info: signInfo, Position: i, Shares: snap.CorruptionThreshold + 1})
 			i++
 		case exported.SigStatus_Signing:
 			signShares += snap.CorruptionThreshold + 1
+			scheduler.AddActive(signInfo, snap.CorruptionThreshold+1)
 			ctx.Logger().Debug(fmt.Sprintf("signing %s in progress", signInfo.SigID))
 			i++
 		case exported.SigStatus_Signed, exported.SigStatus_Aborted, exported.SigStatus_Invalid:
 			signQueue.Dequeue(i, &signInfo)
+			k.RecordSignCompletion(ctx, signInfo.SigID, sigStatus)
 			ctx.Logger().Debug(fmt.Sprintf("dequeque %s, sign status %s", signInfo.SigID, sigStatus))
 		default:
 			panic("invalid sig status type")
 		}
 	}
+
+	maxSignShares := k.GetMaxSimultaneousSignShares(ctx)
+	for {
+		next, ok := scheduler.Peek()
+		// do not skip ahead to smaller signs, otherwise large signs could starve
+		if !ok || signShares+next.Shares > maxSignShares {
+			return
+		}
+
+		scheduler.Pop()
+		signShares += next.Shares
+
+		// no need to check if snapshot exists again, sanity check for that passed at this point
+		snap, _ := s.GetSnapshot(ctx, next.Info.SnapshotCounter)
+		emitSignStartEvent(ctx, k, voter, next.Info, snap)
+		k.SetInfoForSig(ctx, next.Info.SigID, next.Info)
+		k.SetSigStatus(ctx, next.Info.SigID, exported.SigStatus_Signing)
+		k.SetSignStartHeight(ctx, next.Info.SigID)
+		ctx.Logger().Debug(fmt.Sprintf("starting sign %s", next.Info.SigID))
+	}
 }
 
 // request proxies to initiate a tss signing protocol using the specified signing metadata
@@ -190,7 +213,7 @@ func timeoutMultisigKeygen(ctx sdk.Context, multiSigKeygenQueue utils.SequenceKV
 
 				if !multisigKeyInfo.DoesParticipate(participant) {
 					ctx.Logger().Debug(fmt.Sprintf("absent pub keys from %s for multisig keygen %s", participant, keyID))
-					k.PenalizeCriminal(ctx, participant, tofnd.CRIME_TYPE_NON_MALICIOUS)
+					k.PenalizeCriminal(ctx, string(keyID), 1, participant, tofnd.CRIME_TYPE_NON_MALICIOUS, nil)
 				}
 			}
 
@@ -209,7 +232,27 @@ func timeoutMultisigKeygen(ctx sdk.Context, multiSigKeygenQueue utils.SequenceKV
 		multiSigKeygenQueue.Dequeue(0, &keyIDStr)
 	}
 }
-func handleMultisigSigns(ctx sdk.Context, sequenceQueue utils.SequenceKVQueue, k types.TSSKeeper) {
+
+// timeoutReshares aborts all reshare sessions that timed out without result, so their keys can be reshared again
+func timeoutReshares(ctx sdk.Context, k types.TSSKeeper) {
+	for _, info := range k.GetTimedOutReshares(ctx) {
+		if err := k.AbortReshare(ctx, info.SessionID); err != nil {
+			// should not reach here, the session was just retrieved
+			panic(err)
+		}
+
+		ctx.Logger().Debug(fmt.Sprintf("reshare session %s timed out", info.SessionID))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeReshare,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyKeyID, string(info.KeyID)),
+				sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueReject)),
+		)
+	}
+}
+
+func handleMultisigSigns(ctx sdk.Context, sequenceQueue utils.SequenceKVQueue, k types.TSSKeeper, s types.Snapshotter, voter types.InitPoller) {
 	var sigIDStr gogoprototypes.StringValue
 	i := uint64(0)
 
@@ -231,7 +274,7 @@ func handleMultisigSigns(ctx sdk.Context, sequenceQueue utils.SequenceKVQueue, k
 		switch {
 		// handle multisig session completion
 		case multisigSignInfo.IsCompleted():
-			k.SetSigStatus(ctx, sigID, exported.SigStatus_Signed)
+			sig, _ := k.GetSig(ctx, sigID)
 			k.SetSig(ctx, exported.Signature{
 				SigID: sigID,
 				Sig: &exported.Signature_MultiSig_{
@@ -240,6 +283,7 @@ func handleMultisigSigns(ctx sdk.Context, sequenceQueue utils.SequenceKVQueue, k
 					},
 				},
 				SigStatus: exported.SigStatus_Signed,
+				Attempts:  sig.Attempts,
 			})
 
 			ctx.Logger().Debug(fmt.Sprintf("multisig sign %s completed", sigID))
@@ -255,15 +299,43 @@ func handleMultisigSigns(ctx sdk.Context, sequenceQueue utils.SequenceKVQueue, k
 		// handle multisig session timeout
 		case multisigSignInfo.GetTimeoutBlock() <= ctx.BlockHeight():
 			participants := k.GetSignParticipants(ctx, sigID)
+			participantsJSON := k.GetSignParticipantsAsJSON(ctx, sigID)
+			participantShareCountsJSON := k.GetSignParticipantsSharesAsJSON(ctx, sigID)
+
+			sig, _ := k.GetSig(ctx, sigID)
 
+			var absentees []sdk.ValAddress
 			for _, participant := range participants {
 				val, _ := sdk.ValAddressFromBech32(participant)
 				if !multisigSignInfo.DoesParticipate(val) {
 					ctx.Logger().Debug(fmt.Sprintf("signatures from %s absent for multisig sign %s", participant, sigID))
-					k.PenalizeCriminal(ctx, val, tofnd.CRIME_TYPE_NON_MALICIOUS)
+					k.PenalizeCriminal(ctx, sigID, sig.Attempts, val, tofnd.CRIME_TYPE_NON_MALICIOUS, nil)
+					absentees = append(absentees, val)
 				}
 			}
 
+			err := k.RetrySign(ctx, info, absentees, s, voter)
+			if err == nil {
+				sig, _ = k.GetSig(ctx, sigID)
+				ctx.Logger().Debug(fmt.Sprintf("multisig sign %s timed out, retrying (attempt %d)", sigID, sig.Attempts))
+				ctx.EventManager().EmitEvent(sdk.NewEvent(
+					types.EventTypeSign,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeKeySigID, sigID),
+					sdk.NewAttribute(types.AttributeKeySigModule, info.RequestModule),
+					sdk.NewAttribute(types.AttributeKeyParticipants, string(participantsJSON)),
+					sdk.NewAttribute(types.AttributeKeyParticipantShareCounts, string(participantShareCountsJSON)),
+					sdk.NewAttribute(types.AttributeKeyAttempt, strconv.FormatInt(sig.Attempts, 10)),
+					sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueRetry),
+				))
+
+				// the retry keeps its place in the queue
+				i++
+				continue
+			}
+
+			ctx.Logger().Debug(fmt.Sprintf("aborting multisig sign %s: %s", sigID, err.Error()))
+
 			k.SetSigStatus(ctx, sigID, exported.SigStatus_Aborted)
 			k.DeleteInfoForSig(ctx, sigID)
 			k.DeleteMultisigSign(ctx, sigID)
@@ -303,3 +375,189 @@ func handleMultisigSigns(ctx sdk.Context, sequenceQueue utils.SequenceKVQueue, k
 		sequenceQueue.Dequeue(i, &sigIDStr)
 	}
 }
+
+// scheduleKeyRotations generates new keys for chains whose current keys are due for rotation according to the key rotation policies
+func scheduleKeyRotations(ctx sdk.Context, k types.TSSKeeper, voter types.Voter, nexus types.Nexus, s types.Snapshotter, staker types.StakingKeeper) {
+	for _, policy := range k.GetKeyRotationPolicies(ctx) {
+		chain, ok := nexus.GetChain(ctx, policy.Chain)
+		if !ok {
+			continue
+		}
+
+		if !types.TSSEnabled && chain.KeyType == exported.Threshold {
+			continue
+		}
+
+		if rotation, ok := k.GetScheduledRotation(ctx, chain, policy.KeyRole); ok {
+			handleScheduledRotation(ctx, k, chain, rotation)
+			continue
+		}
+
+		// a rotation is already under way
+		if _, ok := k.GetNextKeyID(ctx, chain, policy.KeyRole); ok {
+			continue
+		}
+
+		currKeyID, ok := k.GetCurrentKeyID(ctx, chain, policy.KeyRole)
+		if !ok {
+			continue
+		}
+
+		due, err := isRotationDue(ctx, k, s, staker, policy, chain, currKeyID)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("cannot determine if %s key of chain %s is due for rotation: %s", policy.KeyRole.SimpleString(), chain.Name, err))
+			continue
+		}
+
+		if !due {
+			continue
+		}
+
+		if err := startScheduledKeygen(ctx, k, voter, s, chain, policy.KeyRole); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to start keygen for rotation of %s key of chain %s: %s", policy.KeyRole.SimpleString(), chain.Name, err))
+		}
+	}
+}
+
+func handleScheduledRotation(ctx sdk.Context, k types.TSSKeeper, chain nexus.Chain, rotation types.ScheduledRotation) {
+	event := sdk.NewEvent(types.EventTypeRotation,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeChain, chain.Name),
+		sdk.NewAttribute(types.AttributeKeyRole, rotation.KeyRole.SimpleString()),
+		sdk.NewAttribute(types.AttributeKeyKeyID, string(rotation.KeyID)),
+	)
+
+	if _, ok := k.GetKey(ctx, rotation.KeyID); ok {
+		k.DeleteScheduledRotation(ctx, chain, rotation.KeyRole)
+
+		// the chain module takes over to transfer control over its assets to the next key,
+		// no further rotation gets scheduled until the next key is rotated in
+		if err := k.AssignNextKey(ctx, chain, rotation.KeyRole, rotation.KeyID); err != nil {
+			ctx.EventManager().EmitEvent(event.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueFailed)))
+			k.Logger(ctx).Error(fmt.Sprintf("cannot assign key %s as the next %s key of chain %s: %s", rotation.KeyID, rotation.KeyRole.SimpleString(), chain.Name, err))
+
+			return
+		}
+
+		ctx.EventManager().EmitEvent(event.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueReady)))
+		k.Logger(ctx).Info(fmt.Sprintf("key %s is ready to replace the current %s key of chain %s", rotation.KeyID, rotation.KeyRole.SimpleString(), chain.Name))
+
+		return
+	}
+
+	// failed keygens clean up their snapshot counter, so the rotation can be tried again
+	_, ok := k.GetSnapshotCounterForKeyID(ctx, rotation.KeyID)
+	keyRequirement, _ := k.GetKeyRequirement(ctx, rotation.KeyRole, chain.KeyType)
+	if ok && ctx.BlockHeight() <= rotation.StartedAt+keyRequirement.KeygenTimeout {
+		return
+	}
+
+	k.DeleteScheduledRotation(ctx, chain, rotation.KeyRole)
+	ctx.EventManager().EmitEvent(event.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueFailed)))
+	k.Logger(ctx).Info(fmt.Sprintf("keygen %s for rotation of %s key of chain %s failed", rotation.KeyID, rotation.KeyRole.SimpleString(), chain.Name))
+}
+
+func isRotationDue(ctx sdk.Context, k types.TSSKeeper, s types.Snapshotter, staker types.StakingKeeper, policy types.KeyRotationPolicy, chain nexus.Chain, keyID exported.KeyID) (bool, error) {
+	counter, ok := k.GetSnapshotCounterForKeyID(ctx, keyID)
+	if !ok {
+		return false, fmt.Errorf("could not obtain snapshot counter for key ID %s", keyID)
+	}
+
+	snap, ok := s.GetSnapshot(ctx, counter)
+	if !ok {
+		return false, fmt.Errorf("could not obtain snapshot for counter %d", counter)
+	}
+
+	if policy.PeriodInBlocks > 0 {
+		rotatedAt, ok := k.GetRotatedAtHeight(ctx, chain, policy.KeyRole)
+		if !ok {
+			// keys rotated before the rotation height was tracked fall back to the time they were generated
+			rotatedAt = snap.Height
+		}
+
+		if ctx.BlockHeight()-rotatedAt >= policy.PeriodInBlocks {
+			return true, nil
+		}
+	}
+
+	if policy.HasDriftLimit() {
+		drift := getSnapshotDrift(ctx, s, staker, snap)
+		if drift.GT(policy.MaxSnapshotDrift) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// getSnapshotDrift returns the share of the given snapshot held by validators that are no longer eligible to sign
+func getSnapshotDrift(ctx sdk.Context, s types.Snapshotter, staker types.StakingKeeper, snap snapshot.Snapshot) utils.Threshold {
+	driftedShareCount := int64(0)
+	for _, validator := range snap.Validators {
+		if !isEligibleForSigning(ctx, s, staker, validator.GetSDKValidator().GetOperator()) {
+			driftedShareCount += validator.ShareCount
+		}
+	}
+
+	return utils.Threshold{Numerator: driftedShareCount, Denominator: snap.TotalShareCount.Int64()}
+}
+
+func isEligibleForSigning(ctx sdk.Context, s types.Snapshotter, staker types.StakingKeeper, operator sdk.ValAddress) bool {
+	current := staker.Validator(ctx, operator)
+	if current == nil || !current.IsBonded() {
+		return false
+	}
+
+	sdkValidator, ok := current.(snapshot.SDKValidator)
+	if !ok {
+		return !current.IsJailed()
+	}
+
+	illegibility, err := s.GetValidatorIllegibility(ctx, sdkValidator)
+	if err != nil {
+		return false
+	}
+
+	return illegibility.FilterIllegibilityForSigning().Is(snapshot.None)
+}
+
+func startScheduledKeygen(ctx sdk.Context, k types.TSSKeeper, voter types.Voter, s types.Snapshotter, chain nexus.Chain, keyRole exported.KeyRole) error {
+	keyRequirement, ok := k.GetKeyRequirement(ctx, keyRole, chain.KeyType)
+	if !ok {
+		return fmt.Errorf("key requirement for key role %s type %s not found", keyRole.SimpleString(), chain.KeyType.SimpleString())
+	}
+
+	snap, err := s.TakeSnapshot(ctx, keyRequirement)
+	if err != nil {
+		return err
+	}
+
+	keyInfo := types.KeyInfo{
+		KeyID:   exported.KeyID(fmt.Sprintf("%s-%s-%d", strings.ToLower(chain.Name), keyRole.SimpleString(), ctx.BlockHeight())),
+		KeyRole: keyRole,
+		KeyType: chain.KeyType,
+	}
+
+	if err := k.StartKeygen(ctx, voter, keyInfo, snap); err != nil {
+		return err
+	}
+
+	k.SetScheduledRotation(ctx, types.ScheduledRotation{
+		Chain:     chain.Name,
+		KeyRole:   keyRole,
+		KeyID:     keyInfo.KeyID,
+		StartedAt: ctx.BlockHeight(),
+	})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeRotation,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueStart),
+		sdk.NewAttribute(types.AttributeChain, chain.Name),
+		sdk.NewAttribute(types.AttributeKeyRole, keyRole.SimpleString()),
+		sdk.NewAttribute(types.AttributeKeyKeyID, string(keyInfo.KeyID)),
+	))
+
+	k.Logger(ctx).Info(fmt.Sprintf("started keygen %s for the scheduled rotation of %s key of chain %s", keyInfo.KeyID, keyRole.SimpleString(), chain.Name))
+
+	return nil
+}
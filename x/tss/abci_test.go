@@ -0,0 +1,217 @@
+package tss
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/types"
+	"github.com/axelarnetwork/axelar-core/x/tss/types/mock"
+)
+
+func TestScheduleKeyRotations(t *testing.T) {
+	var (
+		ctx         sdk.Context
+		tssKeeper   *mock.TSSKeeperMock
+		nexusKeeper *mock.NexusMock
+		snapshotter *mock.SnapshotterMock
+		staker      *mock.StakingKeeperMock
+		voter       *mock.VoterMock
+
+		chain       nexus.Chain
+		period      int64
+		rotatedAt   int64
+		currKeyID   exported.KeyID
+		nextKeyID   exported.KeyID
+		scheduled   *types.ScheduledRotation
+		generated   map[exported.KeyID]bool
+		assignErr   error
+		keygenCount int
+	)
+
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.I64Between(1000, 1000000)}, false, log.TestingLogger())
+		chain = nexus.Chain{Name: rand.StrBetween(5, 20), KeyType: exported.Multisig, Module: rand.StrBetween(5, 20)}
+		period = rand.I64Between(10, 100)
+		rotatedAt = ctx.BlockHeight() - period
+		currKeyID = exported.KeyID(rand.StrBetween(5, 20))
+		nextKeyID = ""
+		scheduled = nil
+		generated = map[exported.KeyID]bool{currKeyID: true}
+		assignErr = nil
+		keygenCount = 0
+
+		tssKeeper = &mock.TSSKeeperMock{
+			LoggerFunc: func(sdk.Context) log.Logger { return log.TestingLogger() },
+			GetKeyRotationPoliciesFunc: func(sdk.Context) []types.KeyRotationPolicy {
+				return []types.KeyRotationPolicy{{Chain: chain.Name, KeyRole: exported.MasterKey, PeriodInBlocks: period}}
+			},
+			GetScheduledRotationFunc: func(sdk.Context, nexus.Chain, exported.KeyRole) (types.ScheduledRotation, bool) {
+				if scheduled == nil {
+					return types.ScheduledRotation{}, false
+				}
+
+				return *scheduled, true
+			},
+			SetScheduledRotationFunc:    func(_ sdk.Context, rotation types.ScheduledRotation) { scheduled = &rotation },
+			DeleteScheduledRotationFunc: func(sdk.Context, nexus.Chain, exported.KeyRole) { scheduled = nil },
+			GetNextKeyIDFunc: func(sdk.Context, nexus.Chain, exported.KeyRole) (exported.KeyID, bool) {
+				return nextKeyID, nextKeyID != ""
+			},
+			GetCurrentKeyIDFunc:            func(sdk.Context, nexus.Chain, exported.KeyRole) (exported.KeyID, bool) { return currKeyID, true },
+			GetSnapshotCounterForKeyIDFunc: func(sdk.Context, exported.KeyID) (int64, bool) { return 0, true },
+			GetRotatedAtHeightFunc:         func(sdk.Context, nexus.Chain, exported.KeyRole) (int64, bool) { return rotatedAt, true },
+			GetKeyRequirementFunc: func(sdk.Context, exported.KeyRole, exported.KeyType) (exported.KeyRequirement, bool) {
+				return exported.KeyRequirement{KeygenTimeout: 10}, true
+			},
+			StartKeygenFunc: func(sdk.Context, types.Voter, types.KeyInfo, snapshot.Snapshot) error {
+				keygenCount++
+				return nil
+			},
+			GetKeyFunc: func(_ sdk.Context, keyID exported.KeyID) (exported.Key, bool) {
+				return exported.Key{ID: keyID}, generated[keyID]
+			},
+			AssignNextKeyFunc: func(_ sdk.Context, _ nexus.Chain, _ exported.KeyRole, keyID exported.KeyID) error {
+				if assignErr != nil {
+					return assignErr
+				}
+
+				nextKeyID = keyID
+				return nil
+			},
+		}
+		nexusKeeper = &mock.NexusMock{
+			GetChainFunc: func(_ sdk.Context, name string) (nexus.Chain, bool) { return chain, name == chain.Name },
+		}
+		snapshotter = &mock.SnapshotterMock{
+			GetSnapshotFunc:  func(sdk.Context, int64) (snapshot.Snapshot, bool) { return snapshot.Snapshot{}, true },
+			TakeSnapshotFunc: func(sdk.Context, exported.KeyRequirement) (snapshot.Snapshot, error) { return snapshot.Snapshot{}, nil },
+		}
+		staker = &mock.StakingKeeperMock{}
+		voter = &mock.VoterMock{}
+	}
+
+	endBlock := func() {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+		scheduleKeyRotations(ctx, tssKeeper, voter, nexusKeeper, snapshotter, staker)
+	}
+
+	hasRotationEvent := func(action string) bool {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeRotation {
+				continue
+			}
+
+			for _, attribute := range event.Attributes {
+				if string(attribute.Key) == sdk.AttributeKeyAction && string(attribute.Value) == action {
+					return true
+				}
+			}
+		}
+
+		return false
+	}
+
+	repeats := 20
+	t.Run("should assign the generated key as next key and not start another keygen until it is rotated in", testutils.Func(func(t *testing.T) {
+		setup()
+
+		endBlock()
+		assert.Equal(t, 1, keygenCount)
+		assert.NotNil(t, scheduled)
+		assert.True(t, hasRotationEvent(types.AttributeValueStart))
+		newKeyID := scheduled.KeyID
+
+		// keygen still in progress
+		endBlock()
+		assert.Equal(t, 1, keygenCount)
+		assert.NotNil(t, scheduled)
+
+		generated[newKeyID] = true
+		endBlock()
+		assert.Len(t, tssKeeper.AssignNextKeyCalls(), 1)
+		assert.Equal(t, newKeyID, tssKeeper.AssignNextKeyCalls()[0].KeyID)
+		assert.Equal(t, exported.MasterKey, tssKeeper.AssignNextKeyCalls()[0].KeyRole)
+		assert.Nil(t, scheduled)
+		assert.True(t, hasRotationEvent(types.AttributeValueReady))
+
+		// the chain module has not rotated the key in yet, even though the rotation is overdue
+		for i := int64(0); i < 2*period; i++ {
+			endBlock()
+		}
+		assert.Equal(t, 1, keygenCount)
+		assert.Len(t, tssKeeper.AssignNextKeyCalls(), 1)
+
+		// the chain module rotates the key in
+		currKeyID = nextKeyID
+		nextKeyID = ""
+		rotatedAt = ctx.BlockHeight()
+
+		for i := int64(1); i < period; i++ {
+			endBlock()
+		}
+		assert.Equal(t, 1, keygenCount)
+
+		endBlock()
+		assert.Equal(t, 2, keygenCount)
+	}).Repeat(repeats))
+
+	t.Run("should fail the rotation if the generated key cannot be assigned", testutils.Func(func(t *testing.T) {
+		setup()
+
+		endBlock()
+		assert.Equal(t, 1, keygenCount)
+		generated[scheduled.KeyID] = true
+		assignErr = fmt.Errorf("some error")
+
+		endBlock()
+		assert.Len(t, tssKeeper.AssignNextKeyCalls(), 1)
+		assert.Nil(t, scheduled)
+		assert.True(t, hasRotationEvent(types.AttributeValueFailed))
+		assert.False(t, hasRotationEvent(types.AttributeValueReady))
+	}).Repeat(repeats))
+
+	t.Run("should not start keygen if a next key is assigned already", testutils.Func(func(t *testing.T) {
+		setup()
+		nextKeyID = exported.KeyID(rand.StrBetween(5, 20))
+
+		endBlock()
+		assert.Equal(t, 0, keygenCount)
+		assert.Nil(t, scheduled)
+	}).Repeat(repeats))
+}
+
+func TestTimeoutReshares(t *testing.T) {
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: rand.I64Between(1000, 1000000)}, false, log.TestingLogger())
+
+	var timedOut []types.ReshareInfo
+	for i := 0; i < int(rand.I64Between(1, 10)); i++ {
+		timedOut = append(timedOut, types.ReshareInfo{
+			SessionID: rand.StrBetween(5, 20),
+			KeyID:     exported.KeyID(rand.StrBetween(5, 20)),
+			Timeout:   ctx.BlockHeight() - rand.I64Between(1, 100),
+		})
+	}
+
+	tssKeeper := &mock.TSSKeeperMock{
+		GetTimedOutResharesFunc: func(sdk.Context) []types.ReshareInfo { return timedOut },
+		AbortReshareFunc:        func(sdk.Context, string) error { return nil },
+	}
+
+	timeoutReshares(ctx, tssKeeper)
+
+	assert.Len(t, tssKeeper.AbortReshareCalls(), len(timedOut))
+	for i, call := range tssKeeper.AbortReshareCalls() {
+		assert.Equal(t, timedOut[i].SessionID, call.SessionID)
+	}
+	assert.Len(t, ctx.EventManager().Events(), len(timedOut))
+}
@@ -30,6 +30,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	tssQueryCmd.AddCommand(
 		GetCmdGetSig(queryRoute),
+		GetCmdSignQueuePosition(queryRoute),
 		GetCmdGetKey(queryRoute),
 		GetCmdRecovery(queryRoute),
 		GetCmdGetKeyID(queryRoute),
@@ -40,6 +41,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetCmdGetActiveOldKeysByValidator(queryRoute),
 		GetCmdGetDeactivatedOperators(queryRoute),
 		GetCmdExternalKeyID(queryRoute),
+		GetCmdMisbehaviourHistory(queryRoute),
 	)
 
 	return tssQueryCmd
@@ -76,6 +78,37 @@ func GetCmdGetSig(queryRoute string) *cobra.Command {
 	return cmd
 }
 
+// GetCmdSignQueuePosition returns the query for the position of a sign request in the sign queue
+func GetCmdSignQueuePosition(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-queue-position [sig ID]",
+		Short: "Query the sign queue position and estimated start height of a sign request by sig ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sigID := args[0]
+			bz, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QuerySignQueuePosition, sigID))
+			if err != nil {
+				return sdkerrors.Wrapf(err, "failed to get sign queue position")
+			}
+
+			var res types.QuerySignQueuePositionResponse
+			if err := res.Unmarshal(bz); err != nil {
+				return sdkerrors.Wrapf(err, "failed to get sign queue position")
+			}
+
+			return cliCtx.PrintProto(&res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdGetKey returns the query for a key by its keyID
 func GetCmdGetKey(queryRoute string) *cobra.Command {
 	cmd := &cobra.Command{
@@ -407,3 +440,34 @@ func GetCmdNextKeyID(queryRoute string) *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdMisbehaviourHistory returns the query for the misbehaviour history of a validator
+func GetCmdMisbehaviourHistory(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "misbehaviour-history [validator address]",
+		Short: "Query the evidence of tss misbehaviour and the resulting penalties of a validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			validatorAddress := args[0]
+			bz, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QueryMisbehaviourHistory, validatorAddress))
+			if err != nil {
+				return sdkerrors.Wrapf(err, "failed to get misbehaviour history")
+			}
+
+			var res types.QueryMisbehaviourHistoryResponse
+			if err := res.Unmarshal(bz); err != nil {
+				return sdkerrors.Wrapf(err, "failed to get misbehaviour history")
+			}
+
+			return cliCtx.PrintProto(&res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
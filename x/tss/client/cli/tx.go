@@ -27,6 +27,7 @@ func GetTxCmd() *cobra.Command {
 	tssTxCmd.AddCommand(
 		getCmdKeygenStart(),
 		getCmdRotateKey(),
+		getCmdReshareStart(),
 		GetCmdRegisterExternalKeys(),
 	)
 
@@ -111,6 +112,35 @@ func getCmdRotateKey() *cobra.Command {
 	return cmd
 }
 
+func getCmdReshareStart() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start-reshare [keyID]",
+		Short: "Redistribute the shares of the given key to the current validator set without changing the public key",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		if !types.TSSEnabled {
+			return fmt.Errorf("threshold signing is disabled")
+		}
+
+		msg := types.NewStartReshareRequest(clientCtx.FromAddress, args[0])
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdRegisterExternalKeys returns the cli command to register an external key
 func GetCmdRegisterExternalKeys() *cobra.Command {
 	cmd := &cobra.Command{
@@ -52,6 +52,32 @@ func QueryHandlerSigStatus(cliCtx client.Context) http.HandlerFunc {
 	}
 }
 
+// QueryHandlerSignQueuePosition returns a handler to query the sign queue position of a sign request by its sigID
+func QueryHandlerSignQueuePosition(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		sigID := mux.Vars(r)[utils.PathVarSigID]
+		bz, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QuerySignQueuePosition, sigID))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var res types.QuerySignQueuePositionResponse
+		if err := res.Unmarshal(bz); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, sdkerrors.Wrapf(err, "failed to get sign queue position").Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // QueryHandlerKeyStatus returns a handler to query a key's vote status by its keyID
 func QueryHandlerKeyStatus(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -343,3 +369,29 @@ func QueryHandlerExternalKeyID(cliCtx client.Context) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// QueryHandlerMisbehaviourHistory returns a handler to query the misbehaviour history of a validator
+func QueryHandlerMisbehaviourHistory(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		validatorAddress := mux.Vars(r)[utils.PathVarCosmosAddress]
+		bz, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QueryMisbehaviourHistory, validatorAddress))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var res types.QueryMisbehaviourHistoryResponse
+		if err := res.Unmarshal(bz); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, sdkerrors.Wrapf(err, "failed to get misbehaviour history").Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -20,6 +20,7 @@ const (
 	TxKeygenStart          = "start"
 	TxMasterKeyRotate      = "rotate"
 	TxRegisterExternalKeys = "register-external-keys"
+	TxReshareStart         = "start-reshare"
 
 	QuerySignature                = keeper.QuerySignature
 	QueryKey                      = keeper.QueryKey
@@ -32,6 +33,8 @@ const (
 	QueryKeySharesByValidator     = keeper.QueryKeySharesByValidator
 	QueryDeactivated              = keeper.QueryDeactivated
 	QueryExternalKeyID            = "external-key-id"
+	QuerySignQueuePosition        = keeper.QuerySignQueuePosition
+	QueryMisbehaviourHistory      = keeper.QueryMisbehaviourHistory
 )
 
 // ReqRegisterExternalKey represents a request to register external keys for a chain
@@ -57,15 +60,23 @@ type ReqKeyRotate struct {
 	KeyID   string       `json:"key_id" yaml:"key_id"`
 }
 
+// ReqReshareStart represents a request to reshare a key
+type ReqReshareStart struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	KeyID   string       `json:"key_id" yaml:"key_id"`
+}
+
 // RegisterRoutes registers all REST routes with the given router
 func RegisterRoutes(cliCtx client.Context, r *mux.Router) {
 	registerTx := clientUtils.RegisterTxHandlerFn(r, types.RestRoute)
 	registerTx(GetHandlerKeygenStart(cliCtx), TxKeygenStart)
 	registerTx(GetHandlerKeyRotate(cliCtx), TxMasterKeyRotate, clientUtils.PathVarChain)
 	registerTx(GetHandlerRegisterExternalKeys(cliCtx), TxRegisterExternalKeys, clientUtils.PathVarChain)
+	registerTx(GetHandlerReshareStart(cliCtx), TxReshareStart)
 
 	registerQuery := clientUtils.RegisterQueryHandlerFn(r, types.RestRoute)
 	registerQuery(QueryHandlerSigStatus(cliCtx), QuerySignature, clientUtils.PathVarSigID)
+	registerQuery(QueryHandlerSignQueuePosition(cliCtx), QuerySignQueuePosition, clientUtils.PathVarSigID)
 	registerQuery(QueryHandlerKeyStatus(cliCtx), QueryKey, clientUtils.PathVarKeyID)
 	registerQuery(QueryHandlerRecovery(cliCtx), QueryRecovery)
 	registerQuery(QueryHandlerKeyID(cliCtx), QueryKeyID, clientUtils.PathVarChain, clientUtils.PathVarKeyRole)
@@ -76,6 +87,7 @@ func RegisterRoutes(cliCtx client.Context, r *mux.Router) {
 	registerQuery(QueryHandlerKeySharesByValidator(cliCtx), QueryKeySharesByValidator, clientUtils.PathVarCosmosAddress)
 	registerQuery(QueryHandlerDeactivatedOperator(cliCtx), QueryDeactivated)
 	registerQuery(QueryHandlerExternalKeyID(cliCtx), QueryExternalKeyID, clientUtils.PathVarChain)
+	registerQuery(QueryHandlerMisbehaviourHistory(cliCtx), QueryMisbehaviourHistory, clientUtils.PathVarCosmosAddress)
 }
 
 // GetHandlerKeygenStart returns the handler to start a keygen
@@ -156,6 +168,38 @@ func GetHandlerKeyRotate(cliCtx client.Context) http.HandlerFunc {
 	}
 }
 
+// GetHandlerReshareStart returns the handler to start a reshare
+func GetHandlerReshareStart(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ReqReshareStart
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		sender, ok := clientUtils.ExtractReqSender(w, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		if !types.TSSEnabled {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "threshold signing is disabled")
+			return
+		}
+
+		msg := types.NewStartReshareRequest(sender, req.KeyID)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
 // GetHandlerRegisterExternalKeys returns the handler to register an external key
 func GetHandlerRegisterExternalKeys(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
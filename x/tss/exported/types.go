@@ -141,6 +141,32 @@ func (x KeyRole) Validate() error {
 	}
 }
 
+// Validate validates the SignPriority
+func (x SignPriority) Validate() error {
+	switch x {
+	case SignPriority_Low, SignPriority_Normal, SignPriority_High:
+		return nil
+	default:
+		return fmt.Errorf("invalid sign priority %d", x)
+	}
+}
+
+// IsHigherThan returns true if the priority ranks above the other priority
+func (x SignPriority) IsHigherThan(other SignPriority) bool {
+	return x.rank() > other.rank()
+}
+
+func (x SignPriority) rank() int {
+	switch x {
+	case SignPriority_Low:
+		return 0
+	case SignPriority_High:
+		return 2
+	default:
+		return 1
+	}
+}
+
 // KeyShareDistributionPolicyFromSimpleStr creates a KeyShareDistributionPolicy from string
 func KeyShareDistributionPolicyFromSimpleStr(str string) (KeyShareDistributionPolicy, error) {
 	switch strings.ToLower(str) {
@@ -115,6 +115,34 @@ func (AckType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_6a3f02740fd114b9, []int{2}
 }
 
+type SignPriority int32
+
+const (
+	SignPriority_Normal SignPriority = 0
+	SignPriority_Low    SignPriority = 1
+	SignPriority_High   SignPriority = 2
+)
+
+var SignPriority_name = map[int32]string{
+	0: "SIGN_PRIORITY_NORMAL",
+	1: "SIGN_PRIORITY_LOW",
+	2: "SIGN_PRIORITY_HIGH",
+}
+
+var SignPriority_value = map[string]int32{
+	"SIGN_PRIORITY_NORMAL": 0,
+	"SIGN_PRIORITY_LOW":    1,
+	"SIGN_PRIORITY_HIGH":   2,
+}
+
+func (x SignPriority) String() string {
+	return proto.EnumName(SignPriority_name, int32(x))
+}
+
+func (SignPriority) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6a3f02740fd114b9, []int{3}
+}
+
 type SigStatus int32
 
 const (
@@ -149,7 +177,7 @@ func (x SigStatus) String() string {
 }
 
 func (SigStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_6a3f02740fd114b9, []int{3}
+	return fileDescriptor_6a3f02740fd114b9, []int{4}
 }
 
 type KeyType int32
@@ -180,7 +208,7 @@ func (x KeyType) String() string {
 }
 
 func (KeyType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_6a3f02740fd114b9, []int{4}
+	return fileDescriptor_6a3f02740fd114b9, []int{5}
 }
 
 // KeyRequirement defines requirements for keys
@@ -316,6 +344,11 @@ type SignInfo struct {
 	SnapshotCounter int64  `protobuf:"varint,4,opt,name=snapshot_counter,json=snapshotCounter,proto3" json:"snapshot_counter,omitempty"`
 	RequestModule   string `protobuf:"bytes,5,opt,name=request_module,json=requestModule,proto3" json:"request_module,omitempty"`
 	Metadata        string `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// priority orders the sign request ahead of requests with lower priority
+	Priority SignPriority `protobuf:"varint,7,opt,name=priority,proto3,enum=tss.exported.v1beta1.SignPriority" json:"priority,omitempty"`
+	// chain the sign request is made for, used together with the request module
+	// to share the sign queue fairly
+	Chain string `protobuf:"bytes,8,opt,name=chain,proto3" json:"chain,omitempty"`
 }
 
 func (m *SignInfo) Reset()         { *m = SignInfo{} }
@@ -393,6 +426,20 @@ func (m *SignInfo) GetMetadata() string {
 	return ""
 }
 
+func (m *SignInfo) GetPriority() SignPriority {
+	if m != nil {
+		return m.Priority
+	}
+	return SignPriority_Normal
+}
+
+func (m *SignInfo) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
 // PubKeyInfo holds a pubkey and a signature
 type SigKeyPair struct {
 	PubKey    []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
@@ -454,6 +501,8 @@ type Signature struct {
 	//	*Signature_MultiSig_
 	Sig       isSignature_Sig `protobuf_oneof:"sig"`
 	SigStatus SigStatus       `protobuf:"varint,4,opt,name=sig_status,json=sigStatus,proto3,enum=tss.exported.v1beta1.SigStatus" json:"sig_status,omitempty"`
+	// attempts counts the sign sessions started for this sig ID
+	Attempts int64 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
 }
 
 func (m *Signature) Reset()         { *m = Signature{} }
@@ -540,6 +589,13 @@ func (m *Signature) GetSigStatus() SigStatus {
 	return SigStatus_Unspecified
 }
 
+func (m *Signature) GetAttempts() int64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Signature) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -877,6 +933,7 @@ func init() {
 	proto.RegisterEnum("tss.exported.v1beta1.KeyRole", KeyRole_name, KeyRole_value)
 	proto.RegisterEnum("tss.exported.v1beta1.KeyShareDistributionPolicy", KeyShareDistributionPolicy_name, KeyShareDistributionPolicy_value)
 	proto.RegisterEnum("tss.exported.v1beta1.AckType", AckType_name, AckType_value)
+	proto.RegisterEnum("tss.exported.v1beta1.SignPriority", SignPriority_name, SignPriority_value)
 	proto.RegisterEnum("tss.exported.v1beta1.SigStatus", SigStatus_name, SigStatus_value)
 	proto.RegisterEnum("tss.exported.v1beta1.KeyType", KeyType_name, KeyType_value)
 	proto.RegisterType((*KeyRequirement)(nil), "tss.exported.v1beta1.KeyRequirement")
@@ -893,103 +950,110 @@ func init() {
 func init() { proto.RegisterFile("tss/exported/v1beta1/types.proto", fileDescriptor_6a3f02740fd114b9) }
 
 var fileDescriptor_6a3f02740fd114b9 = []byte{
-	// 1528 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x3d, 0x73, 0xdb, 0xcc,
-	0x11, 0x16, 0x48, 0x7d, 0x90, 0x47, 0x4a, 0x82, 0xcf, 0x92, 0xcd, 0x41, 0x62, 0x12, 0x96, 0xc7,
-	0x19, 0xc9, 0x49, 0xc8, 0x48, 0x6e, 0x52, 0x39, 0xc3, 0x0f, 0x44, 0x82, 0x29, 0x91, 0x34, 0x00,
-	0xca, 0x51, 0x1a, 0x0c, 0x48, 0x9c, 0xa0, 0x1b, 0x92, 0x00, 0x0d, 0x1c, 0x64, 0x71, 0x26, 0x3f,
-	0x20, 0xc3, 0xca, 0x55, 0x3a, 0x56, 0x49, 0x91, 0x9f, 0x90, 0x22, 0x7d, 0x5c, 0xba, 0x4b, 0x2a,
-	0x25, 0x23, 0xff, 0x83, 0x34, 0x99, 0x71, 0x95, 0xb9, 0x3b, 0x80, 0xe4, 0xc8, 0xe2, 0x2b, 0xbd,
-	0xdd, 0xed, 0xde, 0xf3, 0x3c, 0x77, 0xbb, 0xb7, 0xbb, 0x20, 0x81, 0x4c, 0x82, 0xa0, 0x84, 0xae,
-	0x86, 0x9e, 0x4f, 0x90, 0x5d, 0xba, 0xdc, 0xef, 0x20, 0x62, 0xed, 0x97, 0xc8, 0x68, 0x88, 0x82,
-	0xe2, 0xd0, 0xf7, 0x88, 0x07, 0xb7, 0x48, 0x10, 0x14, 0x63, 0x44, 0x31, 0x42, 0x48, 0xcf, 0x42,
-	0x82, 0xfb, 0xc1, 0x8c, 0x70, 0xe1, 0xa3, 0xe0, 0xc2, 0xeb, 0xdb, 0x9c, 0x24, 0x6d, 0x39, 0x9e,
-	0xe3, 0xb1, 0x65, 0x89, 0xae, 0x22, 0x6f, 0xc1, 0xf1, 0x3c, 0xa7, 0x8f, 0x4a, 0xcc, 0xea, 0x84,
-	0xe7, 0x25, 0x82, 0x07, 0x28, 0x20, 0xd6, 0x60, 0xc8, 0x01, 0x3b, 0xff, 0x5d, 0x01, 0x1b, 0x75,
-	0x34, 0xd2, 0xd0, 0x87, 0x10, 0xfb, 0x68, 0x80, 0x5c, 0x02, 0x7f, 0x0d, 0x52, 0x3d, 0x34, 0x32,
-	0x7d, 0xaf, 0x8f, 0x72, 0x82, 0x2c, 0xec, 0x6e, 0x1c, 0x3c, 0x2b, 0xde, 0x75, 0xa3, 0x22, 0xe5,
-	0x79, 0x7d, 0xa4, 0xad, 0xf5, 0xf8, 0x22, 0x66, 0xd2, 0x58, 0x72, 0x89, 0x7b, 0x98, 0xc6, 0x68,
-	0xc8, 0x99, 0x74, 0x01, 0x5b, 0x60, 0x6b, 0x80, 0x5d, 0xb3, 0x87, 0x46, 0x0e, 0x72, 0xcd, 0x69,
-	0x6c, 0xb9, 0xa4, 0x2c, 0xec, 0x66, 0x0e, 0x72, 0x45, 0x16, 0xfb, 0x94, 0x6e, 0xc4, 0xfb, 0x95,
-	0xe5, 0xcf, 0xd7, 0x85, 0x25, 0x0d, 0x0e, 0xb0, 0x5b, 0x67, 0xd4, 0xe9, 0x0e, 0x54, 0x81, 0x18,
-	0x58, 0xe7, 0x88, 0x8c, 0xe6, 0xd4, 0x96, 0x1f, 0xa4, 0xb6, 0xc9, 0x79, 0x33, 0xa9, 0x00, 0x3c,
-	0xa3, 0x61, 0x05, 0x17, 0x96, 0x8f, 0x4c, 0x1b, 0x07, 0xc4, 0xc7, 0x9d, 0x90, 0x60, 0xcf, 0x35,
-	0x87, 0x5e, 0x1f, 0x77, 0x47, 0xb9, 0x15, 0x16, 0xeb, 0xaf, 0x16, 0xc6, 0xaa, 0x53, 0x66, 0x6d,
-	0x8e, 0xd8, 0x62, 0x3c, 0x4d, 0xea, 0x2d, 0xdc, 0x83, 0xfb, 0x60, 0x7b, 0x60, 0x5d, 0x99, 0xc4,
-	0x23, 0x56, 0x3f, 0x3a, 0xba, 0xeb, 0x85, 0x2e, 0xc9, 0xad, 0xca, 0xc2, 0x6e, 0x52, 0x83, 0x03,
-	0xeb, 0xca, 0xa0, 0x7b, 0x8c, 0x5f, 0xa5, 0x3b, 0x8c, 0x82, 0xdd, 0x3b, 0x28, 0x6b, 0x11, 0x05,
-	0xbb, 0xb7, 0x29, 0xa7, 0xe0, 0x69, 0x94, 0xf3, 0x4b, 0x8f, 0x60, 0xd7, 0x99, 0x4b, 0x56, 0xea,
-	0x41, 0xc9, 0xda, 0xe6, 0xf4, 0x53, 0xc6, 0x9e, 0xa5, 0x4c, 0x03, 0xdb, 0x01, 0x76, 0xee, 0x50,
-	0x4d, 0x3f, 0x48, 0xf5, 0x31, 0x25, 0xdf, 0xd6, 0x7c, 0x09, 0x36, 0xe2, 0xfa, 0xc0, 0x03, 0xe4,
-	0x85, 0x24, 0x07, 0x58, 0x5c, 0xeb, 0xdc, 0x6b, 0x70, 0x27, 0x7c, 0x0e, 0xb2, 0xec, 0xe8, 0x18,
-	0x94, 0x61, 0xa0, 0x0c, 0xf5, 0x45, 0x90, 0x9d, 0x7f, 0x0a, 0x20, 0xa5, 0x63, 0xc7, 0x55, 0xdd,
-	0x73, 0x0f, 0xee, 0x81, 0x55, 0xfa, 0xba, 0xd8, 0x66, 0xc5, 0x9e, 0xae, 0xc0, 0x9b, 0xeb, 0xc2,
-	0x4a, 0x1d, 0x8d, 0xd4, 0xda, 0xb7, 0x78, 0xa1, 0xad, 0xf4, 0xd0, 0x48, 0xb5, 0xa1, 0x0c, 0x56,
-	0x03, 0xec, 0x50, 0x68, 0x82, 0x41, 0xd3, 0x14, 0xaa, 0x63, 0x87, 0x22, 0x02, 0xec, 0xa8, 0x36,
-	0x14, 0x41, 0x72, 0x10, 0x38, 0xac, 0x6c, 0xb3, 0x1a, 0x5d, 0xc2, 0x3d, 0x20, 0x06, 0xae, 0x35,
-	0x0c, 0x2e, 0x3c, 0xc2, 0x5f, 0x03, 0xf9, 0xac, 0x0e, 0x93, 0xda, 0x66, 0xec, 0xaf, 0x72, 0x37,
-	0x0d, 0xd0, 0x47, 0x1f, 0x42, 0x14, 0x10, 0x73, 0xe0, 0xd9, 0x61, 0x1f, 0xb1, 0xc2, 0x4a, 0x6b,
-	0xeb, 0x91, 0xf7, 0x84, 0x39, 0xa1, 0x04, 0x52, 0x03, 0x44, 0x2c, 0xdb, 0x22, 0x16, 0x2b, 0x86,
-	0xb4, 0x36, 0xb5, 0x77, 0xaa, 0x00, 0xe8, 0xd8, 0xa9, 0xa3, 0x51, 0xcb, 0xc2, 0x3e, 0x7c, 0x0a,
-	0xd6, 0x86, 0x61, 0x87, 0x76, 0x15, 0x8b, 0x2d, 0xab, 0xad, 0x0e, 0xc3, 0x4e, 0x1d, 0x8d, 0xe0,
-	0x4f, 0x41, 0x9a, 0xe6, 0xc3, 0x22, 0xa1, 0xcf, 0x3b, 0x35, 0xab, 0xcd, 0x1c, 0x3b, 0x7f, 0x4b,
-	0x82, 0xb4, 0x1e, 0x5b, 0x73, 0x41, 0x0b, 0x0b, 0x82, 0x7e, 0x0b, 0x40, 0x80, 0x5d, 0xa7, 0x8f,
-	0xcc, 0x00, 0x3b, 0x4c, 0x2e, 0x73, 0xb0, 0x77, 0x77, 0x33, 0x4c, 0x65, 0x8b, 0x3a, 0x63, 0xe8,
-	0xd8, 0x39, 0x5a, 0xa2, 0x67, 0x47, 0x06, 0x3c, 0x04, 0xe9, 0x41, 0xd8, 0x27, 0x98, 0x49, 0xf1,
-	0xee, 0xdf, 0xbd, 0x4f, 0xea, 0x84, 0x12, 0xb8, 0x52, 0x6a, 0x10, 0xad, 0xe1, 0x1b, 0x7a, 0x29,
-	0xc7, 0x0c, 0x88, 0x45, 0xc2, 0x80, 0x65, 0x7c, 0xe3, 0xa0, 0xb0, 0x50, 0x49, 0x67, 0x30, 0x96,
-	0x04, 0xbe, 0x94, 0xda, 0x34, 0x07, 0xf1, 0xad, 0x8e, 0x58, 0x4d, 0xd1, 0x44, 0x9a, 0x43, 0x0b,
-	0xfb, 0x2c, 0x13, 0x99, 0x03, 0x79, 0xa1, 0x5c, 0xf4, 0x00, 0x51, 0x35, 0xd3, 0x8b, 0x44, 0x1e,
-	0xe9, 0x14, 0xa4, 0xe2, 0xeb, 0xc2, 0xb7, 0x60, 0x7d, 0x5e, 0x35, 0xc8, 0x09, 0x72, 0xf2, 0x47,
-	0xc8, 0x66, 0x66, 0xb2, 0x41, 0x65, 0x05, 0x24, 0x03, 0xec, 0xec, 0xfc, 0x63, 0x19, 0x24, 0xe9,
-	0x03, 0x17, 0x40, 0x62, 0xfa, 0x60, 0x9b, 0x37, 0xd7, 0x85, 0xc4, 0x7c, 0x35, 0x27, 0xb0, 0x0d,
-	0xf7, 0xc1, 0x32, 0x1b, 0xf0, 0x89, 0x87, 0x0c, 0x78, 0x06, 0xa5, 0x14, 0x36, 0xd9, 0x93, 0x0f,
-	0x99, 0xec, 0x0c, 0x0a, 0x9b, 0x20, 0x8d, 0xba, 0x76, 0x60, 0xb1, 0x12, 0xe4, 0xd3, 0x77, 0x67,
-	0x21, 0xaf, 0xa8, 0x54, 0x6b, 0x7a, 0xb9, 0x8e, 0x46, 0x95, 0xec, 0xcd, 0x75, 0x21, 0x15, 0x5b,
-	0xf4, 0x55, 0x99, 0x08, 0x8d, 0xeb, 0x2d, 0xc8, 0xb2, 0x17, 0x8e, 0xf2, 0xc6, 0x1a, 0x24, 0x73,
-	0xf0, 0x72, 0xb1, 0xe6, 0x49, 0x84, 0xe6, 0x42, 0x99, 0xc1, 0xcc, 0x84, 0xbf, 0x01, 0xc0, 0xf7,
-	0x88, 0x45, 0x90, 0x6d, 0x5a, 0x7c, 0xac, 0x66, 0x0e, 0xa4, 0x22, 0xff, 0x60, 0x16, 0xe3, 0x0f,
-	0x66, 0xd1, 0x88, 0x3f, 0x98, 0x95, 0xe5, 0x4f, 0xff, 0x2e, 0x08, 0x5a, 0x3a, 0xe2, 0x94, 0x09,
-	0xeb, 0x57, 0x6a, 0xd0, 0x2f, 0xc1, 0xfc, 0xa0, 0x5d, 0x8f, 0xbd, 0x7c, 0xc6, 0x6e, 0x81, 0x95,
-	0xee, 0x85, 0x85, 0x5d, 0x36, 0x51, 0xd3, 0x1a, 0x37, 0xee, 0x9c, 0x0b, 0xe9, 0x3b, 0xe7, 0x82,
-	0x24, 0x83, 0x69, 0x32, 0xa8, 0xd8, 0xa5, 0xd5, 0x0f, 0x51, 0xd4, 0xd0, 0xdc, 0x90, 0xaa, 0x20,
-	0x33, 0x17, 0x28, 0x7c, 0x02, 0x56, 0x99, 0x9f, 0x57, 0x54, 0x56, 0x8b, 0x2c, 0xda, 0xf6, 0xb3,
-	0x49, 0x9c, 0x60, 0x87, 0xcd, 0x1c, 0x95, 0x2c, 0x00, 0xc3, 0xb0, 0xd3, 0xc7, 0x5d, 0x9a, 0xd9,
-	0x57, 0x7f, 0x17, 0xc0, 0x5a, 0xf4, 0xfe, 0xf0, 0x25, 0xd8, 0xaa, 0x2b, 0x67, 0xa6, 0xd6, 0x3c,
-	0x56, 0xcc, 0x76, 0x43, 0x6f, 0x29, 0x55, 0xf5, 0xb7, 0xaa, 0x52, 0x13, 0x97, 0xa4, 0xcc, 0x78,
-	0x22, 0xaf, 0xb5, 0xdd, 0x9e, 0xeb, 0x7d, 0x74, 0xe1, 0xcf, 0xc0, 0xe3, 0x29, 0xec, 0xa4, 0xac,
-	0x1b, 0x8a, 0x66, 0xd6, 0x95, 0x33, 0x51, 0x90, 0xd6, 0xc7, 0x13, 0x39, 0x7d, 0x62, 0x05, 0x04,
-	0xf9, 0xf4, 0x7a, 0xbf, 0x00, 0x4f, 0xa6, 0x38, 0x5d, 0xa9, 0x36, 0x1b, 0xb5, 0xb2, 0x76, 0xc6,
-	0xa0, 0x09, 0x49, 0x1c, 0x4f, 0xe4, 0xac, 0x8e, 0xba, 0x9e, 0x6b, 0x5b, 0xfe, 0x88, 0xa2, 0x5f,
-	0x81, 0xed, 0x29, 0x5a, 0xf9, 0x9d, 0xa1, 0x68, 0x8d, 0xf2, 0x31, 0x03, 0x27, 0xa5, 0xcd, 0xf1,
-	0x44, 0xce, 0x28, 0x57, 0x04, 0xf9, 0xae, 0xd5, 0xaf, 0xa3, 0x91, 0x94, 0xfa, 0xe3, 0x9f, 0xf3,
-	0x4b, 0x7f, 0xfd, 0x4b, 0x5e, 0x78, 0xf5, 0x4d, 0x00, 0xd2, 0xe2, 0x2f, 0x2f, 0x7c, 0x03, 0xf6,
-	0xa8, 0xa8, 0x7e, 0x54, 0xd6, 0x14, 0xb3, 0xa6, 0xea, 0x86, 0xa6, 0x56, 0xda, 0x86, 0xda, 0x6c,
-	0x98, 0xad, 0xe6, 0xb1, 0x5a, 0x3d, 0xbb, 0x15, 0x26, 0x3b, 0xa8, 0xed, 0x06, 0x43, 0xd4, 0xc5,
-	0xe7, 0x18, 0xd9, 0xf0, 0x08, 0x94, 0x7e, 0x98, 0xff, 0x5e, 0x51, 0x0f, 0x8f, 0x0c, 0xa5, 0x66,
-	0x56, 0xce, 0x4c, 0xdd, 0x28, 0xd7, 0x15, 0x51, 0x90, 0x1e, 0x8f, 0x27, 0xf2, 0xe6, 0x7b, 0x84,
-	0x9d, 0x0b, 0x82, 0xec, 0xca, 0x48, 0x27, 0x56, 0x0f, 0xdd, 0xaf, 0xd4, 0x6c, 0x28, 0x66, 0x4b,
-	0xd1, 0xcc, 0xd3, 0xf2, 0xb1, 0x5a, 0x2b, 0x1b, 0x4d, 0x4d, 0x4c, 0x70, 0xa5, 0xa6, 0x8b, 0x5a,
-	0xc8, 0x3f, 0xb5, 0xfa, 0xd8, 0xb6, 0x88, 0xe7, 0xcf, 0x05, 0xff, 0x07, 0xb0, 0x56, 0xee, 0xf6,
-	0xd8, 0x0f, 0xab, 0x3d, 0xb0, 0x55, 0xae, 0xd6, 0x4d, 0xe3, 0xac, 0xa5, 0xdc, 0x17, 0x53, 0x01,
-	0x6c, 0x4e, 0xa1, 0x75, 0xe5, 0xec, 0x50, 0x69, 0x88, 0x82, 0x04, 0xc6, 0x13, 0x79, 0x95, 0xff,
-	0xb6, 0x82, 0x3f, 0x01, 0xeb, 0x53, 0x80, 0xae, 0x1e, 0x36, 0xc4, 0x84, 0x94, 0x1a, 0x4f, 0xe4,
-	0x65, 0x3a, 0x89, 0xd9, 0xe9, 0x02, 0x3b, 0xfd, 0x7f, 0x02, 0xfb, 0x7c, 0xf0, 0x39, 0x0a, 0x7f,
-	0x0e, 0x9e, 0xe8, 0xea, 0x21, 0xcd, 0x81, 0xd1, 0xd6, 0xef, 0xbb, 0xc2, 0x73, 0xf0, 0x68, 0x0e,
-	0xfc, 0xae, 0xad, 0xb4, 0x95, 0x5a, 0x7c, 0x89, 0x77, 0x21, 0x0a, 0x91, 0x0d, 0x5f, 0x00, 0x38,
-	0x07, 0xa1, 0xd7, 0x50, 0x1b, 0x87, 0x62, 0x82, 0x57, 0x22, 0xbd, 0x09, 0x76, 0x9d, 0x5b, 0x3a,
-	0x14, 0xa4, 0xd4, 0xc4, 0x24, 0xd7, 0xa1, 0x98, 0xef, 0x74, 0xca, 0x95, 0xa6, 0x66, 0x28, 0x35,
-	0x71, 0x99, 0xeb, 0x94, 0x3b, 0x6c, 0x92, 0xdc, 0x02, 0xa9, 0x0d, 0xf6, 0x06, 0xe2, 0x0a, 0x07,
-	0xa9, 0xee, 0x25, 0xcd, 0xfd, 0x5c, 0xe4, 0x7f, 0xe2, 0x3d, 0xc3, 0x12, 0x9f, 0xe3, 0x3d, 0xf3,
-	0x7d, 0xe2, 0x69, 0x1a, 0xa7, 0x3b, 0x8d, 0x66, 0x83, 0x56, 0x06, 0x4b, 0x63, 0xc3, 0x73, 0x69,
-	0xab, 0xc1, 0xe9, 0xa6, 0x71, 0xa4, 0x29, 0xfa, 0x51, 0xf3, 0xb8, 0x26, 0x26, 0x78, 0x0b, 0xcd,
-	0x7e, 0x0b, 0xbd, 0x00, 0x8f, 0xa6, 0xb0, 0x93, 0xf6, 0xb1, 0xa1, 0xea, 0xea, 0xa1, 0x98, 0x94,
-	0xb2, 0xe3, 0x89, 0x9c, 0x8a, 0x27, 0xc1, 0xac, 0x20, 0x2a, 0x27, 0x9f, 0x6f, 0xf2, 0xc2, 0x97,
-	0x9b, 0xbc, 0xf0, 0x9f, 0x9b, 0xbc, 0xf0, 0xe9, 0x6b, 0x7e, 0xe9, 0xcb, 0xd7, 0xfc, 0xd2, 0xbf,
-	0xbe, 0xe6, 0x97, 0x7e, 0xff, 0xda, 0xc1, 0xe4, 0x22, 0xec, 0x14, 0xbb, 0xde, 0xa0, 0x64, 0x5d,
-	0xa1, 0xbe, 0xe5, 0xbb, 0x88, 0x7c, 0xf4, 0xfc, 0x5e, 0x64, 0xfd, 0xb2, 0xeb, 0xf9, 0xa8, 0x74,
-	0x55, 0x9a, 0xff, 0xd3, 0xd2, 0x59, 0x65, 0xd3, 0xf1, 0xf5, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0xe6, 0x74, 0x19, 0x48, 0xcb, 0x0c, 0x00, 0x00,
+	// 1634 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x3d, 0x73, 0xdb, 0xc8,
+	0x19, 0x16, 0x48, 0x7d, 0x90, 0x4b, 0x4a, 0x82, 0xd7, 0xb2, 0x8f, 0x83, 0xc4, 0x24, 0x4e, 0x17,
+	0x67, 0x2c, 0x27, 0x21, 0x63, 0x5d, 0x93, 0xca, 0x19, 0x7e, 0x20, 0x22, 0x4c, 0x8a, 0xe4, 0x01,
+	0xa0, 0x1c, 0xa5, 0xc1, 0x80, 0xe4, 0x1a, 0xdc, 0x21, 0x01, 0xf0, 0x80, 0x85, 0x2d, 0xce, 0xa4,
+	0x48, 0x99, 0x61, 0x75, 0x55, 0x3a, 0x56, 0x49, 0x91, 0x26, 0xff, 0x20, 0x7d, 0xae, 0xbc, 0x22,
+	0x45, 0x2a, 0x25, 0x23, 0xff, 0x83, 0x34, 0x99, 0xb9, 0x2a, 0xb3, 0xbb, 0x00, 0xc8, 0xd3, 0x89,
+	0x91, 0xd2, 0xed, 0xfb, 0xee, 0xf3, 0x3c, 0x8b, 0xf7, 0x63, 0xdf, 0x25, 0x81, 0x4c, 0x82, 0xa0,
+	0x82, 0xae, 0x66, 0x9e, 0x4f, 0xd0, 0xa8, 0xf2, 0xfe, 0xd5, 0x00, 0x11, 0xeb, 0x55, 0x85, 0xcc,
+	0x67, 0x28, 0x28, 0xcf, 0x7c, 0x8f, 0x78, 0xf0, 0x88, 0x04, 0x41, 0x39, 0x46, 0x94, 0x23, 0x84,
+	0xf4, 0x2c, 0x24, 0x78, 0x1a, 0xac, 0x08, 0x63, 0x1f, 0x05, 0x63, 0x6f, 0x3a, 0xe2, 0x24, 0xe9,
+	0xc8, 0xf6, 0x6c, 0x8f, 0x2d, 0x2b, 0x74, 0x15, 0x79, 0x4b, 0xb6, 0xe7, 0xd9, 0x53, 0x54, 0x61,
+	0xd6, 0x20, 0x7c, 0x57, 0x21, 0xd8, 0x41, 0x01, 0xb1, 0x9c, 0x19, 0x07, 0x1c, 0xff, 0x7b, 0x07,
+	0x1c, 0xb4, 0xd0, 0x5c, 0x43, 0x5f, 0x86, 0xd8, 0x47, 0x0e, 0x72, 0x09, 0xfc, 0x05, 0xc8, 0x4c,
+	0xd0, 0xdc, 0xf4, 0xbd, 0x29, 0x2a, 0x08, 0xb2, 0xf0, 0xe2, 0xe0, 0xf4, 0x59, 0xf9, 0xae, 0x2f,
+	0x2a, 0x53, 0x9e, 0x37, 0x45, 0xda, 0xde, 0x84, 0x2f, 0x62, 0x26, 0x8d, 0xa5, 0x90, 0xba, 0x87,
+	0x69, 0xcc, 0x67, 0x9c, 0x49, 0x17, 0xb0, 0x07, 0x8e, 0x1c, 0xec, 0x9a, 0x13, 0x34, 0xb7, 0x91,
+	0x6b, 0x26, 0xb1, 0x15, 0xd2, 0xb2, 0xf0, 0x22, 0x77, 0x5a, 0x28, 0xb3, 0xd8, 0x13, 0xba, 0x11,
+	0xef, 0xd7, 0xb6, 0xbf, 0xbe, 0x2e, 0x6d, 0x69, 0xd0, 0xc1, 0x6e, 0x8b, 0x51, 0x93, 0x1d, 0xa8,
+	0x02, 0x31, 0xb0, 0xde, 0x21, 0x32, 0x5f, 0x53, 0xdb, 0x7e, 0x90, 0xda, 0x21, 0xe7, 0xad, 0xa4,
+	0x02, 0xf0, 0x8c, 0x86, 0x15, 0x8c, 0x2d, 0x1f, 0x99, 0x23, 0x1c, 0x10, 0x1f, 0x0f, 0x42, 0x82,
+	0x3d, 0xd7, 0x9c, 0x79, 0x53, 0x3c, 0x9c, 0x17, 0x76, 0x58, 0xac, 0x3f, 0xdf, 0x18, 0xab, 0x4e,
+	0x99, 0x8d, 0x35, 0x62, 0x8f, 0xf1, 0x34, 0x69, 0xb2, 0x71, 0x0f, 0xbe, 0x02, 0x4f, 0x1c, 0xeb,
+	0xca, 0x24, 0x1e, 0xb1, 0xa6, 0xd1, 0xd1, 0x43, 0x2f, 0x74, 0x49, 0x61, 0x57, 0x16, 0x5e, 0xa4,
+	0x35, 0xe8, 0x58, 0x57, 0x06, 0xdd, 0x63, 0xfc, 0x3a, 0xdd, 0x61, 0x14, 0xec, 0xde, 0x41, 0xd9,
+	0x8b, 0x28, 0xd8, 0xbd, 0x4d, 0xb9, 0x00, 0x9f, 0x44, 0x39, 0x7f, 0xef, 0x11, 0xec, 0xda, 0x6b,
+	0xc9, 0xca, 0x3c, 0x28, 0x59, 0x4f, 0x38, 0xfd, 0x82, 0xb1, 0x57, 0x29, 0xd3, 0xc0, 0x93, 0x00,
+	0xdb, 0x77, 0xa8, 0x66, 0x1f, 0xa4, 0xfa, 0x98, 0x92, 0x6f, 0x6b, 0x3e, 0x07, 0x07, 0x71, 0x7f,
+	0x60, 0x07, 0x79, 0x21, 0x29, 0x00, 0x16, 0xd7, 0x3e, 0xf7, 0x1a, 0xdc, 0x09, 0x3f, 0x05, 0x79,
+	0x76, 0x74, 0x0c, 0xca, 0x31, 0x50, 0x8e, 0xfa, 0x22, 0xc8, 0xf1, 0x5f, 0x52, 0x20, 0xa3, 0x63,
+	0xdb, 0x55, 0xdd, 0x77, 0x1e, 0x3c, 0x01, 0xbb, 0xb4, 0xba, 0x78, 0xc4, 0x9a, 0x3d, 0x5b, 0x83,
+	0x37, 0xd7, 0xa5, 0x9d, 0x16, 0x9a, 0xab, 0x8d, 0x6f, 0xe3, 0x85, 0xb6, 0x33, 0x41, 0x73, 0x75,
+	0x04, 0x65, 0xb0, 0x1b, 0x60, 0x9b, 0x42, 0x53, 0x0c, 0x9a, 0xa5, 0x50, 0x1d, 0xdb, 0x14, 0x11,
+	0x60, 0x5b, 0x1d, 0x41, 0x11, 0xa4, 0x9d, 0xc0, 0x66, 0x6d, 0x9b, 0xd7, 0xe8, 0x12, 0x9e, 0x00,
+	0x31, 0x70, 0xad, 0x59, 0x30, 0xf6, 0x08, 0xaf, 0x06, 0xf2, 0x59, 0x1f, 0xa6, 0xb5, 0xc3, 0xd8,
+	0x5f, 0xe7, 0x6e, 0x1a, 0xa0, 0x8f, 0xbe, 0x0c, 0x51, 0x40, 0x4c, 0xc7, 0x1b, 0x85, 0x53, 0xc4,
+	0x1a, 0x2b, 0xab, 0xed, 0x47, 0xde, 0x73, 0xe6, 0x84, 0x12, 0xc8, 0x38, 0x88, 0x58, 0x23, 0x8b,
+	0x58, 0xac, 0x19, 0xb2, 0x5a, 0x62, 0xc3, 0xd7, 0x20, 0x33, 0xf3, 0xb1, 0xe7, 0x63, 0x32, 0x67,
+	0x55, 0x3f, 0x38, 0x3d, 0xbe, 0xbb, 0x2b, 0x69, 0xf8, 0xbd, 0x08, 0xa9, 0x25, 0x1c, 0x78, 0x04,
+	0x76, 0x86, 0x63, 0x0b, 0xbb, 0xac, 0xfa, 0x59, 0x8d, 0x1b, 0xc7, 0x75, 0x00, 0x74, 0x6c, 0xb7,
+	0xd0, 0xbc, 0x67, 0x61, 0x1f, 0x7e, 0x02, 0xf6, 0x66, 0xe1, 0x80, 0xde, 0x55, 0x96, 0xb1, 0xbc,
+	0xb6, 0x3b, 0x0b, 0x07, 0x2d, 0x34, 0x87, 0x3f, 0x04, 0x59, 0x9a, 0x65, 0x8b, 0x84, 0x3e, 0xbf,
+	0xff, 0x79, 0x6d, 0xe5, 0x38, 0xfe, 0x7b, 0x1a, 0x64, 0xf5, 0xd8, 0x5a, 0x4b, 0xa5, 0xb0, 0x21,
+	0x95, 0x6f, 0x00, 0x08, 0xb0, 0x6b, 0x4f, 0x91, 0x19, 0x60, 0x9b, 0xc9, 0xe5, 0x4e, 0x4f, 0x36,
+	0x07, 0xc3, 0x64, 0xcb, 0x3a, 0x63, 0xe8, 0xd8, 0x6e, 0x6e, 0xd1, 0xb3, 0x23, 0x03, 0x9e, 0x81,
+	0xac, 0x13, 0x4e, 0x09, 0x66, 0x52, 0x7c, 0xa6, 0xbc, 0xb8, 0x4f, 0xea, 0x9c, 0x12, 0xb8, 0x52,
+	0xc6, 0x89, 0xd6, 0xf0, 0x35, 0xfd, 0x28, 0xdb, 0x0c, 0x88, 0x45, 0xc2, 0x80, 0xd5, 0xf1, 0xe0,
+	0xb4, 0xb4, 0x51, 0x49, 0x67, 0x30, 0x96, 0x04, 0xbe, 0xa4, 0xb5, 0xb3, 0x08, 0x41, 0xce, 0x8c,
+	0x04, 0xac, 0xb8, 0x69, 0x2d, 0xb1, 0xa5, 0x3e, 0xcd, 0x4f, 0xfc, 0xc5, 0x4d, 0xd6, 0xc5, 0x34,
+	0xc9, 0xe6, 0xcc, 0xc2, 0x3e, 0xcb, 0x52, 0xee, 0x54, 0xde, 0x78, 0x54, 0x54, 0x9c, 0xe8, 0xfe,
+	0x80, 0x20, 0xf1, 0x48, 0x17, 0x20, 0x13, 0x87, 0x02, 0xdf, 0x80, 0xfd, 0x75, 0xd5, 0xa0, 0x20,
+	0xc8, 0xe9, 0xff, 0x43, 0x36, 0xb7, 0x92, 0x0d, 0x6a, 0x3b, 0x20, 0x1d, 0x60, 0xfb, 0xf8, 0x6f,
+	0xdb, 0x20, 0x4d, 0x8b, 0x5f, 0x02, 0xa9, 0xa4, 0x98, 0x87, 0x37, 0xd7, 0xa5, 0xd4, 0xfa, 0xfd,
+	0x49, 0xe1, 0x11, 0x7c, 0x05, 0xb6, 0xd9, 0x93, 0x92, 0x7a, 0xc8, 0x93, 0xc2, 0xa0, 0x94, 0xc2,
+	0xde, 0x92, 0xf4, 0x43, 0xde, 0x12, 0x06, 0x85, 0x5d, 0x90, 0x45, 0xc3, 0x51, 0x60, 0xb1, 0xf6,
+	0xe4, 0xf3, 0xfe, 0x78, 0x23, 0xaf, 0xac, 0xd4, 0x1b, 0x7a, 0xb5, 0x85, 0xe6, 0xb5, 0xfc, 0xcd,
+	0x75, 0x29, 0x13, 0x5b, 0xb4, 0xe2, 0x4c, 0x84, 0xc6, 0xf5, 0x06, 0xe4, 0x59, 0xf5, 0xa3, 0xbc,
+	0xb1, 0xaa, 0xe5, 0x4e, 0x9f, 0x6f, 0xd6, 0x3c, 0x8f, 0xd0, 0x5c, 0x28, 0xe7, 0xac, 0x4c, 0xf8,
+	0x4b, 0x00, 0x7c, 0x8f, 0x58, 0x04, 0x8d, 0x4c, 0x8b, 0x0f, 0xf2, 0xdc, 0xa9, 0x54, 0xe6, 0x4f,
+	0x74, 0x39, 0x7e, 0xa2, 0xcb, 0x46, 0xfc, 0x44, 0xd7, 0xb6, 0xbf, 0xfa, 0x67, 0x49, 0xd0, 0xb2,
+	0x11, 0xa7, 0x4a, 0xd8, 0x84, 0xa0, 0x06, 0x7d, 0x7b, 0xd6, 0x47, 0xfb, 0x7e, 0xec, 0xe5, 0x53,
+	0xfd, 0xce, 0x5b, 0x7c, 0xe7, 0x24, 0xca, 0xde, 0x39, 0x89, 0x24, 0x19, 0x24, 0xc9, 0xa0, 0x62,
+	0xef, 0xad, 0x69, 0x88, 0xa2, 0xcb, 0xce, 0x0d, 0xa9, 0x0e, 0x72, 0x6b, 0x81, 0xc2, 0xa7, 0x60,
+	0x97, 0xf9, 0x79, 0x47, 0xe5, 0xb5, 0xc8, 0xa2, 0x23, 0x61, 0x35, 0xfb, 0x53, 0xec, 0xb0, 0x95,
+	0xa3, 0x96, 0x07, 0x60, 0x16, 0x0e, 0xa6, 0x78, 0x48, 0x33, 0xfb, 0xf2, 0xaf, 0x02, 0xd8, 0x8b,
+	0xea, 0x0f, 0x9f, 0x83, 0xa3, 0x96, 0x72, 0x69, 0x6a, 0xdd, 0xb6, 0x62, 0xf6, 0x3b, 0x7a, 0x4f,
+	0xa9, 0xab, 0xbf, 0x52, 0x95, 0x86, 0xb8, 0x25, 0xe5, 0x16, 0x4b, 0x79, 0xaf, 0xef, 0x4e, 0x5c,
+	0xef, 0x83, 0x0b, 0x7f, 0x0c, 0x1e, 0x27, 0xb0, 0xf3, 0xaa, 0x6e, 0x28, 0x9a, 0xd9, 0x52, 0x2e,
+	0x45, 0x41, 0xda, 0x5f, 0x2c, 0xe5, 0xec, 0xb9, 0x15, 0x10, 0xe4, 0xd3, 0xcf, 0xfb, 0x29, 0x78,
+	0x9a, 0xe0, 0x74, 0xa5, 0xde, 0xed, 0x34, 0xaa, 0xda, 0x25, 0x83, 0xa6, 0x24, 0x71, 0xb1, 0x94,
+	0xf3, 0x3a, 0x1a, 0x7a, 0xee, 0xc8, 0xf2, 0xe7, 0x14, 0xfd, 0x12, 0x3c, 0x49, 0xd0, 0xca, 0xaf,
+	0x0d, 0x45, 0xeb, 0x54, 0xdb, 0x0c, 0x9c, 0x96, 0x0e, 0x17, 0x4b, 0x39, 0xa7, 0x5c, 0x11, 0xe4,
+	0xbb, 0xd6, 0xb4, 0x85, 0xe6, 0x52, 0xe6, 0xf7, 0x7f, 0x2c, 0x6e, 0xfd, 0xf9, 0x4f, 0x45, 0xe1,
+	0xe5, 0xb7, 0x02, 0x90, 0x36, 0xbf, 0xf5, 0xf0, 0x35, 0x38, 0xa1, 0xa2, 0x7a, 0xb3, 0xaa, 0x29,
+	0x66, 0x43, 0xd5, 0x0d, 0x4d, 0xad, 0xf5, 0x0d, 0xb5, 0xdb, 0x31, 0x7b, 0xdd, 0xb6, 0x5a, 0xbf,
+	0xbc, 0x15, 0x26, 0x3b, 0xa8, 0xef, 0x06, 0x33, 0x34, 0xc4, 0xef, 0x30, 0x1a, 0xc1, 0x26, 0xa8,
+	0xfc, 0x6f, 0xfe, 0x5b, 0x45, 0x3d, 0x6b, 0x1a, 0x4a, 0xc3, 0xac, 0x5d, 0x9a, 0xba, 0x51, 0x6d,
+	0x29, 0xa2, 0x20, 0x3d, 0x5e, 0x2c, 0xe5, 0xc3, 0xb7, 0x08, 0xdb, 0x63, 0x82, 0x46, 0xb5, 0xb9,
+	0x4e, 0xac, 0x09, 0xba, 0x5f, 0xa9, 0xdb, 0x51, 0xcc, 0x9e, 0xa2, 0x99, 0x17, 0xd5, 0xb6, 0xda,
+	0xa8, 0x1a, 0x5d, 0x4d, 0x4c, 0x71, 0xa5, 0xae, 0x8b, 0x7a, 0xc8, 0xbf, 0xb0, 0xa6, 0x78, 0x64,
+	0x11, 0xcf, 0x5f, 0x0b, 0xfe, 0xb7, 0x60, 0xaf, 0x3a, 0x9c, 0xb0, 0x9f, 0x72, 0x27, 0xe0, 0xa8,
+	0x5a, 0x6f, 0x99, 0xc6, 0x65, 0x4f, 0xb9, 0x2f, 0xa6, 0x12, 0x38, 0x4c, 0xa0, 0x2d, 0xe5, 0xf2,
+	0x4c, 0xe9, 0x88, 0x82, 0x04, 0x16, 0x4b, 0x79, 0x97, 0xff, 0x9a, 0x83, 0x3f, 0x00, 0xfb, 0x09,
+	0x40, 0x57, 0xcf, 0x3a, 0x62, 0x4a, 0xca, 0x2c, 0x96, 0xf2, 0x36, 0x9d, 0xd2, 0xec, 0x74, 0x81,
+	0x9d, 0xfe, 0x3b, 0x01, 0xe4, 0xd7, 0x1f, 0x34, 0xf8, 0x23, 0x70, 0x44, 0xe1, 0x66, 0x4f, 0x53,
+	0xbb, 0x9a, 0x6a, 0x5c, 0x9a, 0x9d, 0xae, 0x76, 0x5e, 0x6d, 0x8b, 0x5b, 0x5c, 0xbd, 0xe3, 0xf9,
+	0x8e, 0x35, 0x85, 0x45, 0xf0, 0xe8, 0xbb, 0xa8, 0x76, 0xf7, 0xad, 0x28, 0x48, 0x7b, 0x8b, 0xa5,
+	0x9c, 0x6e, 0x7b, 0x1f, 0xa0, 0x0c, 0xe0, 0x77, 0xf7, 0x9b, 0xea, 0x59, 0x33, 0xfe, 0x84, 0x26,
+	0xb6, 0xc7, 0x6b, 0x9f, 0xf0, 0x1f, 0x81, 0xbd, 0x6e, 0xd1, 0x98, 0xff, 0x09, 0x78, 0xaa, 0xab,
+	0x67, 0xb4, 0x0c, 0x46, 0x5f, 0xbf, 0x2f, 0x0b, 0x9f, 0x82, 0x47, 0x6b, 0xe0, 0x2f, 0xfa, 0x4a,
+	0x5f, 0x69, 0xc4, 0x79, 0xf8, 0x22, 0x44, 0x21, 0x1a, 0xc1, 0xcf, 0x00, 0x5c, 0x83, 0xd0, 0x8f,
+	0x52, 0x3b, 0x67, 0x62, 0x8a, 0x5f, 0x06, 0x1a, 0x39, 0x76, 0xed, 0x5b, 0x3a, 0x14, 0xa4, 0x34,
+	0xc4, 0x34, 0xd7, 0xa1, 0x98, 0xef, 0xe9, 0x54, 0x6b, 0x5d, 0xcd, 0x50, 0x1a, 0xe2, 0x36, 0xd7,
+	0xa9, 0x0e, 0xd8, 0x30, 0xbb, 0x05, 0x52, 0x3b, 0xac, 0x0d, 0xc4, 0x1d, 0x0e, 0x52, 0xdd, 0xf7,
+	0xb4, 0xfc, 0x6b, 0x91, 0xff, 0x81, 0x5f, 0x5b, 0x56, 0xfb, 0x02, 0xbf, 0xb6, 0xdf, 0xaf, 0x3d,
+	0xad, 0x64, 0xb2, 0xd3, 0xe9, 0x76, 0x68, 0x73, 0xb2, 0x34, 0x76, 0x3c, 0x97, 0xde, 0x76, 0x98,
+	0x6c, 0x1a, 0x4d, 0x4d, 0xd1, 0x9b, 0xdd, 0x76, 0x43, 0x4c, 0xf1, 0x5b, 0xbc, 0xfa, 0x01, 0xf8,
+	0x19, 0x78, 0x94, 0xc0, 0xce, 0xfb, 0x6d, 0x43, 0xd5, 0xd5, 0x33, 0x31, 0x2d, 0xe5, 0x17, 0x4b,
+	0x39, 0x13, 0x0f, 0xa3, 0x55, 0x4f, 0xd6, 0xce, 0xbf, 0xbe, 0x29, 0x0a, 0xdf, 0xdc, 0x14, 0x85,
+	0x7f, 0xdd, 0x14, 0x85, 0xaf, 0x3e, 0x16, 0xb7, 0xbe, 0xf9, 0x58, 0xdc, 0xfa, 0xc7, 0xc7, 0xe2,
+	0xd6, 0x6f, 0x3e, 0xb7, 0x31, 0x19, 0x87, 0x83, 0xf2, 0xd0, 0x73, 0x2a, 0xd6, 0x15, 0x9a, 0x5a,
+	0xbe, 0x8b, 0xc8, 0x07, 0xcf, 0x9f, 0x44, 0xd6, 0xcf, 0x86, 0x9e, 0x8f, 0x2a, 0x57, 0x95, 0xf5,
+	0x7f, 0x6a, 0x83, 0x5d, 0x36, 0xa0, 0x3f, 0xff, 0xef, 0x00, 0xe9, 0xf4, 0xea, 0x59, 0xc0, 0x0d,
+	0x00, 0x00,
 }
 
 func (m *KeyRequirement) Marshal() (dAtA []byte, err error) {
@@ -1110,6 +1174,18 @@ func (m *SignInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Priority != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1210,6 +1286,11 @@ func (m *Signature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Attempts != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.SigStatus != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.SigStatus))
 		i--
@@ -1609,6 +1690,13 @@ func (m *SignInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovTypes(uint64(m.Priority))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1645,6 +1733,9 @@ func (m *Signature) Size() (n int) {
 	if m.SigStatus != 0 {
 		n += 1 + sovTypes(uint64(m.SigStatus))
 	}
+	if m.Attempts != 0 {
+		n += 1 + sovTypes(uint64(m.Attempts))
+	}
 	return n
 }
 
@@ -2320,6 +2411,57 @@ func (m *SignInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= SignPriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2609,6 +2751,25 @@ func (m *Signature) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
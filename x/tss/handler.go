@@ -49,6 +49,19 @@ func NewHandler(k keeper.Keeper, s types.Snapshotter, n types.Nexus, v types.Vot
 				result.Log = res.Log
 			}
 			return result, err
+		case *types.StartReshareRequest:
+			res, err := server.StartReshare(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.ProcessReshareTrafficRequest:
+			res, err := server.ProcessReshareTraffic(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.VoteReshareRequest:
+			res, err := server.VoteReshare(sdk.WrapSDKContext(ctx), msg)
+			result, err := sdk.WrapServiceResult(ctx, res, err)
+			if err == nil {
+				result.Log = res.Log
+			}
+			return result, err
 		case *types.SubmitMultisigPubKeysRequest:
 			res, err := server.SubmitMultisigPubKeys(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
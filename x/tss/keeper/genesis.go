@@ -110,6 +110,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, snapshotter types.Snapshotter, genS
 
 		k.setValidatorStatus(ctx, validatorStatus)
 	}
+
+	for _, evidence := range genState.MisbehaviourEvidence {
+		if _, ok := k.GetMisbehaviourEvidence(ctx, evidence.SessionID, evidence.Attempt, evidence.Criminal); ok {
+			panic(fmt.Errorf("misbehaviour evidence against %s for attempt %d of session %s already set", evidence.Criminal.String(), evidence.Attempt, evidence.SessionID))
+		}
+
+		k.setMisbehaviourEvidence(ctx, evidence)
+	}
 }
 
 // ExportGenesis returns the tss module's genesis state.
@@ -124,5 +132,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.getAllExternalKeys(ctx),
 		k.getSignedSigs(ctx),
 		k.getValidatorStatuses(ctx),
+		k.getAllMisbehaviourEvidence(ctx),
 	)
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -28,6 +29,9 @@ var (
 	externalKeysPrefix     = utils.KeyFromStr("external_key_ids")
 	sigPrefix              = utils.KeyFromStr("sig")
 	validatorStatusPrefix  = utils.KeyFromStr("validator_status")
+	rotatedAtHeightPrefix  = utils.KeyFromStr("rotated_at_height")
+	evidencePrefix         = utils.KeyFromStr("misbehaviour_evidence")
+	evidenceByValPrefix    = utils.KeyFromStr("validator_misbehaviour")
 	// temporary
 	keyInfoPrefix      = utils.KeyFromStr("info_for_key")
 	keygenStartPrefix  = utils.KeyFromStr("block_height")
@@ -36,6 +40,14 @@ var (
 	infoForSigPrefix   = utils.KeyFromStr("info_for_sig")
 	participatePrefix  = utils.KeyFromStr("part")
 	multisigSignPrefix = utils.KeyFromStr("multisig_sign")
+	trafficHashPrefix  = utils.KeyFromStr("traffic_hash")
+	trafficSeenPrefix  = utils.KeyFromStr("traffic_seen_height")
+	reshareInfoPrefix  = utils.KeyFromStr("reshare_info")
+	reshareDonePrefix  = utils.KeyFromStr("reshare_done")
+	scheduledPrefix    = utils.KeyFromStr("scheduled_rotation")
+	signFailurePrefix  = utils.KeyFromStr("sign_failure")
+	signStartPrefix    = utils.KeyFromStr("sign_start_height")
+	avgSignDurationKey = utils.KeyFromStr("avg_sign_duration")
 
 	multisigKeygenQueue = "multisig_keygen"
 	multisigSignQueue   = "multisig_sign"
@@ -43,7 +55,8 @@ var (
 
 // Keeper allows access to the broadcast state
 type Keeper struct {
-	slasher  snapshot.Slasher
+	staker   types.StakingKeeper
+	slasher  types.Slasher
 	rewarder types.Rewarder
 	params   params.Subspace
 	storeKey sdk.StoreKey
@@ -116,8 +129,9 @@ func (k Keeper) AssertMatchesRequirements(ctx sdk.Context, snapshotter snapshot.
 }
 
 // NewKeeper constructs a tss keeper
-func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace params.Subspace, slasher snapshot.Slasher, rewarder types.Rewarder) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace params.Subspace, staker types.StakingKeeper, slasher types.Slasher, rewarder types.Rewarder) Keeper {
 	return Keeper{
+		staker:   staker,
 		slasher:  slasher,
 		rewarder: rewarder,
 		cdc:      cdc,
@@ -225,6 +239,54 @@ func (k Keeper) GetMaxSimultaneousSignShares(ctx sdk.Context) int64 {
 	return shares
 }
 
+// getMaxSignAttempts returns the maximum number of sign sessions started for a single sig ID
+func (k Keeper) getMaxSignAttempts(ctx sdk.Context) int64 {
+	var attempts int64
+	k.params.Get(ctx, types.KeyMaxSignAttempts, &attempts)
+
+	return attempts
+}
+
+// getSignFailureCooldownInBlocks returns the number of blocks a validator is deprioritized in signer selection after a failed sign
+func (k Keeper) getSignFailureCooldownInBlocks(ctx sdk.Context) int64 {
+	var blocks int64
+	k.params.Get(ctx, types.KeySignFailureCooldownInBlocks, &blocks)
+
+	return blocks
+}
+
+// getMisbehaviourWindowInBlocks returns the number of blocks within which offences of a validator add up
+func (k Keeper) getMisbehaviourWindowInBlocks(ctx sdk.Context) int64 {
+	var blocks int64
+	k.params.Get(ctx, types.KeyMisbehaviourWindowInBlocks, &blocks)
+
+	return blocks
+}
+
+// getMisbehaviourJailThreshold returns the number of offences within the misbehaviour window after which a validator is jailed
+func (k Keeper) getMisbehaviourJailThreshold(ctx sdk.Context) int64 {
+	var threshold int64
+	k.params.Get(ctx, types.KeyMisbehaviourJailThreshold, &threshold)
+
+	return threshold
+}
+
+// getMisbehaviourJailDuration returns how long a validator jailed for misbehaving is held back from unjailing
+func (k Keeper) getMisbehaviourJailDuration(ctx sdk.Context) time.Duration {
+	var duration time.Duration
+	k.params.Get(ctx, types.KeyMisbehaviourJailDuration, &duration)
+
+	return duration
+}
+
+// GetSignQueueWeights returns the weights with which request modules and chains share the sign queue
+func (k Keeper) GetSignQueueWeights(ctx sdk.Context) []types.SignQueueWeight {
+	var weights []types.SignQueueWeight
+	k.params.Get(ctx, types.KeySignQueueWeights, &weights)
+
+	return weights
+}
+
 // SetAvailableOperator signals that a validator sent an ack
 func (k Keeper) SetAvailableOperator(ctx sdk.Context, validator sdk.ValAddress, presentKeys ...exported.KeyID) {
 	store := k.getStore(ctx)
@@ -32,6 +32,7 @@ func (k Keeper) StartKeygen(ctx sdk.Context, voter types.Voter, keyInfo types.Ke
 	k.setSnapshotCounterForKeyID(ctx, keyInfo.KeyID, snapshot.Counter)
 
 	// set key info that contains key role and key type
+	keyInfo.State = types.Generating
 	k.setKeyInfo(ctx, keyInfo)
 
 	keyRequirement, ok := k.GetKeyRequirement(ctx, keyInfo.KeyRole, keyInfo.KeyType)
@@ -68,6 +69,20 @@ func (k Keeper) StartKeygen(ctx sdk.Context, voter types.Voter, keyInfo types.Ke
 		return fmt.Errorf("invalid key type %s", keyInfo.KeyType.SimpleString())
 	}
 
+	participants, participantShareCounts := getParticipants(snapshot)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeKeygen,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueStart),
+			sdk.NewAttribute(types.AttributeKeyKeyType, keyInfo.KeyType.SimpleString()),
+			sdk.NewAttribute(types.AttributeKeyKeyID, string(keyInfo.KeyID)),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatInt(snapshot.CorruptionThreshold, 10)),
+			sdk.NewAttribute(types.AttributeKeyParticipants, string(types.ModuleCdc.LegacyAmino.MustMarshalJSON(participants))),
+			sdk.NewAttribute(types.AttributeKeyParticipantShareCounts, string(types.ModuleCdc.LegacyAmino.MustMarshalJSON(participantShareCounts))),
+			sdk.NewAttribute(types.AttributeKeyTimeout, strconv.FormatInt(keyRequirement.KeygenTimeout, 10)),
+		),
+	)
+
 	return nil
 }
 
@@ -95,6 +110,9 @@ func (k Keeper) SetKey(ctx sdk.Context, key exported.Key) {
 	if ok {
 		key.Role = keyInfo.KeyRole
 		key.Type = keyInfo.KeyType
+
+		keyInfo.State = types.Active
+		k.setKeyInfo(ctx, keyInfo)
 	}
 
 	if key.Role != exported.ExternalKey {
@@ -207,6 +225,7 @@ func (k Keeper) RotateKey(ctx sdk.Context, chain nexus.Chain, keyRole exported.K
 
 	k.setRotationCount(ctx, chain.Name, keyRole, r+1)
 	k.setRotatedAt(ctx, keyID)
+	k.setRotatedAtHeight(ctx, chain.Name, keyRole, ctx.BlockHeight())
 
 	return nil
 }
@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+)
+
+// StartReshare starts a session to redistribute the shares of the given key to the validators in the given snapshot.
+// The public key stays the same, only the holders of the shares change.
+func (k Keeper) StartReshare(ctx sdk.Context, voter types.Voter, keyID exported.KeyID, snapshot snapshot.Snapshot) (types.ReshareInfo, error) {
+	key, ok := k.GetKey(ctx, keyID)
+	if !ok {
+		return types.ReshareInfo{}, fmt.Errorf("key %s does not exist", keyID)
+	}
+
+	if key.Type != exported.Threshold {
+		return types.ReshareInfo{}, fmt.Errorf("key %s is of type %s, only %s keys can be reshared", keyID, key.Type.SimpleString(), exported.Threshold.SimpleString())
+	}
+
+	keyInfo, ok := k.getKeyInfo(ctx, keyID)
+	if !ok {
+		// keys generated before the key state was tracked are active by definition
+		keyInfo = types.KeyInfo{KeyID: keyID, KeyRole: key.Role, KeyType: key.Type, State: types.Active}
+	}
+
+	if keyInfo.State == types.Resharing {
+		ongoing, ok := k.getOngoingReshare(ctx, keyInfo)
+		if ok && ctx.BlockHeight() <= ongoing.Timeout {
+			return types.ReshareInfo{}, fmt.Errorf("key %s is already being reshared in session %s", keyID, ongoing.SessionID)
+		}
+
+		// the previous session timed out without result, so it can be abandoned
+		if ok {
+			k.deleteReshareSession(ctx, ongoing)
+		}
+	}
+
+	oldCounter, ok := k.GetSnapshotCounterForKeyID(ctx, keyID)
+	if !ok {
+		return types.ReshareInfo{}, fmt.Errorf("could not obtain snapshot counter for key ID %s", keyID)
+	}
+
+	if oldCounter >= snapshot.Counter {
+		return types.ReshareInfo{}, fmt.Errorf("key %s cannot be reshared to snapshot %d because it is held by snapshot %d", keyID, snapshot.Counter, oldCounter)
+	}
+
+	keyRequirement, ok := k.GetKeyRequirement(ctx, key.Role, key.Type)
+	if !ok {
+		return types.ReshareInfo{}, fmt.Errorf("key requirement for key role %s type %s not found", key.Role.SimpleString(), key.Type.SimpleString())
+	}
+
+	keyInfo.ReshareCount++
+	info := types.ReshareInfo{
+		SessionID:          getReshareSessionID(keyID, keyInfo.ReshareCount),
+		KeyID:              keyID,
+		OldSnapshotCounter: oldCounter,
+		NewSnapshotCounter: snapshot.Counter,
+		Timeout:            ctx.BlockHeight() + keyRequirement.KeygenTimeout,
+	}
+
+	pollKey := vote.NewPollKey(types.ModuleName, info.SessionID)
+	if err := voter.InitializePollWithSnapshot(
+		ctx,
+		pollKey,
+		snapshot.Counter,
+		vote.ExpiryAt(info.Timeout),
+		vote.Threshold(keyRequirement.KeygenVotingThreshold),
+	); err != nil {
+		return types.ReshareInfo{}, err
+	}
+
+	k.setReshareInfo(ctx, info)
+
+	keyInfo.State = types.Resharing
+	k.setKeyInfo(ctx, keyInfo)
+
+	return info, nil
+}
+
+// GetReshareInfo returns the info of the reshare session with the given ID
+func (k Keeper) GetReshareInfo(ctx sdk.Context, sessionID string) (info types.ReshareInfo, ok bool) {
+	return info, k.getStore(ctx).Get(reshareInfoPrefix.AppendStr(sessionID), &info)
+}
+
+// CompleteReshare hands the key of the given reshare session over to the new snapshot
+func (k Keeper) CompleteReshare(ctx sdk.Context, sessionID string, groupRecoveryInfo []byte) error {
+	info, ok := k.GetReshareInfo(ctx, sessionID)
+	if !ok {
+		return fmt.Errorf("reshare session %s not found", sessionID)
+	}
+
+	key, ok := k.GetKey(ctx, info.KeyID)
+	if !ok {
+		return fmt.Errorf("key %s does not exist", info.KeyID)
+	}
+
+	k.setSnapshotCounterForKeyID(ctx, info.KeyID, info.NewSnapshotCounter)
+	key.SnapshotCounter = info.NewSnapshotCounter
+	k.setKey(ctx, key)
+
+	// the recovery info of the old shares is useless now, replace it with the one collected during the session
+	recoveryInfo, _ := k.getKeyRecoveryInfo(ctx, exported.KeyID(sessionID))
+	recoveryInfo.KeyID = info.KeyID
+	recoveryInfo.Public = groupRecoveryInfo
+	k.setKeyRecoveryInfo(ctx, recoveryInfo)
+
+	keyInfo, ok := k.getKeyInfo(ctx, info.KeyID)
+	if !ok {
+		keyInfo = types.KeyInfo{KeyID: key.ID, KeyRole: key.Role, KeyType: key.Type}
+	}
+	keyInfo.State = types.Active
+	k.setKeyInfo(ctx, keyInfo)
+
+	k.deleteReshareSession(ctx, info)
+	// keep the completed session around so late voters can still hand in their private recovery info
+	k.getStore(ctx).Set(reshareDonePrefix.AppendStr(info.SessionID), &info)
+
+	return nil
+}
+
+// GetCompletedReshareInfo returns the info of the reshare session with the given ID if it completed successfully
+func (k Keeper) GetCompletedReshareInfo(ctx sdk.Context, sessionID string) (info types.ReshareInfo, ok bool) {
+	return info, k.getStore(ctx).Get(reshareDonePrefix.AppendStr(sessionID), &info)
+}
+
+// GetTimedOutReshares returns all ongoing reshare sessions that timed out without result
+func (k Keeper) GetTimedOutReshares(ctx sdk.Context) []types.ReshareInfo {
+	iter := k.getStore(ctx).Iterator(reshareInfoPrefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	var infos []types.ReshareInfo
+	for ; iter.Valid(); iter.Next() {
+		var info types.ReshareInfo
+		iter.UnmarshalValue(&info)
+
+		if ctx.BlockHeight() > info.Timeout {
+			infos = append(infos, info)
+		}
+	}
+
+	return infos
+}
+
+// AbortReshare ends the given reshare session without result. The key remains with the old snapshot
+func (k Keeper) AbortReshare(ctx sdk.Context, sessionID string) error {
+	info, ok := k.GetReshareInfo(ctx, sessionID)
+	if !ok {
+		return fmt.Errorf("reshare session %s not found", sessionID)
+	}
+
+	if keyInfo, ok := k.getKeyInfo(ctx, info.KeyID); ok {
+		keyInfo.State = types.Active
+		k.setKeyInfo(ctx, keyInfo)
+	}
+
+	k.deleteReshareSession(ctx, info)
+
+	return nil
+}
+
+// GetKeyState returns the lifecycle state of the given key
+func (k Keeper) GetKeyState(ctx sdk.Context, keyID exported.KeyID) types.KeyState {
+	keyInfo, ok := k.getKeyInfo(ctx, keyID)
+	if !ok {
+		return types.KeyStateUnspecified
+	}
+
+	return keyInfo.State
+}
+
+func (k Keeper) getOngoingReshare(ctx sdk.Context, keyInfo types.KeyInfo) (types.ReshareInfo, bool) {
+	return k.GetReshareInfo(ctx, getReshareSessionID(keyInfo.KeyID, keyInfo.ReshareCount))
+}
+
+func (k Keeper) setReshareInfo(ctx sdk.Context, info types.ReshareInfo) {
+	k.getStore(ctx).Set(reshareInfoPrefix.AppendStr(info.SessionID), &info)
+}
+
+func (k Keeper) deleteReshareSession(ctx sdk.Context, info types.ReshareInfo) {
+	k.getStore(ctx).Delete(reshareInfoPrefix.AppendStr(info.SessionID))
+	// private recovery infos are collected under the session ID while the session is ongoing
+	k.DeleteKeyRecoveryInfo(ctx, exported.KeyID(info.SessionID))
+	k.DeleteTrafficHashes(ctx, info.SessionID)
+}
+
+func getReshareSessionID(keyID exported.KeyID, reshareCount int64) string {
+	return fmt.Sprintf("%s_reshare_%d", keyID, reshareCount)
+}
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	rand2 "github.com/axelarnetwork/axelar-core/testutils/rand"
+	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+	"github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+func TestKeeper_StartReshare(t *testing.T) {
+	newSnapshot := func() snapshot.Snapshot {
+		newSnap := snap
+		newSnap.Counter = snap.Counter + rand2.I64Between(1, 100)
+		return newSnap
+	}
+
+	t.Run("should start a reshare session for a threshold key", func(t *testing.T) {
+		s := setup()
+		key := s.SetKey(t, s.Ctx, exported.MasterKey, exported.Threshold)
+		assert.Equal(t, types.Active, s.Keeper.GetKeyState(s.Ctx, key.ID))
+
+		info, err := s.Keeper.StartReshare(s.Ctx, s.Voter, key.ID, newSnapshot())
+		assert.NoError(t, err)
+		assert.Equal(t, key.ID, info.KeyID)
+		assert.Equal(t, snap.Counter, info.OldSnapshotCounter)
+		assert.Equal(t, types.Resharing, s.Keeper.GetKeyState(s.Ctx, key.ID))
+
+		actual, ok := s.Keeper.GetReshareInfo(s.Ctx, info.SessionID)
+		assert.True(t, ok)
+		assert.Equal(t, info, actual)
+	})
+
+	t.Run("should return error for multisig keys", func(t *testing.T) {
+		s := setup()
+		key := s.SetKey(t, s.Ctx, exported.MasterKey, exported.Multisig)
+
+		_, err := s.Keeper.StartReshare(s.Ctx, s.Voter, key.ID, newSnapshot())
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error if the snapshot is not newer than the current one", func(t *testing.T) {
+		s := setup()
+		key := s.SetKey(t, s.Ctx, exported.MasterKey, exported.Threshold)
+
+		_, err := s.Keeper.StartReshare(s.Ctx, s.Voter, key.ID, snap)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error while another session is ongoing and allow a new one after it timed out", func(t *testing.T) {
+		s := setup()
+		key := s.SetKey(t, s.Ctx, exported.MasterKey, exported.Threshold)
+
+		first, err := s.Keeper.StartReshare(s.Ctx, s.Voter, key.ID, newSnapshot())
+		assert.NoError(t, err)
+		sender := rand2.ValAddr()
+		s.Keeper.RecordTrafficHash(s.Ctx, first.SessionID, sender, &tofnd.TrafficOut{Payload: rand2.BytesBetween(1, 100)})
+
+		_, err = s.Keeper.StartReshare(s.Ctx, s.Voter, key.ID, newSnapshot())
+		assert.Error(t, err)
+
+		ctx := s.Ctx.WithBlockHeight(first.Timeout + 1)
+		second, err := s.Keeper.StartReshare(ctx, s.Voter, key.ID, newSnapshot())
+		assert.NoError(t, err)
+		assert.NotEqual(t, first.SessionID, second.SessionID)
+
+		_, ok := s.Keeper.GetReshareInfo(ctx, first.SessionID)
+		assert.False(t, ok)
+		assert.Empty(t, s.Keeper.getTrafficHashes(ctx, first.SessionID, sender))
+	})
+}
+
+func TestKeeper_GetTimedOutReshares(t *testing.T) {
+	s := setup()
+	newSnap := snap
+	newSnap.Counter = snap.Counter + 1
+
+	var infos []types.ReshareInfo
+	for i := 0; i < 3; i++ {
+		key := s.SetKey(t, s.Ctx, exported.MasterKey, exported.Threshold)
+		info, err := s.Keeper.StartReshare(s.Ctx, s.Voter, key.ID, newSnap)
+		assert.NoError(t, err)
+		infos = append(infos, info)
+	}
+
+	assert.Empty(t, s.Keeper.GetTimedOutReshares(s.Ctx.WithBlockHeight(infos[0].Timeout)))
+	assert.ElementsMatch(t, infos, s.Keeper.GetTimedOutReshares(s.Ctx.WithBlockHeight(infos[0].Timeout+1)))
+
+	assert.NoError(t, s.Keeper.AbortReshare(s.Ctx, infos[0].SessionID))
+	assert.ElementsMatch(t, infos[1:], s.Keeper.GetTimedOutReshares(s.Ctx.WithBlockHeight(infos[0].Timeout+1)))
+}
+
+func TestKeeper_CompleteReshare(t *testing.T) {
+	s := setup()
+	key := s.SetKey(t, s.Ctx, exported.MasterKey, exported.Threshold)
+	newSnap := snap
+	newSnap.Counter = snap.Counter + 1
+
+	info, err := s.Keeper.StartReshare(s.Ctx, s.Voter, key.ID, newSnap)
+	assert.NoError(t, err)
+
+	groupRecoveryInfo := rand2.BytesBetween(1, 100)
+	assert.NoError(t, s.Keeper.CompleteReshare(s.Ctx, info.SessionID, groupRecoveryInfo))
+
+	counter, ok := s.Keeper.GetSnapshotCounterForKeyID(s.Ctx, key.ID)
+	assert.True(t, ok)
+	assert.Equal(t, newSnap.Counter, counter)
+
+	actual, ok := s.Keeper.GetKey(s.Ctx, key.ID)
+	assert.True(t, ok)
+	assert.Equal(t, key.GetECDSAKey().GetValue(), actual.GetECDSAKey().GetValue())
+	assert.Equal(t, newSnap.Counter, actual.SnapshotCounter)
+
+	assert.Equal(t, groupRecoveryInfo, s.Keeper.GetGroupRecoveryInfo(s.Ctx, key.ID))
+	assert.Equal(t, types.Active, s.Keeper.GetKeyState(s.Ctx, key.ID))
+
+	_, ok = s.Keeper.GetReshareInfo(s.Ctx, info.SessionID)
+	assert.False(t, ok)
+	assert.Error(t, s.Keeper.CompleteReshare(s.Ctx, info.SessionID, groupRecoveryInfo))
+
+	completed, ok := s.Keeper.GetCompletedReshareInfo(s.Ctx, info.SessionID)
+	assert.True(t, ok)
+	assert.Equal(t, info, completed)
+}
+
+func TestKeeper_AbortReshare(t *testing.T) {
+	s := setup()
+	key := s.SetKey(t, s.Ctx, exported.MasterKey, exported.Threshold)
+	newSnap := snap
+	newSnap.Counter = snap.Counter + 1
+
+	info, err := s.Keeper.StartReshare(s.Ctx, s.Voter, key.ID, newSnap)
+	assert.NoError(t, err)
+	sender := rand2.ValAddr()
+	s.Keeper.RecordTrafficHash(s.Ctx, info.SessionID, sender, &tofnd.TrafficOut{Payload: rand2.BytesBetween(1, 100)})
+
+	assert.NoError(t, s.Keeper.AbortReshare(s.Ctx, info.SessionID))
+	assert.Empty(t, s.Keeper.getTrafficHashes(s.Ctx, info.SessionID, sender))
+
+	counter, ok := s.Keeper.GetSnapshotCounterForKeyID(s.Ctx, key.ID)
+	assert.True(t, ok)
+	assert.Equal(t, snap.Counter, counter)
+	assert.Equal(t, types.Active, s.Keeper.GetKeyState(s.Ctx, key.ID))
+
+	_, ok = s.Keeper.GetReshareInfo(s.Ctx, info.SessionID)
+	assert.False(t, ok)
+	_, ok = s.Keeper.GetCompletedReshareInfo(s.Ctx, info.SessionID)
+	assert.False(t, ok)
+}
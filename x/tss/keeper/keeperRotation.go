@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoprototypes "github.com/gogo/protobuf/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+// GetKeyRotationPolicies returns the policies for automatic key rotations
+func (k Keeper) GetKeyRotationPolicies(ctx sdk.Context) []types.KeyRotationPolicy {
+	var policies []types.KeyRotationPolicy
+	k.params.Get(ctx, types.KeyKeyRotationPolicies, &policies)
+
+	return policies
+}
+
+// GetRotatedAtHeight returns the block height at which the current key of the given role was rotated in for the given chain
+func (k Keeper) GetRotatedAtHeight(ctx sdk.Context, chain nexus.Chain, keyRole exported.KeyRole) (int64, bool) {
+	var height gogoprototypes.Int64Value
+	if ok := k.getStore(ctx).Get(getRotationStorageKey(rotatedAtHeightPrefix, chain.Name, keyRole), &height); !ok {
+		return 0, false
+	}
+
+	return height.Value, true
+}
+
+// GetScheduledRotation returns the automatic rotation that is in progress for the given chain and key role
+func (k Keeper) GetScheduledRotation(ctx sdk.Context, chain nexus.Chain, keyRole exported.KeyRole) (rotation types.ScheduledRotation, ok bool) {
+	return rotation, k.getStore(ctx).Get(getRotationStorageKey(scheduledPrefix, chain.Name, keyRole), &rotation)
+}
+
+// SetScheduledRotation stores the given automatic rotation
+func (k Keeper) SetScheduledRotation(ctx sdk.Context, rotation types.ScheduledRotation) {
+	k.getStore(ctx).Set(getRotationStorageKey(scheduledPrefix, rotation.Chain, rotation.KeyRole), &rotation)
+}
+
+// DeleteScheduledRotation deletes the automatic rotation for the given chain and key role
+func (k Keeper) DeleteScheduledRotation(ctx sdk.Context, chain nexus.Chain, keyRole exported.KeyRole) {
+	k.getStore(ctx).Delete(getRotationStorageKey(scheduledPrefix, chain.Name, keyRole))
+}
+
+func (k Keeper) setRotatedAtHeight(ctx sdk.Context, chain string, keyRole exported.KeyRole, height int64) {
+	k.getStore(ctx).Set(getRotationStorageKey(rotatedAtHeightPrefix, chain, keyRole), &gogoprototypes.Int64Value{Value: height})
+}
+
+func getRotationStorageKey(prefix utils.Key, chain string, keyRole exported.KeyRole) utils.Key {
+	return prefix.Append(utils.LowerCaseKey(chain)).Append(utils.KeyFromStr(keyRole.SimpleString()))
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	rand2 "github.com/axelarnetwork/axelar-core/testutils/rand"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+func TestKeeper_RotateKey_SetsRotatedAtHeight(t *testing.T) {
+	s := setup()
+	chain := evm.Ethereum
+
+	_, ok := s.Keeper.GetRotatedAtHeight(s.Ctx, chain, exported.MasterKey)
+	assert.False(t, ok)
+
+	height := rand2.I64Between(1, 1000000)
+	ctx := s.Ctx.WithBlockHeight(height)
+	key := s.SetKey(t, ctx, exported.MasterKey, chain.KeyType)
+	assert.NoError(t, s.Keeper.AssignNextKey(ctx, chain, exported.MasterKey, key.ID))
+	assert.NoError(t, s.Keeper.RotateKey(ctx, chain, exported.MasterKey))
+
+	actual, ok := s.Keeper.GetRotatedAtHeight(ctx, chain, exported.MasterKey)
+	assert.True(t, ok)
+	assert.Equal(t, height, actual)
+
+	_, ok = s.Keeper.GetRotatedAtHeight(ctx, chain, exported.SecondaryKey)
+	assert.False(t, ok)
+}
+
+func TestKeeper_ScheduledRotation(t *testing.T) {
+	s := setup()
+	chain := evm.Ethereum
+
+	rotation := types.ScheduledRotation{
+		Chain:     chain.Name,
+		KeyRole:   exported.SecondaryKey,
+		KeyID:     exported.KeyID(randDistinctStr.Next()),
+		StartedAt: rand2.I64Between(1, 1000000),
+	}
+	s.Keeper.SetScheduledRotation(s.Ctx, rotation)
+
+	actual, ok := s.Keeper.GetScheduledRotation(s.Ctx, chain, exported.SecondaryKey)
+	assert.True(t, ok)
+	assert.Equal(t, rotation, actual)
+
+	_, ok = s.Keeper.GetScheduledRotation(s.Ctx, chain, exported.MasterKey)
+	assert.False(t, ok)
+
+	s.Keeper.DeleteScheduledRotation(s.Ctx, chain, exported.SecondaryKey)
+	_, ok = s.Keeper.GetScheduledRotation(s.Ctx, chain, exported.SecondaryKey)
+	assert.False(t, ok)
+}
@@ -1,10 +1,10 @@
 package keeper
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"math/big"
-	"sort"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -14,12 +14,16 @@ import (
 	"github.com/axelarnetwork/axelar-core/utils"
 	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 	"github.com/axelarnetwork/axelar-core/x/tss/exported"
-	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
 	"github.com/axelarnetwork/axelar-core/x/tss/types"
 	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
 )
 
-const signQueueName = "sign_queue"
+const (
+	signQueueName = "sign_queue"
+
+	// number of completed signs the average sign duration is smoothed over
+	signDurationWindow = 10
+)
 
 // StartSign kickstarts signing
 func (k Keeper) StartSign(ctx sdk.Context, info exported.SignInfo, snapshotter types.Snapshotter, voter types.InitPoller) error {
@@ -30,17 +34,79 @@ func (k Keeper) StartSign(ctx sdk.Context, info exported.SignInfo, snapshotter t
 		return fmt.Errorf("sig ID '%s' has been used before", info.SigID)
 	}
 
+	if err := info.Priority.Validate(); err != nil {
+		return err
+	}
+
+	key, ok := k.GetKey(ctx, info.KeyID)
+	if !ok {
+		return fmt.Errorf("key %s not found", info.KeyID)
+	}
+
+	// clean up leftovers of an earlier aborted sign with the same sig ID
+	k.deleteSignExclusions(ctx, info.SigID)
+
+	if err := k.initSignSession(ctx, info, key, snapshotter, voter); err != nil {
+		return err
+	}
+
+	if key.Type == exported.Multisig {
+		// enqueue ongoing multisig sign
+		q := k.GetMultisigSignQueue(ctx)
+		// TODO: the sign will be queued and may not start right away, it might affect the sign timeout
+		if err := q.Enqueue(&gogoprototypes.StringValue{Value: info.SigID}); err != nil {
+			return err
+		}
+	}
+
+	q := k.GetSignQueue(ctx)
+	if err := q.Enqueue(&info); err != nil {
+		return err
+	}
+
+	k.setSigAttempt(ctx, info.SigID, 1)
+	return nil
+}
+
+// RetrySign restarts an unsuccessful sign session with a newly selected set of participants that excludes the given absentees.
+// The sign keeps its place in the sign queue. Returns an error if the sign cannot be retried, in which case it should be aborted
+func (k Keeper) RetrySign(ctx sdk.Context, info exported.SignInfo, absentees []sdk.ValAddress, snapshotter types.Snapshotter, voter types.InitPoller) error {
+	sig, status := k.GetSig(ctx, info.SigID)
+	if status != exported.SigStatus_Signing {
+		return fmt.Errorf("sign %s is not in progress", info.SigID)
+	}
+
+	for _, absentee := range absentees {
+		k.setSignFailure(ctx, absentee)
+		k.setExcludedFromSign(ctx, info.SigID, absentee)
+	}
+
+	if sig.Attempts >= k.getMaxSignAttempts(ctx) {
+		return fmt.Errorf("sign %s failed after %d attempts", info.SigID, sig.Attempts)
+	}
+
 	key, ok := k.GetKey(ctx, info.KeyID)
 	if !ok {
 		return fmt.Errorf("key %s not found", info.KeyID)
 	}
 
+	if err := k.initSignSession(ctx, info, key, snapshotter, voter); err != nil {
+		return err
+	}
+
+	k.setSigAttempt(ctx, info.SigID, sig.Attempts+1)
+	return nil
+}
+
+// initSignSession selects the participants of the next sign attempt and prepares its poll or multisig info.
+// The participants of a previous attempt are only replaced if the new attempt can be started
+func (k Keeper) initSignSession(ctx sdk.Context, info exported.SignInfo, key exported.Key, snapshotter types.Snapshotter, voter types.InitPoller) error {
 	snap, ok := snapshotter.GetSnapshot(ctx, info.SnapshotCounter)
 	if !ok {
 		return fmt.Errorf("could not find snapshot with sequence number #%d", info.SnapshotCounter)
 	}
 
-	participants, active, err := k.SelectSignParticipants(ctx, snapshotter, info, snap, key.Type)
+	participants, active, err := k.selectSignParticipants(ctx, snapshotter, info, snap, key.Type)
 	if err != nil {
 		return err
 	}
@@ -70,11 +136,6 @@ func (k Keeper) StartSign(ctx sdk.Context, info exported.SignInfo, snapshotter t
 
 	switch key.Type {
 	case exported.Threshold:
-		_, ok := k.GetKey(ctx, info.KeyID)
-		if !ok {
-			return fmt.Errorf("key %s not found", info.KeyID)
-		}
-
 		pollKey := vote.NewPollKey(types.ModuleName, info.SigID)
 		//TODO: method is deprecated, must be replaced with voter.InitializePoll
 		if err := voter.InitializePollWithSnapshot(
@@ -94,20 +155,13 @@ func (k Keeper) StartSign(ctx sdk.Context, info exported.SignInfo, snapshotter t
 			TargetNum: snap.CorruptionThreshold + 1,
 		}
 		k.SetMultisigSignInfo(ctx, multisigSignInfo)
-		// enqueue ongoing multisig sign
-		q := k.GetMultisigSignQueue(ctx)
-		// TODO: the sign will be queued and may not start right away, it might affect the sign timeout
-		if err := q.Enqueue(&gogoprototypes.StringValue{Value: info.SigID}); err != nil {
-			return err
-		}
 	default:
 		return fmt.Errorf("invalid key type %s", key.Type)
 	}
 
-	q := k.GetSignQueue(ctx)
-	err = q.Enqueue(&info)
-	if err != nil {
-		return err
+	k.deleteSignParticipants(ctx, info.SigID)
+	for _, participant := range participants {
+		k.setParticipateInSign(ctx, info.SigID, participant.GetSDKValidator().GetOperator(), participant.ShareCount)
 	}
 
 	k.Logger(ctx).Info(fmt.Sprintf("enqueued sign with corruption threshold [%d], signing share count [%d], online share count [%d], total share count [%d], excluded [%d] validators",
@@ -118,10 +172,19 @@ func (k Keeper) StartSign(ctx sdk.Context, info exported.SignInfo, snapshotter t
 		len(snap.Validators)-len(participants),
 	))
 
-	k.SetSigStatus(ctx, info.SigID, exported.SigStatus_Queued)
 	return nil
 }
 
+// setSigAttempt queues the sign for the given attempt
+func (k Keeper) setSigAttempt(ctx sdk.Context, sigID string, attempt int64) {
+	sig, _ := k.getSig(ctx, sigID)
+	sig.SigID = sigID
+	sig.SigStatus = exported.SigStatus_Queued
+	sig.Attempts = attempt
+
+	k.SetSig(ctx, sig)
+}
+
 func (k Keeper) getSignedSigs(ctx sdk.Context) (sigs []exported.Signature) {
 	iter := k.getStore(ctx).Iterator(sigPrefix.AppendStr("_"))
 	defer utils.CloseLogError(iter, k.Logger(ctx))
@@ -198,6 +261,19 @@ func (k Keeper) getSigStatus(ctx sdk.Context, sigID string) exported.SigStatus {
 // SelectSignParticipants appoints a subset of the specified validators to participate in sign ID and returns
 // the active share count and excluded validators if no error
 func (k Keeper) SelectSignParticipants(ctx sdk.Context, snapshotter types.Snapshotter, info exported.SignInfo, snap snapshot.Snapshot, keyType exported.KeyType) ([]snapshot.Validator, []snapshot.Validator, error) {
+	selectedSigners, activeValidators, err := k.selectSignParticipants(ctx, snapshotter, info, snap, keyType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, signer := range selectedSigners {
+		k.setParticipateInSign(ctx, info.SigID, signer.GetSDKValidator().GetOperator(), signer.ShareCount)
+	}
+
+	return selectedSigners, activeValidators, nil
+}
+
+func (k Keeper) selectSignParticipants(ctx sdk.Context, snapshotter types.Snapshotter, info exported.SignInfo, snap snapshot.Snapshot, keyType exported.KeyType) ([]snapshot.Validator, []snapshot.Validator, error) {
 	var activeValidators, excludedValidators []snapshot.Validator
 	available := k.GetAvailableOperators(ctx, info.KeyID)
 	validatorAvailable := make(map[string]bool)
@@ -221,6 +297,15 @@ func (k Keeper) SelectSignParticipants(ctx sdk.Context, snapshotter types.Snapsh
 			continue
 		}
 
+		if k.isExcludedFromSign(ctx, info.SigID, validator.GetSDKValidator().GetOperator()) {
+			k.Logger(ctx).Error(fmt.Sprintf("excluding validator %s from signing %s due to [failed-previous-attempt]",
+				validator.GetSDKValidator().GetOperator().String(),
+				info.SigID,
+			))
+			excludedValidators = append(excludedValidators, validator)
+			continue
+		}
+
 		if !validatorAvailable[validator.GetSDKValidator().GetOperator().String()] {
 			k.Logger(ctx).Error(fmt.Sprintf("excluding validator %s from signing %s due to [not-available]",
 				validator.GetSDKValidator().GetOperator().String(),
@@ -235,38 +320,124 @@ func (k Keeper) SelectSignParticipants(ctx sdk.Context, snapshotter types.Snapsh
 
 	selectedSigners := activeValidators
 	if keyType == exported.Threshold {
-		// optimize signing set for threshold
-		selectedSigners = k.optimizedSigningSet(activeValidators, snap.CorruptionThreshold)
+		// randomize signing set for threshold
+		selectedSigners = k.randomizedSigningSet(ctx, info.SigID, activeValidators, snap.CorruptionThreshold)
 	}
 
-	for _, signer := range selectedSigners {
-		k.setParticipateInSign(ctx, info.SigID, signer.GetSDKValidator().GetOperator(), signer.ShareCount)
+	return selectedSigners, activeValidators, nil
+}
+
+// selects a pseudo-random subset of the given participants, weighted by share count, whose total number of shares
+// amount to at least threshold+1. The selection is seeded with the block hash, so every node arrives at the same subset.
+// Validators that failed to sign recently are only selected if the others do not hold enough shares.
+func (k Keeper) randomizedSigningSet(ctx sdk.Context, sigID string, activeValidators []snapshot.Validator, threshold int64) []snapshot.Validator {
+	var preferred, deprioritized []snapshot.Validator
+	for _, validator := range activeValidators {
+		if k.hasRecentSignFailure(ctx, validator.GetSDKValidator().GetOperator()) {
+			deprioritized = append(deprioritized, validator)
+			continue
+		}
+
+		preferred = append(preferred, validator)
 	}
 
-	return selectedSigners, activeValidators, nil
+	seed := sha256.Sum256(append(append([]byte{}, ctx.HeaderHash()...), sigID...))
+	selected, shares := weightedSample(preferred, threshold+1, seed[:], 0)
+	if shares < threshold+1 {
+		fallback, _ := weightedSample(deprioritized, threshold+1-shares, seed[:], uint64(len(selected)))
+		selected = append(selected, fallback...)
+	}
+
+	isSelected := make(map[string]bool)
+	for _, validator := range selected {
+		isSelected[validator.GetSDKValidator().GetOperator().String()] = true
+	}
+
+	// keep the order of the snapshot
+	signers := make([]snapshot.Validator, 0, len(selected))
+	for _, validator := range activeValidators {
+		if isSelected[validator.GetSDKValidator().GetOperator().String()] {
+			signers = append(signers, validator)
+		}
+	}
+
+	return signers
 }
 
-// selects a subset of the given participants whose total number of shares
-// represent the top of the list and amount to at least threshold+1.
-func (k Keeper) optimizedSigningSet(activeValidators []snapshot.Validator, threshold int64) []snapshot.Validator {
-	if len(activeValidators) == 0 {
-		return []snapshot.Validator{}
+// draws validators without replacement with a probability proportional to their share count
+// until the drawn share count reaches the target. Returns the drawn validators and their total share count
+func weightedSample(validators []snapshot.Validator, target int64, seed []byte, round uint64) ([]snapshot.Validator, int64) {
+	candidates := make([]snapshot.Validator, len(validators))
+	copy(candidates, validators)
+
+	var selected []snapshot.Validator
+	var shares int64
+	for ; len(candidates) > 0 && shares < target; round++ {
+		var totalShares int64
+		for _, candidate := range candidates {
+			totalShares += candidate.ShareCount
+		}
+		if totalShares <= 0 {
+			break
+		}
+
+		roundSeed := sha256.Sum256(append(seed, sdk.Uint64ToBigEndian(round)...))
+		r := new(big.Int).Mod(new(big.Int).SetBytes(roundSeed[:]), big.NewInt(totalShares)).Int64()
+
+		index := 0
+		for ; index < len(candidates)-1; index++ {
+			if r < candidates[index].ShareCount {
+				break
+			}
+			r -= candidates[index].ShareCount
+		}
+
+		selected = append(selected, candidates[index])
+		shares += candidates[index].ShareCount
+		candidates = append(candidates[:index], candidates[index+1:]...)
 	}
 
-	sorted := make([]snapshot.Validator, len(activeValidators))
-	copy(sorted, activeValidators)
+	return selected, shares
+}
 
-	sort.SliceStable(sorted, func(i, j int) bool {
-		return sorted[i].ShareCount > sorted[j].ShareCount
-	})
+func (k Keeper) setSignFailure(ctx sdk.Context, validator sdk.ValAddress) {
+	k.getStore(ctx).SetRaw(signFailurePrefix.AppendStr(validator.String()), sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+}
 
-	var index int
-	var totalSubsetShares int64
-	for ; index < len(sorted) && totalSubsetShares < (threshold+1); index++ {
-		totalSubsetShares = totalSubsetShares + sorted[index].ShareCount
+// hasRecentSignFailure returns true if the given validator failed to sign within the sign failure cooldown period
+func (k Keeper) hasRecentSignFailure(ctx sdk.Context, validator sdk.ValAddress) bool {
+	bz := k.getStore(ctx).GetRaw(signFailurePrefix.AppendStr(validator.String()))
+	if bz == nil {
+		return false
 	}
 
-	return sorted[:index]
+	return int64(sdk.BigEndianToUint64(bz))+k.getSignFailureCooldownInBlocks(ctx) > ctx.BlockHeight()
+}
+
+func (k Keeper) setExcludedFromSign(ctx sdk.Context, sigID string, validator sdk.ValAddress) {
+	k.getStore(ctx).SetRaw(participatePrefix.AppendStr("excluded").AppendStr(sigID).AppendStr(validator.String()), []byte{1})
+}
+
+func (k Keeper) isExcludedFromSign(ctx sdk.Context, sigID string, validator sdk.ValAddress) bool {
+	return k.getStore(ctx).Has(participatePrefix.AppendStr("excluded").AppendStr(sigID).AppendStr(validator.String()))
+}
+
+func (k Keeper) deleteSignExclusions(ctx sdk.Context, sigID string) {
+	k.deleteAllWithPrefix(ctx, participatePrefix.AppendStr("excluded").AppendStr(sigID))
+}
+
+func (k Keeper) deleteSignParticipants(ctx sdk.Context, sigID string) {
+	k.deleteAllWithPrefix(ctx, participatePrefix.AppendStr("sign").AppendStr(sigID))
+}
+
+func (k Keeper) deleteAllWithPrefix(ctx sdk.Context, prefix utils.Key) {
+	store := k.getStore(ctx)
+	iter := store.Iterator(prefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.GetKey())
+	}
 }
 
 func (k Keeper) setParticipateInSign(ctx sdk.Context, sigID string, validator sdk.ValAddress, shareCount int64) {
@@ -321,24 +492,57 @@ func (k Keeper) DoesValidatorParticipateInSign(ctx sdk.Context, sigID string, va
 	return k.getStore(ctx).Has(participatePrefix.AppendStr("sign").AppendStr(sigID).AppendStr(validator.String()))
 }
 
-// PenalizeCriminal penalizes the criminal caught during tss protocol according to the given crime type
-func (k Keeper) PenalizeCriminal(ctx sdk.Context, criminal sdk.ValAddress, crimeType tofnd.MessageOut_CriminalList_Criminal_CrimeType) {
-	switch crimeType {
-	// currently we do not distinguish between malicious and non-malicious faults
-	case tofnd.CRIME_TYPE_MALICIOUS, tofnd.CRIME_TYPE_NON_MALICIOUS:
-		k.rewarder.GetPool(ctx, types.ModuleName).ClearRewards(criminal)
-		k.setSuspendedUntil(ctx, criminal, ctx.BlockHeight()+k.GetParams(ctx).SuspendDurationInBlocks)
-	default:
-		k.Logger(ctx).Info(fmt.Sprintf("no policy is set to penalize validator %s for crime type %s", criminal.String(), crimeType.String()))
-	}
-}
-
 // GetSignQueue returns the sign queue
 func (k Keeper) GetSignQueue(ctx sdk.Context) utils.SequenceKVQueue {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(signQueueName))
 	return utils.NewSequenceKVQueue(utils.NewNormalizedStore(store, k.cdc), uint64(k.getMaxSignQueueSize(ctx)), k.Logger(ctx))
 }
 
+// SetSignStartHeight records the current block height as the start of the sign session for the given sig ID
+func (k Keeper) SetSignStartHeight(ctx sdk.Context, sigID string) {
+	k.getStore(ctx).SetRaw(signStartPrefix.AppendStr(sigID), sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+}
+
+// GetSignStartHeight returns the block height at which the sign session for the given sig ID started
+func (k Keeper) GetSignStartHeight(ctx sdk.Context, sigID string) (int64, bool) {
+	bz := k.getStore(ctx).GetRaw(signStartPrefix.AppendStr(sigID))
+	if bz == nil {
+		return 0, false
+	}
+
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// RecordSignCompletion updates the average sign duration if the sign session for the given sig ID succeeded
+func (k Keeper) RecordSignCompletion(ctx sdk.Context, sigID string, status exported.SigStatus) {
+	startHeight, ok := k.GetSignStartHeight(ctx, sigID)
+	if !ok {
+		return
+	}
+	k.getStore(ctx).Delete(signStartPrefix.AppendStr(sigID))
+
+	if status != exported.SigStatus_Signed {
+		return
+	}
+
+	duration := ctx.BlockHeight() - startHeight
+	if avg, ok := k.GetAverageSignDuration(ctx); ok {
+		duration = (avg*(signDurationWindow-1) + duration) / signDurationWindow
+	}
+
+	k.getStore(ctx).SetRaw(avgSignDurationKey, sdk.Uint64ToBigEndian(uint64(duration)))
+}
+
+// GetAverageSignDuration returns the average number of blocks a successful sign session takes
+func (k Keeper) GetAverageSignDuration(ctx sdk.Context) (int64, bool) {
+	bz := k.getStore(ctx).GetRaw(avgSignDurationKey)
+	if bz == nil {
+		return 0, false
+	}
+
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
 // SetMultisigSignInfo stores the MultisigInfo for a multisig sign info
 func (k Keeper) SetMultisigSignInfo(ctx sdk.Context, info types.MultisigInfo) {
 	k.getStore(ctx).Set(multisigSignPrefix.AppendStr(info.ID), &info)
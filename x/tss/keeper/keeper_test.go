@@ -50,6 +50,9 @@ type testSetup struct {
 	Keeper      Keeper
 	Voter       types.Voter
 	Snapshotter *snapMock.SnapshotterMock
+	Staker      *tssMock.StakingKeeperMock
+	Slasher     *tssMock.SlasherMock
+	Rewarder    *tssMock.RewarderMock
 	Ctx         sdk.Context
 	PrivateKey  chan *ecdsa.PrivateKey
 	Signature   chan []byte
@@ -82,7 +85,8 @@ func setup() *testSetup {
 		Signature:   make(chan []byte, 1),
 	}
 
-	slasher := &snapMock.SlasherMock{
+	staker := &tssMock.StakingKeeperMock{}
+	slasher := &tssMock.SlasherMock{
 		GetValidatorSigningInfoFunc: func(ctx sdk.Context, address sdk.ConsAddress) (slashingTypes.ValidatorSigningInfo, bool) {
 			newInfo := slashingTypes.NewValidatorSigningInfo(
 				address,
@@ -99,10 +103,13 @@ func setup() *testSetup {
 	}
 	rewarder := &tssMock.RewarderMock{}
 
-	k := NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("tss"), subspace, slasher, rewarder)
+	k := NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("tss"), subspace, staker, slasher, rewarder)
 	k.SetParams(ctx, types.DefaultParams())
 
 	setup.Keeper = k
+	setup.Staker = staker
+	setup.Slasher = slasher
+	setup.Rewarder = rewarder
 	return setup
 }
 
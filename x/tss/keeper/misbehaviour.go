@@ -0,0 +1,205 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+	"github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+// RecordTrafficHash stores the hash of a message the given sender broadcast during the given tss session,
+// so it can be attached to the evidence should the sender be found guilty of misbehaving
+func (k Keeper) RecordTrafficHash(ctx sdk.Context, sessionID string, sender sdk.ValAddress, payload *tofnd.TrafficOut) {
+	hash := sha256.Sum256(k.cdc.MustMarshal(payload))
+	key := trafficHashPrefix.AppendStr(sessionID).AppendStr(sender.String()).AppendStr(hex.EncodeToString(hash[:]))
+
+	k.getStore(ctx).SetRaw(key, hash[:])
+
+	// keep track of the last traffic of the session, so the hashes of sessions that never conclude can be pruned
+	bz := make([]byte, 8)
+	binary.LittleEndian.PutUint64(bz, uint64(ctx.BlockHeight()))
+	k.getStore(ctx).SetRaw(trafficSeenPrefix.AppendStr(sessionID), bz)
+}
+
+// DeleteTrafficHashes deletes all traffic hashes recorded for the given tss session
+func (k Keeper) DeleteTrafficHashes(ctx sdk.Context, sessionID string) {
+	k.deleteAllWithPrefix(ctx, trafficHashPrefix.AppendStr(sessionID))
+	k.getStore(ctx).Delete(trafficSeenPrefix.AppendStr(sessionID))
+}
+
+// PruneStaleTrafficHashes deletes the traffic hashes of all tss sessions without any traffic within the misbehaviour window.
+// Sessions that time out or are abandoned without a result would otherwise keep their hashes forever
+func (k Keeper) PruneStaleTrafficHashes(ctx sdk.Context) {
+	iter := k.getStore(ctx).Iterator(trafficSeenPrefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	var staleSessions []string
+	for ; iter.Valid(); iter.Next() {
+		height := int64(binary.LittleEndian.Uint64(iter.Value()))
+		if ctx.BlockHeight()-height >= k.getMisbehaviourWindowInBlocks(ctx) {
+			staleSessions = append(staleSessions, strings.TrimPrefix(string(iter.Key()), string(trafficSeenPrefix.AsKey())+"_"))
+		}
+	}
+
+	for _, sessionID := range staleSessions {
+		k.DeleteTrafficHashes(ctx, sessionID)
+		k.Logger(ctx).Debug(fmt.Sprintf("pruned stale traffic hashes of tss session %s", sessionID))
+	}
+}
+
+func (k Keeper) getTrafficHashes(ctx sdk.Context, sessionID string, sender sdk.ValAddress) [][]byte {
+	iter := k.getStore(ctx).Iterator(trafficHashPrefix.AppendStr(sessionID).AppendStr(sender.String()))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	var hashes [][]byte
+	for ; iter.Valid(); iter.Next() {
+		hashes = append(hashes, iter.Value())
+	}
+
+	return hashes
+}
+
+// PenalizeCriminal penalizes the criminal caught during the given attempt of a tss session according to the given crime type
+// and records the evidence against it. Penalties escalate with the number of offences within the misbehaviour window,
+// from increasingly long suspensions up to jailing
+func (k Keeper) PenalizeCriminal(ctx sdk.Context, sessionID string, attempt int64, criminal sdk.ValAddress, crimeType tofnd.MessageOut_CriminalList_Criminal_CrimeType, accusers []sdk.ValAddress) {
+	switch crimeType {
+	case tofnd.CRIME_TYPE_MALICIOUS, tofnd.CRIME_TYPE_NON_MALICIOUS:
+		// currently we do not distinguish between malicious and non-malicious faults
+	default:
+		k.Logger(ctx).Info(fmt.Sprintf("no policy is set to penalize validator %s for crime type %s", criminal.String(), crimeType.String()))
+		return
+	}
+
+	if _, ok := k.GetMisbehaviourEvidence(ctx, sessionID, attempt, criminal); ok {
+		k.Logger(ctx).Debug(fmt.Sprintf("validator %s has already been penalized for attempt %d of session %s", criminal.String(), attempt, sessionID))
+		return
+	}
+
+	evidence := types.MisbehaviourEvidence{
+		SessionID:     sessionID,
+		Criminal:      criminal,
+		CrimeType:     crimeType,
+		Accusers:      accusers,
+		TrafficHashes: k.getTrafficHashes(ctx, sessionID, criminal),
+		Height:        ctx.BlockHeight(),
+		OffenceCount:  k.GetRecentOffenceCount(ctx, criminal) + 1,
+		Attempt:       attempt,
+	}
+
+	k.rewarder.GetPool(ctx, types.ModuleName).ClearRewards(criminal)
+
+	suspendedUntil := ctx.BlockHeight() + evidence.OffenceCount*k.GetParams(ctx).SuspendDurationInBlocks
+	if current := k.GetSuspendedUntil(ctx, criminal); current > suspendedUntil {
+		suspendedUntil = current
+	}
+	k.setSuspendedUntil(ctx, criminal, suspendedUntil)
+	evidence.SuspendedUntil = suspendedUntil
+
+	if evidence.OffenceCount >= k.getMisbehaviourJailThreshold(ctx) {
+		evidence.Jailed = k.jail(ctx, criminal)
+	}
+
+	k.setMisbehaviourEvidence(ctx, evidence)
+
+	k.Logger(ctx).Info(fmt.Sprintf("validator %s penalized for offence #%d in attempt %d of session %s: suspended until block %d, jailed %t",
+		criminal.String(), evidence.OffenceCount, attempt, sessionID, evidence.SuspendedUntil, evidence.Jailed))
+}
+
+// jail jails the given validator through the slashing keeper for the misbehaviour jail duration,
+// so it cannot unjail itself right away. Returns false if the validator could not be jailed
+func (k Keeper) jail(ctx sdk.Context, validator sdk.ValAddress) bool {
+	v := k.staker.Validator(ctx, validator)
+	if v == nil || v.IsJailed() {
+		return false
+	}
+
+	consAddr, err := v.GetConsAddr()
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("cannot jail validator %s: %s", validator.String(), err.Error()))
+		return false
+	}
+
+	k.slasher.Jail(ctx, consAddr)
+	// validators without signing info were never bonded, so the slashing module does not hold them back from unjailing anyway
+	if _, ok := k.slasher.GetValidatorSigningInfo(ctx, consAddr); ok {
+		k.slasher.JailUntil(ctx, consAddr, ctx.BlockTime().Add(k.getMisbehaviourJailDuration(ctx)))
+	}
+
+	return true
+}
+
+// GetMisbehaviourEvidence returns the evidence against the given validator for the given attempt of a tss session
+func (k Keeper) GetMisbehaviourEvidence(ctx sdk.Context, sessionID string, attempt int64, validator sdk.ValAddress) (types.MisbehaviourEvidence, bool) {
+	var evidence types.MisbehaviourEvidence
+	ok := k.getStore(ctx).Get(getEvidenceKey(sessionID, attempt, validator), &evidence)
+
+	return evidence, ok
+}
+
+// GetMisbehaviourHistory returns the evidence of all offences of the given validator, ordered by block height
+func (k Keeper) GetMisbehaviourHistory(ctx sdk.Context, validator sdk.ValAddress) []types.MisbehaviourEvidence {
+	iter := k.getStore(ctx).Iterator(evidenceByValPrefix.AppendStr(validator.String()))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	var history []types.MisbehaviourEvidence
+	for ; iter.Valid(); iter.Next() {
+		var evidence types.MisbehaviourEvidence
+		if k.getStore(ctx).Get(utils.KeyFromBz(iter.Value()), &evidence) {
+			history = append(history, evidence)
+		}
+	}
+
+	sort.SliceStable(history, func(i, j int) bool { return history[i].Height < history[j].Height })
+
+	return history
+}
+
+// GetRecentOffenceCount returns the number of offences of the given validator within the misbehaviour window
+func (k Keeper) GetRecentOffenceCount(ctx sdk.Context, validator sdk.ValAddress) int64 {
+	windowStart := ctx.BlockHeight() - k.getMisbehaviourWindowInBlocks(ctx)
+
+	var count int64
+	for _, evidence := range k.GetMisbehaviourHistory(ctx, validator) {
+		if evidence.Height > windowStart {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (k Keeper) setMisbehaviourEvidence(ctx sdk.Context, evidence types.MisbehaviourEvidence) {
+	key := getEvidenceKey(evidence.SessionID, evidence.Attempt, evidence.Criminal)
+
+	k.getStore(ctx).Set(key, &evidence)
+	k.getStore(ctx).SetRaw(evidenceByValPrefix.AppendStr(evidence.Criminal.String()).AppendStr(evidence.SessionID).AppendStr(strconv.FormatInt(evidence.Attempt, 10)), key.AsKey())
+}
+
+func getEvidenceKey(sessionID string, attempt int64, validator sdk.ValAddress) utils.Key {
+	return evidencePrefix.AppendStr(sessionID).AppendStr(strconv.FormatInt(attempt, 10)).AppendStr(validator.String())
+}
+
+func (k Keeper) getAllMisbehaviourEvidence(ctx sdk.Context) []types.MisbehaviourEvidence {
+	iter := k.getStore(ctx).Iterator(evidencePrefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	var evidence []types.MisbehaviourEvidence
+	for ; iter.Valid(); iter.Next() {
+		var e types.MisbehaviourEvidence
+		iter.UnmarshalValue(&e)
+
+		evidence = append(evidence, e)
+	}
+
+	return evidence
+}
@@ -0,0 +1,205 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/assert"
+
+	rand2 "github.com/axelarnetwork/axelar-core/testutils/rand"
+	reward "github.com/axelarnetwork/axelar-core/x/reward/exported"
+	rewardMock "github.com/axelarnetwork/axelar-core/x/reward/exported/mock"
+	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+	"github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+func TestKeeper_PenalizeCriminal(t *testing.T) {
+	var signingInfo slashingtypes.ValidatorSigningInfo
+	setupWithCriminal := func() (*testSetup, sdk.ValAddress, *stakingtypes.Validator) {
+		s := setup()
+		s.Ctx = s.Ctx.WithBlockHeight(rand2.I64Between(1000000, 2000000)).WithBlockTime(time.Unix(rand2.I64Between(1000000, 2000000), 0))
+
+		criminal := rand2.ValAddr()
+		validator, err := stakingtypes.NewValidator(criminal, ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+		if err != nil {
+			panic(err)
+		}
+
+		pool := &rewardMock.RewardPoolMock{ClearRewardsFunc: func(sdk.ValAddress) {}}
+		s.Rewarder.GetPoolFunc = func(sdk.Context, string) reward.RewardPool { return pool }
+		s.Staker.ValidatorFunc = func(_ sdk.Context, addr sdk.ValAddress) stakingtypes.ValidatorI {
+			if addr.Equals(criminal) {
+				return validator
+			}
+			return nil
+		}
+		s.Slasher.JailFunc = func(sdk.Context, sdk.ConsAddress) { validator.Jailed = true }
+
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			panic(err)
+		}
+		signingInfo = slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0)
+		s.Slasher.GetValidatorSigningInfoFunc = func(sdk.Context, sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, bool) {
+			return signingInfo, true
+		}
+		s.Slasher.JailUntilFunc = func(_ sdk.Context, _ sdk.ConsAddress, jailTime time.Time) { signingInfo.JailedUntil = jailTime }
+
+		return s, criminal, &validator
+	}
+
+	t.Run("should record the evidence with accusers and traffic hashes", func(t *testing.T) {
+		s, criminal, _ := setupWithCriminal()
+		sessionID := rand2.StrBetween(5, 20)
+		accusers := []sdk.ValAddress{rand2.ValAddr(), rand2.ValAddr()}
+
+		payload := &tofnd.TrafficOut{ToPartyUid: rand2.Str(10), Payload: rand2.BytesBetween(1, 100), IsBroadcast: true}
+		s.Keeper.RecordTrafficHash(s.Ctx, sessionID, criminal, payload)
+		s.Keeper.RecordTrafficHash(s.Ctx, sessionID, rand2.ValAddr(), &tofnd.TrafficOut{Payload: rand2.BytesBetween(1, 100)})
+
+		s.Keeper.PenalizeCriminal(s.Ctx, sessionID, 1, criminal, tofnd.CRIME_TYPE_MALICIOUS, accusers)
+
+		evidence, ok := s.Keeper.GetMisbehaviourEvidence(s.Ctx, sessionID, 1, criminal)
+		assert.True(t, ok)
+		assert.NoError(t, evidence.Validate())
+		assert.Equal(t, accusers, evidence.Accusers)
+		assert.Equal(t, tofnd.CRIME_TYPE_MALICIOUS, evidence.CrimeType)
+		assert.Equal(t, s.Ctx.BlockHeight(), evidence.Height)
+		assert.Equal(t, int64(1), evidence.OffenceCount)
+		assert.False(t, evidence.Jailed)
+
+		hash := sha256.Sum256(types.ModuleCdc.MustMarshal(payload))
+		assert.Equal(t, [][]byte{hash[:]}, evidence.TrafficHashes)
+
+		assert.Equal(t, s.Ctx.BlockHeight()+types.DefaultParams().SuspendDurationInBlocks, s.Keeper.GetSuspendedUntil(s.Ctx, criminal))
+		assert.Len(t, s.Rewarder.GetPoolCalls(), 1)
+
+		s.Keeper.DeleteTrafficHashes(s.Ctx, sessionID)
+		assert.Empty(t, s.Keeper.getTrafficHashes(s.Ctx, sessionID, criminal))
+	})
+
+	t.Run("should escalate suspensions and jail once the threshold is reached", func(t *testing.T) {
+		s, criminal, validator := setupWithCriminal()
+		params := types.DefaultParams()
+
+		for i := int64(1); i <= params.MisbehaviourJailThreshold; i++ {
+			sessionID := rand2.StrBetween(5, 20)
+			s.Keeper.PenalizeCriminal(s.Ctx, sessionID, 1, criminal, tofnd.CRIME_TYPE_NON_MALICIOUS, nil)
+
+			evidence, ok := s.Keeper.GetMisbehaviourEvidence(s.Ctx, sessionID, 1, criminal)
+			assert.True(t, ok)
+			assert.Equal(t, i, evidence.OffenceCount)
+			assert.Equal(t, s.Ctx.BlockHeight()+i*params.SuspendDurationInBlocks, evidence.SuspendedUntil)
+			assert.Equal(t, evidence.SuspendedUntil, s.Keeper.GetSuspendedUntil(s.Ctx, criminal))
+			assert.Equal(t, i == params.MisbehaviourJailThreshold, evidence.Jailed)
+
+			s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+		}
+
+		assert.True(t, validator.IsJailed())
+		assert.Len(t, s.Slasher.JailCalls(), 1)
+		assert.Len(t, s.Keeper.GetMisbehaviourHistory(s.Ctx, criminal), int(params.MisbehaviourJailThreshold))
+	})
+
+	t.Run("should keep a jailed validator from unjailing before the jail duration has passed", func(t *testing.T) {
+		s, criminal, _ := setupWithCriminal()
+		params := types.DefaultParams()
+
+		for i := int64(1); i <= params.MisbehaviourJailThreshold; i++ {
+			s.Keeper.PenalizeCriminal(s.Ctx, rand2.StrBetween(5, 20), 1, criminal, tofnd.CRIME_TYPE_MALICIOUS, nil)
+		}
+
+		assert.Len(t, s.Slasher.JailUntilCalls(), 1)
+		assert.Equal(t, s.Ctx.BlockTime().Add(params.MisbehaviourJailDuration), signingInfo.JailedUntil)
+
+		// the slashing module rejects unjailing as long as the block time is before the jailed until time
+		canUnjail := func(ctx sdk.Context) bool { return !ctx.BlockTime().Before(signingInfo.JailedUntil) }
+		assert.False(t, canUnjail(s.Ctx))
+		assert.False(t, canUnjail(s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(params.MisbehaviourJailDuration-time.Second))))
+		assert.True(t, canUnjail(s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(params.MisbehaviourJailDuration))))
+	})
+
+	t.Run("should prune the traffic hashes of sessions without traffic within the misbehaviour window", func(t *testing.T) {
+		s, criminal, _ := setupWithCriminal()
+		params := types.DefaultParams()
+		staleSession := rand2.StrBetween(5, 20)
+		activeSession := rand2.StrBetween(5, 20)
+
+		s.Keeper.RecordTrafficHash(s.Ctx, staleSession, criminal, &tofnd.TrafficOut{Payload: rand2.BytesBetween(1, 100)})
+		s.Keeper.RecordTrafficHash(s.Ctx, activeSession, criminal, &tofnd.TrafficOut{Payload: rand2.BytesBetween(1, 100)})
+
+		s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + params.MisbehaviourWindowInBlocks - 1)
+		s.Keeper.RecordTrafficHash(s.Ctx, activeSession, criminal, &tofnd.TrafficOut{Payload: rand2.BytesBetween(1, 100)})
+		s.Keeper.PruneStaleTrafficHashes(s.Ctx)
+		assert.Len(t, s.Keeper.getTrafficHashes(s.Ctx, staleSession, criminal), 1)
+
+		s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+		s.Keeper.PruneStaleTrafficHashes(s.Ctx)
+		assert.Empty(t, s.Keeper.getTrafficHashes(s.Ctx, staleSession, criminal))
+		assert.Len(t, s.Keeper.getTrafficHashes(s.Ctx, activeSession, criminal), 2)
+	})
+
+	t.Run("should not count offences outside of the misbehaviour window", func(t *testing.T) {
+		s, criminal, _ := setupWithCriminal()
+		params := types.DefaultParams()
+
+		s.Keeper.PenalizeCriminal(s.Ctx, rand2.StrBetween(5, 20), 1, criminal, tofnd.CRIME_TYPE_MALICIOUS, nil)
+		assert.Equal(t, int64(1), s.Keeper.GetRecentOffenceCount(s.Ctx, criminal))
+
+		s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + params.MisbehaviourWindowInBlocks)
+		assert.Equal(t, int64(0), s.Keeper.GetRecentOffenceCount(s.Ctx, criminal))
+
+		sessionID := rand2.StrBetween(5, 20)
+		s.Keeper.PenalizeCriminal(s.Ctx, sessionID, 1, criminal, tofnd.CRIME_TYPE_MALICIOUS, nil)
+
+		evidence, _ := s.Keeper.GetMisbehaviourEvidence(s.Ctx, sessionID, 1, criminal)
+		assert.Equal(t, int64(1), evidence.OffenceCount)
+		assert.Len(t, s.Keeper.GetMisbehaviourHistory(s.Ctx, criminal), 2)
+	})
+
+	t.Run("should penalize a criminal only once per session attempt", func(t *testing.T) {
+		s, criminal, _ := setupWithCriminal()
+		sessionID := rand2.StrBetween(5, 20)
+
+		s.Keeper.PenalizeCriminal(s.Ctx, sessionID, 1, criminal, tofnd.CRIME_TYPE_MALICIOUS, nil)
+		s.Keeper.PenalizeCriminal(s.Ctx, sessionID, 1, criminal, tofnd.CRIME_TYPE_MALICIOUS, nil)
+
+		assert.Len(t, s.Keeper.GetMisbehaviourHistory(s.Ctx, criminal), 1)
+		assert.Len(t, s.Rewarder.GetPoolCalls(), 1)
+	})
+
+	t.Run("should penalize a criminal separately for every attempt of a session", func(t *testing.T) {
+		s, criminal, _ := setupWithCriminal()
+		sessionID := rand2.StrBetween(5, 20)
+
+		s.Keeper.PenalizeCriminal(s.Ctx, sessionID, 1, criminal, tofnd.CRIME_TYPE_NON_MALICIOUS, nil)
+		s.Keeper.PenalizeCriminal(s.Ctx, sessionID, 2, criminal, tofnd.CRIME_TYPE_NON_MALICIOUS, nil)
+
+		first, ok := s.Keeper.GetMisbehaviourEvidence(s.Ctx, sessionID, 1, criminal)
+		assert.True(t, ok)
+		assert.Equal(t, int64(1), first.Attempt)
+		assert.Equal(t, int64(1), first.OffenceCount)
+
+		second, ok := s.Keeper.GetMisbehaviourEvidence(s.Ctx, sessionID, 2, criminal)
+		assert.True(t, ok)
+		assert.Equal(t, int64(2), second.Attempt)
+		assert.Equal(t, int64(2), second.OffenceCount)
+
+		assert.Len(t, s.Keeper.GetMisbehaviourHistory(s.Ctx, criminal), 2)
+		assert.Len(t, s.Rewarder.GetPoolCalls(), 2)
+	})
+
+	t.Run("should not penalize unknown crime types", func(t *testing.T) {
+		s, criminal, _ := setupWithCriminal()
+
+		s.Keeper.PenalizeCriminal(s.Ctx, rand2.StrBetween(5, 20), 1, criminal, tofnd.CRIME_TYPE_UNSPECIFIED, nil)
+
+		assert.Empty(t, s.Keeper.GetMisbehaviourHistory(s.Ctx, criminal))
+		assert.Equal(t, int64(0), s.Keeper.GetSuspendedUntil(s.Ctx, criminal))
+	})
+}
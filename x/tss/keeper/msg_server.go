@@ -183,26 +183,6 @@ func (s msgServer) StartKeygen(c context.Context, req *types.StartKeygenRequest)
 		return nil, err
 	}
 
-	participants := make([]string, 0, len(snapshot.Validators))
-	participantShareCounts := make([]uint32, 0, len(snapshot.Validators))
-	for _, validator := range snapshot.Validators {
-		participants = append(participants, validator.GetSDKValidator().GetOperator().String())
-		participantShareCounts = append(participantShareCounts, uint32(validator.ShareCount))
-	}
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(types.EventTypeKeygen,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueStart),
-			sdk.NewAttribute(types.AttributeKeyKeyType, req.KeyInfo.KeyType.SimpleString()),
-			sdk.NewAttribute(types.AttributeKeyKeyID, string(req.KeyInfo.KeyID)),
-			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatInt(snapshot.CorruptionThreshold, 10)),
-			sdk.NewAttribute(types.AttributeKeyParticipants, string(types.ModuleCdc.LegacyAmino.MustMarshalJSON(participants))),
-			sdk.NewAttribute(types.AttributeKeyParticipantShareCounts, string(types.ModuleCdc.LegacyAmino.MustMarshalJSON(participantShareCounts))),
-			sdk.NewAttribute(types.AttributeKeyTimeout, strconv.FormatInt(keyRequirement.KeygenTimeout, 10)),
-		),
-	)
-
 	s.Logger(ctx).Info(fmt.Sprintf("new Keygen: key_id [%s] threshold [%d] key_share_distribution_policy [%s]", req.KeyInfo.KeyID, snapshot.CorruptionThreshold, keyRequirement.KeyShareDistributionPolicy.SimpleString()))
 
 	telemetry.SetGaugeWithLabels(
@@ -255,6 +235,8 @@ func (s msgServer) ProcessKeygenTraffic(c context.Context, req *types.ProcessKey
 		return nil, fmt.Errorf("invalid message: sender [%.20s] does not participate in keygen [%s] ", senderAddress, req.SessionID)
 	}
 
+	s.RecordTrafficHash(ctx, req.SessionID, senderAddress, req.Payload)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.EventTypeKeygen,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -379,6 +361,7 @@ func (s msgServer) VotePubKey(c context.Context, req *types.VotePubKeyRequest) (
 	if poll.Is(vote.Pending) {
 		return &types.VotePubKeyResponse{Log: fmt.Sprintf("not enough votes to confirm public key %s yet", keyID)}, nil
 	}
+	defer s.DeleteTrafficHashes(ctx, string(keyID))
 
 	event := sdk.NewEvent(
 		types.EventTypeKeygen,
@@ -454,7 +437,7 @@ func (s msgServer) VotePubKey(c context.Context, req *types.VotePubKeyRequest) (
 				continue
 			}
 
-			s.TSSKeeper.PenalizeCriminal(ctx, criminalAddress, criminal.GetCrimeType())
+			s.TSSKeeper.PenalizeCriminal(ctx, string(keyID), 1, criminalAddress, criminal.GetCrimeType(), poll.GetResultVoters())
 
 			s.Logger(ctx).Info(fmt.Sprintf("criminal for generating key %s verified: %s - %s", keyID, criminal.GetPartyUid(), criminal.CrimeType.String()))
 		}
@@ -466,6 +449,224 @@ func (s msgServer) VotePubKey(c context.Context, req *types.VotePubKeyRequest) (
 	}
 }
 
+func (s msgServer) StartReshare(c context.Context, req *types.StartReshareRequest) (*types.StartReshareResponse, error) {
+	if !types.TSSEnabled {
+		return nil, fmt.Errorf("threshold signing is disabled")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	key, ok := s.GetKey(ctx, req.KeyID)
+	if !ok {
+		return nil, fmt.Errorf("key %s does not exist", req.KeyID)
+	}
+
+	keyRequirement, ok := s.GetKeyRequirement(ctx, key.Role, key.Type)
+	if !ok {
+		return nil, fmt.Errorf("key requirement for key role %s type %s not found", key.Role.SimpleString(), key.Type.SimpleString())
+	}
+
+	oldCounter, ok := s.GetSnapshotCounterForKeyID(ctx, req.KeyID)
+	if !ok {
+		return nil, fmt.Errorf("could not obtain snapshot counter for key ID %s", req.KeyID)
+	}
+
+	oldSnapshot, ok := s.snapshotter.GetSnapshot(ctx, oldCounter)
+	if !ok {
+		return nil, fmt.Errorf("could not obtain snapshot for counter %d", oldCounter)
+	}
+
+	// record the snapshot of active validators that the shares get redistributed to
+	newSnapshot, err := s.snapshotter.TakeSnapshot(ctx, keyRequirement)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := s.TSSKeeper.StartReshare(ctx, s.voter, req.KeyID, newSnapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	participants, participantShareCounts := getParticipants(newSnapshot)
+	oldParticipants, oldParticipantShareCounts := getParticipants(oldSnapshot)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeReshare,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueStart),
+			sdk.NewAttribute(types.AttributeKeyKeyID, string(req.KeyID)),
+			sdk.NewAttribute(types.AttributeKeySessionID, info.SessionID),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatInt(newSnapshot.CorruptionThreshold, 10)),
+			sdk.NewAttribute(types.AttributeKeyParticipants, string(types.ModuleCdc.LegacyAmino.MustMarshalJSON(participants))),
+			sdk.NewAttribute(types.AttributeKeyParticipantShareCounts, string(types.ModuleCdc.LegacyAmino.MustMarshalJSON(participantShareCounts))),
+			sdk.NewAttribute(types.AttributeKeyOldParticipants, string(types.ModuleCdc.LegacyAmino.MustMarshalJSON(oldParticipants))),
+			sdk.NewAttribute(types.AttributeKeyOldParticipantShareCounts, string(types.ModuleCdc.LegacyAmino.MustMarshalJSON(oldParticipantShareCounts))),
+			sdk.NewAttribute(types.AttributeKeyTimeout, strconv.FormatInt(keyRequirement.KeygenTimeout, 10)),
+		),
+	)
+
+	s.Logger(ctx).Info(fmt.Sprintf("new reshare: key_id [%s] session_id [%s] from snapshot %d to snapshot %d threshold [%d]",
+		req.KeyID, info.SessionID, info.OldSnapshotCounter, info.NewSnapshotCounter, newSnapshot.CorruptionThreshold))
+
+	return &types.StartReshareResponse{}, nil
+}
+
+func (s msgServer) ProcessReshareTraffic(c context.Context, req *types.ProcessReshareTrafficRequest) (*types.ProcessReshareTrafficResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	senderAddress := s.snapshotter.GetOperator(ctx, req.Sender)
+	if senderAddress.Empty() {
+		return nil, fmt.Errorf("invalid message: sender [%s] is not a validator", req.Sender)
+	}
+
+	info, ok := s.GetReshareInfo(ctx, req.SessionID)
+	if !ok {
+		return nil, fmt.Errorf("reshare session %s not found", req.SessionID)
+	}
+
+	// both the holders of the old shares and the receivers of the new shares take part in the session
+	if !s.participatesInSnapshot(ctx, senderAddress, info.OldSnapshotCounter) && !s.participatesInSnapshot(ctx, senderAddress, info.NewSnapshotCounter) {
+		return nil, fmt.Errorf("invalid message: sender [%.20s] does not participate in reshare [%s] ", senderAddress, req.SessionID)
+	}
+
+	s.RecordTrafficHash(ctx, req.SessionID, senderAddress, req.Payload)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeReshare,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueMsg),
+			sdk.NewAttribute(types.AttributeKeySessionID, req.SessionID),
+			sdk.NewAttribute(sdk.AttributeKeySender, senderAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyPayload, string(types.ModuleCdc.MustMarshalJSON(req.Payload)))))
+
+	return &types.ProcessReshareTrafficResponse{}, nil
+}
+
+func (s msgServer) VoteReshare(c context.Context, req *types.VoteReshareRequest) (*types.VoteReshareResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	voter := s.snapshotter.GetOperator(ctx, req.Sender)
+	if voter == nil {
+		return nil, fmt.Errorf("account %v is not registered as a validator proxy", req.Sender.String())
+	}
+
+	sessionID := req.PollKey.ID
+	info, ok := s.GetReshareInfo(ctx, sessionID)
+	if !ok {
+		// the session is already over, but voters that were too late for it still need to store their private recovery info
+		if completed, ok := s.GetCompletedReshareInfo(ctx, sessionID); ok {
+			if res, ok := req.Result.GetKeygenResultData().(*tofnd.MessageOut_KeygenResult_Data); ok {
+				if err := s.setReshareRecoveryInfo(ctx, voter, completed, completed.KeyID, res.Data); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		s.Logger(ctx).Debug(fmt.Sprintf("reshare session %s already completed", sessionID))
+		return &types.VoteReshareResponse{}, nil
+	}
+
+	var voteData codec.ProtoMarshaler
+	switch res := req.Result.GetKeygenResultData().(type) {
+	case *tofnd.MessageOut_KeygenResult_Criminals:
+		voteData = res.Criminals
+	case *tofnd.MessageOut_KeygenResult_Data:
+		// private recovery infos are collected under the session ID until the session completes
+		if err := s.setReshareRecoveryInfo(ctx, voter, info, exported.KeyID(sessionID), res.Data); err != nil {
+			return nil, err
+		}
+
+		voteData = &types.KeygenVoteData{
+			PubKey:            res.Data.GetPubKey(),
+			GroupRecoveryInfo: res.Data.GetGroupRecoverInfo(),
+		}
+	default:
+		return nil, fmt.Errorf("invalid data type")
+	}
+
+	poll := s.voter.GetPoll(ctx, req.PollKey)
+
+	if err := poll.Vote(voter, voteData); err != nil {
+		return nil, err
+	}
+
+	if poll.Is(vote.Pending) {
+		return &types.VoteReshareResponse{Log: fmt.Sprintf("not enough votes to complete reshare session %s yet", sessionID)}, nil
+	}
+	defer s.DeleteTrafficHashes(ctx, sessionID)
+
+	event := sdk.NewEvent(
+		types.EventTypeReshare,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyPoll, req.PollKey.String()),
+		sdk.NewAttribute(types.AttributeKeyKeyID, string(info.KeyID)),
+	)
+
+	if poll.Is(vote.Failed) {
+		s.Logger(ctx).Info(fmt.Sprintf("voting for reshare session '%s' has failed", sessionID))
+
+		if err := s.AbortReshare(ctx, sessionID); err != nil {
+			return nil, err
+		}
+
+		ctx.EventManager().EmitEvent(
+			event.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueReject)),
+		)
+
+		return &types.VoteReshareResponse{}, nil
+	}
+
+	s.Logger(ctx).Info(fmt.Sprintf("voting for reshare session '%s' has finished", sessionID))
+
+	result := poll.GetResult()
+	// result should be either KeygenVoteData or Criminals
+	switch reshareResult := result.(type) {
+	case *types.KeygenVoteData:
+		if err := s.CompleteReshare(ctx, sessionID, reshareResult.GroupRecoveryInfo); err != nil {
+			return nil, err
+		}
+
+		ctx.EventManager().EmitEvent(
+			event.AppendAttributes(
+				sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueDecided),
+				sdk.NewAttribute(types.AttributeKeyPayload, reshareResult.String()),
+			),
+		)
+
+		s.Logger(ctx).Info(fmt.Sprintf("key %s reshared to snapshot %d", info.KeyID, info.NewSnapshotCounter))
+
+		return &types.VoteReshareResponse{}, nil
+	case *tofnd.MessageOut_CriminalList:
+		s.Logger(ctx).Debug(fmt.Sprintf("extracting criminal list for reshare session %s", sessionID))
+
+		if err := s.AbortReshare(ctx, sessionID); err != nil {
+			return nil, err
+		}
+		poll.AllowOverride()
+
+		ctx.EventManager().EmitEvent(
+			event.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueReject)),
+		)
+
+		for _, criminal := range reshareResult.Criminals {
+			criminalAddress, _ := sdk.ValAddressFromBech32(criminal.GetPartyUid())
+			if err := validateCriminal(criminalAddress, poll); err != nil {
+				s.Logger(ctx).Error(err.Error())
+				continue
+			}
+
+			s.TSSKeeper.PenalizeCriminal(ctx, sessionID, 1, criminalAddress, criminal.GetCrimeType(), poll.GetResultVoters())
+
+			s.Logger(ctx).Info(fmt.Sprintf("criminal for resharing key %s verified: %s - %s", info.KeyID, criminal.GetPartyUid(), criminal.CrimeType.String()))
+		}
+
+		return &types.VoteReshareResponse{}, nil
+	default:
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
+			fmt.Sprintf("unrecognized voting result type: %T", result))
+	}
+}
+
 func (s msgServer) ProcessSignTraffic(c context.Context, req *types.ProcessSignTrafficRequest) (*types.ProcessSignTrafficResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -478,6 +679,8 @@ func (s msgServer) ProcessSignTraffic(c context.Context, req *types.ProcessSignT
 		return nil, fmt.Errorf("invalid message: sender [%.20s] does not participate in sign [%s] ", senderAddress, req.SessionID)
 	}
 
+	s.RecordTrafficHash(ctx, req.SessionID, senderAddress, req.Payload)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.EventTypeSign,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -492,10 +695,14 @@ func (s msgServer) ProcessSignTraffic(c context.Context, req *types.ProcessSignT
 func (s msgServer) VoteSig(c context.Context, req *types.VoteSigRequest) (*types.VoteSigResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	if _, status := s.GetSig(ctx, req.PollKey.ID); status == exported.SigStatus_Signed {
+	switch _, status := s.GetSig(ctx, req.PollKey.ID); status {
+	case exported.SigStatus_Signed:
 		// the signature is already set, no need for further processing of the vote
 		s.Logger(ctx).Debug(fmt.Sprintf("signature %s already verified", req.PollKey.ID))
 		return &types.VoteSigResponse{}, nil
+	case exported.SigStatus_Queued:
+		// votes for a failed attempt must not count towards the retry
+		return nil, fmt.Errorf("sign %s has not started yet", req.PollKey.ID)
 	}
 
 	voter := s.snapshotter.GetOperator(ctx, req.Sender)
@@ -516,6 +723,7 @@ func (s msgServer) VoteSig(c context.Context, req *types.VoteSigRequest) (*types
 	if poll.Is(vote.Pending) {
 		return &types.VoteSigResponse{Log: fmt.Sprintf("not enough votes to confirm signature %s yet", req.PollKey.ID)}, nil
 	}
+	defer s.DeleteTrafficHashes(ctx, req.PollKey.ID)
 
 	event := sdk.NewEvent(
 		types.EventTypeSign,
@@ -534,11 +742,7 @@ func (s msgServer) VoteSig(c context.Context, req *types.VoteSigRequest) (*types
 	}
 
 	if poll.Is(vote.Failed) {
-		event = event.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueReject))
-
-		s.DeleteInfoForSig(ctx, req.PollKey.ID)
-		s.SetSigStatus(ctx, req.PollKey.ID, exported.SigStatus_Aborted)
-		s.route(ctx, info)
+		event = event.AppendAttributes(s.retryOrAbortSign(ctx, info, nil)...)
 
 		return &types.VoteSigResponse{}, nil
 	}
@@ -554,6 +758,7 @@ func (s msgServer) VoteSig(c context.Context, req *types.VoteSigRequest) (*types
 			}
 			btcecPK := btcec.PublicKey(pk)
 
+			sig, _ := s.GetSig(ctx, req.PollKey.ID)
 			s.SetSig(ctx, exported.Signature{
 				SigID: req.PollKey.ID,
 				Sig: &exported.Signature_SingleSig_{
@@ -565,6 +770,7 @@ func (s msgServer) VoteSig(c context.Context, req *types.VoteSigRequest) (*types
 					},
 				},
 				SigStatus: exported.SigStatus_Signed,
+				Attempts:  sig.Attempts,
 			})
 			s.route(ctx, info)
 
@@ -578,12 +784,11 @@ func (s msgServer) VoteSig(c context.Context, req *types.VoteSigRequest) (*types
 		}
 
 		// TODO: allow vote for timeout only if params.TimeoutInBlocks has passed
-		s.DeleteInfoForSig(ctx, req.PollKey.ID)
-		s.SetSigStatus(ctx, req.PollKey.ID, exported.SigStatus_Aborted)
-		s.route(ctx, info)
-		event = event.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueReject))
 		poll.AllowOverride()
 
+		sig, _ := s.GetSig(ctx, req.PollKey.ID)
+
+		var criminals []sdk.ValAddress
 		for _, criminal := range signResult.GetCriminals().Criminals {
 			criminalAddress, _ := sdk.ValAddressFromBech32(criminal.GetPartyUid())
 			if err := validateCriminal(criminalAddress, poll); err != nil {
@@ -591,11 +796,14 @@ func (s msgServer) VoteSig(c context.Context, req *types.VoteSigRequest) (*types
 				continue
 			}
 
-			s.TSSKeeper.PenalizeCriminal(ctx, criminalAddress, criminal.GetCrimeType())
+			s.TSSKeeper.PenalizeCriminal(ctx, req.PollKey.ID, sig.Attempts, criminalAddress, criminal.GetCrimeType(), poll.GetResultVoters())
+			criminals = append(criminals, criminalAddress)
 
 			s.Logger(ctx).Info(fmt.Sprintf("criminal for signature %s verified: %s - %s", req.PollKey.ID, criminal.GetPartyUid(), criminal.CrimeType.String()))
 		}
 
+		event = event.AppendAttributes(s.retryOrAbortSign(ctx, info, criminals)...)
+
 		return &types.VoteSigResponse{}, nil
 	default:
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
@@ -764,6 +972,28 @@ func validateCriminal(criminal sdk.ValAddress, poll vote.Poll) error {
 	return nil
 }
 
+// retryOrAbortSign restarts the sign without the given absentees, or aborts it if no further attempt is possible.
+// Returns the event attributes describing the outcome
+func (s msgServer) retryOrAbortSign(ctx sdk.Context, info exported.SignInfo, absentees []sdk.ValAddress) []sdk.Attribute {
+	if err := s.RetrySign(ctx, info, absentees, s.snapshotter, s.voter); err != nil {
+		s.Logger(ctx).Info(fmt.Sprintf("aborting sign %s: %s", info.SigID, err.Error()))
+
+		s.DeleteInfoForSig(ctx, info.SigID)
+		s.SetSigStatus(ctx, info.SigID, exported.SigStatus_Aborted)
+		s.route(ctx, info)
+
+		return []sdk.Attribute{sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueReject)}
+	}
+
+	sig, _ := s.GetSig(ctx, info.SigID)
+	s.Logger(ctx).Info(fmt.Sprintf("retrying sign %s (attempt %d)", info.SigID, sig.Attempts))
+
+	return []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueRetry),
+		sdk.NewAttribute(types.AttributeKeyAttempt, strconv.FormatInt(sig.Attempts, 10)),
+	}
+}
+
 func (s msgServer) route(ctx sdk.Context, sigInfo exported.SignInfo) {
 	r := s.GetRouter()
 	if r.HasRoute(sigInfo.RequestModule) {
@@ -774,3 +1004,70 @@ func (s msgServer) route(ctx sdk.Context, sigInfo exported.SignInfo) {
 		}
 	}
 }
+
+// setReshareRecoveryInfo stores the private recovery info the voter obtained in the given reshare session under the given key ID
+func (s msgServer) setReshareRecoveryInfo(ctx sdk.Context, voter sdk.ValAddress, info types.ReshareInfo, keyID exported.KeyID, output *tofnd.KeygenOutput) error {
+	if s.HasPrivateRecoveryInfo(ctx, voter, keyID) {
+		return fmt.Errorf("voter %s already submitted their private recovery info", voter.String())
+	}
+
+	if !s.participatesInSnapshot(ctx, voter, info.NewSnapshotCounter) {
+		return fmt.Errorf("could not find validator %s in snapshot #%d", voter.String(), info.NewSnapshotCounter)
+	}
+
+	if err := s.assertSamePubKey(ctx, info.KeyID, output.GetPubKey()); err != nil {
+		return err
+	}
+
+	s.SetPrivateRecoveryInfo(ctx, voter, keyID, output.GetPrivateRecoverInfo())
+	return nil
+}
+
+func (s msgServer) participatesInSnapshot(ctx sdk.Context, validator sdk.ValAddress, counter int64) bool {
+	snapshot, ok := s.snapshotter.GetSnapshot(ctx, counter)
+	if !ok {
+		return false
+	}
+
+	_, ok = snapshot.GetValidator(validator)
+	return ok
+}
+
+// assertSamePubKey checks that a reshare session did not change the public key of the reshared key
+func (s msgServer) assertSamePubKey(ctx sdk.Context, keyID exported.KeyID, pubKeyBytes []byte) error {
+	if pubKeyBytes == nil {
+		return fmt.Errorf("public key is nil")
+	}
+
+	key, ok := s.GetKey(ctx, keyID)
+	if !ok {
+		return fmt.Errorf("key %s does not exist", keyID)
+	}
+
+	expected, err := btcec.ParsePubKey(key.GetECDSAKey().GetValue(), btcec.S256())
+	if err != nil {
+		return fmt.Errorf("could not parse public key of key %s: [%w]", keyID, err)
+	}
+
+	actual, err := btcec.ParsePubKey(pubKeyBytes, btcec.S256())
+	if err != nil {
+		return fmt.Errorf("could not parse public key bytes: [%w]", err)
+	}
+
+	if !expected.IsEqual(actual) {
+		return fmt.Errorf("reshare must not change the public key of key %s", keyID)
+	}
+
+	return nil
+}
+
+func getParticipants(snapshot snapshot.Snapshot) ([]string, []uint32) {
+	participants := make([]string, 0, len(snapshot.Validators))
+	participantShareCounts := make([]uint32, 0, len(snapshot.Validators))
+	for _, validator := range snapshot.Validators {
+		participants = append(participants, validator.GetSDKValidator().GetOperator().String())
+		participantShareCounts = append(participantShareCounts, uint32(validator.ShareCount))
+	}
+
+	return participants, participantShareCounts
+}
@@ -17,8 +17,10 @@ import (
 	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 	"github.com/axelarnetwork/axelar-core/x/tss/exported"
 	tssTestUtils "github.com/axelarnetwork/axelar-core/x/tss/exported/testutils"
+	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
 	"github.com/axelarnetwork/axelar-core/x/tss/types"
 	"github.com/axelarnetwork/axelar-core/x/tss/types/mock"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
 )
 
 func TestMsgServer_RotateKey(t *testing.T) {
@@ -109,6 +111,113 @@ func TestMsgServer_RotateKey(t *testing.T) {
 	}).Repeat(repeats))
 }
 
+func TestMsgServer_VoteReshare(t *testing.T) {
+	var (
+		server    types.MsgServiceServer
+		ctx       sdk.Context
+		tssKeeper *mock.TSSKeeperMock
+		voter     *mock.VoterMock
+		randSnap  snapshot.Snapshot
+		key       exported.Key
+		info      types.ReshareInfo
+	)
+	setup := func() {
+		randSnap = randSnapshot()
+		privKey, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			panic(err)
+		}
+		key = exported.Key{
+			ID:        tssTestUtils.RandKeyID(),
+			PublicKey: &exported.Key_ECDSAKey_{ECDSAKey: &exported.Key_ECDSAKey{Value: privKey.PubKey().SerializeCompressed()}},
+			Role:      exported.MasterKey,
+		}
+		info = types.ReshareInfo{SessionID: rand.Str(20), KeyID: key.ID, OldSnapshotCounter: randSnap.Counter - 1, NewSnapshotCounter: randSnap.Counter}
+
+		tssKeeper = &mock.TSSKeeperMock{
+			LoggerFunc:         func(ctx sdk.Context) log.Logger { return ctx.Logger() },
+			GetReshareInfoFunc: func(sdk.Context, string) (types.ReshareInfo, bool) { return types.ReshareInfo{}, false },
+			GetCompletedReshareInfoFunc: func(_ sdk.Context, sessionID string) (types.ReshareInfo, bool) {
+				return info, sessionID == info.SessionID
+			},
+			GetKeyFunc:                 func(_ sdk.Context, keyID exported.KeyID) (exported.Key, bool) { return key, keyID == key.ID },
+			HasPrivateRecoveryInfoFunc: func(sdk.Context, sdk.ValAddress, exported.KeyID) bool { return false },
+			SetPrivateRecoveryInfoFunc: func(sdk.Context, sdk.ValAddress, exported.KeyID, []byte) {},
+		}
+		snapshotter := &mock.SnapshotterMock{
+			GetOperatorFunc: func(sdk.Context, sdk.AccAddress) sdk.ValAddress {
+				return randSnap.Validators[0].GetSDKValidator().GetOperator()
+			},
+			GetSnapshotFunc: func(_ sdk.Context, counter int64) (snapshot.Snapshot, bool) {
+				return randSnap, counter == randSnap.Counter
+			},
+		}
+		voter = &mock.VoterMock{}
+		server = NewMsgServerImpl(tssKeeper, snapshotter, &mock.StakingKeeperMock{}, voter, &mock.NexusMock{}, &mock.RewarderMock{})
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+	}
+
+	newVoteRequest := func(pubKey []byte) *types.VoteReshareRequest {
+		return &types.VoteReshareRequest{
+			Sender:  rand.AccAddr(),
+			PollKey: vote.NewPollKey(types.ModuleName, info.SessionID),
+			Result: &tofnd.MessageOut_KeygenResult{
+				KeygenResultData: &tofnd.MessageOut_KeygenResult_Data{
+					Data: &tofnd.KeygenOutput{PubKey: pubKey, GroupRecoverInfo: rand.Bytes(10), PrivateRecoverInfo: rand.Bytes(10)},
+				},
+			},
+		}
+	}
+
+	repeats := 20
+	t.Run("should store the private recovery info of late voters under the reshared key", testutils.Func(func(t *testing.T) {
+		setup()
+		req := newVoteRequest(key.GetECDSAKey().GetValue())
+
+		_, err := server.VoteReshare(sdk.WrapSDKContext(ctx), req)
+
+		assert.NoError(t, err)
+		assert.Len(t, tssKeeper.SetPrivateRecoveryInfoCalls(), 1)
+		assert.Equal(t, key.ID, tssKeeper.SetPrivateRecoveryInfoCalls()[0].KeyID)
+		assert.Equal(t, randSnap.Validators[0].GetSDKValidator().GetOperator(), tssKeeper.SetPrivateRecoveryInfoCalls()[0].Sender)
+		assert.Equal(t, req.Result.GetData().GetPrivateRecoverInfo(), tssKeeper.SetPrivateRecoveryInfoCalls()[0].RecoveryInfo)
+		assert.Len(t, voter.GetPollCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should return error if a late voter reports a different public key", testutils.Func(func(t *testing.T) {
+		setup()
+		privKey, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			panic(err)
+		}
+
+		_, err = server.VoteReshare(sdk.WrapSDKContext(ctx), newVoteRequest(privKey.PubKey().SerializeCompressed()))
+
+		assert.Error(t, err)
+		assert.Len(t, tssKeeper.SetPrivateRecoveryInfoCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should return error if a late voter is not part of the new snapshot", testutils.Func(func(t *testing.T) {
+		setup()
+		info.NewSnapshotCounter = randSnap.Counter + 1
+
+		_, err := server.VoteReshare(sdk.WrapSDKContext(ctx), newVoteRequest(key.GetECDSAKey().GetValue()))
+
+		assert.Error(t, err)
+		assert.Len(t, tssKeeper.SetPrivateRecoveryInfoCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should ignore votes for aborted sessions", testutils.Func(func(t *testing.T) {
+		setup()
+		tssKeeper.GetCompletedReshareInfoFunc = func(sdk.Context, string) (types.ReshareInfo, bool) { return types.ReshareInfo{}, false }
+
+		_, err := server.VoteReshare(sdk.WrapSDKContext(ctx), newVoteRequest(key.GetECDSAKey().GetValue()))
+
+		assert.NoError(t, err)
+		assert.Len(t, tssKeeper.SetPrivateRecoveryInfoCalls(), 0)
+	}).Repeat(repeats))
+}
+
 func TestMsgServer_SubmitMultisigPubKey(t *testing.T) {
 	var (
 		server    types.MsgServiceServer
@@ -30,6 +30,8 @@ const (
 	QueryActiveOldKeysByValidator = "active-old-keys-validator"
 	QueryDeactivated              = "deactivated"
 	QExternalKeyID                = "external-key-id"
+	QuerySignQueuePosition        = "sign-queue-position"
+	QueryMisbehaviourHistory      = "misbehaviour-history"
 )
 
 // NewQuerier returns a new querier for the TSS module
@@ -42,6 +44,8 @@ func NewQuerier(k types.TSSKeeper, v types.Voter, s types.Snapshotter, staking t
 			res, err = QueryExternalKeyID(ctx, k, n, path[1])
 		case QuerySignature:
 			res, err = querySignatureStatus(ctx, k, v, path[1])
+		case QuerySignQueuePosition:
+			res, err = querySignQueuePosition(ctx, k, s, path[1])
 		case QueryKey:
 			keyID := exported.KeyID(path[1])
 			err = keyID.Validate()
@@ -80,6 +84,8 @@ func NewQuerier(k types.TSSKeeper, v types.Voter, s types.Snapshotter, staking t
 			res, err = queryActiveOldKeyIDsByValidator(ctx, k, n, s, path[1])
 		case QueryDeactivated:
 			res, err = queryDeactivatedOperator(ctx, k, s, staking)
+		case QueryMisbehaviourHistory:
+			res, err = queryMisbehaviourHistory(ctx, k, path[1])
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("unknown tss query endpoint: %s", path[0]))
 		}
@@ -235,6 +241,150 @@ func querySignatureStatus(ctx sdk.Context, k types.TSSKeeper, v types.Voter, sig
 	return res.Marshal()
 }
 
+func querySignQueuePosition(ctx sdk.Context, k types.TSSKeeper, s types.Snapshotter, sigID string) ([]byte, error) {
+	_, status := k.GetSig(ctx, sigID)
+
+	switch status {
+	case exported.SigStatus_Signing:
+		info, ok := k.GetInfoForSig(ctx, sigID)
+		if !ok {
+			return nil, fmt.Errorf("sig info for %s does not exist", sigID)
+		}
+
+		startHeight, _ := k.GetSignStartHeight(ctx, sigID)
+		res := types.QuerySignQueuePositionResponse{
+			SigStatus:            status,
+			RequestModule:        info.RequestModule,
+			Chain:                info.Chain,
+			Priority:             info.Priority,
+			EstimatedStartHeight: startHeight,
+		}
+
+		return res.Marshal()
+	case exported.SigStatus_Queued:
+		res, err := estimateSignStart(ctx, k, s, sigID)
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Marshal()
+	default:
+		return nil, fmt.Errorf("sign %s is not queued (status %s)", sigID, status.String())
+	}
+}
+
+type activeSign struct {
+	info      exported.SignInfo
+	shares    int64
+	endHeight int64
+}
+
+// estimateSignStart replays the sign scheduler with the average sign duration to estimate when the given sign starts
+func estimateSignStart(ctx sdk.Context, k types.TSSKeeper, s types.Snapshotter, sigID string) (types.QuerySignQueuePositionResponse, error) {
+	scheduler := types.NewSignScheduler(k.GetSignQueueWeights(ctx))
+	var active []activeSign
+	var activeShares int64
+
+	queue := k.GetSignQueue(ctx)
+	for i := uint64(0); ; i++ {
+		var info exported.SignInfo
+		if !queue.Peek(i, &info) {
+			break
+		}
+
+		snap, ok := s.GetSnapshot(ctx, info.SnapshotCounter)
+		if !ok {
+			continue
+		}
+		shares := snap.CorruptionThreshold + 1
+
+		switch _, status := k.GetSig(ctx, info.SigID); status {
+		case exported.SigStatus_Queued:
+			scheduler.Enqueue(types.QueuedSign{Info: info, Position: i, Shares: shares})
+		case exported.SigStatus_Signing:
+			startHeight, ok := k.GetSignStartHeight(ctx, info.SigID)
+			if !ok {
+				startHeight = ctx.BlockHeight()
+			}
+
+			scheduler.AddActive(info, shares)
+			activeShares += shares
+			active = append(active, activeSign{info: info, shares: shares, endHeight: startHeight + estimateSignDuration(ctx, k, info)})
+		}
+	}
+
+	queueSize := int64(scheduler.Len())
+	maxShares := k.GetMaxSimultaneousSignShares(ctx)
+	// queued signs start in the end blocker of the next block at the earliest
+	height := ctx.BlockHeight() + 1
+	position := int64(1)
+
+	for scheduler.Len() > 0 {
+		var stillActive []activeSign
+		for _, sign := range active {
+			if sign.endHeight <= height {
+				scheduler.RemoveActive(sign.info, sign.shares)
+				activeShares -= sign.shares
+				continue
+			}
+			stillActive = append(stillActive, sign)
+		}
+		active = stillActive
+
+		for next, ok := scheduler.Peek(); ok && activeShares+next.Shares <= maxShares; next, ok = scheduler.Peek() {
+			scheduler.Pop()
+			if next.Info.SigID == sigID {
+				return types.QuerySignQueuePositionResponse{
+					SigStatus:            exported.SigStatus_Queued,
+					RequestModule:        next.Info.RequestModule,
+					Chain:                next.Info.Chain,
+					Priority:             next.Info.Priority,
+					Position:             position,
+					QueueSize:            queueSize,
+					EstimatedStartHeight: height,
+				}, nil
+			}
+
+			position++
+			activeShares += next.Shares
+			active = append(active, activeSign{info: next.Info, shares: next.Shares, endHeight: height + estimateSignDuration(ctx, k, next.Info)})
+		}
+
+		if len(active) == 0 {
+			return types.QuerySignQueuePositionResponse{}, fmt.Errorf("sign queue is blocked by a sign that requires more than %d shares", maxShares)
+		}
+
+		// continue when the next active sign is expected to finish
+		height = active[0].endHeight
+		for _, sign := range active[1:] {
+			if sign.endHeight < height {
+				height = sign.endHeight
+			}
+		}
+	}
+
+	return types.QuerySignQueuePositionResponse{}, fmt.Errorf("sign %s not found in the sign queue", sigID)
+}
+
+// estimateSignDuration returns the average sign duration, or the sign timeout if no sign has completed yet
+func estimateSignDuration(ctx sdk.Context, k types.TSSKeeper, info exported.SignInfo) int64 {
+	if duration, ok := k.GetAverageSignDuration(ctx); ok && duration > 0 {
+		return duration
+	}
+
+	key, ok := k.GetKey(ctx, info.KeyID)
+	if !ok {
+		return 1
+	}
+
+	keyRequirement, ok := k.GetKeyRequirement(ctx, key.Role, key.Type)
+	if !ok || keyRequirement.SignTimeout <= 0 {
+		return 1
+	}
+
+	return keyRequirement.SignTimeout
+}
+
 func queryKeyStatus(ctx sdk.Context, k types.TSSKeeper, v types.Voter, keyID exported.KeyID) ([]byte, error) {
 	if key, ok := k.GetKey(ctx, keyID); ok {
 		switch pubKey := key.GetPublicKey().(type) {
@@ -531,3 +681,18 @@ func QueryExternalKeyID(ctx sdk.Context, k types.TSSKeeper, n types.Nexus, chain
 
 	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
 }
+
+func queryMisbehaviourHistory(ctx sdk.Context, k types.TSSKeeper, validatorAddr string) ([]byte, error) {
+	validator, err := sdk.ValAddressFromBech32(validatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	res := types.QueryMisbehaviourHistoryResponse{
+		Evidence:           k.GetMisbehaviourHistory(ctx, validator),
+		RecentOffenceCount: k.GetRecentOffenceCount(ctx, validator),
+		SuspendedUntil:     k.GetSuspendedUntil(ctx, validator),
+	}
+
+	return res.Marshal()
+}
@@ -147,7 +147,7 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 
 // EndBlock executes all state transitions this module requires at the end of each new block
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
-	return EndBlocker(ctx, req, am.keeper, am.voter, am.nexus, am.snapshotter)
+	return EndBlocker(ctx, req, am.keeper, am.voter, am.nexus, am.snapshotter, am.staker)
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
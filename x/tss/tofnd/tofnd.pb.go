@@ -242,6 +242,7 @@ type MessageIn struct {
 	//	*MessageIn_SignInit
 	//	*MessageIn_Traffic
 	//	*MessageIn_Abort
+	//	*MessageIn_ReshareInit
 	Data isMessageIn_Data `protobuf_oneof:"data"`
 }
 
@@ -296,11 +297,15 @@ type MessageIn_Traffic struct {
 type MessageIn_Abort struct {
 	Abort bool `protobuf:"varint,4,opt,name=abort,proto3,oneof" json:"abort,omitempty"`
 }
+type MessageIn_ReshareInit struct {
+	ReshareInit *ReshareInit `protobuf:"bytes,5,opt,name=reshare_init,json=reshareInit,proto3,oneof" json:"reshare_init,omitempty"`
+}
 
-func (*MessageIn_KeygenInit) isMessageIn_Data() {}
-func (*MessageIn_SignInit) isMessageIn_Data()   {}
-func (*MessageIn_Traffic) isMessageIn_Data()    {}
-func (*MessageIn_Abort) isMessageIn_Data()      {}
+func (*MessageIn_KeygenInit) isMessageIn_Data()  {}
+func (*MessageIn_SignInit) isMessageIn_Data()    {}
+func (*MessageIn_Traffic) isMessageIn_Data()     {}
+func (*MessageIn_Abort) isMessageIn_Data()       {}
+func (*MessageIn_ReshareInit) isMessageIn_Data() {}
 
 func (m *MessageIn) GetData() isMessageIn_Data {
 	if m != nil {
@@ -337,6 +342,13 @@ func (m *MessageIn) GetAbort() bool {
 	return false
 }
 
+func (m *MessageIn) GetReshareInit() *ReshareInit {
+	if x, ok := m.GetData().(*MessageIn_ReshareInit); ok {
+		return x.ReshareInit
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*MessageIn) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -344,6 +356,7 @@ func (*MessageIn) XXX_OneofWrappers() []interface{} {
 		(*MessageIn_SignInit)(nil),
 		(*MessageIn_Traffic)(nil),
 		(*MessageIn_Abort)(nil),
+		(*MessageIn_ReshareInit)(nil),
 	}
 }
 
@@ -991,6 +1004,118 @@ func (m *SignInit) GetMessageToSign() []byte {
 	return nil
 }
 
+// ReshareInit redistributes the shares of an existing key to a new set of
+// parties. The public key of the resulting shares is the same as before.
+type ReshareInit struct {
+	NewSessionUid       string   `protobuf:"bytes,1,opt,name=new_session_uid,json=newSessionUid,proto3" json:"new_session_uid,omitempty"`
+	KeyUid              string   `protobuf:"bytes,2,opt,name=key_uid,json=keyUid,proto3" json:"key_uid,omitempty"`
+	OldPartyUids        []string `protobuf:"bytes,3,rep,name=old_party_uids,json=oldPartyUids,proto3" json:"old_party_uids,omitempty"`
+	OldPartyShareCounts []uint32 `protobuf:"varint,4,rep,packed,name=old_party_share_counts,json=oldPartyShareCounts,proto3" json:"old_party_share_counts,omitempty"`
+	NewPartyUids        []string `protobuf:"bytes,5,rep,name=new_party_uids,json=newPartyUids,proto3" json:"new_party_uids,omitempty"`
+	NewPartyShareCounts []uint32 `protobuf:"varint,6,rep,packed,name=new_party_share_counts,json=newPartyShareCounts,proto3" json:"new_party_share_counts,omitempty"`
+	NewThreshold        uint32   `protobuf:"varint,7,opt,name=new_threshold,json=newThreshold,proto3" json:"new_threshold,omitempty"`
+	// index into old_party_uids, or -1 if the server does not hold old shares
+	MyOldPartyIndex int32 `protobuf:"varint,8,opt,name=my_old_party_index,json=myOldPartyIndex,proto3" json:"my_old_party_index,omitempty"`
+	// index into new_party_uids, or -1 if the server does not receive new shares
+	MyNewPartyIndex int32 `protobuf:"varint,9,opt,name=my_new_party_index,json=myNewPartyIndex,proto3" json:"my_new_party_index,omitempty"`
+}
+
+func (m *ReshareInit) Reset()         { *m = ReshareInit{} }
+func (m *ReshareInit) String() string { return proto.CompactTextString(m) }
+func (*ReshareInit) ProtoMessage()    {}
+func (*ReshareInit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_762181cc22940332, []int{9}
+}
+func (m *ReshareInit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReshareInit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReshareInit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReshareInit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReshareInit.Merge(m, src)
+}
+func (m *ReshareInit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReshareInit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReshareInit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReshareInit proto.InternalMessageInfo
+
+func (m *ReshareInit) GetNewSessionUid() string {
+	if m != nil {
+		return m.NewSessionUid
+	}
+	return ""
+}
+
+func (m *ReshareInit) GetKeyUid() string {
+	if m != nil {
+		return m.KeyUid
+	}
+	return ""
+}
+
+func (m *ReshareInit) GetOldPartyUids() []string {
+	if m != nil {
+		return m.OldPartyUids
+	}
+	return nil
+}
+
+func (m *ReshareInit) GetOldPartyShareCounts() []uint32 {
+	if m != nil {
+		return m.OldPartyShareCounts
+	}
+	return nil
+}
+
+func (m *ReshareInit) GetNewPartyUids() []string {
+	if m != nil {
+		return m.NewPartyUids
+	}
+	return nil
+}
+
+func (m *ReshareInit) GetNewPartyShareCounts() []uint32 {
+	if m != nil {
+		return m.NewPartyShareCounts
+	}
+	return nil
+}
+
+func (m *ReshareInit) GetNewThreshold() uint32 {
+	if m != nil {
+		return m.NewThreshold
+	}
+	return 0
+}
+
+func (m *ReshareInit) GetMyOldPartyIndex() int32 {
+	if m != nil {
+		return m.MyOldPartyIndex
+	}
+	return 0
+}
+
+func (m *ReshareInit) GetMyNewPartyIndex() int32 {
+	if m != nil {
+		return m.MyNewPartyIndex
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("tss.tofnd.v1beta1.RecoverResponse_Response", RecoverResponse_Response_name, RecoverResponse_Response_value)
 	proto.RegisterEnum("tss.tofnd.v1beta1.MessageOut_CriminalList_Criminal_CrimeType", MessageOut_CriminalList_Criminal_CrimeType_name, MessageOut_CriminalList_Criminal_CrimeType_value)
@@ -1007,76 +1132,86 @@ func init() {
 	proto.RegisterType((*TrafficOut)(nil), "tss.tofnd.v1beta1.TrafficOut")
 	proto.RegisterType((*KeygenInit)(nil), "tss.tofnd.v1beta1.KeygenInit")
 	proto.RegisterType((*SignInit)(nil), "tss.tofnd.v1beta1.SignInit")
+	proto.RegisterType((*ReshareInit)(nil), "tss.tofnd.v1beta1.ReshareInit")
 }
 
 func init() { proto.RegisterFile("tss/tofnd/v1beta1/tofnd.proto", fileDescriptor_762181cc22940332) }
 
 var fileDescriptor_762181cc22940332 = []byte{
-	// 1012 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0xe3, 0x44,
-	0x18, 0xb6, 0xdb, 0x6e, 0x9b, 0xbc, 0x71, 0xba, 0xd9, 0x51, 0xb4, 0x1b, 0x65, 0x5b, 0x13, 0x02,
-	0x42, 0x11, 0x1f, 0xc9, 0x6e, 0x56, 0x48, 0x80, 0x04, 0x62, 0x9b, 0xcd, 0x12, 0x6f, 0x3f, 0xd2,
-	0x75, 0xda, 0x03, 0x08, 0xc9, 0x72, 0x92, 0x89, 0x6b, 0x25, 0xf1, 0x18, 0xcf, 0xb8, 0x6d, 0x7e,
-	0x01, 0x08, 0x71, 0xe0, 0xc4, 0x19, 0x89, 0x03, 0xe2, 0x47, 0x70, 0xe7, 0xd8, 0x23, 0x47, 0xd4,
-	0x5e, 0xf9, 0x11, 0x68, 0xc6, 0x63, 0xc7, 0x4d, 0xb3, 0xb0, 0xac, 0xf6, 0x36, 0xef, 0xe7, 0xf3,
-	0x3c, 0xef, 0x7c, 0xd8, 0xb0, 0xcd, 0x28, 0x6d, 0x30, 0x32, 0xf2, 0x86, 0x8d, 0xd3, 0x87, 0x7d,
-	0xcc, 0xec, 0x87, 0x91, 0x55, 0xf7, 0x03, 0xc2, 0x08, 0xba, 0xc3, 0x28, 0xad, 0x47, 0x0e, 0x19,
-	0x2e, 0x17, 0x1d, 0xe2, 0x10, 0x11, 0x6d, 0xf0, 0x55, 0x94, 0x58, 0xd6, 0x6f, 0xf6, 0x19, 0x90,
-	0xe9, 0x94, 0x78, 0x51, 0xbc, 0xfa, 0x93, 0x0a, 0x9b, 0x26, 0x1e, 0x90, 0x53, 0x1c, 0x98, 0xf8,
-	0x9b, 0x10, 0x53, 0x86, 0x3e, 0x83, 0xdc, 0x18, 0xcf, 0x1c, 0xec, 0x59, 0xae, 0xe7, 0xb2, 0x92,
-	0x5a, 0x51, 0x6b, 0xb9, 0xe6, 0x76, 0xfd, 0x06, 0x62, 0x7d, 0x57, 0x64, 0x19, 0x9e, 0xcb, 0x4c,
-	0x18, 0x27, 0x6b, 0xf4, 0x04, 0xf2, 0xb2, 0x9e, 0x84, 0xcc, 0x0f, 0x59, 0x69, 0x45, 0x74, 0x78,
-	0xe3, 0x85, 0x1d, 0xba, 0x22, 0xcd, 0xd4, 0xc6, 0x29, 0xab, 0xfa, 0x9b, 0x0a, 0xb7, 0x13, 0x62,
-	0xd4, 0x27, 0x1e, 0xc5, 0xe8, 0x0b, 0xc8, 0x04, 0x72, 0x2d, 0x68, 0x6d, 0x36, 0xdf, 0x5b, 0xd2,
-	0x74, 0xa1, 0xaa, 0x1e, 0x2f, 0xcc, 0xa4, 0xb8, 0xba, 0x0f, 0x99, 0xa4, 0x69, 0x09, 0x8a, 0x66,
-	0xbb, 0x77, 0xd8, 0x3d, 0xe8, 0xb5, 0xad, 0xe3, 0x83, 0xde, 0x61, 0xbb, 0x65, 0x3c, 0x35, 0xda,
-	0x4f, 0x0a, 0x0a, 0x2a, 0x42, 0x21, 0x89, 0xf4, 0x8e, 0x5b, 0xad, 0x76, 0xaf, 0x57, 0x50, 0xd1,
-	0x1d, 0xc8, 0x27, 0xde, 0xa7, 0x8f, 0x8d, 0xbd, 0xc2, 0x4a, 0xf5, 0x5b, 0x15, 0xb4, 0xb4, 0x14,
-	0x74, 0x0f, 0x36, 0xfc, 0xb0, 0x6f, 0x8d, 0xf1, 0x4c, 0xf0, 0xd4, 0xcc, 0x75, 0x3f, 0xec, 0xef,
-	0xe2, 0x19, 0x7a, 0x1f, 0x90, 0x13, 0x90, 0xd0, 0xb7, 0x82, 0x88, 0xa4, 0xe5, 0x7a, 0x23, 0x22,
-	0x06, 0xa4, 0x99, 0x05, 0x11, 0x91, 0xec, 0x0d, 0x6f, 0x44, 0xd0, 0x03, 0x28, 0xfa, 0x81, 0x7b,
-	0x6a, 0x33, 0x7c, 0x3d, 0x7f, 0x55, 0xe4, 0x23, 0x19, 0x4b, 0x55, 0x54, 0xaf, 0x54, 0xc8, 0xee,
-	0x63, 0x4a, 0x6d, 0x07, 0x1b, 0x1e, 0xfa, 0xfc, 0xff, 0xef, 0x64, 0x47, 0xb9, 0xb6, 0x97, 0x9f,
-	0x40, 0x96, 0xba, 0x8e, 0xac, 0x8f, 0xf6, 0xf1, 0xfe, 0x92, 0xfa, 0x9e, 0xeb, 0xc4, 0xd5, 0x19,
-	0x2a, 0xd7, 0xe8, 0x23, 0xd8, 0x60, 0x81, 0x3d, 0x1a, 0xb9, 0x03, 0x41, 0x38, 0xd7, 0xdc, 0x5a,
-	0x52, 0x79, 0x14, 0x65, 0x18, 0x5e, 0x47, 0x31, 0xe3, 0x74, 0x74, 0x17, 0x6e, 0xd9, 0x7d, 0x12,
-	0xb0, 0xd2, 0x5a, 0x45, 0xad, 0x65, 0x3a, 0x8a, 0x19, 0x99, 0x3b, 0xeb, 0xb0, 0x36, 0xb4, 0x99,
-	0x5d, 0xfd, 0x79, 0x03, 0x40, 0xaa, 0xec, 0x86, 0x0c, 0x7d, 0x3c, 0x07, 0x7a, 0xb1, 0x44, 0x09,
-	0xd4, 0x0d, 0x59, 0x1a, 0xe9, 0x79, 0x72, 0x56, 0x03, 0x4c, 0xc3, 0x49, 0xac, 0xf1, 0xdd, 0x25,
-	0x0d, 0xe6, 0x80, 0x72, 0x5c, 0xa6, 0xa8, 0xe8, 0x28, 0xf1, 0xc1, 0x8d, 0x6c, 0xb4, 0x0b, 0x39,
-	0x31, 0x32, 0xd9, 0x30, 0x92, 0x5e, 0xfb, 0xf7, 0x86, 0x7c, 0x7e, 0x49, 0x3b, 0xa0, 0x89, 0x85,
-	0xde, 0x02, 0xcd, 0xc3, 0x78, 0x18, 0x6f, 0x7f, 0x32, 0x90, 0x1c, 0xf7, 0xca, 0x8d, 0x2f, 0xff,
-	0x9a, 0x1c, 0x3f, 0x59, 0xf5, 0x61, 0x34, 0x27, 0x39, 0x8d, 0xff, 0xba, 0x78, 0x1d, 0xc5, 0x14,
-	0xe9, 0xe8, 0x19, 0x64, 0x07, 0x81, 0x3b, 0x75, 0x3d, 0x7b, 0x42, 0x5f, 0x6e, 0x10, 0x2d, 0x99,
-	0xbe, 0xe7, 0x52, 0xde, 0x66, 0x5e, 0xbe, 0x53, 0x04, 0x74, 0x6d, 0xb0, 0x16, 0x47, 0x28, 0xff,
-	0xa0, 0x02, 0xcc, 0xb5, 0x22, 0x3d, 0x3a, 0x5d, 0x36, 0x0b, 0x83, 0xe8, 0x42, 0x6b, 0xbc, 0x49,
-	0xe2, 0x7a, 0xad, 0x84, 0x10, 0x14, 0x52, 0xdb, 0x12, 0xd1, 0xb9, 0x58, 0x01, 0x2d, 0x5d, 0x81,
-	0x9e, 0xa7, 0x01, 0xd5, 0xca, 0x6a, 0x2d, 0xd7, 0x7c, 0xf4, 0xf2, 0x80, 0x89, 0x91, 0xc2, 0x2d,
-	0xff, 0xad, 0x42, 0x26, 0xf6, 0xa3, 0xfb, 0x90, 0xf5, 0xed, 0x80, 0xcd, 0xac, 0xd0, 0x1d, 0x0a,
-	0xc1, 0x59, 0x33, 0x23, 0x1c, 0xc7, 0xee, 0x10, 0x7d, 0x0d, 0xc0, 0xcb, 0xb0, 0xc5, 0x66, 0x3e,
-	0x16, 0x72, 0x37, 0x9b, 0x9f, 0xbe, 0x02, 0xba, 0x58, 0xe0, 0xa3, 0x99, 0x8f, 0x23, 0x1e, 0x62,
-	0x59, 0xc5, 0x90, 0x4d, 0xfc, 0xa8, 0x0c, 0x77, 0x5b, 0xa6, 0xb1, 0xdf, 0xb6, 0x8e, 0xbe, 0x3c,
-	0x5c, 0x7c, 0xf5, 0xb6, 0xa0, 0x94, 0x8a, 0x1d, 0x74, 0x0f, 0xac, 0xfd, 0xc7, 0x7b, 0x46, 0xcb,
-	0xe8, 0x1e, 0xf3, 0xd7, 0xaf, 0x04, 0xc5, 0x54, 0x74, 0x1e, 0x59, 0x29, 0xaf, 0x7d, 0xf7, 0x8b,
-	0xae, 0x24, 0x57, 0xd4, 0x83, 0x6c, 0x72, 0xb5, 0xd1, 0xdb, 0xb0, 0x39, 0x0a, 0xc8, 0xd4, 0x5a,
-	0xd4, 0xae, 0x71, 0xef, 0x61, 0xac, 0xbf, 0x04, 0x1b, 0xbe, 0x3d, 0x9b, 0x10, 0x7b, 0x28, 0x1f,
-	0xc4, 0xd8, 0x44, 0x6f, 0x82, 0xe6, 0x52, 0xab, 0x1f, 0x10, 0x7b, 0x38, 0xb0, 0x69, 0x74, 0xa7,
-	0x32, 0x66, 0xce, 0xa5, 0x3b, 0xb1, 0xab, 0x3a, 0x06, 0x98, 0xdf, 0x70, 0x54, 0x01, 0x8d, 0x91,
-	0x1b, 0x70, 0xc0, 0xc8, 0xeb, 0x01, 0xfb, 0x5d, 0x05, 0x98, 0x3f, 0x99, 0x48, 0x87, 0x9c, 0x87,
-	0xcf, 0xf8, 0x6b, 0x9f, 0x02, 0xcb, 0x7a, 0xf8, 0x6c, 0x17, 0x0b, 0xac, 0x6d, 0x80, 0x84, 0x0a,
-	0x3f, 0xc7, 0xab, 0x3c, 0x1c, 0x6f, 0x3b, 0xe5, 0xdf, 0x84, 0x28, 0x4c, 0x4f, 0xec, 0x00, 0x5b,
-	0x03, 0x12, 0x7a, 0x8c, 0x96, 0x6e, 0x55, 0x56, 0x6b, 0x79, 0xb3, 0x20, 0x22, 0x3d, 0x1e, 0x68,
-	0x09, 0x3f, 0x9f, 0xe5, 0x74, 0x26, 0xa5, 0xb9, 0xde, 0x10, 0x9f, 0x0b, 0x82, 0x79, 0x53, 0x9b,
-	0xce, 0x84, 0x38, 0x83, 0xfb, 0xd0, 0x16, 0x64, 0xd9, 0x49, 0x80, 0xe9, 0x09, 0x99, 0x0c, 0xc5,
-	0xa3, 0x91, 0x37, 0xe7, 0x8e, 0xea, 0xf7, 0x2a, 0x64, 0xe2, 0x27, 0x3b, 0x66, 0x4f, 0x5d, 0x67,
-	0x81, 0x7d, 0xcf, 0x75, 0x38, 0xfb, 0x7b, 0xb0, 0x11, 0x2b, 0x5b, 0x11, 0xb1, 0xf5, 0xf1, 0x32,
-	0x59, 0xab, 0x8b, 0xb2, 0xde, 0x81, 0xdb, 0xd3, 0xe8, 0xa4, 0x5a, 0x8c, 0xf0, 0xf6, 0x9e, 0x20,
-	0xa2, 0x99, 0x79, 0xe9, 0x3e, 0x22, 0x9c, 0xc3, 0xce, 0xb3, 0x3f, 0x2e, 0x75, 0xf5, 0xe2, 0x52,
-	0x57, 0xff, 0xba, 0xd4, 0xd5, 0x1f, 0xaf, 0x74, 0xe5, 0xe2, 0x4a, 0x57, 0xfe, 0xbc, 0xd2, 0x95,
-	0xaf, 0x1e, 0x38, 0x2e, 0x3b, 0x09, 0xfb, 0xf5, 0x01, 0x99, 0x36, 0xec, 0x73, 0x3c, 0xb1, 0x03,
-	0x0f, 0xb3, 0x33, 0x12, 0x8c, 0xa5, 0xf5, 0xc1, 0x80, 0x04, 0xb8, 0x71, 0xde, 0x48, 0x7e, 0x71,
-	0xfa, 0xeb, 0xe2, 0xa7, 0xe6, 0xd1, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x17, 0x0e, 0x7b, 0x81,
-	0x3e, 0x09, 0x00, 0x00,
+	// 1155 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0x22, 0x47,
+	0x13, 0x66, 0xc0, 0x36, 0x50, 0x0c, 0x5e, 0xb6, 0x5f, 0xe4, 0x45, 0xac, 0xcd, 0x4b, 0xd8, 0x55,
+	0x84, 0xb2, 0x09, 0xec, 0xda, 0x8a, 0x94, 0x44, 0x4a, 0x94, 0x35, 0xcb, 0xc6, 0xac, 0x3f, 0xf0,
+	0x0e, 0xf6, 0x21, 0x51, 0xa4, 0xd1, 0xc0, 0x34, 0x78, 0x04, 0x4c, 0x93, 0xe9, 0x1e, 0x63, 0xce,
+	0x39, 0x24, 0x8a, 0x72, 0xc8, 0x29, 0xe7, 0x48, 0x39, 0x24, 0xf9, 0x11, 0xb9, 0xe7, 0xe8, 0x63,
+	0x8e, 0x91, 0x7d, 0xcd, 0x8f, 0x88, 0xba, 0x7b, 0xbe, 0xc0, 0xec, 0xca, 0x89, 0xf6, 0xd6, 0x5d,
+	0xf5, 0xd4, 0x53, 0x4f, 0x55, 0xd7, 0x14, 0xc0, 0x16, 0xa3, 0xb4, 0xce, 0x48, 0xdf, 0x36, 0xeb,
+	0xe7, 0x4f, 0xba, 0x98, 0x19, 0x4f, 0xe4, 0xad, 0x36, 0x71, 0x08, 0x23, 0xe8, 0x2e, 0xa3, 0xb4,
+	0x26, 0x0d, 0x9e, 0xbb, 0x98, 0x1f, 0x90, 0x01, 0x11, 0xde, 0x3a, 0x3f, 0x49, 0x60, 0xb1, 0x74,
+	0x93, 0xa7, 0x47, 0xc6, 0x63, 0x62, 0x4b, 0x7f, 0xe5, 0x47, 0x05, 0xd6, 0x35, 0xdc, 0x23, 0xe7,
+	0xd8, 0xd1, 0xf0, 0x57, 0x2e, 0xa6, 0x0c, 0x7d, 0x02, 0x99, 0x21, 0x9e, 0x0d, 0xb0, 0xad, 0x5b,
+	0xb6, 0xc5, 0x0a, 0x4a, 0x59, 0xa9, 0x66, 0xb6, 0xb7, 0x6a, 0x37, 0x32, 0xd6, 0xf6, 0x05, 0xaa,
+	0x65, 0x5b, 0x4c, 0x83, 0x61, 0x70, 0x46, 0xcf, 0x20, 0xeb, 0xc5, 0x13, 0x97, 0x4d, 0x5c, 0x56,
+	0x88, 0x0b, 0x86, 0xff, 0xbf, 0x92, 0xa1, 0x2d, 0x60, 0x9a, 0x3a, 0x8c, 0xdc, 0x2a, 0xbf, 0x29,
+	0x70, 0x27, 0x10, 0x46, 0x27, 0xc4, 0xa6, 0x18, 0x7d, 0x06, 0x29, 0xc7, 0x3b, 0x0b, 0x59, 0xeb,
+	0xdb, 0x8f, 0x96, 0x90, 0x2e, 0x44, 0xd5, 0xfc, 0x83, 0x16, 0x04, 0x57, 0x0e, 0x21, 0x15, 0x90,
+	0x16, 0x20, 0xaf, 0x35, 0x3b, 0xc7, 0xed, 0xa3, 0x4e, 0x53, 0x3f, 0x3d, 0xea, 0x1c, 0x37, 0x1b,
+	0xad, 0xe7, 0xad, 0xe6, 0xb3, 0x5c, 0x0c, 0xe5, 0x21, 0x17, 0x78, 0x3a, 0xa7, 0x8d, 0x46, 0xb3,
+	0xd3, 0xc9, 0x29, 0xe8, 0x2e, 0x64, 0x03, 0xeb, 0xf3, 0xa7, 0xad, 0x83, 0x5c, 0xbc, 0xf2, 0x8d,
+	0x02, 0x6a, 0xb4, 0x14, 0x74, 0x0f, 0x92, 0x13, 0xb7, 0xab, 0x0f, 0xf1, 0x4c, 0xe8, 0x54, 0xb5,
+	0xb5, 0x89, 0xdb, 0xdd, 0xc7, 0x33, 0xf4, 0x2e, 0xa0, 0x81, 0x43, 0xdc, 0x89, 0xee, 0x48, 0x91,
+	0xba, 0x65, 0xf7, 0x89, 0x68, 0x90, 0xaa, 0xe5, 0x84, 0xc7, 0x53, 0xdf, 0xb2, 0xfb, 0x04, 0x3d,
+	0x86, 0xfc, 0xc4, 0xb1, 0xce, 0x0d, 0x86, 0xe7, 0xf1, 0x09, 0x81, 0x47, 0x9e, 0x2f, 0x12, 0x51,
+	0xf9, 0x35, 0x0e, 0xe9, 0x43, 0x4c, 0xa9, 0x31, 0xc0, 0x2d, 0x1b, 0x7d, 0xfa, 0xef, 0x5f, 0x72,
+	0x2f, 0x36, 0xf7, 0x96, 0x1f, 0x41, 0x9a, 0x5a, 0x03, 0x2f, 0x5e, 0xbe, 0xe3, 0xfd, 0x25, 0xf1,
+	0x1d, 0x6b, 0xe0, 0x47, 0xa7, 0xa8, 0x77, 0x46, 0x1f, 0x40, 0x92, 0x39, 0x46, 0xbf, 0x6f, 0xf5,
+	0x84, 0xe0, 0xcc, 0xf6, 0xe6, 0x92, 0xc8, 0x13, 0x89, 0x68, 0xd9, 0x7b, 0x31, 0xcd, 0x87, 0xa3,
+	0x0d, 0x58, 0x35, 0xba, 0xc4, 0x61, 0x85, 0x95, 0xb2, 0x52, 0x4d, 0xed, 0xc5, 0x34, 0x79, 0x45,
+	0x0d, 0x50, 0x1d, 0x4c, 0xcf, 0x0c, 0x07, 0x4b, 0x41, 0xab, 0x82, 0xb6, 0xb4, 0x74, 0x06, 0x04,
+	0xcc, 0xd3, 0x94, 0x71, 0xc2, 0xeb, 0xee, 0x1a, 0xac, 0x98, 0x06, 0x33, 0x2a, 0x3f, 0x25, 0x01,
+	0xbc, 0x56, 0xb5, 0x5d, 0x86, 0x3e, 0x0c, 0xd5, 0xbe, 0xba, 0x4f, 0x9e, 0xda, 0xb6, 0xcb, 0xa2,
+	0x72, 0x5f, 0x06, 0x03, 0xef, 0x60, 0xea, 0x8e, 0xfc, 0x46, 0xbd, 0xb3, 0x84, 0x20, 0x4c, 0xe8,
+	0xf5, 0x5c, 0x13, 0x11, 0x7b, 0x31, 0x7f, 0xfa, 0xe5, 0x1d, 0xed, 0x43, 0x46, 0xf4, 0xdd, 0x23,
+	0x94, 0xfd, 0xab, 0xbe, 0x9e, 0x90, 0x3f, 0x42, 0x40, 0x07, 0x34, 0xb8, 0xa1, 0x07, 0xa0, 0xda,
+	0x18, 0x9b, 0xfe, 0x0c, 0x05, 0x5d, 0xcd, 0x70, 0xab, 0x37, 0x3d, 0xc5, 0x5f, 0x82, 0x19, 0xf6,
+	0xa2, 0xde, 0x97, 0x7d, 0x2a, 0x28, 0xb7, 0xfa, 0x7a, 0xf7, 0x62, 0x9a, 0x80, 0xa3, 0x17, 0x90,
+	0xee, 0x39, 0xd6, 0xd8, 0xb2, 0x8d, 0x11, 0xbd, 0x5d, 0x23, 0x1a, 0x1e, 0xfc, 0xc0, 0xa2, 0x9c,
+	0x26, 0x0c, 0xdf, 0xcd, 0x03, 0x9a, 0x6b, 0xac, 0xce, 0x33, 0x14, 0xbf, 0x57, 0x00, 0xc2, 0x5a,
+	0x51, 0x49, 0x8e, 0xa8, 0xc1, 0x5c, 0x47, 0x6e, 0x05, 0x95, 0x93, 0x04, 0xa6, 0x37, 0x2a, 0x08,
+	0x41, 0x2e, 0xf2, 0x2c, 0x52, 0xce, 0x65, 0x1c, 0xd4, 0x68, 0x04, 0x7a, 0x19, 0x4d, 0xa8, 0x94,
+	0x13, 0xd5, 0xcc, 0xf6, 0xce, 0xed, 0x13, 0x06, 0x97, 0x48, 0xde, 0xe2, 0xdf, 0x0a, 0xa4, 0x7c,
+	0x3b, 0xba, 0x0f, 0xe9, 0x89, 0xe1, 0xb0, 0x99, 0xee, 0x5a, 0xa6, 0x28, 0x38, 0xad, 0xa5, 0x84,
+	0xe1, 0xd4, 0x32, 0xd1, 0x97, 0x00, 0x3c, 0x0c, 0xeb, 0x6c, 0x36, 0xc1, 0xa2, 0xdc, 0xf5, 0xed,
+	0x8f, 0xff, 0x43, 0x76, 0x71, 0xc0, 0x27, 0xb3, 0x09, 0x96, 0x3a, 0xc4, 0xb1, 0x82, 0x21, 0x1d,
+	0xd8, 0x51, 0x11, 0x36, 0x1a, 0x5a, 0xeb, 0xb0, 0xa9, 0x9f, 0x7c, 0x7e, 0xbc, 0xb8, 0x3a, 0x37,
+	0xa1, 0x10, 0xf1, 0x1d, 0xb5, 0x8f, 0xf4, 0xc3, 0xa7, 0x07, 0xad, 0x46, 0xab, 0x7d, 0xca, 0x57,
+	0x68, 0x01, 0xf2, 0x11, 0x6f, 0xe8, 0x89, 0x17, 0x57, 0xbe, 0xfd, 0xb9, 0x14, 0x0b, 0x3e, 0x51,
+	0x1b, 0xd2, 0xc1, 0x7e, 0x40, 0x0f, 0x61, 0xbd, 0xef, 0x90, 0xb1, 0xbe, 0x58, 0xbb, 0xca, 0xad,
+	0xc7, 0x7e, 0xfd, 0x05, 0x48, 0x4e, 0x8c, 0xd9, 0x88, 0x18, 0xa6, 0xb7, 0x55, 0xfd, 0x2b, 0x7a,
+	0x0b, 0x54, 0x8b, 0xea, 0x5d, 0x87, 0x18, 0x66, 0xcf, 0xa0, 0xf2, 0x9b, 0x4a, 0x69, 0x19, 0x8b,
+	0xee, 0xfa, 0xa6, 0xca, 0x10, 0x20, 0xfc, 0xc2, 0x51, 0x19, 0x54, 0x46, 0x6e, 0xa4, 0x03, 0x46,
+	0xde, 0x4c, 0xb2, 0xdf, 0x15, 0x80, 0x70, 0xef, 0xa2, 0x12, 0x64, 0x6c, 0x3c, 0xe5, 0x3f, 0x19,
+	0x91, 0x64, 0x69, 0x1b, 0x4f, 0xf7, 0xb1, 0xc8, 0xb5, 0x05, 0x10, 0x48, 0xe1, 0x73, 0x9c, 0xe0,
+	0x6e, 0xff, 0xd9, 0x29, 0xff, 0x61, 0x91, 0x6e, 0xb9, 0x1e, 0x7b, 0xc4, 0xb5, 0x19, 0x2d, 0xac,
+	0x96, 0x13, 0xd5, 0xac, 0x96, 0x13, 0x9e, 0x0e, 0x77, 0x34, 0x84, 0x9d, 0xf7, 0x72, 0x3c, 0xf3,
+	0x4a, 0xb3, 0x6c, 0x13, 0x5f, 0x08, 0x81, 0x59, 0x4d, 0x1d, 0xcf, 0x44, 0x71, 0x2d, 0x6e, 0x43,
+	0x9b, 0x90, 0x66, 0x67, 0x7c, 0x75, 0x92, 0x91, 0x29, 0x96, 0x46, 0x56, 0x0b, 0x0d, 0x95, 0xef,
+	0x14, 0x48, 0xf9, 0x7b, 0xdf, 0x57, 0x4f, 0xad, 0xc1, 0x82, 0xfa, 0x8e, 0x35, 0xe0, 0xea, 0xef,
+	0x41, 0xd2, 0xaf, 0x2c, 0x2e, 0x7c, 0x6b, 0xc3, 0x65, 0x65, 0x25, 0x16, 0xcb, 0x7a, 0x1b, 0xee,
+	0x8c, 0xe5, 0xa4, 0xea, 0x8c, 0x70, 0x7a, 0x5b, 0x08, 0x51, 0xb5, 0xac, 0x67, 0x3e, 0x21, 0x5c,
+	0x43, 0xe5, 0xeb, 0x04, 0x64, 0x22, 0x3b, 0x9f, 0xc7, 0x09, 0x3d, 0x98, 0x52, 0x8b, 0xd8, 0x11,
+	0x4d, 0x59, 0xae, 0x49, 0x5a, 0x5f, 0xab, 0xeb, 0x21, 0xac, 0x93, 0x91, 0xa9, 0xdf, 0xd0, 0xa6,
+	0x92, 0x91, 0x79, 0x1c, 0xc8, 0xdb, 0x81, 0x8d, 0x10, 0x35, 0xd7, 0xf9, 0x15, 0xd1, 0xf9, 0xff,
+	0xf9, 0xe8, 0x85, 0xe6, 0x73, 0x6d, 0x11, 0xea, 0x55, 0x49, 0x6d, 0xe3, 0xe9, 0x1c, 0x75, 0x88,
+	0x9a, 0xa3, 0x5e, 0x93, 0xd4, 0x3e, 0x3a, 0x4a, 0xfd, 0x00, 0x78, 0x7d, 0x7a, 0xf8, 0x6a, 0x49,
+	0xf9, 0xac, 0x36, 0x9e, 0x9e, 0xf8, 0x36, 0xf4, 0x08, 0xd0, 0x78, 0xa6, 0x87, 0xba, 0xe5, 0x00,
+	0xa4, 0xca, 0x4a, 0x75, 0x55, 0xbb, 0x33, 0x9e, 0xb5, 0x47, 0x66, 0x64, 0x06, 0x24, 0x38, 0x54,
+	0x22, 0xc1, 0x69, 0x1f, 0x7c, 0x84, 0xa7, 0x21, 0x78, 0xf7, 0xc5, 0x1f, 0x57, 0x25, 0xe5, 0xf2,
+	0xaa, 0xa4, 0xfc, 0x75, 0x55, 0x52, 0x7e, 0xb8, 0x2e, 0xc5, 0x2e, 0xaf, 0x4b, 0xb1, 0x3f, 0xaf,
+	0x4b, 0xb1, 0x2f, 0x1e, 0x0f, 0x2c, 0x76, 0xe6, 0x76, 0x6b, 0x3d, 0x32, 0xae, 0x1b, 0x17, 0x78,
+	0x64, 0x38, 0x36, 0x66, 0x53, 0xe2, 0x0c, 0xbd, 0xdb, 0x7b, 0x3d, 0xe2, 0xe0, 0xfa, 0x45, 0x3d,
+	0xf8, 0xb7, 0xda, 0x5d, 0x13, 0xff, 0x4f, 0x77, 0xfe, 0x19, 0x00, 0x59, 0x9c, 0x15, 0x25, 0x09,
+	0x0b, 0x00, 0x00,
 }
 
 func (m *RecoverRequest) Marshal() (dAtA []byte, err error) {
@@ -1310,6 +1445,27 @@ func (m *MessageIn_Abort) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	dAtA[i] = 0x20
 	return len(dAtA) - i, nil
 }
+func (m *MessageIn_ReshareInit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageIn_ReshareInit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ReshareInit != nil {
+		{
+			size, err := m.ReshareInit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTofnd(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *MessageOut) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1752,20 +1908,20 @@ func (m *KeygenInit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.PartyShareCounts) > 0 {
-		dAtA13 := make([]byte, len(m.PartyShareCounts)*10)
-		var j12 int
+		dAtA14 := make([]byte, len(m.PartyShareCounts)*10)
+		var j13 int
 		for _, num := range m.PartyShareCounts {
 			for num >= 1<<7 {
-				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j12++
+				j13++
 			}
-			dAtA13[j12] = uint8(num)
-			j12++
+			dAtA14[j13] = uint8(num)
+			j13++
 		}
-		i -= j12
-		copy(dAtA[i:], dAtA13[:j12])
-		i = encodeVarintTofnd(dAtA, i, uint64(j12))
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintTofnd(dAtA, i, uint64(j13))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -1851,6 +2007,112 @@ func (m *SignInit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReshareInit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReshareInit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReshareInit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MyNewPartyIndex != 0 {
+		i = encodeVarintTofnd(dAtA, i, uint64(m.MyNewPartyIndex))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MyOldPartyIndex != 0 {
+		i = encodeVarintTofnd(dAtA, i, uint64(m.MyOldPartyIndex))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NewThreshold != 0 {
+		i = encodeVarintTofnd(dAtA, i, uint64(m.NewThreshold))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.NewPartyShareCounts) > 0 {
+		dAtA16 := make([]byte, len(m.NewPartyShareCounts)*10)
+		var j15 int
+		for _, num := range m.NewPartyShareCounts {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintTofnd(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.NewPartyUids) > 0 {
+		for iNdEx := len(m.NewPartyUids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NewPartyUids[iNdEx])
+			copy(dAtA[i:], m.NewPartyUids[iNdEx])
+			i = encodeVarintTofnd(dAtA, i, uint64(len(m.NewPartyUids[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.OldPartyShareCounts) > 0 {
+		dAtA18 := make([]byte, len(m.OldPartyShareCounts)*10)
+		var j17 int
+		for _, num := range m.OldPartyShareCounts {
+			for num >= 1<<7 {
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA18[j17] = uint8(num)
+			j17++
+		}
+		i -= j17
+		copy(dAtA[i:], dAtA18[:j17])
+		i = encodeVarintTofnd(dAtA, i, uint64(j17))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OldPartyUids) > 0 {
+		for iNdEx := len(m.OldPartyUids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OldPartyUids[iNdEx])
+			copy(dAtA[i:], m.OldPartyUids[iNdEx])
+			i = encodeVarintTofnd(dAtA, i, uint64(len(m.OldPartyUids[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.KeyUid) > 0 {
+		i -= len(m.KeyUid)
+		copy(dAtA[i:], m.KeyUid)
+		i = encodeVarintTofnd(dAtA, i, uint64(len(m.KeyUid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NewSessionUid) > 0 {
+		i -= len(m.NewSessionUid)
+		copy(dAtA[i:], m.NewSessionUid)
+		i = encodeVarintTofnd(dAtA, i, uint64(len(m.NewSessionUid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTofnd(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTofnd(v)
 	base := offset
@@ -1969,6 +2231,18 @@ func (m *MessageIn_Abort) Size() (n int) {
 	n += 2
 	return n
 }
+func (m *MessageIn_ReshareInit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReshareInit != nil {
+		l = m.ReshareInit.Size()
+		n += 1 + l + sovTofnd(uint64(l))
+	}
+	return n
+}
 func (m *MessageOut) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2228,6 +2502,58 @@ func (m *SignInit) Size() (n int) {
 	return n
 }
 
+func (m *ReshareInit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewSessionUid)
+	if l > 0 {
+		n += 1 + l + sovTofnd(uint64(l))
+	}
+	l = len(m.KeyUid)
+	if l > 0 {
+		n += 1 + l + sovTofnd(uint64(l))
+	}
+	if len(m.OldPartyUids) > 0 {
+		for _, s := range m.OldPartyUids {
+			l = len(s)
+			n += 1 + l + sovTofnd(uint64(l))
+		}
+	}
+	if len(m.OldPartyShareCounts) > 0 {
+		l = 0
+		for _, e := range m.OldPartyShareCounts {
+			l += sovTofnd(uint64(e))
+		}
+		n += 1 + sovTofnd(uint64(l)) + l
+	}
+	if len(m.NewPartyUids) > 0 {
+		for _, s := range m.NewPartyUids {
+			l = len(s)
+			n += 1 + l + sovTofnd(uint64(l))
+		}
+	}
+	if len(m.NewPartyShareCounts) > 0 {
+		l = 0
+		for _, e := range m.NewPartyShareCounts {
+			l += sovTofnd(uint64(e))
+		}
+		n += 1 + sovTofnd(uint64(l)) + l
+	}
+	if m.NewThreshold != 0 {
+		n += 1 + sovTofnd(uint64(m.NewThreshold))
+	}
+	if m.MyOldPartyIndex != 0 {
+		n += 1 + sovTofnd(uint64(m.MyOldPartyIndex))
+	}
+	if m.MyNewPartyIndex != 0 {
+		n += 1 + sovTofnd(uint64(m.MyNewPartyIndex))
+	}
+	return n
+}
+
 func sovTofnd(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2732,6 +3058,41 @@ func (m *MessageIn) Unmarshal(dAtA []byte) error {
 			}
 			b := bool(v != 0)
 			m.Data = &MessageIn_Abort{b}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReshareInit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTofnd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTofnd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTofnd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ReshareInit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Data = &MessageIn_ReshareInit{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTofnd(dAtA[iNdEx:])
@@ -4032,6 +4393,393 @@ func (m *SignInit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReshareInit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTofnd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReshareInit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReshareInit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSessionUid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTofnd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTofnd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTofnd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewSessionUid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyUid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTofnd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTofnd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTofnd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyUid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPartyUids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTofnd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTofnd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTofnd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldPartyUids = append(m.OldPartyUids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTofnd
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OldPartyShareCounts = append(m.OldPartyShareCounts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTofnd
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTofnd
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTofnd
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OldPartyShareCounts) == 0 {
+					m.OldPartyShareCounts = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTofnd
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OldPartyShareCounts = append(m.OldPartyShareCounts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPartyShareCounts", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPartyUids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTofnd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTofnd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTofnd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPartyUids = append(m.NewPartyUids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTofnd
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NewPartyShareCounts = append(m.NewPartyShareCounts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTofnd
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTofnd
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTofnd
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.NewPartyShareCounts) == 0 {
+					m.NewPartyShareCounts = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTofnd
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NewPartyShareCounts = append(m.NewPartyShareCounts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPartyShareCounts", wireType)
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewThreshold", wireType)
+			}
+			m.NewThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTofnd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MyOldPartyIndex", wireType)
+			}
+			m.MyOldPartyIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTofnd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MyOldPartyIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MyNewPartyIndex", wireType)
+			}
+			m.MyNewPartyIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTofnd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MyNewPartyIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTofnd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTofnd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTofnd(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
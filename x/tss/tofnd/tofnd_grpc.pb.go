@@ -22,6 +22,7 @@ type GG20Client interface {
 	Keygen(ctx context.Context, opts ...grpc.CallOption) (GG20_KeygenClient, error)
 	Sign(ctx context.Context, opts ...grpc.CallOption) (GG20_SignClient, error)
 	KeyPresence(ctx context.Context, in *KeyPresenceRequest, opts ...grpc.CallOption) (*KeyPresenceResponse, error)
+	Reshare(ctx context.Context, opts ...grpc.CallOption) (GG20_ReshareClient, error)
 }
 
 type gG20Client struct {
@@ -112,6 +113,37 @@ func (c *gG20Client) KeyPresence(ctx context.Context, in *KeyPresenceRequest, op
 	return out, nil
 }
 
+func (c *gG20Client) Reshare(ctx context.Context, opts ...grpc.CallOption) (GG20_ReshareClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GG20_ServiceDesc.Streams[2], "/tofnd.GG20/Reshare", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gG20ReshareClient{stream}
+	return x, nil
+}
+
+type GG20_ReshareClient interface {
+	Send(*MessageIn) error
+	Recv() (*MessageOut, error)
+	grpc.ClientStream
+}
+
+type gG20ReshareClient struct {
+	grpc.ClientStream
+}
+
+func (x *gG20ReshareClient) Send(m *MessageIn) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *gG20ReshareClient) Recv() (*MessageOut, error) {
+	m := new(MessageOut)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // GG20Server is the server API for GG20 service.
 // All implementations must embed UnimplementedGG20Server
 // for forward compatibility
@@ -120,6 +152,7 @@ type GG20Server interface {
 	Keygen(GG20_KeygenServer) error
 	Sign(GG20_SignServer) error
 	KeyPresence(context.Context, *KeyPresenceRequest) (*KeyPresenceResponse, error)
+	Reshare(GG20_ReshareServer) error
 	mustEmbedUnimplementedGG20Server()
 }
 
@@ -139,6 +172,9 @@ func (UnimplementedGG20Server) Sign(GG20_SignServer) error {
 func (UnimplementedGG20Server) KeyPresence(context.Context, *KeyPresenceRequest) (*KeyPresenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method KeyPresence not implemented")
 }
+func (UnimplementedGG20Server) Reshare(GG20_ReshareServer) error {
+	return status.Errorf(codes.Unimplemented, "method Reshare not implemented")
+}
 func (UnimplementedGG20Server) mustEmbedUnimplementedGG20Server() {}
 
 // UnsafeGG20Server may be embedded to opt out of forward compatibility for this service.
@@ -240,6 +276,32 @@ func _GG20_KeyPresence_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GG20_Reshare_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GG20Server).Reshare(&gG20ReshareServer{stream})
+}
+
+type GG20_ReshareServer interface {
+	Send(*MessageOut) error
+	Recv() (*MessageIn, error)
+	grpc.ServerStream
+}
+
+type gG20ReshareServer struct {
+	grpc.ServerStream
+}
+
+func (x *gG20ReshareServer) Send(m *MessageOut) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gG20ReshareServer) Recv() (*MessageIn, error) {
+	m := new(MessageIn)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // GG20_ServiceDesc is the grpc.ServiceDesc for GG20 service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -269,6 +331,12 @@ var GG20_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "Reshare",
+			Handler:       _GG20_Reshare_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "tss/tofnd/tofnd.proto",
 }
@@ -17,6 +17,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&ProcessKeygenTrafficResponse{}, "tss/KeygenTraffic", nil)
 	cdc.RegisterConcrete(&ProcessSignTrafficRequest{}, "tss/SignTraffic", nil)
 	cdc.RegisterConcrete(&RotateKeyRequest{}, "tss/RotateKey", nil)
+	cdc.RegisterConcrete(&StartReshareRequest{}, "tss/StartReshare", nil)
+	cdc.RegisterConcrete(&ProcessReshareTrafficRequest{}, "tss/ReshareTraffic", nil)
+	cdc.RegisterConcrete(&VoteReshareRequest{}, "tss/VoteReshare", nil)
 	cdc.RegisterConcrete(&VoteSigRequest{}, "tss/VoteSig", nil)
 	cdc.RegisterConcrete(&VotePubKeyRequest{}, "tss/VotePubKey", nil)
 	cdc.RegisterConcrete(&RegisterExternalKeysRequest{}, "tss/RegisterExternalKey", nil)
@@ -32,6 +35,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&ProcessKeygenTrafficRequest{},
 		&ProcessSignTrafficRequest{},
 		&RotateKeyRequest{},
+		&StartReshareRequest{},
+		&ProcessReshareTrafficRequest{},
+		&VoteReshareRequest{},
 		&VoteSigRequest{},
 		&VotePubKeyRequest{},
 		&RegisterExternalKeysRequest{},
@@ -50,6 +56,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&HeartBeatRequest{},
 		&ProcessKeygenTrafficRequest{},
 		&VotePubKeyRequest{},
+		&ProcessReshareTrafficRequest{},
+		&VoteReshareRequest{},
 		&ProcessSignTrafficRequest{},
 		&VoteSigRequest{},
 		&SubmitMultisigPubKeysRequest{},
@@ -3,9 +3,11 @@ package types
 // Event types
 const (
 	EventTypeKeygen    = "keygen"
+	EventTypeReshare   = "reshare"
 	EventTypeSign      = "sign"
 	EventTypeHeartBeat = "heartbeat"
 	EventTypeKey       = "key"
+	EventTypeRotation  = "keyRotation"
 )
 
 // Event attribute keys
@@ -25,11 +27,14 @@ const (
 	AttributeKeyParticipantShareCounts    = "participantShareCounts"
 	AttributeKeyNonParticipants           = "nonParticipants"
 	AttributeKeyNonParticipantShareCounts = "nonParticipantShareCounts"
+	AttributeKeyOldParticipants           = "oldParticipants"
+	AttributeKeyOldParticipantShareCounts = "oldParticipantShareCounts"
 	AttributeKeyPayload                   = "payload"
 	AttributeKeyTimeout                   = "timeout"
 	AttributeKeyRole                      = "keyRole"
 	AttributeKeyKeyIDs                    = "keyIDs"
 	AttributeKeyKeyInfos                  = "keyInfos"
+	AttributeKeyAttempt                   = "attempt"
 )
 
 // Event attribute values
@@ -40,4 +45,7 @@ const (
 	AttributeValueDecided  = "decided"
 	AttributeValueReject   = "reject"
 	AttributeValueAssigned = "assigned"
+	AttributeValueReady    = "ready"
+	AttributeValueFailed   = "failed"
+	AttributeValueRetry    = "retry"
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	"crypto/ecdsa"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -17,7 +18,7 @@ import (
 	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
 )
 
-//go:generate moq -pkg mock -out ./mock/expected_keepers.go . TofndClient TofndKeyGenClient TofndSignClient Voter StakingKeeper TSSKeeper Snapshotter Nexus Rewarder
+//go:generate moq -pkg mock -out ./mock/expected_keepers.go . TofndClient TofndKeyGenClient TofndSignClient Voter StakingKeeper Slasher TSSKeeper Snapshotter Nexus Rewarder
 
 // Snapshotter provides snapshot functionality
 type Snapshotter = snapshot.Snapshotter
@@ -64,6 +65,13 @@ type StakingKeeper interface {
 	IterateBondedValidatorsByPower(ctx sdk.Context, fn func(index int64, validator stakingtypes.ValidatorI) (stop bool))
 }
 
+// Slasher provides functionality to jail validators for misbehaving during tss sessions
+type Slasher interface {
+	snapshot.Slasher
+	Jail(ctx sdk.Context, consAddr sdk.ConsAddress)
+	JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time)
+}
+
 // TSSKeeper provides keygen and signing functionality
 type TSSKeeper interface {
 	Logger(ctx sdk.Context) log.Logger
@@ -81,9 +89,21 @@ type TSSKeeper interface {
 	GetSig(ctx sdk.Context, sigID string) (exported.Signature, exported.SigStatus)
 	SetSig(ctx sdk.Context, signature exported.Signature)
 	DoesValidatorParticipateInSign(ctx sdk.Context, sigID string, validator sdk.ValAddress) bool
-	PenalizeCriminal(ctx sdk.Context, criminal sdk.ValAddress, crimeType tofnd2.MessageOut_CriminalList_Criminal_CrimeType)
+	PenalizeCriminal(ctx sdk.Context, sessionID string, attempt int64, criminal sdk.ValAddress, crimeType tofnd2.MessageOut_CriminalList_Criminal_CrimeType, accusers []sdk.ValAddress)
+	RecordTrafficHash(ctx sdk.Context, sessionID string, sender sdk.ValAddress, payload *tofnd2.TrafficOut)
+	DeleteTrafficHashes(ctx sdk.Context, sessionID string)
+	GetMisbehaviourHistory(ctx sdk.Context, validator sdk.ValAddress) []MisbehaviourEvidence
+	GetRecentOffenceCount(ctx sdk.Context, validator sdk.ValAddress) int64
 	StartSign(ctx sdk.Context, info exported.SignInfo, snapshotter Snapshotter, voter InitPoller) error
+	RetrySign(ctx sdk.Context, info exported.SignInfo, absentees []sdk.ValAddress, snapshotter Snapshotter, voter InitPoller) error
 	StartKeygen(ctx sdk.Context, voter Voter, keyInfo KeyInfo, snapshot snapshot.Snapshot) error
+	StartReshare(ctx sdk.Context, voter Voter, keyID exported.KeyID, snapshot snapshot.Snapshot) (ReshareInfo, error)
+	GetReshareInfo(ctx sdk.Context, sessionID string) (ReshareInfo, bool)
+	GetCompletedReshareInfo(ctx sdk.Context, sessionID string) (ReshareInfo, bool)
+	CompleteReshare(ctx sdk.Context, sessionID string, groupRecoveryInfo []byte) error
+	AbortReshare(ctx sdk.Context, sessionID string) error
+	GetTimedOutReshares(ctx sdk.Context) []ReshareInfo
+	GetKeyState(ctx sdk.Context, keyID exported.KeyID) KeyState
 	SetAvailableOperator(ctx sdk.Context, validator sdk.ValAddress, keyIDs ...exported.KeyID)
 	GetAvailableOperators(ctx sdk.Context, keyIDs ...exported.KeyID) []sdk.ValAddress
 	GetKey(ctx sdk.Context, keyID exported.KeyID) (exported.Key, bool)
@@ -113,6 +133,17 @@ type TSSKeeper interface {
 	GetHeartbeatPeriodInBlocks(ctx sdk.Context) int64
 	GetOldActiveKeys(ctx sdk.Context, chain nexus.Chain, keyRole exported.KeyRole) ([]exported.Key, error)
 	GetMaxSimultaneousSignShares(ctx sdk.Context) int64
+	GetSignQueueWeights(ctx sdk.Context) []SignQueueWeight
+	GetSignQueue(ctx sdk.Context) utils.SequenceKVQueue
+	SetSignStartHeight(ctx sdk.Context, sigID string)
+	GetSignStartHeight(ctx sdk.Context, sigID string) (int64, bool)
+	RecordSignCompletion(ctx sdk.Context, sigID string, status exported.SigStatus)
+	GetAverageSignDuration(ctx sdk.Context) (int64, bool)
+	GetKeyRotationPolicies(ctx sdk.Context) []KeyRotationPolicy
+	GetRotatedAtHeight(ctx sdk.Context, chain nexus.Chain, keyRole exported.KeyRole) (int64, bool)
+	GetScheduledRotation(ctx sdk.Context, chain nexus.Chain, keyRole exported.KeyRole) (ScheduledRotation, bool)
+	SetScheduledRotation(ctx sdk.Context, rotation ScheduledRotation)
+	DeleteScheduledRotation(ctx sdk.Context, chain nexus.Chain, keyRole exported.KeyRole)
 
 	SubmitPubKeys(ctx sdk.Context, keyID exported.KeyID, validator sdk.ValAddress, pubKeys ...[]byte) bool
 	GetMultisigKeygenInfo(ctx sdk.Context, keyID exported.KeyID) (MultisigKeygenInfo, bool)
@@ -17,15 +17,17 @@ func NewGenesisState(
 	externalKeys []ExternalKeys,
 	signatures []exported.Signature,
 	validatorStatuses []ValidatorStatus,
+	misbehaviourEvidence []MisbehaviourEvidence,
 ) *GenesisState {
 	return &GenesisState{
-		Params:            params,
-		KeyRecoveryInfos:  keyRecoveryInfos,
-		Keys:              keys,
-		MultisigInfos:     multisigInfos,
-		ExternalKeys:      externalKeys,
-		Signatures:        signatures,
-		ValidatorStatuses: validatorStatuses,
+		Params:               params,
+		KeyRecoveryInfos:     keyRecoveryInfos,
+		Keys:                 keys,
+		MultisigInfos:        multisigInfos,
+		ExternalKeys:         externalKeys,
+		Signatures:           signatures,
+		ValidatorStatuses:    validatorStatuses,
+		MisbehaviourEvidence: misbehaviourEvidence,
 	}
 }
 
@@ -39,6 +41,7 @@ func DefaultGenesisState() *GenesisState {
 		[]ExternalKeys{},
 		[]exported.Signature{},
 		[]ValidatorStatus{},
+		[]MisbehaviourEvidence{},
 	)
 }
 
@@ -84,6 +87,12 @@ func (m GenesisState) Validate() error {
 		}
 	}
 
+	for _, evidence := range m.MisbehaviourEvidence {
+		if err := evidence.Validate(); err != nil {
+			return getValidateError(err)
+		}
+	}
+
 	return nil
 }
 
@@ -25,13 +25,14 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Params            Params               `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	KeyRecoveryInfos  []KeyRecoveryInfo    `protobuf:"bytes,2,rep,name=key_recovery_infos,json=keyRecoveryInfos,proto3" json:"key_recovery_infos"`
-	Keys              []exported.Key       `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys"`
-	MultisigInfos     []MultisigInfo       `protobuf:"bytes,4,rep,name=multisig_infos,json=multisigInfos,proto3" json:"multisig_infos"`
-	ExternalKeys      []ExternalKeys       `protobuf:"bytes,5,rep,name=external_keys,json=externalKeys,proto3" json:"external_keys"`
-	Signatures        []exported.Signature `protobuf:"bytes,6,rep,name=signatures,proto3" json:"signatures"`
-	ValidatorStatuses []ValidatorStatus    `protobuf:"bytes,7,rep,name=validator_statuses,json=validatorStatuses,proto3" json:"validator_statuses"`
+	Params               Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	KeyRecoveryInfos     []KeyRecoveryInfo      `protobuf:"bytes,2,rep,name=key_recovery_infos,json=keyRecoveryInfos,proto3" json:"key_recovery_infos"`
+	Keys                 []exported.Key         `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys"`
+	MultisigInfos        []MultisigInfo         `protobuf:"bytes,4,rep,name=multisig_infos,json=multisigInfos,proto3" json:"multisig_infos"`
+	ExternalKeys         []ExternalKeys         `protobuf:"bytes,5,rep,name=external_keys,json=externalKeys,proto3" json:"external_keys"`
+	Signatures           []exported.Signature   `protobuf:"bytes,6,rep,name=signatures,proto3" json:"signatures"`
+	ValidatorStatuses    []ValidatorStatus      `protobuf:"bytes,7,rep,name=validator_statuses,json=validatorStatuses,proto3" json:"validator_statuses"`
+	MisbehaviourEvidence []MisbehaviourEvidence `protobuf:"bytes,8,rep,name=misbehaviour_evidence,json=misbehaviourEvidence,proto3" json:"misbehaviour_evidence"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -74,33 +75,35 @@ func init() {
 func init() { proto.RegisterFile("tss/v1beta1/genesis.proto", fileDescriptor_eb5f1c2be1950e47) }
 
 var fileDescriptor_eb5f1c2be1950e47 = []byte{
-	// 408 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0x87, 0x6d, 0x12, 0x82, 0xb4, 0x69, 0x11, 0x2c, 0x48, 0xb8, 0x11, 0x72, 0x23, 0x4e, 0xbd,
-	0x60, 0x93, 0xf6, 0x0d, 0x2a, 0x0a, 0x42, 0x15, 0xa8, 0x34, 0x12, 0x07, 0x2e, 0xd6, 0x26, 0x9d,
-	0x1a, 0xcb, 0x7f, 0xd6, 0xda, 0x19, 0x1b, 0xfb, 0x2d, 0x78, 0xac, 0x1c, 0x73, 0xe4, 0x84, 0x20,
-	0x79, 0x01, 0x1e, 0xa1, 0xca, 0x66, 0x2d, 0x6d, 0x24, 0xdf, 0xec, 0x99, 0xef, 0xf7, 0xed, 0xec,
-	0x6a, 0xd8, 0x09, 0x21, 0x86, 0xf5, 0x6c, 0x01, 0x24, 0x66, 0x61, 0x0c, 0x05, 0x60, 0x82, 0x41,
-	0xa9, 0x24, 0x49, 0x3e, 0x26, 0xc4, 0xc0, 0xb4, 0x26, 0x2f, 0x63, 0x19, 0x4b, 0x5d, 0x0f, 0x77,
-	0x5f, 0x7b, 0x64, 0xe2, 0xd9, 0xe9, 0x52, 0x28, 0x91, 0x9b, 0xf0, 0xe4, 0x95, 0xdd, 0xa1, 0xb6,
-	0x84, 0xae, 0x31, 0xdd, 0x35, 0xa0, 0x29, 0xa5, 0x22, 0xb8, 0xeb, 0x23, 0xde, 0xfc, 0x1f, 0xb0,
-	0xa3, 0x8f, 0xfb, 0x49, 0xe6, 0x24, 0x08, 0xf8, 0x8c, 0x8d, 0xf6, 0x6e, 0xcf, 0x9d, 0xba, 0x67,
-	0xe3, 0xf3, 0x17, 0x81, 0x35, 0x59, 0x70, 0xa3, 0x5b, 0x97, 0xc3, 0xd5, 0x9f, 0x53, 0xe7, 0xd6,
-	0x80, 0xfc, 0x86, 0xf1, 0x14, 0xda, 0x48, 0xc1, 0x52, 0xd6, 0xa0, 0xda, 0x28, 0x29, 0xee, 0x25,
-	0x7a, 0x8f, 0xa6, 0x83, 0xb3, 0xf1, 0xf9, 0xeb, 0x83, 0xf8, 0x35, 0xb4, 0xb7, 0x86, 0xfa, 0x54,
-	0xdc, 0x4b, 0xe3, 0x79, 0x96, 0x1e, 0x96, 0x91, 0x5f, 0xb0, 0x61, 0x0a, 0x2d, 0x7a, 0x03, 0xed,
-	0x38, 0xd1, 0x8e, 0xee, 0x1a, 0xb6, 0xcc, 0x08, 0x34, 0xcc, 0x3f, 0xb0, 0xa7, 0x79, 0x95, 0x51,
-	0x82, 0x49, 0x6c, 0x46, 0x18, 0x5a, 0xf1, 0x2e, 0xf5, 0xd9, 0x20, 0xd6, 0xf9, 0xc7, 0xb9, 0x55,
-	0x43, 0xfe, 0x9e, 0x1d, 0x43, 0x43, 0xa0, 0x0a, 0x91, 0x45, 0x7a, 0x8a, 0xc7, 0x3d, 0x9a, 0x2b,
-	0x43, 0x5c, 0x43, 0xdb, 0x3d, 0xc7, 0x11, 0x58, 0x35, 0x7e, 0xc5, 0x18, 0x26, 0x71, 0x21, 0xa8,
-	0x52, 0x80, 0xde, 0x48, 0x2b, 0x4e, 0xfb, 0x2f, 0x32, 0xef, 0x38, 0x23, 0xb2, 0x82, 0xfc, 0x2b,
-	0xe3, 0xb5, 0xc8, 0x92, 0x3b, 0x41, 0x52, 0x45, 0x48, 0x82, 0x2a, 0x04, 0xf4, 0x9e, 0xf4, 0xbc,
-	0xed, 0xb7, 0x0e, 0x9b, 0x6b, 0xca, 0xb8, 0x9e, 0xd7, 0x87, 0x65, 0xc0, 0xcb, 0x2f, 0xab, 0x7f,
-	0xbe, 0xb3, 0xda, 0xf8, 0xee, 0x7a, 0xe3, 0xbb, 0x7f, 0x37, 0xbe, 0xfb, 0x6b, 0xeb, 0x3b, 0xeb,
-	0xad, 0xef, 0xfc, 0xde, 0xfa, 0xce, 0xf7, 0x77, 0x71, 0x42, 0x3f, 0xaa, 0x45, 0xb0, 0x94, 0x79,
-	0x28, 0x1a, 0xc8, 0x84, 0x2a, 0x80, 0x7e, 0x4a, 0x95, 0x9a, 0xbf, 0xb7, 0x4b, 0xa9, 0x20, 0x6c,
-	0xc2, 0xdd, 0x66, 0xe9, 0x45, 0x5a, 0x8c, 0xf4, 0x26, 0x5d, 0x3c, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x62, 0x47, 0x56, 0x76, 0xde, 0x02, 0x00, 0x00,
+	// 437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xd1, 0x6e, 0xd3, 0x30,
+	0x14, 0x86, 0x13, 0x56, 0x0a, 0x72, 0x37, 0x04, 0x66, 0x88, 0xac, 0x42, 0x59, 0xe1, 0x6a, 0x37,
+	0x24, 0x74, 0x7b, 0x03, 0x44, 0x41, 0x68, 0x02, 0x8d, 0x55, 0xe2, 0x02, 0x21, 0x45, 0x6e, 0x7b,
+	0x96, 0x59, 0x6d, 0xe2, 0xca, 0xe7, 0x24, 0x34, 0x6f, 0xc1, 0x63, 0xf5, 0x0a, 0xed, 0x92, 0x2b,
+	0x04, 0xed, 0x8b, 0xa0, 0xba, 0x8e, 0xe4, 0x48, 0xb9, 0x6b, 0xcf, 0xff, 0xfd, 0x5f, 0x8e, 0x2d,
+	0xb3, 0x13, 0x42, 0x8c, 0xcb, 0xe1, 0x04, 0x48, 0x0c, 0xe3, 0x14, 0x72, 0x40, 0x89, 0xd1, 0x52,
+	0x2b, 0x52, 0xbc, 0x47, 0x88, 0x91, 0x8d, 0xfa, 0xc7, 0xa9, 0x4a, 0x95, 0x99, 0xc7, 0xbb, 0x5f,
+	0x7b, 0xa4, 0x1f, 0xb8, 0xed, 0xa5, 0xd0, 0x22, 0xb3, 0xe5, 0xfe, 0x73, 0x37, 0xa1, 0x6a, 0x09,
+	0x75, 0x30, 0xd8, 0x05, 0xb0, 0x5a, 0x2a, 0x4d, 0x30, 0x6b, 0x23, 0x5e, 0xfd, 0xea, 0xb0, 0xc3,
+	0x0f, 0xfb, 0x4d, 0xc6, 0x24, 0x08, 0xf8, 0x90, 0x75, 0xf7, 0xee, 0xc0, 0x1f, 0xf8, 0x67, 0xbd,
+	0xf3, 0xa7, 0x91, 0xb3, 0x59, 0x74, 0x65, 0xa2, 0xb7, 0x9d, 0xf5, 0x9f, 0x53, 0xef, 0xda, 0x82,
+	0xfc, 0x8a, 0xf1, 0x39, 0x54, 0x89, 0x86, 0xa9, 0x2a, 0x41, 0x57, 0x89, 0xcc, 0x6f, 0x14, 0x06,
+	0xf7, 0x06, 0x07, 0x67, 0xbd, 0xf3, 0x17, 0x8d, 0xfa, 0x25, 0x54, 0xd7, 0x96, 0xfa, 0x98, 0xdf,
+	0x28, 0xeb, 0x79, 0x3c, 0x6f, 0x8e, 0x91, 0x5f, 0xb0, 0xce, 0x1c, 0x2a, 0x0c, 0x0e, 0x8c, 0xe3,
+	0xc4, 0x38, 0xea, 0x63, 0xb8, 0x32, 0x2b, 0x30, 0x30, 0x7f, 0xcf, 0x1e, 0x65, 0xc5, 0x82, 0x24,
+	0xca, 0xd4, 0xae, 0xd0, 0x71, 0xea, 0x75, 0xeb, 0x93, 0x45, 0x9c, 0xef, 0x1f, 0x65, 0xce, 0x0c,
+	0xf9, 0x3b, 0x76, 0x04, 0x2b, 0x02, 0x9d, 0x8b, 0x45, 0x62, 0xb6, 0xb8, 0xdf, 0xa2, 0x19, 0x59,
+	0xe2, 0x12, 0xaa, 0xfa, 0x3a, 0x0e, 0xc1, 0x99, 0xf1, 0x11, 0x63, 0x28, 0xd3, 0x5c, 0x50, 0xa1,
+	0x01, 0x83, 0xae, 0x51, 0x9c, 0xb6, 0x1f, 0x64, 0x5c, 0x73, 0x56, 0xe4, 0x14, 0xf9, 0x17, 0xc6,
+	0x4b, 0xb1, 0x90, 0x33, 0x41, 0x4a, 0x27, 0x48, 0x82, 0x0a, 0x04, 0x0c, 0x1e, 0xb4, 0xdc, 0xed,
+	0xd7, 0x1a, 0x1b, 0x1b, 0xca, 0xba, 0x9e, 0x94, 0xcd, 0x31, 0x20, 0xff, 0xce, 0x9e, 0x65, 0x12,
+	0x27, 0x70, 0x2b, 0x4a, 0xa9, 0x0a, 0x9d, 0x40, 0x29, 0x67, 0x90, 0x4f, 0x21, 0x78, 0x68, 0xac,
+	0x2f, 0x9b, 0xd7, 0xe5, 0x90, 0x23, 0x0b, 0x5a, 0xf5, 0x71, 0xd6, 0x96, 0x7d, 0x5e, 0xff, 0x0b,
+	0xbd, 0xf5, 0x26, 0xf4, 0xef, 0x36, 0xa1, 0xff, 0x77, 0x13, 0xfa, 0x3f, 0xb7, 0xa1, 0x77, 0xb7,
+	0x0d, 0xbd, 0xdf, 0xdb, 0xd0, 0xfb, 0xf6, 0x26, 0x95, 0x74, 0x5b, 0x4c, 0xa2, 0xa9, 0xca, 0x62,
+	0xb1, 0x82, 0x85, 0xd0, 0x39, 0xd0, 0x0f, 0xa5, 0xe7, 0xf6, 0xdf, 0xeb, 0xa9, 0xd2, 0x10, 0xaf,
+	0xe2, 0xdd, 0xbb, 0x35, 0xcf, 0x74, 0xd2, 0x35, 0xef, 0xf4, 0xe2, 0xff, 0x00, 0x82, 0xd7, 0xcd,
+	0x75, 0x3c, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -123,6 +126,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MisbehaviourEvidence) > 0 {
+		for iNdEx := len(m.MisbehaviourEvidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MisbehaviourEvidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.ValidatorStatuses) > 0 {
 		for iNdEx := len(m.ValidatorStatuses) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -275,6 +292,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MisbehaviourEvidence) > 0 {
+		for _, e := range m.MisbehaviourEvidence {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -550,6 +573,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MisbehaviourEvidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MisbehaviourEvidence = append(m.MisbehaviourEvidence, MisbehaviourEvidence{})
+			if err := m.MisbehaviourEvidence[len(m.MisbehaviourEvidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -15,11 +15,13 @@ import (
 	"github.com/axelarnetwork/axelar-core/x/tss/types"
 	exported1 "github.com/axelarnetwork/axelar-core/x/vote/exported"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/tendermint/tendermint/libs/log"
 	grpc "google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"sync"
+	"time"
 )
 
 // Ensure, that TofndClientMock does implement types.TofndClient.
@@ -41,6 +43,9 @@ var _ types.TofndClient = &TofndClientMock{}
 // 			RecoverFunc: func(ctx context.Context, in *tofnd.RecoverRequest, opts ...grpc.CallOption) (*tofnd.RecoverResponse, error) {
 // 				panic("mock out the Recover method")
 // 			},
+// 			ReshareFunc: func(ctx context.Context, opts ...grpc.CallOption) (tofnd.GG20_ReshareClient, error) {
+// 				panic("mock out the Reshare method")
+// 			},
 // 			SignFunc: func(ctx context.Context, opts ...grpc.CallOption) (tofnd.GG20_SignClient, error) {
 // 				panic("mock out the Sign method")
 // 			},
@@ -60,6 +65,9 @@ type TofndClientMock struct {
 	// RecoverFunc mocks the Recover method.
 	RecoverFunc func(ctx context.Context, in *tofnd.RecoverRequest, opts ...grpc.CallOption) (*tofnd.RecoverResponse, error)
 
+	// ReshareFunc mocks the Reshare method.
+	ReshareFunc func(ctx context.Context, opts ...grpc.CallOption) (tofnd.GG20_ReshareClient, error)
+
 	// SignFunc mocks the Sign method.
 	SignFunc func(ctx context.Context, opts ...grpc.CallOption) (tofnd.GG20_SignClient, error)
 
@@ -90,6 +98,13 @@ type TofndClientMock struct {
 			// Opts is the opts argument value.
 			Opts []grpc.CallOption
 		}
+		// Reshare holds details about calls to the Reshare method.
+		Reshare []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
 		// Sign holds details about calls to the Sign method.
 		Sign []struct {
 			// Ctx is the ctx argument value.
@@ -101,6 +116,7 @@ type TofndClientMock struct {
 	lockKeyPresence sync.RWMutex
 	lockKeygen      sync.RWMutex
 	lockRecover     sync.RWMutex
+	lockReshare     sync.RWMutex
 	lockSign        sync.RWMutex
 }
 
@@ -217,6 +233,41 @@ func (mock *TofndClientMock) RecoverCalls() []struct {
 	return calls
 }
 
+// Reshare calls ReshareFunc.
+func (mock *TofndClientMock) Reshare(ctx context.Context, opts ...grpc.CallOption) (tofnd.GG20_ReshareClient, error) {
+	if mock.ReshareFunc == nil {
+		panic("TofndClientMock.ReshareFunc: method is nil but TofndClient.Reshare was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockReshare.Lock()
+	mock.calls.Reshare = append(mock.calls.Reshare, callInfo)
+	mock.lockReshare.Unlock()
+	return mock.ReshareFunc(ctx, opts...)
+}
+
+// ReshareCalls gets all the calls that were made to Reshare.
+// Check the length with:
+//     len(mockedTofndClient.ReshareCalls())
+func (mock *TofndClientMock) ReshareCalls() []struct {
+	Ctx  context.Context
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts []grpc.CallOption
+	}
+	mock.lockReshare.RLock()
+	calls = mock.calls.Reshare
+	mock.lockReshare.RUnlock()
+	return calls
+}
+
 // Sign calls SignFunc.
 func (mock *TofndClientMock) Sign(ctx context.Context, opts ...grpc.CallOption) (tofnd.GG20_SignClient, error) {
 	if mock.SignFunc == nil {
@@ -1209,6 +1260,225 @@ func (mock *StakingKeeperMock) ValidatorCalls() []struct {
 	return calls
 }
 
+
+// Ensure, that SlasherMock does implement types.Slasher.
+// If this is not the case, regenerate this file with moq.
+var _ types.Slasher = &SlasherMock{}
+
+// SlasherMock is a mock implementation of types.Slasher.
+//
+// 	func TestSomethingThatUsesSlasher(t *testing.T) {
+//
+// 		// make and configure a mocked types.Slasher
+// 		mockedSlasher := &SlasherMock{
+// 			GetValidatorSigningInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, address github_com_cosmos_cosmos_sdk_types.ConsAddress) (slashingtypes.ValidatorSigningInfo, bool) {
+// 				panic("mock out the GetValidatorSigningInfo method")
+// 			},
+// 			JailFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, consAddr github_com_cosmos_cosmos_sdk_types.ConsAddress)  {
+// 				panic("mock out the Jail method")
+// 			},
+// 			JailUntilFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, consAddr github_com_cosmos_cosmos_sdk_types.ConsAddress, jailTime time.Time)  {
+// 				panic("mock out the JailUntil method")
+// 			},
+// 			SignedBlocksWindowFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
+// 				panic("mock out the SignedBlocksWindow method")
+// 			},
+// 		}
+//
+// 		// use mockedSlasher in code that requires types.Slasher
+// 		// and then make assertions.
+//
+// 	}
+type SlasherMock struct {
+	// GetValidatorSigningInfoFunc mocks the GetValidatorSigningInfo method.
+	GetValidatorSigningInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, address github_com_cosmos_cosmos_sdk_types.ConsAddress) (slashingtypes.ValidatorSigningInfo, bool)
+
+	// JailFunc mocks the Jail method.
+	JailFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, consAddr github_com_cosmos_cosmos_sdk_types.ConsAddress)
+
+	// JailUntilFunc mocks the JailUntil method.
+	JailUntilFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, consAddr github_com_cosmos_cosmos_sdk_types.ConsAddress, jailTime time.Time)
+
+	// SignedBlocksWindowFunc mocks the SignedBlocksWindow method.
+	SignedBlocksWindowFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetValidatorSigningInfo holds details about calls to the GetValidatorSigningInfo method.
+		GetValidatorSigningInfo []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Address is the address argument value.
+			Address github_com_cosmos_cosmos_sdk_types.ConsAddress
+		}
+		// Jail holds details about calls to the Jail method.
+		Jail []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// ConsAddr is the consAddr argument value.
+			ConsAddr github_com_cosmos_cosmos_sdk_types.ConsAddress
+		}
+		// JailUntil holds details about calls to the JailUntil method.
+		JailUntil []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// ConsAddr is the consAddr argument value.
+			ConsAddr github_com_cosmos_cosmos_sdk_types.ConsAddress
+			// JailTime is the jailTime argument value.
+			JailTime time.Time
+		}
+		// SignedBlocksWindow holds details about calls to the SignedBlocksWindow method.
+		SignedBlocksWindow []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
+	}
+	lockGetValidatorSigningInfo sync.RWMutex
+	lockJail                    sync.RWMutex
+	lockJailUntil               sync.RWMutex
+	lockSignedBlocksWindow      sync.RWMutex
+}
+
+// GetValidatorSigningInfo calls GetValidatorSigningInfoFunc.
+func (mock *SlasherMock) GetValidatorSigningInfo(ctx github_com_cosmos_cosmos_sdk_types.Context, address github_com_cosmos_cosmos_sdk_types.ConsAddress) (slashingtypes.ValidatorSigningInfo, bool) {
+	if mock.GetValidatorSigningInfoFunc == nil {
+		panic("SlasherMock.GetValidatorSigningInfoFunc: method is nil but Slasher.GetValidatorSigningInfo was just called")
+	}
+	callInfo := struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		Address github_com_cosmos_cosmos_sdk_types.ConsAddress
+	}{
+		Ctx:     ctx,
+		Address: address,
+	}
+	mock.lockGetValidatorSigningInfo.Lock()
+	mock.calls.GetValidatorSigningInfo = append(mock.calls.GetValidatorSigningInfo, callInfo)
+	mock.lockGetValidatorSigningInfo.Unlock()
+	return mock.GetValidatorSigningInfoFunc(ctx, address)
+}
+
+// GetValidatorSigningInfoCalls gets all the calls that were made to GetValidatorSigningInfo.
+// Check the length with:
+//     len(mockedSlasher.GetValidatorSigningInfoCalls())
+func (mock *SlasherMock) GetValidatorSigningInfoCalls() []struct {
+	Ctx     github_com_cosmos_cosmos_sdk_types.Context
+	Address github_com_cosmos_cosmos_sdk_types.ConsAddress
+} {
+	var calls []struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		Address github_com_cosmos_cosmos_sdk_types.ConsAddress
+	}
+	mock.lockGetValidatorSigningInfo.RLock()
+	calls = mock.calls.GetValidatorSigningInfo
+	mock.lockGetValidatorSigningInfo.RUnlock()
+	return calls
+}
+
+// Jail calls JailFunc.
+func (mock *SlasherMock) Jail(ctx github_com_cosmos_cosmos_sdk_types.Context, consAddr github_com_cosmos_cosmos_sdk_types.ConsAddress) {
+	if mock.JailFunc == nil {
+		panic("SlasherMock.JailFunc: method is nil but Slasher.Jail was just called")
+	}
+	callInfo := struct {
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
+		ConsAddr github_com_cosmos_cosmos_sdk_types.ConsAddress
+	}{
+		Ctx:      ctx,
+		ConsAddr: consAddr,
+	}
+	mock.lockJail.Lock()
+	mock.calls.Jail = append(mock.calls.Jail, callInfo)
+	mock.lockJail.Unlock()
+	mock.JailFunc(ctx, consAddr)
+}
+
+// JailCalls gets all the calls that were made to Jail.
+// Check the length with:
+//     len(mockedSlasher.JailCalls())
+func (mock *SlasherMock) JailCalls() []struct {
+	Ctx      github_com_cosmos_cosmos_sdk_types.Context
+	ConsAddr github_com_cosmos_cosmos_sdk_types.ConsAddress
+} {
+	var calls []struct {
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
+		ConsAddr github_com_cosmos_cosmos_sdk_types.ConsAddress
+	}
+	mock.lockJail.RLock()
+	calls = mock.calls.Jail
+	mock.lockJail.RUnlock()
+	return calls
+}
+
+// JailUntil calls JailUntilFunc.
+func (mock *SlasherMock) JailUntil(ctx github_com_cosmos_cosmos_sdk_types.Context, consAddr github_com_cosmos_cosmos_sdk_types.ConsAddress, jailTime time.Time) {
+	if mock.JailUntilFunc == nil {
+		panic("SlasherMock.JailUntilFunc: method is nil but Slasher.JailUntil was just called")
+	}
+	callInfo := struct {
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
+		ConsAddr github_com_cosmos_cosmos_sdk_types.ConsAddress
+		JailTime time.Time
+	}{
+		Ctx:      ctx,
+		ConsAddr: consAddr,
+		JailTime: jailTime,
+	}
+	mock.lockJailUntil.Lock()
+	mock.calls.JailUntil = append(mock.calls.JailUntil, callInfo)
+	mock.lockJailUntil.Unlock()
+	mock.JailUntilFunc(ctx, consAddr, jailTime)
+}
+
+// JailUntilCalls gets all the calls that were made to JailUntil.
+// Check the length with:
+//     len(mockedSlasher.JailUntilCalls())
+func (mock *SlasherMock) JailUntilCalls() []struct {
+	Ctx      github_com_cosmos_cosmos_sdk_types.Context
+	ConsAddr github_com_cosmos_cosmos_sdk_types.ConsAddress
+	JailTime time.Time
+} {
+	var calls []struct {
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
+		ConsAddr github_com_cosmos_cosmos_sdk_types.ConsAddress
+		JailTime time.Time
+	}
+	mock.lockJailUntil.RLock()
+	calls = mock.calls.JailUntil
+	mock.lockJailUntil.RUnlock()
+	return calls
+}
+
+// SignedBlocksWindow calls SignedBlocksWindowFunc.
+func (mock *SlasherMock) SignedBlocksWindow(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
+	if mock.SignedBlocksWindowFunc == nil {
+		panic("SlasherMock.SignedBlocksWindowFunc: method is nil but Slasher.SignedBlocksWindow was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockSignedBlocksWindow.Lock()
+	mock.calls.SignedBlocksWindow = append(mock.calls.SignedBlocksWindow, callInfo)
+	mock.lockSignedBlocksWindow.Unlock()
+	return mock.SignedBlocksWindowFunc(ctx)
+}
+
+// SignedBlocksWindowCalls gets all the calls that were made to SignedBlocksWindow.
+// Check the length with:
+//     len(mockedSlasher.SignedBlocksWindowCalls())
+func (mock *SlasherMock) SignedBlocksWindowCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockSignedBlocksWindow.RLock()
+	calls = mock.calls.SignedBlocksWindow
+	mock.lockSignedBlocksWindow.RUnlock()
+	return calls
+}
+
 // Ensure, that TSSKeeperMock does implement types.TSSKeeper.
 // If this is not the case, regenerate this file with moq.
 var _ types.TSSKeeper = &TSSKeeperMock{}
@@ -1219,12 +1489,18 @@ var _ types.TSSKeeper = &TSSKeeperMock{}
 //
 // 		// make and configure a mocked types.TSSKeeper
 // 		mockedTSSKeeper := &TSSKeeperMock{
+// 			AbortReshareFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sessionID string) error {
+// 				panic("mock out the AbortReshare method")
+// 			},
 // 			AssertMatchesRequirementsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter, chain nexus.Chain, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error {
 // 				panic("mock out the AssertMatchesRequirements method")
 // 			},
 // 			AssignNextKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) error {
 // 				panic("mock out the AssignNextKey method")
 // 			},
+// 			CompleteReshareFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sessionID string, groupRecoveryInfo []byte) error {
+// 				panic("mock out the CompleteReshare method")
+// 			},
 // 			DeleteInfoForSigFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string)  {
 // 				panic("mock out the DeleteInfoForSig method")
 // 			},
@@ -1240,15 +1516,27 @@ var _ types.TSSKeeper = &TSSKeeperMock{}
 // 			DeleteMultisigSignFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, signID string)  {
 // 				panic("mock out the DeleteMultisigSign method")
 // 			},
+// 			DeleteScheduledRotationFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole)  {
+// 				panic("mock out the DeleteScheduledRotation method")
+// 			},
 // 			DeleteSnapshotCounterForKeyIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID)  {
 // 				panic("mock out the DeleteSnapshotCounterForKeyID method")
 // 			},
+// 			DeleteTrafficHashesFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sessionID string)  {
+// 				panic("mock out the DeleteTrafficHashes method")
+// 			},
 // 			DoesValidatorParticipateInSignFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string, validator github_com_cosmos_cosmos_sdk_types.ValAddress) bool {
 // 				panic("mock out the DoesValidatorParticipateInSign method")
 // 			},
 // 			GetAvailableOperatorsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyIDs ...github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) []github_com_cosmos_cosmos_sdk_types.ValAddress {
 // 				panic("mock out the GetAvailableOperators method")
 // 			},
+// 			GetAverageSignDurationFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) (int64, bool) {
+// 				panic("mock out the GetAverageSignDuration method")
+// 			},
+// 			GetCompletedReshareInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sessionID string) (types.ReshareInfo, bool) {
+// 				panic("mock out the GetCompletedReshareInfo method")
+// 			},
 // 			GetCurrentKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
 // 				panic("mock out the GetCurrentKey method")
 // 			},
@@ -1276,12 +1564,21 @@ var _ types.TSSKeeper = &TSSKeeperMock{}
 // 			GetKeyRequirementFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, keyType github_com_axelarnetwork_axelar_core_x_tss_exported.KeyType) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRequirement, bool) {
 // 				panic("mock out the GetKeyRequirement method")
 // 			},
+// 			GetKeyRotationPoliciesFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.KeyRotationPolicy {
+// 				panic("mock out the GetKeyRotationPolicies method")
+// 			},
+// 			GetKeyStateFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) types.KeyState {
+// 				panic("mock out the GetKeyState method")
+// 			},
 // 			GetKeyTypeFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) github_com_axelarnetwork_axelar_core_x_tss_exported.KeyType {
 // 				panic("mock out the GetKeyType method")
 // 			},
 // 			GetMaxSimultaneousSignSharesFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
 // 				panic("mock out the GetMaxSimultaneousSignShares method")
 // 			},
+// 			GetMisbehaviourHistoryFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, validator github_com_cosmos_cosmos_sdk_types.ValAddress) []types.MisbehaviourEvidence {
+// 				panic("mock out the GetMisbehaviourHistory method")
+// 			},
 // 			GetMultisigKeygenInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (types.MultisigKeygenInfo, bool) {
 // 				panic("mock out the GetMultisigKeygenInfo method")
 // 			},
@@ -1306,9 +1603,21 @@ var _ types.TSSKeeper = &TSSKeeperMock{}
 // 			GetPrivateRecoveryInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender github_com_cosmos_cosmos_sdk_types.ValAddress, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) []byte {
 // 				panic("mock out the GetPrivateRecoveryInfo method")
 // 			},
+// 			GetRecentOffenceCountFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, validator github_com_cosmos_cosmos_sdk_types.ValAddress) int64 {
+// 				panic("mock out the GetRecentOffenceCount method")
+// 			},
+// 			GetReshareInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sessionID string) (types.ReshareInfo, bool) {
+// 				panic("mock out the GetReshareInfo method")
+// 			},
+// 			GetRotatedAtHeightFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (int64, bool) {
+// 				panic("mock out the GetRotatedAtHeight method")
+// 			},
 // 			GetRouterFunc: func() types.Router {
 // 				panic("mock out the GetRouter method")
 // 			},
+// 			GetScheduledRotationFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (types.ScheduledRotation, bool) {
+// 				panic("mock out the GetScheduledRotation method")
+// 			},
 // 			GetSigFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) (github_com_axelarnetwork_axelar_core_x_tss_exported.Signature, github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus) {
 // 				panic("mock out the GetSig method")
 // 			},
@@ -1321,12 +1630,24 @@ var _ types.TSSKeeper = &TSSKeeperMock{}
 // 			GetSignParticipantsSharesAsJSONFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) []byte {
 // 				panic("mock out the GetSignParticipantsSharesAsJSON method")
 // 			},
+// 			GetSignQueueFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) utils.SequenceKVQueue {
+// 				panic("mock out the GetSignQueue method")
+// 			},
+// 			GetSignQueueWeightsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.SignQueueWeight {
+// 				panic("mock out the GetSignQueueWeights method")
+// 			},
+// 			GetSignStartHeightFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) (int64, bool) {
+// 				panic("mock out the GetSignStartHeight method")
+// 			},
 // 			GetSnapshotCounterForKeyIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (int64, bool) {
 // 				panic("mock out the GetSnapshotCounterForKeyID method")
 // 			},
 // 			GetSuspendedUntilFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, validator github_com_cosmos_cosmos_sdk_types.ValAddress) int64 {
 // 				panic("mock out the GetSuspendedUntil method")
 // 			},
+// 			GetTimedOutResharesFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.ReshareInfo {
+// 				panic("mock out the GetTimedOutReshares method")
+// 			},
 // 			HasKeygenStartedFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) bool {
 // 				panic("mock out the HasKeygenStarted method")
 // 			},
@@ -1339,9 +1660,18 @@ var _ types.TSSKeeper = &TSSKeeperMock{}
 // 			LoggerFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) log.Logger {
 // 				panic("mock out the Logger method")
 // 			},
-// 			PenalizeCriminalFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, criminal github_com_cosmos_cosmos_sdk_types.ValAddress, crimeType tofnd.MessageOut_CriminalList_Criminal_CrimeType)  {
+// 			PenalizeCriminalFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sessionID string, attempt int64, criminal github_com_cosmos_cosmos_sdk_types.ValAddress, crimeType tofnd.MessageOut_CriminalList_Criminal_CrimeType, accusers []github_com_cosmos_cosmos_sdk_types.ValAddress)  {
 // 				panic("mock out the PenalizeCriminal method")
 // 			},
+// 			RecordSignCompletionFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string, status github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus)  {
+// 				panic("mock out the RecordSignCompletion method")
+// 			},
+// 			RecordTrafficHashFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sessionID string, sender github_com_cosmos_cosmos_sdk_types.ValAddress, payload *tofnd.TrafficOut)  {
+// 				panic("mock out the RecordTrafficHash method")
+// 			},
+// 			RetrySignFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, absentees []github_com_cosmos_cosmos_sdk_types.ValAddress, snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter, voter interface{InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, snapshotSeqNo int64, pollProperties ...exported1.PollProperty) error}) error {
+// 				panic("mock out the RetrySign method")
+// 			},
 // 			RotateKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error {
 // 				panic("mock out the RotateKey method")
 // 			},
@@ -1369,15 +1699,24 @@ var _ types.TSSKeeper = &TSSKeeperMock{}
 // 			SetPrivateRecoveryInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender github_com_cosmos_cosmos_sdk_types.ValAddress, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, recoveryInfo []byte)  {
 // 				panic("mock out the SetPrivateRecoveryInfo method")
 // 			},
+// 			SetScheduledRotationFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, rotation types.ScheduledRotation)  {
+// 				panic("mock out the SetScheduledRotation method")
+// 			},
 // 			SetSigFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, signature github_com_axelarnetwork_axelar_core_x_tss_exported.Signature)  {
 // 				panic("mock out the SetSig method")
 // 			},
 // 			SetSigStatusFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string, status github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus)  {
 // 				panic("mock out the SetSigStatus method")
 // 			},
+// 			SetSignStartHeightFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string)  {
+// 				panic("mock out the SetSignStartHeight method")
+// 			},
 // 			StartKeygenFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, voter types.Voter, keyInfo types.KeyInfo, snapshot github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot) error {
 // 				panic("mock out the StartKeygen method")
 // 			},
+// 			StartReshareFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, voter types.Voter, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, snapshot github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot) (types.ReshareInfo, error) {
+// 				panic("mock out the StartReshare method")
+// 			},
 // 			StartSignFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter, voter interface{InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, snapshotSeqNo int64, pollProperties ...exported1.PollProperty) error}) error {
 // 				panic("mock out the StartSign method")
 // 			},
@@ -1394,12 +1733,18 @@ var _ types.TSSKeeper = &TSSKeeperMock{}
 //
 // 	}
 type TSSKeeperMock struct {
+	// AbortReshareFunc mocks the AbortReshare method.
+	AbortReshareFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sessionID string) error
+
 	// AssertMatchesRequirementsFunc mocks the AssertMatchesRequirements method.
 	AssertMatchesRequirementsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter, chain nexus.Chain, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error
 
 	// AssignNextKeyFunc mocks the AssignNextKey method.
 	AssignNextKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) error
 
+	// CompleteReshareFunc mocks the CompleteReshare method.
+	CompleteReshareFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sessionID string, groupRecoveryInfo []byte) error
+
 	// DeleteInfoForSigFunc mocks the DeleteInfoForSig method.
 	DeleteInfoForSigFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string)
 
@@ -1415,15 +1760,27 @@ type TSSKeeperMock struct {
 	// DeleteMultisigSignFunc mocks the DeleteMultisigSign method.
 	DeleteMultisigSignFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, signID string)
 
+	// DeleteScheduledRotationFunc mocks the DeleteScheduledRotation method.
+	DeleteScheduledRotationFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole)
+
 	// DeleteSnapshotCounterForKeyIDFunc mocks the DeleteSnapshotCounterForKeyID method.
 	DeleteSnapshotCounterForKeyIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID)
 
+	// DeleteTrafficHashesFunc mocks the DeleteTrafficHashes method.
+	DeleteTrafficHashesFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sessionID string)
+
 	// DoesValidatorParticipateInSignFunc mocks the DoesValidatorParticipateInSign method.
 	DoesValidatorParticipateInSignFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string, validator github_com_cosmos_cosmos_sdk_types.ValAddress) bool
 
 	// GetAvailableOperatorsFunc mocks the GetAvailableOperators method.
 	GetAvailableOperatorsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyIDs ...github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) []github_com_cosmos_cosmos_sdk_types.ValAddress
 
+	// GetAverageSignDurationFunc mocks the GetAverageSignDuration method.
+	GetAverageSignDurationFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) (int64, bool)
+
+	// GetCompletedReshareInfoFunc mocks the GetCompletedReshareInfo method.
+	GetCompletedReshareInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sessionID string) (types.ReshareInfo, bool)
+
 	// GetCurrentKeyFunc mocks the GetCurrentKey method.
 	GetCurrentKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool)
 
@@ -1451,12 +1808,21 @@ type TSSKeeperMock struct {
 	// GetKeyRequirementFunc mocks the GetKeyRequirement method.
 	GetKeyRequirementFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, keyType github_com_axelarnetwork_axelar_core_x_tss_exported.KeyType) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRequirement, bool)
 
+	// GetKeyRotationPoliciesFunc mocks the GetKeyRotationPolicies method.
+	GetKeyRotationPoliciesFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.KeyRotationPolicy
+
+	// GetKeyStateFunc mocks the GetKeyState method.
+	GetKeyStateFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) types.KeyState
+
 	// GetKeyTypeFunc mocks the GetKeyType method.
 	GetKeyTypeFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) github_com_axelarnetwork_axelar_core_x_tss_exported.KeyType
 
 	// GetMaxSimultaneousSignSharesFunc mocks the GetMaxSimultaneousSignShares method.
 	GetMaxSimultaneousSignSharesFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64
 
+	// GetMisbehaviourHistoryFunc mocks the GetMisbehaviourHistory method.
+	GetMisbehaviourHistoryFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, validator github_com_cosmos_cosmos_sdk_types.ValAddress) []types.MisbehaviourEvidence
+
 	// GetMultisigKeygenInfoFunc mocks the GetMultisigKeygenInfo method.
 	GetMultisigKeygenInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (types.MultisigKeygenInfo, bool)
 
@@ -1481,9 +1847,21 @@ type TSSKeeperMock struct {
 	// GetPrivateRecoveryInfoFunc mocks the GetPrivateRecoveryInfo method.
 	GetPrivateRecoveryInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender github_com_cosmos_cosmos_sdk_types.ValAddress, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) []byte
 
+	// GetRecentOffenceCountFunc mocks the GetRecentOffenceCount method.
+	GetRecentOffenceCountFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, validator github_com_cosmos_cosmos_sdk_types.ValAddress) int64
+
+	// GetReshareInfoFunc mocks the GetReshareInfo method.
+	GetReshareInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sessionID string) (types.ReshareInfo, bool)
+
+	// GetRotatedAtHeightFunc mocks the GetRotatedAtHeight method.
+	GetRotatedAtHeightFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (int64, bool)
+
 	// GetRouterFunc mocks the GetRouter method.
 	GetRouterFunc func() types.Router
 
+	// GetScheduledRotationFunc mocks the GetScheduledRotation method.
+	GetScheduledRotationFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (types.ScheduledRotation, bool)
+
 	// GetSigFunc mocks the GetSig method.
 	GetSigFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) (github_com_axelarnetwork_axelar_core_x_tss_exported.Signature, github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus)
 
@@ -1496,12 +1874,24 @@ type TSSKeeperMock struct {
 	// GetSignParticipantsSharesAsJSONFunc mocks the GetSignParticipantsSharesAsJSON method.
 	GetSignParticipantsSharesAsJSONFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) []byte
 
+	// GetSignQueueFunc mocks the GetSignQueue method.
+	GetSignQueueFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) utils.SequenceKVQueue
+
+	// GetSignQueueWeightsFunc mocks the GetSignQueueWeights method.
+	GetSignQueueWeightsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.SignQueueWeight
+
+	// GetSignStartHeightFunc mocks the GetSignStartHeight method.
+	GetSignStartHeightFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) (int64, bool)
+
 	// GetSnapshotCounterForKeyIDFunc mocks the GetSnapshotCounterForKeyID method.
 	GetSnapshotCounterForKeyIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (int64, bool)
 
 	// GetSuspendedUntilFunc mocks the GetSuspendedUntil method.
 	GetSuspendedUntilFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, validator github_com_cosmos_cosmos_sdk_types.ValAddress) int64
 
+	// GetTimedOutResharesFunc mocks the GetTimedOutReshares method.
+	GetTimedOutResharesFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.ReshareInfo
+
 	// HasKeygenStartedFunc mocks the HasKeygenStarted method.
 	HasKeygenStartedFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) bool
 
@@ -1515,7 +1905,18 @@ type TSSKeeperMock struct {
 	LoggerFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) log.Logger
 
 	// PenalizeCriminalFunc mocks the PenalizeCriminal method.
-	PenalizeCriminalFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, criminal github_com_cosmos_cosmos_sdk_types.ValAddress, crimeType tofnd.MessageOut_CriminalList_Criminal_CrimeType)
+	PenalizeCriminalFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sessionID string, attempt int64, criminal github_com_cosmos_cosmos_sdk_types.ValAddress, crimeType tofnd.MessageOut_CriminalList_Criminal_CrimeType, accusers []github_com_cosmos_cosmos_sdk_types.ValAddress)
+
+	// RecordSignCompletionFunc mocks the RecordSignCompletion method.
+	RecordSignCompletionFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string, status github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus)
+
+	// RecordTrafficHashFunc mocks the RecordTrafficHash method.
+	RecordTrafficHashFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sessionID string, sender github_com_cosmos_cosmos_sdk_types.ValAddress, payload *tofnd.TrafficOut)
+
+	// RetrySignFunc mocks the RetrySign method.
+	RetrySignFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, absentees []github_com_cosmos_cosmos_sdk_types.ValAddress, snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter, voter interface {
+		InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, snapshotSeqNo int64, pollProperties ...exported1.PollProperty) error
+	}) error
 
 	// RotateKeyFunc mocks the RotateKey method.
 	RotateKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error
@@ -1544,15 +1945,24 @@ type TSSKeeperMock struct {
 	// SetPrivateRecoveryInfoFunc mocks the SetPrivateRecoveryInfo method.
 	SetPrivateRecoveryInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender github_com_cosmos_cosmos_sdk_types.ValAddress, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, recoveryInfo []byte)
 
+	// SetScheduledRotationFunc mocks the SetScheduledRotation method.
+	SetScheduledRotationFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, rotation types.ScheduledRotation)
+
 	// SetSigFunc mocks the SetSig method.
 	SetSigFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, signature github_com_axelarnetwork_axelar_core_x_tss_exported.Signature)
 
 	// SetSigStatusFunc mocks the SetSigStatus method.
 	SetSigStatusFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string, status github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus)
 
+	// SetSignStartHeightFunc mocks the SetSignStartHeight method.
+	SetSignStartHeightFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string)
+
 	// StartKeygenFunc mocks the StartKeygen method.
 	StartKeygenFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, voter types.Voter, keyInfo types.KeyInfo, snapshot github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot) error
 
+	// StartReshareFunc mocks the StartReshare method.
+	StartReshareFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, voter types.Voter, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, snapshot github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot) (types.ReshareInfo, error)
+
 	// StartSignFunc mocks the StartSign method.
 	StartSignFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter, voter interface {
 		InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, snapshotSeqNo int64, pollProperties ...exported1.PollProperty) error
@@ -1566,6 +1976,13 @@ type TSSKeeperMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// AbortReshare holds details about calls to the AbortReshare method.
+		AbortReshare []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// SessionID is the sessionID argument value.
+			SessionID string
+		}
 		// AssertMatchesRequirements holds details about calls to the AssertMatchesRequirements method.
 		AssertMatchesRequirements []struct {
 			// Ctx is the ctx argument value.
@@ -1590,6 +2007,15 @@ type TSSKeeperMock struct {
 			// KeyID is the keyID argument value.
 			KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 		}
+		// CompleteReshare holds details about calls to the CompleteReshare method.
+		CompleteReshare []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// SessionID is the sessionID argument value.
+			SessionID string
+			// GroupRecoveryInfo is the groupRecoveryInfo argument value.
+			GroupRecoveryInfo []byte
+		}
 		// DeleteInfoForSig holds details about calls to the DeleteInfoForSig method.
 		DeleteInfoForSig []struct {
 			// Ctx is the ctx argument value.
@@ -1625,6 +2051,15 @@ type TSSKeeperMock struct {
 			// SignID is the signID argument value.
 			SignID string
 		}
+		// DeleteScheduledRotation holds details about calls to the DeleteScheduledRotation method.
+		DeleteScheduledRotation []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Chain is the chain argument value.
+			Chain nexus.Chain
+			// KeyRole is the keyRole argument value.
+			KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+		}
 		// DeleteSnapshotCounterForKeyID holds details about calls to the DeleteSnapshotCounterForKeyID method.
 		DeleteSnapshotCounterForKeyID []struct {
 			// Ctx is the ctx argument value.
@@ -1632,6 +2067,13 @@ type TSSKeeperMock struct {
 			// KeyID is the keyID argument value.
 			KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 		}
+		// DeleteTrafficHashes holds details about calls to the DeleteTrafficHashes method.
+		DeleteTrafficHashes []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// SessionID is the sessionID argument value.
+			SessionID string
+		}
 		// DoesValidatorParticipateInSign holds details about calls to the DoesValidatorParticipateInSign method.
 		DoesValidatorParticipateInSign []struct {
 			// Ctx is the ctx argument value.
@@ -1648,6 +2090,18 @@ type TSSKeeperMock struct {
 			// KeyIDs is the keyIDs argument value.
 			KeyIDs []github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 		}
+		// GetAverageSignDuration holds details about calls to the GetAverageSignDuration method.
+		GetAverageSignDuration []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
+		// GetCompletedReshareInfo holds details about calls to the GetCompletedReshareInfo method.
+		GetCompletedReshareInfo []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// SessionID is the sessionID argument value.
+			SessionID string
+		}
 		// GetCurrentKey holds details about calls to the GetCurrentKey method.
 		GetCurrentKey []struct {
 			// Ctx is the ctx argument value.
@@ -1713,6 +2167,18 @@ type TSSKeeperMock struct {
 			// KeyType is the keyType argument value.
 			KeyType github_com_axelarnetwork_axelar_core_x_tss_exported.KeyType
 		}
+		// GetKeyRotationPolicies holds details about calls to the GetKeyRotationPolicies method.
+		GetKeyRotationPolicies []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
+		// GetKeyState holds details about calls to the GetKeyState method.
+		GetKeyState []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// KeyID is the keyID argument value.
+			KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
+		}
 		// GetKeyType holds details about calls to the GetKeyType method.
 		GetKeyType []struct {
 			// Ctx is the ctx argument value.
@@ -1725,6 +2191,13 @@ type TSSKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
+		// GetMisbehaviourHistory holds details about calls to the GetMisbehaviourHistory method.
+		GetMisbehaviourHistory []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Validator is the validator argument value.
+			Validator github_com_cosmos_cosmos_sdk_types.ValAddress
+		}
 		// GetMultisigKeygenInfo holds details about calls to the GetMultisigKeygenInfo method.
 		GetMultisigKeygenInfo []struct {
 			// Ctx is the ctx argument value.
@@ -1789,9 +2262,41 @@ type TSSKeeperMock struct {
 			// KeyID is the keyID argument value.
 			KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 		}
+		// GetRecentOffenceCount holds details about calls to the GetRecentOffenceCount method.
+		GetRecentOffenceCount []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Validator is the validator argument value.
+			Validator github_com_cosmos_cosmos_sdk_types.ValAddress
+		}
+		// GetReshareInfo holds details about calls to the GetReshareInfo method.
+		GetReshareInfo []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// SessionID is the sessionID argument value.
+			SessionID string
+		}
+		// GetRotatedAtHeight holds details about calls to the GetRotatedAtHeight method.
+		GetRotatedAtHeight []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Chain is the chain argument value.
+			Chain nexus.Chain
+			// KeyRole is the keyRole argument value.
+			KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+		}
 		// GetRouter holds details about calls to the GetRouter method.
 		GetRouter []struct {
 		}
+		// GetScheduledRotation holds details about calls to the GetScheduledRotation method.
+		GetScheduledRotation []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Chain is the chain argument value.
+			Chain nexus.Chain
+			// KeyRole is the keyRole argument value.
+			KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+		}
 		// GetSig holds details about calls to the GetSig method.
 		GetSig []struct {
 			// Ctx is the ctx argument value.
@@ -1820,6 +2325,23 @@ type TSSKeeperMock struct {
 			// SigID is the sigID argument value.
 			SigID string
 		}
+		// GetSignQueue holds details about calls to the GetSignQueue method.
+		GetSignQueue []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
+		// GetSignQueueWeights holds details about calls to the GetSignQueueWeights method.
+		GetSignQueueWeights []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
+		// GetSignStartHeight holds details about calls to the GetSignStartHeight method.
+		GetSignStartHeight []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// SigID is the sigID argument value.
+			SigID string
+		}
 		// GetSnapshotCounterForKeyID holds details about calls to the GetSnapshotCounterForKeyID method.
 		GetSnapshotCounterForKeyID []struct {
 			// Ctx is the ctx argument value.
@@ -1834,6 +2356,11 @@ type TSSKeeperMock struct {
 			// Validator is the validator argument value.
 			Validator github_com_cosmos_cosmos_sdk_types.ValAddress
 		}
+		// GetTimedOutReshares holds details about calls to the GetTimedOutReshares method.
+		GetTimedOutReshares []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
 		// HasKeygenStarted holds details about calls to the HasKeygenStarted method.
 		HasKeygenStarted []struct {
 			// Ctx is the ctx argument value.
@@ -1866,19 +2393,60 @@ type TSSKeeperMock struct {
 		PenalizeCriminal []struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// SessionID is the sessionID argument value.
+			SessionID string
+			// Attempt is the attempt argument value.
+			Attempt int64
 			// Criminal is the criminal argument value.
 			Criminal github_com_cosmos_cosmos_sdk_types.ValAddress
 			// CrimeType is the crimeType argument value.
 			CrimeType tofnd.MessageOut_CriminalList_Criminal_CrimeType
+			// Accusers is the accusers argument value.
+			Accusers []github_com_cosmos_cosmos_sdk_types.ValAddress
 		}
-		// RotateKey holds details about calls to the RotateKey method.
-		RotateKey []struct {
+		// RecordSignCompletion holds details about calls to the RecordSignCompletion method.
+		RecordSignCompletion []struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
-			// Chain is the chain argument value.
-			Chain nexus.Chain
-			// KeyRole is the keyRole argument value.
-			KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+			// SigID is the sigID argument value.
+			SigID string
+			// Status is the status argument value.
+			Status github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus
+		}
+		// RecordTrafficHash holds details about calls to the RecordTrafficHash method.
+		RecordTrafficHash []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// SessionID is the sessionID argument value.
+			SessionID string
+			// Sender is the sender argument value.
+			Sender github_com_cosmos_cosmos_sdk_types.ValAddress
+			// Payload is the payload argument value.
+			Payload *tofnd.TrafficOut
+		}
+		// RetrySign holds details about calls to the RetrySign method.
+		RetrySign []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Info is the info argument value.
+			Info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
+			// Absentees is the absentees argument value.
+			Absentees []github_com_cosmos_cosmos_sdk_types.ValAddress
+			// Snapshotter is the snapshotter argument value.
+			Snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter
+			// Voter is the voter argument value.
+			Voter interface {
+				InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, snapshotSeqNo int64, pollProperties ...exported1.PollProperty) error
+			}
+		}
+		// RotateKey holds details about calls to the RotateKey method.
+		RotateKey []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Chain is the chain argument value.
+			Chain nexus.Chain
+			// KeyRole is the keyRole argument value.
+			KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 		}
 		// SelectSignParticipants holds details about calls to the SelectSignParticipants method.
 		SelectSignParticipants []struct {
@@ -1954,6 +2522,13 @@ type TSSKeeperMock struct {
 			// RecoveryInfo is the recoveryInfo argument value.
 			RecoveryInfo []byte
 		}
+		// SetScheduledRotation holds details about calls to the SetScheduledRotation method.
+		SetScheduledRotation []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Rotation is the rotation argument value.
+			Rotation types.ScheduledRotation
+		}
 		// SetSig holds details about calls to the SetSig method.
 		SetSig []struct {
 			// Ctx is the ctx argument value.
@@ -1970,6 +2545,13 @@ type TSSKeeperMock struct {
 			// Status is the status argument value.
 			Status github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus
 		}
+		// SetSignStartHeight holds details about calls to the SetSignStartHeight method.
+		SetSignStartHeight []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// SigID is the sigID argument value.
+			SigID string
+		}
 		// StartKeygen holds details about calls to the StartKeygen method.
 		StartKeygen []struct {
 			// Ctx is the ctx argument value.
@@ -1981,6 +2563,17 @@ type TSSKeeperMock struct {
 			// Snapshot is the snapshot argument value.
 			Snapshot github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot
 		}
+		// StartReshare holds details about calls to the StartReshare method.
+		StartReshare []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Voter is the voter argument value.
+			Voter types.Voter
+			// KeyID is the keyID argument value.
+			KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
+			// Snapshot is the snapshot argument value.
+			Snapshot github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot
+		}
 		// StartSign holds details about calls to the StartSign method.
 		StartSign []struct {
 			// Ctx is the ctx argument value.
@@ -2017,16 +2610,22 @@ type TSSKeeperMock struct {
 			Sigs [][]byte
 		}
 	}
+	lockAbortReshare                    sync.RWMutex
 	lockAssertMatchesRequirements       sync.RWMutex
 	lockAssignNextKey                   sync.RWMutex
+	lockCompleteReshare                 sync.RWMutex
 	lockDeleteInfoForSig                sync.RWMutex
 	lockDeleteKeyRecoveryInfo           sync.RWMutex
 	lockDeleteKeygenStart               sync.RWMutex
 	lockDeleteMultisigKeygen            sync.RWMutex
 	lockDeleteMultisigSign              sync.RWMutex
+	lockDeleteScheduledRotation         sync.RWMutex
 	lockDeleteSnapshotCounterForKeyID   sync.RWMutex
+	lockDeleteTrafficHashes             sync.RWMutex
 	lockDoesValidatorParticipateInSign  sync.RWMutex
 	lockGetAvailableOperators           sync.RWMutex
+	lockGetAverageSignDuration          sync.RWMutex
+	lockGetCompletedReshareInfo         sync.RWMutex
 	lockGetCurrentKey                   sync.RWMutex
 	lockGetCurrentKeyID                 sync.RWMutex
 	lockGetExternalKeyIDs               sync.RWMutex
@@ -2036,8 +2635,11 @@ type TSSKeeperMock struct {
 	lockGetInfoForSig                   sync.RWMutex
 	lockGetKey                          sync.RWMutex
 	lockGetKeyRequirement               sync.RWMutex
+	lockGetKeyRotationPolicies          sync.RWMutex
+	lockGetKeyState                     sync.RWMutex
 	lockGetKeyType                      sync.RWMutex
 	lockGetMaxSimultaneousSignShares    sync.RWMutex
+	lockGetMisbehaviourHistory          sync.RWMutex
 	lockGetMultisigKeygenInfo           sync.RWMutex
 	lockGetMultisigPubKeysByValidator   sync.RWMutex
 	lockGetMultisigSignInfo             sync.RWMutex
@@ -2046,18 +2648,29 @@ type TSSKeeperMock struct {
 	lockGetOldActiveKeys                sync.RWMutex
 	lockGetParams                       sync.RWMutex
 	lockGetPrivateRecoveryInfo          sync.RWMutex
+	lockGetRecentOffenceCount           sync.RWMutex
+	lockGetReshareInfo                  sync.RWMutex
+	lockGetRotatedAtHeight              sync.RWMutex
 	lockGetRouter                       sync.RWMutex
+	lockGetScheduledRotation            sync.RWMutex
 	lockGetSig                          sync.RWMutex
 	lockGetSignParticipants             sync.RWMutex
 	lockGetSignParticipantsAsJSON       sync.RWMutex
 	lockGetSignParticipantsSharesAsJSON sync.RWMutex
+	lockGetSignQueue                    sync.RWMutex
+	lockGetSignQueueWeights             sync.RWMutex
+	lockGetSignStartHeight              sync.RWMutex
 	lockGetSnapshotCounterForKeyID      sync.RWMutex
 	lockGetSuspendedUntil               sync.RWMutex
+	lockGetTimedOutReshares             sync.RWMutex
 	lockHasKeygenStarted                sync.RWMutex
 	lockHasPrivateRecoveryInfo          sync.RWMutex
 	lockIsMultisigKeygenCompleted       sync.RWMutex
 	lockLogger                          sync.RWMutex
 	lockPenalizeCriminal                sync.RWMutex
+	lockRecordSignCompletion            sync.RWMutex
+	lockRecordTrafficHash               sync.RWMutex
+	lockRetrySign                       sync.RWMutex
 	lockRotateKey                       sync.RWMutex
 	lockSelectSignParticipants          sync.RWMutex
 	lockSetAvailableOperator            sync.RWMutex
@@ -2067,14 +2680,52 @@ type TSSKeeperMock struct {
 	lockSetKey                          sync.RWMutex
 	lockSetParams                       sync.RWMutex
 	lockSetPrivateRecoveryInfo          sync.RWMutex
+	lockSetScheduledRotation            sync.RWMutex
 	lockSetSig                          sync.RWMutex
 	lockSetSigStatus                    sync.RWMutex
+	lockSetSignStartHeight              sync.RWMutex
 	lockStartKeygen                     sync.RWMutex
+	lockStartReshare                    sync.RWMutex
 	lockStartSign                       sync.RWMutex
 	lockSubmitPubKeys                   sync.RWMutex
 	lockSubmitSignatures                sync.RWMutex
 }
 
+// AbortReshare calls AbortReshareFunc.
+func (mock *TSSKeeperMock) AbortReshare(ctx github_com_cosmos_cosmos_sdk_types.Context, sessionID string) error {
+	if mock.AbortReshareFunc == nil {
+		panic("TSSKeeperMock.AbortReshareFunc: method is nil but TSSKeeper.AbortReshare was just called")
+	}
+	callInfo := struct {
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		SessionID string
+	}{
+		Ctx:       ctx,
+		SessionID: sessionID,
+	}
+	mock.lockAbortReshare.Lock()
+	mock.calls.AbortReshare = append(mock.calls.AbortReshare, callInfo)
+	mock.lockAbortReshare.Unlock()
+	return mock.AbortReshareFunc(ctx, sessionID)
+}
+
+// AbortReshareCalls gets all the calls that were made to AbortReshare.
+// Check the length with:
+//     len(mockedTSSKeeper.AbortReshareCalls())
+func (mock *TSSKeeperMock) AbortReshareCalls() []struct {
+	Ctx       github_com_cosmos_cosmos_sdk_types.Context
+	SessionID string
+} {
+	var calls []struct {
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		SessionID string
+	}
+	mock.lockAbortReshare.RLock()
+	calls = mock.calls.AbortReshare
+	mock.lockAbortReshare.RUnlock()
+	return calls
+}
+
 // AssertMatchesRequirements calls AssertMatchesRequirementsFunc.
 func (mock *TSSKeeperMock) AssertMatchesRequirements(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter, chain nexus.Chain, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error {
 	if mock.AssertMatchesRequirementsFunc == nil {
@@ -2165,6 +2816,45 @@ func (mock *TSSKeeperMock) AssignNextKeyCalls() []struct {
 	return calls
 }
 
+// CompleteReshare calls CompleteReshareFunc.
+func (mock *TSSKeeperMock) CompleteReshare(ctx github_com_cosmos_cosmos_sdk_types.Context, sessionID string, groupRecoveryInfo []byte) error {
+	if mock.CompleteReshareFunc == nil {
+		panic("TSSKeeperMock.CompleteReshareFunc: method is nil but TSSKeeper.CompleteReshare was just called")
+	}
+	callInfo := struct {
+		Ctx               github_com_cosmos_cosmos_sdk_types.Context
+		SessionID         string
+		GroupRecoveryInfo []byte
+	}{
+		Ctx:               ctx,
+		SessionID:         sessionID,
+		GroupRecoveryInfo: groupRecoveryInfo,
+	}
+	mock.lockCompleteReshare.Lock()
+	mock.calls.CompleteReshare = append(mock.calls.CompleteReshare, callInfo)
+	mock.lockCompleteReshare.Unlock()
+	return mock.CompleteReshareFunc(ctx, sessionID, groupRecoveryInfo)
+}
+
+// CompleteReshareCalls gets all the calls that were made to CompleteReshare.
+// Check the length with:
+//     len(mockedTSSKeeper.CompleteReshareCalls())
+func (mock *TSSKeeperMock) CompleteReshareCalls() []struct {
+	Ctx               github_com_cosmos_cosmos_sdk_types.Context
+	SessionID         string
+	GroupRecoveryInfo []byte
+} {
+	var calls []struct {
+		Ctx               github_com_cosmos_cosmos_sdk_types.Context
+		SessionID         string
+		GroupRecoveryInfo []byte
+	}
+	mock.lockCompleteReshare.RLock()
+	calls = mock.calls.CompleteReshare
+	mock.lockCompleteReshare.RUnlock()
+	return calls
+}
+
 // DeleteInfoForSig calls DeleteInfoForSigFunc.
 func (mock *TSSKeeperMock) DeleteInfoForSig(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) {
 	if mock.DeleteInfoForSigFunc == nil {
@@ -2340,6 +3030,45 @@ func (mock *TSSKeeperMock) DeleteMultisigSignCalls() []struct {
 	return calls
 }
 
+// DeleteScheduledRotation calls DeleteScheduledRotationFunc.
+func (mock *TSSKeeperMock) DeleteScheduledRotation(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) {
+	if mock.DeleteScheduledRotationFunc == nil {
+		panic("TSSKeeperMock.DeleteScheduledRotationFunc: method is nil but TSSKeeper.DeleteScheduledRotation was just called")
+	}
+	callInfo := struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		Chain   nexus.Chain
+		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+	}{
+		Ctx:     ctx,
+		Chain:   chain,
+		KeyRole: keyRole,
+	}
+	mock.lockDeleteScheduledRotation.Lock()
+	mock.calls.DeleteScheduledRotation = append(mock.calls.DeleteScheduledRotation, callInfo)
+	mock.lockDeleteScheduledRotation.Unlock()
+	mock.DeleteScheduledRotationFunc(ctx, chain, keyRole)
+}
+
+// DeleteScheduledRotationCalls gets all the calls that were made to DeleteScheduledRotation.
+// Check the length with:
+//     len(mockedTSSKeeper.DeleteScheduledRotationCalls())
+func (mock *TSSKeeperMock) DeleteScheduledRotationCalls() []struct {
+	Ctx     github_com_cosmos_cosmos_sdk_types.Context
+	Chain   nexus.Chain
+	KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+} {
+	var calls []struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		Chain   nexus.Chain
+		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+	}
+	mock.lockDeleteScheduledRotation.RLock()
+	calls = mock.calls.DeleteScheduledRotation
+	mock.lockDeleteScheduledRotation.RUnlock()
+	return calls
+}
+
 // DeleteSnapshotCounterForKeyID calls DeleteSnapshotCounterForKeyIDFunc.
 func (mock *TSSKeeperMock) DeleteSnapshotCounterForKeyID(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) {
 	if mock.DeleteSnapshotCounterForKeyIDFunc == nil {
@@ -2375,6 +3104,41 @@ func (mock *TSSKeeperMock) DeleteSnapshotCounterForKeyIDCalls() []struct {
 	return calls
 }
 
+// DeleteTrafficHashes calls DeleteTrafficHashesFunc.
+func (mock *TSSKeeperMock) DeleteTrafficHashes(ctx github_com_cosmos_cosmos_sdk_types.Context, sessionID string) {
+	if mock.DeleteTrafficHashesFunc == nil {
+		panic("TSSKeeperMock.DeleteTrafficHashesFunc: method is nil but TSSKeeper.DeleteTrafficHashes was just called")
+	}
+	callInfo := struct {
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		SessionID string
+	}{
+		Ctx:       ctx,
+		SessionID: sessionID,
+	}
+	mock.lockDeleteTrafficHashes.Lock()
+	mock.calls.DeleteTrafficHashes = append(mock.calls.DeleteTrafficHashes, callInfo)
+	mock.lockDeleteTrafficHashes.Unlock()
+	mock.DeleteTrafficHashesFunc(ctx, sessionID)
+}
+
+// DeleteTrafficHashesCalls gets all the calls that were made to DeleteTrafficHashes.
+// Check the length with:
+//     len(mockedTSSKeeper.DeleteTrafficHashesCalls())
+func (mock *TSSKeeperMock) DeleteTrafficHashesCalls() []struct {
+	Ctx       github_com_cosmos_cosmos_sdk_types.Context
+	SessionID string
+} {
+	var calls []struct {
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		SessionID string
+	}
+	mock.lockDeleteTrafficHashes.RLock()
+	calls = mock.calls.DeleteTrafficHashes
+	mock.lockDeleteTrafficHashes.RUnlock()
+	return calls
+}
+
 // DoesValidatorParticipateInSign calls DoesValidatorParticipateInSignFunc.
 func (mock *TSSKeeperMock) DoesValidatorParticipateInSign(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string, validator github_com_cosmos_cosmos_sdk_types.ValAddress) bool {
 	if mock.DoesValidatorParticipateInSignFunc == nil {
@@ -2449,6 +3213,72 @@ func (mock *TSSKeeperMock) GetAvailableOperatorsCalls() []struct {
 	return calls
 }
 
+// GetAverageSignDuration calls GetAverageSignDurationFunc.
+func (mock *TSSKeeperMock) GetAverageSignDuration(ctx github_com_cosmos_cosmos_sdk_types.Context) (int64, bool) {
+	if mock.GetAverageSignDurationFunc == nil {
+		panic("TSSKeeperMock.GetAverageSignDurationFunc: method is nil but TSSKeeper.GetAverageSignDuration was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetAverageSignDuration.Lock()
+	mock.calls.GetAverageSignDuration = append(mock.calls.GetAverageSignDuration, callInfo)
+	mock.lockGetAverageSignDuration.Unlock()
+	return mock.GetAverageSignDurationFunc(ctx)
+}
+
+// GetAverageSignDurationCalls gets all the calls that were made to GetAverageSignDuration.
+// Check the length with:
+//     len(mockedTSSKeeper.GetAverageSignDurationCalls())
+func (mock *TSSKeeperMock) GetAverageSignDurationCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetAverageSignDuration.RLock()
+	calls = mock.calls.GetAverageSignDuration
+	mock.lockGetAverageSignDuration.RUnlock()
+	return calls
+}
+
+// GetCompletedReshareInfo calls GetCompletedReshareInfoFunc.
+func (mock *TSSKeeperMock) GetCompletedReshareInfo(ctx github_com_cosmos_cosmos_sdk_types.Context, sessionID string) (types.ReshareInfo, bool) {
+	if mock.GetCompletedReshareInfoFunc == nil {
+		panic("TSSKeeperMock.GetCompletedReshareInfoFunc: method is nil but TSSKeeper.GetCompletedReshareInfo was just called")
+	}
+	callInfo := struct {
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		SessionID string
+	}{
+		Ctx:       ctx,
+		SessionID: sessionID,
+	}
+	mock.lockGetCompletedReshareInfo.Lock()
+	mock.calls.GetCompletedReshareInfo = append(mock.calls.GetCompletedReshareInfo, callInfo)
+	mock.lockGetCompletedReshareInfo.Unlock()
+	return mock.GetCompletedReshareInfoFunc(ctx, sessionID)
+}
+
+// GetCompletedReshareInfoCalls gets all the calls that were made to GetCompletedReshareInfo.
+// Check the length with:
+//     len(mockedTSSKeeper.GetCompletedReshareInfoCalls())
+func (mock *TSSKeeperMock) GetCompletedReshareInfoCalls() []struct {
+	Ctx       github_com_cosmos_cosmos_sdk_types.Context
+	SessionID string
+} {
+	var calls []struct {
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		SessionID string
+	}
+	mock.lockGetCompletedReshareInfo.RLock()
+	calls = mock.calls.GetCompletedReshareInfo
+	mock.lockGetCompletedReshareInfo.RUnlock()
+	return calls
+}
+
 // GetCurrentKey calls GetCurrentKeyFunc.
 func (mock *TSSKeeperMock) GetCurrentKey(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
 	if mock.GetCurrentKeyFunc == nil {
@@ -2768,6 +3598,72 @@ func (mock *TSSKeeperMock) GetKeyRequirementCalls() []struct {
 	return calls
 }
 
+// GetKeyRotationPolicies calls GetKeyRotationPoliciesFunc.
+func (mock *TSSKeeperMock) GetKeyRotationPolicies(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.KeyRotationPolicy {
+	if mock.GetKeyRotationPoliciesFunc == nil {
+		panic("TSSKeeperMock.GetKeyRotationPoliciesFunc: method is nil but TSSKeeper.GetKeyRotationPolicies was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetKeyRotationPolicies.Lock()
+	mock.calls.GetKeyRotationPolicies = append(mock.calls.GetKeyRotationPolicies, callInfo)
+	mock.lockGetKeyRotationPolicies.Unlock()
+	return mock.GetKeyRotationPoliciesFunc(ctx)
+}
+
+// GetKeyRotationPoliciesCalls gets all the calls that were made to GetKeyRotationPolicies.
+// Check the length with:
+//     len(mockedTSSKeeper.GetKeyRotationPoliciesCalls())
+func (mock *TSSKeeperMock) GetKeyRotationPoliciesCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetKeyRotationPolicies.RLock()
+	calls = mock.calls.GetKeyRotationPolicies
+	mock.lockGetKeyRotationPolicies.RUnlock()
+	return calls
+}
+
+// GetKeyState calls GetKeyStateFunc.
+func (mock *TSSKeeperMock) GetKeyState(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) types.KeyState {
+	if mock.GetKeyStateFunc == nil {
+		panic("TSSKeeperMock.GetKeyStateFunc: method is nil but TSSKeeper.GetKeyState was just called")
+	}
+	callInfo := struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
+	}{
+		Ctx:   ctx,
+		KeyID: keyID,
+	}
+	mock.lockGetKeyState.Lock()
+	mock.calls.GetKeyState = append(mock.calls.GetKeyState, callInfo)
+	mock.lockGetKeyState.Unlock()
+	return mock.GetKeyStateFunc(ctx, keyID)
+}
+
+// GetKeyStateCalls gets all the calls that were made to GetKeyState.
+// Check the length with:
+//     len(mockedTSSKeeper.GetKeyStateCalls())
+func (mock *TSSKeeperMock) GetKeyStateCalls() []struct {
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
+	KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
+} {
+	var calls []struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
+	}
+	mock.lockGetKeyState.RLock()
+	calls = mock.calls.GetKeyState
+	mock.lockGetKeyState.RUnlock()
+	return calls
+}
+
 // GetKeyType calls GetKeyTypeFunc.
 func (mock *TSSKeeperMock) GetKeyType(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) github_com_axelarnetwork_axelar_core_x_tss_exported.KeyType {
 	if mock.GetKeyTypeFunc == nil {
@@ -2834,6 +3730,41 @@ func (mock *TSSKeeperMock) GetMaxSimultaneousSignSharesCalls() []struct {
 	return calls
 }
 
+// GetMisbehaviourHistory calls GetMisbehaviourHistoryFunc.
+func (mock *TSSKeeperMock) GetMisbehaviourHistory(ctx github_com_cosmos_cosmos_sdk_types.Context, validator github_com_cosmos_cosmos_sdk_types.ValAddress) []types.MisbehaviourEvidence {
+	if mock.GetMisbehaviourHistoryFunc == nil {
+		panic("TSSKeeperMock.GetMisbehaviourHistoryFunc: method is nil but TSSKeeper.GetMisbehaviourHistory was just called")
+	}
+	callInfo := struct {
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		Validator github_com_cosmos_cosmos_sdk_types.ValAddress
+	}{
+		Ctx:       ctx,
+		Validator: validator,
+	}
+	mock.lockGetMisbehaviourHistory.Lock()
+	mock.calls.GetMisbehaviourHistory = append(mock.calls.GetMisbehaviourHistory, callInfo)
+	mock.lockGetMisbehaviourHistory.Unlock()
+	return mock.GetMisbehaviourHistoryFunc(ctx, validator)
+}
+
+// GetMisbehaviourHistoryCalls gets all the calls that were made to GetMisbehaviourHistory.
+// Check the length with:
+//     len(mockedTSSKeeper.GetMisbehaviourHistoryCalls())
+func (mock *TSSKeeperMock) GetMisbehaviourHistoryCalls() []struct {
+	Ctx       github_com_cosmos_cosmos_sdk_types.Context
+	Validator github_com_cosmos_cosmos_sdk_types.ValAddress
+} {
+	var calls []struct {
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		Validator github_com_cosmos_cosmos_sdk_types.ValAddress
+	}
+	mock.lockGetMisbehaviourHistory.RLock()
+	calls = mock.calls.GetMisbehaviourHistory
+	mock.lockGetMisbehaviourHistory.RUnlock()
+	return calls
+}
+
 // GetMultisigKeygenInfo calls GetMultisigKeygenInfoFunc.
 func (mock *TSSKeeperMock) GetMultisigKeygenInfo(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (types.MultisigKeygenInfo, bool) {
 	if mock.GetMultisigKeygenInfoFunc == nil {
@@ -3130,56 +4061,204 @@ func (mock *TSSKeeperMock) GetPrivateRecoveryInfoCalls() []struct {
 	return calls
 }
 
-// GetRouter calls GetRouterFunc.
-func (mock *TSSKeeperMock) GetRouter() types.Router {
-	if mock.GetRouterFunc == nil {
-		panic("TSSKeeperMock.GetRouterFunc: method is nil but TSSKeeper.GetRouter was just called")
+// GetRecentOffenceCount calls GetRecentOffenceCountFunc.
+func (mock *TSSKeeperMock) GetRecentOffenceCount(ctx github_com_cosmos_cosmos_sdk_types.Context, validator github_com_cosmos_cosmos_sdk_types.ValAddress) int64 {
+	if mock.GetRecentOffenceCountFunc == nil {
+		panic("TSSKeeperMock.GetRecentOffenceCountFunc: method is nil but TSSKeeper.GetRecentOffenceCount was just called")
 	}
 	callInfo := struct {
-	}{}
-	mock.lockGetRouter.Lock()
-	mock.calls.GetRouter = append(mock.calls.GetRouter, callInfo)
-	mock.lockGetRouter.Unlock()
-	return mock.GetRouterFunc()
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		Validator github_com_cosmos_cosmos_sdk_types.ValAddress
+	}{
+		Ctx:       ctx,
+		Validator: validator,
+	}
+	mock.lockGetRecentOffenceCount.Lock()
+	mock.calls.GetRecentOffenceCount = append(mock.calls.GetRecentOffenceCount, callInfo)
+	mock.lockGetRecentOffenceCount.Unlock()
+	return mock.GetRecentOffenceCountFunc(ctx, validator)
 }
 
-// GetRouterCalls gets all the calls that were made to GetRouter.
+// GetRecentOffenceCountCalls gets all the calls that were made to GetRecentOffenceCount.
 // Check the length with:
-//     len(mockedTSSKeeper.GetRouterCalls())
-func (mock *TSSKeeperMock) GetRouterCalls() []struct {
+//     len(mockedTSSKeeper.GetRecentOffenceCountCalls())
+func (mock *TSSKeeperMock) GetRecentOffenceCountCalls() []struct {
+	Ctx       github_com_cosmos_cosmos_sdk_types.Context
+	Validator github_com_cosmos_cosmos_sdk_types.ValAddress
 } {
 	var calls []struct {
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		Validator github_com_cosmos_cosmos_sdk_types.ValAddress
 	}
-	mock.lockGetRouter.RLock()
-	calls = mock.calls.GetRouter
-	mock.lockGetRouter.RUnlock()
+	mock.lockGetRecentOffenceCount.RLock()
+	calls = mock.calls.GetRecentOffenceCount
+	mock.lockGetRecentOffenceCount.RUnlock()
 	return calls
 }
 
-// GetSig calls GetSigFunc.
-func (mock *TSSKeeperMock) GetSig(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) (github_com_axelarnetwork_axelar_core_x_tss_exported.Signature, github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus) {
-	if mock.GetSigFunc == nil {
-		panic("TSSKeeperMock.GetSigFunc: method is nil but TSSKeeper.GetSig was just called")
+// GetReshareInfo calls GetReshareInfoFunc.
+func (mock *TSSKeeperMock) GetReshareInfo(ctx github_com_cosmos_cosmos_sdk_types.Context, sessionID string) (types.ReshareInfo, bool) {
+	if mock.GetReshareInfoFunc == nil {
+		panic("TSSKeeperMock.GetReshareInfoFunc: method is nil but TSSKeeper.GetReshareInfo was just called")
 	}
 	callInfo := struct {
-		Ctx   github_com_cosmos_cosmos_sdk_types.Context
-		SigID string
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		SessionID string
 	}{
-		Ctx:   ctx,
-		SigID: sigID,
+		Ctx:       ctx,
+		SessionID: sessionID,
 	}
-	mock.lockGetSig.Lock()
-	mock.calls.GetSig = append(mock.calls.GetSig, callInfo)
-	mock.lockGetSig.Unlock()
-	return mock.GetSigFunc(ctx, sigID)
+	mock.lockGetReshareInfo.Lock()
+	mock.calls.GetReshareInfo = append(mock.calls.GetReshareInfo, callInfo)
+	mock.lockGetReshareInfo.Unlock()
+	return mock.GetReshareInfoFunc(ctx, sessionID)
 }
 
-// GetSigCalls gets all the calls that were made to GetSig.
+// GetReshareInfoCalls gets all the calls that were made to GetReshareInfo.
 // Check the length with:
-//     len(mockedTSSKeeper.GetSigCalls())
-func (mock *TSSKeeperMock) GetSigCalls() []struct {
-	Ctx   github_com_cosmos_cosmos_sdk_types.Context
-	SigID string
+//     len(mockedTSSKeeper.GetReshareInfoCalls())
+func (mock *TSSKeeperMock) GetReshareInfoCalls() []struct {
+	Ctx       github_com_cosmos_cosmos_sdk_types.Context
+	SessionID string
+} {
+	var calls []struct {
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		SessionID string
+	}
+	mock.lockGetReshareInfo.RLock()
+	calls = mock.calls.GetReshareInfo
+	mock.lockGetReshareInfo.RUnlock()
+	return calls
+}
+
+// GetRotatedAtHeight calls GetRotatedAtHeightFunc.
+func (mock *TSSKeeperMock) GetRotatedAtHeight(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (int64, bool) {
+	if mock.GetRotatedAtHeightFunc == nil {
+		panic("TSSKeeperMock.GetRotatedAtHeightFunc: method is nil but TSSKeeper.GetRotatedAtHeight was just called")
+	}
+	callInfo := struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		Chain   nexus.Chain
+		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+	}{
+		Ctx:     ctx,
+		Chain:   chain,
+		KeyRole: keyRole,
+	}
+	mock.lockGetRotatedAtHeight.Lock()
+	mock.calls.GetRotatedAtHeight = append(mock.calls.GetRotatedAtHeight, callInfo)
+	mock.lockGetRotatedAtHeight.Unlock()
+	return mock.GetRotatedAtHeightFunc(ctx, chain, keyRole)
+}
+
+// GetRotatedAtHeightCalls gets all the calls that were made to GetRotatedAtHeight.
+// Check the length with:
+//     len(mockedTSSKeeper.GetRotatedAtHeightCalls())
+func (mock *TSSKeeperMock) GetRotatedAtHeightCalls() []struct {
+	Ctx     github_com_cosmos_cosmos_sdk_types.Context
+	Chain   nexus.Chain
+	KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+} {
+	var calls []struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		Chain   nexus.Chain
+		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+	}
+	mock.lockGetRotatedAtHeight.RLock()
+	calls = mock.calls.GetRotatedAtHeight
+	mock.lockGetRotatedAtHeight.RUnlock()
+	return calls
+}
+
+// GetRouter calls GetRouterFunc.
+func (mock *TSSKeeperMock) GetRouter() types.Router {
+	if mock.GetRouterFunc == nil {
+		panic("TSSKeeperMock.GetRouterFunc: method is nil but TSSKeeper.GetRouter was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetRouter.Lock()
+	mock.calls.GetRouter = append(mock.calls.GetRouter, callInfo)
+	mock.lockGetRouter.Unlock()
+	return mock.GetRouterFunc()
+}
+
+// GetRouterCalls gets all the calls that were made to GetRouter.
+// Check the length with:
+//     len(mockedTSSKeeper.GetRouterCalls())
+func (mock *TSSKeeperMock) GetRouterCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetRouter.RLock()
+	calls = mock.calls.GetRouter
+	mock.lockGetRouter.RUnlock()
+	return calls
+}
+
+// GetScheduledRotation calls GetScheduledRotationFunc.
+func (mock *TSSKeeperMock) GetScheduledRotation(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (types.ScheduledRotation, bool) {
+	if mock.GetScheduledRotationFunc == nil {
+		panic("TSSKeeperMock.GetScheduledRotationFunc: method is nil but TSSKeeper.GetScheduledRotation was just called")
+	}
+	callInfo := struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		Chain   nexus.Chain
+		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+	}{
+		Ctx:     ctx,
+		Chain:   chain,
+		KeyRole: keyRole,
+	}
+	mock.lockGetScheduledRotation.Lock()
+	mock.calls.GetScheduledRotation = append(mock.calls.GetScheduledRotation, callInfo)
+	mock.lockGetScheduledRotation.Unlock()
+	return mock.GetScheduledRotationFunc(ctx, chain, keyRole)
+}
+
+// GetScheduledRotationCalls gets all the calls that were made to GetScheduledRotation.
+// Check the length with:
+//     len(mockedTSSKeeper.GetScheduledRotationCalls())
+func (mock *TSSKeeperMock) GetScheduledRotationCalls() []struct {
+	Ctx     github_com_cosmos_cosmos_sdk_types.Context
+	Chain   nexus.Chain
+	KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+} {
+	var calls []struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		Chain   nexus.Chain
+		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+	}
+	mock.lockGetScheduledRotation.RLock()
+	calls = mock.calls.GetScheduledRotation
+	mock.lockGetScheduledRotation.RUnlock()
+	return calls
+}
+
+// GetSig calls GetSigFunc.
+func (mock *TSSKeeperMock) GetSig(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) (github_com_axelarnetwork_axelar_core_x_tss_exported.Signature, github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus) {
+	if mock.GetSigFunc == nil {
+		panic("TSSKeeperMock.GetSigFunc: method is nil but TSSKeeper.GetSig was just called")
+	}
+	callInfo := struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		SigID string
+	}{
+		Ctx:   ctx,
+		SigID: sigID,
+	}
+	mock.lockGetSig.Lock()
+	mock.calls.GetSig = append(mock.calls.GetSig, callInfo)
+	mock.lockGetSig.Unlock()
+	return mock.GetSigFunc(ctx, sigID)
+}
+
+// GetSigCalls gets all the calls that were made to GetSig.
+// Check the length with:
+//     len(mockedTSSKeeper.GetSigCalls())
+func (mock *TSSKeeperMock) GetSigCalls() []struct {
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
+	SigID string
 } {
 	var calls []struct {
 		Ctx   github_com_cosmos_cosmos_sdk_types.Context
@@ -3296,6 +4375,103 @@ func (mock *TSSKeeperMock) GetSignParticipantsSharesAsJSONCalls() []struct {
 	return calls
 }
 
+// GetSignQueue calls GetSignQueueFunc.
+func (mock *TSSKeeperMock) GetSignQueue(ctx github_com_cosmos_cosmos_sdk_types.Context) utils.SequenceKVQueue {
+	if mock.GetSignQueueFunc == nil {
+		panic("TSSKeeperMock.GetSignQueueFunc: method is nil but TSSKeeper.GetSignQueue was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetSignQueue.Lock()
+	mock.calls.GetSignQueue = append(mock.calls.GetSignQueue, callInfo)
+	mock.lockGetSignQueue.Unlock()
+	return mock.GetSignQueueFunc(ctx)
+}
+
+// GetSignQueueCalls gets all the calls that were made to GetSignQueue.
+// Check the length with:
+//     len(mockedTSSKeeper.GetSignQueueCalls())
+func (mock *TSSKeeperMock) GetSignQueueCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetSignQueue.RLock()
+	calls = mock.calls.GetSignQueue
+	mock.lockGetSignQueue.RUnlock()
+	return calls
+}
+
+// GetSignQueueWeights calls GetSignQueueWeightsFunc.
+func (mock *TSSKeeperMock) GetSignQueueWeights(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.SignQueueWeight {
+	if mock.GetSignQueueWeightsFunc == nil {
+		panic("TSSKeeperMock.GetSignQueueWeightsFunc: method is nil but TSSKeeper.GetSignQueueWeights was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetSignQueueWeights.Lock()
+	mock.calls.GetSignQueueWeights = append(mock.calls.GetSignQueueWeights, callInfo)
+	mock.lockGetSignQueueWeights.Unlock()
+	return mock.GetSignQueueWeightsFunc(ctx)
+}
+
+// GetSignQueueWeightsCalls gets all the calls that were made to GetSignQueueWeights.
+// Check the length with:
+//     len(mockedTSSKeeper.GetSignQueueWeightsCalls())
+func (mock *TSSKeeperMock) GetSignQueueWeightsCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetSignQueueWeights.RLock()
+	calls = mock.calls.GetSignQueueWeights
+	mock.lockGetSignQueueWeights.RUnlock()
+	return calls
+}
+
+// GetSignStartHeight calls GetSignStartHeightFunc.
+func (mock *TSSKeeperMock) GetSignStartHeight(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) (int64, bool) {
+	if mock.GetSignStartHeightFunc == nil {
+		panic("TSSKeeperMock.GetSignStartHeightFunc: method is nil but TSSKeeper.GetSignStartHeight was just called")
+	}
+	callInfo := struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		SigID string
+	}{
+		Ctx:   ctx,
+		SigID: sigID,
+	}
+	mock.lockGetSignStartHeight.Lock()
+	mock.calls.GetSignStartHeight = append(mock.calls.GetSignStartHeight, callInfo)
+	mock.lockGetSignStartHeight.Unlock()
+	return mock.GetSignStartHeightFunc(ctx, sigID)
+}
+
+// GetSignStartHeightCalls gets all the calls that were made to GetSignStartHeight.
+// Check the length with:
+//     len(mockedTSSKeeper.GetSignStartHeightCalls())
+func (mock *TSSKeeperMock) GetSignStartHeightCalls() []struct {
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
+	SigID string
+} {
+	var calls []struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		SigID string
+	}
+	mock.lockGetSignStartHeight.RLock()
+	calls = mock.calls.GetSignStartHeight
+	mock.lockGetSignStartHeight.RUnlock()
+	return calls
+}
+
 // GetSnapshotCounterForKeyID calls GetSnapshotCounterForKeyIDFunc.
 func (mock *TSSKeeperMock) GetSnapshotCounterForKeyID(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (int64, bool) {
 	if mock.GetSnapshotCounterForKeyIDFunc == nil {
@@ -3366,6 +4542,37 @@ func (mock *TSSKeeperMock) GetSuspendedUntilCalls() []struct {
 	return calls
 }
 
+// GetTimedOutReshares calls GetTimedOutResharesFunc.
+func (mock *TSSKeeperMock) GetTimedOutReshares(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.ReshareInfo {
+	if mock.GetTimedOutResharesFunc == nil {
+		panic("TSSKeeperMock.GetTimedOutResharesFunc: method is nil but TSSKeeper.GetTimedOutReshares was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetTimedOutReshares.Lock()
+	mock.calls.GetTimedOutReshares = append(mock.calls.GetTimedOutReshares, callInfo)
+	mock.lockGetTimedOutReshares.Unlock()
+	return mock.GetTimedOutResharesFunc(ctx)
+}
+
+// GetTimedOutResharesCalls gets all the calls that were made to GetTimedOutReshares.
+// Check the length with:
+//     len(mockedTSSKeeper.GetTimedOutResharesCalls())
+func (mock *TSSKeeperMock) GetTimedOutResharesCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetTimedOutReshares.RLock()
+	calls = mock.calls.GetTimedOutReshares
+	mock.lockGetTimedOutReshares.RUnlock()
+	return calls
+}
+
 // HasKeygenStarted calls HasKeygenStartedFunc.
 func (mock *TSSKeeperMock) HasKeygenStarted(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) bool {
 	if mock.HasKeygenStartedFunc == nil {
@@ -3507,23 +4714,29 @@ func (mock *TSSKeeperMock) LoggerCalls() []struct {
 }
 
 // PenalizeCriminal calls PenalizeCriminalFunc.
-func (mock *TSSKeeperMock) PenalizeCriminal(ctx github_com_cosmos_cosmos_sdk_types.Context, criminal github_com_cosmos_cosmos_sdk_types.ValAddress, crimeType tofnd.MessageOut_CriminalList_Criminal_CrimeType) {
+func (mock *TSSKeeperMock) PenalizeCriminal(ctx github_com_cosmos_cosmos_sdk_types.Context, sessionID string, attempt int64, criminal github_com_cosmos_cosmos_sdk_types.ValAddress, crimeType tofnd.MessageOut_CriminalList_Criminal_CrimeType, accusers []github_com_cosmos_cosmos_sdk_types.ValAddress) {
 	if mock.PenalizeCriminalFunc == nil {
 		panic("TSSKeeperMock.PenalizeCriminalFunc: method is nil but TSSKeeper.PenalizeCriminal was just called")
 	}
 	callInfo := struct {
 		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		SessionID string
+		Attempt   int64
 		Criminal  github_com_cosmos_cosmos_sdk_types.ValAddress
 		CrimeType tofnd.MessageOut_CriminalList_Criminal_CrimeType
+		Accusers  []github_com_cosmos_cosmos_sdk_types.ValAddress
 	}{
 		Ctx:       ctx,
+		SessionID: sessionID,
+		Attempt:   attempt,
 		Criminal:  criminal,
 		CrimeType: crimeType,
+		Accusers:  accusers,
 	}
 	mock.lockPenalizeCriminal.Lock()
 	mock.calls.PenalizeCriminal = append(mock.calls.PenalizeCriminal, callInfo)
 	mock.lockPenalizeCriminal.Unlock()
-	mock.PenalizeCriminalFunc(ctx, criminal, crimeType)
+	mock.PenalizeCriminalFunc(ctx, sessionID, attempt, criminal, crimeType, accusers)
 }
 
 // PenalizeCriminalCalls gets all the calls that were made to PenalizeCriminal.
@@ -3531,13 +4744,19 @@ func (mock *TSSKeeperMock) PenalizeCriminal(ctx github_com_cosmos_cosmos_sdk_typ
 //     len(mockedTSSKeeper.PenalizeCriminalCalls())
 func (mock *TSSKeeperMock) PenalizeCriminalCalls() []struct {
 	Ctx       github_com_cosmos_cosmos_sdk_types.Context
+	SessionID string
+	Attempt   int64
 	Criminal  github_com_cosmos_cosmos_sdk_types.ValAddress
 	CrimeType tofnd.MessageOut_CriminalList_Criminal_CrimeType
+	Accusers  []github_com_cosmos_cosmos_sdk_types.ValAddress
 } {
 	var calls []struct {
 		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		SessionID string
+		Attempt   int64
 		Criminal  github_com_cosmos_cosmos_sdk_types.ValAddress
 		CrimeType tofnd.MessageOut_CriminalList_Criminal_CrimeType
+		Accusers  []github_com_cosmos_cosmos_sdk_types.ValAddress
 	}
 	mock.lockPenalizeCriminal.RLock()
 	calls = mock.calls.PenalizeCriminal
@@ -3545,6 +4764,143 @@ func (mock *TSSKeeperMock) PenalizeCriminalCalls() []struct {
 	return calls
 }
 
+// RecordSignCompletion calls RecordSignCompletionFunc.
+func (mock *TSSKeeperMock) RecordSignCompletion(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string, status github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus) {
+	if mock.RecordSignCompletionFunc == nil {
+		panic("TSSKeeperMock.RecordSignCompletionFunc: method is nil but TSSKeeper.RecordSignCompletion was just called")
+	}
+	callInfo := struct {
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
+		SigID  string
+		Status github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus
+	}{
+		Ctx:    ctx,
+		SigID:  sigID,
+		Status: status,
+	}
+	mock.lockRecordSignCompletion.Lock()
+	mock.calls.RecordSignCompletion = append(mock.calls.RecordSignCompletion, callInfo)
+	mock.lockRecordSignCompletion.Unlock()
+	mock.RecordSignCompletionFunc(ctx, sigID, status)
+}
+
+// RecordSignCompletionCalls gets all the calls that were made to RecordSignCompletion.
+// Check the length with:
+//     len(mockedTSSKeeper.RecordSignCompletionCalls())
+func (mock *TSSKeeperMock) RecordSignCompletionCalls() []struct {
+	Ctx    github_com_cosmos_cosmos_sdk_types.Context
+	SigID  string
+	Status github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus
+} {
+	var calls []struct {
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
+		SigID  string
+		Status github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus
+	}
+	mock.lockRecordSignCompletion.RLock()
+	calls = mock.calls.RecordSignCompletion
+	mock.lockRecordSignCompletion.RUnlock()
+	return calls
+}
+
+// RecordTrafficHash calls RecordTrafficHashFunc.
+func (mock *TSSKeeperMock) RecordTrafficHash(ctx github_com_cosmos_cosmos_sdk_types.Context, sessionID string, sender github_com_cosmos_cosmos_sdk_types.ValAddress, payload *tofnd.TrafficOut) {
+	if mock.RecordTrafficHashFunc == nil {
+		panic("TSSKeeperMock.RecordTrafficHashFunc: method is nil but TSSKeeper.RecordTrafficHash was just called")
+	}
+	callInfo := struct {
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		SessionID string
+		Sender    github_com_cosmos_cosmos_sdk_types.ValAddress
+		Payload   *tofnd.TrafficOut
+	}{
+		Ctx:       ctx,
+		SessionID: sessionID,
+		Sender:    sender,
+		Payload:   payload,
+	}
+	mock.lockRecordTrafficHash.Lock()
+	mock.calls.RecordTrafficHash = append(mock.calls.RecordTrafficHash, callInfo)
+	mock.lockRecordTrafficHash.Unlock()
+	mock.RecordTrafficHashFunc(ctx, sessionID, sender, payload)
+}
+
+// RecordTrafficHashCalls gets all the calls that were made to RecordTrafficHash.
+// Check the length with:
+//     len(mockedTSSKeeper.RecordTrafficHashCalls())
+func (mock *TSSKeeperMock) RecordTrafficHashCalls() []struct {
+	Ctx       github_com_cosmos_cosmos_sdk_types.Context
+	SessionID string
+	Sender    github_com_cosmos_cosmos_sdk_types.ValAddress
+	Payload   *tofnd.TrafficOut
+} {
+	var calls []struct {
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		SessionID string
+		Sender    github_com_cosmos_cosmos_sdk_types.ValAddress
+		Payload   *tofnd.TrafficOut
+	}
+	mock.lockRecordTrafficHash.RLock()
+	calls = mock.calls.RecordTrafficHash
+	mock.lockRecordTrafficHash.RUnlock()
+	return calls
+}
+
+// RetrySign calls RetrySignFunc.
+func (mock *TSSKeeperMock) RetrySign(ctx github_com_cosmos_cosmos_sdk_types.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, absentees []github_com_cosmos_cosmos_sdk_types.ValAddress, snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter, voter interface {
+	InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, snapshotSeqNo int64, pollProperties ...exported1.PollProperty) error
+}) error {
+	if mock.RetrySignFunc == nil {
+		panic("TSSKeeperMock.RetrySignFunc: method is nil but TSSKeeper.RetrySign was just called")
+	}
+	callInfo := struct {
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Info        github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
+		Absentees   []github_com_cosmos_cosmos_sdk_types.ValAddress
+		Snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter
+		Voter       interface {
+			InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, snapshotSeqNo int64, pollProperties ...exported1.PollProperty) error
+		}
+	}{
+		Ctx:         ctx,
+		Info:        info,
+		Absentees:   absentees,
+		Snapshotter: snapshotter,
+		Voter:       voter,
+	}
+	mock.lockRetrySign.Lock()
+	mock.calls.RetrySign = append(mock.calls.RetrySign, callInfo)
+	mock.lockRetrySign.Unlock()
+	return mock.RetrySignFunc(ctx, info, absentees, snapshotter, voter)
+}
+
+// RetrySignCalls gets all the calls that were made to RetrySign.
+// Check the length with:
+//     len(mockedTSSKeeper.RetrySignCalls())
+func (mock *TSSKeeperMock) RetrySignCalls() []struct {
+	Ctx         github_com_cosmos_cosmos_sdk_types.Context
+	Info        github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
+	Absentees   []github_com_cosmos_cosmos_sdk_types.ValAddress
+	Snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter
+	Voter       interface {
+		InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, snapshotSeqNo int64, pollProperties ...exported1.PollProperty) error
+	}
+} {
+	var calls []struct {
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Info        github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
+		Absentees   []github_com_cosmos_cosmos_sdk_types.ValAddress
+		Snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter
+		Voter       interface {
+			InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, snapshotSeqNo int64, pollProperties ...exported1.PollProperty) error
+		}
+	}
+	mock.lockRetrySign.RLock()
+	calls = mock.calls.RetrySign
+	mock.lockRetrySign.RUnlock()
+	return calls
+}
+
 // RotateKey calls RotateKeyFunc.
 func (mock *TSSKeeperMock) RotateKey(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error {
 	if mock.RotateKeyFunc == nil {
@@ -3900,6 +5256,41 @@ func (mock *TSSKeeperMock) SetPrivateRecoveryInfoCalls() []struct {
 	return calls
 }
 
+// SetScheduledRotation calls SetScheduledRotationFunc.
+func (mock *TSSKeeperMock) SetScheduledRotation(ctx github_com_cosmos_cosmos_sdk_types.Context, rotation types.ScheduledRotation) {
+	if mock.SetScheduledRotationFunc == nil {
+		panic("TSSKeeperMock.SetScheduledRotationFunc: method is nil but TSSKeeper.SetScheduledRotation was just called")
+	}
+	callInfo := struct {
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
+		Rotation types.ScheduledRotation
+	}{
+		Ctx:      ctx,
+		Rotation: rotation,
+	}
+	mock.lockSetScheduledRotation.Lock()
+	mock.calls.SetScheduledRotation = append(mock.calls.SetScheduledRotation, callInfo)
+	mock.lockSetScheduledRotation.Unlock()
+	mock.SetScheduledRotationFunc(ctx, rotation)
+}
+
+// SetScheduledRotationCalls gets all the calls that were made to SetScheduledRotation.
+// Check the length with:
+//     len(mockedTSSKeeper.SetScheduledRotationCalls())
+func (mock *TSSKeeperMock) SetScheduledRotationCalls() []struct {
+	Ctx      github_com_cosmos_cosmos_sdk_types.Context
+	Rotation types.ScheduledRotation
+} {
+	var calls []struct {
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
+		Rotation types.ScheduledRotation
+	}
+	mock.lockSetScheduledRotation.RLock()
+	calls = mock.calls.SetScheduledRotation
+	mock.lockSetScheduledRotation.RUnlock()
+	return calls
+}
+
 // SetSig calls SetSigFunc.
 func (mock *TSSKeeperMock) SetSig(ctx github_com_cosmos_cosmos_sdk_types.Context, signature github_com_axelarnetwork_axelar_core_x_tss_exported.Signature) {
 	if mock.SetSigFunc == nil {
@@ -3974,6 +5365,41 @@ func (mock *TSSKeeperMock) SetSigStatusCalls() []struct {
 	return calls
 }
 
+// SetSignStartHeight calls SetSignStartHeightFunc.
+func (mock *TSSKeeperMock) SetSignStartHeight(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) {
+	if mock.SetSignStartHeightFunc == nil {
+		panic("TSSKeeperMock.SetSignStartHeightFunc: method is nil but TSSKeeper.SetSignStartHeight was just called")
+	}
+	callInfo := struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		SigID string
+	}{
+		Ctx:   ctx,
+		SigID: sigID,
+	}
+	mock.lockSetSignStartHeight.Lock()
+	mock.calls.SetSignStartHeight = append(mock.calls.SetSignStartHeight, callInfo)
+	mock.lockSetSignStartHeight.Unlock()
+	mock.SetSignStartHeightFunc(ctx, sigID)
+}
+
+// SetSignStartHeightCalls gets all the calls that were made to SetSignStartHeight.
+// Check the length with:
+//     len(mockedTSSKeeper.SetSignStartHeightCalls())
+func (mock *TSSKeeperMock) SetSignStartHeightCalls() []struct {
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
+	SigID string
+} {
+	var calls []struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		SigID string
+	}
+	mock.lockSetSignStartHeight.RLock()
+	calls = mock.calls.SetSignStartHeight
+	mock.lockSetSignStartHeight.RUnlock()
+	return calls
+}
+
 // StartKeygen calls StartKeygenFunc.
 func (mock *TSSKeeperMock) StartKeygen(ctx github_com_cosmos_cosmos_sdk_types.Context, voter types.Voter, keyInfo types.KeyInfo, snapshot github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot) error {
 	if mock.StartKeygenFunc == nil {
@@ -4017,6 +5443,49 @@ func (mock *TSSKeeperMock) StartKeygenCalls() []struct {
 	return calls
 }
 
+// StartReshare calls StartReshareFunc.
+func (mock *TSSKeeperMock) StartReshare(ctx github_com_cosmos_cosmos_sdk_types.Context, voter types.Voter, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, snapshot github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot) (types.ReshareInfo, error) {
+	if mock.StartReshareFunc == nil {
+		panic("TSSKeeperMock.StartReshareFunc: method is nil but TSSKeeper.StartReshare was just called")
+	}
+	callInfo := struct {
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
+		Voter    types.Voter
+		KeyID    github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
+		Snapshot github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot
+	}{
+		Ctx:      ctx,
+		Voter:    voter,
+		KeyID:    keyID,
+		Snapshot: snapshot,
+	}
+	mock.lockStartReshare.Lock()
+	mock.calls.StartReshare = append(mock.calls.StartReshare, callInfo)
+	mock.lockStartReshare.Unlock()
+	return mock.StartReshareFunc(ctx, voter, keyID, snapshot)
+}
+
+// StartReshareCalls gets all the calls that were made to StartReshare.
+// Check the length with:
+//     len(mockedTSSKeeper.StartReshareCalls())
+func (mock *TSSKeeperMock) StartReshareCalls() []struct {
+	Ctx      github_com_cosmos_cosmos_sdk_types.Context
+	Voter    types.Voter
+	KeyID    github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
+	Snapshot github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot
+} {
+	var calls []struct {
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
+		Voter    types.Voter
+		KeyID    github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
+		Snapshot github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot
+	}
+	mock.lockStartReshare.RLock()
+	calls = mock.calls.StartReshare
+	mock.lockStartReshare.RUnlock()
+	return calls
+}
+
 // StartSign calls StartSignFunc.
 func (mock *TSSKeeperMock) StartSign(ctx github_com_cosmos_cosmos_sdk_types.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter, voter interface {
 	InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, snapshotSeqNo int64, pollProperties ...exported1.PollProperty) error
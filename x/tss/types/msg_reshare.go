@@ -0,0 +1,117 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+)
+
+// NewStartReshareRequest constructor for StartReshareRequest
+func NewStartReshareRequest(sender sdk.AccAddress, keyID string) *StartReshareRequest {
+	return &StartReshareRequest{
+		Sender: sender,
+		KeyID:  exported.KeyID(keyID),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (m StartReshareRequest) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+// naming convention follows x/staking/types/msgs.go
+func (m StartReshareRequest) Type() string { return "ReshareStart" }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m StartReshareRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+
+	if err := m.KeyID.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m StartReshareRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements sdk.Msg
+func (m StartReshareRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// Route implements the sdk.Msg interface.
+func (m ProcessReshareTrafficRequest) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+// naming convention follows x/staking/types/msgs.go
+func (m ProcessReshareTrafficRequest) Type() string { return "ReshareTraffic" }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m ProcessReshareTrafficRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+	if m.SessionID == "" {
+		return sdkerrors.Wrap(ErrTss, "session id must be set")
+	}
+	if m.Payload == nil {
+		return sdkerrors.Wrap(ErrTss, "payload must be set")
+	}
+	if !m.Payload.IsBroadcast && len(m.Payload.ToPartyUid) == 0 {
+		return sdkerrors.Wrap(ErrTss, "non-broadcast message must specify recipient")
+	}
+	if m.Payload.IsBroadcast && len(m.Payload.ToPartyUid) != 0 {
+		return sdkerrors.Wrap(ErrTss, "broadcast message must not specify recipient")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (m ProcessReshareTrafficRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements the sdk.Msg interface
+func (m ProcessReshareTrafficRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// Route returns the route for this message
+func (m VoteReshareRequest) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of this message
+func (m VoteReshareRequest) Type() string {
+	return "VoteReshare"
+}
+
+// ValidateBasic performs a stateless validation of this message
+func (m VoteReshareRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+
+	if err := m.Result.Validate(); err != nil {
+		return err
+	}
+
+	return m.PollKey.Validate()
+}
+
+// GetSignBytes returns the bytes to sign for this message
+func (m VoteReshareRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the set of signers for this message
+func (m VoteReshareRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	params "github.com/cosmos/cosmos-sdk/x/params/types"
 
@@ -24,6 +26,13 @@ var (
 	KeyExternalMultisigThreshold        = []byte("externalMultisigThreshold")
 	KeyMaxSignQueueSize                 = []byte("MaxSignQueueSize")
 	MaxSimultaneousSignShares           = []byte("MaxSimultaneousSignShares")
+	KeyKeyRotationPolicies              = []byte("KeyRotationPolicies")
+	KeyMaxSignAttempts                  = []byte("MaxSignAttempts")
+	KeySignFailureCooldownInBlocks      = []byte("SignFailureCooldownInBlocks")
+	KeySignQueueWeights                 = []byte("SignQueueWeights")
+	KeyMisbehaviourWindowInBlocks       = []byte("MisbehaviourWindowInBlocks")
+	KeyMisbehaviourJailThreshold        = []byte("MisbehaviourJailThreshold")
+	KeyMisbehaviourJailDuration         = []byte("MisbehaviourJailDuration")
 )
 
 // KeyTable returns a subspace.KeyTable that has registered all parameter types in this module's parameter set
@@ -95,6 +104,13 @@ func DefaultParams() Params {
 		ExternalMultisigThreshold:        utils.Threshold{Numerator: 4, Denominator: 8},
 		MaxSignQueueSize:                 50,
 		MaxSimultaneousSignShares:        100,
+		KeyRotationPolicies:              []KeyRotationPolicy{},
+		MaxSignAttempts:                  3,
+		SignFailureCooldownInBlocks:      100,
+		SignQueueWeights:                 []SignQueueWeight{},
+		MisbehaviourWindowInBlocks:       100000,
+		MisbehaviourJailThreshold:        3,
+		MisbehaviourJailDuration:         7 * 24 * time.Hour, // 7 days
 	}
 }
 
@@ -116,6 +132,13 @@ func (m *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyExternalMultisigThreshold, &m.ExternalMultisigThreshold, validateExternalMultisigThreshold),
 		params.NewParamSetPair(KeyMaxSignQueueSize, &m.MaxSignQueueSize, validatePosInt64("MaxSignQueueSize")),
 		params.NewParamSetPair(MaxSimultaneousSignShares, &m.MaxSimultaneousSignShares, validatePosInt64("MaxSimultaneousSignShares")),
+		params.NewParamSetPair(KeyKeyRotationPolicies, &m.KeyRotationPolicies, validateKeyRotationPolicies),
+		params.NewParamSetPair(KeyMaxSignAttempts, &m.MaxSignAttempts, validatePosInt64("MaxSignAttempts")),
+		params.NewParamSetPair(KeySignFailureCooldownInBlocks, &m.SignFailureCooldownInBlocks, validatePosInt64("SignFailureCooldownInBlocks")),
+		params.NewParamSetPair(KeySignQueueWeights, &m.SignQueueWeights, validateSignQueueWeights),
+		params.NewParamSetPair(KeyMisbehaviourWindowInBlocks, &m.MisbehaviourWindowInBlocks, validatePosInt64("MisbehaviourWindowInBlocks")),
+		params.NewParamSetPair(KeyMisbehaviourJailThreshold, &m.MisbehaviourJailThreshold, validatePosInt64("MisbehaviourJailThreshold")),
+		params.NewParamSetPair(KeyMisbehaviourJailDuration, &m.MisbehaviourJailDuration, validateMisbehaviourJailDuration),
 	}
 }
 
@@ -153,6 +176,34 @@ func (m Params) Validate() error {
 		return err
 	}
 
+	if err := validateKeyRotationPolicies(m.KeyRotationPolicies); err != nil {
+		return err
+	}
+
+	if err := validatePosInt64("MaxSignAttempts")(m.MaxSignAttempts); err != nil {
+		return err
+	}
+
+	if err := validatePosInt64("SignFailureCooldownInBlocks")(m.SignFailureCooldownInBlocks); err != nil {
+		return err
+	}
+
+	if err := validateSignQueueWeights(m.SignQueueWeights); err != nil {
+		return err
+	}
+
+	if err := validatePosInt64("MisbehaviourWindowInBlocks")(m.MisbehaviourWindowInBlocks); err != nil {
+		return err
+	}
+
+	if err := validatePosInt64("MisbehaviourJailThreshold")(m.MisbehaviourJailThreshold); err != nil {
+		return err
+	}
+
+	if err := validateMisbehaviourJailDuration(m.MisbehaviourJailDuration); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -179,6 +230,52 @@ func validateKeyRequirements(keyRequirements interface{}) error {
 	return nil
 }
 
+func validateSignQueueWeights(signQueueWeights interface{}) error {
+	val, ok := signQueueWeights.([]SignQueueWeight)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for SignQueueWeights: %T", signQueueWeights)
+	}
+
+	weightSeen := map[signClass]bool{}
+	for _, weight := range val {
+		key := weight.class()
+		if weightSeen[key] {
+			return fmt.Errorf("duplicate request module and chain found in SignQueueWeights")
+		}
+
+		if err := weight.Validate(); err != nil {
+			return err
+		}
+
+		weightSeen[key] = true
+	}
+
+	return nil
+}
+
+func validateKeyRotationPolicies(keyRotationPolicies interface{}) error {
+	val, ok := keyRotationPolicies.([]KeyRotationPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for KeyRotationPolicies: %T", keyRotationPolicies)
+	}
+
+	policySeen := map[string]bool{}
+	for _, policy := range val {
+		key := fmt.Sprintf("%s_%s", strings.ToLower(policy.Chain), policy.KeyRole.SimpleString())
+		if policySeen[key] {
+			return fmt.Errorf("duplicate chain and key role found in KeyRotationPolicies")
+		}
+
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+
+		policySeen[key] = true
+	}
+
+	return nil
+}
+
 func validateSuspendDurationInBlocks(suspendDurationInBlocks interface{}) error {
 	val, ok := suspendDurationInBlocks.(int64)
 	if !ok {
@@ -207,6 +304,19 @@ func validatePosInt64(field string) func(value interface{}) error {
 	}
 }
 
+func validateMisbehaviourJailDuration(misbehaviourJailDuration interface{}) error {
+	val, ok := misbehaviourJailDuration.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for MisbehaviourJailDuration: %T", misbehaviourJailDuration)
+	}
+
+	if val <= 0 {
+		return fmt.Errorf("MisbehaviourJailDuration must be a positive duration")
+	}
+
+	return nil
+}
+
 func validateMaxMissedBlocksPerWindow(maxMissedBlocksPerWindow interface{}) error {
 	val, ok := maxMissedBlocksPerWindow.(utils.Threshold)
 	if !ok {
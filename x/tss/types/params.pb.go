@@ -12,12 +12,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -41,6 +43,27 @@ type Params struct {
 	ExternalMultisigThreshold        utils.Threshold `protobuf:"bytes,6,opt,name=external_multisig_threshold,json=externalMultisigThreshold,proto3" json:"external_multisig_threshold"`
 	MaxSignQueueSize                 int64           `protobuf:"varint,7,opt,name=max_sign_queue_size,json=maxSignQueueSize,proto3" json:"max_sign_queue_size,omitempty"`
 	MaxSimultaneousSignShares        int64           `protobuf:"varint,8,opt,name=max_simultaneous_sign_shares,json=maxSimultaneousSignShares,proto3" json:"max_simultaneous_sign_shares,omitempty"`
+	// KeyRotationPolicies defines when keys are rotated without a manual
+	// rotation
+	KeyRotationPolicies []KeyRotationPolicy `protobuf:"bytes,9,rep,name=key_rotation_policies,json=keyRotationPolicies,proto3" json:"key_rotation_policies"`
+	// MaxSignAttempts defines how many times a sign session is started before
+	// it is aborted for good
+	MaxSignAttempts int64 `protobuf:"varint,10,opt,name=max_sign_attempts,json=maxSignAttempts,proto3" json:"max_sign_attempts,omitempty"`
+	// SignFailureCooldownInBlocks defines the number of blocks a validator is
+	// deprioritized in signer selection after failing to sign
+	SignFailureCooldownInBlocks int64 `protobuf:"varint,11,opt,name=sign_failure_cooldown_in_blocks,json=signFailureCooldownInBlocks,proto3" json:"sign_failure_cooldown_in_blocks,omitempty"`
+	// SignQueueWeights defines how the sign queue is shared between request
+	// modules and chains. Classes without weight have a weight of 1
+	SignQueueWeights []SignQueueWeight `protobuf:"bytes,12,rep,name=sign_queue_weights,json=signQueueWeights,proto3" json:"sign_queue_weights"`
+	// MisbehaviourWindowInBlocks defines the number of blocks within which
+	// offences of a validator add up to graduated penalties
+	MisbehaviourWindowInBlocks int64 `protobuf:"varint,13,opt,name=misbehaviour_window_in_blocks,json=misbehaviourWindowInBlocks,proto3" json:"misbehaviour_window_in_blocks,omitempty"`
+	// MisbehaviourJailThreshold defines the number of offences within the
+	// misbehaviour window after which a validator is jailed
+	MisbehaviourJailThreshold int64 `protobuf:"varint,14,opt,name=misbehaviour_jail_threshold,json=misbehaviourJailThreshold,proto3" json:"misbehaviour_jail_threshold,omitempty"`
+	// MisbehaviourJailDuration defines how long a validator jailed for
+	// misbehaving during tss sessions has to wait before it can unjail
+	MisbehaviourJailDuration time.Duration `protobuf:"varint,15,opt,name=misbehaviour_jail_duration,json=misbehaviourJailDuration,proto3,customtype=time.Duration" json:"misbehaviour_jail_duration"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -83,39 +106,51 @@ func init() {
 func init() { proto.RegisterFile("tss/v1beta1/params.proto", fileDescriptor_67c9a42e8b26dfec) }
 
 var fileDescriptor_67c9a42e8b26dfec = []byte{
-	// 497 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x1c, 0xc5, 0x1b, 0x56, 0x0a, 0x4a, 0x0f, 0x4c, 0x01, 0x89, 0xac, 0x40, 0xa8, 0xd0, 0x0e, 0xbd,
-	0x2c, 0x61, 0xe3, 0xc8, 0x01, 0xa9, 0x70, 0x41, 0xb0, 0xa9, 0xb4, 0x20, 0x24, 0x0e, 0xb3, 0x9c,
-	0xe6, 0xaf, 0xc4, 0x6a, 0x62, 0x67, 0xfe, 0xdb, 0x34, 0xdd, 0xa7, 0xe0, 0x63, 0xf5, 0xb8, 0x23,
-	0x5c, 0x10, 0xb4, 0x5f, 0x04, 0xd9, 0x49, 0x53, 0xb8, 0x71, 0x4a, 0xe2, 0xff, 0xef, 0x3d, 0x3f,
-	0xbf, 0xd8, 0xf5, 0x15, 0x62, 0xf4, 0xf5, 0x34, 0x06, 0x45, 0x4f, 0xa3, 0x92, 0x4a, 0x5a, 0x60,
-	0x58, 0x4a, 0xa1, 0x84, 0xd7, 0x57, 0x88, 0x61, 0x33, 0x19, 0x3c, 0x48, 0x45, 0x2a, 0xec, 0x7a,
-	0x64, 0xde, 0x6a, 0x64, 0xf0, 0x44, 0x2b, 0x96, 0xef, 0xe5, 0x2a, 0x93, 0x80, 0x99, 0xc8, 0x93,
-	0x66, 0x3c, 0x34, 0xde, 0x50, 0x95, 0x42, 0x2a, 0x48, 0xf6, 0xd4, 0xaa, 0x84, 0x66, 0x8f, 0x67,
-	0x3f, 0xba, 0x6e, 0x6f, 0x62, 0x37, 0xf5, 0x3e, 0xb9, 0x87, 0x0b, 0x58, 0x11, 0x09, 0x57, 0x9a,
-	0x49, 0x28, 0x80, 0x2b, 0xf4, 0x9d, 0xe1, 0xc1, 0xa8, 0x7f, 0x76, 0x1c, 0x9a, 0x24, 0x3b, 0x9f,
-	0x5d, 0xa4, 0xf0, 0x1d, 0xac, 0xa6, 0x7b, 0x78, 0xdc, 0x5d, 0xff, 0x7c, 0xda, 0x99, 0xde, 0x5b,
-	0xfc, 0xb3, 0x8a, 0xde, 0x4b, 0x77, 0x80, 0x1a, 0x4b, 0xe0, 0x09, 0x49, 0xb4, 0xa4, 0x8a, 0x09,
-	0x4e, 0x18, 0x27, 0x71, 0x2e, 0xe6, 0x0b, 0xf4, 0x6f, 0x0d, 0x9d, 0xd1, 0xc1, 0xf4, 0x61, 0x43,
-	0xbc, 0x69, 0x80, 0xb7, 0x7c, 0x6c, 0xc7, 0x46, 0x9c, 0x01, 0x95, 0x2a, 0x06, 0xaa, 0x48, 0x09,
-	0x92, 0x89, 0xe4, 0x2f, 0xf1, 0x41, 0x2d, 0x6e, 0x89, 0x89, 0x05, 0x5a, 0xf1, 0xa5, 0xfb, 0xb8,
-	0xa0, 0x15, 0x29, 0x18, 0x22, 0x24, 0x8d, 0xc6, 0x98, 0x90, 0x25, 0xe3, 0x89, 0x58, 0xfa, 0xdd,
-	0xa1, 0x33, 0xea, 0x9f, 0xf9, 0xa1, 0xed, 0xb0, 0x3d, 0xd5, 0xc7, 0x5d, 0x87, 0xcd, 0x81, 0xfc,
-	0x82, 0x56, 0xe7, 0xd6, 0xa2, 0xb6, 0x9d, 0x80, 0xfc, 0x6c, 0xf5, 0xde, 0x85, 0x7b, 0xac, 0x79,
-	0x2c, 0x78, 0xc2, 0x78, 0x4a, 0xcc, 0xcc, 0x3c, 0x6d, 0x85, 0x42, 0xd5, 0xe7, 0x9c, 0x0b, 0xcd,
-	0x95, 0x7f, 0xdb, 0xc6, 0x1c, 0xb6, 0xec, 0xfb, 0x1a, 0x35, 0xf5, 0x35, 0xe0, 0x6b, 0xc3, 0x79,
-	0x97, 0xee, 0x23, 0xa8, 0x14, 0x48, 0x4e, 0x73, 0x52, 0xe8, 0x5c, 0x31, 0x64, 0x29, 0x69, 0x7f,
-	0xa9, 0xdf, 0xfb, 0xaf, 0xb8, 0x47, 0x3b, 0x8b, 0xf3, 0xc6, 0xa1, 0x05, 0xbc, 0x13, 0xf7, 0xbe,
-	0xe9, 0x03, 0x59, 0xca, 0xc9, 0x95, 0x06, 0x0d, 0x04, 0xd9, 0x35, 0xf8, 0x77, 0x6c, 0xbc, 0xc3,
-	0x82, 0x56, 0x33, 0x96, 0xf2, 0x0f, 0x66, 0x30, 0x63, 0xd7, 0xe0, 0xbd, 0xaa, 0xeb, 0x43, 0x66,
-	0xb2, 0x50, 0x0e, 0x42, 0x63, 0xad, 0xc5, 0x8c, 0x4a, 0x40, 0xff, 0xae, 0xd5, 0x1d, 0x59, 0xdd,
-	0x1e, 0x31, 0x1e, 0x33, 0x0b, 0x8c, 0x2f, 0xd6, 0xbf, 0x83, 0xce, 0x7a, 0x13, 0x38, 0x37, 0x9b,
-	0xc0, 0xf9, 0xb5, 0x09, 0x9c, 0x6f, 0xdb, 0xa0, 0x73, 0xb3, 0x0d, 0x3a, 0xdf, 0xb7, 0x41, 0xe7,
-	0xcb, 0xf3, 0x94, 0xa9, 0x4c, 0xc7, 0xe1, 0x5c, 0x14, 0x11, 0xad, 0x20, 0xa7, 0x92, 0x83, 0x5a,
-	0x0a, 0xb9, 0x68, 0xbe, 0x4e, 0xe6, 0x42, 0x42, 0x54, 0x45, 0xe6, 0x0a, 0xdb, 0x1b, 0x1b, 0xf7,
-	0xec, 0x95, 0x7d, 0xf1, 0x27, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x68, 0xb1, 0xfc, 0x32, 0x03, 0x00,
-	0x00,
+	// 696 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x4f, 0x13, 0x41,
+	0x18, 0xc6, 0x5b, 0x41, 0xc4, 0xa9, 0x08, 0x2e, 0x12, 0x96, 0x02, 0x4b, 0x63, 0x38, 0x10, 0x13,
+	0xb6, 0x82, 0x47, 0x13, 0x0d, 0x85, 0x98, 0xf8, 0x07, 0x52, 0x5b, 0x0d, 0xc6, 0x03, 0x9b, 0x69,
+	0xf7, 0x75, 0x3b, 0x76, 0x77, 0x66, 0x99, 0x99, 0xa5, 0x2d, 0x9f, 0xc2, 0x8f, 0xc5, 0x4d, 0x8e,
+	0xc6, 0x03, 0x51, 0xf8, 0x22, 0x66, 0x66, 0x67, 0xb7, 0x5b, 0xbc, 0x78, 0x82, 0xce, 0xfb, 0x7b,
+	0xde, 0x7d, 0xde, 0x67, 0xdf, 0x1d, 0x64, 0x4b, 0x21, 0xea, 0x67, 0x3b, 0x1d, 0x90, 0x78, 0xa7,
+	0x1e, 0x63, 0x8e, 0x23, 0xe1, 0xc6, 0x9c, 0x49, 0x66, 0x55, 0xa4, 0x10, 0xae, 0xa9, 0x54, 0x1f,
+	0x07, 0x2c, 0x60, 0xfa, 0xbc, 0xae, 0xfe, 0x4b, 0x91, 0xea, 0x7a, 0x22, 0x49, 0x38, 0x96, 0xcb,
+	0x1e, 0x07, 0xd1, 0x63, 0xa1, 0x6f, 0xca, 0x35, 0xd5, 0x1b, 0x86, 0x31, 0xe3, 0x12, 0xfc, 0x31,
+	0x35, 0x8a, 0xc1, 0x3c, 0xa3, 0xba, 0x5c, 0x7c, 0x7a, 0xa1, 0xf0, 0xe4, 0xc7, 0x2c, 0x9a, 0x69,
+	0x6a, 0x37, 0xd6, 0x27, 0xb4, 0xd0, 0x87, 0x91, 0xc7, 0xe1, 0x34, 0x21, 0x1c, 0x22, 0xa0, 0x52,
+	0xd8, 0xe5, 0xda, 0xd4, 0x56, 0x65, 0x77, 0xd3, 0x55, 0x16, 0xb3, 0x07, 0x64, 0x5e, 0xdd, 0x77,
+	0x30, 0x6a, 0x8d, 0xe1, 0xc6, 0xf4, 0xc5, 0xd5, 0x46, 0xa9, 0x35, 0xdf, 0x9f, 0x38, 0x15, 0xd6,
+	0x0b, 0x54, 0x15, 0x89, 0x88, 0x81, 0xfa, 0x9e, 0x9f, 0x70, 0x2c, 0x09, 0xa3, 0x1e, 0xa1, 0x5e,
+	0x27, 0x64, 0xdd, 0xbe, 0xb0, 0xef, 0xd4, 0xca, 0x5b, 0x53, 0xad, 0x65, 0x43, 0x1c, 0x18, 0xe0,
+	0x0d, 0x6d, 0xe8, 0xb2, 0x12, 0xf7, 0x00, 0x73, 0xd9, 0x01, 0x2c, 0xbd, 0x18, 0x38, 0x61, 0x7e,
+	0x41, 0x3c, 0x95, 0x8a, 0x73, 0xa2, 0xa9, 0x81, 0x5c, 0x7c, 0x82, 0xd6, 0x22, 0x3c, 0xf4, 0x22,
+	0x22, 0x04, 0xf8, 0x46, 0xa3, 0x9a, 0x78, 0x03, 0x42, 0x7d, 0x36, 0xb0, 0xa7, 0x6b, 0xe5, 0xad,
+	0xca, 0xae, 0xed, 0xea, 0x70, 0xf3, 0xa9, 0x3e, 0x66, 0xe1, 0x9a, 0x81, 0xec, 0x08, 0x0f, 0x0f,
+	0x75, 0x8b, 0xb4, 0x6d, 0x13, 0xf8, 0xb1, 0xd6, 0x5b, 0x47, 0x68, 0x33, 0xa1, 0x1d, 0x46, 0x7d,
+	0x42, 0x03, 0x4f, 0xd5, 0xd4, 0x5f, 0x1d, 0x21, 0x93, 0xe9, 0x9c, 0x5d, 0x96, 0x50, 0x69, 0xdf,
+	0xd5, 0x36, 0x6b, 0x39, 0xfb, 0x3e, 0x45, 0x55, 0x7c, 0x06, 0xdc, 0x57, 0x9c, 0x75, 0x82, 0x56,
+	0x61, 0x28, 0x81, 0x53, 0x1c, 0x7a, 0x51, 0x12, 0x4a, 0x22, 0x48, 0xe0, 0xe5, 0xef, 0xda, 0x9e,
+	0xf9, 0x2f, 0xbb, 0x2b, 0x59, 0x8b, 0x43, 0xd3, 0x21, 0x07, 0xac, 0x6d, 0xb4, 0xa8, 0xf2, 0x10,
+	0x24, 0xa0, 0xde, 0x69, 0x02, 0x09, 0x78, 0x82, 0x9c, 0x83, 0x7d, 0x4f, 0xdb, 0x5b, 0x88, 0xf0,
+	0xb0, 0x4d, 0x02, 0xfa, 0x41, 0x15, 0xda, 0xe4, 0x1c, 0xac, 0x57, 0x69, 0x7c, 0x82, 0x28, 0x2f,
+	0x98, 0x02, 0x4b, 0x44, 0xaa, 0x15, 0x3d, 0xcc, 0x41, 0xd8, 0xb3, 0x5a, 0xb7, 0xa2, 0x75, 0x63,
+	0x44, 0xf5, 0x68, 0x6b, 0xc0, 0xfa, 0x8c, 0x96, 0x26, 0xd2, 0x88, 0x59, 0x48, 0xba, 0x04, 0x84,
+	0x7d, 0x5f, 0x6f, 0x95, 0xe3, 0x16, 0x16, 0xdf, 0x2d, 0xa4, 0xd1, 0x54, 0xdc, 0xc8, 0xcc, 0xb3,
+	0xd8, 0xbf, 0x55, 0x20, 0x20, 0xac, 0xa7, 0xe8, 0x51, 0x3e, 0x09, 0x96, 0x12, 0xa2, 0x58, 0x0a,
+	0x1b, 0x69, 0x3f, 0xf3, 0x66, 0x8e, 0x3d, 0x73, 0x6c, 0x1d, 0xa0, 0x0d, 0xcd, 0x7d, 0xc5, 0x24,
+	0x4c, 0x38, 0x78, 0x5d, 0xc6, 0x42, 0x9f, 0x0d, 0x8a, 0x4b, 0x58, 0xd1, 0xca, 0x55, 0x85, 0xbd,
+	0x4e, 0xa9, 0x7d, 0x03, 0xe5, 0xbb, 0xd4, 0x44, 0x56, 0x21, 0xb7, 0x01, 0x90, 0xa0, 0x27, 0x85,
+	0xfd, 0x40, 0x0f, 0xb2, 0x36, 0x31, 0x48, 0x1e, 0xe2, 0xb1, 0x86, 0xcc, 0x18, 0x0b, 0x62, 0xf2,
+	0x58, 0x58, 0x7b, 0x68, 0x3d, 0x22, 0xa2, 0x03, 0x3d, 0x7c, 0x46, 0x58, 0x92, 0x2d, 0x65, 0xc1,
+	0xd5, 0x9c, 0x76, 0x55, 0x2d, 0x42, 0xe9, 0xe2, 0xe5, 0xa6, 0x5e, 0xa2, 0xd5, 0x89, 0x16, 0xdf,
+	0x30, 0x09, 0x0b, 0x0b, 0xf3, 0xd0, 0xbc, 0xa0, 0x02, 0xf2, 0x16, 0x93, 0x70, 0xbc, 0x10, 0x6d,
+	0x54, 0xfd, 0x57, 0x9f, 0x7d, 0xa4, 0xf6, 0xbc, 0x92, 0x37, 0x96, 0x94, 0xfd, 0x5f, 0x57, 0x1b,
+	0x73, 0x92, 0x44, 0xe0, 0x66, 0x1f, 0x68, 0xcb, 0xbe, 0xdd, 0x35, 0xab, 0x34, 0x8e, 0x2e, 0xfe,
+	0x38, 0xa5, 0x8b, 0x6b, 0xa7, 0x7c, 0x79, 0xed, 0x94, 0x7f, 0x5f, 0x3b, 0xe5, 0xef, 0x37, 0x4e,
+	0xe9, 0xf2, 0xc6, 0x29, 0xfd, 0xbc, 0x71, 0x4a, 0x5f, 0x9e, 0x05, 0x44, 0xf6, 0x92, 0x8e, 0xdb,
+	0x65, 0x51, 0x1d, 0x0f, 0x21, 0xc4, 0x9c, 0x82, 0x1c, 0x30, 0xde, 0x37, 0xbf, 0xb6, 0xbb, 0x8c,
+	0x43, 0x7d, 0x58, 0x57, 0xf7, 0x95, 0xbe, 0xa7, 0x3a, 0x33, 0xfa, 0xa2, 0x7a, 0xfe, 0x77, 0x00,
+	0x2d, 0xd2, 0xe5, 0x98, 0x41, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -138,6 +173,59 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MisbehaviourJailDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MisbehaviourJailDuration))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MisbehaviourJailThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MisbehaviourJailThreshold))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MisbehaviourWindowInBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MisbehaviourWindowInBlocks))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.SignQueueWeights) > 0 {
+		for iNdEx := len(m.SignQueueWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignQueueWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.SignFailureCooldownInBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignFailureCooldownInBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxSignAttempts != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSignAttempts))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.KeyRotationPolicies) > 0 {
+		for iNdEx := len(m.KeyRotationPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyRotationPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.MaxSimultaneousSignShares != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxSimultaneousSignShares))
 		i--
@@ -242,6 +330,33 @@ func (m *Params) Size() (n int) {
 	if m.MaxSimultaneousSignShares != 0 {
 		n += 1 + sovParams(uint64(m.MaxSimultaneousSignShares))
 	}
+	if len(m.KeyRotationPolicies) > 0 {
+		for _, e := range m.KeyRotationPolicies {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxSignAttempts != 0 {
+		n += 1 + sovParams(uint64(m.MaxSignAttempts))
+	}
+	if m.SignFailureCooldownInBlocks != 0 {
+		n += 1 + sovParams(uint64(m.SignFailureCooldownInBlocks))
+	}
+	if len(m.SignQueueWeights) > 0 {
+		for _, e := range m.SignQueueWeights {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MisbehaviourWindowInBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MisbehaviourWindowInBlocks))
+	}
+	if m.MisbehaviourJailThreshold != 0 {
+		n += 1 + sovParams(uint64(m.MisbehaviourJailThreshold))
+	}
+	if m.MisbehaviourJailDuration != 0 {
+		n += 1 + sovParams(uint64(m.MisbehaviourJailDuration))
+	}
 	return n
 }
 
@@ -475,6 +590,169 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyRotationPolicies = append(m.KeyRotationPolicies, KeyRotationPolicy{})
+			if err := m.KeyRotationPolicies[len(m.KeyRotationPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSignAttempts", wireType)
+			}
+			m.MaxSignAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSignAttempts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignFailureCooldownInBlocks", wireType)
+			}
+			m.SignFailureCooldownInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignFailureCooldownInBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignQueueWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignQueueWeights = append(m.SignQueueWeights, SignQueueWeight{})
+			if err := m.SignQueueWeights[len(m.SignQueueWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MisbehaviourWindowInBlocks", wireType)
+			}
+			m.MisbehaviourWindowInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MisbehaviourWindowInBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MisbehaviourJailThreshold", wireType)
+			}
+			m.MisbehaviourJailThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MisbehaviourJailThreshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MisbehaviourJailDuration", wireType)
+			}
+			m.MisbehaviourJailDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MisbehaviourJailDuration |= time.Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
 	"github.com/axelarnetwork/axelar-core/x/tss/types"
 )
 
@@ -13,3 +15,86 @@ func TestDefaultParams(t *testing.T) {
 
 	assert.NoError(t, params.Validate())
 }
+
+func TestParams_KeyRotationPolicies(t *testing.T) {
+	validPolicy := func() types.KeyRotationPolicy {
+		return types.KeyRotationPolicy{
+			Chain:            "Ethereum",
+			KeyRole:          exported.MasterKey,
+			PeriodInBlocks:   100000,
+			MaxSnapshotDrift: utils.Threshold{Numerator: 1, Denominator: 3},
+		}
+	}
+
+	t.Run("should accept period and drift based policies", func(t *testing.T) {
+		params := types.DefaultParams()
+		periodOnly := validPolicy()
+		periodOnly.MaxSnapshotDrift = utils.Threshold{}
+		driftOnly := validPolicy()
+		driftOnly.KeyRole = exported.SecondaryKey
+		driftOnly.PeriodInBlocks = 0
+		params.KeyRotationPolicies = []types.KeyRotationPolicy{periodOnly, driftOnly}
+
+		assert.NoError(t, params.Validate())
+	})
+
+	t.Run("should reject duplicate policies", func(t *testing.T) {
+		params := types.DefaultParams()
+		duplicate := validPolicy()
+		duplicate.Chain = "ethereum"
+		params.KeyRotationPolicies = []types.KeyRotationPolicy{validPolicy(), duplicate}
+
+		assert.Error(t, params.Validate())
+	})
+
+	t.Run("should reject invalid policies", func(t *testing.T) {
+		noTrigger := validPolicy()
+		noTrigger.PeriodInBlocks = 0
+		noTrigger.MaxSnapshotDrift = utils.Threshold{}
+
+		externalKey := validPolicy()
+		externalKey.KeyRole = exported.ExternalKey
+
+		invalidDrift := validPolicy()
+		invalidDrift.MaxSnapshotDrift = utils.Threshold{Numerator: 4, Denominator: 3}
+
+		for _, policy := range []types.KeyRotationPolicy{noTrigger, externalKey, invalidDrift} {
+			params := types.DefaultParams()
+			params.KeyRotationPolicies = []types.KeyRotationPolicy{policy}
+
+			assert.Error(t, params.Validate())
+		}
+	})
+}
+
+func TestParams_SignQueueWeights(t *testing.T) {
+	t.Run("should accept module and chain specific weights", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.SignQueueWeights = []types.SignQueueWeight{
+			{RequestModule: "evm", Weight: 1},
+			{RequestModule: "evm", Chain: "Ethereum", Weight: 2},
+			{RequestModule: "bitcoin", Chain: "Bitcoin", Weight: 3},
+		}
+
+		assert.NoError(t, params.Validate())
+	})
+
+	t.Run("should reject duplicate weights", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.SignQueueWeights = []types.SignQueueWeight{
+			{RequestModule: "evm", Chain: "Ethereum", Weight: 1},
+			{RequestModule: "evm", Chain: "ethereum", Weight: 2},
+		}
+
+		assert.Error(t, params.Validate())
+	})
+
+	t.Run("should reject invalid weights", func(t *testing.T) {
+		for _, weight := range []types.SignQueueWeight{{Chain: "Ethereum", Weight: 1}, {RequestModule: "evm", Weight: 0}} {
+			params := types.DefaultParams()
+			params.SignQueueWeights = []types.SignQueueWeight{weight}
+
+			assert.Error(t, params.Validate())
+		}
+	})
+}
@@ -854,6 +854,92 @@ func (m *QueryNextKeyIDResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryNextKeyIDResponse proto.InternalMessageInfo
 
+type QuerySignQueuePositionResponse struct {
+	SigStatus     exported.SigStatus    `protobuf:"varint,1,opt,name=sig_status,json=sigStatus,proto3,enum=tss.exported.v1beta1.SigStatus" json:"sig_status,omitempty"`
+	RequestModule string                `protobuf:"bytes,2,opt,name=request_module,json=requestModule,proto3" json:"request_module,omitempty"`
+	Chain         string                `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
+	Priority      exported.SignPriority `protobuf:"varint,4,opt,name=priority,proto3,enum=tss.exported.v1beta1.SignPriority" json:"priority,omitempty"`
+	// position is the number of queued sign requests scheduled before this one,
+	// plus one. It is zero once signing has started
+	Position             int64 `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	QueueSize            int64 `protobuf:"varint,6,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
+	EstimatedStartHeight int64 `protobuf:"varint,7,opt,name=estimated_start_height,json=estimatedStartHeight,proto3" json:"estimated_start_height,omitempty"`
+}
+
+func (m *QuerySignQueuePositionResponse) Reset()         { *m = QuerySignQueuePositionResponse{} }
+func (m *QuerySignQueuePositionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignQueuePositionResponse) ProtoMessage()    {}
+func (*QuerySignQueuePositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9e98857940a4a89, []int{10}
+}
+func (m *QuerySignQueuePositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignQueuePositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignQueuePositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignQueuePositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignQueuePositionResponse.Merge(m, src)
+}
+func (m *QuerySignQueuePositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignQueuePositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignQueuePositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySignQueuePositionResponse proto.InternalMessageInfo
+
+type QueryMisbehaviourHistoryResponse struct {
+	Evidence []MisbehaviourEvidence `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence"`
+	// recent_offence_count is the number of offences within the current
+	// misbehaviour window
+	RecentOffenceCount int64 `protobuf:"varint,2,opt,name=recent_offence_count,json=recentOffenceCount,proto3" json:"recent_offence_count,omitempty"`
+	SuspendedUntil     int64 `protobuf:"varint,3,opt,name=suspended_until,json=suspendedUntil,proto3" json:"suspended_until,omitempty"`
+}
+
+func (m *QueryMisbehaviourHistoryResponse) Reset()         { *m = QueryMisbehaviourHistoryResponse{} }
+func (m *QueryMisbehaviourHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMisbehaviourHistoryResponse) ProtoMessage()    {}
+func (*QueryMisbehaviourHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9e98857940a4a89, []int{11}
+}
+func (m *QueryMisbehaviourHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMisbehaviourHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMisbehaviourHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMisbehaviourHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMisbehaviourHistoryResponse.Merge(m, src)
+}
+func (m *QueryMisbehaviourHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMisbehaviourHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMisbehaviourHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMisbehaviourHistoryResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("tss.v1beta1.VoteStatus", VoteStatus_name, VoteStatus_value)
 	proto.RegisterType((*QuerySignatureResponse)(nil), "tss.v1beta1.QuerySignatureResponse")
@@ -874,94 +960,110 @@ func init() {
 	proto.RegisterType((*QueryExternalKeyIDResponse)(nil), "tss.v1beta1.QueryExternalKeyIDResponse")
 	proto.RegisterType((*QueryNextKeyIDRequest)(nil), "tss.v1beta1.QueryNextKeyIDRequest")
 	proto.RegisterType((*QueryNextKeyIDResponse)(nil), "tss.v1beta1.QueryNextKeyIDResponse")
+	proto.RegisterType((*QuerySignQueuePositionResponse)(nil), "tss.v1beta1.QuerySignQueuePositionResponse")
+	proto.RegisterType((*QueryMisbehaviourHistoryResponse)(nil), "tss.v1beta1.QueryMisbehaviourHistoryResponse")
 }
 
 func init() { proto.RegisterFile("tss/v1beta1/query.proto", fileDescriptor_b9e98857940a4a89) }
 
 var fileDescriptor_b9e98857940a4a89 = []byte{
-	// 1308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4b, 0x8f, 0xdb, 0x54,
-	0x14, 0x8e, 0x93, 0x79, 0xf9, 0x24, 0x2d, 0x99, 0xdb, 0x96, 0x0e, 0x86, 0x26, 0x33, 0x01, 0xd1,
-	0x02, 0x6d, 0xd2, 0x0e, 0x02, 0xb5, 0x42, 0x02, 0x4d, 0x1e, 0xd3, 0x46, 0x11, 0x99, 0xe2, 0x64,
-	0xba, 0x00, 0x21, 0xe3, 0xd8, 0x37, 0x1e, 0x2b, 0x89, 0x6f, 0xea, 0x7b, 0x3d, 0x8d, 0x37, 0xb0,
-	0x45, 0x5d, 0x75, 0x8f, 0xba, 0x82, 0x45, 0xff, 0x02, 0xff, 0x60, 0xba, 0xa9, 0xba, 0x64, 0x95,
-	0x42, 0xca, 0xaf, 0xe8, 0x0a, 0xdd, 0xeb, 0x47, 0xdc, 0xa6, 0xaa, 0x5a, 0x46, 0xb0, 0x1a, 0x9f,
-	0xf7, 0x39, 0xdf, 0xf9, 0x8e, 0x9d, 0x81, 0xb3, 0x8c, 0xd2, 0xca, 0xe1, 0x95, 0x1e, 0x66, 0xfa,
-	0x95, 0xca, 0x6d, 0x0f, 0xbb, 0x7e, 0x79, 0xec, 0x12, 0x46, 0x50, 0x96, 0x51, 0x5a, 0x0e, 0x0d,
-	0xca, 0x69, 0x8b, 0x58, 0x44, 0xe8, 0x2b, 0xfc, 0x29, 0x70, 0x51, 0x8a, 0x16, 0x21, 0xd6, 0x10,
-	0x57, 0x84, 0xd4, 0xf3, 0xfa, 0x15, 0x66, 0x8f, 0x30, 0x65, 0xfa, 0x68, 0x1c, 0x3a, 0x6c, 0xf2,
-	0xe4, 0x78, 0x32, 0x26, 0x2e, 0xc3, 0x66, 0x5c, 0x85, 0xf9, 0x63, 0x4c, 0x43, 0x8f, 0x73, 0xdc,
-	0x83, 0x91, 0xbe, 0x93, 0x30, 0x73, 0x29, 0x34, 0x6f, 0x19, 0x84, 0x8e, 0x08, 0xad, 0x18, 0xae,
-	0x3f, 0x66, 0xa4, 0x32, 0xf2, 0x86, 0xcc, 0xa6, 0xb6, 0x55, 0x19, 0x60, 0x3f, 0xcc, 0x50, 0xfa,
-	0x7b, 0x09, 0xde, 0xfe, 0x86, 0xf7, 0xdd, 0xb1, 0x2d, 0x47, 0x67, 0x9e, 0x8b, 0x55, 0x4c, 0xc7,
-	0xc4, 0xa1, 0x18, 0xd9, 0x70, 0x8a, 0x1d, 0xb8, 0x98, 0x1e, 0x90, 0xa1, 0xa9, 0xd1, 0xc8, 0xbc,
-	0x21, 0x6d, 0x4a, 0x17, 0xb2, 0xdb, 0x9f, 0x97, 0x13, 0x03, 0x96, 0x5f, 0x9e, 0xa1, 0xdc, 0x8d,
-	0xc2, 0x63, 0xd3, 0x8d, 0x94, 0x8a, 0xd8, 0x82, 0x16, 0xf5, 0x01, 0x45, 0xcd, 0x25, 0x2a, 0xa5,
-	0x45, 0xa5, 0xcf, 0x5e, 0xa7, 0xd2, 0xd7, 0x61, 0x74, 0xb2, 0xd0, 0xfa, 0xe8, 0x45, 0xa5, 0x72,
-	0x1e, 0xe4, 0x79, 0xd1, 0x1c, 0x48, 0xae, 0x98, 0x46, 0x56, 0x25, 0x97, 0x4b, 0x54, 0x54, 0x94,
-	0x55, 0x89, 0x2a, 0xbf, 0x48, 0x80, 0x16, 0xbb, 0x47, 0x57, 0x21, 0x7b, 0x48, 0x18, 0xd6, 0x28,
-	0xd3, 0x99, 0x47, 0x45, 0xf0, 0xc9, 0xed, 0xb3, 0xcf, 0x35, 0x78, 0x8b, 0x30, 0xdc, 0x11, 0x66,
-	0x15, 0x0e, 0xe3, 0x67, 0xd4, 0x02, 0xf9, 0xc5, 0xc1, 0x2e, 0xbd, 0xce, 0x60, 0x73, 0xcd, 0x3c,
-	0x5e, 0x79, 0x20, 0xc1, 0xfa, 0xc2, 0xc4, 0xe8, 0x4b, 0x00, 0x81, 0x5f, 0xb2, 0xb7, 0xa2, 0xa8,
-	0x11, 0x71, 0x28, 0x2e, 0xd6, 0xb1, 0xad, 0xb0, 0x47, 0x9e, 0x35, 0x6c, 0xb1, 0x23, 0xe2, 0x83,
-	0x64, 0x1c, 0x8a, 0xcc, 0x1b, 0xf7, 0x58, 0x5d, 0x3a, 0x9a, 0x16, 0x53, 0x6a, 0x22, 0x4d, 0x75,
-	0x19, 0x32, 0xd4, 0xb6, 0x4a, 0x0f, 0x97, 0x20, 0x2f, 0xa2, 0x5b, 0xd8, 0x8f, 0x09, 0xd6, 0x01,
-	0x19, 0x1b, 0x26, 0xd5, 0xb5, 0x01, 0xf6, 0x43, 0x5a, 0x7d, 0xb8, 0x58, 0x2f, 0x11, 0x51, 0x6e,
-	0xd4, 0xea, 0x9d, 0x9d, 0x16, 0xf6, 0xab, 0xb9, 0xd9, 0xb4, 0xb8, 0x16, 0x49, 0x37, 0x52, 0xea,
-	0x9a, 0x48, 0xd4, 0xc2, 0x3e, 0x6a, 0x43, 0x2e, 0xa6, 0x12, 0xcf, 0x1b, 0x60, 0xfd, 0xd1, 0xab,
-	0xf3, 0x46, 0x60, 0x06, 0xc9, 0xb2, 0xa3, 0xb9, 0x88, 0xae, 0xc0, 0x92, 0x4b, 0x86, 0x78, 0x23,
-	0x23, 0xf0, 0x3c, 0xf7, 0x72, 0x3c, 0x79, 0x2e, 0x32, 0xc4, 0xaa, 0x70, 0x45, 0x35, 0x00, 0x97,
-	0x30, 0x9d, 0x61, 0x53, 0xd3, 0xd9, 0xc6, 0x92, 0x68, 0x40, 0x29, 0x07, 0xd7, 0x5e, 0x8e, 0xae,
-	0xbd, 0xdc, 0x8d, 0xae, 0xbd, 0xba, 0x76, 0x34, 0x2d, 0x4a, 0xf7, 0x9e, 0x14, 0x25, 0x55, 0x0e,
-	0xe3, 0x76, 0x98, 0xb2, 0x05, 0x19, 0x5e, 0x3e, 0x07, 0xd2, 0x24, 0x22, 0xe9, 0x84, 0x4b, 0x7e,
-	0x44, 0x52, 0x5f, 0xf9, 0x09, 0x62, 0x08, 0x8e, 0xc1, 0xcc, 0x6b, 0x90, 0x99, 0xe3, 0xb4, 0xf5,
-	0x6a, 0x9c, 0x38, 0xf4, 0xc1, 0x8e, 0x79, 0x8c, 0xd2, 0x87, 0x6c, 0x02, 0x39, 0xf4, 0x1e, 0xc8,
-	0xf1, 0x6d, 0x8b, 0x0e, 0x32, 0xea, 0x5c, 0x31, 0xaf, 0x93, 0x79, 0xd3, 0x3a, 0xd5, 0x1c, 0xc0,
-	0xd8, 0xeb, 0x0d, 0x6d, 0x83, 0x6f, 0xb4, 0x74, 0x24, 0xc1, 0x19, 0x11, 0xa1, 0x62, 0x83, 0x1c,
-	0x8a, 0xbf, 0x21, 0xa1, 0xce, 0x01, 0x8c, 0x75, 0x97, 0xf9, 0x9a, 0x67, 0x9b, 0x1c, 0x83, 0xcc,
-	0x05, 0x59, 0x95, 0x85, 0x66, 0xdf, 0x36, 0x29, 0xba, 0x08, 0x28, 0x30, 0xd3, 0x03, 0xdd, 0xc5,
-	0x9a, 0x41, 0x3c, 0x87, 0x05, 0x44, 0x3f, 0xa1, 0xe6, 0x85, 0xa5, 0xc3, 0x0d, 0x35, 0xa1, 0x7f,
-	0x7e, 0x1a, 0xbe, 0xfd, 0x13, 0xc9, 0x69, 0xea, 0x70, 0x62, 0x80, 0x7d, 0x0b, 0x3b, 0x1a, 0xf1,
-	0xd8, 0xd8, 0x8b, 0xd6, 0x1c, 0xdc, 0x5b, 0xf0, 0x0e, 0x4e, 0x90, 0xc3, 0xc2, 0xce, 0x9e, 0x70,
-	0x53, 0x73, 0x83, 0x84, 0x54, 0x7a, 0x94, 0x09, 0x47, 0x69, 0xe1, 0xa0, 0x76, 0xe2, 0x36, 0xb2,
-	0x41, 0x97, 0xb6, 0xd3, 0x27, 0xc1, 0x2c, 0xd9, 0xed, 0x8b, 0x2f, 0x45, 0xed, 0xb9, 0xc0, 0xb2,
-	0x90, 0x9a, 0x4e, 0x9f, 0xc4, 0xc7, 0x18, 0x29, 0xa8, 0xf2, 0x24, 0x0d, 0x72, 0x6c, 0x47, 0xdf,
-	0xc3, 0xca, 0x00, 0xfb, 0x9a, 0x1d, 0xec, 0x4a, 0xae, 0xee, 0xce, 0xa6, 0xc5, 0xe5, 0x16, 0xf6,
-	0x9b, 0xf5, 0x67, 0xd3, 0xe2, 0x35, 0xcb, 0x66, 0x07, 0x5e, 0xaf, 0x6c, 0x90, 0x51, 0x45, 0x9f,
-	0xe0, 0xa1, 0xee, 0x3a, 0x98, 0xdd, 0x21, 0xee, 0x20, 0x94, 0x2e, 0x19, 0xc4, 0xc5, 0x95, 0x49,
-	0x25, 0xf9, 0x89, 0x2a, 0x8b, 0x60, 0x75, 0x79, 0x80, 0xfd, 0xa6, 0x89, 0xde, 0x05, 0x99, 0xa7,
-	0x37, 0x0e, 0x74, 0xdb, 0x09, 0x39, 0xbb, 0x36, 0xc0, 0x7e, 0x8d, 0xcb, 0xe8, 0x1d, 0xe0, 0xcf,
-	0x5a, 0x7c, 0x59, 0xb2, 0xba, 0x3a, 0x08, 0x6e, 0x08, 0x6d, 0xc3, 0x19, 0xea, 0xe8, 0x63, 0x7a,
-	0x40, 0x98, 0xd6, 0x1b, 0x12, 0x63, 0xa0, 0x39, 0xde, 0xa8, 0x87, 0x5d, 0x81, 0x70, 0x46, 0x3d,
-	0x15, 0x19, 0xab, 0xdc, 0xd6, 0x16, 0x26, 0xf4, 0x09, 0xac, 0x1f, 0xea, 0x43, 0xdb, 0xd4, 0x19,
-	0x71, 0x35, 0xdd, 0x34, 0x5d, 0x4c, 0xe9, 0xc6, 0xb2, 0xc8, 0x9b, 0x8f, 0x0d, 0x3b, 0x81, 0x1e,
-	0x5d, 0x86, 0xd3, 0x8e, 0x37, 0xd2, 0xe6, 0x01, 0x02, 0x21, 0xba, 0xb1, 0x22, 0xf2, 0x23, 0xc7,
-	0x1b, 0xdd, 0x8a, 0x4c, 0x02, 0x2c, 0x8a, 0x2e, 0x40, 0x9e, 0x47, 0x30, 0xc2, 0xf4, 0x61, 0xe4,
-	0xbd, 0x2a, 0xbc, 0x4f, 0x3a, 0xde, 0xa8, 0xcb, 0xd5, 0x81, 0x67, 0x49, 0x85, 0x2d, 0xb1, 0x96,
-	0x3a, 0xd6, 0x0d, 0x66, 0x1f, 0xf2, 0x5b, 0xde, 0x1b, 0x63, 0x97, 0xe7, 0xa2, 0xf1, 0x6e, 0x2f,
-	0x01, 0x22, 0xa1, 0x32, 0x6a, 0x16, 0x47, 0x74, 0x5d, 0x8f, 0x2c, 0x3b, 0x91, 0xa1, 0xf4, 0x28,
-	0x0d, 0xef, 0x8b, 0xa4, 0x3b, 0x3c, 0x25, 0xde, 0x1b, 0x9a, 0x2d, 0xec, 0xd3, 0xb8, 0xc7, 0x38,
-	0xed, 0x77, 0x02, 0x70, 0x2a, 0x18, 0x13, 0x12, 0xe6, 0xea, 0x22, 0x61, 0x5e, 0x9d, 0x44, 0xac,
-	0x70, 0x4e, 0x1e, 0xbe, 0x24, 0xca, 0x65, 0xe5, 0xa1, 0x04, 0xab, 0xa1, 0x0d, 0x75, 0x20, 0x1d,
-	0x93, 0xa6, 0x36, 0x9b, 0x16, 0xd3, 0xc7, 0x65, 0x4c, 0xda, 0x36, 0xd1, 0x69, 0x58, 0x4e, 0x52,
-	0x25, 0x10, 0x50, 0x27, 0xf1, 0xf6, 0x5d, 0xae, 0x7e, 0xf5, 0x6c, 0x5a, 0xfc, 0xe2, 0x5f, 0x96,
-	0x99, 0xbf, 0x9f, 0x4b, 0x3f, 0x82, 0xb2, 0x08, 0x45, 0x0c, 0xe3, 0x0f, 0xb0, 0x1a, 0x9c, 0x45,
-	0xb8, 0x92, 0xea, 0xf5, 0xd9, 0xb4, 0xb8, 0x22, 0x1a, 0xa5, 0xc7, 0x1b, 0x73, 0x45, 0x1c, 0x06,
-	0x8d, 0xeb, 0x37, 0x26, 0x0c, 0xbb, 0x8e, 0x3e, 0x0c, 0xac, 0xff, 0x5f, 0x7d, 0x2b, 0x7c, 0xe9,
-	0xb4, 0xf1, 0x84, 0x85, 0xb5, 0x6f, 0x7b, 0x98, 0xb2, 0xf9, 0x0e, 0xa4, 0xe4, 0x0e, 0xae, 0x26,
-	0x6e, 0x35, 0xfd, 0x3a, 0x5f, 0xc1, 0xe8, 0x94, 0x4b, 0x77, 0xc2, 0xdf, 0x96, 0x89, 0x42, 0xe1,
-	0x90, 0xff, 0xed, 0xbb, 0xe7, 0xe3, 0xdf, 0x25, 0x80, 0xf9, 0xe7, 0x0e, 0x5d, 0x84, 0xb3, 0xb7,
-	0xf6, 0xba, 0x0d, 0xad, 0xd3, 0xdd, 0xe9, 0xee, 0x77, 0xb4, 0xfd, 0x76, 0xe7, 0x66, 0xa3, 0xd6,
-	0xdc, 0x6d, 0x36, 0xea, 0xf9, 0x94, 0xf2, 0xd6, 0xdd, 0xfb, 0x9b, 0xd9, 0x7d, 0x87, 0x8e, 0xb1,
-	0x61, 0xf7, 0x6d, 0x6c, 0xa2, 0xf3, 0x70, 0x26, 0xe9, 0xdd, 0xde, 0xeb, 0x6a, 0xbb, 0x7b, 0xfb,
-	0xed, 0x7a, 0x5e, 0x52, 0x72, 0x77, 0xef, 0x6f, 0xae, 0xb5, 0x09, 0xdb, 0x25, 0x9e, 0x63, 0xa2,
-	0x0f, 0xe0, 0x54, 0xd2, 0xf1, 0x66, 0xa3, 0x5d, 0x6f, 0xb6, 0xaf, 0xe7, 0xd3, 0x4a, 0xf6, 0xee,
-	0xfd, 0xcd, 0xd5, 0x9b, 0xd8, 0x31, 0x6d, 0xc7, 0x7a, 0xd1, 0xab, 0xde, 0xa8, 0x35, 0xeb, 0x8d,
-	0x7a, 0x3e, 0x13, 0x78, 0xd5, 0xb1, 0x61, 0x9b, 0xd8, 0x54, 0xd6, 0x7e, 0xfe, 0xb5, 0x90, 0x7a,
-	0xf0, 0x5b, 0x41, 0xaa, 0xb6, 0x8f, 0xfe, 0x2a, 0xa4, 0x8e, 0x66, 0x05, 0xe9, 0xf1, 0xac, 0x20,
-	0xfd, 0x39, 0x2b, 0x48, 0xf7, 0x9e, 0x16, 0x52, 0x8f, 0x9f, 0x16, 0x52, 0x7f, 0x3c, 0x2d, 0xa4,
-	0xbe, 0xbd, 0xfc, 0x06, 0xd8, 0x88, 0xff, 0x14, 0x7a, 0x2b, 0xe2, 0x17, 0xc7, 0xa7, 0xff, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x84, 0xc4, 0x9b, 0xa4, 0xab, 0x0c, 0x00, 0x00,
+	// 1528 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcb, 0x73, 0x13, 0xc9,
+	0x19, 0xd7, 0x48, 0x7e, 0x68, 0x5a, 0xb6, 0x91, 0x1b, 0x03, 0xce, 0x24, 0x48, 0xb6, 0xf2, 0x80,
+	0x24, 0x20, 0x81, 0xf3, 0x28, 0xa8, 0x54, 0x91, 0xb2, 0x1e, 0xc6, 0x2e, 0x17, 0xb2, 0x19, 0xd9,
+	0x1c, 0x92, 0x4a, 0x4d, 0x46, 0x9a, 0x4f, 0x52, 0x97, 0xa4, 0x69, 0x31, 0xdd, 0x23, 0x3c, 0x1c,
+	0x92, 0xeb, 0x16, 0x27, 0xee, 0x5b, 0x9c, 0x76, 0x0f, 0xfc, 0x0b, 0x7b, 0xdd, 0x93, 0xb9, 0x50,
+	0x1c, 0xf7, 0x24, 0x76, 0xcd, 0xfe, 0x15, 0x9c, 0xb6, 0xba, 0xe7, 0xa1, 0xf1, 0x63, 0x29, 0x58,
+	0xd7, 0xee, 0x4d, 0xdf, 0xfb, 0xfb, 0x7e, 0xdf, 0xa3, 0x47, 0xe8, 0x0a, 0x67, 0xac, 0x34, 0xba,
+	0xdd, 0x04, 0x6e, 0xde, 0x2e, 0x3d, 0x76, 0xc1, 0xf1, 0x8a, 0x43, 0x87, 0x72, 0x8a, 0x33, 0x9c,
+	0xb1, 0x62, 0x20, 0xd0, 0x96, 0x3a, 0xb4, 0x43, 0x25, 0xbf, 0x24, 0x7e, 0xf9, 0x2a, 0x5a, 0xbe,
+	0x43, 0x69, 0xa7, 0x0f, 0x25, 0x49, 0x35, 0xdd, 0x76, 0x89, 0x93, 0x01, 0x30, 0x6e, 0x0e, 0x86,
+	0x81, 0xc2, 0x8a, 0x70, 0x0e, 0x07, 0x43, 0xea, 0x70, 0xb0, 0xa2, 0x28, 0xdc, 0x1b, 0x02, 0x0b,
+	0x34, 0xae, 0x0a, 0x0d, 0x4e, 0xdb, 0x76, 0x4c, 0x2c, 0xa8, 0x40, 0x7c, 0x2c, 0xbb, 0xb8, 0xdd,
+	0x6a, 0x8b, 0xb2, 0x01, 0x65, 0xa5, 0x96, 0xe3, 0x0d, 0x39, 0x2d, 0x0d, 0xdc, 0x3e, 0x27, 0x8c,
+	0x74, 0x4a, 0x3d, 0xf0, 0x02, 0x95, 0xc2, 0xf7, 0x53, 0xe8, 0xf2, 0x43, 0x51, 0x50, 0x83, 0x74,
+	0x6c, 0x93, 0xbb, 0x0e, 0xe8, 0xc0, 0x86, 0xd4, 0x66, 0x80, 0x09, 0xba, 0xc8, 0xbb, 0x0e, 0xb0,
+	0x2e, 0xed, 0x5b, 0x06, 0x0b, 0xc5, 0xcb, 0xca, 0x8a, 0x72, 0x3d, 0xb3, 0xf6, 0xf7, 0x62, 0xac,
+	0xf2, 0xe2, 0xd9, 0x1e, 0x8a, 0x7b, 0xa1, 0x79, 0x24, 0xda, 0x4c, 0xe8, 0x98, 0x9f, 0xe2, 0xe2,
+	0x36, 0xc2, 0x61, 0x72, 0xb1, 0x48, 0x49, 0x19, 0xe9, 0x6f, 0x1f, 0x13, 0xe9, 0x41, 0x60, 0x1d,
+	0x0f, 0xb4, 0x38, 0x38, 0xc9, 0xd4, 0xae, 0x21, 0x75, 0x12, 0x74, 0x0e, 0x29, 0x8e, 0xac, 0x46,
+	0xd5, 0x15, 0x47, 0x50, 0x4c, 0x46, 0x54, 0x75, 0x85, 0x69, 0x9f, 0x2b, 0x08, 0x9f, 0xce, 0x1e,
+	0xdf, 0x41, 0x99, 0x11, 0xe5, 0x60, 0x30, 0x6e, 0x72, 0x97, 0x49, 0xe3, 0x85, 0xb5, 0x2b, 0xc7,
+	0x12, 0x7c, 0x44, 0x39, 0x34, 0xa4, 0x58, 0x47, 0xa3, 0xe8, 0x37, 0xde, 0x46, 0xea, 0xc9, 0xc2,
+	0x6e, 0x7e, 0x4c, 0x61, 0x13, 0xce, 0xc4, 0x5e, 0x7b, 0xa9, 0xa0, 0xc5, 0x53, 0x15, 0xe3, 0x7b,
+	0x08, 0x49, 0xfc, 0xe2, 0xb9, 0xe5, 0x65, 0x8c, 0x70, 0xb8, 0xa2, 0x60, 0x0d, 0xd2, 0x09, 0x72,
+	0x54, 0x59, 0xf8, 0x13, 0x37, 0xa4, 0xbd, 0xef, 0x4c, 0x40, 0x91, 0xfa, 0xe4, 0x1c, 0xcb, 0x53,
+	0x87, 0xe3, 0x7c, 0x42, 0x8f, 0xb9, 0x29, 0x4f, 0xa3, 0x14, 0x23, 0x9d, 0xc2, 0xab, 0x29, 0x94,
+	0x95, 0xd6, 0xdb, 0xe0, 0x45, 0x03, 0xd6, 0x40, 0x2a, 0xb4, 0x2c, 0x66, 0x1a, 0x3d, 0xf0, 0x82,
+	0xb1, 0xfa, 0xc3, 0xe9, 0x78, 0x31, 0x8b, 0x62, 0xad, 0x52, 0x6d, 0xac, 0x6f, 0x83, 0x57, 0x9e,
+	0x3b, 0x1a, 0xe7, 0xd3, 0x21, 0xb5, 0x99, 0xd0, 0xd3, 0xd2, 0xd1, 0x36, 0x78, 0xb8, 0x8e, 0xe6,
+	0xa2, 0x51, 0x12, 0x7e, 0x7d, 0xac, 0xff, 0xf8, 0x61, 0xbf, 0x21, 0x98, 0xbe, 0xb3, 0xcc, 0x60,
+	0x42, 0xe2, 0xdb, 0x68, 0xca, 0xa1, 0x7d, 0x58, 0x4e, 0x49, 0x3c, 0xaf, 0x9e, 0x8d, 0xa7, 0xf0,
+	0x45, 0xfb, 0xa0, 0x4b, 0x55, 0x5c, 0x41, 0xc8, 0xa1, 0xdc, 0xe4, 0x60, 0x19, 0x26, 0x5f, 0x9e,
+	0x92, 0x09, 0x68, 0x45, 0xff, 0x0c, 0x14, 0xc3, 0x33, 0x50, 0xdc, 0x0b, 0xcf, 0x40, 0x39, 0x7d,
+	0x38, 0xce, 0x2b, 0xcf, 0xdf, 0xe6, 0x15, 0x5d, 0x0d, 0xec, 0xd6, 0xb9, 0xb6, 0x8a, 0x52, 0x22,
+	0xfc, 0x1c, 0x52, 0x0e, 0xc2, 0x21, 0x3d, 0x10, 0x94, 0x17, 0x0e, 0xa9, 0xa7, 0xfd, 0x1f, 0x45,
+	0x10, 0x9c, 0x63, 0x32, 0xef, 0xa2, 0xd4, 0x04, 0xa7, 0xd5, 0x0f, 0xe3, 0x24, 0xa0, 0xf7, 0x7b,
+	0x2c, 0x6c, 0xb4, 0x36, 0xca, 0xc4, 0x90, 0xc3, 0xbf, 0x41, 0x6a, 0xb4, 0xdb, 0x32, 0x83, 0x94,
+	0x3e, 0x61, 0x4c, 0xe2, 0xa4, 0x3e, 0x35, 0x4e, 0x79, 0x0e, 0xa1, 0xa1, 0xdb, 0xec, 0x93, 0x96,
+	0xe8, 0x68, 0xe1, 0x50, 0x41, 0x97, 0xa4, 0x85, 0x0e, 0x2d, 0x3a, 0x02, 0x27, 0x32, 0xc3, 0x57,
+	0x11, 0x1a, 0x9a, 0x0e, 0xf7, 0x0c, 0x97, 0x58, 0x02, 0x83, 0xd4, 0x75, 0x55, 0x57, 0x25, 0x67,
+	0x9f, 0x58, 0x0c, 0xdf, 0x40, 0xd8, 0x17, 0xb3, 0xae, 0xe9, 0x80, 0xd1, 0xa2, 0xae, 0xcd, 0xfd,
+	0x41, 0x9f, 0xd7, 0xb3, 0x52, 0xd2, 0x10, 0x82, 0x8a, 0xe4, 0x1f, 0xaf, 0x46, 0x74, 0x7f, 0x3e,
+	0x5e, 0x4d, 0x15, 0xcd, 0xf7, 0xc0, 0xeb, 0x80, 0x6d, 0x50, 0x97, 0x0f, 0xdd, 0xb0, 0xcd, 0xfe,
+	0xbe, 0xf9, 0xc7, 0x39, 0x36, 0x1c, 0x1d, 0xb0, 0x77, 0xa4, 0x9a, 0x3e, 0xd7, 0x8b, 0x51, 0x85,
+	0xd7, 0xa9, 0xa0, 0x94, 0x6d, 0xf0, 0x63, 0xc7, 0x76, 0x23, 0xe3, 0x67, 0x49, 0xec, 0x36, 0xf5,
+	0x6b, 0xc9, 0xac, 0xdd, 0x38, 0x13, 0xb5, 0x63, 0x86, 0x45, 0x49, 0x6d, 0xd9, 0x6d, 0x1a, 0x2d,
+	0x63, 0xc8, 0x60, 0xda, 0xdb, 0x24, 0x52, 0x23, 0x39, 0xfe, 0x0f, 0x9a, 0xe9, 0x81, 0x67, 0x10,
+	0xbf, 0x57, 0x6a, 0x79, 0xe3, 0x68, 0x9c, 0x9f, 0xde, 0x06, 0x6f, 0xab, 0xfa, 0x7e, 0x9c, 0xbf,
+	0xdb, 0x21, 0xbc, 0xeb, 0x36, 0x8b, 0x2d, 0x3a, 0x28, 0x99, 0x07, 0xd0, 0x37, 0x1d, 0x1b, 0xf8,
+	0x13, 0xea, 0xf4, 0x02, 0xea, 0x66, 0x8b, 0x3a, 0x50, 0x3a, 0x28, 0xc5, 0xdf, 0xae, 0xa2, 0x34,
+	0xd6, 0xa7, 0x7b, 0xe0, 0x6d, 0x59, 0xf8, 0xd7, 0x48, 0x15, 0xee, 0x5b, 0x5d, 0x93, 0xd8, 0xc1,
+	0xcc, 0xa6, 0x7b, 0xe0, 0x55, 0x04, 0x8d, 0x7f, 0x85, 0xc4, 0x6f, 0x23, 0xda, 0x2c, 0x55, 0x9f,
+	0xed, 0xf9, 0x3b, 0x84, 0xd7, 0xd0, 0x25, 0x66, 0x9b, 0x43, 0xd6, 0xa5, 0xdc, 0x68, 0xf6, 0x69,
+	0xab, 0x67, 0xd8, 0xee, 0xa0, 0x09, 0x8e, 0x44, 0x38, 0xa5, 0x5f, 0x0c, 0x85, 0x65, 0x21, 0xab,
+	0x4b, 0x11, 0xfe, 0x33, 0x5a, 0x1c, 0x99, 0x7d, 0x62, 0x99, 0x9c, 0x3a, 0x86, 0x69, 0x59, 0x0e,
+	0x30, 0xb6, 0x3c, 0x2d, 0xfd, 0x66, 0x23, 0xc1, 0xba, 0xcf, 0xc7, 0xb7, 0xd0, 0x92, 0xed, 0x0e,
+	0x8c, 0x89, 0x81, 0x44, 0x88, 0x2d, 0xcf, 0x48, 0xff, 0xd8, 0x76, 0x07, 0x8f, 0x42, 0x91, 0x04,
+	0x8b, 0xe1, 0xeb, 0x28, 0x2b, 0x2c, 0x38, 0xe5, 0x66, 0x3f, 0xd4, 0x9e, 0x95, 0xda, 0x0b, 0xb6,
+	0x3b, 0xd8, 0x13, 0x6c, 0x5f, 0xb3, 0xa0, 0xa3, 0x55, 0xd9, 0x96, 0x2a, 0x98, 0x2d, 0x4e, 0x46,
+	0x62, 0x97, 0x77, 0x86, 0xe0, 0x08, 0x5f, 0x2c, 0xea, 0xed, 0x4d, 0x84, 0x69, 0xc0, 0x0c, 0x93,
+	0x85, 0x70, 0x5c, 0x17, 0x43, 0xc9, 0x7a, 0x28, 0x28, 0xbc, 0x4e, 0xa2, 0xdf, 0x4a, 0xa7, 0xeb,
+	0xc2, 0x25, 0xec, 0xf4, 0xad, 0x6d, 0xf0, 0x58, 0x94, 0x63, 0xe4, 0xf6, 0xdf, 0x12, 0x70, 0x26,
+	0x27, 0x26, 0x18, 0x98, 0x3b, 0xa7, 0x07, 0xe6, 0xc3, 0x4e, 0x64, 0x0b, 0x27, 0xc3, 0x23, 0x9a,
+	0xc4, 0x04, 0xad, 0xbd, 0x52, 0xd0, 0x6c, 0x20, 0xc3, 0x0d, 0x94, 0x8c, 0x86, 0xa6, 0x72, 0x34,
+	0xce, 0x27, 0xcf, 0x3b, 0x31, 0x49, 0x62, 0xe1, 0x25, 0x34, 0x1d, 0x1f, 0x15, 0x9f, 0xc0, 0x8d,
+	0xd8, 0xf5, 0x9d, 0x2e, 0xff, 0xf3, 0xfd, 0x38, 0xff, 0x8f, 0x9f, 0x18, 0x66, 0x72, 0x9f, 0x0b,
+	0xff, 0x43, 0xda, 0x69, 0x28, 0x22, 0x18, 0xff, 0x8b, 0x66, 0xfd, 0xb5, 0x08, 0x5a, 0x52, 0xbe,
+	0x7f, 0x34, 0xce, 0xcf, 0xc8, 0x44, 0xd9, 0xf9, 0xca, 0x9c, 0x91, 0x8b, 0xc1, 0xa2, 0xf8, 0xb5,
+	0x03, 0x0e, 0x8e, 0x6d, 0xf6, 0x7d, 0xe9, 0x2f, 0x17, 0xbf, 0x13, 0x1c, 0x9d, 0x3a, 0x1c, 0xf0,
+	0x20, 0xf6, 0x63, 0x17, 0x18, 0x9f, 0xf4, 0x40, 0x89, 0xf7, 0xe0, 0x4e, 0x6c, 0x57, 0x93, 0x1f,
+	0xf3, 0x0a, 0x86, 0xab, 0x5c, 0x78, 0x82, 0x2e, 0x9f, 0x0c, 0x14, 0x14, 0xf9, 0xf3, 0xde, 0x1e,
+	0xb1, 0x32, 0xb9, 0xe8, 0x63, 0xe5, 0xa1, 0x0b, 0x2e, 0xec, 0x52, 0x46, 0x38, 0xa1, 0x76, 0x94,
+	0xc1, 0x79, 0xbf, 0x96, 0x7e, 0x8f, 0x16, 0x1c, 0x1f, 0x36, 0x63, 0x40, 0x2d, 0x37, 0xc0, 0x46,
+	0xd5, 0xe7, 0x03, 0xee, 0x03, 0xc9, 0x9c, 0x40, 0x9a, 0x8a, 0x43, 0x7a, 0x0f, 0xa5, 0x87, 0x0e,
+	0xa1, 0x0e, 0xe1, 0x9e, 0x3c, 0x6b, 0x0b, 0x6b, 0x85, 0x1f, 0x0d, 0x6d, 0xef, 0x06, 0x9a, 0x7a,
+	0x64, 0x83, 0x35, 0x94, 0x1e, 0x06, 0x05, 0xc9, 0x33, 0x97, 0xd2, 0x23, 0x5a, 0x3c, 0x82, 0x8f,
+	0x45, 0xc5, 0x06, 0x23, 0x4f, 0x21, 0x38, 0x6a, 0xaa, 0xe4, 0x34, 0xc8, 0x53, 0xc0, 0x7f, 0x45,
+	0x97, 0x81, 0x71, 0x32, 0x90, 0x9f, 0x27, 0x8c, 0x9b, 0x0e, 0x37, 0xba, 0x40, 0x3a, 0x5d, 0x1e,
+	0x5c, 0xb4, 0xa5, 0x48, 0xda, 0x10, 0xc2, 0x4d, 0x29, 0x2b, 0x7c, 0xad, 0xa0, 0x15, 0x09, 0xe8,
+	0x03, 0xc2, 0x9a, 0xd0, 0x35, 0x47, 0x84, 0xba, 0xce, 0x26, 0x61, 0x9c, 0xc6, 0x9e, 0xdf, 0x0a,
+	0x4a, 0xc3, 0x88, 0x58, 0x60, 0xb7, 0x60, 0x59, 0x39, 0xe3, 0x99, 0x8f, 0xdb, 0xd6, 0x02, 0xc5,
+	0xf0, 0xd0, 0x84, 0x86, 0xe2, 0x3a, 0x3b, 0xd0, 0x02, 0x9b, 0x1b, 0xb4, 0xdd, 0x16, 0x1c, 0xff,
+	0x9d, 0x96, 0xe8, 0xa6, 0x74, 0xec, 0xcb, 0x76, 0x7c, 0x91, 0x7c, 0xa9, 0xf1, 0x35, 0x74, 0x81,
+	0xb9, 0x6c, 0x08, 0xb6, 0x05, 0x96, 0xe1, 0xda, 0x9c, 0xf4, 0x25, 0xd8, 0x29, 0x7d, 0x21, 0x62,
+	0xef, 0x0b, 0xee, 0x9f, 0xbe, 0x52, 0x10, 0x9a, 0x7c, 0x04, 0xe1, 0x1b, 0xe8, 0xca, 0xa3, 0x9d,
+	0xbd, 0x9a, 0xd1, 0xd8, 0x5b, 0xdf, 0xdb, 0x6f, 0x18, 0xfb, 0xf5, 0xc6, 0x6e, 0xad, 0xb2, 0xb5,
+	0xb1, 0x55, 0xab, 0x66, 0x13, 0xda, 0x85, 0x67, 0x2f, 0x56, 0x32, 0xfb, 0x36, 0x1b, 0x42, 0x8b,
+	0xb4, 0x09, 0x58, 0xf8, 0x1a, 0xba, 0x14, 0xd7, 0xae, 0xef, 0xec, 0x19, 0x1b, 0x3b, 0xfb, 0xf5,
+	0x6a, 0x56, 0xd1, 0xe6, 0x9e, 0xbd, 0x58, 0x49, 0xd7, 0x29, 0xdf, 0xa0, 0xae, 0x6d, 0xe1, 0xdf,
+	0xa1, 0x8b, 0x71, 0xc5, 0xdd, 0x5a, 0xbd, 0xba, 0x55, 0xbf, 0x9f, 0x4d, 0x6a, 0x99, 0x67, 0x2f,
+	0x56, 0x66, 0x77, 0xc1, 0xb6, 0x88, 0xdd, 0x39, 0xa9, 0x55, 0xad, 0x55, 0xb6, 0xaa, 0xb5, 0x6a,
+	0x36, 0xe5, 0x6b, 0x55, 0xa1, 0x45, 0x2c, 0xb0, 0xb4, 0xf4, 0x67, 0x5f, 0xe4, 0x12, 0x2f, 0xbf,
+	0xcc, 0x29, 0xe5, 0xfa, 0xe1, 0x77, 0xb9, 0xc4, 0xe1, 0x51, 0x4e, 0x79, 0x73, 0x94, 0x53, 0xbe,
+	0x3d, 0xca, 0x29, 0xcf, 0xdf, 0xe5, 0x12, 0x6f, 0xde, 0xe5, 0x12, 0xdf, 0xbc, 0xcb, 0x25, 0xfe,
+	0x75, 0xeb, 0x13, 0x36, 0x46, 0xfe, 0x41, 0x6c, 0xce, 0xc8, 0xef, 0xd0, 0xbf, 0xfc, 0x30, 0x00,
+	0xba, 0xda, 0x25, 0xd2, 0xda, 0x0e, 0x00, 0x00,
 }
 
 func (m *QuerySignatureResponse) Marshal() (dAtA []byte, err error) {
@@ -1773,6 +1875,115 @@ func (m *QueryNextKeyIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySignQueuePositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignQueuePositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignQueuePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EstimatedStartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EstimatedStartHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.QueueSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueueSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Position != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Priority != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RequestModule) > 0 {
+		i -= len(m.RequestModule)
+		copy(dAtA[i:], m.RequestModule)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RequestModule)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SigStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SigStatus))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMisbehaviourHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMisbehaviourHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMisbehaviourHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SuspendedUntil != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SuspendedUntil))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RecentOffenceCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecentOffenceCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2150,6 +2361,59 @@ func (m *QueryNextKeyIDResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySignQueuePositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SigStatus != 0 {
+		n += 1 + sovQuery(uint64(m.SigStatus))
+	}
+	l = len(m.RequestModule)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovQuery(uint64(m.Priority))
+	}
+	if m.Position != 0 {
+		n += 1 + sovQuery(uint64(m.Position))
+	}
+	if m.QueueSize != 0 {
+		n += 1 + sovQuery(uint64(m.QueueSize))
+	}
+	if m.EstimatedStartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EstimatedStartHeight))
+	}
+	return n
+}
+
+func (m *QueryMisbehaviourHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.RecentOffenceCount != 0 {
+		n += 1 + sovQuery(uint64(m.RecentOffenceCount))
+	}
+	if m.SuspendedUntil != 0 {
+		n += 1 + sovQuery(uint64(m.SuspendedUntil))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4270,6 +4534,337 @@ func (m *QueryNextKeyIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySignQueuePositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignQueuePositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignQueuePositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigStatus", wireType)
+			}
+			m.SigStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigStatus |= exported.SigStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestModule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestModule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= exported.SignPriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueSize", wireType)
+			}
+			m.QueueSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedStartHeight", wireType)
+			}
+			m.EstimatedStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMisbehaviourHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMisbehaviourHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMisbehaviourHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = append(m.Evidence, MisbehaviourEvidence{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentOffenceCount", wireType)
+			}
+			m.RecentOffenceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecentOffenceCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedUntil", wireType)
+			}
+			m.SuspendedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuspendedUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -34,43 +34,47 @@ func init() { proto.RegisterFile("tss/v1beta1/service.proto", fileDescriptor_604
 func init() { golang_proto.RegisterFile("tss/v1beta1/service.proto", fileDescriptor_604dc337414bd075) }
 
 var fileDescriptor_604dc337414bd075 = []byte{
-	// 565 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xc1, 0x6e, 0xd3, 0x4e,
-	0x10, 0xc6, 0xe3, 0xbf, 0xf4, 0x6f, 0xd5, 0xed, 0xcd, 0x4a, 0x55, 0x1a, 0x8a, 0x5b, 0x2c, 0x51,
-	0xa0, 0x6a, 0x62, 0x5a, 0x6e, 0x1c, 0x2b, 0x21, 0x21, 0x95, 0xa2, 0x52, 0x03, 0x07, 0x6e, 0x6b,
-	0x6b, 0xba, 0x59, 0x35, 0xf5, 0x26, 0x3b, 0xe3, 0x10, 0x0b, 0x21, 0x04, 0x4f, 0x80, 0xc4, 0x89,
-	0xb7, 0xe1, 0xc8, 0xb1, 0x12, 0x17, 0x8e, 0x28, 0xe1, 0x41, 0x90, 0x9d, 0x75, 0x62, 0x1b, 0x07,
-	0x72, 0x4b, 0xe6, 0xfb, 0x76, 0x7e, 0xdf, 0x8c, 0x57, 0xcb, 0xb6, 0x08, 0xd1, 0x1b, 0x1e, 0x06,
-	0x40, 0xfc, 0xd0, 0x43, 0xd0, 0x43, 0x19, 0x42, 0xa7, 0xaf, 0x15, 0x29, 0x7b, 0x9d, 0x10, 0x3b,
-	0x46, 0x6a, 0x35, 0x85, 0x12, 0x2a, 0xab, 0x7b, 0xe9, 0xaf, 0xa9, 0xa5, 0xb5, 0x2d, 0x94, 0x12,
-	0x3d, 0xf0, 0x78, 0x5f, 0x7a, 0x3c, 0x8a, 0x14, 0x71, 0x92, 0x2a, 0x42, 0xa3, 0x6e, 0x61, 0xc4,
-	0xfb, 0xd8, 0x55, 0x34, 0x03, 0xd0, 0xc8, 0x48, 0xcd, 0x22, 0x76, 0x56, 0xdd, 0x2c, 0x56, 0x07,
-	0x31, 0xe8, 0x64, 0x2a, 0x1c, 0x7d, 0x58, 0x63, 0xec, 0x14, 0x85, 0x3f, 0xcd, 0x67, 0x7f, 0xb1,
-	0x58, 0xf3, 0x1c, 0x84, 0x44, 0x02, 0xfd, 0x78, 0x44, 0xa0, 0x23, 0xde, 0x3b, 0x81, 0x04, 0xed,
-	0x7b, 0x9d, 0x42, 0xe6, 0x4e, 0x9d, 0xe5, 0x1c, 0x06, 0x31, 0x20, 0xb5, 0xee, 0x2f, 0xe1, 0xc4,
-	0xbe, 0x8a, 0x10, 0xdc, 0x83, 0x8f, 0xdf, 0x7f, 0x7d, 0xfe, 0x6f, 0xcf, 0xbd, 0xed, 0xf1, 0x11,
-	0xf4, 0xb8, 0xf6, 0xd2, 0x94, 0xda, 0x9c, 0x68, 0x83, 0x39, 0xd2, 0xbe, 0x84, 0xe4, 0x91, 0xb5,
-	0x6f, 0xf7, 0xd8, 0xda, 0x13, 0xe0, 0x9a, 0x8e, 0x81, 0x93, 0x7d, 0xab, 0x44, 0x99, 0xd5, 0xf3,
-	0x10, 0xce, 0x22, 0xd9, 0x90, 0x77, 0x33, 0x72, 0xcb, 0xdd, 0x28, 0x92, 0xbb, 0xa9, 0x2d, 0x00,
-	0x4e, 0x29, 0x8d, 0xd8, 0xba, 0x4f, 0x5c, 0xd3, 0x09, 0x24, 0x02, 0x22, 0x7b, 0xa7, 0xd4, 0xb0,
-	0xa0, 0xe4, 0xc4, 0xdd, 0xc5, 0x06, 0xc3, 0x74, 0x33, 0xe6, 0xb6, 0xbb, 0x59, 0x64, 0xe2, 0xdc,
-	0x98, 0x52, 0x91, 0x35, 0xcf, 0xb4, 0x0a, 0x01, 0x71, 0x5a, 0x7b, 0xa1, 0xf9, 0xc5, 0x85, 0x0c,
-	0x2b, 0xeb, 0xaf, 0xb3, 0xd4, 0xaf, 0xbf, 0xde, 0x69, 0x02, 0xad, 0x64, 0x81, 0x1a, 0xf6, 0x80,
-	0xad, 0x9d, 0xa7, 0x17, 0x0c, 0x4e, 0x20, 0xa9, 0x2c, 0x76, 0x56, 0xaf, 0x5f, 0x6c, 0x41, 0x36,
-	0x3d, 0xef, 0x64, 0x3d, 0x77, 0xdc, 0x56, 0x71, 0x48, 0x8e, 0x28, 0x45, 0xe4, 0xbd, 0x0d, 0xbb,
-	0x5c, 0x46, 0xef, 0xd2, 0x39, 0x5f, 0x32, 0xf6, 0x4a, 0x11, 0x9c, 0xc5, 0x41, 0xca, 0x2c, 0x37,
-	0x9d, 0x0b, 0x39, 0x74, 0x67, 0xa1, 0x5e, 0x99, 0xe4, 0x8a, 0xd9, 0x66, 0x62, 0x5f, 0x8a, 0xd9,
-	0xf2, 0xf6, 0xea, 0x56, 0x52, 0x30, 0xe4, 0x98, 0xbb, 0xff, 0xf4, 0x55, 0x70, 0x4f, 0xd9, 0x6a,
-	0x1a, 0xc6, 0x97, 0xc2, 0xbe, 0xf9, 0x47, 0x44, 0x5f, 0x8a, 0xbc, 0xf1, 0x76, 0xbd, 0x58, 0xe9,
-	0x36, 0x64, 0x1b, 0x7e, 0x1c, 0x5c, 0x49, 0x3a, 0x8d, 0x7b, 0x24, 0x51, 0x8a, 0xe9, 0x90, 0x68,
-	0x97, 0x3f, 0x69, 0xad, 0x27, 0x27, 0xed, 0x2f, 0x63, 0xad, 0x70, 0xdf, 0xb3, 0x1b, 0x65, 0x63,
-	0x3a, 0x32, 0xa7, 0x58, 0x03, 0xda, 0x07, 0x7f, 0xe9, 0x37, 0xb7, 0xe5, 0xf4, 0xf6, 0x92, 0xee,
-	0x72, 0x80, 0xa3, 0x55, 0xf6, 0xff, 0xf3, 0xf4, 0x49, 0x3a, 0x7e, 0xf6, 0x6d, 0xec, 0x58, 0xd7,
-	0x63, 0xc7, 0xfa, 0x39, 0x76, 0xac, 0x4f, 0x13, 0xa7, 0xf1, 0x75, 0xe2, 0x58, 0xd7, 0x13, 0xa7,
-	0xf1, 0x63, 0xe2, 0x34, 0x5e, 0x3f, 0x10, 0x92, 0xba, 0x71, 0xd0, 0x09, 0xd5, 0x95, 0xb9, 0x5c,
-	0x11, 0xd0, 0x1b, 0xa5, 0x2f, 0xcd, 0xbf, 0x76, 0xa8, 0x34, 0x78, 0xa3, 0xec, 0xc6, 0x51, 0xd2,
-	0x07, 0x0c, 0x56, 0xb2, 0x37, 0xee, 0xe1, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfc, 0xd4, 0xde,
-	0xa8, 0x8b, 0x05, 0x00, 0x00,
+	// 633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xc1, 0x4f, 0x13, 0x4f,
+	0x14, 0xc7, 0xdb, 0x5f, 0xf2, 0x83, 0x30, 0xf5, 0xb4, 0x29, 0x01, 0x2a, 0x2c, 0xb0, 0x46, 0x54,
+	0x42, 0xbb, 0x82, 0x37, 0x8f, 0x24, 0x26, 0x26, 0x88, 0xc1, 0x56, 0x8d, 0xf1, 0x36, 0x6d, 0x1e,
+	0xd3, 0x09, 0x65, 0xa7, 0xcc, 0x7b, 0x5b, 0xdb, 0x18, 0x63, 0xe2, 0x5f, 0x60, 0xe2, 0xc9, 0xff,
+	0xc6, 0xa3, 0x17, 0x13, 0x12, 0x2f, 0x1e, 0x0d, 0xf5, 0x0f, 0x31, 0xb3, 0x9d, 0x2d, 0xbb, 0xcb,
+	0x14, 0xb9, 0xc1, 0xfb, 0x7e, 0xf6, 0x7d, 0xbf, 0xef, 0xed, 0x6b, 0xcb, 0x56, 0x08, 0x31, 0x1c,
+	0xec, 0xb6, 0x81, 0xf8, 0x6e, 0x88, 0xa0, 0x07, 0xb2, 0x03, 0x8d, 0xbe, 0x56, 0xa4, 0xbc, 0x0a,
+	0x21, 0x36, 0xac, 0x54, 0xab, 0x0a, 0x25, 0x54, 0x52, 0x0f, 0xcd, 0x5f, 0x13, 0xa4, 0xb6, 0x2a,
+	0x94, 0x12, 0x3d, 0x08, 0x79, 0x5f, 0x86, 0x3c, 0x8a, 0x14, 0x71, 0x92, 0x2a, 0x42, 0xab, 0xae,
+	0x60, 0xc4, 0xfb, 0xd8, 0x55, 0x34, 0x35, 0xa0, 0xa1, 0x95, 0xaa, 0x59, 0xdb, 0x69, 0x75, 0x29,
+	0x5b, 0x3d, 0x8b, 0x41, 0x8f, 0x26, 0xc2, 0xde, 0x8f, 0x0a, 0x63, 0x87, 0x28, 0x5a, 0x93, 0x7c,
+	0xde, 0xd7, 0x32, 0xab, 0x36, 0x41, 0x48, 0x24, 0xd0, 0x4f, 0x86, 0x04, 0x3a, 0xe2, 0xbd, 0x03,
+	0x18, 0xa1, 0x77, 0xbf, 0x91, 0xc9, 0xdc, 0x70, 0x21, 0x4d, 0x38, 0x8b, 0x01, 0xa9, 0xf6, 0xe0,
+	0x06, 0x24, 0xf6, 0x55, 0x84, 0x10, 0xec, 0x7c, 0xfa, 0xf9, 0xe7, 0xcb, 0x7f, 0x5b, 0xc1, 0x66,
+	0xc8, 0x87, 0xd0, 0xe3, 0x3a, 0x34, 0x29, 0xb5, 0x7d, 0xa2, 0x0e, 0xf6, 0x91, 0xfa, 0x09, 0x8c,
+	0x1e, 0x97, 0xb7, 0xbd, 0x1e, 0x5b, 0x78, 0x0a, 0x5c, 0xd3, 0x3e, 0x70, 0xf2, 0xd6, 0x72, 0x2e,
+	0xd3, 0x7a, 0x1a, 0xc2, 0x9f, 0x25, 0x5b, 0xe7, 0x8d, 0xc4, 0xb9, 0x16, 0x2c, 0x66, 0x9d, 0xbb,
+	0x06, 0x6b, 0x03, 0x27, 0xe3, 0x46, 0xac, 0xd2, 0x22, 0xae, 0xe9, 0x00, 0x46, 0x02, 0x22, 0x6f,
+	0x3d, 0xd7, 0x30, 0xa3, 0xa4, 0x8e, 0x1b, 0xb3, 0x01, 0xeb, 0x19, 0x24, 0x9e, 0xab, 0xc1, 0x52,
+	0xd6, 0x13, 0x2f, 0x41, 0xe3, 0x8a, 0xac, 0x7a, 0xa4, 0x55, 0x07, 0x10, 0x27, 0xb5, 0x97, 0x9a,
+	0x1f, 0x1f, 0xcb, 0x4e, 0x61, 0xfd, 0x2e, 0xc4, 0xbd, 0x7e, 0x37, 0x69, 0x03, 0xcd, 0x25, 0x81,
+	0x4a, 0xde, 0x90, 0xdd, 0x4a, 0xf2, 0x36, 0x01, 0xbb, 0x5c, 0x83, 0xe7, 0x18, 0xc5, 0x4a, 0xa9,
+	0xc9, 0xe6, 0x35, 0x84, 0x6d, 0x7e, 0x27, 0x69, 0xbe, 0x16, 0x2c, 0x5f, 0x99, 0xd6, 0x92, 0x66,
+	0xdc, 0x01, 0x5b, 0xb4, 0x09, 0x6d, 0x31, 0x9d, 0xd7, 0x39, 0x45, 0x9e, 0x49, 0xb3, 0x6c, 0xdf,
+	0x04, 0x2d, 0x4c, 0xfc, 0x86, 0x55, 0x5e, 0x2b, 0x82, 0x74, 0xe0, 0xfc, 0xcb, 0xcd, 0x28, 0xee,
+	0x97, 0x9b, 0x03, 0x0a, 0x9d, 0xcf, 0xd8, 0x42, 0xd3, 0x7c, 0x58, 0xe1, 0x00, 0x46, 0x85, 0x23,
+	0x9d, 0xd6, 0xdd, 0x47, 0x9a, 0x91, 0x6d, 0xcf, 0xbb, 0x49, 0xcf, 0xf5, 0xa0, 0x96, 0x5d, 0x21,
+	0x47, 0x94, 0x22, 0x0a, 0xdf, 0x77, 0xba, 0x5c, 0x46, 0x1f, 0xcc, 0x12, 0x5f, 0x31, 0x66, 0x12,
+	0x1d, 0xc5, 0x6d, 0xe3, 0xe9, 0x5f, 0x89, 0x3a, 0x11, 0x52, 0xd3, 0xf5, 0x99, 0x7a, 0x61, 0x92,
+	0x53, 0xe6, 0xd9, 0x65, 0xb6, 0xa4, 0x98, 0x1e, 0xe2, 0x96, 0x6b, 0xdb, 0x19, 0x20, 0xb5, 0xb9,
+	0xf7, 0x4f, 0xae, 0x60, 0xf7, 0x8c, 0xcd, 0x9b, 0x30, 0x2d, 0x29, 0xbc, 0xdb, 0x57, 0x22, 0xb6,
+	0xa4, 0x48, 0x1b, 0xaf, 0xba, 0xc5, 0x42, 0xb7, 0x01, 0x5b, 0x6c, 0xc5, 0xed, 0x53, 0x49, 0x87,
+	0x71, 0x8f, 0x24, 0x4a, 0x31, 0x19, 0x12, 0x0b, 0x87, 0xe5, 0x64, 0xdc, 0x87, 0x35, 0x03, 0x2d,
+	0xf8, 0x7e, 0x64, 0xcb, 0x79, 0xd0, 0x8c, 0xcc, 0x29, 0xd6, 0x80, 0xde, 0xce, 0x35, 0xfd, 0x2e,
+	0xb1, 0xd4, 0xbd, 0x7e, 0x43, 0x3a, 0x1f, 0x60, 0x6f, 0x9e, 0xfd, 0xff, 0xc2, 0x7c, 0xbd, 0xef,
+	0x3f, 0xff, 0x7e, 0xe1, 0x97, 0xcf, 0x2f, 0xfc, 0xf2, 0xef, 0x0b, 0xbf, 0xfc, 0x79, 0xec, 0x97,
+	0xbe, 0x8d, 0xfd, 0xf2, 0xf9, 0xd8, 0x2f, 0xfd, 0x1a, 0xfb, 0xa5, 0xb7, 0x0f, 0x85, 0xa4, 0x6e,
+	0xdc, 0x6e, 0x74, 0xd4, 0xa9, 0x3d, 0xae, 0x08, 0xe8, 0x9d, 0xd2, 0x27, 0xf6, 0xbf, 0x7a, 0x47,
+	0x69, 0x08, 0x87, 0xc9, 0xc5, 0xd1, 0xa8, 0x0f, 0xd8, 0x9e, 0x4b, 0x7e, 0x2f, 0x1e, 0xfd, 0x1d,
+	0x00, 0xae, 0x60, 0x9f, 0x65, 0xd7, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -89,6 +93,9 @@ type MsgServiceClient interface {
 	HeartBeat(ctx context.Context, in *HeartBeatRequest, opts ...grpc.CallOption) (*HeartBeatResponse, error)
 	StartKeygen(ctx context.Context, in *StartKeygenRequest, opts ...grpc.CallOption) (*StartKeygenResponse, error)
 	ProcessKeygenTraffic(ctx context.Context, in *ProcessKeygenTrafficRequest, opts ...grpc.CallOption) (*ProcessKeygenTrafficResponse, error)
+	StartReshare(ctx context.Context, in *StartReshareRequest, opts ...grpc.CallOption) (*StartReshareResponse, error)
+	ProcessReshareTraffic(ctx context.Context, in *ProcessReshareTrafficRequest, opts ...grpc.CallOption) (*ProcessReshareTrafficResponse, error)
+	VoteReshare(ctx context.Context, in *VoteReshareRequest, opts ...grpc.CallOption) (*VoteReshareResponse, error)
 	RotateKey(ctx context.Context, in *RotateKeyRequest, opts ...grpc.CallOption) (*RotateKeyResponse, error)
 	VotePubKey(ctx context.Context, in *VotePubKeyRequest, opts ...grpc.CallOption) (*VotePubKeyResponse, error)
 	ProcessSignTraffic(ctx context.Context, in *ProcessSignTrafficRequest, opts ...grpc.CallOption) (*ProcessSignTrafficResponse, error)
@@ -141,6 +148,33 @@ func (c *msgServiceClient) ProcessKeygenTraffic(ctx context.Context, in *Process
 	return out, nil
 }
 
+func (c *msgServiceClient) StartReshare(ctx context.Context, in *StartReshareRequest, opts ...grpc.CallOption) (*StartReshareResponse, error) {
+	out := new(StartReshareResponse)
+	err := c.cc.Invoke(ctx, "/tss.v1beta1.MsgService/StartReshare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) ProcessReshareTraffic(ctx context.Context, in *ProcessReshareTrafficRequest, opts ...grpc.CallOption) (*ProcessReshareTrafficResponse, error) {
+	out := new(ProcessReshareTrafficResponse)
+	err := c.cc.Invoke(ctx, "/tss.v1beta1.MsgService/ProcessReshareTraffic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) VoteReshare(ctx context.Context, in *VoteReshareRequest, opts ...grpc.CallOption) (*VoteReshareResponse, error) {
+	out := new(VoteReshareResponse)
+	err := c.cc.Invoke(ctx, "/tss.v1beta1.MsgService/VoteReshare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) RotateKey(ctx context.Context, in *RotateKeyRequest, opts ...grpc.CallOption) (*RotateKeyResponse, error) {
 	out := new(RotateKeyResponse)
 	err := c.cc.Invoke(ctx, "/tss.v1beta1.MsgService/RotateKey", in, out, opts...)
@@ -201,6 +235,9 @@ type MsgServiceServer interface {
 	HeartBeat(context.Context, *HeartBeatRequest) (*HeartBeatResponse, error)
 	StartKeygen(context.Context, *StartKeygenRequest) (*StartKeygenResponse, error)
 	ProcessKeygenTraffic(context.Context, *ProcessKeygenTrafficRequest) (*ProcessKeygenTrafficResponse, error)
+	StartReshare(context.Context, *StartReshareRequest) (*StartReshareResponse, error)
+	ProcessReshareTraffic(context.Context, *ProcessReshareTrafficRequest) (*ProcessReshareTrafficResponse, error)
+	VoteReshare(context.Context, *VoteReshareRequest) (*VoteReshareResponse, error)
 	RotateKey(context.Context, *RotateKeyRequest) (*RotateKeyResponse, error)
 	VotePubKey(context.Context, *VotePubKeyRequest) (*VotePubKeyResponse, error)
 	ProcessSignTraffic(context.Context, *ProcessSignTrafficRequest) (*ProcessSignTrafficResponse, error)
@@ -225,6 +262,15 @@ func (*UnimplementedMsgServiceServer) StartKeygen(ctx context.Context, req *Star
 func (*UnimplementedMsgServiceServer) ProcessKeygenTraffic(ctx context.Context, req *ProcessKeygenTrafficRequest) (*ProcessKeygenTrafficResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessKeygenTraffic not implemented")
 }
+func (*UnimplementedMsgServiceServer) StartReshare(ctx context.Context, req *StartReshareRequest) (*StartReshareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartReshare not implemented")
+}
+func (*UnimplementedMsgServiceServer) ProcessReshareTraffic(ctx context.Context, req *ProcessReshareTrafficRequest) (*ProcessReshareTrafficResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessReshareTraffic not implemented")
+}
+func (*UnimplementedMsgServiceServer) VoteReshare(ctx context.Context, req *VoteReshareRequest) (*VoteReshareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteReshare not implemented")
+}
 func (*UnimplementedMsgServiceServer) RotateKey(ctx context.Context, req *RotateKeyRequest) (*RotateKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RotateKey not implemented")
 }
@@ -320,6 +366,60 @@ func _MsgService_ProcessKeygenTraffic_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_StartReshare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartReshareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).StartReshare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tss.v1beta1.MsgService/StartReshare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).StartReshare(ctx, req.(*StartReshareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_ProcessReshareTraffic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessReshareTrafficRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ProcessReshareTraffic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tss.v1beta1.MsgService/ProcessReshareTraffic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ProcessReshareTraffic(ctx, req.(*ProcessReshareTrafficRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_VoteReshare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteReshareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).VoteReshare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tss.v1beta1.MsgService/VoteReshare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).VoteReshare(ctx, req.(*VoteReshareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_RotateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RotateKeyRequest)
 	if err := dec(in); err != nil {
@@ -448,6 +548,18 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessKeygenTraffic",
 			Handler:    _MsgService_ProcessKeygenTraffic_Handler,
 		},
+		{
+			MethodName: "StartReshare",
+			Handler:    _MsgService_StartReshare_Handler,
+		},
+		{
+			MethodName: "ProcessReshareTraffic",
+			Handler:    _MsgService_ProcessReshareTraffic_Handler,
+		},
+		{
+			MethodName: "VoteReshare",
+			Handler:    _MsgService_VoteReshare_Handler,
+		},
 		{
 			MethodName: "RotateKey",
 			Handler:    _MsgService_RotateKey_Handler,
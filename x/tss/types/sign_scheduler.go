@@ -0,0 +1,144 @@
+package types
+
+import (
+	"sort"
+
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+)
+
+const defaultSignQueueWeight = 1
+
+// QueuedSign is a sign request waiting in the sign queue
+type QueuedSign struct {
+	Info exported.SignInfo
+	// Position is the position of the request in the sign queue
+	Position uint64
+	// Shares is the number of sign shares the request occupies while signing
+	Shares int64
+}
+
+// SignScheduler decides the order in which queued sign requests start.
+// Requests with higher priority always go first. Among requests of the same priority,
+// the sign shares are shared between classes of requests (request module and chain) according to their weights.
+// Requests of the same class and priority start in queue order
+type SignScheduler struct {
+	weights map[signClass]int64
+	active  map[signClass]int64
+	queued  map[signClass][]QueuedSign
+}
+
+// NewSignScheduler returns a new SignScheduler with the given weights
+func NewSignScheduler(weights []SignQueueWeight) *SignScheduler {
+	scheduler := &SignScheduler{
+		weights: make(map[signClass]int64),
+		active:  make(map[signClass]int64),
+		queued:  make(map[signClass][]QueuedSign),
+	}
+
+	for _, weight := range weights {
+		scheduler.weights[weight.class()] = weight.Weight
+	}
+
+	return scheduler
+}
+
+// AddActive registers the shares of a sign request that is in progress
+func (s *SignScheduler) AddActive(info exported.SignInfo, shares int64) {
+	s.active[newSignClass(info.RequestModule, info.Chain)] += shares
+}
+
+// RemoveActive releases the shares of a sign request that is no longer in progress
+func (s *SignScheduler) RemoveActive(info exported.SignInfo, shares int64) {
+	s.active[newSignClass(info.RequestModule, info.Chain)] -= shares
+}
+
+// Enqueue adds a sign request waiting to start. Requests must be added in queue order
+func (s *SignScheduler) Enqueue(sign QueuedSign) {
+	class := newSignClass(sign.Info.RequestModule, sign.Info.Chain)
+	queued := append(s.queued[class], sign)
+	sort.SliceStable(queued, func(i, j int) bool {
+		return queued[i].Info.Priority.IsHigherThan(queued[j].Info.Priority)
+	})
+
+	s.queued[class] = queued
+}
+
+// Len returns the number of sign requests waiting to start
+func (s *SignScheduler) Len() int {
+	var length int
+	for _, queued := range s.queued {
+		length += len(queued)
+	}
+
+	return length
+}
+
+// Peek returns the sign request that should start next
+func (s *SignScheduler) Peek() (QueuedSign, bool) {
+	class, ok := s.nextClass()
+	if !ok {
+		return QueuedSign{}, false
+	}
+
+	return s.queued[class][0], true
+}
+
+// Pop removes the sign request that should start next and registers its shares as active
+func (s *SignScheduler) Pop() (QueuedSign, bool) {
+	class, ok := s.nextClass()
+	if !ok {
+		return QueuedSign{}, false
+	}
+
+	next := s.queued[class][0]
+	s.queued[class] = s.queued[class][1:]
+	if len(s.queued[class]) == 0 {
+		delete(s.queued, class)
+	}
+	s.active[class] += next.Shares
+
+	return next, true
+}
+
+func (s *SignScheduler) nextClass() (signClass, bool) {
+	var next signClass
+	found := false
+
+	// ties are broken by the queue position, so the result does not depend on the map iteration order
+	for class, queued := range s.queued {
+		if !found || s.isAhead(class, queued[0], next, s.queued[next][0]) {
+			next = class
+			found = true
+		}
+	}
+
+	return next, found
+}
+
+func (s *SignScheduler) isAhead(class signClass, sign QueuedSign, otherClass signClass, other QueuedSign) bool {
+	if sign.Info.Priority != other.Info.Priority {
+		return sign.Info.Priority.IsHigherThan(other.Info.Priority)
+	}
+
+	// compare active/weight between both classes without losing precision
+	usage := s.active[class] * s.getWeight(otherClass)
+	otherUsage := s.active[otherClass] * s.getWeight(class)
+	if usage != otherUsage {
+		return usage < otherUsage
+	}
+
+	return sign.Position < other.Position
+}
+
+func (s *SignScheduler) getWeight(class signClass) int64 {
+	if weight, ok := s.weights[class]; ok {
+		return weight
+	}
+
+	// fall back to the weight for all chains of the request module
+	if weight, ok := s.weights[newSignClass(class.requestModule, "")]; ok {
+		return weight
+	}
+
+	return defaultSignQueueWeight
+}
@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+func TestSignScheduler(t *testing.T) {
+	newSign := func(module string, chain string, priority exported.SignPriority, position uint64) types.QueuedSign {
+		return types.QueuedSign{
+			Info: exported.SignInfo{
+				SigID:         rand.Str(10),
+				RequestModule: module,
+				Chain:         chain,
+				Priority:      priority,
+			},
+			Position: position,
+			Shares:   10,
+		}
+	}
+
+	popAll := func(scheduler *types.SignScheduler) []uint64 {
+		var positions []uint64
+		for next, ok := scheduler.Pop(); ok; next, ok = scheduler.Pop() {
+			positions = append(positions, next.Position)
+		}
+
+		return positions
+	}
+
+	t.Run("should start signs with higher priority first", func(t *testing.T) {
+		scheduler := types.NewSignScheduler(nil)
+		scheduler.Enqueue(newSign("evm", "Ethereum", exported.SignPriority_Low, 0))
+		scheduler.Enqueue(newSign("evm", "Ethereum", exported.SignPriority_Normal, 1))
+		scheduler.Enqueue(newSign("bitcoin", "Bitcoin", exported.SignPriority_High, 2))
+		scheduler.Enqueue(newSign("evm", "Ethereum", exported.SignPriority_High, 3))
+
+		assert.Equal(t, 4, scheduler.Len())
+		assert.Equal(t, []uint64{2, 3, 1, 0}, popAll(scheduler))
+		assert.Equal(t, 0, scheduler.Len())
+	})
+
+	t.Run("should alternate between classes instead of draining the queue in order", func(t *testing.T) {
+		scheduler := types.NewSignScheduler(nil)
+		for i := uint64(0); i < 4; i++ {
+			scheduler.Enqueue(newSign("evm", "Ethereum", exported.SignPriority_Normal, i))
+		}
+		scheduler.Enqueue(newSign("evm", "Avalanche", exported.SignPriority_Normal, 4))
+		scheduler.Enqueue(newSign("bitcoin", "Bitcoin", exported.SignPriority_Normal, 5))
+
+		assert.Equal(t, []uint64{0, 4, 5, 1, 2, 3}, popAll(scheduler))
+	})
+
+	t.Run("should account for signs in progress", func(t *testing.T) {
+		scheduler := types.NewSignScheduler(nil)
+		scheduler.AddActive(exported.SignInfo{RequestModule: "evm", Chain: "Ethereum"}, 10)
+		scheduler.Enqueue(newSign("evm", "Ethereum", exported.SignPriority_Normal, 0))
+		scheduler.Enqueue(newSign("bitcoin", "Bitcoin", exported.SignPriority_Normal, 1))
+
+		next, ok := scheduler.Peek()
+		assert.True(t, ok)
+		assert.Equal(t, uint64(1), next.Position)
+
+		scheduler.RemoveActive(exported.SignInfo{RequestModule: "evm", Chain: "Ethereum"}, 10)
+		next, ok = scheduler.Peek()
+		assert.True(t, ok)
+		assert.Equal(t, uint64(0), next.Position)
+	})
+
+	t.Run("should share signs according to weights", func(t *testing.T) {
+		scheduler := types.NewSignScheduler([]types.SignQueueWeight{
+			{RequestModule: "bitcoin", Weight: 2},
+			{RequestModule: "evm", Chain: "ethereum", Weight: 1},
+		})
+		for i := uint64(0); i < 3; i++ {
+			scheduler.Enqueue(newSign("evm", "Ethereum", exported.SignPriority_Normal, i))
+		}
+		for i := uint64(3); i < 7; i++ {
+			scheduler.Enqueue(newSign("bitcoin", "Bitcoin", exported.SignPriority_Normal, i))
+		}
+
+		assert.Equal(t, []uint64{0, 3, 4, 1, 5, 6, 2}, popAll(scheduler))
+	})
+}
@@ -105,6 +105,82 @@ func (m *StartKeygenResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StartKeygenResponse proto.InternalMessageInfo
 
+// StartReshareRequest redistributes the shares of an existing key to the
+// current validator set without changing its public key
+type StartReshareRequest struct {
+	Sender github_com_cosmos_cosmos_sdk_types.AccAddress             `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	KeyID  github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
+}
+
+func (m *StartReshareRequest) Reset()         { *m = StartReshareRequest{} }
+func (m *StartReshareRequest) String() string { return proto.CompactTextString(m) }
+func (*StartReshareRequest) ProtoMessage()    {}
+func (*StartReshareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58d13e1023e3ffaf, []int{2}
+}
+func (m *StartReshareRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartReshareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartReshareRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StartReshareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartReshareRequest.Merge(m, src)
+}
+func (m *StartReshareRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartReshareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartReshareRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartReshareRequest proto.InternalMessageInfo
+
+type StartReshareResponse struct {
+}
+
+func (m *StartReshareResponse) Reset()         { *m = StartReshareResponse{} }
+func (m *StartReshareResponse) String() string { return proto.CompactTextString(m) }
+func (*StartReshareResponse) ProtoMessage()    {}
+func (*StartReshareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58d13e1023e3ffaf, []int{3}
+}
+func (m *StartReshareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartReshareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartReshareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StartReshareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartReshareResponse.Merge(m, src)
+}
+func (m *StartReshareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartReshareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartReshareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartReshareResponse proto.InternalMessageInfo
+
 type RotateKeyRequest struct {
 	Sender  github_com_cosmos_cosmos_sdk_types.AccAddress             `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
 	Chain   string                                                    `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
@@ -116,7 +192,7 @@ func (m *RotateKeyRequest) Reset()         { *m = RotateKeyRequest{} }
 func (m *RotateKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*RotateKeyRequest) ProtoMessage()    {}
 func (*RotateKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_58d13e1023e3ffaf, []int{2}
+	return fileDescriptor_58d13e1023e3ffaf, []int{4}
 }
 func (m *RotateKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -152,7 +228,7 @@ func (m *RotateKeyResponse) Reset()         { *m = RotateKeyResponse{} }
 func (m *RotateKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*RotateKeyResponse) ProtoMessage()    {}
 func (*RotateKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_58d13e1023e3ffaf, []int{3}
+	return fileDescriptor_58d13e1023e3ffaf, []int{5}
 }
 func (m *RotateKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -192,7 +268,7 @@ func (m *ProcessKeygenTrafficRequest) Reset()         { *m = ProcessKeygenTraffi
 func (m *ProcessKeygenTrafficRequest) String() string { return proto.CompactTextString(m) }
 func (*ProcessKeygenTrafficRequest) ProtoMessage()    {}
 func (*ProcessKeygenTrafficRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_58d13e1023e3ffaf, []int{4}
+	return fileDescriptor_58d13e1023e3ffaf, []int{6}
 }
 func (m *ProcessKeygenTrafficRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,7 +304,7 @@ func (m *ProcessKeygenTrafficResponse) Reset()         { *m = ProcessKeygenTraff
 func (m *ProcessKeygenTrafficResponse) String() string { return proto.CompactTextString(m) }
 func (*ProcessKeygenTrafficResponse) ProtoMessage()    {}
 func (*ProcessKeygenTrafficResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_58d13e1023e3ffaf, []int{5}
+	return fileDescriptor_58d13e1023e3ffaf, []int{7}
 }
 func (m *ProcessKeygenTrafficResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -257,6 +333,82 @@ func (m *ProcessKeygenTrafficResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProcessKeygenTrafficResponse proto.InternalMessageInfo
 
+// ProcessReshareTrafficRequest protocol message
+type ProcessReshareTrafficRequest struct {
+	Sender    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	SessionID string                                        `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Payload   *tofnd.TrafficOut                             `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *ProcessReshareTrafficRequest) Reset()         { *m = ProcessReshareTrafficRequest{} }
+func (m *ProcessReshareTrafficRequest) String() string { return proto.CompactTextString(m) }
+func (*ProcessReshareTrafficRequest) ProtoMessage()    {}
+func (*ProcessReshareTrafficRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58d13e1023e3ffaf, []int{8}
+}
+func (m *ProcessReshareTrafficRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProcessReshareTrafficRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProcessReshareTrafficRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProcessReshareTrafficRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessReshareTrafficRequest.Merge(m, src)
+}
+func (m *ProcessReshareTrafficRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProcessReshareTrafficRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessReshareTrafficRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessReshareTrafficRequest proto.InternalMessageInfo
+
+type ProcessReshareTrafficResponse struct {
+}
+
+func (m *ProcessReshareTrafficResponse) Reset()         { *m = ProcessReshareTrafficResponse{} }
+func (m *ProcessReshareTrafficResponse) String() string { return proto.CompactTextString(m) }
+func (*ProcessReshareTrafficResponse) ProtoMessage()    {}
+func (*ProcessReshareTrafficResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58d13e1023e3ffaf, []int{9}
+}
+func (m *ProcessReshareTrafficResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProcessReshareTrafficResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProcessReshareTrafficResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProcessReshareTrafficResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessReshareTrafficResponse.Merge(m, src)
+}
+func (m *ProcessReshareTrafficResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProcessReshareTrafficResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessReshareTrafficResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessReshareTrafficResponse proto.InternalMessageInfo
+
 // ProcessSignTrafficRequest protocol message
 type ProcessSignTrafficRequest struct {
 	Sender    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
@@ -268,7 +420,7 @@ func (m *ProcessSignTrafficRequest) Reset()         { *m = ProcessSignTrafficReq
 func (m *ProcessSignTrafficRequest) String() string { return proto.CompactTextString(m) }
 func (*ProcessSignTrafficRequest) ProtoMessage()    {}
 func (*ProcessSignTrafficRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_58d13e1023e3ffaf, []int{6}
+	return fileDescriptor_58d13e1023e3ffaf, []int{10}
 }
 func (m *ProcessSignTrafficRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -304,7 +456,7 @@ func (m *ProcessSignTrafficResponse) Reset()         { *m = ProcessSignTrafficRe
 func (m *ProcessSignTrafficResponse) String() string { return proto.CompactTextString(m) }
 func (*ProcessSignTrafficResponse) ProtoMessage()    {}
 func (*ProcessSignTrafficResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_58d13e1023e3ffaf, []int{7}
+	return fileDescriptor_58d13e1023e3ffaf, []int{11}
 }
 func (m *ProcessSignTrafficResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,7 +496,7 @@ func (m *VotePubKeyRequest) Reset()         { *m = VotePubKeyRequest{} }
 func (m *VotePubKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*VotePubKeyRequest) ProtoMessage()    {}
 func (*VotePubKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_58d13e1023e3ffaf, []int{8}
+	return fileDescriptor_58d13e1023e3ffaf, []int{12}
 }
 func (m *VotePubKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,7 +533,7 @@ func (m *VotePubKeyResponse) Reset()         { *m = VotePubKeyResponse{} }
 func (m *VotePubKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*VotePubKeyResponse) ProtoMessage()    {}
 func (*VotePubKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_58d13e1023e3ffaf, []int{9}
+	return fileDescriptor_58d13e1023e3ffaf, []int{13}
 }
 func (m *VotePubKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -410,6 +562,84 @@ func (m *VotePubKeyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VotePubKeyResponse proto.InternalMessageInfo
 
+// VoteReshareRequest represents the message to vote on the outcome of a
+// reshare
+type VoteReshareRequest struct {
+	Sender  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	PollKey exported1.PollKey                             `protobuf:"bytes,2,opt,name=poll_key,json=pollKey,proto3" json:"poll_key"`
+	Result  *tofnd.MessageOut_KeygenResult                `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *VoteReshareRequest) Reset()         { *m = VoteReshareRequest{} }
+func (m *VoteReshareRequest) String() string { return proto.CompactTextString(m) }
+func (*VoteReshareRequest) ProtoMessage()    {}
+func (*VoteReshareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58d13e1023e3ffaf, []int{14}
+}
+func (m *VoteReshareRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteReshareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteReshareRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteReshareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteReshareRequest.Merge(m, src)
+}
+func (m *VoteReshareRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteReshareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteReshareRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteReshareRequest proto.InternalMessageInfo
+
+type VoteReshareResponse struct {
+	Log string `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+}
+
+func (m *VoteReshareResponse) Reset()         { *m = VoteReshareResponse{} }
+func (m *VoteReshareResponse) String() string { return proto.CompactTextString(m) }
+func (*VoteReshareResponse) ProtoMessage()    {}
+func (*VoteReshareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58d13e1023e3ffaf, []int{15}
+}
+func (m *VoteReshareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteReshareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteReshareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteReshareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteReshareResponse.Merge(m, src)
+}
+func (m *VoteReshareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteReshareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteReshareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteReshareResponse proto.InternalMessageInfo
+
 // VoteSigRequest represents a message to vote for a signature
 type VoteSigRequest struct {
 	Sender  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
@@ -421,7 +651,7 @@ func (m *VoteSigRequest) Reset()         { *m = VoteSigRequest{} }
 func (m *VoteSigRequest) String() string { return proto.CompactTextString(m) }
 func (*VoteSigRequest) ProtoMessage()    {}
 func (*VoteSigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_58d13e1023e3ffaf, []int{10}
+	return fileDescriptor_58d13e1023e3ffaf, []int{16}
 }
 func (m *VoteSigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -458,7 +688,7 @@ func (m *VoteSigResponse) Reset()         { *m = VoteSigResponse{} }
 func (m *VoteSigResponse) String() string { return proto.CompactTextString(m) }
 func (*VoteSigResponse) ProtoMessage()    {}
 func (*VoteSigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_58d13e1023e3ffaf, []int{11}
+	return fileDescriptor_58d13e1023e3ffaf, []int{17}
 }
 func (m *VoteSigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -496,7 +726,7 @@ func (m *HeartBeatRequest) Reset()         { *m = HeartBeatRequest{} }
 func (m *HeartBeatRequest) String() string { return proto.CompactTextString(m) }
 func (*HeartBeatRequest) ProtoMessage()    {}
 func (*HeartBeatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_58d13e1023e3ffaf, []int{12}
+	return fileDescriptor_58d13e1023e3ffaf, []int{18}
 }
 func (m *HeartBeatRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -534,7 +764,7 @@ func (m *HeartBeatResponse) Reset()         { *m = HeartBeatResponse{} }
 func (m *HeartBeatResponse) String() string { return proto.CompactTextString(m) }
 func (*HeartBeatResponse) ProtoMessage()    {}
 func (*HeartBeatResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_58d13e1023e3ffaf, []int{13}
+	return fileDescriptor_58d13e1023e3ffaf, []int{19}
 }
 func (m *HeartBeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -573,7 +803,7 @@ func (m *RegisterExternalKeysRequest) Reset()         { *m = RegisterExternalKey
 func (m *RegisterExternalKeysRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisterExternalKeysRequest) ProtoMessage()    {}
 func (*RegisterExternalKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_58d13e1023e3ffaf, []int{14}
+	return fileDescriptor_58d13e1023e3ffaf, []int{20}
 }
 func (m *RegisterExternalKeysRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -613,7 +843,7 @@ func (m *RegisterExternalKeysRequest_ExternalKey) Reset() {
 func (m *RegisterExternalKeysRequest_ExternalKey) String() string { return proto.CompactTextString(m) }
 func (*RegisterExternalKeysRequest_ExternalKey) ProtoMessage()    {}
 func (*RegisterExternalKeysRequest_ExternalKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_58d13e1023e3ffaf, []int{14, 0}
+	return fileDescriptor_58d13e1023e3ffaf, []int{20, 0}
 }
 func (m *RegisterExternalKeysRequest_ExternalKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,7 +879,7 @@ func (m *RegisterExternalKeysResponse) Reset()         { *m = RegisterExternalKe
 func (m *RegisterExternalKeysResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterExternalKeysResponse) ProtoMessage()    {}
 func (*RegisterExternalKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_58d13e1023e3ffaf, []int{15}
+	return fileDescriptor_58d13e1023e3ffaf, []int{21}
 }
 func (m *RegisterExternalKeysResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +918,7 @@ func (m *SubmitMultisigPubKeysRequest) Reset()         { *m = SubmitMultisigPubK
 func (m *SubmitMultisigPubKeysRequest) String() string { return proto.CompactTextString(m) }
 func (*SubmitMultisigPubKeysRequest) ProtoMessage()    {}
 func (*SubmitMultisigPubKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_58d13e1023e3ffaf, []int{16}
+	return fileDescriptor_58d13e1023e3ffaf, []int{22}
 }
 func (m *SubmitMultisigPubKeysRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -724,7 +954,7 @@ func (m *SubmitMultisigPubKeysResponse) Reset()         { *m = SubmitMultisigPub
 func (m *SubmitMultisigPubKeysResponse) String() string { return proto.CompactTextString(m) }
 func (*SubmitMultisigPubKeysResponse) ProtoMessage()    {}
 func (*SubmitMultisigPubKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_58d13e1023e3ffaf, []int{17}
+	return fileDescriptor_58d13e1023e3ffaf, []int{23}
 }
 func (m *SubmitMultisigPubKeysResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -763,7 +993,7 @@ func (m *SubmitMultisigSignaturesRequest) Reset()         { *m = SubmitMultisigS
 func (m *SubmitMultisigSignaturesRequest) String() string { return proto.CompactTextString(m) }
 func (*SubmitMultisigSignaturesRequest) ProtoMessage()    {}
 func (*SubmitMultisigSignaturesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_58d13e1023e3ffaf, []int{18}
+	return fileDescriptor_58d13e1023e3ffaf, []int{24}
 }
 func (m *SubmitMultisigSignaturesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -799,7 +1029,7 @@ func (m *SubmitMultisigSignaturesResponse) Reset()         { *m = SubmitMultisig
 func (m *SubmitMultisigSignaturesResponse) String() string { return proto.CompactTextString(m) }
 func (*SubmitMultisigSignaturesResponse) ProtoMessage()    {}
 func (*SubmitMultisigSignaturesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_58d13e1023e3ffaf, []int{19}
+	return fileDescriptor_58d13e1023e3ffaf, []int{25}
 }
 func (m *SubmitMultisigSignaturesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -831,14 +1061,20 @@ var xxx_messageInfo_SubmitMultisigSignaturesResponse proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*StartKeygenRequest)(nil), "tss.v1beta1.StartKeygenRequest")
 	proto.RegisterType((*StartKeygenResponse)(nil), "tss.v1beta1.StartKeygenResponse")
+	proto.RegisterType((*StartReshareRequest)(nil), "tss.v1beta1.StartReshareRequest")
+	proto.RegisterType((*StartReshareResponse)(nil), "tss.v1beta1.StartReshareResponse")
 	proto.RegisterType((*RotateKeyRequest)(nil), "tss.v1beta1.RotateKeyRequest")
 	proto.RegisterType((*RotateKeyResponse)(nil), "tss.v1beta1.RotateKeyResponse")
 	proto.RegisterType((*ProcessKeygenTrafficRequest)(nil), "tss.v1beta1.ProcessKeygenTrafficRequest")
 	proto.RegisterType((*ProcessKeygenTrafficResponse)(nil), "tss.v1beta1.ProcessKeygenTrafficResponse")
+	proto.RegisterType((*ProcessReshareTrafficRequest)(nil), "tss.v1beta1.ProcessReshareTrafficRequest")
+	proto.RegisterType((*ProcessReshareTrafficResponse)(nil), "tss.v1beta1.ProcessReshareTrafficResponse")
 	proto.RegisterType((*ProcessSignTrafficRequest)(nil), "tss.v1beta1.ProcessSignTrafficRequest")
 	proto.RegisterType((*ProcessSignTrafficResponse)(nil), "tss.v1beta1.ProcessSignTrafficResponse")
 	proto.RegisterType((*VotePubKeyRequest)(nil), "tss.v1beta1.VotePubKeyRequest")
 	proto.RegisterType((*VotePubKeyResponse)(nil), "tss.v1beta1.VotePubKeyResponse")
+	proto.RegisterType((*VoteReshareRequest)(nil), "tss.v1beta1.VoteReshareRequest")
+	proto.RegisterType((*VoteReshareResponse)(nil), "tss.v1beta1.VoteReshareResponse")
 	proto.RegisterType((*VoteSigRequest)(nil), "tss.v1beta1.VoteSigRequest")
 	proto.RegisterType((*VoteSigResponse)(nil), "tss.v1beta1.VoteSigResponse")
 	proto.RegisterType((*HeartBeatRequest)(nil), "tss.v1beta1.HeartBeatRequest")
@@ -855,70 +1091,73 @@ func init() {
 func init() { proto.RegisterFile("tss/v1beta1/tx.proto", fileDescriptor_58d13e1023e3ffaf) }
 
 var fileDescriptor_58d13e1023e3ffaf = []byte{
-	// 996 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0x6b, 0x77, 0x9b, 0x6e, 0x5e, 0xda, 0xa5, 0x75, 0x8b, 0x36, 0x74, 0x5b, 0x3b, 0x6b,
-	0x24, 0x14, 0x21, 0x9a, 0xd0, 0x02, 0x02, 0x4e, 0xb0, 0xa1, 0xfc, 0x08, 0xd5, 0xee, 0x56, 0x0e,
-	0xda, 0x03, 0x12, 0x0a, 0x4e, 0xfc, 0xe2, 0x8e, 0xe2, 0x7a, 0xcc, 0xcc, 0x78, 0x89, 0xc5, 0x11,
-	0x71, 0xe3, 0xc0, 0x01, 0x71, 0xe7, 0x1f, 0xe0, 0x5f, 0xe0, 0xda, 0x0b, 0x62, 0x8f, 0x1c, 0x50,
-	0x04, 0xe9, 0x7f, 0xd1, 0x13, 0x1a, 0x7b, 0x92, 0x3a, 0x4b, 0x5a, 0x81, 0xaa, 0x20, 0xf6, 0x64,
-	0xcf, 0xf3, 0xf3, 0xcc, 0xfb, 0x7e, 0x66, 0xde, 0x9b, 0x07, 0x9b, 0x82, 0xf3, 0xfa, 0xe3, 0xbd,
-	0x0e, 0x0a, 0x77, 0xaf, 0x2e, 0x06, 0xb5, 0x88, 0x51, 0x41, 0x8d, 0x92, 0xe0, 0xbc, 0xa6, 0xac,
-	0x5b, 0x9b, 0x3e, 0xf5, 0x69, 0x6a, 0xaf, 0xcb, 0xb7, 0xcc, 0x65, 0xab, 0x22, 0x7f, 0xc4, 0x41,
-	0x44, 0x99, 0x40, 0xef, 0x62, 0x86, 0x24, 0x42, 0xae, 0x3c, 0x6e, 0x4f, 0x4d, 0x9d, 0xfb, 0xb0,
-	0x23, 0x3f, 0x08, 0xda, 0x0b, 0x73, 0xff, 0xc9, 0x91, 0xfa, 0x7c, 0xf7, 0x31, 0x15, 0x78, 0xf5,
-	0xd4, 0x77, 0xbb, 0x94, 0x9f, 0x50, 0x5e, 0xef, 0xb2, 0x24, 0x12, 0xb4, 0x7e, 0x12, 0x07, 0x82,
-	0x70, 0xe2, 0xd7, 0xfb, 0x98, 0x28, 0x17, 0xfb, 0x07, 0x0d, 0x8c, 0x96, 0x70, 0x99, 0x38, 0xc4,
-	0xc4, 0xc7, 0xd0, 0xc1, 0x2f, 0x62, 0xe4, 0xc2, 0x68, 0x42, 0x81, 0x63, 0xe8, 0x21, 0x2b, 0x6b,
-	0x15, 0xad, 0x5a, 0x6c, 0xec, 0x9d, 0x0f, 0xad, 0x5d, 0x9f, 0x88, 0xe3, 0xb8, 0x53, 0xeb, 0xd2,
-	0x93, 0xfa, 0x78, 0xe2, 0xf4, 0xb1, 0xcb, 0xbd, 0xbe, 0x5a, 0xf7, 0x5e, 0xb7, 0x7b, 0xcf, 0xf3,
-	0x18, 0x72, 0xee, 0xa8, 0x09, 0x8c, 0x37, 0xe0, 0x66, 0x1f, 0x93, 0x36, 0x09, 0x7b, 0xb4, 0xac,
-	0x57, 0xb4, 0x6a, 0x69, 0x7f, 0xb3, 0x96, 0xe3, 0x56, 0x3b, 0xc4, 0xa4, 0x19, 0xf6, 0x68, 0xe3,
-	0xc6, 0xe9, 0xd0, 0x5a, 0x70, 0x96, 0xfb, 0xd9, 0xd0, 0x7e, 0x1e, 0x36, 0xa6, 0xe2, 0xe2, 0x11,
-	0x0d, 0x39, 0xda, 0xdf, 0xea, 0xb0, 0xe6, 0x50, 0xe1, 0x0a, 0x3c, 0xc4, 0x64, 0x76, 0xb4, 0x2b,
-	0xd7, 0x89, 0x76, 0x13, 0x96, 0xba, 0xc7, 0x2e, 0x09, 0xd3, 0x50, 0x8b, 0x4e, 0x36, 0x30, 0xde,
-	0xca, 0x34, 0x30, 0x1a, 0x60, 0x79, 0xb1, 0xa2, 0x55, 0x6f, 0xed, 0xef, 0xa4, 0x1a, 0xc6, 0xf4,
-	0xf3, 0x62, 0x1c, 0x1a, 0x60, 0x2a, 0x43, 0xbe, 0x18, 0x9f, 0x41, 0x21, 0x55, 0xef, 0x95, 0x6f,
-	0xa4, 0x20, 0x3f, 0x18, 0x0d, 0xad, 0x25, 0x29, 0xf9, 0xe0, 0x7c, 0x68, 0xbd, 0x9d, 0x8b, 0xd1,
-	0x1d, 0x60, 0xe0, 0xb2, 0x10, 0xc5, 0x97, 0x94, 0xf5, 0xd5, 0x68, 0xb7, 0x4b, 0x19, 0xd6, 0x07,
-	0xf5, 0xfc, 0x19, 0x4a, 0x79, 0x1d, 0x38, 0x4b, 0x92, 0x93, 0x67, 0x6f, 0xc0, 0x7a, 0x8e, 0x86,
-	0x62, 0xf4, 0xab, 0x06, 0x77, 0x8e, 0x18, 0xed, 0x22, 0xe7, 0x19, 0xbd, 0x4f, 0x98, 0xdb, 0xeb,
-	0x91, 0xee, 0x1c, 0x70, 0xbd, 0x02, 0xc0, 0x91, 0x73, 0x42, 0x43, 0x29, 0x31, 0x65, 0xd6, 0x58,
-	0x1d, 0x0d, 0xad, 0x62, 0x2b, 0xb3, 0x36, 0x0f, 0x9c, 0xa2, 0x72, 0x68, 0x7a, 0xc6, 0x9b, 0xb0,
-	0x1c, 0xb9, 0x49, 0x40, 0x5d, 0x2f, 0xa5, 0x58, 0x52, 0x14, 0xb3, 0x53, 0x3d, 0x46, 0xa8, 0x82,
-	0x7d, 0x18, 0x0b, 0x67, 0xec, 0x6d, 0x9b, 0xb0, 0x3d, 0x5b, 0x90, 0x52, 0xfc, 0x8b, 0x06, 0x2f,
-	0x28, 0x87, 0x16, 0xf1, 0x9f, 0x7d, 0xbd, 0xdb, 0xb0, 0x35, 0x4b, 0x8e, 0x52, 0x7b, 0xa6, 0xc1,
-	0xfa, 0x23, 0x2a, 0xf0, 0x28, 0xee, 0xcc, 0x27, 0x09, 0xde, 0x81, 0x9b, 0x11, 0x0d, 0x82, 0x76,
-	0x1f, 0x13, 0x95, 0xb2, 0x66, 0x4d, 0x56, 0x9b, 0xbf, 0x9f, 0xf7, 0x23, 0x1a, 0x04, 0x87, 0x98,
-	0x8c, 0x93, 0x37, 0xca, 0x86, 0x46, 0x03, 0x0a, 0x0c, 0x79, 0x1c, 0x08, 0xa5, 0xfb, 0xe5, 0x19,
-	0xba, 0xef, 0x23, 0xe7, 0xae, 0x8f, 0x0f, 0x63, 0x51, 0x9b, 0xe4, 0x78, 0x1c, 0x08, 0x47, 0xfd,
-	0x69, 0xbf, 0x04, 0x46, 0x5e, 0x64, 0xa6, 0xdd, 0x58, 0x83, 0xc5, 0x80, 0xfa, 0x59, 0x55, 0x72,
-	0xe4, 0xab, 0x3d, 0xd4, 0xe0, 0x96, 0x74, 0x6c, 0x11, 0xff, 0xff, 0x88, 0xe2, 0xdd, 0xa7, 0x50,
-	0x54, 0xaf, 0x46, 0x21, 0xf7, 0xfb, 0x29, 0x10, 0x2f, 0xc2, 0x73, 0x13, 0x7d, 0x97, 0x52, 0xf8,
-	0x59, 0x83, 0xb5, 0x8f, 0xd0, 0x65, 0xa2, 0x81, 0xae, 0x98, 0x03, 0x87, 0xcf, 0x61, 0x39, 0xab,
-	0x63, 0xbc, 0xac, 0x57, 0x16, 0xab, 0xc5, 0xc6, 0x87, 0xa3, 0xa1, 0x55, 0x48, 0x6b, 0x11, 0xbf,
-	0x5e, 0x25, 0x2b, 0xa4, 0x95, 0x8c, 0xdb, 0x3f, 0xea, 0xb0, 0x9e, 0x53, 0xa0, 0x94, 0x7e, 0xad,
-	0xc1, 0x46, 0x3f, 0x3d, 0x1e, 0x6d, 0x12, 0x04, 0xe8, 0x93, 0x0e, 0x09, 0x88, 0x48, 0x52, 0x41,
-	0x4b, 0x0d, 0xe7, 0x7c, 0x68, 0x3d, 0xf8, 0x87, 0x4b, 0xf3, 0xd0, 0x8d, 0xf8, 0x31, 0x15, 0x17,
-	0xeb, 0x3f, 0x72, 0x03, 0xe2, 0xb9, 0x82, 0xb2, 0x66, 0x6e, 0x66, 0xc7, 0xc8, 0x96, 0xcb, 0xdb,
-	0x8c, 0x6f, 0x34, 0xd8, 0xe4, 0xc4, 0x0f, 0x49, 0xe8, 0x4f, 0x87, 0xa1, 0xcf, 0x2d, 0x8c, 0x0d,
-	0xb5, 0x5e, 0xde, 0x68, 0xff, 0xae, 0xc3, 0x1d, 0x07, 0x7d, 0xc2, 0x05, 0xb2, 0xf7, 0x07, 0x02,
-	0x59, 0xe8, 0xca, 0x43, 0xc6, 0xff, 0xb3, 0x8b, 0xb0, 0x0d, 0xab, 0xa8, 0xd6, 0x95, 0x29, 0xc1,
-	0xcb, 0x8b, 0x95, 0xc5, 0x6a, 0x69, 0xff, 0xf5, 0xa9, 0x1b, 0xfd, 0x8a, 0x08, 0x6b, 0x39, 0x9b,
-	0xca, 0x94, 0x15, 0xcc, 0xb9, 0x6d, 0x7d, 0x05, 0xa5, 0x9c, 0x8b, 0xd1, 0x02, 0x9d, 0x78, 0xaa,
-	0x07, 0x79, 0x6f, 0x34, 0xb4, 0xf4, 0xeb, 0xde, 0x9b, 0x3a, 0xf1, 0x8c, 0xdb, 0xb0, 0x1c, 0xc5,
-	0x9d, 0x49, 0x4a, 0xaf, 0x38, 0x85, 0x28, 0x2d, 0x32, 0xf2, 0x9a, 0x99, 0x1d, 0xbb, 0x2a, 0xbc,
-	0xdf, 0xeb, 0xb0, 0xdd, 0x8a, 0x3b, 0x27, 0x44, 0xdc, 0x57, 0xad, 0x54, 0x56, 0x9d, 0xe6, 0xc1,
-	0xff, 0xa2, 0x71, 0xd0, 0xe7, 0xd0, 0x38, 0x18, 0x1f, 0xc3, 0x2a, 0x27, 0xbe, 0x64, 0xd0, 0x8e,
-	0x5c, 0xc2, 0xc6, 0x1b, 0x59, 0x99, 0xdd, 0xd6, 0xb4, 0x88, 0x7f, 0x88, 0xc9, 0x91, 0x4b, 0x98,
-	0xda, 0xb4, 0x12, 0x9f, 0x58, 0xb8, 0x6d, 0xc1, 0xce, 0x25, 0x54, 0x14, 0xb7, 0x9f, 0x34, 0xb0,
-	0xa6, 0x3d, 0x64, 0x99, 0x73, 0x45, 0xcc, 0x70, 0x1e, 0xe8, 0x2a, 0x50, 0x90, 0xda, 0x26, 0xe8,
-	0x8a, 0x12, 0x5d, 0x8b, 0xf8, 0x52, 0x3d, 0x27, 0x7e, 0xd3, 0x33, 0x4c, 0x00, 0x3e, 0x89, 0x20,
-	0x95, 0xbe, 0xe2, 0xe4, 0x2c, 0xb6, 0x0d, 0x95, 0xcb, 0xe3, 0xcd, 0x44, 0x35, 0x1e, 0x9c, 0xfe,
-	0x69, 0x2e, 0x9c, 0x8e, 0x4c, 0xed, 0xc9, 0xc8, 0xd4, 0xfe, 0x18, 0x99, 0xda, 0x77, 0x67, 0xe6,
-	0xc2, 0x93, 0x33, 0x73, 0xe1, 0xb7, 0x33, 0x73, 0xe1, 0xd3, 0x57, 0xff, 0xc5, 0x0e, 0xa5, 0x42,
-	0x3a, 0x85, 0xb4, 0x21, 0x7f, 0xed, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x15, 0xaa, 0x5b, 0xda,
-	0x6b, 0x0c, 0x00, 0x00,
+	// 1044 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0xe3, 0xc4,
+	0x17, 0xaf, 0xd3, 0x6d, 0xba, 0x79, 0x69, 0xf7, 0xdb, 0xba, 0xf9, 0xb2, 0xa5, 0xdb, 0xda, 0x59,
+	0x23, 0x41, 0x84, 0x68, 0x42, 0x0b, 0x08, 0x38, 0xc1, 0x86, 0xf2, 0x23, 0x54, 0xbb, 0x5b, 0x39,
+	0x68, 0x0f, 0x48, 0xa8, 0x4c, 0xe2, 0x57, 0x77, 0x14, 0xd7, 0x63, 0x66, 0xc6, 0x4b, 0x2d, 0x8e,
+	0x88, 0x1b, 0x07, 0x0e, 0x88, 0x3b, 0xff, 0x00, 0xff, 0xc2, 0x5e, 0x7b, 0x41, 0xf4, 0xc8, 0x01,
+	0x45, 0x90, 0xf2, 0x57, 0xf4, 0x84, 0xc6, 0x9e, 0xa6, 0xce, 0x92, 0x56, 0xa0, 0x2a, 0x68, 0x7b,
+	0x8a, 0xe7, 0xf9, 0xf9, 0xcd, 0xe7, 0xf3, 0x79, 0x33, 0xef, 0xbd, 0x40, 0x45, 0x0a, 0xd1, 0x78,
+	0xbc, 0xd1, 0x41, 0x49, 0x36, 0x1a, 0xf2, 0xb0, 0x1e, 0x71, 0x26, 0x99, 0x59, 0x96, 0x42, 0xd4,
+	0xb5, 0x75, 0xa5, 0xe2, 0x33, 0x9f, 0xa5, 0xf6, 0x86, 0x7a, 0xca, 0x5c, 0x56, 0xaa, 0xea, 0x43,
+	0x3c, 0x8c, 0x18, 0x97, 0xe8, 0x9d, 0x47, 0x48, 0x22, 0x14, 0xda, 0xe3, 0xf6, 0x48, 0xe8, 0xdc,
+	0x8b, 0x35, 0xf5, 0x42, 0xb2, 0xbd, 0x30, 0xf7, 0x9d, 0x5a, 0xe9, 0xd7, 0x77, 0x1f, 0x33, 0x89,
+	0x97, 0x87, 0xbe, 0xdb, 0x65, 0xe2, 0x80, 0x89, 0x46, 0x97, 0x27, 0x91, 0x64, 0x8d, 0x83, 0x38,
+	0x90, 0x54, 0x50, 0xbf, 0xd1, 0xc3, 0x44, 0xbb, 0x38, 0x3f, 0x18, 0x60, 0xb6, 0x25, 0xe1, 0x72,
+	0x1b, 0x13, 0x1f, 0x43, 0x17, 0xbf, 0x88, 0x51, 0x48, 0xb3, 0x05, 0x45, 0x81, 0xa1, 0x87, 0x7c,
+	0xd9, 0xa8, 0x1a, 0xb5, 0x52, 0x73, 0xe3, 0xb4, 0x6f, 0xaf, 0xfb, 0x54, 0xee, 0xc7, 0x9d, 0x7a,
+	0x97, 0x1d, 0x34, 0xce, 0x02, 0xa7, 0x3f, 0xeb, 0xc2, 0xeb, 0xe9, 0x7d, 0xef, 0x75, 0xbb, 0xf7,
+	0x3c, 0x8f, 0xa3, 0x10, 0xae, 0x0e, 0x60, 0xbe, 0x01, 0x37, 0x7b, 0x98, 0xec, 0xd2, 0x70, 0x8f,
+	0x2d, 0x17, 0xaa, 0x46, 0xad, 0xbc, 0x59, 0xa9, 0xe7, 0x74, 0xab, 0x6f, 0x63, 0xd2, 0x0a, 0xf7,
+	0x58, 0xf3, 0xc6, 0x51, 0xdf, 0x9e, 0x72, 0x67, 0x7b, 0xd9, 0xd2, 0xf9, 0x3f, 0x2c, 0x8d, 0xe0,
+	0x12, 0x11, 0x0b, 0x05, 0x3a, 0x4f, 0x0c, 0x6d, 0x77, 0x51, 0xec, 0x13, 0x8e, 0xe3, 0x01, 0xcf,
+	0x5d, 0x05, 0xf0, 0x67, 0x50, 0x4c, 0x01, 0x7b, 0x29, 0xdc, 0x52, 0xf3, 0x83, 0x41, 0xdf, 0x9e,
+	0x51, 0x28, 0xb7, 0x4e, 0xfb, 0xf6, 0xdb, 0xb9, 0x98, 0xe4, 0x10, 0x03, 0xc2, 0x43, 0x94, 0x5f,
+	0x32, 0xde, 0xd3, 0xab, 0xf5, 0x2e, 0xe3, 0xd8, 0x38, 0x6c, 0xe4, 0xd3, 0x9e, 0x52, 0xdc, 0x72,
+	0x67, 0x14, 0x35, 0xcf, 0x79, 0x0e, 0x2a, 0xa3, 0x04, 0x34, 0xb3, 0x6f, 0x0b, 0xb0, 0xe0, 0x32,
+	0x49, 0x24, 0x6e, 0x63, 0x32, 0x01, 0x5a, 0x15, 0x98, 0xe9, 0xee, 0x13, 0x1a, 0x66, 0xac, 0xdc,
+	0x6c, 0x61, 0xbe, 0x95, 0x65, 0x87, 0xb3, 0x00, 0x97, 0xa7, 0xab, 0x46, 0xed, 0xd6, 0xe6, 0x5a,
+	0x9a, 0x9d, 0x21, 0xf6, 0x5c, 0x9a, 0x5c, 0x16, 0x60, 0x9a, 0x20, 0xf5, 0x90, 0x93, 0xe9, 0xc6,
+	0x24, 0x64, 0x5a, 0x82, 0xc5, 0x9c, 0x1a, 0x5a, 0xa3, 0x5f, 0x0c, 0xb8, 0xb3, 0xc3, 0x59, 0x17,
+	0x85, 0xc8, 0xce, 0xc5, 0x27, 0x9c, 0xec, 0xed, 0xd1, 0xee, 0x04, 0xe4, 0x7a, 0x05, 0x40, 0xa0,
+	0x10, 0x94, 0x85, 0xe7, 0x27, 0x61, 0x7e, 0xd0, 0xb7, 0x4b, 0xed, 0xcc, 0xda, 0xda, 0x72, 0x4b,
+	0xda, 0xa1, 0xe5, 0x99, 0x6f, 0xc2, 0x6c, 0x44, 0x92, 0x80, 0x11, 0x2f, 0x55, 0xb1, 0xac, 0x55,
+	0xcc, 0xee, 0xeb, 0x99, 0x84, 0x1a, 0xec, 0xc3, 0x58, 0xba, 0x67, 0xde, 0x8e, 0x05, 0xab, 0xe3,
+	0x09, 0x69, 0xc6, 0xc7, 0xc6, 0xd0, 0x41, 0x1f, 0x98, 0x6b, 0x4f, 0xd9, 0x86, 0xb5, 0x0b, 0x18,
+	0x69, 0xce, 0x3f, 0x1b, 0xf0, 0xbc, 0xf6, 0x68, 0x53, 0xff, 0xfa, 0xe7, 0x78, 0x15, 0x56, 0xc6,
+	0xd1, 0xd1, 0x6c, 0x4f, 0x0c, 0x58, 0x7c, 0xc4, 0x24, 0xee, 0xc4, 0x9d, 0xc9, 0x5c, 0xfc, 0x77,
+	0xe0, 0x66, 0xc4, 0x82, 0x60, 0xb7, 0x87, 0x89, 0x2e, 0xc0, 0x56, 0x5d, 0xf5, 0x8e, 0xbf, 0xdf,
+	0xf1, 0x1d, 0x16, 0x04, 0xdb, 0x98, 0x9c, 0x95, 0xe2, 0x28, 0x5b, 0x9a, 0x4d, 0x28, 0x72, 0x14,
+	0x71, 0x20, 0x35, 0xef, 0x97, 0xc7, 0xf0, 0xbe, 0x8f, 0x42, 0x10, 0x1f, 0x1f, 0xc6, 0xb2, 0x3e,
+	0xac, 0xd8, 0x71, 0x20, 0x5d, 0xfd, 0xa5, 0xf3, 0x22, 0x98, 0x79, 0x92, 0x19, 0x77, 0x73, 0x01,
+	0xa6, 0x03, 0xe6, 0x67, 0x3d, 0xc6, 0x55, 0x8f, 0xce, 0x9f, 0x46, 0xe6, 0x38, 0xb9, 0xf2, 0xfe,
+	0x4c, 0xc8, 0xf1, 0x12, 0x2c, 0x8d, 0xb0, 0xbc, 0x50, 0x8f, 0xbe, 0x01, 0xb7, 0x94, 0x67, 0x9b,
+	0xfa, 0xcf, 0xa2, 0x16, 0xef, 0x3e, 0xa5, 0x45, 0xed, 0x72, 0x2d, 0xd4, 0xf9, 0x7f, 0x4a, 0x89,
+	0x17, 0xe0, 0x7f, 0x43, 0x7e, 0x17, 0xaa, 0xf0, 0xc4, 0x80, 0x85, 0x8f, 0x90, 0x70, 0xd9, 0x44,
+	0x22, 0x27, 0xa0, 0xc3, 0xe7, 0x30, 0x9b, 0xf5, 0x32, 0xb1, 0x5c, 0xa8, 0x4e, 0xd7, 0x4a, 0xcd,
+	0x0f, 0x07, 0x7d, 0xbb, 0x98, 0xf6, 0x23, 0x71, 0xb5, 0x6e, 0x56, 0x4c, 0xbb, 0x99, 0x70, 0x7e,
+	0x2c, 0xc0, 0x62, 0x8e, 0x81, 0x66, 0xfa, 0xb5, 0x01, 0x4b, 0xbd, 0xf4, 0x7c, 0xec, 0xd2, 0x20,
+	0x40, 0x9f, 0x76, 0x68, 0x40, 0x65, 0x92, 0x12, 0x9a, 0x69, 0xba, 0xa7, 0x7d, 0xfb, 0xc1, 0x3f,
+	0xdc, 0x5a, 0x84, 0x24, 0x12, 0xfb, 0x4c, 0x9e, 0xef, 0xff, 0x88, 0x04, 0xd4, 0x23, 0x92, 0xf1,
+	0x56, 0x2e, 0xb2, 0x6b, 0x66, 0xdb, 0xe5, 0x6d, 0xe6, 0x37, 0x06, 0x54, 0x04, 0xf5, 0x43, 0x1a,
+	0xfa, 0xa3, 0x30, 0x0a, 0x13, 0x83, 0xb1, 0xa4, 0xf7, 0xcb, 0x1b, 0x9d, 0xdf, 0x0a, 0x70, 0xc7,
+	0x45, 0x9f, 0x0a, 0x89, 0xfc, 0xfd, 0x43, 0x89, 0x3c, 0x24, 0xea, 0x90, 0x89, 0xff, 0x6c, 0x18,
+	0xda, 0x85, 0x79, 0xd4, 0xfb, 0xaa, 0x2b, 0x21, 0x96, 0xa7, 0xab, 0xd3, 0xb5, 0xf2, 0xe6, 0xeb,
+	0x23, 0xf3, 0xea, 0x25, 0x08, 0xeb, 0x39, 0x9b, 0xbe, 0x29, 0x73, 0x98, 0x73, 0x5b, 0xf9, 0x0a,
+	0xca, 0x39, 0x17, 0xb3, 0x0d, 0x05, 0xea, 0xe9, 0x09, 0xfb, 0xbd, 0x41, 0xdf, 0x2e, 0x5c, 0x75,
+	0x76, 0x2a, 0x50, 0xcf, 0xbc, 0x0d, 0xb3, 0x51, 0xdc, 0x19, 0x5e, 0xe9, 0x39, 0xb7, 0x18, 0xa5,
+	0x45, 0x57, 0x8d, 0x1a, 0xe3, 0xb1, 0xeb, 0x46, 0xf4, 0x7d, 0x01, 0x56, 0xdb, 0x71, 0xe7, 0x80,
+	0xca, 0xfb, 0xfa, 0x8f, 0x42, 0x56, 0xad, 0xc5, 0xb5, 0x9b, 0xb1, 0xcd, 0x8f, 0x61, 0x5e, 0x50,
+	0x5f, 0x69, 0xb0, 0x1b, 0x11, 0xca, 0xcf, 0x12, 0x59, 0x1d, 0x3f, 0xda, 0xb6, 0xa9, 0xbf, 0x8d,
+	0xc9, 0x0e, 0xa1, 0x5c, 0x27, 0xad, 0x2c, 0x86, 0x16, 0xa1, 0xc6, 0x95, 0x0b, 0x54, 0xd1, 0xba,
+	0xfd, 0x64, 0x80, 0x3d, 0xea, 0xa1, 0xca, 0x1c, 0x91, 0x31, 0xc7, 0x49, 0x48, 0x57, 0x85, 0xa2,
+	0xe2, 0x36, 0x94, 0xae, 0xa4, 0xa4, 0x6b, 0x53, 0x5f, 0xb1, 0x17, 0xd4, 0x6f, 0x79, 0xa6, 0x05,
+	0x20, 0x86, 0x08, 0x52, 0xea, 0x73, 0x6e, 0xce, 0xe2, 0x38, 0x50, 0xbd, 0x18, 0x6f, 0x46, 0xaa,
+	0xf9, 0xe0, 0xe8, 0x0f, 0x6b, 0xea, 0x68, 0x60, 0x19, 0xc7, 0x03, 0xcb, 0xf8, 0x7d, 0x60, 0x19,
+	0xdf, 0x9d, 0x58, 0x53, 0xc7, 0x27, 0xd6, 0xd4, 0xaf, 0x27, 0xd6, 0xd4, 0xa7, 0xaf, 0xfe, 0x8b,
+	0x0c, 0xa5, 0x44, 0x3a, 0xc5, 0xf4, 0xef, 0xe6, 0x6b, 0x7f, 0x0d, 0x00, 0x41, 0x82, 0xf4, 0x67,
+	0x49, 0x0f, 0x00, 0x00,
 }
 
 func (m *StartKeygenRequest) Marshal() (dAtA []byte, err error) {
@@ -984,6 +1223,66 @@ func (m *StartKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StartReshareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartReshareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartReshareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StartReshareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartReshareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartReshareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *RotateKeyRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1128,7 +1427,7 @@ func (m *ProcessKeygenTrafficResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *ProcessSignTrafficRequest) Marshal() (dAtA []byte, err error) {
+func (m *ProcessReshareTrafficRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1138,12 +1437,12 @@ func (m *ProcessSignTrafficRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProcessSignTrafficRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProcessReshareTrafficRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProcessSignTrafficRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProcessReshareTrafficRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1177,7 +1476,7 @@ func (m *ProcessSignTrafficRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *ProcessSignTrafficResponse) Marshal() (dAtA []byte, err error) {
+func (m *ProcessReshareTrafficResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1187,12 +1486,12 @@ func (m *ProcessSignTrafficResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProcessSignTrafficResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProcessReshareTrafficResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProcessSignTrafficResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProcessReshareTrafficResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1200,7 +1499,7 @@ func (m *ProcessSignTrafficResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *VotePubKeyRequest) Marshal() (dAtA []byte, err error) {
+func (m *ProcessSignTrafficRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1210,19 +1509,19 @@ func (m *VotePubKeyRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VotePubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProcessSignTrafficRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VotePubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProcessSignTrafficRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Result != nil {
+	if m.Payload != nil {
 		{
-			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1232,16 +1531,13 @@ func (m *VotePubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	{
-		size, err := m.PollKey.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.SessionID) > 0 {
+		i -= len(m.SessionID)
+		copy(dAtA[i:], m.SessionID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -1252,7 +1548,82 @@ func (m *VotePubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *VotePubKeyResponse) Marshal() (dAtA []byte, err error) {
+func (m *ProcessSignTrafficResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProcessSignTrafficResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProcessSignTrafficResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *VotePubKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotePubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotePubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.PollKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotePubKeyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1282,6 +1653,88 @@ func (m *VotePubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoteReshareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteReshareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteReshareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.PollKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteReshareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteReshareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteReshareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Log) > 0 {
+		i -= len(m.Log)
+		copy(dAtA[i:], m.Log)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Log)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *VoteSigRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1725,6 +2178,32 @@ func (m *StartKeygenResponse) Size() (n int) {
 	return n
 }
 
+func (m *StartReshareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *StartReshareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *RotateKeyRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1788,6 +2267,36 @@ func (m *ProcessKeygenTrafficResponse) Size() (n int) {
 	return n
 }
 
+func (m *ProcessReshareTrafficRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SessionID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ProcessReshareTrafficResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *ProcessSignTrafficRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1850,6 +2359,38 @@ func (m *VotePubKeyResponse) Size() (n int) {
 	return n
 }
 
+func (m *VoteReshareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PollKey.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *VoteReshareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func (m *VoteSigRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2200,7 +2741,7 @@ func (m *StartKeygenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RotateKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *StartReshareRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2223,10 +2764,10 @@ func (m *RotateKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RotateKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartReshareRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RotateKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartReshareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2265,7 +2806,7 @@ func (m *RotateKeyRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2293,20 +2834,186 @@ func (m *RotateKeyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = string(dAtA[iNdEx:postIndex])
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyRole", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.KeyRole = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartReshareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartReshareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartReshareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RotateKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotateKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotateKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRole", wireType)
+			}
+			m.KeyRole = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
 				b := dAtA[iNdEx]
 				iNdEx++
 				m.KeyRole |= exported.KeyRole(b&0x7F) << shift
@@ -2619,7 +3326,7 @@ func (m *ProcessKeygenTrafficResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessSignTrafficRequest) Unmarshal(dAtA []byte) error {
+func (m *ProcessReshareTrafficRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2642,10 +3349,10 @@ func (m *ProcessSignTrafficRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessSignTrafficRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcessReshareTrafficRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessSignTrafficRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcessReshareTrafficRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2771,7 +3478,7 @@ func (m *ProcessSignTrafficRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessSignTrafficResponse) Unmarshal(dAtA []byte) error {
+func (m *ProcessReshareTrafficResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2794,10 +3501,10 @@ func (m *ProcessSignTrafficResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessSignTrafficResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcessReshareTrafficResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessSignTrafficResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcessReshareTrafficResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2821,7 +3528,7 @@ func (m *ProcessSignTrafficResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VotePubKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *ProcessSignTrafficRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2844,10 +3551,10 @@ func (m *VotePubKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VotePubKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcessSignTrafficRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotePubKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcessSignTrafficRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2886,9 +3593,9 @@ func (m *VotePubKeyRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2898,28 +3605,27 @@ func (m *VotePubKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PollKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SessionID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2946,10 +3652,10 @@ func (m *VotePubKeyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &tofnd.MessageOut_KeygenResult{}
+			if m.Payload == nil {
+				m.Payload = &tofnd.TrafficOut{}
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2974,7 +3680,7 @@ func (m *VotePubKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VotePubKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *ProcessSignTrafficResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2997,10 +3703,448 @@ func (m *VotePubKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VotePubKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcessSignTrafficResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotePubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcessSignTrafficResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotePubKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotePubKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotePubKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PollKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &tofnd.MessageOut_KeygenResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotePubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotePubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotePubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Log = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteReshareRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteReshareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteReshareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PollKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &tofnd.MessageOut_KeygenResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteReshareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteReshareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteReshareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
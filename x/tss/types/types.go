@@ -3,9 +3,12 @@ package types
 import (
 	"bytes"
 	"crypto/ecdsa"
+	"crypto/sha256"
 	"fmt"
+	"strings"
 
 	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
 	"github.com/btcsuite/btcd/btcec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -24,6 +27,45 @@ func (m ValidatorStatus) Validate() error {
 	return nil
 }
 
+// Validate validates the MisbehaviourEvidence
+func (m MisbehaviourEvidence) Validate() error {
+	if m.SessionID == "" {
+		return fmt.Errorf("session ID must be set")
+	}
+
+	if err := sdk.VerifyAddressFormat(m.Criminal); err != nil {
+		return err
+	}
+
+	switch m.CrimeType {
+	case tofnd.CRIME_TYPE_MALICIOUS, tofnd.CRIME_TYPE_NON_MALICIOUS:
+	default:
+		return fmt.Errorf("invalid crime type %s", m.CrimeType.String())
+	}
+
+	for _, accuser := range m.Accusers {
+		if err := sdk.VerifyAddressFormat(accuser); err != nil {
+			return err
+		}
+	}
+
+	for _, hash := range m.TrafficHashes {
+		if len(hash) != sha256.Size {
+			return fmt.Errorf("traffic hash must be of length %d", sha256.Size)
+		}
+	}
+
+	if m.Height <= 0 {
+		return fmt.Errorf("height must be >0")
+	}
+
+	if m.OffenceCount <= 0 {
+		return fmt.Errorf("offence count must be >0")
+	}
+
+	return nil
+}
+
 // Validate validates the MultisigInfo
 func (m MultisigInfo) Validate() error {
 	if m.ID == "" {
@@ -83,6 +125,73 @@ func (m KeyRecoveryInfo) Validate() error {
 	return nil
 }
 
+// Validate validates the KeyRotationPolicy
+func (m KeyRotationPolicy) Validate() error {
+	if m.Chain == "" {
+		return fmt.Errorf("chain must be set")
+	}
+
+	if err := m.KeyRole.Validate(); err != nil {
+		return err
+	}
+
+	if m.KeyRole == exported.ExternalKey {
+		return fmt.Errorf("%s keys cannot be rotated automatically", exported.ExternalKey.SimpleString())
+	}
+
+	if m.PeriodInBlocks < 0 {
+		return fmt.Errorf("period must be >=0")
+	}
+
+	if m.HasDriftLimit() {
+		if err := m.MaxSnapshotDrift.Validate(); err != nil {
+			return err
+		}
+
+		if m.MaxSnapshotDrift.Numerator < 0 || m.MaxSnapshotDrift.Numerator > m.MaxSnapshotDrift.Denominator {
+			return fmt.Errorf("max snapshot drift must be >=0 and <=1")
+		}
+	}
+
+	if m.PeriodInBlocks == 0 && !m.HasDriftLimit() {
+		return fmt.Errorf("either period or max snapshot drift must be set")
+	}
+
+	return nil
+}
+
+// HasDriftLimit returns true if the policy rotates keys based on snapshot drift
+func (m KeyRotationPolicy) HasDriftLimit() bool {
+	return m.MaxSnapshotDrift.Numerator != 0 || m.MaxSnapshotDrift.Denominator != 0
+}
+
+// Validate validates the SignQueueWeight
+func (m SignQueueWeight) Validate() error {
+	if m.RequestModule == "" {
+		return fmt.Errorf("request module must be set")
+	}
+
+	if m.Weight <= 0 {
+		return fmt.Errorf("weight must be >0")
+	}
+
+	return nil
+}
+
+func (m SignQueueWeight) class() signClass {
+	return newSignClass(m.RequestModule, m.Chain)
+}
+
+// signClass identifies the sign requests that share the sign queue fairly with other classes
+type signClass struct {
+	requestModule string
+	chain         string
+}
+
+func newSignClass(requestModule string, chain string) signClass {
+	return signClass{requestModule: requestModule, chain: strings.ToLower(chain)}
+}
+
 // MultisigBaseInfo is an interface for multisig base info
 type MultisigBaseInfo interface {
 	HasData(k []byte) bool
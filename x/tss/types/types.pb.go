@@ -5,8 +5,10 @@ package types
 
 import (
 	fmt "fmt"
+	utils "github.com/axelarnetwork/axelar-core/utils"
 	exported "github.com/axelarnetwork/axelar-core/x/tss/exported"
 	github_com_axelarnetwork_axelar_core_x_tss_exported "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	tofnd "github.com/axelarnetwork/axelar-core/x/tss/tofnd"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -26,6 +28,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// KeyState describes the stage of a key's lifecycle
+type KeyState int32
+
+const (
+	KeyStateUnspecified KeyState = 0
+	Generating          KeyState = 1
+	Active              KeyState = 2
+	Resharing           KeyState = 3
+)
+
+var KeyState_name = map[int32]string{
+	0: "KEY_STATE_UNSPECIFIED",
+	1: "KEY_STATE_GENERATING",
+	2: "KEY_STATE_ACTIVE",
+	3: "KEY_STATE_RESHARING",
+}
+
+var KeyState_value = map[string]int32{
+	"KEY_STATE_UNSPECIFIED": 0,
+	"KEY_STATE_GENERATING":  1,
+	"KEY_STATE_ACTIVE":      2,
+	"KEY_STATE_RESHARING":   3,
+}
+
+func (x KeyState) String() string {
+	return proto.EnumName(KeyState_name, int32(x))
+}
+
+func (KeyState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_757d526ec8821445, []int{0}
+}
+
 type KeygenVoteData struct {
 	PubKey            []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
 	GroupRecoveryInfo []byte `protobuf:"bytes,2,opt,name=group_recovery_info,json=groupRecoveryInfo,proto3" json:"group_recovery_info,omitempty"`
@@ -83,6 +117,9 @@ type KeyInfo struct {
 	KeyID   github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
 	KeyRole exported.KeyRole                                          `protobuf:"varint,2,opt,name=key_role,json=keyRole,proto3,enum=tss.exported.v1beta1.KeyRole" json:"key_role,omitempty"`
 	KeyType exported.KeyType                                          `protobuf:"varint,3,opt,name=key_type,json=keyType,proto3,enum=tss.exported.v1beta1.KeyType" json:"key_type,omitempty"`
+	State   KeyState                                                  `protobuf:"varint,4,opt,name=state,proto3,enum=tss.v1beta1.KeyState" json:"state,omitempty"`
+	// number of reshare sessions that have been started for the key
+	ReshareCount int64 `protobuf:"varint,5,opt,name=reshare_count,json=reshareCount,proto3" json:"reshare_count,omitempty"`
 }
 
 func (m *KeyInfo) Reset()         { *m = KeyInfo{} }
@@ -139,6 +176,243 @@ func (m *KeyInfo) GetKeyType() exported.KeyType {
 	return exported.KEY_TYPE_UNSPECIFIED
 }
 
+func (m *KeyInfo) GetState() KeyState {
+	if m != nil {
+		return m.State
+	}
+	return KeyStateUnspecified
+}
+
+func (m *KeyInfo) GetReshareCount() int64 {
+	if m != nil {
+		return m.ReshareCount
+	}
+	return 0
+}
+
+// ReshareInfo holds information about an ongoing reshare of a key
+type ReshareInfo struct {
+	SessionID          string                                                    `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	KeyID              github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
+	OldSnapshotCounter int64                                                     `protobuf:"varint,3,opt,name=old_snapshot_counter,json=oldSnapshotCounter,proto3" json:"old_snapshot_counter,omitempty"`
+	NewSnapshotCounter int64                                                     `protobuf:"varint,4,opt,name=new_snapshot_counter,json=newSnapshotCounter,proto3" json:"new_snapshot_counter,omitempty"`
+	// block height after which the session is considered abandoned
+	Timeout int64 `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (m *ReshareInfo) Reset()         { *m = ReshareInfo{} }
+func (m *ReshareInfo) String() string { return proto.CompactTextString(m) }
+func (*ReshareInfo) ProtoMessage()    {}
+func (*ReshareInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_757d526ec8821445, []int{2}
+}
+func (m *ReshareInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReshareInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReshareInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReshareInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReshareInfo.Merge(m, src)
+}
+func (m *ReshareInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReshareInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReshareInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReshareInfo proto.InternalMessageInfo
+
+func (m *ReshareInfo) GetSessionID() string {
+	if m != nil {
+		return m.SessionID
+	}
+	return ""
+}
+
+func (m *ReshareInfo) GetKeyID() github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID {
+	if m != nil {
+		return m.KeyID
+	}
+	return ""
+}
+
+func (m *ReshareInfo) GetOldSnapshotCounter() int64 {
+	if m != nil {
+		return m.OldSnapshotCounter
+	}
+	return 0
+}
+
+func (m *ReshareInfo) GetNewSnapshotCounter() int64 {
+	if m != nil {
+		return m.NewSnapshotCounter
+	}
+	return 0
+}
+
+func (m *ReshareInfo) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+// KeyRotationPolicy defines when the key of the given role is rotated
+// automatically for the given chain
+type KeyRotationPolicy struct {
+	Chain   string           `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	KeyRole exported.KeyRole `protobuf:"varint,2,opt,name=key_role,json=keyRole,proto3,enum=tss.exported.v1beta1.KeyRole" json:"key_role,omitempty"`
+	// number of blocks after which the current key gets rotated, 0 disables
+	// period based rotations
+	PeriodInBlocks int64 `protobuf:"varint,3,opt,name=period_in_blocks,json=periodInBlocks,proto3" json:"period_in_blocks,omitempty"`
+	// share of the key's snapshot held by validators that are no longer
+	// eligible after which the key gets rotated, a zero threshold disables
+	// drift based rotations
+	MaxSnapshotDrift utils.Threshold `protobuf:"bytes,4,opt,name=max_snapshot_drift,json=maxSnapshotDrift,proto3" json:"max_snapshot_drift"`
+}
+
+func (m *KeyRotationPolicy) Reset()         { *m = KeyRotationPolicy{} }
+func (m *KeyRotationPolicy) String() string { return proto.CompactTextString(m) }
+func (*KeyRotationPolicy) ProtoMessage()    {}
+func (*KeyRotationPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_757d526ec8821445, []int{3}
+}
+func (m *KeyRotationPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyRotationPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyRotationPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyRotationPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyRotationPolicy.Merge(m, src)
+}
+func (m *KeyRotationPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyRotationPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyRotationPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyRotationPolicy proto.InternalMessageInfo
+
+func (m *KeyRotationPolicy) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *KeyRotationPolicy) GetKeyRole() exported.KeyRole {
+	if m != nil {
+		return m.KeyRole
+	}
+	return exported.Unknown
+}
+
+func (m *KeyRotationPolicy) GetPeriodInBlocks() int64 {
+	if m != nil {
+		return m.PeriodInBlocks
+	}
+	return 0
+}
+
+func (m *KeyRotationPolicy) GetMaxSnapshotDrift() utils.Threshold {
+	if m != nil {
+		return m.MaxSnapshotDrift
+	}
+	return utils.Threshold{}
+}
+
+// ScheduledRotation holds information about a key that is being generated to
+// replace the current key of a chain
+type ScheduledRotation struct {
+	Chain     string                                                    `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	KeyRole   exported.KeyRole                                          `protobuf:"varint,2,opt,name=key_role,json=keyRole,proto3,enum=tss.exported.v1beta1.KeyRole" json:"key_role,omitempty"`
+	KeyID     github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,3,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
+	StartedAt int64                                                     `protobuf:"varint,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+}
+
+func (m *ScheduledRotation) Reset()         { *m = ScheduledRotation{} }
+func (m *ScheduledRotation) String() string { return proto.CompactTextString(m) }
+func (*ScheduledRotation) ProtoMessage()    {}
+func (*ScheduledRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_757d526ec8821445, []int{4}
+}
+func (m *ScheduledRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledRotation.Merge(m, src)
+}
+func (m *ScheduledRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledRotation proto.InternalMessageInfo
+
+func (m *ScheduledRotation) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *ScheduledRotation) GetKeyRole() exported.KeyRole {
+	if m != nil {
+		return m.KeyRole
+	}
+	return exported.Unknown
+}
+
+func (m *ScheduledRotation) GetKeyID() github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID {
+	if m != nil {
+		return m.KeyID
+	}
+	return ""
+}
+
+func (m *ScheduledRotation) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
 type MultisigInfo struct {
 	ID        string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Timeout   int64                `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
@@ -150,7 +424,7 @@ func (m *MultisigInfo) Reset()         { *m = MultisigInfo{} }
 func (m *MultisigInfo) String() string { return proto.CompactTextString(m) }
 func (*MultisigInfo) ProtoMessage()    {}
 func (*MultisigInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_757d526ec8821445, []int{2}
+	return fileDescriptor_757d526ec8821445, []int{5}
 }
 func (m *MultisigInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -216,7 +490,7 @@ func (m *MultisigInfo_Info) Reset()         { *m = MultisigInfo_Info{} }
 func (m *MultisigInfo_Info) String() string { return proto.CompactTextString(m) }
 func (*MultisigInfo_Info) ProtoMessage()    {}
 func (*MultisigInfo_Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_757d526ec8821445, []int{2, 0}
+	return fileDescriptor_757d526ec8821445, []int{5, 0}
 }
 func (m *MultisigInfo_Info) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,7 +543,7 @@ func (m *KeyRecoveryInfo) Reset()         { *m = KeyRecoveryInfo{} }
 func (m *KeyRecoveryInfo) String() string { return proto.CompactTextString(m) }
 func (*KeyRecoveryInfo) ProtoMessage()    {}
 func (*KeyRecoveryInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_757d526ec8821445, []int{3}
+	return fileDescriptor_757d526ec8821445, []int{6}
 }
 func (m *KeyRecoveryInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,7 +602,7 @@ func (m *ExternalKeys) Reset()         { *m = ExternalKeys{} }
 func (m *ExternalKeys) String() string { return proto.CompactTextString(m) }
 func (*ExternalKeys) ProtoMessage()    {}
 func (*ExternalKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_757d526ec8821445, []int{4}
+	return fileDescriptor_757d526ec8821445, []int{7}
 }
 func (m *ExternalKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -380,7 +654,7 @@ func (m *ValidatorStatus) Reset()         { *m = ValidatorStatus{} }
 func (m *ValidatorStatus) String() string { return proto.CompactTextString(m) }
 func (*ValidatorStatus) ProtoMessage()    {}
 func (*ValidatorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_757d526ec8821445, []int{5}
+	return fileDescriptor_757d526ec8821445, []int{8}
 }
 func (m *ValidatorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -423,122 +697,369 @@ func (m *ValidatorStatus) GetSuspendedUntil() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*KeygenVoteData)(nil), "tss.v1beta1.KeygenVoteData")
-	proto.RegisterType((*KeyInfo)(nil), "tss.v1beta1.KeyInfo")
-	proto.RegisterType((*MultisigInfo)(nil), "tss.v1beta1.MultisigInfo")
-	proto.RegisterType((*MultisigInfo_Info)(nil), "tss.v1beta1.MultisigInfo.Info")
-	proto.RegisterType((*KeyRecoveryInfo)(nil), "tss.v1beta1.KeyRecoveryInfo")
-	proto.RegisterMapType((map[string][]byte)(nil), "tss.v1beta1.KeyRecoveryInfo.PrivateEntry")
-	proto.RegisterType((*ExternalKeys)(nil), "tss.v1beta1.ExternalKeys")
-	proto.RegisterType((*ValidatorStatus)(nil), "tss.v1beta1.ValidatorStatus")
+// SignQueueWeight defines the share of the sign queue a class of sign
+// requests is entitled to
+type SignQueueWeight struct {
+	RequestModule string `protobuf:"bytes,1,opt,name=request_module,json=requestModule,proto3" json:"request_module,omitempty"`
+	// chain restricts the weight to requests for the given chain, an empty
+	// chain applies the weight to all chains of the request module
+	Chain  string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Weight int64  `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
 }
 
-func init() { proto.RegisterFile("tss/v1beta1/types.proto", fileDescriptor_757d526ec8821445) }
-
-var fileDescriptor_757d526ec8821445 = []byte{
-	// 667 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0x8e, 0x9d, 0xbf, 0x9b, 0x69, 0xd4, 0xde, 0x3b, 0xb7, 0x6a, 0xa3, 0x48, 0x4d, 0xa2, 0x6c,
-	0x6e, 0xef, 0xa2, 0x0e, 0x2d, 0x2c, 0x4a, 0x77, 0x44, 0x2d, 0x28, 0x44, 0xfc, 0x68, 0x0a, 0x95,
-	0x40, 0x42, 0x61, 0x12, 0x9f, 0xba, 0xa3, 0x38, 0x1e, 0x6b, 0x66, 0x1c, 0xe2, 0x17, 0xe8, 0x86,
-	0x05, 0x3c, 0x56, 0x97, 0x5d, 0xb2, 0x40, 0x11, 0x4a, 0xdf, 0xa2, 0x2b, 0x34, 0x63, 0xa7, 0x18,
-	0x84, 0x84, 0x00, 0xb1, 0x49, 0xce, 0xdf, 0x77, 0xe6, 0x7c, 0xdf, 0x39, 0x09, 0xda, 0x54, 0x52,
-	0x76, 0xa6, 0xbb, 0x43, 0x50, 0x74, 0xb7, 0xa3, 0xe2, 0x10, 0xa4, 0x13, 0x0a, 0xae, 0x38, 0x5e,
-	0x51, 0x52, 0x3a, 0x69, 0xa2, 0xbe, 0xee, 0x71, 0x8f, 0x9b, 0x78, 0x47, 0x5b, 0x49, 0x49, 0xbd,
-	0xa5, 0xb1, 0x30, 0x0b, 0xb9, 0x50, 0xe0, 0x7e, 0xaf, 0x49, 0xfb, 0x05, 0x5a, 0xed, 0x43, 0xec,
-	0x41, 0x70, 0xc2, 0x15, 0x1c, 0x52, 0x45, 0xf1, 0x26, 0x2a, 0x87, 0xd1, 0x70, 0x30, 0x86, 0xb8,
-	0x66, 0xb5, 0xac, 0xed, 0x2a, 0x29, 0x85, 0xd1, 0xb0, 0x0f, 0x31, 0x76, 0xd0, 0xbf, 0x9e, 0xe0,
-	0x51, 0x38, 0x10, 0x30, 0xe2, 0x53, 0x10, 0xf1, 0x80, 0x05, 0xa7, 0xbc, 0x66, 0x9b, 0xa2, 0x7f,
-	0x4c, 0x8a, 0xa4, 0x99, 0x5e, 0x70, 0xca, 0xdb, 0x1f, 0x2d, 0x54, 0xee, 0x83, 0xb1, 0xf1, 0x2b,
-	0x54, 0x1a, 0x43, 0x3c, 0x60, 0xae, 0xe9, 0x59, 0xe9, 0xde, 0x5f, 0xcc, 0x9b, 0x45, 0x9d, 0x3c,
-	0xbc, 0x9e, 0x37, 0xef, 0x7a, 0x4c, 0x9d, 0x45, 0x43, 0x67, 0xc4, 0x27, 0x1d, 0x3a, 0x03, 0x9f,
-	0x8a, 0x00, 0xd4, 0x1b, 0x2e, 0xc6, 0xa9, 0xb7, 0x33, 0xe2, 0x02, 0x3a, 0xb3, 0x4e, 0x96, 0x8c,
-	0x63, 0xc0, 0xa4, 0x38, 0x86, 0xb8, 0xe7, 0xe2, 0x7d, 0xf4, 0x97, 0x6e, 0x2f, 0xb8, 0x0f, 0x66,
-	0x9e, 0xd5, 0xbd, 0x2d, 0x47, 0xab, 0x73, 0x53, 0x9d, 0x52, 0xd7, 0x28, 0xc2, 0x7d, 0x20, 0xe5,
-	0x71, 0x62, 0x2c, 0x91, 0x5a, 0x92, 0x5a, 0xfe, 0x07, 0xc8, 0x67, 0x71, 0x98, 0x20, 0xb5, 0xd1,
-	0x3e, 0xb7, 0x51, 0xf5, 0x51, 0xe4, 0x2b, 0x26, 0x99, 0x67, 0x38, 0x6e, 0x20, 0xfb, 0x86, 0x5f,
-	0x69, 0x31, 0x6f, 0xda, 0xbd, 0x43, 0x62, 0x33, 0x17, 0xd7, 0x50, 0x59, 0xb1, 0x09, 0xf0, 0x48,
-	0x99, 0xd9, 0xf2, 0x64, 0xe9, 0xe2, 0x2d, 0x84, 0x14, 0x15, 0x1e, 0xa8, 0x41, 0x10, 0x4d, 0xcc,
-	0xf3, 0x79, 0x52, 0x49, 0x22, 0x8f, 0xa3, 0x09, 0xbe, 0x83, 0x8a, 0x5a, 0x61, 0x59, 0x2b, 0xb4,
-	0xf2, 0xdb, 0x2b, 0x7b, 0x0d, 0x27, 0xb3, 0x70, 0x27, 0xfb, 0xb4, 0xa3, 0x3f, 0x48, 0x52, 0x5c,
-	0xe7, 0xa8, 0x60, 0xc6, 0x39, 0x46, 0x2b, 0x21, 0x15, 0x8a, 0x8d, 0x58, 0x48, 0x03, 0x95, 0xec,
-	0xb2, 0xbb, 0x7b, 0x3d, 0x6f, 0xee, 0x64, 0xe4, 0x1e, 0x71, 0x39, 0xe1, 0x32, 0xfd, 0xda, 0x91,
-	0xee, 0x38, 0x3d, 0x8e, 0x13, 0xea, 0xdf, 0x73, 0x5d, 0x01, 0x52, 0x92, 0x6c, 0x17, 0x8c, 0x51,
-	0xc1, 0xa5, 0x8a, 0xd6, 0xec, 0x56, 0x7e, 0xbb, 0x4a, 0x8c, 0xdd, 0x7e, 0x67, 0xa3, 0x35, 0xad,
-	0x6b, 0x66, 0xf7, 0x7f, 0x7a, 0xdf, 0x1b, 0x48, 0x1f, 0xa5, 0xcf, 0x46, 0xe9, 0xf5, 0xa5, 0x1e,
-	0xee, 0xa1, 0x72, 0x28, 0xd8, 0x94, 0x2a, 0xbd, 0x4c, 0xad, 0xd9, 0xff, 0x5f, 0x69, 0xf6, 0xcd,
-	0x94, 0xce, 0xd3, 0xa4, 0xf6, 0x28, 0x50, 0x22, 0xee, 0x16, 0x2e, 0xe6, 0xcd, 0x1c, 0x59, 0xe2,
-	0xeb, 0x07, 0xa8, 0x9a, 0x4d, 0xe3, 0xbf, 0x51, 0x7e, 0xf9, 0x93, 0xa8, 0x10, 0x6d, 0xe2, 0x75,
-	0x54, 0x9c, 0x52, 0x3f, 0x82, 0x74, 0x86, 0xc4, 0x39, 0xb0, 0xf7, 0xad, 0xf6, 0xb9, 0x85, 0xaa,
-	0x47, 0x33, 0x05, 0x22, 0xa0, 0x7e, 0x1f, 0x62, 0xa9, 0x4b, 0x47, 0x67, 0x94, 0x05, 0x29, 0x3c,
-	0x71, 0xf0, 0x6b, 0x54, 0x4e, 0x44, 0x92, 0x46, 0xcf, 0x4a, 0xf7, 0xc1, 0x62, 0xde, 0x2c, 0x19,
-	0xa2, 0xf2, 0xf7, 0x64, 0x2a, 0x19, 0x99, 0x64, 0xfb, 0xad, 0x85, 0xd6, 0x4e, 0xa8, 0xcf, 0x5c,
-	0xaa, 0xb8, 0x38, 0x56, 0x54, 0x45, 0x12, 0x3f, 0x41, 0x95, 0xe9, 0x32, 0xf4, 0xeb, 0x57, 0xf1,
-	0xa5, 0x07, 0xfe, 0x0f, 0xad, 0xc9, 0x48, 0x86, 0x10, 0xb8, 0xe0, 0x0e, 0xa2, 0x40, 0x31, 0xdf,
-	0x28, 0x52, 0x20, 0xab, 0x37, 0xe1, 0xe7, 0x3a, 0xda, 0x7d, 0x78, 0xb1, 0x68, 0x58, 0x97, 0x8b,
-	0x86, 0xf5, 0x69, 0xd1, 0xb0, 0xde, 0x5f, 0x35, 0x72, 0x97, 0x57, 0x8d, 0xdc, 0x87, 0xab, 0x46,
-	0xee, 0xe5, 0xad, 0x9f, 0xa0, 0x6a, 0x46, 0x19, 0x96, 0xcc, 0xdf, 0xd7, 0xed, 0xcf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x4c, 0x91, 0x4e, 0x38, 0x1e, 0x05, 0x00, 0x00,
+func (m *SignQueueWeight) Reset()         { *m = SignQueueWeight{} }
+func (m *SignQueueWeight) String() string { return proto.CompactTextString(m) }
+func (*SignQueueWeight) ProtoMessage()    {}
+func (*SignQueueWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_757d526ec8821445, []int{9}
 }
-
-func (m *KeygenVoteData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SignQueueWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignQueueWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignQueueWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *SignQueueWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignQueueWeight.Merge(m, src)
+}
+func (m *SignQueueWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignQueueWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignQueueWeight.DiscardUnknown(m)
 }
 
-func (m *KeygenVoteData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_SignQueueWeight proto.InternalMessageInfo
+
+func (m *SignQueueWeight) GetRequestModule() string {
+	if m != nil {
+		return m.RequestModule
+	}
+	return ""
 }
 
-func (m *KeygenVoteData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GroupRecoveryInfo) > 0 {
-		i -= len(m.GroupRecoveryInfo)
-		copy(dAtA[i:], m.GroupRecoveryInfo)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupRecoveryInfo)))
-		i--
-		dAtA[i] = 0x12
+func (m *SignQueueWeight) GetChain() string {
+	if m != nil {
+		return m.Chain
 	}
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *SignQueueWeight) GetWeight() int64 {
+	if m != nil {
+		return m.Weight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *KeyInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// MisbehaviourEvidence records a validator found guilty of misbehaving during a
+// tss session, together with the penalty it received
+type MisbehaviourEvidence struct {
+	SessionID string                                           `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Criminal  github_com_cosmos_cosmos_sdk_types.ValAddress    `protobuf:"bytes,2,opt,name=criminal,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"criminal,omitempty"`
+	CrimeType tofnd.MessageOut_CriminalList_Criminal_CrimeType `protobuf:"varint,3,opt,name=crime_type,json=crimeType,proto3,enum=tss.tofnd.v1beta1.MessageOut_CriminalList_Criminal_CrimeType" json:"crime_type,omitempty"`
+	// accusers are the validators that voted for the result naming the criminal,
+	// empty if the crime was detected by the chain itself
+	Accusers []github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,4,rep,name=accusers,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"accusers,omitempty"`
+	// traffic_hashes are the hashes of the messages the criminal broadcast
+	// during the session
+	TrafficHashes [][]byte `protobuf:"bytes,5,rep,name=traffic_hashes,json=trafficHashes,proto3" json:"traffic_hashes,omitempty"`
+	Height        int64    `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// offence_count is the number of offences of the criminal within the
+	// misbehaviour window, including this one
+	OffenceCount   int64 `protobuf:"varint,7,opt,name=offence_count,json=offenceCount,proto3" json:"offence_count,omitempty"`
+	SuspendedUntil int64 `protobuf:"varint,8,opt,name=suspended_until,json=suspendedUntil,proto3" json:"suspended_until,omitempty"`
+	Jailed         bool  `protobuf:"varint,9,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// attempt is the attempt of the session during which the crime was
+	// committed, sessions that are retried under the same ID are penalized
+	// separately for every attempt
+	Attempt int64 `protobuf:"varint,10,opt,name=attempt,proto3" json:"attempt,omitempty"`
+}
+
+func (m *MisbehaviourEvidence) Reset()         { *m = MisbehaviourEvidence{} }
+func (m *MisbehaviourEvidence) String() string { return proto.CompactTextString(m) }
+func (*MisbehaviourEvidence) ProtoMessage()    {}
+func (*MisbehaviourEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_757d526ec8821445, []int{10}
+}
+func (m *MisbehaviourEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MisbehaviourEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MisbehaviourEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MisbehaviourEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MisbehaviourEvidence.Merge(m, src)
+}
+func (m *MisbehaviourEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *MisbehaviourEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MisbehaviourEvidence.DiscardUnknown(m)
 }
 
-func (m *KeyInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MisbehaviourEvidence proto.InternalMessageInfo
+
+func (m *MisbehaviourEvidence) GetSessionID() string {
+	if m != nil {
+		return m.SessionID
+	}
+	return ""
 }
 
-func (m *KeyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MisbehaviourEvidence) GetCriminal() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.Criminal
+	}
+	return nil
+}
+
+func (m *MisbehaviourEvidence) GetCrimeType() tofnd.MessageOut_CriminalList_Criminal_CrimeType {
+	if m != nil {
+		return m.CrimeType
+	}
+	return tofnd.CRIME_TYPE_UNSPECIFIED
+}
+
+func (m *MisbehaviourEvidence) GetAccusers() []github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.Accusers
+	}
+	return nil
+}
+
+func (m *MisbehaviourEvidence) GetTrafficHashes() [][]byte {
+	if m != nil {
+		return m.TrafficHashes
+	}
+	return nil
+}
+
+func (m *MisbehaviourEvidence) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MisbehaviourEvidence) GetOffenceCount() int64 {
+	if m != nil {
+		return m.OffenceCount
+	}
+	return 0
+}
+
+func (m *MisbehaviourEvidence) GetSuspendedUntil() int64 {
+	if m != nil {
+		return m.SuspendedUntil
+	}
+	return 0
+}
+
+func (m *MisbehaviourEvidence) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *MisbehaviourEvidence) GetAttempt() int64 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("tss.v1beta1.KeyState", KeyState_name, KeyState_value)
+	proto.RegisterType((*KeygenVoteData)(nil), "tss.v1beta1.KeygenVoteData")
+	proto.RegisterType((*KeyInfo)(nil), "tss.v1beta1.KeyInfo")
+	proto.RegisterType((*ReshareInfo)(nil), "tss.v1beta1.ReshareInfo")
+	proto.RegisterType((*KeyRotationPolicy)(nil), "tss.v1beta1.KeyRotationPolicy")
+	proto.RegisterType((*ScheduledRotation)(nil), "tss.v1beta1.ScheduledRotation")
+	proto.RegisterType((*MultisigInfo)(nil), "tss.v1beta1.MultisigInfo")
+	proto.RegisterType((*MultisigInfo_Info)(nil), "tss.v1beta1.MultisigInfo.Info")
+	proto.RegisterType((*KeyRecoveryInfo)(nil), "tss.v1beta1.KeyRecoveryInfo")
+	proto.RegisterMapType((map[string][]byte)(nil), "tss.v1beta1.KeyRecoveryInfo.PrivateEntry")
+	proto.RegisterType((*ExternalKeys)(nil), "tss.v1beta1.ExternalKeys")
+	proto.RegisterType((*ValidatorStatus)(nil), "tss.v1beta1.ValidatorStatus")
+	proto.RegisterType((*SignQueueWeight)(nil), "tss.v1beta1.SignQueueWeight")
+	proto.RegisterType((*MisbehaviourEvidence)(nil), "tss.v1beta1.MisbehaviourEvidence")
+}
+
+func init() { proto.RegisterFile("tss/v1beta1/types.proto", fileDescriptor_757d526ec8821445) }
+
+var fileDescriptor_757d526ec8821445 = []byte{
+	// 1303 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xcf, 0xae, 0x13, 0x27, 0x9e, 0xbc, 0x76, 0x9b, 0xb6, 0x96, 0xa5, 0x3a, 0x96, 0xff, 0xfa,
+	0x83, 0x79, 0xa9, 0xd3, 0x06, 0x0e, 0xa5, 0x12, 0x07, 0x27, 0x71, 0x53, 0x93, 0x26, 0x2d, 0xeb,
+	0x34, 0xa8, 0x08, 0xb4, 0x8c, 0x77, 0x1f, 0xdb, 0x83, 0xd7, 0x3b, 0xcb, 0xce, 0xac, 0x13, 0x7f,
+	0x81, 0x0a, 0x85, 0x03, 0x1c, 0xb9, 0xe4, 0x04, 0x07, 0x4e, 0x7c, 0x02, 0x3e, 0x40, 0x8f, 0x3d,
+	0x22, 0x21, 0x59, 0xe0, 0x7e, 0x8b, 0x5e, 0x40, 0xf3, 0xb2, 0xf1, 0xb6, 0x14, 0xa1, 0xb6, 0xf4,
+	0x62, 0xcf, 0xf3, 0x7b, 0x5e, 0x76, 0x9e, 0xdf, 0xf3, 0xcc, 0x33, 0x83, 0x2e, 0x71, 0xc6, 0xd6,
+	0x07, 0xd7, 0x5a, 0xc0, 0xf1, 0xb5, 0x75, 0x3e, 0x0c, 0x81, 0x55, 0xc3, 0x88, 0x72, 0x6a, 0xcd,
+	0x73, 0xc6, 0xaa, 0x5a, 0x51, 0x58, 0xed, 0xd0, 0x0e, 0x95, 0xf8, 0xba, 0x58, 0x29, 0x93, 0x42,
+	0x49, 0xf8, 0xc2, 0x71, 0x48, 0x23, 0x0e, 0xde, 0xf3, 0x82, 0x14, 0x2e, 0x0b, 0x0b, 0x4e, 0xdb,
+	0x41, 0x4a, 0x2d, 0xa4, 0x44, 0x1d, 0x73, 0xe2, 0xa7, 0x3e, 0xdf, 0x8d, 0x80, 0x75, 0xa9, 0xaf,
+	0xd5, 0xe5, 0xfb, 0x68, 0x69, 0x17, 0x86, 0x1d, 0x08, 0x0e, 0x29, 0x87, 0x6d, 0xcc, 0xb1, 0x75,
+	0x09, 0xcd, 0x86, 0x71, 0xcb, 0xe9, 0xc1, 0x30, 0x6f, 0x94, 0x8c, 0xca, 0x82, 0x9d, 0x0d, 0xe3,
+	0xd6, 0x2e, 0x0c, 0xad, 0x2a, 0x3a, 0xdf, 0x89, 0x68, 0x1c, 0x3a, 0x11, 0xb8, 0x74, 0x00, 0xd1,
+	0xd0, 0x21, 0x41, 0x9b, 0xe6, 0x4d, 0x69, 0x74, 0x4e, 0xaa, 0x6c, 0xad, 0x69, 0x04, 0x6d, 0x5a,
+	0xfe, 0xd9, 0x44, 0xb3, 0xbb, 0x20, 0xd7, 0xd6, 0xe7, 0x28, 0xdb, 0x83, 0xa1, 0x43, 0x3c, 0x19,
+	0x33, 0xb7, 0x79, 0x73, 0x3c, 0x5a, 0x9b, 0x11, 0xca, 0xed, 0x27, 0xa3, 0xb5, 0x0f, 0x3a, 0x84,
+	0x77, 0xe3, 0x56, 0xd5, 0xa5, 0xfd, 0x75, 0x7c, 0x0c, 0x3e, 0x8e, 0x02, 0xe0, 0x47, 0x34, 0xea,
+	0x69, 0xe9, 0x8a, 0x4b, 0x23, 0x58, 0x3f, 0x5e, 0x4f, 0x53, 0x51, 0x95, 0xce, 0xf6, 0x4c, 0x0f,
+	0x86, 0x0d, 0xcf, 0xba, 0x8e, 0xe6, 0x44, 0xf8, 0x88, 0xfa, 0x20, 0xf7, 0xb3, 0xb4, 0x71, 0xb9,
+	0x2a, 0xb8, 0x3d, 0xb3, 0xd6, 0xe9, 0x0b, 0x2f, 0x9b, 0xfa, 0x60, 0xcf, 0xf6, 0xd4, 0x22, 0xf1,
+	0x14, 0x84, 0xe6, 0x33, 0xff, 0xe2, 0x79, 0x30, 0x0c, 0x95, 0xa7, 0x58, 0x58, 0xef, 0xa0, 0x19,
+	0xc6, 0x31, 0x87, 0xfc, 0xb4, 0x74, 0xbb, 0x50, 0x4d, 0x15, 0x53, 0x58, 0x37, 0x85, 0xd2, 0x56,
+	0x36, 0xd6, 0xff, 0xd0, 0xa2, 0xe0, 0x1d, 0x47, 0xe0, 0xb8, 0x34, 0x0e, 0x78, 0x7e, 0xa6, 0x64,
+	0x54, 0x32, 0xf6, 0x82, 0x06, 0xb7, 0x04, 0x56, 0xfe, 0xde, 0x44, 0xf3, 0xb6, 0x02, 0x24, 0x69,
+	0xef, 0x22, 0xc4, 0x80, 0x31, 0x42, 0x83, 0x09, 0x71, 0x8b, 0xe3, 0xd1, 0x5a, 0xae, 0xa9, 0xd0,
+	0xc6, 0xb6, 0x9d, 0xd3, 0x06, 0x0d, 0x2f, 0x45, 0xb1, 0xf9, 0x3a, 0x28, 0xbe, 0x8a, 0x56, 0xa9,
+	0xef, 0x39, 0x2c, 0xc0, 0x21, 0xeb, 0x52, 0xae, 0xd2, 0x80, 0x48, 0x92, 0x96, 0xb1, 0x2d, 0xea,
+	0x7b, 0x4d, 0xad, 0xda, 0x52, 0x1a, 0xe1, 0x11, 0xc0, 0xd1, 0xdf, 0x3d, 0xa6, 0x95, 0x47, 0x00,
+	0x47, 0xcf, 0x7a, 0xe4, 0xd1, 0x2c, 0x27, 0x7d, 0xa0, 0x71, 0xc2, 0x4f, 0x22, 0x96, 0x7f, 0x33,
+	0xd0, 0x39, 0x59, 0x3b, 0x8e, 0x39, 0xa1, 0xc1, 0x5d, 0xea, 0x13, 0x77, 0x68, 0xad, 0xa2, 0x19,
+	0xb7, 0x8b, 0x49, 0xa0, 0xb8, 0xb1, 0x95, 0xf0, 0x0a, 0xcd, 0x50, 0x41, 0x2b, 0x21, 0x44, 0x84,
+	0x7a, 0x0e, 0x09, 0x9c, 0x96, 0x4f, 0xdd, 0x1e, 0xd3, 0xf9, 0x2d, 0x29, 0xbc, 0x11, 0x6c, 0x4a,
+	0xd4, 0xba, 0x8d, 0xac, 0x3e, 0x3e, 0x9e, 0xe4, 0xe6, 0x45, 0xa4, 0xcd, 0x65, 0x66, 0xf3, 0x1b,
+	0xf9, 0xaa, 0x3c, 0x72, 0x67, 0x9f, 0x39, 0x48, 0x8e, 0xdc, 0xe6, 0xf4, 0xc3, 0xd1, 0xda, 0x94,
+	0xbd, 0xd2, 0xc7, 0xc7, 0x49, 0xe6, 0xdb, 0xc2, 0xaf, 0xfc, 0x87, 0x81, 0xce, 0x35, 0xdd, 0x2e,
+	0x78, 0xb1, 0x0f, 0x5e, 0x92, 0xe3, 0x7f, 0x9e, 0xdd, 0xa4, 0x41, 0x32, 0xaf, 0xa3, 0x41, 0x2e,
+	0x23, 0xc4, 0x38, 0x16, 0xb8, 0x83, 0xb9, 0x2e, 0x72, 0x4e, 0x23, 0x35, 0x5e, 0x7e, 0x60, 0xa2,
+	0x85, 0xbd, 0xd8, 0xe7, 0x84, 0x91, 0x8e, 0xec, 0xee, 0x8b, 0xc8, 0x3c, 0xeb, 0xea, 0xec, 0x78,
+	0xb4, 0x66, 0x36, 0xb6, 0x6d, 0x93, 0x78, 0xe9, 0x26, 0x30, 0x9f, 0x6a, 0x02, 0xf1, 0x05, 0x8e,
+	0xa3, 0x0e, 0x70, 0x27, 0x88, 0xfb, 0xba, 0x30, 0x39, 0x85, 0xec, 0xc7, 0x7d, 0xeb, 0x7d, 0x34,
+	0x23, 0x06, 0x12, 0xcb, 0x4f, 0x97, 0x32, 0x95, 0xf9, 0x8d, 0xe2, 0x53, 0x07, 0x32, 0xfd, 0xe9,
+	0xaa, 0xf8, 0xb1, 0x95, 0x71, 0x81, 0xa2, 0x69, 0xb9, 0x9d, 0x26, 0x9a, 0x0f, 0x71, 0xc4, 0x89,
+	0x4b, 0x42, 0x1c, 0x70, 0x35, 0xfa, 0x36, 0xaf, 0x3d, 0x19, 0xad, 0x5d, 0x49, 0x31, 0xe3, 0x52,
+	0xd6, 0xa7, 0x4c, 0xff, 0x5d, 0x61, 0x5e, 0x4f, 0x4f, 0xe2, 0x43, 0xec, 0xd7, 0x3c, 0x2f, 0x02,
+	0xc6, 0xec, 0x74, 0x14, 0xcb, 0x42, 0xd3, 0x1e, 0xe6, 0x38, 0x6f, 0x96, 0x32, 0x95, 0x05, 0x5b,
+	0xae, 0xcb, 0xdf, 0x9a, 0x68, 0x59, 0xd4, 0x26, 0x35, 0x2a, 0x5f, 0xf7, 0x78, 0xbc, 0x88, 0xc4,
+	0x0c, 0xf7, 0x89, 0xab, 0x87, 0xb5, 0x96, 0xac, 0x06, 0x9a, 0x0d, 0x23, 0x32, 0x10, 0x43, 0x2c,
+	0x23, 0x39, 0x7b, 0xeb, 0xd9, 0x21, 0x96, 0xde, 0x65, 0xf5, 0xae, 0xb2, 0xad, 0x07, 0x3c, 0x1a,
+	0xea, 0x5e, 0x4e, 0xfc, 0x0b, 0x37, 0xd0, 0x42, 0x5a, 0x6d, 0xad, 0xa0, 0x4c, 0x72, 0x83, 0xe4,
+	0x6c, 0xb1, 0x14, 0xed, 0x3c, 0xc0, 0x7e, 0x0c, 0x7a, 0x0f, 0x4a, 0xb8, 0x61, 0x5e, 0x37, 0xca,
+	0x0f, 0x0c, 0xb4, 0x50, 0x3f, 0xe6, 0x10, 0x05, 0xd8, 0xdf, 0x85, 0x21, 0xfb, 0x87, 0xce, 0xff,
+	0x02, 0xcd, 0x2a, 0x92, 0x98, 0xe4, 0x33, 0xb7, 0xb9, 0x33, 0x1e, 0xad, 0x65, 0x65, 0xa2, 0xec,
+	0xd5, 0x68, 0xca, 0x4a, 0x9a, 0x58, 0xf9, 0x1b, 0x03, 0x2d, 0x1f, 0x62, 0x9f, 0x78, 0x98, 0xd3,
+	0x48, 0xcc, 0xef, 0x98, 0x59, 0x77, 0x50, 0x6e, 0x90, 0x40, 0x2f, 0xdf, 0x15, 0x93, 0x18, 0xd6,
+	0x9b, 0x68, 0x99, 0xc5, 0x2c, 0x84, 0xc0, 0x03, 0xcf, 0x89, 0x03, 0x4e, 0x7c, 0xc9, 0xc8, 0xb4,
+	0xbd, 0x74, 0x06, 0xdf, 0x13, 0x68, 0xb9, 0x8d, 0x96, 0x9b, 0xa4, 0x13, 0x7c, 0x1c, 0x43, 0x0c,
+	0x9f, 0x00, 0xe9, 0x74, 0xb9, 0xf5, 0x7f, 0xb4, 0x14, 0xc1, 0x57, 0x31, 0x30, 0xee, 0xf4, 0xa9,
+	0x98, 0x16, 0x9a, 0xa1, 0x45, 0x8d, 0xee, 0x49, 0x70, 0xc2, 0x9f, 0x99, 0xe6, 0xef, 0x22, 0xca,
+	0x1e, 0xc9, 0x30, 0xfa, 0xe8, 0x68, 0xa9, 0xfc, 0x67, 0x06, 0xad, 0xee, 0x11, 0xd6, 0x82, 0x2e,
+	0x1e, 0x10, 0x1a, 0x47, 0xf5, 0x01, 0xf1, 0x20, 0x70, 0xe1, 0x05, 0xef, 0x9f, 0x3d, 0x34, 0xe7,
+	0x46, 0xa4, 0x4f, 0x02, 0xac, 0x12, 0x7a, 0x29, 0x9e, 0xce, 0x42, 0x58, 0x9f, 0x21, 0x24, 0xd6,
+	0x90, 0xbe, 0x9a, 0x3f, 0x94, 0xed, 0xa9, 0x5e, 0x37, 0x67, 0x07, 0x1b, 0x18, 0xc3, 0x1d, 0xb8,
+	0x13, 0xf3, 0xea, 0x96, 0xf6, 0xbd, 0x4d, 0xd8, 0x44, 0x90, 0x0b, 0x90, 0x57, 0x77, 0xce, 0x4d,
+	0x96, 0x62, 0xb3, 0xd8, 0x75, 0x63, 0x06, 0x91, 0x1a, 0x17, 0x2f, 0xb7, 0xd9, 0x24, 0x84, 0xa8,
+	0x0b, 0x8f, 0x70, 0xbb, 0x4d, 0x5c, 0xa7, 0x8b, 0x59, 0x17, 0x58, 0x7e, 0x46, 0x9e, 0xf8, 0x45,
+	0x8d, 0xde, 0x92, 0xa0, 0xa8, 0x40, 0x57, 0x55, 0x20, 0xab, 0x2a, 0xa0, 0x24, 0xf1, 0x3a, 0xa0,
+	0xed, 0xb6, 0xe0, 0x5c, 0xbf, 0x0e, 0x66, 0xd5, 0xeb, 0x40, 0x83, 0xf2, 0x7a, 0x7c, 0x5e, 0xdf,
+	0xcc, 0xa9, 0xbb, 0xe9, 0xe9, 0xbe, 0x11, 0x5f, 0xf9, 0x12, 0x13, 0x1f, 0xbc, 0x7c, 0xae, 0x64,
+	0x54, 0xe6, 0x6c, 0x2d, 0x89, 0xc1, 0x8a, 0x39, 0x87, 0x7e, 0xc8, 0xf3, 0x48, 0x0d, 0x56, 0x2d,
+	0xbe, 0xfd, 0x8b, 0x81, 0xe6, 0x92, 0x17, 0x8b, 0xb5, 0x81, 0x2e, 0xec, 0xd6, 0xef, 0x3b, 0xcd,
+	0x83, 0xda, 0x41, 0xdd, 0xb9, 0xb7, 0xdf, 0xbc, 0x5b, 0xdf, 0x6a, 0xdc, 0x6c, 0xd4, 0xb7, 0x57,
+	0xa6, 0x0a, 0x97, 0x4e, 0x4e, 0x4b, 0xe7, 0x13, 0xc3, 0x7b, 0x01, 0x0b, 0xc1, 0x25, 0x6d, 0x02,
+	0x9e, 0x55, 0x41, 0xab, 0x13, 0x9f, 0x9d, 0xfa, 0x7e, 0xdd, 0xae, 0x1d, 0x34, 0xf6, 0x77, 0x56,
+	0x8c, 0xc2, 0xd2, 0xc9, 0x69, 0x09, 0xed, 0x40, 0x00, 0x11, 0xe6, 0x24, 0xe8, 0x58, 0x25, 0xb4,
+	0x32, 0xb1, 0xac, 0x6d, 0x1d, 0x34, 0x0e, 0xeb, 0x2b, 0x66, 0x01, 0x9d, 0x9c, 0x96, 0xb2, 0x35,
+	0x97, 0x93, 0x01, 0x58, 0x6f, 0xa0, 0xf3, 0x13, 0x0b, 0xbb, 0xde, 0xbc, 0x55, 0xb3, 0x45, 0xa8,
+	0x4c, 0x61, 0xf1, 0xe4, 0xb4, 0x94, 0x53, 0xef, 0x23, 0x12, 0x74, 0x0a, 0x73, 0x5f, 0xff, 0x50,
+	0x9c, 0xfa, 0xe9, 0xc7, 0xa2, 0xb1, 0xf9, 0xd1, 0xc3, 0x71, 0xd1, 0x78, 0x34, 0x2e, 0x1a, 0xbf,
+	0x8f, 0x8b, 0xc6, 0x77, 0x8f, 0x8b, 0x53, 0x8f, 0x1e, 0x17, 0xa7, 0x7e, 0x7d, 0x5c, 0x9c, 0xfa,
+	0xf4, 0xea, 0x0b, 0xcc, 0x04, 0x59, 0xde, 0x56, 0x56, 0x3e, 0x8b, 0xdf, 0xfb, 0x6b, 0x00, 0x98,
+	0xc4, 0xcb, 0xe5, 0xb4, 0x0b, 0x00, 0x00,
+}
+
+func (m *KeygenVoteData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeygenVoteData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeygenVoteData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GroupRecoveryInfo) > 0 {
+		i -= len(m.GroupRecoveryInfo)
+		copy(dAtA[i:], m.GroupRecoveryInfo)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupRecoveryInfo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ReshareCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReshareCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.State != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.KeyType != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.KeyType))
 		i--
@@ -559,6 +1080,155 @@ func (m *KeyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReshareInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReshareInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReshareInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timeout != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NewSnapshotCounter != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NewSnapshotCounter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OldSnapshotCounter != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OldSnapshotCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SessionID) > 0 {
+		i -= len(m.SessionID)
+		copy(dAtA[i:], m.SessionID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SessionID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyRotationPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyRotationPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyRotationPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MaxSnapshotDrift.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PeriodInBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PeriodInBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.KeyRole != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.KeyRole))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.KeyRole != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.KeyRole))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MultisigInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -784,24 +1454,156 @@ func (m *ValidatorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SignQueueWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *KeygenVoteData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PubKey)
+
+func (m *SignQueueWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignQueueWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RequestModule) > 0 {
+		i -= len(m.RequestModule)
+		copy(dAtA[i:], m.RequestModule)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RequestModule)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MisbehaviourEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MisbehaviourEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MisbehaviourEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attempt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SuspendedUntil != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SuspendedUntil))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.OffenceCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OffenceCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TrafficHashes) > 0 {
+		for iNdEx := len(m.TrafficHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TrafficHashes[iNdEx])
+			copy(dAtA[i:], m.TrafficHashes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.TrafficHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Accusers) > 0 {
+		for iNdEx := len(m.Accusers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accusers[iNdEx])
+			copy(dAtA[i:], m.Accusers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Accusers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.CrimeType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CrimeType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Criminal) > 0 {
+		i -= len(m.Criminal)
+		copy(dAtA[i:], m.Criminal)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Criminal)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SessionID) > 0 {
+		i -= len(m.SessionID)
+		copy(dAtA[i:], m.SessionID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SessionID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *KeygenVoteData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
@@ -828,6 +1630,82 @@ func (m *KeyInfo) Size() (n int) {
 	if m.KeyType != 0 {
 		n += 1 + sovTypes(uint64(m.KeyType))
 	}
+	if m.State != 0 {
+		n += 1 + sovTypes(uint64(m.State))
+	}
+	if m.ReshareCount != 0 {
+		n += 1 + sovTypes(uint64(m.ReshareCount))
+	}
+	return n
+}
+
+func (m *ReshareInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.OldSnapshotCounter != 0 {
+		n += 1 + sovTypes(uint64(m.OldSnapshotCounter))
+	}
+	if m.NewSnapshotCounter != 0 {
+		n += 1 + sovTypes(uint64(m.NewSnapshotCounter))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovTypes(uint64(m.Timeout))
+	}
+	return n
+}
+
+func (m *KeyRotationPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.KeyRole != 0 {
+		n += 1 + sovTypes(uint64(m.KeyRole))
+	}
+	if m.PeriodInBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.PeriodInBlocks))
+	}
+	l = m.MaxSnapshotDrift.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ScheduledRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.KeyRole != 0 {
+		n += 1 + sovTypes(uint64(m.KeyRole))
+	}
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.StartedAt != 0 {
+		n += 1 + sovTypes(uint64(m.StartedAt))
+	}
 	return n
 }
 
@@ -939,6 +1817,73 @@ func (m *ValidatorStatus) Size() (n int) {
 	return n
 }
 
+func (m *SignQueueWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestModule)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovTypes(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *MisbehaviourEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Criminal)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CrimeType != 0 {
+		n += 1 + sovTypes(uint64(m.CrimeType))
+	}
+	if len(m.Accusers) > 0 {
+		for _, b := range m.Accusers {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.TrafficHashes) > 0 {
+		for _, b := range m.TrafficHashes {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.OffenceCount != 0 {
+		n += 1 + sovTypes(uint64(m.OffenceCount))
+	}
+	if m.SuspendedUntil != 0 {
+		n += 1 + sovTypes(uint64(m.SuspendedUntil))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovTypes(uint64(m.Attempt))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1162,6 +2107,44 @@ func (m *KeyInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= KeyState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReshareCount", wireType)
+			}
+			m.ReshareCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReshareCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1183,7 +2166,7 @@ func (m *KeyInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultisigInfo) Unmarshal(dAtA []byte) error {
+func (m *ReshareInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1206,15 +2189,15 @@ func (m *MultisigInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultisigInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReshareInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultisigInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReshareInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1242,13 +2225,13 @@ func (m *MultisigInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.SessionID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
-			m.Timeout = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1258,16 +2241,29 @@ func (m *MultisigInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetNum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldSnapshotCounter", wireType)
 			}
-			m.TargetNum = 0
+			m.OldSnapshotCounter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1277,16 +2273,16 @@ func (m *MultisigInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetNum |= int64(b&0x7F) << shift
+				m.OldSnapshotCounter |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Infos", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSnapshotCounter", wireType)
 			}
-			var msglen int
+			m.NewSnapshotCounter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1296,26 +2292,30 @@ func (m *MultisigInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NewSnapshotCounter |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			m.Infos = append(m.Infos, &MultisigInfo_Info{})
-			if err := m.Infos[len(m.Infos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1337,7 +2337,7 @@ func (m *MultisigInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultisigInfo_Info) Unmarshal(dAtA []byte) error {
+func (m *KeyRotationPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1360,17 +2360,17 @@ func (m *MultisigInfo_Info) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Info: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyRotationPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyRotationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1380,31 +2380,67 @@ func (m *MultisigInfo_Info) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participant = append(m.Participant[:0], dAtA[iNdEx:postIndex]...)
-			if m.Participant == nil {
-				m.Participant = []byte{}
-			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRole", wireType)
+			}
+			m.KeyRole = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyRole |= exported.KeyRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodInBlocks", wireType)
+			}
+			m.PeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodInBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSnapshotDrift", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1414,23 +2450,24 @@ func (m *MultisigInfo_Info) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, make([]byte, postIndex-iNdEx))
-			copy(m.Data[len(m.Data)-1], dAtA[iNdEx:postIndex])
+			if err := m.MaxSnapshotDrift.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1453,7 +2490,7 @@ func (m *MultisigInfo_Info) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyRecoveryInfo) Unmarshal(dAtA []byte) error {
+func (m *ScheduledRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1476,15 +2513,15 @@ func (m *KeyRecoveryInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyRecoveryInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyRecoveryInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1512,13 +2549,13 @@ func (m *KeyRecoveryInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			m.Chain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Public", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRole", wireType)
 			}
-			var byteLen int
+			m.KeyRole = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1528,31 +2565,16 @@ func (m *KeyRecoveryInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.KeyRole |= exported.KeyRole(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Public = append(m.Public[:0], dAtA[iNdEx:postIndex]...)
-			if m.Public == nil {
-				m.Public = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Private", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1562,120 +2584,43 @@ func (m *KeyRecoveryInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Private == nil {
-				m.Private = make(map[string][]byte)
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var mapkey string
-			mapvalue := []byte{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.StartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTypes
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthTypes
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthTypes
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapbyteLen uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTypes
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapbyteLen |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intMapbyteLen := int(mapbyteLen)
-					if intMapbyteLen < 0 {
-						return ErrInvalidLengthTypes
-					}
-					postbytesIndex := iNdEx + intMapbyteLen
-					if postbytesIndex < 0 {
-						return ErrInvalidLengthTypes
-					}
-					if postbytesIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = make([]byte, mapbyteLen)
-					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
-					iNdEx = postbytesIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipTypes(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthTypes
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Private[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1697,7 +2642,521 @@ func (m *KeyRecoveryInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExternalKeys) Unmarshal(dAtA []byte) error {
+func (m *MultisigInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultisigInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultisigInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetNum", wireType)
+			}
+			m.TargetNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetNum |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Infos = append(m.Infos, &MultisigInfo_Info{})
+			if err := m.Infos[len(m.Infos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultisigInfo_Info) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Info: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = append(m.Participant[:0], dAtA[iNdEx:postIndex]...)
+			if m.Participant == nil {
+				m.Participant = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, make([]byte, postIndex-iNdEx))
+			copy(m.Data[len(m.Data)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyRecoveryInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyRecoveryInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyRecoveryInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Public", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Public = append(m.Public[:0], dAtA[iNdEx:postIndex]...)
+			if m.Public == nil {
+				m.Public = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Private", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Private == nil {
+				m.Private = make(map[string][]byte)
+			}
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTypes
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTypes
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthTypes
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthTypes
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTypes(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTypes
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Private[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExternalKeys) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1723,12 +3182,229 @@ func (m *ExternalKeys) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: ExternalKeys: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExternalKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExternalKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyIDs = append(m.KeyIDs, github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedUntil", wireType)
+			}
+			m.SuspendedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuspendedUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignQueueWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignQueueWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignQueueWeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestModule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1756,11 +3432,11 @@ func (m *ExternalKeys) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = string(dAtA[iNdEx:postIndex])
+			m.RequestModule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyIDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1788,8 +3464,27 @@ func (m *ExternalKeys) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyIDs = append(m.KeyIDs, github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex]))
+			m.Chain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1811,7 +3506,7 @@ func (m *ExternalKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
+func (m *MisbehaviourEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1834,15 +3529,47 @@ func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MisbehaviourEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MisbehaviourEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Criminal", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1869,12 +3596,133 @@ func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
-			if m.Validator == nil {
-				m.Validator = []byte{}
+			m.Criminal = append(m.Criminal[:0], dAtA[iNdEx:postIndex]...)
+			if m.Criminal == nil {
+				m.Criminal = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrimeType", wireType)
+			}
+			m.CrimeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CrimeType |= tofnd.MessageOut_CriminalList_Criminal_CrimeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accusers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accusers = append(m.Accusers, make([]byte, postIndex-iNdEx))
+			copy(m.Accusers[len(m.Accusers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrafficHashes = append(m.TrafficHashes, make([]byte, postIndex-iNdEx))
+			copy(m.TrafficHashes[len(m.TrafficHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffenceCount", wireType)
+			}
+			m.OffenceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffenceCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedUntil", wireType)
 			}
@@ -1888,7 +3736,46 @@ func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SuspendedUntil |= uint64(b&0x7F) << shift
+				m.SuspendedUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -32,6 +32,9 @@ var _ exported.Poll = &PollMock{}
 // 			GetResultFunc: func() codec.ProtoMarshaler {
 // 				panic("mock out the GetResult method")
 // 			},
+// 			GetResultVotersFunc: func() []github_com_cosmos_cosmos_sdk_types.ValAddress {
+// 				panic("mock out the GetResultVoters method")
+// 			},
 // 			GetTotalVotingPowerFunc: func() github_com_cosmos_cosmos_sdk_types.Int {
 // 				panic("mock out the GetTotalVotingPower method")
 // 			},
@@ -63,6 +66,9 @@ type PollMock struct {
 	// GetResultFunc mocks the GetResult method.
 	GetResultFunc func() codec.ProtoMarshaler
 
+	// GetResultVotersFunc mocks the GetResultVoters method.
+	GetResultVotersFunc func() []github_com_cosmos_cosmos_sdk_types.ValAddress
+
 	// GetTotalVotingPowerFunc mocks the GetTotalVotingPower method.
 	GetTotalVotingPowerFunc func() github_com_cosmos_cosmos_sdk_types.Int
 
@@ -89,6 +95,9 @@ type PollMock struct {
 		// GetResult holds details about calls to the GetResult method.
 		GetResult []struct {
 		}
+		// GetResultVoters holds details about calls to the GetResultVoters method.
+		GetResultVoters []struct {
+		}
 		// GetTotalVotingPower holds details about calls to the GetTotalVotingPower method.
 		GetTotalVotingPower []struct {
 		}
@@ -112,6 +121,7 @@ type PollMock struct {
 	lockDelete              sync.RWMutex
 	lockGetKey              sync.RWMutex
 	lockGetResult           sync.RWMutex
+	lockGetResultVoters     sync.RWMutex
 	lockGetTotalVotingPower sync.RWMutex
 	lockGetVoters           sync.RWMutex
 	lockIs                  sync.RWMutex
@@ -222,6 +232,32 @@ func (mock *PollMock) GetResultCalls() []struct {
 	return calls
 }
 
+// GetResultVoters calls GetResultVotersFunc.
+func (mock *PollMock) GetResultVoters() []github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if mock.GetResultVotersFunc == nil {
+		panic("PollMock.GetResultVotersFunc: method is nil but Poll.GetResultVoters was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetResultVoters.Lock()
+	mock.calls.GetResultVoters = append(mock.calls.GetResultVoters, callInfo)
+	mock.lockGetResultVoters.Unlock()
+	return mock.GetResultVotersFunc()
+}
+
+// GetResultVotersCalls gets all the calls that were made to GetResultVoters.
+// Check the length with:
+//     len(mockedPoll.GetResultVotersCalls())
+func (mock *PollMock) GetResultVotersCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetResultVoters.RLock()
+	calls = mock.calls.GetResultVoters
+	mock.lockGetResultVoters.RUnlock()
+	return calls
+}
+
 // GetTotalVotingPower calls GetTotalVotingPowerFunc.
 func (mock *PollMock) GetTotalVotingPower() github_com_cosmos_cosmos_sdk_types.Int {
 	if mock.GetTotalVotingPowerFunc == nil {
@@ -157,6 +157,7 @@ type Poll interface {
 	Is(state PollState) bool
 	AllowOverride()
 	GetResult() codec.ProtoMarshaler
+	GetResultVoters() []sdk.ValAddress
 	GetKey() PollKey
 	GetVoters() []Voter
 	GetTotalVotingPower() sdk.Int
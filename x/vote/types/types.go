@@ -130,6 +130,21 @@ func (p Poll) GetResult() codec.ProtoMarshaler {
 	return p.Result.GetCachedValue().(codec.ProtoMarshaler)
 }
 
+// GetResultVoters returns the validators that voted for the result of the poll. Returns nil if the poll is not completed.
+func (p Poll) GetResultVoters() []sdk.ValAddress {
+	result := p.GetResult()
+	if result == nil {
+		return nil
+	}
+
+	talliedVote, ok := p.GetVote(hash(result))
+	if !ok {
+		return nil
+	}
+
+	return talliedVote.Voters
+}
+
 // Initialize initializes the poll
 func (p Poll) Initialize() error {
 	sumVotingPower := sdk.ZeroInt()
@@ -261,6 +261,27 @@ func TestPoll_Vote(t *testing.T) {
 		assert.Equal(t, voteValue, poll.GetResult())
 	}).Repeat(repeats))
 
+	t.Run("result voters are the validators that voted for the result", testutils.Func(func(t *testing.T) {
+		metadata := newRandomPollMetadata()
+		poll := setup(metadata, rand.PosI64())
+		assert.Nil(t, poll.GetResultVoters())
+
+		voteValue := &gogoprototypes.StringValue{Value: rand.StrBetween(1, 500)}
+		var resultVoters []sdk.ValAddress
+		for voter := range votingPowers {
+			if !poll.Is(exported.Pending) {
+				break
+			}
+
+			addr, _ := sdk.ValAddressFromBech32(voter)
+			assert.NoError(t, poll.Vote(addr, voteValue))
+			resultVoters = append(resultVoters, addr)
+		}
+
+		assert.True(t, poll.Is(exported.Completed))
+		assert.ElementsMatch(t, resultVoters, poll.GetResultVoters())
+	}).Repeat(repeats))
+
 	t.Run("poll fails", testutils.Func(func(t *testing.T) {
 		metadata := newRandomPollMetadata()
 		poll := setup(metadata, rand.PosI64())